@@ -5,11 +5,14 @@ import (
 	_ "cosmossdk.io/api/amino"
 	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -66,11 +69,65 @@ func (x *_Params_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_5_list)(nil)
+
+type _Params_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Params_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                       protoreflect.MessageDescriptor
-	fd_Params_community_tax         protoreflect.FieldDescriptor
-	fd_Params_withdraw_addr_enabled protoreflect.FieldDescriptor
-	fd_Params_reward_weights        protoreflect.FieldDescriptor
+	md_Params                        protoreflect.MessageDescriptor
+	fd_Params_community_tax          protoreflect.FieldDescriptor
+	fd_Params_withdraw_addr_enabled  protoreflect.FieldDescriptor
+	fd_Params_reward_weights         protoreflect.FieldDescriptor
+	fd_Params_dynamic_reward_weights protoreflect.FieldDescriptor
+	fd_Params_gauge_creation_fee     protoreflect.FieldDescriptor
+	fd_Params_max_active_gauges      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -79,6 +136,9 @@ func init() {
 	fd_Params_community_tax = md_Params.Fields().ByName("community_tax")
 	fd_Params_withdraw_addr_enabled = md_Params.Fields().ByName("withdraw_addr_enabled")
 	fd_Params_reward_weights = md_Params.Fields().ByName("reward_weights")
+	fd_Params_dynamic_reward_weights = md_Params.Fields().ByName("dynamic_reward_weights")
+	fd_Params_gauge_creation_fee = md_Params.Fields().ByName("gauge_creation_fee")
+	fd_Params_max_active_gauges = md_Params.Fields().ByName("max_active_gauges")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -164,6 +224,24 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.DynamicRewardWeights != nil {
+		value := protoreflect.ValueOfMessage(x.DynamicRewardWeights.ProtoReflect())
+		if !f(fd_Params_dynamic_reward_weights, value) {
+			return
+		}
+	}
+	if len(x.GaugeCreationFee) != 0 {
+		value := protoreflect.ValueOfList(&_Params_5_list{list: &x.GaugeCreationFee})
+		if !f(fd_Params_gauge_creation_fee, value) {
+			return
+		}
+	}
+	if x.MaxActiveGauges != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxActiveGauges)
+		if !f(fd_Params_max_active_gauges, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -185,6 +263,12 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.WithdrawAddrEnabled != false
 	case "initia.distribution.v1.Params.reward_weights":
 		return len(x.RewardWeights) != 0
+	case "initia.distribution.v1.Params.dynamic_reward_weights":
+		return x.DynamicRewardWeights != nil
+	case "initia.distribution.v1.Params.gauge_creation_fee":
+		return len(x.GaugeCreationFee) != 0
+	case "initia.distribution.v1.Params.max_active_gauges":
+		return x.MaxActiveGauges != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Params"))
@@ -207,6 +291,12 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.WithdrawAddrEnabled = false
 	case "initia.distribution.v1.Params.reward_weights":
 		x.RewardWeights = nil
+	case "initia.distribution.v1.Params.dynamic_reward_weights":
+		x.DynamicRewardWeights = nil
+	case "initia.distribution.v1.Params.gauge_creation_fee":
+		x.GaugeCreationFee = nil
+	case "initia.distribution.v1.Params.max_active_gauges":
+		x.MaxActiveGauges = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Params"))
@@ -235,6 +325,18 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_Params_3_list{list: &x.RewardWeights}
 		return protoreflect.ValueOfList(listValue)
+	case "initia.distribution.v1.Params.dynamic_reward_weights":
+		value := x.DynamicRewardWeights
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.distribution.v1.Params.gauge_creation_fee":
+		if len(x.GaugeCreationFee) == 0 {
+			return protoreflect.ValueOfList(&_Params_5_list{})
+		}
+		listValue := &_Params_5_list{list: &x.GaugeCreationFee}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.distribution.v1.Params.max_active_gauges":
+		value := x.MaxActiveGauges
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Params"))
@@ -263,6 +365,14 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_Params_3_list)
 		x.RewardWeights = *clv.list
+	case "initia.distribution.v1.Params.dynamic_reward_weights":
+		x.DynamicRewardWeights = value.Message().Interface().(*DynamicRewardWeights)
+	case "initia.distribution.v1.Params.gauge_creation_fee":
+		lv := value.List()
+		clv := lv.(*_Params_5_list)
+		x.GaugeCreationFee = *clv.list
+	case "initia.distribution.v1.Params.max_active_gauges":
+		x.MaxActiveGauges = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Params"))
@@ -289,10 +399,23 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Params_3_list{list: &x.RewardWeights}
 		return protoreflect.ValueOfList(value)
+	case "initia.distribution.v1.Params.dynamic_reward_weights":
+		if x.DynamicRewardWeights == nil {
+			x.DynamicRewardWeights = new(DynamicRewardWeights)
+		}
+		return protoreflect.ValueOfMessage(x.DynamicRewardWeights.ProtoReflect())
+	case "initia.distribution.v1.Params.gauge_creation_fee":
+		if x.GaugeCreationFee == nil {
+			x.GaugeCreationFee = []*v1beta1.Coin{}
+		}
+		value := &_Params_5_list{list: &x.GaugeCreationFee}
+		return protoreflect.ValueOfList(value)
 	case "initia.distribution.v1.Params.community_tax":
 		panic(fmt.Errorf("field community_tax of message initia.distribution.v1.Params is not mutable"))
 	case "initia.distribution.v1.Params.withdraw_addr_enabled":
 		panic(fmt.Errorf("field withdraw_addr_enabled of message initia.distribution.v1.Params is not mutable"))
+	case "initia.distribution.v1.Params.max_active_gauges":
+		panic(fmt.Errorf("field max_active_gauges of message initia.distribution.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Params"))
@@ -313,6 +436,14 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "initia.distribution.v1.Params.reward_weights":
 		list := []*RewardWeight{}
 		return protoreflect.ValueOfList(&_Params_3_list{list: &list})
+	case "initia.distribution.v1.Params.dynamic_reward_weights":
+		m := new(DynamicRewardWeights)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.distribution.v1.Params.gauge_creation_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_5_list{list: &list})
+	case "initia.distribution.v1.Params.max_active_gauges":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Params"))
@@ -395,6 +526,19 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.DynamicRewardWeights != nil {
+			l = options.Size(x.DynamicRewardWeights)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.GaugeCreationFee) > 0 {
+			for _, e := range x.GaugeCreationFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxActiveGauges != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxActiveGauges))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -424,6 +568,41 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxActiveGauges != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxActiveGauges))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.GaugeCreationFee) > 0 {
+			for iNdEx := len(x.GaugeCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.GaugeCreationFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.DynamicRewardWeights != nil {
+			encoded, err := options.Marshal(x.DynamicRewardWeights)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
 		if len(x.RewardWeights) > 0 {
 			for iNdEx := len(x.RewardWeights) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.RewardWeights[iNdEx])
@@ -592,6 +771,95 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DynamicRewardWeights", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DynamicRewardWeights == nil {
+					x.DynamicRewardWeights = &DynamicRewardWeights{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DynamicRewardWeights); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GaugeCreationFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GaugeCreationFee = append(x.GaugeCreationFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GaugeCreationFee[len(x.GaugeCreationFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxActiveGauges", wireType)
+				}
+				x.MaxActiveGauges = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxActiveGauges |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -627,28 +895,83 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_DynamicRewardWeights_4_list)(nil)
+
+type _DynamicRewardWeights_4_list struct {
+	list *[]*RewardWeight
+}
+
+func (x *_DynamicRewardWeights_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DynamicRewardWeights_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DynamicRewardWeights_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RewardWeight)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DynamicRewardWeights_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RewardWeight)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DynamicRewardWeights_4_list) AppendMutable() protoreflect.Value {
+	v := new(RewardWeight)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DynamicRewardWeights_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DynamicRewardWeights_4_list) NewElement() protoreflect.Value {
+	v := new(RewardWeight)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DynamicRewardWeights_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_RewardWeight        protoreflect.MessageDescriptor
-	fd_RewardWeight_denom  protoreflect.FieldDescriptor
-	fd_RewardWeight_weight protoreflect.FieldDescriptor
+	md_DynamicRewardWeights                protoreflect.MessageDescriptor
+	fd_DynamicRewardWeights_enabled        protoreflect.FieldDescriptor
+	fd_DynamicRewardWeights_epoch_duration protoreflect.FieldDescriptor
+	fd_DynamicRewardWeights_max_change     protoreflect.FieldDescriptor
+	fd_DynamicRewardWeights_multipliers    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_initia_distribution_v1_distribution_proto_init()
-	md_RewardWeight = File_initia_distribution_v1_distribution_proto.Messages().ByName("RewardWeight")
-	fd_RewardWeight_denom = md_RewardWeight.Fields().ByName("denom")
-	fd_RewardWeight_weight = md_RewardWeight.Fields().ByName("weight")
+	md_DynamicRewardWeights = File_initia_distribution_v1_distribution_proto.Messages().ByName("DynamicRewardWeights")
+	fd_DynamicRewardWeights_enabled = md_DynamicRewardWeights.Fields().ByName("enabled")
+	fd_DynamicRewardWeights_epoch_duration = md_DynamicRewardWeights.Fields().ByName("epoch_duration")
+	fd_DynamicRewardWeights_max_change = md_DynamicRewardWeights.Fields().ByName("max_change")
+	fd_DynamicRewardWeights_multipliers = md_DynamicRewardWeights.Fields().ByName("multipliers")
 }
 
-var _ protoreflect.Message = (*fastReflection_RewardWeight)(nil)
+var _ protoreflect.Message = (*fastReflection_DynamicRewardWeights)(nil)
 
-type fastReflection_RewardWeight RewardWeight
+type fastReflection_DynamicRewardWeights DynamicRewardWeights
 
-func (x *RewardWeight) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RewardWeight)(x)
+func (x *DynamicRewardWeights) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DynamicRewardWeights)(x)
 }
 
-func (x *RewardWeight) slowProtoReflect() protoreflect.Message {
+func (x *DynamicRewardWeights) slowProtoReflect() protoreflect.Message {
 	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -660,43 +983,43 @@ func (x *RewardWeight) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RewardWeight_messageType fastReflection_RewardWeight_messageType
-var _ protoreflect.MessageType = fastReflection_RewardWeight_messageType{}
+var _fastReflection_DynamicRewardWeights_messageType fastReflection_DynamicRewardWeights_messageType
+var _ protoreflect.MessageType = fastReflection_DynamicRewardWeights_messageType{}
 
-type fastReflection_RewardWeight_messageType struct{}
+type fastReflection_DynamicRewardWeights_messageType struct{}
 
-func (x fastReflection_RewardWeight_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RewardWeight)(nil)
+func (x fastReflection_DynamicRewardWeights_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DynamicRewardWeights)(nil)
 }
-func (x fastReflection_RewardWeight_messageType) New() protoreflect.Message {
-	return new(fastReflection_RewardWeight)
+func (x fastReflection_DynamicRewardWeights_messageType) New() protoreflect.Message {
+	return new(fastReflection_DynamicRewardWeights)
 }
-func (x fastReflection_RewardWeight_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RewardWeight
+func (x fastReflection_DynamicRewardWeights_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DynamicRewardWeights
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RewardWeight) Descriptor() protoreflect.MessageDescriptor {
-	return md_RewardWeight
+func (x *fastReflection_DynamicRewardWeights) Descriptor() protoreflect.MessageDescriptor {
+	return md_DynamicRewardWeights
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RewardWeight) Type() protoreflect.MessageType {
-	return _fastReflection_RewardWeight_messageType
+func (x *fastReflection_DynamicRewardWeights) Type() protoreflect.MessageType {
+	return _fastReflection_DynamicRewardWeights_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RewardWeight) New() protoreflect.Message {
-	return new(fastReflection_RewardWeight)
+func (x *fastReflection_DynamicRewardWeights) New() protoreflect.Message {
+	return new(fastReflection_DynamicRewardWeights)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RewardWeight) Interface() protoreflect.ProtoMessage {
-	return (*RewardWeight)(x)
+func (x *fastReflection_DynamicRewardWeights) Interface() protoreflect.ProtoMessage {
+	return (*DynamicRewardWeights)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -704,16 +1027,28 @@ func (x *fastReflection_RewardWeight) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RewardWeight) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Denom != "" {
-		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_RewardWeight_denom, value) {
+func (x *fastReflection_DynamicRewardWeights) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_DynamicRewardWeights_enabled, value) {
 			return
 		}
 	}
-	if x.Weight != "" {
-		value := protoreflect.ValueOfString(x.Weight)
-		if !f(fd_RewardWeight_weight, value) {
+	if x.EpochDuration != nil {
+		value := protoreflect.ValueOfMessage(x.EpochDuration.ProtoReflect())
+		if !f(fd_DynamicRewardWeights_epoch_duration, value) {
+			return
+		}
+	}
+	if x.MaxChange != "" {
+		value := protoreflect.ValueOfString(x.MaxChange)
+		if !f(fd_DynamicRewardWeights_max_change, value) {
+			return
+		}
+	}
+	if len(x.Multipliers) != 0 {
+		value := protoreflect.ValueOfList(&_DynamicRewardWeights_4_list{list: &x.Multipliers})
+		if !f(fd_DynamicRewardWeights_multipliers, value) {
 			return
 		}
 	}
@@ -730,17 +1065,21 @@ func (x *fastReflection_RewardWeight) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RewardWeight) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DynamicRewardWeights) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "initia.distribution.v1.RewardWeight.denom":
-		return x.Denom != ""
-	case "initia.distribution.v1.RewardWeight.weight":
-		return x.Weight != ""
+	case "initia.distribution.v1.DynamicRewardWeights.enabled":
+		return x.Enabled != false
+	case "initia.distribution.v1.DynamicRewardWeights.epoch_duration":
+		return x.EpochDuration != nil
+	case "initia.distribution.v1.DynamicRewardWeights.max_change":
+		return x.MaxChange != ""
+	case "initia.distribution.v1.DynamicRewardWeights.multipliers":
+		return len(x.Multipliers) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.RewardWeight"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DynamicRewardWeights"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.RewardWeight does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.DynamicRewardWeights does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -750,17 +1089,21 @@ func (x *fastReflection_RewardWeight) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RewardWeight) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DynamicRewardWeights) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "initia.distribution.v1.RewardWeight.denom":
-		x.Denom = ""
-	case "initia.distribution.v1.RewardWeight.weight":
-		x.Weight = ""
+	case "initia.distribution.v1.DynamicRewardWeights.enabled":
+		x.Enabled = false
+	case "initia.distribution.v1.DynamicRewardWeights.epoch_duration":
+		x.EpochDuration = nil
+	case "initia.distribution.v1.DynamicRewardWeights.max_change":
+		x.MaxChange = ""
+	case "initia.distribution.v1.DynamicRewardWeights.multipliers":
+		x.Multipliers = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.RewardWeight"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DynamicRewardWeights"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.RewardWeight does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.DynamicRewardWeights does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -770,19 +1113,28 @@ func (x *fastReflection_RewardWeight) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RewardWeight) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DynamicRewardWeights) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "initia.distribution.v1.RewardWeight.denom":
-		value := x.Denom
-		return protoreflect.ValueOfString(value)
-	case "initia.distribution.v1.RewardWeight.weight":
-		value := x.Weight
+	case "initia.distribution.v1.DynamicRewardWeights.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
+	case "initia.distribution.v1.DynamicRewardWeights.epoch_duration":
+		value := x.EpochDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.distribution.v1.DynamicRewardWeights.max_change":
+		value := x.MaxChange
 		return protoreflect.ValueOfString(value)
+	case "initia.distribution.v1.DynamicRewardWeights.multipliers":
+		if len(x.Multipliers) == 0 {
+			return protoreflect.ValueOfList(&_DynamicRewardWeights_4_list{})
+		}
+		listValue := &_DynamicRewardWeights_4_list{list: &x.Multipliers}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.RewardWeight"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DynamicRewardWeights"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.RewardWeight does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.DynamicRewardWeights does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -796,17 +1148,23 @@ func (x *fastReflection_RewardWeight) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RewardWeight) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DynamicRewardWeights) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "initia.distribution.v1.RewardWeight.denom":
-		x.Denom = value.Interface().(string)
-	case "initia.distribution.v1.RewardWeight.weight":
-		x.Weight = value.Interface().(string)
+	case "initia.distribution.v1.DynamicRewardWeights.enabled":
+		x.Enabled = value.Bool()
+	case "initia.distribution.v1.DynamicRewardWeights.epoch_duration":
+		x.EpochDuration = value.Message().Interface().(*durationpb.Duration)
+	case "initia.distribution.v1.DynamicRewardWeights.max_change":
+		x.MaxChange = value.Interface().(string)
+	case "initia.distribution.v1.DynamicRewardWeights.multipliers":
+		lv := value.List()
+		clv := lv.(*_DynamicRewardWeights_4_list)
+		x.Multipliers = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.RewardWeight"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DynamicRewardWeights"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.RewardWeight does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.DynamicRewardWeights does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -820,44 +1178,61 @@ func (x *fastReflection_RewardWeight) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RewardWeight) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DynamicRewardWeights) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.distribution.v1.RewardWeight.denom":
-		panic(fmt.Errorf("field denom of message initia.distribution.v1.RewardWeight is not mutable"))
-	case "initia.distribution.v1.RewardWeight.weight":
-		panic(fmt.Errorf("field weight of message initia.distribution.v1.RewardWeight is not mutable"))
+	case "initia.distribution.v1.DynamicRewardWeights.epoch_duration":
+		if x.EpochDuration == nil {
+			x.EpochDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.EpochDuration.ProtoReflect())
+	case "initia.distribution.v1.DynamicRewardWeights.multipliers":
+		if x.Multipliers == nil {
+			x.Multipliers = []*RewardWeight{}
+		}
+		value := &_DynamicRewardWeights_4_list{list: &x.Multipliers}
+		return protoreflect.ValueOfList(value)
+	case "initia.distribution.v1.DynamicRewardWeights.enabled":
+		panic(fmt.Errorf("field enabled of message initia.distribution.v1.DynamicRewardWeights is not mutable"))
+	case "initia.distribution.v1.DynamicRewardWeights.max_change":
+		panic(fmt.Errorf("field max_change of message initia.distribution.v1.DynamicRewardWeights is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.RewardWeight"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DynamicRewardWeights"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.RewardWeight does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.DynamicRewardWeights does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RewardWeight) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DynamicRewardWeights) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.distribution.v1.RewardWeight.denom":
-		return protoreflect.ValueOfString("")
-	case "initia.distribution.v1.RewardWeight.weight":
+	case "initia.distribution.v1.DynamicRewardWeights.enabled":
+		return protoreflect.ValueOfBool(false)
+	case "initia.distribution.v1.DynamicRewardWeights.epoch_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.distribution.v1.DynamicRewardWeights.max_change":
 		return protoreflect.ValueOfString("")
+	case "initia.distribution.v1.DynamicRewardWeights.multipliers":
+		list := []*RewardWeight{}
+		return protoreflect.ValueOfList(&_DynamicRewardWeights_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.RewardWeight"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DynamicRewardWeights"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.RewardWeight does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.DynamicRewardWeights does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RewardWeight) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DynamicRewardWeights) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.RewardWeight", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.DynamicRewardWeights", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -865,7 +1240,7 @@ func (x *fastReflection_RewardWeight) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RewardWeight) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DynamicRewardWeights) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -876,7 +1251,7 @@ func (x *fastReflection_RewardWeight) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RewardWeight) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DynamicRewardWeights) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -888,7 +1263,7 @@ func (x *fastReflection_RewardWeight) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RewardWeight) IsValid() bool {
+func (x *fastReflection_DynamicRewardWeights) IsValid() bool {
 	return x != nil
 }
 
@@ -898,9 +1273,9 @@ func (x *fastReflection_RewardWeight) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RewardWeight) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DynamicRewardWeights) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RewardWeight)
+		x := input.Message.Interface().(*DynamicRewardWeights)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -912,14 +1287,23 @@ func (x *fastReflection_RewardWeight) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Denom)
-		if l > 0 {
+		if x.Enabled {
+			n += 2
+		}
+		if x.EpochDuration != nil {
+			l = options.Size(x.EpochDuration)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Weight)
+		l = len(x.MaxChange)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Multipliers) > 0 {
+			for _, e := range x.Multipliers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -930,7 +1314,7 @@ func (x *fastReflection_RewardWeight) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RewardWeight)
+		x := input.Message.Interface().(*DynamicRewardWeights)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -949,19 +1333,52 @@ func (x *fastReflection_RewardWeight) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Weight) > 0 {
-			i -= len(x.Weight)
-			copy(dAtA[i:], x.Weight)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Weight)))
+		if len(x.Multipliers) > 0 {
+			for iNdEx := len(x.Multipliers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Multipliers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.MaxChange) > 0 {
+			i -= len(x.MaxChange)
+			copy(dAtA[i:], x.MaxChange)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxChange)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.EpochDuration != nil {
+			encoded, err := options.Marshal(x.EpochDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+		if x.Enabled {
 			i--
-			dAtA[i] = 0xa
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -974,7 +1391,7 @@ func (x *fastReflection_RewardWeight) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RewardWeight)
+		x := input.Message.Interface().(*DynamicRewardWeights)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1006,17 +1423,37 @@ func (x *fastReflection_RewardWeight) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RewardWeight: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DynamicRewardWeights: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RewardWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DynamicRewardWeights: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Enabled = bool(v != 0)
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochDuration", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1026,27 +1463,31 @@ func (x *fastReflection_RewardWeight) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Denom = string(dAtA[iNdEx:postIndex])
+				if x.EpochDuration == nil {
+					x.EpochDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EpochDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 2:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxChange", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1074,7 +1515,41 @@ func (x *fastReflection_RewardWeight) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Weight = string(dAtA[iNdEx:postIndex])
+				x.MaxChange = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Multipliers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Multipliers = append(x.Multipliers, &RewardWeight{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Multipliers[len(x.Multipliers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1111,79 +1586,28 @@ func (x *fastReflection_RewardWeight) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Pool_2_list)(nil)
-
-type _Pool_2_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_Pool_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_Pool_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_Pool_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Pool_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Pool_2_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Pool_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Pool_2_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Pool_2_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_Pool       protoreflect.MessageDescriptor
-	fd_Pool_denom protoreflect.FieldDescriptor
-	fd_Pool_coins protoreflect.FieldDescriptor
+	md_RewardWeight        protoreflect.MessageDescriptor
+	fd_RewardWeight_denom  protoreflect.FieldDescriptor
+	fd_RewardWeight_weight protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_initia_distribution_v1_distribution_proto_init()
-	md_Pool = File_initia_distribution_v1_distribution_proto.Messages().ByName("Pool")
-	fd_Pool_denom = md_Pool.Fields().ByName("denom")
-	fd_Pool_coins = md_Pool.Fields().ByName("coins")
+	md_RewardWeight = File_initia_distribution_v1_distribution_proto.Messages().ByName("RewardWeight")
+	fd_RewardWeight_denom = md_RewardWeight.Fields().ByName("denom")
+	fd_RewardWeight_weight = md_RewardWeight.Fields().ByName("weight")
 }
 
-var _ protoreflect.Message = (*fastReflection_Pool)(nil)
+var _ protoreflect.Message = (*fastReflection_RewardWeight)(nil)
 
-type fastReflection_Pool Pool
+type fastReflection_RewardWeight RewardWeight
 
-func (x *Pool) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Pool)(x)
+func (x *RewardWeight) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RewardWeight)(x)
 }
 
-func (x *Pool) slowProtoReflect() protoreflect.Message {
+func (x *RewardWeight) slowProtoReflect() protoreflect.Message {
 	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1195,60 +1619,60 @@ func (x *Pool) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Pool_messageType fastReflection_Pool_messageType
-var _ protoreflect.MessageType = fastReflection_Pool_messageType{}
+var _fastReflection_RewardWeight_messageType fastReflection_RewardWeight_messageType
+var _ protoreflect.MessageType = fastReflection_RewardWeight_messageType{}
 
-type fastReflection_Pool_messageType struct{}
+type fastReflection_RewardWeight_messageType struct{}
 
-func (x fastReflection_Pool_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Pool)(nil)
+func (x fastReflection_RewardWeight_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RewardWeight)(nil)
 }
-func (x fastReflection_Pool_messageType) New() protoreflect.Message {
-	return new(fastReflection_Pool)
+func (x fastReflection_RewardWeight_messageType) New() protoreflect.Message {
+	return new(fastReflection_RewardWeight)
 }
-func (x fastReflection_Pool_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Pool
+func (x fastReflection_RewardWeight_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RewardWeight
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Pool) Descriptor() protoreflect.MessageDescriptor {
-	return md_Pool
+func (x *fastReflection_RewardWeight) Descriptor() protoreflect.MessageDescriptor {
+	return md_RewardWeight
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Pool) Type() protoreflect.MessageType {
-	return _fastReflection_Pool_messageType
+func (x *fastReflection_RewardWeight) Type() protoreflect.MessageType {
+	return _fastReflection_RewardWeight_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Pool) New() protoreflect.Message {
-	return new(fastReflection_Pool)
+func (x *fastReflection_RewardWeight) New() protoreflect.Message {
+	return new(fastReflection_RewardWeight)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Pool) Interface() protoreflect.ProtoMessage {
-	return (*Pool)(x)
-}
+func (x *fastReflection_RewardWeight) Interface() protoreflect.ProtoMessage {
+	return (*RewardWeight)(x)
+}
 
 // Range iterates over every populated field in an undefined order,
 // calling f for each field descriptor and value encountered.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Pool) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_RewardWeight) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Denom != "" {
 		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_Pool_denom, value) {
+		if !f(fd_RewardWeight_denom, value) {
 			return
 		}
 	}
-	if len(x.Coins) != 0 {
-		value := protoreflect.ValueOfList(&_Pool_2_list{list: &x.Coins})
-		if !f(fd_Pool_coins, value) {
+	if x.Weight != "" {
+		value := protoreflect.ValueOfString(x.Weight)
+		if !f(fd_RewardWeight_weight, value) {
 			return
 		}
 	}
@@ -1265,17 +1689,17 @@ func (x *fastReflection_Pool) Range(f func(protoreflect.FieldDescriptor, protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Pool) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RewardWeight) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "initia.distribution.v1.Pool.denom":
+	case "initia.distribution.v1.RewardWeight.denom":
 		return x.Denom != ""
-	case "initia.distribution.v1.Pool.coins":
-		return len(x.Coins) != 0
+	case "initia.distribution.v1.RewardWeight.weight":
+		return x.Weight != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Pool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.RewardWeight"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.Pool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.RewardWeight does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1285,17 +1709,17 @@ func (x *fastReflection_Pool) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Pool) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RewardWeight) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "initia.distribution.v1.Pool.denom":
+	case "initia.distribution.v1.RewardWeight.denom":
 		x.Denom = ""
-	case "initia.distribution.v1.Pool.coins":
-		x.Coins = nil
+	case "initia.distribution.v1.RewardWeight.weight":
+		x.Weight = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Pool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.RewardWeight"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.Pool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.RewardWeight does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1305,22 +1729,19 @@ func (x *fastReflection_Pool) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Pool) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RewardWeight) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "initia.distribution.v1.Pool.denom":
+	case "initia.distribution.v1.RewardWeight.denom":
 		value := x.Denom
 		return protoreflect.ValueOfString(value)
-	case "initia.distribution.v1.Pool.coins":
-		if len(x.Coins) == 0 {
-			return protoreflect.ValueOfList(&_Pool_2_list{})
-		}
-		listValue := &_Pool_2_list{list: &x.Coins}
-		return protoreflect.ValueOfList(listValue)
+	case "initia.distribution.v1.RewardWeight.weight":
+		value := x.Weight
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Pool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.RewardWeight"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.Pool does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.RewardWeight does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1334,19 +1755,17 @@ func (x *fastReflection_Pool) Get(descriptor protoreflect.FieldDescriptor) proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Pool) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RewardWeight) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "initia.distribution.v1.Pool.denom":
+	case "initia.distribution.v1.RewardWeight.denom":
 		x.Denom = value.Interface().(string)
-	case "initia.distribution.v1.Pool.coins":
-		lv := value.List()
-		clv := lv.(*_Pool_2_list)
-		x.Coins = *clv.list
+	case "initia.distribution.v1.RewardWeight.weight":
+		x.Weight = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Pool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.RewardWeight"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.Pool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.RewardWeight does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1360,49 +1779,44 @@ func (x *fastReflection_Pool) Set(fd protoreflect.FieldDescriptor, value protore
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Pool) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RewardWeight) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.distribution.v1.Pool.coins":
-		if x.Coins == nil {
-			x.Coins = []*v1beta1.Coin{}
-		}
-		value := &_Pool_2_list{list: &x.Coins}
-		return protoreflect.ValueOfList(value)
-	case "initia.distribution.v1.Pool.denom":
-		panic(fmt.Errorf("field denom of message initia.distribution.v1.Pool is not mutable"))
+	case "initia.distribution.v1.RewardWeight.denom":
+		panic(fmt.Errorf("field denom of message initia.distribution.v1.RewardWeight is not mutable"))
+	case "initia.distribution.v1.RewardWeight.weight":
+		panic(fmt.Errorf("field weight of message initia.distribution.v1.RewardWeight is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Pool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.RewardWeight"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.Pool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.RewardWeight does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Pool) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RewardWeight) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.distribution.v1.Pool.denom":
+	case "initia.distribution.v1.RewardWeight.denom":
+		return protoreflect.ValueOfString("")
+	case "initia.distribution.v1.RewardWeight.weight":
 		return protoreflect.ValueOfString("")
-	case "initia.distribution.v1.Pool.coins":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Pool_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Pool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.RewardWeight"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.Pool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.RewardWeight does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Pool) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RewardWeight) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.Pool", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.RewardWeight", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1410,7 +1824,7 @@ func (x *fastReflection_Pool) WhichOneof(d protoreflect.OneofDescriptor) protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Pool) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RewardWeight) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1421,7 +1835,7 @@ func (x *fastReflection_Pool) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Pool) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RewardWeight) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1433,7 +1847,7 @@ func (x *fastReflection_Pool) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Pool) IsValid() bool {
+func (x *fastReflection_RewardWeight) IsValid() bool {
 	return x != nil
 }
 
@@ -1443,9 +1857,9 @@ func (x *fastReflection_Pool) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RewardWeight) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Pool)
+		x := input.Message.Interface().(*RewardWeight)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1461,11 +1875,9 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Coins) > 0 {
-			for _, e := range x.Coins {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Weight)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1477,7 +1889,7 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Pool)
+		x := input.Message.Interface().(*RewardWeight)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1496,21 +1908,12 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Coins) > 0 {
-			for iNdEx := len(x.Coins) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Coins[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
+		if len(x.Weight) > 0 {
+			i -= len(x.Weight)
+			copy(dAtA[i:], x.Weight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Weight)))
+			i--
+			dAtA[i] = 0x12
 		}
 		if len(x.Denom) > 0 {
 			i -= len(x.Denom)
@@ -1530,7 +1933,7 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Pool)
+		x := input.Message.Interface().(*RewardWeight)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1562,10 +1965,10 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Pool: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RewardWeight: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RewardWeight: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -1602,9 +2005,9 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1614,25 +2017,23 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Coins = append(x.Coins, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Coins[len(x.Coins)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Weight = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1669,79 +2070,79 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_DecPool_2_list)(nil)
+var _ protoreflect.List = (*_Pool_2_list)(nil)
 
-type _DecPool_2_list struct {
-	list *[]*v1beta1.DecCoin
+type _Pool_2_list struct {
+	list *[]*v1beta1.Coin
 }
 
-func (x *_DecPool_2_list) Len() int {
+func (x *_Pool_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_DecPool_2_list) Get(i int) protoreflect.Value {
+func (x *_Pool_2_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_DecPool_2_list) Set(i int, value protoreflect.Value) {
+func (x *_Pool_2_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_DecPool_2_list) Append(value protoreflect.Value) {
+func (x *_Pool_2_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_DecPool_2_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.DecCoin)
+func (x *_Pool_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_DecPool_2_list) Truncate(n int) {
+func (x *_Pool_2_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_DecPool_2_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.DecCoin)
+func (x *_Pool_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_DecPool_2_list) IsValid() bool {
+func (x *_Pool_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_DecPool           protoreflect.MessageDescriptor
-	fd_DecPool_denom     protoreflect.FieldDescriptor
-	fd_DecPool_dec_coins protoreflect.FieldDescriptor
+	md_Pool       protoreflect.MessageDescriptor
+	fd_Pool_denom protoreflect.FieldDescriptor
+	fd_Pool_coins protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_initia_distribution_v1_distribution_proto_init()
-	md_DecPool = File_initia_distribution_v1_distribution_proto.Messages().ByName("DecPool")
-	fd_DecPool_denom = md_DecPool.Fields().ByName("denom")
-	fd_DecPool_dec_coins = md_DecPool.Fields().ByName("dec_coins")
+	md_Pool = File_initia_distribution_v1_distribution_proto.Messages().ByName("Pool")
+	fd_Pool_denom = md_Pool.Fields().ByName("denom")
+	fd_Pool_coins = md_Pool.Fields().ByName("coins")
 }
 
-var _ protoreflect.Message = (*fastReflection_DecPool)(nil)
+var _ protoreflect.Message = (*fastReflection_Pool)(nil)
 
-type fastReflection_DecPool DecPool
+type fastReflection_Pool Pool
 
-func (x *DecPool) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DecPool)(x)
+func (x *Pool) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Pool)(x)
 }
 
-func (x *DecPool) slowProtoReflect() protoreflect.Message {
+func (x *Pool) slowProtoReflect() protoreflect.Message {
 	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1753,43 +2154,43 @@ func (x *DecPool) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DecPool_messageType fastReflection_DecPool_messageType
-var _ protoreflect.MessageType = fastReflection_DecPool_messageType{}
+var _fastReflection_Pool_messageType fastReflection_Pool_messageType
+var _ protoreflect.MessageType = fastReflection_Pool_messageType{}
 
-type fastReflection_DecPool_messageType struct{}
+type fastReflection_Pool_messageType struct{}
 
-func (x fastReflection_DecPool_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DecPool)(nil)
+func (x fastReflection_Pool_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Pool)(nil)
 }
-func (x fastReflection_DecPool_messageType) New() protoreflect.Message {
-	return new(fastReflection_DecPool)
+func (x fastReflection_Pool_messageType) New() protoreflect.Message {
+	return new(fastReflection_Pool)
 }
-func (x fastReflection_DecPool_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DecPool
+func (x fastReflection_Pool_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Pool
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DecPool) Descriptor() protoreflect.MessageDescriptor {
-	return md_DecPool
+func (x *fastReflection_Pool) Descriptor() protoreflect.MessageDescriptor {
+	return md_Pool
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DecPool) Type() protoreflect.MessageType {
-	return _fastReflection_DecPool_messageType
+func (x *fastReflection_Pool) Type() protoreflect.MessageType {
+	return _fastReflection_Pool_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DecPool) New() protoreflect.Message {
-	return new(fastReflection_DecPool)
+func (x *fastReflection_Pool) New() protoreflect.Message {
+	return new(fastReflection_Pool)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DecPool) Interface() protoreflect.ProtoMessage {
-	return (*DecPool)(x)
+func (x *fastReflection_Pool) Interface() protoreflect.ProtoMessage {
+	return (*Pool)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1797,16 +2198,16 @@ func (x *fastReflection_DecPool) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DecPool) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_Pool) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Denom != "" {
 		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_DecPool_denom, value) {
+		if !f(fd_Pool_denom, value) {
 			return
 		}
 	}
-	if len(x.DecCoins) != 0 {
-		value := protoreflect.ValueOfList(&_DecPool_2_list{list: &x.DecCoins})
-		if !f(fd_DecPool_dec_coins, value) {
+	if len(x.Coins) != 0 {
+		value := protoreflect.ValueOfList(&_Pool_2_list{list: &x.Coins})
+		if !f(fd_Pool_coins, value) {
 			return
 		}
 	}
@@ -1823,17 +2224,17 @@ func (x *fastReflection_DecPool) Range(f func(protoreflect.FieldDescriptor, prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DecPool) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Pool) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "initia.distribution.v1.DecPool.denom":
+	case "initia.distribution.v1.Pool.denom":
 		return x.Denom != ""
-	case "initia.distribution.v1.DecPool.dec_coins":
-		return len(x.DecCoins) != 0
+	case "initia.distribution.v1.Pool.coins":
+		return len(x.Coins) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DecPool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Pool"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.DecPool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.Pool does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1843,17 +2244,17 @@ func (x *fastReflection_DecPool) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DecPool) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Pool) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "initia.distribution.v1.DecPool.denom":
+	case "initia.distribution.v1.Pool.denom":
 		x.Denom = ""
-	case "initia.distribution.v1.DecPool.dec_coins":
-		x.DecCoins = nil
+	case "initia.distribution.v1.Pool.coins":
+		x.Coins = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DecPool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Pool"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.DecPool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.Pool does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1863,22 +2264,22 @@ func (x *fastReflection_DecPool) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DecPool) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Pool) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "initia.distribution.v1.DecPool.denom":
+	case "initia.distribution.v1.Pool.denom":
 		value := x.Denom
 		return protoreflect.ValueOfString(value)
-	case "initia.distribution.v1.DecPool.dec_coins":
-		if len(x.DecCoins) == 0 {
-			return protoreflect.ValueOfList(&_DecPool_2_list{})
+	case "initia.distribution.v1.Pool.coins":
+		if len(x.Coins) == 0 {
+			return protoreflect.ValueOfList(&_Pool_2_list{})
 		}
-		listValue := &_DecPool_2_list{list: &x.DecCoins}
+		listValue := &_Pool_2_list{list: &x.Coins}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DecPool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Pool"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.DecPool does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.Pool does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1892,19 +2293,19 @@ func (x *fastReflection_DecPool) Get(descriptor protoreflect.FieldDescriptor) pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DecPool) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Pool) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "initia.distribution.v1.DecPool.denom":
+	case "initia.distribution.v1.Pool.denom":
 		x.Denom = value.Interface().(string)
-	case "initia.distribution.v1.DecPool.dec_coins":
+	case "initia.distribution.v1.Pool.coins":
 		lv := value.List()
-		clv := lv.(*_DecPool_2_list)
-		x.DecCoins = *clv.list
+		clv := lv.(*_Pool_2_list)
+		x.Coins = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DecPool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Pool"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.DecPool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.Pool does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1918,49 +2319,49 @@ func (x *fastReflection_DecPool) Set(fd protoreflect.FieldDescriptor, value prot
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DecPool) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Pool) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.distribution.v1.DecPool.dec_coins":
-		if x.DecCoins == nil {
-			x.DecCoins = []*v1beta1.DecCoin{}
+	case "initia.distribution.v1.Pool.coins":
+		if x.Coins == nil {
+			x.Coins = []*v1beta1.Coin{}
 		}
-		value := &_DecPool_2_list{list: &x.DecCoins}
+		value := &_Pool_2_list{list: &x.Coins}
 		return protoreflect.ValueOfList(value)
-	case "initia.distribution.v1.DecPool.denom":
-		panic(fmt.Errorf("field denom of message initia.distribution.v1.DecPool is not mutable"))
+	case "initia.distribution.v1.Pool.denom":
+		panic(fmt.Errorf("field denom of message initia.distribution.v1.Pool is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DecPool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Pool"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.DecPool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.Pool does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DecPool) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Pool) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.distribution.v1.DecPool.denom":
+	case "initia.distribution.v1.Pool.denom":
 		return protoreflect.ValueOfString("")
-	case "initia.distribution.v1.DecPool.dec_coins":
-		list := []*v1beta1.DecCoin{}
-		return protoreflect.ValueOfList(&_DecPool_2_list{list: &list})
+	case "initia.distribution.v1.Pool.coins":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Pool_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DecPool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Pool"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.DecPool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.Pool does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DecPool) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Pool) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.DecPool", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.Pool", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1968,7 +2369,7 @@ func (x *fastReflection_DecPool) WhichOneof(d protoreflect.OneofDescriptor) prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DecPool) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Pool) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1979,7 +2380,7 @@ func (x *fastReflection_DecPool) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DecPool) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Pool) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1991,7 +2392,7 @@ func (x *fastReflection_DecPool) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DecPool) IsValid() bool {
+func (x *fastReflection_Pool) IsValid() bool {
 	return x != nil
 }
 
@@ -2001,9 +2402,9 @@ func (x *fastReflection_DecPool) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DecPool) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DecPool)
+		x := input.Message.Interface().(*Pool)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2019,8 +2420,8 @@ func (x *fastReflection_DecPool) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.DecCoins) > 0 {
-			for _, e := range x.DecCoins {
+		if len(x.Coins) > 0 {
+			for _, e := range x.Coins {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -2035,7 +2436,7 @@ func (x *fastReflection_DecPool) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DecPool)
+		x := input.Message.Interface().(*Pool)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2054,9 +2455,9 @@ func (x *fastReflection_DecPool) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.DecCoins) > 0 {
-			for iNdEx := len(x.DecCoins) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.DecCoins[iNdEx])
+		if len(x.Coins) > 0 {
+			for iNdEx := len(x.Coins) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Coins[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2088,7 +2489,7 @@ func (x *fastReflection_DecPool) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DecPool)
+		x := input.Message.Interface().(*Pool)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2120,10 +2521,10 @@ func (x *fastReflection_DecPool) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DecPool: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Pool: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DecPool: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2160,7 +2561,7 @@ func (x *fastReflection_DecPool) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecCoins", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2187,8 +2588,8 @@ func (x *fastReflection_DecPool) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DecCoins = append(x.DecCoins, &v1beta1.DecCoin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DecCoins[len(x.DecCoins)-1]); err != nil {
+				x.Coins = append(x.Coins, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Coins[len(x.Coins)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -2227,79 +2628,79 @@ func (x *fastReflection_DecPool) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_ValidatorHistoricalRewards_1_list)(nil)
+var _ protoreflect.List = (*_DecPool_2_list)(nil)
 
-type _ValidatorHistoricalRewards_1_list struct {
-	list *[]*DecPool
+type _DecPool_2_list struct {
+	list *[]*v1beta1.DecCoin
 }
 
-func (x *_ValidatorHistoricalRewards_1_list) Len() int {
+func (x *_DecPool_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_ValidatorHistoricalRewards_1_list) Get(i int) protoreflect.Value {
+func (x *_DecPool_2_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_ValidatorHistoricalRewards_1_list) Set(i int, value protoreflect.Value) {
+func (x *_DecPool_2_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DecPool)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_ValidatorHistoricalRewards_1_list) Append(value protoreflect.Value) {
+func (x *_DecPool_2_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DecPool)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_ValidatorHistoricalRewards_1_list) AppendMutable() protoreflect.Value {
-	v := new(DecPool)
+func (x *_DecPool_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ValidatorHistoricalRewards_1_list) Truncate(n int) {
+func (x *_DecPool_2_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_ValidatorHistoricalRewards_1_list) NewElement() protoreflect.Value {
-	v := new(DecPool)
+func (x *_DecPool_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ValidatorHistoricalRewards_1_list) IsValid() bool {
+func (x *_DecPool_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_ValidatorHistoricalRewards                          protoreflect.MessageDescriptor
-	fd_ValidatorHistoricalRewards_cumulative_reward_ratios protoreflect.FieldDescriptor
-	fd_ValidatorHistoricalRewards_reference_count          protoreflect.FieldDescriptor
+	md_DecPool           protoreflect.MessageDescriptor
+	fd_DecPool_denom     protoreflect.FieldDescriptor
+	fd_DecPool_dec_coins protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_initia_distribution_v1_distribution_proto_init()
-	md_ValidatorHistoricalRewards = File_initia_distribution_v1_distribution_proto.Messages().ByName("ValidatorHistoricalRewards")
-	fd_ValidatorHistoricalRewards_cumulative_reward_ratios = md_ValidatorHistoricalRewards.Fields().ByName("cumulative_reward_ratios")
-	fd_ValidatorHistoricalRewards_reference_count = md_ValidatorHistoricalRewards.Fields().ByName("reference_count")
+	md_DecPool = File_initia_distribution_v1_distribution_proto.Messages().ByName("DecPool")
+	fd_DecPool_denom = md_DecPool.Fields().ByName("denom")
+	fd_DecPool_dec_coins = md_DecPool.Fields().ByName("dec_coins")
 }
 
-var _ protoreflect.Message = (*fastReflection_ValidatorHistoricalRewards)(nil)
+var _ protoreflect.Message = (*fastReflection_DecPool)(nil)
 
-type fastReflection_ValidatorHistoricalRewards ValidatorHistoricalRewards
+type fastReflection_DecPool DecPool
 
-func (x *ValidatorHistoricalRewards) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ValidatorHistoricalRewards)(x)
+func (x *DecPool) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DecPool)(x)
 }
 
-func (x *ValidatorHistoricalRewards) slowProtoReflect() protoreflect.Message {
+func (x *DecPool) slowProtoReflect() protoreflect.Message {
 	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2311,43 +2712,43 @@ func (x *ValidatorHistoricalRewards) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ValidatorHistoricalRewards_messageType fastReflection_ValidatorHistoricalRewards_messageType
-var _ protoreflect.MessageType = fastReflection_ValidatorHistoricalRewards_messageType{}
+var _fastReflection_DecPool_messageType fastReflection_DecPool_messageType
+var _ protoreflect.MessageType = fastReflection_DecPool_messageType{}
 
-type fastReflection_ValidatorHistoricalRewards_messageType struct{}
+type fastReflection_DecPool_messageType struct{}
 
-func (x fastReflection_ValidatorHistoricalRewards_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ValidatorHistoricalRewards)(nil)
+func (x fastReflection_DecPool_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DecPool)(nil)
 }
-func (x fastReflection_ValidatorHistoricalRewards_messageType) New() protoreflect.Message {
-	return new(fastReflection_ValidatorHistoricalRewards)
+func (x fastReflection_DecPool_messageType) New() protoreflect.Message {
+	return new(fastReflection_DecPool)
 }
-func (x fastReflection_ValidatorHistoricalRewards_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorHistoricalRewards
+func (x fastReflection_DecPool_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DecPool
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ValidatorHistoricalRewards) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorHistoricalRewards
+func (x *fastReflection_DecPool) Descriptor() protoreflect.MessageDescriptor {
+	return md_DecPool
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ValidatorHistoricalRewards) Type() protoreflect.MessageType {
-	return _fastReflection_ValidatorHistoricalRewards_messageType
+func (x *fastReflection_DecPool) Type() protoreflect.MessageType {
+	return _fastReflection_DecPool_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ValidatorHistoricalRewards) New() protoreflect.Message {
-	return new(fastReflection_ValidatorHistoricalRewards)
+func (x *fastReflection_DecPool) New() protoreflect.Message {
+	return new(fastReflection_DecPool)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ValidatorHistoricalRewards) Interface() protoreflect.ProtoMessage {
-	return (*ValidatorHistoricalRewards)(x)
+func (x *fastReflection_DecPool) Interface() protoreflect.ProtoMessage {
+	return (*DecPool)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2355,16 +2756,16 @@ func (x *fastReflection_ValidatorHistoricalRewards) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ValidatorHistoricalRewards) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.CumulativeRewardRatios) != 0 {
-		value := protoreflect.ValueOfList(&_ValidatorHistoricalRewards_1_list{list: &x.CumulativeRewardRatios})
-		if !f(fd_ValidatorHistoricalRewards_cumulative_reward_ratios, value) {
+func (x *fastReflection_DecPool) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_DecPool_denom, value) {
 			return
 		}
 	}
-	if x.ReferenceCount != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.ReferenceCount)
-		if !f(fd_ValidatorHistoricalRewards_reference_count, value) {
+	if len(x.DecCoins) != 0 {
+		value := protoreflect.ValueOfList(&_DecPool_2_list{list: &x.DecCoins})
+		if !f(fd_DecPool_dec_coins, value) {
 			return
 		}
 	}
@@ -2381,17 +2782,17 @@ func (x *fastReflection_ValidatorHistoricalRewards) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ValidatorHistoricalRewards) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DecPool) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorHistoricalRewards.cumulative_reward_ratios":
-		return len(x.CumulativeRewardRatios) != 0
-	case "initia.distribution.v1.ValidatorHistoricalRewards.reference_count":
-		return x.ReferenceCount != uint32(0)
+	case "initia.distribution.v1.DecPool.denom":
+		return x.Denom != ""
+	case "initia.distribution.v1.DecPool.dec_coins":
+		return len(x.DecCoins) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorHistoricalRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DecPool"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorHistoricalRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.DecPool does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2401,17 +2802,17 @@ func (x *fastReflection_ValidatorHistoricalRewards) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorHistoricalRewards) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DecPool) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorHistoricalRewards.cumulative_reward_ratios":
-		x.CumulativeRewardRatios = nil
-	case "initia.distribution.v1.ValidatorHistoricalRewards.reference_count":
-		x.ReferenceCount = uint32(0)
+	case "initia.distribution.v1.DecPool.denom":
+		x.Denom = ""
+	case "initia.distribution.v1.DecPool.dec_coins":
+		x.DecCoins = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorHistoricalRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DecPool"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorHistoricalRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.DecPool does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2421,22 +2822,22 @@ func (x *fastReflection_ValidatorHistoricalRewards) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ValidatorHistoricalRewards) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DecPool) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "initia.distribution.v1.ValidatorHistoricalRewards.cumulative_reward_ratios":
-		if len(x.CumulativeRewardRatios) == 0 {
-			return protoreflect.ValueOfList(&_ValidatorHistoricalRewards_1_list{})
+	case "initia.distribution.v1.DecPool.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "initia.distribution.v1.DecPool.dec_coins":
+		if len(x.DecCoins) == 0 {
+			return protoreflect.ValueOfList(&_DecPool_2_list{})
 		}
-		listValue := &_ValidatorHistoricalRewards_1_list{list: &x.CumulativeRewardRatios}
+		listValue := &_DecPool_2_list{list: &x.DecCoins}
 		return protoreflect.ValueOfList(listValue)
-	case "initia.distribution.v1.ValidatorHistoricalRewards.reference_count":
-		value := x.ReferenceCount
-		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorHistoricalRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DecPool"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorHistoricalRewards does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.DecPool does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2450,19 +2851,19 @@ func (x *fastReflection_ValidatorHistoricalRewards) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorHistoricalRewards) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DecPool) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorHistoricalRewards.cumulative_reward_ratios":
+	case "initia.distribution.v1.DecPool.denom":
+		x.Denom = value.Interface().(string)
+	case "initia.distribution.v1.DecPool.dec_coins":
 		lv := value.List()
-		clv := lv.(*_ValidatorHistoricalRewards_1_list)
-		x.CumulativeRewardRatios = *clv.list
-	case "initia.distribution.v1.ValidatorHistoricalRewards.reference_count":
-		x.ReferenceCount = uint32(value.Uint())
+		clv := lv.(*_DecPool_2_list)
+		x.DecCoins = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorHistoricalRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DecPool"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorHistoricalRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.DecPool does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2476,49 +2877,49 @@ func (x *fastReflection_ValidatorHistoricalRewards) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorHistoricalRewards) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DecPool) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorHistoricalRewards.cumulative_reward_ratios":
-		if x.CumulativeRewardRatios == nil {
-			x.CumulativeRewardRatios = []*DecPool{}
+	case "initia.distribution.v1.DecPool.dec_coins":
+		if x.DecCoins == nil {
+			x.DecCoins = []*v1beta1.DecCoin{}
 		}
-		value := &_ValidatorHistoricalRewards_1_list{list: &x.CumulativeRewardRatios}
+		value := &_DecPool_2_list{list: &x.DecCoins}
 		return protoreflect.ValueOfList(value)
-	case "initia.distribution.v1.ValidatorHistoricalRewards.reference_count":
-		panic(fmt.Errorf("field reference_count of message initia.distribution.v1.ValidatorHistoricalRewards is not mutable"))
+	case "initia.distribution.v1.DecPool.denom":
+		panic(fmt.Errorf("field denom of message initia.distribution.v1.DecPool is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorHistoricalRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DecPool"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorHistoricalRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.DecPool does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ValidatorHistoricalRewards) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DecPool) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorHistoricalRewards.cumulative_reward_ratios":
-		list := []*DecPool{}
-		return protoreflect.ValueOfList(&_ValidatorHistoricalRewards_1_list{list: &list})
-	case "initia.distribution.v1.ValidatorHistoricalRewards.reference_count":
-		return protoreflect.ValueOfUint32(uint32(0))
+	case "initia.distribution.v1.DecPool.denom":
+		return protoreflect.ValueOfString("")
+	case "initia.distribution.v1.DecPool.dec_coins":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_DecPool_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorHistoricalRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DecPool"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorHistoricalRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.DecPool does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ValidatorHistoricalRewards) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DecPool) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.ValidatorHistoricalRewards", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.DecPool", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2526,7 +2927,7 @@ func (x *fastReflection_ValidatorHistoricalRewards) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ValidatorHistoricalRewards) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DecPool) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2537,7 +2938,7 @@ func (x *fastReflection_ValidatorHistoricalRewards) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorHistoricalRewards) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DecPool) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2549,7 +2950,7 @@ func (x *fastReflection_ValidatorHistoricalRewards) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ValidatorHistoricalRewards) IsValid() bool {
+func (x *fastReflection_DecPool) IsValid() bool {
 	return x != nil
 }
 
@@ -2559,9 +2960,9 @@ func (x *fastReflection_ValidatorHistoricalRewards) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ValidatorHistoricalRewards) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DecPool) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ValidatorHistoricalRewards)
+		x := input.Message.Interface().(*DecPool)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2573,15 +2974,16 @@ func (x *fastReflection_ValidatorHistoricalRewards) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		if len(x.CumulativeRewardRatios) > 0 {
-			for _, e := range x.CumulativeRewardRatios {
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.DecCoins) > 0 {
+			for _, e := range x.DecCoins {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.ReferenceCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.ReferenceCount))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2592,7 +2994,7 @@ func (x *fastReflection_ValidatorHistoricalRewards) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorHistoricalRewards)
+		x := input.Message.Interface().(*DecPool)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2611,14 +3013,9 @@ func (x *fastReflection_ValidatorHistoricalRewards) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ReferenceCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReferenceCount))
-			i--
-			dAtA[i] = 0x10
-		}
-		if len(x.CumulativeRewardRatios) > 0 {
-			for iNdEx := len(x.CumulativeRewardRatios) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.CumulativeRewardRatios[iNdEx])
+		if len(x.DecCoins) > 0 {
+			for iNdEx := len(x.DecCoins) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DecCoins[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2629,9 +3026,16 @@ func (x *fastReflection_ValidatorHistoricalRewards) ProtoMethods() *protoiface.M
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0xa
+				dAtA[i] = 0x12
 			}
 		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -2643,7 +3047,7 @@ func (x *fastReflection_ValidatorHistoricalRewards) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorHistoricalRewards)
+		x := input.Message.Interface().(*DecPool)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2675,17 +3079,17 @@ func (x *fastReflection_ValidatorHistoricalRewards) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorHistoricalRewards: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DecPool: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorHistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DecPool: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatios", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2695,31 +3099,29 @@ func (x *fastReflection_ValidatorHistoricalRewards) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CumulativeRewardRatios = append(x.CumulativeRewardRatios, &DecPool{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CumulativeRewardRatios[len(x.CumulativeRewardRatios)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecCoins", wireType)
 				}
-				x.ReferenceCount = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2729,11 +3131,26 @@ func (x *fastReflection_ValidatorHistoricalRewards) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ReferenceCount |= uint32(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DecCoins = append(x.DecCoins, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DecCoins[len(x.DecCoins)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2769,79 +3186,81 @@ func (x *fastReflection_ValidatorHistoricalRewards) ProtoMethods() *protoiface.M
 	}
 }
 
-var _ protoreflect.List = (*_ValidatorCurrentRewards_1_list)(nil)
+var _ protoreflect.List = (*_ValidatorHistoricalRewards_1_list)(nil)
 
-type _ValidatorCurrentRewards_1_list struct {
+type _ValidatorHistoricalRewards_1_list struct {
 	list *[]*DecPool
 }
 
-func (x *_ValidatorCurrentRewards_1_list) Len() int {
+func (x *_ValidatorHistoricalRewards_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_ValidatorCurrentRewards_1_list) Get(i int) protoreflect.Value {
+func (x *_ValidatorHistoricalRewards_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_ValidatorCurrentRewards_1_list) Set(i int, value protoreflect.Value) {
+func (x *_ValidatorHistoricalRewards_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*DecPool)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_ValidatorCurrentRewards_1_list) Append(value protoreflect.Value) {
+func (x *_ValidatorHistoricalRewards_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*DecPool)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_ValidatorCurrentRewards_1_list) AppendMutable() protoreflect.Value {
+func (x *_ValidatorHistoricalRewards_1_list) AppendMutable() protoreflect.Value {
 	v := new(DecPool)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ValidatorCurrentRewards_1_list) Truncate(n int) {
+func (x *_ValidatorHistoricalRewards_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_ValidatorCurrentRewards_1_list) NewElement() protoreflect.Value {
+func (x *_ValidatorHistoricalRewards_1_list) NewElement() protoreflect.Value {
 	v := new(DecPool)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ValidatorCurrentRewards_1_list) IsValid() bool {
+func (x *_ValidatorHistoricalRewards_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_ValidatorCurrentRewards         protoreflect.MessageDescriptor
-	fd_ValidatorCurrentRewards_rewards protoreflect.FieldDescriptor
-	fd_ValidatorCurrentRewards_period  protoreflect.FieldDescriptor
+	md_ValidatorHistoricalRewards                          protoreflect.MessageDescriptor
+	fd_ValidatorHistoricalRewards_cumulative_reward_ratios protoreflect.FieldDescriptor
+	fd_ValidatorHistoricalRewards_reference_count          protoreflect.FieldDescriptor
+	fd_ValidatorHistoricalRewards_time                     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_initia_distribution_v1_distribution_proto_init()
-	md_ValidatorCurrentRewards = File_initia_distribution_v1_distribution_proto.Messages().ByName("ValidatorCurrentRewards")
-	fd_ValidatorCurrentRewards_rewards = md_ValidatorCurrentRewards.Fields().ByName("rewards")
-	fd_ValidatorCurrentRewards_period = md_ValidatorCurrentRewards.Fields().ByName("period")
+	md_ValidatorHistoricalRewards = File_initia_distribution_v1_distribution_proto.Messages().ByName("ValidatorHistoricalRewards")
+	fd_ValidatorHistoricalRewards_cumulative_reward_ratios = md_ValidatorHistoricalRewards.Fields().ByName("cumulative_reward_ratios")
+	fd_ValidatorHistoricalRewards_reference_count = md_ValidatorHistoricalRewards.Fields().ByName("reference_count")
+	fd_ValidatorHistoricalRewards_time = md_ValidatorHistoricalRewards.Fields().ByName("time")
 }
 
-var _ protoreflect.Message = (*fastReflection_ValidatorCurrentRewards)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorHistoricalRewards)(nil)
 
-type fastReflection_ValidatorCurrentRewards ValidatorCurrentRewards
+type fastReflection_ValidatorHistoricalRewards ValidatorHistoricalRewards
 
-func (x *ValidatorCurrentRewards) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ValidatorCurrentRewards)(x)
+func (x *ValidatorHistoricalRewards) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorHistoricalRewards)(x)
 }
 
-func (x *ValidatorCurrentRewards) slowProtoReflect() protoreflect.Message {
+func (x *ValidatorHistoricalRewards) slowProtoReflect() protoreflect.Message {
 	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2853,43 +3272,43 @@ func (x *ValidatorCurrentRewards) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ValidatorCurrentRewards_messageType fastReflection_ValidatorCurrentRewards_messageType
-var _ protoreflect.MessageType = fastReflection_ValidatorCurrentRewards_messageType{}
+var _fastReflection_ValidatorHistoricalRewards_messageType fastReflection_ValidatorHistoricalRewards_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorHistoricalRewards_messageType{}
 
-type fastReflection_ValidatorCurrentRewards_messageType struct{}
+type fastReflection_ValidatorHistoricalRewards_messageType struct{}
 
-func (x fastReflection_ValidatorCurrentRewards_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ValidatorCurrentRewards)(nil)
+func (x fastReflection_ValidatorHistoricalRewards_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorHistoricalRewards)(nil)
 }
-func (x fastReflection_ValidatorCurrentRewards_messageType) New() protoreflect.Message {
-	return new(fastReflection_ValidatorCurrentRewards)
+func (x fastReflection_ValidatorHistoricalRewards_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorHistoricalRewards)
 }
-func (x fastReflection_ValidatorCurrentRewards_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorCurrentRewards
+func (x fastReflection_ValidatorHistoricalRewards_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorHistoricalRewards
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ValidatorCurrentRewards) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorCurrentRewards
+func (x *fastReflection_ValidatorHistoricalRewards) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorHistoricalRewards
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ValidatorCurrentRewards) Type() protoreflect.MessageType {
-	return _fastReflection_ValidatorCurrentRewards_messageType
+func (x *fastReflection_ValidatorHistoricalRewards) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorHistoricalRewards_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ValidatorCurrentRewards) New() protoreflect.Message {
-	return new(fastReflection_ValidatorCurrentRewards)
+func (x *fastReflection_ValidatorHistoricalRewards) New() protoreflect.Message {
+	return new(fastReflection_ValidatorHistoricalRewards)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ValidatorCurrentRewards) Interface() protoreflect.ProtoMessage {
-	return (*ValidatorCurrentRewards)(x)
+func (x *fastReflection_ValidatorHistoricalRewards) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorHistoricalRewards)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2897,21 +3316,27 @@ func (x *fastReflection_ValidatorCurrentRewards) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ValidatorCurrentRewards) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Rewards) != 0 {
-		value := protoreflect.ValueOfList(&_ValidatorCurrentRewards_1_list{list: &x.Rewards})
-		if !f(fd_ValidatorCurrentRewards_rewards, value) {
+func (x *fastReflection_ValidatorHistoricalRewards) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.CumulativeRewardRatios) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorHistoricalRewards_1_list{list: &x.CumulativeRewardRatios})
+		if !f(fd_ValidatorHistoricalRewards_cumulative_reward_ratios, value) {
 			return
 		}
 	}
-	if x.Period != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Period)
-		if !f(fd_ValidatorCurrentRewards_period, value) {
+	if x.ReferenceCount != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ReferenceCount)
+		if !f(fd_ValidatorHistoricalRewards_reference_count, value) {
 			return
 		}
 	}
-}
-
+	if x.Time != nil {
+		value := protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+		if !f(fd_ValidatorHistoricalRewards_time, value) {
+			return
+		}
+	}
+}
+
 // Has reports whether a field is populated.
 //
 // Some fields have the property of nullability where it is possible to
@@ -2923,17 +3348,19 @@ func (x *fastReflection_ValidatorCurrentRewards) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ValidatorCurrentRewards) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorHistoricalRewards) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorCurrentRewards.rewards":
-		return len(x.Rewards) != 0
-	case "initia.distribution.v1.ValidatorCurrentRewards.period":
-		return x.Period != uint64(0)
+	case "initia.distribution.v1.ValidatorHistoricalRewards.cumulative_reward_ratios":
+		return len(x.CumulativeRewardRatios) != 0
+	case "initia.distribution.v1.ValidatorHistoricalRewards.reference_count":
+		return x.ReferenceCount != uint32(0)
+	case "initia.distribution.v1.ValidatorHistoricalRewards.time":
+		return x.Time != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorCurrentRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorHistoricalRewards"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorCurrentRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorHistoricalRewards does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2943,17 +3370,19 @@ func (x *fastReflection_ValidatorCurrentRewards) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorCurrentRewards) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorHistoricalRewards) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorCurrentRewards.rewards":
-		x.Rewards = nil
-	case "initia.distribution.v1.ValidatorCurrentRewards.period":
-		x.Period = uint64(0)
+	case "initia.distribution.v1.ValidatorHistoricalRewards.cumulative_reward_ratios":
+		x.CumulativeRewardRatios = nil
+	case "initia.distribution.v1.ValidatorHistoricalRewards.reference_count":
+		x.ReferenceCount = uint32(0)
+	case "initia.distribution.v1.ValidatorHistoricalRewards.time":
+		x.Time = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorCurrentRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorHistoricalRewards"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorCurrentRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorHistoricalRewards does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2963,22 +3392,25 @@ func (x *fastReflection_ValidatorCurrentRewards) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ValidatorCurrentRewards) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorHistoricalRewards) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "initia.distribution.v1.ValidatorCurrentRewards.rewards":
-		if len(x.Rewards) == 0 {
-			return protoreflect.ValueOfList(&_ValidatorCurrentRewards_1_list{})
+	case "initia.distribution.v1.ValidatorHistoricalRewards.cumulative_reward_ratios":
+		if len(x.CumulativeRewardRatios) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorHistoricalRewards_1_list{})
 		}
-		listValue := &_ValidatorCurrentRewards_1_list{list: &x.Rewards}
+		listValue := &_ValidatorHistoricalRewards_1_list{list: &x.CumulativeRewardRatios}
 		return protoreflect.ValueOfList(listValue)
-	case "initia.distribution.v1.ValidatorCurrentRewards.period":
-		value := x.Period
-		return protoreflect.ValueOfUint64(value)
+	case "initia.distribution.v1.ValidatorHistoricalRewards.reference_count":
+		value := x.ReferenceCount
+		return protoreflect.ValueOfUint32(value)
+	case "initia.distribution.v1.ValidatorHistoricalRewards.time":
+		value := x.Time
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorCurrentRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorHistoricalRewards"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorCurrentRewards does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorHistoricalRewards does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2992,19 +3424,21 @@ func (x *fastReflection_ValidatorCurrentRewards) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorCurrentRewards) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorHistoricalRewards) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorCurrentRewards.rewards":
+	case "initia.distribution.v1.ValidatorHistoricalRewards.cumulative_reward_ratios":
 		lv := value.List()
-		clv := lv.(*_ValidatorCurrentRewards_1_list)
-		x.Rewards = *clv.list
-	case "initia.distribution.v1.ValidatorCurrentRewards.period":
-		x.Period = value.Uint()
+		clv := lv.(*_ValidatorHistoricalRewards_1_list)
+		x.CumulativeRewardRatios = *clv.list
+	case "initia.distribution.v1.ValidatorHistoricalRewards.reference_count":
+		x.ReferenceCount = uint32(value.Uint())
+	case "initia.distribution.v1.ValidatorHistoricalRewards.time":
+		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorCurrentRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorHistoricalRewards"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorCurrentRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorHistoricalRewards does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3018,49 +3452,57 @@ func (x *fastReflection_ValidatorCurrentRewards) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorCurrentRewards) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorHistoricalRewards) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorCurrentRewards.rewards":
-		if x.Rewards == nil {
-			x.Rewards = []*DecPool{}
+	case "initia.distribution.v1.ValidatorHistoricalRewards.cumulative_reward_ratios":
+		if x.CumulativeRewardRatios == nil {
+			x.CumulativeRewardRatios = []*DecPool{}
 		}
-		value := &_ValidatorCurrentRewards_1_list{list: &x.Rewards}
+		value := &_ValidatorHistoricalRewards_1_list{list: &x.CumulativeRewardRatios}
 		return protoreflect.ValueOfList(value)
-	case "initia.distribution.v1.ValidatorCurrentRewards.period":
-		panic(fmt.Errorf("field period of message initia.distribution.v1.ValidatorCurrentRewards is not mutable"))
+	case "initia.distribution.v1.ValidatorHistoricalRewards.time":
+		if x.Time == nil {
+			x.Time = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+	case "initia.distribution.v1.ValidatorHistoricalRewards.reference_count":
+		panic(fmt.Errorf("field reference_count of message initia.distribution.v1.ValidatorHistoricalRewards is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorCurrentRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorHistoricalRewards"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorCurrentRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorHistoricalRewards does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ValidatorCurrentRewards) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorHistoricalRewards) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorCurrentRewards.rewards":
+	case "initia.distribution.v1.ValidatorHistoricalRewards.cumulative_reward_ratios":
 		list := []*DecPool{}
-		return protoreflect.ValueOfList(&_ValidatorCurrentRewards_1_list{list: &list})
-	case "initia.distribution.v1.ValidatorCurrentRewards.period":
-		return protoreflect.ValueOfUint64(uint64(0))
+		return protoreflect.ValueOfList(&_ValidatorHistoricalRewards_1_list{list: &list})
+	case "initia.distribution.v1.ValidatorHistoricalRewards.reference_count":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "initia.distribution.v1.ValidatorHistoricalRewards.time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorCurrentRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorHistoricalRewards"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorCurrentRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorHistoricalRewards does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ValidatorCurrentRewards) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorHistoricalRewards) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.ValidatorCurrentRewards", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.ValidatorHistoricalRewards", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3068,7 +3510,7 @@ func (x *fastReflection_ValidatorCurrentRewards) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ValidatorCurrentRewards) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorHistoricalRewards) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3079,7 +3521,7 @@ func (x *fastReflection_ValidatorCurrentRewards) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorCurrentRewards) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorHistoricalRewards) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3091,7 +3533,7 @@ func (x *fastReflection_ValidatorCurrentRewards) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ValidatorCurrentRewards) IsValid() bool {
+func (x *fastReflection_ValidatorHistoricalRewards) IsValid() bool {
 	return x != nil
 }
 
@@ -3101,9 +3543,9 @@ func (x *fastReflection_ValidatorCurrentRewards) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ValidatorCurrentRewards) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorHistoricalRewards) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ValidatorCurrentRewards)
+		x := input.Message.Interface().(*ValidatorHistoricalRewards)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3115,14 +3557,18 @@ func (x *fastReflection_ValidatorCurrentRewards) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if len(x.Rewards) > 0 {
-			for _, e := range x.Rewards {
+		if len(x.CumulativeRewardRatios) > 0 {
+			for _, e := range x.CumulativeRewardRatios {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.Period != 0 {
-			n += 1 + runtime.Sov(uint64(x.Period))
+		if x.ReferenceCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReferenceCount))
+		}
+		if x.Time != nil {
+			l = options.Size(x.Time)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3134,7 +3580,7 @@ func (x *fastReflection_ValidatorCurrentRewards) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorCurrentRewards)
+		x := input.Message.Interface().(*ValidatorHistoricalRewards)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3153,14 +3599,28 @@ func (x *fastReflection_ValidatorCurrentRewards) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Period != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Period))
+		if x.Time != nil {
+			encoded, err := options.Marshal(x.Time)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ReferenceCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReferenceCount))
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.Rewards) > 0 {
-			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Rewards[iNdEx])
+		if len(x.CumulativeRewardRatios) > 0 {
+			for iNdEx := len(x.CumulativeRewardRatios) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CumulativeRewardRatios[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3185,7 +3645,7 @@ func (x *fastReflection_ValidatorCurrentRewards) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorCurrentRewards)
+		x := input.Message.Interface().(*ValidatorHistoricalRewards)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3217,15 +3677,15 @@ func (x *fastReflection_ValidatorCurrentRewards) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorCurrentRewards: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorHistoricalRewards: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorCurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorHistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatios", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3252,16 +3712,16 @@ func (x *fastReflection_ValidatorCurrentRewards) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Rewards = append(x.Rewards, &DecPool{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards[len(x.Rewards)-1]); err != nil {
+				x.CumulativeRewardRatios = append(x.CumulativeRewardRatios, &DecPool{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CumulativeRewardRatios[len(x.CumulativeRewardRatios)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
 				}
-				x.Period = 0
+				x.ReferenceCount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3271,11 +3731,47 @@ func (x *fastReflection_ValidatorCurrentRewards) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Period |= uint64(b&0x7F) << shift
+					x.ReferenceCount |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Time == nil {
+					x.Time = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Time); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3311,77 +3807,79 @@ func (x *fastReflection_ValidatorCurrentRewards) ProtoMethods() *protoiface.Meth
 	}
 }
 
-var _ protoreflect.List = (*_ValidatorAccumulatedCommission_1_list)(nil)
+var _ protoreflect.List = (*_ValidatorCurrentRewards_1_list)(nil)
 
-type _ValidatorAccumulatedCommission_1_list struct {
+type _ValidatorCurrentRewards_1_list struct {
 	list *[]*DecPool
 }
 
-func (x *_ValidatorAccumulatedCommission_1_list) Len() int {
+func (x *_ValidatorCurrentRewards_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_ValidatorAccumulatedCommission_1_list) Get(i int) protoreflect.Value {
+func (x *_ValidatorCurrentRewards_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_ValidatorAccumulatedCommission_1_list) Set(i int, value protoreflect.Value) {
+func (x *_ValidatorCurrentRewards_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*DecPool)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_ValidatorAccumulatedCommission_1_list) Append(value protoreflect.Value) {
+func (x *_ValidatorCurrentRewards_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*DecPool)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_ValidatorAccumulatedCommission_1_list) AppendMutable() protoreflect.Value {
+func (x *_ValidatorCurrentRewards_1_list) AppendMutable() protoreflect.Value {
 	v := new(DecPool)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ValidatorAccumulatedCommission_1_list) Truncate(n int) {
+func (x *_ValidatorCurrentRewards_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_ValidatorAccumulatedCommission_1_list) NewElement() protoreflect.Value {
+func (x *_ValidatorCurrentRewards_1_list) NewElement() protoreflect.Value {
 	v := new(DecPool)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ValidatorAccumulatedCommission_1_list) IsValid() bool {
+func (x *_ValidatorCurrentRewards_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_ValidatorAccumulatedCommission             protoreflect.MessageDescriptor
-	fd_ValidatorAccumulatedCommission_commissions protoreflect.FieldDescriptor
+	md_ValidatorCurrentRewards         protoreflect.MessageDescriptor
+	fd_ValidatorCurrentRewards_rewards protoreflect.FieldDescriptor
+	fd_ValidatorCurrentRewards_period  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_initia_distribution_v1_distribution_proto_init()
-	md_ValidatorAccumulatedCommission = File_initia_distribution_v1_distribution_proto.Messages().ByName("ValidatorAccumulatedCommission")
-	fd_ValidatorAccumulatedCommission_commissions = md_ValidatorAccumulatedCommission.Fields().ByName("commissions")
+	md_ValidatorCurrentRewards = File_initia_distribution_v1_distribution_proto.Messages().ByName("ValidatorCurrentRewards")
+	fd_ValidatorCurrentRewards_rewards = md_ValidatorCurrentRewards.Fields().ByName("rewards")
+	fd_ValidatorCurrentRewards_period = md_ValidatorCurrentRewards.Fields().ByName("period")
 }
 
-var _ protoreflect.Message = (*fastReflection_ValidatorAccumulatedCommission)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorCurrentRewards)(nil)
 
-type fastReflection_ValidatorAccumulatedCommission ValidatorAccumulatedCommission
+type fastReflection_ValidatorCurrentRewards ValidatorCurrentRewards
 
-func (x *ValidatorAccumulatedCommission) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ValidatorAccumulatedCommission)(x)
+func (x *ValidatorCurrentRewards) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorCurrentRewards)(x)
 }
 
-func (x *ValidatorAccumulatedCommission) slowProtoReflect() protoreflect.Message {
+func (x *ValidatorCurrentRewards) slowProtoReflect() protoreflect.Message {
 	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3393,43 +3891,43 @@ func (x *ValidatorAccumulatedCommission) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ValidatorAccumulatedCommission_messageType fastReflection_ValidatorAccumulatedCommission_messageType
-var _ protoreflect.MessageType = fastReflection_ValidatorAccumulatedCommission_messageType{}
+var _fastReflection_ValidatorCurrentRewards_messageType fastReflection_ValidatorCurrentRewards_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorCurrentRewards_messageType{}
 
-type fastReflection_ValidatorAccumulatedCommission_messageType struct{}
+type fastReflection_ValidatorCurrentRewards_messageType struct{}
 
-func (x fastReflection_ValidatorAccumulatedCommission_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ValidatorAccumulatedCommission)(nil)
+func (x fastReflection_ValidatorCurrentRewards_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorCurrentRewards)(nil)
 }
-func (x fastReflection_ValidatorAccumulatedCommission_messageType) New() protoreflect.Message {
-	return new(fastReflection_ValidatorAccumulatedCommission)
+func (x fastReflection_ValidatorCurrentRewards_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorCurrentRewards)
 }
-func (x fastReflection_ValidatorAccumulatedCommission_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorAccumulatedCommission
+func (x fastReflection_ValidatorCurrentRewards_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorCurrentRewards
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ValidatorAccumulatedCommission) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorAccumulatedCommission
+func (x *fastReflection_ValidatorCurrentRewards) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorCurrentRewards
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ValidatorAccumulatedCommission) Type() protoreflect.MessageType {
-	return _fastReflection_ValidatorAccumulatedCommission_messageType
-}
+func (x *fastReflection_ValidatorCurrentRewards) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorCurrentRewards_messageType
+}
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ValidatorAccumulatedCommission) New() protoreflect.Message {
-	return new(fastReflection_ValidatorAccumulatedCommission)
+func (x *fastReflection_ValidatorCurrentRewards) New() protoreflect.Message {
+	return new(fastReflection_ValidatorCurrentRewards)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ValidatorAccumulatedCommission) Interface() protoreflect.ProtoMessage {
-	return (*ValidatorAccumulatedCommission)(x)
+func (x *fastReflection_ValidatorCurrentRewards) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorCurrentRewards)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3437,10 +3935,16 @@ func (x *fastReflection_ValidatorAccumulatedCommission) Interface() protoreflect
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ValidatorAccumulatedCommission) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Commissions) != 0 {
-		value := protoreflect.ValueOfList(&_ValidatorAccumulatedCommission_1_list{list: &x.Commissions})
-		if !f(fd_ValidatorAccumulatedCommission_commissions, value) {
+func (x *fastReflection_ValidatorCurrentRewards) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Rewards) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorCurrentRewards_1_list{list: &x.Rewards})
+		if !f(fd_ValidatorCurrentRewards_rewards, value) {
+			return
+		}
+	}
+	if x.Period != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Period)
+		if !f(fd_ValidatorCurrentRewards_period, value) {
 			return
 		}
 	}
@@ -3457,15 +3961,17 @@ func (x *fastReflection_ValidatorAccumulatedCommission) Range(f func(protoreflec
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ValidatorAccumulatedCommission) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorCurrentRewards) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorAccumulatedCommission.commissions":
-		return len(x.Commissions) != 0
+	case "initia.distribution.v1.ValidatorCurrentRewards.rewards":
+		return len(x.Rewards) != 0
+	case "initia.distribution.v1.ValidatorCurrentRewards.period":
+		return x.Period != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorAccumulatedCommission"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorCurrentRewards"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorAccumulatedCommission does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorCurrentRewards does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3475,15 +3981,17 @@ func (x *fastReflection_ValidatorAccumulatedCommission) Has(fd protoreflect.Fiel
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorAccumulatedCommission) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorCurrentRewards) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorAccumulatedCommission.commissions":
-		x.Commissions = nil
+	case "initia.distribution.v1.ValidatorCurrentRewards.rewards":
+		x.Rewards = nil
+	case "initia.distribution.v1.ValidatorCurrentRewards.period":
+		x.Period = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorAccumulatedCommission"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorCurrentRewards"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorAccumulatedCommission does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorCurrentRewards does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3493,19 +4001,22 @@ func (x *fastReflection_ValidatorAccumulatedCommission) Clear(fd protoreflect.Fi
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ValidatorAccumulatedCommission) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorCurrentRewards) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "initia.distribution.v1.ValidatorAccumulatedCommission.commissions":
-		if len(x.Commissions) == 0 {
-			return protoreflect.ValueOfList(&_ValidatorAccumulatedCommission_1_list{})
+	case "initia.distribution.v1.ValidatorCurrentRewards.rewards":
+		if len(x.Rewards) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorCurrentRewards_1_list{})
 		}
-		listValue := &_ValidatorAccumulatedCommission_1_list{list: &x.Commissions}
+		listValue := &_ValidatorCurrentRewards_1_list{list: &x.Rewards}
 		return protoreflect.ValueOfList(listValue)
+	case "initia.distribution.v1.ValidatorCurrentRewards.period":
+		value := x.Period
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorAccumulatedCommission"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorCurrentRewards"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorAccumulatedCommission does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorCurrentRewards does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3519,17 +4030,19 @@ func (x *fastReflection_ValidatorAccumulatedCommission) Get(descriptor protorefl
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorAccumulatedCommission) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorCurrentRewards) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorAccumulatedCommission.commissions":
+	case "initia.distribution.v1.ValidatorCurrentRewards.rewards":
 		lv := value.List()
-		clv := lv.(*_ValidatorAccumulatedCommission_1_list)
-		x.Commissions = *clv.list
+		clv := lv.(*_ValidatorCurrentRewards_1_list)
+		x.Rewards = *clv.list
+	case "initia.distribution.v1.ValidatorCurrentRewards.period":
+		x.Period = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorAccumulatedCommission"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorCurrentRewards"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorAccumulatedCommission does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorCurrentRewards does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3543,45 +4056,49 @@ func (x *fastReflection_ValidatorAccumulatedCommission) Set(fd protoreflect.Fiel
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorAccumulatedCommission) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorCurrentRewards) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorAccumulatedCommission.commissions":
-		if x.Commissions == nil {
-			x.Commissions = []*DecPool{}
+	case "initia.distribution.v1.ValidatorCurrentRewards.rewards":
+		if x.Rewards == nil {
+			x.Rewards = []*DecPool{}
 		}
-		value := &_ValidatorAccumulatedCommission_1_list{list: &x.Commissions}
+		value := &_ValidatorCurrentRewards_1_list{list: &x.Rewards}
 		return protoreflect.ValueOfList(value)
+	case "initia.distribution.v1.ValidatorCurrentRewards.period":
+		panic(fmt.Errorf("field period of message initia.distribution.v1.ValidatorCurrentRewards is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorAccumulatedCommission"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorCurrentRewards"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorAccumulatedCommission does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorCurrentRewards does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ValidatorAccumulatedCommission) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorCurrentRewards) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorAccumulatedCommission.commissions":
+	case "initia.distribution.v1.ValidatorCurrentRewards.rewards":
 		list := []*DecPool{}
-		return protoreflect.ValueOfList(&_ValidatorAccumulatedCommission_1_list{list: &list})
+		return protoreflect.ValueOfList(&_ValidatorCurrentRewards_1_list{list: &list})
+	case "initia.distribution.v1.ValidatorCurrentRewards.period":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorAccumulatedCommission"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorCurrentRewards"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorAccumulatedCommission does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorCurrentRewards does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ValidatorAccumulatedCommission) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorCurrentRewards) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.ValidatorAccumulatedCommission", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.ValidatorCurrentRewards", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3589,7 +4106,7 @@ func (x *fastReflection_ValidatorAccumulatedCommission) WhichOneof(d protoreflec
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ValidatorAccumulatedCommission) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorCurrentRewards) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3600,7 +4117,7 @@ func (x *fastReflection_ValidatorAccumulatedCommission) GetUnknown() protoreflec
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorAccumulatedCommission) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorCurrentRewards) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3612,7 +4129,7 @@ func (x *fastReflection_ValidatorAccumulatedCommission) SetUnknown(fields protor
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ValidatorAccumulatedCommission) IsValid() bool {
+func (x *fastReflection_ValidatorCurrentRewards) IsValid() bool {
 	return x != nil
 }
 
@@ -3622,9 +4139,9 @@ func (x *fastReflection_ValidatorAccumulatedCommission) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ValidatorAccumulatedCommission) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorCurrentRewards) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ValidatorAccumulatedCommission)
+		x := input.Message.Interface().(*ValidatorCurrentRewards)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3636,12 +4153,15 @@ func (x *fastReflection_ValidatorAccumulatedCommission) ProtoMethods() *protoifa
 		var n int
 		var l int
 		_ = l
-		if len(x.Commissions) > 0 {
-			for _, e := range x.Commissions {
+		if len(x.Rewards) > 0 {
+			for _, e := range x.Rewards {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.Period != 0 {
+			n += 1 + runtime.Sov(uint64(x.Period))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3652,7 +4172,7 @@ func (x *fastReflection_ValidatorAccumulatedCommission) ProtoMethods() *protoifa
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorAccumulatedCommission)
+		x := input.Message.Interface().(*ValidatorCurrentRewards)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3671,9 +4191,14 @@ func (x *fastReflection_ValidatorAccumulatedCommission) ProtoMethods() *protoifa
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Commissions) > 0 {
-			for iNdEx := len(x.Commissions) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Commissions[iNdEx])
+		if x.Period != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Period))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Rewards) > 0 {
+			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Rewards[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3698,7 +4223,7 @@ func (x *fastReflection_ValidatorAccumulatedCommission) ProtoMethods() *protoifa
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorAccumulatedCommission)
+		x := input.Message.Interface().(*ValidatorCurrentRewards)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3730,15 +4255,15 @@ func (x *fastReflection_ValidatorAccumulatedCommission) ProtoMethods() *protoifa
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorAccumulatedCommission: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorCurrentRewards: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorAccumulatedCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorCurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commissions", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3765,11 +4290,30 @@ func (x *fastReflection_ValidatorAccumulatedCommission) ProtoMethods() *protoifa
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Commissions = append(x.Commissions, &DecPool{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Commissions[len(x.Commissions)-1]); err != nil {
+				x.Rewards = append(x.Rewards, &DecPool{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards[len(x.Rewards)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				}
+				x.Period = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Period |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3805,77 +4349,77 @@ func (x *fastReflection_ValidatorAccumulatedCommission) ProtoMethods() *protoifa
 	}
 }
 
-var _ protoreflect.List = (*_ValidatorOutstandingRewards_1_list)(nil)
+var _ protoreflect.List = (*_ValidatorAccumulatedCommission_1_list)(nil)
 
-type _ValidatorOutstandingRewards_1_list struct {
+type _ValidatorAccumulatedCommission_1_list struct {
 	list *[]*DecPool
 }
 
-func (x *_ValidatorOutstandingRewards_1_list) Len() int {
+func (x *_ValidatorAccumulatedCommission_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_ValidatorOutstandingRewards_1_list) Get(i int) protoreflect.Value {
+func (x *_ValidatorAccumulatedCommission_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_ValidatorOutstandingRewards_1_list) Set(i int, value protoreflect.Value) {
+func (x *_ValidatorAccumulatedCommission_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*DecPool)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_ValidatorOutstandingRewards_1_list) Append(value protoreflect.Value) {
+func (x *_ValidatorAccumulatedCommission_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*DecPool)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_ValidatorOutstandingRewards_1_list) AppendMutable() protoreflect.Value {
+func (x *_ValidatorAccumulatedCommission_1_list) AppendMutable() protoreflect.Value {
 	v := new(DecPool)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ValidatorOutstandingRewards_1_list) Truncate(n int) {
+func (x *_ValidatorAccumulatedCommission_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_ValidatorOutstandingRewards_1_list) NewElement() protoreflect.Value {
+func (x *_ValidatorAccumulatedCommission_1_list) NewElement() protoreflect.Value {
 	v := new(DecPool)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ValidatorOutstandingRewards_1_list) IsValid() bool {
+func (x *_ValidatorAccumulatedCommission_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_ValidatorOutstandingRewards         protoreflect.MessageDescriptor
-	fd_ValidatorOutstandingRewards_rewards protoreflect.FieldDescriptor
+	md_ValidatorAccumulatedCommission             protoreflect.MessageDescriptor
+	fd_ValidatorAccumulatedCommission_commissions protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_initia_distribution_v1_distribution_proto_init()
-	md_ValidatorOutstandingRewards = File_initia_distribution_v1_distribution_proto.Messages().ByName("ValidatorOutstandingRewards")
-	fd_ValidatorOutstandingRewards_rewards = md_ValidatorOutstandingRewards.Fields().ByName("rewards")
+	md_ValidatorAccumulatedCommission = File_initia_distribution_v1_distribution_proto.Messages().ByName("ValidatorAccumulatedCommission")
+	fd_ValidatorAccumulatedCommission_commissions = md_ValidatorAccumulatedCommission.Fields().ByName("commissions")
 }
 
-var _ protoreflect.Message = (*fastReflection_ValidatorOutstandingRewards)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorAccumulatedCommission)(nil)
 
-type fastReflection_ValidatorOutstandingRewards ValidatorOutstandingRewards
+type fastReflection_ValidatorAccumulatedCommission ValidatorAccumulatedCommission
 
-func (x *ValidatorOutstandingRewards) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ValidatorOutstandingRewards)(x)
+func (x *ValidatorAccumulatedCommission) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorAccumulatedCommission)(x)
 }
 
-func (x *ValidatorOutstandingRewards) slowProtoReflect() protoreflect.Message {
+func (x *ValidatorAccumulatedCommission) slowProtoReflect() protoreflect.Message {
 	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3887,43 +4431,43 @@ func (x *ValidatorOutstandingRewards) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ValidatorOutstandingRewards_messageType fastReflection_ValidatorOutstandingRewards_messageType
-var _ protoreflect.MessageType = fastReflection_ValidatorOutstandingRewards_messageType{}
+var _fastReflection_ValidatorAccumulatedCommission_messageType fastReflection_ValidatorAccumulatedCommission_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorAccumulatedCommission_messageType{}
 
-type fastReflection_ValidatorOutstandingRewards_messageType struct{}
+type fastReflection_ValidatorAccumulatedCommission_messageType struct{}
 
-func (x fastReflection_ValidatorOutstandingRewards_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ValidatorOutstandingRewards)(nil)
+func (x fastReflection_ValidatorAccumulatedCommission_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorAccumulatedCommission)(nil)
 }
-func (x fastReflection_ValidatorOutstandingRewards_messageType) New() protoreflect.Message {
-	return new(fastReflection_ValidatorOutstandingRewards)
+func (x fastReflection_ValidatorAccumulatedCommission_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorAccumulatedCommission)
 }
-func (x fastReflection_ValidatorOutstandingRewards_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorOutstandingRewards
+func (x fastReflection_ValidatorAccumulatedCommission_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorAccumulatedCommission
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ValidatorOutstandingRewards) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorOutstandingRewards
+func (x *fastReflection_ValidatorAccumulatedCommission) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorAccumulatedCommission
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ValidatorOutstandingRewards) Type() protoreflect.MessageType {
-	return _fastReflection_ValidatorOutstandingRewards_messageType
+func (x *fastReflection_ValidatorAccumulatedCommission) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorAccumulatedCommission_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ValidatorOutstandingRewards) New() protoreflect.Message {
-	return new(fastReflection_ValidatorOutstandingRewards)
+func (x *fastReflection_ValidatorAccumulatedCommission) New() protoreflect.Message {
+	return new(fastReflection_ValidatorAccumulatedCommission)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ValidatorOutstandingRewards) Interface() protoreflect.ProtoMessage {
-	return (*ValidatorOutstandingRewards)(x)
+func (x *fastReflection_ValidatorAccumulatedCommission) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorAccumulatedCommission)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3931,10 +4475,10 @@ func (x *fastReflection_ValidatorOutstandingRewards) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ValidatorOutstandingRewards) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Rewards) != 0 {
-		value := protoreflect.ValueOfList(&_ValidatorOutstandingRewards_1_list{list: &x.Rewards})
-		if !f(fd_ValidatorOutstandingRewards_rewards, value) {
+func (x *fastReflection_ValidatorAccumulatedCommission) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Commissions) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorAccumulatedCommission_1_list{list: &x.Commissions})
+		if !f(fd_ValidatorAccumulatedCommission_commissions, value) {
 			return
 		}
 	}
@@ -3951,15 +4495,15 @@ func (x *fastReflection_ValidatorOutstandingRewards) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ValidatorOutstandingRewards) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorAccumulatedCommission) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorOutstandingRewards.rewards":
-		return len(x.Rewards) != 0
+	case "initia.distribution.v1.ValidatorAccumulatedCommission.commissions":
+		return len(x.Commissions) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorOutstandingRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorAccumulatedCommission"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorOutstandingRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorAccumulatedCommission does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3969,15 +4513,15 @@ func (x *fastReflection_ValidatorOutstandingRewards) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorOutstandingRewards) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorAccumulatedCommission) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorOutstandingRewards.rewards":
-		x.Rewards = nil
+	case "initia.distribution.v1.ValidatorAccumulatedCommission.commissions":
+		x.Commissions = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorOutstandingRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorAccumulatedCommission"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorOutstandingRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorAccumulatedCommission does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3987,19 +4531,19 @@ func (x *fastReflection_ValidatorOutstandingRewards) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ValidatorOutstandingRewards) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorAccumulatedCommission) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "initia.distribution.v1.ValidatorOutstandingRewards.rewards":
-		if len(x.Rewards) == 0 {
-			return protoreflect.ValueOfList(&_ValidatorOutstandingRewards_1_list{})
+	case "initia.distribution.v1.ValidatorAccumulatedCommission.commissions":
+		if len(x.Commissions) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorAccumulatedCommission_1_list{})
 		}
-		listValue := &_ValidatorOutstandingRewards_1_list{list: &x.Rewards}
+		listValue := &_ValidatorAccumulatedCommission_1_list{list: &x.Commissions}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorOutstandingRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorAccumulatedCommission"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorOutstandingRewards does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorAccumulatedCommission does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4013,17 +4557,17 @@ func (x *fastReflection_ValidatorOutstandingRewards) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorOutstandingRewards) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorAccumulatedCommission) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorOutstandingRewards.rewards":
+	case "initia.distribution.v1.ValidatorAccumulatedCommission.commissions":
 		lv := value.List()
-		clv := lv.(*_ValidatorOutstandingRewards_1_list)
-		x.Rewards = *clv.list
+		clv := lv.(*_ValidatorAccumulatedCommission_1_list)
+		x.Commissions = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorOutstandingRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorAccumulatedCommission"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorOutstandingRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorAccumulatedCommission does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4037,45 +4581,45 @@ func (x *fastReflection_ValidatorOutstandingRewards) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorOutstandingRewards) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorAccumulatedCommission) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorOutstandingRewards.rewards":
-		if x.Rewards == nil {
-			x.Rewards = []*DecPool{}
+	case "initia.distribution.v1.ValidatorAccumulatedCommission.commissions":
+		if x.Commissions == nil {
+			x.Commissions = []*DecPool{}
 		}
-		value := &_ValidatorOutstandingRewards_1_list{list: &x.Rewards}
+		value := &_ValidatorAccumulatedCommission_1_list{list: &x.Commissions}
 		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorOutstandingRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorAccumulatedCommission"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorOutstandingRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorAccumulatedCommission does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ValidatorOutstandingRewards) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorAccumulatedCommission) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorOutstandingRewards.rewards":
+	case "initia.distribution.v1.ValidatorAccumulatedCommission.commissions":
 		list := []*DecPool{}
-		return protoreflect.ValueOfList(&_ValidatorOutstandingRewards_1_list{list: &list})
+		return protoreflect.ValueOfList(&_ValidatorAccumulatedCommission_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorOutstandingRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorAccumulatedCommission"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorOutstandingRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorAccumulatedCommission does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ValidatorOutstandingRewards) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorAccumulatedCommission) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.ValidatorOutstandingRewards", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.ValidatorAccumulatedCommission", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4083,7 +4627,7 @@ func (x *fastReflection_ValidatorOutstandingRewards) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ValidatorOutstandingRewards) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorAccumulatedCommission) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4094,7 +4638,7 @@ func (x *fastReflection_ValidatorOutstandingRewards) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorOutstandingRewards) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorAccumulatedCommission) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4106,7 +4650,7 @@ func (x *fastReflection_ValidatorOutstandingRewards) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ValidatorOutstandingRewards) IsValid() bool {
+func (x *fastReflection_ValidatorAccumulatedCommission) IsValid() bool {
 	return x != nil
 }
 
@@ -4116,9 +4660,9 @@ func (x *fastReflection_ValidatorOutstandingRewards) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ValidatorOutstandingRewards) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorAccumulatedCommission) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ValidatorOutstandingRewards)
+		x := input.Message.Interface().(*ValidatorAccumulatedCommission)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4130,8 +4674,8 @@ func (x *fastReflection_ValidatorOutstandingRewards) ProtoMethods() *protoiface.
 		var n int
 		var l int
 		_ = l
-		if len(x.Rewards) > 0 {
-			for _, e := range x.Rewards {
+		if len(x.Commissions) > 0 {
+			for _, e := range x.Commissions {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -4146,7 +4690,7 @@ func (x *fastReflection_ValidatorOutstandingRewards) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorOutstandingRewards)
+		x := input.Message.Interface().(*ValidatorAccumulatedCommission)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4165,9 +4709,9 @@ func (x *fastReflection_ValidatorOutstandingRewards) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Rewards) > 0 {
-			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Rewards[iNdEx])
+		if len(x.Commissions) > 0 {
+			for iNdEx := len(x.Commissions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Commissions[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4192,7 +4736,7 @@ func (x *fastReflection_ValidatorOutstandingRewards) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorOutstandingRewards)
+		x := input.Message.Interface().(*ValidatorAccumulatedCommission)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4224,15 +4768,15 @@ func (x *fastReflection_ValidatorOutstandingRewards) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorOutstandingRewards: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorAccumulatedCommission: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorOutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorAccumulatedCommission: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commissions", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4259,8 +4803,8 @@ func (x *fastReflection_ValidatorOutstandingRewards) ProtoMethods() *protoiface.
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Rewards = append(x.Rewards, &DecPool{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards[len(x.Rewards)-1]); err != nil {
+				x.Commissions = append(x.Commissions, &DecPool{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Commissions[len(x.Commissions)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4299,79 +4843,77 @@ func (x *fastReflection_ValidatorOutstandingRewards) ProtoMethods() *protoiface.
 	}
 }
 
-var _ protoreflect.List = (*_ValidatorSlashEvent_2_list)(nil)
+var _ protoreflect.List = (*_ValidatorOutstandingRewards_1_list)(nil)
 
-type _ValidatorSlashEvent_2_list struct {
-	list *[]*v1beta1.DecCoin
+type _ValidatorOutstandingRewards_1_list struct {
+	list *[]*DecPool
 }
 
-func (x *_ValidatorSlashEvent_2_list) Len() int {
+func (x *_ValidatorOutstandingRewards_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_ValidatorSlashEvent_2_list) Get(i int) protoreflect.Value {
+func (x *_ValidatorOutstandingRewards_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_ValidatorSlashEvent_2_list) Set(i int, value protoreflect.Value) {
+func (x *_ValidatorOutstandingRewards_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	concreteValue := valueUnwrapped.Interface().(*DecPool)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_ValidatorSlashEvent_2_list) Append(value protoreflect.Value) {
+func (x *_ValidatorOutstandingRewards_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	concreteValue := valueUnwrapped.Interface().(*DecPool)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_ValidatorSlashEvent_2_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.DecCoin)
+func (x *_ValidatorOutstandingRewards_1_list) AppendMutable() protoreflect.Value {
+	v := new(DecPool)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ValidatorSlashEvent_2_list) Truncate(n int) {
+func (x *_ValidatorOutstandingRewards_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_ValidatorSlashEvent_2_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.DecCoin)
+func (x *_ValidatorOutstandingRewards_1_list) NewElement() protoreflect.Value {
+	v := new(DecPool)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ValidatorSlashEvent_2_list) IsValid() bool {
+func (x *_ValidatorOutstandingRewards_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_ValidatorSlashEvent                  protoreflect.MessageDescriptor
-	fd_ValidatorSlashEvent_validator_period protoreflect.FieldDescriptor
-	fd_ValidatorSlashEvent_fractions        protoreflect.FieldDescriptor
+	md_ValidatorOutstandingRewards         protoreflect.MessageDescriptor
+	fd_ValidatorOutstandingRewards_rewards protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_initia_distribution_v1_distribution_proto_init()
-	md_ValidatorSlashEvent = File_initia_distribution_v1_distribution_proto.Messages().ByName("ValidatorSlashEvent")
-	fd_ValidatorSlashEvent_validator_period = md_ValidatorSlashEvent.Fields().ByName("validator_period")
-	fd_ValidatorSlashEvent_fractions = md_ValidatorSlashEvent.Fields().ByName("fractions")
+	md_ValidatorOutstandingRewards = File_initia_distribution_v1_distribution_proto.Messages().ByName("ValidatorOutstandingRewards")
+	fd_ValidatorOutstandingRewards_rewards = md_ValidatorOutstandingRewards.Fields().ByName("rewards")
 }
 
-var _ protoreflect.Message = (*fastReflection_ValidatorSlashEvent)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorOutstandingRewards)(nil)
 
-type fastReflection_ValidatorSlashEvent ValidatorSlashEvent
+type fastReflection_ValidatorOutstandingRewards ValidatorOutstandingRewards
 
-func (x *ValidatorSlashEvent) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ValidatorSlashEvent)(x)
+func (x *ValidatorOutstandingRewards) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorOutstandingRewards)(x)
 }
 
-func (x *ValidatorSlashEvent) slowProtoReflect() protoreflect.Message {
+func (x *ValidatorOutstandingRewards) slowProtoReflect() protoreflect.Message {
 	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4383,43 +4925,43 @@ func (x *ValidatorSlashEvent) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ValidatorSlashEvent_messageType fastReflection_ValidatorSlashEvent_messageType
-var _ protoreflect.MessageType = fastReflection_ValidatorSlashEvent_messageType{}
+var _fastReflection_ValidatorOutstandingRewards_messageType fastReflection_ValidatorOutstandingRewards_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorOutstandingRewards_messageType{}
 
-type fastReflection_ValidatorSlashEvent_messageType struct{}
+type fastReflection_ValidatorOutstandingRewards_messageType struct{}
 
-func (x fastReflection_ValidatorSlashEvent_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ValidatorSlashEvent)(nil)
+func (x fastReflection_ValidatorOutstandingRewards_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorOutstandingRewards)(nil)
 }
-func (x fastReflection_ValidatorSlashEvent_messageType) New() protoreflect.Message {
-	return new(fastReflection_ValidatorSlashEvent)
+func (x fastReflection_ValidatorOutstandingRewards_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorOutstandingRewards)
 }
-func (x fastReflection_ValidatorSlashEvent_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorSlashEvent
+func (x fastReflection_ValidatorOutstandingRewards_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorOutstandingRewards
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ValidatorSlashEvent) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorSlashEvent
+func (x *fastReflection_ValidatorOutstandingRewards) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorOutstandingRewards
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ValidatorSlashEvent) Type() protoreflect.MessageType {
-	return _fastReflection_ValidatorSlashEvent_messageType
+func (x *fastReflection_ValidatorOutstandingRewards) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorOutstandingRewards_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ValidatorSlashEvent) New() protoreflect.Message {
-	return new(fastReflection_ValidatorSlashEvent)
+func (x *fastReflection_ValidatorOutstandingRewards) New() protoreflect.Message {
+	return new(fastReflection_ValidatorOutstandingRewards)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ValidatorSlashEvent) Interface() protoreflect.ProtoMessage {
-	return (*ValidatorSlashEvent)(x)
+func (x *fastReflection_ValidatorOutstandingRewards) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorOutstandingRewards)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4427,16 +4969,10 @@ func (x *fastReflection_ValidatorSlashEvent) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ValidatorSlashEvent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ValidatorPeriod != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ValidatorPeriod)
-		if !f(fd_ValidatorSlashEvent_validator_period, value) {
-			return
-		}
-	}
-	if len(x.Fractions) != 0 {
-		value := protoreflect.ValueOfList(&_ValidatorSlashEvent_2_list{list: &x.Fractions})
-		if !f(fd_ValidatorSlashEvent_fractions, value) {
+func (x *fastReflection_ValidatorOutstandingRewards) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Rewards) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorOutstandingRewards_1_list{list: &x.Rewards})
+		if !f(fd_ValidatorOutstandingRewards_rewards, value) {
 			return
 		}
 	}
@@ -4453,17 +4989,15 @@ func (x *fastReflection_ValidatorSlashEvent) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ValidatorSlashEvent) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorOutstandingRewards) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorSlashEvent.validator_period":
-		return x.ValidatorPeriod != uint64(0)
-	case "initia.distribution.v1.ValidatorSlashEvent.fractions":
-		return len(x.Fractions) != 0
+	case "initia.distribution.v1.ValidatorOutstandingRewards.rewards":
+		return len(x.Rewards) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorSlashEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorOutstandingRewards"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorSlashEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorOutstandingRewards does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4473,17 +5007,15 @@ func (x *fastReflection_ValidatorSlashEvent) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorSlashEvent) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorOutstandingRewards) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorSlashEvent.validator_period":
-		x.ValidatorPeriod = uint64(0)
-	case "initia.distribution.v1.ValidatorSlashEvent.fractions":
-		x.Fractions = nil
+	case "initia.distribution.v1.ValidatorOutstandingRewards.rewards":
+		x.Rewards = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorSlashEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorOutstandingRewards"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorSlashEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorOutstandingRewards does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4493,22 +5025,19 @@ func (x *fastReflection_ValidatorSlashEvent) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ValidatorSlashEvent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorOutstandingRewards) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "initia.distribution.v1.ValidatorSlashEvent.validator_period":
-		value := x.ValidatorPeriod
-		return protoreflect.ValueOfUint64(value)
-	case "initia.distribution.v1.ValidatorSlashEvent.fractions":
-		if len(x.Fractions) == 0 {
-			return protoreflect.ValueOfList(&_ValidatorSlashEvent_2_list{})
+	case "initia.distribution.v1.ValidatorOutstandingRewards.rewards":
+		if len(x.Rewards) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorOutstandingRewards_1_list{})
 		}
-		listValue := &_ValidatorSlashEvent_2_list{list: &x.Fractions}
+		listValue := &_ValidatorOutstandingRewards_1_list{list: &x.Rewards}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorSlashEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorOutstandingRewards"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorSlashEvent does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorOutstandingRewards does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4522,19 +5051,17 @@ func (x *fastReflection_ValidatorSlashEvent) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorSlashEvent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorOutstandingRewards) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorSlashEvent.validator_period":
-		x.ValidatorPeriod = value.Uint()
-	case "initia.distribution.v1.ValidatorSlashEvent.fractions":
+	case "initia.distribution.v1.ValidatorOutstandingRewards.rewards":
 		lv := value.List()
-		clv := lv.(*_ValidatorSlashEvent_2_list)
-		x.Fractions = *clv.list
+		clv := lv.(*_ValidatorOutstandingRewards_1_list)
+		x.Rewards = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorSlashEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorOutstandingRewards"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorSlashEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorOutstandingRewards does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4548,49 +5075,45 @@ func (x *fastReflection_ValidatorSlashEvent) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorSlashEvent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorOutstandingRewards) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorSlashEvent.fractions":
-		if x.Fractions == nil {
-			x.Fractions = []*v1beta1.DecCoin{}
+	case "initia.distribution.v1.ValidatorOutstandingRewards.rewards":
+		if x.Rewards == nil {
+			x.Rewards = []*DecPool{}
 		}
-		value := &_ValidatorSlashEvent_2_list{list: &x.Fractions}
+		value := &_ValidatorOutstandingRewards_1_list{list: &x.Rewards}
 		return protoreflect.ValueOfList(value)
-	case "initia.distribution.v1.ValidatorSlashEvent.validator_period":
-		panic(fmt.Errorf("field validator_period of message initia.distribution.v1.ValidatorSlashEvent is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorSlashEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorOutstandingRewards"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorSlashEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorOutstandingRewards does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ValidatorSlashEvent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorOutstandingRewards) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorSlashEvent.validator_period":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "initia.distribution.v1.ValidatorSlashEvent.fractions":
-		list := []*v1beta1.DecCoin{}
-		return protoreflect.ValueOfList(&_ValidatorSlashEvent_2_list{list: &list})
+	case "initia.distribution.v1.ValidatorOutstandingRewards.rewards":
+		list := []*DecPool{}
+		return protoreflect.ValueOfList(&_ValidatorOutstandingRewards_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorSlashEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorOutstandingRewards"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorSlashEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorOutstandingRewards does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ValidatorSlashEvent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorOutstandingRewards) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.ValidatorSlashEvent", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.ValidatorOutstandingRewards", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4598,7 +5121,7 @@ func (x *fastReflection_ValidatorSlashEvent) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ValidatorSlashEvent) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorOutstandingRewards) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4609,7 +5132,7 @@ func (x *fastReflection_ValidatorSlashEvent) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorSlashEvent) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorOutstandingRewards) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4621,7 +5144,7 @@ func (x *fastReflection_ValidatorSlashEvent) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ValidatorSlashEvent) IsValid() bool {
+func (x *fastReflection_ValidatorOutstandingRewards) IsValid() bool {
 	return x != nil
 }
 
@@ -4631,9 +5154,9 @@ func (x *fastReflection_ValidatorSlashEvent) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ValidatorSlashEvent) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorOutstandingRewards) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ValidatorSlashEvent)
+		x := input.Message.Interface().(*ValidatorOutstandingRewards)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4645,11 +5168,8 @@ func (x *fastReflection_ValidatorSlashEvent) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.ValidatorPeriod != 0 {
-			n += 1 + runtime.Sov(uint64(x.ValidatorPeriod))
-		}
-		if len(x.Fractions) > 0 {
-			for _, e := range x.Fractions {
+		if len(x.Rewards) > 0 {
+			for _, e := range x.Rewards {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -4664,7 +5184,7 @@ func (x *fastReflection_ValidatorSlashEvent) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorSlashEvent)
+		x := input.Message.Interface().(*ValidatorOutstandingRewards)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4683,9 +5203,9 @@ func (x *fastReflection_ValidatorSlashEvent) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Fractions) > 0 {
-			for iNdEx := len(x.Fractions) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Fractions[iNdEx])
+		if len(x.Rewards) > 0 {
+			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Rewards[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4696,14 +5216,9 @@ func (x *fastReflection_ValidatorSlashEvent) ProtoMethods() *protoiface.Methods
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x12
+				dAtA[i] = 0xa
 			}
 		}
-		if x.ValidatorPeriod != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorPeriod))
-			i--
-			dAtA[i] = 0x8
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -4715,7 +5230,7 @@ func (x *fastReflection_ValidatorSlashEvent) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorSlashEvent)
+		x := input.Message.Interface().(*ValidatorOutstandingRewards)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4747,34 +5262,15 @@ func (x *fastReflection_ValidatorSlashEvent) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSlashEvent: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorOutstandingRewards: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorOutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorPeriod", wireType)
-				}
-				x.ValidatorPeriod = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ValidatorPeriod |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fractions", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4801,8 +5297,8 @@ func (x *fastReflection_ValidatorSlashEvent) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Fractions = append(x.Fractions, &v1beta1.DecCoin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fractions[len(x.Fractions)-1]); err != nil {
+				x.Rewards = append(x.Rewards, &DecPool{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards[len(x.Rewards)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4841,77 +5337,79 @@ func (x *fastReflection_ValidatorSlashEvent) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var _ protoreflect.List = (*_ValidatorSlashEvents_1_list)(nil)
+var _ protoreflect.List = (*_ValidatorSlashEvent_2_list)(nil)
 
-type _ValidatorSlashEvents_1_list struct {
-	list *[]*ValidatorSlashEvent
+type _ValidatorSlashEvent_2_list struct {
+	list *[]*v1beta1.DecCoin
 }
 
-func (x *_ValidatorSlashEvents_1_list) Len() int {
+func (x *_ValidatorSlashEvent_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_ValidatorSlashEvents_1_list) Get(i int) protoreflect.Value {
+func (x *_ValidatorSlashEvent_2_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_ValidatorSlashEvents_1_list) Set(i int, value protoreflect.Value) {
+func (x *_ValidatorSlashEvent_2_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ValidatorSlashEvent)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_ValidatorSlashEvents_1_list) Append(value protoreflect.Value) {
+func (x *_ValidatorSlashEvent_2_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ValidatorSlashEvent)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_ValidatorSlashEvents_1_list) AppendMutable() protoreflect.Value {
-	v := new(ValidatorSlashEvent)
+func (x *_ValidatorSlashEvent_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ValidatorSlashEvents_1_list) Truncate(n int) {
+func (x *_ValidatorSlashEvent_2_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_ValidatorSlashEvents_1_list) NewElement() protoreflect.Value {
-	v := new(ValidatorSlashEvent)
+func (x *_ValidatorSlashEvent_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ValidatorSlashEvents_1_list) IsValid() bool {
+func (x *_ValidatorSlashEvent_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_ValidatorSlashEvents                        protoreflect.MessageDescriptor
-	fd_ValidatorSlashEvents_validator_slash_events protoreflect.FieldDescriptor
+	md_ValidatorSlashEvent                  protoreflect.MessageDescriptor
+	fd_ValidatorSlashEvent_validator_period protoreflect.FieldDescriptor
+	fd_ValidatorSlashEvent_fractions        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_initia_distribution_v1_distribution_proto_init()
-	md_ValidatorSlashEvents = File_initia_distribution_v1_distribution_proto.Messages().ByName("ValidatorSlashEvents")
-	fd_ValidatorSlashEvents_validator_slash_events = md_ValidatorSlashEvents.Fields().ByName("validator_slash_events")
+	md_ValidatorSlashEvent = File_initia_distribution_v1_distribution_proto.Messages().ByName("ValidatorSlashEvent")
+	fd_ValidatorSlashEvent_validator_period = md_ValidatorSlashEvent.Fields().ByName("validator_period")
+	fd_ValidatorSlashEvent_fractions = md_ValidatorSlashEvent.Fields().ByName("fractions")
 }
 
-var _ protoreflect.Message = (*fastReflection_ValidatorSlashEvents)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorSlashEvent)(nil)
 
-type fastReflection_ValidatorSlashEvents ValidatorSlashEvents
+type fastReflection_ValidatorSlashEvent ValidatorSlashEvent
 
-func (x *ValidatorSlashEvents) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ValidatorSlashEvents)(x)
+func (x *ValidatorSlashEvent) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorSlashEvent)(x)
 }
 
-func (x *ValidatorSlashEvents) slowProtoReflect() protoreflect.Message {
+func (x *ValidatorSlashEvent) slowProtoReflect() protoreflect.Message {
 	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4923,43 +5421,43 @@ func (x *ValidatorSlashEvents) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ValidatorSlashEvents_messageType fastReflection_ValidatorSlashEvents_messageType
-var _ protoreflect.MessageType = fastReflection_ValidatorSlashEvents_messageType{}
+var _fastReflection_ValidatorSlashEvent_messageType fastReflection_ValidatorSlashEvent_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorSlashEvent_messageType{}
 
-type fastReflection_ValidatorSlashEvents_messageType struct{}
+type fastReflection_ValidatorSlashEvent_messageType struct{}
 
-func (x fastReflection_ValidatorSlashEvents_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ValidatorSlashEvents)(nil)
+func (x fastReflection_ValidatorSlashEvent_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorSlashEvent)(nil)
 }
-func (x fastReflection_ValidatorSlashEvents_messageType) New() protoreflect.Message {
-	return new(fastReflection_ValidatorSlashEvents)
+func (x fastReflection_ValidatorSlashEvent_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorSlashEvent)
 }
-func (x fastReflection_ValidatorSlashEvents_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorSlashEvents
+func (x fastReflection_ValidatorSlashEvent_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorSlashEvent
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ValidatorSlashEvents) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorSlashEvents
+func (x *fastReflection_ValidatorSlashEvent) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorSlashEvent
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ValidatorSlashEvents) Type() protoreflect.MessageType {
-	return _fastReflection_ValidatorSlashEvents_messageType
+func (x *fastReflection_ValidatorSlashEvent) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorSlashEvent_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ValidatorSlashEvents) New() protoreflect.Message {
-	return new(fastReflection_ValidatorSlashEvents)
+func (x *fastReflection_ValidatorSlashEvent) New() protoreflect.Message {
+	return new(fastReflection_ValidatorSlashEvent)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ValidatorSlashEvents) Interface() protoreflect.ProtoMessage {
-	return (*ValidatorSlashEvents)(x)
+func (x *fastReflection_ValidatorSlashEvent) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorSlashEvent)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4967,10 +5465,16 @@ func (x *fastReflection_ValidatorSlashEvents) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ValidatorSlashEvents) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.ValidatorSlashEvents) != 0 {
-		value := protoreflect.ValueOfList(&_ValidatorSlashEvents_1_list{list: &x.ValidatorSlashEvents})
-		if !f(fd_ValidatorSlashEvents_validator_slash_events, value) {
+func (x *fastReflection_ValidatorSlashEvent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorPeriod != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ValidatorPeriod)
+		if !f(fd_ValidatorSlashEvent_validator_period, value) {
+			return
+		}
+	}
+	if len(x.Fractions) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorSlashEvent_2_list{list: &x.Fractions})
+		if !f(fd_ValidatorSlashEvent_fractions, value) {
 			return
 		}
 	}
@@ -4987,15 +5491,17 @@ func (x *fastReflection_ValidatorSlashEvents) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ValidatorSlashEvents) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorSlashEvent) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorSlashEvents.validator_slash_events":
-		return len(x.ValidatorSlashEvents) != 0
+	case "initia.distribution.v1.ValidatorSlashEvent.validator_period":
+		return x.ValidatorPeriod != uint64(0)
+	case "initia.distribution.v1.ValidatorSlashEvent.fractions":
+		return len(x.Fractions) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorSlashEvents"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorSlashEvent"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorSlashEvents does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorSlashEvent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5005,15 +5511,17 @@ func (x *fastReflection_ValidatorSlashEvents) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorSlashEvents) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorSlashEvent) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorSlashEvents.validator_slash_events":
-		x.ValidatorSlashEvents = nil
+	case "initia.distribution.v1.ValidatorSlashEvent.validator_period":
+		x.ValidatorPeriod = uint64(0)
+	case "initia.distribution.v1.ValidatorSlashEvent.fractions":
+		x.Fractions = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorSlashEvents"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorSlashEvent"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorSlashEvents does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorSlashEvent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5023,19 +5531,22 @@ func (x *fastReflection_ValidatorSlashEvents) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ValidatorSlashEvents) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorSlashEvent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "initia.distribution.v1.ValidatorSlashEvents.validator_slash_events":
-		if len(x.ValidatorSlashEvents) == 0 {
-			return protoreflect.ValueOfList(&_ValidatorSlashEvents_1_list{})
+	case "initia.distribution.v1.ValidatorSlashEvent.validator_period":
+		value := x.ValidatorPeriod
+		return protoreflect.ValueOfUint64(value)
+	case "initia.distribution.v1.ValidatorSlashEvent.fractions":
+		if len(x.Fractions) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorSlashEvent_2_list{})
 		}
-		listValue := &_ValidatorSlashEvents_1_list{list: &x.ValidatorSlashEvents}
+		listValue := &_ValidatorSlashEvent_2_list{list: &x.Fractions}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorSlashEvents"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorSlashEvent"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorSlashEvents does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorSlashEvent does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5049,17 +5560,19 @@ func (x *fastReflection_ValidatorSlashEvents) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorSlashEvents) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorSlashEvent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorSlashEvents.validator_slash_events":
+	case "initia.distribution.v1.ValidatorSlashEvent.validator_period":
+		x.ValidatorPeriod = value.Uint()
+	case "initia.distribution.v1.ValidatorSlashEvent.fractions":
 		lv := value.List()
-		clv := lv.(*_ValidatorSlashEvents_1_list)
-		x.ValidatorSlashEvents = *clv.list
+		clv := lv.(*_ValidatorSlashEvent_2_list)
+		x.Fractions = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorSlashEvents"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorSlashEvent"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorSlashEvents does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorSlashEvent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5073,45 +5586,49 @@ func (x *fastReflection_ValidatorSlashEvents) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorSlashEvents) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorSlashEvent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorSlashEvents.validator_slash_events":
-		if x.ValidatorSlashEvents == nil {
-			x.ValidatorSlashEvents = []*ValidatorSlashEvent{}
+	case "initia.distribution.v1.ValidatorSlashEvent.fractions":
+		if x.Fractions == nil {
+			x.Fractions = []*v1beta1.DecCoin{}
 		}
-		value := &_ValidatorSlashEvents_1_list{list: &x.ValidatorSlashEvents}
+		value := &_ValidatorSlashEvent_2_list{list: &x.Fractions}
 		return protoreflect.ValueOfList(value)
+	case "initia.distribution.v1.ValidatorSlashEvent.validator_period":
+		panic(fmt.Errorf("field validator_period of message initia.distribution.v1.ValidatorSlashEvent is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorSlashEvents"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorSlashEvent"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorSlashEvents does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorSlashEvent does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ValidatorSlashEvents) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorSlashEvent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.distribution.v1.ValidatorSlashEvents.validator_slash_events":
-		list := []*ValidatorSlashEvent{}
-		return protoreflect.ValueOfList(&_ValidatorSlashEvents_1_list{list: &list})
+	case "initia.distribution.v1.ValidatorSlashEvent.validator_period":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.distribution.v1.ValidatorSlashEvent.fractions":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_ValidatorSlashEvent_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorSlashEvents"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorSlashEvent"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.ValidatorSlashEvents does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorSlashEvent does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ValidatorSlashEvents) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorSlashEvent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.ValidatorSlashEvents", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.ValidatorSlashEvent", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5119,7 +5636,7 @@ func (x *fastReflection_ValidatorSlashEvents) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ValidatorSlashEvents) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorSlashEvent) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5130,7 +5647,7 @@ func (x *fastReflection_ValidatorSlashEvents) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorSlashEvents) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorSlashEvent) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5142,7 +5659,7 @@ func (x *fastReflection_ValidatorSlashEvents) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ValidatorSlashEvents) IsValid() bool {
+func (x *fastReflection_ValidatorSlashEvent) IsValid() bool {
 	return x != nil
 }
 
@@ -5152,9 +5669,9 @@ func (x *fastReflection_ValidatorSlashEvents) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ValidatorSlashEvents) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorSlashEvent) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ValidatorSlashEvents)
+		x := input.Message.Interface().(*ValidatorSlashEvent)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5166,8 +5683,11 @@ func (x *fastReflection_ValidatorSlashEvents) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if len(x.ValidatorSlashEvents) > 0 {
-			for _, e := range x.ValidatorSlashEvents {
+		if x.ValidatorPeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidatorPeriod))
+		}
+		if len(x.Fractions) > 0 {
+			for _, e := range x.Fractions {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -5182,7 +5702,7 @@ func (x *fastReflection_ValidatorSlashEvents) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorSlashEvents)
+		x := input.Message.Interface().(*ValidatorSlashEvent)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5201,9 +5721,9 @@ func (x *fastReflection_ValidatorSlashEvents) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ValidatorSlashEvents) > 0 {
-			for iNdEx := len(x.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ValidatorSlashEvents[iNdEx])
+		if len(x.Fractions) > 0 {
+			for iNdEx := len(x.Fractions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Fractions[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5214,9 +5734,14 @@ func (x *fastReflection_ValidatorSlashEvents) ProtoMethods() *protoiface.Methods
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0xa
+				dAtA[i] = 0x12
 			}
 		}
+		if x.ValidatorPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorPeriod))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -5228,7 +5753,7 @@ func (x *fastReflection_ValidatorSlashEvents) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorSlashEvents)
+		x := input.Message.Interface().(*ValidatorSlashEvent)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5260,15 +5785,34 @@ func (x *fastReflection_ValidatorSlashEvents) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSlashEvents: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSlashEvent: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSlashEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorPeriod", wireType)
+				}
+				x.ValidatorPeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidatorPeriod |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fractions", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5295,8 +5839,8 @@ func (x *fastReflection_ValidatorSlashEvents) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ValidatorSlashEvents = append(x.ValidatorSlashEvents, &ValidatorSlashEvent{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorSlashEvents[len(x.ValidatorSlashEvents)-1]); err != nil {
+				x.Fractions = append(x.Fractions, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fractions[len(x.Fractions)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -5335,81 +5879,77 @@ func (x *fastReflection_ValidatorSlashEvents) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var _ protoreflect.List = (*_DelegatorStartingInfo_2_list)(nil)
+var _ protoreflect.List = (*_ValidatorSlashEvents_1_list)(nil)
 
-type _DelegatorStartingInfo_2_list struct {
-	list *[]*v1beta1.DecCoin
+type _ValidatorSlashEvents_1_list struct {
+	list *[]*ValidatorSlashEvent
 }
 
-func (x *_DelegatorStartingInfo_2_list) Len() int {
+func (x *_ValidatorSlashEvents_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_DelegatorStartingInfo_2_list) Get(i int) protoreflect.Value {
+func (x *_ValidatorSlashEvents_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_DelegatorStartingInfo_2_list) Set(i int, value protoreflect.Value) {
+func (x *_ValidatorSlashEvents_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	concreteValue := valueUnwrapped.Interface().(*ValidatorSlashEvent)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_DelegatorStartingInfo_2_list) Append(value protoreflect.Value) {
+func (x *_ValidatorSlashEvents_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	concreteValue := valueUnwrapped.Interface().(*ValidatorSlashEvent)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_DelegatorStartingInfo_2_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.DecCoin)
+func (x *_ValidatorSlashEvents_1_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorSlashEvent)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_DelegatorStartingInfo_2_list) Truncate(n int) {
+func (x *_ValidatorSlashEvents_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_DelegatorStartingInfo_2_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.DecCoin)
+func (x *_ValidatorSlashEvents_1_list) NewElement() protoreflect.Value {
+	v := new(ValidatorSlashEvent)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_DelegatorStartingInfo_2_list) IsValid() bool {
+func (x *_ValidatorSlashEvents_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_DelegatorStartingInfo                 protoreflect.MessageDescriptor
-	fd_DelegatorStartingInfo_previous_period protoreflect.FieldDescriptor
-	fd_DelegatorStartingInfo_stakes          protoreflect.FieldDescriptor
-	fd_DelegatorStartingInfo_height          protoreflect.FieldDescriptor
+	md_ValidatorSlashEvents                        protoreflect.MessageDescriptor
+	fd_ValidatorSlashEvents_validator_slash_events protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_initia_distribution_v1_distribution_proto_init()
-	md_DelegatorStartingInfo = File_initia_distribution_v1_distribution_proto.Messages().ByName("DelegatorStartingInfo")
-	fd_DelegatorStartingInfo_previous_period = md_DelegatorStartingInfo.Fields().ByName("previous_period")
-	fd_DelegatorStartingInfo_stakes = md_DelegatorStartingInfo.Fields().ByName("stakes")
-	fd_DelegatorStartingInfo_height = md_DelegatorStartingInfo.Fields().ByName("height")
+	md_ValidatorSlashEvents = File_initia_distribution_v1_distribution_proto.Messages().ByName("ValidatorSlashEvents")
+	fd_ValidatorSlashEvents_validator_slash_events = md_ValidatorSlashEvents.Fields().ByName("validator_slash_events")
 }
 
-var _ protoreflect.Message = (*fastReflection_DelegatorStartingInfo)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorSlashEvents)(nil)
 
-type fastReflection_DelegatorStartingInfo DelegatorStartingInfo
+type fastReflection_ValidatorSlashEvents ValidatorSlashEvents
 
-func (x *DelegatorStartingInfo) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DelegatorStartingInfo)(x)
+func (x *ValidatorSlashEvents) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorSlashEvents)(x)
 }
 
-func (x *DelegatorStartingInfo) slowProtoReflect() protoreflect.Message {
+func (x *ValidatorSlashEvents) slowProtoReflect() protoreflect.Message {
 	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5421,43 +5961,43 @@ func (x *DelegatorStartingInfo) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DelegatorStartingInfo_messageType fastReflection_DelegatorStartingInfo_messageType
-var _ protoreflect.MessageType = fastReflection_DelegatorStartingInfo_messageType{}
+var _fastReflection_ValidatorSlashEvents_messageType fastReflection_ValidatorSlashEvents_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorSlashEvents_messageType{}
 
-type fastReflection_DelegatorStartingInfo_messageType struct{}
+type fastReflection_ValidatorSlashEvents_messageType struct{}
 
-func (x fastReflection_DelegatorStartingInfo_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DelegatorStartingInfo)(nil)
+func (x fastReflection_ValidatorSlashEvents_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorSlashEvents)(nil)
 }
-func (x fastReflection_DelegatorStartingInfo_messageType) New() protoreflect.Message {
-	return new(fastReflection_DelegatorStartingInfo)
+func (x fastReflection_ValidatorSlashEvents_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorSlashEvents)
 }
-func (x fastReflection_DelegatorStartingInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DelegatorStartingInfo
+func (x fastReflection_ValidatorSlashEvents_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorSlashEvents
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DelegatorStartingInfo) Descriptor() protoreflect.MessageDescriptor {
-	return md_DelegatorStartingInfo
+func (x *fastReflection_ValidatorSlashEvents) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorSlashEvents
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DelegatorStartingInfo) Type() protoreflect.MessageType {
-	return _fastReflection_DelegatorStartingInfo_messageType
+func (x *fastReflection_ValidatorSlashEvents) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorSlashEvents_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DelegatorStartingInfo) New() protoreflect.Message {
-	return new(fastReflection_DelegatorStartingInfo)
+func (x *fastReflection_ValidatorSlashEvents) New() protoreflect.Message {
+	return new(fastReflection_ValidatorSlashEvents)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DelegatorStartingInfo) Interface() protoreflect.ProtoMessage {
-	return (*DelegatorStartingInfo)(x)
+func (x *fastReflection_ValidatorSlashEvents) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorSlashEvents)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5465,22 +6005,10 @@ func (x *fastReflection_DelegatorStartingInfo) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DelegatorStartingInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.PreviousPeriod != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.PreviousPeriod)
-		if !f(fd_DelegatorStartingInfo_previous_period, value) {
-			return
-		}
-	}
-	if len(x.Stakes) != 0 {
-		value := protoreflect.ValueOfList(&_DelegatorStartingInfo_2_list{list: &x.Stakes})
-		if !f(fd_DelegatorStartingInfo_stakes, value) {
-			return
-		}
-	}
-	if x.Height != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Height)
-		if !f(fd_DelegatorStartingInfo_height, value) {
+func (x *fastReflection_ValidatorSlashEvents) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ValidatorSlashEvents) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorSlashEvents_1_list{list: &x.ValidatorSlashEvents})
+		if !f(fd_ValidatorSlashEvents_validator_slash_events, value) {
 			return
 		}
 	}
@@ -5497,19 +6025,15 @@ func (x *fastReflection_DelegatorStartingInfo) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DelegatorStartingInfo) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorSlashEvents) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "initia.distribution.v1.DelegatorStartingInfo.previous_period":
-		return x.PreviousPeriod != uint64(0)
-	case "initia.distribution.v1.DelegatorStartingInfo.stakes":
-		return len(x.Stakes) != 0
-	case "initia.distribution.v1.DelegatorStartingInfo.height":
-		return x.Height != uint64(0)
+	case "initia.distribution.v1.ValidatorSlashEvents.validator_slash_events":
+		return len(x.ValidatorSlashEvents) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DelegatorStartingInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorSlashEvents"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.DelegatorStartingInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorSlashEvents does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5519,19 +6043,15 @@ func (x *fastReflection_DelegatorStartingInfo) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DelegatorStartingInfo) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorSlashEvents) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "initia.distribution.v1.DelegatorStartingInfo.previous_period":
-		x.PreviousPeriod = uint64(0)
-	case "initia.distribution.v1.DelegatorStartingInfo.stakes":
-		x.Stakes = nil
-	case "initia.distribution.v1.DelegatorStartingInfo.height":
-		x.Height = uint64(0)
+	case "initia.distribution.v1.ValidatorSlashEvents.validator_slash_events":
+		x.ValidatorSlashEvents = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DelegatorStartingInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorSlashEvents"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.DelegatorStartingInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorSlashEvents does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5541,25 +6061,19 @@ func (x *fastReflection_DelegatorStartingInfo) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DelegatorStartingInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorSlashEvents) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "initia.distribution.v1.DelegatorStartingInfo.previous_period":
-		value := x.PreviousPeriod
-		return protoreflect.ValueOfUint64(value)
-	case "initia.distribution.v1.DelegatorStartingInfo.stakes":
-		if len(x.Stakes) == 0 {
-			return protoreflect.ValueOfList(&_DelegatorStartingInfo_2_list{})
+	case "initia.distribution.v1.ValidatorSlashEvents.validator_slash_events":
+		if len(x.ValidatorSlashEvents) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorSlashEvents_1_list{})
 		}
-		listValue := &_DelegatorStartingInfo_2_list{list: &x.Stakes}
+		listValue := &_ValidatorSlashEvents_1_list{list: &x.ValidatorSlashEvents}
 		return protoreflect.ValueOfList(listValue)
-	case "initia.distribution.v1.DelegatorStartingInfo.height":
-		value := x.Height
-		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DelegatorStartingInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorSlashEvents"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.DelegatorStartingInfo does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorSlashEvents does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5573,21 +6087,17 @@ func (x *fastReflection_DelegatorStartingInfo) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DelegatorStartingInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorSlashEvents) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "initia.distribution.v1.DelegatorStartingInfo.previous_period":
-		x.PreviousPeriod = value.Uint()
-	case "initia.distribution.v1.DelegatorStartingInfo.stakes":
+	case "initia.distribution.v1.ValidatorSlashEvents.validator_slash_events":
 		lv := value.List()
-		clv := lv.(*_DelegatorStartingInfo_2_list)
-		x.Stakes = *clv.list
-	case "initia.distribution.v1.DelegatorStartingInfo.height":
-		x.Height = value.Uint()
+		clv := lv.(*_ValidatorSlashEvents_1_list)
+		x.ValidatorSlashEvents = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DelegatorStartingInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorSlashEvents"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.DelegatorStartingInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorSlashEvents does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5601,53 +6111,45 @@ func (x *fastReflection_DelegatorStartingInfo) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DelegatorStartingInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorSlashEvents) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.distribution.v1.DelegatorStartingInfo.stakes":
-		if x.Stakes == nil {
-			x.Stakes = []*v1beta1.DecCoin{}
+	case "initia.distribution.v1.ValidatorSlashEvents.validator_slash_events":
+		if x.ValidatorSlashEvents == nil {
+			x.ValidatorSlashEvents = []*ValidatorSlashEvent{}
 		}
-		value := &_DelegatorStartingInfo_2_list{list: &x.Stakes}
+		value := &_ValidatorSlashEvents_1_list{list: &x.ValidatorSlashEvents}
 		return protoreflect.ValueOfList(value)
-	case "initia.distribution.v1.DelegatorStartingInfo.previous_period":
-		panic(fmt.Errorf("field previous_period of message initia.distribution.v1.DelegatorStartingInfo is not mutable"))
-	case "initia.distribution.v1.DelegatorStartingInfo.height":
-		panic(fmt.Errorf("field height of message initia.distribution.v1.DelegatorStartingInfo is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DelegatorStartingInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorSlashEvents"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.DelegatorStartingInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorSlashEvents does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DelegatorStartingInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorSlashEvents) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.distribution.v1.DelegatorStartingInfo.previous_period":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "initia.distribution.v1.DelegatorStartingInfo.stakes":
-		list := []*v1beta1.DecCoin{}
-		return protoreflect.ValueOfList(&_DelegatorStartingInfo_2_list{list: &list})
-	case "initia.distribution.v1.DelegatorStartingInfo.height":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.distribution.v1.ValidatorSlashEvents.validator_slash_events":
+		list := []*ValidatorSlashEvent{}
+		return protoreflect.ValueOfList(&_ValidatorSlashEvents_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DelegatorStartingInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorSlashEvents"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.DelegatorStartingInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorSlashEvents does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DelegatorStartingInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorSlashEvents) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.DelegatorStartingInfo", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.ValidatorSlashEvents", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5655,7 +6157,7 @@ func (x *fastReflection_DelegatorStartingInfo) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DelegatorStartingInfo) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorSlashEvents) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5666,7 +6168,7 @@ func (x *fastReflection_DelegatorStartingInfo) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DelegatorStartingInfo) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorSlashEvents) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5678,7 +6180,7 @@ func (x *fastReflection_DelegatorStartingInfo) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DelegatorStartingInfo) IsValid() bool {
+func (x *fastReflection_ValidatorSlashEvents) IsValid() bool {
 	return x != nil
 }
 
@@ -5688,9 +6190,9 @@ func (x *fastReflection_DelegatorStartingInfo) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DelegatorStartingInfo) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorSlashEvents) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DelegatorStartingInfo)
+		x := input.Message.Interface().(*ValidatorSlashEvents)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5702,18 +6204,12 @@ func (x *fastReflection_DelegatorStartingInfo) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		if x.PreviousPeriod != 0 {
-			n += 1 + runtime.Sov(uint64(x.PreviousPeriod))
-		}
-		if len(x.Stakes) > 0 {
-			for _, e := range x.Stakes {
+		if len(x.ValidatorSlashEvents) > 0 {
+			for _, e := range x.ValidatorSlashEvents {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.Height != 0 {
-			n += 1 + runtime.Sov(uint64(x.Height))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5724,7 +6220,7 @@ func (x *fastReflection_DelegatorStartingInfo) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DelegatorStartingInfo)
+		x := input.Message.Interface().(*ValidatorSlashEvents)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5743,14 +6239,9 @@ func (x *fastReflection_DelegatorStartingInfo) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Height != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.Stakes) > 0 {
-			for iNdEx := len(x.Stakes) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Stakes[iNdEx])
+		if len(x.ValidatorSlashEvents) > 0 {
+			for iNdEx := len(x.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ValidatorSlashEvents[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5761,14 +6252,9 @@ func (x *fastReflection_DelegatorStartingInfo) ProtoMethods() *protoiface.Method
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x12
+				dAtA[i] = 0xa
 			}
 		}
-		if x.PreviousPeriod != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PreviousPeriod))
-			i--
-			dAtA[i] = 0x8
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -5780,7 +6266,7 @@ func (x *fastReflection_DelegatorStartingInfo) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DelegatorStartingInfo)
+		x := input.Message.Interface().(*ValidatorSlashEvents)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5812,34 +6298,15 @@ func (x *fastReflection_DelegatorStartingInfo) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegatorStartingInfo: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSlashEvents: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegatorStartingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSlashEvents: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousPeriod", wireType)
-				}
-				x.PreviousPeriod = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.PreviousPeriod |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stakes", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5866,30 +6333,11 @@ func (x *fastReflection_DelegatorStartingInfo) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Stakes = append(x.Stakes, &v1beta1.DecCoin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Stakes[len(x.Stakes)-1]); err != nil {
+				x.ValidatorSlashEvents = append(x.ValidatorSlashEvents, &ValidatorSlashEvent{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorSlashEvents[len(x.ValidatorSlashEvents)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-				}
-				x.Height = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Height |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5925,79 +6373,81 @@ func (x *fastReflection_DelegatorStartingInfo) ProtoMethods() *protoiface.Method
 	}
 }
 
-var _ protoreflect.List = (*_DelegationDelegatorReward_2_list)(nil)
+var _ protoreflect.List = (*_DelegatorStartingInfo_2_list)(nil)
 
-type _DelegationDelegatorReward_2_list struct {
-	list *[]*DecPool
+type _DelegatorStartingInfo_2_list struct {
+	list *[]*v1beta1.DecCoin
 }
 
-func (x *_DelegationDelegatorReward_2_list) Len() int {
+func (x *_DelegatorStartingInfo_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_DelegationDelegatorReward_2_list) Get(i int) protoreflect.Value {
+func (x *_DelegatorStartingInfo_2_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_DelegationDelegatorReward_2_list) Set(i int, value protoreflect.Value) {
+func (x *_DelegatorStartingInfo_2_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DecPool)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_DelegationDelegatorReward_2_list) Append(value protoreflect.Value) {
+func (x *_DelegatorStartingInfo_2_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DecPool)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_DelegationDelegatorReward_2_list) AppendMutable() protoreflect.Value {
-	v := new(DecPool)
+func (x *_DelegatorStartingInfo_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_DelegationDelegatorReward_2_list) Truncate(n int) {
+func (x *_DelegatorStartingInfo_2_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_DelegationDelegatorReward_2_list) NewElement() protoreflect.Value {
-	v := new(DecPool)
+func (x *_DelegatorStartingInfo_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_DelegationDelegatorReward_2_list) IsValid() bool {
+func (x *_DelegatorStartingInfo_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_DelegationDelegatorReward                   protoreflect.MessageDescriptor
-	fd_DelegationDelegatorReward_validator_address protoreflect.FieldDescriptor
-	fd_DelegationDelegatorReward_reward            protoreflect.FieldDescriptor
+	md_DelegatorStartingInfo                 protoreflect.MessageDescriptor
+	fd_DelegatorStartingInfo_previous_period protoreflect.FieldDescriptor
+	fd_DelegatorStartingInfo_stakes          protoreflect.FieldDescriptor
+	fd_DelegatorStartingInfo_height          protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_initia_distribution_v1_distribution_proto_init()
-	md_DelegationDelegatorReward = File_initia_distribution_v1_distribution_proto.Messages().ByName("DelegationDelegatorReward")
-	fd_DelegationDelegatorReward_validator_address = md_DelegationDelegatorReward.Fields().ByName("validator_address")
-	fd_DelegationDelegatorReward_reward = md_DelegationDelegatorReward.Fields().ByName("reward")
+	md_DelegatorStartingInfo = File_initia_distribution_v1_distribution_proto.Messages().ByName("DelegatorStartingInfo")
+	fd_DelegatorStartingInfo_previous_period = md_DelegatorStartingInfo.Fields().ByName("previous_period")
+	fd_DelegatorStartingInfo_stakes = md_DelegatorStartingInfo.Fields().ByName("stakes")
+	fd_DelegatorStartingInfo_height = md_DelegatorStartingInfo.Fields().ByName("height")
 }
 
-var _ protoreflect.Message = (*fastReflection_DelegationDelegatorReward)(nil)
+var _ protoreflect.Message = (*fastReflection_DelegatorStartingInfo)(nil)
 
-type fastReflection_DelegationDelegatorReward DelegationDelegatorReward
+type fastReflection_DelegatorStartingInfo DelegatorStartingInfo
 
-func (x *DelegationDelegatorReward) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DelegationDelegatorReward)(x)
+func (x *DelegatorStartingInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DelegatorStartingInfo)(x)
 }
 
-func (x *DelegationDelegatorReward) slowProtoReflect() protoreflect.Message {
+func (x *DelegatorStartingInfo) slowProtoReflect() protoreflect.Message {
 	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6009,43 +6459,43 @@ func (x *DelegationDelegatorReward) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DelegationDelegatorReward_messageType fastReflection_DelegationDelegatorReward_messageType
-var _ protoreflect.MessageType = fastReflection_DelegationDelegatorReward_messageType{}
+var _fastReflection_DelegatorStartingInfo_messageType fastReflection_DelegatorStartingInfo_messageType
+var _ protoreflect.MessageType = fastReflection_DelegatorStartingInfo_messageType{}
 
-type fastReflection_DelegationDelegatorReward_messageType struct{}
+type fastReflection_DelegatorStartingInfo_messageType struct{}
 
-func (x fastReflection_DelegationDelegatorReward_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DelegationDelegatorReward)(nil)
+func (x fastReflection_DelegatorStartingInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DelegatorStartingInfo)(nil)
 }
-func (x fastReflection_DelegationDelegatorReward_messageType) New() protoreflect.Message {
-	return new(fastReflection_DelegationDelegatorReward)
+func (x fastReflection_DelegatorStartingInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_DelegatorStartingInfo)
 }
-func (x fastReflection_DelegationDelegatorReward_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DelegationDelegatorReward
+func (x fastReflection_DelegatorStartingInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DelegatorStartingInfo
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DelegationDelegatorReward) Descriptor() protoreflect.MessageDescriptor {
-	return md_DelegationDelegatorReward
+func (x *fastReflection_DelegatorStartingInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_DelegatorStartingInfo
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DelegationDelegatorReward) Type() protoreflect.MessageType {
-	return _fastReflection_DelegationDelegatorReward_messageType
+func (x *fastReflection_DelegatorStartingInfo) Type() protoreflect.MessageType {
+	return _fastReflection_DelegatorStartingInfo_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DelegationDelegatorReward) New() protoreflect.Message {
-	return new(fastReflection_DelegationDelegatorReward)
+func (x *fastReflection_DelegatorStartingInfo) New() protoreflect.Message {
+	return new(fastReflection_DelegatorStartingInfo)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DelegationDelegatorReward) Interface() protoreflect.ProtoMessage {
-	return (*DelegationDelegatorReward)(x)
+func (x *fastReflection_DelegatorStartingInfo) Interface() protoreflect.ProtoMessage {
+	return (*DelegatorStartingInfo)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6053,16 +6503,22 @@ func (x *fastReflection_DelegationDelegatorReward) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DelegationDelegatorReward) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ValidatorAddress != "" {
-		value := protoreflect.ValueOfString(x.ValidatorAddress)
-		if !f(fd_DelegationDelegatorReward_validator_address, value) {
+func (x *fastReflection_DelegatorStartingInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PreviousPeriod != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PreviousPeriod)
+		if !f(fd_DelegatorStartingInfo_previous_period, value) {
 			return
 		}
 	}
-	if len(x.Reward) != 0 {
-		value := protoreflect.ValueOfList(&_DelegationDelegatorReward_2_list{list: &x.Reward})
-		if !f(fd_DelegationDelegatorReward_reward, value) {
+	if len(x.Stakes) != 0 {
+		value := protoreflect.ValueOfList(&_DelegatorStartingInfo_2_list{list: &x.Stakes})
+		if !f(fd_DelegatorStartingInfo_stakes, value) {
+			return
+		}
+	}
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_DelegatorStartingInfo_height, value) {
 			return
 		}
 	}
@@ -6079,17 +6535,19 @@ func (x *fastReflection_DelegationDelegatorReward) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DelegationDelegatorReward) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DelegatorStartingInfo) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "initia.distribution.v1.DelegationDelegatorReward.validator_address":
-		return x.ValidatorAddress != ""
-	case "initia.distribution.v1.DelegationDelegatorReward.reward":
-		return len(x.Reward) != 0
+	case "initia.distribution.v1.DelegatorStartingInfo.previous_period":
+		return x.PreviousPeriod != uint64(0)
+	case "initia.distribution.v1.DelegatorStartingInfo.stakes":
+		return len(x.Stakes) != 0
+	case "initia.distribution.v1.DelegatorStartingInfo.height":
+		return x.Height != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DelegationDelegatorReward"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DelegatorStartingInfo"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.DelegationDelegatorReward does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.DelegatorStartingInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6099,17 +6557,19 @@ func (x *fastReflection_DelegationDelegatorReward) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DelegationDelegatorReward) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DelegatorStartingInfo) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "initia.distribution.v1.DelegationDelegatorReward.validator_address":
-		x.ValidatorAddress = ""
-	case "initia.distribution.v1.DelegationDelegatorReward.reward":
-		x.Reward = nil
+	case "initia.distribution.v1.DelegatorStartingInfo.previous_period":
+		x.PreviousPeriod = uint64(0)
+	case "initia.distribution.v1.DelegatorStartingInfo.stakes":
+		x.Stakes = nil
+	case "initia.distribution.v1.DelegatorStartingInfo.height":
+		x.Height = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DelegationDelegatorReward"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DelegatorStartingInfo"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.DelegationDelegatorReward does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.DelegatorStartingInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6119,22 +6579,25 @@ func (x *fastReflection_DelegationDelegatorReward) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DelegationDelegatorReward) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DelegatorStartingInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "initia.distribution.v1.DelegationDelegatorReward.validator_address":
-		value := x.ValidatorAddress
-		return protoreflect.ValueOfString(value)
-	case "initia.distribution.v1.DelegationDelegatorReward.reward":
-		if len(x.Reward) == 0 {
-			return protoreflect.ValueOfList(&_DelegationDelegatorReward_2_list{})
+	case "initia.distribution.v1.DelegatorStartingInfo.previous_period":
+		value := x.PreviousPeriod
+		return protoreflect.ValueOfUint64(value)
+	case "initia.distribution.v1.DelegatorStartingInfo.stakes":
+		if len(x.Stakes) == 0 {
+			return protoreflect.ValueOfList(&_DelegatorStartingInfo_2_list{})
 		}
-		listValue := &_DelegationDelegatorReward_2_list{list: &x.Reward}
+		listValue := &_DelegatorStartingInfo_2_list{list: &x.Stakes}
 		return protoreflect.ValueOfList(listValue)
+	case "initia.distribution.v1.DelegatorStartingInfo.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DelegationDelegatorReward"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DelegatorStartingInfo"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.DelegationDelegatorReward does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.DelegatorStartingInfo does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6148,19 +6611,21 @@ func (x *fastReflection_DelegationDelegatorReward) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DelegationDelegatorReward) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DelegatorStartingInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "initia.distribution.v1.DelegationDelegatorReward.validator_address":
-		x.ValidatorAddress = value.Interface().(string)
-	case "initia.distribution.v1.DelegationDelegatorReward.reward":
+	case "initia.distribution.v1.DelegatorStartingInfo.previous_period":
+		x.PreviousPeriod = value.Uint()
+	case "initia.distribution.v1.DelegatorStartingInfo.stakes":
 		lv := value.List()
-		clv := lv.(*_DelegationDelegatorReward_2_list)
-		x.Reward = *clv.list
+		clv := lv.(*_DelegatorStartingInfo_2_list)
+		x.Stakes = *clv.list
+	case "initia.distribution.v1.DelegatorStartingInfo.height":
+		x.Height = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DelegationDelegatorReward"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DelegatorStartingInfo"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.DelegationDelegatorReward does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.DelegatorStartingInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6174,49 +6639,53 @@ func (x *fastReflection_DelegationDelegatorReward) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DelegationDelegatorReward) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DelegatorStartingInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.distribution.v1.DelegationDelegatorReward.reward":
-		if x.Reward == nil {
-			x.Reward = []*DecPool{}
+	case "initia.distribution.v1.DelegatorStartingInfo.stakes":
+		if x.Stakes == nil {
+			x.Stakes = []*v1beta1.DecCoin{}
 		}
-		value := &_DelegationDelegatorReward_2_list{list: &x.Reward}
+		value := &_DelegatorStartingInfo_2_list{list: &x.Stakes}
 		return protoreflect.ValueOfList(value)
-	case "initia.distribution.v1.DelegationDelegatorReward.validator_address":
-		panic(fmt.Errorf("field validator_address of message initia.distribution.v1.DelegationDelegatorReward is not mutable"))
+	case "initia.distribution.v1.DelegatorStartingInfo.previous_period":
+		panic(fmt.Errorf("field previous_period of message initia.distribution.v1.DelegatorStartingInfo is not mutable"))
+	case "initia.distribution.v1.DelegatorStartingInfo.height":
+		panic(fmt.Errorf("field height of message initia.distribution.v1.DelegatorStartingInfo is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DelegationDelegatorReward"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DelegatorStartingInfo"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.DelegationDelegatorReward does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.DelegatorStartingInfo does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DelegationDelegatorReward) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DelegatorStartingInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.distribution.v1.DelegationDelegatorReward.validator_address":
-		return protoreflect.ValueOfString("")
-	case "initia.distribution.v1.DelegationDelegatorReward.reward":
-		list := []*DecPool{}
-		return protoreflect.ValueOfList(&_DelegationDelegatorReward_2_list{list: &list})
+	case "initia.distribution.v1.DelegatorStartingInfo.previous_period":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.distribution.v1.DelegatorStartingInfo.stakes":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_DelegatorStartingInfo_2_list{list: &list})
+	case "initia.distribution.v1.DelegatorStartingInfo.height":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DelegationDelegatorReward"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DelegatorStartingInfo"))
 		}
-		panic(fmt.Errorf("message initia.distribution.v1.DelegationDelegatorReward does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.distribution.v1.DelegatorStartingInfo does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DelegationDelegatorReward) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DelegatorStartingInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.DelegationDelegatorReward", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.DelegatorStartingInfo", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6224,7 +6693,7 @@ func (x *fastReflection_DelegationDelegatorReward) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DelegationDelegatorReward) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DelegatorStartingInfo) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6235,7 +6704,7 @@ func (x *fastReflection_DelegationDelegatorReward) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DelegationDelegatorReward) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DelegatorStartingInfo) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6247,7 +6716,7 @@ func (x *fastReflection_DelegationDelegatorReward) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DelegationDelegatorReward) IsValid() bool {
+func (x *fastReflection_DelegatorStartingInfo) IsValid() bool {
 	return x != nil
 }
 
@@ -6257,9 +6726,9 @@ func (x *fastReflection_DelegationDelegatorReward) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DelegationDelegatorReward) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DelegatorStartingInfo) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DelegationDelegatorReward)
+		x := input.Message.Interface().(*DelegatorStartingInfo)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6271,16 +6740,18 @@ func (x *fastReflection_DelegationDelegatorReward) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		l = len(x.ValidatorAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.PreviousPeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.PreviousPeriod))
 		}
-		if len(x.Reward) > 0 {
-			for _, e := range x.Reward {
+		if len(x.Stakes) > 0 {
+			for _, e := range x.Stakes {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6291,7 +6762,7 @@ func (x *fastReflection_DelegationDelegatorReward) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DelegationDelegatorReward)
+		x := input.Message.Interface().(*DelegatorStartingInfo)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6310,9 +6781,14 @@ func (x *fastReflection_DelegationDelegatorReward) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Reward) > 0 {
-			for iNdEx := len(x.Reward) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Reward[iNdEx])
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Stakes) > 0 {
+			for iNdEx := len(x.Stakes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Stakes[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6326,12 +6802,10 @@ func (x *fastReflection_DelegationDelegatorReward) ProtoMethods() *protoiface.Me
 				dAtA[i] = 0x12
 			}
 		}
-		if len(x.ValidatorAddress) > 0 {
-			i -= len(x.ValidatorAddress)
-			copy(dAtA[i:], x.ValidatorAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+		if x.PreviousPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PreviousPeriod))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6344,7 +6818,7 @@ func (x *fastReflection_DelegationDelegatorReward) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DelegationDelegatorReward)
+		x := input.Message.Interface().(*DelegatorStartingInfo)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6376,17 +6850,17 @@ func (x *fastReflection_DelegationDelegatorReward) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegationDelegatorReward: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegatorStartingInfo: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegationDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegatorStartingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousPeriod", wireType)
 				}
-				var stringLen uint64
+				x.PreviousPeriod = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6396,27 +6870,14 @@ func (x *fastReflection_DelegationDelegatorReward) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.PreviousPeriod |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stakes", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6443,11 +6904,30 @@ func (x *fastReflection_DelegationDelegatorReward) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Reward = append(x.Reward, &DecPool{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Reward[len(x.Reward)-1]); err != nil {
+				x.Stakes = append(x.Stakes, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Stakes[len(x.Stakes)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6483,561 +6963,4592 @@ func (x *fastReflection_DelegationDelegatorReward) ProtoMethods() *protoiface.Me
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: initia/distribution/v1/distribution.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// Params defines the set of params for the distribution module.
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+var _ protoreflect.List = (*_DelegationDelegatorReward_2_list)(nil)
 
-	CommunityTax        string          `protobuf:"bytes,1,opt,name=community_tax,json=communityTax,proto3" json:"community_tax,omitempty"`
-	WithdrawAddrEnabled bool            `protobuf:"varint,2,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
-	RewardWeights       []*RewardWeight `protobuf:"bytes,3,rep,name=reward_weights,json=rewardWeights,proto3" json:"reward_weights,omitempty"`
+type _DelegationDelegatorReward_2_list struct {
+	list *[]*DecPool
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *_DelegationDelegatorReward_2_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_DelegationDelegatorReward_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (*Params) ProtoMessage() {}
+func (x *_DelegationDelegatorReward_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DecPool)
+	(*x.list)[i] = concreteValue
+}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{0}
+func (x *_DelegationDelegatorReward_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DecPool)
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *Params) GetCommunityTax() string {
-	if x != nil {
-		return x.CommunityTax
-	}
-	return ""
+func (x *_DelegationDelegatorReward_2_list) AppendMutable() protoreflect.Value {
+	v := new(DecPool)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *Params) GetWithdrawAddrEnabled() bool {
-	if x != nil {
+func (x *_DelegationDelegatorReward_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DelegationDelegatorReward_2_list) NewElement() protoreflect.Value {
+	v := new(DecPool)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DelegationDelegatorReward_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DelegationDelegatorReward                   protoreflect.MessageDescriptor
+	fd_DelegationDelegatorReward_validator_address protoreflect.FieldDescriptor
+	fd_DelegationDelegatorReward_reward            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_distribution_v1_distribution_proto_init()
+	md_DelegationDelegatorReward = File_initia_distribution_v1_distribution_proto.Messages().ByName("DelegationDelegatorReward")
+	fd_DelegationDelegatorReward_validator_address = md_DelegationDelegatorReward.Fields().ByName("validator_address")
+	fd_DelegationDelegatorReward_reward = md_DelegationDelegatorReward.Fields().ByName("reward")
+}
+
+var _ protoreflect.Message = (*fastReflection_DelegationDelegatorReward)(nil)
+
+type fastReflection_DelegationDelegatorReward DelegationDelegatorReward
+
+func (x *DelegationDelegatorReward) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DelegationDelegatorReward)(x)
+}
+
+func (x *DelegationDelegatorReward) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DelegationDelegatorReward_messageType fastReflection_DelegationDelegatorReward_messageType
+var _ protoreflect.MessageType = fastReflection_DelegationDelegatorReward_messageType{}
+
+type fastReflection_DelegationDelegatorReward_messageType struct{}
+
+func (x fastReflection_DelegationDelegatorReward_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DelegationDelegatorReward)(nil)
+}
+func (x fastReflection_DelegationDelegatorReward_messageType) New() protoreflect.Message {
+	return new(fastReflection_DelegationDelegatorReward)
+}
+func (x fastReflection_DelegationDelegatorReward_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DelegationDelegatorReward
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DelegationDelegatorReward) Descriptor() protoreflect.MessageDescriptor {
+	return md_DelegationDelegatorReward
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DelegationDelegatorReward) Type() protoreflect.MessageType {
+	return _fastReflection_DelegationDelegatorReward_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DelegationDelegatorReward) New() protoreflect.Message {
+	return new(fastReflection_DelegationDelegatorReward)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DelegationDelegatorReward) Interface() protoreflect.ProtoMessage {
+	return (*DelegationDelegatorReward)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DelegationDelegatorReward) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_DelegationDelegatorReward_validator_address, value) {
+			return
+		}
+	}
+	if len(x.Reward) != 0 {
+		value := protoreflect.ValueOfList(&_DelegationDelegatorReward_2_list{list: &x.Reward})
+		if !f(fd_DelegationDelegatorReward_reward, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DelegationDelegatorReward) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.distribution.v1.DelegationDelegatorReward.validator_address":
+		return x.ValidatorAddress != ""
+	case "initia.distribution.v1.DelegationDelegatorReward.reward":
+		return len(x.Reward) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DelegationDelegatorReward"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.DelegationDelegatorReward does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegationDelegatorReward) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.distribution.v1.DelegationDelegatorReward.validator_address":
+		x.ValidatorAddress = ""
+	case "initia.distribution.v1.DelegationDelegatorReward.reward":
+		x.Reward = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DelegationDelegatorReward"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.DelegationDelegatorReward does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DelegationDelegatorReward) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.distribution.v1.DelegationDelegatorReward.validator_address":
+		value := x.ValidatorAddress
+		return protoreflect.ValueOfString(value)
+	case "initia.distribution.v1.DelegationDelegatorReward.reward":
+		if len(x.Reward) == 0 {
+			return protoreflect.ValueOfList(&_DelegationDelegatorReward_2_list{})
+		}
+		listValue := &_DelegationDelegatorReward_2_list{list: &x.Reward}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DelegationDelegatorReward"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.DelegationDelegatorReward does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegationDelegatorReward) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.distribution.v1.DelegationDelegatorReward.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	case "initia.distribution.v1.DelegationDelegatorReward.reward":
+		lv := value.List()
+		clv := lv.(*_DelegationDelegatorReward_2_list)
+		x.Reward = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DelegationDelegatorReward"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.DelegationDelegatorReward does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegationDelegatorReward) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.distribution.v1.DelegationDelegatorReward.reward":
+		if x.Reward == nil {
+			x.Reward = []*DecPool{}
+		}
+		value := &_DelegationDelegatorReward_2_list{list: &x.Reward}
+		return protoreflect.ValueOfList(value)
+	case "initia.distribution.v1.DelegationDelegatorReward.validator_address":
+		panic(fmt.Errorf("field validator_address of message initia.distribution.v1.DelegationDelegatorReward is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DelegationDelegatorReward"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.DelegationDelegatorReward does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DelegationDelegatorReward) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.distribution.v1.DelegationDelegatorReward.validator_address":
+		return protoreflect.ValueOfString("")
+	case "initia.distribution.v1.DelegationDelegatorReward.reward":
+		list := []*DecPool{}
+		return protoreflect.ValueOfList(&_DelegationDelegatorReward_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DelegationDelegatorReward"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.DelegationDelegatorReward does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DelegationDelegatorReward) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.DelegationDelegatorReward", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DelegationDelegatorReward) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegationDelegatorReward) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DelegationDelegatorReward) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DelegationDelegatorReward) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DelegationDelegatorReward)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ValidatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Reward) > 0 {
+			for _, e := range x.Reward {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DelegationDelegatorReward)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reward) > 0 {
+			for iNdEx := len(x.Reward) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Reward[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DelegationDelegatorReward)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegationDelegatorReward: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegationDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reward = append(x.Reward, &DecPool{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Reward[len(x.Reward)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Gauge_4_list)(nil)
+
+type _Gauge_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Gauge_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Gauge_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Gauge_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Gauge_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Gauge_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Gauge_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Gauge_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Gauge_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Gauge_5_list)(nil)
+
+type _Gauge_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Gauge_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Gauge_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Gauge_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Gauge_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Gauge_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Gauge_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Gauge_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Gauge_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Gauge                protoreflect.MessageDescriptor
+	fd_Gauge_id             protoreflect.FieldDescriptor
+	fd_Gauge_depositor      protoreflect.FieldDescriptor
+	fd_Gauge_denom          protoreflect.FieldDescriptor
+	fd_Gauge_amount         protoreflect.FieldDescriptor
+	fd_Gauge_distributed    protoreflect.FieldDescriptor
+	fd_Gauge_start_time     protoreflect.FieldDescriptor
+	fd_Gauge_num_epochs     protoreflect.FieldDescriptor
+	fd_Gauge_epoch_duration protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_distribution_v1_distribution_proto_init()
+	md_Gauge = File_initia_distribution_v1_distribution_proto.Messages().ByName("Gauge")
+	fd_Gauge_id = md_Gauge.Fields().ByName("id")
+	fd_Gauge_depositor = md_Gauge.Fields().ByName("depositor")
+	fd_Gauge_denom = md_Gauge.Fields().ByName("denom")
+	fd_Gauge_amount = md_Gauge.Fields().ByName("amount")
+	fd_Gauge_distributed = md_Gauge.Fields().ByName("distributed")
+	fd_Gauge_start_time = md_Gauge.Fields().ByName("start_time")
+	fd_Gauge_num_epochs = md_Gauge.Fields().ByName("num_epochs")
+	fd_Gauge_epoch_duration = md_Gauge.Fields().ByName("epoch_duration")
+}
+
+var _ protoreflect.Message = (*fastReflection_Gauge)(nil)
+
+type fastReflection_Gauge Gauge
+
+func (x *Gauge) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Gauge)(x)
+}
+
+func (x *Gauge) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Gauge_messageType fastReflection_Gauge_messageType
+var _ protoreflect.MessageType = fastReflection_Gauge_messageType{}
+
+type fastReflection_Gauge_messageType struct{}
+
+func (x fastReflection_Gauge_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Gauge)(nil)
+}
+func (x fastReflection_Gauge_messageType) New() protoreflect.Message {
+	return new(fastReflection_Gauge)
+}
+func (x fastReflection_Gauge_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Gauge
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Gauge) Descriptor() protoreflect.MessageDescriptor {
+	return md_Gauge
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Gauge) Type() protoreflect.MessageType {
+	return _fastReflection_Gauge_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Gauge) New() protoreflect.Message {
+	return new(fastReflection_Gauge)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Gauge) Interface() protoreflect.ProtoMessage {
+	return (*Gauge)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Gauge) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Gauge_id, value) {
+			return
+		}
+	}
+	if x.Depositor != "" {
+		value := protoreflect.ValueOfString(x.Depositor)
+		if !f(fd_Gauge_depositor, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_Gauge_denom, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_Gauge_4_list{list: &x.Amount})
+		if !f(fd_Gauge_amount, value) {
+			return
+		}
+	}
+	if len(x.Distributed) != 0 {
+		value := protoreflect.ValueOfList(&_Gauge_5_list{list: &x.Distributed})
+		if !f(fd_Gauge_distributed, value) {
+			return
+		}
+	}
+	if x.StartTime != nil {
+		value := protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+		if !f(fd_Gauge_start_time, value) {
+			return
+		}
+	}
+	if x.NumEpochs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumEpochs)
+		if !f(fd_Gauge_num_epochs, value) {
+			return
+		}
+	}
+	if x.EpochDuration != nil {
+		value := protoreflect.ValueOfMessage(x.EpochDuration.ProtoReflect())
+		if !f(fd_Gauge_epoch_duration, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Gauge) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.distribution.v1.Gauge.id":
+		return x.Id != uint64(0)
+	case "initia.distribution.v1.Gauge.depositor":
+		return x.Depositor != ""
+	case "initia.distribution.v1.Gauge.denom":
+		return x.Denom != ""
+	case "initia.distribution.v1.Gauge.amount":
+		return len(x.Amount) != 0
+	case "initia.distribution.v1.Gauge.distributed":
+		return len(x.Distributed) != 0
+	case "initia.distribution.v1.Gauge.start_time":
+		return x.StartTime != nil
+	case "initia.distribution.v1.Gauge.num_epochs":
+		return x.NumEpochs != uint64(0)
+	case "initia.distribution.v1.Gauge.epoch_duration":
+		return x.EpochDuration != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Gauge"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.Gauge does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Gauge) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.distribution.v1.Gauge.id":
+		x.Id = uint64(0)
+	case "initia.distribution.v1.Gauge.depositor":
+		x.Depositor = ""
+	case "initia.distribution.v1.Gauge.denom":
+		x.Denom = ""
+	case "initia.distribution.v1.Gauge.amount":
+		x.Amount = nil
+	case "initia.distribution.v1.Gauge.distributed":
+		x.Distributed = nil
+	case "initia.distribution.v1.Gauge.start_time":
+		x.StartTime = nil
+	case "initia.distribution.v1.Gauge.num_epochs":
+		x.NumEpochs = uint64(0)
+	case "initia.distribution.v1.Gauge.epoch_duration":
+		x.EpochDuration = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Gauge"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.Gauge does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Gauge) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.distribution.v1.Gauge.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "initia.distribution.v1.Gauge.depositor":
+		value := x.Depositor
+		return protoreflect.ValueOfString(value)
+	case "initia.distribution.v1.Gauge.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "initia.distribution.v1.Gauge.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_Gauge_4_list{})
+		}
+		listValue := &_Gauge_4_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.distribution.v1.Gauge.distributed":
+		if len(x.Distributed) == 0 {
+			return protoreflect.ValueOfList(&_Gauge_5_list{})
+		}
+		listValue := &_Gauge_5_list{list: &x.Distributed}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.distribution.v1.Gauge.start_time":
+		value := x.StartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.distribution.v1.Gauge.num_epochs":
+		value := x.NumEpochs
+		return protoreflect.ValueOfUint64(value)
+	case "initia.distribution.v1.Gauge.epoch_duration":
+		value := x.EpochDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Gauge"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.Gauge does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Gauge) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.distribution.v1.Gauge.id":
+		x.Id = value.Uint()
+	case "initia.distribution.v1.Gauge.depositor":
+		x.Depositor = value.Interface().(string)
+	case "initia.distribution.v1.Gauge.denom":
+		x.Denom = value.Interface().(string)
+	case "initia.distribution.v1.Gauge.amount":
+		lv := value.List()
+		clv := lv.(*_Gauge_4_list)
+		x.Amount = *clv.list
+	case "initia.distribution.v1.Gauge.distributed":
+		lv := value.List()
+		clv := lv.(*_Gauge_5_list)
+		x.Distributed = *clv.list
+	case "initia.distribution.v1.Gauge.start_time":
+		x.StartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "initia.distribution.v1.Gauge.num_epochs":
+		x.NumEpochs = value.Uint()
+	case "initia.distribution.v1.Gauge.epoch_duration":
+		x.EpochDuration = value.Message().Interface().(*durationpb.Duration)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Gauge"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.Gauge does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Gauge) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.distribution.v1.Gauge.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_Gauge_4_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "initia.distribution.v1.Gauge.distributed":
+		if x.Distributed == nil {
+			x.Distributed = []*v1beta1.Coin{}
+		}
+		value := &_Gauge_5_list{list: &x.Distributed}
+		return protoreflect.ValueOfList(value)
+	case "initia.distribution.v1.Gauge.start_time":
+		if x.StartTime == nil {
+			x.StartTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+	case "initia.distribution.v1.Gauge.epoch_duration":
+		if x.EpochDuration == nil {
+			x.EpochDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.EpochDuration.ProtoReflect())
+	case "initia.distribution.v1.Gauge.id":
+		panic(fmt.Errorf("field id of message initia.distribution.v1.Gauge is not mutable"))
+	case "initia.distribution.v1.Gauge.depositor":
+		panic(fmt.Errorf("field depositor of message initia.distribution.v1.Gauge is not mutable"))
+	case "initia.distribution.v1.Gauge.denom":
+		panic(fmt.Errorf("field denom of message initia.distribution.v1.Gauge is not mutable"))
+	case "initia.distribution.v1.Gauge.num_epochs":
+		panic(fmt.Errorf("field num_epochs of message initia.distribution.v1.Gauge is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Gauge"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.Gauge does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Gauge) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.distribution.v1.Gauge.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.distribution.v1.Gauge.depositor":
+		return protoreflect.ValueOfString("")
+	case "initia.distribution.v1.Gauge.denom":
+		return protoreflect.ValueOfString("")
+	case "initia.distribution.v1.Gauge.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Gauge_4_list{list: &list})
+	case "initia.distribution.v1.Gauge.distributed":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Gauge_5_list{list: &list})
+	case "initia.distribution.v1.Gauge.start_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.distribution.v1.Gauge.num_epochs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.distribution.v1.Gauge.epoch_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Gauge"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.Gauge does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Gauge) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.Gauge", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Gauge) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Gauge) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Gauge) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Gauge) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Gauge)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Depositor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Distributed) > 0 {
+			for _, e := range x.Distributed {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.StartTime != nil {
+			l = options.Size(x.StartTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NumEpochs != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumEpochs))
+		}
+		if x.EpochDuration != nil {
+			l = options.Size(x.EpochDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Gauge)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.EpochDuration != nil {
+			encoded, err := options.Marshal(x.EpochDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.NumEpochs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumEpochs))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.StartTime != nil {
+			encoded, err := options.Marshal(x.StartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Distributed) > 0 {
+			for iNdEx := len(x.Distributed) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Distributed[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Depositor) > 0 {
+			i -= len(x.Depositor)
+			copy(dAtA[i:], x.Depositor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Depositor)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Gauge)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Depositor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Distributed = append(x.Distributed, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Distributed[len(x.Distributed)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StartTime == nil {
+					x.StartTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StartTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumEpochs", wireType)
+				}
+				x.NumEpochs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumEpochs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EpochDuration == nil {
+					x.EpochDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EpochDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_PoolRewardRate_2_list)(nil)
+
+type _PoolRewardRate_2_list struct {
+	list *[]*v1beta1.DecCoin
+}
+
+func (x *_PoolRewardRate_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PoolRewardRate_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PoolRewardRate_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PoolRewardRate_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PoolRewardRate_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolRewardRate_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PoolRewardRate_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolRewardRate_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PoolRewardRate                  protoreflect.MessageDescriptor
+	fd_PoolRewardRate_denom            protoreflect.FieldDescriptor
+	fd_PoolRewardRate_reward_per_token protoreflect.FieldDescriptor
+	fd_PoolRewardRate_apr              protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_distribution_v1_distribution_proto_init()
+	md_PoolRewardRate = File_initia_distribution_v1_distribution_proto.Messages().ByName("PoolRewardRate")
+	fd_PoolRewardRate_denom = md_PoolRewardRate.Fields().ByName("denom")
+	fd_PoolRewardRate_reward_per_token = md_PoolRewardRate.Fields().ByName("reward_per_token")
+	fd_PoolRewardRate_apr = md_PoolRewardRate.Fields().ByName("apr")
+}
+
+var _ protoreflect.Message = (*fastReflection_PoolRewardRate)(nil)
+
+type fastReflection_PoolRewardRate PoolRewardRate
+
+func (x *PoolRewardRate) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PoolRewardRate)(x)
+}
+
+func (x *PoolRewardRate) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PoolRewardRate_messageType fastReflection_PoolRewardRate_messageType
+var _ protoreflect.MessageType = fastReflection_PoolRewardRate_messageType{}
+
+type fastReflection_PoolRewardRate_messageType struct{}
+
+func (x fastReflection_PoolRewardRate_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PoolRewardRate)(nil)
+}
+func (x fastReflection_PoolRewardRate_messageType) New() protoreflect.Message {
+	return new(fastReflection_PoolRewardRate)
+}
+func (x fastReflection_PoolRewardRate_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PoolRewardRate
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PoolRewardRate) Descriptor() protoreflect.MessageDescriptor {
+	return md_PoolRewardRate
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PoolRewardRate) Type() protoreflect.MessageType {
+	return _fastReflection_PoolRewardRate_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PoolRewardRate) New() protoreflect.Message {
+	return new(fastReflection_PoolRewardRate)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PoolRewardRate) Interface() protoreflect.ProtoMessage {
+	return (*PoolRewardRate)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PoolRewardRate) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_PoolRewardRate_denom, value) {
+			return
+		}
+	}
+	if len(x.RewardPerToken) != 0 {
+		value := protoreflect.ValueOfList(&_PoolRewardRate_2_list{list: &x.RewardPerToken})
+		if !f(fd_PoolRewardRate_reward_per_token, value) {
+			return
+		}
+	}
+	if x.Apr != "" {
+		value := protoreflect.ValueOfString(x.Apr)
+		if !f(fd_PoolRewardRate_apr, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PoolRewardRate) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.distribution.v1.PoolRewardRate.denom":
+		return x.Denom != ""
+	case "initia.distribution.v1.PoolRewardRate.reward_per_token":
+		return len(x.RewardPerToken) != 0
+	case "initia.distribution.v1.PoolRewardRate.apr":
+		return x.Apr != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.PoolRewardRate"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.PoolRewardRate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolRewardRate) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.distribution.v1.PoolRewardRate.denom":
+		x.Denom = ""
+	case "initia.distribution.v1.PoolRewardRate.reward_per_token":
+		x.RewardPerToken = nil
+	case "initia.distribution.v1.PoolRewardRate.apr":
+		x.Apr = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.PoolRewardRate"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.PoolRewardRate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PoolRewardRate) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.distribution.v1.PoolRewardRate.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "initia.distribution.v1.PoolRewardRate.reward_per_token":
+		if len(x.RewardPerToken) == 0 {
+			return protoreflect.ValueOfList(&_PoolRewardRate_2_list{})
+		}
+		listValue := &_PoolRewardRate_2_list{list: &x.RewardPerToken}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.distribution.v1.PoolRewardRate.apr":
+		value := x.Apr
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.PoolRewardRate"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.PoolRewardRate does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolRewardRate) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.distribution.v1.PoolRewardRate.denom":
+		x.Denom = value.Interface().(string)
+	case "initia.distribution.v1.PoolRewardRate.reward_per_token":
+		lv := value.List()
+		clv := lv.(*_PoolRewardRate_2_list)
+		x.RewardPerToken = *clv.list
+	case "initia.distribution.v1.PoolRewardRate.apr":
+		x.Apr = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.PoolRewardRate"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.PoolRewardRate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolRewardRate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.distribution.v1.PoolRewardRate.reward_per_token":
+		if x.RewardPerToken == nil {
+			x.RewardPerToken = []*v1beta1.DecCoin{}
+		}
+		value := &_PoolRewardRate_2_list{list: &x.RewardPerToken}
+		return protoreflect.ValueOfList(value)
+	case "initia.distribution.v1.PoolRewardRate.denom":
+		panic(fmt.Errorf("field denom of message initia.distribution.v1.PoolRewardRate is not mutable"))
+	case "initia.distribution.v1.PoolRewardRate.apr":
+		panic(fmt.Errorf("field apr of message initia.distribution.v1.PoolRewardRate is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.PoolRewardRate"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.PoolRewardRate does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PoolRewardRate) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.distribution.v1.PoolRewardRate.denom":
+		return protoreflect.ValueOfString("")
+	case "initia.distribution.v1.PoolRewardRate.reward_per_token":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_PoolRewardRate_2_list{list: &list})
+	case "initia.distribution.v1.PoolRewardRate.apr":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.PoolRewardRate"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.PoolRewardRate does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PoolRewardRate) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.PoolRewardRate", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PoolRewardRate) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolRewardRate) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PoolRewardRate) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PoolRewardRate) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PoolRewardRate)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RewardPerToken) > 0 {
+			for _, e := range x.RewardPerToken {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Apr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PoolRewardRate)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Apr) > 0 {
+			i -= len(x.Apr)
+			copy(dAtA[i:], x.Apr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Apr)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.RewardPerToken) > 0 {
+			for iNdEx := len(x.RewardPerToken) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RewardPerToken[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PoolRewardRate)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PoolRewardRate: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PoolRewardRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardPerToken", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardPerToken = append(x.RewardPerToken, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RewardPerToken[len(x.RewardPerToken)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Apr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ValidatorRewardRates_2_list)(nil)
+
+type _ValidatorRewardRates_2_list struct {
+	list *[]*PoolRewardRate
+}
+
+func (x *_ValidatorRewardRates_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ValidatorRewardRates_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ValidatorRewardRates_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PoolRewardRate)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ValidatorRewardRates_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PoolRewardRate)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ValidatorRewardRates_2_list) AppendMutable() protoreflect.Value {
+	v := new(PoolRewardRate)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorRewardRates_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ValidatorRewardRates_2_list) NewElement() protoreflect.Value {
+	v := new(PoolRewardRate)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorRewardRates_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ValidatorRewardRates                   protoreflect.MessageDescriptor
+	fd_ValidatorRewardRates_validator_address protoreflect.FieldDescriptor
+	fd_ValidatorRewardRates_pools             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_distribution_v1_distribution_proto_init()
+	md_ValidatorRewardRates = File_initia_distribution_v1_distribution_proto.Messages().ByName("ValidatorRewardRates")
+	fd_ValidatorRewardRates_validator_address = md_ValidatorRewardRates.Fields().ByName("validator_address")
+	fd_ValidatorRewardRates_pools = md_ValidatorRewardRates.Fields().ByName("pools")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorRewardRates)(nil)
+
+type fastReflection_ValidatorRewardRates ValidatorRewardRates
+
+func (x *ValidatorRewardRates) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorRewardRates)(x)
+}
+
+func (x *ValidatorRewardRates) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorRewardRates_messageType fastReflection_ValidatorRewardRates_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorRewardRates_messageType{}
+
+type fastReflection_ValidatorRewardRates_messageType struct{}
+
+func (x fastReflection_ValidatorRewardRates_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorRewardRates)(nil)
+}
+func (x fastReflection_ValidatorRewardRates_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorRewardRates)
+}
+func (x fastReflection_ValidatorRewardRates_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorRewardRates
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorRewardRates) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorRewardRates
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorRewardRates) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorRewardRates_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorRewardRates) New() protoreflect.Message {
+	return new(fastReflection_ValidatorRewardRates)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorRewardRates) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorRewardRates)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorRewardRates) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_ValidatorRewardRates_validator_address, value) {
+			return
+		}
+	}
+	if len(x.Pools) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorRewardRates_2_list{list: &x.Pools})
+		if !f(fd_ValidatorRewardRates_pools, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorRewardRates) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.distribution.v1.ValidatorRewardRates.validator_address":
+		return x.ValidatorAddress != ""
+	case "initia.distribution.v1.ValidatorRewardRates.pools":
+		return len(x.Pools) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorRewardRates"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorRewardRates does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorRewardRates) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.distribution.v1.ValidatorRewardRates.validator_address":
+		x.ValidatorAddress = ""
+	case "initia.distribution.v1.ValidatorRewardRates.pools":
+		x.Pools = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorRewardRates"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorRewardRates does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorRewardRates) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.distribution.v1.ValidatorRewardRates.validator_address":
+		value := x.ValidatorAddress
+		return protoreflect.ValueOfString(value)
+	case "initia.distribution.v1.ValidatorRewardRates.pools":
+		if len(x.Pools) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorRewardRates_2_list{})
+		}
+		listValue := &_ValidatorRewardRates_2_list{list: &x.Pools}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorRewardRates"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorRewardRates does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorRewardRates) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.distribution.v1.ValidatorRewardRates.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	case "initia.distribution.v1.ValidatorRewardRates.pools":
+		lv := value.List()
+		clv := lv.(*_ValidatorRewardRates_2_list)
+		x.Pools = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorRewardRates"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorRewardRates does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorRewardRates) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.distribution.v1.ValidatorRewardRates.pools":
+		if x.Pools == nil {
+			x.Pools = []*PoolRewardRate{}
+		}
+		value := &_ValidatorRewardRates_2_list{list: &x.Pools}
+		return protoreflect.ValueOfList(value)
+	case "initia.distribution.v1.ValidatorRewardRates.validator_address":
+		panic(fmt.Errorf("field validator_address of message initia.distribution.v1.ValidatorRewardRates is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorRewardRates"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorRewardRates does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorRewardRates) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.distribution.v1.ValidatorRewardRates.validator_address":
+		return protoreflect.ValueOfString("")
+	case "initia.distribution.v1.ValidatorRewardRates.pools":
+		list := []*PoolRewardRate{}
+		return protoreflect.ValueOfList(&_ValidatorRewardRates_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorRewardRates"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorRewardRates does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorRewardRates) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.ValidatorRewardRates", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorRewardRates) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorRewardRates) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorRewardRates) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorRewardRates) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorRewardRates)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ValidatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Pools) > 0 {
+			for _, e := range x.Pools {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorRewardRates)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Pools) > 0 {
+			for iNdEx := len(x.Pools) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Pools[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorRewardRates)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorRewardRates: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorRewardRates: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Pools = append(x.Pools, &PoolRewardRate{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pools[len(x.Pools)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PaymentStream            protoreflect.MessageDescriptor
+	fd_PaymentStream_id         protoreflect.FieldDescriptor
+	fd_PaymentStream_recipient  protoreflect.FieldDescriptor
+	fd_PaymentStream_denom      protoreflect.FieldDescriptor
+	fd_PaymentStream_total      protoreflect.FieldDescriptor
+	fd_PaymentStream_released   protoreflect.FieldDescriptor
+	fd_PaymentStream_claimed    protoreflect.FieldDescriptor
+	fd_PaymentStream_start_time protoreflect.FieldDescriptor
+	fd_PaymentStream_end_time   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_distribution_v1_distribution_proto_init()
+	md_PaymentStream = File_initia_distribution_v1_distribution_proto.Messages().ByName("PaymentStream")
+	fd_PaymentStream_id = md_PaymentStream.Fields().ByName("id")
+	fd_PaymentStream_recipient = md_PaymentStream.Fields().ByName("recipient")
+	fd_PaymentStream_denom = md_PaymentStream.Fields().ByName("denom")
+	fd_PaymentStream_total = md_PaymentStream.Fields().ByName("total")
+	fd_PaymentStream_released = md_PaymentStream.Fields().ByName("released")
+	fd_PaymentStream_claimed = md_PaymentStream.Fields().ByName("claimed")
+	fd_PaymentStream_start_time = md_PaymentStream.Fields().ByName("start_time")
+	fd_PaymentStream_end_time = md_PaymentStream.Fields().ByName("end_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_PaymentStream)(nil)
+
+type fastReflection_PaymentStream PaymentStream
+
+func (x *PaymentStream) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PaymentStream)(x)
+}
+
+func (x *PaymentStream) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PaymentStream_messageType fastReflection_PaymentStream_messageType
+var _ protoreflect.MessageType = fastReflection_PaymentStream_messageType{}
+
+type fastReflection_PaymentStream_messageType struct{}
+
+func (x fastReflection_PaymentStream_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PaymentStream)(nil)
+}
+func (x fastReflection_PaymentStream_messageType) New() protoreflect.Message {
+	return new(fastReflection_PaymentStream)
+}
+func (x fastReflection_PaymentStream_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PaymentStream
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PaymentStream) Descriptor() protoreflect.MessageDescriptor {
+	return md_PaymentStream
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PaymentStream) Type() protoreflect.MessageType {
+	return _fastReflection_PaymentStream_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PaymentStream) New() protoreflect.Message {
+	return new(fastReflection_PaymentStream)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PaymentStream) Interface() protoreflect.ProtoMessage {
+	return (*PaymentStream)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PaymentStream) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_PaymentStream_id, value) {
+			return
+		}
+	}
+	if x.Recipient != "" {
+		value := protoreflect.ValueOfString(x.Recipient)
+		if !f(fd_PaymentStream_recipient, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_PaymentStream_denom, value) {
+			return
+		}
+	}
+	if x.Total != "" {
+		value := protoreflect.ValueOfString(x.Total)
+		if !f(fd_PaymentStream_total, value) {
+			return
+		}
+	}
+	if x.Released != "" {
+		value := protoreflect.ValueOfString(x.Released)
+		if !f(fd_PaymentStream_released, value) {
+			return
+		}
+	}
+	if x.Claimed != "" {
+		value := protoreflect.ValueOfString(x.Claimed)
+		if !f(fd_PaymentStream_claimed, value) {
+			return
+		}
+	}
+	if x.StartTime != nil {
+		value := protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+		if !f(fd_PaymentStream_start_time, value) {
+			return
+		}
+	}
+	if x.EndTime != nil {
+		value := protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+		if !f(fd_PaymentStream_end_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PaymentStream) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.distribution.v1.PaymentStream.id":
+		return x.Id != uint64(0)
+	case "initia.distribution.v1.PaymentStream.recipient":
+		return x.Recipient != ""
+	case "initia.distribution.v1.PaymentStream.denom":
+		return x.Denom != ""
+	case "initia.distribution.v1.PaymentStream.total":
+		return x.Total != ""
+	case "initia.distribution.v1.PaymentStream.released":
+		return x.Released != ""
+	case "initia.distribution.v1.PaymentStream.claimed":
+		return x.Claimed != ""
+	case "initia.distribution.v1.PaymentStream.start_time":
+		return x.StartTime != nil
+	case "initia.distribution.v1.PaymentStream.end_time":
+		return x.EndTime != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.PaymentStream"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.PaymentStream does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PaymentStream) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.distribution.v1.PaymentStream.id":
+		x.Id = uint64(0)
+	case "initia.distribution.v1.PaymentStream.recipient":
+		x.Recipient = ""
+	case "initia.distribution.v1.PaymentStream.denom":
+		x.Denom = ""
+	case "initia.distribution.v1.PaymentStream.total":
+		x.Total = ""
+	case "initia.distribution.v1.PaymentStream.released":
+		x.Released = ""
+	case "initia.distribution.v1.PaymentStream.claimed":
+		x.Claimed = ""
+	case "initia.distribution.v1.PaymentStream.start_time":
+		x.StartTime = nil
+	case "initia.distribution.v1.PaymentStream.end_time":
+		x.EndTime = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.PaymentStream"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.PaymentStream does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PaymentStream) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.distribution.v1.PaymentStream.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "initia.distribution.v1.PaymentStream.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfString(value)
+	case "initia.distribution.v1.PaymentStream.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "initia.distribution.v1.PaymentStream.total":
+		value := x.Total
+		return protoreflect.ValueOfString(value)
+	case "initia.distribution.v1.PaymentStream.released":
+		value := x.Released
+		return protoreflect.ValueOfString(value)
+	case "initia.distribution.v1.PaymentStream.claimed":
+		value := x.Claimed
+		return protoreflect.ValueOfString(value)
+	case "initia.distribution.v1.PaymentStream.start_time":
+		value := x.StartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.distribution.v1.PaymentStream.end_time":
+		value := x.EndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.PaymentStream"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.PaymentStream does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PaymentStream) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.distribution.v1.PaymentStream.id":
+		x.Id = value.Uint()
+	case "initia.distribution.v1.PaymentStream.recipient":
+		x.Recipient = value.Interface().(string)
+	case "initia.distribution.v1.PaymentStream.denom":
+		x.Denom = value.Interface().(string)
+	case "initia.distribution.v1.PaymentStream.total":
+		x.Total = value.Interface().(string)
+	case "initia.distribution.v1.PaymentStream.released":
+		x.Released = value.Interface().(string)
+	case "initia.distribution.v1.PaymentStream.claimed":
+		x.Claimed = value.Interface().(string)
+	case "initia.distribution.v1.PaymentStream.start_time":
+		x.StartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "initia.distribution.v1.PaymentStream.end_time":
+		x.EndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.PaymentStream"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.PaymentStream does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PaymentStream) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.distribution.v1.PaymentStream.start_time":
+		if x.StartTime == nil {
+			x.StartTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+	case "initia.distribution.v1.PaymentStream.end_time":
+		if x.EndTime == nil {
+			x.EndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+	case "initia.distribution.v1.PaymentStream.id":
+		panic(fmt.Errorf("field id of message initia.distribution.v1.PaymentStream is not mutable"))
+	case "initia.distribution.v1.PaymentStream.recipient":
+		panic(fmt.Errorf("field recipient of message initia.distribution.v1.PaymentStream is not mutable"))
+	case "initia.distribution.v1.PaymentStream.denom":
+		panic(fmt.Errorf("field denom of message initia.distribution.v1.PaymentStream is not mutable"))
+	case "initia.distribution.v1.PaymentStream.total":
+		panic(fmt.Errorf("field total of message initia.distribution.v1.PaymentStream is not mutable"))
+	case "initia.distribution.v1.PaymentStream.released":
+		panic(fmt.Errorf("field released of message initia.distribution.v1.PaymentStream is not mutable"))
+	case "initia.distribution.v1.PaymentStream.claimed":
+		panic(fmt.Errorf("field claimed of message initia.distribution.v1.PaymentStream is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.PaymentStream"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.PaymentStream does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PaymentStream) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.distribution.v1.PaymentStream.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.distribution.v1.PaymentStream.recipient":
+		return protoreflect.ValueOfString("")
+	case "initia.distribution.v1.PaymentStream.denom":
+		return protoreflect.ValueOfString("")
+	case "initia.distribution.v1.PaymentStream.total":
+		return protoreflect.ValueOfString("")
+	case "initia.distribution.v1.PaymentStream.released":
+		return protoreflect.ValueOfString("")
+	case "initia.distribution.v1.PaymentStream.claimed":
+		return protoreflect.ValueOfString("")
+	case "initia.distribution.v1.PaymentStream.start_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.distribution.v1.PaymentStream.end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.PaymentStream"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.PaymentStream does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PaymentStream) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.PaymentStream", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PaymentStream) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PaymentStream) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PaymentStream) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PaymentStream) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PaymentStream)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Total)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Released)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Claimed)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartTime != nil {
+			l = options.Size(x.StartTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EndTime != nil {
+			l = options.Size(x.EndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PaymentStream)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.EndTime != nil {
+			encoded, err := options.Marshal(x.EndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.StartTime != nil {
+			encoded, err := options.Marshal(x.StartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Claimed) > 0 {
+			i -= len(x.Claimed)
+			copy(dAtA[i:], x.Claimed)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Claimed)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Released) > 0 {
+			i -= len(x.Released)
+			copy(dAtA[i:], x.Released)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Released)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Total) > 0 {
+			i -= len(x.Total)
+			copy(dAtA[i:], x.Total)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Total)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Recipient) > 0 {
+			i -= len(x.Recipient)
+			copy(dAtA[i:], x.Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PaymentStream)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PaymentStream: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PaymentStream: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Total = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Released = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Claimed = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StartTime == nil {
+					x.StartTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StartTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EndTime == nil {
+					x.EndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: initia/distribution/v1/distribution.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Params defines the set of params for the distribution module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityTax         string                `protobuf:"bytes,1,opt,name=community_tax,json=communityTax,proto3" json:"community_tax,omitempty"`
+	WithdrawAddrEnabled  bool                  `protobuf:"varint,2,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	RewardWeights        []*RewardWeight       `protobuf:"bytes,3,rep,name=reward_weights,json=rewardWeights,proto3" json:"reward_weights,omitempty"`
+	DynamicRewardWeights *DynamicRewardWeights `protobuf:"bytes,4,opt,name=dynamic_reward_weights,json=dynamicRewardWeights,proto3" json:"dynamic_reward_weights,omitempty"`
+	// gauge_creation_fee defines the fee charged to the depositor of an
+	// incentive gauge, which is sent to the community pool.
+	GaugeCreationFee []*v1beta1.Coin `protobuf:"bytes,5,rep,name=gauge_creation_fee,json=gaugeCreationFee,proto3" json:"gauge_creation_fee,omitempty"`
+	// max_active_gauges defines the maximum number of the incentive gauges
+	// which are not yet finished.
+	MaxActiveGauges uint64 `protobuf:"varint,6,opt,name=max_active_gauges,json=maxActiveGauges,proto3" json:"max_active_gauges,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetCommunityTax() string {
+	if x != nil {
+		return x.CommunityTax
+	}
+	return ""
+}
+
+func (x *Params) GetWithdrawAddrEnabled() bool {
+	if x != nil {
 		return x.WithdrawAddrEnabled
 	}
-	return false
+	return false
+}
+
+func (x *Params) GetRewardWeights() []*RewardWeight {
+	if x != nil {
+		return x.RewardWeights
+	}
+	return nil
+}
+
+func (x *Params) GetDynamicRewardWeights() *DynamicRewardWeights {
+	if x != nil {
+		return x.DynamicRewardWeights
+	}
+	return nil
+}
+
+func (x *Params) GetGaugeCreationFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.GaugeCreationFee
+	}
+	return nil
+}
+
+func (x *Params) GetMaxActiveGauges() uint64 {
+	if x != nil {
+		return x.MaxActiveGauges
+	}
+	return 0
+}
+
+// DynamicRewardWeights defines the mode to recompute the reward weights every
+// epoch from the locked base value of the reward pools. The target weight of
+// a denom is multiplier * locked base value, and the normalized weight of each
+// denom moves toward the target by at most max_change per epoch.
+type DynamicRewardWeights struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled       bool                 `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	EpochDuration *durationpb.Duration `protobuf:"bytes,2,opt,name=epoch_duration,json=epochDuration,proto3" json:"epoch_duration,omitempty"`
+	MaxChange     string               `protobuf:"bytes,3,opt,name=max_change,json=maxChange,proto3" json:"max_change,omitempty"`
+	// multipliers defines the multiplier of each denom; the denom without
+	// the multiplier uses one.
+	Multipliers []*RewardWeight `protobuf:"bytes,4,rep,name=multipliers,proto3" json:"multipliers,omitempty"`
+}
+
+func (x *DynamicRewardWeights) Reset() {
+	*x = DynamicRewardWeights{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DynamicRewardWeights) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicRewardWeights) ProtoMessage() {}
+
+// Deprecated: Use DynamicRewardWeights.ProtoReflect.Descriptor instead.
+func (*DynamicRewardWeights) Descriptor() ([]byte, []int) {
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DynamicRewardWeights) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *DynamicRewardWeights) GetEpochDuration() *durationpb.Duration {
+	if x != nil {
+		return x.EpochDuration
+	}
+	return nil
+}
+
+func (x *DynamicRewardWeights) GetMaxChange() string {
+	if x != nil {
+		return x.MaxChange
+	}
+	return ""
+}
+
+func (x *DynamicRewardWeights) GetMultipliers() []*RewardWeight {
+	if x != nil {
+		return x.Multipliers
+	}
+	return nil
+}
+
+// RewardWeight represents reward allocation ratio between
+// pools.
+type RewardWeight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *RewardWeight) Reset() {
+	*x = RewardWeight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardWeight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardWeight) ProtoMessage() {}
+
+// Deprecated: Use RewardWeight.ProtoReflect.Descriptor instead.
+func (*RewardWeight) Descriptor() ([]byte, []int) {
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RewardWeight) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *RewardWeight) GetWeight() string {
+	if x != nil {
+		return x.Weight
+	}
+	return ""
+}
+
+// Pool is a Coins wrapper with denom.
+type Pool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom string          `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Coins []*v1beta1.Coin `protobuf:"bytes,2,rep,name=coins,proto3" json:"coins,omitempty"`
+}
+
+func (x *Pool) Reset() {
+	*x = Pool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pool) ProtoMessage() {}
+
+// Deprecated: Use Pool.ProtoReflect.Descriptor instead.
+func (*Pool) Descriptor() ([]byte, []int) {
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Pool) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *Pool) GetCoins() []*v1beta1.Coin {
+	if x != nil {
+		return x.Coins
+	}
+	return nil
+}
+
+// DecPool is a DecCoins wrapper with denom.
+type DecPool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom    string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	DecCoins []*v1beta1.DecCoin `protobuf:"bytes,2,rep,name=dec_coins,json=decCoins,proto3" json:"dec_coins,omitempty"`
+}
+
+func (x *DecPool) Reset() {
+	*x = DecPool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecPool) ProtoMessage() {}
+
+// Deprecated: Use DecPool.ProtoReflect.Descriptor instead.
+func (*DecPool) Descriptor() ([]byte, []int) {
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DecPool) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *DecPool) GetDecCoins() []*v1beta1.DecCoin {
+	if x != nil {
+		return x.DecCoins
+	}
+	return nil
+}
+
+// ValidatorHistoricalRewards represents historical rewards for a validator.
+// Height is implicit within the store key.
+// Cumulative reward ratio is the sum from the zeroeth period
+// until this period of rewards / tokens, per the spec.
+// The reference count indicates the number of objects
+// which might need to reference this historical entry at any point.
+// ReferenceCount =
+//
+//	  number of outstanding delegations which ended the associated period (and
+//	  might need to read that record)
+//	+ number of slashes which ended the associated period (and might need to
+//	read that record)
+//	+ one per validator for the zeroeth period, set on initialization
+type ValidatorHistoricalRewards struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CumulativeRewardRatios []*DecPool `protobuf:"bytes,1,rep,name=cumulative_reward_ratios,json=cumulativeRewardRatios,proto3" json:"cumulative_reward_ratios,omitempty"`
+	ReferenceCount         uint32     `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty"`
+	// time is the block time when the period ended; it is used to compute
+	// the realized reward rates between periods.
+	Time *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *ValidatorHistoricalRewards) Reset() {
+	*x = ValidatorHistoricalRewards{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorHistoricalRewards) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorHistoricalRewards) ProtoMessage() {}
+
+// Deprecated: Use ValidatorHistoricalRewards.ProtoReflect.Descriptor instead.
+func (*ValidatorHistoricalRewards) Descriptor() ([]byte, []int) {
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ValidatorHistoricalRewards) GetCumulativeRewardRatios() []*DecPool {
+	if x != nil {
+		return x.CumulativeRewardRatios
+	}
+	return nil
+}
+
+func (x *ValidatorHistoricalRewards) GetReferenceCount() uint32 {
+	if x != nil {
+		return x.ReferenceCount
+	}
+	return 0
+}
+
+func (x *ValidatorHistoricalRewards) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// ValidatorCurrentRewards represents current rewards and current
+// period for a validator kept as a running counter and incremented
+// each block as long as the validator's tokens remain constant.
+type ValidatorCurrentRewards struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rewards []*DecPool `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Period  uint64     `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+}
+
+func (x *ValidatorCurrentRewards) Reset() {
+	*x = ValidatorCurrentRewards{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *Params) GetRewardWeights() []*RewardWeight {
+func (x *ValidatorCurrentRewards) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorCurrentRewards) ProtoMessage() {}
+
+// Deprecated: Use ValidatorCurrentRewards.ProtoReflect.Descriptor instead.
+func (*ValidatorCurrentRewards) Descriptor() ([]byte, []int) {
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ValidatorCurrentRewards) GetRewards() []*DecPool {
 	if x != nil {
-		return x.RewardWeights
+		return x.Rewards
 	}
 	return nil
 }
 
-// RewardWeight represents reward allocation ratio between
-// pools.
-type RewardWeight struct {
+func (x *ValidatorCurrentRewards) GetPeriod() uint64 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+// ValidatorAccumulatedCommission represents accumulated commission
+// for a validator kept as a running counter, can be withdrawn at any time.
+type ValidatorAccumulatedCommission struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	Commissions []*DecPool `protobuf:"bytes,1,rep,name=commissions,proto3" json:"commissions,omitempty"`
 }
 
-func (x *RewardWeight) Reset() {
-	*x = RewardWeight{}
+func (x *ValidatorAccumulatedCommission) Reset() {
+	*x = ValidatorAccumulatedCommission{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[1]
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RewardWeight) String() string {
+func (x *ValidatorAccumulatedCommission) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RewardWeight) ProtoMessage() {}
+func (*ValidatorAccumulatedCommission) ProtoMessage() {}
 
-// Deprecated: Use RewardWeight.ProtoReflect.Descriptor instead.
-func (*RewardWeight) Descriptor() ([]byte, []int) {
-	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ValidatorAccumulatedCommission.ProtoReflect.Descriptor instead.
+func (*ValidatorAccumulatedCommission) Descriptor() ([]byte, []int) {
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *RewardWeight) GetDenom() string {
+func (x *ValidatorAccumulatedCommission) GetCommissions() []*DecPool {
 	if x != nil {
-		return x.Denom
+		return x.Commissions
 	}
-	return ""
+	return nil
 }
 
-func (x *RewardWeight) GetWeight() string {
+// ValidatorOutstandingRewards represents outstanding (un-withdrawn) rewards
+// for a validator inexpensive to track, allows simple sanity checks.
+type ValidatorOutstandingRewards struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rewards []*DecPool `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+}
+
+func (x *ValidatorOutstandingRewards) Reset() {
+	*x = ValidatorOutstandingRewards{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorOutstandingRewards) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorOutstandingRewards) ProtoMessage() {}
+
+// Deprecated: Use ValidatorOutstandingRewards.ProtoReflect.Descriptor instead.
+func (*ValidatorOutstandingRewards) Descriptor() ([]byte, []int) {
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ValidatorOutstandingRewards) GetRewards() []*DecPool {
 	if x != nil {
-		return x.Weight
+		return x.Rewards
 	}
-	return ""
+	return nil
 }
 
-// Pool is a Coins wrapper with denom.
-type Pool struct {
+// ValidatorSlashEvent represents a validator slash event.
+// Height is implicit within the store key.
+// This is needed to calculate appropriate amount of staking tokens
+// for delegations which are withdrawn after a slash has occurred.
+type ValidatorSlashEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Denom string          `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Coins []*v1beta1.Coin `protobuf:"bytes,2,rep,name=coins,proto3" json:"coins,omitempty"`
+	ValidatorPeriod uint64             `protobuf:"varint,1,opt,name=validator_period,json=validatorPeriod,proto3" json:"validator_period,omitempty"`
+	Fractions       []*v1beta1.DecCoin `protobuf:"bytes,2,rep,name=fractions,proto3" json:"fractions,omitempty"`
 }
 
-func (x *Pool) Reset() {
-	*x = Pool{}
+func (x *ValidatorSlashEvent) Reset() {
+	*x = ValidatorSlashEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[2]
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Pool) String() string {
+func (x *ValidatorSlashEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pool) ProtoMessage() {}
+func (*ValidatorSlashEvent) ProtoMessage() {}
 
-// Deprecated: Use Pool.ProtoReflect.Descriptor instead.
-func (*Pool) Descriptor() ([]byte, []int) {
-	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ValidatorSlashEvent.ProtoReflect.Descriptor instead.
+func (*ValidatorSlashEvent) Descriptor() ([]byte, []int) {
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Pool) GetDenom() string {
+func (x *ValidatorSlashEvent) GetValidatorPeriod() uint64 {
 	if x != nil {
-		return x.Denom
+		return x.ValidatorPeriod
 	}
-	return ""
+	return 0
 }
 
-func (x *Pool) GetCoins() []*v1beta1.Coin {
+func (x *ValidatorSlashEvent) GetFractions() []*v1beta1.DecCoin {
 	if x != nil {
-		return x.Coins
+		return x.Fractions
 	}
 	return nil
 }
 
-// DecPool is a DecCoins wrapper with denom.
-type DecPool struct {
+// ValidatorSlashEvents is a collection of ValidatorSlashEvent messages.
+type ValidatorSlashEvents struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Denom    string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	DecCoins []*v1beta1.DecCoin `protobuf:"bytes,2,rep,name=dec_coins,json=decCoins,proto3" json:"dec_coins,omitempty"`
+	ValidatorSlashEvents []*ValidatorSlashEvent `protobuf:"bytes,1,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events,omitempty"`
 }
 
-func (x *DecPool) Reset() {
-	*x = DecPool{}
+func (x *ValidatorSlashEvents) Reset() {
+	*x = ValidatorSlashEvents{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[3]
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DecPool) String() string {
+func (x *ValidatorSlashEvents) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecPool) ProtoMessage() {}
-
-// Deprecated: Use DecPool.ProtoReflect.Descriptor instead.
-func (*DecPool) Descriptor() ([]byte, []int) {
-	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{3}
-}
+func (*ValidatorSlashEvents) ProtoMessage() {}
 
-func (x *DecPool) GetDenom() string {
-	if x != nil {
-		return x.Denom
-	}
-	return ""
+// Deprecated: Use ValidatorSlashEvents.ProtoReflect.Descriptor instead.
+func (*ValidatorSlashEvents) Descriptor() ([]byte, []int) {
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DecPool) GetDecCoins() []*v1beta1.DecCoin {
+func (x *ValidatorSlashEvents) GetValidatorSlashEvents() []*ValidatorSlashEvent {
 	if x != nil {
-		return x.DecCoins
+		return x.ValidatorSlashEvents
 	}
 	return nil
 }
 
-// ValidatorHistoricalRewards represents historical rewards for a validator.
-// Height is implicit within the store key.
-// Cumulative reward ratio is the sum from the zeroeth period
-// until this period of rewards / tokens, per the spec.
-// The reference count indicates the number of objects
-// which might need to reference this historical entry at any point.
-// ReferenceCount =
-//
-//	  number of outstanding delegations which ended the associated period (and
-//	  might need to read that record)
-//	+ number of slashes which ended the associated period (and might need to
-//	read that record)
-//	+ one per validator for the zeroeth period, set on initialization
-type ValidatorHistoricalRewards struct {
+// DelegatorStartingInfo represents the starting info for a delegator reward
+// period. It tracks the previous validator period, the delegation's amount of
+// staking token, and the creation height (to check later on if any slashes have
+// occurred). NOTE: Even though validators are slashed to whole staking tokens,
+// the delegators within the validator may be left with less than a full token,
+// thus sdk.Dec is used.
+type DelegatorStartingInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CumulativeRewardRatios []*DecPool `protobuf:"bytes,1,rep,name=cumulative_reward_ratios,json=cumulativeRewardRatios,proto3" json:"cumulative_reward_ratios,omitempty"`
-	ReferenceCount         uint32     `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty"`
+	PreviousPeriod uint64             `protobuf:"varint,1,opt,name=previous_period,json=previousPeriod,proto3" json:"previous_period,omitempty"`
+	Stakes         []*v1beta1.DecCoin `protobuf:"bytes,2,rep,name=stakes,proto3" json:"stakes,omitempty"`
+	Height         uint64             `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (x *ValidatorHistoricalRewards) Reset() {
-	*x = ValidatorHistoricalRewards{}
+func (x *DelegatorStartingInfo) Reset() {
+	*x = DelegatorStartingInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[4]
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValidatorHistoricalRewards) String() string {
+func (x *DelegatorStartingInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorHistoricalRewards) ProtoMessage() {}
+func (*DelegatorStartingInfo) ProtoMessage() {}
 
-// Deprecated: Use ValidatorHistoricalRewards.ProtoReflect.Descriptor instead.
-func (*ValidatorHistoricalRewards) Descriptor() ([]byte, []int) {
-	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use DelegatorStartingInfo.ProtoReflect.Descriptor instead.
+func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ValidatorHistoricalRewards) GetCumulativeRewardRatios() []*DecPool {
+func (x *DelegatorStartingInfo) GetPreviousPeriod() uint64 {
 	if x != nil {
-		return x.CumulativeRewardRatios
+		return x.PreviousPeriod
+	}
+	return 0
+}
+
+func (x *DelegatorStartingInfo) GetStakes() []*v1beta1.DecCoin {
+	if x != nil {
+		return x.Stakes
 	}
 	return nil
 }
 
-func (x *ValidatorHistoricalRewards) GetReferenceCount() uint32 {
+func (x *DelegatorStartingInfo) GetHeight() uint64 {
 	if x != nil {
-		return x.ReferenceCount
+		return x.Height
 	}
 	return 0
 }
 
-// ValidatorCurrentRewards represents current rewards and current
-// period for a validator kept as a running counter and incremented
-// each block as long as the validator's tokens remain constant.
-type ValidatorCurrentRewards struct {
+// DelegationDelegatorReward represents the properties
+// of a delegator's delegation reward.
+type DelegationDelegatorReward struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rewards []*DecPool `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
-	Period  uint64     `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	ValidatorAddress string     `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Reward           []*DecPool `protobuf:"bytes,2,rep,name=reward,proto3" json:"reward,omitempty"`
 }
 
-func (x *ValidatorCurrentRewards) Reset() {
-	*x = ValidatorCurrentRewards{}
+func (x *DelegationDelegatorReward) Reset() {
+	*x = DelegationDelegatorReward{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[5]
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValidatorCurrentRewards) String() string {
+func (x *DelegationDelegatorReward) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorCurrentRewards) ProtoMessage() {}
+func (*DelegationDelegatorReward) ProtoMessage() {}
 
-// Deprecated: Use ValidatorCurrentRewards.ProtoReflect.Descriptor instead.
-func (*ValidatorCurrentRewards) Descriptor() ([]byte, []int) {
-	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use DelegationDelegatorReward.ProtoReflect.Descriptor instead.
+func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ValidatorCurrentRewards) GetRewards() []*DecPool {
+func (x *DelegationDelegatorReward) GetValidatorAddress() string {
 	if x != nil {
-		return x.Rewards
+		return x.ValidatorAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *ValidatorCurrentRewards) GetPeriod() uint64 {
+func (x *DelegationDelegatorReward) GetReward() []*DecPool {
 	if x != nil {
-		return x.Period
+		return x.Reward
 	}
-	return 0
+	return nil
 }
 
-// ValidatorAccumulatedCommission represents accumulated commission
-// for a validator kept as a running counter, can be withdrawn at any time.
-type ValidatorAccumulatedCommission struct {
+// Gauge defines an external incentive which linearly releases the deposited
+// coins to the reward pool of a bond denom over the given number of epochs.
+type Gauge struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Commissions []*DecPool `protobuf:"bytes,1,rep,name=commissions,proto3" json:"commissions,omitempty"`
-}
-
-func (x *ValidatorAccumulatedCommission) Reset() {
-	*x = ValidatorAccumulatedCommission{}
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// denom is the bond denom of the reward pool to incentivize.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the total coins deposited to the gauge.
+	Amount []*v1beta1.Coin `protobuf:"bytes,4,rep,name=amount,proto3" json:"amount,omitempty"`
+	// distributed is the coins already released to the reward pool.
+	Distributed   []*v1beta1.Coin        `protobuf:"bytes,5,rep,name=distributed,proto3" json:"distributed,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	NumEpochs     uint64                 `protobuf:"varint,7,opt,name=num_epochs,json=numEpochs,proto3" json:"num_epochs,omitempty"`
+	EpochDuration *durationpb.Duration   `protobuf:"bytes,8,opt,name=epoch_duration,json=epochDuration,proto3" json:"epoch_duration,omitempty"`
+}
+
+func (x *Gauge) Reset() {
+	*x = Gauge{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[6]
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValidatorAccumulatedCommission) String() string {
+func (x *Gauge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorAccumulatedCommission) ProtoMessage() {}
+func (*Gauge) ProtoMessage() {}
 
-// Deprecated: Use ValidatorAccumulatedCommission.ProtoReflect.Descriptor instead.
-func (*ValidatorAccumulatedCommission) Descriptor() ([]byte, []int) {
-	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use Gauge.ProtoReflect.Descriptor instead.
+func (*Gauge) Descriptor() ([]byte, []int) {
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ValidatorAccumulatedCommission) GetCommissions() []*DecPool {
+func (x *Gauge) GetId() uint64 {
 	if x != nil {
-		return x.Commissions
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-// ValidatorOutstandingRewards represents outstanding (un-withdrawn) rewards
-// for a validator inexpensive to track, allows simple sanity checks.
-type ValidatorOutstandingRewards struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Gauge) GetDepositor() string {
+	if x != nil {
+		return x.Depositor
+	}
+	return ""
+}
 
-	Rewards []*DecPool `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+func (x *Gauge) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
 }
 
-func (x *ValidatorOutstandingRewards) Reset() {
-	*x = ValidatorOutstandingRewards{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Gauge) GetAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.Amount
 	}
+	return nil
 }
 
-func (x *ValidatorOutstandingRewards) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Gauge) GetDistributed() []*v1beta1.Coin {
+	if x != nil {
+		return x.Distributed
+	}
+	return nil
 }
 
-func (*ValidatorOutstandingRewards) ProtoMessage() {}
+func (x *Gauge) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
 
-// Deprecated: Use ValidatorOutstandingRewards.ProtoReflect.Descriptor instead.
-func (*ValidatorOutstandingRewards) Descriptor() ([]byte, []int) {
-	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{7}
+func (x *Gauge) GetNumEpochs() uint64 {
+	if x != nil {
+		return x.NumEpochs
+	}
+	return 0
 }
 
-func (x *ValidatorOutstandingRewards) GetRewards() []*DecPool {
+func (x *Gauge) GetEpochDuration() *durationpb.Duration {
 	if x != nil {
-		return x.Rewards
+		return x.EpochDuration
 	}
 	return nil
 }
 
-// ValidatorSlashEvent represents a validator slash event.
-// Height is implicit within the store key.
-// This is needed to calculate appropriate amount of staking tokens
-// for delegations which are withdrawn after a slash has occurred.
-type ValidatorSlashEvent struct {
+// PoolRewardRate represents the annual reward rate of a reward pool.
+type PoolRewardRate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ValidatorPeriod uint64             `protobuf:"varint,1,opt,name=validator_period,json=validatorPeriod,proto3" json:"validator_period,omitempty"`
-	Fractions       []*v1beta1.DecCoin `protobuf:"bytes,2,rep,name=fractions,proto3" json:"fractions,omitempty"`
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// reward_per_token is the annual rewards per staked token of the pool.
+	RewardPerToken []*v1beta1.DecCoin `protobuf:"bytes,2,rep,name=reward_per_token,json=rewardPerToken,proto3" json:"reward_per_token,omitempty"`
+	// apr is the annual rewards in the reward denom divided by the locked
+	// base value of a staked token.
+	Apr string `protobuf:"bytes,3,opt,name=apr,proto3" json:"apr,omitempty"`
 }
 
-func (x *ValidatorSlashEvent) Reset() {
-	*x = ValidatorSlashEvent{}
+func (x *PoolRewardRate) Reset() {
+	*x = PoolRewardRate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[8]
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValidatorSlashEvent) String() string {
+func (x *PoolRewardRate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorSlashEvent) ProtoMessage() {}
+func (*PoolRewardRate) ProtoMessage() {}
 
-// Deprecated: Use ValidatorSlashEvent.ProtoReflect.Descriptor instead.
-func (*ValidatorSlashEvent) Descriptor() ([]byte, []int) {
-	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use PoolRewardRate.ProtoReflect.Descriptor instead.
+func (*PoolRewardRate) Descriptor() ([]byte, []int) {
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ValidatorSlashEvent) GetValidatorPeriod() uint64 {
+func (x *PoolRewardRate) GetDenom() string {
 	if x != nil {
-		return x.ValidatorPeriod
+		return x.Denom
 	}
-	return 0
+	return ""
 }
 
-func (x *ValidatorSlashEvent) GetFractions() []*v1beta1.DecCoin {
+func (x *PoolRewardRate) GetRewardPerToken() []*v1beta1.DecCoin {
 	if x != nil {
-		return x.Fractions
+		return x.RewardPerToken
 	}
 	return nil
 }
 
-// ValidatorSlashEvents is a collection of ValidatorSlashEvent messages.
-type ValidatorSlashEvents struct {
+func (x *PoolRewardRate) GetApr() string {
+	if x != nil {
+		return x.Apr
+	}
+	return ""
+}
+
+// ValidatorRewardRates represents the annual reward rates of the delegations
+// to a validator, after the commission.
+type ValidatorRewardRates struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ValidatorSlashEvents []*ValidatorSlashEvent `protobuf:"bytes,1,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events,omitempty"`
+	ValidatorAddress string            `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Pools            []*PoolRewardRate `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools,omitempty"`
 }
 
-func (x *ValidatorSlashEvents) Reset() {
-	*x = ValidatorSlashEvents{}
+func (x *ValidatorRewardRates) Reset() {
+	*x = ValidatorRewardRates{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[9]
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValidatorSlashEvents) String() string {
+func (x *ValidatorRewardRates) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorSlashEvents) ProtoMessage() {}
+func (*ValidatorRewardRates) ProtoMessage() {}
 
-// Deprecated: Use ValidatorSlashEvents.ProtoReflect.Descriptor instead.
-func (*ValidatorSlashEvents) Descriptor() ([]byte, []int) {
-	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ValidatorRewardRates.ProtoReflect.Descriptor instead.
+func (*ValidatorRewardRates) Descriptor() ([]byte, []int) {
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ValidatorSlashEvents) GetValidatorSlashEvents() []*ValidatorSlashEvent {
+func (x *ValidatorRewardRates) GetValidatorAddress() string {
 	if x != nil {
-		return x.ValidatorSlashEvents
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+func (x *ValidatorRewardRates) GetPools() []*PoolRewardRate {
+	if x != nil {
+		return x.Pools
 	}
 	return nil
 }
 
-// DelegatorStartingInfo represents the starting info for a delegator reward
-// period. It tracks the previous validator period, the delegation's amount of
-// staking token, and the creation height (to check later on if any slashes have
-// occurred). NOTE: Even though validators are slashed to whole staking tokens,
-// the delegators within the validator may be left with less than a full token,
-// thus sdk.Dec is used.
-type DelegatorStartingInfo struct {
+// PaymentStream defines a streaming payment from the community pool, which
+// linearly releases the total amount to the recipient from the start time
+// to the end time.
+type PaymentStream struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PreviousPeriod uint64             `protobuf:"varint,1,opt,name=previous_period,json=previousPeriod,proto3" json:"previous_period,omitempty"`
-	Stakes         []*v1beta1.DecCoin `protobuf:"bytes,2,rep,name=stakes,proto3" json:"stakes,omitempty"`
-	Height         uint64             `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
-}
-
-func (x *DelegatorStartingInfo) Reset() {
-	*x = DelegatorStartingInfo{}
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Denom     string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// total is the total amount to be paid by the stream.
+	Total string `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"`
+	// released is the amount already paid out from the community pool to the
+	// stream, which is claimable by the recipient.
+	Released string `protobuf:"bytes,5,opt,name=released,proto3" json:"released,omitempty"`
+	// claimed is the amount already claimed by the recipient.
+	Claimed   string                 `protobuf:"bytes,6,opt,name=claimed,proto3" json:"claimed,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *PaymentStream) Reset() {
+	*x = PaymentStream{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[10]
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DelegatorStartingInfo) String() string {
+func (x *PaymentStream) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelegatorStartingInfo) ProtoMessage() {}
+func (*PaymentStream) ProtoMessage() {}
 
-// Deprecated: Use DelegatorStartingInfo.ProtoReflect.Descriptor instead.
-func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use PaymentStream.ProtoReflect.Descriptor instead.
+func (*PaymentStream) Descriptor() ([]byte, []int) {
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *DelegatorStartingInfo) GetPreviousPeriod() uint64 {
+func (x *PaymentStream) GetId() uint64 {
 	if x != nil {
-		return x.PreviousPeriod
+		return x.Id
 	}
 	return 0
 }
 
-func (x *DelegatorStartingInfo) GetStakes() []*v1beta1.DecCoin {
+func (x *PaymentStream) GetRecipient() string {
 	if x != nil {
-		return x.Stakes
+		return x.Recipient
 	}
-	return nil
+	return ""
 }
 
-func (x *DelegatorStartingInfo) GetHeight() uint64 {
+func (x *PaymentStream) GetDenom() string {
 	if x != nil {
-		return x.Height
+		return x.Denom
 	}
-	return 0
-}
-
-// DelegationDelegatorReward represents the properties
-// of a delegator's delegation reward.
-type DelegationDelegatorReward struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ValidatorAddress string     `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	Reward           []*DecPool `protobuf:"bytes,2,rep,name=reward,proto3" json:"reward,omitempty"`
+	return ""
 }
 
-func (x *DelegationDelegatorReward) Reset() {
-	*x = DelegationDelegatorReward{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *PaymentStream) GetTotal() string {
+	if x != nil {
+		return x.Total
 	}
+	return ""
 }
 
-func (x *DelegationDelegatorReward) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PaymentStream) GetReleased() string {
+	if x != nil {
+		return x.Released
+	}
+	return ""
 }
 
-func (*DelegationDelegatorReward) ProtoMessage() {}
-
-// Deprecated: Use DelegationDelegatorReward.ProtoReflect.Descriptor instead.
-func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{11}
+func (x *PaymentStream) GetClaimed() string {
+	if x != nil {
+		return x.Claimed
+	}
+	return ""
 }
 
-func (x *DelegationDelegatorReward) GetValidatorAddress() string {
+func (x *PaymentStream) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ValidatorAddress
+		return x.StartTime
 	}
-	return ""
+	return nil
 }
 
-func (x *DelegationDelegatorReward) GetReward() []*DecPool {
+func (x *PaymentStream) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Reward
+		return x.EndTime
 	}
 	return nil
 }
@@ -7052,172 +11563,320 @@ var file_initia_distribution_v1_distribution_proto_rawDesc = []byte{
 	0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62,
 	0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xce, 0x02, 0x0a,
-	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x60, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x75,
-	0x6e, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x61, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3b,
-	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
-	0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6d,
-	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x61, 0x78, 0x22, 0x52, 0x0c, 0x63, 0x6f, 0x6d,
-	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x54, 0x61, 0x78, 0x12, 0x54, 0x0a, 0x15, 0x77, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x20, 0xf2, 0xde, 0x1f, 0x1c, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x52, 0x13, 0x77, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
-	0x6a, 0x0a, 0x0e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x2e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x1d, 0xc8,
-	0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22, 0x52, 0x0d, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x3a, 0x20, 0x98, 0xa0, 0x1f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc2, 0x05, 0x0a, 0x06, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x60, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
+	0x5f, 0x74, 0x61, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3b, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2,
+	0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x5f, 0x74, 0x61, 0x78, 0x22, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x54, 0x61, 0x78, 0x12, 0x54, 0x0a, 0x15, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x20, 0xf2, 0xde, 0x1f, 0x1c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x52, 0x13, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x41, 0x64, 0x64, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x6a, 0x0a, 0x0e, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xf2,
+	0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22, 0x52, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x89, 0x01, 0x0a, 0x16, 0x64, 0x79, 0x6e, 0x61,
+	0x6d, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x42, 0x25, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x1d,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22, 0x52, 0x14, 0x64,
+	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x12, 0x9b, 0x01, 0x0a, 0x12, 0x67, 0x61, 0x75, 0x67, 0x65, 0x5f, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x52, 0xc8, 0xde, 0x1f,
+	0x00, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x67, 0x61, 0x75, 0x67, 0x65,
+	0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x22, 0xaa, 0xdf,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x10, 0x67, 0x61, 0x75, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65,
+	0x65, 0x12, 0x48, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x67, 0x61, 0x75, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xf2, 0xde,
+	0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x67, 0x61, 0x75, 0x67, 0x65, 0x73, 0x22, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x47, 0x61, 0x75, 0x67, 0x65, 0x73, 0x3a, 0x20, 0x98, 0xa0, 0x1f,
 	0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x13, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x84, 0x01,
-	0x0a, 0x0c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x26,
-	0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xf2,
-	0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52,
-	0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x4c, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x34, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
-	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x0d, 0x79,
-	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x52, 0x06, 0x77, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x26, 0x0a,
-	0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xf2, 0xde,
-	0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x05,
-	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x71, 0x0a, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
-	0x40, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63,
-	0x6f, 0x69, 0x6e, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x73, 0x52, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x63,
-	0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x26, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x10, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64,
-	0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x82, 0x01, 0x0a,
-	0x09, 0x64, 0x65, 0x63, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x47,
-	0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65,
-	0x63, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44,
-	0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x64, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e,
-	0x73, 0x22, 0xf2, 0x01, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x48,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
-	0x12, 0x8e, 0x01, 0x0a, 0x18, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
-	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63,
-	0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x33, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x1f, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x72,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x73, 0x22, 0xaa, 0xdf, 0x1f,
-	0x08, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x16, 0x63, 0x75, 0x6d, 0x75, 0x6c,
-	0x61, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f,
-	0x73, 0x12, 0x43, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0xf2, 0xde, 0x1f, 0x16,
-	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xeb, 0x02,
+	0x0a, 0x14, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x12, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x52, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x68, 0x0a, 0x0e, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x26, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f,
+	0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0d, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57,
+	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x38, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x52, 0x09, 0x6d, 0x61,
+	0x78, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x62, 0x0a, 0x0b, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x42, 0x1a, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x22, 0x52, 0x0b,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x0c,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x26, 0x0a, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xf2, 0xde, 0x1f,
+	0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x05, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x4c, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x34, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x26, 0x0a, 0x05, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xf2, 0xde, 0x1f, 0x0c,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x05, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x12, 0x71, 0x0a, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x40, 0xc8,
+	0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x69,
+	0x6e, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52,
+	0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x63, 0x50, 0x6f,
+	0x6f, 0x6c, 0x12, 0x26, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x10, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x22, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x82, 0x01, 0x0a, 0x09, 0x64,
+	0x65, 0x63, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x47, 0xc8, 0xde,
+	0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x63, 0x5f,
+	0x63, 0x6f, 0x69, 0x6e, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x64, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x22,
+	0xc0, 0x02, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x8e,
+	0x01, 0x0a, 0x18, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x50, 0x6f,
+	0x6f, 0x6c, 0x42, 0x33, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x1f, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x08, 0x44,
+	0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x16, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x73, 0x12,
+	0x43, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4c, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x1c,
+	0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x69,
+	0x6d, 0x65, 0x22, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x5d,
+	0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c,
+	0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x08, 0x44, 0x65, 0x63, 0x50,
+	0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x8b, 0x01, 0x0a, 0x1e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x69, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x26,
+	0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x08, 0x44, 0x65,
+	0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x7c, 0x0a, 0x1b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72,
 	0x64, 0x73, 0x12, 0x5d, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20,
 	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73,
 	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63,
 	0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61,
 	0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x08,
 	0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x8b, 0x01, 0x0a, 0x1e, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74,
-	0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x69, 0x0a, 0x0b,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x50, 0x6f,
-	0x6f, 0x6c, 0x42, 0x26, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xaa, 0xdf,
-	0x1f, 0x08, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7c, 0x0a, 0x1b, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x5d, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x2e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde,
-	0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22,
-	0xaa, 0xdf, 0x1f, 0x08, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x07, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0xe3, 0x01, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x46, 0x0a,
-	0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1b, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x22, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50,
-	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x83, 0x01, 0x0a, 0x09, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x73, 0x22, 0xe3, 0x01, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x10, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x1b, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22,
+	0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x12, 0x83, 0x01, 0x0a, 0x09, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x47, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xaa, 0xdf, 0x1f,
+	0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x09, 0x66, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x88, 0x01, 0x0a, 0x16, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x25,
+	0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x1d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x52, 0x14, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x53, 0x6c, 0x61, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x3a, 0x04, 0x98, 0xa0, 0x1f,
+	0x00, 0x22, 0x9f, 0x02, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x43, 0x0a, 0x0f, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22,
+	0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x12, 0x7a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x44,
+	0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xea, 0xde,
+	0x1f, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0xbb, 0x01, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x12, 0x49, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xf2, 0xde,
+	0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x49, 0x0a, 0x06,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x10, 0xc8,
+	0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x08, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52,
+	0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0x98, 0xa0, 0x1f,
+	0x01, 0x22, 0xfd, 0x03, 0x0a, 0x05, 0x47, 0x61, 0x75, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x09, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x68, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x47, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f,
-	0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
-	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73,
-	0x52, 0x09, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x14,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x12, 0x88, 0x01, 0x0a, 0x16, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64,
-	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x42, 0x25, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x1d, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x6c, 0x61, 0x73,
-	0x68, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x52, 0x14, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x3a,
-	0x04, 0x98, 0xa0, 0x1f, 0x00, 0x22, 0x9f, 0x02, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x43, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x22, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x12, 0x7a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f,
-	0x69, 0x6e, 0x42, 0x44, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x72, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
 	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x73,
-	0x12, 0x45, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x2d, 0xea, 0xde, 0x1f, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x52,
-	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xbb, 0x01, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65,
-	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x49, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x1c, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x10,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x49, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f,
-	0x6c, 0x42, 0x10, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x08, 0x44, 0x65, 0x63, 0x50, 0x6f,
-	0x6f, 0x6c, 0x73, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x3a, 0x08, 0x88, 0xa0, 0x1f,
-	0x00, 0x98, 0xa0, 0x1f, 0x01, 0x42, 0xf6, 0x01, 0xa8, 0xe2, 0x1e, 0x01, 0x0a, 0x1a, 0x63, 0x6f,
-	0x6d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x11, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x47, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x44, 0x58, 0xaa, 0x02, 0x16, 0x49,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x44,
-	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-	0x22, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x3a, 0x3a, 0x44, 0x69,
-	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f,
+	0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73,
+	0x12, 0x4f, 0x0a, 0x0e, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0d, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x96, 0x02, 0x0a, 0x0e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x10, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x96, 0x01, 0x0a,
+	0x10, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65,
+	0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x4e, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x17, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x43, 0x0a, 0x03, 0x61, 0x70, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x0a, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x61, 0x70, 0x72, 0x22, 0x52, 0x03, 0x61, 0x70, 0x72, 0x22, 0xb5, 0x01, 0x0a, 0x14, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c,
+	0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x10, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x52,
+	0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x52, 0x61, 0x74, 0x65, 0x42, 0x14, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0c, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0x52, 0x05, 0x70, 0x6f, 0x6f,
+	0x6c, 0x73, 0x22, 0xdf, 0x03, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x12, 0x46, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x4c, 0x0a, 0x08, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x4a, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x44,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde,
+	0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x42, 0xf6, 0x01, 0xa8, 0xe2, 0x1e, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d,
+	0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x11, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x47, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d,
+	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x44, 0x58, 0xaa, 0x02, 0x16, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x44, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22,
+	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x18, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x3a, 0x3a, 0x44, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -7232,40 +11891,60 @@ func file_initia_distribution_v1_distribution_proto_rawDescGZIP() []byte {
 	return file_initia_distribution_v1_distribution_proto_rawDescData
 }
 
-var file_initia_distribution_v1_distribution_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_initia_distribution_v1_distribution_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_initia_distribution_v1_distribution_proto_goTypes = []interface{}{
 	(*Params)(nil),                         // 0: initia.distribution.v1.Params
-	(*RewardWeight)(nil),                   // 1: initia.distribution.v1.RewardWeight
-	(*Pool)(nil),                           // 2: initia.distribution.v1.Pool
-	(*DecPool)(nil),                        // 3: initia.distribution.v1.DecPool
-	(*ValidatorHistoricalRewards)(nil),     // 4: initia.distribution.v1.ValidatorHistoricalRewards
-	(*ValidatorCurrentRewards)(nil),        // 5: initia.distribution.v1.ValidatorCurrentRewards
-	(*ValidatorAccumulatedCommission)(nil), // 6: initia.distribution.v1.ValidatorAccumulatedCommission
-	(*ValidatorOutstandingRewards)(nil),    // 7: initia.distribution.v1.ValidatorOutstandingRewards
-	(*ValidatorSlashEvent)(nil),            // 8: initia.distribution.v1.ValidatorSlashEvent
-	(*ValidatorSlashEvents)(nil),           // 9: initia.distribution.v1.ValidatorSlashEvents
-	(*DelegatorStartingInfo)(nil),          // 10: initia.distribution.v1.DelegatorStartingInfo
-	(*DelegationDelegatorReward)(nil),      // 11: initia.distribution.v1.DelegationDelegatorReward
-	(*v1beta1.Coin)(nil),                   // 12: cosmos.base.v1beta1.Coin
-	(*v1beta1.DecCoin)(nil),                // 13: cosmos.base.v1beta1.DecCoin
+	(*DynamicRewardWeights)(nil),           // 1: initia.distribution.v1.DynamicRewardWeights
+	(*RewardWeight)(nil),                   // 2: initia.distribution.v1.RewardWeight
+	(*Pool)(nil),                           // 3: initia.distribution.v1.Pool
+	(*DecPool)(nil),                        // 4: initia.distribution.v1.DecPool
+	(*ValidatorHistoricalRewards)(nil),     // 5: initia.distribution.v1.ValidatorHistoricalRewards
+	(*ValidatorCurrentRewards)(nil),        // 6: initia.distribution.v1.ValidatorCurrentRewards
+	(*ValidatorAccumulatedCommission)(nil), // 7: initia.distribution.v1.ValidatorAccumulatedCommission
+	(*ValidatorOutstandingRewards)(nil),    // 8: initia.distribution.v1.ValidatorOutstandingRewards
+	(*ValidatorSlashEvent)(nil),            // 9: initia.distribution.v1.ValidatorSlashEvent
+	(*ValidatorSlashEvents)(nil),           // 10: initia.distribution.v1.ValidatorSlashEvents
+	(*DelegatorStartingInfo)(nil),          // 11: initia.distribution.v1.DelegatorStartingInfo
+	(*DelegationDelegatorReward)(nil),      // 12: initia.distribution.v1.DelegationDelegatorReward
+	(*Gauge)(nil),                          // 13: initia.distribution.v1.Gauge
+	(*PoolRewardRate)(nil),                 // 14: initia.distribution.v1.PoolRewardRate
+	(*ValidatorRewardRates)(nil),           // 15: initia.distribution.v1.ValidatorRewardRates
+	(*PaymentStream)(nil),                  // 16: initia.distribution.v1.PaymentStream
+	(*v1beta1.Coin)(nil),                   // 17: cosmos.base.v1beta1.Coin
+	(*durationpb.Duration)(nil),            // 18: google.protobuf.Duration
+	(*v1beta1.DecCoin)(nil),                // 19: cosmos.base.v1beta1.DecCoin
+	(*timestamppb.Timestamp)(nil),          // 20: google.protobuf.Timestamp
 }
 var file_initia_distribution_v1_distribution_proto_depIdxs = []int32{
-	1,  // 0: initia.distribution.v1.Params.reward_weights:type_name -> initia.distribution.v1.RewardWeight
-	12, // 1: initia.distribution.v1.Pool.coins:type_name -> cosmos.base.v1beta1.Coin
-	13, // 2: initia.distribution.v1.DecPool.dec_coins:type_name -> cosmos.base.v1beta1.DecCoin
-	3,  // 3: initia.distribution.v1.ValidatorHistoricalRewards.cumulative_reward_ratios:type_name -> initia.distribution.v1.DecPool
-	3,  // 4: initia.distribution.v1.ValidatorCurrentRewards.rewards:type_name -> initia.distribution.v1.DecPool
-	3,  // 5: initia.distribution.v1.ValidatorAccumulatedCommission.commissions:type_name -> initia.distribution.v1.DecPool
-	3,  // 6: initia.distribution.v1.ValidatorOutstandingRewards.rewards:type_name -> initia.distribution.v1.DecPool
-	13, // 7: initia.distribution.v1.ValidatorSlashEvent.fractions:type_name -> cosmos.base.v1beta1.DecCoin
-	8,  // 8: initia.distribution.v1.ValidatorSlashEvents.validator_slash_events:type_name -> initia.distribution.v1.ValidatorSlashEvent
-	13, // 9: initia.distribution.v1.DelegatorStartingInfo.stakes:type_name -> cosmos.base.v1beta1.DecCoin
-	3,  // 10: initia.distribution.v1.DelegationDelegatorReward.reward:type_name -> initia.distribution.v1.DecPool
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	2,  // 0: initia.distribution.v1.Params.reward_weights:type_name -> initia.distribution.v1.RewardWeight
+	1,  // 1: initia.distribution.v1.Params.dynamic_reward_weights:type_name -> initia.distribution.v1.DynamicRewardWeights
+	17, // 2: initia.distribution.v1.Params.gauge_creation_fee:type_name -> cosmos.base.v1beta1.Coin
+	18, // 3: initia.distribution.v1.DynamicRewardWeights.epoch_duration:type_name -> google.protobuf.Duration
+	2,  // 4: initia.distribution.v1.DynamicRewardWeights.multipliers:type_name -> initia.distribution.v1.RewardWeight
+	17, // 5: initia.distribution.v1.Pool.coins:type_name -> cosmos.base.v1beta1.Coin
+	19, // 6: initia.distribution.v1.DecPool.dec_coins:type_name -> cosmos.base.v1beta1.DecCoin
+	4,  // 7: initia.distribution.v1.ValidatorHistoricalRewards.cumulative_reward_ratios:type_name -> initia.distribution.v1.DecPool
+	20, // 8: initia.distribution.v1.ValidatorHistoricalRewards.time:type_name -> google.protobuf.Timestamp
+	4,  // 9: initia.distribution.v1.ValidatorCurrentRewards.rewards:type_name -> initia.distribution.v1.DecPool
+	4,  // 10: initia.distribution.v1.ValidatorAccumulatedCommission.commissions:type_name -> initia.distribution.v1.DecPool
+	4,  // 11: initia.distribution.v1.ValidatorOutstandingRewards.rewards:type_name -> initia.distribution.v1.DecPool
+	19, // 12: initia.distribution.v1.ValidatorSlashEvent.fractions:type_name -> cosmos.base.v1beta1.DecCoin
+	9,  // 13: initia.distribution.v1.ValidatorSlashEvents.validator_slash_events:type_name -> initia.distribution.v1.ValidatorSlashEvent
+	19, // 14: initia.distribution.v1.DelegatorStartingInfo.stakes:type_name -> cosmos.base.v1beta1.DecCoin
+	4,  // 15: initia.distribution.v1.DelegationDelegatorReward.reward:type_name -> initia.distribution.v1.DecPool
+	17, // 16: initia.distribution.v1.Gauge.amount:type_name -> cosmos.base.v1beta1.Coin
+	17, // 17: initia.distribution.v1.Gauge.distributed:type_name -> cosmos.base.v1beta1.Coin
+	20, // 18: initia.distribution.v1.Gauge.start_time:type_name -> google.protobuf.Timestamp
+	18, // 19: initia.distribution.v1.Gauge.epoch_duration:type_name -> google.protobuf.Duration
+	19, // 20: initia.distribution.v1.PoolRewardRate.reward_per_token:type_name -> cosmos.base.v1beta1.DecCoin
+	14, // 21: initia.distribution.v1.ValidatorRewardRates.pools:type_name -> initia.distribution.v1.PoolRewardRate
+	20, // 22: initia.distribution.v1.PaymentStream.start_time:type_name -> google.protobuf.Timestamp
+	20, // 23: initia.distribution.v1.PaymentStream.end_time:type_name -> google.protobuf.Timestamp
+	24, // [24:24] is the sub-list for method output_type
+	24, // [24:24] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_initia_distribution_v1_distribution_proto_init() }
@@ -7287,7 +11966,7 @@ func file_initia_distribution_v1_distribution_proto_init() {
 			}
 		}
 		file_initia_distribution_v1_distribution_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardWeight); i {
+			switch v := v.(*DynamicRewardWeights); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7299,7 +11978,7 @@ func file_initia_distribution_v1_distribution_proto_init() {
 			}
 		}
 		file_initia_distribution_v1_distribution_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pool); i {
+			switch v := v.(*RewardWeight); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7311,7 +11990,7 @@ func file_initia_distribution_v1_distribution_proto_init() {
 			}
 		}
 		file_initia_distribution_v1_distribution_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecPool); i {
+			switch v := v.(*Pool); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7323,7 +12002,7 @@ func file_initia_distribution_v1_distribution_proto_init() {
 			}
 		}
 		file_initia_distribution_v1_distribution_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorHistoricalRewards); i {
+			switch v := v.(*DecPool); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7335,7 +12014,7 @@ func file_initia_distribution_v1_distribution_proto_init() {
 			}
 		}
 		file_initia_distribution_v1_distribution_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorCurrentRewards); i {
+			switch v := v.(*ValidatorHistoricalRewards); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7347,7 +12026,7 @@ func file_initia_distribution_v1_distribution_proto_init() {
 			}
 		}
 		file_initia_distribution_v1_distribution_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorAccumulatedCommission); i {
+			switch v := v.(*ValidatorCurrentRewards); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7359,7 +12038,7 @@ func file_initia_distribution_v1_distribution_proto_init() {
 			}
 		}
 		file_initia_distribution_v1_distribution_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorOutstandingRewards); i {
+			switch v := v.(*ValidatorAccumulatedCommission); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7371,7 +12050,7 @@ func file_initia_distribution_v1_distribution_proto_init() {
 			}
 		}
 		file_initia_distribution_v1_distribution_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorSlashEvent); i {
+			switch v := v.(*ValidatorOutstandingRewards); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7383,7 +12062,7 @@ func file_initia_distribution_v1_distribution_proto_init() {
 			}
 		}
 		file_initia_distribution_v1_distribution_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorSlashEvents); i {
+			switch v := v.(*ValidatorSlashEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7395,7 +12074,7 @@ func file_initia_distribution_v1_distribution_proto_init() {
 			}
 		}
 		file_initia_distribution_v1_distribution_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelegatorStartingInfo); i {
+			switch v := v.(*ValidatorSlashEvents); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7407,6 +12086,18 @@ func file_initia_distribution_v1_distribution_proto_init() {
 			}
 		}
 		file_initia_distribution_v1_distribution_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelegatorStartingInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_distribution_v1_distribution_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DelegationDelegatorReward); i {
 			case 0:
 				return &v.state
@@ -7418,6 +12109,54 @@ func file_initia_distribution_v1_distribution_proto_init() {
 				return nil
 			}
 		}
+		file_initia_distribution_v1_distribution_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gauge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_distribution_v1_distribution_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolRewardRate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_distribution_v1_distribution_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorRewardRates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_distribution_v1_distribution_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentStream); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -7425,7 +12164,7 @@ func file_initia_distribution_v1_distribution_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_initia_distribution_v1_distribution_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
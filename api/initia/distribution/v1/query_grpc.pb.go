@@ -25,6 +25,12 @@ const (
 	Query_ValidatorSlashes_FullMethodName            = "/initia.distribution.v1.Query/ValidatorSlashes"
 	Query_DelegationRewards_FullMethodName           = "/initia.distribution.v1.Query/DelegationRewards"
 	Query_DelegationTotalRewards_FullMethodName      = "/initia.distribution.v1.Query/DelegationTotalRewards"
+	Query_ProjectedRewardRates_FullMethodName        = "/initia.distribution.v1.Query/ProjectedRewardRates"
+	Query_RealizedRewardRates_FullMethodName         = "/initia.distribution.v1.Query/RealizedRewardRates"
+	Query_Gauge_FullMethodName                       = "/initia.distribution.v1.Query/Gauge"
+	Query_Gauges_FullMethodName                      = "/initia.distribution.v1.Query/Gauges"
+	Query_PaymentStream_FullMethodName               = "/initia.distribution.v1.Query/PaymentStream"
+	Query_PaymentStreams_FullMethodName              = "/initia.distribution.v1.Query/PaymentStreams"
 )
 
 // QueryClient is the client API for Query service.
@@ -44,6 +50,21 @@ type QueryClient interface {
 	// DelegationTotalRewards queries the total rewards accrued by a each
 	// validator.
 	DelegationTotalRewards(ctx context.Context, in *QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsResponse, error)
+	// ProjectedRewardRates queries the annual reward rates of the bonded validators
+	// projected from the current annual provisions and reward weights.
+	ProjectedRewardRates(ctx context.Context, in *QueryProjectedRewardRatesRequest, opts ...grpc.CallOption) (*QueryProjectedRewardRatesResponse, error)
+	// RealizedRewardRates queries the annual reward rates of a validator realized
+	// since the oldest historical rewards period.
+	RealizedRewardRates(ctx context.Context, in *QueryRealizedRewardRatesRequest, opts ...grpc.CallOption) (*QueryRealizedRewardRatesResponse, error)
+	// Gauge queries an incentive gauge by id.
+	Gauge(ctx context.Context, in *QueryGaugeRequest, opts ...grpc.CallOption) (*QueryGaugeResponse, error)
+	// Gauges queries all incentive gauges, optionally filtered by the bond denom.
+	Gauges(ctx context.Context, in *QueryGaugesRequest, opts ...grpc.CallOption) (*QueryGaugesResponse, error)
+	// PaymentStream queries a community pool payment stream by id.
+	PaymentStream(ctx context.Context, in *QueryPaymentStreamRequest, opts ...grpc.CallOption) (*QueryPaymentStreamResponse, error)
+	// PaymentStreams queries all community pool payment streams, optionally
+	// filtered by the recipient.
+	PaymentStreams(ctx context.Context, in *QueryPaymentStreamsRequest, opts ...grpc.CallOption) (*QueryPaymentStreamsResponse, error)
 }
 
 type queryClient struct {
@@ -108,6 +129,60 @@ func (c *queryClient) DelegationTotalRewards(ctx context.Context, in *QueryDeleg
 	return out, nil
 }
 
+func (c *queryClient) ProjectedRewardRates(ctx context.Context, in *QueryProjectedRewardRatesRequest, opts ...grpc.CallOption) (*QueryProjectedRewardRatesResponse, error) {
+	out := new(QueryProjectedRewardRatesResponse)
+	err := c.cc.Invoke(ctx, Query_ProjectedRewardRates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RealizedRewardRates(ctx context.Context, in *QueryRealizedRewardRatesRequest, opts ...grpc.CallOption) (*QueryRealizedRewardRatesResponse, error) {
+	out := new(QueryRealizedRewardRatesResponse)
+	err := c.cc.Invoke(ctx, Query_RealizedRewardRates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Gauge(ctx context.Context, in *QueryGaugeRequest, opts ...grpc.CallOption) (*QueryGaugeResponse, error) {
+	out := new(QueryGaugeResponse)
+	err := c.cc.Invoke(ctx, Query_Gauge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Gauges(ctx context.Context, in *QueryGaugesRequest, opts ...grpc.CallOption) (*QueryGaugesResponse, error) {
+	out := new(QueryGaugesResponse)
+	err := c.cc.Invoke(ctx, Query_Gauges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PaymentStream(ctx context.Context, in *QueryPaymentStreamRequest, opts ...grpc.CallOption) (*QueryPaymentStreamResponse, error) {
+	out := new(QueryPaymentStreamResponse)
+	err := c.cc.Invoke(ctx, Query_PaymentStream_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PaymentStreams(ctx context.Context, in *QueryPaymentStreamsRequest, opts ...grpc.CallOption) (*QueryPaymentStreamsResponse, error) {
+	out := new(QueryPaymentStreamsResponse)
+	err := c.cc.Invoke(ctx, Query_PaymentStreams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -125,6 +200,21 @@ type QueryServer interface {
 	// DelegationTotalRewards queries the total rewards accrued by a each
 	// validator.
 	DelegationTotalRewards(context.Context, *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error)
+	// ProjectedRewardRates queries the annual reward rates of the bonded validators
+	// projected from the current annual provisions and reward weights.
+	ProjectedRewardRates(context.Context, *QueryProjectedRewardRatesRequest) (*QueryProjectedRewardRatesResponse, error)
+	// RealizedRewardRates queries the annual reward rates of a validator realized
+	// since the oldest historical rewards period.
+	RealizedRewardRates(context.Context, *QueryRealizedRewardRatesRequest) (*QueryRealizedRewardRatesResponse, error)
+	// Gauge queries an incentive gauge by id.
+	Gauge(context.Context, *QueryGaugeRequest) (*QueryGaugeResponse, error)
+	// Gauges queries all incentive gauges, optionally filtered by the bond denom.
+	Gauges(context.Context, *QueryGaugesRequest) (*QueryGaugesResponse, error)
+	// PaymentStream queries a community pool payment stream by id.
+	PaymentStream(context.Context, *QueryPaymentStreamRequest) (*QueryPaymentStreamResponse, error)
+	// PaymentStreams queries all community pool payment streams, optionally
+	// filtered by the recipient.
+	PaymentStreams(context.Context, *QueryPaymentStreamsRequest) (*QueryPaymentStreamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -150,6 +240,24 @@ func (UnimplementedQueryServer) DelegationRewards(context.Context, *QueryDelegat
 func (UnimplementedQueryServer) DelegationTotalRewards(context.Context, *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegationTotalRewards not implemented")
 }
+func (UnimplementedQueryServer) ProjectedRewardRates(context.Context, *QueryProjectedRewardRatesRequest) (*QueryProjectedRewardRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedRewardRates not implemented")
+}
+func (UnimplementedQueryServer) RealizedRewardRates(context.Context, *QueryRealizedRewardRatesRequest) (*QueryRealizedRewardRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RealizedRewardRates not implemented")
+}
+func (UnimplementedQueryServer) Gauge(context.Context, *QueryGaugeRequest) (*QueryGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Gauge not implemented")
+}
+func (UnimplementedQueryServer) Gauges(context.Context, *QueryGaugesRequest) (*QueryGaugesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Gauges not implemented")
+}
+func (UnimplementedQueryServer) PaymentStream(context.Context, *QueryPaymentStreamRequest) (*QueryPaymentStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentStream not implemented")
+}
+func (UnimplementedQueryServer) PaymentStreams(context.Context, *QueryPaymentStreamsRequest) (*QueryPaymentStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentStreams not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -271,6 +379,114 @@ func _Query_DelegationTotalRewards_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProjectedRewardRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedRewardRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedRewardRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProjectedRewardRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedRewardRates(ctx, req.(*QueryProjectedRewardRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RealizedRewardRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRealizedRewardRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RealizedRewardRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RealizedRewardRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RealizedRewardRates(ctx, req.(*QueryRealizedRewardRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Gauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGaugeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Gauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Gauge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Gauge(ctx, req.(*QueryGaugeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Gauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGaugesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Gauges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Gauges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Gauges(ctx, req.(*QueryGaugesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PaymentStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentStream(ctx, req.(*QueryPaymentStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentStreamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PaymentStreams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentStreams(ctx, req.(*QueryPaymentStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -302,6 +518,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelegationTotalRewards",
 			Handler:    _Query_DelegationTotalRewards_Handler,
 		},
+		{
+			MethodName: "ProjectedRewardRates",
+			Handler:    _Query_ProjectedRewardRates_Handler,
+		},
+		{
+			MethodName: "RealizedRewardRates",
+			Handler:    _Query_RealizedRewardRates_Handler,
+		},
+		{
+			MethodName: "Gauge",
+			Handler:    _Query_Gauge_Handler,
+		},
+		{
+			MethodName: "Gauges",
+			Handler:    _Query_Gauges_Handler,
+		},
+		{
+			MethodName: "PaymentStream",
+			Handler:    _Query_PaymentStream_Handler,
+		},
+		{
+			MethodName: "PaymentStreams",
+			Handler:    _Query_PaymentStreams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/distribution/v1/query.proto",
@@ -21,6 +21,11 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Msg_UpdateParams_FullMethodName                = "/initia.distribution.v1.Msg/UpdateParams"
 	Msg_DepositValidatorRewardsPool_FullMethodName = "/initia.distribution.v1.Msg/DepositValidatorRewardsPool"
+	Msg_CreateGauge_FullMethodName                 = "/initia.distribution.v1.Msg/CreateGauge"
+	Msg_WithdrawAllDelegatorRewards_FullMethodName = "/initia.distribution.v1.Msg/WithdrawAllDelegatorRewards"
+	Msg_CreatePaymentStream_FullMethodName         = "/initia.distribution.v1.Msg/CreatePaymentStream"
+	Msg_ClaimPaymentStream_FullMethodName          = "/initia.distribution.v1.Msg/ClaimPaymentStream"
+	Msg_CancelPaymentStream_FullMethodName         = "/initia.distribution.v1.Msg/CancelPaymentStream"
 )
 
 // MsgClient is the client API for Msg service.
@@ -33,6 +38,21 @@ type MsgClient interface {
 	// DepositValidatorRewardsPool defines a method to provide additional rewards
 	// to delegators to a specific validator.
 	DepositValidatorRewardsPool(ctx context.Context, in *MsgDepositValidatorRewardsPool, opts ...grpc.CallOption) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// CreateGauge defines a method to create an incentive gauge which linearly
+	// releases the deposited coins to the reward pool of a bond denom.
+	CreateGauge(ctx context.Context, in *MsgCreateGauge, opts ...grpc.CallOption) (*MsgCreateGaugeResponse, error)
+	// WithdrawAllDelegatorRewards defines a method to withdraw the rewards of
+	// all the delegations of a delegator in a single message.
+	WithdrawAllDelegatorRewards(ctx context.Context, in *MsgWithdrawAllDelegatorRewards, opts ...grpc.CallOption) (*MsgWithdrawAllDelegatorRewardsResponse, error)
+	// CreatePaymentStream defines a governance operation for creating a payment
+	// stream which pays out the community pool to the recipient over time.
+	CreatePaymentStream(ctx context.Context, in *MsgCreatePaymentStream, opts ...grpc.CallOption) (*MsgCreatePaymentStreamResponse, error)
+	// ClaimPaymentStream defines a method for the recipient to claim the
+	// released amount of a payment stream.
+	ClaimPaymentStream(ctx context.Context, in *MsgClaimPaymentStream, opts ...grpc.CallOption) (*MsgClaimPaymentStreamResponse, error)
+	// CancelPaymentStream defines a governance operation for cancelling a
+	// payment stream; the unreleased amount remains in the community pool.
+	CancelPaymentStream(ctx context.Context, in *MsgCancelPaymentStream, opts ...grpc.CallOption) (*MsgCancelPaymentStreamResponse, error)
 }
 
 type msgClient struct {
@@ -61,6 +81,51 @@ func (c *msgClient) DepositValidatorRewardsPool(ctx context.Context, in *MsgDepo
 	return out, nil
 }
 
+func (c *msgClient) CreateGauge(ctx context.Context, in *MsgCreateGauge, opts ...grpc.CallOption) (*MsgCreateGaugeResponse, error) {
+	out := new(MsgCreateGaugeResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateGauge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawAllDelegatorRewards(ctx context.Context, in *MsgWithdrawAllDelegatorRewards, opts ...grpc.CallOption) (*MsgWithdrawAllDelegatorRewardsResponse, error) {
+	out := new(MsgWithdrawAllDelegatorRewardsResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawAllDelegatorRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreatePaymentStream(ctx context.Context, in *MsgCreatePaymentStream, opts ...grpc.CallOption) (*MsgCreatePaymentStreamResponse, error) {
+	out := new(MsgCreatePaymentStreamResponse)
+	err := c.cc.Invoke(ctx, Msg_CreatePaymentStream_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimPaymentStream(ctx context.Context, in *MsgClaimPaymentStream, opts ...grpc.CallOption) (*MsgClaimPaymentStreamResponse, error) {
+	out := new(MsgClaimPaymentStreamResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimPaymentStream_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelPaymentStream(ctx context.Context, in *MsgCancelPaymentStream, opts ...grpc.CallOption) (*MsgCancelPaymentStreamResponse, error) {
+	out := new(MsgCancelPaymentStreamResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelPaymentStream_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -71,6 +136,21 @@ type MsgServer interface {
 	// DepositValidatorRewardsPool defines a method to provide additional rewards
 	// to delegators to a specific validator.
 	DepositValidatorRewardsPool(context.Context, *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// CreateGauge defines a method to create an incentive gauge which linearly
+	// releases the deposited coins to the reward pool of a bond denom.
+	CreateGauge(context.Context, *MsgCreateGauge) (*MsgCreateGaugeResponse, error)
+	// WithdrawAllDelegatorRewards defines a method to withdraw the rewards of
+	// all the delegations of a delegator in a single message.
+	WithdrawAllDelegatorRewards(context.Context, *MsgWithdrawAllDelegatorRewards) (*MsgWithdrawAllDelegatorRewardsResponse, error)
+	// CreatePaymentStream defines a governance operation for creating a payment
+	// stream which pays out the community pool to the recipient over time.
+	CreatePaymentStream(context.Context, *MsgCreatePaymentStream) (*MsgCreatePaymentStreamResponse, error)
+	// ClaimPaymentStream defines a method for the recipient to claim the
+	// released amount of a payment stream.
+	ClaimPaymentStream(context.Context, *MsgClaimPaymentStream) (*MsgClaimPaymentStreamResponse, error)
+	// CancelPaymentStream defines a governance operation for cancelling a
+	// payment stream; the unreleased amount remains in the community pool.
+	CancelPaymentStream(context.Context, *MsgCancelPaymentStream) (*MsgCancelPaymentStreamResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -84,6 +164,21 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) DepositValidatorRewardsPool(context.Context, *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositValidatorRewardsPool not implemented")
 }
+func (UnimplementedMsgServer) CreateGauge(context.Context, *MsgCreateGauge) (*MsgCreateGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGauge not implemented")
+}
+func (UnimplementedMsgServer) WithdrawAllDelegatorRewards(context.Context, *MsgWithdrawAllDelegatorRewards) (*MsgWithdrawAllDelegatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAllDelegatorRewards not implemented")
+}
+func (UnimplementedMsgServer) CreatePaymentStream(context.Context, *MsgCreatePaymentStream) (*MsgCreatePaymentStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePaymentStream not implemented")
+}
+func (UnimplementedMsgServer) ClaimPaymentStream(context.Context, *MsgClaimPaymentStream) (*MsgClaimPaymentStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimPaymentStream not implemented")
+}
+func (UnimplementedMsgServer) CancelPaymentStream(context.Context, *MsgCancelPaymentStream) (*MsgCancelPaymentStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPaymentStream not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -133,6 +228,96 @@ func _Msg_DepositValidatorRewardsPool_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateGauge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateGauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateGauge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateGauge(ctx, req.(*MsgCreateGauge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawAllDelegatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawAllDelegatorRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawAllDelegatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawAllDelegatorRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawAllDelegatorRewards(ctx, req.(*MsgWithdrawAllDelegatorRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreatePaymentStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePaymentStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePaymentStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreatePaymentStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePaymentStream(ctx, req.(*MsgCreatePaymentStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimPaymentStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimPaymentStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimPaymentStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimPaymentStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimPaymentStream(ctx, req.(*MsgClaimPaymentStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelPaymentStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPaymentStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPaymentStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelPaymentStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPaymentStream(ctx, req.(*MsgCancelPaymentStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -148,6 +333,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DepositValidatorRewardsPool",
 			Handler:    _Msg_DepositValidatorRewardsPool_Handler,
 		},
+		{
+			MethodName: "CreateGauge",
+			Handler:    _Msg_CreateGauge_Handler,
+		},
+		{
+			MethodName: "WithdrawAllDelegatorRewards",
+			Handler:    _Msg_WithdrawAllDelegatorRewards_Handler,
+		},
+		{
+			MethodName: "CreatePaymentStream",
+			Handler:    _Msg_CreatePaymentStream_Handler,
+		},
+		{
+			MethodName: "ClaimPaymentStream",
+			Handler:    _Msg_ClaimPaymentStream_Handler,
+		},
+		{
+			MethodName: "CancelPaymentStream",
+			Handler:    _Msg_CancelPaymentStream_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/distribution/v1/tx.proto",
@@ -166,14 +166,269 @@ func (x *_GenesisState_4_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*VoteDelegation
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VoteDelegation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VoteDelegation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(VoteDelegation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(VoteDelegation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*MoveHook
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MoveHook)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MoveHook)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(MoveHook)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(MoveHook)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*DepositOutcome
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DepositOutcome)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DepositOutcome)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(DepositOutcome)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(DepositOutcome)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*VoterBreakdown
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VoterBreakdown)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VoterBreakdown)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(VoterBreakdown)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(VoterBreakdown)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]uint64
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field VoterBreakdownProposals as it is not of Message kind"))
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                      protoreflect.MessageDescriptor
-	fd_GenesisState_starting_proposal_id protoreflect.FieldDescriptor
-	fd_GenesisState_deposits             protoreflect.FieldDescriptor
-	fd_GenesisState_votes                protoreflect.FieldDescriptor
-	fd_GenesisState_proposals            protoreflect.FieldDescriptor
-	fd_GenesisState_params               protoreflect.FieldDescriptor
-	fd_GenesisState_constitution         protoreflect.FieldDescriptor
+	md_GenesisState                           protoreflect.MessageDescriptor
+	fd_GenesisState_starting_proposal_id      protoreflect.FieldDescriptor
+	fd_GenesisState_deposits                  protoreflect.FieldDescriptor
+	fd_GenesisState_votes                     protoreflect.FieldDescriptor
+	fd_GenesisState_proposals                 protoreflect.FieldDescriptor
+	fd_GenesisState_params                    protoreflect.FieldDescriptor
+	fd_GenesisState_constitution              protoreflect.FieldDescriptor
+	fd_GenesisState_vote_delegations          protoreflect.FieldDescriptor
+	fd_GenesisState_move_hooks                protoreflect.FieldDescriptor
+	fd_GenesisState_deposit_outcomes          protoreflect.FieldDescriptor
+	fd_GenesisState_voter_breakdowns          protoreflect.FieldDescriptor
+	fd_GenesisState_voter_breakdown_proposals protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -185,6 +440,11 @@ func init() {
 	fd_GenesisState_proposals = md_GenesisState.Fields().ByName("proposals")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_constitution = md_GenesisState.Fields().ByName("constitution")
+	fd_GenesisState_vote_delegations = md_GenesisState.Fields().ByName("vote_delegations")
+	fd_GenesisState_move_hooks = md_GenesisState.Fields().ByName("move_hooks")
+	fd_GenesisState_deposit_outcomes = md_GenesisState.Fields().ByName("deposit_outcomes")
+	fd_GenesisState_voter_breakdowns = md_GenesisState.Fields().ByName("voter_breakdowns")
+	fd_GenesisState_voter_breakdown_proposals = md_GenesisState.Fields().ByName("voter_breakdown_proposals")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -288,6 +548,36 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.VoteDelegations) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.VoteDelegations})
+		if !f(fd_GenesisState_vote_delegations, value) {
+			return
+		}
+	}
+	if len(x.MoveHooks) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.MoveHooks})
+		if !f(fd_GenesisState_move_hooks, value) {
+			return
+		}
+	}
+	if len(x.DepositOutcomes) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.DepositOutcomes})
+		if !f(fd_GenesisState_deposit_outcomes, value) {
+			return
+		}
+	}
+	if len(x.VoterBreakdowns) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.VoterBreakdowns})
+		if !f(fd_GenesisState_voter_breakdowns, value) {
+			return
+		}
+	}
+	if len(x.VoterBreakdownProposals) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.VoterBreakdownProposals})
+		if !f(fd_GenesisState_voter_breakdown_proposals, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -315,6 +605,16 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.Params != nil
 	case "initia.gov.v1.GenesisState.constitution":
 		return x.Constitution != ""
+	case "initia.gov.v1.GenesisState.vote_delegations":
+		return len(x.VoteDelegations) != 0
+	case "initia.gov.v1.GenesisState.move_hooks":
+		return len(x.MoveHooks) != 0
+	case "initia.gov.v1.GenesisState.deposit_outcomes":
+		return len(x.DepositOutcomes) != 0
+	case "initia.gov.v1.GenesisState.voter_breakdowns":
+		return len(x.VoterBreakdowns) != 0
+	case "initia.gov.v1.GenesisState.voter_breakdown_proposals":
+		return len(x.VoterBreakdownProposals) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.GenesisState"))
@@ -343,6 +643,16 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Params = nil
 	case "initia.gov.v1.GenesisState.constitution":
 		x.Constitution = ""
+	case "initia.gov.v1.GenesisState.vote_delegations":
+		x.VoteDelegations = nil
+	case "initia.gov.v1.GenesisState.move_hooks":
+		x.MoveHooks = nil
+	case "initia.gov.v1.GenesisState.deposit_outcomes":
+		x.DepositOutcomes = nil
+	case "initia.gov.v1.GenesisState.voter_breakdowns":
+		x.VoterBreakdowns = nil
+	case "initia.gov.v1.GenesisState.voter_breakdown_proposals":
+		x.VoterBreakdownProposals = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.GenesisState"))
@@ -386,6 +696,36 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "initia.gov.v1.GenesisState.constitution":
 		value := x.Constitution
 		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.GenesisState.vote_delegations":
+		if len(x.VoteDelegations) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.VoteDelegations}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.gov.v1.GenesisState.move_hooks":
+		if len(x.MoveHooks) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.MoveHooks}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.gov.v1.GenesisState.deposit_outcomes":
+		if len(x.DepositOutcomes) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.DepositOutcomes}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.gov.v1.GenesisState.voter_breakdowns":
+		if len(x.VoterBreakdowns) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.VoterBreakdowns}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.gov.v1.GenesisState.voter_breakdown_proposals":
+		if len(x.VoterBreakdownProposals) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.VoterBreakdownProposals}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.GenesisState"))
@@ -424,6 +764,26 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.Params = value.Message().Interface().(*Params)
 	case "initia.gov.v1.GenesisState.constitution":
 		x.Constitution = value.Interface().(string)
+	case "initia.gov.v1.GenesisState.vote_delegations":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.VoteDelegations = *clv.list
+	case "initia.gov.v1.GenesisState.move_hooks":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.MoveHooks = *clv.list
+	case "initia.gov.v1.GenesisState.deposit_outcomes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.DepositOutcomes = *clv.list
+	case "initia.gov.v1.GenesisState.voter_breakdowns":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.VoterBreakdowns = *clv.list
+	case "initia.gov.v1.GenesisState.voter_breakdown_proposals":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.VoterBreakdownProposals = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.GenesisState"))
@@ -467,6 +827,36 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Params = new(Params)
 		}
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "initia.gov.v1.GenesisState.vote_delegations":
+		if x.VoteDelegations == nil {
+			x.VoteDelegations = []*VoteDelegation{}
+		}
+		value := &_GenesisState_7_list{list: &x.VoteDelegations}
+		return protoreflect.ValueOfList(value)
+	case "initia.gov.v1.GenesisState.move_hooks":
+		if x.MoveHooks == nil {
+			x.MoveHooks = []*MoveHook{}
+		}
+		value := &_GenesisState_8_list{list: &x.MoveHooks}
+		return protoreflect.ValueOfList(value)
+	case "initia.gov.v1.GenesisState.deposit_outcomes":
+		if x.DepositOutcomes == nil {
+			x.DepositOutcomes = []*DepositOutcome{}
+		}
+		value := &_GenesisState_9_list{list: &x.DepositOutcomes}
+		return protoreflect.ValueOfList(value)
+	case "initia.gov.v1.GenesisState.voter_breakdowns":
+		if x.VoterBreakdowns == nil {
+			x.VoterBreakdowns = []*VoterBreakdown{}
+		}
+		value := &_GenesisState_10_list{list: &x.VoterBreakdowns}
+		return protoreflect.ValueOfList(value)
+	case "initia.gov.v1.GenesisState.voter_breakdown_proposals":
+		if x.VoterBreakdownProposals == nil {
+			x.VoterBreakdownProposals = []uint64{}
+		}
+		value := &_GenesisState_11_list{list: &x.VoterBreakdownProposals}
+		return protoreflect.ValueOfList(value)
 	case "initia.gov.v1.GenesisState.starting_proposal_id":
 		panic(fmt.Errorf("field starting_proposal_id of message initia.gov.v1.GenesisState is not mutable"))
 	case "initia.gov.v1.GenesisState.constitution":
@@ -500,6 +890,21 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "initia.gov.v1.GenesisState.constitution":
 		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.GenesisState.vote_delegations":
+		list := []*VoteDelegation{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "initia.gov.v1.GenesisState.move_hooks":
+		list := []*MoveHook{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "initia.gov.v1.GenesisState.deposit_outcomes":
+		list := []*DepositOutcome{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "initia.gov.v1.GenesisState.voter_breakdowns":
+		list := []*VoterBreakdown{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "initia.gov.v1.GenesisState.voter_breakdown_proposals":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.GenesisState"))
@@ -598,6 +1003,37 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.VoteDelegations) > 0 {
+			for _, e := range x.VoteDelegations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MoveHooks) > 0 {
+			for _, e := range x.MoveHooks {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DepositOutcomes) > 0 {
+			for _, e := range x.DepositOutcomes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VoterBreakdowns) > 0 {
+			for _, e := range x.VoterBreakdowns {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VoterBreakdownProposals) > 0 {
+			l = 0
+			for _, e := range x.VoterBreakdownProposals {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -627,6 +1063,90 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.VoterBreakdownProposals) > 0 {
+			var pksize2 int
+			for _, num := range x.VoterBreakdownProposals {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.VoterBreakdownProposals {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.VoterBreakdowns) > 0 {
+			for iNdEx := len(x.VoterBreakdowns) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VoterBreakdowns[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.DepositOutcomes) > 0 {
+			for iNdEx := len(x.DepositOutcomes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DepositOutcomes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.MoveHooks) > 0 {
+			for iNdEx := len(x.MoveHooks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MoveHooks[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.VoteDelegations) > 0 {
+			for iNdEx := len(x.VoteDelegations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VoteDelegations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
 		if len(x.Constitution) > 0 {
 			i -= len(x.Constitution)
 			copy(dAtA[i:], x.Constitution)
@@ -939,6 +1459,218 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				}
 				x.Constitution = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VoteDelegations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VoteDelegations = append(x.VoteDelegations, &VoteDelegation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VoteDelegations[len(x.VoteDelegations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MoveHooks", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MoveHooks = append(x.MoveHooks, &MoveHook{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MoveHooks[len(x.MoveHooks)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositOutcomes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DepositOutcomes = append(x.DepositOutcomes, &DepositOutcome{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DepositOutcomes[len(x.DepositOutcomes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VoterBreakdowns", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VoterBreakdowns = append(x.VoterBreakdowns, &VoterBreakdown{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VoterBreakdowns[len(x.VoterBreakdowns)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.VoterBreakdownProposals = append(x.VoterBreakdownProposals, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.VoterBreakdownProposals) == 0 {
+						x.VoterBreakdownProposals = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.VoterBreakdownProposals = append(x.VoterBreakdownProposals, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VoterBreakdownProposals", wireType)
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1010,6 +1742,17 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.50
 	Constitution string `protobuf:"bytes,6,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// vote_delegations defines all the vote delegations present at genesis.
+	VoteDelegations []*VoteDelegation `protobuf:"bytes,7,rep,name=vote_delegations,json=voteDelegations,proto3" json:"vote_delegations,omitempty"`
+	// move_hooks defines all the Move hooks present at genesis.
+	MoveHooks []*MoveHook `protobuf:"bytes,8,rep,name=move_hooks,json=moveHooks,proto3" json:"move_hooks,omitempty"`
+	// deposit_outcomes defines the final outcomes of the deleted deposits.
+	DepositOutcomes []*DepositOutcome `protobuf:"bytes,9,rep,name=deposit_outcomes,json=depositOutcomes,proto3" json:"deposit_outcomes,omitempty"`
+	// voter_breakdowns defines the archived voter breakdowns of the proposals.
+	VoterBreakdowns []*VoterBreakdown `protobuf:"bytes,10,rep,name=voter_breakdowns,json=voterBreakdowns,proto3" json:"voter_breakdowns,omitempty"`
+	// voter_breakdown_proposals defines the ids of the proposals whose voter
+	// breakdowns are archived.
+	VoterBreakdownProposals []uint64 `protobuf:"varint,11,rep,packed,name=voter_breakdown_proposals,json=voterBreakdownProposals,proto3" json:"voter_breakdown_proposals,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1074,6 +1817,41 @@ func (x *GenesisState) GetConstitution() string {
 	return ""
 }
 
+func (x *GenesisState) GetVoteDelegations() []*VoteDelegation {
+	if x != nil {
+		return x.VoteDelegations
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMoveHooks() []*MoveHook {
+	if x != nil {
+		return x.MoveHooks
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDepositOutcomes() []*DepositOutcome {
+	if x != nil {
+		return x.DepositOutcomes
+	}
+	return nil
+}
+
+func (x *GenesisState) GetVoterBreakdowns() []*VoterBreakdown {
+	if x != nil {
+		return x.VoterBreakdowns
+	}
+	return nil
+}
+
+func (x *GenesisState) GetVoterBreakdownProposals() []uint64 {
+	if x != nil {
+		return x.VoterBreakdownProposals
+	}
+	return nil
+}
+
 var File_initia_gov_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_initia_gov_v1_genesis_proto_rawDesc = []byte{
@@ -1082,8 +1860,8 @@ var file_initia_gov_v1_genesis_proto_rawDesc = []byte{
 	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x1a, 0x17, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x6f, 0x76, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x67, 0x6f,
-	0x76, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x6f, 0x76, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa9,
-	0x02, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x76, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x6f, 0x76, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfb,
+	0x04, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
 	0x30, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x70,
 	0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x73,
 	0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49,
@@ -1101,19 +1879,40 @@ var file_initia_gov_v1_genesis_proto_rawDesc = []byte{
 	0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06,
 	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x69,
 	0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f,
-	0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0xae, 0x01, 0x0a, 0x11, 0x63,
-	0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31,
-	0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76,
-	0x31, 0x3b, 0x67, 0x6f, 0x76, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x47, 0x58, 0xaa, 0x02, 0x0d,
-	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x47, 0x6f, 0x76, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d,
-	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19,
-	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x49, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x3a, 0x3a, 0x47, 0x6f, 0x76, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x10, 0x76, 0x6f,
+	0x74, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x67, 0x6f,
+	0x76, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x76, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x48, 0x6f, 0x6f,
+	0x6b, 0x52, 0x09, 0x6d, 0x6f, 0x76, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x48, 0x0a, 0x10,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e,
+	0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x10, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x5f,
+	0x62, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52,
+	0x0f, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x73,
+	0x12, 0x3a, 0x0a, 0x19, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x64,
+	0x6f, 0x77, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x17, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64,
+	0x6f, 0x77, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x42, 0xae, 0x01, 0x0a,
+	0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x67, 0x6f, 0x76, 0x2e,
+	0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x67, 0x6f, 0x76,
+	0x2f, 0x76, 0x31, 0x3b, 0x67, 0x6f, 0x76, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x47, 0x58, 0xaa,
+	0x02, 0x0d, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x47, 0x6f, 0x76, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x0d, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x19, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x56, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x3a, 0x3a, 0x47, 0x6f, 0x76, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1130,22 +1929,30 @@ func file_initia_gov_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_initia_gov_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_initia_gov_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: initia.gov.v1.GenesisState
-	(*v1.Deposit)(nil),   // 1: cosmos.gov.v1.Deposit
-	(*v1.Vote)(nil),      // 2: cosmos.gov.v1.Vote
-	(*Proposal)(nil),     // 3: initia.gov.v1.Proposal
-	(*Params)(nil),       // 4: initia.gov.v1.Params
+	(*GenesisState)(nil),   // 0: initia.gov.v1.GenesisState
+	(*v1.Deposit)(nil),     // 1: cosmos.gov.v1.Deposit
+	(*v1.Vote)(nil),        // 2: cosmos.gov.v1.Vote
+	(*Proposal)(nil),       // 3: initia.gov.v1.Proposal
+	(*Params)(nil),         // 4: initia.gov.v1.Params
+	(*VoteDelegation)(nil), // 5: initia.gov.v1.VoteDelegation
+	(*MoveHook)(nil),       // 6: initia.gov.v1.MoveHook
+	(*DepositOutcome)(nil), // 7: initia.gov.v1.DepositOutcome
+	(*VoterBreakdown)(nil), // 8: initia.gov.v1.VoterBreakdown
 }
 var file_initia_gov_v1_genesis_proto_depIdxs = []int32{
 	1, // 0: initia.gov.v1.GenesisState.deposits:type_name -> cosmos.gov.v1.Deposit
 	2, // 1: initia.gov.v1.GenesisState.votes:type_name -> cosmos.gov.v1.Vote
 	3, // 2: initia.gov.v1.GenesisState.proposals:type_name -> initia.gov.v1.Proposal
 	4, // 3: initia.gov.v1.GenesisState.params:type_name -> initia.gov.v1.Params
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	5, // 4: initia.gov.v1.GenesisState.vote_delegations:type_name -> initia.gov.v1.VoteDelegation
+	6, // 5: initia.gov.v1.GenesisState.move_hooks:type_name -> initia.gov.v1.MoveHook
+	7, // 6: initia.gov.v1.GenesisState.deposit_outcomes:type_name -> initia.gov.v1.DepositOutcome
+	8, // 7: initia.gov.v1.GenesisState.voter_breakdowns:type_name -> initia.gov.v1.VoterBreakdown
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_initia_gov_v1_genesis_proto_init() }
@@ -219,28 +219,330 @@ func (x *_Params_93_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_97_list)(nil)
+
+type _Params_97_list struct {
+	list *[]*VotingPowerProvider
+}
+
+func (x *_Params_97_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_97_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_97_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VotingPowerProvider)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_97_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VotingPowerProvider)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_97_list) AppendMutable() protoreflect.Value {
+	v := new(VotingPowerProvider)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_97_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_97_list) NewElement() protoreflect.Value {
+	v := new(VotingPowerProvider)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_97_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_98_list)(nil)
+
+type _Params_98_list struct {
+	list *[]string
+}
+
+func (x *_Params_98_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_98_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_98_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_98_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_98_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field EmergencySubmitters as it is not of Message kind"))
+}
+
+func (x *_Params_98_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_98_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_98_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_101_list)(nil)
+
+type _Params_101_list struct {
+	list *[]*ExecutionDelay
+}
+
+func (x *_Params_101_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_101_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_101_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExecutionDelay)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_101_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExecutionDelay)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_101_list) AppendMutable() protoreflect.Value {
+	v := new(ExecutionDelay)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_101_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_101_list) NewElement() protoreflect.Value {
+	v := new(ExecutionDelay)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_101_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_102_list)(nil)
+
+type _Params_102_list struct {
+	list *[]string
+}
+
+func (x *_Params_102_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_102_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_102_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_102_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_102_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field ExecutionCancellers as it is not of Message kind"))
+}
+
+func (x *_Params_102_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_102_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_102_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_103_list)(nil)
+
+type _Params_103_list struct {
+	list *[]*MsgTypeParams
+}
+
+func (x *_Params_103_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_103_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_103_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgTypeParams)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_103_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgTypeParams)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_103_list) AppendMutable() protoreflect.Value {
+	v := new(MsgTypeParams)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_103_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_103_list) NewElement() protoreflect.Value {
+	v := new(MsgTypeParams)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_103_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_104_list)(nil)
+
+type _Params_104_list struct {
+	list *[]string
+}
+
+func (x *_Params_104_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_104_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_104_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_104_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_104_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field OptimisticMsgTypes as it is not of Message kind"))
+}
+
+func (x *_Params_104_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_104_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_104_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                               protoreflect.MessageDescriptor
-	fd_Params_min_deposit                   protoreflect.FieldDescriptor
-	fd_Params_max_deposit_period            protoreflect.FieldDescriptor
-	fd_Params_voting_period                 protoreflect.FieldDescriptor
-	fd_Params_quorum                        protoreflect.FieldDescriptor
-	fd_Params_threshold                     protoreflect.FieldDescriptor
-	fd_Params_veto_threshold                protoreflect.FieldDescriptor
-	fd_Params_min_initial_deposit_ratio     protoreflect.FieldDescriptor
-	fd_Params_proposal_cancel_ratio         protoreflect.FieldDescriptor
-	fd_Params_proposal_cancel_dest          protoreflect.FieldDescriptor
-	fd_Params_expedited_voting_period       protoreflect.FieldDescriptor
-	fd_Params_expedited_threshold           protoreflect.FieldDescriptor
-	fd_Params_expedited_min_deposit         protoreflect.FieldDescriptor
-	fd_Params_burn_vote_quorum              protoreflect.FieldDescriptor
-	fd_Params_burn_proposal_deposit_prevote protoreflect.FieldDescriptor
-	fd_Params_burn_vote_veto                protoreflect.FieldDescriptor
-	fd_Params_min_deposit_ratio             protoreflect.FieldDescriptor
-	fd_Params_emergency_min_deposit         protoreflect.FieldDescriptor
-	fd_Params_emergency_tally_interval      protoreflect.FieldDescriptor
-	fd_Params_low_threshold_functions       protoreflect.FieldDescriptor
-	fd_Params_vesting                       protoreflect.FieldDescriptor
+	md_Params                                protoreflect.MessageDescriptor
+	fd_Params_min_deposit                    protoreflect.FieldDescriptor
+	fd_Params_max_deposit_period             protoreflect.FieldDescriptor
+	fd_Params_voting_period                  protoreflect.FieldDescriptor
+	fd_Params_quorum                         protoreflect.FieldDescriptor
+	fd_Params_threshold                      protoreflect.FieldDescriptor
+	fd_Params_veto_threshold                 protoreflect.FieldDescriptor
+	fd_Params_min_initial_deposit_ratio      protoreflect.FieldDescriptor
+	fd_Params_proposal_cancel_ratio          protoreflect.FieldDescriptor
+	fd_Params_proposal_cancel_dest           protoreflect.FieldDescriptor
+	fd_Params_expedited_voting_period        protoreflect.FieldDescriptor
+	fd_Params_expedited_threshold            protoreflect.FieldDescriptor
+	fd_Params_expedited_min_deposit          protoreflect.FieldDescriptor
+	fd_Params_burn_vote_quorum               protoreflect.FieldDescriptor
+	fd_Params_burn_proposal_deposit_prevote  protoreflect.FieldDescriptor
+	fd_Params_burn_vote_veto                 protoreflect.FieldDescriptor
+	fd_Params_min_deposit_ratio              protoreflect.FieldDescriptor
+	fd_Params_emergency_min_deposit          protoreflect.FieldDescriptor
+	fd_Params_emergency_tally_interval       protoreflect.FieldDescriptor
+	fd_Params_low_threshold_functions        protoreflect.FieldDescriptor
+	fd_Params_vesting                        protoreflect.FieldDescriptor
+	fd_Params_archive_voter_breakdown        protoreflect.FieldDescriptor
+	fd_Params_voter_breakdown_retention      protoreflect.FieldDescriptor
+	fd_Params_voting_power_providers         protoreflect.FieldDescriptor
+	fd_Params_emergency_submitters           protoreflect.FieldDescriptor
+	fd_Params_emergency_escalation_threshold protoreflect.FieldDescriptor
+	fd_Params_emergency_escalation_window    protoreflect.FieldDescriptor
+	fd_Params_execution_delays               protoreflect.FieldDescriptor
+	fd_Params_execution_cancellers           protoreflect.FieldDescriptor
+	fd_Params_msg_type_params                protoreflect.FieldDescriptor
+	fd_Params_optimistic_msg_types           protoreflect.FieldDescriptor
+	fd_Params_optimistic_rejection_threshold protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -266,6 +568,17 @@ func init() {
 	fd_Params_emergency_tally_interval = md_Params.Fields().ByName("emergency_tally_interval")
 	fd_Params_low_threshold_functions = md_Params.Fields().ByName("low_threshold_functions")
 	fd_Params_vesting = md_Params.Fields().ByName("vesting")
+	fd_Params_archive_voter_breakdown = md_Params.Fields().ByName("archive_voter_breakdown")
+	fd_Params_voter_breakdown_retention = md_Params.Fields().ByName("voter_breakdown_retention")
+	fd_Params_voting_power_providers = md_Params.Fields().ByName("voting_power_providers")
+	fd_Params_emergency_submitters = md_Params.Fields().ByName("emergency_submitters")
+	fd_Params_emergency_escalation_threshold = md_Params.Fields().ByName("emergency_escalation_threshold")
+	fd_Params_emergency_escalation_window = md_Params.Fields().ByName("emergency_escalation_window")
+	fd_Params_execution_delays = md_Params.Fields().ByName("execution_delays")
+	fd_Params_execution_cancellers = md_Params.Fields().ByName("execution_cancellers")
+	fd_Params_msg_type_params = md_Params.Fields().ByName("msg_type_params")
+	fd_Params_optimistic_msg_types = md_Params.Fields().ByName("optimistic_msg_types")
+	fd_Params_optimistic_rejection_threshold = md_Params.Fields().ByName("optimistic_rejection_threshold")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -453,6 +766,72 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.ArchiveVoterBreakdown != false {
+		value := protoreflect.ValueOfBool(x.ArchiveVoterBreakdown)
+		if !f(fd_Params_archive_voter_breakdown, value) {
+			return
+		}
+	}
+	if x.VoterBreakdownRetention != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.VoterBreakdownRetention)
+		if !f(fd_Params_voter_breakdown_retention, value) {
+			return
+		}
+	}
+	if len(x.VotingPowerProviders) != 0 {
+		value := protoreflect.ValueOfList(&_Params_97_list{list: &x.VotingPowerProviders})
+		if !f(fd_Params_voting_power_providers, value) {
+			return
+		}
+	}
+	if len(x.EmergencySubmitters) != 0 {
+		value := protoreflect.ValueOfList(&_Params_98_list{list: &x.EmergencySubmitters})
+		if !f(fd_Params_emergency_submitters, value) {
+			return
+		}
+	}
+	if x.EmergencyEscalationThreshold != "" {
+		value := protoreflect.ValueOfString(x.EmergencyEscalationThreshold)
+		if !f(fd_Params_emergency_escalation_threshold, value) {
+			return
+		}
+	}
+	if x.EmergencyEscalationWindow != nil {
+		value := protoreflect.ValueOfMessage(x.EmergencyEscalationWindow.ProtoReflect())
+		if !f(fd_Params_emergency_escalation_window, value) {
+			return
+		}
+	}
+	if len(x.ExecutionDelays) != 0 {
+		value := protoreflect.ValueOfList(&_Params_101_list{list: &x.ExecutionDelays})
+		if !f(fd_Params_execution_delays, value) {
+			return
+		}
+	}
+	if len(x.ExecutionCancellers) != 0 {
+		value := protoreflect.ValueOfList(&_Params_102_list{list: &x.ExecutionCancellers})
+		if !f(fd_Params_execution_cancellers, value) {
+			return
+		}
+	}
+	if len(x.MsgTypeParams) != 0 {
+		value := protoreflect.ValueOfList(&_Params_103_list{list: &x.MsgTypeParams})
+		if !f(fd_Params_msg_type_params, value) {
+			return
+		}
+	}
+	if len(x.OptimisticMsgTypes) != 0 {
+		value := protoreflect.ValueOfList(&_Params_104_list{list: &x.OptimisticMsgTypes})
+		if !f(fd_Params_optimistic_msg_types, value) {
+			return
+		}
+	}
+	if x.OptimisticRejectionThreshold != "" {
+		value := protoreflect.ValueOfString(x.OptimisticRejectionThreshold)
+		if !f(fd_Params_optimistic_rejection_threshold, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -508,6 +887,28 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.LowThresholdFunctions) != 0
 	case "initia.gov.v1.Params.vesting":
 		return x.Vesting != nil
+	case "initia.gov.v1.Params.archive_voter_breakdown":
+		return x.ArchiveVoterBreakdown != false
+	case "initia.gov.v1.Params.voter_breakdown_retention":
+		return x.VoterBreakdownRetention != uint64(0)
+	case "initia.gov.v1.Params.voting_power_providers":
+		return len(x.VotingPowerProviders) != 0
+	case "initia.gov.v1.Params.emergency_submitters":
+		return len(x.EmergencySubmitters) != 0
+	case "initia.gov.v1.Params.emergency_escalation_threshold":
+		return x.EmergencyEscalationThreshold != ""
+	case "initia.gov.v1.Params.emergency_escalation_window":
+		return x.EmergencyEscalationWindow != nil
+	case "initia.gov.v1.Params.execution_delays":
+		return len(x.ExecutionDelays) != 0
+	case "initia.gov.v1.Params.execution_cancellers":
+		return len(x.ExecutionCancellers) != 0
+	case "initia.gov.v1.Params.msg_type_params":
+		return len(x.MsgTypeParams) != 0
+	case "initia.gov.v1.Params.optimistic_msg_types":
+		return len(x.OptimisticMsgTypes) != 0
+	case "initia.gov.v1.Params.optimistic_rejection_threshold":
+		return x.OptimisticRejectionThreshold != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Params"))
@@ -564,6 +965,28 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.LowThresholdFunctions = nil
 	case "initia.gov.v1.Params.vesting":
 		x.Vesting = nil
+	case "initia.gov.v1.Params.archive_voter_breakdown":
+		x.ArchiveVoterBreakdown = false
+	case "initia.gov.v1.Params.voter_breakdown_retention":
+		x.VoterBreakdownRetention = uint64(0)
+	case "initia.gov.v1.Params.voting_power_providers":
+		x.VotingPowerProviders = nil
+	case "initia.gov.v1.Params.emergency_submitters":
+		x.EmergencySubmitters = nil
+	case "initia.gov.v1.Params.emergency_escalation_threshold":
+		x.EmergencyEscalationThreshold = ""
+	case "initia.gov.v1.Params.emergency_escalation_window":
+		x.EmergencyEscalationWindow = nil
+	case "initia.gov.v1.Params.execution_delays":
+		x.ExecutionDelays = nil
+	case "initia.gov.v1.Params.execution_cancellers":
+		x.ExecutionCancellers = nil
+	case "initia.gov.v1.Params.msg_type_params":
+		x.MsgTypeParams = nil
+	case "initia.gov.v1.Params.optimistic_msg_types":
+		x.OptimisticMsgTypes = nil
+	case "initia.gov.v1.Params.optimistic_rejection_threshold":
+		x.OptimisticRejectionThreshold = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Params"))
@@ -652,15 +1075,66 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "initia.gov.v1.Params.vesting":
 		value := x.Vesting
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Params"))
+	case "initia.gov.v1.Params.archive_voter_breakdown":
+		value := x.ArchiveVoterBreakdown
+		return protoreflect.ValueOfBool(value)
+	case "initia.gov.v1.Params.voter_breakdown_retention":
+		value := x.VoterBreakdownRetention
+		return protoreflect.ValueOfUint64(value)
+	case "initia.gov.v1.Params.voting_power_providers":
+		if len(x.VotingPowerProviders) == 0 {
+			return protoreflect.ValueOfList(&_Params_97_list{})
 		}
-		panic(fmt.Errorf("message initia.gov.v1.Params does not contain field %s", descriptor.FullName()))
-	}
-}
-
-// Set stores the value for a field.
+		listValue := &_Params_97_list{list: &x.VotingPowerProviders}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.gov.v1.Params.emergency_submitters":
+		if len(x.EmergencySubmitters) == 0 {
+			return protoreflect.ValueOfList(&_Params_98_list{})
+		}
+		listValue := &_Params_98_list{list: &x.EmergencySubmitters}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.gov.v1.Params.emergency_escalation_threshold":
+		value := x.EmergencyEscalationThreshold
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.Params.emergency_escalation_window":
+		value := x.EmergencyEscalationWindow
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.gov.v1.Params.execution_delays":
+		if len(x.ExecutionDelays) == 0 {
+			return protoreflect.ValueOfList(&_Params_101_list{})
+		}
+		listValue := &_Params_101_list{list: &x.ExecutionDelays}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.gov.v1.Params.execution_cancellers":
+		if len(x.ExecutionCancellers) == 0 {
+			return protoreflect.ValueOfList(&_Params_102_list{})
+		}
+		listValue := &_Params_102_list{list: &x.ExecutionCancellers}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.gov.v1.Params.msg_type_params":
+		if len(x.MsgTypeParams) == 0 {
+			return protoreflect.ValueOfList(&_Params_103_list{})
+		}
+		listValue := &_Params_103_list{list: &x.MsgTypeParams}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.gov.v1.Params.optimistic_msg_types":
+		if len(x.OptimisticMsgTypes) == 0 {
+			return protoreflect.ValueOfList(&_Params_104_list{})
+		}
+		listValue := &_Params_104_list{list: &x.OptimisticMsgTypes}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.gov.v1.Params.optimistic_rejection_threshold":
+		value := x.OptimisticRejectionThreshold
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Params"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
 //
 // For a field belonging to a oneof, it implicitly clears any other field
 // that may be currently set within the same oneof.
@@ -720,6 +1194,40 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.LowThresholdFunctions = *clv.list
 	case "initia.gov.v1.Params.vesting":
 		x.Vesting = value.Message().Interface().(*Vesting)
+	case "initia.gov.v1.Params.archive_voter_breakdown":
+		x.ArchiveVoterBreakdown = value.Bool()
+	case "initia.gov.v1.Params.voter_breakdown_retention":
+		x.VoterBreakdownRetention = value.Uint()
+	case "initia.gov.v1.Params.voting_power_providers":
+		lv := value.List()
+		clv := lv.(*_Params_97_list)
+		x.VotingPowerProviders = *clv.list
+	case "initia.gov.v1.Params.emergency_submitters":
+		lv := value.List()
+		clv := lv.(*_Params_98_list)
+		x.EmergencySubmitters = *clv.list
+	case "initia.gov.v1.Params.emergency_escalation_threshold":
+		x.EmergencyEscalationThreshold = value.Interface().(string)
+	case "initia.gov.v1.Params.emergency_escalation_window":
+		x.EmergencyEscalationWindow = value.Message().Interface().(*durationpb.Duration)
+	case "initia.gov.v1.Params.execution_delays":
+		lv := value.List()
+		clv := lv.(*_Params_101_list)
+		x.ExecutionDelays = *clv.list
+	case "initia.gov.v1.Params.execution_cancellers":
+		lv := value.List()
+		clv := lv.(*_Params_102_list)
+		x.ExecutionCancellers = *clv.list
+	case "initia.gov.v1.Params.msg_type_params":
+		lv := value.List()
+		clv := lv.(*_Params_103_list)
+		x.MsgTypeParams = *clv.list
+	case "initia.gov.v1.Params.optimistic_msg_types":
+		lv := value.List()
+		clv := lv.(*_Params_104_list)
+		x.OptimisticMsgTypes = *clv.list
+	case "initia.gov.v1.Params.optimistic_rejection_threshold":
+		x.OptimisticRejectionThreshold = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Params"))
@@ -789,6 +1297,47 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.Vesting = new(Vesting)
 		}
 		return protoreflect.ValueOfMessage(x.Vesting.ProtoReflect())
+	case "initia.gov.v1.Params.voting_power_providers":
+		if x.VotingPowerProviders == nil {
+			x.VotingPowerProviders = []*VotingPowerProvider{}
+		}
+		value := &_Params_97_list{list: &x.VotingPowerProviders}
+		return protoreflect.ValueOfList(value)
+	case "initia.gov.v1.Params.emergency_submitters":
+		if x.EmergencySubmitters == nil {
+			x.EmergencySubmitters = []string{}
+		}
+		value := &_Params_98_list{list: &x.EmergencySubmitters}
+		return protoreflect.ValueOfList(value)
+	case "initia.gov.v1.Params.emergency_escalation_window":
+		if x.EmergencyEscalationWindow == nil {
+			x.EmergencyEscalationWindow = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.EmergencyEscalationWindow.ProtoReflect())
+	case "initia.gov.v1.Params.execution_delays":
+		if x.ExecutionDelays == nil {
+			x.ExecutionDelays = []*ExecutionDelay{}
+		}
+		value := &_Params_101_list{list: &x.ExecutionDelays}
+		return protoreflect.ValueOfList(value)
+	case "initia.gov.v1.Params.execution_cancellers":
+		if x.ExecutionCancellers == nil {
+			x.ExecutionCancellers = []string{}
+		}
+		value := &_Params_102_list{list: &x.ExecutionCancellers}
+		return protoreflect.ValueOfList(value)
+	case "initia.gov.v1.Params.msg_type_params":
+		if x.MsgTypeParams == nil {
+			x.MsgTypeParams = []*MsgTypeParams{}
+		}
+		value := &_Params_103_list{list: &x.MsgTypeParams}
+		return protoreflect.ValueOfList(value)
+	case "initia.gov.v1.Params.optimistic_msg_types":
+		if x.OptimisticMsgTypes == nil {
+			x.OptimisticMsgTypes = []string{}
+		}
+		value := &_Params_104_list{list: &x.OptimisticMsgTypes}
+		return protoreflect.ValueOfList(value)
 	case "initia.gov.v1.Params.quorum":
 		panic(fmt.Errorf("field quorum of message initia.gov.v1.Params is not mutable"))
 	case "initia.gov.v1.Params.threshold":
@@ -811,6 +1360,14 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field burn_vote_veto of message initia.gov.v1.Params is not mutable"))
 	case "initia.gov.v1.Params.min_deposit_ratio":
 		panic(fmt.Errorf("field min_deposit_ratio of message initia.gov.v1.Params is not mutable"))
+	case "initia.gov.v1.Params.archive_voter_breakdown":
+		panic(fmt.Errorf("field archive_voter_breakdown of message initia.gov.v1.Params is not mutable"))
+	case "initia.gov.v1.Params.voter_breakdown_retention":
+		panic(fmt.Errorf("field voter_breakdown_retention of message initia.gov.v1.Params is not mutable"))
+	case "initia.gov.v1.Params.emergency_escalation_threshold":
+		panic(fmt.Errorf("field emergency_escalation_threshold of message initia.gov.v1.Params is not mutable"))
+	case "initia.gov.v1.Params.optimistic_rejection_threshold":
+		panic(fmt.Errorf("field optimistic_rejection_threshold of message initia.gov.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Params"))
@@ -873,6 +1430,35 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "initia.gov.v1.Params.vesting":
 		m := new(Vesting)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.gov.v1.Params.archive_voter_breakdown":
+		return protoreflect.ValueOfBool(false)
+	case "initia.gov.v1.Params.voter_breakdown_retention":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.gov.v1.Params.voting_power_providers":
+		list := []*VotingPowerProvider{}
+		return protoreflect.ValueOfList(&_Params_97_list{list: &list})
+	case "initia.gov.v1.Params.emergency_submitters":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_98_list{list: &list})
+	case "initia.gov.v1.Params.emergency_escalation_threshold":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.Params.emergency_escalation_window":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.gov.v1.Params.execution_delays":
+		list := []*ExecutionDelay{}
+		return protoreflect.ValueOfList(&_Params_101_list{list: &list})
+	case "initia.gov.v1.Params.execution_cancellers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_102_list{list: &list})
+	case "initia.gov.v1.Params.msg_type_params":
+		list := []*MsgTypeParams{}
+		return protoreflect.ValueOfList(&_Params_103_list{list: &list})
+	case "initia.gov.v1.Params.optimistic_msg_types":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_104_list{list: &list})
+	case "initia.gov.v1.Params.optimistic_rejection_threshold":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Params"))
@@ -1027,6 +1613,60 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Vesting)
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
+		if x.ArchiveVoterBreakdown {
+			n += 3
+		}
+		if x.VoterBreakdownRetention != 0 {
+			n += 2 + runtime.Sov(uint64(x.VoterBreakdownRetention))
+		}
+		if len(x.VotingPowerProviders) > 0 {
+			for _, e := range x.VotingPowerProviders {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.EmergencySubmitters) > 0 {
+			for _, s := range x.EmergencySubmitters {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.EmergencyEscalationThreshold)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.EmergencyEscalationWindow != nil {
+			l = options.Size(x.EmergencyEscalationWindow)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ExecutionDelays) > 0 {
+			for _, e := range x.ExecutionDelays {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ExecutionCancellers) > 0 {
+			for _, s := range x.ExecutionCancellers {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MsgTypeParams) > 0 {
+			for _, e := range x.MsgTypeParams {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.OptimisticMsgTypes) > 0 {
+			for _, s := range x.OptimisticMsgTypes {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.OptimisticRejectionThreshold)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1056,6 +1696,146 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.OptimisticRejectionThreshold) > 0 {
+			i -= len(x.OptimisticRejectionThreshold)
+			copy(dAtA[i:], x.OptimisticRejectionThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OptimisticRejectionThreshold)))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xca
+		}
+		if len(x.OptimisticMsgTypes) > 0 {
+			for iNdEx := len(x.OptimisticMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.OptimisticMsgTypes[iNdEx])
+				copy(dAtA[i:], x.OptimisticMsgTypes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OptimisticMsgTypes[iNdEx])))
+				i--
+				dAtA[i] = 0x6
+				i--
+				dAtA[i] = 0xc2
+			}
+		}
+		if len(x.MsgTypeParams) > 0 {
+			for iNdEx := len(x.MsgTypeParams) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MsgTypeParams[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6
+				i--
+				dAtA[i] = 0xba
+			}
+		}
+		if len(x.ExecutionCancellers) > 0 {
+			for iNdEx := len(x.ExecutionCancellers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ExecutionCancellers[iNdEx])
+				copy(dAtA[i:], x.ExecutionCancellers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExecutionCancellers[iNdEx])))
+				i--
+				dAtA[i] = 0x6
+				i--
+				dAtA[i] = 0xb2
+			}
+		}
+		if len(x.ExecutionDelays) > 0 {
+			for iNdEx := len(x.ExecutionDelays) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExecutionDelays[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6
+				i--
+				dAtA[i] = 0xaa
+			}
+		}
+		if x.EmergencyEscalationWindow != nil {
+			encoded, err := options.Marshal(x.EmergencyEscalationWindow)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xa2
+		}
+		if len(x.EmergencyEscalationThreshold) > 0 {
+			i -= len(x.EmergencyEscalationThreshold)
+			copy(dAtA[i:], x.EmergencyEscalationThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EmergencyEscalationThreshold)))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0x9a
+		}
+		if len(x.EmergencySubmitters) > 0 {
+			for iNdEx := len(x.EmergencySubmitters) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.EmergencySubmitters[iNdEx])
+				copy(dAtA[i:], x.EmergencySubmitters[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EmergencySubmitters[iNdEx])))
+				i--
+				dAtA[i] = 0x6
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if len(x.VotingPowerProviders) > 0 {
+			for iNdEx := len(x.VotingPowerProviders) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VotingPowerProviders[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if x.VoterBreakdownRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VoterBreakdownRetention))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.ArchiveVoterBreakdown {
+			i--
+			if x.ArchiveVoterBreakdown {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xf8
+		}
 		if x.Vesting != nil {
 			encoded, err := options.Marshal(x.Vesting)
 			if err != nil {
@@ -1958,66 +2738,4941 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 95:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ArchiveVoterBreakdown", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ArchiveVoterBreakdown = bool(v != 0)
+			case 96:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VoterBreakdownRetention", wireType)
+				}
+				x.VoterBreakdownRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VoterBreakdownRetention |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 97:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPowerProviders", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.VotingPowerProviders = append(x.VotingPowerProviders, &VotingPowerProvider{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPowerProviders[len(x.VotingPowerProviders)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_Vesting              protoreflect.MessageDescriptor
-	fd_Vesting_module_addr  protoreflect.FieldDescriptor
-	fd_Vesting_module_name  protoreflect.FieldDescriptor
+				iNdEx = postIndex
+			case 98:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmergencySubmitters", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EmergencySubmitters = append(x.EmergencySubmitters, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 99:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmergencyEscalationThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EmergencyEscalationThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 100:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmergencyEscalationWindow", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EmergencyEscalationWindow == nil {
+					x.EmergencyEscalationWindow = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EmergencyEscalationWindow); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 101:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelays", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExecutionDelays = append(x.ExecutionDelays, &ExecutionDelay{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecutionDelays[len(x.ExecutionDelays)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 102:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionCancellers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExecutionCancellers = append(x.ExecutionCancellers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 103:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgTypeParams = append(x.MsgTypeParams, &MsgTypeParams{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MsgTypeParams[len(x.MsgTypeParams)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 104:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptimisticMsgTypes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OptimisticMsgTypes = append(x.OptimisticMsgTypes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 105:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptimisticRejectionThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OptimisticRejectionThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgTypeParams_5_list)(nil)
+
+type _MsgTypeParams_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgTypeParams_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgTypeParams_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgTypeParams_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgTypeParams_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgTypeParams_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgTypeParams_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgTypeParams_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgTypeParams_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgTypeParams                protoreflect.MessageDescriptor
+	fd_MsgTypeParams_msg_type_url   protoreflect.FieldDescriptor
+	fd_MsgTypeParams_quorum         protoreflect.FieldDescriptor
+	fd_MsgTypeParams_threshold      protoreflect.FieldDescriptor
+	fd_MsgTypeParams_veto_threshold protoreflect.FieldDescriptor
+	fd_MsgTypeParams_min_deposit    protoreflect.FieldDescriptor
+	fd_MsgTypeParams_voting_period  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_gov_v1_gov_proto_init()
+	md_MsgTypeParams = File_initia_gov_v1_gov_proto.Messages().ByName("MsgTypeParams")
+	fd_MsgTypeParams_msg_type_url = md_MsgTypeParams.Fields().ByName("msg_type_url")
+	fd_MsgTypeParams_quorum = md_MsgTypeParams.Fields().ByName("quorum")
+	fd_MsgTypeParams_threshold = md_MsgTypeParams.Fields().ByName("threshold")
+	fd_MsgTypeParams_veto_threshold = md_MsgTypeParams.Fields().ByName("veto_threshold")
+	fd_MsgTypeParams_min_deposit = md_MsgTypeParams.Fields().ByName("min_deposit")
+	fd_MsgTypeParams_voting_period = md_MsgTypeParams.Fields().ByName("voting_period")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgTypeParams)(nil)
+
+type fastReflection_MsgTypeParams MsgTypeParams
+
+func (x *MsgTypeParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTypeParams)(x)
+}
+
+func (x *MsgTypeParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_gov_v1_gov_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgTypeParams_messageType fastReflection_MsgTypeParams_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTypeParams_messageType{}
+
+type fastReflection_MsgTypeParams_messageType struct{}
+
+func (x fastReflection_MsgTypeParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTypeParams)(nil)
+}
+func (x fastReflection_MsgTypeParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTypeParams)
+}
+func (x fastReflection_MsgTypeParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTypeParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgTypeParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTypeParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgTypeParams) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTypeParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgTypeParams) New() protoreflect.Message {
+	return new(fastReflection_MsgTypeParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgTypeParams) Interface() protoreflect.ProtoMessage {
+	return (*MsgTypeParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgTypeParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MsgTypeUrl != "" {
+		value := protoreflect.ValueOfString(x.MsgTypeUrl)
+		if !f(fd_MsgTypeParams_msg_type_url, value) {
+			return
+		}
+	}
+	if x.Quorum != "" {
+		value := protoreflect.ValueOfString(x.Quorum)
+		if !f(fd_MsgTypeParams_quorum, value) {
+			return
+		}
+	}
+	if x.Threshold != "" {
+		value := protoreflect.ValueOfString(x.Threshold)
+		if !f(fd_MsgTypeParams_threshold, value) {
+			return
+		}
+	}
+	if x.VetoThreshold != "" {
+		value := protoreflect.ValueOfString(x.VetoThreshold)
+		if !f(fd_MsgTypeParams_veto_threshold, value) {
+			return
+		}
+	}
+	if len(x.MinDeposit) != 0 {
+		value := protoreflect.ValueOfList(&_MsgTypeParams_5_list{list: &x.MinDeposit})
+		if !f(fd_MsgTypeParams_min_deposit, value) {
+			return
+		}
+	}
+	if x.VotingPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
+		if !f(fd_MsgTypeParams_voting_period, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgTypeParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.gov.v1.MsgTypeParams.msg_type_url":
+		return x.MsgTypeUrl != ""
+	case "initia.gov.v1.MsgTypeParams.quorum":
+		return x.Quorum != ""
+	case "initia.gov.v1.MsgTypeParams.threshold":
+		return x.Threshold != ""
+	case "initia.gov.v1.MsgTypeParams.veto_threshold":
+		return x.VetoThreshold != ""
+	case "initia.gov.v1.MsgTypeParams.min_deposit":
+		return len(x.MinDeposit) != 0
+	case "initia.gov.v1.MsgTypeParams.voting_period":
+		return x.VotingPeriod != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.MsgTypeParams"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.MsgTypeParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTypeParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.gov.v1.MsgTypeParams.msg_type_url":
+		x.MsgTypeUrl = ""
+	case "initia.gov.v1.MsgTypeParams.quorum":
+		x.Quorum = ""
+	case "initia.gov.v1.MsgTypeParams.threshold":
+		x.Threshold = ""
+	case "initia.gov.v1.MsgTypeParams.veto_threshold":
+		x.VetoThreshold = ""
+	case "initia.gov.v1.MsgTypeParams.min_deposit":
+		x.MinDeposit = nil
+	case "initia.gov.v1.MsgTypeParams.voting_period":
+		x.VotingPeriod = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.MsgTypeParams"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.MsgTypeParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgTypeParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.gov.v1.MsgTypeParams.msg_type_url":
+		value := x.MsgTypeUrl
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.MsgTypeParams.quorum":
+		value := x.Quorum
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.MsgTypeParams.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.MsgTypeParams.veto_threshold":
+		value := x.VetoThreshold
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.MsgTypeParams.min_deposit":
+		if len(x.MinDeposit) == 0 {
+			return protoreflect.ValueOfList(&_MsgTypeParams_5_list{})
+		}
+		listValue := &_MsgTypeParams_5_list{list: &x.MinDeposit}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.gov.v1.MsgTypeParams.voting_period":
+		value := x.VotingPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.MsgTypeParams"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.MsgTypeParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTypeParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.gov.v1.MsgTypeParams.msg_type_url":
+		x.MsgTypeUrl = value.Interface().(string)
+	case "initia.gov.v1.MsgTypeParams.quorum":
+		x.Quorum = value.Interface().(string)
+	case "initia.gov.v1.MsgTypeParams.threshold":
+		x.Threshold = value.Interface().(string)
+	case "initia.gov.v1.MsgTypeParams.veto_threshold":
+		x.VetoThreshold = value.Interface().(string)
+	case "initia.gov.v1.MsgTypeParams.min_deposit":
+		lv := value.List()
+		clv := lv.(*_MsgTypeParams_5_list)
+		x.MinDeposit = *clv.list
+	case "initia.gov.v1.MsgTypeParams.voting_period":
+		x.VotingPeriod = value.Message().Interface().(*durationpb.Duration)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.MsgTypeParams"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.MsgTypeParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTypeParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.MsgTypeParams.min_deposit":
+		if x.MinDeposit == nil {
+			x.MinDeposit = []*v1beta1.Coin{}
+		}
+		value := &_MsgTypeParams_5_list{list: &x.MinDeposit}
+		return protoreflect.ValueOfList(value)
+	case "initia.gov.v1.MsgTypeParams.voting_period":
+		if x.VotingPeriod == nil {
+			x.VotingPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
+	case "initia.gov.v1.MsgTypeParams.msg_type_url":
+		panic(fmt.Errorf("field msg_type_url of message initia.gov.v1.MsgTypeParams is not mutable"))
+	case "initia.gov.v1.MsgTypeParams.quorum":
+		panic(fmt.Errorf("field quorum of message initia.gov.v1.MsgTypeParams is not mutable"))
+	case "initia.gov.v1.MsgTypeParams.threshold":
+		panic(fmt.Errorf("field threshold of message initia.gov.v1.MsgTypeParams is not mutable"))
+	case "initia.gov.v1.MsgTypeParams.veto_threshold":
+		panic(fmt.Errorf("field veto_threshold of message initia.gov.v1.MsgTypeParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.MsgTypeParams"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.MsgTypeParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgTypeParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.MsgTypeParams.msg_type_url":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.MsgTypeParams.quorum":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.MsgTypeParams.threshold":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.MsgTypeParams.veto_threshold":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.MsgTypeParams.min_deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgTypeParams_5_list{list: &list})
+	case "initia.gov.v1.MsgTypeParams.voting_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.MsgTypeParams"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.MsgTypeParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgTypeParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.gov.v1.MsgTypeParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgTypeParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTypeParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgTypeParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgTypeParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgTypeParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MsgTypeUrl)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Quorum)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Threshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VetoThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.MinDeposit) > 0 {
+			for _, e := range x.MinDeposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.VotingPeriod != nil {
+			l = options.Size(x.VotingPeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTypeParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.VotingPeriod != nil {
+			encoded, err := options.Marshal(x.VotingPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.MinDeposit) > 0 {
+			for iNdEx := len(x.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinDeposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.VetoThreshold) > 0 {
+			i -= len(x.VetoThreshold)
+			copy(dAtA[i:], x.VetoThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VetoThreshold)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Threshold) > 0 {
+			i -= len(x.Threshold)
+			copy(dAtA[i:], x.Threshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Threshold)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Quorum) > 0 {
+			i -= len(x.Quorum)
+			copy(dAtA[i:], x.Quorum)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quorum)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MsgTypeUrl) > 0 {
+			i -= len(x.MsgTypeUrl)
+			copy(dAtA[i:], x.MsgTypeUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypeUrl)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTypeParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTypeParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTypeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Quorum = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Threshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VetoThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinDeposit = append(x.MinDeposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinDeposit[len(x.MinDeposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.VotingPeriod == nil {
+					x.VotingPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ExecutionDelay              protoreflect.MessageDescriptor
+	fd_ExecutionDelay_msg_type_url protoreflect.FieldDescriptor
+	fd_ExecutionDelay_delay        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_gov_v1_gov_proto_init()
+	md_ExecutionDelay = File_initia_gov_v1_gov_proto.Messages().ByName("ExecutionDelay")
+	fd_ExecutionDelay_msg_type_url = md_ExecutionDelay.Fields().ByName("msg_type_url")
+	fd_ExecutionDelay_delay = md_ExecutionDelay.Fields().ByName("delay")
+}
+
+var _ protoreflect.Message = (*fastReflection_ExecutionDelay)(nil)
+
+type fastReflection_ExecutionDelay ExecutionDelay
+
+func (x *ExecutionDelay) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ExecutionDelay)(x)
+}
+
+func (x *ExecutionDelay) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_gov_v1_gov_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ExecutionDelay_messageType fastReflection_ExecutionDelay_messageType
+var _ protoreflect.MessageType = fastReflection_ExecutionDelay_messageType{}
+
+type fastReflection_ExecutionDelay_messageType struct{}
+
+func (x fastReflection_ExecutionDelay_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ExecutionDelay)(nil)
+}
+func (x fastReflection_ExecutionDelay_messageType) New() protoreflect.Message {
+	return new(fastReflection_ExecutionDelay)
+}
+func (x fastReflection_ExecutionDelay_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExecutionDelay
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ExecutionDelay) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExecutionDelay
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ExecutionDelay) Type() protoreflect.MessageType {
+	return _fastReflection_ExecutionDelay_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ExecutionDelay) New() protoreflect.Message {
+	return new(fastReflection_ExecutionDelay)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ExecutionDelay) Interface() protoreflect.ProtoMessage {
+	return (*ExecutionDelay)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ExecutionDelay) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MsgTypeUrl != "" {
+		value := protoreflect.ValueOfString(x.MsgTypeUrl)
+		if !f(fd_ExecutionDelay_msg_type_url, value) {
+			return
+		}
+	}
+	if x.Delay != nil {
+		value := protoreflect.ValueOfMessage(x.Delay.ProtoReflect())
+		if !f(fd_ExecutionDelay_delay, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ExecutionDelay) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.gov.v1.ExecutionDelay.msg_type_url":
+		return x.MsgTypeUrl != ""
+	case "initia.gov.v1.ExecutionDelay.delay":
+		return x.Delay != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.ExecutionDelay"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.ExecutionDelay does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutionDelay) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.gov.v1.ExecutionDelay.msg_type_url":
+		x.MsgTypeUrl = ""
+	case "initia.gov.v1.ExecutionDelay.delay":
+		x.Delay = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.ExecutionDelay"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.ExecutionDelay does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ExecutionDelay) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.gov.v1.ExecutionDelay.msg_type_url":
+		value := x.MsgTypeUrl
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.ExecutionDelay.delay":
+		value := x.Delay
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.ExecutionDelay"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.ExecutionDelay does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutionDelay) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.gov.v1.ExecutionDelay.msg_type_url":
+		x.MsgTypeUrl = value.Interface().(string)
+	case "initia.gov.v1.ExecutionDelay.delay":
+		x.Delay = value.Message().Interface().(*durationpb.Duration)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.ExecutionDelay"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.ExecutionDelay does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutionDelay) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.ExecutionDelay.delay":
+		if x.Delay == nil {
+			x.Delay = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.Delay.ProtoReflect())
+	case "initia.gov.v1.ExecutionDelay.msg_type_url":
+		panic(fmt.Errorf("field msg_type_url of message initia.gov.v1.ExecutionDelay is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.ExecutionDelay"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.ExecutionDelay does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ExecutionDelay) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.ExecutionDelay.msg_type_url":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.ExecutionDelay.delay":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.ExecutionDelay"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.ExecutionDelay does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ExecutionDelay) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.gov.v1.ExecutionDelay", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ExecutionDelay) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutionDelay) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ExecutionDelay) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ExecutionDelay) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ExecutionDelay)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MsgTypeUrl)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Delay != nil {
+			l = options.Size(x.Delay)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ExecutionDelay)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Delay != nil {
+			encoded, err := options.Marshal(x.Delay)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MsgTypeUrl) > 0 {
+			i -= len(x.MsgTypeUrl)
+			copy(dAtA[i:], x.MsgTypeUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypeUrl)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ExecutionDelay)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExecutionDelay: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExecutionDelay: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Delay == nil {
+					x.Delay = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Delay); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_VotingPowerProvider               protoreflect.MessageDescriptor
+	fd_VotingPowerProvider_name          protoreflect.FieldDescriptor
+	fd_VotingPowerProvider_module_addr   protoreflect.FieldDescriptor
+	fd_VotingPowerProvider_module_name   protoreflect.FieldDescriptor
+	fd_VotingPowerProvider_function_name protoreflect.FieldDescriptor
+	fd_VotingPowerProvider_weight        protoreflect.FieldDescriptor
+	fd_VotingPowerProvider_gas_limit     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_gov_v1_gov_proto_init()
+	md_VotingPowerProvider = File_initia_gov_v1_gov_proto.Messages().ByName("VotingPowerProvider")
+	fd_VotingPowerProvider_name = md_VotingPowerProvider.Fields().ByName("name")
+	fd_VotingPowerProvider_module_addr = md_VotingPowerProvider.Fields().ByName("module_addr")
+	fd_VotingPowerProvider_module_name = md_VotingPowerProvider.Fields().ByName("module_name")
+	fd_VotingPowerProvider_function_name = md_VotingPowerProvider.Fields().ByName("function_name")
+	fd_VotingPowerProvider_weight = md_VotingPowerProvider.Fields().ByName("weight")
+	fd_VotingPowerProvider_gas_limit = md_VotingPowerProvider.Fields().ByName("gas_limit")
+}
+
+var _ protoreflect.Message = (*fastReflection_VotingPowerProvider)(nil)
+
+type fastReflection_VotingPowerProvider VotingPowerProvider
+
+func (x *VotingPowerProvider) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VotingPowerProvider)(x)
+}
+
+func (x *VotingPowerProvider) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_gov_v1_gov_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VotingPowerProvider_messageType fastReflection_VotingPowerProvider_messageType
+var _ protoreflect.MessageType = fastReflection_VotingPowerProvider_messageType{}
+
+type fastReflection_VotingPowerProvider_messageType struct{}
+
+func (x fastReflection_VotingPowerProvider_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VotingPowerProvider)(nil)
+}
+func (x fastReflection_VotingPowerProvider_messageType) New() protoreflect.Message {
+	return new(fastReflection_VotingPowerProvider)
+}
+func (x fastReflection_VotingPowerProvider_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VotingPowerProvider
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VotingPowerProvider) Descriptor() protoreflect.MessageDescriptor {
+	return md_VotingPowerProvider
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VotingPowerProvider) Type() protoreflect.MessageType {
+	return _fastReflection_VotingPowerProvider_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VotingPowerProvider) New() protoreflect.Message {
+	return new(fastReflection_VotingPowerProvider)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VotingPowerProvider) Interface() protoreflect.ProtoMessage {
+	return (*VotingPowerProvider)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VotingPowerProvider) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_VotingPowerProvider_name, value) {
+			return
+		}
+	}
+	if x.ModuleAddr != "" {
+		value := protoreflect.ValueOfString(x.ModuleAddr)
+		if !f(fd_VotingPowerProvider_module_addr, value) {
+			return
+		}
+	}
+	if x.ModuleName != "" {
+		value := protoreflect.ValueOfString(x.ModuleName)
+		if !f(fd_VotingPowerProvider_module_name, value) {
+			return
+		}
+	}
+	if x.FunctionName != "" {
+		value := protoreflect.ValueOfString(x.FunctionName)
+		if !f(fd_VotingPowerProvider_function_name, value) {
+			return
+		}
+	}
+	if x.Weight != "" {
+		value := protoreflect.ValueOfString(x.Weight)
+		if !f(fd_VotingPowerProvider_weight, value) {
+			return
+		}
+	}
+	if x.GasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasLimit)
+		if !f(fd_VotingPowerProvider_gas_limit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VotingPowerProvider) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.gov.v1.VotingPowerProvider.name":
+		return x.Name != ""
+	case "initia.gov.v1.VotingPowerProvider.module_addr":
+		return x.ModuleAddr != ""
+	case "initia.gov.v1.VotingPowerProvider.module_name":
+		return x.ModuleName != ""
+	case "initia.gov.v1.VotingPowerProvider.function_name":
+		return x.FunctionName != ""
+	case "initia.gov.v1.VotingPowerProvider.weight":
+		return x.Weight != ""
+	case "initia.gov.v1.VotingPowerProvider.gas_limit":
+		return x.GasLimit != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.VotingPowerProvider"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.VotingPowerProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingPowerProvider) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.gov.v1.VotingPowerProvider.name":
+		x.Name = ""
+	case "initia.gov.v1.VotingPowerProvider.module_addr":
+		x.ModuleAddr = ""
+	case "initia.gov.v1.VotingPowerProvider.module_name":
+		x.ModuleName = ""
+	case "initia.gov.v1.VotingPowerProvider.function_name":
+		x.FunctionName = ""
+	case "initia.gov.v1.VotingPowerProvider.weight":
+		x.Weight = ""
+	case "initia.gov.v1.VotingPowerProvider.gas_limit":
+		x.GasLimit = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.VotingPowerProvider"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.VotingPowerProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VotingPowerProvider) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.gov.v1.VotingPowerProvider.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.VotingPowerProvider.module_addr":
+		value := x.ModuleAddr
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.VotingPowerProvider.module_name":
+		value := x.ModuleName
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.VotingPowerProvider.function_name":
+		value := x.FunctionName
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.VotingPowerProvider.weight":
+		value := x.Weight
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.VotingPowerProvider.gas_limit":
+		value := x.GasLimit
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.VotingPowerProvider"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.VotingPowerProvider does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingPowerProvider) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.gov.v1.VotingPowerProvider.name":
+		x.Name = value.Interface().(string)
+	case "initia.gov.v1.VotingPowerProvider.module_addr":
+		x.ModuleAddr = value.Interface().(string)
+	case "initia.gov.v1.VotingPowerProvider.module_name":
+		x.ModuleName = value.Interface().(string)
+	case "initia.gov.v1.VotingPowerProvider.function_name":
+		x.FunctionName = value.Interface().(string)
+	case "initia.gov.v1.VotingPowerProvider.weight":
+		x.Weight = value.Interface().(string)
+	case "initia.gov.v1.VotingPowerProvider.gas_limit":
+		x.GasLimit = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.VotingPowerProvider"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.VotingPowerProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingPowerProvider) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.VotingPowerProvider.name":
+		panic(fmt.Errorf("field name of message initia.gov.v1.VotingPowerProvider is not mutable"))
+	case "initia.gov.v1.VotingPowerProvider.module_addr":
+		panic(fmt.Errorf("field module_addr of message initia.gov.v1.VotingPowerProvider is not mutable"))
+	case "initia.gov.v1.VotingPowerProvider.module_name":
+		panic(fmt.Errorf("field module_name of message initia.gov.v1.VotingPowerProvider is not mutable"))
+	case "initia.gov.v1.VotingPowerProvider.function_name":
+		panic(fmt.Errorf("field function_name of message initia.gov.v1.VotingPowerProvider is not mutable"))
+	case "initia.gov.v1.VotingPowerProvider.weight":
+		panic(fmt.Errorf("field weight of message initia.gov.v1.VotingPowerProvider is not mutable"))
+	case "initia.gov.v1.VotingPowerProvider.gas_limit":
+		panic(fmt.Errorf("field gas_limit of message initia.gov.v1.VotingPowerProvider is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.VotingPowerProvider"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.VotingPowerProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VotingPowerProvider) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.VotingPowerProvider.name":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.VotingPowerProvider.module_addr":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.VotingPowerProvider.module_name":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.VotingPowerProvider.function_name":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.VotingPowerProvider.weight":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.VotingPowerProvider.gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.VotingPowerProvider"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.VotingPowerProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VotingPowerProvider) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.gov.v1.VotingPowerProvider", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VotingPowerProvider) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingPowerProvider) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VotingPowerProvider) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VotingPowerProvider) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VotingPowerProvider)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ModuleAddr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ModuleName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FunctionName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Weight)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasLimit))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VotingPowerProvider)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.GasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasLimit))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Weight) > 0 {
+			i -= len(x.Weight)
+			copy(dAtA[i:], x.Weight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Weight)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.FunctionName) > 0 {
+			i -= len(x.FunctionName)
+			copy(dAtA[i:], x.FunctionName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FunctionName)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ModuleName) > 0 {
+			i -= len(x.ModuleName)
+			copy(dAtA[i:], x.ModuleName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleName)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ModuleAddr) > 0 {
+			i -= len(x.ModuleAddr)
+			copy(dAtA[i:], x.ModuleAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleAddr)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VotingPowerProvider)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VotingPowerProvider: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VotingPowerProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleAddr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleAddr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FunctionName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Weight = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				}
+				x.GasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MoveHook               protoreflect.MessageDescriptor
+	fd_MoveHook_module_addr   protoreflect.FieldDescriptor
+	fd_MoveHook_module_name   protoreflect.FieldDescriptor
+	fd_MoveHook_function_name protoreflect.FieldDescriptor
+	fd_MoveHook_gas_limit     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_gov_v1_gov_proto_init()
+	md_MoveHook = File_initia_gov_v1_gov_proto.Messages().ByName("MoveHook")
+	fd_MoveHook_module_addr = md_MoveHook.Fields().ByName("module_addr")
+	fd_MoveHook_module_name = md_MoveHook.Fields().ByName("module_name")
+	fd_MoveHook_function_name = md_MoveHook.Fields().ByName("function_name")
+	fd_MoveHook_gas_limit = md_MoveHook.Fields().ByName("gas_limit")
+}
+
+var _ protoreflect.Message = (*fastReflection_MoveHook)(nil)
+
+type fastReflection_MoveHook MoveHook
+
+func (x *MoveHook) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MoveHook)(x)
+}
+
+func (x *MoveHook) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_gov_v1_gov_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MoveHook_messageType fastReflection_MoveHook_messageType
+var _ protoreflect.MessageType = fastReflection_MoveHook_messageType{}
+
+type fastReflection_MoveHook_messageType struct{}
+
+func (x fastReflection_MoveHook_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MoveHook)(nil)
+}
+func (x fastReflection_MoveHook_messageType) New() protoreflect.Message {
+	return new(fastReflection_MoveHook)
+}
+func (x fastReflection_MoveHook_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MoveHook
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MoveHook) Descriptor() protoreflect.MessageDescriptor {
+	return md_MoveHook
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MoveHook) Type() protoreflect.MessageType {
+	return _fastReflection_MoveHook_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MoveHook) New() protoreflect.Message {
+	return new(fastReflection_MoveHook)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MoveHook) Interface() protoreflect.ProtoMessage {
+	return (*MoveHook)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MoveHook) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ModuleAddr != "" {
+		value := protoreflect.ValueOfString(x.ModuleAddr)
+		if !f(fd_MoveHook_module_addr, value) {
+			return
+		}
+	}
+	if x.ModuleName != "" {
+		value := protoreflect.ValueOfString(x.ModuleName)
+		if !f(fd_MoveHook_module_name, value) {
+			return
+		}
+	}
+	if x.FunctionName != "" {
+		value := protoreflect.ValueOfString(x.FunctionName)
+		if !f(fd_MoveHook_function_name, value) {
+			return
+		}
+	}
+	if x.GasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasLimit)
+		if !f(fd_MoveHook_gas_limit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MoveHook) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.gov.v1.MoveHook.module_addr":
+		return x.ModuleAddr != ""
+	case "initia.gov.v1.MoveHook.module_name":
+		return x.ModuleName != ""
+	case "initia.gov.v1.MoveHook.function_name":
+		return x.FunctionName != ""
+	case "initia.gov.v1.MoveHook.gas_limit":
+		return x.GasLimit != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.MoveHook"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.MoveHook does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MoveHook) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.gov.v1.MoveHook.module_addr":
+		x.ModuleAddr = ""
+	case "initia.gov.v1.MoveHook.module_name":
+		x.ModuleName = ""
+	case "initia.gov.v1.MoveHook.function_name":
+		x.FunctionName = ""
+	case "initia.gov.v1.MoveHook.gas_limit":
+		x.GasLimit = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.MoveHook"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.MoveHook does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MoveHook) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.gov.v1.MoveHook.module_addr":
+		value := x.ModuleAddr
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.MoveHook.module_name":
+		value := x.ModuleName
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.MoveHook.function_name":
+		value := x.FunctionName
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.MoveHook.gas_limit":
+		value := x.GasLimit
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.MoveHook"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.MoveHook does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MoveHook) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.gov.v1.MoveHook.module_addr":
+		x.ModuleAddr = value.Interface().(string)
+	case "initia.gov.v1.MoveHook.module_name":
+		x.ModuleName = value.Interface().(string)
+	case "initia.gov.v1.MoveHook.function_name":
+		x.FunctionName = value.Interface().(string)
+	case "initia.gov.v1.MoveHook.gas_limit":
+		x.GasLimit = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.MoveHook"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.MoveHook does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MoveHook) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.MoveHook.module_addr":
+		panic(fmt.Errorf("field module_addr of message initia.gov.v1.MoveHook is not mutable"))
+	case "initia.gov.v1.MoveHook.module_name":
+		panic(fmt.Errorf("field module_name of message initia.gov.v1.MoveHook is not mutable"))
+	case "initia.gov.v1.MoveHook.function_name":
+		panic(fmt.Errorf("field function_name of message initia.gov.v1.MoveHook is not mutable"))
+	case "initia.gov.v1.MoveHook.gas_limit":
+		panic(fmt.Errorf("field gas_limit of message initia.gov.v1.MoveHook is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.MoveHook"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.MoveHook does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MoveHook) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.MoveHook.module_addr":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.MoveHook.module_name":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.MoveHook.function_name":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.MoveHook.gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.MoveHook"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.MoveHook does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MoveHook) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.gov.v1.MoveHook", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MoveHook) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MoveHook) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MoveHook) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MoveHook) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MoveHook)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ModuleAddr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ModuleName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FunctionName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasLimit))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MoveHook)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.GasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasLimit))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.FunctionName) > 0 {
+			i -= len(x.FunctionName)
+			copy(dAtA[i:], x.FunctionName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FunctionName)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ModuleName) > 0 {
+			i -= len(x.ModuleName)
+			copy(dAtA[i:], x.ModuleName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleName)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ModuleAddr) > 0 {
+			i -= len(x.ModuleAddr)
+			copy(dAtA[i:], x.ModuleAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleAddr)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MoveHook)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MoveHook: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MoveHook: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleAddr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleAddr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FunctionName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				}
+				x.GasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Vesting              protoreflect.MessageDescriptor
+	fd_Vesting_module_addr  protoreflect.FieldDescriptor
+	fd_Vesting_module_name  protoreflect.FieldDescriptor
 	fd_Vesting_creator_addr protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_initia_gov_v1_gov_proto_init()
-	md_Vesting = File_initia_gov_v1_gov_proto.Messages().ByName("Vesting")
-	fd_Vesting_module_addr = md_Vesting.Fields().ByName("module_addr")
-	fd_Vesting_module_name = md_Vesting.Fields().ByName("module_name")
-	fd_Vesting_creator_addr = md_Vesting.Fields().ByName("creator_addr")
+	md_Vesting = File_initia_gov_v1_gov_proto.Messages().ByName("Vesting")
+	fd_Vesting_module_addr = md_Vesting.Fields().ByName("module_addr")
+	fd_Vesting_module_name = md_Vesting.Fields().ByName("module_name")
+	fd_Vesting_creator_addr = md_Vesting.Fields().ByName("creator_addr")
+}
+
+var _ protoreflect.Message = (*fastReflection_Vesting)(nil)
+
+type fastReflection_Vesting Vesting
+
+func (x *Vesting) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Vesting)(x)
+}
+
+func (x *Vesting) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_gov_v1_gov_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Vesting_messageType fastReflection_Vesting_messageType
+var _ protoreflect.MessageType = fastReflection_Vesting_messageType{}
+
+type fastReflection_Vesting_messageType struct{}
+
+func (x fastReflection_Vesting_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Vesting)(nil)
+}
+func (x fastReflection_Vesting_messageType) New() protoreflect.Message {
+	return new(fastReflection_Vesting)
+}
+func (x fastReflection_Vesting_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Vesting
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Vesting) Descriptor() protoreflect.MessageDescriptor {
+	return md_Vesting
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Vesting) Type() protoreflect.MessageType {
+	return _fastReflection_Vesting_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Vesting) New() protoreflect.Message {
+	return new(fastReflection_Vesting)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Vesting) Interface() protoreflect.ProtoMessage {
+	return (*Vesting)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Vesting) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ModuleAddr != "" {
+		value := protoreflect.ValueOfString(x.ModuleAddr)
+		if !f(fd_Vesting_module_addr, value) {
+			return
+		}
+	}
+	if x.ModuleName != "" {
+		value := protoreflect.ValueOfString(x.ModuleName)
+		if !f(fd_Vesting_module_name, value) {
+			return
+		}
+	}
+	if x.CreatorAddr != "" {
+		value := protoreflect.ValueOfString(x.CreatorAddr)
+		if !f(fd_Vesting_creator_addr, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Vesting) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.gov.v1.Vesting.module_addr":
+		return x.ModuleAddr != ""
+	case "initia.gov.v1.Vesting.module_name":
+		return x.ModuleName != ""
+	case "initia.gov.v1.Vesting.creator_addr":
+		return x.CreatorAddr != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Vesting"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.Vesting does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Vesting) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.gov.v1.Vesting.module_addr":
+		x.ModuleAddr = ""
+	case "initia.gov.v1.Vesting.module_name":
+		x.ModuleName = ""
+	case "initia.gov.v1.Vesting.creator_addr":
+		x.CreatorAddr = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Vesting"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.Vesting does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Vesting) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.gov.v1.Vesting.module_addr":
+		value := x.ModuleAddr
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.Vesting.module_name":
+		value := x.ModuleName
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.Vesting.creator_addr":
+		value := x.CreatorAddr
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Vesting"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.Vesting does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Vesting) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.gov.v1.Vesting.module_addr":
+		x.ModuleAddr = value.Interface().(string)
+	case "initia.gov.v1.Vesting.module_name":
+		x.ModuleName = value.Interface().(string)
+	case "initia.gov.v1.Vesting.creator_addr":
+		x.CreatorAddr = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Vesting"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.Vesting does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Vesting) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.Vesting.module_addr":
+		panic(fmt.Errorf("field module_addr of message initia.gov.v1.Vesting is not mutable"))
+	case "initia.gov.v1.Vesting.module_name":
+		panic(fmt.Errorf("field module_name of message initia.gov.v1.Vesting is not mutable"))
+	case "initia.gov.v1.Vesting.creator_addr":
+		panic(fmt.Errorf("field creator_addr of message initia.gov.v1.Vesting is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Vesting"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.Vesting does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Vesting) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.Vesting.module_addr":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.Vesting.module_name":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.Vesting.creator_addr":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Vesting"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.Vesting does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Vesting) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.gov.v1.Vesting", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Vesting) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Vesting) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Vesting) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Vesting) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Vesting)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ModuleAddr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ModuleName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CreatorAddr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Vesting)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.CreatorAddr) > 0 {
+			i -= len(x.CreatorAddr)
+			copy(dAtA[i:], x.CreatorAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CreatorAddr)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ModuleName) > 0 {
+			i -= len(x.ModuleName)
+			copy(dAtA[i:], x.ModuleName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleName)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ModuleAddr) > 0 {
+			i -= len(x.ModuleAddr)
+			copy(dAtA[i:], x.ModuleAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleAddr)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Vesting)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Vesting: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Vesting: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleAddr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleAddr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatorAddr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CreatorAddr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TallyResult_5_list)(nil)
+
+type _TallyResult_5_list struct {
+	list *[]*ProviderVotingPower
+}
+
+func (x *_TallyResult_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TallyResult_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TallyResult_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProviderVotingPower)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TallyResult_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProviderVotingPower)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TallyResult_5_list) AppendMutable() protoreflect.Value {
+	v := new(ProviderVotingPower)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TallyResult_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TallyResult_5_list) NewElement() protoreflect.Value {
+	v := new(ProviderVotingPower)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TallyResult_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TallyResult                         protoreflect.MessageDescriptor
+	fd_TallyResult_tally_height            protoreflect.FieldDescriptor
+	fd_TallyResult_total_staking_power     protoreflect.FieldDescriptor
+	fd_TallyResult_total_vesting_power     protoreflect.FieldDescriptor
+	fd_TallyResult_v1_tally_result         protoreflect.FieldDescriptor
+	fd_TallyResult_provider_powers         protoreflect.FieldDescriptor
+	fd_TallyResult_provider_omitted_voters protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_gov_v1_gov_proto_init()
+	md_TallyResult = File_initia_gov_v1_gov_proto.Messages().ByName("TallyResult")
+	fd_TallyResult_tally_height = md_TallyResult.Fields().ByName("tally_height")
+	fd_TallyResult_total_staking_power = md_TallyResult.Fields().ByName("total_staking_power")
+	fd_TallyResult_total_vesting_power = md_TallyResult.Fields().ByName("total_vesting_power")
+	fd_TallyResult_v1_tally_result = md_TallyResult.Fields().ByName("v1_tally_result")
+	fd_TallyResult_provider_powers = md_TallyResult.Fields().ByName("provider_powers")
+	fd_TallyResult_provider_omitted_voters = md_TallyResult.Fields().ByName("provider_omitted_voters")
+}
+
+var _ protoreflect.Message = (*fastReflection_TallyResult)(nil)
+
+type fastReflection_TallyResult TallyResult
+
+func (x *TallyResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TallyResult)(x)
+}
+
+func (x *TallyResult) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_gov_v1_gov_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TallyResult_messageType fastReflection_TallyResult_messageType
+var _ protoreflect.MessageType = fastReflection_TallyResult_messageType{}
+
+type fastReflection_TallyResult_messageType struct{}
+
+func (x fastReflection_TallyResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TallyResult)(nil)
+}
+func (x fastReflection_TallyResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_TallyResult)
+}
+func (x fastReflection_TallyResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TallyResult
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TallyResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_TallyResult
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TallyResult) Type() protoreflect.MessageType {
+	return _fastReflection_TallyResult_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TallyResult) New() protoreflect.Message {
+	return new(fastReflection_TallyResult)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TallyResult) Interface() protoreflect.ProtoMessage {
+	return (*TallyResult)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TallyResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TallyHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TallyHeight)
+		if !f(fd_TallyResult_tally_height, value) {
+			return
+		}
+	}
+	if x.TotalStakingPower != "" {
+		value := protoreflect.ValueOfString(x.TotalStakingPower)
+		if !f(fd_TallyResult_total_staking_power, value) {
+			return
+		}
+	}
+	if x.TotalVestingPower != "" {
+		value := protoreflect.ValueOfString(x.TotalVestingPower)
+		if !f(fd_TallyResult_total_vesting_power, value) {
+			return
+		}
+	}
+	if x.V1TallyResult != nil {
+		value := protoreflect.ValueOfMessage(x.V1TallyResult.ProtoReflect())
+		if !f(fd_TallyResult_v1_tally_result, value) {
+			return
+		}
+	}
+	if len(x.ProviderPowers) != 0 {
+		value := protoreflect.ValueOfList(&_TallyResult_5_list{list: &x.ProviderPowers})
+		if !f(fd_TallyResult_provider_powers, value) {
+			return
+		}
+	}
+	if x.ProviderOmittedVoters != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProviderOmittedVoters)
+		if !f(fd_TallyResult_provider_omitted_voters, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TallyResult) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.gov.v1.TallyResult.tally_height":
+		return x.TallyHeight != uint64(0)
+	case "initia.gov.v1.TallyResult.total_staking_power":
+		return x.TotalStakingPower != ""
+	case "initia.gov.v1.TallyResult.total_vesting_power":
+		return x.TotalVestingPower != ""
+	case "initia.gov.v1.TallyResult.v1_tally_result":
+		return x.V1TallyResult != nil
+	case "initia.gov.v1.TallyResult.provider_powers":
+		return len(x.ProviderPowers) != 0
+	case "initia.gov.v1.TallyResult.provider_omitted_voters":
+		return x.ProviderOmittedVoters != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.TallyResult"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TallyResult) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.gov.v1.TallyResult.tally_height":
+		x.TallyHeight = uint64(0)
+	case "initia.gov.v1.TallyResult.total_staking_power":
+		x.TotalStakingPower = ""
+	case "initia.gov.v1.TallyResult.total_vesting_power":
+		x.TotalVestingPower = ""
+	case "initia.gov.v1.TallyResult.v1_tally_result":
+		x.V1TallyResult = nil
+	case "initia.gov.v1.TallyResult.provider_powers":
+		x.ProviderPowers = nil
+	case "initia.gov.v1.TallyResult.provider_omitted_voters":
+		x.ProviderOmittedVoters = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.TallyResult"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TallyResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.gov.v1.TallyResult.tally_height":
+		value := x.TallyHeight
+		return protoreflect.ValueOfUint64(value)
+	case "initia.gov.v1.TallyResult.total_staking_power":
+		value := x.TotalStakingPower
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.TallyResult.total_vesting_power":
+		value := x.TotalVestingPower
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.TallyResult.v1_tally_result":
+		value := x.V1TallyResult
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.gov.v1.TallyResult.provider_powers":
+		if len(x.ProviderPowers) == 0 {
+			return protoreflect.ValueOfList(&_TallyResult_5_list{})
+		}
+		listValue := &_TallyResult_5_list{list: &x.ProviderPowers}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.gov.v1.TallyResult.provider_omitted_voters":
+		value := x.ProviderOmittedVoters
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.TallyResult"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.TallyResult does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TallyResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.gov.v1.TallyResult.tally_height":
+		x.TallyHeight = value.Uint()
+	case "initia.gov.v1.TallyResult.total_staking_power":
+		x.TotalStakingPower = value.Interface().(string)
+	case "initia.gov.v1.TallyResult.total_vesting_power":
+		x.TotalVestingPower = value.Interface().(string)
+	case "initia.gov.v1.TallyResult.v1_tally_result":
+		x.V1TallyResult = value.Message().Interface().(*v1.TallyResult)
+	case "initia.gov.v1.TallyResult.provider_powers":
+		lv := value.List()
+		clv := lv.(*_TallyResult_5_list)
+		x.ProviderPowers = *clv.list
+	case "initia.gov.v1.TallyResult.provider_omitted_voters":
+		x.ProviderOmittedVoters = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.TallyResult"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TallyResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.TallyResult.v1_tally_result":
+		if x.V1TallyResult == nil {
+			x.V1TallyResult = new(v1.TallyResult)
+		}
+		return protoreflect.ValueOfMessage(x.V1TallyResult.ProtoReflect())
+	case "initia.gov.v1.TallyResult.provider_powers":
+		if x.ProviderPowers == nil {
+			x.ProviderPowers = []*ProviderVotingPower{}
+		}
+		value := &_TallyResult_5_list{list: &x.ProviderPowers}
+		return protoreflect.ValueOfList(value)
+	case "initia.gov.v1.TallyResult.tally_height":
+		panic(fmt.Errorf("field tally_height of message initia.gov.v1.TallyResult is not mutable"))
+	case "initia.gov.v1.TallyResult.total_staking_power":
+		panic(fmt.Errorf("field total_staking_power of message initia.gov.v1.TallyResult is not mutable"))
+	case "initia.gov.v1.TallyResult.total_vesting_power":
+		panic(fmt.Errorf("field total_vesting_power of message initia.gov.v1.TallyResult is not mutable"))
+	case "initia.gov.v1.TallyResult.provider_omitted_voters":
+		panic(fmt.Errorf("field provider_omitted_voters of message initia.gov.v1.TallyResult is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.TallyResult"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TallyResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.TallyResult.tally_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.gov.v1.TallyResult.total_staking_power":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.TallyResult.total_vesting_power":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.TallyResult.v1_tally_result":
+		m := new(v1.TallyResult)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.gov.v1.TallyResult.provider_powers":
+		list := []*ProviderVotingPower{}
+		return protoreflect.ValueOfList(&_TallyResult_5_list{list: &list})
+	case "initia.gov.v1.TallyResult.provider_omitted_voters":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.TallyResult"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TallyResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.gov.v1.TallyResult", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TallyResult) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TallyResult) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TallyResult) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TallyResult)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TallyHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.TallyHeight))
+		}
+		l = len(x.TotalStakingPower)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TotalVestingPower)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.V1TallyResult != nil {
+			l = options.Size(x.V1TallyResult)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ProviderPowers) > 0 {
+			for _, e := range x.ProviderPowers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ProviderOmittedVoters != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProviderOmittedVoters))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TallyResult)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ProviderOmittedVoters != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProviderOmittedVoters))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.ProviderPowers) > 0 {
+			for iNdEx := len(x.ProviderPowers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ProviderPowers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.V1TallyResult != nil {
+			encoded, err := options.Marshal(x.V1TallyResult)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.TotalVestingPower) > 0 {
+			i -= len(x.TotalVestingPower)
+			copy(dAtA[i:], x.TotalVestingPower)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalVestingPower)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TotalStakingPower) > 0 {
+			i -= len(x.TotalStakingPower)
+			copy(dAtA[i:], x.TotalStakingPower)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalStakingPower)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TallyHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TallyHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TallyResult)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TallyHeight", wireType)
+				}
+				x.TallyHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TallyHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalStakingPower", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalStakingPower = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalVestingPower", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalVestingPower = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field V1TallyResult", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.V1TallyResult == nil {
+					x.V1TallyResult = &v1.TallyResult{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.V1TallyResult); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderPowers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProviderPowers = append(x.ProviderPowers, &ProviderVotingPower{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProviderPowers[len(x.ProviderPowers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderOmittedVoters", wireType)
+				}
+				x.ProviderOmittedVoters = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProviderOmittedVoters |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ProviderVotingPower              protoreflect.MessageDescriptor
+	fd_ProviderVotingPower_name         protoreflect.FieldDescriptor
+	fd_ProviderVotingPower_voting_power protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_gov_v1_gov_proto_init()
+	md_ProviderVotingPower = File_initia_gov_v1_gov_proto.Messages().ByName("ProviderVotingPower")
+	fd_ProviderVotingPower_name = md_ProviderVotingPower.Fields().ByName("name")
+	fd_ProviderVotingPower_voting_power = md_ProviderVotingPower.Fields().ByName("voting_power")
+}
+
+var _ protoreflect.Message = (*fastReflection_ProviderVotingPower)(nil)
+
+type fastReflection_ProviderVotingPower ProviderVotingPower
+
+func (x *ProviderVotingPower) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProviderVotingPower)(x)
+}
+
+func (x *ProviderVotingPower) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_gov_v1_gov_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ProviderVotingPower_messageType fastReflection_ProviderVotingPower_messageType
+var _ protoreflect.MessageType = fastReflection_ProviderVotingPower_messageType{}
+
+type fastReflection_ProviderVotingPower_messageType struct{}
+
+func (x fastReflection_ProviderVotingPower_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProviderVotingPower)(nil)
+}
+func (x fastReflection_ProviderVotingPower_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProviderVotingPower)
+}
+func (x fastReflection_ProviderVotingPower_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProviderVotingPower
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ProviderVotingPower) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProviderVotingPower
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ProviderVotingPower) Type() protoreflect.MessageType {
+	return _fastReflection_ProviderVotingPower_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ProviderVotingPower) New() protoreflect.Message {
+	return new(fastReflection_ProviderVotingPower)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ProviderVotingPower) Interface() protoreflect.ProtoMessage {
+	return (*ProviderVotingPower)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ProviderVotingPower) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_ProviderVotingPower_name, value) {
+			return
+		}
+	}
+	if x.VotingPower != "" {
+		value := protoreflect.ValueOfString(x.VotingPower)
+		if !f(fd_ProviderVotingPower_voting_power, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ProviderVotingPower) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.gov.v1.ProviderVotingPower.name":
+		return x.Name != ""
+	case "initia.gov.v1.ProviderVotingPower.voting_power":
+		return x.VotingPower != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.ProviderVotingPower"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.ProviderVotingPower does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderVotingPower) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.gov.v1.ProviderVotingPower.name":
+		x.Name = ""
+	case "initia.gov.v1.ProviderVotingPower.voting_power":
+		x.VotingPower = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.ProviderVotingPower"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.ProviderVotingPower does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ProviderVotingPower) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.gov.v1.ProviderVotingPower.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.ProviderVotingPower.voting_power":
+		value := x.VotingPower
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.ProviderVotingPower"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.ProviderVotingPower does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderVotingPower) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.gov.v1.ProviderVotingPower.name":
+		x.Name = value.Interface().(string)
+	case "initia.gov.v1.ProviderVotingPower.voting_power":
+		x.VotingPower = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.ProviderVotingPower"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.ProviderVotingPower does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderVotingPower) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.ProviderVotingPower.name":
+		panic(fmt.Errorf("field name of message initia.gov.v1.ProviderVotingPower is not mutable"))
+	case "initia.gov.v1.ProviderVotingPower.voting_power":
+		panic(fmt.Errorf("field voting_power of message initia.gov.v1.ProviderVotingPower is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.ProviderVotingPower"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.ProviderVotingPower does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ProviderVotingPower) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.ProviderVotingPower.name":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.ProviderVotingPower.voting_power":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.ProviderVotingPower"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.ProviderVotingPower does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ProviderVotingPower) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.gov.v1.ProviderVotingPower", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ProviderVotingPower) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderVotingPower) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ProviderVotingPower) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ProviderVotingPower) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ProviderVotingPower)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VotingPower)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ProviderVotingPower)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.VotingPower) > 0 {
+			i -= len(x.VotingPower)
+			copy(dAtA[i:], x.VotingPower)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VotingPower)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ProviderVotingPower)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProviderVotingPower: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProviderVotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VotingPower = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_VoterBreakdown_3_list)(nil)
+
+type _VoterBreakdown_3_list struct {
+	list *[]*v1.WeightedVoteOption
+}
+
+func (x *_VoterBreakdown_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VoterBreakdown_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_VoterBreakdown_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1.WeightedVoteOption)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VoterBreakdown_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1.WeightedVoteOption)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VoterBreakdown_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1.WeightedVoteOption)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VoterBreakdown_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VoterBreakdown_3_list) NewElement() protoreflect.Value {
+	v := new(v1.WeightedVoteOption)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VoterBreakdown_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_VoterBreakdown                 protoreflect.MessageDescriptor
+	fd_VoterBreakdown_proposal_id     protoreflect.FieldDescriptor
+	fd_VoterBreakdown_voter           protoreflect.FieldDescriptor
+	fd_VoterBreakdown_options         protoreflect.FieldDescriptor
+	fd_VoterBreakdown_delegate        protoreflect.FieldDescriptor
+	fd_VoterBreakdown_staking_power   protoreflect.FieldDescriptor
+	fd_VoterBreakdown_vesting_power   protoreflect.FieldDescriptor
+	fd_VoterBreakdown_inherited_power protoreflect.FieldDescriptor
+	fd_VoterBreakdown_provider_power  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_gov_v1_gov_proto_init()
+	md_VoterBreakdown = File_initia_gov_v1_gov_proto.Messages().ByName("VoterBreakdown")
+	fd_VoterBreakdown_proposal_id = md_VoterBreakdown.Fields().ByName("proposal_id")
+	fd_VoterBreakdown_voter = md_VoterBreakdown.Fields().ByName("voter")
+	fd_VoterBreakdown_options = md_VoterBreakdown.Fields().ByName("options")
+	fd_VoterBreakdown_delegate = md_VoterBreakdown.Fields().ByName("delegate")
+	fd_VoterBreakdown_staking_power = md_VoterBreakdown.Fields().ByName("staking_power")
+	fd_VoterBreakdown_vesting_power = md_VoterBreakdown.Fields().ByName("vesting_power")
+	fd_VoterBreakdown_inherited_power = md_VoterBreakdown.Fields().ByName("inherited_power")
+	fd_VoterBreakdown_provider_power = md_VoterBreakdown.Fields().ByName("provider_power")
 }
 
-var _ protoreflect.Message = (*fastReflection_Vesting)(nil)
+var _ protoreflect.Message = (*fastReflection_VoterBreakdown)(nil)
 
-type fastReflection_Vesting Vesting
+type fastReflection_VoterBreakdown VoterBreakdown
 
-func (x *Vesting) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Vesting)(x)
+func (x *VoterBreakdown) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VoterBreakdown)(x)
 }
 
-func (x *Vesting) slowProtoReflect() protoreflect.Message {
-	mi := &file_initia_gov_v1_gov_proto_msgTypes[1]
+func (x *VoterBreakdown) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_gov_v1_gov_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2028,43 +7683,43 @@ func (x *Vesting) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Vesting_messageType fastReflection_Vesting_messageType
-var _ protoreflect.MessageType = fastReflection_Vesting_messageType{}
+var _fastReflection_VoterBreakdown_messageType fastReflection_VoterBreakdown_messageType
+var _ protoreflect.MessageType = fastReflection_VoterBreakdown_messageType{}
 
-type fastReflection_Vesting_messageType struct{}
+type fastReflection_VoterBreakdown_messageType struct{}
 
-func (x fastReflection_Vesting_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Vesting)(nil)
+func (x fastReflection_VoterBreakdown_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VoterBreakdown)(nil)
 }
-func (x fastReflection_Vesting_messageType) New() protoreflect.Message {
-	return new(fastReflection_Vesting)
+func (x fastReflection_VoterBreakdown_messageType) New() protoreflect.Message {
+	return new(fastReflection_VoterBreakdown)
 }
-func (x fastReflection_Vesting_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Vesting
+func (x fastReflection_VoterBreakdown_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VoterBreakdown
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Vesting) Descriptor() protoreflect.MessageDescriptor {
-	return md_Vesting
+func (x *fastReflection_VoterBreakdown) Descriptor() protoreflect.MessageDescriptor {
+	return md_VoterBreakdown
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Vesting) Type() protoreflect.MessageType {
-	return _fastReflection_Vesting_messageType
+func (x *fastReflection_VoterBreakdown) Type() protoreflect.MessageType {
+	return _fastReflection_VoterBreakdown_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Vesting) New() protoreflect.Message {
-	return new(fastReflection_Vesting)
+func (x *fastReflection_VoterBreakdown) New() protoreflect.Message {
+	return new(fastReflection_VoterBreakdown)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Vesting) Interface() protoreflect.ProtoMessage {
-	return (*Vesting)(x)
+func (x *fastReflection_VoterBreakdown) Interface() protoreflect.ProtoMessage {
+	return (*VoterBreakdown)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2072,22 +7727,52 @@ func (x *fastReflection_Vesting) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Vesting) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ModuleAddr != "" {
-		value := protoreflect.ValueOfString(x.ModuleAddr)
-		if !f(fd_Vesting_module_addr, value) {
+func (x *fastReflection_VoterBreakdown) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_VoterBreakdown_proposal_id, value) {
 			return
 		}
 	}
-	if x.ModuleName != "" {
-		value := protoreflect.ValueOfString(x.ModuleName)
-		if !f(fd_Vesting_module_name, value) {
+	if x.Voter != "" {
+		value := protoreflect.ValueOfString(x.Voter)
+		if !f(fd_VoterBreakdown_voter, value) {
 			return
 		}
 	}
-	if x.CreatorAddr != "" {
-		value := protoreflect.ValueOfString(x.CreatorAddr)
-		if !f(fd_Vesting_creator_addr, value) {
+	if len(x.Options) != 0 {
+		value := protoreflect.ValueOfList(&_VoterBreakdown_3_list{list: &x.Options})
+		if !f(fd_VoterBreakdown_options, value) {
+			return
+		}
+	}
+	if x.Delegate != "" {
+		value := protoreflect.ValueOfString(x.Delegate)
+		if !f(fd_VoterBreakdown_delegate, value) {
+			return
+		}
+	}
+	if x.StakingPower != "" {
+		value := protoreflect.ValueOfString(x.StakingPower)
+		if !f(fd_VoterBreakdown_staking_power, value) {
+			return
+		}
+	}
+	if x.VestingPower != "" {
+		value := protoreflect.ValueOfString(x.VestingPower)
+		if !f(fd_VoterBreakdown_vesting_power, value) {
+			return
+		}
+	}
+	if x.InheritedPower != "" {
+		value := protoreflect.ValueOfString(x.InheritedPower)
+		if !f(fd_VoterBreakdown_inherited_power, value) {
+			return
+		}
+	}
+	if x.ProviderPower != "" {
+		value := protoreflect.ValueOfString(x.ProviderPower)
+		if !f(fd_VoterBreakdown_provider_power, value) {
 			return
 		}
 	}
@@ -2104,19 +7789,29 @@ func (x *fastReflection_Vesting) Range(f func(protoreflect.FieldDescriptor, prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Vesting) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_VoterBreakdown) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "initia.gov.v1.Vesting.module_addr":
-		return x.ModuleAddr != ""
-	case "initia.gov.v1.Vesting.module_name":
-		return x.ModuleName != ""
-	case "initia.gov.v1.Vesting.creator_addr":
-		return x.CreatorAddr != ""
+	case "initia.gov.v1.VoterBreakdown.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "initia.gov.v1.VoterBreakdown.voter":
+		return x.Voter != ""
+	case "initia.gov.v1.VoterBreakdown.options":
+		return len(x.Options) != 0
+	case "initia.gov.v1.VoterBreakdown.delegate":
+		return x.Delegate != ""
+	case "initia.gov.v1.VoterBreakdown.staking_power":
+		return x.StakingPower != ""
+	case "initia.gov.v1.VoterBreakdown.vesting_power":
+		return x.VestingPower != ""
+	case "initia.gov.v1.VoterBreakdown.inherited_power":
+		return x.InheritedPower != ""
+	case "initia.gov.v1.VoterBreakdown.provider_power":
+		return x.ProviderPower != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Vesting"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.VoterBreakdown"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.Vesting does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.VoterBreakdown does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2126,19 +7821,29 @@ func (x *fastReflection_Vesting) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Vesting) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_VoterBreakdown) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "initia.gov.v1.Vesting.module_addr":
-		x.ModuleAddr = ""
-	case "initia.gov.v1.Vesting.module_name":
-		x.ModuleName = ""
-	case "initia.gov.v1.Vesting.creator_addr":
-		x.CreatorAddr = ""
+	case "initia.gov.v1.VoterBreakdown.proposal_id":
+		x.ProposalId = uint64(0)
+	case "initia.gov.v1.VoterBreakdown.voter":
+		x.Voter = ""
+	case "initia.gov.v1.VoterBreakdown.options":
+		x.Options = nil
+	case "initia.gov.v1.VoterBreakdown.delegate":
+		x.Delegate = ""
+	case "initia.gov.v1.VoterBreakdown.staking_power":
+		x.StakingPower = ""
+	case "initia.gov.v1.VoterBreakdown.vesting_power":
+		x.VestingPower = ""
+	case "initia.gov.v1.VoterBreakdown.inherited_power":
+		x.InheritedPower = ""
+	case "initia.gov.v1.VoterBreakdown.provider_power":
+		x.ProviderPower = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Vesting"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.VoterBreakdown"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.Vesting does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.VoterBreakdown does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2148,22 +7853,40 @@ func (x *fastReflection_Vesting) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Vesting) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VoterBreakdown) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "initia.gov.v1.Vesting.module_addr":
-		value := x.ModuleAddr
+	case "initia.gov.v1.VoterBreakdown.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "initia.gov.v1.VoterBreakdown.voter":
+		value := x.Voter
 		return protoreflect.ValueOfString(value)
-	case "initia.gov.v1.Vesting.module_name":
-		value := x.ModuleName
+	case "initia.gov.v1.VoterBreakdown.options":
+		if len(x.Options) == 0 {
+			return protoreflect.ValueOfList(&_VoterBreakdown_3_list{})
+		}
+		listValue := &_VoterBreakdown_3_list{list: &x.Options}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.gov.v1.VoterBreakdown.delegate":
+		value := x.Delegate
 		return protoreflect.ValueOfString(value)
-	case "initia.gov.v1.Vesting.creator_addr":
-		value := x.CreatorAddr
+	case "initia.gov.v1.VoterBreakdown.staking_power":
+		value := x.StakingPower
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.VoterBreakdown.vesting_power":
+		value := x.VestingPower
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.VoterBreakdown.inherited_power":
+		value := x.InheritedPower
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.VoterBreakdown.provider_power":
+		value := x.ProviderPower
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Vesting"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.VoterBreakdown"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.Vesting does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.VoterBreakdown does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2177,19 +7900,31 @@ func (x *fastReflection_Vesting) Get(descriptor protoreflect.FieldDescriptor) pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Vesting) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_VoterBreakdown) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "initia.gov.v1.Vesting.module_addr":
-		x.ModuleAddr = value.Interface().(string)
-	case "initia.gov.v1.Vesting.module_name":
-		x.ModuleName = value.Interface().(string)
-	case "initia.gov.v1.Vesting.creator_addr":
-		x.CreatorAddr = value.Interface().(string)
+	case "initia.gov.v1.VoterBreakdown.proposal_id":
+		x.ProposalId = value.Uint()
+	case "initia.gov.v1.VoterBreakdown.voter":
+		x.Voter = value.Interface().(string)
+	case "initia.gov.v1.VoterBreakdown.options":
+		lv := value.List()
+		clv := lv.(*_VoterBreakdown_3_list)
+		x.Options = *clv.list
+	case "initia.gov.v1.VoterBreakdown.delegate":
+		x.Delegate = value.Interface().(string)
+	case "initia.gov.v1.VoterBreakdown.staking_power":
+		x.StakingPower = value.Interface().(string)
+	case "initia.gov.v1.VoterBreakdown.vesting_power":
+		x.VestingPower = value.Interface().(string)
+	case "initia.gov.v1.VoterBreakdown.inherited_power":
+		x.InheritedPower = value.Interface().(string)
+	case "initia.gov.v1.VoterBreakdown.provider_power":
+		x.ProviderPower = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Vesting"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.VoterBreakdown"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.Vesting does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.VoterBreakdown does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2203,48 +7938,73 @@ func (x *fastReflection_Vesting) Set(fd protoreflect.FieldDescriptor, value prot
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Vesting) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VoterBreakdown) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.gov.v1.Vesting.module_addr":
-		panic(fmt.Errorf("field module_addr of message initia.gov.v1.Vesting is not mutable"))
-	case "initia.gov.v1.Vesting.module_name":
-		panic(fmt.Errorf("field module_name of message initia.gov.v1.Vesting is not mutable"))
-	case "initia.gov.v1.Vesting.creator_addr":
-		panic(fmt.Errorf("field creator_addr of message initia.gov.v1.Vesting is not mutable"))
+	case "initia.gov.v1.VoterBreakdown.options":
+		if x.Options == nil {
+			x.Options = []*v1.WeightedVoteOption{}
+		}
+		value := &_VoterBreakdown_3_list{list: &x.Options}
+		return protoreflect.ValueOfList(value)
+	case "initia.gov.v1.VoterBreakdown.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message initia.gov.v1.VoterBreakdown is not mutable"))
+	case "initia.gov.v1.VoterBreakdown.voter":
+		panic(fmt.Errorf("field voter of message initia.gov.v1.VoterBreakdown is not mutable"))
+	case "initia.gov.v1.VoterBreakdown.delegate":
+		panic(fmt.Errorf("field delegate of message initia.gov.v1.VoterBreakdown is not mutable"))
+	case "initia.gov.v1.VoterBreakdown.staking_power":
+		panic(fmt.Errorf("field staking_power of message initia.gov.v1.VoterBreakdown is not mutable"))
+	case "initia.gov.v1.VoterBreakdown.vesting_power":
+		panic(fmt.Errorf("field vesting_power of message initia.gov.v1.VoterBreakdown is not mutable"))
+	case "initia.gov.v1.VoterBreakdown.inherited_power":
+		panic(fmt.Errorf("field inherited_power of message initia.gov.v1.VoterBreakdown is not mutable"))
+	case "initia.gov.v1.VoterBreakdown.provider_power":
+		panic(fmt.Errorf("field provider_power of message initia.gov.v1.VoterBreakdown is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Vesting"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.VoterBreakdown"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.Vesting does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.VoterBreakdown does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Vesting) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VoterBreakdown) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.gov.v1.Vesting.module_addr":
+	case "initia.gov.v1.VoterBreakdown.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.gov.v1.VoterBreakdown.voter":
 		return protoreflect.ValueOfString("")
-	case "initia.gov.v1.Vesting.module_name":
+	case "initia.gov.v1.VoterBreakdown.options":
+		list := []*v1.WeightedVoteOption{}
+		return protoreflect.ValueOfList(&_VoterBreakdown_3_list{list: &list})
+	case "initia.gov.v1.VoterBreakdown.delegate":
 		return protoreflect.ValueOfString("")
-	case "initia.gov.v1.Vesting.creator_addr":
+	case "initia.gov.v1.VoterBreakdown.staking_power":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.VoterBreakdown.vesting_power":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.VoterBreakdown.inherited_power":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.VoterBreakdown.provider_power":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Vesting"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.VoterBreakdown"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.Vesting does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.VoterBreakdown does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Vesting) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_VoterBreakdown) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in initia.gov.v1.Vesting", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in initia.gov.v1.VoterBreakdown", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2252,7 +8012,7 @@ func (x *fastReflection_Vesting) WhichOneof(d protoreflect.OneofDescriptor) prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Vesting) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_VoterBreakdown) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2263,7 +8023,7 @@ func (x *fastReflection_Vesting) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Vesting) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_VoterBreakdown) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2275,7 +8035,7 @@ func (x *fastReflection_Vesting) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Vesting) IsValid() bool {
+func (x *fastReflection_VoterBreakdown) IsValid() bool {
 	return x != nil
 }
 
@@ -2285,9 +8045,9 @@ func (x *fastReflection_Vesting) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Vesting) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_VoterBreakdown) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Vesting)
+		x := input.Message.Interface().(*VoterBreakdown)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2299,15 +8059,36 @@ func (x *fastReflection_Vesting) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.ModuleAddr)
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		l = len(x.Voter)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ModuleName)
+		if len(x.Options) > 0 {
+			for _, e := range x.Options {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Delegate)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.CreatorAddr)
+		l = len(x.StakingPower)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VestingPower)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.InheritedPower)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ProviderPower)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -2321,7 +8102,7 @@ func (x *fastReflection_Vesting) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Vesting)
+		x := input.Message.Interface().(*VoterBreakdown)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2340,26 +8121,68 @@ func (x *fastReflection_Vesting) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.CreatorAddr) > 0 {
-			i -= len(x.CreatorAddr)
-			copy(dAtA[i:], x.CreatorAddr)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CreatorAddr)))
+		if len(x.ProviderPower) > 0 {
+			i -= len(x.ProviderPower)
+			copy(dAtA[i:], x.ProviderPower)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProviderPower)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x42
 		}
-		if len(x.ModuleName) > 0 {
-			i -= len(x.ModuleName)
-			copy(dAtA[i:], x.ModuleName)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleName)))
+		if len(x.InheritedPower) > 0 {
+			i -= len(x.InheritedPower)
+			copy(dAtA[i:], x.InheritedPower)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InheritedPower)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.VestingPower) > 0 {
+			i -= len(x.VestingPower)
+			copy(dAtA[i:], x.VestingPower)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VestingPower)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.StakingPower) > 0 {
+			i -= len(x.StakingPower)
+			copy(dAtA[i:], x.StakingPower)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StakingPower)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Delegate) > 0 {
+			i -= len(x.Delegate)
+			copy(dAtA[i:], x.Delegate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegate)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Options) > 0 {
+			for iNdEx := len(x.Options) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Options[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Voter) > 0 {
+			i -= len(x.Voter)
+			copy(dAtA[i:], x.Voter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.ModuleAddr) > 0 {
-			i -= len(x.ModuleAddr)
-			copy(dAtA[i:], x.ModuleAddr)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleAddr)))
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2372,7 +8195,7 @@ func (x *fastReflection_Vesting) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Vesting)
+		x := input.Message.Interface().(*VoterBreakdown)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2404,15 +8227,164 @@ func (x *fastReflection_Vesting) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Vesting: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VoterBreakdown: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Vesting: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VoterBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Voter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Options = append(x.Options, &v1.WeightedVoteOption{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Options[len(x.Options)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakingPower", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StakingPower = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleAddr", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VestingPower", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2440,11 +8412,11 @@ func (x *fastReflection_Vesting) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ModuleAddr = string(dAtA[iNdEx:postIndex])
+				x.VestingPower = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InheritedPower", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2472,11 +8444,11 @@ func (x *fastReflection_Vesting) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ModuleName = string(dAtA[iNdEx:postIndex])
+				x.InheritedPower = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 8:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatorAddr", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderPower", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2504,7 +8476,7 @@ func (x *fastReflection_Vesting) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CreatorAddr = string(dAtA[iNdEx:postIndex])
+				x.ProviderPower = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2541,33 +8513,167 @@ func (x *fastReflection_Vesting) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_Proposal_2_list)(nil)
+
+type _Proposal_2_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_Proposal_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Proposal_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Proposal_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Proposal_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Proposal_2_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Proposal_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Proposal_2_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Proposal_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Proposal_7_list)(nil)
+
+type _Proposal_7_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Proposal_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Proposal_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Proposal_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Proposal_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Proposal_7_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Proposal_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Proposal_7_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Proposal_7_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_TallyResult                     protoreflect.MessageDescriptor
-	fd_TallyResult_tally_height        protoreflect.FieldDescriptor
-	fd_TallyResult_total_staking_power protoreflect.FieldDescriptor
-	fd_TallyResult_total_vesting_power protoreflect.FieldDescriptor
-	fd_TallyResult_v1_tally_result     protoreflect.FieldDescriptor
+	md_Proposal                           protoreflect.MessageDescriptor
+	fd_Proposal_id                        protoreflect.FieldDescriptor
+	fd_Proposal_messages                  protoreflect.FieldDescriptor
+	fd_Proposal_status                    protoreflect.FieldDescriptor
+	fd_Proposal_final_tally_result        protoreflect.FieldDescriptor
+	fd_Proposal_submit_time               protoreflect.FieldDescriptor
+	fd_Proposal_deposit_end_time          protoreflect.FieldDescriptor
+	fd_Proposal_total_deposit             protoreflect.FieldDescriptor
+	fd_Proposal_voting_start_time         protoreflect.FieldDescriptor
+	fd_Proposal_voting_end_time           protoreflect.FieldDescriptor
+	fd_Proposal_emergency_start_time      protoreflect.FieldDescriptor
+	fd_Proposal_emergency_next_tally_time protoreflect.FieldDescriptor
+	fd_Proposal_metadata                  protoreflect.FieldDescriptor
+	fd_Proposal_title                     protoreflect.FieldDescriptor
+	fd_Proposal_summary                   protoreflect.FieldDescriptor
+	fd_Proposal_proposer                  protoreflect.FieldDescriptor
+	fd_Proposal_expedited                 protoreflect.FieldDescriptor
+	fd_Proposal_emergency                 protoreflect.FieldDescriptor
+	fd_Proposal_failed_reason             protoreflect.FieldDescriptor
+	fd_Proposal_execution_time            protoreflect.FieldDescriptor
+	fd_Proposal_optimistic                protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_initia_gov_v1_gov_proto_init()
-	md_TallyResult = File_initia_gov_v1_gov_proto.Messages().ByName("TallyResult")
-	fd_TallyResult_tally_height = md_TallyResult.Fields().ByName("tally_height")
-	fd_TallyResult_total_staking_power = md_TallyResult.Fields().ByName("total_staking_power")
-	fd_TallyResult_total_vesting_power = md_TallyResult.Fields().ByName("total_vesting_power")
-	fd_TallyResult_v1_tally_result = md_TallyResult.Fields().ByName("v1_tally_result")
+	md_Proposal = File_initia_gov_v1_gov_proto.Messages().ByName("Proposal")
+	fd_Proposal_id = md_Proposal.Fields().ByName("id")
+	fd_Proposal_messages = md_Proposal.Fields().ByName("messages")
+	fd_Proposal_status = md_Proposal.Fields().ByName("status")
+	fd_Proposal_final_tally_result = md_Proposal.Fields().ByName("final_tally_result")
+	fd_Proposal_submit_time = md_Proposal.Fields().ByName("submit_time")
+	fd_Proposal_deposit_end_time = md_Proposal.Fields().ByName("deposit_end_time")
+	fd_Proposal_total_deposit = md_Proposal.Fields().ByName("total_deposit")
+	fd_Proposal_voting_start_time = md_Proposal.Fields().ByName("voting_start_time")
+	fd_Proposal_voting_end_time = md_Proposal.Fields().ByName("voting_end_time")
+	fd_Proposal_emergency_start_time = md_Proposal.Fields().ByName("emergency_start_time")
+	fd_Proposal_emergency_next_tally_time = md_Proposal.Fields().ByName("emergency_next_tally_time")
+	fd_Proposal_metadata = md_Proposal.Fields().ByName("metadata")
+	fd_Proposal_title = md_Proposal.Fields().ByName("title")
+	fd_Proposal_summary = md_Proposal.Fields().ByName("summary")
+	fd_Proposal_proposer = md_Proposal.Fields().ByName("proposer")
+	fd_Proposal_expedited = md_Proposal.Fields().ByName("expedited")
+	fd_Proposal_emergency = md_Proposal.Fields().ByName("emergency")
+	fd_Proposal_failed_reason = md_Proposal.Fields().ByName("failed_reason")
+	fd_Proposal_execution_time = md_Proposal.Fields().ByName("execution_time")
+	fd_Proposal_optimistic = md_Proposal.Fields().ByName("optimistic")
 }
 
-var _ protoreflect.Message = (*fastReflection_TallyResult)(nil)
+var _ protoreflect.Message = (*fastReflection_Proposal)(nil)
 
-type fastReflection_TallyResult TallyResult
+type fastReflection_Proposal Proposal
 
-func (x *TallyResult) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TallyResult)(x)
+func (x *Proposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Proposal)(x)
 }
 
-func (x *TallyResult) slowProtoReflect() protoreflect.Message {
-	mi := &file_initia_gov_v1_gov_proto_msgTypes[2]
+func (x *Proposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_gov_v1_gov_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2578,43 +8684,43 @@ func (x *TallyResult) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TallyResult_messageType fastReflection_TallyResult_messageType
-var _ protoreflect.MessageType = fastReflection_TallyResult_messageType{}
+var _fastReflection_Proposal_messageType fastReflection_Proposal_messageType
+var _ protoreflect.MessageType = fastReflection_Proposal_messageType{}
 
-type fastReflection_TallyResult_messageType struct{}
+type fastReflection_Proposal_messageType struct{}
 
-func (x fastReflection_TallyResult_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TallyResult)(nil)
+func (x fastReflection_Proposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Proposal)(nil)
 }
-func (x fastReflection_TallyResult_messageType) New() protoreflect.Message {
-	return new(fastReflection_TallyResult)
+func (x fastReflection_Proposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_Proposal)
 }
-func (x fastReflection_TallyResult_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TallyResult
+func (x fastReflection_Proposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Proposal
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TallyResult) Descriptor() protoreflect.MessageDescriptor {
-	return md_TallyResult
+func (x *fastReflection_Proposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_Proposal
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TallyResult) Type() protoreflect.MessageType {
-	return _fastReflection_TallyResult_messageType
+func (x *fastReflection_Proposal) Type() protoreflect.MessageType {
+	return _fastReflection_Proposal_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TallyResult) New() protoreflect.Message {
-	return new(fastReflection_TallyResult)
+func (x *fastReflection_Proposal) New() protoreflect.Message {
+	return new(fastReflection_Proposal)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TallyResult) Interface() protoreflect.ProtoMessage {
-	return (*TallyResult)(x)
+func (x *fastReflection_Proposal) Interface() protoreflect.ProtoMessage {
+	return (*Proposal)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2622,28 +8728,124 @@ func (x *fastReflection_TallyResult) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TallyResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.TallyHeight != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.TallyHeight)
-		if !f(fd_TallyResult_tally_height, value) {
+func (x *fastReflection_Proposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Proposal_id, value) {
+			return
+		}
+	}
+	if len(x.Messages) != 0 {
+		value := protoreflect.ValueOfList(&_Proposal_2_list{list: &x.Messages})
+		if !f(fd_Proposal_messages, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_Proposal_status, value) {
+			return
+		}
+	}
+	if x.FinalTallyResult != nil {
+		value := protoreflect.ValueOfMessage(x.FinalTallyResult.ProtoReflect())
+		if !f(fd_Proposal_final_tally_result, value) {
+			return
+		}
+	}
+	if x.SubmitTime != nil {
+		value := protoreflect.ValueOfMessage(x.SubmitTime.ProtoReflect())
+		if !f(fd_Proposal_submit_time, value) {
+			return
+		}
+	}
+	if x.DepositEndTime != nil {
+		value := protoreflect.ValueOfMessage(x.DepositEndTime.ProtoReflect())
+		if !f(fd_Proposal_deposit_end_time, value) {
+			return
+		}
+	}
+	if len(x.TotalDeposit) != 0 {
+		value := protoreflect.ValueOfList(&_Proposal_7_list{list: &x.TotalDeposit})
+		if !f(fd_Proposal_total_deposit, value) {
+			return
+		}
+	}
+	if x.VotingStartTime != nil {
+		value := protoreflect.ValueOfMessage(x.VotingStartTime.ProtoReflect())
+		if !f(fd_Proposal_voting_start_time, value) {
+			return
+		}
+	}
+	if x.VotingEndTime != nil {
+		value := protoreflect.ValueOfMessage(x.VotingEndTime.ProtoReflect())
+		if !f(fd_Proposal_voting_end_time, value) {
 			return
 		}
 	}
-	if x.TotalStakingPower != "" {
-		value := protoreflect.ValueOfString(x.TotalStakingPower)
-		if !f(fd_TallyResult_total_staking_power, value) {
+	if x.EmergencyStartTime != nil {
+		value := protoreflect.ValueOfMessage(x.EmergencyStartTime.ProtoReflect())
+		if !f(fd_Proposal_emergency_start_time, value) {
 			return
 		}
 	}
-	if x.TotalVestingPower != "" {
-		value := protoreflect.ValueOfString(x.TotalVestingPower)
-		if !f(fd_TallyResult_total_vesting_power, value) {
+	if x.EmergencyNextTallyTime != nil {
+		value := protoreflect.ValueOfMessage(x.EmergencyNextTallyTime.ProtoReflect())
+		if !f(fd_Proposal_emergency_next_tally_time, value) {
 			return
 		}
 	}
-	if x.V1TallyResult != nil {
-		value := protoreflect.ValueOfMessage(x.V1TallyResult.ProtoReflect())
-		if !f(fd_TallyResult_v1_tally_result, value) {
+	if x.Metadata != "" {
+		value := protoreflect.ValueOfString(x.Metadata)
+		if !f(fd_Proposal_metadata, value) {
+			return
+		}
+	}
+	if x.Title != "" {
+		value := protoreflect.ValueOfString(x.Title)
+		if !f(fd_Proposal_title, value) {
+			return
+		}
+	}
+	if x.Summary != "" {
+		value := protoreflect.ValueOfString(x.Summary)
+		if !f(fd_Proposal_summary, value) {
+			return
+		}
+	}
+	if x.Proposer != "" {
+		value := protoreflect.ValueOfString(x.Proposer)
+		if !f(fd_Proposal_proposer, value) {
+			return
+		}
+	}
+	if x.Expedited != false {
+		value := protoreflect.ValueOfBool(x.Expedited)
+		if !f(fd_Proposal_expedited, value) {
+			return
+		}
+	}
+	if x.Emergency != false {
+		value := protoreflect.ValueOfBool(x.Emergency)
+		if !f(fd_Proposal_emergency, value) {
+			return
+		}
+	}
+	if x.FailedReason != "" {
+		value := protoreflect.ValueOfString(x.FailedReason)
+		if !f(fd_Proposal_failed_reason, value) {
+			return
+		}
+	}
+	if x.ExecutionTime != nil {
+		value := protoreflect.ValueOfMessage(x.ExecutionTime.ProtoReflect())
+		if !f(fd_Proposal_execution_time, value) {
+			return
+		}
+	}
+	if x.Optimistic != false {
+		value := protoreflect.ValueOfBool(x.Optimistic)
+		if !f(fd_Proposal_optimistic, value) {
 			return
 		}
 	}
@@ -2660,21 +8862,53 @@ func (x *fastReflection_TallyResult) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TallyResult) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Proposal) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "initia.gov.v1.TallyResult.tally_height":
-		return x.TallyHeight != uint64(0)
-	case "initia.gov.v1.TallyResult.total_staking_power":
-		return x.TotalStakingPower != ""
-	case "initia.gov.v1.TallyResult.total_vesting_power":
-		return x.TotalVestingPower != ""
-	case "initia.gov.v1.TallyResult.v1_tally_result":
-		return x.V1TallyResult != nil
+	case "initia.gov.v1.Proposal.id":
+		return x.Id != uint64(0)
+	case "initia.gov.v1.Proposal.messages":
+		return len(x.Messages) != 0
+	case "initia.gov.v1.Proposal.status":
+		return x.Status != 0
+	case "initia.gov.v1.Proposal.final_tally_result":
+		return x.FinalTallyResult != nil
+	case "initia.gov.v1.Proposal.submit_time":
+		return x.SubmitTime != nil
+	case "initia.gov.v1.Proposal.deposit_end_time":
+		return x.DepositEndTime != nil
+	case "initia.gov.v1.Proposal.total_deposit":
+		return len(x.TotalDeposit) != 0
+	case "initia.gov.v1.Proposal.voting_start_time":
+		return x.VotingStartTime != nil
+	case "initia.gov.v1.Proposal.voting_end_time":
+		return x.VotingEndTime != nil
+	case "initia.gov.v1.Proposal.emergency_start_time":
+		return x.EmergencyStartTime != nil
+	case "initia.gov.v1.Proposal.emergency_next_tally_time":
+		return x.EmergencyNextTallyTime != nil
+	case "initia.gov.v1.Proposal.metadata":
+		return x.Metadata != ""
+	case "initia.gov.v1.Proposal.title":
+		return x.Title != ""
+	case "initia.gov.v1.Proposal.summary":
+		return x.Summary != ""
+	case "initia.gov.v1.Proposal.proposer":
+		return x.Proposer != ""
+	case "initia.gov.v1.Proposal.expedited":
+		return x.Expedited != false
+	case "initia.gov.v1.Proposal.emergency":
+		return x.Emergency != false
+	case "initia.gov.v1.Proposal.failed_reason":
+		return x.FailedReason != ""
+	case "initia.gov.v1.Proposal.execution_time":
+		return x.ExecutionTime != nil
+	case "initia.gov.v1.Proposal.optimistic":
+		return x.Optimistic != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Proposal"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.Proposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2684,21 +8918,53 @@ func (x *fastReflection_TallyResult) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyResult) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Proposal) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "initia.gov.v1.TallyResult.tally_height":
-		x.TallyHeight = uint64(0)
-	case "initia.gov.v1.TallyResult.total_staking_power":
-		x.TotalStakingPower = ""
-	case "initia.gov.v1.TallyResult.total_vesting_power":
-		x.TotalVestingPower = ""
-	case "initia.gov.v1.TallyResult.v1_tally_result":
-		x.V1TallyResult = nil
+	case "initia.gov.v1.Proposal.id":
+		x.Id = uint64(0)
+	case "initia.gov.v1.Proposal.messages":
+		x.Messages = nil
+	case "initia.gov.v1.Proposal.status":
+		x.Status = 0
+	case "initia.gov.v1.Proposal.final_tally_result":
+		x.FinalTallyResult = nil
+	case "initia.gov.v1.Proposal.submit_time":
+		x.SubmitTime = nil
+	case "initia.gov.v1.Proposal.deposit_end_time":
+		x.DepositEndTime = nil
+	case "initia.gov.v1.Proposal.total_deposit":
+		x.TotalDeposit = nil
+	case "initia.gov.v1.Proposal.voting_start_time":
+		x.VotingStartTime = nil
+	case "initia.gov.v1.Proposal.voting_end_time":
+		x.VotingEndTime = nil
+	case "initia.gov.v1.Proposal.emergency_start_time":
+		x.EmergencyStartTime = nil
+	case "initia.gov.v1.Proposal.emergency_next_tally_time":
+		x.EmergencyNextTallyTime = nil
+	case "initia.gov.v1.Proposal.metadata":
+		x.Metadata = ""
+	case "initia.gov.v1.Proposal.title":
+		x.Title = ""
+	case "initia.gov.v1.Proposal.summary":
+		x.Summary = ""
+	case "initia.gov.v1.Proposal.proposer":
+		x.Proposer = ""
+	case "initia.gov.v1.Proposal.expedited":
+		x.Expedited = false
+	case "initia.gov.v1.Proposal.emergency":
+		x.Emergency = false
+	case "initia.gov.v1.Proposal.failed_reason":
+		x.FailedReason = ""
+	case "initia.gov.v1.Proposal.execution_time":
+		x.ExecutionTime = nil
+	case "initia.gov.v1.Proposal.optimistic":
+		x.Optimistic = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Proposal"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.Proposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2708,25 +8974,79 @@ func (x *fastReflection_TallyResult) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TallyResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Proposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "initia.gov.v1.TallyResult.tally_height":
-		value := x.TallyHeight
+	case "initia.gov.v1.Proposal.id":
+		value := x.Id
 		return protoreflect.ValueOfUint64(value)
-	case "initia.gov.v1.TallyResult.total_staking_power":
-		value := x.TotalStakingPower
+	case "initia.gov.v1.Proposal.messages":
+		if len(x.Messages) == 0 {
+			return protoreflect.ValueOfList(&_Proposal_2_list{})
+		}
+		listValue := &_Proposal_2_list{list: &x.Messages}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.gov.v1.Proposal.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "initia.gov.v1.Proposal.final_tally_result":
+		value := x.FinalTallyResult
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.gov.v1.Proposal.submit_time":
+		value := x.SubmitTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.gov.v1.Proposal.deposit_end_time":
+		value := x.DepositEndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.gov.v1.Proposal.total_deposit":
+		if len(x.TotalDeposit) == 0 {
+			return protoreflect.ValueOfList(&_Proposal_7_list{})
+		}
+		listValue := &_Proposal_7_list{list: &x.TotalDeposit}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.gov.v1.Proposal.voting_start_time":
+		value := x.VotingStartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.gov.v1.Proposal.voting_end_time":
+		value := x.VotingEndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.gov.v1.Proposal.emergency_start_time":
+		value := x.EmergencyStartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.gov.v1.Proposal.emergency_next_tally_time":
+		value := x.EmergencyNextTallyTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.gov.v1.Proposal.metadata":
+		value := x.Metadata
 		return protoreflect.ValueOfString(value)
-	case "initia.gov.v1.TallyResult.total_vesting_power":
-		value := x.TotalVestingPower
+	case "initia.gov.v1.Proposal.title":
+		value := x.Title
 		return protoreflect.ValueOfString(value)
-	case "initia.gov.v1.TallyResult.v1_tally_result":
-		value := x.V1TallyResult
+	case "initia.gov.v1.Proposal.summary":
+		value := x.Summary
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.Proposal.proposer":
+		value := x.Proposer
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.Proposal.expedited":
+		value := x.Expedited
+		return protoreflect.ValueOfBool(value)
+	case "initia.gov.v1.Proposal.emergency":
+		value := x.Emergency
+		return protoreflect.ValueOfBool(value)
+	case "initia.gov.v1.Proposal.failed_reason":
+		value := x.FailedReason
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.Proposal.execution_time":
+		value := x.ExecutionTime
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.gov.v1.Proposal.optimistic":
+		value := x.Optimistic
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Proposal"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.TallyResult does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.Proposal does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2740,21 +9060,57 @@ func (x *fastReflection_TallyResult) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Proposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "initia.gov.v1.TallyResult.tally_height":
-		x.TallyHeight = value.Uint()
-	case "initia.gov.v1.TallyResult.total_staking_power":
-		x.TotalStakingPower = value.Interface().(string)
-	case "initia.gov.v1.TallyResult.total_vesting_power":
-		x.TotalVestingPower = value.Interface().(string)
-	case "initia.gov.v1.TallyResult.v1_tally_result":
-		x.V1TallyResult = value.Message().Interface().(*v1.TallyResult)
+	case "initia.gov.v1.Proposal.id":
+		x.Id = value.Uint()
+	case "initia.gov.v1.Proposal.messages":
+		lv := value.List()
+		clv := lv.(*_Proposal_2_list)
+		x.Messages = *clv.list
+	case "initia.gov.v1.Proposal.status":
+		x.Status = (v1.ProposalStatus)(value.Enum())
+	case "initia.gov.v1.Proposal.final_tally_result":
+		x.FinalTallyResult = value.Message().Interface().(*TallyResult)
+	case "initia.gov.v1.Proposal.submit_time":
+		x.SubmitTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "initia.gov.v1.Proposal.deposit_end_time":
+		x.DepositEndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "initia.gov.v1.Proposal.total_deposit":
+		lv := value.List()
+		clv := lv.(*_Proposal_7_list)
+		x.TotalDeposit = *clv.list
+	case "initia.gov.v1.Proposal.voting_start_time":
+		x.VotingStartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "initia.gov.v1.Proposal.voting_end_time":
+		x.VotingEndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "initia.gov.v1.Proposal.emergency_start_time":
+		x.EmergencyStartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "initia.gov.v1.Proposal.emergency_next_tally_time":
+		x.EmergencyNextTallyTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "initia.gov.v1.Proposal.metadata":
+		x.Metadata = value.Interface().(string)
+	case "initia.gov.v1.Proposal.title":
+		x.Title = value.Interface().(string)
+	case "initia.gov.v1.Proposal.summary":
+		x.Summary = value.Interface().(string)
+	case "initia.gov.v1.Proposal.proposer":
+		x.Proposer = value.Interface().(string)
+	case "initia.gov.v1.Proposal.expedited":
+		x.Expedited = value.Bool()
+	case "initia.gov.v1.Proposal.emergency":
+		x.Emergency = value.Bool()
+	case "initia.gov.v1.Proposal.failed_reason":
+		x.FailedReason = value.Interface().(string)
+	case "initia.gov.v1.Proposal.execution_time":
+		x.ExecutionTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "initia.gov.v1.Proposal.optimistic":
+		x.Optimistic = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Proposal"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.Proposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2768,56 +9124,158 @@ func (x *fastReflection_TallyResult) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Proposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.gov.v1.TallyResult.v1_tally_result":
-		if x.V1TallyResult == nil {
-			x.V1TallyResult = new(v1.TallyResult)
+	case "initia.gov.v1.Proposal.messages":
+		if x.Messages == nil {
+			x.Messages = []*anypb.Any{}
 		}
-		return protoreflect.ValueOfMessage(x.V1TallyResult.ProtoReflect())
-	case "initia.gov.v1.TallyResult.tally_height":
-		panic(fmt.Errorf("field tally_height of message initia.gov.v1.TallyResult is not mutable"))
-	case "initia.gov.v1.TallyResult.total_staking_power":
-		panic(fmt.Errorf("field total_staking_power of message initia.gov.v1.TallyResult is not mutable"))
-	case "initia.gov.v1.TallyResult.total_vesting_power":
-		panic(fmt.Errorf("field total_vesting_power of message initia.gov.v1.TallyResult is not mutable"))
+		value := &_Proposal_2_list{list: &x.Messages}
+		return protoreflect.ValueOfList(value)
+	case "initia.gov.v1.Proposal.final_tally_result":
+		if x.FinalTallyResult == nil {
+			x.FinalTallyResult = new(TallyResult)
+		}
+		return protoreflect.ValueOfMessage(x.FinalTallyResult.ProtoReflect())
+	case "initia.gov.v1.Proposal.submit_time":
+		if x.SubmitTime == nil {
+			x.SubmitTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.SubmitTime.ProtoReflect())
+	case "initia.gov.v1.Proposal.deposit_end_time":
+		if x.DepositEndTime == nil {
+			x.DepositEndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.DepositEndTime.ProtoReflect())
+	case "initia.gov.v1.Proposal.total_deposit":
+		if x.TotalDeposit == nil {
+			x.TotalDeposit = []*v1beta1.Coin{}
+		}
+		value := &_Proposal_7_list{list: &x.TotalDeposit}
+		return protoreflect.ValueOfList(value)
+	case "initia.gov.v1.Proposal.voting_start_time":
+		if x.VotingStartTime == nil {
+			x.VotingStartTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.VotingStartTime.ProtoReflect())
+	case "initia.gov.v1.Proposal.voting_end_time":
+		if x.VotingEndTime == nil {
+			x.VotingEndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.VotingEndTime.ProtoReflect())
+	case "initia.gov.v1.Proposal.emergency_start_time":
+		if x.EmergencyStartTime == nil {
+			x.EmergencyStartTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EmergencyStartTime.ProtoReflect())
+	case "initia.gov.v1.Proposal.emergency_next_tally_time":
+		if x.EmergencyNextTallyTime == nil {
+			x.EmergencyNextTallyTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EmergencyNextTallyTime.ProtoReflect())
+	case "initia.gov.v1.Proposal.execution_time":
+		if x.ExecutionTime == nil {
+			x.ExecutionTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExecutionTime.ProtoReflect())
+	case "initia.gov.v1.Proposal.id":
+		panic(fmt.Errorf("field id of message initia.gov.v1.Proposal is not mutable"))
+	case "initia.gov.v1.Proposal.status":
+		panic(fmt.Errorf("field status of message initia.gov.v1.Proposal is not mutable"))
+	case "initia.gov.v1.Proposal.metadata":
+		panic(fmt.Errorf("field metadata of message initia.gov.v1.Proposal is not mutable"))
+	case "initia.gov.v1.Proposal.title":
+		panic(fmt.Errorf("field title of message initia.gov.v1.Proposal is not mutable"))
+	case "initia.gov.v1.Proposal.summary":
+		panic(fmt.Errorf("field summary of message initia.gov.v1.Proposal is not mutable"))
+	case "initia.gov.v1.Proposal.proposer":
+		panic(fmt.Errorf("field proposer of message initia.gov.v1.Proposal is not mutable"))
+	case "initia.gov.v1.Proposal.expedited":
+		panic(fmt.Errorf("field expedited of message initia.gov.v1.Proposal is not mutable"))
+	case "initia.gov.v1.Proposal.emergency":
+		panic(fmt.Errorf("field emergency of message initia.gov.v1.Proposal is not mutable"))
+	case "initia.gov.v1.Proposal.failed_reason":
+		panic(fmt.Errorf("field failed_reason of message initia.gov.v1.Proposal is not mutable"))
+	case "initia.gov.v1.Proposal.optimistic":
+		panic(fmt.Errorf("field optimistic of message initia.gov.v1.Proposal is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Proposal"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.Proposal does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TallyResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Proposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.gov.v1.TallyResult.tally_height":
+	case "initia.gov.v1.Proposal.id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "initia.gov.v1.TallyResult.total_staking_power":
+	case "initia.gov.v1.Proposal.messages":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_Proposal_2_list{list: &list})
+	case "initia.gov.v1.Proposal.status":
+		return protoreflect.ValueOfEnum(0)
+	case "initia.gov.v1.Proposal.final_tally_result":
+		m := new(TallyResult)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.gov.v1.Proposal.submit_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.gov.v1.Proposal.deposit_end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.gov.v1.Proposal.total_deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Proposal_7_list{list: &list})
+	case "initia.gov.v1.Proposal.voting_start_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.gov.v1.Proposal.voting_end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.gov.v1.Proposal.emergency_start_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.gov.v1.Proposal.emergency_next_tally_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.gov.v1.Proposal.metadata":
 		return protoreflect.ValueOfString("")
-	case "initia.gov.v1.TallyResult.total_vesting_power":
+	case "initia.gov.v1.Proposal.title":
 		return protoreflect.ValueOfString("")
-	case "initia.gov.v1.TallyResult.v1_tally_result":
-		m := new(v1.TallyResult)
+	case "initia.gov.v1.Proposal.summary":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.Proposal.proposer":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.Proposal.expedited":
+		return protoreflect.ValueOfBool(false)
+	case "initia.gov.v1.Proposal.emergency":
+		return protoreflect.ValueOfBool(false)
+	case "initia.gov.v1.Proposal.failed_reason":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.Proposal.execution_time":
+		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.gov.v1.Proposal.optimistic":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Proposal"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.Proposal does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TallyResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Proposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in initia.gov.v1.TallyResult", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in initia.gov.v1.Proposal", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2825,7 +9283,7 @@ func (x *fastReflection_TallyResult) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TallyResult) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Proposal) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2836,7 +9294,7 @@ func (x *fastReflection_TallyResult) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyResult) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Proposal) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2848,7 +9306,7 @@ func (x *fastReflection_TallyResult) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TallyResult) IsValid() bool {
+func (x *fastReflection_Proposal) IsValid() bool {
 	return x != nil
 }
 
@@ -2858,9 +9316,9 @@ func (x *fastReflection_TallyResult) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TallyResult)
+		x := input.Message.Interface().(*Proposal)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2872,21 +9330,85 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.TallyHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.TallyHeight))
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
 		}
-		l = len(x.TotalStakingPower)
+		if len(x.Messages) > 0 {
+			for _, e := range x.Messages {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.FinalTallyResult != nil {
+			l = options.Size(x.FinalTallyResult)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SubmitTime != nil {
+			l = options.Size(x.SubmitTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DepositEndTime != nil {
+			l = options.Size(x.DepositEndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.TotalDeposit) > 0 {
+			for _, e := range x.TotalDeposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.VotingStartTime != nil {
+			l = options.Size(x.VotingStartTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.VotingEndTime != nil {
+			l = options.Size(x.VotingEndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EmergencyStartTime != nil {
+			l = options.Size(x.EmergencyStartTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EmergencyNextTallyTime != nil {
+			l = options.Size(x.EmergencyNextTallyTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Metadata)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.TotalVestingPower)
+		l = len(x.Title)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.V1TallyResult != nil {
-			l = options.Size(x.V1TallyResult)
+		l = len(x.Summary)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Proposer)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Expedited {
+			n += 3
+		}
+		if x.Emergency {
+			n += 3
+		}
+		l = len(x.FailedReason)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExecutionTime != nil {
+			l = options.Size(x.ExecutionTime)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.Optimistic {
+			n += 3
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2897,7 +9419,7 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TallyResult)
+		x := input.Message.Interface().(*Proposal)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2916,8 +9438,20 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.V1TallyResult != nil {
-			encoded, err := options.Marshal(x.V1TallyResult)
+		if x.Optimistic {
+			i--
+			if x.Optimistic {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
+		}
+		if x.ExecutionTime != nil {
+			encoded, err := options.Marshal(x.ExecutionTime)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2928,24 +9462,208 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
 		}
-		if len(x.TotalVestingPower) > 0 {
-			i -= len(x.TotalVestingPower)
-			copy(dAtA[i:], x.TotalVestingPower)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalVestingPower)))
+		if len(x.FailedReason) > 0 {
+			i -= len(x.FailedReason)
+			copy(dAtA[i:], x.FailedReason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FailedReason)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
 		}
-		if len(x.TotalStakingPower) > 0 {
-			i -= len(x.TotalStakingPower)
-			copy(dAtA[i:], x.TotalStakingPower)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalStakingPower)))
+		if x.Emergency {
 			i--
-			dAtA[i] = 0x12
+			if x.Emergency {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
 		}
-		if x.TallyHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TallyHeight))
+		if x.Expedited {
+			i--
+			if x.Expedited {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if len(x.Proposer) > 0 {
+			i -= len(x.Proposer)
+			copy(dAtA[i:], x.Proposer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposer)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if len(x.Summary) > 0 {
+			i -= len(x.Summary)
+			copy(dAtA[i:], x.Summary)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Summary)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.Title) > 0 {
+			i -= len(x.Title)
+			copy(dAtA[i:], x.Title)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.Metadata) > 0 {
+			i -= len(x.Metadata)
+			copy(dAtA[i:], x.Metadata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.EmergencyNextTallyTime != nil {
+			encoded, err := options.Marshal(x.EmergencyNextTallyTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.EmergencyStartTime != nil {
+			encoded, err := options.Marshal(x.EmergencyStartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.VotingEndTime != nil {
+			encoded, err := options.Marshal(x.VotingEndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.VotingStartTime != nil {
+			encoded, err := options.Marshal(x.VotingStartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.TotalDeposit) > 0 {
+			for iNdEx := len(x.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TotalDeposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.DepositEndTime != nil {
+			encoded, err := options.Marshal(x.DepositEndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.SubmitTime != nil {
+			encoded, err := options.Marshal(x.SubmitTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.FinalTallyResult != nil {
+			encoded, err := options.Marshal(x.FinalTallyResult)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Messages) > 0 {
+			for iNdEx := len(x.Messages) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Messages[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -2960,7 +9678,7 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TallyResult)
+		x := input.Message.Interface().(*Proposal)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2979,30 +9697,316 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Messages = append(x.Messages, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Messages[len(x.Messages)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= v1.ProposalStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FinalTallyResult == nil {
+					x.FinalTallyResult = &TallyResult{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FinalTallyResult); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SubmitTime == nil {
+					x.SubmitTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SubmitTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DepositEndTime == nil {
+					x.DepositEndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DepositEndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalDeposit = append(x.TotalDeposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalDeposit[len(x.TotalDeposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.VotingStartTime == nil {
+					x.VotingStartTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingStartTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if x.VotingEndTime == nil {
+					x.VotingEndTime = &timestamppb.Timestamp{}
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TallyHeight", wireType)
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingEndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.TallyHeight = 0
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmergencyStartTime", wireType)
+				}
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3012,14 +10016,67 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.TallyHeight |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 2:
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EmergencyStartTime == nil {
+					x.EmergencyStartTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EmergencyStartTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalStakingPower", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmergencyNextTallyTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EmergencyNextTallyTime == nil {
+					x.EmergencyNextTallyTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EmergencyNextTallyTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3047,11 +10104,11 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TotalStakingPower = string(dAtA[iNdEx:postIndex])
+				x.Metadata = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 13:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalVestingPower", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3079,11 +10136,147 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TotalVestingPower = string(dAtA[iNdEx:postIndex])
+				x.Title = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
+			case 14:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field V1TallyResult", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Summary = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proposer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Expedited = bool(v != 0)
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Emergency", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Emergency = bool(v != 0)
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailedReason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FailedReason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3110,13 +10303,33 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.V1TallyResult == nil {
-					x.V1TallyResult = &v1.TallyResult{}
+				if x.ExecutionTime == nil {
+					x.ExecutionTime = &timestamppb.Timestamp{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.V1TallyResult); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecutionTime); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx = postIndex
+				iNdEx = postIndex
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Optimistic", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Optimistic = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3152,163 +10365,247 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Proposal_2_list)(nil)
+var _ protoreflect.List = (*_DepositOutcome_4_list)(nil)
 
-type _Proposal_2_list struct {
-	list *[]*anypb.Any
+type _DepositOutcome_4_list struct {
+	list *[]*v1beta1.Coin
 }
 
-func (x *_Proposal_2_list) Len() int {
+func (x *_DepositOutcome_4_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Proposal_2_list) Get(i int) protoreflect.Value {
+func (x *_DepositOutcome_4_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_Proposal_2_list) Set(i int, value protoreflect.Value) {
+func (x *_DepositOutcome_4_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Proposal_2_list) Append(value protoreflect.Value) {
+func (x *_DepositOutcome_4_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Proposal_2_list) AppendMutable() protoreflect.Value {
-	v := new(anypb.Any)
+func (x *_DepositOutcome_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Proposal_2_list) Truncate(n int) {
+func (x *_DepositOutcome_4_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Proposal_2_list) NewElement() protoreflect.Value {
-	v := new(anypb.Any)
+func (x *_DepositOutcome_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Proposal_2_list) IsValid() bool {
+func (x *_DepositOutcome_4_list) IsValid() bool {
 	return x.list != nil
 }
 
-var _ protoreflect.List = (*_Proposal_7_list)(nil)
+var _ protoreflect.List = (*_DepositOutcome_5_list)(nil)
 
-type _Proposal_7_list struct {
+type _DepositOutcome_5_list struct {
 	list *[]*v1beta1.Coin
 }
 
-func (x *_Proposal_7_list) Len() int {
+func (x *_DepositOutcome_5_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Proposal_7_list) Get(i int) protoreflect.Value {
+func (x *_DepositOutcome_5_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_Proposal_7_list) Set(i int, value protoreflect.Value) {
+func (x *_DepositOutcome_5_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Proposal_7_list) Append(value protoreflect.Value) {
+func (x *_DepositOutcome_5_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Proposal_7_list) AppendMutable() protoreflect.Value {
+func (x *_DepositOutcome_5_list) AppendMutable() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Proposal_7_list) Truncate(n int) {
+func (x *_DepositOutcome_5_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Proposal_7_list) NewElement() protoreflect.Value {
+func (x *_DepositOutcome_5_list) NewElement() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Proposal_7_list) IsValid() bool {
+func (x *_DepositOutcome_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DepositOutcome_6_list)(nil)
+
+type _DepositOutcome_6_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_DepositOutcome_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DepositOutcome_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DepositOutcome_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DepositOutcome_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DepositOutcome_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DepositOutcome_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DepositOutcome_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DepositOutcome_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DepositOutcome_7_list)(nil)
+
+type _DepositOutcome_7_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_DepositOutcome_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DepositOutcome_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DepositOutcome_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DepositOutcome_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DepositOutcome_7_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DepositOutcome_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DepositOutcome_7_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DepositOutcome_7_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_Proposal                           protoreflect.MessageDescriptor
-	fd_Proposal_id                        protoreflect.FieldDescriptor
-	fd_Proposal_messages                  protoreflect.FieldDescriptor
-	fd_Proposal_status                    protoreflect.FieldDescriptor
-	fd_Proposal_final_tally_result        protoreflect.FieldDescriptor
-	fd_Proposal_submit_time               protoreflect.FieldDescriptor
-	fd_Proposal_deposit_end_time          protoreflect.FieldDescriptor
-	fd_Proposal_total_deposit             protoreflect.FieldDescriptor
-	fd_Proposal_voting_start_time         protoreflect.FieldDescriptor
-	fd_Proposal_voting_end_time           protoreflect.FieldDescriptor
-	fd_Proposal_emergency_start_time      protoreflect.FieldDescriptor
-	fd_Proposal_emergency_next_tally_time protoreflect.FieldDescriptor
-	fd_Proposal_metadata                  protoreflect.FieldDescriptor
-	fd_Proposal_title                     protoreflect.FieldDescriptor
-	fd_Proposal_summary                   protoreflect.FieldDescriptor
-	fd_Proposal_proposer                  protoreflect.FieldDescriptor
-	fd_Proposal_expedited                 protoreflect.FieldDescriptor
-	fd_Proposal_emergency                 protoreflect.FieldDescriptor
-	fd_Proposal_failed_reason             protoreflect.FieldDescriptor
+	md_DepositOutcome             protoreflect.MessageDescriptor
+	fd_DepositOutcome_proposal_id protoreflect.FieldDescriptor
+	fd_DepositOutcome_depositor   protoreflect.FieldDescriptor
+	fd_DepositOutcome_outcome     protoreflect.FieldDescriptor
+	fd_DepositOutcome_amount      protoreflect.FieldDescriptor
+	fd_DepositOutcome_refunded    protoreflect.FieldDescriptor
+	fd_DepositOutcome_burned      protoreflect.FieldDescriptor
+	fd_DepositOutcome_charged     protoreflect.FieldDescriptor
+	fd_DepositOutcome_destination protoreflect.FieldDescriptor
+	fd_DepositOutcome_height      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_initia_gov_v1_gov_proto_init()
-	md_Proposal = File_initia_gov_v1_gov_proto.Messages().ByName("Proposal")
-	fd_Proposal_id = md_Proposal.Fields().ByName("id")
-	fd_Proposal_messages = md_Proposal.Fields().ByName("messages")
-	fd_Proposal_status = md_Proposal.Fields().ByName("status")
-	fd_Proposal_final_tally_result = md_Proposal.Fields().ByName("final_tally_result")
-	fd_Proposal_submit_time = md_Proposal.Fields().ByName("submit_time")
-	fd_Proposal_deposit_end_time = md_Proposal.Fields().ByName("deposit_end_time")
-	fd_Proposal_total_deposit = md_Proposal.Fields().ByName("total_deposit")
-	fd_Proposal_voting_start_time = md_Proposal.Fields().ByName("voting_start_time")
-	fd_Proposal_voting_end_time = md_Proposal.Fields().ByName("voting_end_time")
-	fd_Proposal_emergency_start_time = md_Proposal.Fields().ByName("emergency_start_time")
-	fd_Proposal_emergency_next_tally_time = md_Proposal.Fields().ByName("emergency_next_tally_time")
-	fd_Proposal_metadata = md_Proposal.Fields().ByName("metadata")
-	fd_Proposal_title = md_Proposal.Fields().ByName("title")
-	fd_Proposal_summary = md_Proposal.Fields().ByName("summary")
-	fd_Proposal_proposer = md_Proposal.Fields().ByName("proposer")
-	fd_Proposal_expedited = md_Proposal.Fields().ByName("expedited")
-	fd_Proposal_emergency = md_Proposal.Fields().ByName("emergency")
-	fd_Proposal_failed_reason = md_Proposal.Fields().ByName("failed_reason")
+	md_DepositOutcome = File_initia_gov_v1_gov_proto.Messages().ByName("DepositOutcome")
+	fd_DepositOutcome_proposal_id = md_DepositOutcome.Fields().ByName("proposal_id")
+	fd_DepositOutcome_depositor = md_DepositOutcome.Fields().ByName("depositor")
+	fd_DepositOutcome_outcome = md_DepositOutcome.Fields().ByName("outcome")
+	fd_DepositOutcome_amount = md_DepositOutcome.Fields().ByName("amount")
+	fd_DepositOutcome_refunded = md_DepositOutcome.Fields().ByName("refunded")
+	fd_DepositOutcome_burned = md_DepositOutcome.Fields().ByName("burned")
+	fd_DepositOutcome_charged = md_DepositOutcome.Fields().ByName("charged")
+	fd_DepositOutcome_destination = md_DepositOutcome.Fields().ByName("destination")
+	fd_DepositOutcome_height = md_DepositOutcome.Fields().ByName("height")
 }
 
-var _ protoreflect.Message = (*fastReflection_Proposal)(nil)
+var _ protoreflect.Message = (*fastReflection_DepositOutcome)(nil)
 
-type fastReflection_Proposal Proposal
+type fastReflection_DepositOutcome DepositOutcome
 
-func (x *Proposal) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Proposal)(x)
+func (x *DepositOutcome) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DepositOutcome)(x)
 }
 
-func (x *Proposal) slowProtoReflect() protoreflect.Message {
-	mi := &file_initia_gov_v1_gov_proto_msgTypes[3]
+func (x *DepositOutcome) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_gov_v1_gov_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3319,156 +10616,102 @@ func (x *Proposal) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Proposal_messageType fastReflection_Proposal_messageType
-var _ protoreflect.MessageType = fastReflection_Proposal_messageType{}
+var _fastReflection_DepositOutcome_messageType fastReflection_DepositOutcome_messageType
+var _ protoreflect.MessageType = fastReflection_DepositOutcome_messageType{}
 
-type fastReflection_Proposal_messageType struct{}
+type fastReflection_DepositOutcome_messageType struct{}
 
-func (x fastReflection_Proposal_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Proposal)(nil)
+func (x fastReflection_DepositOutcome_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DepositOutcome)(nil)
 }
-func (x fastReflection_Proposal_messageType) New() protoreflect.Message {
-	return new(fastReflection_Proposal)
+func (x fastReflection_DepositOutcome_messageType) New() protoreflect.Message {
+	return new(fastReflection_DepositOutcome)
 }
-func (x fastReflection_Proposal_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Proposal
+func (x fastReflection_DepositOutcome_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositOutcome
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Proposal) Descriptor() protoreflect.MessageDescriptor {
-	return md_Proposal
+func (x *fastReflection_DepositOutcome) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositOutcome
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Proposal) Type() protoreflect.MessageType {
-	return _fastReflection_Proposal_messageType
-}
-
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Proposal) New() protoreflect.Message {
-	return new(fastReflection_Proposal)
-}
-
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_Proposal) Interface() protoreflect.ProtoMessage {
-	return (*Proposal)(x)
-}
-
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_Proposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_Proposal_id, value) {
-			return
-		}
-	}
-	if len(x.Messages) != 0 {
-		value := protoreflect.ValueOfList(&_Proposal_2_list{list: &x.Messages})
-		if !f(fd_Proposal_messages, value) {
-			return
-		}
-	}
-	if x.Status != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
-		if !f(fd_Proposal_status, value) {
-			return
-		}
-	}
-	if x.FinalTallyResult != nil {
-		value := protoreflect.ValueOfMessage(x.FinalTallyResult.ProtoReflect())
-		if !f(fd_Proposal_final_tally_result, value) {
-			return
-		}
-	}
-	if x.SubmitTime != nil {
-		value := protoreflect.ValueOfMessage(x.SubmitTime.ProtoReflect())
-		if !f(fd_Proposal_submit_time, value) {
-			return
-		}
-	}
-	if x.DepositEndTime != nil {
-		value := protoreflect.ValueOfMessage(x.DepositEndTime.ProtoReflect())
-		if !f(fd_Proposal_deposit_end_time, value) {
-			return
-		}
-	}
-	if len(x.TotalDeposit) != 0 {
-		value := protoreflect.ValueOfList(&_Proposal_7_list{list: &x.TotalDeposit})
-		if !f(fd_Proposal_total_deposit, value) {
-			return
-		}
-	}
-	if x.VotingStartTime != nil {
-		value := protoreflect.ValueOfMessage(x.VotingStartTime.ProtoReflect())
-		if !f(fd_Proposal_voting_start_time, value) {
-			return
-		}
-	}
-	if x.VotingEndTime != nil {
-		value := protoreflect.ValueOfMessage(x.VotingEndTime.ProtoReflect())
-		if !f(fd_Proposal_voting_end_time, value) {
-			return
-		}
-	}
-	if x.EmergencyStartTime != nil {
-		value := protoreflect.ValueOfMessage(x.EmergencyStartTime.ProtoReflect())
-		if !f(fd_Proposal_emergency_start_time, value) {
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DepositOutcome) Type() protoreflect.MessageType {
+	return _fastReflection_DepositOutcome_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DepositOutcome) New() protoreflect.Message {
+	return new(fastReflection_DepositOutcome)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DepositOutcome) Interface() protoreflect.ProtoMessage {
+	return (*DepositOutcome)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DepositOutcome) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_DepositOutcome_proposal_id, value) {
 			return
 		}
 	}
-	if x.EmergencyNextTallyTime != nil {
-		value := protoreflect.ValueOfMessage(x.EmergencyNextTallyTime.ProtoReflect())
-		if !f(fd_Proposal_emergency_next_tally_time, value) {
+	if x.Depositor != "" {
+		value := protoreflect.ValueOfString(x.Depositor)
+		if !f(fd_DepositOutcome_depositor, value) {
 			return
 		}
 	}
-	if x.Metadata != "" {
-		value := protoreflect.ValueOfString(x.Metadata)
-		if !f(fd_Proposal_metadata, value) {
+	if x.Outcome != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Outcome))
+		if !f(fd_DepositOutcome_outcome, value) {
 			return
 		}
 	}
-	if x.Title != "" {
-		value := protoreflect.ValueOfString(x.Title)
-		if !f(fd_Proposal_title, value) {
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_DepositOutcome_4_list{list: &x.Amount})
+		if !f(fd_DepositOutcome_amount, value) {
 			return
 		}
 	}
-	if x.Summary != "" {
-		value := protoreflect.ValueOfString(x.Summary)
-		if !f(fd_Proposal_summary, value) {
+	if len(x.Refunded) != 0 {
+		value := protoreflect.ValueOfList(&_DepositOutcome_5_list{list: &x.Refunded})
+		if !f(fd_DepositOutcome_refunded, value) {
 			return
 		}
 	}
-	if x.Proposer != "" {
-		value := protoreflect.ValueOfString(x.Proposer)
-		if !f(fd_Proposal_proposer, value) {
+	if len(x.Burned) != 0 {
+		value := protoreflect.ValueOfList(&_DepositOutcome_6_list{list: &x.Burned})
+		if !f(fd_DepositOutcome_burned, value) {
 			return
 		}
 	}
-	if x.Expedited != false {
-		value := protoreflect.ValueOfBool(x.Expedited)
-		if !f(fd_Proposal_expedited, value) {
+	if len(x.Charged) != 0 {
+		value := protoreflect.ValueOfList(&_DepositOutcome_7_list{list: &x.Charged})
+		if !f(fd_DepositOutcome_charged, value) {
 			return
 		}
 	}
-	if x.Emergency != false {
-		value := protoreflect.ValueOfBool(x.Emergency)
-		if !f(fd_Proposal_emergency, value) {
+	if x.Destination != "" {
+		value := protoreflect.ValueOfString(x.Destination)
+		if !f(fd_DepositOutcome_destination, value) {
 			return
 		}
 	}
-	if x.FailedReason != "" {
-		value := protoreflect.ValueOfString(x.FailedReason)
-		if !f(fd_Proposal_failed_reason, value) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_DepositOutcome_height, value) {
 			return
 		}
 	}
@@ -3485,49 +10728,31 @@ func (x *fastReflection_Proposal) Range(f func(protoreflect.FieldDescriptor, pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Proposal) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DepositOutcome) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "initia.gov.v1.Proposal.id":
-		return x.Id != uint64(0)
-	case "initia.gov.v1.Proposal.messages":
-		return len(x.Messages) != 0
-	case "initia.gov.v1.Proposal.status":
-		return x.Status != 0
-	case "initia.gov.v1.Proposal.final_tally_result":
-		return x.FinalTallyResult != nil
-	case "initia.gov.v1.Proposal.submit_time":
-		return x.SubmitTime != nil
-	case "initia.gov.v1.Proposal.deposit_end_time":
-		return x.DepositEndTime != nil
-	case "initia.gov.v1.Proposal.total_deposit":
-		return len(x.TotalDeposit) != 0
-	case "initia.gov.v1.Proposal.voting_start_time":
-		return x.VotingStartTime != nil
-	case "initia.gov.v1.Proposal.voting_end_time":
-		return x.VotingEndTime != nil
-	case "initia.gov.v1.Proposal.emergency_start_time":
-		return x.EmergencyStartTime != nil
-	case "initia.gov.v1.Proposal.emergency_next_tally_time":
-		return x.EmergencyNextTallyTime != nil
-	case "initia.gov.v1.Proposal.metadata":
-		return x.Metadata != ""
-	case "initia.gov.v1.Proposal.title":
-		return x.Title != ""
-	case "initia.gov.v1.Proposal.summary":
-		return x.Summary != ""
-	case "initia.gov.v1.Proposal.proposer":
-		return x.Proposer != ""
-	case "initia.gov.v1.Proposal.expedited":
-		return x.Expedited != false
-	case "initia.gov.v1.Proposal.emergency":
-		return x.Emergency != false
-	case "initia.gov.v1.Proposal.failed_reason":
-		return x.FailedReason != ""
+	case "initia.gov.v1.DepositOutcome.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "initia.gov.v1.DepositOutcome.depositor":
+		return x.Depositor != ""
+	case "initia.gov.v1.DepositOutcome.outcome":
+		return x.Outcome != 0
+	case "initia.gov.v1.DepositOutcome.amount":
+		return len(x.Amount) != 0
+	case "initia.gov.v1.DepositOutcome.refunded":
+		return len(x.Refunded) != 0
+	case "initia.gov.v1.DepositOutcome.burned":
+		return len(x.Burned) != 0
+	case "initia.gov.v1.DepositOutcome.charged":
+		return len(x.Charged) != 0
+	case "initia.gov.v1.DepositOutcome.destination":
+		return x.Destination != ""
+	case "initia.gov.v1.DepositOutcome.height":
+		return x.Height != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.DepositOutcome"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.DepositOutcome does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3537,49 +10762,31 @@ func (x *fastReflection_Proposal) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Proposal) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DepositOutcome) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "initia.gov.v1.Proposal.id":
-		x.Id = uint64(0)
-	case "initia.gov.v1.Proposal.messages":
-		x.Messages = nil
-	case "initia.gov.v1.Proposal.status":
-		x.Status = 0
-	case "initia.gov.v1.Proposal.final_tally_result":
-		x.FinalTallyResult = nil
-	case "initia.gov.v1.Proposal.submit_time":
-		x.SubmitTime = nil
-	case "initia.gov.v1.Proposal.deposit_end_time":
-		x.DepositEndTime = nil
-	case "initia.gov.v1.Proposal.total_deposit":
-		x.TotalDeposit = nil
-	case "initia.gov.v1.Proposal.voting_start_time":
-		x.VotingStartTime = nil
-	case "initia.gov.v1.Proposal.voting_end_time":
-		x.VotingEndTime = nil
-	case "initia.gov.v1.Proposal.emergency_start_time":
-		x.EmergencyStartTime = nil
-	case "initia.gov.v1.Proposal.emergency_next_tally_time":
-		x.EmergencyNextTallyTime = nil
-	case "initia.gov.v1.Proposal.metadata":
-		x.Metadata = ""
-	case "initia.gov.v1.Proposal.title":
-		x.Title = ""
-	case "initia.gov.v1.Proposal.summary":
-		x.Summary = ""
-	case "initia.gov.v1.Proposal.proposer":
-		x.Proposer = ""
-	case "initia.gov.v1.Proposal.expedited":
-		x.Expedited = false
-	case "initia.gov.v1.Proposal.emergency":
-		x.Emergency = false
-	case "initia.gov.v1.Proposal.failed_reason":
-		x.FailedReason = ""
+	case "initia.gov.v1.DepositOutcome.proposal_id":
+		x.ProposalId = uint64(0)
+	case "initia.gov.v1.DepositOutcome.depositor":
+		x.Depositor = ""
+	case "initia.gov.v1.DepositOutcome.outcome":
+		x.Outcome = 0
+	case "initia.gov.v1.DepositOutcome.amount":
+		x.Amount = nil
+	case "initia.gov.v1.DepositOutcome.refunded":
+		x.Refunded = nil
+	case "initia.gov.v1.DepositOutcome.burned":
+		x.Burned = nil
+	case "initia.gov.v1.DepositOutcome.charged":
+		x.Charged = nil
+	case "initia.gov.v1.DepositOutcome.destination":
+		x.Destination = ""
+	case "initia.gov.v1.DepositOutcome.height":
+		x.Height = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.DepositOutcome"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.DepositOutcome does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3589,73 +10796,52 @@ func (x *fastReflection_Proposal) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Proposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DepositOutcome) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "initia.gov.v1.Proposal.id":
-		value := x.Id
+	case "initia.gov.v1.DepositOutcome.proposal_id":
+		value := x.ProposalId
 		return protoreflect.ValueOfUint64(value)
-	case "initia.gov.v1.Proposal.messages":
-		if len(x.Messages) == 0 {
-			return protoreflect.ValueOfList(&_Proposal_2_list{})
+	case "initia.gov.v1.DepositOutcome.depositor":
+		value := x.Depositor
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.DepositOutcome.outcome":
+		value := x.Outcome
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "initia.gov.v1.DepositOutcome.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_DepositOutcome_4_list{})
 		}
-		listValue := &_Proposal_2_list{list: &x.Messages}
+		listValue := &_DepositOutcome_4_list{list: &x.Amount}
 		return protoreflect.ValueOfList(listValue)
-	case "initia.gov.v1.Proposal.status":
-		value := x.Status
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "initia.gov.v1.Proposal.final_tally_result":
-		value := x.FinalTallyResult
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "initia.gov.v1.Proposal.submit_time":
-		value := x.SubmitTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "initia.gov.v1.Proposal.deposit_end_time":
-		value := x.DepositEndTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "initia.gov.v1.Proposal.total_deposit":
-		if len(x.TotalDeposit) == 0 {
-			return protoreflect.ValueOfList(&_Proposal_7_list{})
+	case "initia.gov.v1.DepositOutcome.refunded":
+		if len(x.Refunded) == 0 {
+			return protoreflect.ValueOfList(&_DepositOutcome_5_list{})
 		}
-		listValue := &_Proposal_7_list{list: &x.TotalDeposit}
+		listValue := &_DepositOutcome_5_list{list: &x.Refunded}
 		return protoreflect.ValueOfList(listValue)
-	case "initia.gov.v1.Proposal.voting_start_time":
-		value := x.VotingStartTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "initia.gov.v1.Proposal.voting_end_time":
-		value := x.VotingEndTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "initia.gov.v1.Proposal.emergency_start_time":
-		value := x.EmergencyStartTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "initia.gov.v1.Proposal.emergency_next_tally_time":
-		value := x.EmergencyNextTallyTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "initia.gov.v1.Proposal.metadata":
-		value := x.Metadata
-		return protoreflect.ValueOfString(value)
-	case "initia.gov.v1.Proposal.title":
-		value := x.Title
-		return protoreflect.ValueOfString(value)
-	case "initia.gov.v1.Proposal.summary":
-		value := x.Summary
-		return protoreflect.ValueOfString(value)
-	case "initia.gov.v1.Proposal.proposer":
-		value := x.Proposer
-		return protoreflect.ValueOfString(value)
-	case "initia.gov.v1.Proposal.expedited":
-		value := x.Expedited
-		return protoreflect.ValueOfBool(value)
-	case "initia.gov.v1.Proposal.emergency":
-		value := x.Emergency
-		return protoreflect.ValueOfBool(value)
-	case "initia.gov.v1.Proposal.failed_reason":
-		value := x.FailedReason
+	case "initia.gov.v1.DepositOutcome.burned":
+		if len(x.Burned) == 0 {
+			return protoreflect.ValueOfList(&_DepositOutcome_6_list{})
+		}
+		listValue := &_DepositOutcome_6_list{list: &x.Burned}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.gov.v1.DepositOutcome.charged":
+		if len(x.Charged) == 0 {
+			return protoreflect.ValueOfList(&_DepositOutcome_7_list{})
+		}
+		listValue := &_DepositOutcome_7_list{list: &x.Charged}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.gov.v1.DepositOutcome.destination":
+		value := x.Destination
 		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.DepositOutcome.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.DepositOutcome"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.Proposal does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.DepositOutcome does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3669,53 +10855,39 @@ func (x *fastReflection_Proposal) Get(descriptor protoreflect.FieldDescriptor) p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Proposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DepositOutcome) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "initia.gov.v1.Proposal.id":
-		x.Id = value.Uint()
-	case "initia.gov.v1.Proposal.messages":
+	case "initia.gov.v1.DepositOutcome.proposal_id":
+		x.ProposalId = value.Uint()
+	case "initia.gov.v1.DepositOutcome.depositor":
+		x.Depositor = value.Interface().(string)
+	case "initia.gov.v1.DepositOutcome.outcome":
+		x.Outcome = (DepositOutcomeType)(value.Enum())
+	case "initia.gov.v1.DepositOutcome.amount":
 		lv := value.List()
-		clv := lv.(*_Proposal_2_list)
-		x.Messages = *clv.list
-	case "initia.gov.v1.Proposal.status":
-		x.Status = (v1.ProposalStatus)(value.Enum())
-	case "initia.gov.v1.Proposal.final_tally_result":
-		x.FinalTallyResult = value.Message().Interface().(*TallyResult)
-	case "initia.gov.v1.Proposal.submit_time":
-		x.SubmitTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "initia.gov.v1.Proposal.deposit_end_time":
-		x.DepositEndTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "initia.gov.v1.Proposal.total_deposit":
+		clv := lv.(*_DepositOutcome_4_list)
+		x.Amount = *clv.list
+	case "initia.gov.v1.DepositOutcome.refunded":
 		lv := value.List()
-		clv := lv.(*_Proposal_7_list)
-		x.TotalDeposit = *clv.list
-	case "initia.gov.v1.Proposal.voting_start_time":
-		x.VotingStartTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "initia.gov.v1.Proposal.voting_end_time":
-		x.VotingEndTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "initia.gov.v1.Proposal.emergency_start_time":
-		x.EmergencyStartTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "initia.gov.v1.Proposal.emergency_next_tally_time":
-		x.EmergencyNextTallyTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "initia.gov.v1.Proposal.metadata":
-		x.Metadata = value.Interface().(string)
-	case "initia.gov.v1.Proposal.title":
-		x.Title = value.Interface().(string)
-	case "initia.gov.v1.Proposal.summary":
-		x.Summary = value.Interface().(string)
-	case "initia.gov.v1.Proposal.proposer":
-		x.Proposer = value.Interface().(string)
-	case "initia.gov.v1.Proposal.expedited":
-		x.Expedited = value.Bool()
-	case "initia.gov.v1.Proposal.emergency":
-		x.Emergency = value.Bool()
-	case "initia.gov.v1.Proposal.failed_reason":
-		x.FailedReason = value.Interface().(string)
+		clv := lv.(*_DepositOutcome_5_list)
+		x.Refunded = *clv.list
+	case "initia.gov.v1.DepositOutcome.burned":
+		lv := value.List()
+		clv := lv.(*_DepositOutcome_6_list)
+		x.Burned = *clv.list
+	case "initia.gov.v1.DepositOutcome.charged":
+		lv := value.List()
+		clv := lv.(*_DepositOutcome_7_list)
+		x.Charged = *clv.list
+	case "initia.gov.v1.DepositOutcome.destination":
+		x.Destination = value.Interface().(string)
+	case "initia.gov.v1.DepositOutcome.height":
+		x.Height = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.DepositOutcome"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.DepositOutcome does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3729,146 +10901,92 @@ func (x *fastReflection_Proposal) Set(fd protoreflect.FieldDescriptor, value pro
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Proposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "initia.gov.v1.Proposal.messages":
-		if x.Messages == nil {
-			x.Messages = []*anypb.Any{}
-		}
-		value := &_Proposal_2_list{list: &x.Messages}
-		return protoreflect.ValueOfList(value)
-	case "initia.gov.v1.Proposal.final_tally_result":
-		if x.FinalTallyResult == nil {
-			x.FinalTallyResult = new(TallyResult)
-		}
-		return protoreflect.ValueOfMessage(x.FinalTallyResult.ProtoReflect())
-	case "initia.gov.v1.Proposal.submit_time":
-		if x.SubmitTime == nil {
-			x.SubmitTime = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.SubmitTime.ProtoReflect())
-	case "initia.gov.v1.Proposal.deposit_end_time":
-		if x.DepositEndTime == nil {
-			x.DepositEndTime = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.DepositEndTime.ProtoReflect())
-	case "initia.gov.v1.Proposal.total_deposit":
-		if x.TotalDeposit == nil {
-			x.TotalDeposit = []*v1beta1.Coin{}
-		}
-		value := &_Proposal_7_list{list: &x.TotalDeposit}
-		return protoreflect.ValueOfList(value)
-	case "initia.gov.v1.Proposal.voting_start_time":
-		if x.VotingStartTime == nil {
-			x.VotingStartTime = new(timestamppb.Timestamp)
+func (x *fastReflection_DepositOutcome) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.DepositOutcome.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
 		}
-		return protoreflect.ValueOfMessage(x.VotingStartTime.ProtoReflect())
-	case "initia.gov.v1.Proposal.voting_end_time":
-		if x.VotingEndTime == nil {
-			x.VotingEndTime = new(timestamppb.Timestamp)
+		value := &_DepositOutcome_4_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "initia.gov.v1.DepositOutcome.refunded":
+		if x.Refunded == nil {
+			x.Refunded = []*v1beta1.Coin{}
 		}
-		return protoreflect.ValueOfMessage(x.VotingEndTime.ProtoReflect())
-	case "initia.gov.v1.Proposal.emergency_start_time":
-		if x.EmergencyStartTime == nil {
-			x.EmergencyStartTime = new(timestamppb.Timestamp)
+		value := &_DepositOutcome_5_list{list: &x.Refunded}
+		return protoreflect.ValueOfList(value)
+	case "initia.gov.v1.DepositOutcome.burned":
+		if x.Burned == nil {
+			x.Burned = []*v1beta1.Coin{}
 		}
-		return protoreflect.ValueOfMessage(x.EmergencyStartTime.ProtoReflect())
-	case "initia.gov.v1.Proposal.emergency_next_tally_time":
-		if x.EmergencyNextTallyTime == nil {
-			x.EmergencyNextTallyTime = new(timestamppb.Timestamp)
+		value := &_DepositOutcome_6_list{list: &x.Burned}
+		return protoreflect.ValueOfList(value)
+	case "initia.gov.v1.DepositOutcome.charged":
+		if x.Charged == nil {
+			x.Charged = []*v1beta1.Coin{}
 		}
-		return protoreflect.ValueOfMessage(x.EmergencyNextTallyTime.ProtoReflect())
-	case "initia.gov.v1.Proposal.id":
-		panic(fmt.Errorf("field id of message initia.gov.v1.Proposal is not mutable"))
-	case "initia.gov.v1.Proposal.status":
-		panic(fmt.Errorf("field status of message initia.gov.v1.Proposal is not mutable"))
-	case "initia.gov.v1.Proposal.metadata":
-		panic(fmt.Errorf("field metadata of message initia.gov.v1.Proposal is not mutable"))
-	case "initia.gov.v1.Proposal.title":
-		panic(fmt.Errorf("field title of message initia.gov.v1.Proposal is not mutable"))
-	case "initia.gov.v1.Proposal.summary":
-		panic(fmt.Errorf("field summary of message initia.gov.v1.Proposal is not mutable"))
-	case "initia.gov.v1.Proposal.proposer":
-		panic(fmt.Errorf("field proposer of message initia.gov.v1.Proposal is not mutable"))
-	case "initia.gov.v1.Proposal.expedited":
-		panic(fmt.Errorf("field expedited of message initia.gov.v1.Proposal is not mutable"))
-	case "initia.gov.v1.Proposal.emergency":
-		panic(fmt.Errorf("field emergency of message initia.gov.v1.Proposal is not mutable"))
-	case "initia.gov.v1.Proposal.failed_reason":
-		panic(fmt.Errorf("field failed_reason of message initia.gov.v1.Proposal is not mutable"))
+		value := &_DepositOutcome_7_list{list: &x.Charged}
+		return protoreflect.ValueOfList(value)
+	case "initia.gov.v1.DepositOutcome.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message initia.gov.v1.DepositOutcome is not mutable"))
+	case "initia.gov.v1.DepositOutcome.depositor":
+		panic(fmt.Errorf("field depositor of message initia.gov.v1.DepositOutcome is not mutable"))
+	case "initia.gov.v1.DepositOutcome.outcome":
+		panic(fmt.Errorf("field outcome of message initia.gov.v1.DepositOutcome is not mutable"))
+	case "initia.gov.v1.DepositOutcome.destination":
+		panic(fmt.Errorf("field destination of message initia.gov.v1.DepositOutcome is not mutable"))
+	case "initia.gov.v1.DepositOutcome.height":
+		panic(fmt.Errorf("field height of message initia.gov.v1.DepositOutcome is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.DepositOutcome"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.DepositOutcome does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Proposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DepositOutcome) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.gov.v1.Proposal.id":
+	case "initia.gov.v1.DepositOutcome.proposal_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "initia.gov.v1.Proposal.messages":
-		list := []*anypb.Any{}
-		return protoreflect.ValueOfList(&_Proposal_2_list{list: &list})
-	case "initia.gov.v1.Proposal.status":
+	case "initia.gov.v1.DepositOutcome.depositor":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.DepositOutcome.outcome":
 		return protoreflect.ValueOfEnum(0)
-	case "initia.gov.v1.Proposal.final_tally_result":
-		m := new(TallyResult)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "initia.gov.v1.Proposal.submit_time":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "initia.gov.v1.Proposal.deposit_end_time":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "initia.gov.v1.Proposal.total_deposit":
+	case "initia.gov.v1.DepositOutcome.amount":
 		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Proposal_7_list{list: &list})
-	case "initia.gov.v1.Proposal.voting_start_time":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "initia.gov.v1.Proposal.voting_end_time":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "initia.gov.v1.Proposal.emergency_start_time":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "initia.gov.v1.Proposal.emergency_next_tally_time":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "initia.gov.v1.Proposal.metadata":
-		return protoreflect.ValueOfString("")
-	case "initia.gov.v1.Proposal.title":
-		return protoreflect.ValueOfString("")
-	case "initia.gov.v1.Proposal.summary":
-		return protoreflect.ValueOfString("")
-	case "initia.gov.v1.Proposal.proposer":
-		return protoreflect.ValueOfString("")
-	case "initia.gov.v1.Proposal.expedited":
-		return protoreflect.ValueOfBool(false)
-	case "initia.gov.v1.Proposal.emergency":
-		return protoreflect.ValueOfBool(false)
-	case "initia.gov.v1.Proposal.failed_reason":
+		return protoreflect.ValueOfList(&_DepositOutcome_4_list{list: &list})
+	case "initia.gov.v1.DepositOutcome.refunded":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_DepositOutcome_5_list{list: &list})
+	case "initia.gov.v1.DepositOutcome.burned":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_DepositOutcome_6_list{list: &list})
+	case "initia.gov.v1.DepositOutcome.charged":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_DepositOutcome_7_list{list: &list})
+	case "initia.gov.v1.DepositOutcome.destination":
 		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.DepositOutcome.height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.DepositOutcome"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.DepositOutcome does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Proposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DepositOutcome) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in initia.gov.v1.Proposal", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in initia.gov.v1.DepositOutcome", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3876,7 +10994,7 @@ func (x *fastReflection_Proposal) WhichOneof(d protoreflect.OneofDescriptor) pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Proposal) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DepositOutcome) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3887,7 +11005,7 @@ func (x *fastReflection_Proposal) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Proposal) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DepositOutcome) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3899,7 +11017,7 @@ func (x *fastReflection_Proposal) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Proposal) IsValid() bool {
+func (x *fastReflection_DepositOutcome) IsValid() bool {
 	return x != nil
 }
 
@@ -3909,9 +11027,9 @@ func (x *fastReflection_Proposal) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DepositOutcome) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Proposal)
+		x := input.Message.Interface().(*DepositOutcome)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3923,77 +11041,46 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
-		}
-		if len(x.Messages) > 0 {
-			for _, e := range x.Messages {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Status != 0 {
-			n += 1 + runtime.Sov(uint64(x.Status))
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
 		}
-		if x.FinalTallyResult != nil {
-			l = options.Size(x.FinalTallyResult)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.SubmitTime != nil {
-			l = options.Size(x.SubmitTime)
+		l = len(x.Depositor)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.DepositEndTime != nil {
-			l = options.Size(x.DepositEndTime)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Outcome != 0 {
+			n += 1 + runtime.Sov(uint64(x.Outcome))
 		}
-		if len(x.TotalDeposit) > 0 {
-			for _, e := range x.TotalDeposit {
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.VotingStartTime != nil {
-			l = options.Size(x.VotingStartTime)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.VotingEndTime != nil {
-			l = options.Size(x.VotingEndTime)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.EmergencyStartTime != nil {
-			l = options.Size(x.EmergencyStartTime)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.EmergencyNextTallyTime != nil {
-			l = options.Size(x.EmergencyNextTallyTime)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Metadata)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Refunded) > 0 {
+			for _, e := range x.Refunded {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.Title)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Burned) > 0 {
+			for _, e := range x.Burned {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.Summary)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Charged) > 0 {
+			for _, e := range x.Charged {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.Proposer)
+		l = len(x.Destination)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Expedited {
-			n += 3
-		}
-		if x.Emergency {
-			n += 3
-		}
-		l = len(x.FailedReason)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4002,148 +11089,43 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 			Size:              n,
 		}
-	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Proposal)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.FailedReason) > 0 {
-			i -= len(x.FailedReason)
-			copy(dAtA[i:], x.FailedReason)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FailedReason)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
-		}
-		if x.Emergency {
-			i--
-			if x.Emergency {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x88
-		}
-		if x.Expedited {
-			i--
-			if x.Expedited {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x80
-		}
-		if len(x.Proposer) > 0 {
-			i -= len(x.Proposer)
-			copy(dAtA[i:], x.Proposer)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposer)))
-			i--
-			dAtA[i] = 0x7a
-		}
-		if len(x.Summary) > 0 {
-			i -= len(x.Summary)
-			copy(dAtA[i:], x.Summary)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Summary)))
-			i--
-			dAtA[i] = 0x72
-		}
-		if len(x.Title) > 0 {
-			i -= len(x.Title)
-			copy(dAtA[i:], x.Title)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
-			i--
-			dAtA[i] = 0x6a
-		}
-		if len(x.Metadata) > 0 {
-			i -= len(x.Metadata)
-			copy(dAtA[i:], x.Metadata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
-			i--
-			dAtA[i] = 0x62
-		}
-		if x.EmergencyNextTallyTime != nil {
-			encoded, err := options.Marshal(x.EmergencyNextTallyTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x5a
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DepositOutcome)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
 		}
-		if x.EmergencyStartTime != nil {
-			encoded, err := options.Marshal(x.EmergencyStartTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x52
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.VotingEndTime != nil {
-			encoded, err := options.Marshal(x.VotingEndTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x48
 		}
-		if x.VotingStartTime != nil {
-			encoded, err := options.Marshal(x.VotingStartTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Destination) > 0 {
+			i -= len(x.Destination)
+			copy(dAtA[i:], x.Destination)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Destination)))
 			i--
 			dAtA[i] = 0x42
 		}
-		if len(x.TotalDeposit) > 0 {
-			for iNdEx := len(x.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.TotalDeposit[iNdEx])
+		if len(x.Charged) > 0 {
+			for iNdEx := len(x.Charged) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Charged[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4157,56 +11139,41 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				dAtA[i] = 0x3a
 			}
 		}
-		if x.DepositEndTime != nil {
-			encoded, err := options.Marshal(x.DepositEndTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if x.SubmitTime != nil {
-			encoded, err := options.Marshal(x.SubmitTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.Burned) > 0 {
+			for iNdEx := len(x.Burned) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Burned[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x2a
 		}
-		if x.FinalTallyResult != nil {
-			encoded, err := options.Marshal(x.FinalTallyResult)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.Refunded) > 0 {
+			for iNdEx := len(x.Refunded) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Refunded[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.Status != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
-			i--
-			dAtA[i] = 0x18
 		}
-		if len(x.Messages) > 0 {
-			for iNdEx := len(x.Messages) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Messages[iNdEx])
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4217,11 +11184,23 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x12
+				dAtA[i] = 0x22
 			}
 		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+		if x.Outcome != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Outcome))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Depositor) > 0 {
+			i -= len(x.Depositor)
+			copy(dAtA[i:], x.Depositor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Depositor)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -4236,7 +11215,7 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Proposal)
+		x := input.Message.Interface().(*DepositOutcome)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4258,135 +11237,27 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Proposal: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-				}
-				x.Id = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Messages = append(x.Messages, &anypb.Any{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Messages[len(x.Messages)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-				}
-				x.Status = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Status |= v1.ProposalStatus(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.FinalTallyResult == nil {
-					x.FinalTallyResult = &TallyResult{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FinalTallyResult); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositOutcome: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositOutcome: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 				}
-				var msglen int
+				x.ProposalId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4396,33 +11267,16 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.ProposalId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.SubmitTime == nil {
-					x.SubmitTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SubmitTime); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 6:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4432,33 +11286,29 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.DepositEndTime == nil {
-					x.DepositEndTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DepositEndTime); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Depositor = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
 				}
-				var msglen int
+				x.Outcome = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4468,29 +11318,14 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Outcome |= DepositOutcomeType(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.TotalDeposit = append(x.TotalDeposit, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalDeposit[len(x.TotalDeposit)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 8:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4517,16 +11352,14 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.VotingStartTime == nil {
-					x.VotingStartTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingStartTime); err != nil {
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 9:
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4553,16 +11386,14 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.VotingEndTime == nil {
-					x.VotingEndTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingEndTime); err != nil {
+				x.Refunded = append(x.Refunded, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Refunded[len(x.Refunded)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 10:
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmergencyStartTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4589,16 +11420,14 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.EmergencyStartTime == nil {
-					x.EmergencyStartTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EmergencyStartTime); err != nil {
+				x.Burned = append(x.Burned, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Burned[len(x.Burned)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 11:
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmergencyNextTallyTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Charged", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4625,16 +11454,14 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.EmergencyNextTallyTime == nil {
-					x.EmergencyNextTallyTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EmergencyNextTallyTime); err != nil {
+				x.Charged = append(x.Charged, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Charged[len(x.Charged)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 12:
+			case 8:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4662,13 +11489,13 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Metadata = string(dAtA[iNdEx:postIndex])
+				x.Destination = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 13:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
-				var stringLen uint64
+				x.Height = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4678,59 +11505,466 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Height |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_VoteDelegation              protoreflect.MessageDescriptor
+	fd_VoteDelegation_delegator    protoreflect.FieldDescriptor
+	fd_VoteDelegation_delegate     protoreflect.FieldDescriptor
+	fd_VoteDelegation_msg_type_url protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_gov_v1_gov_proto_init()
+	md_VoteDelegation = File_initia_gov_v1_gov_proto.Messages().ByName("VoteDelegation")
+	fd_VoteDelegation_delegator = md_VoteDelegation.Fields().ByName("delegator")
+	fd_VoteDelegation_delegate = md_VoteDelegation.Fields().ByName("delegate")
+	fd_VoteDelegation_msg_type_url = md_VoteDelegation.Fields().ByName("msg_type_url")
+}
+
+var _ protoreflect.Message = (*fastReflection_VoteDelegation)(nil)
+
+type fastReflection_VoteDelegation VoteDelegation
+
+func (x *VoteDelegation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VoteDelegation)(x)
+}
+
+func (x *VoteDelegation) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_gov_v1_gov_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VoteDelegation_messageType fastReflection_VoteDelegation_messageType
+var _ protoreflect.MessageType = fastReflection_VoteDelegation_messageType{}
+
+type fastReflection_VoteDelegation_messageType struct{}
+
+func (x fastReflection_VoteDelegation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VoteDelegation)(nil)
+}
+func (x fastReflection_VoteDelegation_messageType) New() protoreflect.Message {
+	return new(fastReflection_VoteDelegation)
+}
+func (x fastReflection_VoteDelegation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VoteDelegation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VoteDelegation) Descriptor() protoreflect.MessageDescriptor {
+	return md_VoteDelegation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VoteDelegation) Type() protoreflect.MessageType {
+	return _fastReflection_VoteDelegation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VoteDelegation) New() protoreflect.Message {
+	return new(fastReflection_VoteDelegation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VoteDelegation) Interface() protoreflect.ProtoMessage {
+	return (*VoteDelegation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VoteDelegation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Delegator != "" {
+		value := protoreflect.ValueOfString(x.Delegator)
+		if !f(fd_VoteDelegation_delegator, value) {
+			return
+		}
+	}
+	if x.Delegate != "" {
+		value := protoreflect.ValueOfString(x.Delegate)
+		if !f(fd_VoteDelegation_delegate, value) {
+			return
+		}
+	}
+	if x.MsgTypeUrl != "" {
+		value := protoreflect.ValueOfString(x.MsgTypeUrl)
+		if !f(fd_VoteDelegation_msg_type_url, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VoteDelegation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.gov.v1.VoteDelegation.delegator":
+		return x.Delegator != ""
+	case "initia.gov.v1.VoteDelegation.delegate":
+		return x.Delegate != ""
+	case "initia.gov.v1.VoteDelegation.msg_type_url":
+		return x.MsgTypeUrl != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.VoteDelegation"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.VoteDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VoteDelegation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.gov.v1.VoteDelegation.delegator":
+		x.Delegator = ""
+	case "initia.gov.v1.VoteDelegation.delegate":
+		x.Delegate = ""
+	case "initia.gov.v1.VoteDelegation.msg_type_url":
+		x.MsgTypeUrl = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.VoteDelegation"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.VoteDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VoteDelegation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.gov.v1.VoteDelegation.delegator":
+		value := x.Delegator
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.VoteDelegation.delegate":
+		value := x.Delegate
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.VoteDelegation.msg_type_url":
+		value := x.MsgTypeUrl
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.VoteDelegation"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.VoteDelegation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VoteDelegation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.gov.v1.VoteDelegation.delegator":
+		x.Delegator = value.Interface().(string)
+	case "initia.gov.v1.VoteDelegation.delegate":
+		x.Delegate = value.Interface().(string)
+	case "initia.gov.v1.VoteDelegation.msg_type_url":
+		x.MsgTypeUrl = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.VoteDelegation"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.VoteDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VoteDelegation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.VoteDelegation.delegator":
+		panic(fmt.Errorf("field delegator of message initia.gov.v1.VoteDelegation is not mutable"))
+	case "initia.gov.v1.VoteDelegation.delegate":
+		panic(fmt.Errorf("field delegate of message initia.gov.v1.VoteDelegation is not mutable"))
+	case "initia.gov.v1.VoteDelegation.msg_type_url":
+		panic(fmt.Errorf("field msg_type_url of message initia.gov.v1.VoteDelegation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.VoteDelegation"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.VoteDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VoteDelegation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.VoteDelegation.delegator":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.VoteDelegation.delegate":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.VoteDelegation.msg_type_url":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.VoteDelegation"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.VoteDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VoteDelegation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.gov.v1.VoteDelegation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VoteDelegation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VoteDelegation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VoteDelegation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VoteDelegation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VoteDelegation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Delegator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Delegate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MsgTypeUrl)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VoteDelegation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MsgTypeUrl) > 0 {
+			i -= len(x.MsgTypeUrl)
+			copy(dAtA[i:], x.MsgTypeUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypeUrl)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Delegate) > 0 {
+			i -= len(x.Delegate)
+			copy(dAtA[i:], x.Delegate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegate)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Delegator) > 0 {
+			i -= len(x.Delegator)
+			copy(dAtA[i:], x.Delegator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VoteDelegation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Title = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 14:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.Summary = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 15:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VoteDelegation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VoteDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4758,33 +11992,13 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Proposer = string(dAtA[iNdEx:postIndex])
+				x.Delegator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 16:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.Expedited = bool(v != 0)
-			case 17:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Emergency", wireType)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 				}
-				var v int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4794,15 +12008,27 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.Emergency = bool(v != 0)
-			case 18:
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailedReason", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4830,7 +12056,7 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.FailedReason = string(dAtA[iNdEx:postIndex])
+				x.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -4880,6 +12106,64 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// DepositOutcomeType enumerates the final outcomes of a deposit.
+type DepositOutcomeType int32
+
+const (
+	// DEPOSIT_OUTCOME_TYPE_UNSPECIFIED defines an invalid outcome.
+	DepositOutcomeType_DEPOSIT_OUTCOME_TYPE_UNSPECIFIED DepositOutcomeType = 0
+	// DEPOSIT_OUTCOME_TYPE_REFUNDED defines the deposit returned to the depositor.
+	DepositOutcomeType_DEPOSIT_OUTCOME_TYPE_REFUNDED DepositOutcomeType = 1
+	// DEPOSIT_OUTCOME_TYPE_BURNED defines the deposit burned.
+	DepositOutcomeType_DEPOSIT_OUTCOME_TYPE_BURNED DepositOutcomeType = 2
+	// DEPOSIT_OUTCOME_TYPE_CHARGED defines the deposit charged with the proposal
+	// cancel ratio on the proposal cancel.
+	DepositOutcomeType_DEPOSIT_OUTCOME_TYPE_CHARGED DepositOutcomeType = 3
+)
+
+// Enum value maps for DepositOutcomeType.
+var (
+	DepositOutcomeType_name = map[int32]string{
+		0: "DEPOSIT_OUTCOME_TYPE_UNSPECIFIED",
+		1: "DEPOSIT_OUTCOME_TYPE_REFUNDED",
+		2: "DEPOSIT_OUTCOME_TYPE_BURNED",
+		3: "DEPOSIT_OUTCOME_TYPE_CHARGED",
+	}
+	DepositOutcomeType_value = map[string]int32{
+		"DEPOSIT_OUTCOME_TYPE_UNSPECIFIED": 0,
+		"DEPOSIT_OUTCOME_TYPE_REFUNDED":    1,
+		"DEPOSIT_OUTCOME_TYPE_BURNED":      2,
+		"DEPOSIT_OUTCOME_TYPE_CHARGED":     3,
+	}
+)
+
+func (x DepositOutcomeType) Enum() *DepositOutcomeType {
+	p := new(DepositOutcomeType)
+	*p = x
+	return p
+}
+
+func (x DepositOutcomeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DepositOutcomeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_initia_gov_v1_gov_proto_enumTypes[0].Descriptor()
+}
+
+func (DepositOutcomeType) Type() protoreflect.EnumType {
+	return &file_initia_gov_v1_gov_proto_enumTypes[0]
+}
+
+func (x DepositOutcomeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DepositOutcomeType.Descriptor instead.
+func (DepositOutcomeType) EnumDescriptor() ([]byte, []int) {
+	return file_initia_gov_v1_gov_proto_rawDescGZIP(), []int{0}
+}
+
 // Params defines the parameters for the x/gov module.
 type Params struct {
 	state         protoimpl.MessageState
@@ -4893,15 +12177,15 @@ type Params struct {
 	MaxDepositPeriod *durationpb.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *durationpb.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	//
@@ -4920,7 +12204,7 @@ type Params struct {
 	//
 	// Since: cosmos-sdk 0.50
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []*v1beta1.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit,omitempty"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -4944,166 +12228,575 @@ type Params struct {
 	LowThresholdFunctions []string `protobuf:"bytes,93,rep,name=low_threshold_functions,json=lowThresholdFunctions,proto3" json:"low_threshold_functions,omitempty"`
 	// Vesting is the vesting contract info for tally.
 	Vesting *Vesting `protobuf:"bytes,94,opt,name=vesting,proto3" json:"vesting,omitempty"`
+	// archive_voter_breakdown enables archiving the vote options and voting
+	// power of each voter when a proposal is finalized.
+	ArchiveVoterBreakdown bool `protobuf:"varint,95,opt,name=archive_voter_breakdown,json=archiveVoterBreakdown,proto3" json:"archive_voter_breakdown,omitempty"`
+	// voter_breakdown_retention is the number of the most recent proposals whose
+	// voter breakdowns are retained. Zero means the breakdowns are never pruned.
+	// The breakdowns are pruned on each tally even if archiving is disabled.
+	VoterBreakdownRetention uint64 `protobuf:"varint,96,opt,name=voter_breakdown_retention,json=voterBreakdownRetention,proto3" json:"voter_breakdown_retention,omitempty"`
+	// voting_power_providers are the Move view functions providing additional
+	// voting power of the voters, e.g. locked LP tokens or liquid staking receipts.
+	VotingPowerProviders []*VotingPowerProvider `protobuf:"bytes,97,rep,name=voting_power_providers,json=votingPowerProviders,proto3" json:"voting_power_providers,omitempty"`
+	// emergency_submitters are the accounts allowed to activate the emergency
+	// proposal by depositing the emergency minimum deposit, e.g. a security
+	// council multisig. Anyone can activate it if empty.
+	EmergencySubmitters []string `protobuf:"bytes,98,rep,name=emergency_submitters,json=emergencySubmitters,proto3" json:"emergency_submitters,omitempty"`
+	// emergency_escalation_threshold is the minimum ratio of the bonded validator
+	// voting power voted yes to escalate a proposal to the emergency proposal.
+	EmergencyEscalationThreshold string `protobuf:"bytes,99,opt,name=emergency_escalation_threshold,json=emergencyEscalationThreshold,proto3" json:"emergency_escalation_threshold,omitempty"`
+	// emergency_escalation_window is the duration from the voting start time
+	// during which a proposal can be escalated to the emergency proposal by the
+	// validator votes. Zero disables the escalation.
+	EmergencyEscalationWindow *durationpb.Duration `protobuf:"bytes,100,opt,name=emergency_escalation_window,json=emergencyEscalationWindow,proto3" json:"emergency_escalation_window,omitempty"`
+	// execution_delays are the delays of the execution of the passed proposals
+	// per message type url. A passed proposal is queued for the longest delay of
+	// its messages and executed immediately if none of them has a delay. The
+	// emergency proposals are executed immediately regardless of the delays.
+	ExecutionDelays []*ExecutionDelay `protobuf:"bytes,101,rep,name=execution_delays,json=executionDelays,proto3" json:"execution_delays,omitempty"`
+	// execution_cancellers are the accounts allowed to cancel the queued
+	// execution of a passed proposal in addition to the gov module account,
+	// e.g. a security council multisig.
+	ExecutionCancellers []string `protobuf:"bytes,102,rep,name=execution_cancellers,json=executionCancellers,proto3" json:"execution_cancellers,omitempty"`
+	// msg_type_params are the governance params overridden per message type url.
+	// When a proposal contains the messages of different types, the strictest
+	// params among them are applied.
+	MsgTypeParams []*MsgTypeParams `protobuf:"bytes,103,rep,name=msg_type_params,json=msgTypeParams,proto3" json:"msg_type_params,omitempty"`
+	// optimistic_msg_types are the message type urls eligible for the optimistic
+	// proposals. The optimistic proposals are disabled if empty.
+	OptimisticMsgTypes []string `protobuf:"bytes,104,rep,name=optimistic_msg_types,json=optimisticMsgTypes,proto3" json:"optimistic_msg_types,omitempty"`
+	// optimistic_rejection_threshold is the minimum proportion of the no and
+	// no with veto votes to the total voting power to reject an optimistic
+	// proposal.
+	OptimisticRejectionThreshold string `protobuf:"bytes,105,opt,name=optimistic_rejection_threshold,json=optimisticRejectionThreshold,proto3" json:"optimistic_rejection_threshold,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_gov_v1_gov_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_initia_gov_v1_gov_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetMinDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.MinDeposit
+	}
+	return nil
+}
+
+func (x *Params) GetMaxDepositPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.MaxDepositPeriod
+	}
+	return nil
+}
+
+func (x *Params) GetVotingPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.VotingPeriod
+	}
+	return nil
+}
+
+func (x *Params) GetQuorum() string {
+	if x != nil {
+		return x.Quorum
+	}
+	return ""
+}
+
+func (x *Params) GetThreshold() string {
+	if x != nil {
+		return x.Threshold
+	}
+	return ""
+}
+
+func (x *Params) GetVetoThreshold() string {
+	if x != nil {
+		return x.VetoThreshold
+	}
+	return ""
+}
+
+func (x *Params) GetMinInitialDepositRatio() string {
+	if x != nil {
+		return x.MinInitialDepositRatio
+	}
+	return ""
+}
+
+func (x *Params) GetProposalCancelRatio() string {
+	if x != nil {
+		return x.ProposalCancelRatio
+	}
+	return ""
+}
+
+func (x *Params) GetProposalCancelDest() string {
+	if x != nil {
+		return x.ProposalCancelDest
+	}
+	return ""
+}
+
+func (x *Params) GetExpeditedVotingPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.ExpeditedVotingPeriod
+	}
+	return nil
+}
+
+func (x *Params) GetExpeditedThreshold() string {
+	if x != nil {
+		return x.ExpeditedThreshold
+	}
+	return ""
+}
+
+func (x *Params) GetExpeditedMinDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.ExpeditedMinDeposit
+	}
+	return nil
+}
+
+func (x *Params) GetBurnVoteQuorum() bool {
+	if x != nil {
+		return x.BurnVoteQuorum
+	}
+	return false
+}
+
+func (x *Params) GetBurnProposalDepositPrevote() bool {
+	if x != nil {
+		return x.BurnProposalDepositPrevote
+	}
+	return false
+}
+
+func (x *Params) GetBurnVoteVeto() bool {
+	if x != nil {
+		return x.BurnVoteVeto
+	}
+	return false
+}
+
+func (x *Params) GetMinDepositRatio() string {
+	if x != nil {
+		return x.MinDepositRatio
+	}
+	return ""
+}
+
+func (x *Params) GetEmergencyMinDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.EmergencyMinDeposit
+	}
+	return nil
+}
+
+func (x *Params) GetEmergencyTallyInterval() *durationpb.Duration {
+	if x != nil {
+		return x.EmergencyTallyInterval
+	}
+	return nil
+}
+
+func (x *Params) GetLowThresholdFunctions() []string {
+	if x != nil {
+		return x.LowThresholdFunctions
+	}
+	return nil
+}
+
+func (x *Params) GetVesting() *Vesting {
+	if x != nil {
+		return x.Vesting
+	}
+	return nil
+}
+
+func (x *Params) GetArchiveVoterBreakdown() bool {
+	if x != nil {
+		return x.ArchiveVoterBreakdown
+	}
+	return false
+}
+
+func (x *Params) GetVoterBreakdownRetention() uint64 {
+	if x != nil {
+		return x.VoterBreakdownRetention
+	}
+	return 0
+}
+
+func (x *Params) GetVotingPowerProviders() []*VotingPowerProvider {
+	if x != nil {
+		return x.VotingPowerProviders
+	}
+	return nil
+}
+
+func (x *Params) GetEmergencySubmitters() []string {
+	if x != nil {
+		return x.EmergencySubmitters
+	}
+	return nil
+}
+
+func (x *Params) GetEmergencyEscalationThreshold() string {
+	if x != nil {
+		return x.EmergencyEscalationThreshold
+	}
+	return ""
+}
+
+func (x *Params) GetEmergencyEscalationWindow() *durationpb.Duration {
+	if x != nil {
+		return x.EmergencyEscalationWindow
+	}
+	return nil
+}
+
+func (x *Params) GetExecutionDelays() []*ExecutionDelay {
+	if x != nil {
+		return x.ExecutionDelays
+	}
+	return nil
+}
+
+func (x *Params) GetExecutionCancellers() []string {
+	if x != nil {
+		return x.ExecutionCancellers
+	}
+	return nil
+}
+
+func (x *Params) GetMsgTypeParams() []*MsgTypeParams {
+	if x != nil {
+		return x.MsgTypeParams
+	}
+	return nil
+}
+
+func (x *Params) GetOptimisticMsgTypes() []string {
+	if x != nil {
+		return x.OptimisticMsgTypes
+	}
+	return nil
+}
+
+func (x *Params) GetOptimisticRejectionThreshold() string {
+	if x != nil {
+		return x.OptimisticRejectionThreshold
+	}
+	return ""
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
+// MsgTypeParams defines the governance params overriding the global params for
+// the proposals containing the messages of the given type. The empty fields are
+// not overridden.
+type MsgTypeParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// msg_type_url is the type url of the message, or "*" to apply to the
+	// messages without their own params.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// quorum is the minimum percentage of the total voting power to be voted.
+	Quorum string `protobuf:"bytes,2,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// threshold is the minimum proportion of the yes votes to pass.
+	Threshold string `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// veto_threshold is the minimum proportion of the veto votes to reject.
+	VetoThreshold string `protobuf:"bytes,4,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// min_deposit is the minimum deposit to enter the voting period.
+	MinDeposit []*v1beta1.Coin `protobuf:"bytes,5,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit,omitempty"`
+	// voting_period is the duration of the voting period. It must be strictly
+	// greater than the expedited voting period.
+	VotingPeriod *durationpb.Duration `protobuf:"bytes,6,opt,name=voting_period,json=votingPeriod,proto3" json:"voting_period,omitempty"`
+}
+
+func (x *MsgTypeParams) Reset() {
+	*x = MsgTypeParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_gov_v1_gov_proto_msgTypes[0]
+		mi := &file_initia_gov_v1_gov_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Params) String() string {
+func (x *MsgTypeParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Params) ProtoMessage() {}
-
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_initia_gov_v1_gov_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *Params) GetMinDeposit() []*v1beta1.Coin {
-	if x != nil {
-		return x.MinDeposit
-	}
-	return nil
-}
+func (*MsgTypeParams) ProtoMessage() {}
 
-func (x *Params) GetMaxDepositPeriod() *durationpb.Duration {
-	if x != nil {
-		return x.MaxDepositPeriod
-	}
-	return nil
+// Deprecated: Use MsgTypeParams.ProtoReflect.Descriptor instead.
+func (*MsgTypeParams) Descriptor() ([]byte, []int) {
+	return file_initia_gov_v1_gov_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Params) GetVotingPeriod() *durationpb.Duration {
+func (x *MsgTypeParams) GetMsgTypeUrl() string {
 	if x != nil {
-		return x.VotingPeriod
+		return x.MsgTypeUrl
 	}
-	return nil
+	return ""
 }
 
-func (x *Params) GetQuorum() string {
+func (x *MsgTypeParams) GetQuorum() string {
 	if x != nil {
 		return x.Quorum
 	}
 	return ""
 }
 
-func (x *Params) GetThreshold() string {
+func (x *MsgTypeParams) GetThreshold() string {
 	if x != nil {
 		return x.Threshold
 	}
 	return ""
 }
 
-func (x *Params) GetVetoThreshold() string {
+func (x *MsgTypeParams) GetVetoThreshold() string {
 	if x != nil {
 		return x.VetoThreshold
 	}
 	return ""
 }
 
-func (x *Params) GetMinInitialDepositRatio() string {
+func (x *MsgTypeParams) GetMinDeposit() []*v1beta1.Coin {
 	if x != nil {
-		return x.MinInitialDepositRatio
+		return x.MinDeposit
 	}
-	return ""
+	return nil
 }
 
-func (x *Params) GetProposalCancelRatio() string {
+func (x *MsgTypeParams) GetVotingPeriod() *durationpb.Duration {
 	if x != nil {
-		return x.ProposalCancelRatio
+		return x.VotingPeriod
 	}
-	return ""
+	return nil
 }
 
-func (x *Params) GetProposalCancelDest() string {
+// ExecutionDelay defines the execution delay of the passed proposals containing
+// the messages of the given type.
+type ExecutionDelay struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// msg_type_url is the type url of the message.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// delay is the duration to wait before executing the proposal.
+	Delay *durationpb.Duration `protobuf:"bytes,2,opt,name=delay,proto3" json:"delay,omitempty"`
+}
+
+func (x *ExecutionDelay) Reset() {
+	*x = ExecutionDelay{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_gov_v1_gov_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecutionDelay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionDelay) ProtoMessage() {}
+
+// Deprecated: Use ExecutionDelay.ProtoReflect.Descriptor instead.
+func (*ExecutionDelay) Descriptor() ([]byte, []int) {
+	return file_initia_gov_v1_gov_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExecutionDelay) GetMsgTypeUrl() string {
 	if x != nil {
-		return x.ProposalCancelDest
+		return x.MsgTypeUrl
 	}
 	return ""
 }
 
-func (x *Params) GetExpeditedVotingPeriod() *durationpb.Duration {
+func (x *ExecutionDelay) GetDelay() *durationpb.Duration {
 	if x != nil {
-		return x.ExpeditedVotingPeriod
+		return x.Delay
 	}
 	return nil
 }
 
-func (x *Params) GetExpeditedThreshold() string {
+// VotingPowerProvider defines a Move view function providing the voting power
+// of a voter in tally. The function must have the signature
+// `#[view] public fun <function_name>(voter: address): u64`.
+//
+// The provider must exclude the tokens delegated to the validators, e.g. LP
+// tokens locked in a Move locker which delegates them, as the delegated tokens
+// are already counted as the staking voting power of the validator votes.
+// The provider voting power is counted only for the voters and excluded from
+// the quorum, and at most 10,000 provider calls are made per tally.
+type VotingPowerProvider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the unique name of the provider.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// module_addr is the address of the provider module.
+	ModuleAddr string `protobuf:"bytes,2,opt,name=module_addr,json=moduleAddr,proto3" json:"module_addr,omitempty"`
+	// module_name is the name of the provider module.
+	ModuleName string `protobuf:"bytes,3,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// function_name is the name of the view function.
+	FunctionName string `protobuf:"bytes,4,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	// weight is multiplied to the returned amount to get the voting power.
+	Weight string `protobuf:"bytes,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	// gas_limit is the gas budget of a view function call per voter.
+	GasLimit uint64 `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (x *VotingPowerProvider) Reset() {
+	*x = VotingPowerProvider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_gov_v1_gov_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VotingPowerProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotingPowerProvider) ProtoMessage() {}
+
+// Deprecated: Use VotingPowerProvider.ProtoReflect.Descriptor instead.
+func (*VotingPowerProvider) Descriptor() ([]byte, []int) {
+	return file_initia_gov_v1_gov_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VotingPowerProvider) GetName() string {
 	if x != nil {
-		return x.ExpeditedThreshold
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Params) GetExpeditedMinDeposit() []*v1beta1.Coin {
+func (x *VotingPowerProvider) GetModuleAddr() string {
 	if x != nil {
-		return x.ExpeditedMinDeposit
+		return x.ModuleAddr
 	}
-	return nil
+	return ""
 }
 
-func (x *Params) GetBurnVoteQuorum() bool {
+func (x *VotingPowerProvider) GetModuleName() string {
 	if x != nil {
-		return x.BurnVoteQuorum
+		return x.ModuleName
 	}
-	return false
+	return ""
 }
 
-func (x *Params) GetBurnProposalDepositPrevote() bool {
+func (x *VotingPowerProvider) GetFunctionName() string {
 	if x != nil {
-		return x.BurnProposalDepositPrevote
+		return x.FunctionName
 	}
-	return false
+	return ""
 }
 
-func (x *Params) GetBurnVoteVeto() bool {
+func (x *VotingPowerProvider) GetWeight() string {
 	if x != nil {
-		return x.BurnVoteVeto
+		return x.Weight
 	}
-	return false
+	return ""
 }
 
-func (x *Params) GetMinDepositRatio() string {
+func (x *VotingPowerProvider) GetGasLimit() uint64 {
 	if x != nil {
-		return x.MinDepositRatio
+		return x.GasLimit
 	}
-	return ""
+	return 0
 }
 
-func (x *Params) GetEmergencyMinDeposit() []*v1beta1.Coin {
+// MoveHook defines a Move entry function called on the proposal lifecycle
+// events. The function must have the signature
+// `public entry fun <function_name>(module: &signer, proposal_id: u64, event: String, account: address)`,
+// where the signer is the hook module address and the account is the proposer,
+// depositor or voter related to the event.
+type MoveHook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// module_addr is the address of the hook module.
+	ModuleAddr string `protobuf:"bytes,1,opt,name=module_addr,json=moduleAddr,proto3" json:"module_addr,omitempty"`
+	// module_name is the name of the hook module.
+	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// function_name is the name of the entry function.
+	FunctionName string `protobuf:"bytes,3,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	// gas_limit is the gas budget of an entry function call per event.
+	GasLimit uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (x *MoveHook) Reset() {
+	*x = MoveHook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_gov_v1_gov_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveHook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveHook) ProtoMessage() {}
+
+// Deprecated: Use MoveHook.ProtoReflect.Descriptor instead.
+func (*MoveHook) Descriptor() ([]byte, []int) {
+	return file_initia_gov_v1_gov_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MoveHook) GetModuleAddr() string {
 	if x != nil {
-		return x.EmergencyMinDeposit
+		return x.ModuleAddr
 	}
-	return nil
+	return ""
 }
 
-func (x *Params) GetEmergencyTallyInterval() *durationpb.Duration {
+func (x *MoveHook) GetModuleName() string {
 	if x != nil {
-		return x.EmergencyTallyInterval
+		return x.ModuleName
 	}
-	return nil
+	return ""
 }
 
-func (x *Params) GetLowThresholdFunctions() []string {
+func (x *MoveHook) GetFunctionName() string {
 	if x != nil {
-		return x.LowThresholdFunctions
+		return x.FunctionName
 	}
-	return nil
+	return ""
 }
 
-func (x *Params) GetVesting() *Vesting {
+func (x *MoveHook) GetGasLimit() uint64 {
 	if x != nil {
-		return x.Vesting
+		return x.GasLimit
 	}
-	return nil
+	return 0
 }
 
 // Vesting defines the vesting contract info for MsgVestingVote.
@@ -5124,7 +12817,7 @@ type Vesting struct {
 func (x *Vesting) Reset() {
 	*x = Vesting{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_gov_v1_gov_proto_msgTypes[1]
+		mi := &file_initia_gov_v1_gov_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5138,89 +12831,261 @@ func (*Vesting) ProtoMessage() {}
 
 // Deprecated: Use Vesting.ProtoReflect.Descriptor instead.
 func (*Vesting) Descriptor() ([]byte, []int) {
-	return file_initia_gov_v1_gov_proto_rawDescGZIP(), []int{1}
+	return file_initia_gov_v1_gov_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Vesting) GetModuleAddr() string {
+	if x != nil {
+		return x.ModuleAddr
+	}
+	return ""
+}
+
+func (x *Vesting) GetModuleName() string {
+	if x != nil {
+		return x.ModuleName
+	}
+	return ""
+}
+
+func (x *Vesting) GetCreatorAddr() string {
+	if x != nil {
+		return x.CreatorAddr
+	}
+	return ""
+}
+
+type TallyResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TallyHeight       uint64 `protobuf:"varint,1,opt,name=tally_height,json=tallyHeight,proto3" json:"tally_height,omitempty"`
+	TotalStakingPower string `protobuf:"bytes,2,opt,name=total_staking_power,json=totalStakingPower,proto3" json:"total_staking_power,omitempty"`
+	TotalVestingPower string `protobuf:"bytes,3,opt,name=total_vesting_power,json=totalVestingPower,proto3" json:"total_vesting_power,omitempty"`
+	// v1_tally_result is the original TallyResult from cosmos-sdk,
+	// which contains both staking and vesting power.
+	V1TallyResult *v1.TallyResult `protobuf:"bytes,4,opt,name=v1_tally_result,json=v1TallyResult,proto3" json:"v1_tally_result,omitempty"`
+	// provider_powers are the voting powers counted from the voting power
+	// providers.
+	ProviderPowers []*ProviderVotingPower `protobuf:"bytes,5,rep,name=provider_powers,json=providerPowers,proto3" json:"provider_powers,omitempty"`
+	// provider_omitted_voters is the number of the voters whose voting powers
+	// from the providers were not counted, as the provider calls reached the max
+	// per tally. The provider powers are truncated if it is not zero.
+	ProviderOmittedVoters uint64 `protobuf:"varint,6,opt,name=provider_omitted_voters,json=providerOmittedVoters,proto3" json:"provider_omitted_voters,omitempty"`
+}
+
+func (x *TallyResult) Reset() {
+	*x = TallyResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_gov_v1_gov_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TallyResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TallyResult) ProtoMessage() {}
+
+// Deprecated: Use TallyResult.ProtoReflect.Descriptor instead.
+func (*TallyResult) Descriptor() ([]byte, []int) {
+	return file_initia_gov_v1_gov_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TallyResult) GetTallyHeight() uint64 {
+	if x != nil {
+		return x.TallyHeight
+	}
+	return 0
+}
+
+func (x *TallyResult) GetTotalStakingPower() string {
+	if x != nil {
+		return x.TotalStakingPower
+	}
+	return ""
+}
+
+func (x *TallyResult) GetTotalVestingPower() string {
+	if x != nil {
+		return x.TotalVestingPower
+	}
+	return ""
+}
+
+func (x *TallyResult) GetV1TallyResult() *v1.TallyResult {
+	if x != nil {
+		return x.V1TallyResult
+	}
+	return nil
+}
+
+func (x *TallyResult) GetProviderPowers() []*ProviderVotingPower {
+	if x != nil {
+		return x.ProviderPowers
+	}
+	return nil
+}
+
+func (x *TallyResult) GetProviderOmittedVoters() uint64 {
+	if x != nil {
+		return x.ProviderOmittedVoters
+	}
+	return 0
+}
+
+// ProviderVotingPower defines the voting power counted from a voting power
+// provider.
+type ProviderVotingPower struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the provider.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// voting_power is the weighted voting power counted from the provider.
+	VotingPower string `protobuf:"bytes,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+}
+
+func (x *ProviderVotingPower) Reset() {
+	*x = ProviderVotingPower{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_gov_v1_gov_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *Vesting) GetModuleAddr() string {
-	if x != nil {
-		return x.ModuleAddr
-	}
-	return ""
+func (x *ProviderVotingPower) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Vesting) GetModuleName() string {
+func (*ProviderVotingPower) ProtoMessage() {}
+
+// Deprecated: Use ProviderVotingPower.ProtoReflect.Descriptor instead.
+func (*ProviderVotingPower) Descriptor() ([]byte, []int) {
+	return file_initia_gov_v1_gov_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ProviderVotingPower) GetName() string {
 	if x != nil {
-		return x.ModuleName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Vesting) GetCreatorAddr() string {
+func (x *ProviderVotingPower) GetVotingPower() string {
 	if x != nil {
-		return x.CreatorAddr
+		return x.VotingPower
 	}
 	return ""
 }
 
-type TallyResult struct {
+// VoterBreakdown defines the archived vote options and voting power of a voter
+// on a finalized proposal.
+type VoterBreakdown struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TallyHeight       uint64 `protobuf:"varint,1,opt,name=tally_height,json=tallyHeight,proto3" json:"tally_height,omitempty"`
-	TotalStakingPower string `protobuf:"bytes,2,opt,name=total_staking_power,json=totalStakingPower,proto3" json:"total_staking_power,omitempty"`
-	TotalVestingPower string `protobuf:"bytes,3,opt,name=total_vesting_power,json=totalVestingPower,proto3" json:"total_vesting_power,omitempty"`
-	// v1_tally_result is the original TallyResult from cosmos-sdk,
-	// which contains both staking and vesting power.
-	V1TallyResult *v1.TallyResult `protobuf:"bytes,4,opt,name=v1_tally_result,json=v1TallyResult,proto3" json:"v1_tally_result,omitempty"`
-}
-
-func (x *TallyResult) Reset() {
-	*x = TallyResult{}
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter is the address of the voter.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// options are the vote options counted for the voter.
+	Options []*v1.WeightedVoteOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	// delegate is the address of the account whose vote was counted by the
+	// vote delegation. It is empty if the voter voted by itself.
+	Delegate string `protobuf:"bytes,4,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// staking_power is the voting power of the delegations of the voter.
+	StakingPower string `protobuf:"bytes,5,opt,name=staking_power,json=stakingPower,proto3" json:"staking_power,omitempty"`
+	// vesting_power is the voting power of the unclaimed vesting of the voter.
+	VestingPower string `protobuf:"bytes,6,opt,name=vesting_power,json=vestingPower,proto3" json:"vesting_power,omitempty"`
+	// inherited_power is the voting power inherited by the validator of the voter
+	// from the delegators who did not vote.
+	InheritedPower string `protobuf:"bytes,7,opt,name=inherited_power,json=inheritedPower,proto3" json:"inherited_power,omitempty"`
+	// provider_power is the weighted voting power of the voter counted from the
+	// voting power providers.
+	ProviderPower string `protobuf:"bytes,8,opt,name=provider_power,json=providerPower,proto3" json:"provider_power,omitempty"`
+}
+
+func (x *VoterBreakdown) Reset() {
+	*x = VoterBreakdown{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_gov_v1_gov_proto_msgTypes[2]
+		mi := &file_initia_gov_v1_gov_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TallyResult) String() string {
+func (x *VoterBreakdown) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TallyResult) ProtoMessage() {}
+func (*VoterBreakdown) ProtoMessage() {}
 
-// Deprecated: Use TallyResult.ProtoReflect.Descriptor instead.
-func (*TallyResult) Descriptor() ([]byte, []int) {
-	return file_initia_gov_v1_gov_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use VoterBreakdown.ProtoReflect.Descriptor instead.
+func (*VoterBreakdown) Descriptor() ([]byte, []int) {
+	return file_initia_gov_v1_gov_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *TallyResult) GetTallyHeight() uint64 {
+func (x *VoterBreakdown) GetProposalId() uint64 {
 	if x != nil {
-		return x.TallyHeight
+		return x.ProposalId
 	}
 	return 0
 }
 
-func (x *TallyResult) GetTotalStakingPower() string {
+func (x *VoterBreakdown) GetVoter() string {
 	if x != nil {
-		return x.TotalStakingPower
+		return x.Voter
 	}
 	return ""
 }
 
-func (x *TallyResult) GetTotalVestingPower() string {
+func (x *VoterBreakdown) GetOptions() []*v1.WeightedVoteOption {
 	if x != nil {
-		return x.TotalVestingPower
+		return x.Options
+	}
+	return nil
+}
+
+func (x *VoterBreakdown) GetDelegate() string {
+	if x != nil {
+		return x.Delegate
 	}
 	return ""
 }
 
-func (x *TallyResult) GetV1TallyResult() *v1.TallyResult {
+func (x *VoterBreakdown) GetStakingPower() string {
 	if x != nil {
-		return x.V1TallyResult
+		return x.StakingPower
 	}
-	return nil
+	return ""
+}
+
+func (x *VoterBreakdown) GetVestingPower() string {
+	if x != nil {
+		return x.VestingPower
+	}
+	return ""
+}
+
+func (x *VoterBreakdown) GetInheritedPower() string {
+	if x != nil {
+		return x.InheritedPower
+	}
+	return ""
+}
+
+func (x *VoterBreakdown) GetProviderPower() string {
+	if x != nil {
+		return x.ProviderPower
+	}
+	return ""
 }
 
 // Proposal defines the core field members of a governance proposal.
@@ -5276,12 +13141,21 @@ type Proposal struct {
 	//
 	// Since: cosmos-sdk 0.50
 	FailedReason string `protobuf:"bytes,18,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"`
+	// execution_time is the time when the passed proposal is executed. It is set
+	// only while the execution of the proposal is queued, during which the status
+	// is PROPOSAL_STATUS_PASSED although the messages are not executed yet. Once
+	// executed, the status remains PROPOSAL_STATUS_PASSED or turns into
+	// PROPOSAL_STATUS_FAILED if the execution fails or is cancelled.
+	ExecutionTime *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=execution_time,json=executionTime,proto3" json:"execution_time,omitempty"`
+	// optimistic is true if the proposal passes at the end of the voting period
+	// unless it is rejected by the no and no with veto votes.
+	Optimistic bool `protobuf:"varint,20,opt,name=optimistic,proto3" json:"optimistic,omitempty"`
 }
 
 func (x *Proposal) Reset() {
 	*x = Proposal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_gov_v1_gov_proto_msgTypes[3]
+		mi := &file_initia_gov_v1_gov_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5295,7 +13169,7 @@ func (*Proposal) ProtoMessage() {}
 
 // Deprecated: Use Proposal.ProtoReflect.Descriptor instead.
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return file_initia_gov_v1_gov_proto_rawDescGZIP(), []int{3}
+	return file_initia_gov_v1_gov_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Proposal) GetId() uint64 {
@@ -5424,6 +13298,189 @@ func (x *Proposal) GetFailedReason() string {
 	return ""
 }
 
+func (x *Proposal) GetExecutionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExecutionTime
+	}
+	return nil
+}
+
+func (x *Proposal) GetOptimistic() bool {
+	if x != nil {
+		return x.Optimistic
+	}
+	return false
+}
+
+// DepositOutcome defines the final outcome of a deposit on a proposal after the
+// deposit is deleted.
+type DepositOutcome struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// depositor is the address of the depositor.
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// outcome is the outcome of the deposit.
+	Outcome DepositOutcomeType `protobuf:"varint,3,opt,name=outcome,proto3,enum=initia.gov.v1.DepositOutcomeType" json:"outcome,omitempty"`
+	// amount is the deposited amount.
+	Amount []*v1beta1.Coin `protobuf:"bytes,4,rep,name=amount,proto3" json:"amount,omitempty"`
+	// refunded is the amount returned to the depositor.
+	Refunded []*v1beta1.Coin `protobuf:"bytes,5,rep,name=refunded,proto3" json:"refunded,omitempty"`
+	// burned is the amount burned.
+	Burned []*v1beta1.Coin `protobuf:"bytes,6,rep,name=burned,proto3" json:"burned,omitempty"`
+	// charged is the amount charged with the proposal cancel ratio and sent to
+	// the destination. The charged amount is counted as burned if the proposal
+	// cancel destination is empty.
+	Charged []*v1beta1.Coin `protobuf:"bytes,7,rep,name=charged,proto3" json:"charged,omitempty"`
+	// destination is the address receiving the charged amount.
+	Destination string `protobuf:"bytes,8,opt,name=destination,proto3" json:"destination,omitempty"`
+	// height is the block height at which the deposit is deleted.
+	Height int64 `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *DepositOutcome) Reset() {
+	*x = DepositOutcome{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_gov_v1_gov_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositOutcome) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositOutcome) ProtoMessage() {}
+
+// Deprecated: Use DepositOutcome.ProtoReflect.Descriptor instead.
+func (*DepositOutcome) Descriptor() ([]byte, []int) {
+	return file_initia_gov_v1_gov_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DepositOutcome) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *DepositOutcome) GetDepositor() string {
+	if x != nil {
+		return x.Depositor
+	}
+	return ""
+}
+
+func (x *DepositOutcome) GetOutcome() DepositOutcomeType {
+	if x != nil {
+		return x.Outcome
+	}
+	return DepositOutcomeType_DEPOSIT_OUTCOME_TYPE_UNSPECIFIED
+}
+
+func (x *DepositOutcome) GetAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *DepositOutcome) GetRefunded() []*v1beta1.Coin {
+	if x != nil {
+		return x.Refunded
+	}
+	return nil
+}
+
+func (x *DepositOutcome) GetBurned() []*v1beta1.Coin {
+	if x != nil {
+		return x.Burned
+	}
+	return nil
+}
+
+func (x *DepositOutcome) GetCharged() []*v1beta1.Coin {
+	if x != nil {
+		return x.Charged
+	}
+	return nil
+}
+
+func (x *DepositOutcome) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *DepositOutcome) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// VoteDelegation defines a delegation of the voting power (staking and vesting)
+// of an account to another account.
+type VoteDelegation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// delegator is the address of the account delegating its voting power.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegate is the address of the account receiving the voting power.
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// msg_type_url scopes the delegation to the proposals whose messages are all
+	// of the given type. The delegation applies to all proposals if it is empty.
+	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (x *VoteDelegation) Reset() {
+	*x = VoteDelegation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_gov_v1_gov_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoteDelegation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteDelegation) ProtoMessage() {}
+
+// Deprecated: Use VoteDelegation.ProtoReflect.Descriptor instead.
+func (*VoteDelegation) Descriptor() ([]byte, []int) {
+	return file_initia_gov_v1_gov_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *VoteDelegation) GetDelegator() string {
+	if x != nil {
+		return x.Delegator
+	}
+	return ""
+}
+
+func (x *VoteDelegation) GetDelegate() string {
+	if x != nil {
+		return x.Delegate
+	}
+	return ""
+}
+
+func (x *VoteDelegation) GetMsgTypeUrl() string {
+	if x != nil {
+		return x.MsgTypeUrl
+	}
+	return ""
+}
+
 var File_initia_gov_v1_gov_proto protoreflect.FileDescriptor
 
 var file_initia_gov_v1_gov_proto_rawDesc = []byte{
@@ -5443,7 +13500,7 @@ var file_initia_gov_v1_gov_proto_rawDesc = []byte{
 	0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xf6, 0x0a, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x45, 0x0a, 0x0b,
+	0x6f, 0x22, 0xd7, 0x11, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x45, 0x0a, 0x0b,
 	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
 	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
 	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde,
@@ -5529,105 +13586,329 @@ var file_initia_gov_v1_gov_proto_rawDesc = []byte{
 	0x5e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x67,
 	0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x09, 0xc8,
 	0xde, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x00, 0x52, 0x07, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x67, 0x3a, 0x17, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x0a,
-	0x67, 0x6f, 0x76, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x78, 0x0a, 0x07, 0x56, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75,
-	0x6c, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x3a, 0x08, 0x98, 0xa0, 0x1f, 0x00,
-	0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xf4, 0x01, 0x0a, 0x0b, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x5f, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x61, 0x6c, 0x6c,
-	0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3e, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x5f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x49, 0x6e, 0x74, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x69,
-	0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x5f, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x03,
+	0x67, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x76, 0x6f, 0x74,
+	0x65, 0x72, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x5f, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x15, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x72,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x3a, 0x0a, 0x19, 0x76, 0x6f, 0x74,
+	0x65, 0x72, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x72, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x60, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x76, 0x6f,
+	0x74, 0x65, 0x72, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x63, 0x0a, 0x16, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x61, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x67,
+	0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x4b, 0x0a, 0x14, 0x65, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x62, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x13, 0x65, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x54, 0x0a, 0x1e, 0x65, 0x6d, 0x65, 0x72, 0x67,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x63, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x1c, 0x65, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x68, 0x0a,
+	0x1b, 0x65, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x65, 0x73, 0x63, 0x61, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x64, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8,
+	0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x19, 0x65, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x53, 0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x65, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x4b, 0x0a, 0x14,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x6c, 0x65, 0x72, 0x73, 0x18, 0x66, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x13, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x4f, 0x0a, 0x0f, 0x6d, 0x73, 0x67,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x67, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x67, 0x6f, 0x76, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x6d, 0x73, 0x67,
+	0x54, 0x79, 0x70, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6f, 0x70,
+	0x74, 0x69, 0x6d, 0x69, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x18, 0x68, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x54, 0x0a, 0x1e,
+	0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x69,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x49, 0x6e, 0x74, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0f, 0x76, 0x31, 0x5f, 0x74, 0x61,
-	0x6c, 0x6c, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0d, 0x76, 0x31,
-	0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xde, 0x07, 0x0a, 0x08,
-	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79,
-	0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x53, 0x0a, 0x12, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x61, 0x6c, 0x6c, 0x79,
-	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61,
-	0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x6c, 0x6c, 0x79,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x41, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x73,
-	0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x10, 0x64, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
-	0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45, 0x6e,
-	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x1c, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x3a, 0x17, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a,
+	0x0a, 0x67, 0x6f, 0x76, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xcc, 0x02, 0x0a, 0x0d,
+	0x4d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x20, 0x0a,
+	0x0c, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x12,
+	0x26, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x2c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x35, 0x0a, 0x0e, 0x76, 0x65, 0x74, 0x6f, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x76,
+	0x65, 0x74, 0x6f, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x40, 0x0a, 0x0b,
+	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x44,
+	0x0a, 0x0d, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0c, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x78, 0x0a, 0x0e, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x20, 0x0a, 0x0c,
+	0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x3e,
+	0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf,
+	0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x3a, 0x04,
+	0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xdb, 0x01, 0x0a, 0x13, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x77, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x64, 0x64,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x04, 0xe8, 0xa0,
+	0x1f, 0x01, 0x22, 0x94, 0x01, 0x0a, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x78, 0x0a, 0x07, 0x56, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x3a, 0x08, 0x98, 0xa0, 0x1f, 0x00, 0xe8,
+	0xa0, 0x1f, 0x01, 0x22, 0x84, 0x03, 0x0a, 0x0b, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x61, 0x6c, 0x6c, 0x79,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3e, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0f, 0x76, 0x31, 0x5f, 0x74, 0x61, 0x6c,
+	0x6c, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0d, 0x76, 0x31, 0x54,
+	0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x56, 0x0a, 0x0f, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x67, 0x6f, 0x76,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x69,
+	0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x6f,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x15, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4f, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x22, 0x5c, 0x0a, 0x13, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x0c, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0b, 0x76, 0x6f, 0x74,
+	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x22, 0xae, 0x03, 0x0a, 0x0e, 0x56, 0x6f, 0x74,
+	0x65, 0x72, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05,
+	0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12,
+	0x33, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x77, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x0d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0c, 0x76, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x0f, 0x69, 0x6e, 0x68,
+	0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x0e, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x77,
+	0x65, 0x72, 0x12, 0x35, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x22, 0xc7, 0x08, 0x0a, 0x08, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x53, 0x0a, 0x12, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x5f, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x6c,
+	0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x10, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x41, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90,
+	0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x4c,
+	0x0a, 0x11, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0f, 0x76, 0x6f, 0x74,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x48, 0x0a, 0x0f,
+	0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x45,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x52, 0x0a, 0x14, 0x65, 0x6d, 0x65, 0x72, 0x67, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x12, 0x65, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63,
+	0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x5b, 0x0a, 0x19, 0x65, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x74, 0x61, 0x6c,
+	0x6c, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52,
+	0x16, 0x65, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x4e, 0x65, 0x78, 0x74, 0x54, 0x61,
+	0x6c, 0x6c, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70,
+	0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6d, 0x65, 0x72, 0x67,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x0e, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04,
+	0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x22, 0xa8, 0x05, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12,
+	0x3b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x21, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x68, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x12, 0x4c, 0x0a, 0x11, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0f, 0x76,
-	0x6f, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x48,
-	0x0a, 0x0f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x76, 0x6f, 0x74, 0x69, 0x6e,
-	0x67, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x52, 0x0a, 0x14, 0x65, 0x6d, 0x65, 0x72,
-	0x67, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x12, 0x65, 0x6d, 0x65, 0x72, 0x67, 0x65,
-	0x6e, 0x63, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x5b, 0x0a, 0x19,
-	0x65, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x74,
-	0x61, 0x6c, 0x6c, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f,
-	0x01, 0x52, 0x16, 0x65, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x4e, 0x65, 0x78, 0x74,
-	0x54, 0x61, 0x6c, 0x6c, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
-	0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x65,
-	0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x65, 0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6d, 0x65,
-	0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x6d,
-	0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65,
-	0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0xaa, 0x01, 0x0a,
-	0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x67, 0x6f, 0x76, 0x2e,
-	0x76, 0x31, 0x42, 0x08, 0x47, 0x6f, 0x76, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x3b,
-	0x67, 0x6f, 0x76, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x47, 0x58, 0xaa, 0x02, 0x0d, 0x49, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x2e, 0x47, 0x6f, 0x76, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x49, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x49, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x3a, 0x3a, 0x47, 0x6f, 0x76, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x6c, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x65, 0x64, 0x12, 0x68, 0x0a, 0x06, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x6a,
+	0x0a, 0x07, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00,
+	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x07, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xa0,
+	0x01, 0x0a, 0x0e, 0x56, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x08, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12,
+	0x20, 0x0a, 0x0c, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72,
+	0x6c, 0x2a, 0x96, 0x02, 0x0a, 0x12, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x43, 0x0a, 0x20, 0x44, 0x45, 0x50, 0x4f,
+	0x53, 0x49, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x1d,
+	0x8a, 0x9d, 0x20, 0x19, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x3d, 0x0a,
+	0x1d, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x01,
+	0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x1b,
+	0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x55, 0x52, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x1a, 0x18, 0x8a,
+	0x9d, 0x20, 0x14, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x1c, 0x44, 0x45, 0x50, 0x4f, 0x53,
+	0x49, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x43, 0x48, 0x41, 0x52, 0x47, 0x45, 0x44, 0x10, 0x03, 0x1a, 0x19, 0x8a, 0x9d, 0x20, 0x15, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x43, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x64, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xaa, 0x01, 0x0a, 0x11, 0x63,
+	0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31,
+	0x42, 0x08, 0x47, 0x6f, 0x76, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d,
+	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x6f,
+	0x76, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x47, 0x58, 0xaa, 0x02, 0x0d, 0x49, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x2e, 0x47, 0x6f, 0x76, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x49, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x49, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x3a, 0x3a,
+	0x47, 0x6f, 0x76, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5642,44 +13923,70 @@ func file_initia_gov_v1_gov_proto_rawDescGZIP() []byte {
 	return file_initia_gov_v1_gov_proto_rawDescData
 }
 
-var file_initia_gov_v1_gov_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_initia_gov_v1_gov_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_initia_gov_v1_gov_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_initia_gov_v1_gov_proto_goTypes = []interface{}{
-	(*Params)(nil),                // 0: initia.gov.v1.Params
-	(*Vesting)(nil),               // 1: initia.gov.v1.Vesting
-	(*TallyResult)(nil),           // 2: initia.gov.v1.TallyResult
-	(*Proposal)(nil),              // 3: initia.gov.v1.Proposal
-	(*v1beta1.Coin)(nil),          // 4: cosmos.base.v1beta1.Coin
-	(*durationpb.Duration)(nil),   // 5: google.protobuf.Duration
-	(*v1.TallyResult)(nil),        // 6: cosmos.gov.v1.TallyResult
-	(*anypb.Any)(nil),             // 7: google.protobuf.Any
-	(v1.ProposalStatus)(0),        // 8: cosmos.gov.v1.ProposalStatus
-	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+	(DepositOutcomeType)(0),       // 0: initia.gov.v1.DepositOutcomeType
+	(*Params)(nil),                // 1: initia.gov.v1.Params
+	(*MsgTypeParams)(nil),         // 2: initia.gov.v1.MsgTypeParams
+	(*ExecutionDelay)(nil),        // 3: initia.gov.v1.ExecutionDelay
+	(*VotingPowerProvider)(nil),   // 4: initia.gov.v1.VotingPowerProvider
+	(*MoveHook)(nil),              // 5: initia.gov.v1.MoveHook
+	(*Vesting)(nil),               // 6: initia.gov.v1.Vesting
+	(*TallyResult)(nil),           // 7: initia.gov.v1.TallyResult
+	(*ProviderVotingPower)(nil),   // 8: initia.gov.v1.ProviderVotingPower
+	(*VoterBreakdown)(nil),        // 9: initia.gov.v1.VoterBreakdown
+	(*Proposal)(nil),              // 10: initia.gov.v1.Proposal
+	(*DepositOutcome)(nil),        // 11: initia.gov.v1.DepositOutcome
+	(*VoteDelegation)(nil),        // 12: initia.gov.v1.VoteDelegation
+	(*v1beta1.Coin)(nil),          // 13: cosmos.base.v1beta1.Coin
+	(*durationpb.Duration)(nil),   // 14: google.protobuf.Duration
+	(*v1.TallyResult)(nil),        // 15: cosmos.gov.v1.TallyResult
+	(*v1.WeightedVoteOption)(nil), // 16: cosmos.gov.v1.WeightedVoteOption
+	(*anypb.Any)(nil),             // 17: google.protobuf.Any
+	(v1.ProposalStatus)(0),        // 18: cosmos.gov.v1.ProposalStatus
+	(*timestamppb.Timestamp)(nil), // 19: google.protobuf.Timestamp
 }
 var file_initia_gov_v1_gov_proto_depIdxs = []int32{
-	4,  // 0: initia.gov.v1.Params.min_deposit:type_name -> cosmos.base.v1beta1.Coin
-	5,  // 1: initia.gov.v1.Params.max_deposit_period:type_name -> google.protobuf.Duration
-	5,  // 2: initia.gov.v1.Params.voting_period:type_name -> google.protobuf.Duration
-	5,  // 3: initia.gov.v1.Params.expedited_voting_period:type_name -> google.protobuf.Duration
-	4,  // 4: initia.gov.v1.Params.expedited_min_deposit:type_name -> cosmos.base.v1beta1.Coin
-	4,  // 5: initia.gov.v1.Params.emergency_min_deposit:type_name -> cosmos.base.v1beta1.Coin
-	5,  // 6: initia.gov.v1.Params.emergency_tally_interval:type_name -> google.protobuf.Duration
-	1,  // 7: initia.gov.v1.Params.vesting:type_name -> initia.gov.v1.Vesting
-	6,  // 8: initia.gov.v1.TallyResult.v1_tally_result:type_name -> cosmos.gov.v1.TallyResult
-	7,  // 9: initia.gov.v1.Proposal.messages:type_name -> google.protobuf.Any
-	8,  // 10: initia.gov.v1.Proposal.status:type_name -> cosmos.gov.v1.ProposalStatus
-	2,  // 11: initia.gov.v1.Proposal.final_tally_result:type_name -> initia.gov.v1.TallyResult
-	9,  // 12: initia.gov.v1.Proposal.submit_time:type_name -> google.protobuf.Timestamp
-	9,  // 13: initia.gov.v1.Proposal.deposit_end_time:type_name -> google.protobuf.Timestamp
-	4,  // 14: initia.gov.v1.Proposal.total_deposit:type_name -> cosmos.base.v1beta1.Coin
-	9,  // 15: initia.gov.v1.Proposal.voting_start_time:type_name -> google.protobuf.Timestamp
-	9,  // 16: initia.gov.v1.Proposal.voting_end_time:type_name -> google.protobuf.Timestamp
-	9,  // 17: initia.gov.v1.Proposal.emergency_start_time:type_name -> google.protobuf.Timestamp
-	9,  // 18: initia.gov.v1.Proposal.emergency_next_tally_time:type_name -> google.protobuf.Timestamp
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	13, // 0: initia.gov.v1.Params.min_deposit:type_name -> cosmos.base.v1beta1.Coin
+	14, // 1: initia.gov.v1.Params.max_deposit_period:type_name -> google.protobuf.Duration
+	14, // 2: initia.gov.v1.Params.voting_period:type_name -> google.protobuf.Duration
+	14, // 3: initia.gov.v1.Params.expedited_voting_period:type_name -> google.protobuf.Duration
+	13, // 4: initia.gov.v1.Params.expedited_min_deposit:type_name -> cosmos.base.v1beta1.Coin
+	13, // 5: initia.gov.v1.Params.emergency_min_deposit:type_name -> cosmos.base.v1beta1.Coin
+	14, // 6: initia.gov.v1.Params.emergency_tally_interval:type_name -> google.protobuf.Duration
+	6,  // 7: initia.gov.v1.Params.vesting:type_name -> initia.gov.v1.Vesting
+	4,  // 8: initia.gov.v1.Params.voting_power_providers:type_name -> initia.gov.v1.VotingPowerProvider
+	14, // 9: initia.gov.v1.Params.emergency_escalation_window:type_name -> google.protobuf.Duration
+	3,  // 10: initia.gov.v1.Params.execution_delays:type_name -> initia.gov.v1.ExecutionDelay
+	2,  // 11: initia.gov.v1.Params.msg_type_params:type_name -> initia.gov.v1.MsgTypeParams
+	13, // 12: initia.gov.v1.MsgTypeParams.min_deposit:type_name -> cosmos.base.v1beta1.Coin
+	14, // 13: initia.gov.v1.MsgTypeParams.voting_period:type_name -> google.protobuf.Duration
+	14, // 14: initia.gov.v1.ExecutionDelay.delay:type_name -> google.protobuf.Duration
+	15, // 15: initia.gov.v1.TallyResult.v1_tally_result:type_name -> cosmos.gov.v1.TallyResult
+	8,  // 16: initia.gov.v1.TallyResult.provider_powers:type_name -> initia.gov.v1.ProviderVotingPower
+	16, // 17: initia.gov.v1.VoterBreakdown.options:type_name -> cosmos.gov.v1.WeightedVoteOption
+	17, // 18: initia.gov.v1.Proposal.messages:type_name -> google.protobuf.Any
+	18, // 19: initia.gov.v1.Proposal.status:type_name -> cosmos.gov.v1.ProposalStatus
+	7,  // 20: initia.gov.v1.Proposal.final_tally_result:type_name -> initia.gov.v1.TallyResult
+	19, // 21: initia.gov.v1.Proposal.submit_time:type_name -> google.protobuf.Timestamp
+	19, // 22: initia.gov.v1.Proposal.deposit_end_time:type_name -> google.protobuf.Timestamp
+	13, // 23: initia.gov.v1.Proposal.total_deposit:type_name -> cosmos.base.v1beta1.Coin
+	19, // 24: initia.gov.v1.Proposal.voting_start_time:type_name -> google.protobuf.Timestamp
+	19, // 25: initia.gov.v1.Proposal.voting_end_time:type_name -> google.protobuf.Timestamp
+	19, // 26: initia.gov.v1.Proposal.emergency_start_time:type_name -> google.protobuf.Timestamp
+	19, // 27: initia.gov.v1.Proposal.emergency_next_tally_time:type_name -> google.protobuf.Timestamp
+	19, // 28: initia.gov.v1.Proposal.execution_time:type_name -> google.protobuf.Timestamp
+	0,  // 29: initia.gov.v1.DepositOutcome.outcome:type_name -> initia.gov.v1.DepositOutcomeType
+	13, // 30: initia.gov.v1.DepositOutcome.amount:type_name -> cosmos.base.v1beta1.Coin
+	13, // 31: initia.gov.v1.DepositOutcome.refunded:type_name -> cosmos.base.v1beta1.Coin
+	13, // 32: initia.gov.v1.DepositOutcome.burned:type_name -> cosmos.base.v1beta1.Coin
+	13, // 33: initia.gov.v1.DepositOutcome.charged:type_name -> cosmos.base.v1beta1.Coin
+	34, // [34:34] is the sub-list for method output_type
+	34, // [34:34] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_initia_gov_v1_gov_proto_init() }
@@ -5701,7 +14008,7 @@ func file_initia_gov_v1_gov_proto_init() {
 			}
 		}
 		file_initia_gov_v1_gov_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Vesting); i {
+			switch v := v.(*MsgTypeParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5713,7 +14020,7 @@ func file_initia_gov_v1_gov_proto_init() {
 			}
 		}
 		file_initia_gov_v1_gov_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TallyResult); i {
+			switch v := v.(*ExecutionDelay); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5725,6 +14032,78 @@ func file_initia_gov_v1_gov_proto_init() {
 			}
 		}
 		file_initia_gov_v1_gov_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VotingPowerProvider); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_gov_v1_gov_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveHook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_gov_v1_gov_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Vesting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_gov_v1_gov_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TallyResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_gov_v1_gov_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderVotingPower); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_gov_v1_gov_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoterBreakdown); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_gov_v1_gov_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Proposal); i {
 			case 0:
 				return &v.state
@@ -5736,19 +14115,44 @@ func file_initia_gov_v1_gov_proto_init() {
 				return nil
 			}
 		}
+		file_initia_gov_v1_gov_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositOutcome); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_gov_v1_gov_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteDelegation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_initia_gov_v1_gov_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      1,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_initia_gov_v1_gov_proto_goTypes,
 		DependencyIndexes: file_initia_gov_v1_gov_proto_depIdxs,
+		EnumInfos:         file_initia_gov_v1_gov_proto_enumTypes,
 		MessageInfos:      file_initia_gov_v1_gov_proto_msgTypes,
 	}.Build()
 	File_initia_gov_v1_gov_proto = out.File
@@ -19,11 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName             = "/initia.gov.v1.Query/Params"
-	Query_EmergencyProposals_FullMethodName = "/initia.gov.v1.Query/EmergencyProposals"
-	Query_Proposal_FullMethodName           = "/initia.gov.v1.Query/Proposal"
-	Query_Proposals_FullMethodName          = "/initia.gov.v1.Query/Proposals"
-	Query_TallyResult_FullMethodName        = "/initia.gov.v1.Query/TallyResult"
+	Query_Params_FullMethodName                  = "/initia.gov.v1.Query/Params"
+	Query_EmergencyProposals_FullMethodName      = "/initia.gov.v1.Query/EmergencyProposals"
+	Query_Proposal_FullMethodName                = "/initia.gov.v1.Query/Proposal"
+	Query_Proposals_FullMethodName               = "/initia.gov.v1.Query/Proposals"
+	Query_TallyResult_FullMethodName             = "/initia.gov.v1.Query/TallyResult"
+	Query_VoteDelegations_FullMethodName         = "/initia.gov.v1.Query/VoteDelegations"
+	Query_VoteDelegators_FullMethodName          = "/initia.gov.v1.Query/VoteDelegators"
+	Query_ProposalVoterBreakdown_FullMethodName  = "/initia.gov.v1.Query/ProposalVoterBreakdown"
+	Query_ProposalDepositOutcomes_FullMethodName = "/initia.gov.v1.Query/ProposalDepositOutcomes"
+	Query_TallyPreview_FullMethodName            = "/initia.gov.v1.Query/TallyPreview"
+	Query_QueuedExecutions_FullMethodName        = "/initia.gov.v1.Query/QueuedExecutions"
+	Query_MoveHooks_FullMethodName               = "/initia.gov.v1.Query/MoveHooks"
+	Query_SimulateProposal_FullMethodName        = "/initia.gov.v1.Query/SimulateProposal"
 )
 
 // QueryClient is the client API for Query service.
@@ -40,6 +48,27 @@ type QueryClient interface {
 	Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// VoteDelegations queries the vote delegations given by a delegator.
+	VoteDelegations(ctx context.Context, in *QueryVoteDelegationsRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsResponse, error)
+	// VoteDelegators queries the vote delegations received by a delegate.
+	VoteDelegators(ctx context.Context, in *QueryVoteDelegatorsRequest, opts ...grpc.CallOption) (*QueryVoteDelegatorsResponse, error)
+	// ProposalVoterBreakdown queries the archived vote options and voting power
+	// of the voters of a finalized proposal.
+	ProposalVoterBreakdown(ctx context.Context, in *QueryProposalVoterBreakdownRequest, opts ...grpc.CallOption) (*QueryProposalVoterBreakdownResponse, error)
+	// ProposalDepositOutcomes queries the final outcomes of the deleted deposits
+	// of a proposal.
+	ProposalDepositOutcomes(ctx context.Context, in *QueryProposalDepositOutcomesRequest, opts ...grpc.CallOption) (*QueryProposalDepositOutcomesResponse, error)
+	// TallyPreview queries the tally of an active proposal as if the voting
+	// ended now, without modifying the state.
+	TallyPreview(ctx context.Context, in *QueryTallyPreviewRequest, opts ...grpc.CallOption) (*QueryTallyPreviewResponse, error)
+	// QueuedExecutions queries the passed proposals whose execution is queued,
+	// ordered by the execution time.
+	QueuedExecutions(ctx context.Context, in *QueryQueuedExecutionsRequest, opts ...grpc.CallOption) (*QueryQueuedExecutionsResponse, error)
+	// MoveHooks queries the Move hooks called on the proposal lifecycle events.
+	MoveHooks(ctx context.Context, in *QueryMoveHooksRequest, opts ...grpc.CallOption) (*QueryMoveHooksResponse, error)
+	// SimulateProposal executes the proposal messages against the current state
+	// with the gov module account as signer, without modifying the state.
+	SimulateProposal(ctx context.Context, in *QuerySimulateProposalRequest, opts ...grpc.CallOption) (*QuerySimulateProposalResponse, error)
 }
 
 type queryClient struct {
@@ -95,6 +124,78 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) VoteDelegations(ctx context.Context, in *QueryVoteDelegationsRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsResponse, error) {
+	out := new(QueryVoteDelegationsResponse)
+	err := c.cc.Invoke(ctx, Query_VoteDelegations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VoteDelegators(ctx context.Context, in *QueryVoteDelegatorsRequest, opts ...grpc.CallOption) (*QueryVoteDelegatorsResponse, error) {
+	out := new(QueryVoteDelegatorsResponse)
+	err := c.cc.Invoke(ctx, Query_VoteDelegators_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalVoterBreakdown(ctx context.Context, in *QueryProposalVoterBreakdownRequest, opts ...grpc.CallOption) (*QueryProposalVoterBreakdownResponse, error) {
+	out := new(QueryProposalVoterBreakdownResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalVoterBreakdown_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalDepositOutcomes(ctx context.Context, in *QueryProposalDepositOutcomesRequest, opts ...grpc.CallOption) (*QueryProposalDepositOutcomesResponse, error) {
+	out := new(QueryProposalDepositOutcomesResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalDepositOutcomes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TallyPreview(ctx context.Context, in *QueryTallyPreviewRequest, opts ...grpc.CallOption) (*QueryTallyPreviewResponse, error) {
+	out := new(QueryTallyPreviewResponse)
+	err := c.cc.Invoke(ctx, Query_TallyPreview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedExecutions(ctx context.Context, in *QueryQueuedExecutionsRequest, opts ...grpc.CallOption) (*QueryQueuedExecutionsResponse, error) {
+	out := new(QueryQueuedExecutionsResponse)
+	err := c.cc.Invoke(ctx, Query_QueuedExecutions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MoveHooks(ctx context.Context, in *QueryMoveHooksRequest, opts ...grpc.CallOption) (*QueryMoveHooksResponse, error) {
+	out := new(QueryMoveHooksResponse)
+	err := c.cc.Invoke(ctx, Query_MoveHooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateProposal(ctx context.Context, in *QuerySimulateProposalRequest, opts ...grpc.CallOption) (*QuerySimulateProposalResponse, error) {
+	out := new(QuerySimulateProposalResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -109,6 +210,27 @@ type QueryServer interface {
 	Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// VoteDelegations queries the vote delegations given by a delegator.
+	VoteDelegations(context.Context, *QueryVoteDelegationsRequest) (*QueryVoteDelegationsResponse, error)
+	// VoteDelegators queries the vote delegations received by a delegate.
+	VoteDelegators(context.Context, *QueryVoteDelegatorsRequest) (*QueryVoteDelegatorsResponse, error)
+	// ProposalVoterBreakdown queries the archived vote options and voting power
+	// of the voters of a finalized proposal.
+	ProposalVoterBreakdown(context.Context, *QueryProposalVoterBreakdownRequest) (*QueryProposalVoterBreakdownResponse, error)
+	// ProposalDepositOutcomes queries the final outcomes of the deleted deposits
+	// of a proposal.
+	ProposalDepositOutcomes(context.Context, *QueryProposalDepositOutcomesRequest) (*QueryProposalDepositOutcomesResponse, error)
+	// TallyPreview queries the tally of an active proposal as if the voting
+	// ended now, without modifying the state.
+	TallyPreview(context.Context, *QueryTallyPreviewRequest) (*QueryTallyPreviewResponse, error)
+	// QueuedExecutions queries the passed proposals whose execution is queued,
+	// ordered by the execution time.
+	QueuedExecutions(context.Context, *QueryQueuedExecutionsRequest) (*QueryQueuedExecutionsResponse, error)
+	// MoveHooks queries the Move hooks called on the proposal lifecycle events.
+	MoveHooks(context.Context, *QueryMoveHooksRequest) (*QueryMoveHooksResponse, error)
+	// SimulateProposal executes the proposal messages against the current state
+	// with the gov module account as signer, without modifying the state.
+	SimulateProposal(context.Context, *QuerySimulateProposalRequest) (*QuerySimulateProposalResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -131,6 +253,30 @@ func (UnimplementedQueryServer) Proposals(context.Context, *QueryProposalsReques
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) VoteDelegations(context.Context, *QueryVoteDelegationsRequest) (*QueryVoteDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDelegations not implemented")
+}
+func (UnimplementedQueryServer) VoteDelegators(context.Context, *QueryVoteDelegatorsRequest) (*QueryVoteDelegatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDelegators not implemented")
+}
+func (UnimplementedQueryServer) ProposalVoterBreakdown(context.Context, *QueryProposalVoterBreakdownRequest) (*QueryProposalVoterBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalVoterBreakdown not implemented")
+}
+func (UnimplementedQueryServer) ProposalDepositOutcomes(context.Context, *QueryProposalDepositOutcomesRequest) (*QueryProposalDepositOutcomesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalDepositOutcomes not implemented")
+}
+func (UnimplementedQueryServer) TallyPreview(context.Context, *QueryTallyPreviewRequest) (*QueryTallyPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TallyPreview not implemented")
+}
+func (UnimplementedQueryServer) QueuedExecutions(context.Context, *QueryQueuedExecutionsRequest) (*QueryQueuedExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedExecutions not implemented")
+}
+func (UnimplementedQueryServer) MoveHooks(context.Context, *QueryMoveHooksRequest) (*QueryMoveHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveHooks not implemented")
+}
+func (UnimplementedQueryServer) SimulateProposal(context.Context, *QuerySimulateProposalRequest) (*QuerySimulateProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateProposal not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -234,6 +380,150 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VoteDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VoteDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteDelegations(ctx, req.(*QueryVoteDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VoteDelegators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteDelegatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteDelegators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VoteDelegators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteDelegators(ctx, req.(*QueryVoteDelegatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalVoterBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalVoterBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalVoterBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalVoterBreakdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalVoterBreakdown(ctx, req.(*QueryProposalVoterBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalDepositOutcomes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalDepositOutcomesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalDepositOutcomes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalDepositOutcomes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalDepositOutcomes(ctx, req.(*QueryProposalDepositOutcomesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TallyPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTallyPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TallyPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TallyPreview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TallyPreview(ctx, req.(*QueryTallyPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueuedExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedExecutions(ctx, req.(*QueryQueuedExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MoveHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMoveHooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MoveHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MoveHooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MoveHooks(ctx, req.(*QueryMoveHooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateProposal(ctx, req.(*QuerySimulateProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -261,6 +551,38 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "VoteDelegations",
+			Handler:    _Query_VoteDelegations_Handler,
+		},
+		{
+			MethodName: "VoteDelegators",
+			Handler:    _Query_VoteDelegators_Handler,
+		},
+		{
+			MethodName: "ProposalVoterBreakdown",
+			Handler:    _Query_ProposalVoterBreakdown_Handler,
+		},
+		{
+			MethodName: "ProposalDepositOutcomes",
+			Handler:    _Query_ProposalDepositOutcomes_Handler,
+		},
+		{
+			MethodName: "TallyPreview",
+			Handler:    _Query_TallyPreview_Handler,
+		},
+		{
+			MethodName: "QueuedExecutions",
+			Handler:    _Query_QueuedExecutions_Handler,
+		},
+		{
+			MethodName: "MoveHooks",
+			Handler:    _Query_MoveHooks_Handler,
+		},
+		{
+			MethodName: "SimulateProposal",
+			Handler:    _Query_SimulateProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/gov/v1/query.proto",
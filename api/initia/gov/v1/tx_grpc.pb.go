@@ -19,7 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/initia.gov.v1.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName             = "/initia.gov.v1.Msg/UpdateParams"
+	Msg_DelegateVote_FullMethodName             = "/initia.gov.v1.Msg/DelegateVote"
+	Msg_UndelegateVote_FullMethodName           = "/initia.gov.v1.Msg/UndelegateVote"
+	Msg_CancelQueuedExecution_FullMethodName    = "/initia.gov.v1.Msg/CancelQueuedExecution"
+	Msg_SubmitOptimisticProposal_FullMethodName = "/initia.gov.v1.Msg/SubmitOptimisticProposal"
+	Msg_UpdateMoveHook_FullMethodName           = "/initia.gov.v1.Msg/UpdateMoveHook"
 )
 
 // MsgClient is the client API for Msg service.
@@ -29,6 +34,20 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/gov
 	// module parameters. The authority is defined in the keeper.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// DelegateVote defines a method to delegate the voting power of an account
+	// to another account.
+	DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error)
+	// UndelegateVote defines a method to revoke a vote delegation.
+	UndelegateVote(ctx context.Context, in *MsgUndelegateVote, opts ...grpc.CallOption) (*MsgUndelegateVoteResponse, error)
+	// CancelQueuedExecution defines a method to cancel the queued execution of a
+	// passed proposal.
+	CancelQueuedExecution(ctx context.Context, in *MsgCancelQueuedExecution, opts ...grpc.CallOption) (*MsgCancelQueuedExecutionResponse, error)
+	// SubmitOptimisticProposal defines a method to submit an optimistic proposal,
+	// which passes unless it is rejected by the no and no with veto votes.
+	SubmitOptimisticProposal(ctx context.Context, in *MsgSubmitOptimisticProposal, opts ...grpc.CallOption) (*MsgSubmitOptimisticProposalResponse, error)
+	// UpdateMoveHook defines a governance operation for registering or removing
+	// a Move hook called on the proposal lifecycle events.
+	UpdateMoveHook(ctx context.Context, in *MsgUpdateMoveHook, opts ...grpc.CallOption) (*MsgUpdateMoveHookResponse, error)
 }
 
 type msgClient struct {
@@ -48,6 +67,51 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error) {
+	out := new(MsgDelegateVoteResponse)
+	err := c.cc.Invoke(ctx, Msg_DelegateVote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateVote(ctx context.Context, in *MsgUndelegateVote, opts ...grpc.CallOption) (*MsgUndelegateVoteResponse, error) {
+	out := new(MsgUndelegateVoteResponse)
+	err := c.cc.Invoke(ctx, Msg_UndelegateVote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelQueuedExecution(ctx context.Context, in *MsgCancelQueuedExecution, opts ...grpc.CallOption) (*MsgCancelQueuedExecutionResponse, error) {
+	out := new(MsgCancelQueuedExecutionResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelQueuedExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitOptimisticProposal(ctx context.Context, in *MsgSubmitOptimisticProposal, opts ...grpc.CallOption) (*MsgSubmitOptimisticProposalResponse, error) {
+	out := new(MsgSubmitOptimisticProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitOptimisticProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateMoveHook(ctx context.Context, in *MsgUpdateMoveHook, opts ...grpc.CallOption) (*MsgUpdateMoveHookResponse, error) {
+	out := new(MsgUpdateMoveHookResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateMoveHook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -55,6 +119,20 @@ type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/gov
 	// module parameters. The authority is defined in the keeper.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// DelegateVote defines a method to delegate the voting power of an account
+	// to another account.
+	DelegateVote(context.Context, *MsgDelegateVote) (*MsgDelegateVoteResponse, error)
+	// UndelegateVote defines a method to revoke a vote delegation.
+	UndelegateVote(context.Context, *MsgUndelegateVote) (*MsgUndelegateVoteResponse, error)
+	// CancelQueuedExecution defines a method to cancel the queued execution of a
+	// passed proposal.
+	CancelQueuedExecution(context.Context, *MsgCancelQueuedExecution) (*MsgCancelQueuedExecutionResponse, error)
+	// SubmitOptimisticProposal defines a method to submit an optimistic proposal,
+	// which passes unless it is rejected by the no and no with veto votes.
+	SubmitOptimisticProposal(context.Context, *MsgSubmitOptimisticProposal) (*MsgSubmitOptimisticProposalResponse, error)
+	// UpdateMoveHook defines a governance operation for registering or removing
+	// a Move hook called on the proposal lifecycle events.
+	UpdateMoveHook(context.Context, *MsgUpdateMoveHook) (*MsgUpdateMoveHookResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -65,6 +143,21 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) DelegateVote(context.Context, *MsgDelegateVote) (*MsgDelegateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateVote not implemented")
+}
+func (UnimplementedMsgServer) UndelegateVote(context.Context, *MsgUndelegateVote) (*MsgUndelegateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateVote not implemented")
+}
+func (UnimplementedMsgServer) CancelQueuedExecution(context.Context, *MsgCancelQueuedExecution) (*MsgCancelQueuedExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelQueuedExecution not implemented")
+}
+func (UnimplementedMsgServer) SubmitOptimisticProposal(context.Context, *MsgSubmitOptimisticProposal) (*MsgSubmitOptimisticProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitOptimisticProposal not implemented")
+}
+func (UnimplementedMsgServer) UpdateMoveHook(context.Context, *MsgUpdateMoveHook) (*MsgUpdateMoveHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMoveHook not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -96,6 +189,96 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelegateVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateVote(ctx, req.(*MsgDelegateVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UndelegateVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateVote(ctx, req.(*MsgUndelegateVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelQueuedExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelQueuedExecution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelQueuedExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelQueuedExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelQueuedExecution(ctx, req.(*MsgCancelQueuedExecution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitOptimisticProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitOptimisticProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitOptimisticProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitOptimisticProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitOptimisticProposal(ctx, req.(*MsgSubmitOptimisticProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMoveHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMoveHook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMoveHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateMoveHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMoveHook(ctx, req.(*MsgUpdateMoveHook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -107,6 +290,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "DelegateVote",
+			Handler:    _Msg_DelegateVote_Handler,
+		},
+		{
+			MethodName: "UndelegateVote",
+			Handler:    _Msg_UndelegateVote_Handler,
+		},
+		{
+			MethodName: "CancelQueuedExecution",
+			Handler:    _Msg_CancelQueuedExecution_Handler,
+		},
+		{
+			MethodName: "SubmitOptimisticProposal",
+			Handler:    _Msg_SubmitOptimisticProposal_Handler,
+		},
+		{
+			MethodName: "UpdateMoveHook",
+			Handler:    _Msg_UpdateMoveHook_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/gov/v1/tx.proto",
@@ -270,16 +270,173 @@ func (x *_GenesisState_6_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*SlashEvent
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SlashEvent)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SlashEvent)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(SlashEvent)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(SlashEvent)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*DelegatorSlashImpact
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DelegatorSlashImpact)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DelegatorSlashImpact)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(DelegatorSlashImpact)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(DelegatorSlashImpact)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*ConsPubKeyRotationHistory
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ConsPubKeyRotationHistory)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ConsPubKeyRotationHistory)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(ConsPubKeyRotationHistory)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(ConsPubKeyRotationHistory)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                       protoreflect.MessageDescriptor
-	fd_GenesisState_params                protoreflect.FieldDescriptor
-	fd_GenesisState_last_validator_powers protoreflect.FieldDescriptor
-	fd_GenesisState_validators            protoreflect.FieldDescriptor
-	fd_GenesisState_delegations           protoreflect.FieldDescriptor
-	fd_GenesisState_unbonding_delegations protoreflect.FieldDescriptor
-	fd_GenesisState_redelegations         protoreflect.FieldDescriptor
-	fd_GenesisState_exported              protoreflect.FieldDescriptor
-	fd_GenesisState_next_unbonding_id     protoreflect.FieldDescriptor
+	md_GenesisState                                protoreflect.MessageDescriptor
+	fd_GenesisState_params                         protoreflect.FieldDescriptor
+	fd_GenesisState_last_validator_powers          protoreflect.FieldDescriptor
+	fd_GenesisState_validators                     protoreflect.FieldDescriptor
+	fd_GenesisState_delegations                    protoreflect.FieldDescriptor
+	fd_GenesisState_unbonding_delegations          protoreflect.FieldDescriptor
+	fd_GenesisState_redelegations                  protoreflect.FieldDescriptor
+	fd_GenesisState_exported                       protoreflect.FieldDescriptor
+	fd_GenesisState_next_unbonding_id              protoreflect.FieldDescriptor
+	fd_GenesisState_slash_events                   protoreflect.FieldDescriptor
+	fd_GenesisState_slash_impacts                  protoreflect.FieldDescriptor
+	fd_GenesisState_next_slash_event_id            protoreflect.FieldDescriptor
+	fd_GenesisState_cons_pubkey_rotation_histories protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -293,6 +450,10 @@ func init() {
 	fd_GenesisState_redelegations = md_GenesisState.Fields().ByName("redelegations")
 	fd_GenesisState_exported = md_GenesisState.Fields().ByName("exported")
 	fd_GenesisState_next_unbonding_id = md_GenesisState.Fields().ByName("next_unbonding_id")
+	fd_GenesisState_slash_events = md_GenesisState.Fields().ByName("slash_events")
+	fd_GenesisState_slash_impacts = md_GenesisState.Fields().ByName("slash_impacts")
+	fd_GenesisState_next_slash_event_id = md_GenesisState.Fields().ByName("next_slash_event_id")
+	fd_GenesisState_cons_pubkey_rotation_histories = md_GenesisState.Fields().ByName("cons_pubkey_rotation_histories")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -408,6 +569,30 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.SlashEvents) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.SlashEvents})
+		if !f(fd_GenesisState_slash_events, value) {
+			return
+		}
+	}
+	if len(x.SlashImpacts) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.SlashImpacts})
+		if !f(fd_GenesisState_slash_impacts, value) {
+			return
+		}
+	}
+	if x.NextSlashEventId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextSlashEventId)
+		if !f(fd_GenesisState_next_slash_event_id, value) {
+			return
+		}
+	}
+	if len(x.ConsPubkeyRotationHistories) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.ConsPubkeyRotationHistories})
+		if !f(fd_GenesisState_cons_pubkey_rotation_histories, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -439,6 +624,14 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.Exported != false
 	case "initia.mstaking.v1.GenesisState.next_unbonding_id":
 		return x.NextUnbondingId != uint64(0)
+	case "initia.mstaking.v1.GenesisState.slash_events":
+		return len(x.SlashEvents) != 0
+	case "initia.mstaking.v1.GenesisState.slash_impacts":
+		return len(x.SlashImpacts) != 0
+	case "initia.mstaking.v1.GenesisState.next_slash_event_id":
+		return x.NextSlashEventId != uint64(0)
+	case "initia.mstaking.v1.GenesisState.cons_pubkey_rotation_histories":
+		return len(x.ConsPubkeyRotationHistories) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.GenesisState"))
@@ -471,6 +664,14 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Exported = false
 	case "initia.mstaking.v1.GenesisState.next_unbonding_id":
 		x.NextUnbondingId = uint64(0)
+	case "initia.mstaking.v1.GenesisState.slash_events":
+		x.SlashEvents = nil
+	case "initia.mstaking.v1.GenesisState.slash_impacts":
+		x.SlashImpacts = nil
+	case "initia.mstaking.v1.GenesisState.next_slash_event_id":
+		x.NextSlashEventId = uint64(0)
+	case "initia.mstaking.v1.GenesisState.cons_pubkey_rotation_histories":
+		x.ConsPubkeyRotationHistories = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.GenesisState"))
@@ -526,6 +727,27 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "initia.mstaking.v1.GenesisState.next_unbonding_id":
 		value := x.NextUnbondingId
 		return protoreflect.ValueOfUint64(value)
+	case "initia.mstaking.v1.GenesisState.slash_events":
+		if len(x.SlashEvents) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.SlashEvents}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.mstaking.v1.GenesisState.slash_impacts":
+		if len(x.SlashImpacts) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.SlashImpacts}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.mstaking.v1.GenesisState.next_slash_event_id":
+		value := x.NextSlashEventId
+		return protoreflect.ValueOfUint64(value)
+	case "initia.mstaking.v1.GenesisState.cons_pubkey_rotation_histories":
+		if len(x.ConsPubkeyRotationHistories) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.ConsPubkeyRotationHistories}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.GenesisState"))
@@ -572,6 +794,20 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.Exported = value.Bool()
 	case "initia.mstaking.v1.GenesisState.next_unbonding_id":
 		x.NextUnbondingId = value.Uint()
+	case "initia.mstaking.v1.GenesisState.slash_events":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.SlashEvents = *clv.list
+	case "initia.mstaking.v1.GenesisState.slash_impacts":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.SlashImpacts = *clv.list
+	case "initia.mstaking.v1.GenesisState.next_slash_event_id":
+		x.NextSlashEventId = value.Uint()
+	case "initia.mstaking.v1.GenesisState.cons_pubkey_rotation_histories":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.ConsPubkeyRotationHistories = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.GenesisState"))
@@ -627,10 +863,30 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_6_list{list: &x.Redelegations}
 		return protoreflect.ValueOfList(value)
+	case "initia.mstaking.v1.GenesisState.slash_events":
+		if x.SlashEvents == nil {
+			x.SlashEvents = []*SlashEvent{}
+		}
+		value := &_GenesisState_9_list{list: &x.SlashEvents}
+		return protoreflect.ValueOfList(value)
+	case "initia.mstaking.v1.GenesisState.slash_impacts":
+		if x.SlashImpacts == nil {
+			x.SlashImpacts = []*DelegatorSlashImpact{}
+		}
+		value := &_GenesisState_10_list{list: &x.SlashImpacts}
+		return protoreflect.ValueOfList(value)
+	case "initia.mstaking.v1.GenesisState.cons_pubkey_rotation_histories":
+		if x.ConsPubkeyRotationHistories == nil {
+			x.ConsPubkeyRotationHistories = []*ConsPubKeyRotationHistory{}
+		}
+		value := &_GenesisState_12_list{list: &x.ConsPubkeyRotationHistories}
+		return protoreflect.ValueOfList(value)
 	case "initia.mstaking.v1.GenesisState.exported":
 		panic(fmt.Errorf("field exported of message initia.mstaking.v1.GenesisState is not mutable"))
 	case "initia.mstaking.v1.GenesisState.next_unbonding_id":
 		panic(fmt.Errorf("field next_unbonding_id of message initia.mstaking.v1.GenesisState is not mutable"))
+	case "initia.mstaking.v1.GenesisState.next_slash_event_id":
+		panic(fmt.Errorf("field next_slash_event_id of message initia.mstaking.v1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.GenesisState"))
@@ -666,6 +922,17 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfBool(false)
 	case "initia.mstaking.v1.GenesisState.next_unbonding_id":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.mstaking.v1.GenesisState.slash_events":
+		list := []*SlashEvent{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "initia.mstaking.v1.GenesisState.slash_impacts":
+		list := []*DelegatorSlashImpact{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "initia.mstaking.v1.GenesisState.next_slash_event_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.mstaking.v1.GenesisState.cons_pubkey_rotation_histories":
+		list := []*ConsPubKeyRotationHistory{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.GenesisState"))
@@ -775,6 +1042,27 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		if x.NextUnbondingId != 0 {
 			n += 1 + runtime.Sov(uint64(x.NextUnbondingId))
 		}
+		if len(x.SlashEvents) > 0 {
+			for _, e := range x.SlashEvents {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SlashImpacts) > 0 {
+			for _, e := range x.SlashImpacts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextSlashEventId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextSlashEventId))
+		}
+		if len(x.ConsPubkeyRotationHistories) > 0 {
+			for _, e := range x.ConsPubkeyRotationHistories {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -804,6 +1092,59 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ConsPubkeyRotationHistories) > 0 {
+			for iNdEx := len(x.ConsPubkeyRotationHistories) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ConsPubkeyRotationHistories[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if x.NextSlashEventId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextSlashEventId))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.SlashImpacts) > 0 {
+			for iNdEx := len(x.SlashImpacts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SlashImpacts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.SlashEvents) > 0 {
+			for iNdEx := len(x.SlashEvents) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SlashEvents[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
 		if x.NextUnbondingId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextUnbondingId))
 			i--
@@ -1207,6 +1548,127 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashEvents", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashEvents = append(x.SlashEvents, &SlashEvent{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SlashEvents[len(x.SlashEvents)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashImpacts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashImpacts = append(x.SlashImpacts, &DelegatorSlashImpact{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SlashImpacts[len(x.SlashImpacts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextSlashEventId", wireType)
+				}
+				x.NextSlashEventId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextSlashEventId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsPubkeyRotationHistories", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConsPubkeyRotationHistories = append(x.ConsPubkeyRotationHistories, &ConsPubKeyRotationHistory{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ConsPubkeyRotationHistories[len(x.ConsPubkeyRotationHistories)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1745,6 +2207,16 @@ type GenesisState struct {
 	Exported      bool            `protobuf:"varint,7,opt,name=exported,proto3" json:"exported,omitempty"`
 	// sdk missed this
 	NextUnbondingId uint64 `protobuf:"varint,8,opt,name=next_unbonding_id,json=nextUnbondingId,proto3" json:"next_unbonding_id,omitempty"`
+	// slash_events defines the slash events within the unbonding time.
+	SlashEvents []*SlashEvent `protobuf:"bytes,9,rep,name=slash_events,json=slashEvents,proto3" json:"slash_events,omitempty"`
+	// slash_impacts defines the tokens lost by the delegators by the slash events.
+	SlashImpacts []*DelegatorSlashImpact `protobuf:"bytes,10,rep,name=slash_impacts,json=slashImpacts,proto3" json:"slash_impacts,omitempty"`
+	// next_slash_event_id defines the id of the next slash event.
+	NextSlashEventId uint64 `protobuf:"varint,11,opt,name=next_slash_event_id,json=nextSlashEventId,proto3" json:"next_slash_event_id,omitempty"`
+	// cons_pubkey_rotation_histories defines the consensus pubkey rotations
+	// within the unbonding time. The rotation queue and the old consensus
+	// address indexes are rebuilt from them.
+	ConsPubkeyRotationHistories []*ConsPubKeyRotationHistory `protobuf:"bytes,12,rep,name=cons_pubkey_rotation_histories,json=consPubkeyRotationHistories,proto3" json:"cons_pubkey_rotation_histories,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1823,6 +2295,34 @@ func (x *GenesisState) GetNextUnbondingId() uint64 {
 	return 0
 }
 
+func (x *GenesisState) GetSlashEvents() []*SlashEvent {
+	if x != nil {
+		return x.SlashEvents
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSlashImpacts() []*DelegatorSlashImpact {
+	if x != nil {
+		return x.SlashImpacts
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextSlashEventId() uint64 {
+	if x != nil {
+		return x.NextSlashEventId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetConsPubkeyRotationHistories() []*ConsPubKeyRotationHistory {
+	if x != nil {
+		return x.ConsPubkeyRotationHistories
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	state         protoimpl.MessageState
@@ -1881,7 +2381,7 @@ var file_initia_mstaking_v1_genesis_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
 	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x69, 0x6e, 0x69, 0x74,
 	0x69, 0x61, 0x2f, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x73,
-	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd4, 0x05, 0x0a,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x83, 0x09, 0x0a,
 	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a,
 	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
@@ -1927,27 +2427,54 @@ var file_initia_mstaking_v1_genesis_proto_rawDesc = []byte{
 	0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x78, 0x74, 0x5f,
 	0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01,
 	0x28, 0x04, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x49, 0x64, 0x22, 0x68, 0x0a, 0x12, 0x4c, 0x61, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f,
-	0x77, 0x65, 0x72, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x42, 0xd1, 0x01,
-	0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x73, 0x74,
-	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
-	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73,
-	0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x2f, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x6d,
-	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x4d, 0x58, 0xaa,
-	0x02, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x4d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
-	0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x4d, 0x73,
-	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e, 0x49, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x5c, 0x4d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x3a, 0x3a, 0x4d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x67, 0x49, 0x64, 0x12, 0x63, 0x0a, 0x0c, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x2e, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x20, 0xc8, 0xde, 0x1f, 0x00, 0xf2,
+	0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x73, 0x6c, 0x61,
+	0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x70, 0x0a, 0x0d, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x5f, 0x69, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x42, 0x21, 0xc8, 0xde, 0x1f, 0x00, 0xf2,
+	0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x69,
+	0x6d, 0x70, 0x61, 0x63, 0x74, 0x73, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x6c, 0x61,
+	0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0xa6, 0x01, 0x0a, 0x1e, 0x63, 0x6f,
+	0x6e, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x73, 0x74, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x50, 0x75, 0x62, 0x4b,
+	0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x42, 0x32, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x25, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1b, 0x63, 0x6f, 0x6e, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x22, 0x68, 0x0a, 0x12, 0x4c, 0x61, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x42, 0xd1, 0x01, 0x0a,
+	0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x73, 0x74, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x2f, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x73,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x4d, 0x58, 0xaa, 0x02,
+	0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x4d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x4d, 0x73, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e, 0x49, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x5c, 0x4d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x3a, 0x3a, 0x4d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1964,13 +2491,16 @@ func file_initia_mstaking_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_initia_mstaking_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_initia_mstaking_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),        // 0: initia.mstaking.v1.GenesisState
-	(*LastValidatorPower)(nil),  // 1: initia.mstaking.v1.LastValidatorPower
-	(*Params)(nil),              // 2: initia.mstaking.v1.Params
-	(*Validator)(nil),           // 3: initia.mstaking.v1.Validator
-	(*Delegation)(nil),          // 4: initia.mstaking.v1.Delegation
-	(*UnbondingDelegation)(nil), // 5: initia.mstaking.v1.UnbondingDelegation
-	(*Redelegation)(nil),        // 6: initia.mstaking.v1.Redelegation
+	(*GenesisState)(nil),              // 0: initia.mstaking.v1.GenesisState
+	(*LastValidatorPower)(nil),        // 1: initia.mstaking.v1.LastValidatorPower
+	(*Params)(nil),                    // 2: initia.mstaking.v1.Params
+	(*Validator)(nil),                 // 3: initia.mstaking.v1.Validator
+	(*Delegation)(nil),                // 4: initia.mstaking.v1.Delegation
+	(*UnbondingDelegation)(nil),       // 5: initia.mstaking.v1.UnbondingDelegation
+	(*Redelegation)(nil),              // 6: initia.mstaking.v1.Redelegation
+	(*SlashEvent)(nil),                // 7: initia.mstaking.v1.SlashEvent
+	(*DelegatorSlashImpact)(nil),      // 8: initia.mstaking.v1.DelegatorSlashImpact
+	(*ConsPubKeyRotationHistory)(nil), // 9: initia.mstaking.v1.ConsPubKeyRotationHistory
 }
 var file_initia_mstaking_v1_genesis_proto_depIdxs = []int32{
 	2, // 0: initia.mstaking.v1.GenesisState.params:type_name -> initia.mstaking.v1.Params
@@ -1979,11 +2509,14 @@ var file_initia_mstaking_v1_genesis_proto_depIdxs = []int32{
 	4, // 3: initia.mstaking.v1.GenesisState.delegations:type_name -> initia.mstaking.v1.Delegation
 	5, // 4: initia.mstaking.v1.GenesisState.unbonding_delegations:type_name -> initia.mstaking.v1.UnbondingDelegation
 	6, // 5: initia.mstaking.v1.GenesisState.redelegations:type_name -> initia.mstaking.v1.Redelegation
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	7, // 6: initia.mstaking.v1.GenesisState.slash_events:type_name -> initia.mstaking.v1.SlashEvent
+	8, // 7: initia.mstaking.v1.GenesisState.slash_impacts:type_name -> initia.mstaking.v1.DelegatorSlashImpact
+	9, // 8: initia.mstaking.v1.GenesisState.cons_pubkey_rotation_histories:type_name -> initia.mstaking.v1.ConsPubKeyRotationHistory
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_initia_mstaking_v1_genesis_proto_init() }
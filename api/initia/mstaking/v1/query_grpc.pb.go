@@ -32,6 +32,14 @@ const (
 	Query_DelegatorValidator_FullMethodName            = "/initia.mstaking.v1.Query/DelegatorValidator"
 	Query_Pool_FullMethodName                          = "/initia.mstaking.v1.Query/Pool"
 	Query_Params_FullMethodName                        = "/initia.mstaking.v1.Query/Params"
+	Query_ValidatorPowerAt_FullMethodName              = "/initia.mstaking.v1.Query/ValidatorPowerAt"
+	Query_VotingPowerWeightsAt_FullMethodName          = "/initia.mstaking.v1.Query/VotingPowerWeightsAt"
+	Query_PendingPowerUpdates_FullMethodName           = "/initia.mstaking.v1.Query/PendingPowerUpdates"
+	Query_UnbondingQueue_FullMethodName                = "/initia.mstaking.v1.Query/UnbondingQueue"
+	Query_RedelegationQueue_FullMethodName             = "/initia.mstaking.v1.Query/RedelegationQueue"
+	Query_DelegatorUnbondingSummary_FullMethodName     = "/initia.mstaking.v1.Query/DelegatorUnbondingSummary"
+	Query_ValidatorSlashEvents_FullMethodName          = "/initia.mstaking.v1.Query/ValidatorSlashEvents"
+	Query_DelegatorSlashImpacts_FullMethodName         = "/initia.mstaking.v1.Query/DelegatorSlashImpacts"
 )
 
 // QueryClient is the client API for Query service.
@@ -68,6 +76,32 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ValidatorPowerAt queries the validator tokens and voting power recorded
+	// at the latest power snapshot at or before the given height.
+	ValidatorPowerAt(ctx context.Context, in *QueryValidatorPowerAtRequest, opts ...grpc.CallOption) (*QueryValidatorPowerAtResponse, error)
+	// VotingPowerWeightsAt queries the voting power weights recorded
+	// at the latest power snapshot at or before the given height.
+	VotingPowerWeightsAt(ctx context.Context, in *QueryVotingPowerWeightsAtRequest, opts ...grpc.CallOption) (*QueryVotingPowerWeightsAtResponse, error)
+	// PendingPowerUpdates queries the consensus power changes of the bonded
+	// validators and the deferred entering validators which are carried over to
+	// the later blocks.
+	PendingPowerUpdates(ctx context.Context, in *QueryPendingPowerUpdatesRequest, opts ...grpc.CallOption) (*QueryPendingPowerUpdatesResponse, error)
+	// UnbondingQueue queries the unbonding delegation entries maturing in the
+	// given time range, optionally filtered by delegator and validator.
+	UnbondingQueue(ctx context.Context, in *QueryUnbondingQueueRequest, opts ...grpc.CallOption) (*QueryUnbondingQueueResponse, error)
+	// RedelegationQueue queries the redelegation entries maturing in the
+	// given time range, optionally filtered by delegator and validator.
+	RedelegationQueue(ctx context.Context, in *QueryRedelegationQueueRequest, opts ...grpc.CallOption) (*QueryRedelegationQueueResponse, error)
+	// DelegatorUnbondingSummary queries the total unbonding amounts of a delegator
+	// and the amounts unlocked at each completion time.
+	DelegatorUnbondingSummary(ctx context.Context, in *QueryDelegatorUnbondingSummaryRequest, opts ...grpc.CallOption) (*QueryDelegatorUnbondingSummaryResponse, error)
+	// ValidatorSlashEvents queries the slash events of a validator within the
+	// unbonding time.
+	ValidatorSlashEvents(ctx context.Context, in *QueryValidatorSlashEventsRequest, opts ...grpc.CallOption) (*QueryValidatorSlashEventsResponse, error)
+	// DelegatorSlashImpacts queries the tokens a delegator lost by the slash
+	// events within the unbonding time, optionally filtered by validator. At most
+	// 1000 delegators are recorded per slash event.
+	DelegatorSlashImpacts(ctx context.Context, in *QueryDelegatorSlashImpactsRequest, opts ...grpc.CallOption) (*QueryDelegatorSlashImpactsResponse, error)
 }
 
 type queryClient struct {
@@ -195,6 +229,78 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ValidatorPowerAt(ctx context.Context, in *QueryValidatorPowerAtRequest, opts ...grpc.CallOption) (*QueryValidatorPowerAtResponse, error) {
+	out := new(QueryValidatorPowerAtResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorPowerAt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VotingPowerWeightsAt(ctx context.Context, in *QueryVotingPowerWeightsAtRequest, opts ...grpc.CallOption) (*QueryVotingPowerWeightsAtResponse, error) {
+	out := new(QueryVotingPowerWeightsAtResponse)
+	err := c.cc.Invoke(ctx, Query_VotingPowerWeightsAt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingPowerUpdates(ctx context.Context, in *QueryPendingPowerUpdatesRequest, opts ...grpc.CallOption) (*QueryPendingPowerUpdatesResponse, error) {
+	out := new(QueryPendingPowerUpdatesResponse)
+	err := c.cc.Invoke(ctx, Query_PendingPowerUpdates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbondingQueue(ctx context.Context, in *QueryUnbondingQueueRequest, opts ...grpc.CallOption) (*QueryUnbondingQueueResponse, error) {
+	out := new(QueryUnbondingQueueResponse)
+	err := c.cc.Invoke(ctx, Query_UnbondingQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RedelegationQueue(ctx context.Context, in *QueryRedelegationQueueRequest, opts ...grpc.CallOption) (*QueryRedelegationQueueResponse, error) {
+	out := new(QueryRedelegationQueueResponse)
+	err := c.cc.Invoke(ctx, Query_RedelegationQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorUnbondingSummary(ctx context.Context, in *QueryDelegatorUnbondingSummaryRequest, opts ...grpc.CallOption) (*QueryDelegatorUnbondingSummaryResponse, error) {
+	out := new(QueryDelegatorUnbondingSummaryResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorUnbondingSummary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorSlashEvents(ctx context.Context, in *QueryValidatorSlashEventsRequest, opts ...grpc.CallOption) (*QueryValidatorSlashEventsResponse, error) {
+	out := new(QueryValidatorSlashEventsResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorSlashEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorSlashImpacts(ctx context.Context, in *QueryDelegatorSlashImpactsRequest, opts ...grpc.CallOption) (*QueryDelegatorSlashImpactsResponse, error) {
+	out := new(QueryDelegatorSlashImpactsResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorSlashImpacts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -229,6 +335,32 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ValidatorPowerAt queries the validator tokens and voting power recorded
+	// at the latest power snapshot at or before the given height.
+	ValidatorPowerAt(context.Context, *QueryValidatorPowerAtRequest) (*QueryValidatorPowerAtResponse, error)
+	// VotingPowerWeightsAt queries the voting power weights recorded
+	// at the latest power snapshot at or before the given height.
+	VotingPowerWeightsAt(context.Context, *QueryVotingPowerWeightsAtRequest) (*QueryVotingPowerWeightsAtResponse, error)
+	// PendingPowerUpdates queries the consensus power changes of the bonded
+	// validators and the deferred entering validators which are carried over to
+	// the later blocks.
+	PendingPowerUpdates(context.Context, *QueryPendingPowerUpdatesRequest) (*QueryPendingPowerUpdatesResponse, error)
+	// UnbondingQueue queries the unbonding delegation entries maturing in the
+	// given time range, optionally filtered by delegator and validator.
+	UnbondingQueue(context.Context, *QueryUnbondingQueueRequest) (*QueryUnbondingQueueResponse, error)
+	// RedelegationQueue queries the redelegation entries maturing in the
+	// given time range, optionally filtered by delegator and validator.
+	RedelegationQueue(context.Context, *QueryRedelegationQueueRequest) (*QueryRedelegationQueueResponse, error)
+	// DelegatorUnbondingSummary queries the total unbonding amounts of a delegator
+	// and the amounts unlocked at each completion time.
+	DelegatorUnbondingSummary(context.Context, *QueryDelegatorUnbondingSummaryRequest) (*QueryDelegatorUnbondingSummaryResponse, error)
+	// ValidatorSlashEvents queries the slash events of a validator within the
+	// unbonding time.
+	ValidatorSlashEvents(context.Context, *QueryValidatorSlashEventsRequest) (*QueryValidatorSlashEventsResponse, error)
+	// DelegatorSlashImpacts queries the tokens a delegator lost by the slash
+	// events within the unbonding time, optionally filtered by validator. At most
+	// 1000 delegators are recorded per slash event.
+	DelegatorSlashImpacts(context.Context, *QueryDelegatorSlashImpactsRequest) (*QueryDelegatorSlashImpactsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -275,6 +407,30 @@ func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*Query
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) ValidatorPowerAt(context.Context, *QueryValidatorPowerAtRequest) (*QueryValidatorPowerAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPowerAt not implemented")
+}
+func (UnimplementedQueryServer) VotingPowerWeightsAt(context.Context, *QueryVotingPowerWeightsAtRequest) (*QueryVotingPowerWeightsAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotingPowerWeightsAt not implemented")
+}
+func (UnimplementedQueryServer) PendingPowerUpdates(context.Context, *QueryPendingPowerUpdatesRequest) (*QueryPendingPowerUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingPowerUpdates not implemented")
+}
+func (UnimplementedQueryServer) UnbondingQueue(context.Context, *QueryUnbondingQueueRequest) (*QueryUnbondingQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingQueue not implemented")
+}
+func (UnimplementedQueryServer) RedelegationQueue(context.Context, *QueryRedelegationQueueRequest) (*QueryRedelegationQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegationQueue not implemented")
+}
+func (UnimplementedQueryServer) DelegatorUnbondingSummary(context.Context, *QueryDelegatorUnbondingSummaryRequest) (*QueryDelegatorUnbondingSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorUnbondingSummary not implemented")
+}
+func (UnimplementedQueryServer) ValidatorSlashEvents(context.Context, *QueryValidatorSlashEventsRequest) (*QueryValidatorSlashEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSlashEvents not implemented")
+}
+func (UnimplementedQueryServer) DelegatorSlashImpacts(context.Context, *QueryDelegatorSlashImpactsRequest) (*QueryDelegatorSlashImpactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorSlashImpacts not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -522,6 +678,150 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorPowerAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorPowerAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorPowerAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorPowerAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorPowerAt(ctx, req.(*QueryValidatorPowerAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VotingPowerWeightsAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingPowerWeightsAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingPowerWeightsAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VotingPowerWeightsAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingPowerWeightsAt(ctx, req.(*QueryVotingPowerWeightsAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingPowerUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingPowerUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingPowerUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingPowerUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingPowerUpdates(ctx, req.(*QueryPendingPowerUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UnbondingQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingQueue(ctx, req.(*QueryUnbondingQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedelegationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedelegationQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedelegationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RedelegationQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedelegationQueue(ctx, req.(*QueryRedelegationQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorUnbondingSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorUnbondingSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorUnbondingSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorUnbondingSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorUnbondingSummary(ctx, req.(*QueryDelegatorUnbondingSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorSlashEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorSlashEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSlashEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorSlashEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSlashEvents(ctx, req.(*QueryValidatorSlashEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorSlashImpacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorSlashImpactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorSlashImpacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorSlashImpacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorSlashImpacts(ctx, req.(*QueryDelegatorSlashImpactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -581,6 +881,38 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ValidatorPowerAt",
+			Handler:    _Query_ValidatorPowerAt_Handler,
+		},
+		{
+			MethodName: "VotingPowerWeightsAt",
+			Handler:    _Query_VotingPowerWeightsAt_Handler,
+		},
+		{
+			MethodName: "PendingPowerUpdates",
+			Handler:    _Query_PendingPowerUpdates_Handler,
+		},
+		{
+			MethodName: "UnbondingQueue",
+			Handler:    _Query_UnbondingQueue_Handler,
+		},
+		{
+			MethodName: "RedelegationQueue",
+			Handler:    _Query_RedelegationQueue_Handler,
+		},
+		{
+			MethodName: "DelegatorUnbondingSummary",
+			Handler:    _Query_DelegatorUnbondingSummary_Handler,
+		},
+		{
+			MethodName: "ValidatorSlashEvents",
+			Handler:    _Query_ValidatorSlashEvents_Handler,
+		},
+		{
+			MethodName: "DelegatorSlashImpacts",
+			Handler:    _Query_DelegatorSlashImpacts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/mstaking/v1/query.proto",
@@ -9403,14 +9403,19 @@ func (x *_Params_5_list) IsValid() bool {
 }
 
 var (
-	md_Params                     protoreflect.MessageDescriptor
-	fd_Params_unbonding_time      protoreflect.FieldDescriptor
-	fd_Params_max_validators      protoreflect.FieldDescriptor
-	fd_Params_max_entries         protoreflect.FieldDescriptor
-	fd_Params_historical_entries  protoreflect.FieldDescriptor
-	fd_Params_bond_denoms         protoreflect.FieldDescriptor
-	fd_Params_min_voting_power    protoreflect.FieldDescriptor
-	fd_Params_min_commission_rate protoreflect.FieldDescriptor
+	md_Params                           protoreflect.MessageDescriptor
+	fd_Params_unbonding_time            protoreflect.FieldDescriptor
+	fd_Params_max_validators            protoreflect.FieldDescriptor
+	fd_Params_max_entries               protoreflect.FieldDescriptor
+	fd_Params_historical_entries        protoreflect.FieldDescriptor
+	fd_Params_bond_denoms               protoreflect.FieldDescriptor
+	fd_Params_min_voting_power          protoreflect.FieldDescriptor
+	fd_Params_min_commission_rate       protoreflect.FieldDescriptor
+	fd_Params_power_snapshot_interval   protoreflect.FieldDescriptor
+	fd_Params_power_snapshot_entries    protoreflect.FieldDescriptor
+	fd_Params_max_power_change_rate     protoreflect.FieldDescriptor
+	fd_Params_key_rotation_fee          protoreflect.FieldDescriptor
+	fd_Params_max_cons_pubkey_rotations protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -9423,6 +9428,11 @@ func init() {
 	fd_Params_bond_denoms = md_Params.Fields().ByName("bond_denoms")
 	fd_Params_min_voting_power = md_Params.Fields().ByName("min_voting_power")
 	fd_Params_min_commission_rate = md_Params.Fields().ByName("min_commission_rate")
+	fd_Params_power_snapshot_interval = md_Params.Fields().ByName("power_snapshot_interval")
+	fd_Params_power_snapshot_entries = md_Params.Fields().ByName("power_snapshot_entries")
+	fd_Params_max_power_change_rate = md_Params.Fields().ByName("max_power_change_rate")
+	fd_Params_key_rotation_fee = md_Params.Fields().ByName("key_rotation_fee")
+	fd_Params_max_cons_pubkey_rotations = md_Params.Fields().ByName("max_cons_pubkey_rotations")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -9532,6 +9542,36 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.PowerSnapshotInterval != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.PowerSnapshotInterval)
+		if !f(fd_Params_power_snapshot_interval, value) {
+			return
+		}
+	}
+	if x.PowerSnapshotEntries != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.PowerSnapshotEntries)
+		if !f(fd_Params_power_snapshot_entries, value) {
+			return
+		}
+	}
+	if x.MaxPowerChangeRate != "" {
+		value := protoreflect.ValueOfString(x.MaxPowerChangeRate)
+		if !f(fd_Params_max_power_change_rate, value) {
+			return
+		}
+	}
+	if x.KeyRotationFee != nil {
+		value := protoreflect.ValueOfMessage(x.KeyRotationFee.ProtoReflect())
+		if !f(fd_Params_key_rotation_fee, value) {
+			return
+		}
+	}
+	if x.MaxConsPubkeyRotations != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxConsPubkeyRotations)
+		if !f(fd_Params_max_cons_pubkey_rotations, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -9561,6 +9601,16 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MinVotingPower != uint64(0)
 	case "initia.mstaking.v1.Params.min_commission_rate":
 		return x.MinCommissionRate != ""
+	case "initia.mstaking.v1.Params.power_snapshot_interval":
+		return x.PowerSnapshotInterval != uint32(0)
+	case "initia.mstaking.v1.Params.power_snapshot_entries":
+		return x.PowerSnapshotEntries != uint32(0)
+	case "initia.mstaking.v1.Params.max_power_change_rate":
+		return x.MaxPowerChangeRate != ""
+	case "initia.mstaking.v1.Params.key_rotation_fee":
+		return x.KeyRotationFee != nil
+	case "initia.mstaking.v1.Params.max_cons_pubkey_rotations":
+		return x.MaxConsPubkeyRotations != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.Params"))
@@ -9591,6 +9641,16 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.MinVotingPower = uint64(0)
 	case "initia.mstaking.v1.Params.min_commission_rate":
 		x.MinCommissionRate = ""
+	case "initia.mstaking.v1.Params.power_snapshot_interval":
+		x.PowerSnapshotInterval = uint32(0)
+	case "initia.mstaking.v1.Params.power_snapshot_entries":
+		x.PowerSnapshotEntries = uint32(0)
+	case "initia.mstaking.v1.Params.max_power_change_rate":
+		x.MaxPowerChangeRate = ""
+	case "initia.mstaking.v1.Params.key_rotation_fee":
+		x.KeyRotationFee = nil
+	case "initia.mstaking.v1.Params.max_cons_pubkey_rotations":
+		x.MaxConsPubkeyRotations = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.Params"))
@@ -9631,6 +9691,21 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "initia.mstaking.v1.Params.min_commission_rate":
 		value := x.MinCommissionRate
 		return protoreflect.ValueOfString(value)
+	case "initia.mstaking.v1.Params.power_snapshot_interval":
+		value := x.PowerSnapshotInterval
+		return protoreflect.ValueOfUint32(value)
+	case "initia.mstaking.v1.Params.power_snapshot_entries":
+		value := x.PowerSnapshotEntries
+		return protoreflect.ValueOfUint32(value)
+	case "initia.mstaking.v1.Params.max_power_change_rate":
+		value := x.MaxPowerChangeRate
+		return protoreflect.ValueOfString(value)
+	case "initia.mstaking.v1.Params.key_rotation_fee":
+		value := x.KeyRotationFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.mstaking.v1.Params.max_cons_pubkey_rotations":
+		value := x.MaxConsPubkeyRotations
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.Params"))
@@ -9667,6 +9742,16 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MinVotingPower = value.Uint()
 	case "initia.mstaking.v1.Params.min_commission_rate":
 		x.MinCommissionRate = value.Interface().(string)
+	case "initia.mstaking.v1.Params.power_snapshot_interval":
+		x.PowerSnapshotInterval = uint32(value.Uint())
+	case "initia.mstaking.v1.Params.power_snapshot_entries":
+		x.PowerSnapshotEntries = uint32(value.Uint())
+	case "initia.mstaking.v1.Params.max_power_change_rate":
+		x.MaxPowerChangeRate = value.Interface().(string)
+	case "initia.mstaking.v1.Params.key_rotation_fee":
+		x.KeyRotationFee = value.Message().Interface().(*v1beta1.Coin)
+	case "initia.mstaking.v1.Params.max_cons_pubkey_rotations":
+		x.MaxConsPubkeyRotations = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.Params"))
@@ -9698,6 +9783,11 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Params_5_list{list: &x.BondDenoms}
 		return protoreflect.ValueOfList(value)
+	case "initia.mstaking.v1.Params.key_rotation_fee":
+		if x.KeyRotationFee == nil {
+			x.KeyRotationFee = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.KeyRotationFee.ProtoReflect())
 	case "initia.mstaking.v1.Params.max_validators":
 		panic(fmt.Errorf("field max_validators of message initia.mstaking.v1.Params is not mutable"))
 	case "initia.mstaking.v1.Params.max_entries":
@@ -9708,6 +9798,14 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field min_voting_power of message initia.mstaking.v1.Params is not mutable"))
 	case "initia.mstaking.v1.Params.min_commission_rate":
 		panic(fmt.Errorf("field min_commission_rate of message initia.mstaking.v1.Params is not mutable"))
+	case "initia.mstaking.v1.Params.power_snapshot_interval":
+		panic(fmt.Errorf("field power_snapshot_interval of message initia.mstaking.v1.Params is not mutable"))
+	case "initia.mstaking.v1.Params.power_snapshot_entries":
+		panic(fmt.Errorf("field power_snapshot_entries of message initia.mstaking.v1.Params is not mutable"))
+	case "initia.mstaking.v1.Params.max_power_change_rate":
+		panic(fmt.Errorf("field max_power_change_rate of message initia.mstaking.v1.Params is not mutable"))
+	case "initia.mstaking.v1.Params.max_cons_pubkey_rotations":
+		panic(fmt.Errorf("field max_cons_pubkey_rotations of message initia.mstaking.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.Params"))
@@ -9737,6 +9835,17 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "initia.mstaking.v1.Params.min_commission_rate":
 		return protoreflect.ValueOfString("")
+	case "initia.mstaking.v1.Params.power_snapshot_interval":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "initia.mstaking.v1.Params.power_snapshot_entries":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "initia.mstaking.v1.Params.max_power_change_rate":
+		return protoreflect.ValueOfString("")
+	case "initia.mstaking.v1.Params.key_rotation_fee":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.mstaking.v1.Params.max_cons_pubkey_rotations":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.Params"))
@@ -9832,6 +9941,23 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.PowerSnapshotInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.PowerSnapshotInterval))
+		}
+		if x.PowerSnapshotEntries != 0 {
+			n += 1 + runtime.Sov(uint64(x.PowerSnapshotEntries))
+		}
+		l = len(x.MaxPowerChangeRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.KeyRotationFee != nil {
+			l = options.Size(x.KeyRotationFee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxConsPubkeyRotations != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxConsPubkeyRotations))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -9861,6 +9987,42 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxConsPubkeyRotations != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxConsPubkeyRotations))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.KeyRotationFee != nil {
+			encoded, err := options.Marshal(x.KeyRotationFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.MaxPowerChangeRate) > 0 {
+			i -= len(x.MaxPowerChangeRate)
+			copy(dAtA[i:], x.MaxPowerChangeRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxPowerChangeRate)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.PowerSnapshotEntries != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PowerSnapshotEntries))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.PowerSnapshotInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PowerSnapshotInterval))
+			i--
+			dAtA[i] = 0x40
+		}
 		if len(x.MinCommissionRate) > 0 {
 			i -= len(x.MinCommissionRate)
 			copy(dAtA[i:], x.MinCommissionRate)
@@ -10136,6 +10298,131 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.MinCommissionRate = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PowerSnapshotInterval", wireType)
+				}
+				x.PowerSnapshotInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PowerSnapshotInterval |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PowerSnapshotEntries", wireType)
+				}
+				x.PowerSnapshotEntries = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PowerSnapshotEntries |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPowerChangeRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxPowerChangeRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyRotationFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.KeyRotationFee == nil {
+					x.KeyRotationFee = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.KeyRotationFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxConsPubkeyRotations", wireType)
+				}
+				x.MaxConsPubkeyRotations = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxConsPubkeyRotations |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -10171,79 +10458,36 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_DelegationResponse_2_list)(nil)
-
-type _DelegationResponse_2_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_DelegationResponse_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_DelegationResponse_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_DelegationResponse_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_DelegationResponse_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_DelegationResponse_2_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_DelegationResponse_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_DelegationResponse_2_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_DelegationResponse_2_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_DelegationResponse            protoreflect.MessageDescriptor
-	fd_DelegationResponse_delegation protoreflect.FieldDescriptor
-	fd_DelegationResponse_balance    protoreflect.FieldDescriptor
+	md_ConsPubKeyRotationHistory                  protoreflect.MessageDescriptor
+	fd_ConsPubKeyRotationHistory_operator_address protoreflect.FieldDescriptor
+	fd_ConsPubKeyRotationHistory_old_cons_pubkey  protoreflect.FieldDescriptor
+	fd_ConsPubKeyRotationHistory_new_cons_pubkey  protoreflect.FieldDescriptor
+	fd_ConsPubKeyRotationHistory_height           protoreflect.FieldDescriptor
+	fd_ConsPubKeyRotationHistory_completion_time  protoreflect.FieldDescriptor
+	fd_ConsPubKeyRotationHistory_fee              protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_initia_mstaking_v1_staking_proto_init()
-	md_DelegationResponse = File_initia_mstaking_v1_staking_proto.Messages().ByName("DelegationResponse")
-	fd_DelegationResponse_delegation = md_DelegationResponse.Fields().ByName("delegation")
-	fd_DelegationResponse_balance = md_DelegationResponse.Fields().ByName("balance")
+	md_ConsPubKeyRotationHistory = File_initia_mstaking_v1_staking_proto.Messages().ByName("ConsPubKeyRotationHistory")
+	fd_ConsPubKeyRotationHistory_operator_address = md_ConsPubKeyRotationHistory.Fields().ByName("operator_address")
+	fd_ConsPubKeyRotationHistory_old_cons_pubkey = md_ConsPubKeyRotationHistory.Fields().ByName("old_cons_pubkey")
+	fd_ConsPubKeyRotationHistory_new_cons_pubkey = md_ConsPubKeyRotationHistory.Fields().ByName("new_cons_pubkey")
+	fd_ConsPubKeyRotationHistory_height = md_ConsPubKeyRotationHistory.Fields().ByName("height")
+	fd_ConsPubKeyRotationHistory_completion_time = md_ConsPubKeyRotationHistory.Fields().ByName("completion_time")
+	fd_ConsPubKeyRotationHistory_fee = md_ConsPubKeyRotationHistory.Fields().ByName("fee")
 }
 
-var _ protoreflect.Message = (*fastReflection_DelegationResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_ConsPubKeyRotationHistory)(nil)
 
-type fastReflection_DelegationResponse DelegationResponse
+type fastReflection_ConsPubKeyRotationHistory ConsPubKeyRotationHistory
 
-func (x *DelegationResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DelegationResponse)(x)
+func (x *ConsPubKeyRotationHistory) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ConsPubKeyRotationHistory)(x)
 }
 
-func (x *DelegationResponse) slowProtoReflect() protoreflect.Message {
+func (x *ConsPubKeyRotationHistory) slowProtoReflect() protoreflect.Message {
 	mi := &file_initia_mstaking_v1_staking_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10255,43 +10499,43 @@ func (x *DelegationResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DelegationResponse_messageType fastReflection_DelegationResponse_messageType
-var _ protoreflect.MessageType = fastReflection_DelegationResponse_messageType{}
+var _fastReflection_ConsPubKeyRotationHistory_messageType fastReflection_ConsPubKeyRotationHistory_messageType
+var _ protoreflect.MessageType = fastReflection_ConsPubKeyRotationHistory_messageType{}
 
-type fastReflection_DelegationResponse_messageType struct{}
+type fastReflection_ConsPubKeyRotationHistory_messageType struct{}
 
-func (x fastReflection_DelegationResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DelegationResponse)(nil)
+func (x fastReflection_ConsPubKeyRotationHistory_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ConsPubKeyRotationHistory)(nil)
 }
-func (x fastReflection_DelegationResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_DelegationResponse)
+func (x fastReflection_ConsPubKeyRotationHistory_messageType) New() protoreflect.Message {
+	return new(fastReflection_ConsPubKeyRotationHistory)
 }
-func (x fastReflection_DelegationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DelegationResponse
+func (x fastReflection_ConsPubKeyRotationHistory_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ConsPubKeyRotationHistory
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DelegationResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_DelegationResponse
+func (x *fastReflection_ConsPubKeyRotationHistory) Descriptor() protoreflect.MessageDescriptor {
+	return md_ConsPubKeyRotationHistory
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DelegationResponse) Type() protoreflect.MessageType {
-	return _fastReflection_DelegationResponse_messageType
+func (x *fastReflection_ConsPubKeyRotationHistory) Type() protoreflect.MessageType {
+	return _fastReflection_ConsPubKeyRotationHistory_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DelegationResponse) New() protoreflect.Message {
-	return new(fastReflection_DelegationResponse)
+func (x *fastReflection_ConsPubKeyRotationHistory) New() protoreflect.Message {
+	return new(fastReflection_ConsPubKeyRotationHistory)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DelegationResponse) Interface() protoreflect.ProtoMessage {
-	return (*DelegationResponse)(x)
+func (x *fastReflection_ConsPubKeyRotationHistory) Interface() protoreflect.ProtoMessage {
+	return (*ConsPubKeyRotationHistory)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10299,22 +10543,46 @@ func (x *fastReflection_DelegationResponse) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DelegationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Delegation != nil {
-		value := protoreflect.ValueOfMessage(x.Delegation.ProtoReflect())
-		if !f(fd_DelegationResponse_delegation, value) {
+func (x *fastReflection_ConsPubKeyRotationHistory) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OperatorAddress != "" {
+		value := protoreflect.ValueOfString(x.OperatorAddress)
+		if !f(fd_ConsPubKeyRotationHistory_operator_address, value) {
 			return
 		}
 	}
-	if len(x.Balance) != 0 {
-		value := protoreflect.ValueOfList(&_DelegationResponse_2_list{list: &x.Balance})
-		if !f(fd_DelegationResponse_balance, value) {
+	if x.OldConsPubkey != nil {
+		value := protoreflect.ValueOfMessage(x.OldConsPubkey.ProtoReflect())
+		if !f(fd_ConsPubKeyRotationHistory_old_cons_pubkey, value) {
 			return
 		}
 	}
-}
-
-// Has reports whether a field is populated.
+	if x.NewConsPubkey != nil {
+		value := protoreflect.ValueOfMessage(x.NewConsPubkey.ProtoReflect())
+		if !f(fd_ConsPubKeyRotationHistory_new_cons_pubkey, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_ConsPubKeyRotationHistory_height, value) {
+			return
+		}
+	}
+	if x.CompletionTime != nil {
+		value := protoreflect.ValueOfMessage(x.CompletionTime.ProtoReflect())
+		if !f(fd_ConsPubKeyRotationHistory_completion_time, value) {
+			return
+		}
+	}
+	if x.Fee != nil {
+		value := protoreflect.ValueOfMessage(x.Fee.ProtoReflect())
+		if !f(fd_ConsPubKeyRotationHistory_fee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
 //
 // Some fields have the property of nullability where it is possible to
 // distinguish between the default value of a field and whether the field
@@ -10325,17 +10593,25 @@ func (x *fastReflection_DelegationResponse) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DelegationResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ConsPubKeyRotationHistory) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "initia.mstaking.v1.DelegationResponse.delegation":
-		return x.Delegation != nil
-	case "initia.mstaking.v1.DelegationResponse.balance":
-		return len(x.Balance) != 0
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.operator_address":
+		return x.OperatorAddress != ""
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.old_cons_pubkey":
+		return x.OldConsPubkey != nil
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.new_cons_pubkey":
+		return x.NewConsPubkey != nil
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.height":
+		return x.Height != int64(0)
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.completion_time":
+		return x.CompletionTime != nil
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.fee":
+		return x.Fee != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.DelegationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.ConsPubKeyRotationHistory"))
 		}
-		panic(fmt.Errorf("message initia.mstaking.v1.DelegationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.mstaking.v1.ConsPubKeyRotationHistory does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10345,17 +10621,25 @@ func (x *fastReflection_DelegationResponse) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DelegationResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ConsPubKeyRotationHistory) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "initia.mstaking.v1.DelegationResponse.delegation":
-		x.Delegation = nil
-	case "initia.mstaking.v1.DelegationResponse.balance":
-		x.Balance = nil
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.operator_address":
+		x.OperatorAddress = ""
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.old_cons_pubkey":
+		x.OldConsPubkey = nil
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.new_cons_pubkey":
+		x.NewConsPubkey = nil
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.height":
+		x.Height = int64(0)
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.completion_time":
+		x.CompletionTime = nil
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.fee":
+		x.Fee = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.DelegationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.ConsPubKeyRotationHistory"))
 		}
-		panic(fmt.Errorf("message initia.mstaking.v1.DelegationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.mstaking.v1.ConsPubKeyRotationHistory does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10365,22 +10649,31 @@ func (x *fastReflection_DelegationResponse) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DelegationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ConsPubKeyRotationHistory) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "initia.mstaking.v1.DelegationResponse.delegation":
-		value := x.Delegation
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.operator_address":
+		value := x.OperatorAddress
+		return protoreflect.ValueOfString(value)
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.old_cons_pubkey":
+		value := x.OldConsPubkey
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.new_cons_pubkey":
+		value := x.NewConsPubkey
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.completion_time":
+		value := x.CompletionTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.fee":
+		value := x.Fee
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "initia.mstaking.v1.DelegationResponse.balance":
-		if len(x.Balance) == 0 {
-			return protoreflect.ValueOfList(&_DelegationResponse_2_list{})
-		}
-		listValue := &_DelegationResponse_2_list{list: &x.Balance}
-		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.DelegationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.ConsPubKeyRotationHistory"))
 		}
-		panic(fmt.Errorf("message initia.mstaking.v1.DelegationResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message initia.mstaking.v1.ConsPubKeyRotationHistory does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10394,19 +10687,25 @@ func (x *fastReflection_DelegationResponse) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DelegationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ConsPubKeyRotationHistory) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "initia.mstaking.v1.DelegationResponse.delegation":
-		x.Delegation = value.Message().Interface().(*Delegation)
-	case "initia.mstaking.v1.DelegationResponse.balance":
-		lv := value.List()
-		clv := lv.(*_DelegationResponse_2_list)
-		x.Balance = *clv.list
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.operator_address":
+		x.OperatorAddress = value.Interface().(string)
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.old_cons_pubkey":
+		x.OldConsPubkey = value.Message().Interface().(*anypb.Any)
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.new_cons_pubkey":
+		x.NewConsPubkey = value.Message().Interface().(*anypb.Any)
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.height":
+		x.Height = value.Int()
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.completion_time":
+		x.CompletionTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.fee":
+		x.Fee = value.Message().Interface().(*v1beta1.Coin)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.DelegationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.ConsPubKeyRotationHistory"))
 		}
-		panic(fmt.Errorf("message initia.mstaking.v1.DelegationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.mstaking.v1.ConsPubKeyRotationHistory does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10420,53 +10719,76 @@ func (x *fastReflection_DelegationResponse) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DelegationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ConsPubKeyRotationHistory) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.mstaking.v1.DelegationResponse.delegation":
-		if x.Delegation == nil {
-			x.Delegation = new(Delegation)
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.old_cons_pubkey":
+		if x.OldConsPubkey == nil {
+			x.OldConsPubkey = new(anypb.Any)
 		}
-		return protoreflect.ValueOfMessage(x.Delegation.ProtoReflect())
-	case "initia.mstaking.v1.DelegationResponse.balance":
-		if x.Balance == nil {
-			x.Balance = []*v1beta1.Coin{}
+		return protoreflect.ValueOfMessage(x.OldConsPubkey.ProtoReflect())
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.new_cons_pubkey":
+		if x.NewConsPubkey == nil {
+			x.NewConsPubkey = new(anypb.Any)
 		}
-		value := &_DelegationResponse_2_list{list: &x.Balance}
-		return protoreflect.ValueOfList(value)
+		return protoreflect.ValueOfMessage(x.NewConsPubkey.ProtoReflect())
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.completion_time":
+		if x.CompletionTime == nil {
+			x.CompletionTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.CompletionTime.ProtoReflect())
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.fee":
+		if x.Fee == nil {
+			x.Fee = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Fee.ProtoReflect())
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.operator_address":
+		panic(fmt.Errorf("field operator_address of message initia.mstaking.v1.ConsPubKeyRotationHistory is not mutable"))
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.height":
+		panic(fmt.Errorf("field height of message initia.mstaking.v1.ConsPubKeyRotationHistory is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.DelegationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.ConsPubKeyRotationHistory"))
 		}
-		panic(fmt.Errorf("message initia.mstaking.v1.DelegationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.mstaking.v1.ConsPubKeyRotationHistory does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DelegationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ConsPubKeyRotationHistory) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.mstaking.v1.DelegationResponse.delegation":
-		m := new(Delegation)
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.operator_address":
+		return protoreflect.ValueOfString("")
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.old_cons_pubkey":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.new_cons_pubkey":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.completion_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.mstaking.v1.ConsPubKeyRotationHistory.fee":
+		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "initia.mstaking.v1.DelegationResponse.balance":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_DelegationResponse_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.DelegationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.ConsPubKeyRotationHistory"))
 		}
-		panic(fmt.Errorf("message initia.mstaking.v1.DelegationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.mstaking.v1.ConsPubKeyRotationHistory does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DelegationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ConsPubKeyRotationHistory) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in initia.mstaking.v1.DelegationResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in initia.mstaking.v1.ConsPubKeyRotationHistory", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10474,7 +10796,7 @@ func (x *fastReflection_DelegationResponse) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DelegationResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ConsPubKeyRotationHistory) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10485,7 +10807,7 @@ func (x *fastReflection_DelegationResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DelegationResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ConsPubKeyRotationHistory) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10497,7 +10819,7 @@ func (x *fastReflection_DelegationResponse) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DelegationResponse) IsValid() bool {
+func (x *fastReflection_ConsPubKeyRotationHistory) IsValid() bool {
 	return x != nil
 }
 
@@ -10507,9 +10829,9 @@ func (x *fastReflection_DelegationResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DelegationResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ConsPubKeyRotationHistory) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DelegationResponse)
+		x := input.Message.Interface().(*ConsPubKeyRotationHistory)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10521,15 +10843,28 @@ func (x *fastReflection_DelegationResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Delegation != nil {
-			l = options.Size(x.Delegation)
+		l = len(x.OperatorAddress)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Balance) > 0 {
-			for _, e := range x.Balance {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.OldConsPubkey != nil {
+			l = options.Size(x.OldConsPubkey)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NewConsPubkey != nil {
+			l = options.Size(x.NewConsPubkey)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.CompletionTime != nil {
+			l = options.Size(x.CompletionTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Fee != nil {
+			l = options.Size(x.Fee)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -10541,7 +10876,7 @@ func (x *fastReflection_DelegationResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DelegationResponse)
+		x := input.Message.Interface().(*ConsPubKeyRotationHistory)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10560,24 +10895,55 @@ func (x *fastReflection_DelegationResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Balance) > 0 {
-			for iNdEx := len(x.Balance) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Balance[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
+		if x.Fee != nil {
+			encoded, err := options.Marshal(x.Fee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
 		}
-		if x.Delegation != nil {
-			encoded, err := options.Marshal(x.Delegation)
+		if x.CompletionTime != nil {
+			encoded, err := options.Marshal(x.CompletionTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.NewConsPubkey != nil {
+			encoded, err := options.Marshal(x.NewConsPubkey)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.OldConsPubkey != nil {
+			encoded, err := options.Marshal(x.OldConsPubkey)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10588,6 +10954,13 @@ func (x *fastReflection_DelegationResponse) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.OperatorAddress) > 0 {
+			i -= len(x.OperatorAddress)
+			copy(dAtA[i:], x.OperatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OperatorAddress)))
+			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -10601,7 +10974,7 @@ func (x *fastReflection_DelegationResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DelegationResponse)
+		x := input.Message.Interface().(*ConsPubKeyRotationHistory)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10633,15 +11006,47 @@ func (x *fastReflection_DelegationResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ConsPubKeyRotationHistory: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ConsPubKeyRotationHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OperatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldConsPubkey", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10668,16 +11073,16 @@ func (x *fastReflection_DelegationResponse) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Delegation == nil {
-					x.Delegation = &Delegation{}
+				if x.OldConsPubkey == nil {
+					x.OldConsPubkey = &anypb.Any{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Delegation); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OldConsPubkey); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewConsPubkey", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10704,24 +11109,117 @@ func (x *fastReflection_DelegationResponse) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Balance = append(x.Balance, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Balance[len(x.Balance)-1]); err != nil {
+				if x.NewConsPubkey == nil {
+					x.NewConsPubkey = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NewConsPubkey); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 				}
-				if !options.DiscardUnknown {
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CompletionTime == nil {
+					x.CompletionTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CompletionTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Fee == nil {
+					x.Fee = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
 					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
@@ -10744,79 +11242,30 @@ func (x *fastReflection_DelegationResponse) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_RedelegationEntryResponse_2_list)(nil)
-
-type _RedelegationEntryResponse_2_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_RedelegationEntryResponse_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_RedelegationEntryResponse_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_RedelegationEntryResponse_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_RedelegationEntryResponse_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_RedelegationEntryResponse_2_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_RedelegationEntryResponse_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_RedelegationEntryResponse_2_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_RedelegationEntryResponse_2_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_RedelegationEntryResponse                    protoreflect.MessageDescriptor
-	fd_RedelegationEntryResponse_redelegation_entry protoreflect.FieldDescriptor
-	fd_RedelegationEntryResponse_balance            protoreflect.FieldDescriptor
+	md_PendingPowerUpdate                  protoreflect.MessageDescriptor
+	fd_PendingPowerUpdate_operator_address protoreflect.FieldDescriptor
+	fd_PendingPowerUpdate_power            protoreflect.FieldDescriptor
+	fd_PendingPowerUpdate_target_power     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_initia_mstaking_v1_staking_proto_init()
-	md_RedelegationEntryResponse = File_initia_mstaking_v1_staking_proto.Messages().ByName("RedelegationEntryResponse")
-	fd_RedelegationEntryResponse_redelegation_entry = md_RedelegationEntryResponse.Fields().ByName("redelegation_entry")
-	fd_RedelegationEntryResponse_balance = md_RedelegationEntryResponse.Fields().ByName("balance")
+	md_PendingPowerUpdate = File_initia_mstaking_v1_staking_proto.Messages().ByName("PendingPowerUpdate")
+	fd_PendingPowerUpdate_operator_address = md_PendingPowerUpdate.Fields().ByName("operator_address")
+	fd_PendingPowerUpdate_power = md_PendingPowerUpdate.Fields().ByName("power")
+	fd_PendingPowerUpdate_target_power = md_PendingPowerUpdate.Fields().ByName("target_power")
 }
 
-var _ protoreflect.Message = (*fastReflection_RedelegationEntryResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_PendingPowerUpdate)(nil)
 
-type fastReflection_RedelegationEntryResponse RedelegationEntryResponse
+type fastReflection_PendingPowerUpdate PendingPowerUpdate
 
-func (x *RedelegationEntryResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RedelegationEntryResponse)(x)
+func (x *PendingPowerUpdate) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PendingPowerUpdate)(x)
 }
 
-func (x *RedelegationEntryResponse) slowProtoReflect() protoreflect.Message {
+func (x *PendingPowerUpdate) slowProtoReflect() protoreflect.Message {
 	mi := &file_initia_mstaking_v1_staking_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10828,43 +11277,43 @@ func (x *RedelegationEntryResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RedelegationEntryResponse_messageType fastReflection_RedelegationEntryResponse_messageType
-var _ protoreflect.MessageType = fastReflection_RedelegationEntryResponse_messageType{}
+var _fastReflection_PendingPowerUpdate_messageType fastReflection_PendingPowerUpdate_messageType
+var _ protoreflect.MessageType = fastReflection_PendingPowerUpdate_messageType{}
 
-type fastReflection_RedelegationEntryResponse_messageType struct{}
+type fastReflection_PendingPowerUpdate_messageType struct{}
 
-func (x fastReflection_RedelegationEntryResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RedelegationEntryResponse)(nil)
+func (x fastReflection_PendingPowerUpdate_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PendingPowerUpdate)(nil)
 }
-func (x fastReflection_RedelegationEntryResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_RedelegationEntryResponse)
+func (x fastReflection_PendingPowerUpdate_messageType) New() protoreflect.Message {
+	return new(fastReflection_PendingPowerUpdate)
 }
-func (x fastReflection_RedelegationEntryResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RedelegationEntryResponse
+func (x fastReflection_PendingPowerUpdate_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingPowerUpdate
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RedelegationEntryResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_RedelegationEntryResponse
+func (x *fastReflection_PendingPowerUpdate) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingPowerUpdate
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RedelegationEntryResponse) Type() protoreflect.MessageType {
-	return _fastReflection_RedelegationEntryResponse_messageType
+func (x *fastReflection_PendingPowerUpdate) Type() protoreflect.MessageType {
+	return _fastReflection_PendingPowerUpdate_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RedelegationEntryResponse) New() protoreflect.Message {
-	return new(fastReflection_RedelegationEntryResponse)
+func (x *fastReflection_PendingPowerUpdate) New() protoreflect.Message {
+	return new(fastReflection_PendingPowerUpdate)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RedelegationEntryResponse) Interface() protoreflect.ProtoMessage {
-	return (*RedelegationEntryResponse)(x)
+func (x *fastReflection_PendingPowerUpdate) Interface() protoreflect.ProtoMessage {
+	return (*PendingPowerUpdate)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10872,16 +11321,22 @@ func (x *fastReflection_RedelegationEntryResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RedelegationEntryResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.RedelegationEntry != nil {
-		value := protoreflect.ValueOfMessage(x.RedelegationEntry.ProtoReflect())
-		if !f(fd_RedelegationEntryResponse_redelegation_entry, value) {
+func (x *fastReflection_PendingPowerUpdate) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OperatorAddress != "" {
+		value := protoreflect.ValueOfString(x.OperatorAddress)
+		if !f(fd_PendingPowerUpdate_operator_address, value) {
 			return
 		}
 	}
-	if len(x.Balance) != 0 {
-		value := protoreflect.ValueOfList(&_RedelegationEntryResponse_2_list{list: &x.Balance})
-		if !f(fd_RedelegationEntryResponse_balance, value) {
+	if x.Power != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Power)
+		if !f(fd_PendingPowerUpdate_power, value) {
+			return
+		}
+	}
+	if x.TargetPower != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TargetPower)
+		if !f(fd_PendingPowerUpdate_target_power, value) {
 			return
 		}
 	}
@@ -10898,17 +11353,19 @@ func (x *fastReflection_RedelegationEntryResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RedelegationEntryResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PendingPowerUpdate) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "initia.mstaking.v1.RedelegationEntryResponse.redelegation_entry":
-		return x.RedelegationEntry != nil
-	case "initia.mstaking.v1.RedelegationEntryResponse.balance":
-		return len(x.Balance) != 0
+	case "initia.mstaking.v1.PendingPowerUpdate.operator_address":
+		return x.OperatorAddress != ""
+	case "initia.mstaking.v1.PendingPowerUpdate.power":
+		return x.Power != int64(0)
+	case "initia.mstaking.v1.PendingPowerUpdate.target_power":
+		return x.TargetPower != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationEntryResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.PendingPowerUpdate"))
 		}
-		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationEntryResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.mstaking.v1.PendingPowerUpdate does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10918,17 +11375,19 @@ func (x *fastReflection_RedelegationEntryResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RedelegationEntryResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PendingPowerUpdate) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "initia.mstaking.v1.RedelegationEntryResponse.redelegation_entry":
-		x.RedelegationEntry = nil
-	case "initia.mstaking.v1.RedelegationEntryResponse.balance":
-		x.Balance = nil
+	case "initia.mstaking.v1.PendingPowerUpdate.operator_address":
+		x.OperatorAddress = ""
+	case "initia.mstaking.v1.PendingPowerUpdate.power":
+		x.Power = int64(0)
+	case "initia.mstaking.v1.PendingPowerUpdate.target_power":
+		x.TargetPower = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationEntryResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.PendingPowerUpdate"))
 		}
-		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationEntryResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.mstaking.v1.PendingPowerUpdate does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10938,22 +11397,22 @@ func (x *fastReflection_RedelegationEntryResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RedelegationEntryResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PendingPowerUpdate) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "initia.mstaking.v1.RedelegationEntryResponse.redelegation_entry":
-		value := x.RedelegationEntry
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "initia.mstaking.v1.RedelegationEntryResponse.balance":
-		if len(x.Balance) == 0 {
-			return protoreflect.ValueOfList(&_RedelegationEntryResponse_2_list{})
-		}
-		listValue := &_RedelegationEntryResponse_2_list{list: &x.Balance}
-		return protoreflect.ValueOfList(listValue)
+	case "initia.mstaking.v1.PendingPowerUpdate.operator_address":
+		value := x.OperatorAddress
+		return protoreflect.ValueOfString(value)
+	case "initia.mstaking.v1.PendingPowerUpdate.power":
+		value := x.Power
+		return protoreflect.ValueOfInt64(value)
+	case "initia.mstaking.v1.PendingPowerUpdate.target_power":
+		value := x.TargetPower
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationEntryResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.PendingPowerUpdate"))
 		}
-		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationEntryResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message initia.mstaking.v1.PendingPowerUpdate does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10967,19 +11426,19 @@ func (x *fastReflection_RedelegationEntryResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RedelegationEntryResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PendingPowerUpdate) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "initia.mstaking.v1.RedelegationEntryResponse.redelegation_entry":
-		x.RedelegationEntry = value.Message().Interface().(*RedelegationEntry)
-	case "initia.mstaking.v1.RedelegationEntryResponse.balance":
-		lv := value.List()
-		clv := lv.(*_RedelegationEntryResponse_2_list)
-		x.Balance = *clv.list
+	case "initia.mstaking.v1.PendingPowerUpdate.operator_address":
+		x.OperatorAddress = value.Interface().(string)
+	case "initia.mstaking.v1.PendingPowerUpdate.power":
+		x.Power = value.Int()
+	case "initia.mstaking.v1.PendingPowerUpdate.target_power":
+		x.TargetPower = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationEntryResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.PendingPowerUpdate"))
 		}
-		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationEntryResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.mstaking.v1.PendingPowerUpdate does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10993,53 +11452,48 @@ func (x *fastReflection_RedelegationEntryResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RedelegationEntryResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PendingPowerUpdate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.mstaking.v1.RedelegationEntryResponse.redelegation_entry":
-		if x.RedelegationEntry == nil {
-			x.RedelegationEntry = new(RedelegationEntry)
-		}
-		return protoreflect.ValueOfMessage(x.RedelegationEntry.ProtoReflect())
-	case "initia.mstaking.v1.RedelegationEntryResponse.balance":
-		if x.Balance == nil {
-			x.Balance = []*v1beta1.Coin{}
-		}
-		value := &_RedelegationEntryResponse_2_list{list: &x.Balance}
-		return protoreflect.ValueOfList(value)
+	case "initia.mstaking.v1.PendingPowerUpdate.operator_address":
+		panic(fmt.Errorf("field operator_address of message initia.mstaking.v1.PendingPowerUpdate is not mutable"))
+	case "initia.mstaking.v1.PendingPowerUpdate.power":
+		panic(fmt.Errorf("field power of message initia.mstaking.v1.PendingPowerUpdate is not mutable"))
+	case "initia.mstaking.v1.PendingPowerUpdate.target_power":
+		panic(fmt.Errorf("field target_power of message initia.mstaking.v1.PendingPowerUpdate is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationEntryResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.PendingPowerUpdate"))
 		}
-		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationEntryResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.mstaking.v1.PendingPowerUpdate does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RedelegationEntryResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PendingPowerUpdate) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.mstaking.v1.RedelegationEntryResponse.redelegation_entry":
-		m := new(RedelegationEntry)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "initia.mstaking.v1.RedelegationEntryResponse.balance":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_RedelegationEntryResponse_2_list{list: &list})
+	case "initia.mstaking.v1.PendingPowerUpdate.operator_address":
+		return protoreflect.ValueOfString("")
+	case "initia.mstaking.v1.PendingPowerUpdate.power":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "initia.mstaking.v1.PendingPowerUpdate.target_power":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationEntryResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.PendingPowerUpdate"))
 		}
-		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationEntryResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.mstaking.v1.PendingPowerUpdate does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RedelegationEntryResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PendingPowerUpdate) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in initia.mstaking.v1.RedelegationEntryResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in initia.mstaking.v1.PendingPowerUpdate", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11047,7 +11501,7 @@ func (x *fastReflection_RedelegationEntryResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RedelegationEntryResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PendingPowerUpdate) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11058,7 +11512,7 @@ func (x *fastReflection_RedelegationEntryResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RedelegationEntryResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PendingPowerUpdate) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11070,7 +11524,7 @@ func (x *fastReflection_RedelegationEntryResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RedelegationEntryResponse) IsValid() bool {
+func (x *fastReflection_PendingPowerUpdate) IsValid() bool {
 	return x != nil
 }
 
@@ -11080,9 +11534,9 @@ func (x *fastReflection_RedelegationEntryResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RedelegationEntryResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PendingPowerUpdate) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RedelegationEntryResponse)
+		x := input.Message.Interface().(*PendingPowerUpdate)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11094,15 +11548,15 @@ func (x *fastReflection_RedelegationEntryResponse) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		if x.RedelegationEntry != nil {
-			l = options.Size(x.RedelegationEntry)
+		l = len(x.OperatorAddress)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Balance) > 0 {
-			for _, e := range x.Balance {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Power != 0 {
+			n += 1 + runtime.Sov(uint64(x.Power))
+		}
+		if x.TargetPower != 0 {
+			n += 1 + runtime.Sov(uint64(x.TargetPower))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -11114,7 +11568,7 @@ func (x *fastReflection_RedelegationEntryResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RedelegationEntryResponse)
+		x := input.Message.Interface().(*PendingPowerUpdate)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11133,33 +11587,20 @@ func (x *fastReflection_RedelegationEntryResponse) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Balance) > 0 {
-			for iNdEx := len(x.Balance) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Balance[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
+		if x.TargetPower != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetPower))
+			i--
+			dAtA[i] = 0x18
 		}
-		if x.RedelegationEntry != nil {
-			encoded, err := options.Marshal(x.RedelegationEntry)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.Power != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Power))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.OperatorAddress) > 0 {
+			i -= len(x.OperatorAddress)
+			copy(dAtA[i:], x.OperatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OperatorAddress)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -11174,7 +11615,7 @@ func (x *fastReflection_RedelegationEntryResponse) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RedelegationEntryResponse)
+		x := input.Message.Interface().(*PendingPowerUpdate)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11206,17 +11647,17 @@ func (x *fastReflection_RedelegationEntryResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingPowerUpdate: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingPowerUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11226,33 +11667,29 @@ func (x *fastReflection_RedelegationEntryResponse) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.RedelegationEntry == nil {
-					x.RedelegationEntry = &RedelegationEntry{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RedelegationEntry); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.OperatorAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 				}
-				var msglen int
+				x.Power = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11262,26 +11699,30 @@ func (x *fastReflection_RedelegationEntryResponse) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Power |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetPower", wireType)
 				}
-				x.Balance = append(x.Balance, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Balance[len(x.Balance)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.TargetPower = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TargetPower |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -11317,79 +11758,134 @@ func (x *fastReflection_RedelegationEntryResponse) ProtoMethods() *protoiface.Me
 	}
 }
 
-var _ protoreflect.List = (*_RedelegationResponse_2_list)(nil)
+var _ protoreflect.List = (*_ValidatorPower_2_list)(nil)
 
-type _RedelegationResponse_2_list struct {
-	list *[]*RedelegationEntryResponse
+type _ValidatorPower_2_list struct {
+	list *[]*v1beta1.Coin
 }
 
-func (x *_RedelegationResponse_2_list) Len() int {
+func (x *_ValidatorPower_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_RedelegationResponse_2_list) Get(i int) protoreflect.Value {
+func (x *_ValidatorPower_2_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_RedelegationResponse_2_list) Set(i int, value protoreflect.Value) {
+func (x *_ValidatorPower_2_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*RedelegationEntryResponse)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_RedelegationResponse_2_list) Append(value protoreflect.Value) {
+func (x *_ValidatorPower_2_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*RedelegationEntryResponse)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_RedelegationResponse_2_list) AppendMutable() protoreflect.Value {
-	v := new(RedelegationEntryResponse)
+func (x *_ValidatorPower_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_RedelegationResponse_2_list) Truncate(n int) {
+func (x *_ValidatorPower_2_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_RedelegationResponse_2_list) NewElement() protoreflect.Value {
-	v := new(RedelegationEntryResponse)
+func (x *_ValidatorPower_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_RedelegationResponse_2_list) IsValid() bool {
+func (x *_ValidatorPower_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ValidatorPower_3_list)(nil)
+
+type _ValidatorPower_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_ValidatorPower_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ValidatorPower_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ValidatorPower_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ValidatorPower_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ValidatorPower_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorPower_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ValidatorPower_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorPower_3_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_RedelegationResponse              protoreflect.MessageDescriptor
-	fd_RedelegationResponse_redelegation protoreflect.FieldDescriptor
-	fd_RedelegationResponse_entries      protoreflect.FieldDescriptor
+	md_ValidatorPower                  protoreflect.MessageDescriptor
+	fd_ValidatorPower_operator_address protoreflect.FieldDescriptor
+	fd_ValidatorPower_tokens           protoreflect.FieldDescriptor
+	fd_ValidatorPower_voting_powers    protoreflect.FieldDescriptor
+	fd_ValidatorPower_voting_power     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_initia_mstaking_v1_staking_proto_init()
-	md_RedelegationResponse = File_initia_mstaking_v1_staking_proto.Messages().ByName("RedelegationResponse")
-	fd_RedelegationResponse_redelegation = md_RedelegationResponse.Fields().ByName("redelegation")
-	fd_RedelegationResponse_entries = md_RedelegationResponse.Fields().ByName("entries")
+	md_ValidatorPower = File_initia_mstaking_v1_staking_proto.Messages().ByName("ValidatorPower")
+	fd_ValidatorPower_operator_address = md_ValidatorPower.Fields().ByName("operator_address")
+	fd_ValidatorPower_tokens = md_ValidatorPower.Fields().ByName("tokens")
+	fd_ValidatorPower_voting_powers = md_ValidatorPower.Fields().ByName("voting_powers")
+	fd_ValidatorPower_voting_power = md_ValidatorPower.Fields().ByName("voting_power")
 }
 
-var _ protoreflect.Message = (*fastReflection_RedelegationResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorPower)(nil)
 
-type fastReflection_RedelegationResponse RedelegationResponse
+type fastReflection_ValidatorPower ValidatorPower
 
-func (x *RedelegationResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RedelegationResponse)(x)
+func (x *ValidatorPower) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorPower)(x)
 }
 
-func (x *RedelegationResponse) slowProtoReflect() protoreflect.Message {
+func (x *ValidatorPower) slowProtoReflect() protoreflect.Message {
 	mi := &file_initia_mstaking_v1_staking_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -11401,43 +11897,43 @@ func (x *RedelegationResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RedelegationResponse_messageType fastReflection_RedelegationResponse_messageType
-var _ protoreflect.MessageType = fastReflection_RedelegationResponse_messageType{}
+var _fastReflection_ValidatorPower_messageType fastReflection_ValidatorPower_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorPower_messageType{}
 
-type fastReflection_RedelegationResponse_messageType struct{}
+type fastReflection_ValidatorPower_messageType struct{}
 
-func (x fastReflection_RedelegationResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RedelegationResponse)(nil)
+func (x fastReflection_ValidatorPower_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorPower)(nil)
 }
-func (x fastReflection_RedelegationResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_RedelegationResponse)
+func (x fastReflection_ValidatorPower_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorPower)
 }
-func (x fastReflection_RedelegationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RedelegationResponse
+func (x fastReflection_ValidatorPower_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorPower
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RedelegationResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_RedelegationResponse
+func (x *fastReflection_ValidatorPower) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorPower
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RedelegationResponse) Type() protoreflect.MessageType {
-	return _fastReflection_RedelegationResponse_messageType
+func (x *fastReflection_ValidatorPower) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorPower_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RedelegationResponse) New() protoreflect.Message {
-	return new(fastReflection_RedelegationResponse)
+func (x *fastReflection_ValidatorPower) New() protoreflect.Message {
+	return new(fastReflection_ValidatorPower)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RedelegationResponse) Interface() protoreflect.ProtoMessage {
-	return (*RedelegationResponse)(x)
+func (x *fastReflection_ValidatorPower) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorPower)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -11445,16 +11941,28 @@ func (x *fastReflection_RedelegationResponse) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RedelegationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Redelegation != nil {
-		value := protoreflect.ValueOfMessage(x.Redelegation.ProtoReflect())
-		if !f(fd_RedelegationResponse_redelegation, value) {
+func (x *fastReflection_ValidatorPower) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OperatorAddress != "" {
+		value := protoreflect.ValueOfString(x.OperatorAddress)
+		if !f(fd_ValidatorPower_operator_address, value) {
 			return
 		}
 	}
-	if len(x.Entries) != 0 {
-		value := protoreflect.ValueOfList(&_RedelegationResponse_2_list{list: &x.Entries})
-		if !f(fd_RedelegationResponse_entries, value) {
+	if len(x.Tokens) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorPower_2_list{list: &x.Tokens})
+		if !f(fd_ValidatorPower_tokens, value) {
+			return
+		}
+	}
+	if len(x.VotingPowers) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorPower_3_list{list: &x.VotingPowers})
+		if !f(fd_ValidatorPower_voting_powers, value) {
+			return
+		}
+	}
+	if x.VotingPower != "" {
+		value := protoreflect.ValueOfString(x.VotingPower)
+		if !f(fd_ValidatorPower_voting_power, value) {
 			return
 		}
 	}
@@ -11471,17 +11979,21 @@ func (x *fastReflection_RedelegationResponse) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RedelegationResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorPower) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "initia.mstaking.v1.RedelegationResponse.redelegation":
-		return x.Redelegation != nil
-	case "initia.mstaking.v1.RedelegationResponse.entries":
-		return len(x.Entries) != 0
+	case "initia.mstaking.v1.ValidatorPower.operator_address":
+		return x.OperatorAddress != ""
+	case "initia.mstaking.v1.ValidatorPower.tokens":
+		return len(x.Tokens) != 0
+	case "initia.mstaking.v1.ValidatorPower.voting_powers":
+		return len(x.VotingPowers) != 0
+	case "initia.mstaking.v1.ValidatorPower.voting_power":
+		return x.VotingPower != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.ValidatorPower"))
 		}
-		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.mstaking.v1.ValidatorPower does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11491,17 +12003,21 @@ func (x *fastReflection_RedelegationResponse) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RedelegationResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorPower) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "initia.mstaking.v1.RedelegationResponse.redelegation":
-		x.Redelegation = nil
-	case "initia.mstaking.v1.RedelegationResponse.entries":
-		x.Entries = nil
+	case "initia.mstaking.v1.ValidatorPower.operator_address":
+		x.OperatorAddress = ""
+	case "initia.mstaking.v1.ValidatorPower.tokens":
+		x.Tokens = nil
+	case "initia.mstaking.v1.ValidatorPower.voting_powers":
+		x.VotingPowers = nil
+	case "initia.mstaking.v1.ValidatorPower.voting_power":
+		x.VotingPower = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.ValidatorPower"))
 		}
-		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.mstaking.v1.ValidatorPower does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11511,22 +12027,31 @@ func (x *fastReflection_RedelegationResponse) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RedelegationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorPower) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "initia.mstaking.v1.RedelegationResponse.redelegation":
-		value := x.Redelegation
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "initia.mstaking.v1.RedelegationResponse.entries":
-		if len(x.Entries) == 0 {
-			return protoreflect.ValueOfList(&_RedelegationResponse_2_list{})
+	case "initia.mstaking.v1.ValidatorPower.operator_address":
+		value := x.OperatorAddress
+		return protoreflect.ValueOfString(value)
+	case "initia.mstaking.v1.ValidatorPower.tokens":
+		if len(x.Tokens) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorPower_2_list{})
 		}
-		listValue := &_RedelegationResponse_2_list{list: &x.Entries}
+		listValue := &_ValidatorPower_2_list{list: &x.Tokens}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.mstaking.v1.ValidatorPower.voting_powers":
+		if len(x.VotingPowers) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorPower_3_list{})
+		}
+		listValue := &_ValidatorPower_3_list{list: &x.VotingPowers}
 		return protoreflect.ValueOfList(listValue)
+	case "initia.mstaking.v1.ValidatorPower.voting_power":
+		value := x.VotingPower
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.ValidatorPower"))
 		}
-		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message initia.mstaking.v1.ValidatorPower does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -11540,19 +12065,25 @@ func (x *fastReflection_RedelegationResponse) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RedelegationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorPower) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "initia.mstaking.v1.RedelegationResponse.redelegation":
-		x.Redelegation = value.Message().Interface().(*Redelegation)
-	case "initia.mstaking.v1.RedelegationResponse.entries":
+	case "initia.mstaking.v1.ValidatorPower.operator_address":
+		x.OperatorAddress = value.Interface().(string)
+	case "initia.mstaking.v1.ValidatorPower.tokens":
 		lv := value.List()
-		clv := lv.(*_RedelegationResponse_2_list)
-		x.Entries = *clv.list
+		clv := lv.(*_ValidatorPower_2_list)
+		x.Tokens = *clv.list
+	case "initia.mstaking.v1.ValidatorPower.voting_powers":
+		lv := value.List()
+		clv := lv.(*_ValidatorPower_3_list)
+		x.VotingPowers = *clv.list
+	case "initia.mstaking.v1.ValidatorPower.voting_power":
+		x.VotingPower = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.ValidatorPower"))
 		}
-		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.mstaking.v1.ValidatorPower does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11566,53 +12097,62 @@ func (x *fastReflection_RedelegationResponse) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RedelegationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorPower) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.mstaking.v1.RedelegationResponse.redelegation":
-		if x.Redelegation == nil {
-			x.Redelegation = new(Redelegation)
+	case "initia.mstaking.v1.ValidatorPower.tokens":
+		if x.Tokens == nil {
+			x.Tokens = []*v1beta1.Coin{}
 		}
-		return protoreflect.ValueOfMessage(x.Redelegation.ProtoReflect())
-	case "initia.mstaking.v1.RedelegationResponse.entries":
-		if x.Entries == nil {
-			x.Entries = []*RedelegationEntryResponse{}
+		value := &_ValidatorPower_2_list{list: &x.Tokens}
+		return protoreflect.ValueOfList(value)
+	case "initia.mstaking.v1.ValidatorPower.voting_powers":
+		if x.VotingPowers == nil {
+			x.VotingPowers = []*v1beta1.Coin{}
 		}
-		value := &_RedelegationResponse_2_list{list: &x.Entries}
+		value := &_ValidatorPower_3_list{list: &x.VotingPowers}
 		return protoreflect.ValueOfList(value)
+	case "initia.mstaking.v1.ValidatorPower.operator_address":
+		panic(fmt.Errorf("field operator_address of message initia.mstaking.v1.ValidatorPower is not mutable"))
+	case "initia.mstaking.v1.ValidatorPower.voting_power":
+		panic(fmt.Errorf("field voting_power of message initia.mstaking.v1.ValidatorPower is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.ValidatorPower"))
 		}
-		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.mstaking.v1.ValidatorPower does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RedelegationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorPower) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.mstaking.v1.RedelegationResponse.redelegation":
-		m := new(Redelegation)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "initia.mstaking.v1.RedelegationResponse.entries":
-		list := []*RedelegationEntryResponse{}
-		return protoreflect.ValueOfList(&_RedelegationResponse_2_list{list: &list})
+	case "initia.mstaking.v1.ValidatorPower.operator_address":
+		return protoreflect.ValueOfString("")
+	case "initia.mstaking.v1.ValidatorPower.tokens":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_ValidatorPower_2_list{list: &list})
+	case "initia.mstaking.v1.ValidatorPower.voting_powers":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_ValidatorPower_3_list{list: &list})
+	case "initia.mstaking.v1.ValidatorPower.voting_power":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.ValidatorPower"))
 		}
-		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.mstaking.v1.ValidatorPower does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RedelegationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorPower) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in initia.mstaking.v1.RedelegationResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in initia.mstaking.v1.ValidatorPower", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11620,7 +12160,7 @@ func (x *fastReflection_RedelegationResponse) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RedelegationResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorPower) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11631,7 +12171,7 @@ func (x *fastReflection_RedelegationResponse) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RedelegationResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorPower) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11643,7 +12183,7 @@ func (x *fastReflection_RedelegationResponse) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RedelegationResponse) IsValid() bool {
+func (x *fastReflection_ValidatorPower) IsValid() bool {
 	return x != nil
 }
 
@@ -11653,9 +12193,9 @@ func (x *fastReflection_RedelegationResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RedelegationResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorPower) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RedelegationResponse)
+		x := input.Message.Interface().(*ValidatorPower)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11667,16 +12207,26 @@ func (x *fastReflection_RedelegationResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Redelegation != nil {
-			l = options.Size(x.Redelegation)
+		l = len(x.OperatorAddress)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Entries) > 0 {
-			for _, e := range x.Entries {
+		if len(x.Tokens) > 0 {
+			for _, e := range x.Tokens {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VotingPowers) > 0 {
+			for _, e := range x.VotingPowers {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		l = len(x.VotingPower)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -11687,7 +12237,7 @@ func (x *fastReflection_RedelegationResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RedelegationResponse)
+		x := input.Message.Interface().(*ValidatorPower)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11706,9 +12256,16 @@ func (x *fastReflection_RedelegationResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Entries) > 0 {
-			for iNdEx := len(x.Entries) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Entries[iNdEx])
+		if len(x.VotingPower) > 0 {
+			i -= len(x.VotingPower)
+			copy(dAtA[i:], x.VotingPower)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VotingPower)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.VotingPowers) > 0 {
+			for iNdEx := len(x.VotingPowers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VotingPowers[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11719,20 +12276,29 @@ func (x *fastReflection_RedelegationResponse) ProtoMethods() *protoiface.Methods
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x12
+				dAtA[i] = 0x1a
 			}
 		}
-		if x.Redelegation != nil {
-			encoded, err := options.Marshal(x.Redelegation)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.Tokens) > 0 {
+			for iNdEx := len(x.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Tokens[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		}
+		if len(x.OperatorAddress) > 0 {
+			i -= len(x.OperatorAddress)
+			copy(dAtA[i:], x.OperatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OperatorAddress)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -11747,7 +12313,7 @@ func (x *fastReflection_RedelegationResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RedelegationResponse)
+		x := input.Message.Interface().(*ValidatorPower)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11779,15 +12345,47 @@ func (x *fastReflection_RedelegationResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPower: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPower: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OperatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -11814,16 +12412,14 @@ func (x *fastReflection_RedelegationResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Redelegation == nil {
-					x.Redelegation = &Redelegation{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Redelegation); err != nil {
+				x.Tokens = append(x.Tokens, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tokens[len(x.Tokens)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPowers", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -11850,11 +12446,43 @@ func (x *fastReflection_RedelegationResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Entries = append(x.Entries, &RedelegationEntryResponse{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entries[len(x.Entries)-1]); err != nil {
+				x.VotingPowers = append(x.VotingPowers, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPowers[len(x.VotingPowers)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VotingPower = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -11890,231 +12518,180 @@ func (x *fastReflection_RedelegationResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var _ protoreflect.List = (*_Pool_1_list)(nil)
+var _ protoreflect.List = (*_PowerSnapshot_2_list)(nil)
 
-type _Pool_1_list struct {
-	list *[]*v1beta1.Coin
+type _PowerSnapshot_2_list struct {
+	list *[]*v1beta1.DecCoin
 }
 
-func (x *_Pool_1_list) Len() int {
+func (x *_PowerSnapshot_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Pool_1_list) Get(i int) protoreflect.Value {
+func (x *_PowerSnapshot_2_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_Pool_1_list) Set(i int, value protoreflect.Value) {
+func (x *_PowerSnapshot_2_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Pool_1_list) Append(value protoreflect.Value) {
+func (x *_PowerSnapshot_2_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Pool_1_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
+func (x *_PowerSnapshot_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Pool_1_list) Truncate(n int) {
+func (x *_PowerSnapshot_2_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Pool_1_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
+func (x *_PowerSnapshot_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Pool_1_list) IsValid() bool {
+func (x *_PowerSnapshot_2_list) IsValid() bool {
 	return x.list != nil
 }
 
-var _ protoreflect.List = (*_Pool_2_list)(nil)
+var _ protoreflect.List = (*_PowerSnapshot_3_list)(nil)
 
-type _Pool_2_list struct {
-	list *[]*v1beta1.Coin
+type _PowerSnapshot_3_list struct {
+	list *[]*ValidatorPower
 }
 
-func (x *_Pool_2_list) Len() int {
+func (x *_PowerSnapshot_3_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Pool_2_list) Get(i int) protoreflect.Value {
+func (x *_PowerSnapshot_3_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_Pool_2_list) Set(i int, value protoreflect.Value) {
+func (x *_PowerSnapshot_3_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	concreteValue := valueUnwrapped.Interface().(*ValidatorPower)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Pool_2_list) Append(value protoreflect.Value) {
+func (x *_PowerSnapshot_3_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	concreteValue := valueUnwrapped.Interface().(*ValidatorPower)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Pool_2_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
+func (x *_PowerSnapshot_3_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorPower)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Pool_2_list) Truncate(n int) {
+func (x *_PowerSnapshot_3_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Pool_2_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
+func (x *_PowerSnapshot_3_list) NewElement() protoreflect.Value {
+	v := new(ValidatorPower)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Pool_2_list) IsValid() bool {
+func (x *_PowerSnapshot_3_list) IsValid() bool {
 	return x.list != nil
 }
 
-var _ protoreflect.List = (*_Pool_3_list)(nil)
+var (
+	md_PowerSnapshot                      protoreflect.MessageDescriptor
+	fd_PowerSnapshot_time                 protoreflect.FieldDescriptor
+	fd_PowerSnapshot_voting_power_weights protoreflect.FieldDescriptor
+	fd_PowerSnapshot_validators           protoreflect.FieldDescriptor
+)
 
-type _Pool_3_list struct {
-	list *[]*v1beta1.DecCoin
+func init() {
+	file_initia_mstaking_v1_staking_proto_init()
+	md_PowerSnapshot = File_initia_mstaking_v1_staking_proto.Messages().ByName("PowerSnapshot")
+	fd_PowerSnapshot_time = md_PowerSnapshot.Fields().ByName("time")
+	fd_PowerSnapshot_voting_power_weights = md_PowerSnapshot.Fields().ByName("voting_power_weights")
+	fd_PowerSnapshot_validators = md_PowerSnapshot.Fields().ByName("validators")
 }
 
-func (x *_Pool_3_list) Len() int {
-	if x.list == nil {
-		return 0
+var _ protoreflect.Message = (*fastReflection_PowerSnapshot)(nil)
+
+type fastReflection_PowerSnapshot PowerSnapshot
+
+func (x *PowerSnapshot) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PowerSnapshot)(x)
+}
+
+func (x *PowerSnapshot) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_mstaking_v1_staking_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return len(*x.list)
+	return mi.MessageOf(x)
 }
 
-func (x *_Pool_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+var _fastReflection_PowerSnapshot_messageType fastReflection_PowerSnapshot_messageType
+var _ protoreflect.MessageType = fastReflection_PowerSnapshot_messageType{}
+
+type fastReflection_PowerSnapshot_messageType struct{}
+
+func (x fastReflection_PowerSnapshot_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PowerSnapshot)(nil)
+}
+func (x fastReflection_PowerSnapshot_messageType) New() protoreflect.Message {
+	return new(fastReflection_PowerSnapshot)
+}
+func (x fastReflection_PowerSnapshot_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PowerSnapshot
 }
 
-func (x *_Pool_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
-	(*x.list)[i] = concreteValue
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PowerSnapshot) Descriptor() protoreflect.MessageDescriptor {
+	return md_PowerSnapshot
 }
 
-func (x *_Pool_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
-	*x.list = append(*x.list, concreteValue)
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PowerSnapshot) Type() protoreflect.MessageType {
+	return _fastReflection_PowerSnapshot_messageType
 }
 
-func (x *_Pool_3_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.DecCoin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Pool_3_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Pool_3_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.DecCoin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Pool_3_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_Pool                      protoreflect.MessageDescriptor
-	fd_Pool_not_bonded_tokens    protoreflect.FieldDescriptor
-	fd_Pool_bonded_tokens        protoreflect.FieldDescriptor
-	fd_Pool_voting_power_weights protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_initia_mstaking_v1_staking_proto_init()
-	md_Pool = File_initia_mstaking_v1_staking_proto.Messages().ByName("Pool")
-	fd_Pool_not_bonded_tokens = md_Pool.Fields().ByName("not_bonded_tokens")
-	fd_Pool_bonded_tokens = md_Pool.Fields().ByName("bonded_tokens")
-	fd_Pool_voting_power_weights = md_Pool.Fields().ByName("voting_power_weights")
-}
-
-var _ protoreflect.Message = (*fastReflection_Pool)(nil)
-
-type fastReflection_Pool Pool
-
-func (x *Pool) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Pool)(x)
-}
-
-func (x *Pool) slowProtoReflect() protoreflect.Message {
-	mi := &file_initia_mstaking_v1_staking_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_Pool_messageType fastReflection_Pool_messageType
-var _ protoreflect.MessageType = fastReflection_Pool_messageType{}
-
-type fastReflection_Pool_messageType struct{}
-
-func (x fastReflection_Pool_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Pool)(nil)
-}
-func (x fastReflection_Pool_messageType) New() protoreflect.Message {
-	return new(fastReflection_Pool)
-}
-func (x fastReflection_Pool_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Pool
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_Pool) Descriptor() protoreflect.MessageDescriptor {
-	return md_Pool
-}
-
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Pool) Type() protoreflect.MessageType {
-	return _fastReflection_Pool_messageType
-}
-
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Pool) New() protoreflect.Message {
-	return new(fastReflection_Pool)
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PowerSnapshot) New() protoreflect.Message {
+	return new(fastReflection_PowerSnapshot)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Pool) Interface() protoreflect.ProtoMessage {
-	return (*Pool)(x)
+func (x *fastReflection_PowerSnapshot) Interface() protoreflect.ProtoMessage {
+	return (*PowerSnapshot)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -12122,22 +12699,22 @@ func (x *fastReflection_Pool) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Pool) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.NotBondedTokens) != 0 {
-		value := protoreflect.ValueOfList(&_Pool_1_list{list: &x.NotBondedTokens})
-		if !f(fd_Pool_not_bonded_tokens, value) {
+func (x *fastReflection_PowerSnapshot) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Time != nil {
+		value := protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+		if !f(fd_PowerSnapshot_time, value) {
 			return
 		}
 	}
-	if len(x.BondedTokens) != 0 {
-		value := protoreflect.ValueOfList(&_Pool_2_list{list: &x.BondedTokens})
-		if !f(fd_Pool_bonded_tokens, value) {
+	if len(x.VotingPowerWeights) != 0 {
+		value := protoreflect.ValueOfList(&_PowerSnapshot_2_list{list: &x.VotingPowerWeights})
+		if !f(fd_PowerSnapshot_voting_power_weights, value) {
 			return
 		}
 	}
-	if len(x.VotingPowerWeights) != 0 {
-		value := protoreflect.ValueOfList(&_Pool_3_list{list: &x.VotingPowerWeights})
-		if !f(fd_Pool_voting_power_weights, value) {
+	if len(x.Validators) != 0 {
+		value := protoreflect.ValueOfList(&_PowerSnapshot_3_list{list: &x.Validators})
+		if !f(fd_PowerSnapshot_validators, value) {
 			return
 		}
 	}
@@ -12154,19 +12731,19 @@ func (x *fastReflection_Pool) Range(f func(protoreflect.FieldDescriptor, protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Pool) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PowerSnapshot) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "initia.mstaking.v1.Pool.not_bonded_tokens":
-		return len(x.NotBondedTokens) != 0
-	case "initia.mstaking.v1.Pool.bonded_tokens":
-		return len(x.BondedTokens) != 0
-	case "initia.mstaking.v1.Pool.voting_power_weights":
+	case "initia.mstaking.v1.PowerSnapshot.time":
+		return x.Time != nil
+	case "initia.mstaking.v1.PowerSnapshot.voting_power_weights":
 		return len(x.VotingPowerWeights) != 0
+	case "initia.mstaking.v1.PowerSnapshot.validators":
+		return len(x.Validators) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.Pool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.PowerSnapshot"))
 		}
-		panic(fmt.Errorf("message initia.mstaking.v1.Pool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.mstaking.v1.PowerSnapshot does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12176,19 +12753,19 @@ func (x *fastReflection_Pool) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Pool) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PowerSnapshot) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "initia.mstaking.v1.Pool.not_bonded_tokens":
-		x.NotBondedTokens = nil
-	case "initia.mstaking.v1.Pool.bonded_tokens":
-		x.BondedTokens = nil
-	case "initia.mstaking.v1.Pool.voting_power_weights":
+	case "initia.mstaking.v1.PowerSnapshot.time":
+		x.Time = nil
+	case "initia.mstaking.v1.PowerSnapshot.voting_power_weights":
 		x.VotingPowerWeights = nil
+	case "initia.mstaking.v1.PowerSnapshot.validators":
+		x.Validators = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.Pool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.PowerSnapshot"))
 		}
-		panic(fmt.Errorf("message initia.mstaking.v1.Pool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.mstaking.v1.PowerSnapshot does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12198,31 +12775,28 @@ func (x *fastReflection_Pool) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Pool) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PowerSnapshot) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "initia.mstaking.v1.Pool.not_bonded_tokens":
-		if len(x.NotBondedTokens) == 0 {
-			return protoreflect.ValueOfList(&_Pool_1_list{})
-		}
-		listValue := &_Pool_1_list{list: &x.NotBondedTokens}
-		return protoreflect.ValueOfList(listValue)
-	case "initia.mstaking.v1.Pool.bonded_tokens":
-		if len(x.BondedTokens) == 0 {
-			return protoreflect.ValueOfList(&_Pool_2_list{})
+	case "initia.mstaking.v1.PowerSnapshot.time":
+		value := x.Time
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.mstaking.v1.PowerSnapshot.voting_power_weights":
+		if len(x.VotingPowerWeights) == 0 {
+			return protoreflect.ValueOfList(&_PowerSnapshot_2_list{})
 		}
-		listValue := &_Pool_2_list{list: &x.BondedTokens}
+		listValue := &_PowerSnapshot_2_list{list: &x.VotingPowerWeights}
 		return protoreflect.ValueOfList(listValue)
-	case "initia.mstaking.v1.Pool.voting_power_weights":
-		if len(x.VotingPowerWeights) == 0 {
-			return protoreflect.ValueOfList(&_Pool_3_list{})
+	case "initia.mstaking.v1.PowerSnapshot.validators":
+		if len(x.Validators) == 0 {
+			return protoreflect.ValueOfList(&_PowerSnapshot_3_list{})
 		}
-		listValue := &_Pool_3_list{list: &x.VotingPowerWeights}
+		listValue := &_PowerSnapshot_3_list{list: &x.Validators}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.Pool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.PowerSnapshot"))
 		}
-		panic(fmt.Errorf("message initia.mstaking.v1.Pool does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message initia.mstaking.v1.PowerSnapshot does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -12236,25 +12810,23 @@ func (x *fastReflection_Pool) Get(descriptor protoreflect.FieldDescriptor) proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Pool) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PowerSnapshot) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "initia.mstaking.v1.Pool.not_bonded_tokens":
-		lv := value.List()
-		clv := lv.(*_Pool_1_list)
-		x.NotBondedTokens = *clv.list
-	case "initia.mstaking.v1.Pool.bonded_tokens":
-		lv := value.List()
-		clv := lv.(*_Pool_2_list)
-		x.BondedTokens = *clv.list
-	case "initia.mstaking.v1.Pool.voting_power_weights":
+	case "initia.mstaking.v1.PowerSnapshot.time":
+		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
+	case "initia.mstaking.v1.PowerSnapshot.voting_power_weights":
 		lv := value.List()
-		clv := lv.(*_Pool_3_list)
+		clv := lv.(*_PowerSnapshot_2_list)
 		x.VotingPowerWeights = *clv.list
+	case "initia.mstaking.v1.PowerSnapshot.validators":
+		lv := value.List()
+		clv := lv.(*_PowerSnapshot_3_list)
+		x.Validators = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.Pool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.PowerSnapshot"))
 		}
-		panic(fmt.Errorf("message initia.mstaking.v1.Pool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.mstaking.v1.PowerSnapshot does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12268,63 +12840,62 @@ func (x *fastReflection_Pool) Set(fd protoreflect.FieldDescriptor, value protore
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Pool) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PowerSnapshot) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.mstaking.v1.Pool.not_bonded_tokens":
-		if x.NotBondedTokens == nil {
-			x.NotBondedTokens = []*v1beta1.Coin{}
-		}
-		value := &_Pool_1_list{list: &x.NotBondedTokens}
-		return protoreflect.ValueOfList(value)
-	case "initia.mstaking.v1.Pool.bonded_tokens":
-		if x.BondedTokens == nil {
-			x.BondedTokens = []*v1beta1.Coin{}
+	case "initia.mstaking.v1.PowerSnapshot.time":
+		if x.Time == nil {
+			x.Time = new(timestamppb.Timestamp)
 		}
-		value := &_Pool_2_list{list: &x.BondedTokens}
-		return protoreflect.ValueOfList(value)
-	case "initia.mstaking.v1.Pool.voting_power_weights":
+		return protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+	case "initia.mstaking.v1.PowerSnapshot.voting_power_weights":
 		if x.VotingPowerWeights == nil {
 			x.VotingPowerWeights = []*v1beta1.DecCoin{}
 		}
-		value := &_Pool_3_list{list: &x.VotingPowerWeights}
+		value := &_PowerSnapshot_2_list{list: &x.VotingPowerWeights}
+		return protoreflect.ValueOfList(value)
+	case "initia.mstaking.v1.PowerSnapshot.validators":
+		if x.Validators == nil {
+			x.Validators = []*ValidatorPower{}
+		}
+		value := &_PowerSnapshot_3_list{list: &x.Validators}
 		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.Pool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.PowerSnapshot"))
 		}
-		panic(fmt.Errorf("message initia.mstaking.v1.Pool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.mstaking.v1.PowerSnapshot does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Pool) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PowerSnapshot) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.mstaking.v1.Pool.not_bonded_tokens":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Pool_1_list{list: &list})
-	case "initia.mstaking.v1.Pool.bonded_tokens":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Pool_2_list{list: &list})
-	case "initia.mstaking.v1.Pool.voting_power_weights":
+	case "initia.mstaking.v1.PowerSnapshot.time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.mstaking.v1.PowerSnapshot.voting_power_weights":
 		list := []*v1beta1.DecCoin{}
-		return protoreflect.ValueOfList(&_Pool_3_list{list: &list})
+		return protoreflect.ValueOfList(&_PowerSnapshot_2_list{list: &list})
+	case "initia.mstaking.v1.PowerSnapshot.validators":
+		list := []*ValidatorPower{}
+		return protoreflect.ValueOfList(&_PowerSnapshot_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.Pool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.PowerSnapshot"))
 		}
-		panic(fmt.Errorf("message initia.mstaking.v1.Pool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.mstaking.v1.PowerSnapshot does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Pool) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PowerSnapshot) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in initia.mstaking.v1.Pool", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in initia.mstaking.v1.PowerSnapshot", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -12332,7 +12903,7 @@ func (x *fastReflection_Pool) WhichOneof(d protoreflect.OneofDescriptor) protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Pool) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PowerSnapshot) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -12343,7 +12914,7 @@ func (x *fastReflection_Pool) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Pool) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PowerSnapshot) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -12355,7 +12926,7 @@ func (x *fastReflection_Pool) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Pool) IsValid() bool {
+func (x *fastReflection_PowerSnapshot) IsValid() bool {
 	return x != nil
 }
 
@@ -12365,9 +12936,9 @@ func (x *fastReflection_Pool) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PowerSnapshot) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Pool)
+		x := input.Message.Interface().(*PowerSnapshot)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12379,20 +12950,18 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.NotBondedTokens) > 0 {
-			for _, e := range x.NotBondedTokens {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Time != nil {
+			l = options.Size(x.Time)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.BondedTokens) > 0 {
-			for _, e := range x.BondedTokens {
+		if len(x.VotingPowerWeights) > 0 {
+			for _, e := range x.VotingPowerWeights {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if len(x.VotingPowerWeights) > 0 {
-			for _, e := range x.VotingPowerWeights {
+		if len(x.Validators) > 0 {
+			for _, e := range x.Validators {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -12407,7 +12976,7 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Pool)
+		x := input.Message.Interface().(*PowerSnapshot)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12426,9 +12995,9 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.VotingPowerWeights) > 0 {
-			for iNdEx := len(x.VotingPowerWeights) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.VotingPowerWeights[iNdEx])
+		if len(x.Validators) > 0 {
+			for iNdEx := len(x.Validators) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Validators[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12442,9 +13011,9 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 				dAtA[i] = 0x1a
 			}
 		}
-		if len(x.BondedTokens) > 0 {
-			for iNdEx := len(x.BondedTokens) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.BondedTokens[iNdEx])
+		if len(x.VotingPowerWeights) > 0 {
+			for iNdEx := len(x.VotingPowerWeights) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VotingPowerWeights[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12458,21 +13027,19 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 				dAtA[i] = 0x12
 			}
 		}
-		if len(x.NotBondedTokens) > 0 {
-			for iNdEx := len(x.NotBondedTokens) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.NotBondedTokens[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
+		if x.Time != nil {
+			encoded, err := options.Marshal(x.Time)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -12485,7 +13052,7 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Pool)
+		x := input.Message.Interface().(*PowerSnapshot)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12517,15 +13084,15 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Pool: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PowerSnapshot: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PowerSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -12552,14 +13119,16 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.NotBondedTokens = append(x.NotBondedTokens, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NotBondedTokens[len(x.NotBondedTokens)-1]); err != nil {
+				if x.Time == nil {
+					x.Time = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Time); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPowerWeights", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -12586,14 +13155,14 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BondedTokens = append(x.BondedTokens, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BondedTokens[len(x.BondedTokens)-1]); err != nil {
+				x.VotingPowerWeights = append(x.VotingPowerWeights, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPowerWeights[len(x.VotingPowerWeights)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPowerWeights", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -12620,8 +13189,8 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.VotingPowerWeights = append(x.VotingPowerWeights, &v1beta1.DecCoin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPowerWeights[len(x.VotingPowerWeights)-1]); err != nil {
+				x.Validators = append(x.Validators, &ValidatorPower{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Validators[len(x.Validators)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -12660,954 +13229,7702 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: initia/mstaking/v1/staking.proto
+var (
+	md_UnbondingQueueEntry                   protoreflect.MessageDescriptor
+	fd_UnbondingQueueEntry_delegator_address protoreflect.FieldDescriptor
+	fd_UnbondingQueueEntry_validator_address protoreflect.FieldDescriptor
+	fd_UnbondingQueueEntry_entry             protoreflect.FieldDescriptor
+)
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
+func init() {
+	file_initia_mstaking_v1_staking_proto_init()
+	md_UnbondingQueueEntry = File_initia_mstaking_v1_staking_proto.Messages().ByName("UnbondingQueueEntry")
+	fd_UnbondingQueueEntry_delegator_address = md_UnbondingQueueEntry.Fields().ByName("delegator_address")
+	fd_UnbondingQueueEntry_validator_address = md_UnbondingQueueEntry.Fields().ByName("validator_address")
+	fd_UnbondingQueueEntry_entry = md_UnbondingQueueEntry.Fields().ByName("entry")
+}
+
+var _ protoreflect.Message = (*fastReflection_UnbondingQueueEntry)(nil)
+
+type fastReflection_UnbondingQueueEntry UnbondingQueueEntry
+
+func (x *UnbondingQueueEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_UnbondingQueueEntry)(x)
+}
+
+func (x *UnbondingQueueEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_mstaking_v1_staking_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_UnbondingQueueEntry_messageType fastReflection_UnbondingQueueEntry_messageType
+var _ protoreflect.MessageType = fastReflection_UnbondingQueueEntry_messageType{}
+
+type fastReflection_UnbondingQueueEntry_messageType struct{}
+
+func (x fastReflection_UnbondingQueueEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_UnbondingQueueEntry)(nil)
+}
+func (x fastReflection_UnbondingQueueEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_UnbondingQueueEntry)
+}
+func (x fastReflection_UnbondingQueueEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_UnbondingQueueEntry
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_UnbondingQueueEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_UnbondingQueueEntry
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_UnbondingQueueEntry) Type() protoreflect.MessageType {
+	return _fastReflection_UnbondingQueueEntry_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_UnbondingQueueEntry) New() protoreflect.Message {
+	return new(fastReflection_UnbondingQueueEntry)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_UnbondingQueueEntry) Interface() protoreflect.ProtoMessage {
+	return (*UnbondingQueueEntry)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_UnbondingQueueEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DelegatorAddress != "" {
+		value := protoreflect.ValueOfString(x.DelegatorAddress)
+		if !f(fd_UnbondingQueueEntry_delegator_address, value) {
+			return
+		}
+	}
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_UnbondingQueueEntry_validator_address, value) {
+			return
+		}
+	}
+	if x.Entry != nil {
+		value := protoreflect.ValueOfMessage(x.Entry.ProtoReflect())
+		if !f(fd_UnbondingQueueEntry_entry, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_UnbondingQueueEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.UnbondingQueueEntry.delegator_address":
+		return x.DelegatorAddress != ""
+	case "initia.mstaking.v1.UnbondingQueueEntry.validator_address":
+		return x.ValidatorAddress != ""
+	case "initia.mstaking.v1.UnbondingQueueEntry.entry":
+		return x.Entry != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.UnbondingQueueEntry"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.UnbondingQueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnbondingQueueEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.UnbondingQueueEntry.delegator_address":
+		x.DelegatorAddress = ""
+	case "initia.mstaking.v1.UnbondingQueueEntry.validator_address":
+		x.ValidatorAddress = ""
+	case "initia.mstaking.v1.UnbondingQueueEntry.entry":
+		x.Entry = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.UnbondingQueueEntry"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.UnbondingQueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_UnbondingQueueEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.mstaking.v1.UnbondingQueueEntry.delegator_address":
+		value := x.DelegatorAddress
+		return protoreflect.ValueOfString(value)
+	case "initia.mstaking.v1.UnbondingQueueEntry.validator_address":
+		value := x.ValidatorAddress
+		return protoreflect.ValueOfString(value)
+	case "initia.mstaking.v1.UnbondingQueueEntry.entry":
+		value := x.Entry
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.UnbondingQueueEntry"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.UnbondingQueueEntry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnbondingQueueEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.UnbondingQueueEntry.delegator_address":
+		x.DelegatorAddress = value.Interface().(string)
+	case "initia.mstaking.v1.UnbondingQueueEntry.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	case "initia.mstaking.v1.UnbondingQueueEntry.entry":
+		x.Entry = value.Message().Interface().(*UnbondingDelegationEntry)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.UnbondingQueueEntry"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.UnbondingQueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnbondingQueueEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.UnbondingQueueEntry.entry":
+		if x.Entry == nil {
+			x.Entry = new(UnbondingDelegationEntry)
+		}
+		return protoreflect.ValueOfMessage(x.Entry.ProtoReflect())
+	case "initia.mstaking.v1.UnbondingQueueEntry.delegator_address":
+		panic(fmt.Errorf("field delegator_address of message initia.mstaking.v1.UnbondingQueueEntry is not mutable"))
+	case "initia.mstaking.v1.UnbondingQueueEntry.validator_address":
+		panic(fmt.Errorf("field validator_address of message initia.mstaking.v1.UnbondingQueueEntry is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.UnbondingQueueEntry"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.UnbondingQueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_UnbondingQueueEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.UnbondingQueueEntry.delegator_address":
+		return protoreflect.ValueOfString("")
+	case "initia.mstaking.v1.UnbondingQueueEntry.validator_address":
+		return protoreflect.ValueOfString("")
+	case "initia.mstaking.v1.UnbondingQueueEntry.entry":
+		m := new(UnbondingDelegationEntry)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.UnbondingQueueEntry"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.UnbondingQueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_UnbondingQueueEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.mstaking.v1.UnbondingQueueEntry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_UnbondingQueueEntry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnbondingQueueEntry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_UnbondingQueueEntry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_UnbondingQueueEntry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*UnbondingQueueEntry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.DelegatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ValidatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Entry != nil {
+			l = options.Size(x.Entry)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*UnbondingQueueEntry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Entry != nil {
+			encoded, err := options.Marshal(x.Entry)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.DelegatorAddress) > 0 {
+			i -= len(x.DelegatorAddress)
+			copy(dAtA[i:], x.DelegatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*UnbondingQueueEntry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnbondingQueueEntry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnbondingQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Entry == nil {
+					x.Entry = &UnbondingDelegationEntry{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entry); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RedelegationQueueEntry                       protoreflect.MessageDescriptor
+	fd_RedelegationQueueEntry_delegator_address     protoreflect.FieldDescriptor
+	fd_RedelegationQueueEntry_validator_src_address protoreflect.FieldDescriptor
+	fd_RedelegationQueueEntry_validator_dst_address protoreflect.FieldDescriptor
+	fd_RedelegationQueueEntry_entry                 protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_mstaking_v1_staking_proto_init()
+	md_RedelegationQueueEntry = File_initia_mstaking_v1_staking_proto.Messages().ByName("RedelegationQueueEntry")
+	fd_RedelegationQueueEntry_delegator_address = md_RedelegationQueueEntry.Fields().ByName("delegator_address")
+	fd_RedelegationQueueEntry_validator_src_address = md_RedelegationQueueEntry.Fields().ByName("validator_src_address")
+	fd_RedelegationQueueEntry_validator_dst_address = md_RedelegationQueueEntry.Fields().ByName("validator_dst_address")
+	fd_RedelegationQueueEntry_entry = md_RedelegationQueueEntry.Fields().ByName("entry")
+}
+
+var _ protoreflect.Message = (*fastReflection_RedelegationQueueEntry)(nil)
+
+type fastReflection_RedelegationQueueEntry RedelegationQueueEntry
+
+func (x *RedelegationQueueEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RedelegationQueueEntry)(x)
+}
+
+func (x *RedelegationQueueEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_mstaking_v1_staking_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RedelegationQueueEntry_messageType fastReflection_RedelegationQueueEntry_messageType
+var _ protoreflect.MessageType = fastReflection_RedelegationQueueEntry_messageType{}
+
+type fastReflection_RedelegationQueueEntry_messageType struct{}
+
+func (x fastReflection_RedelegationQueueEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RedelegationQueueEntry)(nil)
+}
+func (x fastReflection_RedelegationQueueEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_RedelegationQueueEntry)
+}
+func (x fastReflection_RedelegationQueueEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RedelegationQueueEntry
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RedelegationQueueEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_RedelegationQueueEntry
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RedelegationQueueEntry) Type() protoreflect.MessageType {
+	return _fastReflection_RedelegationQueueEntry_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RedelegationQueueEntry) New() protoreflect.Message {
+	return new(fastReflection_RedelegationQueueEntry)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RedelegationQueueEntry) Interface() protoreflect.ProtoMessage {
+	return (*RedelegationQueueEntry)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RedelegationQueueEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DelegatorAddress != "" {
+		value := protoreflect.ValueOfString(x.DelegatorAddress)
+		if !f(fd_RedelegationQueueEntry_delegator_address, value) {
+			return
+		}
+	}
+	if x.ValidatorSrcAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorSrcAddress)
+		if !f(fd_RedelegationQueueEntry_validator_src_address, value) {
+			return
+		}
+	}
+	if x.ValidatorDstAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorDstAddress)
+		if !f(fd_RedelegationQueueEntry_validator_dst_address, value) {
+			return
+		}
+	}
+	if x.Entry != nil {
+		value := protoreflect.ValueOfMessage(x.Entry.ProtoReflect())
+		if !f(fd_RedelegationQueueEntry_entry, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RedelegationQueueEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.RedelegationQueueEntry.delegator_address":
+		return x.DelegatorAddress != ""
+	case "initia.mstaking.v1.RedelegationQueueEntry.validator_src_address":
+		return x.ValidatorSrcAddress != ""
+	case "initia.mstaking.v1.RedelegationQueueEntry.validator_dst_address":
+		return x.ValidatorDstAddress != ""
+	case "initia.mstaking.v1.RedelegationQueueEntry.entry":
+		return x.Entry != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationQueueEntry"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationQueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RedelegationQueueEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.RedelegationQueueEntry.delegator_address":
+		x.DelegatorAddress = ""
+	case "initia.mstaking.v1.RedelegationQueueEntry.validator_src_address":
+		x.ValidatorSrcAddress = ""
+	case "initia.mstaking.v1.RedelegationQueueEntry.validator_dst_address":
+		x.ValidatorDstAddress = ""
+	case "initia.mstaking.v1.RedelegationQueueEntry.entry":
+		x.Entry = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationQueueEntry"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationQueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RedelegationQueueEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.mstaking.v1.RedelegationQueueEntry.delegator_address":
+		value := x.DelegatorAddress
+		return protoreflect.ValueOfString(value)
+	case "initia.mstaking.v1.RedelegationQueueEntry.validator_src_address":
+		value := x.ValidatorSrcAddress
+		return protoreflect.ValueOfString(value)
+	case "initia.mstaking.v1.RedelegationQueueEntry.validator_dst_address":
+		value := x.ValidatorDstAddress
+		return protoreflect.ValueOfString(value)
+	case "initia.mstaking.v1.RedelegationQueueEntry.entry":
+		value := x.Entry
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationQueueEntry"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationQueueEntry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RedelegationQueueEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.RedelegationQueueEntry.delegator_address":
+		x.DelegatorAddress = value.Interface().(string)
+	case "initia.mstaking.v1.RedelegationQueueEntry.validator_src_address":
+		x.ValidatorSrcAddress = value.Interface().(string)
+	case "initia.mstaking.v1.RedelegationQueueEntry.validator_dst_address":
+		x.ValidatorDstAddress = value.Interface().(string)
+	case "initia.mstaking.v1.RedelegationQueueEntry.entry":
+		x.Entry = value.Message().Interface().(*RedelegationEntry)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationQueueEntry"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationQueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RedelegationQueueEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.RedelegationQueueEntry.entry":
+		if x.Entry == nil {
+			x.Entry = new(RedelegationEntry)
+		}
+		return protoreflect.ValueOfMessage(x.Entry.ProtoReflect())
+	case "initia.mstaking.v1.RedelegationQueueEntry.delegator_address":
+		panic(fmt.Errorf("field delegator_address of message initia.mstaking.v1.RedelegationQueueEntry is not mutable"))
+	case "initia.mstaking.v1.RedelegationQueueEntry.validator_src_address":
+		panic(fmt.Errorf("field validator_src_address of message initia.mstaking.v1.RedelegationQueueEntry is not mutable"))
+	case "initia.mstaking.v1.RedelegationQueueEntry.validator_dst_address":
+		panic(fmt.Errorf("field validator_dst_address of message initia.mstaking.v1.RedelegationQueueEntry is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationQueueEntry"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationQueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RedelegationQueueEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.RedelegationQueueEntry.delegator_address":
+		return protoreflect.ValueOfString("")
+	case "initia.mstaking.v1.RedelegationQueueEntry.validator_src_address":
+		return protoreflect.ValueOfString("")
+	case "initia.mstaking.v1.RedelegationQueueEntry.validator_dst_address":
+		return protoreflect.ValueOfString("")
+	case "initia.mstaking.v1.RedelegationQueueEntry.entry":
+		m := new(RedelegationEntry)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationQueueEntry"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationQueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RedelegationQueueEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.mstaking.v1.RedelegationQueueEntry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RedelegationQueueEntry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RedelegationQueueEntry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RedelegationQueueEntry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RedelegationQueueEntry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RedelegationQueueEntry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.DelegatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ValidatorSrcAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ValidatorDstAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Entry != nil {
+			l = options.Size(x.Entry)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RedelegationQueueEntry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Entry != nil {
+			encoded, err := options.Marshal(x.Entry)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ValidatorDstAddress) > 0 {
+			i -= len(x.ValidatorDstAddress)
+			copy(dAtA[i:], x.ValidatorDstAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorDstAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ValidatorSrcAddress) > 0 {
+			i -= len(x.ValidatorSrcAddress)
+			copy(dAtA[i:], x.ValidatorSrcAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorSrcAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.DelegatorAddress) > 0 {
+			i -= len(x.DelegatorAddress)
+			copy(dAtA[i:], x.DelegatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RedelegationQueueEntry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RedelegationQueueEntry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RedelegationQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Entry == nil {
+					x.Entry = &RedelegationEntry{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entry); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_UnbondingMaturity_2_list)(nil)
+
+type _UnbondingMaturity_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_UnbondingMaturity_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_UnbondingMaturity_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_UnbondingMaturity_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_UnbondingMaturity_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_UnbondingMaturity_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_UnbondingMaturity_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_UnbondingMaturity_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_UnbondingMaturity_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_UnbondingMaturity                 protoreflect.MessageDescriptor
+	fd_UnbondingMaturity_completion_time protoreflect.FieldDescriptor
+	fd_UnbondingMaturity_amount          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_mstaking_v1_staking_proto_init()
+	md_UnbondingMaturity = File_initia_mstaking_v1_staking_proto.Messages().ByName("UnbondingMaturity")
+	fd_UnbondingMaturity_completion_time = md_UnbondingMaturity.Fields().ByName("completion_time")
+	fd_UnbondingMaturity_amount = md_UnbondingMaturity.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_UnbondingMaturity)(nil)
+
+type fastReflection_UnbondingMaturity UnbondingMaturity
+
+func (x *UnbondingMaturity) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_UnbondingMaturity)(x)
+}
+
+func (x *UnbondingMaturity) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_mstaking_v1_staking_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_UnbondingMaturity_messageType fastReflection_UnbondingMaturity_messageType
+var _ protoreflect.MessageType = fastReflection_UnbondingMaturity_messageType{}
+
+type fastReflection_UnbondingMaturity_messageType struct{}
+
+func (x fastReflection_UnbondingMaturity_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_UnbondingMaturity)(nil)
+}
+func (x fastReflection_UnbondingMaturity_messageType) New() protoreflect.Message {
+	return new(fastReflection_UnbondingMaturity)
+}
+func (x fastReflection_UnbondingMaturity_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_UnbondingMaturity
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_UnbondingMaturity) Descriptor() protoreflect.MessageDescriptor {
+	return md_UnbondingMaturity
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_UnbondingMaturity) Type() protoreflect.MessageType {
+	return _fastReflection_UnbondingMaturity_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_UnbondingMaturity) New() protoreflect.Message {
+	return new(fastReflection_UnbondingMaturity)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_UnbondingMaturity) Interface() protoreflect.ProtoMessage {
+	return (*UnbondingMaturity)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_UnbondingMaturity) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CompletionTime != nil {
+		value := protoreflect.ValueOfMessage(x.CompletionTime.ProtoReflect())
+		if !f(fd_UnbondingMaturity_completion_time, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_UnbondingMaturity_2_list{list: &x.Amount})
+		if !f(fd_UnbondingMaturity_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_UnbondingMaturity) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.UnbondingMaturity.completion_time":
+		return x.CompletionTime != nil
+	case "initia.mstaking.v1.UnbondingMaturity.amount":
+		return len(x.Amount) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.UnbondingMaturity"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.UnbondingMaturity does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnbondingMaturity) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.UnbondingMaturity.completion_time":
+		x.CompletionTime = nil
+	case "initia.mstaking.v1.UnbondingMaturity.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.UnbondingMaturity"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.UnbondingMaturity does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_UnbondingMaturity) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.mstaking.v1.UnbondingMaturity.completion_time":
+		value := x.CompletionTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.mstaking.v1.UnbondingMaturity.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_UnbondingMaturity_2_list{})
+		}
+		listValue := &_UnbondingMaturity_2_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.UnbondingMaturity"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.UnbondingMaturity does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnbondingMaturity) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.UnbondingMaturity.completion_time":
+		x.CompletionTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "initia.mstaking.v1.UnbondingMaturity.amount":
+		lv := value.List()
+		clv := lv.(*_UnbondingMaturity_2_list)
+		x.Amount = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.UnbondingMaturity"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.UnbondingMaturity does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnbondingMaturity) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.UnbondingMaturity.completion_time":
+		if x.CompletionTime == nil {
+			x.CompletionTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.CompletionTime.ProtoReflect())
+	case "initia.mstaking.v1.UnbondingMaturity.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_UnbondingMaturity_2_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.UnbondingMaturity"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.UnbondingMaturity does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_UnbondingMaturity) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.UnbondingMaturity.completion_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.mstaking.v1.UnbondingMaturity.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_UnbondingMaturity_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.UnbondingMaturity"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.UnbondingMaturity does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_UnbondingMaturity) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.mstaking.v1.UnbondingMaturity", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_UnbondingMaturity) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnbondingMaturity) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_UnbondingMaturity) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_UnbondingMaturity) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*UnbondingMaturity)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.CompletionTime != nil {
+			l = options.Size(x.CompletionTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*UnbondingMaturity)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.CompletionTime != nil {
+			encoded, err := options.Marshal(x.CompletionTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*UnbondingMaturity)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnbondingMaturity: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnbondingMaturity: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CompletionTime == nil {
+					x.CompletionTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CompletionTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DelegationResponse_2_list)(nil)
+
+type _DelegationResponse_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_DelegationResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DelegationResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DelegationResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DelegationResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DelegationResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DelegationResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DelegationResponse_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DelegationResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DelegationResponse            protoreflect.MessageDescriptor
+	fd_DelegationResponse_delegation protoreflect.FieldDescriptor
+	fd_DelegationResponse_balance    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_mstaking_v1_staking_proto_init()
+	md_DelegationResponse = File_initia_mstaking_v1_staking_proto.Messages().ByName("DelegationResponse")
+	fd_DelegationResponse_delegation = md_DelegationResponse.Fields().ByName("delegation")
+	fd_DelegationResponse_balance = md_DelegationResponse.Fields().ByName("balance")
+}
+
+var _ protoreflect.Message = (*fastReflection_DelegationResponse)(nil)
+
+type fastReflection_DelegationResponse DelegationResponse
+
+func (x *DelegationResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DelegationResponse)(x)
+}
+
+func (x *DelegationResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_mstaking_v1_staking_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DelegationResponse_messageType fastReflection_DelegationResponse_messageType
+var _ protoreflect.MessageType = fastReflection_DelegationResponse_messageType{}
+
+type fastReflection_DelegationResponse_messageType struct{}
+
+func (x fastReflection_DelegationResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DelegationResponse)(nil)
+}
+func (x fastReflection_DelegationResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_DelegationResponse)
+}
+func (x fastReflection_DelegationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DelegationResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DelegationResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_DelegationResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DelegationResponse) Type() protoreflect.MessageType {
+	return _fastReflection_DelegationResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DelegationResponse) New() protoreflect.Message {
+	return new(fastReflection_DelegationResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DelegationResponse) Interface() protoreflect.ProtoMessage {
+	return (*DelegationResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DelegationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Delegation != nil {
+		value := protoreflect.ValueOfMessage(x.Delegation.ProtoReflect())
+		if !f(fd_DelegationResponse_delegation, value) {
+			return
+		}
+	}
+	if len(x.Balance) != 0 {
+		value := protoreflect.ValueOfList(&_DelegationResponse_2_list{list: &x.Balance})
+		if !f(fd_DelegationResponse_balance, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DelegationResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.DelegationResponse.delegation":
+		return x.Delegation != nil
+	case "initia.mstaking.v1.DelegationResponse.balance":
+		return len(x.Balance) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.DelegationResponse"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.DelegationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegationResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.DelegationResponse.delegation":
+		x.Delegation = nil
+	case "initia.mstaking.v1.DelegationResponse.balance":
+		x.Balance = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.DelegationResponse"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.DelegationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DelegationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.mstaking.v1.DelegationResponse.delegation":
+		value := x.Delegation
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.mstaking.v1.DelegationResponse.balance":
+		if len(x.Balance) == 0 {
+			return protoreflect.ValueOfList(&_DelegationResponse_2_list{})
+		}
+		listValue := &_DelegationResponse_2_list{list: &x.Balance}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.DelegationResponse"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.DelegationResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.DelegationResponse.delegation":
+		x.Delegation = value.Message().Interface().(*Delegation)
+	case "initia.mstaking.v1.DelegationResponse.balance":
+		lv := value.List()
+		clv := lv.(*_DelegationResponse_2_list)
+		x.Balance = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.DelegationResponse"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.DelegationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.DelegationResponse.delegation":
+		if x.Delegation == nil {
+			x.Delegation = new(Delegation)
+		}
+		return protoreflect.ValueOfMessage(x.Delegation.ProtoReflect())
+	case "initia.mstaking.v1.DelegationResponse.balance":
+		if x.Balance == nil {
+			x.Balance = []*v1beta1.Coin{}
+		}
+		value := &_DelegationResponse_2_list{list: &x.Balance}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.DelegationResponse"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.DelegationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DelegationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.DelegationResponse.delegation":
+		m := new(Delegation)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.mstaking.v1.DelegationResponse.balance":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_DelegationResponse_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.DelegationResponse"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.DelegationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DelegationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.mstaking.v1.DelegationResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DelegationResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegationResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DelegationResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DelegationResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DelegationResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Delegation != nil {
+			l = options.Size(x.Delegation)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Balance) > 0 {
+			for _, e := range x.Balance {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DelegationResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Balance) > 0 {
+			for iNdEx := len(x.Balance) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Balance[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Delegation != nil {
+			encoded, err := options.Marshal(x.Delegation)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DelegationResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Delegation == nil {
+					x.Delegation = &Delegation{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Delegation); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Balance = append(x.Balance, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Balance[len(x.Balance)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_RedelegationEntryResponse_2_list)(nil)
+
+type _RedelegationEntryResponse_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_RedelegationEntryResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RedelegationEntryResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_RedelegationEntryResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RedelegationEntryResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RedelegationEntryResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RedelegationEntryResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RedelegationEntryResponse_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RedelegationEntryResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_RedelegationEntryResponse                    protoreflect.MessageDescriptor
+	fd_RedelegationEntryResponse_redelegation_entry protoreflect.FieldDescriptor
+	fd_RedelegationEntryResponse_balance            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_mstaking_v1_staking_proto_init()
+	md_RedelegationEntryResponse = File_initia_mstaking_v1_staking_proto.Messages().ByName("RedelegationEntryResponse")
+	fd_RedelegationEntryResponse_redelegation_entry = md_RedelegationEntryResponse.Fields().ByName("redelegation_entry")
+	fd_RedelegationEntryResponse_balance = md_RedelegationEntryResponse.Fields().ByName("balance")
+}
+
+var _ protoreflect.Message = (*fastReflection_RedelegationEntryResponse)(nil)
+
+type fastReflection_RedelegationEntryResponse RedelegationEntryResponse
+
+func (x *RedelegationEntryResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RedelegationEntryResponse)(x)
+}
+
+func (x *RedelegationEntryResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_mstaking_v1_staking_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RedelegationEntryResponse_messageType fastReflection_RedelegationEntryResponse_messageType
+var _ protoreflect.MessageType = fastReflection_RedelegationEntryResponse_messageType{}
+
+type fastReflection_RedelegationEntryResponse_messageType struct{}
+
+func (x fastReflection_RedelegationEntryResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RedelegationEntryResponse)(nil)
+}
+func (x fastReflection_RedelegationEntryResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_RedelegationEntryResponse)
+}
+func (x fastReflection_RedelegationEntryResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RedelegationEntryResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RedelegationEntryResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_RedelegationEntryResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RedelegationEntryResponse) Type() protoreflect.MessageType {
+	return _fastReflection_RedelegationEntryResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RedelegationEntryResponse) New() protoreflect.Message {
+	return new(fastReflection_RedelegationEntryResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RedelegationEntryResponse) Interface() protoreflect.ProtoMessage {
+	return (*RedelegationEntryResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RedelegationEntryResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RedelegationEntry != nil {
+		value := protoreflect.ValueOfMessage(x.RedelegationEntry.ProtoReflect())
+		if !f(fd_RedelegationEntryResponse_redelegation_entry, value) {
+			return
+		}
+	}
+	if len(x.Balance) != 0 {
+		value := protoreflect.ValueOfList(&_RedelegationEntryResponse_2_list{list: &x.Balance})
+		if !f(fd_RedelegationEntryResponse_balance, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RedelegationEntryResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.RedelegationEntryResponse.redelegation_entry":
+		return x.RedelegationEntry != nil
+	case "initia.mstaking.v1.RedelegationEntryResponse.balance":
+		return len(x.Balance) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationEntryResponse"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationEntryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RedelegationEntryResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.RedelegationEntryResponse.redelegation_entry":
+		x.RedelegationEntry = nil
+	case "initia.mstaking.v1.RedelegationEntryResponse.balance":
+		x.Balance = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationEntryResponse"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationEntryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RedelegationEntryResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.mstaking.v1.RedelegationEntryResponse.redelegation_entry":
+		value := x.RedelegationEntry
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.mstaking.v1.RedelegationEntryResponse.balance":
+		if len(x.Balance) == 0 {
+			return protoreflect.ValueOfList(&_RedelegationEntryResponse_2_list{})
+		}
+		listValue := &_RedelegationEntryResponse_2_list{list: &x.Balance}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationEntryResponse"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationEntryResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RedelegationEntryResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.RedelegationEntryResponse.redelegation_entry":
+		x.RedelegationEntry = value.Message().Interface().(*RedelegationEntry)
+	case "initia.mstaking.v1.RedelegationEntryResponse.balance":
+		lv := value.List()
+		clv := lv.(*_RedelegationEntryResponse_2_list)
+		x.Balance = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationEntryResponse"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationEntryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RedelegationEntryResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.RedelegationEntryResponse.redelegation_entry":
+		if x.RedelegationEntry == nil {
+			x.RedelegationEntry = new(RedelegationEntry)
+		}
+		return protoreflect.ValueOfMessage(x.RedelegationEntry.ProtoReflect())
+	case "initia.mstaking.v1.RedelegationEntryResponse.balance":
+		if x.Balance == nil {
+			x.Balance = []*v1beta1.Coin{}
+		}
+		value := &_RedelegationEntryResponse_2_list{list: &x.Balance}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationEntryResponse"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationEntryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RedelegationEntryResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.RedelegationEntryResponse.redelegation_entry":
+		m := new(RedelegationEntry)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.mstaking.v1.RedelegationEntryResponse.balance":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_RedelegationEntryResponse_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationEntryResponse"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationEntryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RedelegationEntryResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.mstaking.v1.RedelegationEntryResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RedelegationEntryResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RedelegationEntryResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RedelegationEntryResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RedelegationEntryResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RedelegationEntryResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.RedelegationEntry != nil {
+			l = options.Size(x.RedelegationEntry)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Balance) > 0 {
+			for _, e := range x.Balance {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RedelegationEntryResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Balance) > 0 {
+			for iNdEx := len(x.Balance) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Balance[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.RedelegationEntry != nil {
+			encoded, err := options.Marshal(x.RedelegationEntry)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RedelegationEntryResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RedelegationEntry == nil {
+					x.RedelegationEntry = &RedelegationEntry{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RedelegationEntry); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Balance = append(x.Balance, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Balance[len(x.Balance)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_RedelegationResponse_2_list)(nil)
+
+type _RedelegationResponse_2_list struct {
+	list *[]*RedelegationEntryResponse
+}
+
+func (x *_RedelegationResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RedelegationResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_RedelegationResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RedelegationEntryResponse)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RedelegationResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RedelegationEntryResponse)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RedelegationResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(RedelegationEntryResponse)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RedelegationResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RedelegationResponse_2_list) NewElement() protoreflect.Value {
+	v := new(RedelegationEntryResponse)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RedelegationResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_RedelegationResponse              protoreflect.MessageDescriptor
+	fd_RedelegationResponse_redelegation protoreflect.FieldDescriptor
+	fd_RedelegationResponse_entries      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_mstaking_v1_staking_proto_init()
+	md_RedelegationResponse = File_initia_mstaking_v1_staking_proto.Messages().ByName("RedelegationResponse")
+	fd_RedelegationResponse_redelegation = md_RedelegationResponse.Fields().ByName("redelegation")
+	fd_RedelegationResponse_entries = md_RedelegationResponse.Fields().ByName("entries")
+}
+
+var _ protoreflect.Message = (*fastReflection_RedelegationResponse)(nil)
+
+type fastReflection_RedelegationResponse RedelegationResponse
+
+func (x *RedelegationResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RedelegationResponse)(x)
+}
+
+func (x *RedelegationResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_mstaking_v1_staking_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RedelegationResponse_messageType fastReflection_RedelegationResponse_messageType
+var _ protoreflect.MessageType = fastReflection_RedelegationResponse_messageType{}
+
+type fastReflection_RedelegationResponse_messageType struct{}
+
+func (x fastReflection_RedelegationResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RedelegationResponse)(nil)
+}
+func (x fastReflection_RedelegationResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_RedelegationResponse)
+}
+func (x fastReflection_RedelegationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RedelegationResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RedelegationResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_RedelegationResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RedelegationResponse) Type() protoreflect.MessageType {
+	return _fastReflection_RedelegationResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RedelegationResponse) New() protoreflect.Message {
+	return new(fastReflection_RedelegationResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RedelegationResponse) Interface() protoreflect.ProtoMessage {
+	return (*RedelegationResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RedelegationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Redelegation != nil {
+		value := protoreflect.ValueOfMessage(x.Redelegation.ProtoReflect())
+		if !f(fd_RedelegationResponse_redelegation, value) {
+			return
+		}
+	}
+	if len(x.Entries) != 0 {
+		value := protoreflect.ValueOfList(&_RedelegationResponse_2_list{list: &x.Entries})
+		if !f(fd_RedelegationResponse_entries, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RedelegationResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.RedelegationResponse.redelegation":
+		return x.Redelegation != nil
+	case "initia.mstaking.v1.RedelegationResponse.entries":
+		return len(x.Entries) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationResponse"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RedelegationResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.RedelegationResponse.redelegation":
+		x.Redelegation = nil
+	case "initia.mstaking.v1.RedelegationResponse.entries":
+		x.Entries = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationResponse"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RedelegationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.mstaking.v1.RedelegationResponse.redelegation":
+		value := x.Redelegation
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.mstaking.v1.RedelegationResponse.entries":
+		if len(x.Entries) == 0 {
+			return protoreflect.ValueOfList(&_RedelegationResponse_2_list{})
+		}
+		listValue := &_RedelegationResponse_2_list{list: &x.Entries}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationResponse"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RedelegationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.RedelegationResponse.redelegation":
+		x.Redelegation = value.Message().Interface().(*Redelegation)
+	case "initia.mstaking.v1.RedelegationResponse.entries":
+		lv := value.List()
+		clv := lv.(*_RedelegationResponse_2_list)
+		x.Entries = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationResponse"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RedelegationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.RedelegationResponse.redelegation":
+		if x.Redelegation == nil {
+			x.Redelegation = new(Redelegation)
+		}
+		return protoreflect.ValueOfMessage(x.Redelegation.ProtoReflect())
+	case "initia.mstaking.v1.RedelegationResponse.entries":
+		if x.Entries == nil {
+			x.Entries = []*RedelegationEntryResponse{}
+		}
+		value := &_RedelegationResponse_2_list{list: &x.Entries}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationResponse"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RedelegationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.RedelegationResponse.redelegation":
+		m := new(Redelegation)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.mstaking.v1.RedelegationResponse.entries":
+		list := []*RedelegationEntryResponse{}
+		return protoreflect.ValueOfList(&_RedelegationResponse_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.RedelegationResponse"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.RedelegationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RedelegationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.mstaking.v1.RedelegationResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RedelegationResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RedelegationResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RedelegationResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RedelegationResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RedelegationResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Redelegation != nil {
+			l = options.Size(x.Redelegation)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Entries) > 0 {
+			for _, e := range x.Entries {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RedelegationResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Entries) > 0 {
+			for iNdEx := len(x.Entries) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Entries[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Redelegation != nil {
+			encoded, err := options.Marshal(x.Redelegation)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RedelegationResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Redelegation == nil {
+					x.Redelegation = &Redelegation{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Redelegation); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Entries = append(x.Entries, &RedelegationEntryResponse{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entries[len(x.Entries)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Pool_1_list)(nil)
+
+type _Pool_1_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Pool_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Pool_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Pool_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Pool_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Pool_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Pool_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Pool_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Pool_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Pool_2_list)(nil)
+
+type _Pool_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Pool_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Pool_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Pool_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Pool_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Pool_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Pool_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Pool_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Pool_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Pool_3_list)(nil)
+
+type _Pool_3_list struct {
+	list *[]*v1beta1.DecCoin
+}
+
+func (x *_Pool_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Pool_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Pool_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Pool_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Pool_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Pool_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Pool_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Pool_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Pool                      protoreflect.MessageDescriptor
+	fd_Pool_not_bonded_tokens    protoreflect.FieldDescriptor
+	fd_Pool_bonded_tokens        protoreflect.FieldDescriptor
+	fd_Pool_voting_power_weights protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_mstaking_v1_staking_proto_init()
+	md_Pool = File_initia_mstaking_v1_staking_proto.Messages().ByName("Pool")
+	fd_Pool_not_bonded_tokens = md_Pool.Fields().ByName("not_bonded_tokens")
+	fd_Pool_bonded_tokens = md_Pool.Fields().ByName("bonded_tokens")
+	fd_Pool_voting_power_weights = md_Pool.Fields().ByName("voting_power_weights")
+}
+
+var _ protoreflect.Message = (*fastReflection_Pool)(nil)
+
+type fastReflection_Pool Pool
+
+func (x *Pool) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Pool)(x)
+}
+
+func (x *Pool) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_mstaking_v1_staking_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Pool_messageType fastReflection_Pool_messageType
+var _ protoreflect.MessageType = fastReflection_Pool_messageType{}
+
+type fastReflection_Pool_messageType struct{}
+
+func (x fastReflection_Pool_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Pool)(nil)
+}
+func (x fastReflection_Pool_messageType) New() protoreflect.Message {
+	return new(fastReflection_Pool)
+}
+func (x fastReflection_Pool_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Pool
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Pool) Descriptor() protoreflect.MessageDescriptor {
+	return md_Pool
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Pool) Type() protoreflect.MessageType {
+	return _fastReflection_Pool_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Pool) New() protoreflect.Message {
+	return new(fastReflection_Pool)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Pool) Interface() protoreflect.ProtoMessage {
+	return (*Pool)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Pool) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.NotBondedTokens) != 0 {
+		value := protoreflect.ValueOfList(&_Pool_1_list{list: &x.NotBondedTokens})
+		if !f(fd_Pool_not_bonded_tokens, value) {
+			return
+		}
+	}
+	if len(x.BondedTokens) != 0 {
+		value := protoreflect.ValueOfList(&_Pool_2_list{list: &x.BondedTokens})
+		if !f(fd_Pool_bonded_tokens, value) {
+			return
+		}
+	}
+	if len(x.VotingPowerWeights) != 0 {
+		value := protoreflect.ValueOfList(&_Pool_3_list{list: &x.VotingPowerWeights})
+		if !f(fd_Pool_voting_power_weights, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Pool) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.Pool.not_bonded_tokens":
+		return len(x.NotBondedTokens) != 0
+	case "initia.mstaking.v1.Pool.bonded_tokens":
+		return len(x.BondedTokens) != 0
+	case "initia.mstaking.v1.Pool.voting_power_weights":
+		return len(x.VotingPowerWeights) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.Pool"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.Pool does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Pool) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.Pool.not_bonded_tokens":
+		x.NotBondedTokens = nil
+	case "initia.mstaking.v1.Pool.bonded_tokens":
+		x.BondedTokens = nil
+	case "initia.mstaking.v1.Pool.voting_power_weights":
+		x.VotingPowerWeights = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.Pool"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.Pool does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Pool) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.mstaking.v1.Pool.not_bonded_tokens":
+		if len(x.NotBondedTokens) == 0 {
+			return protoreflect.ValueOfList(&_Pool_1_list{})
+		}
+		listValue := &_Pool_1_list{list: &x.NotBondedTokens}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.mstaking.v1.Pool.bonded_tokens":
+		if len(x.BondedTokens) == 0 {
+			return protoreflect.ValueOfList(&_Pool_2_list{})
+		}
+		listValue := &_Pool_2_list{list: &x.BondedTokens}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.mstaking.v1.Pool.voting_power_weights":
+		if len(x.VotingPowerWeights) == 0 {
+			return protoreflect.ValueOfList(&_Pool_3_list{})
+		}
+		listValue := &_Pool_3_list{list: &x.VotingPowerWeights}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.Pool"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.Pool does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Pool) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.Pool.not_bonded_tokens":
+		lv := value.List()
+		clv := lv.(*_Pool_1_list)
+		x.NotBondedTokens = *clv.list
+	case "initia.mstaking.v1.Pool.bonded_tokens":
+		lv := value.List()
+		clv := lv.(*_Pool_2_list)
+		x.BondedTokens = *clv.list
+	case "initia.mstaking.v1.Pool.voting_power_weights":
+		lv := value.List()
+		clv := lv.(*_Pool_3_list)
+		x.VotingPowerWeights = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.Pool"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.Pool does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Pool) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.Pool.not_bonded_tokens":
+		if x.NotBondedTokens == nil {
+			x.NotBondedTokens = []*v1beta1.Coin{}
+		}
+		value := &_Pool_1_list{list: &x.NotBondedTokens}
+		return protoreflect.ValueOfList(value)
+	case "initia.mstaking.v1.Pool.bonded_tokens":
+		if x.BondedTokens == nil {
+			x.BondedTokens = []*v1beta1.Coin{}
+		}
+		value := &_Pool_2_list{list: &x.BondedTokens}
+		return protoreflect.ValueOfList(value)
+	case "initia.mstaking.v1.Pool.voting_power_weights":
+		if x.VotingPowerWeights == nil {
+			x.VotingPowerWeights = []*v1beta1.DecCoin{}
+		}
+		value := &_Pool_3_list{list: &x.VotingPowerWeights}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.Pool"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.Pool does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Pool) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.Pool.not_bonded_tokens":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Pool_1_list{list: &list})
+	case "initia.mstaking.v1.Pool.bonded_tokens":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Pool_2_list{list: &list})
+	case "initia.mstaking.v1.Pool.voting_power_weights":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_Pool_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.Pool"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.Pool does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Pool) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.mstaking.v1.Pool", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Pool) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Pool) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Pool) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Pool)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.NotBondedTokens) > 0 {
+			for _, e := range x.NotBondedTokens {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.BondedTokens) > 0 {
+			for _, e := range x.BondedTokens {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VotingPowerWeights) > 0 {
+			for _, e := range x.VotingPowerWeights {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Pool)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.VotingPowerWeights) > 0 {
+			for iNdEx := len(x.VotingPowerWeights) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VotingPowerWeights[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.BondedTokens) > 0 {
+			for iNdEx := len(x.BondedTokens) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BondedTokens[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.NotBondedTokens) > 0 {
+			for iNdEx := len(x.NotBondedTokens) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.NotBondedTokens[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Pool)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NotBondedTokens = append(x.NotBondedTokens, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NotBondedTokens[len(x.NotBondedTokens)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BondedTokens = append(x.BondedTokens, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BondedTokens[len(x.BondedTokens)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPowerWeights", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VotingPowerWeights = append(x.VotingPowerWeights, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPowerWeights[len(x.VotingPowerWeights)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_SlashEvent_7_list)(nil)
+
+type _SlashEvent_7_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_SlashEvent_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SlashEvent_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_SlashEvent_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SlashEvent_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SlashEvent_7_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SlashEvent_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SlashEvent_7_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SlashEvent_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_SlashEvent                   protoreflect.MessageDescriptor
+	fd_SlashEvent_id                protoreflect.FieldDescriptor
+	fd_SlashEvent_validator_address protoreflect.FieldDescriptor
+	fd_SlashEvent_infraction_height protoreflect.FieldDescriptor
+	fd_SlashEvent_height            protoreflect.FieldDescriptor
+	fd_SlashEvent_time              protoreflect.FieldDescriptor
+	fd_SlashEvent_slash_factor      protoreflect.FieldDescriptor
+	fd_SlashEvent_burned            protoreflect.FieldDescriptor
+	fd_SlashEvent_truncated         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_mstaking_v1_staking_proto_init()
+	md_SlashEvent = File_initia_mstaking_v1_staking_proto.Messages().ByName("SlashEvent")
+	fd_SlashEvent_id = md_SlashEvent.Fields().ByName("id")
+	fd_SlashEvent_validator_address = md_SlashEvent.Fields().ByName("validator_address")
+	fd_SlashEvent_infraction_height = md_SlashEvent.Fields().ByName("infraction_height")
+	fd_SlashEvent_height = md_SlashEvent.Fields().ByName("height")
+	fd_SlashEvent_time = md_SlashEvent.Fields().ByName("time")
+	fd_SlashEvent_slash_factor = md_SlashEvent.Fields().ByName("slash_factor")
+	fd_SlashEvent_burned = md_SlashEvent.Fields().ByName("burned")
+	fd_SlashEvent_truncated = md_SlashEvent.Fields().ByName("truncated")
+}
+
+var _ protoreflect.Message = (*fastReflection_SlashEvent)(nil)
+
+type fastReflection_SlashEvent SlashEvent
+
+func (x *SlashEvent) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SlashEvent)(x)
+}
+
+func (x *SlashEvent) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_mstaking_v1_staking_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SlashEvent_messageType fastReflection_SlashEvent_messageType
+var _ protoreflect.MessageType = fastReflection_SlashEvent_messageType{}
+
+type fastReflection_SlashEvent_messageType struct{}
+
+func (x fastReflection_SlashEvent_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SlashEvent)(nil)
+}
+func (x fastReflection_SlashEvent_messageType) New() protoreflect.Message {
+	return new(fastReflection_SlashEvent)
+}
+func (x fastReflection_SlashEvent_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SlashEvent
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SlashEvent) Descriptor() protoreflect.MessageDescriptor {
+	return md_SlashEvent
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SlashEvent) Type() protoreflect.MessageType {
+	return _fastReflection_SlashEvent_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SlashEvent) New() protoreflect.Message {
+	return new(fastReflection_SlashEvent)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SlashEvent) Interface() protoreflect.ProtoMessage {
+	return (*SlashEvent)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SlashEvent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_SlashEvent_id, value) {
+			return
+		}
+	}
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_SlashEvent_validator_address, value) {
+			return
+		}
+	}
+	if x.InfractionHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.InfractionHeight)
+		if !f(fd_SlashEvent_infraction_height, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_SlashEvent_height, value) {
+			return
+		}
+	}
+	if x.Time != nil {
+		value := protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+		if !f(fd_SlashEvent_time, value) {
+			return
+		}
+	}
+	if x.SlashFactor != "" {
+		value := protoreflect.ValueOfString(x.SlashFactor)
+		if !f(fd_SlashEvent_slash_factor, value) {
+			return
+		}
+	}
+	if len(x.Burned) != 0 {
+		value := protoreflect.ValueOfList(&_SlashEvent_7_list{list: &x.Burned})
+		if !f(fd_SlashEvent_burned, value) {
+			return
+		}
+	}
+	if x.Truncated != false {
+		value := protoreflect.ValueOfBool(x.Truncated)
+		if !f(fd_SlashEvent_truncated, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SlashEvent) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.SlashEvent.id":
+		return x.Id != uint64(0)
+	case "initia.mstaking.v1.SlashEvent.validator_address":
+		return x.ValidatorAddress != ""
+	case "initia.mstaking.v1.SlashEvent.infraction_height":
+		return x.InfractionHeight != int64(0)
+	case "initia.mstaking.v1.SlashEvent.height":
+		return x.Height != int64(0)
+	case "initia.mstaking.v1.SlashEvent.time":
+		return x.Time != nil
+	case "initia.mstaking.v1.SlashEvent.slash_factor":
+		return x.SlashFactor != ""
+	case "initia.mstaking.v1.SlashEvent.burned":
+		return len(x.Burned) != 0
+	case "initia.mstaking.v1.SlashEvent.truncated":
+		return x.Truncated != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.SlashEvent"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.SlashEvent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SlashEvent) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.SlashEvent.id":
+		x.Id = uint64(0)
+	case "initia.mstaking.v1.SlashEvent.validator_address":
+		x.ValidatorAddress = ""
+	case "initia.mstaking.v1.SlashEvent.infraction_height":
+		x.InfractionHeight = int64(0)
+	case "initia.mstaking.v1.SlashEvent.height":
+		x.Height = int64(0)
+	case "initia.mstaking.v1.SlashEvent.time":
+		x.Time = nil
+	case "initia.mstaking.v1.SlashEvent.slash_factor":
+		x.SlashFactor = ""
+	case "initia.mstaking.v1.SlashEvent.burned":
+		x.Burned = nil
+	case "initia.mstaking.v1.SlashEvent.truncated":
+		x.Truncated = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.SlashEvent"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.SlashEvent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SlashEvent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.mstaking.v1.SlashEvent.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "initia.mstaking.v1.SlashEvent.validator_address":
+		value := x.ValidatorAddress
+		return protoreflect.ValueOfString(value)
+	case "initia.mstaking.v1.SlashEvent.infraction_height":
+		value := x.InfractionHeight
+		return protoreflect.ValueOfInt64(value)
+	case "initia.mstaking.v1.SlashEvent.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "initia.mstaking.v1.SlashEvent.time":
+		value := x.Time
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.mstaking.v1.SlashEvent.slash_factor":
+		value := x.SlashFactor
+		return protoreflect.ValueOfString(value)
+	case "initia.mstaking.v1.SlashEvent.burned":
+		if len(x.Burned) == 0 {
+			return protoreflect.ValueOfList(&_SlashEvent_7_list{})
+		}
+		listValue := &_SlashEvent_7_list{list: &x.Burned}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.mstaking.v1.SlashEvent.truncated":
+		value := x.Truncated
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.SlashEvent"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.SlashEvent does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SlashEvent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.SlashEvent.id":
+		x.Id = value.Uint()
+	case "initia.mstaking.v1.SlashEvent.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	case "initia.mstaking.v1.SlashEvent.infraction_height":
+		x.InfractionHeight = value.Int()
+	case "initia.mstaking.v1.SlashEvent.height":
+		x.Height = value.Int()
+	case "initia.mstaking.v1.SlashEvent.time":
+		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
+	case "initia.mstaking.v1.SlashEvent.slash_factor":
+		x.SlashFactor = value.Interface().(string)
+	case "initia.mstaking.v1.SlashEvent.burned":
+		lv := value.List()
+		clv := lv.(*_SlashEvent_7_list)
+		x.Burned = *clv.list
+	case "initia.mstaking.v1.SlashEvent.truncated":
+		x.Truncated = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.SlashEvent"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.SlashEvent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SlashEvent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.SlashEvent.time":
+		if x.Time == nil {
+			x.Time = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+	case "initia.mstaking.v1.SlashEvent.burned":
+		if x.Burned == nil {
+			x.Burned = []*v1beta1.Coin{}
+		}
+		value := &_SlashEvent_7_list{list: &x.Burned}
+		return protoreflect.ValueOfList(value)
+	case "initia.mstaking.v1.SlashEvent.id":
+		panic(fmt.Errorf("field id of message initia.mstaking.v1.SlashEvent is not mutable"))
+	case "initia.mstaking.v1.SlashEvent.validator_address":
+		panic(fmt.Errorf("field validator_address of message initia.mstaking.v1.SlashEvent is not mutable"))
+	case "initia.mstaking.v1.SlashEvent.infraction_height":
+		panic(fmt.Errorf("field infraction_height of message initia.mstaking.v1.SlashEvent is not mutable"))
+	case "initia.mstaking.v1.SlashEvent.height":
+		panic(fmt.Errorf("field height of message initia.mstaking.v1.SlashEvent is not mutable"))
+	case "initia.mstaking.v1.SlashEvent.slash_factor":
+		panic(fmt.Errorf("field slash_factor of message initia.mstaking.v1.SlashEvent is not mutable"))
+	case "initia.mstaking.v1.SlashEvent.truncated":
+		panic(fmt.Errorf("field truncated of message initia.mstaking.v1.SlashEvent is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.SlashEvent"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.SlashEvent does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SlashEvent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.SlashEvent.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.mstaking.v1.SlashEvent.validator_address":
+		return protoreflect.ValueOfString("")
+	case "initia.mstaking.v1.SlashEvent.infraction_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "initia.mstaking.v1.SlashEvent.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "initia.mstaking.v1.SlashEvent.time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.mstaking.v1.SlashEvent.slash_factor":
+		return protoreflect.ValueOfString("")
+	case "initia.mstaking.v1.SlashEvent.burned":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_SlashEvent_7_list{list: &list})
+	case "initia.mstaking.v1.SlashEvent.truncated":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.SlashEvent"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.SlashEvent does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SlashEvent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.mstaking.v1.SlashEvent", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SlashEvent) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SlashEvent) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SlashEvent) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SlashEvent) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SlashEvent)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.ValidatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.InfractionHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.InfractionHeight))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Time != nil {
+			l = options.Size(x.Time)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SlashFactor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Burned) > 0 {
+			for _, e := range x.Burned {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Truncated {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SlashEvent)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Truncated {
+			i--
+			if x.Truncated {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.Burned) > 0 {
+			for iNdEx := len(x.Burned) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Burned[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.SlashFactor) > 0 {
+			i -= len(x.SlashFactor)
+			copy(dAtA[i:], x.SlashFactor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashFactor)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Time != nil {
+			encoded, err := options.Marshal(x.Time)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.InfractionHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InfractionHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SlashEvent)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SlashEvent: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InfractionHeight", wireType)
+				}
+				x.InfractionHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InfractionHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Time == nil {
+					x.Time = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Time); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashFactor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashFactor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Burned = append(x.Burned, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Burned[len(x.Burned)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Truncated = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DelegatorSlashImpact_6_list)(nil)
+
+type _DelegatorSlashImpact_6_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_DelegatorSlashImpact_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DelegatorSlashImpact_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DelegatorSlashImpact_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DelegatorSlashImpact_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DelegatorSlashImpact_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DelegatorSlashImpact_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DelegatorSlashImpact_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DelegatorSlashImpact_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DelegatorSlashImpact_7_list)(nil)
+
+type _DelegatorSlashImpact_7_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_DelegatorSlashImpact_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DelegatorSlashImpact_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DelegatorSlashImpact_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DelegatorSlashImpact_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DelegatorSlashImpact_7_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DelegatorSlashImpact_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DelegatorSlashImpact_7_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DelegatorSlashImpact_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DelegatorSlashImpact_8_list)(nil)
+
+type _DelegatorSlashImpact_8_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_DelegatorSlashImpact_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DelegatorSlashImpact_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DelegatorSlashImpact_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DelegatorSlashImpact_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DelegatorSlashImpact_8_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DelegatorSlashImpact_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DelegatorSlashImpact_8_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DelegatorSlashImpact_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DelegatorSlashImpact                   protoreflect.MessageDescriptor
+	fd_DelegatorSlashImpact_slash_event_id    protoreflect.FieldDescriptor
+	fd_DelegatorSlashImpact_delegator_address protoreflect.FieldDescriptor
+	fd_DelegatorSlashImpact_validator_address protoreflect.FieldDescriptor
+	fd_DelegatorSlashImpact_height            protoreflect.FieldDescriptor
+	fd_DelegatorSlashImpact_time              protoreflect.FieldDescriptor
+	fd_DelegatorSlashImpact_delegation        protoreflect.FieldDescriptor
+	fd_DelegatorSlashImpact_unbonding         protoreflect.FieldDescriptor
+	fd_DelegatorSlashImpact_redelegation      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_mstaking_v1_staking_proto_init()
+	md_DelegatorSlashImpact = File_initia_mstaking_v1_staking_proto.Messages().ByName("DelegatorSlashImpact")
+	fd_DelegatorSlashImpact_slash_event_id = md_DelegatorSlashImpact.Fields().ByName("slash_event_id")
+	fd_DelegatorSlashImpact_delegator_address = md_DelegatorSlashImpact.Fields().ByName("delegator_address")
+	fd_DelegatorSlashImpact_validator_address = md_DelegatorSlashImpact.Fields().ByName("validator_address")
+	fd_DelegatorSlashImpact_height = md_DelegatorSlashImpact.Fields().ByName("height")
+	fd_DelegatorSlashImpact_time = md_DelegatorSlashImpact.Fields().ByName("time")
+	fd_DelegatorSlashImpact_delegation = md_DelegatorSlashImpact.Fields().ByName("delegation")
+	fd_DelegatorSlashImpact_unbonding = md_DelegatorSlashImpact.Fields().ByName("unbonding")
+	fd_DelegatorSlashImpact_redelegation = md_DelegatorSlashImpact.Fields().ByName("redelegation")
+}
+
+var _ protoreflect.Message = (*fastReflection_DelegatorSlashImpact)(nil)
+
+type fastReflection_DelegatorSlashImpact DelegatorSlashImpact
+
+func (x *DelegatorSlashImpact) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DelegatorSlashImpact)(x)
+}
+
+func (x *DelegatorSlashImpact) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_mstaking_v1_staking_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DelegatorSlashImpact_messageType fastReflection_DelegatorSlashImpact_messageType
+var _ protoreflect.MessageType = fastReflection_DelegatorSlashImpact_messageType{}
+
+type fastReflection_DelegatorSlashImpact_messageType struct{}
+
+func (x fastReflection_DelegatorSlashImpact_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DelegatorSlashImpact)(nil)
+}
+func (x fastReflection_DelegatorSlashImpact_messageType) New() protoreflect.Message {
+	return new(fastReflection_DelegatorSlashImpact)
+}
+func (x fastReflection_DelegatorSlashImpact_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DelegatorSlashImpact
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DelegatorSlashImpact) Descriptor() protoreflect.MessageDescriptor {
+	return md_DelegatorSlashImpact
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DelegatorSlashImpact) Type() protoreflect.MessageType {
+	return _fastReflection_DelegatorSlashImpact_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DelegatorSlashImpact) New() protoreflect.Message {
+	return new(fastReflection_DelegatorSlashImpact)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DelegatorSlashImpact) Interface() protoreflect.ProtoMessage {
+	return (*DelegatorSlashImpact)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DelegatorSlashImpact) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SlashEventId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SlashEventId)
+		if !f(fd_DelegatorSlashImpact_slash_event_id, value) {
+			return
+		}
+	}
+	if x.DelegatorAddress != "" {
+		value := protoreflect.ValueOfString(x.DelegatorAddress)
+		if !f(fd_DelegatorSlashImpact_delegator_address, value) {
+			return
+		}
+	}
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_DelegatorSlashImpact_validator_address, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_DelegatorSlashImpact_height, value) {
+			return
+		}
+	}
+	if x.Time != nil {
+		value := protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+		if !f(fd_DelegatorSlashImpact_time, value) {
+			return
+		}
+	}
+	if len(x.Delegation) != 0 {
+		value := protoreflect.ValueOfList(&_DelegatorSlashImpact_6_list{list: &x.Delegation})
+		if !f(fd_DelegatorSlashImpact_delegation, value) {
+			return
+		}
+	}
+	if len(x.Unbonding) != 0 {
+		value := protoreflect.ValueOfList(&_DelegatorSlashImpact_7_list{list: &x.Unbonding})
+		if !f(fd_DelegatorSlashImpact_unbonding, value) {
+			return
+		}
+	}
+	if len(x.Redelegation) != 0 {
+		value := protoreflect.ValueOfList(&_DelegatorSlashImpact_8_list{list: &x.Redelegation})
+		if !f(fd_DelegatorSlashImpact_redelegation, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DelegatorSlashImpact) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.DelegatorSlashImpact.slash_event_id":
+		return x.SlashEventId != uint64(0)
+	case "initia.mstaking.v1.DelegatorSlashImpact.delegator_address":
+		return x.DelegatorAddress != ""
+	case "initia.mstaking.v1.DelegatorSlashImpact.validator_address":
+		return x.ValidatorAddress != ""
+	case "initia.mstaking.v1.DelegatorSlashImpact.height":
+		return x.Height != int64(0)
+	case "initia.mstaking.v1.DelegatorSlashImpact.time":
+		return x.Time != nil
+	case "initia.mstaking.v1.DelegatorSlashImpact.delegation":
+		return len(x.Delegation) != 0
+	case "initia.mstaking.v1.DelegatorSlashImpact.unbonding":
+		return len(x.Unbonding) != 0
+	case "initia.mstaking.v1.DelegatorSlashImpact.redelegation":
+		return len(x.Redelegation) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.DelegatorSlashImpact"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.DelegatorSlashImpact does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegatorSlashImpact) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.DelegatorSlashImpact.slash_event_id":
+		x.SlashEventId = uint64(0)
+	case "initia.mstaking.v1.DelegatorSlashImpact.delegator_address":
+		x.DelegatorAddress = ""
+	case "initia.mstaking.v1.DelegatorSlashImpact.validator_address":
+		x.ValidatorAddress = ""
+	case "initia.mstaking.v1.DelegatorSlashImpact.height":
+		x.Height = int64(0)
+	case "initia.mstaking.v1.DelegatorSlashImpact.time":
+		x.Time = nil
+	case "initia.mstaking.v1.DelegatorSlashImpact.delegation":
+		x.Delegation = nil
+	case "initia.mstaking.v1.DelegatorSlashImpact.unbonding":
+		x.Unbonding = nil
+	case "initia.mstaking.v1.DelegatorSlashImpact.redelegation":
+		x.Redelegation = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.DelegatorSlashImpact"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.DelegatorSlashImpact does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DelegatorSlashImpact) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.mstaking.v1.DelegatorSlashImpact.slash_event_id":
+		value := x.SlashEventId
+		return protoreflect.ValueOfUint64(value)
+	case "initia.mstaking.v1.DelegatorSlashImpact.delegator_address":
+		value := x.DelegatorAddress
+		return protoreflect.ValueOfString(value)
+	case "initia.mstaking.v1.DelegatorSlashImpact.validator_address":
+		value := x.ValidatorAddress
+		return protoreflect.ValueOfString(value)
+	case "initia.mstaking.v1.DelegatorSlashImpact.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "initia.mstaking.v1.DelegatorSlashImpact.time":
+		value := x.Time
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.mstaking.v1.DelegatorSlashImpact.delegation":
+		if len(x.Delegation) == 0 {
+			return protoreflect.ValueOfList(&_DelegatorSlashImpact_6_list{})
+		}
+		listValue := &_DelegatorSlashImpact_6_list{list: &x.Delegation}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.mstaking.v1.DelegatorSlashImpact.unbonding":
+		if len(x.Unbonding) == 0 {
+			return protoreflect.ValueOfList(&_DelegatorSlashImpact_7_list{})
+		}
+		listValue := &_DelegatorSlashImpact_7_list{list: &x.Unbonding}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.mstaking.v1.DelegatorSlashImpact.redelegation":
+		if len(x.Redelegation) == 0 {
+			return protoreflect.ValueOfList(&_DelegatorSlashImpact_8_list{})
+		}
+		listValue := &_DelegatorSlashImpact_8_list{list: &x.Redelegation}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.DelegatorSlashImpact"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.DelegatorSlashImpact does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegatorSlashImpact) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.DelegatorSlashImpact.slash_event_id":
+		x.SlashEventId = value.Uint()
+	case "initia.mstaking.v1.DelegatorSlashImpact.delegator_address":
+		x.DelegatorAddress = value.Interface().(string)
+	case "initia.mstaking.v1.DelegatorSlashImpact.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	case "initia.mstaking.v1.DelegatorSlashImpact.height":
+		x.Height = value.Int()
+	case "initia.mstaking.v1.DelegatorSlashImpact.time":
+		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
+	case "initia.mstaking.v1.DelegatorSlashImpact.delegation":
+		lv := value.List()
+		clv := lv.(*_DelegatorSlashImpact_6_list)
+		x.Delegation = *clv.list
+	case "initia.mstaking.v1.DelegatorSlashImpact.unbonding":
+		lv := value.List()
+		clv := lv.(*_DelegatorSlashImpact_7_list)
+		x.Unbonding = *clv.list
+	case "initia.mstaking.v1.DelegatorSlashImpact.redelegation":
+		lv := value.List()
+		clv := lv.(*_DelegatorSlashImpact_8_list)
+		x.Redelegation = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.DelegatorSlashImpact"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.DelegatorSlashImpact does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegatorSlashImpact) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.DelegatorSlashImpact.time":
+		if x.Time == nil {
+			x.Time = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+	case "initia.mstaking.v1.DelegatorSlashImpact.delegation":
+		if x.Delegation == nil {
+			x.Delegation = []*v1beta1.Coin{}
+		}
+		value := &_DelegatorSlashImpact_6_list{list: &x.Delegation}
+		return protoreflect.ValueOfList(value)
+	case "initia.mstaking.v1.DelegatorSlashImpact.unbonding":
+		if x.Unbonding == nil {
+			x.Unbonding = []*v1beta1.Coin{}
+		}
+		value := &_DelegatorSlashImpact_7_list{list: &x.Unbonding}
+		return protoreflect.ValueOfList(value)
+	case "initia.mstaking.v1.DelegatorSlashImpact.redelegation":
+		if x.Redelegation == nil {
+			x.Redelegation = []*v1beta1.Coin{}
+		}
+		value := &_DelegatorSlashImpact_8_list{list: &x.Redelegation}
+		return protoreflect.ValueOfList(value)
+	case "initia.mstaking.v1.DelegatorSlashImpact.slash_event_id":
+		panic(fmt.Errorf("field slash_event_id of message initia.mstaking.v1.DelegatorSlashImpact is not mutable"))
+	case "initia.mstaking.v1.DelegatorSlashImpact.delegator_address":
+		panic(fmt.Errorf("field delegator_address of message initia.mstaking.v1.DelegatorSlashImpact is not mutable"))
+	case "initia.mstaking.v1.DelegatorSlashImpact.validator_address":
+		panic(fmt.Errorf("field validator_address of message initia.mstaking.v1.DelegatorSlashImpact is not mutable"))
+	case "initia.mstaking.v1.DelegatorSlashImpact.height":
+		panic(fmt.Errorf("field height of message initia.mstaking.v1.DelegatorSlashImpact is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.DelegatorSlashImpact"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.DelegatorSlashImpact does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DelegatorSlashImpact) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.mstaking.v1.DelegatorSlashImpact.slash_event_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.mstaking.v1.DelegatorSlashImpact.delegator_address":
+		return protoreflect.ValueOfString("")
+	case "initia.mstaking.v1.DelegatorSlashImpact.validator_address":
+		return protoreflect.ValueOfString("")
+	case "initia.mstaking.v1.DelegatorSlashImpact.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "initia.mstaking.v1.DelegatorSlashImpact.time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.mstaking.v1.DelegatorSlashImpact.delegation":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_DelegatorSlashImpact_6_list{list: &list})
+	case "initia.mstaking.v1.DelegatorSlashImpact.unbonding":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_DelegatorSlashImpact_7_list{list: &list})
+	case "initia.mstaking.v1.DelegatorSlashImpact.redelegation":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_DelegatorSlashImpact_8_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.mstaking.v1.DelegatorSlashImpact"))
+		}
+		panic(fmt.Errorf("message initia.mstaking.v1.DelegatorSlashImpact does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DelegatorSlashImpact) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.mstaking.v1.DelegatorSlashImpact", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DelegatorSlashImpact) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegatorSlashImpact) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DelegatorSlashImpact) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DelegatorSlashImpact) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DelegatorSlashImpact)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SlashEventId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlashEventId))
+		}
+		l = len(x.DelegatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ValidatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Time != nil {
+			l = options.Size(x.Time)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Delegation) > 0 {
+			for _, e := range x.Delegation {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Unbonding) > 0 {
+			for _, e := range x.Unbonding {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Redelegation) > 0 {
+			for _, e := range x.Redelegation {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DelegatorSlashImpact)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Redelegation) > 0 {
+			for iNdEx := len(x.Redelegation) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Redelegation[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.Unbonding) > 0 {
+			for iNdEx := len(x.Unbonding) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Unbonding[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.Delegation) > 0 {
+			for iNdEx := len(x.Delegation) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Delegation[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.Time != nil {
+			encoded, err := options.Marshal(x.Time)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.DelegatorAddress) > 0 {
+			i -= len(x.DelegatorAddress)
+			copy(dAtA[i:], x.DelegatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.SlashEventId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashEventId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DelegatorSlashImpact)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegatorSlashImpact: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegatorSlashImpact: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashEventId", wireType)
+				}
+				x.SlashEventId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlashEventId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Time == nil {
+					x.Time = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Time); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegation = append(x.Delegation, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Delegation[len(x.Delegation)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Unbonding = append(x.Unbonding, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Unbonding[len(x.Unbonding)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Redelegation = append(x.Redelegation, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Redelegation[len(x.Redelegation)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: initia/mstaking/v1/staking.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// BondStatus is the status of a validator.
-type BondStatus int32
+// BondStatus is the status of a validator.
+type BondStatus int32
+
+const (
+	// UNSPECIFIED defines an invalid validator status.
+	BondStatus_BOND_STATUS_UNSPECIFIED BondStatus = 0
+	// UNBONDED defines a validator that is not bonded.
+	BondStatus_BOND_STATUS_UNBONDED BondStatus = 1
+	// UNBONDING defines a validator that is unbonding.
+	BondStatus_BOND_STATUS_UNBONDING BondStatus = 2
+	// BONDED defines a validator that is bonded.
+	BondStatus_BOND_STATUS_BONDED BondStatus = 3
+)
+
+// Enum value maps for BondStatus.
+var (
+	BondStatus_name = map[int32]string{
+		0: "BOND_STATUS_UNSPECIFIED",
+		1: "BOND_STATUS_UNBONDED",
+		2: "BOND_STATUS_UNBONDING",
+		3: "BOND_STATUS_BONDED",
+	}
+	BondStatus_value = map[string]int32{
+		"BOND_STATUS_UNSPECIFIED": 0,
+		"BOND_STATUS_UNBONDED":    1,
+		"BOND_STATUS_UNBONDING":   2,
+		"BOND_STATUS_BONDED":      3,
+	}
+)
+
+func (x BondStatus) Enum() *BondStatus {
+	p := new(BondStatus)
+	*p = x
+	return p
+}
+
+func (x BondStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BondStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_initia_mstaking_v1_staking_proto_enumTypes[0].Descriptor()
+}
+
+func (BondStatus) Type() protoreflect.EnumType {
+	return &file_initia_mstaking_v1_staking_proto_enumTypes[0]
+}
+
+func (x BondStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BondStatus.Descriptor instead.
+func (BondStatus) EnumDescriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{0}
+}
+
+// Infraction indicates the infraction a validator committed.
+type Infraction int32
+
+const (
+	// UNSPECIFIED defines an empty infraction.
+	Infraction_INFRACTION_UNSPECIFIED Infraction = 0
+	// DOUBLE_SIGN defines a validator that double-signs a block.
+	Infraction_INFRACTION_DOUBLE_SIGN Infraction = 1
+	// DOWNTIME defines a validator that missed signing too many blocks.
+	Infraction_INFRACTION_DOWNTIME Infraction = 2
+)
+
+// Enum value maps for Infraction.
+var (
+	Infraction_name = map[int32]string{
+		0: "INFRACTION_UNSPECIFIED",
+		1: "INFRACTION_DOUBLE_SIGN",
+		2: "INFRACTION_DOWNTIME",
+	}
+	Infraction_value = map[string]int32{
+		"INFRACTION_UNSPECIFIED": 0,
+		"INFRACTION_DOUBLE_SIGN": 1,
+		"INFRACTION_DOWNTIME":    2,
+	}
+)
+
+func (x Infraction) Enum() *Infraction {
+	p := new(Infraction)
+	*p = x
+	return p
+}
+
+func (x Infraction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Infraction) Descriptor() protoreflect.EnumDescriptor {
+	return file_initia_mstaking_v1_staking_proto_enumTypes[1].Descriptor()
+}
+
+func (Infraction) Type() protoreflect.EnumType {
+	return &file_initia_mstaking_v1_staking_proto_enumTypes[1]
+}
+
+func (x Infraction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Infraction.Descriptor instead.
+func (Infraction) EnumDescriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{1}
+}
+
+// CommissionRates defines the initial commission rates to be used for creating
+// a validator.
+type CommissionRates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// rate is the commission rate charged to delegators, as a fraction.
+	Rate string `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	// max_rate defines the maximum commission rate which validator can ever charge, as a fraction.
+	MaxRate string `protobuf:"bytes,2,opt,name=max_rate,json=maxRate,proto3" json:"max_rate,omitempty"`
+	// max_change_rate defines the maximum daily increase of the validator commission, as a fraction.
+	MaxChangeRate string `protobuf:"bytes,3,opt,name=max_change_rate,json=maxChangeRate,proto3" json:"max_change_rate,omitempty"`
+}
+
+func (x *CommissionRates) Reset() {
+	*x = CommissionRates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommissionRates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommissionRates) ProtoMessage() {}
+
+// Deprecated: Use CommissionRates.ProtoReflect.Descriptor instead.
+func (*CommissionRates) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CommissionRates) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *CommissionRates) GetMaxRate() string {
+	if x != nil {
+		return x.MaxRate
+	}
+	return ""
+}
+
+func (x *CommissionRates) GetMaxChangeRate() string {
+	if x != nil {
+		return x.MaxChangeRate
+	}
+	return ""
+}
+
+// Commission defines commission parameters for a given validator.
+type Commission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// commission_rates defines the initial commission rates to be used for creating a validator.
+	CommissionRates *CommissionRates `protobuf:"bytes,1,opt,name=commission_rates,json=commissionRates,proto3" json:"commission_rates,omitempty"`
+	// update_time is the last time the commission rate was changed.
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+}
+
+func (x *Commission) Reset() {
+	*x = Commission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Commission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Commission) ProtoMessage() {}
+
+// Deprecated: Use Commission.ProtoReflect.Descriptor instead.
+func (*Commission) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Commission) GetCommissionRates() *CommissionRates {
+	if x != nil {
+		return x.CommissionRates
+	}
+	return nil
+}
+
+func (x *Commission) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// Description defines a validator description.
+type Description struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// moniker defines a human-readable name for the validator.
+	Moniker string `protobuf:"bytes,1,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	// identity defines an optional identity signature (ex. UPort or Keybase).
+	Identity string `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	// website defines an optional website link.
+	Website string `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+	// security_contact defines an optional email for security contact.
+	SecurityContact string `protobuf:"bytes,4,opt,name=security_contact,json=securityContact,proto3" json:"security_contact,omitempty"`
+	// details define other optional details.
+	Details string `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+}
+
+func (x *Description) Reset() {
+	*x = Description{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Description) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Description) ProtoMessage() {}
+
+// Deprecated: Use Description.ProtoReflect.Descriptor instead.
+func (*Description) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Description) GetMoniker() string {
+	if x != nil {
+		return x.Moniker
+	}
+	return ""
+}
+
+func (x *Description) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *Description) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *Description) GetSecurityContact() string {
+	if x != nil {
+		return x.SecurityContact
+	}
+	return ""
+}
+
+func (x *Description) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+// Validator defines a validator, together with the total amount of the
+// Validator's bond shares and their exchange rate to coins. Slashing results in
+// a decrease in the exchange rate, allowing correct calculation of future
+// undelegations without iterating over delegators. When coins are delegated to
+// this validator, the validator is credited with a delegation whose number of
+// bond shares is based on the amount of coins delegated divided by the current
+// exchange rate. Voting power can be calculated as total bonded shares
+// multiplied by exchange rate.
+type Validator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// operator_address defines the address of the validator's operator; bech encoded in JSON.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// consensus_pubkey is the consensus public key of the validator, as a Protobuf Any.
+	ConsensusPubkey *anypb.Any `protobuf:"bytes,2,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// jailed defined whether the validator has been jailed from bonded status or not.
+	Jailed bool `protobuf:"varint,3,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// status is the validator status (bonded/unbonding/unbonded).
+	Status BondStatus `protobuf:"varint,4,opt,name=status,proto3,enum=initia.mstaking.v1.BondStatus" json:"status,omitempty"`
+	// tokens define the delegated tokens (incl. self-delegation).
+	Tokens []*v1beta1.Coin `protobuf:"bytes,5,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	// delegator_shares defines total shares issued to a validator's delegators.
+	DelegatorShares []*v1beta1.DecCoin `protobuf:"bytes,6,rep,name=delegator_shares,json=delegatorShares,proto3" json:"delegator_shares,omitempty"`
+	// description defines the description terms for the validator.
+	Description *Description `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	// unbonding_height defines, if unbonding, the height at which this validator has begun unbonding.
+	UnbondingHeight int64 `protobuf:"varint,8,opt,name=unbonding_height,json=unbondingHeight,proto3" json:"unbonding_height,omitempty"`
+	// unbonding_time defines, if unbonding, the min time for the validator to complete unbonding.
+	UnbondingTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
+	// commission defines the commission parameters.
+	Commission *Commission `protobuf:"bytes,10,opt,name=commission,proto3" json:"commission,omitempty"`
+	// voting_powers defines the voting powers for each bond denoms
+	VotingPowers []*v1beta1.Coin `protobuf:"bytes,11,rep,name=voting_powers,json=votingPowers,proto3" json:"voting_powers,omitempty"`
+	// voting_power defines the sum of voting powers
+	VotingPower string `protobuf:"bytes,12,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// strictly positive if this validator's unbonding has been stopped by external modules
+	UnbondingOnHoldRefCount int64 `protobuf:"varint,13,opt,name=unbonding_on_hold_ref_count,json=unbondingOnHoldRefCount,proto3" json:"unbonding_on_hold_ref_count,omitempty"`
+	// unbonding id, uniquely identifing an unbonding of this validator
+	UnbondingId uint64 `protobuf:"varint,14,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty"`
+}
+
+func (x *Validator) Reset() {
+	*x = Validator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-const (
-	// UNSPECIFIED defines an invalid validator status.
-	BondStatus_BOND_STATUS_UNSPECIFIED BondStatus = 0
-	// UNBONDED defines a validator that is not bonded.
-	BondStatus_BOND_STATUS_UNBONDED BondStatus = 1
-	// UNBONDING defines a validator that is unbonding.
-	BondStatus_BOND_STATUS_UNBONDING BondStatus = 2
-	// BONDED defines a validator that is bonded.
-	BondStatus_BOND_STATUS_BONDED BondStatus = 3
-)
+func (x *Validator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-// Enum value maps for BondStatus.
-var (
-	BondStatus_name = map[int32]string{
-		0: "BOND_STATUS_UNSPECIFIED",
-		1: "BOND_STATUS_UNBONDED",
-		2: "BOND_STATUS_UNBONDING",
-		3: "BOND_STATUS_BONDED",
+func (*Validator) ProtoMessage() {}
+
+// Deprecated: Use Validator.ProtoReflect.Descriptor instead.
+func (*Validator) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Validator) GetOperatorAddress() string {
+	if x != nil {
+		return x.OperatorAddress
 	}
-	BondStatus_value = map[string]int32{
-		"BOND_STATUS_UNSPECIFIED": 0,
-		"BOND_STATUS_UNBONDED":    1,
-		"BOND_STATUS_UNBONDING":   2,
-		"BOND_STATUS_BONDED":      3,
+	return ""
+}
+
+func (x *Validator) GetConsensusPubkey() *anypb.Any {
+	if x != nil {
+		return x.ConsensusPubkey
 	}
-)
+	return nil
+}
 
-func (x BondStatus) Enum() *BondStatus {
-	p := new(BondStatus)
-	*p = x
-	return p
+func (x *Validator) GetJailed() bool {
+	if x != nil {
+		return x.Jailed
+	}
+	return false
 }
 
-func (x BondStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *Validator) GetStatus() BondStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BondStatus_BOND_STATUS_UNSPECIFIED
 }
 
-func (BondStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_initia_mstaking_v1_staking_proto_enumTypes[0].Descriptor()
+func (x *Validator) GetTokens() []*v1beta1.Coin {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
 }
 
-func (BondStatus) Type() protoreflect.EnumType {
-	return &file_initia_mstaking_v1_staking_proto_enumTypes[0]
+func (x *Validator) GetDelegatorShares() []*v1beta1.DecCoin {
+	if x != nil {
+		return x.DelegatorShares
+	}
+	return nil
 }
 
-func (x BondStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *Validator) GetDescription() *Description {
+	if x != nil {
+		return x.Description
+	}
+	return nil
 }
 
-// Deprecated: Use BondStatus.Descriptor instead.
-func (BondStatus) EnumDescriptor() ([]byte, []int) {
-	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{0}
+func (x *Validator) GetUnbondingHeight() int64 {
+	if x != nil {
+		return x.UnbondingHeight
+	}
+	return 0
 }
 
-// Infraction indicates the infraction a validator committed.
-type Infraction int32
+func (x *Validator) GetUnbondingTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnbondingTime
+	}
+	return nil
+}
 
-const (
-	// UNSPECIFIED defines an empty infraction.
-	Infraction_INFRACTION_UNSPECIFIED Infraction = 0
-	// DOUBLE_SIGN defines a validator that double-signs a block.
-	Infraction_INFRACTION_DOUBLE_SIGN Infraction = 1
-	// DOWNTIME defines a validator that missed signing too many blocks.
-	Infraction_INFRACTION_DOWNTIME Infraction = 2
-)
+func (x *Validator) GetCommission() *Commission {
+	if x != nil {
+		return x.Commission
+	}
+	return nil
+}
 
-// Enum value maps for Infraction.
-var (
-	Infraction_name = map[int32]string{
-		0: "INFRACTION_UNSPECIFIED",
-		1: "INFRACTION_DOUBLE_SIGN",
-		2: "INFRACTION_DOWNTIME",
+func (x *Validator) GetVotingPowers() []*v1beta1.Coin {
+	if x != nil {
+		return x.VotingPowers
 	}
-	Infraction_value = map[string]int32{
-		"INFRACTION_UNSPECIFIED": 0,
-		"INFRACTION_DOUBLE_SIGN": 1,
-		"INFRACTION_DOWNTIME":    2,
+	return nil
+}
+
+func (x *Validator) GetVotingPower() string {
+	if x != nil {
+		return x.VotingPower
 	}
-)
+	return ""
+}
 
-func (x Infraction) Enum() *Infraction {
-	p := new(Infraction)
-	*p = x
-	return p
+func (x *Validator) GetUnbondingOnHoldRefCount() int64 {
+	if x != nil {
+		return x.UnbondingOnHoldRefCount
+	}
+	return 0
 }
 
-func (x Infraction) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *Validator) GetUnbondingId() uint64 {
+	if x != nil {
+		return x.UnbondingId
+	}
+	return 0
+}
+
+// ValAddresses defines a repeated set of validator addresses.
+type ValAddresses struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (x *ValAddresses) Reset() {
+	*x = ValAddresses{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValAddresses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValAddresses) ProtoMessage() {}
+
+// Deprecated: Use ValAddresses.ProtoReflect.Descriptor instead.
+func (*ValAddresses) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ValAddresses) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+// DVPair is struct that just has a delegator-validator pair with no other data.
+// It is intended to be used as a marshalable pointer. For example, a DVPair can
+// be used to construct the key to getting an UnbondingDelegation from state.
+type DVPair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (x *DVPair) Reset() {
+	*x = DVPair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DVPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DVPair) ProtoMessage() {}
+
+// Deprecated: Use DVPair.ProtoReflect.Descriptor instead.
+func (*DVPair) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DVPair) GetDelegatorAddress() string {
+	if x != nil {
+		return x.DelegatorAddress
+	}
+	return ""
+}
+
+func (x *DVPair) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+// DVPairs defines an array of DVPair objects.
+type DVPairs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pairs []*DVPair `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
 }
 
-func (Infraction) Descriptor() protoreflect.EnumDescriptor {
-	return file_initia_mstaking_v1_staking_proto_enumTypes[1].Descriptor()
+func (x *DVPairs) Reset() {
+	*x = DVPairs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (Infraction) Type() protoreflect.EnumType {
-	return &file_initia_mstaking_v1_staking_proto_enumTypes[1]
+func (x *DVPairs) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x Infraction) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (*DVPairs) ProtoMessage() {}
+
+// Deprecated: Use DVPairs.ProtoReflect.Descriptor instead.
+func (*DVPairs) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{6}
 }
 
-// Deprecated: Use Infraction.Descriptor instead.
-func (Infraction) EnumDescriptor() ([]byte, []int) {
-	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{1}
+func (x *DVPairs) GetPairs() []*DVPair {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
 }
 
-// CommissionRates defines the initial commission rates to be used for creating
-// a validator.
-type CommissionRates struct {
+// DVVTriplet is struct that just has a delegator-validator-validator triplet
+// with no other data. It is intended to be used as a marshalable pointer. For
+// example, a DVVTriplet can be used to construct the key to getting a
+// Redelegation from state.
+type DVVTriplet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// rate is the commission rate charged to delegators, as a fraction.
-	Rate string `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
-	// max_rate defines the maximum commission rate which validator can ever charge, as a fraction.
-	MaxRate string `protobuf:"bytes,2,opt,name=max_rate,json=maxRate,proto3" json:"max_rate,omitempty"`
-	// max_change_rate defines the maximum daily increase of the validator commission, as a fraction.
-	MaxChangeRate string `protobuf:"bytes,3,opt,name=max_change_rate,json=maxChangeRate,proto3" json:"max_change_rate,omitempty"`
+	DelegatorAddress    string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorSrcAddress string `protobuf:"bytes,2,opt,name=validator_src_address,json=validatorSrcAddress,proto3" json:"validator_src_address,omitempty"`
+	ValidatorDstAddress string `protobuf:"bytes,3,opt,name=validator_dst_address,json=validatorDstAddress,proto3" json:"validator_dst_address,omitempty"`
 }
 
-func (x *CommissionRates) Reset() {
-	*x = CommissionRates{}
+func (x *DVVTriplet) Reset() {
+	*x = DVVTriplet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[0]
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CommissionRates) String() string {
+func (x *DVVTriplet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommissionRates) ProtoMessage() {}
+func (*DVVTriplet) ProtoMessage() {}
 
-// Deprecated: Use CommissionRates.ProtoReflect.Descriptor instead.
-func (*CommissionRates) Descriptor() ([]byte, []int) {
-	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use DVVTriplet.ProtoReflect.Descriptor instead.
+func (*DVVTriplet) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CommissionRates) GetRate() string {
+func (x *DVVTriplet) GetDelegatorAddress() string {
 	if x != nil {
-		return x.Rate
+		return x.DelegatorAddress
 	}
 	return ""
 }
 
-func (x *CommissionRates) GetMaxRate() string {
+func (x *DVVTriplet) GetValidatorSrcAddress() string {
 	if x != nil {
-		return x.MaxRate
+		return x.ValidatorSrcAddress
 	}
 	return ""
 }
 
-func (x *CommissionRates) GetMaxChangeRate() string {
+func (x *DVVTriplet) GetValidatorDstAddress() string {
 	if x != nil {
-		return x.MaxChangeRate
+		return x.ValidatorDstAddress
 	}
 	return ""
 }
 
-// Commission defines commission parameters for a given validator.
-type Commission struct {
+// DVVTriplets defines an array of DVVTriplet objects.
+type DVVTriplets struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// commission_rates defines the initial commission rates to be used for creating a validator.
-	CommissionRates *CommissionRates `protobuf:"bytes,1,opt,name=commission_rates,json=commissionRates,proto3" json:"commission_rates,omitempty"`
-	// update_time is the last time the commission rate was changed.
-	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	Triplets []*DVVTriplet `protobuf:"bytes,1,rep,name=triplets,proto3" json:"triplets,omitempty"`
 }
 
-func (x *Commission) Reset() {
-	*x = Commission{}
+func (x *DVVTriplets) Reset() {
+	*x = DVVTriplets{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[1]
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Commission) String() string {
+func (x *DVVTriplets) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Commission) ProtoMessage() {}
-
-// Deprecated: Use Commission.ProtoReflect.Descriptor instead.
-func (*Commission) Descriptor() ([]byte, []int) {
-	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{1}
-}
+func (*DVVTriplets) ProtoMessage() {}
 
-func (x *Commission) GetCommissionRates() *CommissionRates {
-	if x != nil {
-		return x.CommissionRates
-	}
-	return nil
+// Deprecated: Use DVVTriplets.ProtoReflect.Descriptor instead.
+func (*DVVTriplets) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Commission) GetUpdateTime() *timestamppb.Timestamp {
+func (x *DVVTriplets) GetTriplets() []*DVVTriplet {
 	if x != nil {
-		return x.UpdateTime
+		return x.Triplets
 	}
 	return nil
 }
 
-// Description defines a validator description.
-type Description struct {
+// Delegation represents the bond with tokens held by an account. It is
+// owned by one delegator, and is associated with the voting power of one
+// validator.
+type Delegation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// moniker defines a human-readable name for the validator.
-	Moniker string `protobuf:"bytes,1,opt,name=moniker,proto3" json:"moniker,omitempty"`
-	// identity defines an optional identity signature (ex. UPort or Keybase).
-	Identity string `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
-	// website defines an optional website link.
-	Website string `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
-	// security_contact defines an optional email for security contact.
-	SecurityContact string `protobuf:"bytes,4,opt,name=security_contact,json=securityContact,proto3" json:"security_contact,omitempty"`
-	// details define other optional details.
-	Details string `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+	// delegator_address is the bech32-encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the bech32-encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// shares define the delegation shares received.
+	Shares []*v1beta1.DecCoin `protobuf:"bytes,3,rep,name=shares,proto3" json:"shares,omitempty"`
 }
 
-func (x *Description) Reset() {
-	*x = Description{}
+func (x *Delegation) Reset() {
+	*x = Delegation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[2]
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Description) String() string {
+func (x *Delegation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Description) ProtoMessage() {}
+func (*Delegation) ProtoMessage() {}
 
-// Deprecated: Use Description.ProtoReflect.Descriptor instead.
-func (*Description) Descriptor() ([]byte, []int) {
-	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use Delegation.ProtoReflect.Descriptor instead.
+func (*Delegation) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Description) GetMoniker() string {
+func (x *Delegation) GetDelegatorAddress() string {
 	if x != nil {
-		return x.Moniker
+		return x.DelegatorAddress
 	}
 	return ""
 }
 
-func (x *Description) GetIdentity() string {
+func (x *Delegation) GetValidatorAddress() string {
 	if x != nil {
-		return x.Identity
+		return x.ValidatorAddress
 	}
 	return ""
 }
 
-func (x *Description) GetWebsite() string {
+func (x *Delegation) GetShares() []*v1beta1.DecCoin {
 	if x != nil {
-		return x.Website
+		return x.Shares
 	}
-	return ""
+	return nil
 }
 
-func (x *Description) GetSecurityContact() string {
+// UnbondingDelegation stores all of a single delegator's unbonding bonds
+// for a single validator in an time-ordered list.
+type UnbondingDelegation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// delegator_address is the bech32-encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the bech32-encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// entries are the unbonding delegation entries.
+	Entries []*UnbondingDelegationEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"` // unbonding delegation entries
+}
+
+func (x *UnbondingDelegation) Reset() {
+	*x = UnbondingDelegation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnbondingDelegation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbondingDelegation) ProtoMessage() {}
+
+// Deprecated: Use UnbondingDelegation.ProtoReflect.Descriptor instead.
+func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UnbondingDelegation) GetDelegatorAddress() string {
 	if x != nil {
-		return x.SecurityContact
+		return x.DelegatorAddress
 	}
 	return ""
 }
 
-func (x *Description) GetDetails() string {
+func (x *UnbondingDelegation) GetValidatorAddress() string {
 	if x != nil {
-		return x.Details
+		return x.ValidatorAddress
 	}
 	return ""
 }
 
-// Validator defines a validator, together with the total amount of the
-// Validator's bond shares and their exchange rate to coins. Slashing results in
-// a decrease in the exchange rate, allowing correct calculation of future
-// undelegations without iterating over delegators. When coins are delegated to
-// this validator, the validator is credited with a delegation whose number of
-// bond shares is based on the amount of coins delegated divided by the current
-// exchange rate. Voting power can be calculated as total bonded shares
-// multiplied by exchange rate.
-type Validator struct {
+func (x *UnbondingDelegation) GetEntries() []*UnbondingDelegationEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// UnbondingDelegationEntry defines an unbonding object with relevant metadata.
+type UnbondingDelegationEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// operator_address defines the address of the validator's operator; bech encoded in JSON.
-	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
-	// consensus_pubkey is the consensus public key of the validator, as a Protobuf Any.
-	ConsensusPubkey *anypb.Any `protobuf:"bytes,2,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
-	// jailed defined whether the validator has been jailed from bonded status or not.
-	Jailed bool `protobuf:"varint,3,opt,name=jailed,proto3" json:"jailed,omitempty"`
-	// status is the validator status (bonded/unbonding/unbonded).
-	Status BondStatus `protobuf:"varint,4,opt,name=status,proto3,enum=initia.mstaking.v1.BondStatus" json:"status,omitempty"`
-	// tokens define the delegated tokens (incl. self-delegation).
-	Tokens []*v1beta1.Coin `protobuf:"bytes,5,rep,name=tokens,proto3" json:"tokens,omitempty"`
-	// delegator_shares defines total shares issued to a validator's delegators.
-	DelegatorShares []*v1beta1.DecCoin `protobuf:"bytes,6,rep,name=delegator_shares,json=delegatorShares,proto3" json:"delegator_shares,omitempty"`
-	// description defines the description terms for the validator.
-	Description *Description `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
-	// unbonding_height defines, if unbonding, the height at which this validator has begun unbonding.
-	UnbondingHeight int64 `protobuf:"varint,8,opt,name=unbonding_height,json=unbondingHeight,proto3" json:"unbonding_height,omitempty"`
-	// unbonding_time defines, if unbonding, the min time for the validator to complete unbonding.
-	UnbondingTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
-	// commission defines the commission parameters.
-	Commission *Commission `protobuf:"bytes,10,opt,name=commission,proto3" json:"commission,omitempty"`
-	// voting_powers defines the voting powers for each bond denoms
-	VotingPowers []*v1beta1.Coin `protobuf:"bytes,11,rep,name=voting_powers,json=votingPowers,proto3" json:"voting_powers,omitempty"`
-	// voting_power defines the sum of voting powers
-	VotingPower string `protobuf:"bytes,12,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
-	// strictly positive if this validator's unbonding has been stopped by external modules
-	UnbondingOnHoldRefCount int64 `protobuf:"varint,13,opt,name=unbonding_on_hold_ref_count,json=unbondingOnHoldRefCount,proto3" json:"unbonding_on_hold_ref_count,omitempty"`
-	// unbonding id, uniquely identifing an unbonding of this validator
-	UnbondingId uint64 `protobuf:"varint,14,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty"`
+	// creation_height is the height which the unbonding took place.
+	CreationHeight int64 `protobuf:"varint,1,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	// completion_time is the unix time for unbonding completion.
+	CompletionTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=completion_time,json=completionTime,proto3" json:"completion_time,omitempty"`
+	// initial_balance defines the tokens initially scheduled to receive at completion.
+	InitialBalance []*v1beta1.Coin `protobuf:"bytes,3,rep,name=initial_balance,json=initialBalance,proto3" json:"initial_balance,omitempty"`
+	// balance defines the tokens to receive at completion.
+	Balance []*v1beta1.Coin `protobuf:"bytes,4,rep,name=balance,proto3" json:"balance,omitempty"`
+	// Incrementing id that uniquely identifies this entry
+	UnbondingId uint64 `protobuf:"varint,5,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty"`
+	// Strictly positive if this entry's unbonding has been stopped by external modules
+	UnbondingOnHoldRefCount int64 `protobuf:"varint,6,opt,name=unbonding_on_hold_ref_count,json=unbondingOnHoldRefCount,proto3" json:"unbonding_on_hold_ref_count,omitempty"`
 }
 
-func (x *Validator) Reset() {
-	*x = Validator{}
+func (x *UnbondingDelegationEntry) Reset() {
+	*x = UnbondingDelegationEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[3]
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Validator) String() string {
+func (x *UnbondingDelegationEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Validator) ProtoMessage() {}
+func (*UnbondingDelegationEntry) ProtoMessage() {}
 
-// Deprecated: Use Validator.ProtoReflect.Descriptor instead.
-func (*Validator) Descriptor() ([]byte, []int) {
-	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use UnbondingDelegationEntry.ProtoReflect.Descriptor instead.
+func (*UnbondingDelegationEntry) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Validator) GetOperatorAddress() string {
+func (x *UnbondingDelegationEntry) GetCreationHeight() int64 {
 	if x != nil {
-		return x.OperatorAddress
+		return x.CreationHeight
 	}
-	return ""
+	return 0
 }
 
-func (x *Validator) GetConsensusPubkey() *anypb.Any {
+func (x *UnbondingDelegationEntry) GetCompletionTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ConsensusPubkey
+		return x.CompletionTime
 	}
 	return nil
 }
 
-func (x *Validator) GetJailed() bool {
+func (x *UnbondingDelegationEntry) GetInitialBalance() []*v1beta1.Coin {
 	if x != nil {
-		return x.Jailed
+		return x.InitialBalance
 	}
-	return false
+	return nil
 }
 
-func (x *Validator) GetStatus() BondStatus {
+func (x *UnbondingDelegationEntry) GetBalance() []*v1beta1.Coin {
 	if x != nil {
-		return x.Status
+		return x.Balance
 	}
-	return BondStatus_BOND_STATUS_UNSPECIFIED
+	return nil
 }
 
-func (x *Validator) GetTokens() []*v1beta1.Coin {
+func (x *UnbondingDelegationEntry) GetUnbondingId() uint64 {
 	if x != nil {
-		return x.Tokens
+		return x.UnbondingId
 	}
-	return nil
+	return 0
 }
 
-func (x *Validator) GetDelegatorShares() []*v1beta1.DecCoin {
+func (x *UnbondingDelegationEntry) GetUnbondingOnHoldRefCount() int64 {
 	if x != nil {
-		return x.DelegatorShares
+		return x.UnbondingOnHoldRefCount
 	}
-	return nil
+	return 0
 }
 
-func (x *Validator) GetDescription() *Description {
-	if x != nil {
-		return x.Description
-	}
-	return nil
+// RedelegationEntry defines a redelegation object with relevant metadata.
+type RedelegationEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// creation_height  defines the height which the redelegation took place.
+	CreationHeight int64 `protobuf:"varint,1,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	// completion_time defines the unix time for redelegation completion.
+	CompletionTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=completion_time,json=completionTime,proto3" json:"completion_time,omitempty"`
+	// initial_balance defines the initial balance when redelegation started.
+	InitialBalance []*v1beta1.Coin `protobuf:"bytes,3,rep,name=initial_balance,json=initialBalance,proto3" json:"initial_balance,omitempty"`
+	// shares_dst is the amount of destination-validator shares created by redelegation.
+	SharesDst []*v1beta1.DecCoin `protobuf:"bytes,4,rep,name=shares_dst,json=sharesDst,proto3" json:"shares_dst,omitempty"`
+	// Incrementing id that uniquely identifies this entry
+	UnbondingId uint64 `protobuf:"varint,5,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty"`
+	// Strictly positive if this entry's unbonding has been stopped by external modules
+	UnbondingOnHoldRefCount int64 `protobuf:"varint,6,opt,name=unbonding_on_hold_ref_count,json=unbondingOnHoldRefCount,proto3" json:"unbonding_on_hold_ref_count,omitempty"`
 }
 
-func (x *Validator) GetUnbondingHeight() int64 {
-	if x != nil {
-		return x.UnbondingHeight
+func (x *RedelegationEntry) Reset() {
+	*x = RedelegationEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Validator) GetUnbondingTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UnbondingTime
-	}
-	return nil
+func (x *RedelegationEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Validator) GetCommission() *Commission {
+func (*RedelegationEntry) ProtoMessage() {}
+
+// Deprecated: Use RedelegationEntry.ProtoReflect.Descriptor instead.
+func (*RedelegationEntry) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RedelegationEntry) GetCreationHeight() int64 {
 	if x != nil {
-		return x.Commission
+		return x.CreationHeight
 	}
-	return nil
+	return 0
 }
 
-func (x *Validator) GetVotingPowers() []*v1beta1.Coin {
+func (x *RedelegationEntry) GetCompletionTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.VotingPowers
+		return x.CompletionTime
 	}
 	return nil
 }
 
-func (x *Validator) GetVotingPower() string {
+func (x *RedelegationEntry) GetInitialBalance() []*v1beta1.Coin {
 	if x != nil {
-		return x.VotingPower
+		return x.InitialBalance
 	}
-	return ""
+	return nil
 }
 
-func (x *Validator) GetUnbondingOnHoldRefCount() int64 {
+func (x *RedelegationEntry) GetSharesDst() []*v1beta1.DecCoin {
 	if x != nil {
-		return x.UnbondingOnHoldRefCount
+		return x.SharesDst
 	}
-	return 0
+	return nil
 }
 
-func (x *Validator) GetUnbondingId() uint64 {
+func (x *RedelegationEntry) GetUnbondingId() uint64 {
 	if x != nil {
 		return x.UnbondingId
 	}
 	return 0
 }
 
-// ValAddresses defines a repeated set of validator addresses.
-type ValAddresses struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
-}
-
-func (x *ValAddresses) Reset() {
-	*x = ValAddresses{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ValAddresses) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ValAddresses) ProtoMessage() {}
-
-// Deprecated: Use ValAddresses.ProtoReflect.Descriptor instead.
-func (*ValAddresses) Descriptor() ([]byte, []int) {
-	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *ValAddresses) GetAddresses() []string {
+func (x *RedelegationEntry) GetUnbondingOnHoldRefCount() int64 {
 	if x != nil {
-		return x.Addresses
+		return x.UnbondingOnHoldRefCount
 	}
-	return nil
+	return 0
 }
 
-// DVPair is struct that just has a delegator-validator pair with no other data.
-// It is intended to be used as a marshalable pointer. For example, a DVPair can
-// be used to construct the key to getting an UnbondingDelegation from state.
-type DVPair struct {
+// Redelegation contains the list of a particular delegator's redelegating bonds
+// from a particular source validator to a particular destination validator.
+type Redelegation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// delegator_address is the bech32-encoded address of the delegator.
 	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
-	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// validator_src_address is the validator redelegation source operator address.
+	ValidatorSrcAddress string `protobuf:"bytes,2,opt,name=validator_src_address,json=validatorSrcAddress,proto3" json:"validator_src_address,omitempty"`
+	// validator_dst_address is the validator redelegation destination operator address.
+	ValidatorDstAddress string `protobuf:"bytes,3,opt,name=validator_dst_address,json=validatorDstAddress,proto3" json:"validator_dst_address,omitempty"`
+	// entries are the redelegation entries.
+	Entries []*RedelegationEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"` // redelegation entries
 }
 
-func (x *DVPair) Reset() {
-	*x = DVPair{}
+func (x *Redelegation) Reset() {
+	*x = Redelegation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[5]
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DVPair) String() string {
+func (x *Redelegation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DVPair) ProtoMessage() {}
+func (*Redelegation) ProtoMessage() {}
 
-// Deprecated: Use DVPair.ProtoReflect.Descriptor instead.
-func (*DVPair) Descriptor() ([]byte, []int) {
-	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Redelegation.ProtoReflect.Descriptor instead.
+func (*Redelegation) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *DVPair) GetDelegatorAddress() string {
+func (x *Redelegation) GetDelegatorAddress() string {
 	if x != nil {
 		return x.DelegatorAddress
 	}
 	return ""
 }
 
-func (x *DVPair) GetValidatorAddress() string {
+func (x *Redelegation) GetValidatorSrcAddress() string {
 	if x != nil {
-		return x.ValidatorAddress
+		return x.ValidatorSrcAddress
 	}
 	return ""
 }
 
-// DVPairs defines an array of DVPair objects.
-type DVPairs struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Pairs []*DVPair `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
-}
-
-func (x *DVPairs) Reset() {
-	*x = DVPairs{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Redelegation) GetValidatorDstAddress() string {
+	if x != nil {
+		return x.ValidatorDstAddress
 	}
+	return ""
 }
 
-func (x *DVPairs) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DVPairs) ProtoMessage() {}
-
-// Deprecated: Use DVPairs.ProtoReflect.Descriptor instead.
-func (*DVPairs) Descriptor() ([]byte, []int) {
-	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *DVPairs) GetPairs() []*DVPair {
+func (x *Redelegation) GetEntries() []*RedelegationEntry {
 	if x != nil {
-		return x.Pairs
+		return x.Entries
 	}
 	return nil
 }
 
-// DVVTriplet is struct that just has a delegator-validator-validator triplet
-// with no other data. It is intended to be used as a marshalable pointer. For
-// example, a DVVTriplet can be used to construct the key to getting a
-// Redelegation from state.
-type DVVTriplet struct {
+// Params defines the parameters for the staking module.
+type Params struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DelegatorAddress    string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
-	ValidatorSrcAddress string `protobuf:"bytes,2,opt,name=validator_src_address,json=validatorSrcAddress,proto3" json:"validator_src_address,omitempty"`
-	ValidatorDstAddress string `protobuf:"bytes,3,opt,name=validator_dst_address,json=validatorDstAddress,proto3" json:"validator_dst_address,omitempty"`
+	// unbonding_time is the time duration of unbonding.
+	UnbondingTime *durationpb.Duration `protobuf:"bytes,1,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
+	// max_validators is the maximum number of validators.
+	MaxValidators uint32 `protobuf:"varint,2,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty"`
+	// max_entries is the max entries for either unbonding delegation or redelegation (per pair/trio).
+	MaxEntries uint32 `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
+	// historical_entries is the number of historical entries to persist.
+	HistoricalEntries uint32 `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty"`
+	// bond_denoms defines the bondable coin denomination.
+	BondDenoms []string `protobuf:"bytes,5,rep,name=bond_denoms,json=bondDenoms,proto3" json:"bond_denoms,omitempty"`
+	// minimum voting power is the chain-wide minimum voting power to get into power update whitelist
+	MinVotingPower uint64 `protobuf:"varint,6,opt,name=min_voting_power,json=minVotingPower,proto3" json:"min_voting_power,omitempty"`
+	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
+	MinCommissionRate string `protobuf:"bytes,7,opt,name=min_commission_rate,json=minCommissionRate,proto3" json:"min_commission_rate,omitempty"`
+	// power_snapshot_interval is the number of blocks between voting power snapshots.
+	// zero disables the voting power snapshots.
+	PowerSnapshotInterval uint32 `protobuf:"varint,8,opt,name=power_snapshot_interval,json=powerSnapshotInterval,proto3" json:"power_snapshot_interval,omitempty"`
+	// power_snapshot_entries is the number of voting power snapshots to persist.
+	PowerSnapshotEntries uint32 `protobuf:"varint,9,opt,name=power_snapshot_entries,json=powerSnapshotEntries,proto3" json:"power_snapshot_entries,omitempty"`
+	// max_power_change_rate is the maximum fraction of the last total consensus power which
+	// can be changed in a single block by the power changes of the bonded validators. The
+	// remaining changes are carried over to the later blocks. zero disables the limit.
+	MaxPowerChangeRate string `protobuf:"bytes,10,opt,name=max_power_change_rate,json=maxPowerChangeRate,proto3" json:"max_power_change_rate,omitempty"`
+	// key_rotation_fee is the fee charged to the validator operator for a consensus key rotation.
+	KeyRotationFee *v1beta1.Coin `protobuf:"bytes,11,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee,omitempty"`
+	// max_cons_pubkey_rotations is the maximum number of consensus key rotations
+	// a validator can perform within an unbonding period.
+	MaxConsPubkeyRotations uint32 `protobuf:"varint,12,opt,name=max_cons_pubkey_rotations,json=maxConsPubkeyRotations,proto3" json:"max_cons_pubkey_rotations,omitempty"`
 }
 
-func (x *DVVTriplet) Reset() {
-	*x = DVVTriplet{}
+func (x *Params) Reset() {
+	*x = Params{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[7]
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DVVTriplet) String() string {
+func (x *Params) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DVVTriplet) ProtoMessage() {}
+func (*Params) ProtoMessage() {}
 
-// Deprecated: Use DVVTriplet.ProtoReflect.Descriptor instead.
-func (*DVVTriplet) Descriptor() ([]byte, []int) {
-	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *DVVTriplet) GetDelegatorAddress() string {
+func (x *Params) GetUnbondingTime() *durationpb.Duration {
 	if x != nil {
-		return x.DelegatorAddress
+		return x.UnbondingTime
 	}
-	return ""
+	return nil
 }
 
-func (x *DVVTriplet) GetValidatorSrcAddress() string {
+func (x *Params) GetMaxValidators() uint32 {
 	if x != nil {
-		return x.ValidatorSrcAddress
+		return x.MaxValidators
 	}
-	return ""
+	return 0
 }
 
-func (x *DVVTriplet) GetValidatorDstAddress() string {
+func (x *Params) GetMaxEntries() uint32 {
 	if x != nil {
-		return x.ValidatorDstAddress
+		return x.MaxEntries
 	}
-	return ""
+	return 0
 }
 
-// DVVTriplets defines an array of DVVTriplet objects.
-type DVVTriplets struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Params) GetHistoricalEntries() uint32 {
+	if x != nil {
+		return x.HistoricalEntries
+	}
+	return 0
+}
 
-	Triplets []*DVVTriplet `protobuf:"bytes,1,rep,name=triplets,proto3" json:"triplets,omitempty"`
+func (x *Params) GetBondDenoms() []string {
+	if x != nil {
+		return x.BondDenoms
+	}
+	return nil
 }
 
-func (x *DVVTriplets) Reset() {
-	*x = DVVTriplets{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Params) GetMinVotingPower() uint64 {
+	if x != nil {
+		return x.MinVotingPower
 	}
+	return 0
 }
 
-func (x *DVVTriplets) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Params) GetMinCommissionRate() string {
+	if x != nil {
+		return x.MinCommissionRate
+	}
+	return ""
 }
 
-func (*DVVTriplets) ProtoMessage() {}
+func (x *Params) GetPowerSnapshotInterval() uint32 {
+	if x != nil {
+		return x.PowerSnapshotInterval
+	}
+	return 0
+}
 
-// Deprecated: Use DVVTriplets.ProtoReflect.Descriptor instead.
-func (*DVVTriplets) Descriptor() ([]byte, []int) {
-	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{8}
+func (x *Params) GetPowerSnapshotEntries() uint32 {
+	if x != nil {
+		return x.PowerSnapshotEntries
+	}
+	return 0
 }
 
-func (x *DVVTriplets) GetTriplets() []*DVVTriplet {
+func (x *Params) GetMaxPowerChangeRate() string {
 	if x != nil {
-		return x.Triplets
+		return x.MaxPowerChangeRate
+	}
+	return ""
+}
+
+func (x *Params) GetKeyRotationFee() *v1beta1.Coin {
+	if x != nil {
+		return x.KeyRotationFee
 	}
 	return nil
 }
 
-// Delegation represents the bond with tokens held by an account. It is
-// owned by one delegator, and is associated with the voting power of one
-// validator.
-type Delegation struct {
+func (x *Params) GetMaxConsPubkeyRotations() uint32 {
+	if x != nil {
+		return x.MaxConsPubkeyRotations
+	}
+	return 0
+}
+
+// ConsPubKeyRotationHistory defines a consensus key rotation of a validator which
+// is not yet matured. The old consensus key remains slashable until the rotation
+// matures after an unbonding period.
+type ConsPubKeyRotationHistory struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// delegator_address is the bech32-encoded address of the delegator.
-	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
-	// validator_address is the bech32-encoded address of the validator.
-	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	// shares define the delegation shares received.
-	Shares []*v1beta1.DecCoin `protobuf:"bytes,3,rep,name=shares,proto3" json:"shares,omitempty"`
-}
-
-func (x *Delegation) Reset() {
-	*x = Delegation{}
+	// operator_address defines the address of the validator's operator; bech encoded in JSON.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// old_cons_pubkey is the consensus public key before the rotation.
+	OldConsPubkey *anypb.Any `protobuf:"bytes,2,opt,name=old_cons_pubkey,json=oldConsPubkey,proto3" json:"old_cons_pubkey,omitempty"`
+	// new_cons_pubkey is the consensus public key after the rotation.
+	NewConsPubkey *anypb.Any `protobuf:"bytes,3,opt,name=new_cons_pubkey,json=newConsPubkey,proto3" json:"new_cons_pubkey,omitempty"`
+	// height is the block height at which the rotation took place.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// completion_time is the time at which the rotation matures.
+	CompletionTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=completion_time,json=completionTime,proto3" json:"completion_time,omitempty"`
+	// fee is the rotation fee paid by the validator operator.
+	Fee *v1beta1.Coin `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee,omitempty"`
+}
+
+func (x *ConsPubKeyRotationHistory) Reset() {
+	*x = ConsPubKeyRotationHistory{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[9]
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Delegation) String() string {
+func (x *ConsPubKeyRotationHistory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Delegation) ProtoMessage() {}
+func (*ConsPubKeyRotationHistory) ProtoMessage() {}
 
-// Deprecated: Use Delegation.ProtoReflect.Descriptor instead.
-func (*Delegation) Descriptor() ([]byte, []int) {
-	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ConsPubKeyRotationHistory.ProtoReflect.Descriptor instead.
+func (*ConsPubKeyRotationHistory) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Delegation) GetDelegatorAddress() string {
+func (x *ConsPubKeyRotationHistory) GetOperatorAddress() string {
 	if x != nil {
-		return x.DelegatorAddress
+		return x.OperatorAddress
 	}
 	return ""
 }
 
-func (x *Delegation) GetValidatorAddress() string {
+func (x *ConsPubKeyRotationHistory) GetOldConsPubkey() *anypb.Any {
 	if x != nil {
-		return x.ValidatorAddress
+		return x.OldConsPubkey
 	}
-	return ""
+	return nil
 }
 
-func (x *Delegation) GetShares() []*v1beta1.DecCoin {
+func (x *ConsPubKeyRotationHistory) GetNewConsPubkey() *anypb.Any {
 	if x != nil {
-		return x.Shares
+		return x.NewConsPubkey
 	}
 	return nil
 }
 
-// UnbondingDelegation stores all of a single delegator's unbonding bonds
-// for a single validator in an time-ordered list.
-type UnbondingDelegation struct {
+func (x *ConsPubKeyRotationHistory) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ConsPubKeyRotationHistory) GetCompletionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletionTime
+	}
+	return nil
+}
+
+func (x *ConsPubKeyRotationHistory) GetFee() *v1beta1.Coin {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+// PendingPowerUpdate defines a consensus power change of a validator which is
+// not yet applied to the consensus engine due to the power change limit. The
+// validators pushed out of the bonded set target zero power, and the deferred
+// entering validators have zero applied power.
+type PendingPowerUpdate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// delegator_address is the bech32-encoded address of the delegator.
-	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
-	// validator_address is the bech32-encoded address of the validator.
-	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	// entries are the unbonding delegation entries.
-	Entries []*UnbondingDelegationEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"` // unbonding delegation entries
+	// operator_address defines the address of the validator's operator; bech encoded in JSON.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// power is the consensus power currently applied to the consensus engine.
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	// target_power is the consensus power computed from the validator voting power.
+	TargetPower int64 `protobuf:"varint,3,opt,name=target_power,json=targetPower,proto3" json:"target_power,omitempty"`
 }
 
-func (x *UnbondingDelegation) Reset() {
-	*x = UnbondingDelegation{}
+func (x *PendingPowerUpdate) Reset() {
+	*x = PendingPowerUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[10]
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnbondingDelegation) String() string {
+func (x *PendingPowerUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnbondingDelegation) ProtoMessage() {}
+func (*PendingPowerUpdate) ProtoMessage() {}
 
-// Deprecated: Use UnbondingDelegation.ProtoReflect.Descriptor instead.
-func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
-	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use PendingPowerUpdate.ProtoReflect.Descriptor instead.
+func (*PendingPowerUpdate) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *UnbondingDelegation) GetDelegatorAddress() string {
+func (x *PendingPowerUpdate) GetOperatorAddress() string {
 	if x != nil {
-		return x.DelegatorAddress
+		return x.OperatorAddress
 	}
 	return ""
 }
 
-func (x *UnbondingDelegation) GetValidatorAddress() string {
+func (x *PendingPowerUpdate) GetPower() int64 {
 	if x != nil {
-		return x.ValidatorAddress
+		return x.Power
 	}
-	return ""
+	return 0
 }
 
-func (x *UnbondingDelegation) GetEntries() []*UnbondingDelegationEntry {
+func (x *PendingPowerUpdate) GetTargetPower() int64 {
 	if x != nil {
-		return x.Entries
+		return x.TargetPower
 	}
-	return nil
+	return 0
 }
 
-// UnbondingDelegationEntry defines an unbonding object with relevant metadata.
-type UnbondingDelegationEntry struct {
+// ValidatorPower defines the token composition and voting power
+// of a validator at the time of a voting power snapshot.
+type ValidatorPower struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// creation_height is the height which the unbonding took place.
-	CreationHeight int64 `protobuf:"varint,1,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
-	// completion_time is the unix time for unbonding completion.
-	CompletionTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=completion_time,json=completionTime,proto3" json:"completion_time,omitempty"`
-	// initial_balance defines the tokens initially scheduled to receive at completion.
-	InitialBalance []*v1beta1.Coin `protobuf:"bytes,3,rep,name=initial_balance,json=initialBalance,proto3" json:"initial_balance,omitempty"`
-	// balance defines the tokens to receive at completion.
-	Balance []*v1beta1.Coin `protobuf:"bytes,4,rep,name=balance,proto3" json:"balance,omitempty"`
-	// Incrementing id that uniquely identifies this entry
-	UnbondingId uint64 `protobuf:"varint,5,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty"`
-	// Strictly positive if this entry's unbonding has been stopped by external modules
-	UnbondingOnHoldRefCount int64 `protobuf:"varint,6,opt,name=unbonding_on_hold_ref_count,json=unbondingOnHoldRefCount,proto3" json:"unbonding_on_hold_ref_count,omitempty"`
+	// operator_address defines the address of the validator's operator; bech encoded in JSON.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// tokens define the delegated tokens (incl. self-delegation).
+	Tokens []*v1beta1.Coin `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	// voting_powers defines the voting powers for each bond denoms
+	VotingPowers []*v1beta1.Coin `protobuf:"bytes,3,rep,name=voting_powers,json=votingPowers,proto3" json:"voting_powers,omitempty"`
+	// voting_power defines the sum of voting powers
+	VotingPower string `protobuf:"bytes,4,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
 }
 
-func (x *UnbondingDelegationEntry) Reset() {
-	*x = UnbondingDelegationEntry{}
+func (x *ValidatorPower) Reset() {
+	*x = ValidatorPower{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[11]
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnbondingDelegationEntry) String() string {
+func (x *ValidatorPower) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnbondingDelegationEntry) ProtoMessage() {}
-
-// Deprecated: Use UnbondingDelegationEntry.ProtoReflect.Descriptor instead.
-func (*UnbondingDelegationEntry) Descriptor() ([]byte, []int) {
-	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{11}
-}
+func (*ValidatorPower) ProtoMessage() {}
 
-func (x *UnbondingDelegationEntry) GetCreationHeight() int64 {
-	if x != nil {
-		return x.CreationHeight
-	}
-	return 0
+// Deprecated: Use ValidatorPower.ProtoReflect.Descriptor instead.
+func (*ValidatorPower) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UnbondingDelegationEntry) GetCompletionTime() *timestamppb.Timestamp {
+func (x *ValidatorPower) GetOperatorAddress() string {
 	if x != nil {
-		return x.CompletionTime
+		return x.OperatorAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *UnbondingDelegationEntry) GetInitialBalance() []*v1beta1.Coin {
+func (x *ValidatorPower) GetTokens() []*v1beta1.Coin {
 	if x != nil {
-		return x.InitialBalance
+		return x.Tokens
 	}
 	return nil
 }
 
-func (x *UnbondingDelegationEntry) GetBalance() []*v1beta1.Coin {
+func (x *ValidatorPower) GetVotingPowers() []*v1beta1.Coin {
 	if x != nil {
-		return x.Balance
+		return x.VotingPowers
 	}
 	return nil
 }
 
-func (x *UnbondingDelegationEntry) GetUnbondingId() uint64 {
-	if x != nil {
-		return x.UnbondingId
-	}
-	return 0
-}
-
-func (x *UnbondingDelegationEntry) GetUnbondingOnHoldRefCount() int64 {
+func (x *ValidatorPower) GetVotingPower() string {
 	if x != nil {
-		return x.UnbondingOnHoldRefCount
+		return x.VotingPower
 	}
-	return 0
+	return ""
 }
 
-// RedelegationEntry defines a redelegation object with relevant metadata.
-type RedelegationEntry struct {
+// PowerSnapshot defines the bonded validator set powers and the voting power
+// weights recorded at a snapshot height.
+type PowerSnapshot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// creation_height  defines the height which the redelegation took place.
-	CreationHeight int64 `protobuf:"varint,1,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
-	// completion_time defines the unix time for redelegation completion.
-	CompletionTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=completion_time,json=completionTime,proto3" json:"completion_time,omitempty"`
-	// initial_balance defines the initial balance when redelegation started.
-	InitialBalance []*v1beta1.Coin `protobuf:"bytes,3,rep,name=initial_balance,json=initialBalance,proto3" json:"initial_balance,omitempty"`
-	// shares_dst is the amount of destination-validator shares created by redelegation.
-	SharesDst []*v1beta1.DecCoin `protobuf:"bytes,4,rep,name=shares_dst,json=sharesDst,proto3" json:"shares_dst,omitempty"`
-	// Incrementing id that uniquely identifies this entry
-	UnbondingId uint64 `protobuf:"varint,5,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty"`
-	// Strictly positive if this entry's unbonding has been stopped by external modules
-	UnbondingOnHoldRefCount int64 `protobuf:"varint,6,opt,name=unbonding_on_hold_ref_count,json=unbondingOnHoldRefCount,proto3" json:"unbonding_on_hold_ref_count,omitempty"`
+	// time is the block time of the snapshot height.
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// voting_power_weights defines the voting power weights for each bond denoms.
+	VotingPowerWeights []*v1beta1.DecCoin `protobuf:"bytes,2,rep,name=voting_power_weights,json=votingPowerWeights,proto3" json:"voting_power_weights,omitempty"`
+	// validators defines the powers of the bonded validators.
+	Validators []*ValidatorPower `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators,omitempty"`
 }
 
-func (x *RedelegationEntry) Reset() {
-	*x = RedelegationEntry{}
+func (x *PowerSnapshot) Reset() {
+	*x = PowerSnapshot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[12]
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RedelegationEntry) String() string {
+func (x *PowerSnapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RedelegationEntry) ProtoMessage() {}
+func (*PowerSnapshot) ProtoMessage() {}
 
-// Deprecated: Use RedelegationEntry.ProtoReflect.Descriptor instead.
-func (*RedelegationEntry) Descriptor() ([]byte, []int) {
-	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use PowerSnapshot.ProtoReflect.Descriptor instead.
+func (*PowerSnapshot) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *RedelegationEntry) GetCreationHeight() int64 {
+func (x *PowerSnapshot) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreationHeight
+		return x.Time
 	}
-	return 0
+	return nil
 }
 
-func (x *RedelegationEntry) GetCompletionTime() *timestamppb.Timestamp {
+func (x *PowerSnapshot) GetVotingPowerWeights() []*v1beta1.DecCoin {
 	if x != nil {
-		return x.CompletionTime
+		return x.VotingPowerWeights
 	}
 	return nil
 }
 
-func (x *RedelegationEntry) GetInitialBalance() []*v1beta1.Coin {
+func (x *PowerSnapshot) GetValidators() []*ValidatorPower {
 	if x != nil {
-		return x.InitialBalance
+		return x.Validators
 	}
 	return nil
 }
 
-func (x *RedelegationEntry) GetSharesDst() []*v1beta1.DecCoin {
+// UnbondingQueueEntry defines an unbonding delegation entry scheduled
+// in the unbonding queue.
+type UnbondingQueueEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// delegator_address is the bech32-encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the bech32-encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// entry defines the unbonding delegation entry.
+	Entry *UnbondingDelegationEntry `protobuf:"bytes,3,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *UnbondingQueueEntry) Reset() {
+	*x = UnbondingQueueEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnbondingQueueEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbondingQueueEntry) ProtoMessage() {}
+
+// Deprecated: Use UnbondingQueueEntry.ProtoReflect.Descriptor instead.
+func (*UnbondingQueueEntry) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UnbondingQueueEntry) GetDelegatorAddress() string {
 	if x != nil {
-		return x.SharesDst
+		return x.DelegatorAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *RedelegationEntry) GetUnbondingId() uint64 {
+func (x *UnbondingQueueEntry) GetValidatorAddress() string {
 	if x != nil {
-		return x.UnbondingId
+		return x.ValidatorAddress
 	}
-	return 0
+	return ""
 }
 
-func (x *RedelegationEntry) GetUnbondingOnHoldRefCount() int64 {
+func (x *UnbondingQueueEntry) GetEntry() *UnbondingDelegationEntry {
 	if x != nil {
-		return x.UnbondingOnHoldRefCount
+		return x.Entry
 	}
-	return 0
+	return nil
 }
 
-// Redelegation contains the list of a particular delegator's redelegating bonds
-// from a particular source validator to a particular destination validator.
-type Redelegation struct {
+// RedelegationQueueEntry defines a redelegation entry scheduled
+// in the redelegation queue.
+type RedelegationQueueEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -13618,335 +20935,494 @@ type Redelegation struct {
 	ValidatorSrcAddress string `protobuf:"bytes,2,opt,name=validator_src_address,json=validatorSrcAddress,proto3" json:"validator_src_address,omitempty"`
 	// validator_dst_address is the validator redelegation destination operator address.
 	ValidatorDstAddress string `protobuf:"bytes,3,opt,name=validator_dst_address,json=validatorDstAddress,proto3" json:"validator_dst_address,omitempty"`
-	// entries are the redelegation entries.
-	Entries []*RedelegationEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"` // redelegation entries
+	// entry defines the redelegation entry.
+	Entry *RedelegationEntry `protobuf:"bytes,4,opt,name=entry,proto3" json:"entry,omitempty"`
 }
 
-func (x *Redelegation) Reset() {
-	*x = Redelegation{}
+func (x *RedelegationQueueEntry) Reset() {
+	*x = RedelegationQueueEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[13]
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Redelegation) String() string {
+func (x *RedelegationQueueEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Redelegation) ProtoMessage() {}
+func (*RedelegationQueueEntry) ProtoMessage() {}
 
-// Deprecated: Use Redelegation.ProtoReflect.Descriptor instead.
-func (*Redelegation) Descriptor() ([]byte, []int) {
-	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use RedelegationQueueEntry.ProtoReflect.Descriptor instead.
+func (*RedelegationQueueEntry) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *Redelegation) GetDelegatorAddress() string {
+func (x *RedelegationQueueEntry) GetDelegatorAddress() string {
 	if x != nil {
 		return x.DelegatorAddress
 	}
 	return ""
 }
 
-func (x *Redelegation) GetValidatorSrcAddress() string {
+func (x *RedelegationQueueEntry) GetValidatorSrcAddress() string {
 	if x != nil {
 		return x.ValidatorSrcAddress
 	}
 	return ""
 }
 
-func (x *Redelegation) GetValidatorDstAddress() string {
+func (x *RedelegationQueueEntry) GetValidatorDstAddress() string {
 	if x != nil {
 		return x.ValidatorDstAddress
 	}
 	return ""
 }
 
-func (x *Redelegation) GetEntries() []*RedelegationEntry {
+func (x *RedelegationQueueEntry) GetEntry() *RedelegationEntry {
 	if x != nil {
-		return x.Entries
+		return x.Entry
 	}
 	return nil
 }
 
-// Params defines the parameters for the staking module.
-type Params struct {
+// UnbondingMaturity defines the amount of tokens unlocked at a completion time.
+type UnbondingMaturity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// unbonding_time is the time duration of unbonding.
-	UnbondingTime *durationpb.Duration `protobuf:"bytes,1,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
-	// max_validators is the maximum number of validators.
-	MaxValidators uint32 `protobuf:"varint,2,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty"`
-	// max_entries is the max entries for either unbonding delegation or redelegation (per pair/trio).
-	MaxEntries uint32 `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
-	// historical_entries is the number of historical entries to persist.
-	HistoricalEntries uint32 `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty"`
-	// bond_denoms defines the bondable coin denomination.
-	BondDenoms []string `protobuf:"bytes,5,rep,name=bond_denoms,json=bondDenoms,proto3" json:"bond_denoms,omitempty"`
-	// minimum voting power is the chain-wide minimum voting power to get into power update whitelist
-	MinVotingPower uint64 `protobuf:"varint,6,opt,name=min_voting_power,json=minVotingPower,proto3" json:"min_voting_power,omitempty"`
-	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
-	MinCommissionRate string `protobuf:"bytes,7,opt,name=min_commission_rate,json=minCommissionRate,proto3" json:"min_commission_rate,omitempty"`
+	// completion_time defines the unix time for unbonding completion.
+	CompletionTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3" json:"completion_time,omitempty"`
+	// amount defines the tokens to receive at completion.
+	Amount []*v1beta1.Coin `protobuf:"bytes,2,rep,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
+func (x *UnbondingMaturity) Reset() {
+	*x = UnbondingMaturity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[14]
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Params) String() string {
+func (x *UnbondingMaturity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Params) ProtoMessage() {}
+func (*UnbondingMaturity) ProtoMessage() {}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use UnbondingMaturity.ProtoReflect.Descriptor instead.
+func (*UnbondingMaturity) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *Params) GetUnbondingTime() *durationpb.Duration {
+func (x *UnbondingMaturity) GetCompletionTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UnbondingTime
+		return x.CompletionTime
 	}
 	return nil
 }
 
-func (x *Params) GetMaxValidators() uint32 {
+func (x *UnbondingMaturity) GetAmount() []*v1beta1.Coin {
 	if x != nil {
-		return x.MaxValidators
+		return x.Amount
 	}
-	return 0
+	return nil
 }
 
-func (x *Params) GetMaxEntries() uint32 {
+// DelegationResponse is equivalent to Delegation except that it contains a
+// balance in addition to shares which is more suitable for client responses.
+type DelegationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delegation *Delegation     `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation,omitempty"`
+	Balance    []*v1beta1.Coin `protobuf:"bytes,2,rep,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *DelegationResponse) Reset() {
+	*x = DelegationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelegationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegationResponse) ProtoMessage() {}
+
+// Deprecated: Use DelegationResponse.ProtoReflect.Descriptor instead.
+func (*DelegationResponse) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DelegationResponse) GetDelegation() *Delegation {
 	if x != nil {
-		return x.MaxEntries
+		return x.Delegation
 	}
-	return 0
+	return nil
 }
 
-func (x *Params) GetHistoricalEntries() uint32 {
+func (x *DelegationResponse) GetBalance() []*v1beta1.Coin {
 	if x != nil {
-		return x.HistoricalEntries
+		return x.Balance
 	}
-	return 0
+	return nil
 }
 
-func (x *Params) GetBondDenoms() []string {
+// RedelegationEntryResponse is equivalent to a RedelegationEntry except that it
+// contains a balance in addition to shares which is more suitable for client
+// responses.
+type RedelegationEntryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RedelegationEntry *RedelegationEntry `protobuf:"bytes,1,opt,name=redelegation_entry,json=redelegationEntry,proto3" json:"redelegation_entry,omitempty"`
+	Balance           []*v1beta1.Coin    `protobuf:"bytes,2,rep,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *RedelegationEntryResponse) Reset() {
+	*x = RedelegationEntryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedelegationEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedelegationEntryResponse) ProtoMessage() {}
+
+// Deprecated: Use RedelegationEntryResponse.ProtoReflect.Descriptor instead.
+func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RedelegationEntryResponse) GetRedelegationEntry() *RedelegationEntry {
 	if x != nil {
-		return x.BondDenoms
+		return x.RedelegationEntry
 	}
 	return nil
 }
 
-func (x *Params) GetMinVotingPower() uint64 {
+func (x *RedelegationEntryResponse) GetBalance() []*v1beta1.Coin {
 	if x != nil {
-		return x.MinVotingPower
+		return x.Balance
 	}
-	return 0
+	return nil
 }
 
-func (x *Params) GetMinCommissionRate() string {
+// RedelegationResponse is equivalent to a Redelegation except that its entries
+// contain a balance in addition to shares which is more suitable for client
+// responses.
+type RedelegationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Redelegation *Redelegation                `protobuf:"bytes,1,opt,name=redelegation,proto3" json:"redelegation,omitempty"`
+	Entries      []*RedelegationEntryResponse `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *RedelegationResponse) Reset() {
+	*x = RedelegationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedelegationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedelegationResponse) ProtoMessage() {}
+
+// Deprecated: Use RedelegationResponse.ProtoReflect.Descriptor instead.
+func (*RedelegationResponse) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RedelegationResponse) GetRedelegation() *Redelegation {
 	if x != nil {
-		return x.MinCommissionRate
+		return x.Redelegation
 	}
-	return ""
+	return nil
 }
 
-// DelegationResponse is equivalent to Delegation except that it contains a
-// balance in addition to shares which is more suitable for client responses.
-type DelegationResponse struct {
+func (x *RedelegationResponse) GetEntries() []*RedelegationEntryResponse {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Pool is used for tracking bonded and not-bonded token supply of the bond
+// denomination.
+type Pool struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Delegation *Delegation     `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation,omitempty"`
-	Balance    []*v1beta1.Coin `protobuf:"bytes,2,rep,name=balance,proto3" json:"balance,omitempty"`
+	NotBondedTokens    []*v1beta1.Coin    `protobuf:"bytes,1,rep,name=not_bonded_tokens,json=notBondedTokens,proto3" json:"not_bonded_tokens,omitempty"`
+	BondedTokens       []*v1beta1.Coin    `protobuf:"bytes,2,rep,name=bonded_tokens,json=bondedTokens,proto3" json:"bonded_tokens,omitempty"`
+	VotingPowerWeights []*v1beta1.DecCoin `protobuf:"bytes,3,rep,name=voting_power_weights,json=votingPowerWeights,proto3" json:"voting_power_weights,omitempty"`
 }
 
-func (x *DelegationResponse) Reset() {
-	*x = DelegationResponse{}
+func (x *Pool) Reset() {
+	*x = Pool{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[15]
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DelegationResponse) String() string {
+func (x *Pool) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelegationResponse) ProtoMessage() {}
+func (*Pool) ProtoMessage() {}
 
-// Deprecated: Use DelegationResponse.ProtoReflect.Descriptor instead.
-func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use Pool.ProtoReflect.Descriptor instead.
+func (*Pool) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *DelegationResponse) GetDelegation() *Delegation {
+func (x *Pool) GetNotBondedTokens() []*v1beta1.Coin {
 	if x != nil {
-		return x.Delegation
+		return x.NotBondedTokens
 	}
 	return nil
 }
 
-func (x *DelegationResponse) GetBalance() []*v1beta1.Coin {
+func (x *Pool) GetBondedTokens() []*v1beta1.Coin {
+	if x != nil {
+		return x.BondedTokens
+	}
+	return nil
+}
+
+func (x *Pool) GetVotingPowerWeights() []*v1beta1.DecCoin {
 	if x != nil {
-		return x.Balance
+		return x.VotingPowerWeights
 	}
 	return nil
 }
 
-// RedelegationEntryResponse is equivalent to a RedelegationEntry except that it
-// contains a balance in addition to shares which is more suitable for client
-// responses.
-type RedelegationEntryResponse struct {
+// SlashEvent defines a slash of a validator.
+type SlashEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RedelegationEntry *RedelegationEntry `protobuf:"bytes,1,opt,name=redelegation_entry,json=redelegationEntry,proto3" json:"redelegation_entry,omitempty"`
-	Balance           []*v1beta1.Coin    `protobuf:"bytes,2,rep,name=balance,proto3" json:"balance,omitempty"`
-}
-
-func (x *RedelegationEntryResponse) Reset() {
-	*x = RedelegationEntryResponse{}
+	// id is the unique id of the slash event.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// validator_address is the operator address of the slashed validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// infraction_height is the height at which the infraction was committed.
+	InfractionHeight int64 `protobuf:"varint,3,opt,name=infraction_height,json=infractionHeight,proto3" json:"infraction_height,omitempty"`
+	// height is the height at which the validator was slashed.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the validator was slashed.
+	Time *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
+	// slash_factor is the fraction of the stake slashed.
+	SlashFactor string `protobuf:"bytes,6,opt,name=slash_factor,json=slashFactor,proto3" json:"slash_factor,omitempty"`
+	// burned defines the tokens burned by the slash, from the validator, the unbonding
+	// delegations and the redelegations.
+	Burned []*v1beta1.Coin `protobuf:"bytes,7,rep,name=burned,proto3" json:"burned,omitempty"`
+	// truncated is true if the delegator impacts were dropped by the max number of
+	// impacts per slash event.
+	Truncated bool `protobuf:"varint,8,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (x *SlashEvent) Reset() {
+	*x = SlashEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[16]
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RedelegationEntryResponse) String() string {
+func (x *SlashEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RedelegationEntryResponse) ProtoMessage() {}
+func (*SlashEvent) ProtoMessage() {}
 
-// Deprecated: Use RedelegationEntryResponse.ProtoReflect.Descriptor instead.
-func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SlashEvent.ProtoReflect.Descriptor instead.
+func (*SlashEvent) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *RedelegationEntryResponse) GetRedelegationEntry() *RedelegationEntry {
+func (x *SlashEvent) GetId() uint64 {
 	if x != nil {
-		return x.RedelegationEntry
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *RedelegationEntryResponse) GetBalance() []*v1beta1.Coin {
+func (x *SlashEvent) GetValidatorAddress() string {
 	if x != nil {
-		return x.Balance
+		return x.ValidatorAddress
 	}
-	return nil
+	return ""
 }
 
-// RedelegationResponse is equivalent to a Redelegation except that its entries
-// contain a balance in addition to shares which is more suitable for client
-// responses.
-type RedelegationResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Redelegation *Redelegation                `protobuf:"bytes,1,opt,name=redelegation,proto3" json:"redelegation,omitempty"`
-	Entries      []*RedelegationEntryResponse `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+func (x *SlashEvent) GetInfractionHeight() int64 {
+	if x != nil {
+		return x.InfractionHeight
+	}
+	return 0
 }
 
-func (x *RedelegationResponse) Reset() {
-	*x = RedelegationResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SlashEvent) GetHeight() int64 {
+	if x != nil {
+		return x.Height
 	}
+	return 0
 }
 
-func (x *RedelegationResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SlashEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
 }
 
-func (*RedelegationResponse) ProtoMessage() {}
-
-// Deprecated: Use RedelegationResponse.ProtoReflect.Descriptor instead.
-func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{17}
+func (x *SlashEvent) GetSlashFactor() string {
+	if x != nil {
+		return x.SlashFactor
+	}
+	return ""
 }
 
-func (x *RedelegationResponse) GetRedelegation() *Redelegation {
+func (x *SlashEvent) GetBurned() []*v1beta1.Coin {
 	if x != nil {
-		return x.Redelegation
+		return x.Burned
 	}
 	return nil
 }
 
-func (x *RedelegationResponse) GetEntries() []*RedelegationEntryResponse {
+func (x *SlashEvent) GetTruncated() bool {
 	if x != nil {
-		return x.Entries
+		return x.Truncated
 	}
-	return nil
+	return false
 }
 
-// Pool is used for tracking bonded and not-bonded token supply of the bond
-// denomination.
-type Pool struct {
+// DelegatorSlashImpact defines the tokens a delegator lost by a slash event.
+type DelegatorSlashImpact struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NotBondedTokens    []*v1beta1.Coin    `protobuf:"bytes,1,rep,name=not_bonded_tokens,json=notBondedTokens,proto3" json:"not_bonded_tokens,omitempty"`
-	BondedTokens       []*v1beta1.Coin    `protobuf:"bytes,2,rep,name=bonded_tokens,json=bondedTokens,proto3" json:"bonded_tokens,omitempty"`
-	VotingPowerWeights []*v1beta1.DecCoin `protobuf:"bytes,3,rep,name=voting_power_weights,json=votingPowerWeights,proto3" json:"voting_power_weights,omitempty"`
-}
-
-func (x *Pool) Reset() {
-	*x = Pool{}
+	// slash_event_id is the id of the slash event.
+	SlashEventId uint64 `protobuf:"varint,1,opt,name=slash_event_id,json=slashEventId,proto3" json:"slash_event_id,omitempty"`
+	// delegator_address is the bech32-encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the operator address of the slashed validator.
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// height is the height at which the validator was slashed.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the validator was slashed.
+	Time *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
+	// delegation defines the tokens lost from the delegation to the slashed validator.
+	Delegation []*v1beta1.Coin `protobuf:"bytes,6,rep,name=delegation,proto3" json:"delegation,omitempty"`
+	// unbonding defines the tokens burned from the unbonding delegations from the
+	// slashed validator.
+	Unbonding []*v1beta1.Coin `protobuf:"bytes,7,rep,name=unbonding,proto3" json:"unbonding,omitempty"`
+	// redelegation defines the tokens burned from the redelegations from the
+	// slashed validator.
+	Redelegation []*v1beta1.Coin `protobuf:"bytes,8,rep,name=redelegation,proto3" json:"redelegation,omitempty"`
+}
+
+func (x *DelegatorSlashImpact) Reset() {
+	*x = DelegatorSlashImpact{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[18]
+		mi := &file_initia_mstaking_v1_staking_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Pool) String() string {
+func (x *DelegatorSlashImpact) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pool) ProtoMessage() {}
+func (*DelegatorSlashImpact) ProtoMessage() {}
 
-// Deprecated: Use Pool.ProtoReflect.Descriptor instead.
-func (*Pool) Descriptor() ([]byte, []int) {
-	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DelegatorSlashImpact.ProtoReflect.Descriptor instead.
+func (*DelegatorSlashImpact) Descriptor() ([]byte, []int) {
+	return file_initia_mstaking_v1_staking_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *Pool) GetNotBondedTokens() []*v1beta1.Coin {
+func (x *DelegatorSlashImpact) GetSlashEventId() uint64 {
 	if x != nil {
-		return x.NotBondedTokens
+		return x.SlashEventId
+	}
+	return 0
+}
+
+func (x *DelegatorSlashImpact) GetDelegatorAddress() string {
+	if x != nil {
+		return x.DelegatorAddress
+	}
+	return ""
+}
+
+func (x *DelegatorSlashImpact) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+func (x *DelegatorSlashImpact) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *DelegatorSlashImpact) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
 	}
 	return nil
 }
 
-func (x *Pool) GetBondedTokens() []*v1beta1.Coin {
+func (x *DelegatorSlashImpact) GetDelegation() []*v1beta1.Coin {
 	if x != nil {
-		return x.BondedTokens
+		return x.Delegation
 	}
 	return nil
 }
 
-func (x *Pool) GetVotingPowerWeights() []*v1beta1.DecCoin {
+func (x *DelegatorSlashImpact) GetUnbonding() []*v1beta1.Coin {
 	if x != nil {
-		return x.VotingPowerWeights
+		return x.Unbonding
+	}
+	return nil
+}
+
+func (x *DelegatorSlashImpact) GetRedelegation() []*v1beta1.Coin {
+	if x != nil {
+		return x.Redelegation
 	}
 	return nil
 }
@@ -14257,7 +21733,7 @@ var file_initia_mstaking_v1_staking_proto_rawDesc = []byte{
 	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x6c,
 	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde,
 	0x1f, 0x00, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x0c, 0x88, 0xa0, 0x1f,
-	0x00, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xb3, 0x04, 0x0a, 0x06, 0x50, 0x61,
+	0x00, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xa7, 0x08, 0x0a, 0x06, 0x50, 0x61,
 	0x72, 0x61, 0x6d, 0x73, 0x12, 0x63, 0x0a, 0x0e, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e,
 	0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
@@ -14292,110 +21768,354 @@ var file_initia_mstaking_v1_staking_proto_rawDesc = []byte{
 	0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x69,
 	0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74,
 	0x65, 0x22, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x61, 0x74, 0x65, 0x3a, 0x08, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
-	0xcb, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x2e, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
-	0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x65, 0x0a, 0x07,
-	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
+	0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x5a, 0x0a, 0x17, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x22, 0xf2, 0xde, 0x1f, 0x1e, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x52, 0x15, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x57, 0x0a, 0x16, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x21, 0xf2, 0xde, 0x1f, 0x1d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0x52, 0x14, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x76, 0x0a, 0x15, 0x6d, 0x61,
+	0x78, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x43, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde,
+	0x1f, 0x1c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0x52, 0x12,
+	0x6d, 0x61, 0x78, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x64, 0x0a, 0x10, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x1f, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f,
+	0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x22, 0x52, 0x0e, 0x6b, 0x65, 0x79, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x5f, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f,
+	0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x24, 0xf2, 0xde, 0x1f,
+	0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x5f,
+	0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x08, 0x98, 0xa0, 0x1f, 0x00, 0xe8,
+	0xa0, 0x1f, 0x01, 0x22, 0x85, 0x04, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x73, 0x50, 0x75, 0x62, 0x4b,
+	0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x46, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xf2, 0xde, 0x1f,
+	0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x70, 0x0a, 0x0f, 0x6f, 0x6c, 0x64,
+	0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x32, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x6f, 0x6c, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x22, 0xca, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x0d, 0x6f, 0x6c,
+	0x64, 0x43, 0x6f, 0x6e, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x70, 0x0a, 0x0f, 0x6e,
+	0x65, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x32, 0xf2, 0xde, 0x1f, 0x16,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x70,
+	0x75, 0x62, 0x6b, 0x65, 0x79, 0x22, 0xca, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x0d,
+	0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x67, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00,
+	0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x31,
+	0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x03, 0x66, 0x65,
+	0x65, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xae, 0x01, 0x0a, 0x12,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x46, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xf2, 0xde,
+	0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x12, 0x3a, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x52,
+	0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x22, 0xb2, 0x03, 0x0a,
+	0x0e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12,
+	0x46, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xf2, 0xde, 0x1f, 0x17, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x74, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x88, 0x01,
+	0x0a, 0x0d, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x48, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x22, 0xaa, 0xdf,
 	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x3a, 0x08, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xe4, 0x01,
-	0x0a, 0x19, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x12, 0x72,
-	0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x74, 0x72,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x2e, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x64,
-	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04,
-	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x65, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0c, 0x76, 0x6f, 0x74, 0x69,
+	0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x57, 0x0a, 0x0c, 0x76, 0x6f, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x34,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f,
+	0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x22, 0x52, 0x0b, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x22, 0xb8, 0x02, 0x0a, 0x0d, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8,
+	0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0xa2, 0x01,
+	0x0a, 0x14, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x52, 0xc8, 0xde, 0x1f, 0x00,
+	0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22, 0xaa,
+	0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x12,
+	0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x12, 0x48, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e,
+	0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0xf5, 0x01, 0x0a,
+	0x13, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x49, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1c, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x10, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x49, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xf2, 0xde, 0x1f, 0x18,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x48, 0x0a, 0x05, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x2e, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x22, 0xd2, 0x02, 0x0a, 0x16, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x49, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xf2, 0xde, 0x1f, 0x18,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x54, 0x0a, 0x15, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0xf2, 0xde, 0x1f, 0x1c, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73,
+	0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x13, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x54, 0x0a, 0x15, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x73,
+	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x20, 0xf2, 0xde, 0x1f, 0x1c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x52, 0x13, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x73, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x41, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d,
+	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xe1, 0x01, 0x0a, 0x11, 0x55, 0x6e,
+	0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x67, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x22, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x63, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
 	0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
 	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x3a, 0x04,
-	0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb7, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a,
-	0x0c, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x73, 0x74,
-	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x64,
-	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x07, 0x65, 0x6e, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x2e, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xcb, 0x01,
+	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x2e, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x65, 0x0a, 0x07, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x3a, 0x08, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xe4, 0x01, 0x0a, 0x19,
 	0x52, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xfc,
-	0x03, 0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0xa8, 0x01, 0x0a, 0x11, 0x6e, 0x6f, 0x74, 0x5f,
-	0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x61,
-	0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x11, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x6f, 0x6e, 0x64,
-	0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x12, 0x72, 0x65, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d,
+	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x11, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x65, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x3a, 0x04, 0xe8, 0xa0,
+	0x1f, 0x01, 0x22, 0xb7, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x72,
+	0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x73, 0x74, 0x61, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x2e, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xfc, 0x03, 0x0a,
+	0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0xa8, 0x01, 0x0a, 0x11, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x6f,
+	0x6e, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x61, 0xc8, 0xde,
+	0x1f, 0x00, 0xea, 0xde, 0x1f, 0x11, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52,
+	0x0f, 0x6e, 0x6f, 0x74, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x12, 0x99, 0x01, 0x0a, 0x0d, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x59, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0d, 0x62, 0x6f, 0x6e,
+	0x64, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0c,
+	0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0xa2, 0x01, 0x0a,
+	0x14, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x52, 0xc8, 0xde, 0x1f, 0x00, 0xf2,
+	0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22, 0xaa, 0xdf,
+	0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x12, 0x76,
+	0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x3a, 0x08, 0xe8, 0xa0, 0x1f, 0x01, 0xf0, 0xa0, 0x1f, 0x01, 0x22, 0xe6, 0x03, 0x0a, 0x0a,
+	0x53, 0x6c, 0x61, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x49, 0x0a, 0x11, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x49, 0x0a, 0x11, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x1c, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x69, 0x6e, 0x66, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x52, 0x10,
+	0x69, 0x6e, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x5d, 0x0a, 0x0c, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3a, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f,
+	0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x22, 0x52, 0x0b, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x63, 0x0a, 0x06, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x06,
+	0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x22, 0x88, 0x05, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x12, 0x3f, 0x0a,
+	0x0e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x19, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22,
+	0x52, 0x0c, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x49,
+	0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xf2, 0xde, 0x1f, 0x18, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x49, 0x0a, 0x11, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x38, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x6b, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x69, 0x0a, 0x09, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0x52, 0x09, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x6f,
+	0x0a, 0x0c, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
 	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x73, 0x52, 0x0f, 0x6e, 0x6f, 0x74, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x73, 0x12, 0x99, 0x01, 0x0a, 0x0d, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x59, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0d, 0x62,
-	0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0xf2, 0xde, 0x1f, 0x14,
-	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73,
-	0x52, 0x0c, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0xa2,
-	0x01, 0x0a, 0x14, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f,
-	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x52, 0xc8, 0xde, 0x1f,
-	0x00, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x69, 0x6e,
-	0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22,
-	0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52,
-	0x12, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x57, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x73, 0x3a, 0x08, 0xe8, 0xa0, 0x1f, 0x01, 0xf0, 0xa0, 0x1f, 0x01, 0x2a, 0xb6, 0x01,
-	0x0a, 0x0a, 0x42, 0x6f, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x17,
-	0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x0f, 0x8a, 0x9d, 0x20, 0x0b, 0x55,
-	0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x14, 0x42, 0x4f,
-	0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x42, 0x4f, 0x4e, 0x44,
-	0x45, 0x44, 0x10, 0x01, 0x1a, 0x0c, 0x8a, 0x9d, 0x20, 0x08, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64,
-	0x65, 0x64, 0x12, 0x28, 0x0a, 0x15, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x55, 0x4e, 0x42, 0x4f, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x1a, 0x0d, 0x8a,
-	0x9d, 0x20, 0x09, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a, 0x12,
-	0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x42, 0x4f, 0x4e, 0x44,
-	0x45, 0x44, 0x10, 0x03, 0x1a, 0x0a, 0x8a, 0x9d, 0x20, 0x06, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64,
-	0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x5d, 0x0a, 0x0a, 0x49, 0x6e, 0x66, 0x72, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x46, 0x52, 0x41, 0x43, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x46, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44,
-	0x4f, 0x55, 0x42, 0x4c, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13,
-	0x49, 0x4e, 0x46, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x54,
-	0x49, 0x4d, 0x45, 0x10, 0x02, 0x42, 0xd1, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
-	0x42, 0x0c, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x73, 0x74, 0x61, 0x6b,
-	0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x76,
-	0x31, 0xa2, 0x02, 0x03, 0x49, 0x4d, 0x58, 0xaa, 0x02, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x2e, 0x4d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12, 0x49,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x4d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56,
-	0x31, 0xe2, 0x02, 0x1e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x4d, 0x73, 0x74, 0x61, 0x6b,
-	0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x3a, 0x3a, 0x4d, 0x73, 0x74,
-	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x73, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2a,
+	0xb6, 0x01, 0x0a, 0x0a, 0x42, 0x6f, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c,
+	0x0a, 0x17, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x0f, 0x8a, 0x9d, 0x20,
+	0x0b, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x14,
+	0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x42, 0x4f,
+	0x4e, 0x44, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x0c, 0x8a, 0x9d, 0x20, 0x08, 0x55, 0x6e, 0x62, 0x6f,
+	0x6e, 0x64, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x15, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x42, 0x4f, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x1a,
+	0x0d, 0x8a, 0x9d, 0x20, 0x09, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x22,
+	0x0a, 0x12, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x42, 0x4f,
+	0x4e, 0x44, 0x45, 0x44, 0x10, 0x03, 0x1a, 0x0a, 0x8a, 0x9d, 0x20, 0x06, 0x42, 0x6f, 0x6e, 0x64,
+	0x65, 0x64, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x5d, 0x0a, 0x0a, 0x49, 0x6e, 0x66, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x46, 0x52, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x46, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x44, 0x4f, 0x55, 0x42, 0x4c, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x10, 0x01, 0x12, 0x17,
+	0x0a, 0x13, 0x49, 0x4e, 0x46, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x4f, 0x57,
+	0x4e, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x02, 0x42, 0xd1, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x42, 0x0c, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x73, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x4d, 0x58, 0xaa, 0x02, 0x12, 0x49, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x2e, 0x4d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x4d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x4d, 0x73, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x3a, 0x3a, 0x4d,
+	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -14411,7 +22131,7 @@ func file_initia_mstaking_v1_staking_proto_rawDescGZIP() []byte {
 }
 
 var file_initia_mstaking_v1_staking_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_initia_mstaking_v1_staking_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_initia_mstaking_v1_staking_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_initia_mstaking_v1_staking_proto_goTypes = []interface{}{
 	(BondStatus)(0),                   // 0: initia.mstaking.v1.BondStatus
 	(Infraction)(0),                   // 1: initia.mstaking.v1.Infraction
@@ -14430,53 +22150,82 @@ var file_initia_mstaking_v1_staking_proto_goTypes = []interface{}{
 	(*RedelegationEntry)(nil),         // 14: initia.mstaking.v1.RedelegationEntry
 	(*Redelegation)(nil),              // 15: initia.mstaking.v1.Redelegation
 	(*Params)(nil),                    // 16: initia.mstaking.v1.Params
-	(*DelegationResponse)(nil),        // 17: initia.mstaking.v1.DelegationResponse
-	(*RedelegationEntryResponse)(nil), // 18: initia.mstaking.v1.RedelegationEntryResponse
-	(*RedelegationResponse)(nil),      // 19: initia.mstaking.v1.RedelegationResponse
-	(*Pool)(nil),                      // 20: initia.mstaking.v1.Pool
-	(*timestamppb.Timestamp)(nil),     // 21: google.protobuf.Timestamp
-	(*anypb.Any)(nil),                 // 22: google.protobuf.Any
-	(*v1beta1.Coin)(nil),              // 23: cosmos.base.v1beta1.Coin
-	(*v1beta1.DecCoin)(nil),           // 24: cosmos.base.v1beta1.DecCoin
-	(*durationpb.Duration)(nil),       // 25: google.protobuf.Duration
+	(*ConsPubKeyRotationHistory)(nil), // 17: initia.mstaking.v1.ConsPubKeyRotationHistory
+	(*PendingPowerUpdate)(nil),        // 18: initia.mstaking.v1.PendingPowerUpdate
+	(*ValidatorPower)(nil),            // 19: initia.mstaking.v1.ValidatorPower
+	(*PowerSnapshot)(nil),             // 20: initia.mstaking.v1.PowerSnapshot
+	(*UnbondingQueueEntry)(nil),       // 21: initia.mstaking.v1.UnbondingQueueEntry
+	(*RedelegationQueueEntry)(nil),    // 22: initia.mstaking.v1.RedelegationQueueEntry
+	(*UnbondingMaturity)(nil),         // 23: initia.mstaking.v1.UnbondingMaturity
+	(*DelegationResponse)(nil),        // 24: initia.mstaking.v1.DelegationResponse
+	(*RedelegationEntryResponse)(nil), // 25: initia.mstaking.v1.RedelegationEntryResponse
+	(*RedelegationResponse)(nil),      // 26: initia.mstaking.v1.RedelegationResponse
+	(*Pool)(nil),                      // 27: initia.mstaking.v1.Pool
+	(*SlashEvent)(nil),                // 28: initia.mstaking.v1.SlashEvent
+	(*DelegatorSlashImpact)(nil),      // 29: initia.mstaking.v1.DelegatorSlashImpact
+	(*timestamppb.Timestamp)(nil),     // 30: google.protobuf.Timestamp
+	(*anypb.Any)(nil),                 // 31: google.protobuf.Any
+	(*v1beta1.Coin)(nil),              // 32: cosmos.base.v1beta1.Coin
+	(*v1beta1.DecCoin)(nil),           // 33: cosmos.base.v1beta1.DecCoin
+	(*durationpb.Duration)(nil),       // 34: google.protobuf.Duration
 }
 var file_initia_mstaking_v1_staking_proto_depIdxs = []int32{
 	2,  // 0: initia.mstaking.v1.Commission.commission_rates:type_name -> initia.mstaking.v1.CommissionRates
-	21, // 1: initia.mstaking.v1.Commission.update_time:type_name -> google.protobuf.Timestamp
-	22, // 2: initia.mstaking.v1.Validator.consensus_pubkey:type_name -> google.protobuf.Any
+	30, // 1: initia.mstaking.v1.Commission.update_time:type_name -> google.protobuf.Timestamp
+	31, // 2: initia.mstaking.v1.Validator.consensus_pubkey:type_name -> google.protobuf.Any
 	0,  // 3: initia.mstaking.v1.Validator.status:type_name -> initia.mstaking.v1.BondStatus
-	23, // 4: initia.mstaking.v1.Validator.tokens:type_name -> cosmos.base.v1beta1.Coin
-	24, // 5: initia.mstaking.v1.Validator.delegator_shares:type_name -> cosmos.base.v1beta1.DecCoin
+	32, // 4: initia.mstaking.v1.Validator.tokens:type_name -> cosmos.base.v1beta1.Coin
+	33, // 5: initia.mstaking.v1.Validator.delegator_shares:type_name -> cosmos.base.v1beta1.DecCoin
 	4,  // 6: initia.mstaking.v1.Validator.description:type_name -> initia.mstaking.v1.Description
-	21, // 7: initia.mstaking.v1.Validator.unbonding_time:type_name -> google.protobuf.Timestamp
+	30, // 7: initia.mstaking.v1.Validator.unbonding_time:type_name -> google.protobuf.Timestamp
 	3,  // 8: initia.mstaking.v1.Validator.commission:type_name -> initia.mstaking.v1.Commission
-	23, // 9: initia.mstaking.v1.Validator.voting_powers:type_name -> cosmos.base.v1beta1.Coin
+	32, // 9: initia.mstaking.v1.Validator.voting_powers:type_name -> cosmos.base.v1beta1.Coin
 	7,  // 10: initia.mstaking.v1.DVPairs.pairs:type_name -> initia.mstaking.v1.DVPair
 	9,  // 11: initia.mstaking.v1.DVVTriplets.triplets:type_name -> initia.mstaking.v1.DVVTriplet
-	24, // 12: initia.mstaking.v1.Delegation.shares:type_name -> cosmos.base.v1beta1.DecCoin
+	33, // 12: initia.mstaking.v1.Delegation.shares:type_name -> cosmos.base.v1beta1.DecCoin
 	13, // 13: initia.mstaking.v1.UnbondingDelegation.entries:type_name -> initia.mstaking.v1.UnbondingDelegationEntry
-	21, // 14: initia.mstaking.v1.UnbondingDelegationEntry.completion_time:type_name -> google.protobuf.Timestamp
-	23, // 15: initia.mstaking.v1.UnbondingDelegationEntry.initial_balance:type_name -> cosmos.base.v1beta1.Coin
-	23, // 16: initia.mstaking.v1.UnbondingDelegationEntry.balance:type_name -> cosmos.base.v1beta1.Coin
-	21, // 17: initia.mstaking.v1.RedelegationEntry.completion_time:type_name -> google.protobuf.Timestamp
-	23, // 18: initia.mstaking.v1.RedelegationEntry.initial_balance:type_name -> cosmos.base.v1beta1.Coin
-	24, // 19: initia.mstaking.v1.RedelegationEntry.shares_dst:type_name -> cosmos.base.v1beta1.DecCoin
+	30, // 14: initia.mstaking.v1.UnbondingDelegationEntry.completion_time:type_name -> google.protobuf.Timestamp
+	32, // 15: initia.mstaking.v1.UnbondingDelegationEntry.initial_balance:type_name -> cosmos.base.v1beta1.Coin
+	32, // 16: initia.mstaking.v1.UnbondingDelegationEntry.balance:type_name -> cosmos.base.v1beta1.Coin
+	30, // 17: initia.mstaking.v1.RedelegationEntry.completion_time:type_name -> google.protobuf.Timestamp
+	32, // 18: initia.mstaking.v1.RedelegationEntry.initial_balance:type_name -> cosmos.base.v1beta1.Coin
+	33, // 19: initia.mstaking.v1.RedelegationEntry.shares_dst:type_name -> cosmos.base.v1beta1.DecCoin
 	14, // 20: initia.mstaking.v1.Redelegation.entries:type_name -> initia.mstaking.v1.RedelegationEntry
-	25, // 21: initia.mstaking.v1.Params.unbonding_time:type_name -> google.protobuf.Duration
-	11, // 22: initia.mstaking.v1.DelegationResponse.delegation:type_name -> initia.mstaking.v1.Delegation
-	23, // 23: initia.mstaking.v1.DelegationResponse.balance:type_name -> cosmos.base.v1beta1.Coin
-	14, // 24: initia.mstaking.v1.RedelegationEntryResponse.redelegation_entry:type_name -> initia.mstaking.v1.RedelegationEntry
-	23, // 25: initia.mstaking.v1.RedelegationEntryResponse.balance:type_name -> cosmos.base.v1beta1.Coin
-	15, // 26: initia.mstaking.v1.RedelegationResponse.redelegation:type_name -> initia.mstaking.v1.Redelegation
-	18, // 27: initia.mstaking.v1.RedelegationResponse.entries:type_name -> initia.mstaking.v1.RedelegationEntryResponse
-	23, // 28: initia.mstaking.v1.Pool.not_bonded_tokens:type_name -> cosmos.base.v1beta1.Coin
-	23, // 29: initia.mstaking.v1.Pool.bonded_tokens:type_name -> cosmos.base.v1beta1.Coin
-	24, // 30: initia.mstaking.v1.Pool.voting_power_weights:type_name -> cosmos.base.v1beta1.DecCoin
-	31, // [31:31] is the sub-list for method output_type
-	31, // [31:31] is the sub-list for method input_type
-	31, // [31:31] is the sub-list for extension type_name
-	31, // [31:31] is the sub-list for extension extendee
-	0,  // [0:31] is the sub-list for field type_name
+	34, // 21: initia.mstaking.v1.Params.unbonding_time:type_name -> google.protobuf.Duration
+	32, // 22: initia.mstaking.v1.Params.key_rotation_fee:type_name -> cosmos.base.v1beta1.Coin
+	31, // 23: initia.mstaking.v1.ConsPubKeyRotationHistory.old_cons_pubkey:type_name -> google.protobuf.Any
+	31, // 24: initia.mstaking.v1.ConsPubKeyRotationHistory.new_cons_pubkey:type_name -> google.protobuf.Any
+	30, // 25: initia.mstaking.v1.ConsPubKeyRotationHistory.completion_time:type_name -> google.protobuf.Timestamp
+	32, // 26: initia.mstaking.v1.ConsPubKeyRotationHistory.fee:type_name -> cosmos.base.v1beta1.Coin
+	32, // 27: initia.mstaking.v1.ValidatorPower.tokens:type_name -> cosmos.base.v1beta1.Coin
+	32, // 28: initia.mstaking.v1.ValidatorPower.voting_powers:type_name -> cosmos.base.v1beta1.Coin
+	30, // 29: initia.mstaking.v1.PowerSnapshot.time:type_name -> google.protobuf.Timestamp
+	33, // 30: initia.mstaking.v1.PowerSnapshot.voting_power_weights:type_name -> cosmos.base.v1beta1.DecCoin
+	19, // 31: initia.mstaking.v1.PowerSnapshot.validators:type_name -> initia.mstaking.v1.ValidatorPower
+	13, // 32: initia.mstaking.v1.UnbondingQueueEntry.entry:type_name -> initia.mstaking.v1.UnbondingDelegationEntry
+	14, // 33: initia.mstaking.v1.RedelegationQueueEntry.entry:type_name -> initia.mstaking.v1.RedelegationEntry
+	30, // 34: initia.mstaking.v1.UnbondingMaturity.completion_time:type_name -> google.protobuf.Timestamp
+	32, // 35: initia.mstaking.v1.UnbondingMaturity.amount:type_name -> cosmos.base.v1beta1.Coin
+	11, // 36: initia.mstaking.v1.DelegationResponse.delegation:type_name -> initia.mstaking.v1.Delegation
+	32, // 37: initia.mstaking.v1.DelegationResponse.balance:type_name -> cosmos.base.v1beta1.Coin
+	14, // 38: initia.mstaking.v1.RedelegationEntryResponse.redelegation_entry:type_name -> initia.mstaking.v1.RedelegationEntry
+	32, // 39: initia.mstaking.v1.RedelegationEntryResponse.balance:type_name -> cosmos.base.v1beta1.Coin
+	15, // 40: initia.mstaking.v1.RedelegationResponse.redelegation:type_name -> initia.mstaking.v1.Redelegation
+	25, // 41: initia.mstaking.v1.RedelegationResponse.entries:type_name -> initia.mstaking.v1.RedelegationEntryResponse
+	32, // 42: initia.mstaking.v1.Pool.not_bonded_tokens:type_name -> cosmos.base.v1beta1.Coin
+	32, // 43: initia.mstaking.v1.Pool.bonded_tokens:type_name -> cosmos.base.v1beta1.Coin
+	33, // 44: initia.mstaking.v1.Pool.voting_power_weights:type_name -> cosmos.base.v1beta1.DecCoin
+	30, // 45: initia.mstaking.v1.SlashEvent.time:type_name -> google.protobuf.Timestamp
+	32, // 46: initia.mstaking.v1.SlashEvent.burned:type_name -> cosmos.base.v1beta1.Coin
+	30, // 47: initia.mstaking.v1.DelegatorSlashImpact.time:type_name -> google.protobuf.Timestamp
+	32, // 48: initia.mstaking.v1.DelegatorSlashImpact.delegation:type_name -> cosmos.base.v1beta1.Coin
+	32, // 49: initia.mstaking.v1.DelegatorSlashImpact.unbonding:type_name -> cosmos.base.v1beta1.Coin
+	32, // 50: initia.mstaking.v1.DelegatorSlashImpact.redelegation:type_name -> cosmos.base.v1beta1.Coin
+	51, // [51:51] is the sub-list for method output_type
+	51, // [51:51] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_initia_mstaking_v1_staking_proto_init() }
@@ -14666,7 +22415,7 @@ func file_initia_mstaking_v1_staking_proto_init() {
 			}
 		}
 		file_initia_mstaking_v1_staking_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelegationResponse); i {
+			switch v := v.(*ConsPubKeyRotationHistory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14678,7 +22427,7 @@ func file_initia_mstaking_v1_staking_proto_init() {
 			}
 		}
 		file_initia_mstaking_v1_staking_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RedelegationEntryResponse); i {
+			switch v := v.(*PendingPowerUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14690,7 +22439,7 @@ func file_initia_mstaking_v1_staking_proto_init() {
 			}
 		}
 		file_initia_mstaking_v1_staking_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RedelegationResponse); i {
+			switch v := v.(*ValidatorPower); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14702,6 +22451,90 @@ func file_initia_mstaking_v1_staking_proto_init() {
 			}
 		}
 		file_initia_mstaking_v1_staking_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PowerSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_mstaking_v1_staking_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnbondingQueueEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_mstaking_v1_staking_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedelegationQueueEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_mstaking_v1_staking_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnbondingMaturity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_mstaking_v1_staking_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelegationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_mstaking_v1_staking_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedelegationEntryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_mstaking_v1_staking_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedelegationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_mstaking_v1_staking_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Pool); i {
 			case 0:
 				return &v.state
@@ -14713,6 +22546,30 @@ func file_initia_mstaking_v1_staking_proto_init() {
 				return nil
 			}
 		}
+		file_initia_mstaking_v1_staking_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlashEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_mstaking_v1_staking_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelegatorSlashImpact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -14720,7 +22577,7 @@ func file_initia_mstaking_v1_staking_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_initia_mstaking_v1_staking_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   19,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -25,6 +25,8 @@ const (
 	Msg_BeginRedelegate_FullMethodName           = "/initia.mstaking.v1.Msg/BeginRedelegate"
 	Msg_Undelegate_FullMethodName                = "/initia.mstaking.v1.Msg/Undelegate"
 	Msg_CancelUnbondingDelegation_FullMethodName = "/initia.mstaking.v1.Msg/CancelUnbondingDelegation"
+	Msg_SwapDelegationDenom_FullMethodName       = "/initia.mstaking.v1.Msg/SwapDelegationDenom"
+	Msg_RotateConsPubKey_FullMethodName          = "/initia.mstaking.v1.Msg/RotateConsPubKey"
 	Msg_UpdateParams_FullMethodName              = "/initia.mstaking.v1.Msg/UpdateParams"
 )
 
@@ -48,6 +50,13 @@ type MsgClient interface {
 	// CancelUnbondingDelegation defines a method for performing canceling the unbonding delegation
 	// and delegate back to previous validator.
 	CancelUnbondingDelegation(ctx context.Context, in *MsgCancelUnbondingDelegation, opts ...grpc.CallOption) (*MsgCancelUnbondingDelegationResponse, error)
+	// SwapDelegationDenom defines a method for converting delegated coins of
+	// one bond denom to another bond denom through the whitelisted dex pool and
+	// redelegating the converted coins to a destination validator.
+	SwapDelegationDenom(ctx context.Context, in *MsgSwapDelegationDenom, opts ...grpc.CallOption) (*MsgSwapDelegationDenomResponse, error)
+	// RotateConsPubKey defines a method for rotating the consensus public key
+	// of an existing validator.
+	RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error)
 	// UpdateParams defines an operation for updating the x/staking module
 	// parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -115,6 +124,24 @@ func (c *msgClient) CancelUnbondingDelegation(ctx context.Context, in *MsgCancel
 	return out, nil
 }
 
+func (c *msgClient) SwapDelegationDenom(ctx context.Context, in *MsgSwapDelegationDenom, opts ...grpc.CallOption) (*MsgSwapDelegationDenomResponse, error) {
+	out := new(MsgSwapDelegationDenomResponse)
+	err := c.cc.Invoke(ctx, Msg_SwapDelegationDenom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error) {
+	out := new(MsgRotateConsPubKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_RotateConsPubKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
@@ -144,6 +171,13 @@ type MsgServer interface {
 	// CancelUnbondingDelegation defines a method for performing canceling the unbonding delegation
 	// and delegate back to previous validator.
 	CancelUnbondingDelegation(context.Context, *MsgCancelUnbondingDelegation) (*MsgCancelUnbondingDelegationResponse, error)
+	// SwapDelegationDenom defines a method for converting delegated coins of
+	// one bond denom to another bond denom through the whitelisted dex pool and
+	// redelegating the converted coins to a destination validator.
+	SwapDelegationDenom(context.Context, *MsgSwapDelegationDenom) (*MsgSwapDelegationDenomResponse, error)
+	// RotateConsPubKey defines a method for rotating the consensus public key
+	// of an existing validator.
+	RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error)
 	// UpdateParams defines an operation for updating the x/staking module
 	// parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -172,6 +206,12 @@ func (UnimplementedMsgServer) Undelegate(context.Context, *MsgUndelegate) (*MsgU
 func (UnimplementedMsgServer) CancelUnbondingDelegation(context.Context, *MsgCancelUnbondingDelegation) (*MsgCancelUnbondingDelegationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelUnbondingDelegation not implemented")
 }
+func (UnimplementedMsgServer) SwapDelegationDenom(context.Context, *MsgSwapDelegationDenom) (*MsgSwapDelegationDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapDelegationDenom not implemented")
+}
+func (UnimplementedMsgServer) RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateConsPubKey not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -296,6 +336,42 @@ func _Msg_CancelUnbondingDelegation_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SwapDelegationDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapDelegationDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapDelegationDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SwapDelegationDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapDelegationDenom(ctx, req.(*MsgSwapDelegationDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateConsPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateConsPubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateConsPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RotateConsPubKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateConsPubKey(ctx, req.(*MsgRotateConsPubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -345,6 +421,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelUnbondingDelegation",
 			Handler:    _Msg_CancelUnbondingDelegation_Handler,
 		},
+		{
+			MethodName: "SwapDelegationDenom",
+			Handler:    _Msg_SwapDelegationDenom_Handler,
+		},
+		{
+			MethodName: "RotateConsPubKey",
+			Handler:    _Msg_RotateConsPubKey_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -19,6 +19,7 @@ var (
 	fd_GenesisState_params                  protoreflect.FieldDescriptor
 	fd_GenesisState_last_release_timestamp  protoreflect.FieldDescriptor
 	fd_GenesisState_last_dilution_timestamp protoreflect.FieldDescriptor
+	fd_GenesisState_current_release_rate    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -27,6 +28,7 @@ func init() {
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_last_release_timestamp = md_GenesisState.Fields().ByName("last_release_timestamp")
 	fd_GenesisState_last_dilution_timestamp = md_GenesisState.Fields().ByName("last_dilution_timestamp")
+	fd_GenesisState_current_release_rate = md_GenesisState.Fields().ByName("current_release_rate")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -112,6 +114,12 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.CurrentReleaseRate != "" {
+		value := protoreflect.ValueOfString(x.CurrentReleaseRate)
+		if !f(fd_GenesisState_current_release_rate, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -133,6 +141,8 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.LastReleaseTimestamp != nil
 	case "initia.reward.v1.GenesisState.last_dilution_timestamp":
 		return x.LastDilutionTimestamp != nil
+	case "initia.reward.v1.GenesisState.current_release_rate":
+		return x.CurrentReleaseRate != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.GenesisState"))
@@ -155,6 +165,8 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.LastReleaseTimestamp = nil
 	case "initia.reward.v1.GenesisState.last_dilution_timestamp":
 		x.LastDilutionTimestamp = nil
+	case "initia.reward.v1.GenesisState.current_release_rate":
+		x.CurrentReleaseRate = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.GenesisState"))
@@ -180,6 +192,9 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "initia.reward.v1.GenesisState.last_dilution_timestamp":
 		value := x.LastDilutionTimestamp
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.reward.v1.GenesisState.current_release_rate":
+		value := x.CurrentReleaseRate
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.GenesisState"))
@@ -206,6 +221,8 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.LastReleaseTimestamp = value.Message().Interface().(*timestamppb.Timestamp)
 	case "initia.reward.v1.GenesisState.last_dilution_timestamp":
 		x.LastDilutionTimestamp = value.Message().Interface().(*timestamppb.Timestamp)
+	case "initia.reward.v1.GenesisState.current_release_rate":
+		x.CurrentReleaseRate = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.GenesisState"))
@@ -241,6 +258,8 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.LastDilutionTimestamp = new(timestamppb.Timestamp)
 		}
 		return protoreflect.ValueOfMessage(x.LastDilutionTimestamp.ProtoReflect())
+	case "initia.reward.v1.GenesisState.current_release_rate":
+		panic(fmt.Errorf("field current_release_rate of message initia.reward.v1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.GenesisState"))
@@ -263,6 +282,8 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "initia.reward.v1.GenesisState.last_dilution_timestamp":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.reward.v1.GenesisState.current_release_rate":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.GenesisState"))
@@ -344,6 +365,10 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.LastDilutionTimestamp)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.CurrentReleaseRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -373,6 +398,13 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.CurrentReleaseRate) > 0 {
+			i -= len(x.CurrentReleaseRate)
+			copy(dAtA[i:], x.CurrentReleaseRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CurrentReleaseRate)))
+			i--
+			dAtA[i] = 0x22
+		}
 		if x.LastDilutionTimestamp != nil {
 			encoded, err := options.Marshal(x.LastDilutionTimestamp)
 			if err != nil {
@@ -572,6 +604,38 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrentReleaseRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CurrentReleaseRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -630,6 +694,9 @@ type GenesisState struct {
 	Params                *Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
 	LastReleaseTimestamp  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_release_timestamp,json=lastReleaseTimestamp,proto3" json:"last_release_timestamp,omitempty"`
 	LastDilutionTimestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_dilution_timestamp,json=lastDilutionTimestamp,proto3" json:"last_dilution_timestamp,omitempty"`
+	// current_release_rate is the release rate computed by the piecewise linear
+	// and target staking ratio curves, which is empty until the curve computes it.
+	CurrentReleaseRate string `protobuf:"bytes,4,opt,name=current_release_rate,json=currentReleaseRate,proto3" json:"current_release_rate,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -673,6 +740,13 @@ func (x *GenesisState) GetLastDilutionTimestamp() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *GenesisState) GetCurrentReleaseRate() string {
+	if x != nil {
+		return x.CurrentReleaseRate
+	}
+	return ""
+}
+
 var File_initia_reward_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_initia_reward_v1_genesis_proto_rawDesc = []byte{
@@ -684,7 +758,7 @@ var file_initia_reward_v1_genesis_proto_rawDesc = []byte{
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
 	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x69, 0x6e, 0x69, 0x74, 0x69,
 	0x61, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd7, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65,
 	0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
 	0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69,
 	0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61,
@@ -700,20 +774,25 @@ var file_initia_reward_v1_genesis_proto_rawDesc = []byte{
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
 	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90,
 	0xdf, 0x1f, 0x01, 0x52, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x69, 0x6c, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0xc3, 0x01, 0x0a, 0x14, 0x63,
-	0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x76, 0x31,
-	0xa2, 0x02, 0x03, 0x49, 0x52, 0x58, 0xaa, 0x02, 0x10, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x49, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x5c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x49,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x49, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x3a, 0x3a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x55, 0x0a, 0x14, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x01, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x12, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x42, 0xc3, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61,
+	0x62, 0x73, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x52, 0x58, 0xaa, 0x02, 0x10,
+	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x10, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x3a, 0x3a, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -22,6 +22,7 @@ const (
 	Query_Params_FullMethodName                = "/initia.reward.v1.Query/Params"
 	Query_AnnualProvisions_FullMethodName      = "/initia.reward.v1.Query/AnnualProvisions"
 	Query_LastDilutionTimestamp_FullMethodName = "/initia.reward.v1.Query/LastDilutionTimestamp"
+	Query_EmissionForecast_FullMethodName      = "/initia.reward.v1.Query/EmissionForecast"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +35,9 @@ type QueryClient interface {
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
 	// LastDilutionTimestamp returns the time when the last release rate dilution occurred.
 	LastDilutionTimestamp(ctx context.Context, in *QueryLastDilutionTimestampRequest, opts ...grpc.CallOption) (*QueryLastDilutionTimestampResponse, error)
+	// EmissionForecast returns the forecasted emissions for the next periods of
+	// the dilution period length under the current emission schedule.
+	EmissionForecast(ctx context.Context, in *QueryEmissionForecastRequest, opts ...grpc.CallOption) (*QueryEmissionForecastResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +75,15 @@ func (c *queryClient) LastDilutionTimestamp(ctx context.Context, in *QueryLastDi
 	return out, nil
 }
 
+func (c *queryClient) EmissionForecast(ctx context.Context, in *QueryEmissionForecastRequest, opts ...grpc.CallOption) (*QueryEmissionForecastResponse, error) {
+	out := new(QueryEmissionForecastResponse)
+	err := c.cc.Invoke(ctx, Query_EmissionForecast_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +94,9 @@ type QueryServer interface {
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
 	// LastDilutionTimestamp returns the time when the last release rate dilution occurred.
 	LastDilutionTimestamp(context.Context, *QueryLastDilutionTimestampRequest) (*QueryLastDilutionTimestampResponse, error)
+	// EmissionForecast returns the forecasted emissions for the next periods of
+	// the dilution period length under the current emission schedule.
+	EmissionForecast(context.Context, *QueryEmissionForecastRequest) (*QueryEmissionForecastResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +113,9 @@ func (UnimplementedQueryServer) AnnualProvisions(context.Context, *QueryAnnualPr
 func (UnimplementedQueryServer) LastDilutionTimestamp(context.Context, *QueryLastDilutionTimestampRequest) (*QueryLastDilutionTimestampResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LastDilutionTimestamp not implemented")
 }
+func (UnimplementedQueryServer) EmissionForecast(context.Context, *QueryEmissionForecastRequest) (*QueryEmissionForecastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionForecast not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +183,24 @@ func _Query_LastDilutionTimestamp_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EmissionForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EmissionForecast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionForecast(ctx, req.(*QueryEmissionForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +220,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LastDilutionTimestamp",
 			Handler:    _Query_LastDilutionTimestamp_Handler,
 		},
+		{
+			MethodName: "EmissionForecast",
+			Handler:    _Query_EmissionForecast_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/reward/v1/query.proto",
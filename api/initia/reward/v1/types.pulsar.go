@@ -10,18 +10,72 @@ import (
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_7_list)(nil)
+
+type _Params_7_list struct {
+	list *[]*ReleaseDestination
+}
+
+func (x *_Params_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ReleaseDestination)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ReleaseDestination)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_7_list) AppendMutable() protoreflect.Value {
+	v := new(ReleaseDestination)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_7_list) NewElement() protoreflect.Value {
+	v := new(ReleaseDestination)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_7_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                 protoreflect.MessageDescriptor
-	fd_Params_reward_denom    protoreflect.FieldDescriptor
-	fd_Params_dilution_period protoreflect.FieldDescriptor
-	fd_Params_release_rate    protoreflect.FieldDescriptor
-	fd_Params_dilution_rate   protoreflect.FieldDescriptor
-	fd_Params_release_enabled protoreflect.FieldDescriptor
+	md_Params                      protoreflect.MessageDescriptor
+	fd_Params_reward_denom         protoreflect.FieldDescriptor
+	fd_Params_dilution_period      protoreflect.FieldDescriptor
+	fd_Params_release_rate         protoreflect.FieldDescriptor
+	fd_Params_dilution_rate        protoreflect.FieldDescriptor
+	fd_Params_release_enabled      protoreflect.FieldDescriptor
+	fd_Params_emission_schedule    protoreflect.FieldDescriptor
+	fd_Params_release_destinations protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -32,6 +86,8 @@ func init() {
 	fd_Params_release_rate = md_Params.Fields().ByName("release_rate")
 	fd_Params_dilution_rate = md_Params.Fields().ByName("dilution_rate")
 	fd_Params_release_enabled = md_Params.Fields().ByName("release_enabled")
+	fd_Params_emission_schedule = md_Params.Fields().ByName("emission_schedule")
+	fd_Params_release_destinations = md_Params.Fields().ByName("release_destinations")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -129,6 +185,18 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.EmissionSchedule != nil {
+		value := protoreflect.ValueOfMessage(x.EmissionSchedule.ProtoReflect())
+		if !f(fd_Params_emission_schedule, value) {
+			return
+		}
+	}
+	if len(x.ReleaseDestinations) != 0 {
+		value := protoreflect.ValueOfList(&_Params_7_list{list: &x.ReleaseDestinations})
+		if !f(fd_Params_release_destinations, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -154,6 +222,10 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.DilutionRate != ""
 	case "initia.reward.v1.Params.release_enabled":
 		return x.ReleaseEnabled != false
+	case "initia.reward.v1.Params.emission_schedule":
+		return x.EmissionSchedule != nil
+	case "initia.reward.v1.Params.release_destinations":
+		return len(x.ReleaseDestinations) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.Params"))
@@ -180,6 +252,10 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.DilutionRate = ""
 	case "initia.reward.v1.Params.release_enabled":
 		x.ReleaseEnabled = false
+	case "initia.reward.v1.Params.emission_schedule":
+		x.EmissionSchedule = nil
+	case "initia.reward.v1.Params.release_destinations":
+		x.ReleaseDestinations = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.Params"))
@@ -211,6 +287,15 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "initia.reward.v1.Params.release_enabled":
 		value := x.ReleaseEnabled
 		return protoreflect.ValueOfBool(value)
+	case "initia.reward.v1.Params.emission_schedule":
+		value := x.EmissionSchedule
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.reward.v1.Params.release_destinations":
+		if len(x.ReleaseDestinations) == 0 {
+			return protoreflect.ValueOfList(&_Params_7_list{})
+		}
+		listValue := &_Params_7_list{list: &x.ReleaseDestinations}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.Params"))
@@ -241,6 +326,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.DilutionRate = value.Interface().(string)
 	case "initia.reward.v1.Params.release_enabled":
 		x.ReleaseEnabled = value.Bool()
+	case "initia.reward.v1.Params.emission_schedule":
+		x.EmissionSchedule = value.Message().Interface().(*EmissionSchedule)
+	case "initia.reward.v1.Params.release_destinations":
+		lv := value.List()
+		clv := lv.(*_Params_7_list)
+		x.ReleaseDestinations = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.Params"))
@@ -266,6 +357,17 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.DilutionPeriod = new(durationpb.Duration)
 		}
 		return protoreflect.ValueOfMessage(x.DilutionPeriod.ProtoReflect())
+	case "initia.reward.v1.Params.emission_schedule":
+		if x.EmissionSchedule == nil {
+			x.EmissionSchedule = new(EmissionSchedule)
+		}
+		return protoreflect.ValueOfMessage(x.EmissionSchedule.ProtoReflect())
+	case "initia.reward.v1.Params.release_destinations":
+		if x.ReleaseDestinations == nil {
+			x.ReleaseDestinations = []*ReleaseDestination{}
+		}
+		value := &_Params_7_list{list: &x.ReleaseDestinations}
+		return protoreflect.ValueOfList(value)
 	case "initia.reward.v1.Params.reward_denom":
 		panic(fmt.Errorf("field reward_denom of message initia.reward.v1.Params is not mutable"))
 	case "initia.reward.v1.Params.release_rate":
@@ -298,6 +400,12 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "initia.reward.v1.Params.release_enabled":
 		return protoreflect.ValueOfBool(false)
+	case "initia.reward.v1.Params.emission_schedule":
+		m := new(EmissionSchedule)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.reward.v1.Params.release_destinations":
+		list := []*ReleaseDestination{}
+		return protoreflect.ValueOfList(&_Params_7_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.Params"))
@@ -386,6 +494,16 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.ReleaseEnabled {
 			n += 2
 		}
+		if x.EmissionSchedule != nil {
+			l = options.Size(x.EmissionSchedule)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ReleaseDestinations) > 0 {
+			for _, e := range x.ReleaseDestinations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -415,6 +533,36 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ReleaseDestinations) > 0 {
+			for iNdEx := len(x.ReleaseDestinations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReleaseDestinations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.EmissionSchedule != nil {
+			encoded, err := options.Marshal(x.EmissionSchedule)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
 		if x.ReleaseEnabled {
 			i--
 			if x.ReleaseEnabled {
@@ -661,6 +809,76 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.ReleaseEnabled = bool(v != 0)
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmissionSchedule", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EmissionSchedule == nil {
+					x.EmissionSchedule = &EmissionSchedule{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EmissionSchedule); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReleaseDestinations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReleaseDestinations = append(x.ReleaseDestinations, &ReleaseDestination{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReleaseDestinations[len(x.ReleaseDestinations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -696,141 +914,4751 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: initia/reward/v1/types.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_ReleaseDestination                  protoreflect.MessageDescriptor
+	fd_ReleaseDestination_name             protoreflect.FieldDescriptor
+	fd_ReleaseDestination_destination_type protoreflect.FieldDescriptor
+	fd_ReleaseDestination_address          protoreflect.FieldDescriptor
+	fd_ReleaseDestination_ratio            protoreflect.FieldDescriptor
 )
 
-// Params defines the set of mint parameters.
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_initia_reward_v1_types_proto_init()
+	md_ReleaseDestination = File_initia_reward_v1_types_proto.Messages().ByName("ReleaseDestination")
+	fd_ReleaseDestination_name = md_ReleaseDestination.Fields().ByName("name")
+	fd_ReleaseDestination_destination_type = md_ReleaseDestination.Fields().ByName("destination_type")
+	fd_ReleaseDestination_address = md_ReleaseDestination.Fields().ByName("address")
+	fd_ReleaseDestination_ratio = md_ReleaseDestination.Fields().ByName("ratio")
+}
 
-	RewardDenom    string               `protobuf:"bytes,1,opt,name=reward_denom,json=rewardDenom,proto3" json:"reward_denom,omitempty"`
-	DilutionPeriod *durationpb.Duration `protobuf:"bytes,2,opt,name=dilution_period,json=dilutionPeriod,proto3" json:"dilution_period,omitempty"`
-	ReleaseRate    string               `protobuf:"bytes,3,opt,name=release_rate,json=releaseRate,proto3" json:"release_rate,omitempty"`
-	// The dilution rate of release rate.
-	// if `(block.timestamp - last_dilute_timestamp) < dilution_period`:
-	//
-	//	`release_rate -= (release_rate * dilution_rate)`
-	//	`last_dilution_timestamp = block.timestamp`
-	DilutionRate   string `protobuf:"bytes,4,opt,name=dilution_rate,json=dilutionRate,proto3" json:"dilution_rate,omitempty"`
-	ReleaseEnabled bool   `protobuf:"varint,5,opt,name=release_enabled,json=releaseEnabled,proto3" json:"release_enabled,omitempty"`
+var _ protoreflect.Message = (*fastReflection_ReleaseDestination)(nil)
+
+type fastReflection_ReleaseDestination ReleaseDestination
+
+func (x *ReleaseDestination) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ReleaseDestination)(x)
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_reward_v1_types_proto_msgTypes[0]
+func (x *ReleaseDestination) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_reward_v1_types_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
+var _fastReflection_ReleaseDestination_messageType fastReflection_ReleaseDestination_messageType
+var _ protoreflect.MessageType = fastReflection_ReleaseDestination_messageType{}
+
+type fastReflection_ReleaseDestination_messageType struct{}
+
+func (x fastReflection_ReleaseDestination_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ReleaseDestination)(nil)
+}
+func (x fastReflection_ReleaseDestination_messageType) New() protoreflect.Message {
+	return new(fastReflection_ReleaseDestination)
+}
+func (x fastReflection_ReleaseDestination_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReleaseDestination
 }
 
-func (*Params) ProtoMessage() {}
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ReleaseDestination) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReleaseDestination
+}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_initia_reward_v1_types_proto_rawDescGZIP(), []int{0}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ReleaseDestination) Type() protoreflect.MessageType {
+	return _fastReflection_ReleaseDestination_messageType
 }
 
-func (x *Params) GetRewardDenom() string {
-	if x != nil {
-		return x.RewardDenom
-	}
-	return ""
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ReleaseDestination) New() protoreflect.Message {
+	return new(fastReflection_ReleaseDestination)
 }
 
-func (x *Params) GetDilutionPeriod() *durationpb.Duration {
-	if x != nil {
-		return x.DilutionPeriod
-	}
-	return nil
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ReleaseDestination) Interface() protoreflect.ProtoMessage {
+	return (*ReleaseDestination)(x)
 }
 
-func (x *Params) GetReleaseRate() string {
-	if x != nil {
-		return x.ReleaseRate
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ReleaseDestination) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_ReleaseDestination_name, value) {
+			return
+		}
+	}
+	if x.DestinationType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.DestinationType))
+		if !f(fd_ReleaseDestination_destination_type, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ReleaseDestination_address, value) {
+			return
+		}
+	}
+	if x.Ratio != "" {
+		value := protoreflect.ValueOfString(x.Ratio)
+		if !f(fd_ReleaseDestination_ratio, value) {
+			return
+		}
 	}
-	return ""
 }
 
-func (x *Params) GetDilutionRate() string {
-	if x != nil {
-		return x.DilutionRate
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ReleaseDestination) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.reward.v1.ReleaseDestination.name":
+		return x.Name != ""
+	case "initia.reward.v1.ReleaseDestination.destination_type":
+		return x.DestinationType != 0
+	case "initia.reward.v1.ReleaseDestination.address":
+		return x.Address != ""
+	case "initia.reward.v1.ReleaseDestination.ratio":
+		return x.Ratio != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.ReleaseDestination"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.ReleaseDestination does not contain field %s", fd.FullName()))
 	}
-	return ""
 }
 
-func (x *Params) GetReleaseEnabled() bool {
-	if x != nil {
-		return x.ReleaseEnabled
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReleaseDestination) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.reward.v1.ReleaseDestination.name":
+		x.Name = ""
+	case "initia.reward.v1.ReleaseDestination.destination_type":
+		x.DestinationType = 0
+	case "initia.reward.v1.ReleaseDestination.address":
+		x.Address = ""
+	case "initia.reward.v1.ReleaseDestination.ratio":
+		x.Ratio = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.ReleaseDestination"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.ReleaseDestination does not contain field %s", fd.FullName()))
 	}
-	return false
 }
 
-var File_initia_reward_v1_types_proto protoreflect.FileDescriptor
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ReleaseDestination) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.reward.v1.ReleaseDestination.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "initia.reward.v1.ReleaseDestination.destination_type":
+		value := x.DestinationType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "initia.reward.v1.ReleaseDestination.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "initia.reward.v1.ReleaseDestination.ratio":
+		value := x.Ratio
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.ReleaseDestination"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.ReleaseDestination does not contain field %s", descriptor.FullName()))
+	}
+}
 
-var file_initia_reward_v1_types_proto_rawDesc = []byte{
-	0x0a, 0x1c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2f,
-	0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
-	0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReleaseDestination) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.reward.v1.ReleaseDestination.name":
+		x.Name = value.Interface().(string)
+	case "initia.reward.v1.ReleaseDestination.destination_type":
+		x.DestinationType = (ReleaseDestinationType)(value.Enum())
+	case "initia.reward.v1.ReleaseDestination.address":
+		x.Address = value.Interface().(string)
+	case "initia.reward.v1.ReleaseDestination.ratio":
+		x.Ratio = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.ReleaseDestination"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.ReleaseDestination does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReleaseDestination) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.reward.v1.ReleaseDestination.name":
+		panic(fmt.Errorf("field name of message initia.reward.v1.ReleaseDestination is not mutable"))
+	case "initia.reward.v1.ReleaseDestination.destination_type":
+		panic(fmt.Errorf("field destination_type of message initia.reward.v1.ReleaseDestination is not mutable"))
+	case "initia.reward.v1.ReleaseDestination.address":
+		panic(fmt.Errorf("field address of message initia.reward.v1.ReleaseDestination is not mutable"))
+	case "initia.reward.v1.ReleaseDestination.ratio":
+		panic(fmt.Errorf("field ratio of message initia.reward.v1.ReleaseDestination is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.ReleaseDestination"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.ReleaseDestination does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ReleaseDestination) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.reward.v1.ReleaseDestination.name":
+		return protoreflect.ValueOfString("")
+	case "initia.reward.v1.ReleaseDestination.destination_type":
+		return protoreflect.ValueOfEnum(0)
+	case "initia.reward.v1.ReleaseDestination.address":
+		return protoreflect.ValueOfString("")
+	case "initia.reward.v1.ReleaseDestination.ratio":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.ReleaseDestination"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.ReleaseDestination does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ReleaseDestination) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.reward.v1.ReleaseDestination", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ReleaseDestination) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReleaseDestination) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ReleaseDestination) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ReleaseDestination) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ReleaseDestination)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DestinationType != 0 {
+			n += 1 + runtime.Sov(uint64(x.DestinationType))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Ratio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ReleaseDestination)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Ratio) > 0 {
+			i -= len(x.Ratio)
+			copy(dAtA[i:], x.Ratio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ratio)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.DestinationType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DestinationType))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ReleaseDestination)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReleaseDestination: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReleaseDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationType", wireType)
+				}
+				x.DestinationType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DestinationType |= ReleaseDestinationType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Ratio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EmissionSchedule                      protoreflect.MessageDescriptor
+	fd_EmissionSchedule_curve                protoreflect.FieldDescriptor
+	fd_EmissionSchedule_piecewise_linear     protoreflect.FieldDescriptor
+	fd_EmissionSchedule_fixed_per_block      protoreflect.FieldDescriptor
+	fd_EmissionSchedule_target_staking_ratio protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_reward_v1_types_proto_init()
+	md_EmissionSchedule = File_initia_reward_v1_types_proto.Messages().ByName("EmissionSchedule")
+	fd_EmissionSchedule_curve = md_EmissionSchedule.Fields().ByName("curve")
+	fd_EmissionSchedule_piecewise_linear = md_EmissionSchedule.Fields().ByName("piecewise_linear")
+	fd_EmissionSchedule_fixed_per_block = md_EmissionSchedule.Fields().ByName("fixed_per_block")
+	fd_EmissionSchedule_target_staking_ratio = md_EmissionSchedule.Fields().ByName("target_staking_ratio")
+}
+
+var _ protoreflect.Message = (*fastReflection_EmissionSchedule)(nil)
+
+type fastReflection_EmissionSchedule EmissionSchedule
+
+func (x *EmissionSchedule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EmissionSchedule)(x)
+}
+
+func (x *EmissionSchedule) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_reward_v1_types_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EmissionSchedule_messageType fastReflection_EmissionSchedule_messageType
+var _ protoreflect.MessageType = fastReflection_EmissionSchedule_messageType{}
+
+type fastReflection_EmissionSchedule_messageType struct{}
+
+func (x fastReflection_EmissionSchedule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EmissionSchedule)(nil)
+}
+func (x fastReflection_EmissionSchedule_messageType) New() protoreflect.Message {
+	return new(fastReflection_EmissionSchedule)
+}
+func (x fastReflection_EmissionSchedule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EmissionSchedule
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EmissionSchedule) Descriptor() protoreflect.MessageDescriptor {
+	return md_EmissionSchedule
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EmissionSchedule) Type() protoreflect.MessageType {
+	return _fastReflection_EmissionSchedule_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EmissionSchedule) New() protoreflect.Message {
+	return new(fastReflection_EmissionSchedule)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EmissionSchedule) Interface() protoreflect.ProtoMessage {
+	return (*EmissionSchedule)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EmissionSchedule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Curve != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Curve))
+		if !f(fd_EmissionSchedule_curve, value) {
+			return
+		}
+	}
+	if x.PiecewiseLinear != nil {
+		value := protoreflect.ValueOfMessage(x.PiecewiseLinear.ProtoReflect())
+		if !f(fd_EmissionSchedule_piecewise_linear, value) {
+			return
+		}
+	}
+	if x.FixedPerBlock != nil {
+		value := protoreflect.ValueOfMessage(x.FixedPerBlock.ProtoReflect())
+		if !f(fd_EmissionSchedule_fixed_per_block, value) {
+			return
+		}
+	}
+	if x.TargetStakingRatio != nil {
+		value := protoreflect.ValueOfMessage(x.TargetStakingRatio.ProtoReflect())
+		if !f(fd_EmissionSchedule_target_staking_ratio, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EmissionSchedule) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.reward.v1.EmissionSchedule.curve":
+		return x.Curve != 0
+	case "initia.reward.v1.EmissionSchedule.piecewise_linear":
+		return x.PiecewiseLinear != nil
+	case "initia.reward.v1.EmissionSchedule.fixed_per_block":
+		return x.FixedPerBlock != nil
+	case "initia.reward.v1.EmissionSchedule.target_staking_ratio":
+		return x.TargetStakingRatio != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.EmissionSchedule"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.EmissionSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionSchedule) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.reward.v1.EmissionSchedule.curve":
+		x.Curve = 0
+	case "initia.reward.v1.EmissionSchedule.piecewise_linear":
+		x.PiecewiseLinear = nil
+	case "initia.reward.v1.EmissionSchedule.fixed_per_block":
+		x.FixedPerBlock = nil
+	case "initia.reward.v1.EmissionSchedule.target_staking_ratio":
+		x.TargetStakingRatio = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.EmissionSchedule"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.EmissionSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EmissionSchedule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.reward.v1.EmissionSchedule.curve":
+		value := x.Curve
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "initia.reward.v1.EmissionSchedule.piecewise_linear":
+		value := x.PiecewiseLinear
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.reward.v1.EmissionSchedule.fixed_per_block":
+		value := x.FixedPerBlock
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.reward.v1.EmissionSchedule.target_staking_ratio":
+		value := x.TargetStakingRatio
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.EmissionSchedule"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.EmissionSchedule does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionSchedule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.reward.v1.EmissionSchedule.curve":
+		x.Curve = (EmissionCurve)(value.Enum())
+	case "initia.reward.v1.EmissionSchedule.piecewise_linear":
+		x.PiecewiseLinear = value.Message().Interface().(*PiecewiseLinearEmission)
+	case "initia.reward.v1.EmissionSchedule.fixed_per_block":
+		x.FixedPerBlock = value.Message().Interface().(*FixedPerBlockEmission)
+	case "initia.reward.v1.EmissionSchedule.target_staking_ratio":
+		x.TargetStakingRatio = value.Message().Interface().(*TargetStakingRatioEmission)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.EmissionSchedule"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.EmissionSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionSchedule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.reward.v1.EmissionSchedule.piecewise_linear":
+		if x.PiecewiseLinear == nil {
+			x.PiecewiseLinear = new(PiecewiseLinearEmission)
+		}
+		return protoreflect.ValueOfMessage(x.PiecewiseLinear.ProtoReflect())
+	case "initia.reward.v1.EmissionSchedule.fixed_per_block":
+		if x.FixedPerBlock == nil {
+			x.FixedPerBlock = new(FixedPerBlockEmission)
+		}
+		return protoreflect.ValueOfMessage(x.FixedPerBlock.ProtoReflect())
+	case "initia.reward.v1.EmissionSchedule.target_staking_ratio":
+		if x.TargetStakingRatio == nil {
+			x.TargetStakingRatio = new(TargetStakingRatioEmission)
+		}
+		return protoreflect.ValueOfMessage(x.TargetStakingRatio.ProtoReflect())
+	case "initia.reward.v1.EmissionSchedule.curve":
+		panic(fmt.Errorf("field curve of message initia.reward.v1.EmissionSchedule is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.EmissionSchedule"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.EmissionSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EmissionSchedule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.reward.v1.EmissionSchedule.curve":
+		return protoreflect.ValueOfEnum(0)
+	case "initia.reward.v1.EmissionSchedule.piecewise_linear":
+		m := new(PiecewiseLinearEmission)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.reward.v1.EmissionSchedule.fixed_per_block":
+		m := new(FixedPerBlockEmission)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.reward.v1.EmissionSchedule.target_staking_ratio":
+		m := new(TargetStakingRatioEmission)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.EmissionSchedule"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.EmissionSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EmissionSchedule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.reward.v1.EmissionSchedule", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EmissionSchedule) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionSchedule) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EmissionSchedule) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EmissionSchedule) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EmissionSchedule)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Curve != 0 {
+			n += 1 + runtime.Sov(uint64(x.Curve))
+		}
+		if x.PiecewiseLinear != nil {
+			l = options.Size(x.PiecewiseLinear)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FixedPerBlock != nil {
+			l = options.Size(x.FixedPerBlock)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TargetStakingRatio != nil {
+			l = options.Size(x.TargetStakingRatio)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EmissionSchedule)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TargetStakingRatio != nil {
+			encoded, err := options.Marshal(x.TargetStakingRatio)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.FixedPerBlock != nil {
+			encoded, err := options.Marshal(x.FixedPerBlock)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.PiecewiseLinear != nil {
+			encoded, err := options.Marshal(x.PiecewiseLinear)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Curve != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Curve))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EmissionSchedule)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EmissionSchedule: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EmissionSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+				}
+				x.Curve = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Curve |= EmissionCurve(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PiecewiseLinear", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PiecewiseLinear == nil {
+					x.PiecewiseLinear = &PiecewiseLinearEmission{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PiecewiseLinear); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FixedPerBlock", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FixedPerBlock == nil {
+					x.FixedPerBlock = &FixedPerBlockEmission{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FixedPerBlock); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetStakingRatio", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TargetStakingRatio == nil {
+					x.TargetStakingRatio = &TargetStakingRatioEmission{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TargetStakingRatio); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EmissionPoint              protoreflect.MessageDescriptor
+	fd_EmissionPoint_time         protoreflect.FieldDescriptor
+	fd_EmissionPoint_release_rate protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_reward_v1_types_proto_init()
+	md_EmissionPoint = File_initia_reward_v1_types_proto.Messages().ByName("EmissionPoint")
+	fd_EmissionPoint_time = md_EmissionPoint.Fields().ByName("time")
+	fd_EmissionPoint_release_rate = md_EmissionPoint.Fields().ByName("release_rate")
+}
+
+var _ protoreflect.Message = (*fastReflection_EmissionPoint)(nil)
+
+type fastReflection_EmissionPoint EmissionPoint
+
+func (x *EmissionPoint) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EmissionPoint)(x)
+}
+
+func (x *EmissionPoint) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_reward_v1_types_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EmissionPoint_messageType fastReflection_EmissionPoint_messageType
+var _ protoreflect.MessageType = fastReflection_EmissionPoint_messageType{}
+
+type fastReflection_EmissionPoint_messageType struct{}
+
+func (x fastReflection_EmissionPoint_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EmissionPoint)(nil)
+}
+func (x fastReflection_EmissionPoint_messageType) New() protoreflect.Message {
+	return new(fastReflection_EmissionPoint)
+}
+func (x fastReflection_EmissionPoint_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EmissionPoint
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EmissionPoint) Descriptor() protoreflect.MessageDescriptor {
+	return md_EmissionPoint
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EmissionPoint) Type() protoreflect.MessageType {
+	return _fastReflection_EmissionPoint_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EmissionPoint) New() protoreflect.Message {
+	return new(fastReflection_EmissionPoint)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EmissionPoint) Interface() protoreflect.ProtoMessage {
+	return (*EmissionPoint)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EmissionPoint) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Time != nil {
+		value := protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+		if !f(fd_EmissionPoint_time, value) {
+			return
+		}
+	}
+	if x.ReleaseRate != "" {
+		value := protoreflect.ValueOfString(x.ReleaseRate)
+		if !f(fd_EmissionPoint_release_rate, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EmissionPoint) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.reward.v1.EmissionPoint.time":
+		return x.Time != nil
+	case "initia.reward.v1.EmissionPoint.release_rate":
+		return x.ReleaseRate != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.EmissionPoint"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.EmissionPoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionPoint) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.reward.v1.EmissionPoint.time":
+		x.Time = nil
+	case "initia.reward.v1.EmissionPoint.release_rate":
+		x.ReleaseRate = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.EmissionPoint"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.EmissionPoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EmissionPoint) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.reward.v1.EmissionPoint.time":
+		value := x.Time
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.reward.v1.EmissionPoint.release_rate":
+		value := x.ReleaseRate
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.EmissionPoint"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.EmissionPoint does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionPoint) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.reward.v1.EmissionPoint.time":
+		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
+	case "initia.reward.v1.EmissionPoint.release_rate":
+		x.ReleaseRate = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.EmissionPoint"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.EmissionPoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionPoint) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.reward.v1.EmissionPoint.time":
+		if x.Time == nil {
+			x.Time = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+	case "initia.reward.v1.EmissionPoint.release_rate":
+		panic(fmt.Errorf("field release_rate of message initia.reward.v1.EmissionPoint is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.EmissionPoint"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.EmissionPoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EmissionPoint) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.reward.v1.EmissionPoint.time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.reward.v1.EmissionPoint.release_rate":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.EmissionPoint"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.EmissionPoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EmissionPoint) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.reward.v1.EmissionPoint", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EmissionPoint) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionPoint) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EmissionPoint) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EmissionPoint) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EmissionPoint)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Time != nil {
+			l = options.Size(x.Time)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReleaseRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EmissionPoint)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ReleaseRate) > 0 {
+			i -= len(x.ReleaseRate)
+			copy(dAtA[i:], x.ReleaseRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReleaseRate)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Time != nil {
+			encoded, err := options.Marshal(x.Time)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EmissionPoint)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EmissionPoint: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EmissionPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Time == nil {
+					x.Time = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Time); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReleaseRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReleaseRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_PiecewiseLinearEmission_1_list)(nil)
+
+type _PiecewiseLinearEmission_1_list struct {
+	list *[]*EmissionPoint
+}
+
+func (x *_PiecewiseLinearEmission_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PiecewiseLinearEmission_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PiecewiseLinearEmission_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EmissionPoint)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PiecewiseLinearEmission_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EmissionPoint)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PiecewiseLinearEmission_1_list) AppendMutable() protoreflect.Value {
+	v := new(EmissionPoint)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PiecewiseLinearEmission_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PiecewiseLinearEmission_1_list) NewElement() protoreflect.Value {
+	v := new(EmissionPoint)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PiecewiseLinearEmission_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PiecewiseLinearEmission        protoreflect.MessageDescriptor
+	fd_PiecewiseLinearEmission_points protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_reward_v1_types_proto_init()
+	md_PiecewiseLinearEmission = File_initia_reward_v1_types_proto.Messages().ByName("PiecewiseLinearEmission")
+	fd_PiecewiseLinearEmission_points = md_PiecewiseLinearEmission.Fields().ByName("points")
+}
+
+var _ protoreflect.Message = (*fastReflection_PiecewiseLinearEmission)(nil)
+
+type fastReflection_PiecewiseLinearEmission PiecewiseLinearEmission
+
+func (x *PiecewiseLinearEmission) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PiecewiseLinearEmission)(x)
+}
+
+func (x *PiecewiseLinearEmission) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_reward_v1_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PiecewiseLinearEmission_messageType fastReflection_PiecewiseLinearEmission_messageType
+var _ protoreflect.MessageType = fastReflection_PiecewiseLinearEmission_messageType{}
+
+type fastReflection_PiecewiseLinearEmission_messageType struct{}
+
+func (x fastReflection_PiecewiseLinearEmission_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PiecewiseLinearEmission)(nil)
+}
+func (x fastReflection_PiecewiseLinearEmission_messageType) New() protoreflect.Message {
+	return new(fastReflection_PiecewiseLinearEmission)
+}
+func (x fastReflection_PiecewiseLinearEmission_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PiecewiseLinearEmission
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PiecewiseLinearEmission) Descriptor() protoreflect.MessageDescriptor {
+	return md_PiecewiseLinearEmission
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PiecewiseLinearEmission) Type() protoreflect.MessageType {
+	return _fastReflection_PiecewiseLinearEmission_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PiecewiseLinearEmission) New() protoreflect.Message {
+	return new(fastReflection_PiecewiseLinearEmission)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PiecewiseLinearEmission) Interface() protoreflect.ProtoMessage {
+	return (*PiecewiseLinearEmission)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PiecewiseLinearEmission) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Points) != 0 {
+		value := protoreflect.ValueOfList(&_PiecewiseLinearEmission_1_list{list: &x.Points})
+		if !f(fd_PiecewiseLinearEmission_points, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PiecewiseLinearEmission) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.reward.v1.PiecewiseLinearEmission.points":
+		return len(x.Points) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.PiecewiseLinearEmission"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.PiecewiseLinearEmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PiecewiseLinearEmission) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.reward.v1.PiecewiseLinearEmission.points":
+		x.Points = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.PiecewiseLinearEmission"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.PiecewiseLinearEmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PiecewiseLinearEmission) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.reward.v1.PiecewiseLinearEmission.points":
+		if len(x.Points) == 0 {
+			return protoreflect.ValueOfList(&_PiecewiseLinearEmission_1_list{})
+		}
+		listValue := &_PiecewiseLinearEmission_1_list{list: &x.Points}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.PiecewiseLinearEmission"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.PiecewiseLinearEmission does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PiecewiseLinearEmission) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.reward.v1.PiecewiseLinearEmission.points":
+		lv := value.List()
+		clv := lv.(*_PiecewiseLinearEmission_1_list)
+		x.Points = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.PiecewiseLinearEmission"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.PiecewiseLinearEmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PiecewiseLinearEmission) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.reward.v1.PiecewiseLinearEmission.points":
+		if x.Points == nil {
+			x.Points = []*EmissionPoint{}
+		}
+		value := &_PiecewiseLinearEmission_1_list{list: &x.Points}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.PiecewiseLinearEmission"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.PiecewiseLinearEmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PiecewiseLinearEmission) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.reward.v1.PiecewiseLinearEmission.points":
+		list := []*EmissionPoint{}
+		return protoreflect.ValueOfList(&_PiecewiseLinearEmission_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.PiecewiseLinearEmission"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.PiecewiseLinearEmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PiecewiseLinearEmission) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.reward.v1.PiecewiseLinearEmission", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PiecewiseLinearEmission) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PiecewiseLinearEmission) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PiecewiseLinearEmission) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PiecewiseLinearEmission) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PiecewiseLinearEmission)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Points) > 0 {
+			for _, e := range x.Points {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PiecewiseLinearEmission)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Points) > 0 {
+			for iNdEx := len(x.Points) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Points[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PiecewiseLinearEmission)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PiecewiseLinearEmission: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PiecewiseLinearEmission: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Points = append(x.Points, &EmissionPoint{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Points[len(x.Points)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FixedPerBlockEmission                 protoreflect.MessageDescriptor
+	fd_FixedPerBlockEmission_amount          protoreflect.FieldDescriptor
+	fd_FixedPerBlockEmission_blocks_per_year protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_reward_v1_types_proto_init()
+	md_FixedPerBlockEmission = File_initia_reward_v1_types_proto.Messages().ByName("FixedPerBlockEmission")
+	fd_FixedPerBlockEmission_amount = md_FixedPerBlockEmission.Fields().ByName("amount")
+	fd_FixedPerBlockEmission_blocks_per_year = md_FixedPerBlockEmission.Fields().ByName("blocks_per_year")
+}
+
+var _ protoreflect.Message = (*fastReflection_FixedPerBlockEmission)(nil)
+
+type fastReflection_FixedPerBlockEmission FixedPerBlockEmission
+
+func (x *FixedPerBlockEmission) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FixedPerBlockEmission)(x)
+}
+
+func (x *FixedPerBlockEmission) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_reward_v1_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FixedPerBlockEmission_messageType fastReflection_FixedPerBlockEmission_messageType
+var _ protoreflect.MessageType = fastReflection_FixedPerBlockEmission_messageType{}
+
+type fastReflection_FixedPerBlockEmission_messageType struct{}
+
+func (x fastReflection_FixedPerBlockEmission_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FixedPerBlockEmission)(nil)
+}
+func (x fastReflection_FixedPerBlockEmission_messageType) New() protoreflect.Message {
+	return new(fastReflection_FixedPerBlockEmission)
+}
+func (x fastReflection_FixedPerBlockEmission_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FixedPerBlockEmission
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FixedPerBlockEmission) Descriptor() protoreflect.MessageDescriptor {
+	return md_FixedPerBlockEmission
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FixedPerBlockEmission) Type() protoreflect.MessageType {
+	return _fastReflection_FixedPerBlockEmission_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FixedPerBlockEmission) New() protoreflect.Message {
+	return new(fastReflection_FixedPerBlockEmission)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FixedPerBlockEmission) Interface() protoreflect.ProtoMessage {
+	return (*FixedPerBlockEmission)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FixedPerBlockEmission) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_FixedPerBlockEmission_amount, value) {
+			return
+		}
+	}
+	if x.BlocksPerYear != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlocksPerYear)
+		if !f(fd_FixedPerBlockEmission_blocks_per_year, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FixedPerBlockEmission) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.reward.v1.FixedPerBlockEmission.amount":
+		return x.Amount != ""
+	case "initia.reward.v1.FixedPerBlockEmission.blocks_per_year":
+		return x.BlocksPerYear != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.FixedPerBlockEmission"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.FixedPerBlockEmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FixedPerBlockEmission) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.reward.v1.FixedPerBlockEmission.amount":
+		x.Amount = ""
+	case "initia.reward.v1.FixedPerBlockEmission.blocks_per_year":
+		x.BlocksPerYear = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.FixedPerBlockEmission"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.FixedPerBlockEmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FixedPerBlockEmission) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.reward.v1.FixedPerBlockEmission.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "initia.reward.v1.FixedPerBlockEmission.blocks_per_year":
+		value := x.BlocksPerYear
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.FixedPerBlockEmission"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.FixedPerBlockEmission does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FixedPerBlockEmission) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.reward.v1.FixedPerBlockEmission.amount":
+		x.Amount = value.Interface().(string)
+	case "initia.reward.v1.FixedPerBlockEmission.blocks_per_year":
+		x.BlocksPerYear = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.FixedPerBlockEmission"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.FixedPerBlockEmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FixedPerBlockEmission) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.reward.v1.FixedPerBlockEmission.amount":
+		panic(fmt.Errorf("field amount of message initia.reward.v1.FixedPerBlockEmission is not mutable"))
+	case "initia.reward.v1.FixedPerBlockEmission.blocks_per_year":
+		panic(fmt.Errorf("field blocks_per_year of message initia.reward.v1.FixedPerBlockEmission is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.FixedPerBlockEmission"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.FixedPerBlockEmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FixedPerBlockEmission) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.reward.v1.FixedPerBlockEmission.amount":
+		return protoreflect.ValueOfString("")
+	case "initia.reward.v1.FixedPerBlockEmission.blocks_per_year":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.FixedPerBlockEmission"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.FixedPerBlockEmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FixedPerBlockEmission) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.reward.v1.FixedPerBlockEmission", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FixedPerBlockEmission) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FixedPerBlockEmission) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FixedPerBlockEmission) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FixedPerBlockEmission) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FixedPerBlockEmission)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlocksPerYear != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlocksPerYear))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FixedPerBlockEmission)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlocksPerYear != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlocksPerYear))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FixedPerBlockEmission)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FixedPerBlockEmission: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FixedPerBlockEmission: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+				}
+				x.BlocksPerYear = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlocksPerYear |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TargetStakingRatioEmission                      protoreflect.MessageDescriptor
+	fd_TargetStakingRatioEmission_goal_bonded          protoreflect.FieldDescriptor
+	fd_TargetStakingRatioEmission_rate_change_per_year protoreflect.FieldDescriptor
+	fd_TargetStakingRatioEmission_min_release_rate     protoreflect.FieldDescriptor
+	fd_TargetStakingRatioEmission_max_release_rate     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_reward_v1_types_proto_init()
+	md_TargetStakingRatioEmission = File_initia_reward_v1_types_proto.Messages().ByName("TargetStakingRatioEmission")
+	fd_TargetStakingRatioEmission_goal_bonded = md_TargetStakingRatioEmission.Fields().ByName("goal_bonded")
+	fd_TargetStakingRatioEmission_rate_change_per_year = md_TargetStakingRatioEmission.Fields().ByName("rate_change_per_year")
+	fd_TargetStakingRatioEmission_min_release_rate = md_TargetStakingRatioEmission.Fields().ByName("min_release_rate")
+	fd_TargetStakingRatioEmission_max_release_rate = md_TargetStakingRatioEmission.Fields().ByName("max_release_rate")
+}
+
+var _ protoreflect.Message = (*fastReflection_TargetStakingRatioEmission)(nil)
+
+type fastReflection_TargetStakingRatioEmission TargetStakingRatioEmission
+
+func (x *TargetStakingRatioEmission) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TargetStakingRatioEmission)(x)
+}
+
+func (x *TargetStakingRatioEmission) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_reward_v1_types_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TargetStakingRatioEmission_messageType fastReflection_TargetStakingRatioEmission_messageType
+var _ protoreflect.MessageType = fastReflection_TargetStakingRatioEmission_messageType{}
+
+type fastReflection_TargetStakingRatioEmission_messageType struct{}
+
+func (x fastReflection_TargetStakingRatioEmission_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TargetStakingRatioEmission)(nil)
+}
+func (x fastReflection_TargetStakingRatioEmission_messageType) New() protoreflect.Message {
+	return new(fastReflection_TargetStakingRatioEmission)
+}
+func (x fastReflection_TargetStakingRatioEmission_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TargetStakingRatioEmission
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TargetStakingRatioEmission) Descriptor() protoreflect.MessageDescriptor {
+	return md_TargetStakingRatioEmission
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TargetStakingRatioEmission) Type() protoreflect.MessageType {
+	return _fastReflection_TargetStakingRatioEmission_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TargetStakingRatioEmission) New() protoreflect.Message {
+	return new(fastReflection_TargetStakingRatioEmission)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TargetStakingRatioEmission) Interface() protoreflect.ProtoMessage {
+	return (*TargetStakingRatioEmission)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TargetStakingRatioEmission) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GoalBonded != "" {
+		value := protoreflect.ValueOfString(x.GoalBonded)
+		if !f(fd_TargetStakingRatioEmission_goal_bonded, value) {
+			return
+		}
+	}
+	if x.RateChangePerYear != "" {
+		value := protoreflect.ValueOfString(x.RateChangePerYear)
+		if !f(fd_TargetStakingRatioEmission_rate_change_per_year, value) {
+			return
+		}
+	}
+	if x.MinReleaseRate != "" {
+		value := protoreflect.ValueOfString(x.MinReleaseRate)
+		if !f(fd_TargetStakingRatioEmission_min_release_rate, value) {
+			return
+		}
+	}
+	if x.MaxReleaseRate != "" {
+		value := protoreflect.ValueOfString(x.MaxReleaseRate)
+		if !f(fd_TargetStakingRatioEmission_max_release_rate, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TargetStakingRatioEmission) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.reward.v1.TargetStakingRatioEmission.goal_bonded":
+		return x.GoalBonded != ""
+	case "initia.reward.v1.TargetStakingRatioEmission.rate_change_per_year":
+		return x.RateChangePerYear != ""
+	case "initia.reward.v1.TargetStakingRatioEmission.min_release_rate":
+		return x.MinReleaseRate != ""
+	case "initia.reward.v1.TargetStakingRatioEmission.max_release_rate":
+		return x.MaxReleaseRate != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.TargetStakingRatioEmission"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.TargetStakingRatioEmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TargetStakingRatioEmission) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.reward.v1.TargetStakingRatioEmission.goal_bonded":
+		x.GoalBonded = ""
+	case "initia.reward.v1.TargetStakingRatioEmission.rate_change_per_year":
+		x.RateChangePerYear = ""
+	case "initia.reward.v1.TargetStakingRatioEmission.min_release_rate":
+		x.MinReleaseRate = ""
+	case "initia.reward.v1.TargetStakingRatioEmission.max_release_rate":
+		x.MaxReleaseRate = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.TargetStakingRatioEmission"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.TargetStakingRatioEmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TargetStakingRatioEmission) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.reward.v1.TargetStakingRatioEmission.goal_bonded":
+		value := x.GoalBonded
+		return protoreflect.ValueOfString(value)
+	case "initia.reward.v1.TargetStakingRatioEmission.rate_change_per_year":
+		value := x.RateChangePerYear
+		return protoreflect.ValueOfString(value)
+	case "initia.reward.v1.TargetStakingRatioEmission.min_release_rate":
+		value := x.MinReleaseRate
+		return protoreflect.ValueOfString(value)
+	case "initia.reward.v1.TargetStakingRatioEmission.max_release_rate":
+		value := x.MaxReleaseRate
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.TargetStakingRatioEmission"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.TargetStakingRatioEmission does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TargetStakingRatioEmission) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.reward.v1.TargetStakingRatioEmission.goal_bonded":
+		x.GoalBonded = value.Interface().(string)
+	case "initia.reward.v1.TargetStakingRatioEmission.rate_change_per_year":
+		x.RateChangePerYear = value.Interface().(string)
+	case "initia.reward.v1.TargetStakingRatioEmission.min_release_rate":
+		x.MinReleaseRate = value.Interface().(string)
+	case "initia.reward.v1.TargetStakingRatioEmission.max_release_rate":
+		x.MaxReleaseRate = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.TargetStakingRatioEmission"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.TargetStakingRatioEmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TargetStakingRatioEmission) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.reward.v1.TargetStakingRatioEmission.goal_bonded":
+		panic(fmt.Errorf("field goal_bonded of message initia.reward.v1.TargetStakingRatioEmission is not mutable"))
+	case "initia.reward.v1.TargetStakingRatioEmission.rate_change_per_year":
+		panic(fmt.Errorf("field rate_change_per_year of message initia.reward.v1.TargetStakingRatioEmission is not mutable"))
+	case "initia.reward.v1.TargetStakingRatioEmission.min_release_rate":
+		panic(fmt.Errorf("field min_release_rate of message initia.reward.v1.TargetStakingRatioEmission is not mutable"))
+	case "initia.reward.v1.TargetStakingRatioEmission.max_release_rate":
+		panic(fmt.Errorf("field max_release_rate of message initia.reward.v1.TargetStakingRatioEmission is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.TargetStakingRatioEmission"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.TargetStakingRatioEmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TargetStakingRatioEmission) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.reward.v1.TargetStakingRatioEmission.goal_bonded":
+		return protoreflect.ValueOfString("")
+	case "initia.reward.v1.TargetStakingRatioEmission.rate_change_per_year":
+		return protoreflect.ValueOfString("")
+	case "initia.reward.v1.TargetStakingRatioEmission.min_release_rate":
+		return protoreflect.ValueOfString("")
+	case "initia.reward.v1.TargetStakingRatioEmission.max_release_rate":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.TargetStakingRatioEmission"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.TargetStakingRatioEmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TargetStakingRatioEmission) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.reward.v1.TargetStakingRatioEmission", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TargetStakingRatioEmission) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TargetStakingRatioEmission) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TargetStakingRatioEmission) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TargetStakingRatioEmission) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TargetStakingRatioEmission)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.GoalBonded)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RateChangePerYear)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinReleaseRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxReleaseRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TargetStakingRatioEmission)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxReleaseRate) > 0 {
+			i -= len(x.MaxReleaseRate)
+			copy(dAtA[i:], x.MaxReleaseRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxReleaseRate)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.MinReleaseRate) > 0 {
+			i -= len(x.MinReleaseRate)
+			copy(dAtA[i:], x.MinReleaseRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinReleaseRate)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.RateChangePerYear) > 0 {
+			i -= len(x.RateChangePerYear)
+			copy(dAtA[i:], x.RateChangePerYear)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RateChangePerYear)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.GoalBonded) > 0 {
+			i -= len(x.GoalBonded)
+			copy(dAtA[i:], x.GoalBonded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GoalBonded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TargetStakingRatioEmission)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TargetStakingRatioEmission: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TargetStakingRatioEmission: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GoalBonded = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RateChangePerYear", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RateChangePerYear = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinReleaseRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinReleaseRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxReleaseRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxReleaseRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EmissionForecastPeriod              protoreflect.MessageDescriptor
+	fd_EmissionForecastPeriod_start_time   protoreflect.FieldDescriptor
+	fd_EmissionForecastPeriod_end_time     protoreflect.FieldDescriptor
+	fd_EmissionForecastPeriod_release_rate protoreflect.FieldDescriptor
+	fd_EmissionForecastPeriod_amount       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_reward_v1_types_proto_init()
+	md_EmissionForecastPeriod = File_initia_reward_v1_types_proto.Messages().ByName("EmissionForecastPeriod")
+	fd_EmissionForecastPeriod_start_time = md_EmissionForecastPeriod.Fields().ByName("start_time")
+	fd_EmissionForecastPeriod_end_time = md_EmissionForecastPeriod.Fields().ByName("end_time")
+	fd_EmissionForecastPeriod_release_rate = md_EmissionForecastPeriod.Fields().ByName("release_rate")
+	fd_EmissionForecastPeriod_amount = md_EmissionForecastPeriod.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_EmissionForecastPeriod)(nil)
+
+type fastReflection_EmissionForecastPeriod EmissionForecastPeriod
+
+func (x *EmissionForecastPeriod) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EmissionForecastPeriod)(x)
+}
+
+func (x *EmissionForecastPeriod) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_reward_v1_types_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EmissionForecastPeriod_messageType fastReflection_EmissionForecastPeriod_messageType
+var _ protoreflect.MessageType = fastReflection_EmissionForecastPeriod_messageType{}
+
+type fastReflection_EmissionForecastPeriod_messageType struct{}
+
+func (x fastReflection_EmissionForecastPeriod_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EmissionForecastPeriod)(nil)
+}
+func (x fastReflection_EmissionForecastPeriod_messageType) New() protoreflect.Message {
+	return new(fastReflection_EmissionForecastPeriod)
+}
+func (x fastReflection_EmissionForecastPeriod_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EmissionForecastPeriod
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EmissionForecastPeriod) Descriptor() protoreflect.MessageDescriptor {
+	return md_EmissionForecastPeriod
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EmissionForecastPeriod) Type() protoreflect.MessageType {
+	return _fastReflection_EmissionForecastPeriod_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EmissionForecastPeriod) New() protoreflect.Message {
+	return new(fastReflection_EmissionForecastPeriod)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EmissionForecastPeriod) Interface() protoreflect.ProtoMessage {
+	return (*EmissionForecastPeriod)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EmissionForecastPeriod) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StartTime != nil {
+		value := protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+		if !f(fd_EmissionForecastPeriod_start_time, value) {
+			return
+		}
+	}
+	if x.EndTime != nil {
+		value := protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+		if !f(fd_EmissionForecastPeriod_end_time, value) {
+			return
+		}
+	}
+	if x.ReleaseRate != "" {
+		value := protoreflect.ValueOfString(x.ReleaseRate)
+		if !f(fd_EmissionForecastPeriod_release_rate, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_EmissionForecastPeriod_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EmissionForecastPeriod) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.reward.v1.EmissionForecastPeriod.start_time":
+		return x.StartTime != nil
+	case "initia.reward.v1.EmissionForecastPeriod.end_time":
+		return x.EndTime != nil
+	case "initia.reward.v1.EmissionForecastPeriod.release_rate":
+		return x.ReleaseRate != ""
+	case "initia.reward.v1.EmissionForecastPeriod.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.EmissionForecastPeriod"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.EmissionForecastPeriod does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionForecastPeriod) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.reward.v1.EmissionForecastPeriod.start_time":
+		x.StartTime = nil
+	case "initia.reward.v1.EmissionForecastPeriod.end_time":
+		x.EndTime = nil
+	case "initia.reward.v1.EmissionForecastPeriod.release_rate":
+		x.ReleaseRate = ""
+	case "initia.reward.v1.EmissionForecastPeriod.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.EmissionForecastPeriod"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.EmissionForecastPeriod does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EmissionForecastPeriod) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.reward.v1.EmissionForecastPeriod.start_time":
+		value := x.StartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.reward.v1.EmissionForecastPeriod.end_time":
+		value := x.EndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.reward.v1.EmissionForecastPeriod.release_rate":
+		value := x.ReleaseRate
+		return protoreflect.ValueOfString(value)
+	case "initia.reward.v1.EmissionForecastPeriod.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.EmissionForecastPeriod"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.EmissionForecastPeriod does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionForecastPeriod) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.reward.v1.EmissionForecastPeriod.start_time":
+		x.StartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "initia.reward.v1.EmissionForecastPeriod.end_time":
+		x.EndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "initia.reward.v1.EmissionForecastPeriod.release_rate":
+		x.ReleaseRate = value.Interface().(string)
+	case "initia.reward.v1.EmissionForecastPeriod.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.EmissionForecastPeriod"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.EmissionForecastPeriod does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionForecastPeriod) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.reward.v1.EmissionForecastPeriod.start_time":
+		if x.StartTime == nil {
+			x.StartTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+	case "initia.reward.v1.EmissionForecastPeriod.end_time":
+		if x.EndTime == nil {
+			x.EndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+	case "initia.reward.v1.EmissionForecastPeriod.release_rate":
+		panic(fmt.Errorf("field release_rate of message initia.reward.v1.EmissionForecastPeriod is not mutable"))
+	case "initia.reward.v1.EmissionForecastPeriod.amount":
+		panic(fmt.Errorf("field amount of message initia.reward.v1.EmissionForecastPeriod is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.EmissionForecastPeriod"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.EmissionForecastPeriod does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EmissionForecastPeriod) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.reward.v1.EmissionForecastPeriod.start_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.reward.v1.EmissionForecastPeriod.end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.reward.v1.EmissionForecastPeriod.release_rate":
+		return protoreflect.ValueOfString("")
+	case "initia.reward.v1.EmissionForecastPeriod.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.reward.v1.EmissionForecastPeriod"))
+		}
+		panic(fmt.Errorf("message initia.reward.v1.EmissionForecastPeriod does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EmissionForecastPeriod) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.reward.v1.EmissionForecastPeriod", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EmissionForecastPeriod) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionForecastPeriod) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EmissionForecastPeriod) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EmissionForecastPeriod) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EmissionForecastPeriod)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.StartTime != nil {
+			l = options.Size(x.StartTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EndTime != nil {
+			l = options.Size(x.EndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReleaseRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EmissionForecastPeriod)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ReleaseRate) > 0 {
+			i -= len(x.ReleaseRate)
+			copy(dAtA[i:], x.ReleaseRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReleaseRate)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.EndTime != nil {
+			encoded, err := options.Marshal(x.EndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.StartTime != nil {
+			encoded, err := options.Marshal(x.StartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EmissionForecastPeriod)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EmissionForecastPeriod: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EmissionForecastPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StartTime == nil {
+					x.StartTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StartTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EndTime == nil {
+					x.EndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReleaseRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReleaseRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: initia/reward/v1/types.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ReleaseDestinationType enumerates the types of the release destinations.
+type ReleaseDestinationType int32
+
+const (
+	// RELEASE_DESTINATION_TYPE_ADDRESS sends the share to the account address.
+	ReleaseDestinationType_RELEASE_DESTINATION_TYPE_ADDRESS ReleaseDestinationType = 0
+	// RELEASE_DESTINATION_TYPE_MODULE sends the share to the module account of
+	// the module name.
+	ReleaseDestinationType_RELEASE_DESTINATION_TYPE_MODULE ReleaseDestinationType = 1
+	// RELEASE_DESTINATION_TYPE_COMMUNITY_POOL funds the community pool with the
+	// share.
+	ReleaseDestinationType_RELEASE_DESTINATION_TYPE_COMMUNITY_POOL ReleaseDestinationType = 2
+)
+
+// Enum value maps for ReleaseDestinationType.
+var (
+	ReleaseDestinationType_name = map[int32]string{
+		0: "RELEASE_DESTINATION_TYPE_ADDRESS",
+		1: "RELEASE_DESTINATION_TYPE_MODULE",
+		2: "RELEASE_DESTINATION_TYPE_COMMUNITY_POOL",
+	}
+	ReleaseDestinationType_value = map[string]int32{
+		"RELEASE_DESTINATION_TYPE_ADDRESS":        0,
+		"RELEASE_DESTINATION_TYPE_MODULE":         1,
+		"RELEASE_DESTINATION_TYPE_COMMUNITY_POOL": 2,
+	}
+)
+
+func (x ReleaseDestinationType) Enum() *ReleaseDestinationType {
+	p := new(ReleaseDestinationType)
+	*p = x
+	return p
+}
+
+func (x ReleaseDestinationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReleaseDestinationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_initia_reward_v1_types_proto_enumTypes[0].Descriptor()
+}
+
+func (ReleaseDestinationType) Type() protoreflect.EnumType {
+	return &file_initia_reward_v1_types_proto_enumTypes[0]
+}
+
+func (x ReleaseDestinationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReleaseDestinationType.Descriptor instead.
+func (ReleaseDestinationType) EnumDescriptor() ([]byte, []int) {
+	return file_initia_reward_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+// EmissionCurve enumerates the supported emission curves.
+type EmissionCurve int32
+
+const (
+	// EMISSION_CURVE_GEOMETRIC dilutes the release rate by the dilution rate
+	// every dilution period.
+	EmissionCurve_EMISSION_CURVE_GEOMETRIC EmissionCurve = 0
+	// EMISSION_CURVE_PIECEWISE_LINEAR interpolates the release rate linearly
+	// between the points of the schedule.
+	EmissionCurve_EMISSION_CURVE_PIECEWISE_LINEAR EmissionCurve = 1
+	// EMISSION_CURVE_FIXED_PER_BLOCK releases a fixed amount every block.
+	EmissionCurve_EMISSION_CURVE_FIXED_PER_BLOCK EmissionCurve = 2
+	// EMISSION_CURVE_TARGET_STAKING_RATIO adjusts the release rate toward the
+	// goal bonded ratio of the reward denom.
+	EmissionCurve_EMISSION_CURVE_TARGET_STAKING_RATIO EmissionCurve = 3
+)
+
+// Enum value maps for EmissionCurve.
+var (
+	EmissionCurve_name = map[int32]string{
+		0: "EMISSION_CURVE_GEOMETRIC",
+		1: "EMISSION_CURVE_PIECEWISE_LINEAR",
+		2: "EMISSION_CURVE_FIXED_PER_BLOCK",
+		3: "EMISSION_CURVE_TARGET_STAKING_RATIO",
+	}
+	EmissionCurve_value = map[string]int32{
+		"EMISSION_CURVE_GEOMETRIC":            0,
+		"EMISSION_CURVE_PIECEWISE_LINEAR":     1,
+		"EMISSION_CURVE_FIXED_PER_BLOCK":      2,
+		"EMISSION_CURVE_TARGET_STAKING_RATIO": 3,
+	}
+)
+
+func (x EmissionCurve) Enum() *EmissionCurve {
+	p := new(EmissionCurve)
+	*p = x
+	return p
+}
+
+func (x EmissionCurve) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmissionCurve) Descriptor() protoreflect.EnumDescriptor {
+	return file_initia_reward_v1_types_proto_enumTypes[1].Descriptor()
+}
+
+func (EmissionCurve) Type() protoreflect.EnumType {
+	return &file_initia_reward_v1_types_proto_enumTypes[1]
+}
+
+func (x EmissionCurve) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmissionCurve.Descriptor instead.
+func (EmissionCurve) EnumDescriptor() ([]byte, []int) {
+	return file_initia_reward_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+// Params defines the set of mint parameters.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RewardDenom    string               `protobuf:"bytes,1,opt,name=reward_denom,json=rewardDenom,proto3" json:"reward_denom,omitempty"`
+	DilutionPeriod *durationpb.Duration `protobuf:"bytes,2,opt,name=dilution_period,json=dilutionPeriod,proto3" json:"dilution_period,omitempty"`
+	ReleaseRate    string               `protobuf:"bytes,3,opt,name=release_rate,json=releaseRate,proto3" json:"release_rate,omitempty"`
+	// The dilution rate of release rate.
+	// if `(block.timestamp - last_dilute_timestamp) < dilution_period`:
+	//    `release_rate -= (release_rate * dilution_rate)`
+	//    `last_dilution_timestamp = block.timestamp`
+	DilutionRate   string `protobuf:"bytes,4,opt,name=dilution_rate,json=dilutionRate,proto3" json:"dilution_rate,omitempty"`
+	ReleaseEnabled bool   `protobuf:"varint,5,opt,name=release_enabled,json=releaseEnabled,proto3" json:"release_enabled,omitempty"`
+	// emission_schedule defines the curve to release the rewards.
+	EmissionSchedule *EmissionSchedule `protobuf:"bytes,6,opt,name=emission_schedule,json=emissionSchedule,proto3" json:"emission_schedule,omitempty"`
+	// release_destinations defines the destinations receiving the shares of the
+	// released rewards; the rest is sent to the fee collector as the staking
+	// rewards.
+	ReleaseDestinations []*ReleaseDestination `protobuf:"bytes,7,rep,name=release_destinations,json=releaseDestinations,proto3" json:"release_destinations,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_reward_v1_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_initia_reward_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetRewardDenom() string {
+	if x != nil {
+		return x.RewardDenom
+	}
+	return ""
+}
+
+func (x *Params) GetDilutionPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.DilutionPeriod
+	}
+	return nil
+}
+
+func (x *Params) GetReleaseRate() string {
+	if x != nil {
+		return x.ReleaseRate
+	}
+	return ""
+}
+
+func (x *Params) GetDilutionRate() string {
+	if x != nil {
+		return x.DilutionRate
+	}
+	return ""
+}
+
+func (x *Params) GetReleaseEnabled() bool {
+	if x != nil {
+		return x.ReleaseEnabled
+	}
+	return false
+}
+
+func (x *Params) GetEmissionSchedule() *EmissionSchedule {
+	if x != nil {
+		return x.EmissionSchedule
+	}
+	return nil
+}
+
+func (x *Params) GetReleaseDestinations() []*ReleaseDestination {
+	if x != nil {
+		return x.ReleaseDestinations
+	}
+	return nil
+}
+
+// ReleaseDestination defines a destination of the released rewards.
+type ReleaseDestination struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the unique name of the destination emitted with the events.
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DestinationType ReleaseDestinationType `protobuf:"varint,2,opt,name=destination_type,json=destinationType,proto3,enum=initia.reward.v1.ReleaseDestinationType" json:"destination_type,omitempty"`
+	// address is the account address for the address type and the module name
+	// for the module type; it is empty for the community pool type.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// ratio is the share of the released rewards sent to the destination.
+	Ratio string `protobuf:"bytes,4,opt,name=ratio,proto3" json:"ratio,omitempty"`
+}
+
+func (x *ReleaseDestination) Reset() {
+	*x = ReleaseDestination{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_reward_v1_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseDestination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseDestination) ProtoMessage() {}
+
+// Deprecated: Use ReleaseDestination.ProtoReflect.Descriptor instead.
+func (*ReleaseDestination) Descriptor() ([]byte, []int) {
+	return file_initia_reward_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReleaseDestination) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReleaseDestination) GetDestinationType() ReleaseDestinationType {
+	if x != nil {
+		return x.DestinationType
+	}
+	return ReleaseDestinationType_RELEASE_DESTINATION_TYPE_ADDRESS
+}
+
+func (x *ReleaseDestination) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ReleaseDestination) GetRatio() string {
+	if x != nil {
+		return x.Ratio
+	}
+	return ""
+}
+
+// EmissionSchedule defines the emission curve and its configuration; only the
+// configuration of the selected curve is used.
+type EmissionSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Curve              EmissionCurve               `protobuf:"varint,1,opt,name=curve,proto3,enum=initia.reward.v1.EmissionCurve" json:"curve,omitempty"`
+	PiecewiseLinear    *PiecewiseLinearEmission    `protobuf:"bytes,2,opt,name=piecewise_linear,json=piecewiseLinear,proto3" json:"piecewise_linear,omitempty"`
+	FixedPerBlock      *FixedPerBlockEmission      `protobuf:"bytes,3,opt,name=fixed_per_block,json=fixedPerBlock,proto3" json:"fixed_per_block,omitempty"`
+	TargetStakingRatio *TargetStakingRatioEmission `protobuf:"bytes,4,opt,name=target_staking_ratio,json=targetStakingRatio,proto3" json:"target_staking_ratio,omitempty"`
+}
+
+func (x *EmissionSchedule) Reset() {
+	*x = EmissionSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_reward_v1_types_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmissionSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmissionSchedule) ProtoMessage() {}
+
+// Deprecated: Use EmissionSchedule.ProtoReflect.Descriptor instead.
+func (*EmissionSchedule) Descriptor() ([]byte, []int) {
+	return file_initia_reward_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EmissionSchedule) GetCurve() EmissionCurve {
+	if x != nil {
+		return x.Curve
+	}
+	return EmissionCurve_EMISSION_CURVE_GEOMETRIC
+}
+
+func (x *EmissionSchedule) GetPiecewiseLinear() *PiecewiseLinearEmission {
+	if x != nil {
+		return x.PiecewiseLinear
+	}
+	return nil
+}
+
+func (x *EmissionSchedule) GetFixedPerBlock() *FixedPerBlockEmission {
+	if x != nil {
+		return x.FixedPerBlock
+	}
+	return nil
+}
+
+func (x *EmissionSchedule) GetTargetStakingRatio() *TargetStakingRatioEmission {
+	if x != nil {
+		return x.TargetStakingRatio
+	}
+	return nil
+}
+
+// EmissionPoint defines the release rate at a point of time.
+type EmissionPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	ReleaseRate string                 `protobuf:"bytes,2,opt,name=release_rate,json=releaseRate,proto3" json:"release_rate,omitempty"`
+}
+
+func (x *EmissionPoint) Reset() {
+	*x = EmissionPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_reward_v1_types_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmissionPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmissionPoint) ProtoMessage() {}
+
+// Deprecated: Use EmissionPoint.ProtoReflect.Descriptor instead.
+func (*EmissionPoint) Descriptor() ([]byte, []int) {
+	return file_initia_reward_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EmissionPoint) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *EmissionPoint) GetReleaseRate() string {
+	if x != nil {
+		return x.ReleaseRate
+	}
+	return ""
+}
+
+// PiecewiseLinearEmission defines the release rate curve by the points sorted
+// by time; the rate is constant before the first and after the last point.
+type PiecewiseLinearEmission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Points []*EmissionPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *PiecewiseLinearEmission) Reset() {
+	*x = PiecewiseLinearEmission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_reward_v1_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PiecewiseLinearEmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PiecewiseLinearEmission) ProtoMessage() {}
+
+// Deprecated: Use PiecewiseLinearEmission.ProtoReflect.Descriptor instead.
+func (*PiecewiseLinearEmission) Descriptor() ([]byte, []int) {
+	return file_initia_reward_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PiecewiseLinearEmission) GetPoints() []*EmissionPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// FixedPerBlockEmission defines the fixed amount released every block.
+type FixedPerBlockEmission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// blocks_per_year is the expected number of blocks per year, which is used
+	// to compute the annual provisions.
+	BlocksPerYear uint64 `protobuf:"varint,2,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+}
+
+func (x *FixedPerBlockEmission) Reset() {
+	*x = FixedPerBlockEmission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_reward_v1_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FixedPerBlockEmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FixedPerBlockEmission) ProtoMessage() {}
+
+// Deprecated: Use FixedPerBlockEmission.ProtoReflect.Descriptor instead.
+func (*FixedPerBlockEmission) Descriptor() ([]byte, []int) {
+	return file_initia_reward_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FixedPerBlockEmission) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *FixedPerBlockEmission) GetBlocksPerYear() uint64 {
+	if x != nil {
+		return x.BlocksPerYear
+	}
+	return 0
+}
+
+// TargetStakingRatioEmission defines the controller which moves the release
+// rate toward the goal bonded ratio like the inflation of the cosmos mint
+// module; the rate changes by `(1 - bonded_ratio / goal_bonded) *
+// rate_change_per_year` per year.
+type TargetStakingRatioEmission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GoalBonded        string `protobuf:"bytes,1,opt,name=goal_bonded,json=goalBonded,proto3" json:"goal_bonded,omitempty"`
+	RateChangePerYear string `protobuf:"bytes,2,opt,name=rate_change_per_year,json=rateChangePerYear,proto3" json:"rate_change_per_year,omitempty"`
+	MinReleaseRate    string `protobuf:"bytes,3,opt,name=min_release_rate,json=minReleaseRate,proto3" json:"min_release_rate,omitempty"`
+	MaxReleaseRate    string `protobuf:"bytes,4,opt,name=max_release_rate,json=maxReleaseRate,proto3" json:"max_release_rate,omitempty"`
+}
+
+func (x *TargetStakingRatioEmission) Reset() {
+	*x = TargetStakingRatioEmission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_reward_v1_types_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TargetStakingRatioEmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetStakingRatioEmission) ProtoMessage() {}
+
+// Deprecated: Use TargetStakingRatioEmission.ProtoReflect.Descriptor instead.
+func (*TargetStakingRatioEmission) Descriptor() ([]byte, []int) {
+	return file_initia_reward_v1_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TargetStakingRatioEmission) GetGoalBonded() string {
+	if x != nil {
+		return x.GoalBonded
+	}
+	return ""
+}
+
+func (x *TargetStakingRatioEmission) GetRateChangePerYear() string {
+	if x != nil {
+		return x.RateChangePerYear
+	}
+	return ""
+}
+
+func (x *TargetStakingRatioEmission) GetMinReleaseRate() string {
+	if x != nil {
+		return x.MinReleaseRate
+	}
+	return ""
+}
+
+func (x *TargetStakingRatioEmission) GetMaxReleaseRate() string {
+	if x != nil {
+		return x.MaxReleaseRate
+	}
+	return ""
+}
+
+// EmissionForecastPeriod defines the forecasted emission of a period.
+type EmissionForecastPeriod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// release_rate is the release rate at the start of the period.
+	ReleaseRate string `protobuf:"bytes,3,opt,name=release_rate,json=releaseRate,proto3" json:"release_rate,omitempty"`
+	// amount is the amount of the reward denom released during the period.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *EmissionForecastPeriod) Reset() {
+	*x = EmissionForecastPeriod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_reward_v1_types_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmissionForecastPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmissionForecastPeriod) ProtoMessage() {}
+
+// Deprecated: Use EmissionForecastPeriod.ProtoReflect.Descriptor instead.
+func (*EmissionForecastPeriod) Descriptor() ([]byte, []int) {
+	return file_initia_reward_v1_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EmissionForecastPeriod) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *EmissionForecastPeriod) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *EmissionForecastPeriod) GetReleaseRate() string {
+	if x != nil {
+		return x.ReleaseRate
+	}
+	return ""
+}
+
+func (x *EmissionForecastPeriod) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+var File_initia_reward_v1_types_proto protoreflect.FileDescriptor
+
+var file_initia_reward_v1_types_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2f,
+	0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
 	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x84, 0x03, 0x0a, 0x06, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x64,
-	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x51, 0x0a, 0x0f, 0x64, 0x69, 0x6c, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f,
-	0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x64, 0x69, 0x6c, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x5d, 0x0a, 0x0c, 0x72, 0x65,
-	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x3a, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc4, 0x04, 0x0a, 0x06, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x51, 0x0a, 0x0f, 0x64, 0x69, 0x6c, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde,
+	0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x64, 0x69, 0x6c,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x5d, 0x0a, 0x0c, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x3a, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0x52, 0x0b, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x60, 0x0a, 0x0d, 0x64, 0x69,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x3b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x64, 0x69, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0x52, 0x0c,
+	0x64, 0x69, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x5a, 0x0a, 0x11, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x10, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x62, 0x0a, 0x14, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x13, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x1a, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x8a,
+	0xe7, 0xb0, 0x2a, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x22, 0xd2, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x53, 0x0a, 0x10,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x05, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52,
+	0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x22, 0xd0, 0x02, 0x0a, 0x10, 0x45, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x63,
+	0x75, 0x72, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x76, 0x65, 0x52, 0x05, 0x63, 0x75, 0x72,
+	0x76, 0x65, 0x12, 0x54, 0x0a, 0x10, 0x70, 0x69, 0x65, 0x63, 0x65, 0x77, 0x69, 0x73, 0x65, 0x5f,
+	0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x69, 0x65, 0x63, 0x65, 0x77, 0x69, 0x73, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x45,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x70, 0x69, 0x65, 0x63, 0x65, 0x77, 0x69,
+	0x73, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x12, 0x4f, 0x0a, 0x0f, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x78, 0x65, 0x64, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x5e, 0x0a, 0x14, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x45, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x22, 0x96, 0x01, 0x0a, 0x0d, 0x45, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
-	0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72,
-	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0x52, 0x0b, 0x72, 0x65,
-	0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x60, 0x0a, 0x0d, 0x64, 0x69, 0x6c,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x3b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
-	0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64,
-	0x69, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0x52, 0x0c, 0x64,
-	0x69, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72,
-	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x3a, 0x1a, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7,
-	0xb0, 0x2a, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x42, 0xc9, 0x01, 0xc8, 0xe1, 0x1e, 0x00, 0xa8, 0xe2, 0x1e, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d,
-	0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76,
-	0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x2f, 0x76, 0x31, 0x3b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49,
-	0x52, 0x58, 0xaa, 0x02, 0x10, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x49, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x5c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x3a, 0x3a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x22, 0x5d, 0x0a, 0x17, 0x50, 0x69, 0x65, 0x63, 0x65, 0x77, 0x69, 0x73, 0x65, 0x4c,
+	0x69, 0x6e, 0x65, 0x61, 0x72, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a,
+	0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x22, 0x76, 0x0a, 0x15, 0x46, 0x69, 0x78, 0x65, 0x64, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x79, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x50, 0x65, 0x72, 0x59, 0x65, 0x61, 0x72, 0x22, 0xd6, 0x02, 0x0a, 0x1a, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x69, 0x6f,
+	0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x0b, 0x67, 0x6f, 0x61, 0x6c,
+	0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0x52, 0x0a, 0x67, 0x6f, 0x61, 0x6c, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x54,
+	0x0a, 0x14, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0x52, 0x11, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x65, 0x72,
+	0x59, 0x65, 0x61, 0x72, 0x12, 0x4d, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x22, 0xa7, 0x02, 0x0a, 0x16, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46,
+	0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x48, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8,
+	0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x46, 0x0a,
+	0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x2a, 0x84, 0x02, 0x0a,
+	0x16, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x47, 0x0a, 0x20, 0x52, 0x45, 0x4c, 0x45, 0x41,
+	0x53, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10, 0x00, 0x1a, 0x21, 0x8a,
+	0x9d, 0x20, 0x1d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x45, 0x0a, 0x1f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x54,
+	0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x44,
+	0x55, 0x4c, 0x45, 0x10, 0x01, 0x1a, 0x20, 0x8a, 0x9d, 0x20, 0x1c, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x54, 0x0a, 0x27, 0x52, 0x45, 0x4c, 0x45, 0x41,
+	0x53, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x55, 0x4e, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x4f,
+	0x4f, 0x4c, 0x10, 0x02, 0x1a, 0x27, 0x8a, 0x9d, 0x20, 0x23, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x1a, 0x04, 0x88,
+	0xa3, 0x1e, 0x00, 0x2a, 0xa8, 0x02, 0x0a, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x43, 0x75, 0x72, 0x76, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x45, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x43, 0x55, 0x52, 0x56, 0x45, 0x5f, 0x47, 0x45, 0x4f, 0x4d, 0x45, 0x54, 0x52, 0x49,
+	0x43, 0x10, 0x00, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x43, 0x75, 0x72, 0x76, 0x65, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12,
+	0x45, 0x0a, 0x1f, 0x45, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x55, 0x52, 0x56,
+	0x45, 0x5f, 0x50, 0x49, 0x45, 0x43, 0x45, 0x57, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x45,
+	0x41, 0x52, 0x10, 0x01, 0x1a, 0x20, 0x8a, 0x9d, 0x20, 0x1c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x43, 0x75, 0x72, 0x76, 0x65, 0x50, 0x69, 0x65, 0x63, 0x65, 0x77, 0x69, 0x73, 0x65,
+	0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x12, 0x42, 0x0a, 0x1e, 0x45, 0x4d, 0x49, 0x53, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x43, 0x55, 0x52, 0x56, 0x45, 0x5f, 0x46, 0x49, 0x58, 0x45, 0x44, 0x5f, 0x50,
+	0x45, 0x52, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x02, 0x1a, 0x1e, 0x8a, 0x9d, 0x20, 0x1a,
+	0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x76, 0x65, 0x46, 0x69, 0x78,
+	0x65, 0x64, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x4c, 0x0a, 0x23, 0x45, 0x4d,
+	0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x55, 0x52, 0x56, 0x45, 0x5f, 0x54, 0x41, 0x52,
+	0x47, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x41, 0x54, 0x49,
+	0x4f, 0x10, 0x03, 0x1a, 0x23, 0x8a, 0x9d, 0x20, 0x1f, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x43, 0x75, 0x72, 0x76, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xc9,
+	0x01, 0xc8, 0xe1, 0x1e, 0x00, 0xa8, 0xe2, 0x1e, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x42,
+	0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2f, 0x76,
+	0x31, 0x3b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x52, 0x58,
+	0xaa, 0x02, 0x10, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x3a, 0x3a,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -845,18 +5673,40 @@ func file_initia_reward_v1_types_proto_rawDescGZIP() []byte {
 	return file_initia_reward_v1_types_proto_rawDescData
 }
 
-var file_initia_reward_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_initia_reward_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_initia_reward_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_initia_reward_v1_types_proto_goTypes = []interface{}{
-	(*Params)(nil),              // 0: initia.reward.v1.Params
-	(*durationpb.Duration)(nil), // 1: google.protobuf.Duration
+	(ReleaseDestinationType)(0),        // 0: initia.reward.v1.ReleaseDestinationType
+	(EmissionCurve)(0),                 // 1: initia.reward.v1.EmissionCurve
+	(*Params)(nil),                     // 2: initia.reward.v1.Params
+	(*ReleaseDestination)(nil),         // 3: initia.reward.v1.ReleaseDestination
+	(*EmissionSchedule)(nil),           // 4: initia.reward.v1.EmissionSchedule
+	(*EmissionPoint)(nil),              // 5: initia.reward.v1.EmissionPoint
+	(*PiecewiseLinearEmission)(nil),    // 6: initia.reward.v1.PiecewiseLinearEmission
+	(*FixedPerBlockEmission)(nil),      // 7: initia.reward.v1.FixedPerBlockEmission
+	(*TargetStakingRatioEmission)(nil), // 8: initia.reward.v1.TargetStakingRatioEmission
+	(*EmissionForecastPeriod)(nil),     // 9: initia.reward.v1.EmissionForecastPeriod
+	(*durationpb.Duration)(nil),        // 10: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),      // 11: google.protobuf.Timestamp
 }
 var file_initia_reward_v1_types_proto_depIdxs = []int32{
-	1, // 0: initia.reward.v1.Params.dilution_period:type_name -> google.protobuf.Duration
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	10, // 0: initia.reward.v1.Params.dilution_period:type_name -> google.protobuf.Duration
+	4,  // 1: initia.reward.v1.Params.emission_schedule:type_name -> initia.reward.v1.EmissionSchedule
+	3,  // 2: initia.reward.v1.Params.release_destinations:type_name -> initia.reward.v1.ReleaseDestination
+	0,  // 3: initia.reward.v1.ReleaseDestination.destination_type:type_name -> initia.reward.v1.ReleaseDestinationType
+	1,  // 4: initia.reward.v1.EmissionSchedule.curve:type_name -> initia.reward.v1.EmissionCurve
+	6,  // 5: initia.reward.v1.EmissionSchedule.piecewise_linear:type_name -> initia.reward.v1.PiecewiseLinearEmission
+	7,  // 6: initia.reward.v1.EmissionSchedule.fixed_per_block:type_name -> initia.reward.v1.FixedPerBlockEmission
+	8,  // 7: initia.reward.v1.EmissionSchedule.target_staking_ratio:type_name -> initia.reward.v1.TargetStakingRatioEmission
+	11, // 8: initia.reward.v1.EmissionPoint.time:type_name -> google.protobuf.Timestamp
+	5,  // 9: initia.reward.v1.PiecewiseLinearEmission.points:type_name -> initia.reward.v1.EmissionPoint
+	11, // 10: initia.reward.v1.EmissionForecastPeriod.start_time:type_name -> google.protobuf.Timestamp
+	11, // 11: initia.reward.v1.EmissionForecastPeriod.end_time:type_name -> google.protobuf.Timestamp
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_initia_reward_v1_types_proto_init() }
@@ -877,19 +5727,104 @@ func file_initia_reward_v1_types_proto_init() {
 				return nil
 			}
 		}
+		file_initia_reward_v1_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseDestination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_reward_v1_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmissionSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_reward_v1_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmissionPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_reward_v1_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PiecewiseLinearEmission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_reward_v1_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FixedPerBlockEmission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_reward_v1_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TargetStakingRatioEmission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_reward_v1_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmissionForecastPeriod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_initia_reward_v1_types_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      2,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_initia_reward_v1_types_proto_goTypes,
 		DependencyIndexes: file_initia_reward_v1_types_proto_depIdxs,
+		EnumInfos:         file_initia_reward_v1_types_proto_enumTypes,
 		MessageInfos:      file_initia_reward_v1_types_proto_msgTypes,
 	}.Build()
 	File_initia_reward_v1_types_proto = out.File
@@ -0,0 +1,193 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: initia/slashing/v1/query.proto
+
+package slashingv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_MissedBlocks_FullMethodName    = "/initia.slashing.v1.Query/MissedBlocks"
+	Query_ValidatorUptime_FullMethodName = "/initia.slashing.v1.Query/ValidatorUptime"
+	Query_GraceMode_FullMethodName       = "/initia.slashing.v1.Query/GraceMode"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// MissedBlocks queries the missed blocks of a validator in the current
+	// signed blocks window.
+	MissedBlocks(ctx context.Context, in *QueryMissedBlocksRequest, opts ...grpc.CallOption) (*QueryMissedBlocksResponse, error)
+	// ValidatorUptime queries the uptime of a validator over the current
+	// signed blocks window.
+	ValidatorUptime(ctx context.Context, in *QueryValidatorUptimeRequest, opts ...grpc.CallOption) (*QueryValidatorUptimeResponse, error)
+	// GraceMode queries the grace mode suspending the downtime jailing.
+	GraceMode(ctx context.Context, in *QueryGraceModeRequest, opts ...grpc.CallOption) (*QueryGraceModeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) MissedBlocks(ctx context.Context, in *QueryMissedBlocksRequest, opts ...grpc.CallOption) (*QueryMissedBlocksResponse, error) {
+	out := new(QueryMissedBlocksResponse)
+	err := c.cc.Invoke(ctx, Query_MissedBlocks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorUptime(ctx context.Context, in *QueryValidatorUptimeRequest, opts ...grpc.CallOption) (*QueryValidatorUptimeResponse, error) {
+	out := new(QueryValidatorUptimeResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorUptime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GraceMode(ctx context.Context, in *QueryGraceModeRequest, opts ...grpc.CallOption) (*QueryGraceModeResponse, error) {
+	out := new(QueryGraceModeResponse)
+	err := c.cc.Invoke(ctx, Query_GraceMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// MissedBlocks queries the missed blocks of a validator in the current
+	// signed blocks window.
+	MissedBlocks(context.Context, *QueryMissedBlocksRequest) (*QueryMissedBlocksResponse, error)
+	// ValidatorUptime queries the uptime of a validator over the current
+	// signed blocks window.
+	ValidatorUptime(context.Context, *QueryValidatorUptimeRequest) (*QueryValidatorUptimeResponse, error)
+	// GraceMode queries the grace mode suspending the downtime jailing.
+	GraceMode(context.Context, *QueryGraceModeRequest) (*QueryGraceModeResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) MissedBlocks(context.Context, *QueryMissedBlocksRequest) (*QueryMissedBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissedBlocks not implemented")
+}
+func (UnimplementedQueryServer) ValidatorUptime(context.Context, *QueryValidatorUptimeRequest) (*QueryValidatorUptimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorUptime not implemented")
+}
+func (UnimplementedQueryServer) GraceMode(context.Context, *QueryGraceModeRequest) (*QueryGraceModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GraceMode not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_MissedBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissedBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MissedBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MissedBlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MissedBlocks(ctx, req.(*QueryMissedBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorUptime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorUptimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorUptime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorUptime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorUptime(ctx, req.(*QueryValidatorUptimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GraceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGraceModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GraceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GraceMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GraceMode(ctx, req.(*QueryGraceModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "initia.slashing.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MissedBlocks",
+			Handler:    _Query_MissedBlocks_Handler,
+		},
+		{
+			MethodName: "ValidatorUptime",
+			Handler:    _Query_ValidatorUptime_Handler,
+		},
+		{
+			MethodName: "GraceMode",
+			Handler:    _Query_GraceMode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "initia/slashing/v1/query.proto",
+}
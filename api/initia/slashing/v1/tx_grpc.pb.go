@@ -0,0 +1,154 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: initia/slashing/v1/tx.proto
+
+package slashingv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_ActivateGraceMode_FullMethodName   = "/initia.slashing.v1.Msg/ActivateGraceMode"
+	Msg_DeactivateGraceMode_FullMethodName = "/initia.slashing.v1.Msg/DeactivateGraceMode"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	// ActivateGraceMode defines a governance operation for suspending the
+	// downtime jailing for a bounded number of blocks.
+	ActivateGraceMode(ctx context.Context, in *MsgActivateGraceMode, opts ...grpc.CallOption) (*MsgActivateGraceModeResponse, error)
+	// DeactivateGraceMode defines a governance operation for ending the grace
+	// mode before its end height.
+	DeactivateGraceMode(ctx context.Context, in *MsgDeactivateGraceMode, opts ...grpc.CallOption) (*MsgDeactivateGraceModeResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ActivateGraceMode(ctx context.Context, in *MsgActivateGraceMode, opts ...grpc.CallOption) (*MsgActivateGraceModeResponse, error) {
+	out := new(MsgActivateGraceModeResponse)
+	err := c.cc.Invoke(ctx, Msg_ActivateGraceMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeactivateGraceMode(ctx context.Context, in *MsgDeactivateGraceMode, opts ...grpc.CallOption) (*MsgDeactivateGraceModeResponse, error) {
+	out := new(MsgDeactivateGraceModeResponse)
+	err := c.cc.Invoke(ctx, Msg_DeactivateGraceMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// ActivateGraceMode defines a governance operation for suspending the
+	// downtime jailing for a bounded number of blocks.
+	ActivateGraceMode(context.Context, *MsgActivateGraceMode) (*MsgActivateGraceModeResponse, error)
+	// DeactivateGraceMode defines a governance operation for ending the grace
+	// mode before its end height.
+	DeactivateGraceMode(context.Context, *MsgDeactivateGraceMode) (*MsgDeactivateGraceModeResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) ActivateGraceMode(context.Context, *MsgActivateGraceMode) (*MsgActivateGraceModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateGraceMode not implemented")
+}
+func (UnimplementedMsgServer) DeactivateGraceMode(context.Context, *MsgDeactivateGraceMode) (*MsgDeactivateGraceModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateGraceMode not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_ActivateGraceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgActivateGraceMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ActivateGraceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ActivateGraceMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ActivateGraceMode(ctx, req.(*MsgActivateGraceMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeactivateGraceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeactivateGraceMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeactivateGraceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeactivateGraceMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeactivateGraceMode(ctx, req.(*MsgDeactivateGraceMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "initia.slashing.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ActivateGraceMode",
+			Handler:    _Msg_ActivateGraceMode_Handler,
+		},
+		{
+			MethodName: "DeactivateGraceMode",
+			Handler:    _Msg_DeactivateGraceMode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "initia/slashing/v1/tx.proto",
+}
@@ -27,6 +27,7 @@ import (
 
 	feegrantmodule "cosmossdk.io/x/feegrant/module"
 	"cosmossdk.io/x/upgrade"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 
 	ica "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts"
@@ -140,6 +141,61 @@ func TestUpgradeStateOnGenesis(t *testing.T) {
 	}
 }
 
+func TestMigrationUpgradeHandler(t *testing.T) {
+	app := SetupWithGenesisAccounts(nil, nil)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 1})
+
+	// the params stored by the version 1
+	govParams, err := app.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	govParams.EmergencyEscalationThreshold = ""
+	govParams.OptimisticRejectionThreshold = ""
+	require.NoError(t, app.GovKeeper.Params.Set(ctx, govParams))
+
+	stakingParams, err := app.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	stakingParams.PowerSnapshotInterval = 0
+	stakingParams.PowerSnapshotEntries = 0
+	stakingParams.MaxConsPubkeyRotations = 0
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, stakingParams))
+
+	distrParams, err := app.DistrKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	distrParams.GaugeCreationFee = nil
+	distrParams.MaxActiveGauges = 0
+	require.NoError(t, app.DistrKeeper.Params.Set(ctx, distrParams))
+
+	vm, err := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	vm["gov"] = 1
+	vm["mstaking"] = 1
+	vm["distribution"] = 1
+	require.NoError(t, app.UpgradeKeeper.SetModuleVersionMap(ctx, vm))
+
+	err = app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: migrationUpgradeName, Height: 1})
+	require.NoError(t, err)
+
+	vm, err = app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	require.Equal(t, gov.AppModule{}.ConsensusVersion(), vm["gov"])
+	require.Equal(t, staking.AppModule{}.ConsensusVersion(), vm["mstaking"])
+	require.Equal(t, distribution.AppModule{}.ConsensusVersion(), vm["distribution"])
+
+	govParams, err = app.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, govParams.EmergencyEscalationThreshold)
+	require.NotEmpty(t, govParams.OptimisticRejectionThreshold)
+
+	stakingParams, err = app.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.NotZero(t, stakingParams.PowerSnapshotInterval)
+	require.NotZero(t, stakingParams.MaxConsPubkeyRotations)
+
+	distrParams, err = app.DistrKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.NotZero(t, distrParams.MaxActiveGauges)
+}
+
 func TestGetKey(t *testing.T) {
 	db := dbm.NewMemDB()
 	app := NewInitiaApp(
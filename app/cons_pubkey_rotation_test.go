@@ -0,0 +1,158 @@
+package app
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	testutilsims "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
+)
+
+func finalizeBlockWithMsgs(t *testing.T, app *InitiaApp, blockTime time.Time, votes []abci.VoteInfo, msgs []sdk.Msg, accNum, accSeq uint64, priv cryptotypes.PrivKey) *abci.ResponseFinalizeBlock {
+	txConfig := MakeEncodingConfig().TxConfig
+
+	var txs [][]byte
+	if len(msgs) > 0 {
+		tx, err := testutilsims.GenSignedMockTx(
+			rand.New(rand.NewSource(time.Now().UnixNano())),
+			txConfig,
+			msgs,
+			sdk.Coins{sdk.NewInt64Coin(BondDenom, 0)},
+			testutilsims.DefaultGenTxGas,
+			"",
+			[]uint64{accNum},
+			[]uint64{accSeq},
+			priv,
+		)
+		require.NoError(t, err)
+
+		bz, err := txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		txs = append(txs, bz)
+	}
+
+	res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height:            app.LastBlockHeight() + 1,
+		Time:              blockTime,
+		Txs:               txs,
+		DecidedLastCommit: abci.CommitInfo{Votes: votes},
+	})
+	require.NoError(t, err)
+	for _, txResult := range res.TxResults {
+		require.Equal(t, uint32(0), txResult.Code, txResult.Log)
+	}
+
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	return res
+}
+
+func TestConsPubKeyRotation(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	valAddr := sdk.ValAddress(addr)
+	genCoins := sdk.NewCoins(sdk.NewCoin(BondDenom, math.NewInt(10_000_000)))
+
+	app := SetupWithGenesisAccounts(nil, authtypes.GenesisAccounts{
+		&authtypes.BaseAccount{Address: addr.String()},
+	},
+		banktypes.Balance{Address: addr.String(), Coins: genCoins},
+	)
+
+	blockTime := time.Now().UTC()
+	oldKey := ed25519.GenPrivKey()
+	newKey := ed25519.GenPrivKey()
+
+	// create validator
+	bondCoin := sdk.NewCoin(BondDenom, math.NewInt(5_000_000))
+	createValidatorMsg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr.String(), oldKey.PubKey(), sdk.NewCoins(bondCoin),
+		stakingtypes.NewDescription("foo_moniker", "", "", "", ""),
+		stakingtypes.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()),
+	)
+	require.NoError(t, err)
+
+	res := finalizeBlockWithMsgs(t, app, blockTime, nil, []sdk.Msg{createValidatorMsg}, 0, 0, priv)
+	require.Len(t, res.ValidatorUpdates, 1)
+
+	oldCmtPubKey, err := cryptocodec.ToCmtProtoPublicKey(oldKey.PubKey())
+	require.NoError(t, err)
+	require.Equal(t, oldCmtPubKey, res.ValidatorUpdates[0].PubKey)
+	power := res.ValidatorUpdates[0].Power
+
+	// rotate consensus key
+	rotateMsg, err := stakingtypes.NewMsgRotateConsPubKey(valAddr.String(), newKey.PubKey())
+	require.NoError(t, err)
+
+	blockTime = blockTime.Add(time.Second)
+	oldVotes := []abci.VoteInfo{{
+		Validator:   abci.Validator{Address: oldKey.PubKey().Address(), Power: power},
+		BlockIdFlag: tmproto.BlockIDFlagCommit,
+	}}
+	res = finalizeBlockWithMsgs(t, app, blockTime, oldVotes, []sdk.Msg{rotateMsg}, 0, 1, priv)
+
+	// the old key is removed and the new key is added with the same power
+	newCmtPubKey, err := cryptocodec.ToCmtProtoPublicKey(newKey.PubKey())
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{
+		{PubKey: oldCmtPubKey, Power: 0},
+		{PubKey: newCmtPubKey, Power: power},
+	}, res.ValidatorUpdates)
+
+	ctx := app.BaseApp.NewContext(true)
+	params, err := app.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, genCoins.Sub(bondCoin).Sub(params.KeyRotationFee), app.BankKeeper.GetAllBalances(ctx, addr))
+
+	// the votes signed with the old key are still handled until the update is applied
+	blockTime = blockTime.Add(time.Second)
+	finalizeBlockWithMsgs(t, app, blockTime, oldVotes, nil, 0, 0, nil)
+
+	newVotes := []abci.VoteInfo{{
+		Validator:   abci.Validator{Address: newKey.PubKey().Address(), Power: power},
+		BlockIdFlag: tmproto.BlockIDFlagCommit,
+	}}
+	blockTime = blockTime.Add(time.Second)
+	finalizeBlockWithMsgs(t, app, blockTime, newVotes, nil, 0, 0, nil)
+
+	ctx = app.BaseApp.NewContext(true)
+	signingInfo, err := app.SlashingKeeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(newKey.PubKey().Address()))
+	require.NoError(t, err)
+	require.Equal(t, int64(3), signingInfo.IndexOffset)
+	require.Equal(t, int64(0), signingInfo.MissedBlocksCounter)
+
+	// the old key is released after the unbonding period
+	_, err = app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(oldKey.PubKey().Address()))
+	require.NoError(t, err)
+
+	blockTime = blockTime.Add(params.UnbondingTime)
+	finalizeBlockWithMsgs(t, app, blockTime, newVotes, nil, 0, 0, nil)
+
+	ctx = app.BaseApp.NewContext(true)
+	_, err = app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(oldKey.PubKey().Address()))
+	require.Error(t, err)
+	_, err = app.SlashingKeeper.GetPubkey(ctx, oldKey.PubKey().Address())
+	require.Error(t, err)
+
+	validator, err := app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(newKey.PubKey().Address()))
+	require.NoError(t, err)
+	require.Equal(t, valAddr.String(), validator.GetOperator())
+	require.Equal(t, stakingtypes.Bonded, validator.GetStatus())
+}
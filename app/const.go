@@ -56,7 +56,9 @@ const (
 	stakingMsgDelegate                   = "/initia.mstaking.v1.MsgDelegate"
 	stakingMsgUndelegate                 = "/initia.mstaking.v1.MsgUndelegate"
 	stakingMsgBeginRedelegate            = "/initia.mstaking.v1.MsgBeginRedelegate"
+	stakingMsgSwapDelegationDenom        = "/initia.mstaking.v1.MsgSwapDelegationDenom"
 	stakingMsgCreateValidator            = "/initia.mstaking.v1.MsgCreateValidator"
+	stakingMsgRotateConsPubKey           = "/initia.mstaking.v1.MsgRotateConsPubKey"
 	moveMsgPublishModuleBundle           = "/initia.move.v1.MsgPublish"
 	moveMsgExecuteEntryFunction          = "/initia.move.v1.MsgExecute"
 	moveMsgExecuteScript                 = "/initia.move.v1.MsgScript"
@@ -53,6 +53,7 @@ func (genState GenesisState) ConfigureBondDenom(cdc codec.JSONCodec, bondDenom s
 	var stakingGenState stakingtypes.GenesisState
 	cdc.MustUnmarshalJSON(genState[stakingtypes.ModuleName], &stakingGenState)
 	stakingGenState.Params.BondDenoms = []string{bondDenom}
+	stakingGenState.Params.KeyRotationFee.Denom = bondDenom
 	genState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(&stakingGenState)
 
 	var distrGenState customdistrtypes.GenesisState
@@ -192,7 +193,9 @@ func (genState GenesisState) ConfigureICA(cdc codec.JSONCodec) GenesisState {
 			stakingMsgDelegate,
 			stakingMsgUndelegate,
 			stakingMsgBeginRedelegate,
+			stakingMsgSwapDelegationDenom,
 			stakingMsgCreateValidator,
+			stakingMsgRotateConsPubKey,
 			transferMsgTransfer,
 			nftTransferMsgTransfer,
 			moveMsgPublishModuleBundle,
@@ -240,6 +240,7 @@ func NewAppKeeper(
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
 		movekeeper.NewVotingPowerKeeper(appKeepers.MoveKeeper),
+		movekeeper.NewDexKeeper(appKeepers.MoveKeeper),
 		authorityAddr,
 		vc,
 		cc,
@@ -261,9 +262,11 @@ func NewAppKeeper(
 		appKeepers.BankKeeper,
 		appKeepers.StakingKeeper,
 		movekeeper.NewDexKeeper(appKeepers.MoveKeeper),
+		appKeepers.RewardKeeper,
 		authtypes.FeeCollectorName,
 		authorityAddr,
 	)
+	appKeepers.RewardKeeper.SetCommunityPoolKeeper(appKeepers.DistrKeeper)
 
 	slashingKeeper := slashingkeeper.NewKeeper(
 		appCodec,
@@ -696,7 +699,8 @@ func NewAppKeeper(
 	govConfig := govtypes.DefaultConfig()
 	appKeepers.GovKeeper = govkeeper.NewKeeper(
 		appCodec, runtime.NewKVStoreService(appKeepers.keys[govtypes.StoreKey]), appKeepers.AccountKeeper, appKeepers.BankKeeper,
-		appKeepers.StakingKeeper, appKeepers.DistrKeeper, movekeeper.NewVestingKeeper(appKeepers.MoveKeeper), bApp.MsgServiceRouter(), govConfig, authorityAddr,
+		appKeepers.StakingKeeper, appKeepers.DistrKeeper, movekeeper.NewVestingKeeper(appKeepers.MoveKeeper), movekeeper.NewMoveGovKeeper(appKeepers.MoveKeeper),
+		bApp.MsgServiceRouter(), govConfig, authorityAddr,
 	)
 
 	return appKeepers
@@ -12,7 +12,13 @@ import (
 	vmtypes "github.com/initia-labs/movevm/types"
 )
 
-const upgradeName = "0.5.4"
+const (
+	upgradeName = "0.5.4"
+
+	// migrationUpgradeName runs the in-place store migrations of the modules bumped to
+	// their version 2, e.g. the staking, distribution and gov params.
+	migrationUpgradeName = "0.5.5"
+)
 
 // RegisterUpgradeHandlers returns upgrade handlers
 func (app *InitiaApp) RegisterUpgradeHandlers(cfg module.Configurator) {
@@ -45,4 +51,11 @@ func (app *InitiaApp) RegisterUpgradeHandlers(cfg module.Configurator) {
 			return vm, nil
 		},
 	)
+
+	app.UpgradeKeeper.SetUpgradeHandler(
+		migrationUpgradeName,
+		func(ctx context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+			return app.ModuleManager.RunMigrations(ctx, cfg, vm)
+		},
+	)
 }
@@ -319,6 +319,7 @@ func _createTestInput(
 		accountKeeper,
 		bankKeeper,
 		movekeeper.NewVotingPowerKeeper(moveKeeper),
+		movekeeper.NewDexKeeper(moveKeeper),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		vc, cc,
 	)
@@ -345,6 +346,7 @@ func _createTestInput(
 		bankKeeper,
 		stakingKeeper,
 		movekeeper.NewDexKeeper(moveKeeper),
+		rewardKeeper,
 		authtypes.FeeCollectorName,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -414,6 +416,7 @@ func _createTestInput(
 		stakingKeeper,
 		distKeeper,
 		movekeeper.NewVestingKeeper(moveKeeper),
+		movekeeper.NewMoveGovKeeper(moveKeeper),
 		msgRouter,
 		govConfig,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
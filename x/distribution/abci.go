@@ -29,6 +29,16 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) error {
 		}
 	}
 
+	// pay out the payment streams from the community pool
+	if err := k.ReleasePaymentStreams(ctx); err != nil {
+		return err
+	}
+
+	// recompute the reward weights for the next allocations
+	if err := k.UpdateDynamicRewardWeights(ctx); err != nil {
+		return err
+	}
+
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(ctx.BlockHeader().ProposerAddress)
 	return k.PreviousProposerConsAddr.Set(ctx, consAddr)
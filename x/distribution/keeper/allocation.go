@@ -2,7 +2,10 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"maps"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	abci "github.com/cometbft/cometbft/abci/types"
 
@@ -72,8 +75,26 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 
 	// map iteration not guarantee the ordering,
 	// so we have to use array for iteration.
-	rewardWeights, rewardWeightMap, weightsSum := k.LoadRewardWeights(ctx, params)
-	validators, bondedTokens, bondedTokensSum, err := k.LoadBondedTokens(ctx, bondedVotes, rewardWeightMap)
+	rewardWeights, rewardWeightMap, weightsSum, err := k.LoadRewardWeights(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	// load the bonded tokens of the gauge denoms too, which could be
+	// removed from the reward weights after the gauge creation.
+	gauges, err := k.GetActiveGauges(ctx)
+	if err != nil {
+		return err
+	}
+
+	poolDenoms := maps.Clone(rewardWeightMap)
+	for _, gauge := range gauges {
+		if _, found := poolDenoms[gauge.Denom]; !found {
+			poolDenoms[gauge.Denom] = math.LegacyZeroDec()
+		}
+	}
+
+	validators, bondedTokens, bondedTokensSum, err := k.LoadBondedTokens(ctx, bondedVotes, poolDenoms)
 	if err != nil {
 		return err
 	}
@@ -113,16 +134,38 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 		}
 	}
 
+	// release the incentive gauges
+	gaugeRemaining, err := k.allocateGaugeRewards(ctx, gauges, validators, bondedTokens, bondedTokensSum)
+	if err != nil {
+		return err
+	}
+	remaining = remaining.Add(gaugeRemaining...)
+
 	// allocate community funding
 	feePool.CommunityPool = feePool.CommunityPool.Add(remaining...)
 	return k.FeePool.Set(ctx, feePool)
 }
 
-// LoadRewardWeights load reward weights with its sum
+// LoadRewardWeights load reward weights with its sum. In the dynamic reward weights mode,
+// the computed weights replace the weights of the params; the denom not computed yet
+// uses the weight of the params.
 func (k Keeper) LoadRewardWeights(ctx context.Context, params customtypes.Params) (
-	[]customtypes.RewardWeight, map[string]math.LegacyDec, math.LegacyDec,
+	[]customtypes.RewardWeight, map[string]math.LegacyDec, math.LegacyDec, error,
 ) {
 	rewardWeights := params.RewardWeights
+	if params.DynamicRewardWeights.Enabled {
+		rewardWeights = make([]customtypes.RewardWeight, len(params.RewardWeights))
+		for i, rewardWeight := range params.RewardWeights {
+			weight, err := k.ComputedRewardWeights.Get(ctx, rewardWeight.Denom)
+			if err != nil && errors.Is(err, collections.ErrNotFound) {
+				weight = rewardWeight.Weight
+			} else if err != nil {
+				return nil, nil, math.LegacyDec{}, err
+			}
+
+			rewardWeights[i] = customtypes.RewardWeight{Denom: rewardWeight.Denom, Weight: weight}
+		}
+	}
 
 	weightsSum := math.LegacyZeroDec()
 	weightsMap := make(map[string]math.LegacyDec, len(rewardWeights))
@@ -132,7 +175,7 @@ func (k Keeper) LoadRewardWeights(ctx context.Context, params customtypes.Params
 		weightsMap[rewardWeight.Denom] = rewardWeight.Weight
 	}
 
-	return rewardWeights, weightsMap, weightsSum
+	return rewardWeights, weightsMap, weightsSum, nil
 }
 
 type validatorBondedToken struct {
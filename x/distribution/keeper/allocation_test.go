@@ -28,7 +28,10 @@ func loadRewardsWeight(t *testing.T, ctx context.Context, input TestKeepers) ([]
 	params, err := input.DistKeeper.Params.Get(ctx)
 	require.NoError(t, err)
 
-	return input.DistKeeper.LoadRewardWeights(ctx, params)
+	rewardWeights, rewardWeightMap, weightsSum, err := input.DistKeeper.LoadRewardWeights(ctx, params)
+	require.NoError(t, err)
+
+	return rewardWeights, rewardWeightMap, weightsSum
 }
 
 func TestLoadRewardWeights(t *testing.T) {
@@ -195,6 +195,7 @@ type TestKeepers struct {
 	BankKeeper        bankkeeper.Keeper
 	GovKeeper         govkeeper.Keeper
 	MoveKeeper        movekeeper.Keeper
+	RewardKeeper      rewardkeeper.Keeper
 	DexKeeper         TestDexKeeper
 	VotingPowerKeeper *TestVotingPowerKeeper
 	EncodingConfig    initiaappparams.EncodingConfig
@@ -309,6 +310,7 @@ func _createTestInput(
 		accountKeeper,
 		bankKeeper,
 		votingPowerKeeper,
+		movekeeper.NewDexKeeper(moveKeeper),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		vc, cc,
 	)
@@ -336,6 +338,7 @@ func _createTestInput(
 		bankKeeper,
 		stakingKeeper,
 		dexKeeper,
+		rewardKeeper,
 		authtypes.FeeCollectorName,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -348,6 +351,8 @@ func _createTestInput(
 
 	// set genesis items required for distribution
 	require.NoError(t, distKeeper.FeePool.Set(ctx, distributiontypes.InitialFeePool()))
+	require.NoError(t, distKeeper.NextGaugeId.Set(ctx, 1))
+	require.NoError(t, distKeeper.NextPaymentStreamId.Set(ctx, 1))
 
 	accountKeeper.GetModuleAccount(ctx, movetypes.MoveStakingModuleName)
 
@@ -398,6 +403,7 @@ func _createTestInput(
 		stakingKeeper,
 		distKeeper,
 		movekeeper.NewVestingKeeper(moveKeeper),
+		movekeeper.NewMoveGovKeeper(moveKeeper),
 		msgRouter,
 		govConfig,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -414,6 +420,7 @@ func _createTestInput(
 		StakingKeeper:     *stakingKeeper,
 		DistKeeper:        *distKeeper,
 		MoveKeeper:        *moveKeeper,
+		RewardKeeper:      *rewardKeeper,
 		BankKeeper:        bankKeeper,
 		GovKeeper:         *govKeeper,
 		DexKeeper:         dexKeeper,
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"slices"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -225,3 +227,131 @@ func (q CustomQueryServer) DelegationTotalRewards(ctx context.Context, req *cust
 
 	return &customtypes.QueryDelegationTotalRewardsResponse{Rewards: delRewards, Total: total}, nil
 }
+
+// Gauge queries an incentive gauge by id
+func (q CustomQueryServer) Gauge(ctx context.Context, req *customtypes.QueryGaugeRequest) (*customtypes.QueryGaugeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	gauge, err := q.Keeper.Gauges.Get(ctx, req.GaugeId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "gauge %d not found", req.GaugeId)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &customtypes.QueryGaugeResponse{Gauge: gauge}, nil
+}
+
+// Gauges queries all incentive gauges, optionally filtered by the bond denom
+func (q CustomQueryServer) Gauges(ctx context.Context, req *customtypes.QueryGaugesRequest) (*customtypes.QueryGaugesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	gauges, pageRes, err := query.CollectionFilteredPaginate(ctx, q.Keeper.Gauges, req.Pagination,
+		func(_ uint64, gauge customtypes.Gauge) (bool, error) {
+			return req.Denom == "" || gauge.Denom == req.Denom, nil
+		},
+		func(_ uint64, gauge customtypes.Gauge) (customtypes.Gauge, error) {
+			return gauge, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &customtypes.QueryGaugesResponse{Gauges: gauges, Pagination: pageRes}, nil
+}
+
+// ProjectedRewardRates queries the projected annual reward rates of the bonded validators,
+// optionally filtered by the validator address
+func (q CustomQueryServer) ProjectedRewardRates(ctx context.Context, req *customtypes.QueryProjectedRewardRatesRequest) (*customtypes.QueryProjectedRewardRatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	rates, err := q.Keeper.GetProjectedRewardRates(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if req.ValidatorAddress != "" {
+		if _, err := q.Keeper.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid validator address")
+		}
+
+		rates = slices.DeleteFunc(rates, func(rate customtypes.ValidatorRewardRates) bool {
+			return rate.ValidatorAddress != req.ValidatorAddress
+		})
+	}
+
+	return &customtypes.QueryProjectedRewardRatesResponse{Rates: rates}, nil
+}
+
+// RealizedRewardRates queries the annual reward rates of a validator realized over the
+// stored historical rewards periods
+func (q CustomQueryServer) RealizedRewardRates(ctx context.Context, req *customtypes.QueryRealizedRewardRatesRequest) (*customtypes.QueryRealizedRewardRatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	valAddr, err := q.Keeper.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address")
+	}
+
+	val, err := q.stakingKeeper.Validator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, startTime, endTime, err := q.Keeper.GetRealizedRewardRates(ctx, val)
+	if err != nil {
+		return nil, err
+	}
+
+	return &customtypes.QueryRealizedRewardRatesResponse{Rates: rates, StartTime: startTime, EndTime: endTime}, nil
+}
+
+// PaymentStream queries a community pool payment stream by id
+func (q CustomQueryServer) PaymentStream(ctx context.Context, req *customtypes.QueryPaymentStreamRequest) (*customtypes.QueryPaymentStreamResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	stream, err := q.Keeper.PaymentStreams.Get(ctx, req.StreamId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "payment stream %d not found", req.StreamId)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &customtypes.QueryPaymentStreamResponse{Stream: stream}, nil
+}
+
+// PaymentStreams queries all community pool payment streams, optionally filtered by the recipient
+func (q CustomQueryServer) PaymentStreams(ctx context.Context, req *customtypes.QueryPaymentStreamsRequest) (*customtypes.QueryPaymentStreamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	streams, pageRes, err := query.CollectionFilteredPaginate(ctx, q.Keeper.PaymentStreams, req.Pagination,
+		func(_ uint64, stream customtypes.PaymentStream) (bool, error) {
+			return req.Recipient == "" || stream.Recipient == req.Recipient, nil
+		},
+		func(_ uint64, stream customtypes.PaymentStream) (customtypes.PaymentStream, error) {
+			return stream, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &customtypes.QueryPaymentStreamsResponse{Streams: streams, Pagination: pageRes}, nil
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"cosmossdk.io/errors"
 
@@ -82,3 +84,175 @@ func (k customMsgServer) DepositValidatorRewardsPool(ctx context.Context, msg *c
 
 	return &customtypes.MsgDepositValidatorRewardsPoolResponse{}, nil
 }
+
+func (k customMsgServer) CreateGauge(ctx context.Context, msg *customtypes.MsgCreateGauge) (*customtypes.MsgCreateGaugeResponse, error) {
+	depositor, err := k.authKeeper.AddressCodec().StringToBytes(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	gaugeId, err := k.Keeper.CreateGauge(ctx, depositor, msg.Denom, msg.Amount, msg.StartTime, msg.NumEpochs, msg.EpochDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	gauge, err := k.Gauges.Get(ctx, gaugeId)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			customtypes.EventTypeCreateGauge,
+			sdk.NewAttribute(customtypes.AttributeKeyGaugeId, strconv.FormatUint(gaugeId, 10)),
+			sdk.NewAttribute(customtypes.AttributeKeyDepositor, msg.Depositor),
+			sdk.NewAttribute(customtypes.AttributeKeyPool, msg.Denom),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(customtypes.AttributeKeyStartTime, gauge.StartTime.String()),
+			sdk.NewAttribute(customtypes.AttributeKeyEndTime, gauge.EndTime().String()),
+		),
+	)
+
+	return &customtypes.MsgCreateGaugeResponse{GaugeId: gaugeId}, nil
+}
+
+func (k customMsgServer) WithdrawAllDelegatorRewards(ctx context.Context, msg *customtypes.MsgWithdrawAllDelegatorRewards) (*customtypes.MsgWithdrawAllDelegatorRewardsResponse, error) {
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddrs := make([]sdk.ValAddress, len(msg.ValidatorAddresses))
+	for i, valAddrStr := range msg.ValidatorAddresses {
+		valAddrs[i], err = k.stakingKeeper.ValidatorAddressCodec().StringToBytes(valAddrStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var startValAddr sdk.ValAddress
+	if msg.StartValidatorAddress != "" {
+		startValAddr, err = k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.StartValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rewards, nextValAddr, err := k.Keeper.WithdrawAllDelegationRewards(ctx, delAddr, valAddrs, startValAddr, msg.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextValAddrStr string
+	if nextValAddr != nil {
+		nextValAddrStr, err = k.stakingKeeper.ValidatorAddressCodec().BytesToString(nextValAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &customtypes.MsgWithdrawAllDelegatorRewardsResponse{
+		Amount:               customtypes.NewDecPoolsFromPools(rewards),
+		NextValidatorAddress: nextValAddrStr,
+	}, nil
+}
+
+func (k customMsgServer) CreatePaymentStream(ctx context.Context, msg *customtypes.MsgCreatePaymentStream) (*customtypes.MsgCreatePaymentStreamResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	startTime := msg.StartTime
+	if startTime.Before(sdkCtx.BlockTime()) {
+		startTime = sdkCtx.BlockTime()
+	}
+
+	if err := customtypes.ValidatePaymentStreamSchedule(startTime, msg.EndTime); err != nil {
+		return nil, errors.Wrap(customtypes.ErrInvalidPaymentStream, err.Error())
+	}
+
+	// exactly one of the total and the rate must be set
+	hasTotal := !msg.Total.IsNil() && !msg.Total.IsZero()
+	hasRate := !msg.Rate.IsNil() && !msg.Rate.IsZero()
+	if hasTotal == hasRate {
+		return nil, errors.Wrap(customtypes.ErrInvalidPaymentStream, "exactly one of total and rate must be set")
+	}
+
+	total := msg.Total
+	if hasRate {
+		total = msg.Rate.MulRaw(int64(msg.EndTime.Sub(startTime) / time.Second))
+	}
+
+	streamId, err := k.Keeper.CreatePaymentStream(ctx, recipient, msg.Denom, total, startTime, msg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			customtypes.EventTypeCreatePaymentStream,
+			sdk.NewAttribute(customtypes.AttributeKeyStreamId, strconv.FormatUint(streamId, 10)),
+			sdk.NewAttribute(customtypes.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(msg.Denom, total).String()),
+			sdk.NewAttribute(customtypes.AttributeKeyStartTime, startTime.String()),
+			sdk.NewAttribute(customtypes.AttributeKeyEndTime, msg.EndTime.String()),
+		),
+	)
+
+	return &customtypes.MsgCreatePaymentStreamResponse{StreamId: streamId}, nil
+}
+
+func (k customMsgServer) ClaimPaymentStream(ctx context.Context, msg *customtypes.MsgClaimPaymentStream) (*customtypes.MsgClaimPaymentStreamResponse, error) {
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := k.Keeper.ClaimPaymentStream(ctx, recipient, msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			customtypes.EventTypeClaimPaymentStream,
+			sdk.NewAttribute(customtypes.AttributeKeyStreamId, strconv.FormatUint(msg.StreamId, 10)),
+			sdk.NewAttribute(customtypes.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return &customtypes.MsgClaimPaymentStreamResponse{Amount: amount}, nil
+}
+
+func (k customMsgServer) CancelPaymentStream(ctx context.Context, msg *customtypes.MsgCancelPaymentStream) (*customtypes.MsgCancelPaymentStreamResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := k.Keeper.CancelPaymentStream(ctx, msg.StreamId); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			customtypes.EventTypeCancelPaymentStream,
+			sdk.NewAttribute(customtypes.AttributeKeyStreamId, strconv.FormatUint(msg.StreamId, 10)),
+		),
+	)
+
+	return &customtypes.MsgCancelPaymentStreamResponse{}, nil
+}
@@ -1,12 +1,18 @@
 package keeper_test
 
 import (
+	"bytes"
+	"slices"
 	"testing"
 
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/initia-labs/initia/x/distribution/keeper"
 	customTypes "github.com/initia-labs/initia/x/distribution/types"
+	stakingkeeper "github.com/initia-labs/initia/x/mstaking/keeper"
+	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -74,3 +80,83 @@ func TestDepositValidatorRewardsPool(t *testing.T) {
 	}
 
 }
+
+func TestWithdrawAllDelegatorRewards(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	customMsgServer := keeper.NewCustomMsgServerImpl(input.DistKeeper)
+	stakingMsgServer := stakingkeeper.NewMsgServerImpl(input.StakingKeeper)
+
+	_, _, delAddr := keyPubAddr()
+	input.Faucet.Fund(ctx, delAddr, types.NewCoin(bondDenom, math.NewInt(3_000_000)))
+
+	valAddrs := make([]types.ValAddress, 3)
+	for i := range valAddrs {
+		valAddrs[i] = createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, i+1)
+
+		_, err := stakingMsgServer.Delegate(ctx, &stakingtypes.MsgDelegate{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: valAddrs[i].String(),
+			Amount:           types.NewCoins(types.NewCoin(bondDenom, math.NewInt(1_000_000))),
+		})
+		require.NoError(t, err)
+	}
+
+	// the delegations are withdrawn in the order of the validator addresses
+	slices.SortFunc(valAddrs, func(a, b types.ValAddress) int { return bytes.Compare(a, b) })
+
+	// 10% commission and the half of the rewards to the delegator
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	input.Faucet.Fund(ctx, authtypes.NewModuleAddress(distrtypes.ModuleName), types.NewCoin(bondDenom, math.NewInt(60_000)))
+	for _, valAddr := range valAddrs {
+		validator, err := input.StakingKeeper.Validator(ctx, valAddr)
+		require.NoError(t, err)
+		require.NoError(t, input.DistKeeper.AllocateTokensToValidatorPool(ctx, validator, bondDenom, types.NewDecCoins(types.NewInt64DecCoin(bondDenom, 10_000))))
+	}
+
+	res, err := customMsgServer.WithdrawAllDelegatorRewards(ctx, &customTypes.MsgWithdrawAllDelegatorRewards{
+		DelegatorAddress: delAddr.String(),
+		Limit:            2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, valAddrs[2].String(), res.NextValidatorAddress)
+	require.Equal(t, customTypes.DecPools{
+		customTypes.NewDecPool(bondDenom, types.NewDecCoins(types.NewInt64DecCoin(bondDenom, 9_000))),
+	}, res.Amount)
+
+	// resume from the next validator
+	res, err = customMsgServer.WithdrawAllDelegatorRewards(ctx, &customTypes.MsgWithdrawAllDelegatorRewards{
+		DelegatorAddress:      delAddr.String(),
+		StartValidatorAddress: res.NextValidatorAddress,
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.NextValidatorAddress)
+	require.Equal(t, customTypes.DecPools{
+		customTypes.NewDecPool(bondDenom, types.NewDecCoins(types.NewInt64DecCoin(bondDenom, 4_500))),
+	}, res.Amount)
+
+	require.Equal(t, math.NewInt(13_500), input.BankKeeper.GetBalance(ctx, delAddr, bondDenom).Amount)
+
+	// restricted to the given validators
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	for _, valAddr := range valAddrs {
+		validator, err := input.StakingKeeper.Validator(ctx, valAddr)
+		require.NoError(t, err)
+		require.NoError(t, input.DistKeeper.AllocateTokensToValidatorPool(ctx, validator, bondDenom, types.NewDecCoins(types.NewInt64DecCoin(bondDenom, 10_000))))
+	}
+
+	res, err = customMsgServer.WithdrawAllDelegatorRewards(ctx, &customTypes.MsgWithdrawAllDelegatorRewards{
+		DelegatorAddress:   delAddr.String(),
+		ValidatorAddresses: []string{valAddrs[1].String()},
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.NextValidatorAddress)
+	require.Equal(t, customTypes.DecPools{
+		customTypes.NewDecPool(bondDenom, types.NewDecCoins(types.NewInt64DecCoin(bondDenom, 4_500))),
+	}, res.Amount)
+
+	_, err = customMsgServer.WithdrawAllDelegatorRewards(ctx, &customTypes.MsgWithdrawAllDelegatorRewards{
+		DelegatorAddress:   delAddr.String(),
+		ValidatorAddresses: []string{"invalid"},
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,219 @@
+package keeper
+
+import (
+	"context"
+	gomath "math"
+	"slices"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	customtypes "github.com/initia-labs/initia/x/distribution/types"
+	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
+)
+
+// CreateGauge deposits the coins to the distribution module and creates an incentive gauge
+// which linearly releases them to the reward pool of the denom from the start time.
+func (k Keeper) CreateGauge(
+	ctx context.Context, depositor sdk.AccAddress, denom string, amount sdk.Coins,
+	startTime time.Time, numEpochs uint64, epochDuration time.Duration,
+) (uint64, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// only the reward pools can be incentivized
+	if !slices.ContainsFunc(params.RewardWeights, func(rw customtypes.RewardWeight) bool { return rw.Denom == denom }) {
+		return 0, errorsmod.Wrapf(customtypes.ErrInvalidGauge, "%s is not a reward pool denom", denom)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if startTime.Before(sdkCtx.BlockTime()) {
+		startTime = sdkCtx.BlockTime()
+	}
+
+	depositorStr, err := k.authKeeper.AddressCodec().BytesToString(depositor)
+	if err != nil {
+		return 0, err
+	}
+
+	// bound the number of the gauges iterated every block
+	numGauges := uint64(0)
+	err = k.Gauges.Walk(ctx, nil, func(_ uint64, _ customtypes.Gauge) (stop bool, err error) {
+		numGauges++
+		return numGauges >= params.MaxActiveGauges, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if numGauges >= params.MaxActiveGauges {
+		return 0, errorsmod.Wrapf(customtypes.ErrTooManyGauges, "max %d", params.MaxActiveGauges)
+	}
+
+	id, err := k.NextGaugeId.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	gauge := customtypes.NewGauge(id, depositorStr, denom, amount, startTime, numEpochs, epochDuration)
+	if err := gauge.Validate(); err != nil {
+		return 0, errorsmod.Wrap(customtypes.ErrInvalidGauge, err.Error())
+	}
+
+	// the creation fee goes to the community pool
+	if !params.GaugeCreationFee.IsZero() {
+		if err := k.FundCommunityPool(ctx, params.GaugeCreationFee, depositor); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, amount); err != nil {
+		return 0, err
+	}
+
+	if err := k.SetGauge(ctx, gauge); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// SetGauge stores the gauge and indexes it by the start time.
+func (k Keeper) SetGauge(ctx context.Context, gauge customtypes.Gauge) error {
+	if err := k.Gauges.Set(ctx, gauge.Id, gauge); err != nil {
+		return err
+	}
+
+	return k.GaugesByStartTime.Set(ctx, collections.Join(gauge.StartTime, gauge.Id))
+}
+
+// RemoveGauge removes the gauge and its start time index.
+func (k Keeper) RemoveGauge(ctx context.Context, gauge customtypes.Gauge) error {
+	if err := k.Gauges.Remove(ctx, gauge.Id); err != nil {
+		return err
+	}
+
+	return k.GaugesByStartTime.Remove(ctx, collections.Join(gauge.StartTime, gauge.Id))
+}
+
+// GetActiveGauges returns the gauges started at or before the current block time. The
+// finished gauges are removed, so only the live gauges are iterated.
+func (k Keeper) GetActiveGauges(ctx context.Context) (gauges []customtypes.Gauge, err error) {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	rng := new(collections.Range[collections.Pair[time.Time, uint64]]).
+		EndInclusive(collections.Join(blockTime, uint64(gomath.MaxUint64)))
+
+	err = k.GaugesByStartTime.Walk(ctx, rng, func(key collections.Pair[time.Time, uint64]) (stop bool, err error) {
+		gauge, err := k.Gauges.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		gauges = append(gauges, gauge)
+		return false, nil
+	})
+
+	return gauges, err
+}
+
+// GetTotalGaugeRemaining returns the sum of the coins not yet released by the gauges,
+// which are held by the distribution module account.
+func (k Keeper) GetTotalGaugeRemaining(ctx context.Context) (remaining sdk.Coins, err error) {
+	remaining = sdk.NewCoins()
+	err = k.Gauges.Walk(ctx, nil, func(_ uint64, gauge customtypes.Gauge) (stop bool, err error) {
+		remaining = remaining.Add(gauge.Remaining()...)
+		return false, nil
+	})
+
+	return remaining, err
+}
+
+// allocateGaugeRewards releases the coins of the active gauges to the reward pools of
+// the gauge denoms, spread proportionally to the bonded tokens of the validators. The
+// gauges of the denom without bonded tokens wait until the tokens are bonded. It returns
+// the remainder of the released coins to be sent to the community pool.
+func (k Keeper) allocateGaugeRewards(
+	ctx context.Context,
+	gauges []customtypes.Gauge,
+	validators map[string]stakingtypes.ValidatorI,
+	bondedTokens map[string][]validatorBondedToken,
+	bondedTokensSum map[string]math.Int,
+) (sdk.DecCoins, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+
+	remaining := sdk.NewDecCoins()
+	for _, gauge := range gauges {
+		poolSize, ok := bondedTokensSum[gauge.Denom]
+		if !ok || poolSize.IsZero() {
+			continue
+		}
+
+		released := gauge.ReleasableAt(blockTime)
+		if released.IsZero() {
+			continue
+		}
+
+		releasedDec := sdk.NewDecCoinsFromCoins(released...)
+		gaugeRemaining := releasedDec
+		for _, bondedTokens := range bondedTokens[gauge.Denom] {
+			if bondedTokens.Amount.IsZero() {
+				continue
+			}
+
+			validator := validators[bondedTokens.ValAddr]
+
+			amountFraction := math.LegacyNewDecFromInt(bondedTokens.Amount).QuoInt(poolSize)
+			reward := releasedDec.MulDecTruncate(amountFraction)
+			if reward.IsZero() {
+				continue
+			}
+
+			if err := k.AllocateTokensToValidatorPool(ctx, validator, gauge.Denom, reward); err != nil {
+				return nil, err
+			}
+
+			gaugeRemaining = gaugeRemaining.Sub(reward)
+		}
+
+		remaining = remaining.Add(gaugeRemaining...)
+
+		gauge.Distributed = gauge.Distributed.Add(released...)
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				customtypes.EventTypeGaugeRelease,
+				sdk.NewAttribute(customtypes.AttributeKeyGaugeId, strconv.FormatUint(gauge.Id, 10)),
+				sdk.NewAttribute(customtypes.AttributeKeyPool, gauge.Denom),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, released.String()),
+			),
+		)
+
+		if !gauge.IsFinished() {
+			if err := k.Gauges.Set(ctx, gauge.Id, gauge); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		if err := k.RemoveGauge(ctx, gauge); err != nil {
+			return nil, err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				customtypes.EventTypeGaugeFinish,
+				sdk.NewAttribute(customtypes.AttributeKeyGaugeId, strconv.FormatUint(gauge.Id, 10)),
+			),
+		)
+	}
+
+	return remaining, nil
+}
@@ -0,0 +1,201 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/distribution/keeper"
+	customtypes "github.com/initia-labs/initia/x/distribution/types"
+)
+
+func TestCreateGauge(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewCustomMsgServerImpl(input.DistKeeper)
+
+	_, _, depositor := keyPubAddr()
+	input.Faucet.Fund(ctx, depositor, sdk.NewInt64Coin("foo", 1_000))
+
+	// only the reward pools can be incentivized
+	_, err := ms.CreateGauge(ctx, &customtypes.MsgCreateGauge{
+		Depositor:     depositor.String(),
+		Denom:         "bar",
+		Amount:        sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000)),
+		NumEpochs:     10,
+		EpochDuration: time.Hour,
+	})
+	require.ErrorIs(t, err, customtypes.ErrInvalidGauge)
+
+	// invalid schedule
+	_, err = ms.CreateGauge(ctx, &customtypes.MsgCreateGauge{
+		Depositor:     depositor.String(),
+		Denom:         bondDenom,
+		Amount:        sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000)),
+		NumEpochs:     0,
+		EpochDuration: time.Hour,
+	})
+	require.ErrorIs(t, err, customtypes.ErrInvalidGauge)
+
+	// the past start time is replaced with the block time
+	res, err := ms.CreateGauge(ctx, &customtypes.MsgCreateGauge{
+		Depositor:     depositor.String(),
+		Denom:         bondDenom,
+		Amount:        sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000)),
+		StartTime:     ctx.BlockTime().Add(-time.Hour),
+		NumEpochs:     10,
+		EpochDuration: time.Hour,
+	})
+	require.NoError(t, err)
+
+	queryServer := keeper.NewCustomQueryServer(input.DistKeeper)
+	gaugeRes, err := queryServer.Gauge(ctx, &customtypes.QueryGaugeRequest{GaugeId: res.GaugeId})
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime(), gaugeRes.Gauge.StartTime)
+	require.Equal(t, ctx.BlockTime().Add(10*time.Hour), gaugeRes.Gauge.EndTime())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000)), gaugeRes.Gauge.Amount)
+	require.True(t, gaugeRes.Gauge.Distributed.IsZero())
+
+	require.True(t, input.BankKeeper.GetBalance(ctx, depositor, "foo").IsZero())
+
+	gaugesRes, err := queryServer.Gauges(ctx, &customtypes.QueryGaugesRequest{Denom: bondDenom})
+	require.NoError(t, err)
+	require.Len(t, gaugesRes.Gauges, 1)
+
+	gaugesRes, err = queryServer.Gauges(ctx, &customtypes.QueryGaugesRequest{Denom: "bar"})
+	require.NoError(t, err)
+	require.Empty(t, gaugesRes.Gauges)
+}
+
+func TestCreateGauge_FeeAndMaxActiveGauges(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params, err := input.DistKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.GaugeCreationFee = sdk.NewCoins(sdk.NewInt64Coin("foo", 100))
+	params.MaxActiveGauges = 1
+	require.NoError(t, input.DistKeeper.Params.Set(ctx, params))
+
+	_, _, depositor := keyPubAddr()
+	input.Faucet.Fund(ctx, depositor, sdk.NewInt64Coin("foo", 2_200))
+
+	feePool, err := input.DistKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	communityPool := feePool.CommunityPool
+
+	// the creation fee goes to the community pool
+	_, err = input.DistKeeper.CreateGauge(ctx, depositor, bondDenom, sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000)), ctx.BlockTime().Add(time.Hour), 10, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("foo", 1_100), input.BankKeeper.GetBalance(ctx, depositor, "foo"))
+
+	feePool, err = input.DistKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, communityPool.Add(sdk.NewInt64DecCoin("foo", 100)), feePool.CommunityPool)
+
+	// the number of the active gauges is bounded
+	_, err = input.DistKeeper.CreateGauge(ctx, depositor, bondDenom, sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000)), ctx.BlockTime(), 10, time.Hour)
+	require.ErrorIs(t, err, customtypes.ErrTooManyGauges)
+
+	// the gauge not started yet is not iterated
+	gauges, err := input.DistKeeper.GetActiveGauges(ctx)
+	require.NoError(t, err)
+	require.Empty(t, gauges)
+
+	gauges, err = input.DistKeeper.GetActiveGauges(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)))
+	require.NoError(t, err)
+	require.Len(t, gauges, 1)
+}
+
+func TestAllocateGaugeRewards(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	valAddr1 := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 1)
+	valAddr2 := createValidatorWithBalance(ctx, input, 100_000_000, 3_000_000, 2)
+
+	validator1, err := input.StakingKeeper.Validator(ctx, valAddr1)
+	require.NoError(t, err)
+	validator2, err := input.StakingKeeper.Validator(ctx, valAddr2)
+	require.NoError(t, err)
+
+	valConsPk1, err := validator1.ConsPubKey()
+	require.NoError(t, err)
+	valConsPk2, err := validator2.ConsPubKey()
+	require.NoError(t, err)
+
+	votes := []abci.VoteInfo{
+		{
+			Validator:   abci.Validator{Address: valConsPk1.Address(), Power: 1},
+			BlockIdFlag: types.BlockIDFlagCommit,
+		},
+		{
+			Validator:   abci.Validator{Address: valConsPk2.Address(), Power: 3},
+			BlockIdFlag: types.BlockIDFlagCommit,
+		},
+	}
+
+	_, _, depositor := keyPubAddr()
+	input.Faucet.Fund(ctx, depositor, sdk.NewInt64Coin("foo", 1_000))
+
+	startTime := ctx.BlockTime().Add(time.Hour)
+	gaugeId, err := input.DistKeeper.CreateGauge(ctx, depositor, bondDenom, sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000)), startTime, 10, time.Hour)
+	require.NoError(t, err)
+
+	// not started yet
+	require.NoError(t, input.DistKeeper.AllocateTokens(ctx, 4, votes))
+	rewards, err := input.DistKeeper.GetValidatorOutstandingRewards(ctx, valAddr1)
+	require.NoError(t, err)
+	require.True(t, rewards.Rewards.IsEmpty())
+
+	// half released
+	ctx = ctx.WithBlockTime(startTime.Add(5 * time.Hour))
+	require.NoError(t, input.DistKeeper.AllocateTokens(ctx, 4, votes))
+
+	rewards, err = input.DistKeeper.GetValidatorOutstandingRewards(ctx, valAddr1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("foo", 125)), rewards.Rewards.CoinsOf(bondDenom))
+	rewards, err = input.DistKeeper.GetValidatorOutstandingRewards(ctx, valAddr2)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("foo", 375)), rewards.Rewards.CoinsOf(bondDenom))
+
+	gauge, err := input.DistKeeper.Gauges.Get(ctx, gaugeId)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foo", 500)), gauge.Distributed)
+
+	remaining, err := input.DistKeeper.GetTotalGaugeRemaining(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foo", 500)), remaining)
+
+	// all released after the end time and the gauge is removed
+	ctx = ctx.WithBlockTime(startTime.Add(11 * time.Hour))
+	require.NoError(t, input.DistKeeper.AllocateTokens(ctx, 4, votes))
+
+	rewards, err = input.DistKeeper.GetValidatorOutstandingRewards(ctx, valAddr1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("foo", 250)), rewards.Rewards.CoinsOf(bondDenom))
+	rewards, err = input.DistKeeper.GetValidatorOutstandingRewards(ctx, valAddr2)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("foo", 750)), rewards.Rewards.CoinsOf(bondDenom))
+
+	_, err = input.DistKeeper.Gauges.Get(ctx, gaugeId)
+	require.Error(t, err)
+
+	// the module account holds all the rewards
+	_, broken := keeper.ModuleAccountInvariant(input.DistKeeper)(ctx)
+	require.False(t, broken)
+
+	// genesis export contains the next gauge id
+	require.NoError(t, input.DistKeeper.PreviousProposerConsAddr.Set(ctx, valConsPk1.Address()))
+	genState := input.DistKeeper.ExportGenesis(ctx)
+	require.Empty(t, genState.Gauges)
+	require.Equal(t, gaugeId+1, genState.NextGaugeId)
+	require.NoError(t, customtypes.ValidateGenesis(genState))
+
+	// the gauge ids start from 1
+	genState.NextGaugeId = 0
+	require.Error(t, customtypes.ValidateGenesis(genState))
+}
@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 
@@ -109,6 +111,38 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data customtypes.GenesisState) {
 		}
 	}
 
+	for _, gauge := range data.Gauges {
+		if err := k.SetGauge(ctx, gauge); err != nil {
+			panic(err)
+		}
+
+		moduleHoldings = moduleHoldings.Add(sdk.NewDecCoinsFromCoins(gauge.Remaining()...)...)
+	}
+	if err := k.NextGaugeId.Set(ctx, data.NextGaugeId); err != nil {
+		panic(err)
+	}
+
+	for _, stream := range data.PaymentStreams {
+		if err := k.PaymentStreams.Set(ctx, stream.Id, stream); err != nil {
+			panic(err)
+		}
+
+		moduleHoldings = moduleHoldings.Add(sdk.NewDecCoin(stream.Denom, stream.Claimable()))
+	}
+	if err := k.NextPaymentStreamId.Set(ctx, data.NextPaymentStreamId); err != nil {
+		panic(err)
+	}
+	for _, rewardWeight := range data.ComputedRewardWeights {
+		if err := k.ComputedRewardWeights.Set(ctx, rewardWeight.Denom, rewardWeight.Weight); err != nil {
+			panic(err)
+		}
+	}
+	if data.RewardWeightsUpdateTime != nil {
+		if err := k.RewardWeightsUpdateTime.Set(ctx, *data.RewardWeightsUpdateTime); err != nil {
+			panic(err)
+		}
+	}
+
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
 
@@ -290,5 +324,50 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *customtypes.GenesisState {
 		panic(err)
 	}
 
-	return customtypes.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	gauges := make([]customtypes.Gauge, 0)
+	err = k.Gauges.Walk(ctx, nil, func(_ uint64, gauge customtypes.Gauge) (stop bool, err error) {
+		gauges = append(gauges, gauge)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	nextGaugeId, err := k.NextGaugeId.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	streams := make([]customtypes.PaymentStream, 0)
+	err = k.PaymentStreams.Walk(ctx, nil, func(_ uint64, stream customtypes.PaymentStream) (stop bool, err error) {
+		streams = append(streams, stream)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	nextStreamId, err := k.NextPaymentStreamId.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	computedWeights := make([]customtypes.RewardWeight, 0)
+	err = k.ComputedRewardWeights.Walk(ctx, nil, func(denom string, weight math.LegacyDec) (stop bool, err error) {
+		computedWeights = append(computedWeights, customtypes.RewardWeight{Denom: denom, Weight: weight})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	genState := customtypes.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, gauges, nextGaugeId, streams, nextStreamId)
+	genState.ComputedRewardWeights = computedWeights
+	if updateTime, err := k.RewardWeightsUpdateTime.Get(ctx); err == nil {
+		genState.RewardWeightsUpdateTime = &updateTime
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+
+	return genState
 }
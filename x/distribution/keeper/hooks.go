@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/collections"
 	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 
@@ -195,6 +196,14 @@ func (h Hooks) BeforeDelegationRemoved(_ context.Context, _ sdk.AccAddress, _ sd
 	return nil
 }
 
+func (h Hooks) AfterConsensusPubKeyUpdate(_ context.Context, _, _ cryptotypes.PubKey, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterConsensusPubKeyRotationMatured(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
 func (h Hooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error {
 	return nil
 }
@@ -189,8 +189,19 @@ func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 			panic(err)
 		}
 
+		gaugeRemaining, err := k.GetTotalGaugeRemaining(ctx)
+		if err != nil {
+			panic(err)
+		}
+
+		streamClaimable, err := k.GetTotalPaymentStreamClaimable(ctx)
+		if err != nil {
+			panic(err)
+		}
+
 		communityPool := feePool.CommunityPool
 		expectedInt, _ := expectedCoins.Add(communityPool...).TruncateDecimal()
+		expectedInt = expectedInt.Add(gaugeRemaining...).Add(streamClaimable...)
 
 		macc := k.GetDistributionAccount(ctx)
 		balances := k.bankKeeper.GetAllBalances(ctx, macc.GetAddress())
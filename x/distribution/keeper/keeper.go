@@ -1,13 +1,18 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -26,6 +31,7 @@ type Keeper struct {
 	bankKeeper    types.BankKeeper
 	stakingKeeper customtypes.StakingKeeper
 	dexKeeper     customtypes.DexKeeper
+	rewardKeeper  customtypes.RewardKeeper
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -44,13 +50,20 @@ type Keeper struct {
 	ValidatorCurrentRewards         collections.Map[[]byte, customtypes.ValidatorCurrentRewards]
 	ValidatorAccumulatedCommissions collections.Map[[]byte, customtypes.ValidatorAccumulatedCommission]
 	ValidatorSlashEvents            collections.Map[collections.Triple[[]byte, uint64, uint64], customtypes.ValidatorSlashEvent]
+	Gauges                          collections.Map[uint64, customtypes.Gauge]
+	NextGaugeId                     collections.Sequence
+	GaugesByStartTime               collections.KeySet[collections.Pair[time.Time, uint64]]
+	RewardWeightsUpdateTime         collections.Item[time.Time]
+	ComputedRewardWeights           collections.Map[string, math.LegacyDec]
+	PaymentStreams                  collections.Map[uint64, customtypes.PaymentStream]
+	NextPaymentStreamId             collections.Sequence
 }
 
 // NewKeeper creates a new distribution Keeper instance
 func NewKeeper(
 	cdc codec.Codec, storeService store.KVStoreService,
 	ak types.AccountKeeper, bk types.BankKeeper, sk customtypes.StakingKeeper,
-	dk customtypes.DexKeeper, feeCollectorName string, authority string,
+	dk customtypes.DexKeeper, rk customtypes.RewardKeeper, feeCollectorName string, authority string,
 ) *Keeper {
 
 	// ensure distribution module account is set
@@ -70,6 +83,7 @@ func NewKeeper(
 		bankKeeper:                      bk,
 		stakingKeeper:                   sk,
 		dexKeeper:                       dk,
+		rewardKeeper:                    rk,
 		feeCollectorName:                feeCollectorName,
 		authority:                       authority,
 		Params:                          collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[customtypes.Params](cdc)),
@@ -82,6 +96,13 @@ func NewKeeper(
 		ValidatorCurrentRewards:         collections.NewMap(sb, types.ValidatorCurrentRewardsPrefix, "validator_current_rewards", collections.BytesKey, codec.CollValue[customtypes.ValidatorCurrentRewards](cdc)),
 		ValidatorAccumulatedCommissions: collections.NewMap(sb, types.ValidatorAccumulatedCommissionPrefix, "validator_accumulated_commissions", collections.BytesKey, codec.CollValue[customtypes.ValidatorAccumulatedCommission](cdc)),
 		ValidatorSlashEvents:            collections.NewMap(sb, types.ValidatorSlashEventPrefix, "validator_slash_events", collections.TripleKeyCodec(collections.BytesKey, collections.Uint64Key, collections.Uint64Key), codec.CollValue[customtypes.ValidatorSlashEvent](cdc)),
+		Gauges:                          collections.NewMap(sb, customtypes.GaugesPrefix, "gauges", collections.Uint64Key, codec.CollValue[customtypes.Gauge](cdc)),
+		NextGaugeId:                     collections.NewSequence(sb, customtypes.NextGaugeIdKey, "next_gauge_id"),
+		GaugesByStartTime:               collections.NewKeySet(sb, customtypes.GaugesByStartTimePrefix, "gauges_by_start_time", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+		RewardWeightsUpdateTime:         collections.NewItem(sb, customtypes.RewardWeightsUpdateTimeKey, "reward_weights_update_time", collcodec.KeyToValueCodec(sdk.TimeKey)),
+		ComputedRewardWeights:           collections.NewMap(sb, customtypes.ComputedRewardWeightsPrefix, "computed_reward_weights", collections.StringKey, sdk.LegacyDecValue),
+		PaymentStreams:                  collections.NewMap(sb, customtypes.PaymentStreamsPrefix, "payment_streams", collections.Uint64Key, codec.CollValue[customtypes.PaymentStream](cdc)),
+		NextPaymentStreamId:             collections.NewSequence(sb, customtypes.NextPaymentStreamIdKey, "next_payment_stream_id"),
 	}
 
 	schema, err := sb.Build()
@@ -166,6 +187,63 @@ func (k Keeper) WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAd
 	return rewards, nil
 }
 
+// WithdrawAllDelegationRewards withdraws the rewards of the delegations of a delegator in the
+// order of the validator addresses, starting from the start validator and optionally restricted
+// to the given validators. The batch stops at the limit or when the remaining gas is less than
+// the most expensive withdrawal so far, and returns the validator address to resume from.
+func (k Keeper) WithdrawAllDelegationRewards(
+	ctx context.Context, delAddr sdk.AccAddress, valAddrs []sdk.ValAddress, startValAddr sdk.ValAddress, limit uint32,
+) (customtypes.Pools, sdk.ValAddress, error) {
+	if limit == 0 || limit > customtypes.MaxWithdrawAllBatchSize {
+		limit = customtypes.MaxWithdrawAllBatchSize
+	}
+
+	filter := make(map[string]bool, len(valAddrs))
+	for _, valAddr := range valAddrs {
+		filter[string(valAddr)] = true
+	}
+
+	delegations, err := k.stakingKeeper.GetAllDelegatorDelegations(ctx, delAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gasMeter := sdk.UnwrapSDKContext(ctx).GasMeter()
+	maxGasUsed := storetypes.Gas(0)
+
+	var withdrawn uint32
+	rewards := customtypes.Pools{}
+	for _, delegation := range delegations {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.GetValidatorAddr())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if bytes.Compare(valAddr, startValAddr) < 0 {
+			continue
+		} else if len(filter) != 0 && !filter[string(valAddr)] {
+			continue
+		}
+
+		// bound the batch to let the message succeed with the partial withdrawal
+		if withdrawn == limit || gasMeter.GasRemaining() < maxGasUsed {
+			return rewards, valAddr, nil
+		}
+
+		gasBefore := gasMeter.GasConsumed()
+		reward, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		maxGasUsed = max(maxGasUsed, gasMeter.GasConsumed()-gasBefore)
+		rewards = rewards.Add(reward...)
+		withdrawn++
+	}
+
+	return rewards, nil, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx context.Context, valAddr sdk.ValAddress) (customtypes.Pools, error) {
 	// fetch validator accumulated commission
@@ -1,5 +1,11 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	customtypes "github.com/initia-labs/initia/x/distribution/types"
+)
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	k Keeper
@@ -9,3 +15,26 @@ type Migrator struct {
 func NewMigrator(k Keeper) Migrator {
 	return Migrator{k: k}
 }
+
+// Migrate1to2 migrates the distribution store from version 1 to 2. The gauge params
+// added in version 2 are filled with their defaults.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.GaugeCreationFee == nil {
+		params.GaugeCreationFee = customtypes.DefaultGaugeCreationFee
+	}
+
+	if params.MaxActiveGauges == 0 {
+		params.MaxActiveGauges = customtypes.DefaultMaxActiveGauges
+	}
+
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return m.k.Params.Set(ctx, params)
+}
@@ -0,0 +1,29 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/initia/x/distribution/keeper"
+	customtypes "github.com/initia-labs/initia/x/distribution/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	// the params and the gauge stored by the version 1
+	params, err := input.DistKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.GaugeCreationFee = nil
+	params.MaxActiveGauges = 0
+	require.NoError(t, input.DistKeeper.Params.Set(ctx, params))
+
+	m := keeper.NewMigrator(input.DistKeeper)
+	require.NoError(t, m.Migrate1to2(ctx))
+
+	params, err = input.DistKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, customtypes.DefaultMaxActiveGauges, params.MaxActiveGauges)
+	require.True(t, params.GaugeCreationFee.IsZero())
+}
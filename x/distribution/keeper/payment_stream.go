@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	customtypes "github.com/initia-labs/initia/x/distribution/types"
+)
+
+// CreatePaymentStream creates a payment stream which linearly pays out the total amount
+// from the community pool to the recipient between the start time and the end time.
+func (k Keeper) CreatePaymentStream(
+	ctx context.Context, recipient sdk.AccAddress, denom string, total math.Int,
+	startTime, endTime time.Time,
+) (uint64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if startTime.Before(sdkCtx.BlockTime()) {
+		startTime = sdkCtx.BlockTime()
+	}
+
+	recipientStr, err := k.authKeeper.AddressCodec().BytesToString(recipient)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := k.NextPaymentStreamId.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	stream := customtypes.NewPaymentStream(id, recipientStr, denom, total, startTime, endTime)
+	if err := stream.Validate(); err != nil {
+		return 0, errorsmod.Wrap(customtypes.ErrInvalidPaymentStream, err.Error())
+	}
+
+	if err := k.PaymentStreams.Set(ctx, id, stream); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// ClaimPaymentStream sends the released and unclaimed amount of the payment stream to
+// the recipient. The finished stream is removed after the claim.
+func (k Keeper) ClaimPaymentStream(ctx context.Context, recipient sdk.AccAddress, id uint64) (sdk.Coins, error) {
+	stream, err := k.GetPaymentStream(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	recipientStr, err := k.authKeeper.AddressCodec().BytesToString(recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if stream.Recipient != recipientStr {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "expected recipient %s, got %s", stream.Recipient, recipientStr)
+	}
+
+	amount, err := k.payPaymentStream(ctx, stream)
+	if err != nil {
+		return nil, err
+	}
+
+	stream.Claimed = stream.Released
+	if stream.IsFinished() {
+		return amount, k.PaymentStreams.Remove(ctx, id)
+	}
+
+	return amount, k.PaymentStreams.Set(ctx, id, stream)
+}
+
+// CancelPaymentStream pays the released and unclaimed amount of the payment stream to the
+// recipient and removes the stream; the unreleased amount remains in the community pool.
+func (k Keeper) CancelPaymentStream(ctx context.Context, id uint64) error {
+	stream, err := k.GetPaymentStream(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := k.payPaymentStream(ctx, stream); err != nil {
+		return err
+	}
+
+	return k.PaymentStreams.Remove(ctx, id)
+}
+
+// GetPaymentStream returns the payment stream of the id.
+func (k Keeper) GetPaymentStream(ctx context.Context, id uint64) (customtypes.PaymentStream, error) {
+	found, err := k.PaymentStreams.Has(ctx, id)
+	if err != nil {
+		return customtypes.PaymentStream{}, err
+	} else if !found {
+		return customtypes.PaymentStream{}, errorsmod.Wrapf(customtypes.ErrPaymentStreamNotFound, "id %d", id)
+	}
+
+	return k.PaymentStreams.Get(ctx, id)
+}
+
+// GetTotalPaymentStreamClaimable returns the sum of the released and unclaimed amounts of
+// the payment streams, which are held by the distribution module account.
+func (k Keeper) GetTotalPaymentStreamClaimable(ctx context.Context) (claimable sdk.Coins, err error) {
+	claimable = sdk.NewCoins()
+	err = k.PaymentStreams.Walk(ctx, nil, func(_ uint64, stream customtypes.PaymentStream) (stop bool, err error) {
+		claimable = claimable.Add(sdk.NewCoin(stream.Denom, stream.Claimable()))
+		return false, nil
+	})
+
+	return claimable, err
+}
+
+// ReleasePaymentStreams pays out the releasable amounts of the started payment streams
+// from the community pool. The release is bounded by the balance of the community pool,
+// and the shortfall is released later when the community pool is funded.
+func (k Keeper) ReleasePaymentStreams(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+
+	var streams []customtypes.PaymentStream
+	err := k.PaymentStreams.Walk(ctx, nil, func(_ uint64, stream customtypes.PaymentStream) (stop bool, err error) {
+		if !stream.StartTime.After(blockTime) && !stream.IsFinished() {
+			streams = append(streams, stream)
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(streams) == 0 {
+		return nil
+	}
+
+	feePool, err := k.FeePool.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, stream := range streams {
+		released := math.MinInt(stream.ReleasableAt(blockTime), feePool.CommunityPool.AmountOf(stream.Denom).TruncateInt())
+		if !released.IsPositive() {
+			continue
+		}
+
+		feePool.CommunityPool = feePool.CommunityPool.Sub(sdk.NewDecCoins(sdk.NewDecCoin(stream.Denom, released)))
+		stream.Released = stream.Released.Add(released)
+		if err := k.PaymentStreams.Set(ctx, stream.Id, stream); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				customtypes.EventTypePaymentStreamRelease,
+				sdk.NewAttribute(customtypes.AttributeKeyStreamId, strconv.FormatUint(stream.Id, 10)),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(stream.Denom, released).String()),
+			),
+		)
+	}
+
+	return k.FeePool.Set(ctx, feePool)
+}
+
+// payPaymentStream sends the claimable amount of the payment stream to the recipient.
+func (k Keeper) payPaymentStream(ctx context.Context, stream customtypes.PaymentStream) (sdk.Coins, error) {
+	amount := sdk.NewCoins(sdk.NewCoin(stream.Denom, stream.Claimable()))
+	if amount.IsZero() {
+		return amount, nil
+	}
+
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(stream.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amount); err != nil {
+		return nil, err
+	}
+
+	return amount, nil
+}
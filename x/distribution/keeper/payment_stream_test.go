@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/initia-labs/initia/x/distribution/keeper"
+	customtypes "github.com/initia-labs/initia/x/distribution/types"
+)
+
+func TestCreatePaymentStream(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewCustomMsgServerImpl(input.DistKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	_, _, recipient := keyPubAddr()
+
+	// invalid authority
+	_, err := ms.CreatePaymentStream(ctx, &customtypes.MsgCreatePaymentStream{
+		Authority: recipient.String(),
+		Recipient: recipient.String(),
+		Denom:     bondDenom,
+		Total:     math.NewInt(1_000),
+		EndTime:   ctx.BlockTime().Add(time.Hour),
+	})
+	require.Error(t, err)
+
+	// both of the total and the rate
+	_, err = ms.CreatePaymentStream(ctx, &customtypes.MsgCreatePaymentStream{
+		Authority: authority,
+		Recipient: recipient.String(),
+		Denom:     bondDenom,
+		Total:     math.NewInt(1_000),
+		Rate:      math.NewInt(1),
+		EndTime:   ctx.BlockTime().Add(time.Hour),
+	})
+	require.ErrorIs(t, err, customtypes.ErrInvalidPaymentStream)
+
+	// invalid schedule
+	_, err = ms.CreatePaymentStream(ctx, &customtypes.MsgCreatePaymentStream{
+		Authority: authority,
+		Recipient: recipient.String(),
+		Denom:     bondDenom,
+		Total:     math.NewInt(1_000),
+		EndTime:   ctx.BlockTime().Add(-time.Hour),
+	})
+	require.ErrorIs(t, err, customtypes.ErrInvalidPaymentStream)
+
+	// the total is computed from the rate
+	res, err := ms.CreatePaymentStream(ctx, &customtypes.MsgCreatePaymentStream{
+		Authority: authority,
+		Recipient: recipient.String(),
+		Denom:     bondDenom,
+		Rate:      math.NewInt(2),
+		StartTime: ctx.BlockTime().Add(-time.Hour),
+		EndTime:   ctx.BlockTime().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	queryServer := keeper.NewCustomQueryServer(input.DistKeeper)
+	streamRes, err := queryServer.PaymentStream(ctx, &customtypes.QueryPaymentStreamRequest{StreamId: res.StreamId})
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime(), streamRes.Stream.StartTime)
+	require.Equal(t, math.NewInt(7_200), streamRes.Stream.Total)
+
+	streamsRes, err := queryServer.PaymentStreams(ctx, &customtypes.QueryPaymentStreamsRequest{Recipient: recipient.String()})
+	require.NoError(t, err)
+	require.Len(t, streamsRes.Streams, 1)
+
+	streamsRes, err = queryServer.PaymentStreams(ctx, &customtypes.QueryPaymentStreamsRequest{Recipient: authority})
+	require.NoError(t, err)
+	require.Empty(t, streamsRes.Streams)
+
+	_, err = queryServer.PaymentStream(ctx, &customtypes.QueryPaymentStreamRequest{StreamId: res.StreamId + 1})
+	require.Error(t, err)
+}
+
+func TestPaymentStreamRelease(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewCustomMsgServerImpl(input.DistKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	_, _, funder := keyPubAddr()
+	input.Faucet.Fund(ctx, funder, sdk.NewInt64Coin(bondDenom, 600))
+	require.NoError(t, input.DistKeeper.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 600)), funder))
+
+	_, _, recipient := keyPubAddr()
+	startTime := ctx.BlockTime()
+	res, err := ms.CreatePaymentStream(ctx, &customtypes.MsgCreatePaymentStream{
+		Authority: authority,
+		Recipient: recipient.String(),
+		Denom:     bondDenom,
+		Total:     math.NewInt(1_000),
+		StartTime: startTime,
+		EndTime:   startTime.Add(10 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	// half released
+	ctx = ctx.WithBlockTime(startTime.Add(5 * time.Hour))
+	require.NoError(t, input.DistKeeper.ReleasePaymentStreams(ctx))
+
+	stream, err := input.DistKeeper.GetPaymentStream(ctx, res.StreamId)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(500), stream.Released)
+
+	feePool, err := input.DistKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(100), feePool.CommunityPool.AmountOf(bondDenom))
+
+	// only the recipient can claim
+	_, err = ms.ClaimPaymentStream(ctx, &customtypes.MsgClaimPaymentStream{Recipient: funder.String(), StreamId: res.StreamId})
+	require.Error(t, err)
+
+	claimRes, err := ms.ClaimPaymentStream(ctx, &customtypes.MsgClaimPaymentStream{Recipient: recipient.String(), StreamId: res.StreamId})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500)), claimRes.Amount)
+	require.Equal(t, math.NewInt(500), input.BankKeeper.GetBalance(ctx, recipient, bondDenom).Amount)
+
+	// bounded by the community pool balance
+	ctx = ctx.WithBlockTime(startTime.Add(8 * time.Hour))
+	require.NoError(t, input.DistKeeper.ReleasePaymentStreams(ctx))
+
+	stream, err = input.DistKeeper.GetPaymentStream(ctx, res.StreamId)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(600), stream.Released)
+	require.Equal(t, math.NewInt(100), stream.Claimable())
+
+	_, broken := keeper.ModuleAccountInvariant(input.DistKeeper)(ctx)
+	require.False(t, broken)
+
+	// genesis export contains the stream
+	require.NoError(t, input.DistKeeper.PreviousProposerConsAddr.Set(ctx, sdk.ConsAddress(recipient)))
+	genState := input.DistKeeper.ExportGenesis(ctx)
+	require.Equal(t, []customtypes.PaymentStream{stream}, genState.PaymentStreams)
+	require.Equal(t, res.StreamId+1, genState.NextPaymentStreamId)
+	require.NoError(t, customtypes.ValidateGenesis(genState))
+
+	// the payment stream ids start from 1
+	genState.NextPaymentStreamId = 0
+	require.Error(t, customtypes.ValidateGenesis(genState))
+
+	// cancel pays the claimable amount and keeps the remaining in the community pool
+	_, err = ms.CancelPaymentStream(ctx, &customtypes.MsgCancelPaymentStream{Authority: recipient.String(), StreamId: res.StreamId})
+	require.Error(t, err)
+
+	_, err = ms.CancelPaymentStream(ctx, &customtypes.MsgCancelPaymentStream{Authority: authority, StreamId: res.StreamId})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(600), input.BankKeeper.GetBalance(ctx, recipient, bondDenom).Amount)
+
+	_, err = input.DistKeeper.GetPaymentStream(ctx, res.StreamId)
+	require.ErrorIs(t, err, customtypes.ErrPaymentStreamNotFound)
+
+	_, broken = keeper.ModuleAccountInvariant(input.DistKeeper)(ctx)
+	require.False(t, broken)
+}
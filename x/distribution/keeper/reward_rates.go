@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	customtypes "github.com/initia-labs/initia/x/distribution/types"
+	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
+)
+
+const secondsPerYear = int64(time.Hour * 24 * 365 / time.Second)
+
+// GetProjectedRewardRates projects the annual reward rates of the delegations to the bonded
+// validators with the same logic as AllocateTokens, assuming the current annual provisions of
+// the reward module are allocated with the current community tax and reward weights.
+func (k Keeper) GetProjectedRewardRates(ctx context.Context) ([]customtypes.ValidatorRewardRates, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardParams, err := k.rewardKeeper.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	annualProvisions := math.LegacyZeroDec()
+	if rewardParams.ReleaseEnabled {
+		annualProvisions, err = k.rewardKeeper.GetAnnualProvisions(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	powerWeights, err := k.stakingKeeper.GetVotingPowerWeights(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// build the votes of the bonded validators to load the bonded tokens
+	// in the same way as the allocation.
+	var operators []string
+	var bondedVotes []abci.VoteInfo
+	err = k.stakingKeeper.IterateValidators(ctx, func(validator stakingtypes.ValidatorI) (stop bool, err error) {
+		if !validator.IsBonded() {
+			return false, nil
+		}
+
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return true, err
+		}
+
+		operators = append(operators, validator.GetOperator())
+		bondedVotes = append(bondedVotes, abci.VoteInfo{Validator: abci.Validator{Address: consAddr}})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rewardWeights, rewardWeightMap, weightsSum, err := k.LoadRewardWeights(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	validators, _, bondedTokensSum, err := k.LoadBondedTokens(ctx, bondedVotes, rewardWeightMap)
+	if err != nil {
+		return nil, err
+	}
+
+	voteMultiplier := math.LegacyOneDec().Sub(params.CommunityTax)
+	distributable := annualProvisions.Mul(voteMultiplier)
+
+	rates := make([]customtypes.ValidatorRewardRates, 0, len(operators))
+	for _, operator := range operators {
+		validator := validators[operator]
+		delegatorMultiplier := math.LegacyOneDec().Sub(validator.GetCommission())
+
+		pools := make([]customtypes.PoolRewardRate, 0, len(rewardWeights))
+		for _, rewardWeight := range rewardWeights {
+			poolSize, ok := bondedTokensSum[rewardWeight.Denom]
+			if !ok || poolSize.IsZero() || !weightsSum.IsPositive() {
+				continue
+			}
+
+			rewardPerToken := distributable.Mul(rewardWeight.Weight).Quo(weightsSum).QuoInt(poolSize).Mul(delegatorMultiplier)
+			pools = append(pools, newPoolRewardRate(
+				rewardWeight.Denom,
+				sdk.NewDecCoins(sdk.NewDecCoinFromDec(rewardParams.RewardDenom, rewardPerToken)),
+				rewardParams.RewardDenom,
+				powerWeights,
+			))
+		}
+
+		rates = append(rates, customtypes.ValidatorRewardRates{
+			ValidatorAddress: operator,
+			Pools:            pools,
+		})
+	}
+
+	return rates, nil
+}
+
+// GetRealizedRewardRates returns the annual reward rates of the delegations to the validator
+// realized since the end of the oldest historical rewards period, which are derived from the
+// difference of the cumulative reward ratios. It also returns the start and end times.
+func (k Keeper) GetRealizedRewardRates(ctx context.Context, val stakingtypes.ValidatorI) (customtypes.ValidatorRewardRates, time.Time, time.Time, error) {
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(val.GetOperator())
+	if err != nil {
+		return customtypes.ValidatorRewardRates{}, time.Time{}, time.Time{}, err
+	}
+
+	// find the oldest period with the time
+	var start customtypes.ValidatorHistoricalRewards
+	err = k.ValidatorHistoricalRewards.Walk(ctx, collections.NewPrefixedPairRange[[]byte, uint64](valAddr), func(_ collections.Pair[[]byte, uint64], rewards customtypes.ValidatorHistoricalRewards) (stop bool, err error) {
+		if rewards.Time.IsZero() {
+			return false, nil
+		}
+
+		start = rewards
+		return true, nil
+	})
+	if err != nil {
+		return customtypes.ValidatorRewardRates{}, time.Time{}, time.Time{}, err
+	}
+
+	// end the current period to include the pending rewards
+	endingPeriod, err := k.IncrementValidatorPeriod(ctx, val)
+	if err != nil {
+		return customtypes.ValidatorRewardRates{}, time.Time{}, time.Time{}, err
+	}
+
+	end, err := k.ValidatorHistoricalRewards.Get(ctx, collections.Join(valAddr, endingPeriod))
+	if err != nil {
+		return customtypes.ValidatorRewardRates{}, time.Time{}, time.Time{}, err
+	}
+
+	rates := customtypes.ValidatorRewardRates{
+		ValidatorAddress: val.GetOperator(),
+		Pools:            []customtypes.PoolRewardRate{},
+	}
+
+	elapsed := int64(end.Time.Sub(start.Time) / time.Second)
+	if start.Time.IsZero() || elapsed <= 0 {
+		return rates, start.Time, end.Time, nil
+	}
+
+	rewardParams, err := k.rewardKeeper.GetParams(ctx)
+	if err != nil {
+		return customtypes.ValidatorRewardRates{}, time.Time{}, time.Time{}, err
+	}
+
+	powerWeights, err := k.stakingKeeper.GetVotingPowerWeights(ctx)
+	if err != nil {
+		return customtypes.ValidatorRewardRates{}, time.Time{}, time.Time{}, err
+	}
+
+	for _, pool := range end.CumulativeRewardRatios {
+		realized := pool.DecCoins.Sub(start.CumulativeRewardRatios.CoinsOf(pool.Denom))
+		rewardPerToken := realized.MulDec(math.LegacyNewDec(secondsPerYear)).QuoDec(math.LegacyNewDec(elapsed))
+		rates.Pools = append(rates.Pools, newPoolRewardRate(pool.Denom, rewardPerToken, rewardParams.RewardDenom, powerWeights))
+	}
+
+	return rates, start.Time, end.Time, nil
+}
+
+// newPoolRewardRate creates a PoolRewardRate with the apr computed from the rewards
+// in the reward denom and the locked base value of a staked token.
+func newPoolRewardRate(denom string, rewardPerToken sdk.DecCoins, rewardDenom string, powerWeights sdk.DecCoins) customtypes.PoolRewardRate {
+	apr := math.LegacyZeroDec()
+	if powerWeight := powerWeights.AmountOf(denom); powerWeight.IsPositive() {
+		apr = rewardPerToken.AmountOf(rewardDenom).Quo(powerWeight)
+	}
+
+	return customtypes.PoolRewardRate{
+		Denom:          denom,
+		RewardPerToken: rewardPerToken,
+		Apr:            apr,
+	}
+}
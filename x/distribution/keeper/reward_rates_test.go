@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/distribution/keeper"
+	customtypes "github.com/initia-labs/initia/x/distribution/types"
+)
+
+func TestQueryProjectedRewardRates(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	valAddr1 := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 1)
+	valAddr2 := createValidatorWithBalance(ctx, input, 100_000_000, 3_000_000, 2)
+
+	queryServer := keeper.NewCustomQueryServer(input.DistKeeper)
+
+	// the release is disabled by default
+	res, err := queryServer.ProjectedRewardRates(ctx, &customtypes.QueryProjectedRewardRatesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Rates, 2)
+	for _, rate := range res.Rates {
+		require.Len(t, rate.Pools, 1)
+		require.True(t, rate.Pools[0].Apr.IsZero())
+	}
+
+	rewardParams, err := input.RewardKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	rewardParams.ReleaseEnabled = true
+	require.NoError(t, input.RewardKeeper.SetParams(ctx, rewardParams))
+
+	annualProvisions, err := input.RewardKeeper.GetAnnualProvisions(ctx)
+	require.NoError(t, err)
+
+	params, err := input.DistKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	// all the validators earn the same rate from the single pool; 10% commission
+	expected := annualProvisions.Mul(math.LegacyOneDec().Sub(params.CommunityTax)).QuoInt64(4_000_000).Mul(math.LegacyNewDecWithPrec(9, 1))
+
+	res, err = queryServer.ProjectedRewardRates(ctx, &customtypes.QueryProjectedRewardRatesRequest{ValidatorAddress: valAddr2.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Rates, 1)
+	require.Equal(t, valAddr2.String(), res.Rates[0].ValidatorAddress)
+	require.Equal(t, []customtypes.PoolRewardRate{{
+		Denom:          bondDenom,
+		RewardPerToken: sdk.NewDecCoins(sdk.NewDecCoinFromDec(bondDenom, expected)),
+		Apr:            expected,
+	}}, res.Rates[0].Pools)
+
+	// the apr is discounted by the voting power weight
+	input.VotingPowerKeeper.SetVotingPowerWeights(sdk.NewDecCoins(sdk.NewDecCoin(bondDenom, math.NewInt(2))))
+	res, err = queryServer.ProjectedRewardRates(ctx, &customtypes.QueryProjectedRewardRatesRequest{ValidatorAddress: valAddr1.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Rates, 1)
+	require.Equal(t, expected.QuoInt64(2), res.Rates[0].Pools[0].Apr)
+
+	_, err = queryServer.ProjectedRewardRates(ctx, &customtypes.QueryProjectedRewardRatesRequest{ValidatorAddress: "invalid"})
+	require.Error(t, err)
+}
+
+func TestQueryRealizedRewardRates(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	valAddr := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 1)
+	queryServer := keeper.NewCustomQueryServer(input.DistKeeper)
+
+	// no time has passed
+	res, err := queryServer.RealizedRewardRates(ctx, &customtypes.QueryRealizedRewardRatesRequest{ValidatorAddress: valAddr.String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Rates.Pools)
+
+	startTime := ctx.BlockTime()
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour * 24 * 365 / 2))
+
+	validator, err := input.StakingKeeper.Validator(ctx, valAddr)
+	require.NoError(t, err)
+
+	// 45_000 shared by the delegators after the 10% commission in a half year
+	require.NoError(t, input.DistKeeper.AllocateTokensToValidatorPool(ctx, validator, bondDenom, sdk.NewDecCoins(sdk.NewInt64DecCoin(bondDenom, 50_000))))
+
+	res, err = queryServer.RealizedRewardRates(ctx, &customtypes.QueryRealizedRewardRatesRequest{ValidatorAddress: valAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, startTime, res.StartTime)
+	require.Equal(t, ctx.BlockTime(), res.EndTime)
+	require.Equal(t, []customtypes.PoolRewardRate{{
+		Denom:          bondDenom,
+		RewardPerToken: sdk.NewDecCoins(sdk.NewDecCoinFromDec(bondDenom, math.LegacyNewDecWithPrec(9, 2))),
+		Apr:            math.LegacyNewDecWithPrec(9, 2),
+	}}, res.Rates.Pools)
+
+	_, err = queryServer.RealizedRewardRates(ctx, &customtypes.QueryRealizedRewardRatesRequest{})
+	require.Error(t, err)
+}
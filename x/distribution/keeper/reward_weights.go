@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	customtypes "github.com/initia-labs/initia/x/distribution/types"
+	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
+)
+
+// UpdateDynamicRewardWeights recomputes the reward weights from the locked base value
+// of the reward pools when the dynamic reward weights mode is enabled and an epoch
+// has passed since the last update. The computed weights are stored apart from the
+// reward weights of the params, which are kept as set by the governance.
+func (k Keeper) UpdateDynamicRewardWeights(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	config := params.DynamicRewardWeights
+	if !config.Enabled {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+
+	lastUpdateTime, err := k.RewardWeightsUpdateTime.Get(ctx)
+	if err == nil && blockTime.Before(lastUpdateTime.Add(config.EpochDuration)) {
+		return nil
+	} else if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if err := k.RewardWeightsUpdateTime.Set(ctx, blockTime); err != nil {
+		return err
+	}
+
+	if err := k.pruneComputedRewardWeights(ctx, params); err != nil {
+		return err
+	}
+
+	targets, err := k.GetTargetRewardWeights(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	// nothing is locked in the reward pools; keep the current weights
+	if len(targets) == 0 {
+		return nil
+	}
+
+	currentWeights, _, weightsSum, err := k.LoadRewardWeights(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	for _, rewardWeight := range currentWeights {
+		current := math.LegacyZeroDec()
+		if weightsSum.IsPositive() {
+			current = rewardWeight.Weight.Quo(weightsSum)
+		}
+
+		target := targets[rewardWeight.Denom]
+		if target.IsNil() {
+			target = math.LegacyZeroDec()
+		}
+
+		// bound the change per epoch
+		weight := math.LegacyMinDec(target, current.Add(config.MaxChange))
+		weight = math.LegacyMaxDec(weight, current.Sub(config.MaxChange))
+		weight = math.LegacyMaxDec(weight, math.LegacyZeroDec())
+
+		// compare with the previously computed weight; without one, the normalized
+		// param weight is in effect
+		oldWeight, err := k.ComputedRewardWeights.Get(ctx, rewardWeight.Denom)
+		if err != nil && errors.Is(err, collections.ErrNotFound) {
+			oldWeight = current
+		} else if err != nil {
+			return err
+		}
+
+		if err := k.ComputedRewardWeights.Set(ctx, rewardWeight.Denom, weight); err != nil {
+			return err
+		}
+		if weight.Equal(oldWeight) {
+			continue
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				customtypes.EventTypeUpdateRewardWeight,
+				sdk.NewAttribute(customtypes.AttributeKeyDenom, rewardWeight.Denom),
+				sdk.NewAttribute(customtypes.AttributeKeyOldWeight, oldWeight.String()),
+				sdk.NewAttribute(customtypes.AttributeKeyNewWeight, weight.String()),
+				sdk.NewAttribute(customtypes.AttributeKeyTargetWeight, target.String()),
+			),
+		)
+	}
+
+	return nil
+}
+
+// pruneComputedRewardWeights removes the computed weights of the denoms removed from the
+// reward weights of the params.
+func (k Keeper) pruneComputedRewardWeights(ctx context.Context, params customtypes.Params) error {
+	denoms := make(map[string]bool, len(params.RewardWeights))
+	for _, rewardWeight := range params.RewardWeights {
+		denoms[rewardWeight.Denom] = true
+	}
+
+	var removed []string
+	err := k.ComputedRewardWeights.Walk(ctx, nil, func(denom string, _ math.LegacyDec) (stop bool, err error) {
+		if !denoms[denom] {
+			removed = append(removed, denom)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, denom := range removed {
+		if err := k.ComputedRewardWeights.Remove(ctx, denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetTargetRewardWeights returns the normalized target weights of the reward pools, which
+// are proportional to the multiplier * locked base value of the bonded tokens. It returns
+// an empty map if no base value is locked in the reward pools.
+func (k Keeper) GetTargetRewardWeights(ctx context.Context, params customtypes.Params) (map[string]math.LegacyDec, error) {
+	powerWeights, err := k.stakingKeeper.GetVotingPowerWeights(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bondedTokens := sdk.NewCoins()
+	err = k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(validator stakingtypes.ValidatorI) (stop bool, err error) {
+		bondedTokens = bondedTokens.Add(validator.GetBondedTokens()...)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	multipliers := make(map[string]math.LegacyDec, len(params.DynamicRewardWeights.Multipliers))
+	for _, multiplier := range params.DynamicRewardWeights.Multipliers {
+		multipliers[multiplier.Denom] = multiplier.Weight
+	}
+
+	targetsSum := math.LegacyZeroDec()
+	targets := make(map[string]math.LegacyDec, len(params.RewardWeights))
+	for _, rewardWeight := range params.RewardWeights {
+		denom := rewardWeight.Denom
+		lockedValue := powerWeights.AmountOf(denom).MulInt(bondedTokens.AmountOf(denom))
+		if multiplier, found := multipliers[denom]; found {
+			lockedValue = lockedValue.Mul(multiplier)
+		}
+
+		targets[denom] = lockedValue
+		targetsSum = targetsSum.Add(lockedValue)
+	}
+
+	if !targetsSum.IsPositive() {
+		return map[string]math.LegacyDec{}, nil
+	}
+
+	for denom, target := range targets {
+		targets[denom] = target.Quo(targetsSum)
+	}
+
+	return targets, nil
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	customtypes "github.com/initia-labs/initia/x/distribution/types"
+)
+
+func TestUpdateDynamicRewardWeights(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	input.StakingKeeper.SetBondDenoms(ctx, []string{bondDenom, "foo"})
+	input.VotingPowerKeeper.SetVotingPowerWeights(sdk.NewDecCoins(sdk.NewDecCoin(bondDenom, math.NewInt(1)), sdk.NewDecCoin("foo", math.NewInt(3))))
+
+	createValidatorWithCoin(ctx, input,
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000), sdk.NewInt64Coin("foo", 100_000_000)),
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000), sdk.NewInt64Coin("foo", 1_000_000)), 1)
+
+	params, err := input.DistKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.RewardWeights = []customtypes.RewardWeight{
+		{Denom: bondDenom, Weight: math.LegacyNewDecWithPrec(5, 1)},
+		{Denom: "foo", Weight: math.LegacyNewDecWithPrec(5, 1)},
+	}
+	require.NoError(t, input.DistKeeper.Params.Set(ctx, params))
+
+	// static mode
+	require.NoError(t, input.DistKeeper.UpdateDynamicRewardWeights(ctx))
+	weights, err := input.DistKeeper.GetRewardWeights(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params.RewardWeights, weights)
+
+	params.DynamicRewardWeights = customtypes.DynamicRewardWeights{
+		Enabled:       true,
+		EpochDuration: time.Hour,
+		MaxChange:     math.LegacyNewDecWithPrec(1, 1),
+	}
+	require.NoError(t, params.ValidateBasic())
+	require.NoError(t, input.DistKeeper.Params.Set(ctx, params))
+
+	// the targets are 0.25 and 0.75 from the locked base values; bounded by the max change
+	targets, err := input.DistKeeper.GetTargetRewardWeights(ctx, params)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(25, 2), targets[bondDenom])
+	require.Equal(t, math.LegacyNewDecWithPrec(75, 2), targets["foo"])
+
+	require.NoError(t, input.DistKeeper.UpdateDynamicRewardWeights(ctx))
+	weights, _, _ = loadRewardsWeight(t, ctx, input)
+	require.Equal(t, []customtypes.RewardWeight{
+		{Denom: bondDenom, Weight: math.LegacyNewDecWithPrec(4, 1)},
+		{Denom: "foo", Weight: math.LegacyNewDecWithPrec(6, 1)},
+	}, weights)
+
+	events := ctx.EventManager().Events()
+	numEvents := 0
+	for _, event := range events {
+		if event.Type == customtypes.EventTypeUpdateRewardWeight {
+			numEvents++
+		}
+	}
+	require.Equal(t, 2, numEvents)
+
+	// not updated within the epoch
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	require.NoError(t, input.DistKeeper.UpdateDynamicRewardWeights(ctx))
+	weights, _, _ = loadRewardsWeight(t, ctx, input)
+	require.Equal(t, math.LegacyNewDecWithPrec(4, 1), weights[0].Weight)
+
+	// reach the target after the epochs
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, input.DistKeeper.UpdateDynamicRewardWeights(ctx))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, input.DistKeeper.UpdateDynamicRewardWeights(ctx))
+	weights, _, _ = loadRewardsWeight(t, ctx, input)
+	require.Equal(t, []customtypes.RewardWeight{
+		{Denom: bondDenom, Weight: math.LegacyNewDecWithPrec(25, 2)},
+		{Denom: "foo", Weight: math.LegacyNewDecWithPrec(75, 2)},
+	}, weights)
+
+	// the reward weights of the params are kept as set by the governance
+	paramsWeights, err := input.DistKeeper.GetRewardWeights(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params.RewardWeights, paramsWeights)
+
+	// no update is emitted once the computed weights reach the targets
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, input.DistKeeper.UpdateDynamicRewardWeights(ctx))
+	for _, event := range ctx.EventManager().Events() {
+		require.NotEqual(t, customtypes.EventTypeUpdateRewardWeight, event.Type)
+	}
+
+	// the computed weights are exported to genesis
+	require.NoError(t, input.DistKeeper.PreviousProposerConsAddr.Set(ctx, sdk.ConsAddress("proposer")))
+	genState := input.DistKeeper.ExportGenesis(ctx)
+	require.ElementsMatch(t, weights, genState.ComputedRewardWeights)
+
+	// the multiplier scales the locked base value
+	params, err = input.DistKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.DynamicRewardWeights.Multipliers = []customtypes.RewardWeight{
+		{Denom: bondDenom, Weight: math.LegacyNewDec(3)},
+	}
+	require.NoError(t, input.DistKeeper.Params.Set(ctx, params))
+
+	targets, err = input.DistKeeper.GetTargetRewardWeights(ctx, params)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), targets[bondDenom])
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), targets["foo"])
+
+	// the computed weight of the denom removed from the params is pruned
+	params.RewardWeights = params.RewardWeights[:1]
+	require.NoError(t, input.DistKeeper.Params.Set(ctx, params))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, input.DistKeeper.UpdateDynamicRewardWeights(ctx))
+	found, err := input.DistKeeper.ComputedRewardWeights.Has(ctx, "foo")
+	require.NoError(t, err)
+	require.False(t, found)
+}
@@ -22,7 +22,7 @@ func (k Keeper) initializeValidator(ctx context.Context, val stakingtypes.Valida
 	}
 
 	// set initial historical rewards (period 0) with reference count of 1
-	err = k.ValidatorHistoricalRewards.Set(ctx, collections.Join(valAddr, uint64(0)), customtypes.NewValidatorHistoricalRewards(customtypes.DecPools{}, 1))
+	err = k.ValidatorHistoricalRewards.Set(ctx, collections.Join(valAddr, uint64(0)), customtypes.NewValidatorHistoricalRewards(customtypes.DecPools{}, 1, sdk.UnwrapSDKContext(ctx).BlockTime()))
 	if err != nil {
 		return err
 	}
@@ -112,7 +112,7 @@ func (k Keeper) IncrementValidatorPeriod(ctx context.Context, val stakingtypes.V
 	}
 
 	// set new historical rewards with reference count of 1
-	err = k.ValidatorHistoricalRewards.Set(ctx, collections.Join(valAddr, rewards.Period), customtypes.NewValidatorHistoricalRewards(historicalRewards.CumulativeRewardRatios.Add(current...), 1))
+	err = k.ValidatorHistoricalRewards.Set(ctx, collections.Join(valAddr, rewards.Period), customtypes.NewValidatorHistoricalRewards(historicalRewards.CumulativeRewardRatios.Add(current...), 1, sdk.UnwrapSDKContext(ctx).BlockTime()))
 	if err != nil {
 		return 0, err
 	}
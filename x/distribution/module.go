@@ -28,7 +28,7 @@ import (
 	staking "github.com/initia-labs/initia/x/mstaking/types"
 )
 
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 var (
 	_ module.AppModuleBasic = AppModule{}
@@ -133,6 +133,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 
 	customtypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewCustomMsgServerImpl(am.keeper))
 	customtypes.RegisterQueryServer(cfg.QueryServer(), keeper.NewCustomQueryServer(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the distribution module. It returns
@@ -180,6 +185,7 @@ type ModuleInputs struct {
 	BankKeeper    types.BankKeeper
 	StakingKeeper customtypes.StakingKeeper
 	DexKeeper     customtypes.DexKeeper
+	RewardKeeper  customtypes.RewardKeeper
 }
 
 type ModuleOutputs struct {
@@ -209,6 +215,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.BankKeeper,
 		in.StakingKeeper,
 		in.DexKeeper,
+		in.RewardKeeper,
 		feeCollectorName,
 		authority.String(),
 	)
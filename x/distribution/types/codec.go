@@ -15,6 +15,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	// amino is rejecting long type names, so we register under `distr`
 	legacy.RegisterAminoMsg(cdc, &MsgDepositValidatorRewardsPool{}, "distr/MsgDepositValidatorRewardsPool")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "distribution/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateGauge{}, "distr/MsgCreateGauge")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawAllDelegatorRewards{}, "distr/MsgWithdrawAllDelegatorRewards")
+	legacy.RegisterAminoMsg(cdc, &MsgCreatePaymentStream{}, "distr/MsgCreatePaymentStream")
+	legacy.RegisterAminoMsg(cdc, &MsgClaimPaymentStream{}, "distr/MsgClaimPaymentStream")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelPaymentStream{}, "distr/MsgCancelPaymentStream")
 	cdc.RegisterConcrete(Params{}, "distribution/Params", nil)
 }
 
@@ -23,6 +28,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgDepositValidatorRewardsPool{},
 		&MsgUpdateParams{},
+		&MsgCreateGauge{},
+		&MsgWithdrawAllDelegatorRewards{},
+		&MsgCreatePaymentStream{},
+		&MsgClaimPaymentStream{},
+		&MsgCancelPaymentStream{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
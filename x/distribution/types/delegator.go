@@ -20,3 +20,7 @@ func NewDelegationDelegatorReward(valAddr sdk.ValAddress, rewards DecPools) Dele
 		Reward:           rewards,
 	}
 }
+
+// MaxWithdrawAllBatchSize is the maximum number of delegations withdrawn
+// by a single MsgWithdrawAllDelegatorRewards.
+const MaxWithdrawAllBatchSize = 100
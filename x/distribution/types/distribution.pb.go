@@ -6,20 +6,26 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -29,9 +35,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the set of params for the distribution module.
 type Params struct {
-	CommunityTax        cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=community_tax,json=communityTax,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_tax" yaml:"community_tax"`
-	WithdrawAddrEnabled bool                        `protobuf:"varint,2,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty" yaml:"withdraw_addr_enabled"`
-	RewardWeights       []RewardWeight              `protobuf:"bytes,3,rep,name=reward_weights,json=rewardWeights,proto3" json:"reward_weights" yaml:"reward_weights"`
+	CommunityTax         cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=community_tax,json=communityTax,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_tax" yaml:"community_tax"`
+	WithdrawAddrEnabled  bool                        `protobuf:"varint,2,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty" yaml:"withdraw_addr_enabled"`
+	RewardWeights        []RewardWeight              `protobuf:"bytes,3,rep,name=reward_weights,json=rewardWeights,proto3" json:"reward_weights" yaml:"reward_weights"`
+	DynamicRewardWeights DynamicRewardWeights        `protobuf:"bytes,4,opt,name=dynamic_reward_weights,json=dynamicRewardWeights,proto3" json:"dynamic_reward_weights" yaml:"dynamic_reward_weights"`
+	// gauge_creation_fee defines the fee charged to the depositor of an
+	// incentive gauge, which is sent to the community pool.
+	GaugeCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=gauge_creation_fee,json=gaugeCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"gauge_creation_fee" yaml:"gauge_creation_fee"`
+	// max_active_gauges defines the maximum number of the incentive gauges
+	// which are not yet finished.
+	MaxActiveGauges uint64 `protobuf:"varint,6,opt,name=max_active_gauges,json=maxActiveGauges,proto3" json:"max_active_gauges,omitempty" yaml:"max_active_gauges"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -80,6 +93,94 @@ func (m *Params) GetRewardWeights() []RewardWeight {
 	return nil
 }
 
+func (m *Params) GetDynamicRewardWeights() DynamicRewardWeights {
+	if m != nil {
+		return m.DynamicRewardWeights
+	}
+	return DynamicRewardWeights{}
+}
+
+func (m *Params) GetGaugeCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.GaugeCreationFee
+	}
+	return nil
+}
+
+func (m *Params) GetMaxActiveGauges() uint64 {
+	if m != nil {
+		return m.MaxActiveGauges
+	}
+	return 0
+}
+
+// DynamicRewardWeights defines the mode to recompute the reward weights every
+// epoch from the locked base value of the reward pools. The target weight of
+// a denom is multiplier * locked base value, and the normalized weight of each
+// denom moves toward the target by at most max_change per epoch.
+type DynamicRewardWeights struct {
+	Enabled       bool                        `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+	EpochDuration time.Duration               `protobuf:"bytes,2,opt,name=epoch_duration,json=epochDuration,proto3,stdduration" json:"epoch_duration" yaml:"epoch_duration"`
+	MaxChange     cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_change,json=maxChange,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change" yaml:"max_change"`
+	// multipliers defines the multiplier of each denom; the denom without
+	// the multiplier uses one.
+	Multipliers []RewardWeight `protobuf:"bytes,4,rep,name=multipliers,proto3" json:"multipliers" yaml:"multipliers"`
+}
+
+func (m *DynamicRewardWeights) Reset()         { *m = DynamicRewardWeights{} }
+func (m *DynamicRewardWeights) String() string { return proto.CompactTextString(m) }
+func (*DynamicRewardWeights) ProtoMessage()    {}
+func (*DynamicRewardWeights) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c78b8933cf70cc23, []int{1}
+}
+func (m *DynamicRewardWeights) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicRewardWeights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicRewardWeights.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicRewardWeights) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicRewardWeights.Merge(m, src)
+}
+func (m *DynamicRewardWeights) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicRewardWeights) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicRewardWeights.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicRewardWeights proto.InternalMessageInfo
+
+func (m *DynamicRewardWeights) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *DynamicRewardWeights) GetEpochDuration() time.Duration {
+	if m != nil {
+		return m.EpochDuration
+	}
+	return 0
+}
+
+func (m *DynamicRewardWeights) GetMultipliers() []RewardWeight {
+	if m != nil {
+		return m.Multipliers
+	}
+	return nil
+}
+
 // RewardWeight represents reward allocation ratio between
 // pools.
 type RewardWeight struct {
@@ -91,7 +192,7 @@ func (m *RewardWeight) Reset()         { *m = RewardWeight{} }
 func (m *RewardWeight) String() string { return proto.CompactTextString(m) }
 func (*RewardWeight) ProtoMessage()    {}
 func (*RewardWeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c78b8933cf70cc23, []int{1}
+	return fileDescriptor_c78b8933cf70cc23, []int{2}
 }
 func (m *RewardWeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -137,7 +238,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c78b8933cf70cc23, []int{2}
+	return fileDescriptor_c78b8933cf70cc23, []int{3}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -190,7 +291,7 @@ func (m *DecPool) Reset()         { *m = DecPool{} }
 func (m *DecPool) String() string { return proto.CompactTextString(m) }
 func (*DecPool) ProtoMessage()    {}
 func (*DecPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c78b8933cf70cc23, []int{3}
+	return fileDescriptor_c78b8933cf70cc23, []int{4}
 }
 func (m *DecPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -249,13 +350,16 @@ func (m *DecPool) GetDecCoins() github_com_cosmos_cosmos_sdk_types.DecCoins {
 type ValidatorHistoricalRewards struct {
 	CumulativeRewardRatios DecPools `protobuf:"bytes,1,rep,name=cumulative_reward_ratios,json=cumulativeRewardRatios,proto3,castrepeated=DecPools" json:"cumulative_reward_ratios" yaml:"cumulative_reward_ratios"`
 	ReferenceCount         uint32   `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty" yaml:"reference_count"`
+	// time is the block time when the period ended; it is used to compute
+	// the realized reward rates between periods.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
 }
 
 func (m *ValidatorHistoricalRewards) Reset()         { *m = ValidatorHistoricalRewards{} }
 func (m *ValidatorHistoricalRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewards) ProtoMessage()    {}
 func (*ValidatorHistoricalRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c78b8933cf70cc23, []int{4}
+	return fileDescriptor_c78b8933cf70cc23, []int{5}
 }
 func (m *ValidatorHistoricalRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -298,6 +402,13 @@ func (m *ValidatorHistoricalRewards) GetReferenceCount() uint32 {
 	return 0
 }
 
+func (m *ValidatorHistoricalRewards) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 // ValidatorCurrentRewards represents current rewards and current
 // period for a validator kept as a running counter and incremented
 // each block as long as the validator's tokens remain constant.
@@ -310,7 +421,7 @@ func (m *ValidatorCurrentRewards) Reset()         { *m = ValidatorCurrentRewards
 func (m *ValidatorCurrentRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewards) ProtoMessage()    {}
 func (*ValidatorCurrentRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c78b8933cf70cc23, []int{5}
+	return fileDescriptor_c78b8933cf70cc23, []int{6}
 }
 func (m *ValidatorCurrentRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -363,7 +474,7 @@ func (m *ValidatorAccumulatedCommission) Reset()         { *m = ValidatorAccumul
 func (m *ValidatorAccumulatedCommission) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommission) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c78b8933cf70cc23, []int{6}
+	return fileDescriptor_c78b8933cf70cc23, []int{7}
 }
 func (m *ValidatorAccumulatedCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -409,7 +520,7 @@ func (m *ValidatorOutstandingRewards) Reset()         { *m = ValidatorOutstandin
 func (m *ValidatorOutstandingRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewards) ProtoMessage()    {}
 func (*ValidatorOutstandingRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c78b8933cf70cc23, []int{7}
+	return fileDescriptor_c78b8933cf70cc23, []int{8}
 }
 func (m *ValidatorOutstandingRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -458,7 +569,7 @@ func (m *ValidatorSlashEvent) Reset()         { *m = ValidatorSlashEvent{} }
 func (m *ValidatorSlashEvent) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvent) ProtoMessage()    {}
 func (*ValidatorSlashEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c78b8933cf70cc23, []int{8}
+	return fileDescriptor_c78b8933cf70cc23, []int{9}
 }
 func (m *ValidatorSlashEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -509,7 +620,7 @@ type ValidatorSlashEvents struct {
 func (m *ValidatorSlashEvents) Reset()      { *m = ValidatorSlashEvents{} }
 func (*ValidatorSlashEvents) ProtoMessage() {}
 func (*ValidatorSlashEvents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c78b8933cf70cc23, []int{9}
+	return fileDescriptor_c78b8933cf70cc23, []int{10}
 }
 func (m *ValidatorSlashEvents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,7 +672,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c78b8933cf70cc23, []int{10}
+	return fileDescriptor_c78b8933cf70cc23, []int{11}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -622,7 +733,7 @@ func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorRe
 func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*DelegationDelegatorReward) ProtoMessage()    {}
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c78b8933cf70cc23, []int{11}
+	return fileDescriptor_c78b8933cf70cc23, []int{12}
 }
 func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -651,8 +762,311 @@ func (m *DelegationDelegatorReward) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegationDelegatorReward proto.InternalMessageInfo
 
+// Gauge defines an external incentive which linearly releases the deposited
+// coins to the reward pool of a bond denom over the given number of epochs.
+type Gauge struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// denom is the bond denom of the reward pool to incentivize.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the total coins deposited to the gauge.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// distributed is the coins already released to the reward pool.
+	Distributed   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=distributed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed"`
+	StartTime     time.Time                                `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	NumEpochs     uint64                                   `protobuf:"varint,7,opt,name=num_epochs,json=numEpochs,proto3" json:"num_epochs,omitempty"`
+	EpochDuration time.Duration                            `protobuf:"bytes,8,opt,name=epoch_duration,json=epochDuration,proto3,stdduration" json:"epoch_duration"`
+}
+
+func (m *Gauge) Reset()         { *m = Gauge{} }
+func (m *Gauge) String() string { return proto.CompactTextString(m) }
+func (*Gauge) ProtoMessage()    {}
+func (*Gauge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c78b8933cf70cc23, []int{13}
+}
+func (m *Gauge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Gauge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Gauge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Gauge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Gauge.Merge(m, src)
+}
+func (m *Gauge) XXX_Size() int {
+	return m.Size()
+}
+func (m *Gauge) XXX_DiscardUnknown() {
+	xxx_messageInfo_Gauge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Gauge proto.InternalMessageInfo
+
+func (m *Gauge) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Gauge) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *Gauge) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Gauge) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Gauge) GetDistributed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Distributed
+	}
+	return nil
+}
+
+func (m *Gauge) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *Gauge) GetNumEpochs() uint64 {
+	if m != nil {
+		return m.NumEpochs
+	}
+	return 0
+}
+
+func (m *Gauge) GetEpochDuration() time.Duration {
+	if m != nil {
+		return m.EpochDuration
+	}
+	return 0
+}
+
+// PoolRewardRate represents the annual reward rate of a reward pool.
+type PoolRewardRate struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// reward_per_token is the annual rewards per staked token of the pool.
+	RewardPerToken github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=reward_per_token,json=rewardPerToken,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward_per_token" yaml:"reward_per_token"`
+	// apr is the annual rewards in the reward denom divided by the locked
+	// base value of a staked token.
+	Apr cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=apr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"apr" yaml:"apr"`
+}
+
+func (m *PoolRewardRate) Reset()         { *m = PoolRewardRate{} }
+func (m *PoolRewardRate) String() string { return proto.CompactTextString(m) }
+func (*PoolRewardRate) ProtoMessage()    {}
+func (*PoolRewardRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c78b8933cf70cc23, []int{14}
+}
+func (m *PoolRewardRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolRewardRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolRewardRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolRewardRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolRewardRate.Merge(m, src)
+}
+func (m *PoolRewardRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolRewardRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolRewardRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolRewardRate proto.InternalMessageInfo
+
+func (m *PoolRewardRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PoolRewardRate) GetRewardPerToken() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardPerToken
+	}
+	return nil
+}
+
+// ValidatorRewardRates represents the annual reward rates of the delegations
+// to a validator, after the commission.
+type ValidatorRewardRates struct {
+	ValidatorAddress string           `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	Pools            []PoolRewardRate `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools" yaml:"pools"`
+}
+
+func (m *ValidatorRewardRates) Reset()         { *m = ValidatorRewardRates{} }
+func (m *ValidatorRewardRates) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewardRates) ProtoMessage()    {}
+func (*ValidatorRewardRates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c78b8933cf70cc23, []int{15}
+}
+func (m *ValidatorRewardRates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewardRates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewardRates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRewardRates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewardRates.Merge(m, src)
+}
+func (m *ValidatorRewardRates) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewardRates) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewardRates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewardRates proto.InternalMessageInfo
+
+func (m *ValidatorRewardRates) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorRewardRates) GetPools() []PoolRewardRate {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+// PaymentStream defines a streaming payment from the community pool, which
+// linearly releases the total amount to the recipient from the start time
+// to the end time.
+type PaymentStream struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Denom     string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// total is the total amount to be paid by the stream.
+	Total cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=total,proto3,customtype=cosmossdk.io/math.Int" json:"total"`
+	// released is the amount already paid out from the community pool to the
+	// stream, which is claimable by the recipient.
+	Released cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=released,proto3,customtype=cosmossdk.io/math.Int" json:"released"`
+	// claimed is the amount already claimed by the recipient.
+	Claimed   cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=claimed,proto3,customtype=cosmossdk.io/math.Int" json:"claimed"`
+	StartTime time.Time             `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime   time.Time             `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *PaymentStream) Reset()         { *m = PaymentStream{} }
+func (m *PaymentStream) String() string { return proto.CompactTextString(m) }
+func (*PaymentStream) ProtoMessage()    {}
+func (*PaymentStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c78b8933cf70cc23, []int{16}
+}
+func (m *PaymentStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymentStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymentStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaymentStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentStream.Merge(m, src)
+}
+func (m *PaymentStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymentStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentStream proto.InternalMessageInfo
+
+func (m *PaymentStream) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PaymentStream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *PaymentStream) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PaymentStream) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *PaymentStream) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "initia.distribution.v1.Params")
+	proto.RegisterType((*DynamicRewardWeights)(nil), "initia.distribution.v1.DynamicRewardWeights")
 	proto.RegisterType((*RewardWeight)(nil), "initia.distribution.v1.RewardWeight")
 	proto.RegisterType((*Pool)(nil), "initia.distribution.v1.Pool")
 	proto.RegisterType((*DecPool)(nil), "initia.distribution.v1.DecPool")
@@ -664,6 +1078,10 @@ func init() {
 	proto.RegisterType((*ValidatorSlashEvents)(nil), "initia.distribution.v1.ValidatorSlashEvents")
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "initia.distribution.v1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "initia.distribution.v1.DelegationDelegatorReward")
+	proto.RegisterType((*Gauge)(nil), "initia.distribution.v1.Gauge")
+	proto.RegisterType((*PoolRewardRate)(nil), "initia.distribution.v1.PoolRewardRate")
+	proto.RegisterType((*ValidatorRewardRates)(nil), "initia.distribution.v1.ValidatorRewardRates")
+	proto.RegisterType((*PaymentStream)(nil), "initia.distribution.v1.PaymentStream")
 }
 
 func init() {
@@ -671,73 +1089,115 @@ func init() {
 }
 
 var fileDescriptor_c78b8933cf70cc23 = []byte{
-	// 1052 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x24, 0xa9, 0x9b, 0x4c, 0xf3, 0xab, 0x9b, 0x1f, 0x75, 0x93, 0xd4, 0x1b, 0x8d, 0xa0,
-	0x0a, 0x54, 0xd9, 0x55, 0x5a, 0x4e, 0xe1, 0x42, 0x9c, 0x04, 0x88, 0x54, 0x89, 0x68, 0xa9, 0x40,
-	0x42, 0x42, 0xcb, 0x78, 0x77, 0x62, 0x0f, 0xf1, 0xee, 0x84, 0x99, 0xb1, 0x93, 0x20, 0x4e, 0xc0,
-	0xa1, 0x02, 0x09, 0xf5, 0xd8, 0x1b, 0xe1, 0x04, 0xe2, 0xc4, 0x89, 0x0b, 0xff, 0x40, 0x4f, 0xa8,
-	0x47, 0xc4, 0x61, 0x41, 0xc9, 0x01, 0xc4, 0xd1, 0x7f, 0x01, 0xda, 0x99, 0xf1, 0xda, 0x6b, 0x39,
-	0xc2, 0xe1, 0xd0, 0x8b, 0xbd, 0xf3, 0xe6, 0xcd, 0xf7, 0xbe, 0xf7, 0xbd, 0xf7, 0x76, 0x16, 0xbe,
-	0x42, 0x63, 0x2a, 0x29, 0x76, 0x43, 0x2a, 0x24, 0xa7, 0xd5, 0xa6, 0xa4, 0x2c, 0x76, 0x5b, 0x1b,
-	0xb9, 0xb5, 0x73, 0xc4, 0x99, 0x64, 0xd6, 0xa2, 0x76, 0x75, 0x72, 0x5b, 0xad, 0x8d, 0xa5, 0x9b,
-	0x38, 0xa2, 0x31, 0x73, 0xd5, 0xaf, 0x76, 0x5d, 0x2a, 0x07, 0x4c, 0x44, 0x4c, 0xb8, 0x55, 0x2c,
-	0x88, 0xdb, 0xda, 0xa8, 0x12, 0x89, 0x37, 0xdc, 0x80, 0x51, 0x03, 0xb5, 0x34, 0x5f, 0x63, 0x35,
-	0xa6, 0x1e, 0xdd, 0xf4, 0x49, 0x5b, 0xd1, 0xaf, 0x23, 0xb0, 0xb8, 0x8f, 0x39, 0x8e, 0x84, 0xf5,
-	0x11, 0x9c, 0x0a, 0x58, 0x14, 0x35, 0x63, 0x2a, 0x4f, 0x7d, 0x89, 0x4f, 0x4a, 0x60, 0x15, 0xac,
-	0x4d, 0x54, 0x5e, 0x7f, 0x96, 0xd8, 0x85, 0xdf, 0x13, 0x7b, 0x59, 0xe3, 0x8b, 0xf0, 0xd0, 0xa1,
-	0xcc, 0x8d, 0xb0, 0xac, 0x3b, 0x0f, 0x49, 0x0d, 0x07, 0xa7, 0x3b, 0x24, 0x68, 0x27, 0xf6, 0xfc,
-	0x29, 0x8e, 0x1a, 0x9b, 0x28, 0x87, 0x80, 0xbc, 0xc9, 0x6c, 0xfd, 0x08, 0x9f, 0x58, 0x8f, 0xe0,
-	0xc2, 0x31, 0x95, 0xf5, 0x90, 0xe3, 0x63, 0x1f, 0x87, 0x21, 0xf7, 0x49, 0x8c, 0xab, 0x0d, 0x12,
-	0x96, 0x46, 0x56, 0xc1, 0xda, 0x78, 0x65, 0xb5, 0x9d, 0xd8, 0x2b, 0x1a, 0x66, 0xa0, 0x1b, 0xf2,
-	0xe6, 0x3a, 0xf6, 0xad, 0x30, 0xe4, 0xbb, 0xda, 0x6a, 0x7d, 0x0c, 0xa7, 0x39, 0x39, 0xc6, 0x3c,
-	0xf4, 0x8f, 0x09, 0xad, 0xd5, 0xa5, 0x28, 0x8d, 0xae, 0x8e, 0xae, 0xdd, 0xb8, 0xff, 0x92, 0x33,
-	0x58, 0x3c, 0xc7, 0x53, 0xde, 0xef, 0x2b, 0xe7, 0xca, 0x9d, 0x34, 0xbd, 0x76, 0x62, 0x2f, 0xe8,
-	0xc0, 0x79, 0x24, 0xe4, 0x4d, 0xf1, 0x1e, 0x67, 0xb1, 0xb9, 0xfa, 0xf4, 0xcc, 0x2e, 0xfc, 0x7d,
-	0x66, 0x83, 0xaf, 0xfe, 0xfa, 0xe9, 0xd5, 0xb9, 0x5c, 0x01, 0xb5, 0x8a, 0xe8, 0x4b, 0x00, 0x27,
-	0x7b, 0x03, 0x58, 0x77, 0xe1, 0xb5, 0x90, 0xc4, 0x2c, 0x32, 0x72, 0xce, 0xb6, 0x13, 0x7b, 0x52,
-	0xc7, 0x52, 0x66, 0xe4, 0xe9, 0x6d, 0xeb, 0x21, 0x2c, 0xea, 0xa8, 0x4a, 0x8d, 0x89, 0xca, 0x6b,
-	0xc3, 0xe9, 0x3e, 0x65, 0x04, 0x53, 0x47, 0x91, 0x67, 0x30, 0xd0, 0x77, 0x00, 0x8e, 0xed, 0x33,
-	0xd6, 0x18, 0x3a, 0xfc, 0x27, 0xf0, 0x5a, 0xda, 0x2c, 0xa2, 0x34, 0xa2, 0xc4, 0xbb, 0xed, 0xe8,
-	0xb0, 0x4e, 0xda, 0x4e, 0x8e, 0x69, 0x27, 0x67, 0x9b, 0xd1, 0xb8, 0xf2, 0x86, 0x51, 0x6c, 0xb2,
-	0x53, 0x71, 0x1a, 0x0b, 0xf4, 0xe3, 0x1f, 0xf6, 0x5a, 0x8d, 0xca, 0x7a, 0xb3, 0xea, 0x04, 0x2c,
-	0x72, 0x4d, 0x2f, 0xea, 0xbf, 0x75, 0x11, 0x1e, 0xba, 0xf2, 0xf4, 0x88, 0x08, 0x05, 0x20, 0x3c,
-	0x1d, 0x09, 0xfd, 0x0c, 0xe0, 0xf5, 0x1d, 0x12, 0x5c, 0x89, 0xe6, 0xe7, 0x00, 0x4e, 0x84, 0x24,
-	0xf0, 0x7b, 0xb9, 0xae, 0x0c, 0xe4, 0xba, 0x43, 0x02, 0x45, 0xf7, 0x2d, 0x43, 0x77, 0xb6, 0x03,
-	0x67, 0x0e, 0xa7, 0x94, 0xef, 0x0d, 0x41, 0xd9, 0xe0, 0x08, 0x6f, 0x3c, 0x34, 0x4f, 0xa8, 0x0d,
-	0xe0, 0xd2, 0x7b, 0xb8, 0x41, 0x43, 0x2c, 0x19, 0x7f, 0x9b, 0x0a, 0xc9, 0x38, 0x0d, 0x70, 0x43,
-	0x97, 0x5d, 0x58, 0xdf, 0x00, 0x58, 0x0a, 0x9a, 0x51, 0xb3, 0x81, 0x25, 0x6d, 0x11, 0xdf, 0xb4,
-	0x14, 0xc7, 0x92, 0x32, 0x51, 0x02, 0x8a, 0xb2, 0x7d, 0x59, 0x6f, 0x1a, 0x3d, 0x2a, 0x0f, 0x0c,
-	0x6b, 0xdb, 0x88, 0x7c, 0x09, 0x5c, 0x9a, 0xc4, 0xb8, 0x39, 0x23, 0xbc, 0xc5, 0xae, 0x9b, 0xe6,
-	0xe2, 0x29, 0x27, 0x6b, 0x1b, 0xce, 0x70, 0x72, 0x40, 0x38, 0x89, 0x03, 0xe2, 0x07, 0xac, 0x19,
-	0xeb, 0x1e, 0x9b, 0xaa, 0x2c, 0xb5, 0x13, 0x7b, 0xb1, 0xd3, 0xf8, 0x39, 0x07, 0xe4, 0x4d, 0x67,
-	0x96, 0x6d, 0x65, 0x78, 0x02, 0xe0, 0xad, 0x2c, 0xe9, 0xed, 0x26, 0xe7, 0x24, 0x96, 0x9d, 0x8c,
-	0x3f, 0x84, 0xd7, 0x35, 0xad, 0xa1, 0xf3, 0x43, 0x26, 0xbf, 0xe9, 0xde, 0xb1, 0xeb, 0x4b, 0xa7,
-	0x83, 0x69, 0x2d, 0xc2, 0xe2, 0x11, 0xe1, 0x94, 0xe9, 0x17, 0xc5, 0x98, 0x67, 0x56, 0xe8, 0x6b,
-	0x00, 0xcb, 0x19, 0xa5, 0xad, 0xc0, 0x64, 0x4f, 0xc2, 0x6d, 0x16, 0x45, 0x54, 0x08, 0xca, 0x62,
-	0x8b, 0xc2, 0x1b, 0x41, 0xb6, 0x1a, 0x9a, 0xdd, 0x5d, 0xc3, 0xce, 0xea, 0xbe, 0xd4, 0x0c, 0x42,
-	0x9e, 0x61, 0x2f, 0x36, 0xfa, 0x0c, 0x2e, 0x67, 0x64, 0xde, 0x69, 0x4a, 0x21, 0x71, 0x1c, 0xd2,
-	0xb8, 0xf6, 0x62, 0x34, 0x42, 0x17, 0x00, 0xce, 0x65, 0xe1, 0xdf, 0x6d, 0x60, 0x51, 0xdf, 0x6d,
-	0x91, 0x58, 0x5a, 0x6f, 0xc2, 0xd9, 0x56, 0xc7, 0xec, 0x1b, 0x15, 0xd3, 0x19, 0x1b, 0xab, 0x2c,
-	0xb7, 0x13, 0xfb, 0x96, 0x86, 0xee, 0xf7, 0x40, 0xde, 0x4c, 0x66, 0xda, 0x57, 0x16, 0xeb, 0x0b,
-	0x00, 0x27, 0x0e, 0x38, 0x0e, 0xa4, 0xd2, 0xf1, 0x7f, 0x0c, 0x5e, 0x76, 0xf8, 0xca, 0x83, 0xd7,
-	0x8d, 0x8b, 0xbe, 0x07, 0x70, 0x7e, 0x40, 0x96, 0xc2, 0x7a, 0x0c, 0xe0, 0x62, 0x37, 0x0b, 0x91,
-	0xee, 0xf8, 0x44, 0x6d, 0x19, 0xb5, 0xef, 0x5d, 0xa6, 0xf6, 0x00, 0xb8, 0xca, 0xcb, 0x86, 0xfa,
-	0x9d, 0x7e, 0x79, 0x7a, 0x81, 0x91, 0x37, 0xdf, 0x1a, 0x40, 0x65, 0x73, 0x2c, 0xbd, 0x23, 0xd0,
-	0xb7, 0x23, 0x70, 0x61, 0x87, 0x34, 0x48, 0x4d, 0x6d, 0x4b, 0xcc, 0x25, 0x8d, 0x6b, 0x7b, 0xf1,
-	0x01, 0x4b, 0xa7, 0xf1, 0x88, 0x93, 0x16, 0x65, 0x4d, 0x91, 0x2f, 0x48, 0xcf, 0x34, 0xf6, 0x39,
-	0x20, 0x6f, 0xba, 0x63, 0x31, 0xe5, 0xf8, 0x14, 0x16, 0x85, 0xc4, 0x87, 0x64, 0xb8, 0x52, 0xec,
-	0x98, 0x7c, 0xcc, 0x65, 0xa1, 0x4f, 0x5e, 0xb9, 0x0e, 0x26, 0xa2, 0xb5, 0x0b, 0x8b, 0x75, 0x7d,
-	0x53, 0x8d, 0x2a, 0xde, 0xeb, 0xff, 0x24, 0xf6, 0x4c, 0xc0, 0x49, 0xfa, 0xb2, 0x89, 0x7d, 0xbd,
-	0xd5, 0x4d, 0xa5, 0x6f, 0x03, 0x79, 0xe6, 0x30, 0xfa, 0x05, 0xc0, 0xdb, 0x46, 0x21, 0xca, 0xe2,
-	0x4c, 0x2b, 0x3d, 0x2f, 0xd6, 0x1e, 0xbc, 0xd9, 0x95, 0x3d, 0xfd, 0x0a, 0x20, 0x42, 0x98, 0xcb,
-	0x61, 0xa5, 0x9d, 0xd8, 0xa5, 0xfe, 0xca, 0x18, 0x17, 0xe4, 0x75, 0xdb, 0x7d, 0x4b, 0x9b, 0xac,
-	0x3d, 0x58, 0xd4, 0x53, 0x62, 0xb4, 0xfa, 0xcf, 0xc1, 0x9b, 0x4d, 0xe5, 0xca, 0x8d, 0x99, 0x01,
-	0xd8, 0x1c, 0x7f, 0x7c, 0x66, 0x17, 0x9e, 0x9e, 0xd9, 0xa0, 0xb2, 0xff, 0xc3, 0x79, 0x19, 0x3c,
-	0x3b, 0x2f, 0x83, 0xe7, 0xe7, 0x65, 0xf0, 0xe7, 0x79, 0x19, 0x3c, 0xb9, 0x28, 0x17, 0x9e, 0x5f,
-	0x94, 0x0b, 0xbf, 0x5d, 0x94, 0x0b, 0x1f, 0xdc, 0xef, 0xd1, 0x55, 0x07, 0x5b, 0x6f, 0xe0, 0xaa,
-	0x30, 0xcf, 0xee, 0x49, 0xfe, 0xdb, 0x4f, 0xe9, 0x5c, 0x2d, 0xaa, 0x2f, 0xb2, 0x07, 0xff, 0x06,
-	0x00, 0x00, 0xff, 0xff, 0xca, 0x58, 0x6f, 0xc5, 0x1f, 0x0a, 0x00, 0x00,
+	// 1715 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x41, 0x8c, 0x1b, 0x49,
+	0x15, 0x9d, 0xf6, 0x8c, 0x3d, 0x76, 0x4d, 0xc6, 0x99, 0x54, 0x9c, 0x89, 0xe3, 0x24, 0xee, 0x51,
+	0x09, 0xa2, 0x61, 0x77, 0xc7, 0x66, 0xb2, 0x80, 0x50, 0xb8, 0x10, 0xdb, 0xc9, 0x66, 0x50, 0xc4,
+	0x8e, 0x3a, 0x11, 0x2b, 0x21, 0xa1, 0xa6, 0xdc, 0x5d, 0x63, 0x17, 0x71, 0x77, 0x99, 0xaa, 0xb2,
+	0x33, 0x83, 0x38, 0x01, 0x87, 0x05, 0x24, 0xb4, 0xa7, 0x55, 0x24, 0x0e, 0x84, 0x13, 0x2b, 0x4e,
+	0x7b, 0x58, 0xb8, 0x70, 0x41, 0x9c, 0xf6, 0xb8, 0xda, 0x13, 0xe2, 0xe0, 0xa0, 0xcc, 0x61, 0x11,
+	0xdc, 0x7c, 0x47, 0x42, 0x5d, 0x55, 0xdd, 0x76, 0x7b, 0x3c, 0x1a, 0x4f, 0x80, 0xbd, 0x24, 0x5d,
+	0xf5, 0xff, 0x7f, 0xff, 0xd7, 0xaf, 0xff, 0xea, 0x7f, 0x0f, 0xf8, 0x12, 0x0d, 0xa9, 0xa4, 0xb8,
+	0xee, 0x53, 0x21, 0x39, 0x6d, 0x0f, 0x24, 0x65, 0x61, 0x7d, 0xb8, 0x9b, 0x5a, 0xd7, 0xfa, 0x9c,
+	0x49, 0x06, 0x37, 0xb5, 0x6a, 0x2d, 0x25, 0x1a, 0xee, 0x56, 0x2e, 0xe1, 0x80, 0x86, 0xac, 0xae,
+	0xfe, 0xd5, 0xaa, 0x95, 0xaa, 0xc7, 0x44, 0xc0, 0x44, 0xbd, 0x8d, 0x05, 0xa9, 0x0f, 0x77, 0xdb,
+	0x44, 0xe2, 0xdd, 0xba, 0xc7, 0xa8, 0x81, 0xaa, 0x5c, 0xd3, 0x72, 0x57, 0xad, 0xea, 0x7a, 0x61,
+	0x44, 0xa5, 0x0e, 0xeb, 0x30, 0xbd, 0x1f, 0x7d, 0xc5, 0x80, 0x1d, 0xc6, 0x3a, 0x3d, 0x52, 0x57,
+	0xab, 0xf6, 0xe0, 0xa0, 0xee, 0x0f, 0x38, 0x9e, 0xc4, 0x56, 0xb1, 0x67, 0xe5, 0x92, 0x06, 0x44,
+	0x48, 0x1c, 0xf4, 0xb5, 0x02, 0xfa, 0x4b, 0x16, 0xe4, 0xf6, 0x31, 0xc7, 0x81, 0x80, 0xdf, 0x07,
+	0xeb, 0x1e, 0x0b, 0x82, 0x41, 0x48, 0xe5, 0x91, 0x2b, 0xf1, 0x61, 0xd9, 0xda, 0xb2, 0xb6, 0x0b,
+	0x8d, 0x6f, 0x7c, 0x3c, 0xb2, 0x97, 0xfe, 0x36, 0xb2, 0xaf, 0xeb, 0x70, 0x84, 0xff, 0xa4, 0x46,
+	0x59, 0x3d, 0xc0, 0xb2, 0x5b, 0x7b, 0x48, 0x3a, 0xd8, 0x3b, 0x6a, 0x11, 0x6f, 0x3c, 0xb2, 0x4b,
+	0x47, 0x38, 0xe8, 0xdd, 0x41, 0x29, 0x04, 0xe4, 0x5c, 0x48, 0xd6, 0x8f, 0xf1, 0x21, 0x7c, 0x0c,
+	0xae, 0x3c, 0xa5, 0xb2, 0xeb, 0x73, 0xfc, 0xd4, 0xc5, 0xbe, 0xcf, 0x5d, 0x12, 0xe2, 0x76, 0x8f,
+	0xf8, 0xe5, 0xcc, 0x96, 0xb5, 0x9d, 0x6f, 0x6c, 0x8d, 0x47, 0xf6, 0x0d, 0x0d, 0x33, 0x57, 0x0d,
+	0x39, 0x97, 0xe3, 0xfd, 0xbb, 0xbe, 0xcf, 0xef, 0xe9, 0x5d, 0xf8, 0x03, 0x50, 0xe4, 0xe4, 0x29,
+	0xe6, 0xbe, 0xfb, 0x94, 0xd0, 0x4e, 0x57, 0x8a, 0xf2, 0xf2, 0xd6, 0xf2, 0xf6, 0xda, 0xed, 0x2f,
+	0xd4, 0xe6, 0x5f, 0x4c, 0xcd, 0x51, 0xda, 0xef, 0x28, 0xe5, 0xc6, 0xcd, 0xe8, 0x78, 0xe3, 0x91,
+	0x7d, 0x45, 0x3b, 0x4e, 0x23, 0x21, 0x67, 0x9d, 0x4f, 0x29, 0x0b, 0xf8, 0x73, 0x0b, 0x6c, 0xfa,
+	0x47, 0x21, 0x0e, 0xa8, 0xe7, 0xce, 0x38, 0x5d, 0xd9, 0xb2, 0xb6, 0xd7, 0x6e, 0xbf, 0x71, 0x9a,
+	0xd3, 0x96, 0xb6, 0x9a, 0xf6, 0x2d, 0x1a, 0x5f, 0x34, 0xce, 0x6f, 0x6a, 0xe7, 0xf3, 0x91, 0x91,
+	0x53, 0xf2, 0xe7, 0x18, 0xc3, 0x5f, 0x5b, 0x00, 0x76, 0xf0, 0xa0, 0x43, 0x5c, 0x8f, 0x13, 0x75,
+	0xe9, 0xee, 0x01, 0x21, 0xe5, 0xac, 0x3a, 0xfc, 0xb5, 0x9a, 0xa9, 0x9e, 0xa8, 0xd4, 0x6a, 0xa6,
+	0xd4, 0x6a, 0x4d, 0x46, 0xc3, 0x86, 0x63, 0x9c, 0x5e, 0xd3, 0x4e, 0x4f, 0x42, 0xa0, 0xdf, 0xbf,
+	0xb0, 0xb7, 0x3b, 0x54, 0x76, 0x07, 0xed, 0x9a, 0xc7, 0x02, 0x53, 0x87, 0xe6, 0xbf, 0x1d, 0xe1,
+	0x3f, 0xa9, 0xcb, 0xa3, 0x3e, 0x11, 0x0a, 0x4d, 0x7c, 0xf0, 0xd9, 0x87, 0xaf, 0x59, 0xce, 0x86,
+	0x42, 0x69, 0x1a, 0x90, 0xfb, 0x84, 0xc0, 0x07, 0xe0, 0x52, 0x80, 0x0f, 0x5d, 0xec, 0x49, 0x3a,
+	0x24, 0xae, 0x12, 0x8b, 0x72, 0x6e, 0xcb, 0xda, 0x5e, 0x69, 0xdc, 0x18, 0x8f, 0xec, 0xb2, 0x76,
+	0x7e, 0x42, 0x05, 0x39, 0x17, 0x03, 0x7c, 0x78, 0x57, 0x6d, 0xbd, 0xa5, 0x76, 0xee, 0x6c, 0x3d,
+	0x7b, 0x6e, 0x2f, 0xfd, 0xe3, 0xb9, 0x6d, 0xfd, 0xe2, 0xb3, 0x0f, 0x5f, 0xbb, 0x9c, 0x22, 0xa4,
+	0xae, 0x5c, 0xf4, 0xaf, 0x0c, 0x28, 0xcd, 0xcb, 0x2f, 0x7c, 0x03, 0xac, 0xc6, 0x25, 0x66, 0xa9,
+	0x12, 0x83, 0xe3, 0x91, 0x5d, 0xd4, 0xae, 0x93, 0xa2, 0x8a, 0x55, 0x60, 0x17, 0x14, 0x49, 0x9f,
+	0x79, 0x5d, 0x37, 0x26, 0x91, 0xaa, 0xcb, 0x28, 0x97, 0x9a, 0x45, 0xb5, 0x98, 0x45, 0xb5, 0x96,
+	0x51, 0x68, 0xdc, 0x4a, 0x57, 0x4f, 0xda, 0x1c, 0x3d, 0x7b, 0x61, 0x5b, 0x3a, 0x3f, 0xeb, 0x4a,
+	0x12, 0x9b, 0xc1, 0x77, 0x00, 0x88, 0x4e, 0xee, 0x75, 0x71, 0xd8, 0x21, 0xe5, 0x65, 0xc5, 0xb3,
+	0xaf, 0x2f, 0xc6, 0xb3, 0x4b, 0x93, 0xc4, 0x69, 0x73, 0xe4, 0x14, 0x02, 0x7c, 0xd8, 0x54, 0xdf,
+	0xb0, 0x0d, 0xd6, 0x82, 0x41, 0x4f, 0xd2, 0x7e, 0x8f, 0x12, 0x1e, 0xd5, 0xe4, 0xe2, 0x44, 0xa8,
+	0x98, 0xa3, 0x40, 0xe3, 0x60, 0x02, 0x83, 0x9c, 0x69, 0x50, 0xf4, 0x33, 0x0b, 0x5c, 0x98, 0xb6,
+	0x84, 0xb7, 0x40, 0xd6, 0x27, 0x21, 0x0b, 0xcc, 0x83, 0xb1, 0x31, 0x1e, 0xd9, 0x17, 0x4c, 0x41,
+	0x47, 0xdb, 0xc8, 0xd1, 0x62, 0xf8, 0x10, 0xe4, 0x74, 0x49, 0xab, 0xbc, 0x16, 0x1a, 0x5f, 0x59,
+	0xec, 0xc4, 0xeb, 0xe6, 0x49, 0x50, 0xa6, 0xc8, 0x31, 0x18, 0xe8, 0xb7, 0x16, 0x58, 0xd9, 0x67,
+	0xac, 0xb7, 0xb0, 0xfb, 0x1f, 0x82, 0x6c, 0xf4, 0xd4, 0x8a, 0x72, 0xe6, 0x2c, 0x86, 0x7c, 0xd3,
+	0xa4, 0xe2, 0x42, 0xfc, 0xa6, 0xd1, 0x50, 0x9c, 0x8b, 0x14, 0x8e, 0xf6, 0x84, 0xfe, 0x68, 0x81,
+	0xd5, 0x16, 0xf1, 0xce, 0x15, 0xe6, 0x4f, 0x2c, 0x50, 0xf0, 0x89, 0xe7, 0x4e, 0xc7, 0x7a, 0x63,
+	0x6e, 0xac, 0x2d, 0xe2, 0xa9, 0x70, 0xdf, 0x32, 0xe1, 0x6e, 0xc4, 0x70, 0x9e, 0x9b, 0x84, 0xfc,
+	0xfa, 0x02, 0x21, 0x1b, 0x1c, 0xe1, 0xe4, 0x7d, 0xf3, 0x85, 0xfe, 0x9c, 0x01, 0x95, 0xef, 0xe0,
+	0x1e, 0xf5, 0xb1, 0x64, 0xfc, 0x01, 0x15, 0x92, 0x71, 0xea, 0xe1, 0x9e, 0xbe, 0x76, 0x01, 0x7f,
+	0x65, 0x81, 0xb2, 0x37, 0x08, 0x06, 0x3d, 0xac, 0xa8, 0x6b, 0xde, 0x2b, 0x55, 0xdc, 0xa2, 0x6c,
+	0xa9, 0x90, 0xed, 0x53, 0x1f, 0x42, 0x9d, 0x8f, 0xc6, 0x9b, 0x26, 0x6a, 0xdb, 0x24, 0xf9, 0x14,
+	0xb8, 0xe8, 0x10, 0x79, 0x63, 0x23, 0x9c, 0xcd, 0x89, 0x9a, 0x8e, 0xc5, 0x51, 0x4a, 0xb0, 0x09,
+	0x2e, 0x72, 0x72, 0x40, 0x38, 0x09, 0x3d, 0xe2, 0x7a, 0x6c, 0x10, 0xea, 0x1a, 0x5b, 0x6f, 0x54,
+	0xc6, 0x23, 0x7b, 0x33, 0x7e, 0xda, 0x53, 0x0a, 0xc8, 0x29, 0x26, 0x3b, 0xcd, 0x68, 0x03, 0x3e,
+	0x04, 0x2b, 0x51, 0x7b, 0x54, 0x7c, 0x5c, 0xbb, 0x5d, 0x39, 0xc1, 0xfa, 0xc7, 0x71, 0xef, 0x6c,
+	0xdc, 0x30, 0xb1, 0xaf, 0x69, 0xe4, 0xc8, 0x0a, 0xbd, 0x97, 0x90, 0x7d, 0x45, 0xaf, 0x2d, 0x70,
+	0x35, 0x49, 0x61, 0x73, 0xc0, 0x39, 0x09, 0x65, 0x9c, 0xbf, 0xef, 0x81, 0x55, 0x7d, 0xc8, 0x85,
+	0xb3, 0x85, 0x8c, 0xc7, 0xe2, 0x74, 0x9b, 0x9a, 0x49, 0x4e, 0x8c, 0x09, 0x37, 0x41, 0xae, 0x4f,
+	0x38, 0x65, 0xba, 0xb1, 0xae, 0x38, 0x66, 0x85, 0x7e, 0x69, 0x81, 0x6a, 0x12, 0xd2, 0x5d, 0xcf,
+	0xe4, 0x92, 0xf8, 0x4d, 0x16, 0x04, 0x54, 0x88, 0xe8, 0x65, 0xa2, 0x60, 0xcd, 0x4b, 0x56, 0x0b,
+	0x47, 0x77, 0x2b, 0xfd, 0x76, 0x4c, 0x21, 0xa4, 0x23, 0x9c, 0xc6, 0x46, 0x3f, 0x06, 0xd7, 0x93,
+	0x60, 0xde, 0x1e, 0x48, 0x21, 0x71, 0xe8, 0xd3, 0xb0, 0xf3, 0xf9, 0xe4, 0x08, 0x1d, 0x5b, 0xe0,
+	0x72, 0xe2, 0xfe, 0x51, 0x0f, 0x8b, 0xee, 0xbd, 0x21, 0x09, 0x25, 0xbc, 0x0f, 0x36, 0x86, 0xf1,
+	0xb6, 0x6b, 0xb2, 0x68, 0xa9, 0xb6, 0x75, 0x7d, 0x3c, 0xb2, 0xaf, 0x6a, 0xe8, 0x59, 0x0d, 0xe4,
+	0x5c, 0x4c, 0xb6, 0xf6, 0xd5, 0x0e, 0xfc, 0xa9, 0x05, 0x0a, 0x07, 0x3c, 0xea, 0x6d, 0xec, 0xd5,
+	0x68, 0x9c, 0x18, 0x9f, 0x9b, 0xc6, 0x13, 0xbf, 0xe8, 0x77, 0x16, 0x28, 0xcd, 0x39, 0xa5, 0x80,
+	0xef, 0x5a, 0x60, 0x73, 0x72, 0x0a, 0x11, 0x49, 0x5c, 0xa2, 0x44, 0x26, 0xdb, 0xaf, 0x9f, 0x96,
+	0xed, 0x39, 0x70, 0xb3, 0x73, 0xcc, 0x7c, 0x60, 0xe4, 0x94, 0x86, 0x73, 0x42, 0xb9, 0xb3, 0x12,
+	0xf5, 0x77, 0xf4, 0x9b, 0x0c, 0xb8, 0xd2, 0x22, 0x3d, 0xd2, 0x51, 0x62, 0x89, 0xb9, 0xa4, 0x61,
+	0x67, 0x2f, 0x3c, 0x60, 0x11, 0xb7, 0xfb, 0x9c, 0x0c, 0x29, 0x1b, 0x88, 0xf4, 0x85, 0x4c, 0x71,
+	0x7b, 0x46, 0x01, 0x39, 0xc5, 0x78, 0xc7, 0x5c, 0xc7, 0x8f, 0x40, 0x4e, 0x48, 0xfc, 0x84, 0x2c,
+	0x76, 0x15, 0x2d, 0x73, 0x1e, 0xd3, 0x7a, 0xb4, 0xe5, 0xb9, 0xef, 0xc1, 0x78, 0x84, 0xf7, 0x40,
+	0xae, 0xab, 0xfb, 0xde, 0xb2, 0x8a, 0x7b, 0xe7, 0x9f, 0x23, 0xfb, 0x62, 0x32, 0x70, 0x69, 0xd1,
+	0xe4, 0x28, 0x33, 0x02, 0xe4, 0x18, 0x63, 0xf4, 0x27, 0x0b, 0x5c, 0x33, 0x19, 0xa2, 0x2c, 0x4c,
+	0x72, 0xa5, 0xf9, 0x02, 0xf7, 0xc0, 0xa5, 0x49, 0xda, 0xa3, 0xa9, 0x99, 0x08, 0x61, 0x5a, 0xcd,
+	0xd4, 0xbc, 0x75, 0x42, 0x05, 0x39, 0x93, 0x72, 0xbf, 0xab, 0xb7, 0xe0, 0x1e, 0xc8, 0x69, 0x96,
+	0x94, 0x33, 0x8b, 0x11, 0x6f, 0x23, 0x4a, 0x57, 0x8a, 0x66, 0x06, 0xe0, 0x4e, 0xfe, 0xdd, 0xe7,
+	0xf6, 0xd2, 0xb3, 0xe7, 0xb6, 0x85, 0xfe, 0xbd, 0x0c, 0xb2, 0x6a, 0xa0, 0x83, 0x45, 0x90, 0xa1,
+	0xe6, 0x0a, 0x9d, 0x0c, 0xf5, 0xe1, 0xd7, 0xa2, 0x7e, 0xd7, 0x67, 0x82, 0x4a, 0xc6, 0xcd, 0x64,
+	0x50, 0xfe, 0xf4, 0xa3, 0x9d, 0x92, 0xb9, 0x20, 0x13, 0xd5, 0x23, 0xc9, 0xa3, 0xc7, 0x61, 0xa2,
+	0x0a, 0x4b, 0x71, 0x43, 0x55, 0xf3, 0x53, 0xdc, 0x3e, 0xbb, 0x20, 0x87, 0x03, 0xd5, 0x00, 0x56,
+	0xce, 0x6a, 0xf3, 0x5f, 0x35, 0x61, 0x9f, 0x73, 0xd6, 0x35, 0xf8, 0x90, 0x83, 0xb5, 0x24, 0x21,
+	0xc4, 0x2f, 0x67, 0xff, 0x4f, 0xee, 0xa6, 0x9d, 0xc0, 0x07, 0x00, 0x88, 0x88, 0x1b, 0xae, 0x6a,
+	0x54, 0xb9, 0x33, 0x1b, 0xd5, 0x7a, 0xe4, 0x73, 0xd2, 0x99, 0x0a, 0xca, 0x38, 0x12, 0xc3, 0x9b,
+	0x00, 0x84, 0x83, 0xc0, 0x55, 0x73, 0xa9, 0x28, 0xaf, 0xaa, 0xdb, 0x28, 0x84, 0x83, 0xe0, 0x9e,
+	0xda, 0x80, 0x6f, 0x9f, 0x98, 0x85, 0xf3, 0x67, 0xcd, 0xc2, 0xca, 0xd7, 0x69, 0x23, 0x2f, 0x7a,
+	0x3f, 0x03, 0x8a, 0x51, 0x6d, 0x24, 0x6d, 0x9b, 0x2c, 0x3c, 0x11, 0xbd, 0x6f, 0x81, 0x0d, 0x33,
+	0x13, 0xf4, 0x09, 0x77, 0x25, 0x7b, 0x42, 0xc2, 0x85, 0x68, 0xfc, 0x6d, 0x43, 0xe3, 0xab, 0xa9,
+	0xdf, 0x76, 0x09, 0xc6, 0xb9, 0x09, 0x6d, 0x7e, 0x67, 0xee, 0x13, 0xfe, 0x38, 0xb2, 0x87, 0x4d,
+	0xb0, 0x8c, 0xfb, 0xdc, 0xcc, 0xef, 0xbb, 0x8b, 0x4d, 0xb3, 0x40, 0xc7, 0x82, 0xfb, 0x1c, 0x39,
+	0x91, 0x35, 0xfa, 0xc3, 0xf4, 0x13, 0x3d, 0xc9, 0x8e, 0xf8, 0x5f, 0x32, 0xda, 0x01, 0xd9, 0x7e,
+	0xc4, 0x4b, 0x93, 0xb5, 0x5b, 0xa7, 0x11, 0x3a, 0x7d, 0x41, 0x8d, 0x52, 0x7a, 0x0e, 0x56, 0x10,
+	0xc8, 0xd1, 0x50, 0xe8, 0xc5, 0x32, 0x58, 0xdf, 0xc7, 0x47, 0x01, 0x09, 0xe5, 0x23, 0xc9, 0x09,
+	0x0e, 0xe6, 0x11, 0x9b, 0x13, 0x8f, 0xf6, 0x29, 0x09, 0xe5, 0xd9, 0xc4, 0x4e, 0x54, 0x4f, 0x21,
+	0xf6, 0x7d, 0x90, 0x95, 0x4c, 0xe2, 0x9e, 0xfa, 0xa1, 0x5d, 0x68, 0x7c, 0xd9, 0xa4, 0xfb, 0xca,
+	0xc9, 0x74, 0xef, 0x85, 0xf2, 0xd3, 0x8f, 0x76, 0x80, 0x71, 0xb3, 0x17, 0x4a, 0x5d, 0x90, 0xda,
+	0x1c, 0x3e, 0x04, 0x79, 0x4e, 0x7a, 0x04, 0x0b, 0xc5, 0xd9, 0x57, 0x83, 0x4a, 0x10, 0xe0, 0xb7,
+	0xc0, 0xaa, 0xd7, 0xc3, 0x34, 0x20, 0x7e, 0x39, 0xf7, 0x8a, 0x60, 0x31, 0xc0, 0x0c, 0xb9, 0x57,
+	0xff, 0x0b, 0x72, 0xb7, 0x40, 0x9e, 0x84, 0xbe, 0xc6, 0xc9, 0x9f, 0x17, 0x67, 0x95, 0x84, 0x7e,
+	0x24, 0x6c, 0xec, 0x7f, 0xf0, 0xb2, 0x6a, 0x7d, 0xfc, 0xb2, 0x6a, 0x7d, 0xf2, 0xb2, 0x6a, 0xfd,
+	0xfd, 0x65, 0xd5, 0x7a, 0xef, 0xb8, 0xba, 0xf4, 0xc9, 0x71, 0x75, 0xe9, 0xaf, 0xc7, 0xd5, 0xa5,
+	0xef, 0xde, 0x9e, 0x62, 0x8e, 0x2e, 0xa7, 0x9d, 0x1e, 0x6e, 0x0b, 0xf3, 0x5d, 0x3f, 0x4c, 0xff,
+	0xe9, 0x4c, 0x31, 0xa9, 0x9d, 0x53, 0xde, 0xdf, 0xfc, 0xcf, 0x00, 0x54, 0x71, 0x0b, 0xdf, 0x5e,
+	0x13, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -773,16 +1233,30 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.DynamicRewardWeights.Equal(&that1.DynamicRewardWeights) {
+		return false
+	}
+	if len(this.GaugeCreationFee) != len(that1.GaugeCreationFee) {
+		return false
+	}
+	for i := range this.GaugeCreationFee {
+		if !this.GaugeCreationFee[i].Equal(&that1.GaugeCreationFee[i]) {
+			return false
+		}
+	}
+	if this.MaxActiveGauges != that1.MaxActiveGauges {
+		return false
+	}
 	return true
 }
-func (this *RewardWeight) Equal(that interface{}) bool {
+func (this *DynamicRewardWeights) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RewardWeight)
+	that1, ok := that.(*DynamicRewardWeights)
 	if !ok {
-		that2, ok := that.(RewardWeight)
+		that2, ok := that.(DynamicRewardWeights)
 		if ok {
 			that1 = &that2
 		} else {
@@ -794,15 +1268,53 @@ func (this *RewardWeight) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Denom != that1.Denom {
+	if this.Enabled != that1.Enabled {
 		return false
 	}
-	if !this.Weight.Equal(that1.Weight) {
+	if this.EpochDuration != that1.EpochDuration {
 		return false
 	}
-	return true
-}
-func (this *Pool) Equal(that interface{}) bool {
+	if !this.MaxChange.Equal(that1.MaxChange) {
+		return false
+	}
+	if len(this.Multipliers) != len(that1.Multipliers) {
+		return false
+	}
+	for i := range this.Multipliers {
+		if !this.Multipliers[i].Equal(&that1.Multipliers[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RewardWeight) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RewardWeight)
+	if !ok {
+		that2, ok := that.(RewardWeight)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
+func (this *Pool) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
@@ -896,6 +1408,9 @@ func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
 	if this.ReferenceCount != that1.ReferenceCount {
 		return false
 	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
 	return true
 }
 func (this *ValidatorCurrentRewards) Equal(that interface{}) bool {
@@ -1116,6 +1631,173 @@ func (this *DelegationDelegatorReward) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Gauge) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Gauge)
+	if !ok {
+		that2, ok := that.(Gauge)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Depositor != that1.Depositor {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if len(this.Distributed) != len(that1.Distributed) {
+		return false
+	}
+	for i := range this.Distributed {
+		if !this.Distributed[i].Equal(&that1.Distributed[i]) {
+			return false
+		}
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
+	}
+	if this.NumEpochs != that1.NumEpochs {
+		return false
+	}
+	if this.EpochDuration != that1.EpochDuration {
+		return false
+	}
+	return true
+}
+func (this *PoolRewardRate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PoolRewardRate)
+	if !ok {
+		that2, ok := that.(PoolRewardRate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if len(this.RewardPerToken) != len(that1.RewardPerToken) {
+		return false
+	}
+	for i := range this.RewardPerToken {
+		if !this.RewardPerToken[i].Equal(&that1.RewardPerToken[i]) {
+			return false
+		}
+	}
+	if !this.Apr.Equal(that1.Apr) {
+		return false
+	}
+	return true
+}
+func (this *ValidatorRewardRates) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorRewardRates)
+	if !ok {
+		that2, ok := that.(ValidatorRewardRates)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if len(this.Pools) != len(that1.Pools) {
+		return false
+	}
+	for i := range this.Pools {
+		if !this.Pools[i].Equal(&that1.Pools[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PaymentStream) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PaymentStream)
+	if !ok {
+		that2, ok := that.(PaymentStream)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Total.Equal(that1.Total) {
+		return false
+	}
+	if !this.Released.Equal(that1.Released) {
+		return false
+	}
+	if !this.Claimed.Equal(that1.Claimed) {
+		return false
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
+	}
+	if !this.EndTime.Equal(that1.EndTime) {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1136,6 +1818,35 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxActiveGauges != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.MaxActiveGauges))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.GaugeCreationFee) > 0 {
+		for iNdEx := len(m.GaugeCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GaugeCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.DynamicRewardWeights.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.RewardWeights) > 0 {
 		for iNdEx := len(m.RewardWeights) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1173,6 +1884,71 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DynamicRewardWeights) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicRewardWeights) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicRewardWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Multipliers) > 0 {
+		for iNdEx := len(m.Multipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Multipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.MaxChange.Size()
+		i -= size
+		if _, err := m.MaxChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.EpochDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EpochDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintDistribution(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RewardWeight) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1321,6 +2097,14 @@ func (m *ValidatorHistoricalRewards) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintDistribution(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
 	if m.ReferenceCount != 0 {
 		i = encodeVarintDistribution(dAtA, i, uint64(m.ReferenceCount))
 		i--
@@ -1629,19 +2413,296 @@ func (m *DelegationDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDistribution(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Gauge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
+
+func (m *Gauge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.EpochDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EpochDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintDistribution(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x42
+	if m.NumEpochs != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.NumEpochs))
+		i--
+		dAtA[i] = 0x38
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintDistribution(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Distributed) > 0 {
+		for iNdEx := len(m.Distributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolRewardRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolRewardRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolRewardRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Apr.Size()
+		i -= size
+		if _, err := m.Apr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RewardPerToken) > 0 {
+		for iNdEx := len(m.RewardPerToken) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPerToken[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRewardRates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewardRates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewardRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PaymentStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymentStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintDistribution(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x42
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintDistribution(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Released.Size()
+		i -= size
+		if _, err := m.Released.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDistribution(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1657,6 +2718,39 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovDistribution(uint64(l))
 		}
 	}
+	l = m.DynamicRewardWeights.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if len(m.GaugeCreationFee) > 0 {
+		for _, e := range m.GaugeCreationFee {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.MaxActiveGauges != 0 {
+		n += 1 + sovDistribution(uint64(m.MaxActiveGauges))
+	}
+	return n
+}
+
+func (m *DynamicRewardWeights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EpochDuration)
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.MaxChange.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if len(m.Multipliers) > 0 {
+		for _, e := range m.Multipliers {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1728,6 +2822,8 @@ func (m *ValidatorHistoricalRewards) Size() (n int) {
 	if m.ReferenceCount != 0 {
 		n += 1 + sovDistribution(uint64(m.ReferenceCount))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovDistribution(uint64(l))
 	return n
 }
 
@@ -1849,16 +2945,1275 @@ func (m *DelegationDelegatorReward) Size() (n int) {
 			n += 1 + l + sovDistribution(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *Gauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDistribution(uint64(m.Id))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.Distributed) > 0 {
+		for _, e := range m.Distributed {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.NumEpochs != 0 {
+		n += 1 + sovDistribution(uint64(m.NumEpochs))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EpochDuration)
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *PoolRewardRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.RewardPerToken) > 0 {
+		for _, e := range m.RewardPerToken {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	l = m.Apr.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *ValidatorRewardRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PaymentStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDistribution(uint64(m.Id))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.Released.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovDistribution(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func sovDistribution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDistribution(x uint64) (n int) {
+	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddrEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithdrawAddrEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardWeights = append(m.RewardWeights, RewardWeight{})
+			if err := m.RewardWeights[len(m.RewardWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicRewardWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DynamicRewardWeights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GaugeCreationFee = append(m.GaugeCreationFee, types.Coin{})
+			if err := m.GaugeCreationFee[len(m.GaugeCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveGauges", wireType)
+			}
+			m.MaxActiveGauges = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActiveGauges |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicRewardWeights) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicRewardWeights: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicRewardWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.EpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Multipliers = append(m.Multipliers, RewardWeight{})
+			if err := m.Multipliers[len(m.Multipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecCoins = append(m.DecCoins, types.DecCoin{})
+			if err := m.DecCoins[len(m.DecCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorHistoricalRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorHistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatios", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CumulativeRewardRatios = append(m.CumulativeRewardRatios, DecPool{})
+			if err := m.CumulativeRewardRatios[len(m.CumulativeRewardRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
+			}
+			m.ReferenceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferenceCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorCurrentRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorCurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, DecPool{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorAccumulatedCommission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorAccumulatedCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commissions = append(m.Commissions, DecPool{})
+			if err := m.Commissions[len(m.Commissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorOutstandingRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorOutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, DecPool{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovDistribution(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDistribution(x uint64) (n int) {
-	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1881,17 +4236,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorSlashEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorSlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTax", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPeriod", wireType)
 			}
-			var stringLen uint64
+			m.ValidatorPeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -1901,31 +4256,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ValidatorPeriod |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CommunityTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddrEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fractions", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -1935,15 +4275,79 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.WithdrawAddrEnabled = bool(v != 0)
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fractions = append(m.Fractions, types.DecCoin{})
+			if err := m.Fractions[len(m.Fractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSlashEvents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSlashEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeights", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1970,8 +4374,8 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardWeights = append(m.RewardWeights, RewardWeight{})
-			if err := m.RewardWeights[len(m.RewardWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorSlashEvents = append(m.ValidatorSlashEvents, ValidatorSlashEvent{})
+			if err := m.ValidatorSlashEvents[len(m.ValidatorSlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1996,7 +4400,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardWeight) Unmarshal(dAtA []byte) error {
+func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2019,17 +4423,17 @@ func (m *RewardWeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardWeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorStartingInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorStartingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPeriod", wireType)
 			}
-			var stringLen uint64
+			m.PreviousPeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2039,29 +4443,16 @@ func (m *RewardWeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PreviousPeriod |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2071,26 +4462,45 @@ func (m *RewardWeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Stakes = append(m.Stakes, types.DecCoin{})
+			if err := m.Stakes[len(m.Stakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2112,7 +4522,7 @@ func (m *RewardWeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2135,15 +4545,15 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationDelegatorReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2171,11 +4581,11 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2202,8 +4612,8 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reward = append(m.Reward, DecPool{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2228,7 +4638,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecPool) Unmarshal(dAtA []byte) error {
+func (m *Gauge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2247,17 +4657,68 @@ func (m *DecPool) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DecPool: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecPool: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
@@ -2289,9 +4750,9 @@ func (m *DecPool) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2318,64 +4779,14 @@ func (m *DecPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DecCoins = append(m.DecCoins, types.DecCoin{})
-			if err := m.DecCoins[len(m.DecCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDistribution
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorHistoricalRewards: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorHistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatios", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2402,16 +4813,16 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CumulativeRewardRatios = append(m.CumulativeRewardRatios, DecPool{})
-			if err := m.CumulativeRewardRatios[len(m.CumulativeRewardRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Distributed = append(m.Distributed, types.Coin{})
+			if err := m.Distributed[len(m.Distributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			m.ReferenceCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2421,64 +4832,47 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReferenceCount |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDistribution
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochs", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.NumEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorCurrentRewards: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorCurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2505,30 +4899,10 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, DecPool{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.EpochDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
-			}
-			m.Period = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDistribution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Period |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2550,7 +4924,7 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
+func (m *PoolRewardRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2573,17 +4947,17 @@ func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorAccumulatedCommission: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolRewardRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorAccumulatedCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolRewardRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2593,81 +4967,63 @@ func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commissions = append(m.Commissions, DecPool{})
-			if err := m.Commissions[len(m.Commissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerToken", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDistribution
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDistribution
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RewardPerToken = append(m.RewardPerToken, types.DecCoin{})
+			if err := m.RewardPerToken[len(m.RewardPerToken)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorOutstandingRewards: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorOutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2677,23 +5033,23 @@ func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, DecPool{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Apr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2718,7 +5074,7 @@ func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
+func (m *ValidatorRewardRates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2741,17 +5097,17 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSlashEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorRewardRates: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorRewardRates: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPeriod", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.ValidatorPeriod = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2761,14 +5117,27 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorPeriod |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fractions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2795,8 +5164,8 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fractions = append(m.Fractions, types.DecCoin{})
-			if err := m.Fractions[len(m.Fractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pools = append(m.Pools, PoolRewardRate{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2821,7 +5190,7 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
+func (m *PaymentStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2844,17 +5213,36 @@ func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSlashEvents: wiretype end group for non-group")
+			return fmt.Errorf("proto: PaymentStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSlashEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PaymentStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2864,81 +5252,61 @@ func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSlashEvents = append(m.ValidatorSlashEvents, ValidatorSlashEvent{})
-			if err := m.ValidatorSlashEvents[len(m.ValidatorSlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDistribution
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDistribution
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorStartingInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorStartingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPeriod", wireType)
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			m.PreviousPeriod = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2948,16 +5316,31 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PreviousPeriod |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stakes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2967,31 +5350,31 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stakes = append(m.Stakes, types.DecCoin{})
-			if err := m.Stakes[len(m.Stakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Released.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3001,66 +5384,31 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDistribution
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationDelegatorReward: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3070,27 +5418,28 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3117,8 +5466,7 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reward = append(m.Reward, DecPool{})
-			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
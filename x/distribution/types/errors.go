@@ -0,0 +1,17 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// x/distribution module sentinel errors; the codes start from 100 to avoid
+// the conflicts with the errors of the cosmos-sdk distribution module.
+var (
+	ErrInvalidGauge          = errorsmod.Register(distrtypes.ModuleName, 100, "invalid gauge")
+	ErrGaugeNotFound         = errorsmod.Register(distrtypes.ModuleName, 101, "gauge not found")
+	ErrInvalidPaymentStream  = errorsmod.Register(distrtypes.ModuleName, 102, "invalid payment stream")
+	ErrPaymentStreamNotFound = errorsmod.Register(distrtypes.ModuleName, 103, "payment stream not found")
+	ErrTooManyGauges         = errorsmod.Register(distrtypes.ModuleName, 104, "too many active gauges")
+)
@@ -1,6 +1,27 @@
 package types
 
 const (
+	EventTypeCreateGauge  = "create_gauge"
+	EventTypeGaugeRelease = "gauge_release"
+	EventTypeGaugeFinish  = "gauge_finish"
+
+	EventTypeUpdateRewardWeight = "update_reward_weight"
+
+	EventTypeCreatePaymentStream  = "create_payment_stream"
+	EventTypePaymentStreamRelease = "payment_stream_release"
+	EventTypeClaimPaymentStream   = "claim_payment_stream"
+	EventTypeCancelPaymentStream  = "cancel_payment_stream"
+
 	AttributeKeyAmountPerPool = "amount_per_pool"
 	AttributeKeyPool          = "pool"
+	AttributeKeyGaugeId       = "gauge_id"
+	AttributeKeyDepositor     = "depositor"
+	AttributeKeyStartTime     = "start_time"
+	AttributeKeyEndTime       = "end_time"
+	AttributeKeyDenom         = "denom"
+	AttributeKeyOldWeight     = "old_weight"
+	AttributeKeyNewWeight     = "new_weight"
+	AttributeKeyTargetWeight  = "target_weight"
+	AttributeKeyStreamId      = "stream_id"
+	AttributeKeyRecipient     = "recipient"
 )
@@ -4,9 +4,11 @@ import (
 	context "context"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
+	rewardtypes "github.com/initia-labs/initia/x/reward/types"
 )
 
 // StakingKeeper expected staking keeper (noalias)
@@ -14,6 +16,9 @@ type StakingKeeper interface {
 	// iterate through validators by operator address, execute func for each validator
 	IterateValidators(ctx context.Context, cb func(validator stakingtypes.ValidatorI) (stop bool, err error)) error
 
+	// iterate through the bonded validators by power, execute func for each validator
+	IterateBondedValidatorsByPower(ctx context.Context, cb func(validator stakingtypes.ValidatorI) (stop bool, err error)) error
+
 	Validator(ctx context.Context, address sdk.ValAddress) (stakingtypes.ValidatorI, error)
 	ValidatorByConsAddr(ctx context.Context, addr sdk.ConsAddress) (stakingtypes.ValidatorI, error)
 
@@ -25,10 +30,20 @@ type StakingKeeper interface {
 		fn func(delegation stakingtypes.DelegationI) (stop bool, err error)) error
 
 	GetAllSDKDelegations(ctx context.Context) ([]stakingtypes.Delegation, error)
+	GetAllDelegatorDelegations(ctx context.Context, delegator sdk.AccAddress) ([]stakingtypes.Delegation, error)
+
+	// GetVotingPowerWeights returns the locked base value per share of each bond denom.
+	GetVotingPowerWeights(ctx context.Context) (sdk.DecCoins, error)
 
 	ValidatorAddressCodec() address.Codec
 }
 
+// RewardKeeper expected reward keeper (noalias)
+type RewardKeeper interface {
+	GetParams(ctx context.Context) (rewardtypes.Params, error)
+	GetAnnualProvisions(ctx context.Context) (math.LegacyDec, error)
+}
+
 // DexKeeper expected dex keeper
 type DexKeeper interface {
 	SwapToBase(ctx context.Context, addr sdk.AccAddress, quoteCoin sdk.Coin) error
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGauge creates a new Gauge instance
+func NewGauge(
+	id uint64, depositor, denom string, amount sdk.Coins,
+	startTime time.Time, numEpochs uint64, epochDuration time.Duration,
+) Gauge {
+	return Gauge{
+		Id:            id,
+		Depositor:     depositor,
+		Denom:         denom,
+		Amount:        amount,
+		Distributed:   sdk.NewCoins(),
+		StartTime:     startTime,
+		NumEpochs:     numEpochs,
+		EpochDuration: epochDuration,
+	}
+}
+
+// EndTime returns the time when the gauge releases all the coins.
+func (g Gauge) EndTime() time.Time {
+	return g.StartTime.Add(g.totalDuration())
+}
+
+// Remaining returns the coins not yet released.
+func (g Gauge) Remaining() sdk.Coins {
+	return g.Amount.Sub(g.Distributed...)
+}
+
+// IsFinished returns true if the gauge released all the coins.
+func (g Gauge) IsFinished() bool {
+	return g.Remaining().IsZero()
+}
+
+// ReleasableAt returns the coins to be released at the given time,
+// which are linearly increasing from the start time to the end time.
+func (g Gauge) ReleasableAt(blockTime time.Time) sdk.Coins {
+	if blockTime.Before(g.StartTime) {
+		return sdk.NewCoins()
+	}
+
+	total := g.totalDuration()
+	elapsed := blockTime.Sub(g.StartTime)
+	if elapsed >= total {
+		return g.Remaining()
+	}
+
+	released := sdk.NewCoins()
+	for _, coin := range g.Amount {
+		amount := coin.Amount.Mul(math.NewInt(int64(elapsed))).Quo(math.NewInt(int64(total)))
+		released = released.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+
+	releasable, _ := released.SafeSub(g.Distributed...)
+	return releasable
+}
+
+func (g Gauge) totalDuration() time.Duration {
+	return g.EpochDuration * time.Duration(g.NumEpochs)
+}
+
+// Validate performs basic validation of the gauge.
+func (g Gauge) Validate() error {
+	if err := sdk.ValidateDenom(g.Denom); err != nil {
+		return err
+	}
+
+	if !g.Amount.IsValid() || g.Amount.IsZero() {
+		return fmt.Errorf("invalid gauge amount: %s", g.Amount)
+	}
+
+	if !g.Distributed.IsValid() || !g.Amount.IsAllGTE(g.Distributed) {
+		return fmt.Errorf("invalid gauge distributed amount: %s", g.Distributed)
+	}
+
+	return ValidateGaugeSchedule(g.NumEpochs, g.EpochDuration)
+}
+
+// ValidateGaugeSchedule validates the release schedule of a gauge.
+func ValidateGaugeSchedule(numEpochs uint64, epochDuration time.Duration) error {
+	if numEpochs == 0 {
+		return fmt.Errorf("number of epochs must be positive")
+	}
+
+	if epochDuration <= 0 {
+		return fmt.Errorf("epoch duration must be positive: %s", epochDuration)
+	}
+
+	// the total duration must fit in time.Duration
+	if numEpochs > uint64(time.Duration(1<<63-1)/epochDuration) {
+		return fmt.Errorf("gauge duration is too long: %d epochs of %s", numEpochs, epochDuration)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/distribution/types"
+)
+
+func TestGauge_ReleasableAt(t *testing.T) {
+	startTime := time.Now().UTC()
+	gauge := types.NewGauge(1, "", "foo", sdk.NewCoins(sdk.NewInt64Coin("bar", 1_000), sdk.NewInt64Coin("baz", 3)), startTime, 4, time.Hour)
+	require.NoError(t, gauge.Validate())
+	require.Equal(t, startTime.Add(4*time.Hour), gauge.EndTime())
+
+	require.True(t, gauge.ReleasableAt(startTime.Add(-time.Second)).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("bar", 250)), gauge.ReleasableAt(startTime.Add(time.Hour)))
+
+	gauge.Distributed = sdk.NewCoins(sdk.NewInt64Coin("bar", 250))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("bar", 250), sdk.NewInt64Coin("baz", 1)), gauge.ReleasableAt(startTime.Add(2*time.Hour)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("bar", 750), sdk.NewInt64Coin("baz", 3)), gauge.ReleasableAt(startTime.Add(5*time.Hour)))
+
+	gauge.Distributed = gauge.Amount
+	require.True(t, gauge.IsFinished())
+}
+
+func TestValidateGaugeSchedule(t *testing.T) {
+	require.NoError(t, types.ValidateGaugeSchedule(10, time.Hour))
+	require.Error(t, types.ValidateGaugeSchedule(0, time.Hour))
+	require.Error(t, types.ValidateGaugeSchedule(10, 0))
+	require.Error(t, types.ValidateGaugeSchedule(1<<40, time.Hour))
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
@@ -10,6 +12,7 @@ func NewGenesisState(
 	params Params, fp distrtypes.FeePool, dwis []distrtypes.DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	gauges []Gauge, nextGaugeId uint64, streams []PaymentStream, nextStreamId uint64,
 ) *GenesisState {
 
 	return &GenesisState{
@@ -23,6 +26,10 @@ func NewGenesisState(
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		Gauges:                          gauges,
+		NextGaugeId:                     nextGaugeId,
+		PaymentStreams:                  streams,
+		NextPaymentStreamId:             nextStreamId,
 	}
 }
 
@@ -39,6 +46,11 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		Gauges:                          []Gauge{},
+		NextGaugeId:                     1,
+		PaymentStreams:                  []PaymentStream{},
+		NextPaymentStreamId:             1,
+		ComputedRewardWeights:           []RewardWeight{},
 	}
 }
 
@@ -47,5 +59,40 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+
+	// the gauge ids start from 1
+	if gs.NextGaugeId == 0 {
+		return fmt.Errorf("next gauge id cannot be zero")
+	}
+
+	for _, gauge := range gs.Gauges {
+		if gauge.Id >= gs.NextGaugeId {
+			return fmt.Errorf("gauge id %d must be less than the next gauge id %d", gauge.Id, gs.NextGaugeId)
+		}
+
+		if err := gauge.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// the payment stream ids start from 1
+	if gs.NextPaymentStreamId == 0 {
+		return fmt.Errorf("next payment stream id cannot be zero")
+	}
+
+	for _, stream := range gs.PaymentStreams {
+		if stream.Id >= gs.NextPaymentStreamId {
+			return fmt.Errorf("payment stream id %d must be less than the next payment stream id %d", stream.Id, gs.NextPaymentStreamId)
+		}
+
+		if err := stream.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := validateRewardWeights(gs.ComputedRewardWeights); err != nil {
+		return err
+	}
+
 	return gs.FeePool.ValidateGenesis()
 }
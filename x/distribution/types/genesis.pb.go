@@ -8,15 +8,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -302,6 +306,20 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos" yaml:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events" yaml:"validator_slash_events"`
+	// gauges defines the incentive gauges at genesis.
+	Gauges []Gauge `protobuf:"bytes,11,rep,name=gauges,proto3" json:"gauges" yaml:"gauges"`
+	// next_gauge_id defines the id of the next incentive gauge.
+	NextGaugeId uint64 `protobuf:"varint,12,opt,name=next_gauge_id,json=nextGaugeId,proto3" json:"next_gauge_id,omitempty" yaml:"next_gauge_id"`
+	// reward_weights_update_time defines the last time the reward weights were
+	// recomputed in the dynamic reward weights mode.
+	RewardWeightsUpdateTime *time.Time `protobuf:"bytes,13,opt,name=reward_weights_update_time,json=rewardWeightsUpdateTime,proto3,stdtime" json:"reward_weights_update_time,omitempty" yaml:"reward_weights_update_time"`
+	// payment_streams defines the community pool payment streams at genesis.
+	PaymentStreams []PaymentStream `protobuf:"bytes,14,rep,name=payment_streams,json=paymentStreams,proto3" json:"payment_streams" yaml:"payment_streams"`
+	// next_payment_stream_id defines the id of the next payment stream.
+	NextPaymentStreamId uint64 `protobuf:"varint,15,opt,name=next_payment_stream_id,json=nextPaymentStreamId,proto3" json:"next_payment_stream_id,omitempty" yaml:"next_payment_stream_id"`
+	// computed_reward_weights defines the reward weights computed in the dynamic
+	// reward weights mode.
+	ComputedRewardWeights []RewardWeight `protobuf:"bytes,16,rep,name=computed_reward_weights,json=computedRewardWeights,proto3" json:"computed_reward_weights" yaml:"computed_reward_weights"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -352,69 +370,84 @@ func init() {
 }
 
 var fileDescriptor_feeaf8e1b2cb541b = []byte{
-	// 981 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xbd, 0x8f, 0x1b, 0x45,
-	0x1c, 0xf5, 0xfa, 0xc2, 0xdd, 0x65, 0xee, 0x02, 0xc7, 0xe6, 0xe2, 0x38, 0xe6, 0x62, 0x3b, 0xc3,
-	0x21, 0x8c, 0xa2, 0xd8, 0x9c, 0x23, 0x10, 0x44, 0x34, 0xd9, 0x84, 0x8f, 0x14, 0x88, 0xd3, 0x9c,
-	0x04, 0x88, 0xc6, 0x1a, 0xef, 0xce, 0xd9, 0x23, 0xad, 0x77, 0x56, 0x3b, 0xe3, 0x3d, 0xd2, 0x51,
-	0x52, 0x21, 0x1a, 0xa0, 0xa0, 0x49, 0x07, 0xa2, 0xa1, 0xe7, 0x2f, 0x48, 0x99, 0x0e, 0xaa, 0x03,
-	0xdd, 0x51, 0xd0, 0x12, 0x89, 0x8a, 0x06, 0x79, 0x66, 0xf6, 0xcb, 0xbb, 0x7b, 0x96, 0x23, 0xae,
-	0x5b, 0x8f, 0x7f, 0xf3, 0x7e, 0xef, 0xbd, 0xfd, 0x7d, 0x2c, 0xd8, 0xa5, 0x1e, 0x15, 0x14, 0xf7,
-	0x1c, 0xca, 0x45, 0x40, 0x87, 0x53, 0x41, 0x99, 0xd7, 0x0b, 0xf7, 0x7a, 0x23, 0xe2, 0x11, 0x4e,
-	0x79, 0xd7, 0x0f, 0x98, 0x60, 0x66, 0x4d, 0x45, 0x75, 0xd3, 0x51, 0xdd, 0x70, 0xaf, 0xd1, 0xb5,
-	0x19, 0x9f, 0x30, 0x3e, 0x7f, 0x7b, 0x48, 0x04, 0xde, 0xcb, 0x1c, 0x2a, 0x9c, 0xc6, 0x6b, 0x67,
-	0xc5, 0x67, 0x52, 0x36, 0xb6, 0x47, 0x6c, 0xc4, 0xe4, 0x63, 0x6f, 0xf6, 0x14, 0x01, 0x94, 0xd0,
-	0xcd, 0xe7, 0x82, 0xff, 0x1a, 0xe0, 0xc6, 0xc7, 0xd8, 0xa5, 0x0e, 0x16, 0x2c, 0xf8, 0x68, 0x2a,
-	0xb8, 0xc0, 0x9e, 0x43, 0xbd, 0x11, 0x22, 0x47, 0x38, 0x70, 0x38, 0x22, 0x36, 0x0b, 0x1c, 0xf3,
-	0x01, 0x78, 0x31, 0x8c, 0x82, 0x06, 0xd8, 0x71, 0x02, 0xc2, 0x79, 0xdd, 0x68, 0x1b, 0x9d, 0x8b,
-	0xd6, 0xce, 0xd3, 0xe3, 0x56, 0xfd, 0x21, 0x9e, 0xb8, 0x77, 0x60, 0x2e, 0x04, 0xa2, 0xad, 0xf8,
-	0xec, 0xae, 0x3a, 0x32, 0xbf, 0x30, 0xc0, 0x65, 0x96, 0xe4, 0x19, 0x04, 0x2a, 0x51, 0xbd, 0xda,
-	0x5e, 0xe9, 0x6c, 0xf4, 0x5b, 0xdd, 0x62, 0x0f, 0xbb, 0xf7, 0x89, 0xbd, 0xcf, 0x98, 0x6b, 0x75,
-	0x1f, 0x1f, 0xb7, 0x2a, 0x4f, 0x8f, 0x5b, 0x0d, 0x95, 0xb2, 0x00, 0x09, 0xfe, 0xf4, 0x7b, 0x6b,
-	0x5d, 0x87, 0x73, 0x64, 0xb2, 0x9c, 0xa6, 0x3b, 0xeb, 0x5f, 0x3e, 0x6a, 0x55, 0xfe, 0x7a, 0xd4,
-	0xaa, 0xc0, 0xbf, 0x0d, 0xb0, 0x1b, 0xab, 0xbf, 0x6b, 0xdb, 0xd3, 0xc9, 0xd4, 0xc5, 0x82, 0x38,
-	0xf7, 0xd8, 0x64, 0x42, 0x39, 0xa7, 0xcc, 0xfb, 0xff, 0x0d, 0x10, 0x60, 0x03, 0x27, 0x99, 0xea,
-	0xd5, 0xb6, 0xd1, 0xd9, 0xe8, 0xbf, 0x59, 0xa6, 0xfb, 0x6c, 0x76, 0x56, 0x43, 0xdb, 0x61, 0x2a,
-	0x02, 0x29, 0x60, 0x88, 0xd2, 0x69, 0x52, 0x9a, 0xff, 0x31, 0x40, 0x3b, 0x46, 0xfd, 0x80, 0x72,
-	0xc1, 0x02, 0x6a, 0x63, 0xf7, 0xdc, 0x5e, 0x78, 0x0d, 0xac, 0xfa, 0x24, 0xa0, 0x4c, 0x49, 0xbd,
-	0x80, 0xf4, 0x2f, 0xd3, 0x01, 0x6b, 0xd1, 0xbb, 0x5f, 0x91, 0x1e, 0xf4, 0x17, 0x7a, 0x90, 0x63,
-	0x6b, 0xd5, 0xb4, 0xfe, 0xe7, 0x15, 0xa1, 0xa8, 0x04, 0x50, 0x04, 0x9d, 0xd2, 0xfd, 0xab, 0x01,
-	0xae, 0xc7, 0x48, 0xf7, 0xa6, 0x41, 0x40, 0x3c, 0x71, 0x6e, 0xa2, 0x71, 0x22, 0x4e, 0xbd, 0xe0,
-	0xde, 0x42, 0x71, 0x59, 0x4a, 0xcb, 0x28, 0xfb, 0xb9, 0x0a, 0x5e, 0xba, 0x4f, 0x5c, 0x32, 0x9a,
-	0xc1, 0x1c, 0x08, 0x1c, 0x08, 0xea, 0x8d, 0x1e, 0x78, 0x87, 0x2c, 0xd1, 0xe5, 0x44, 0x7f, 0x97,
-	0xeb, 0xca, 0x85, 0x40, 0xb4, 0x15, 0x9f, 0x45, 0xba, 0x0a, 0x2d, 0xaa, 0x3e, 0x93, 0x45, 0x3e,
-	0xb8, 0xc4, 0x35, 0xd7, 0x01, 0xf5, 0x0e, 0x99, 0xae, 0x82, 0x5b, 0xe5, 0x13, 0xa0, 0x40, 0xa1,
-	0xb5, 0xa3, 0x6d, 0xda, 0x56, 0x99, 0x33, 0x88, 0x10, 0x6d, 0xf2, 0x54, 0x6c, 0xca, 0xb1, 0xef,
-	0xaa, 0xe0, 0x5a, 0x6c, 0xfc, 0x81, 0x8b, 0xf9, 0xf8, 0xdd, 0x50, 0x7a, 0x7f, 0x0e, 0xc5, 0x3f,
-	0x26, 0x74, 0x34, 0x16, 0x51, 0xf1, 0xab, 0x5f, 0xa9, 0xa6, 0x58, 0xc9, 0x34, 0xc5, 0x14, 0x5c,
-	0x49, 0x70, 0xf9, 0x8c, 0xd8, 0x80, 0xcc, 0x98, 0xd5, 0x2f, 0x48, 0x73, 0x6e, 0x2e, 0xac, 0xa2,
-	0x44, 0x8c, 0xb5, 0xad, 0xad, 0xd9, 0x54, 0x7c, 0x25, 0x0e, 0x44, 0x97, 0xc3, 0x7c, 0x68, 0xca,
-	0x99, 0x3f, 0x01, 0xd8, 0x7c, 0x5f, 0xad, 0x98, 0x03, 0x81, 0x05, 0x31, 0x3f, 0x04, 0xab, 0x3e,
-	0x0e, 0xf0, 0x44, 0x39, 0xb0, 0xd1, 0x6f, 0x96, 0x51, 0xd8, 0x97, 0x51, 0xd6, 0x15, 0x9d, 0xf5,
-	0x92, 0xca, 0xaa, 0xee, 0x42, 0xa4, 0x41, 0xcc, 0x4f, 0xc1, 0xfa, 0x21, 0x21, 0x03, 0x9f, 0x31,
-	0x57, 0x77, 0xc6, 0xae, 0x5e, 0x8f, 0xf3, 0x80, 0x72, 0xdd, 0x75, 0xdf, 0x23, 0x44, 0xce, 0xfd,
-	0xab, 0x1a, 0xf6, 0x05, 0x05, 0x1b, 0x61, 0x40, 0xb4, 0x76, 0xa8, 0x22, 0xcc, 0x6f, 0x0c, 0x50,
-	0x4f, 0x6a, 0xf8, 0x88, 0x8a, 0xb1, 0x13, 0xe0, 0x23, 0x59, 0x08, 0xb3, 0x09, 0xb3, 0x22, 0x27,
-	0xcc, 0x59, 0xa9, 0xe2, 0x02, 0xfb, 0x44, 0xdf, 0x95, 0x05, 0xf6, 0xaa, 0x4e, 0xdc, 0x9a, 0xef,
-	0x92, 0x6c, 0x06, 0x88, 0x6a, 0x4e, 0xd1, 0x7d, 0xd9, 0x32, 0x7e, 0x40, 0x42, 0xca, 0xa6, 0x7c,
-	0xe0, 0x07, 0xcc, 0x67, 0x9c, 0x04, 0xf2, 0x75, 0x66, 0xaa, 0x29, 0x17, 0x02, 0xd1, 0x56, 0x74,
-	0xb6, 0xaf, 0x8f, 0xcc, 0xaf, 0x4a, 0x76, 0xe7, 0x73, 0x52, 0xdd, 0xdb, 0x0b, 0x8b, 0xa3, 0x6c,
-	0xbf, 0x5b, 0x70, 0xf1, 0x56, 0x2d, 0xda, 0xa4, 0xe6, 0x2f, 0x06, 0xb8, 0x91, 0xea, 0x83, 0x64,
-	0xdf, 0x0c, 0xec, 0x78, 0x47, 0xf1, 0xfa, 0xaa, 0xa4, 0xf7, 0xce, 0xb3, 0xad, 0x38, 0xcd, 0xf0,
-	0x75, 0xcd, 0xb0, 0x93, 0x6b, 0xbe, 0xe2, 0xa4, 0x10, 0xb5, 0xc2, 0x33, 0x71, 0xb9, 0xf9, 0x83,
-	0x01, 0x76, 0x12, 0x9c, 0x71, 0xbc, 0x5b, 0x62, 0x5b, 0xd7, 0x24, 0xef, 0xb7, 0x96, 0x5f, 0x4b,
-	0x9a, 0xf3, 0x4d, 0xcd, 0xf9, 0xe5, 0x79, 0xce, 0xf9, 0x5c, 0x10, 0x35, 0xc2, 0x52, 0x38, 0xf3,
-	0x7b, 0x03, 0x5c, 0x4b, 0x6e, 0xdb, 0x6a, 0x51, 0xc4, 0x34, 0xd7, 0x25, 0xcd, 0x37, 0x96, 0x5c,
-	0x30, 0x9a, 0x63, 0x47, 0x73, 0x6c, 0xcf, 0x73, 0x9c, 0xcb, 0x02, 0xd1, 0xd5, 0xb0, 0x18, 0xc8,
-	0xfc, 0x36, 0xd3, 0x78, 0x99, 0x09, 0xcc, 0xeb, 0x17, 0x25, 0xb9, 0xdb, 0x4b, 0x0d, 0x75, 0x4d,
-	0xad, 0xb4, 0xf3, 0xb2, 0x29, 0xd2, 0x9d, 0x97, 0x46, 0xe1, 0xb3, 0x76, 0xa9, 0x15, 0x4e, 0x53,
-	0x5e, 0x07, 0x92, 0xd6, 0xde, 0x12, 0xe3, 0x54, 0x93, 0x7a, 0x45, 0x93, 0xba, 0x3e, 0xef, 0x57,
-	0x1a, 0x1e, 0xa2, 0xed, 0x82, 0x29, 0x9b, 0x5a, 0xd9, 0xd6, 0xfe, 0x8f, 0x27, 0x4d, 0xe3, 0xf1,
-	0x49, 0xd3, 0x78, 0x72, 0xd2, 0x34, 0xfe, 0x38, 0x69, 0x1a, 0x5f, 0x9f, 0x36, 0x2b, 0x4f, 0x4e,
-	0x9b, 0x95, 0xdf, 0x4e, 0x9b, 0x95, 0xcf, 0xfa, 0x23, 0x2a, 0xc6, 0xd3, 0x61, 0xd7, 0x66, 0x93,
-	0x9e, 0x62, 0x78, 0xcb, 0xc5, 0x43, 0xae, 0x9f, 0x7b, 0x9f, 0x67, 0x3f, 0xec, 0xc5, 0x43, 0x9f,
-	0xf0, 0xe1, 0xaa, 0xfc, 0x9e, 0xbf, 0xfd, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb9, 0xdc, 0xf6,
-	0x85, 0xab, 0x0c, 0x00, 0x00,
+	// 1222 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6c, 0xdc, 0xc4,
+	0x17, 0x5e, 0x27, 0xfd, 0xa5, 0xe9, 0x6c, 0xfe, 0xfd, 0x9c, 0x64, 0xe3, 0x2e, 0xc9, 0x3a, 0x19,
+	0x52, 0x48, 0x55, 0xd5, 0x4b, 0x52, 0x81, 0xa0, 0xea, 0xa5, 0x6e, 0xa1, 0x44, 0x02, 0x11, 0x4d,
+	0xa0, 0x45, 0x5c, 0x2c, 0xef, 0x7a, 0xe2, 0xb5, 0xb4, 0xeb, 0xb1, 0x3c, 0xe3, 0x4d, 0x73, 0x43,
+	0x88, 0x03, 0x27, 0xd4, 0x0b, 0x70, 0xe0, 0xd2, 0x1b, 0x88, 0x0b, 0x77, 0xce, 0x1c, 0x7a, 0xec,
+	0x0d, 0x4e, 0x29, 0x4a, 0x2e, 0x5c, 0x89, 0xc4, 0x89, 0x0b, 0xf2, 0xcc, 0x78, 0x6d, 0xef, 0xda,
+	0x89, 0x52, 0x91, 0xdb, 0x7a, 0xe6, 0xcd, 0xf7, 0xbe, 0xef, 0xf3, 0x9b, 0xf7, 0xd6, 0x60, 0xdd,
+	0xf3, 0x3d, 0xe6, 0xd9, 0x4d, 0xc7, 0xa3, 0x2c, 0xf4, 0x5a, 0x11, 0xf3, 0x88, 0xdf, 0xec, 0x6f,
+	0x36, 0x5d, 0xec, 0x63, 0xea, 0x51, 0x23, 0x08, 0x09, 0x23, 0x6a, 0x4d, 0x44, 0x19, 0xd9, 0x28,
+	0xa3, 0xbf, 0x59, 0x37, 0xda, 0x84, 0xf6, 0x08, 0x1d, 0x3e, 0xdd, 0xc2, 0xcc, 0xde, 0xcc, 0x2d,
+	0x0a, 0x9c, 0xfa, 0xf5, 0xd3, 0xe2, 0x73, 0x29, 0xeb, 0x0b, 0x2e, 0x71, 0x09, 0xff, 0xd9, 0x8c,
+	0x7f, 0xc9, 0x55, 0xdd, 0x25, 0xc4, 0xed, 0xe2, 0x26, 0x7f, 0x6a, 0x45, 0x7b, 0x4d, 0xe6, 0xf5,
+	0x30, 0x65, 0x76, 0x2f, 0x48, 0x32, 0x94, 0xe8, 0x19, 0x25, 0x03, 0xff, 0x51, 0xc0, 0xda, 0x43,
+	0xbb, 0xeb, 0x39, 0x36, 0x23, 0xe1, 0x47, 0x11, 0xa3, 0xcc, 0xf6, 0x1d, 0xcf, 0x77, 0x11, 0xde,
+	0xb7, 0x43, 0x87, 0x22, 0xdc, 0x26, 0xa1, 0xa3, 0x6e, 0x83, 0xff, 0xf7, 0x93, 0x20, 0xcb, 0x76,
+	0x9c, 0x10, 0x53, 0xaa, 0x29, 0xab, 0xca, 0xc6, 0x15, 0x73, 0xf9, 0xe4, 0x50, 0xd7, 0x0e, 0xec,
+	0x5e, 0xf7, 0x36, 0x1c, 0x09, 0x81, 0x68, 0x6e, 0xb0, 0x76, 0x57, 0x2c, 0xa9, 0x9f, 0x2b, 0x60,
+	0x9e, 0xa4, 0x79, 0xac, 0x50, 0x24, 0xd2, 0xc6, 0x56, 0xc7, 0x37, 0xaa, 0x5b, 0xba, 0x51, 0x6c,
+	0xb2, 0x71, 0x1f, 0xb7, 0x77, 0x08, 0xe9, 0x9a, 0xc6, 0xb3, 0x43, 0xbd, 0x72, 0x72, 0xa8, 0xd7,
+	0x45, 0xca, 0x02, 0x24, 0xf8, 0xd3, 0x0b, 0x7d, 0x52, 0x86, 0x53, 0xa4, 0x92, 0x11, 0x4d, 0xb7,
+	0x27, 0xbf, 0x7a, 0xaa, 0x57, 0xfe, 0x7c, 0xaa, 0x57, 0xe0, 0x5f, 0x0a, 0x58, 0x1f, 0xa8, 0xbf,
+	0xdb, 0x6e, 0x47, 0xbd, 0xa8, 0x6b, 0x33, 0xec, 0xdc, 0x23, 0xbd, 0x9e, 0x47, 0xa9, 0x47, 0xfc,
+	0xff, 0xde, 0x00, 0x06, 0xaa, 0x76, 0x9a, 0x49, 0x1b, 0x5b, 0x55, 0x36, 0xaa, 0x5b, 0x6f, 0x95,
+	0xe9, 0x3e, 0x9d, 0x9d, 0x59, 0x97, 0x76, 0xa8, 0x82, 0x40, 0x06, 0x18, 0xa2, 0x6c, 0x9a, 0x8c,
+	0xe6, 0xbf, 0x15, 0xb0, 0x3a, 0x40, 0x7d, 0xdf, 0xa3, 0x8c, 0x84, 0x5e, 0xdb, 0xee, 0x5e, 0xd8,
+	0x0b, 0xaf, 0x81, 0x89, 0x00, 0x87, 0x1e, 0x11, 0x52, 0x2f, 0x21, 0xf9, 0xa4, 0x3a, 0xe0, 0x72,
+	0xf2, 0xee, 0xc7, 0xb9, 0x07, 0x5b, 0x67, 0x7a, 0x30, 0xc2, 0xd6, 0xac, 0x49, 0xfd, 0x33, 0x82,
+	0x50, 0x52, 0x02, 0x28, 0x81, 0xce, 0xe8, 0xfe, 0x4d, 0x01, 0x2b, 0x03, 0xa4, 0x7b, 0x51, 0x18,
+	0x62, 0x9f, 0x5d, 0x98, 0x68, 0x3b, 0x15, 0x27, 0x5e, 0x70, 0xf3, 0x4c, 0x71, 0x79, 0x4a, 0xe7,
+	0x51, 0xf6, 0xf3, 0x18, 0x78, 0xe5, 0x3e, 0xee, 0x62, 0x37, 0x86, 0xd9, 0x65, 0x76, 0xc8, 0x3c,
+	0xdf, 0xdd, 0xf6, 0xf7, 0x48, 0xaa, 0xcb, 0x49, 0xb6, 0xcb, 0x75, 0x8d, 0x84, 0x40, 0x34, 0x37,
+	0x58, 0x4b, 0x74, 0x15, 0x5a, 0x34, 0xf6, 0x52, 0x16, 0x05, 0x60, 0x9a, 0x4a, 0xae, 0x96, 0xe7,
+	0xef, 0x11, 0x59, 0x05, 0x37, 0xcb, 0x3b, 0x40, 0x81, 0x42, 0x73, 0x59, 0xda, 0xb4, 0x20, 0x32,
+	0xe7, 0x10, 0x21, 0x9a, 0xa2, 0x99, 0xd8, 0x8c, 0x63, 0xdf, 0x8d, 0x81, 0xab, 0x03, 0xe3, 0x77,
+	0xbb, 0x36, 0xed, 0xbc, 0xdb, 0xe7, 0xde, 0x5f, 0x40, 0xf1, 0x77, 0xb0, 0xe7, 0x76, 0x58, 0x52,
+	0xfc, 0xe2, 0x29, 0x73, 0x29, 0xc6, 0x73, 0x97, 0x22, 0x02, 0x8b, 0x29, 0x2e, 0x8d, 0x89, 0x59,
+	0x38, 0x66, 0xa6, 0x5d, 0xe2, 0xe6, 0xdc, 0x38, 0xb3, 0x8a, 0x52, 0x31, 0xe6, 0x82, 0xb4, 0x66,
+	0x4a, 0xf0, 0xe5, 0x38, 0x10, 0xcd, 0xf7, 0x47, 0x43, 0x33, 0xce, 0xfc, 0x3a, 0x0b, 0xa6, 0x1e,
+	0x88, 0x19, 0xb4, 0xcb, 0x6c, 0x86, 0xd5, 0x0f, 0xc1, 0x44, 0x60, 0x87, 0x76, 0x4f, 0x38, 0x50,
+	0xdd, 0x6a, 0x94, 0x51, 0xd8, 0xe1, 0x51, 0xe6, 0xa2, 0xcc, 0x3a, 0x2d, 0xb2, 0x8a, 0xb3, 0x10,
+	0x49, 0x10, 0xf5, 0x53, 0x30, 0xb9, 0x87, 0xb1, 0x15, 0x10, 0xd2, 0x95, 0x37, 0x63, 0x5d, 0xce,
+	0xcf, 0x61, 0x40, 0x3e, 0x0f, 0x8d, 0xf7, 0x30, 0xe6, 0x7d, 0x7f, 0x49, 0xc2, 0xce, 0x0a, 0xd8,
+	0x04, 0x03, 0xa2, 0xcb, 0x7b, 0x22, 0x42, 0xfd, 0x46, 0x01, 0x5a, 0x5a, 0xc3, 0xfb, 0x1e, 0xeb,
+	0x38, 0xa1, 0xbd, 0xcf, 0x0b, 0x21, 0xee, 0x30, 0xe3, 0xbc, 0xc3, 0x9c, 0x96, 0x6a, 0x50, 0x60,
+	0x8f, 0xe4, 0x59, 0x5e, 0x60, 0xaf, 0xcb, 0xc4, 0xfa, 0xf0, 0x2d, 0xc9, 0x67, 0x80, 0xa8, 0xe6,
+	0x14, 0x9d, 0xe7, 0x57, 0x26, 0x08, 0x71, 0xdf, 0x23, 0x11, 0xb5, 0x82, 0x90, 0x04, 0x84, 0xe2,
+	0x50, 0xbb, 0x34, 0x5c, 0x4d, 0x23, 0x21, 0x10, 0xcd, 0x25, 0x6b, 0x3b, 0x72, 0x49, 0xfd, 0xba,
+	0x64, 0x76, 0xfe, 0x8f, 0xab, 0x7b, 0xe7, 0xcc, 0xe2, 0x28, 0x9b, 0xef, 0x26, 0x3c, 0x7b, 0xaa,
+	0x16, 0x4d, 0x52, 0xf5, 0x17, 0x05, 0xac, 0x65, 0xee, 0x41, 0x3a, 0x6f, 0xac, 0xf6, 0x60, 0x46,
+	0x51, 0x6d, 0x82, 0xd3, 0xbb, 0xf3, 0x72, 0x23, 0x4e, 0x32, 0x7c, 0x43, 0x32, 0xdc, 0x18, 0xb9,
+	0x7c, 0xc5, 0x49, 0x21, 0xd2, 0xfb, 0xa7, 0xe2, 0x52, 0xf5, 0x07, 0x05, 0x2c, 0xa7, 0x38, 0x9d,
+	0xc1, 0x6c, 0x19, 0xd8, 0x7a, 0x99, 0xf3, 0x7e, 0xfb, 0xfc, 0x63, 0x49, 0x72, 0xbe, 0x21, 0x39,
+	0xbf, 0x3a, 0xcc, 0x79, 0x34, 0x17, 0x44, 0xf5, 0x7e, 0x29, 0x9c, 0xfa, 0xbd, 0x02, 0xae, 0xa6,
+	0xa7, 0xdb, 0x62, 0x50, 0x0c, 0x68, 0x4e, 0x72, 0x9a, 0x6f, 0x9e, 0x73, 0xc0, 0x48, 0x8e, 0x1b,
+	0x92, 0xe3, 0xea, 0x30, 0xc7, 0xa1, 0x2c, 0x10, 0x2d, 0xf5, 0x8b, 0x81, 0xd4, 0x6f, 0x73, 0x17,
+	0x2f, 0xd7, 0x81, 0xa9, 0x76, 0x85, 0x93, 0xbb, 0x75, 0xae, 0xa6, 0x2e, 0xa9, 0x95, 0xde, 0xbc,
+	0x7c, 0x8a, 0xec, 0xcd, 0xcb, 0xa2, 0xd0, 0xf8, 0xba, 0xd4, 0x0a, 0xbb, 0x29, 0xd5, 0x00, 0xa7,
+	0xb5, 0x79, 0x8e, 0x76, 0x2a, 0x49, 0x5d, 0x93, 0xa4, 0x56, 0x86, 0xfd, 0xca, 0xc2, 0x43, 0xb4,
+	0x50, 0xd0, 0x65, 0xa9, 0xfa, 0x01, 0x98, 0x70, 0xed, 0xc8, 0xc5, 0x54, 0xab, 0xf2, 0xfc, 0x2b,
+	0x65, 0xf9, 0x1f, 0xc4, 0x51, 0xc3, 0xad, 0x54, 0x1c, 0x85, 0x48, 0x62, 0xa8, 0x77, 0xc0, 0xb4,
+	0x8f, 0x1f, 0x33, 0x8b, 0x3f, 0x5a, 0x9e, 0xa3, 0x4d, 0xc5, 0xa3, 0xc4, 0xd4, 0xd2, 0x69, 0x98,
+	0xdb, 0x86, 0xa8, 0x1a, 0x3f, 0x73, 0xe8, 0x6d, 0x47, 0xfd, 0x42, 0x01, 0x75, 0xf1, 0x6e, 0xad,
+	0x7d, 0x3e, 0x93, 0xa8, 0x15, 0x05, 0x8e, 0xcd, 0xb0, 0x15, 0x7f, 0x4d, 0x68, 0xd3, 0xbc, 0x37,
+	0xd7, 0x0d, 0xf1, 0xa9, 0x61, 0x24, 0x9f, 0x1a, 0xc6, 0xc7, 0xc9, 0xa7, 0x86, 0x79, 0xfd, 0xe4,
+	0x50, 0x5f, 0xcb, 0xfe, 0x39, 0x29, 0xc2, 0x81, 0x4f, 0x5e, 0xe8, 0x0a, 0x5a, 0x12, 0x01, 0x8f,
+	0xc4, 0xfe, 0x27, 0x7c, 0x3b, 0x06, 0x52, 0x7d, 0x30, 0x1b, 0xd8, 0x07, 0xbd, 0xb8, 0xce, 0x28,
+	0x0b, 0x71, 0x3c, 0x65, 0x66, 0xb8, 0x33, 0xd7, 0xca, 0xa7, 0x0c, 0x0f, 0xdf, 0xe5, 0xd1, 0x66,
+	0x43, 0x3a, 0x54, 0x4b, 0x86, 0x4d, 0x0e, 0x0b, 0xa2, 0x99, 0x20, 0x1b, 0x4e, 0xd5, 0x87, 0xa0,
+	0xc6, 0x3d, 0xc9, 0x07, 0xc6, 0xde, 0xcd, 0x72, 0xef, 0xd6, 0xd2, 0x37, 0x5b, 0x1c, 0x07, 0xd1,
+	0x7c, 0xbc, 0x91, 0x63, 0xb1, 0xed, 0xa8, 0x5f, 0x2a, 0x60, 0xa9, 0x4d, 0x7a, 0x41, 0x14, 0x77,
+	0xa1, 0xbc, 0x1b, 0xda, 0x1c, 0x17, 0xb4, 0x5e, 0x26, 0x08, 0x65, 0xac, 0x31, 0x5f, 0x93, 0x7a,
+	0x1a, 0x82, 0x43, 0x09, 0x24, 0x44, 0x8b, 0xc9, 0x4e, 0xf6, 0x74, 0xe6, 0x2f, 0xa1, 0xb9, 0xf3,
+	0xe3, 0x51, 0x43, 0x79, 0x76, 0xd4, 0x50, 0x9e, 0x1f, 0x35, 0x94, 0x3f, 0x8e, 0x1a, 0xca, 0x93,
+	0xe3, 0x46, 0xe5, 0xf9, 0x71, 0xa3, 0xf2, 0xfb, 0x71, 0xa3, 0xf2, 0xd9, 0x96, 0xeb, 0xb1, 0x4e,
+	0xd4, 0x32, 0xda, 0xa4, 0xd7, 0x14, 0xb4, 0x6e, 0x76, 0xed, 0x16, 0x95, 0xbf, 0x9b, 0x8f, 0xf3,
+	0x1f, 0x8e, 0xec, 0x20, 0xc0, 0xb4, 0x35, 0xc1, 0x4b, 0xe0, 0xd6, 0xbf, 0x03, 0x00, 0x6a, 0xdb,
+	0x18, 0x1e, 0x2c, 0x0f, 0x00, 0x00,
 }
 
 func (m *ValidatorOutstandingRewardsRecord) Marshal() (dAtA []byte, err error) {
@@ -703,6 +736,70 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ComputedRewardWeights) > 0 {
+		for iNdEx := len(m.ComputedRewardWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ComputedRewardWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.NextPaymentStreamId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPaymentStreamId))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.PaymentStreams) > 0 {
+		for iNdEx := len(m.PaymentStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.RewardWeightsUpdateTime != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.RewardWeightsUpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RewardWeightsUpdateTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintGenesis(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.NextGaugeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextGaugeId))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Gauges) > 0 {
+		for iNdEx := len(m.Gauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1005,6 +1102,34 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Gauges) > 0 {
+		for _, e := range m.Gauges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextGaugeId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextGaugeId))
+	}
+	if m.RewardWeightsUpdateTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RewardWeightsUpdateTime)
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PaymentStreams) > 0 {
+		for _, e := range m.PaymentStreams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextPaymentStreamId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextPaymentStreamId))
+	}
+	if len(m.ComputedRewardWeights) > 0 {
+		for _, e := range m.ComputedRewardWeights {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2159,6 +2284,182 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gauges = append(m.Gauges, Gauge{})
+			if err := m.Gauges[len(m.Gauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextGaugeId", wireType)
+			}
+			m.NextGaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextGaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightsUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RewardWeightsUpdateTime == nil {
+				m.RewardWeightsUpdateTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.RewardWeightsUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentStreams = append(m.PaymentStreams, PaymentStream{})
+			if err := m.PaymentStreams[len(m.PaymentStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPaymentStreamId", wireType)
+			}
+			m.NextPaymentStreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPaymentStreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComputedRewardWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComputedRewardWeights = append(m.ComputedRewardWeights, RewardWeight{})
+			if err := m.ComputedRewardWeights[len(m.ComputedRewardWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
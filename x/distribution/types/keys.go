@@ -0,0 +1,15 @@
+package types
+
+var (
+	GaugesPrefix   = []byte{0x90}
+	NextGaugeIdKey = []byte{0x91}
+
+	RewardWeightsUpdateTimeKey = []byte{0x92}
+
+	PaymentStreamsPrefix   = []byte{0x93}
+	NextPaymentStreamIdKey = []byte{0x94}
+
+	GaugesByStartTimePrefix = []byte{0x95}
+
+	ComputedRewardWeightsPrefix = []byte{0x96}
+)
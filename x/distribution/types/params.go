@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
 	yaml "gopkg.in/yaml.v3"
@@ -13,6 +14,12 @@ var (
 	DefaultCommunityTax        = math.LegacyNewDecWithPrec(2, 2) // 2%
 	DefaultWithdrawAddrEnabled = true
 	DefaultRewardWeights       = []RewardWeight{}
+
+	DefaultDynamicRewardWeightsEpochDuration = time.Hour * 24
+	DefaultDynamicRewardWeightsMaxChange     = math.LegacyNewDecWithPrec(5, 2) // 5%
+
+	DefaultGaugeCreationFee        = sdk.NewCoins()
+	DefaultMaxActiveGauges  uint64 = 100
 )
 
 // DefaultParams returns default distribution parameters
@@ -21,6 +28,14 @@ func DefaultParams() Params {
 		CommunityTax:        DefaultCommunityTax,
 		WithdrawAddrEnabled: DefaultWithdrawAddrEnabled,
 		RewardWeights:       DefaultRewardWeights,
+		DynamicRewardWeights: DynamicRewardWeights{
+			Enabled:       false,
+			EpochDuration: DefaultDynamicRewardWeightsEpochDuration,
+			MaxChange:     DefaultDynamicRewardWeightsMaxChange,
+			Multipliers:   []RewardWeight{},
+		},
+		GaugeCreationFee: DefaultGaugeCreationFee,
+		MaxActiveGauges:  DefaultMaxActiveGauges,
 	}
 }
 
@@ -43,6 +58,18 @@ func (p Params) ValidateBasic() error {
 		return err
 	}
 
+	if err := validateDynamicRewardWeights(p.DynamicRewardWeights); err != nil {
+		return err
+	}
+
+	if err := validateGaugeCreationFee(p.GaugeCreationFee); err != nil {
+		return err
+	}
+
+	if err := validateMaxActiveGauges(p.MaxActiveGauges); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -92,3 +119,54 @@ func validateRewardWeights(i interface{}) error {
 
 	return nil
 }
+
+func validateDynamicRewardWeights(i interface{}) error {
+	v, ok := i.(DynamicRewardWeights)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// the other fields are not used in the static mode
+	if !v.Enabled {
+		return nil
+	}
+
+	if v.EpochDuration <= 0 {
+		return fmt.Errorf("dynamic reward weights epoch duration must be positive: %s", v.EpochDuration)
+	}
+
+	if v.MaxChange.IsNil() || !v.MaxChange.IsPositive() {
+		return fmt.Errorf("dynamic reward weights max change must be positive: %s", v.MaxChange)
+	}
+	if v.MaxChange.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("dynamic reward weights max change too large: %s", v.MaxChange)
+	}
+
+	return validateRewardWeights(v.Multipliers)
+}
+
+func validateGaugeCreationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsValid() {
+		return fmt.Errorf("invalid gauge creation fee: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxActiveGauges(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max active gauges must be positive: %d", v)
+	}
+
+	return nil
+}
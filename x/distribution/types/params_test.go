@@ -50,6 +50,7 @@ func TestParams_ValidateBasic(t *testing.T) {
 				CommunityTax:        tt.fields.CommunityTax,
 				WithdrawAddrEnabled: tt.fields.WithdrawAddrEnabled,
 				RewardWeights:       tt.fields.RewardWeights,
+				MaxActiveGauges:     types.DefaultMaxActiveGauges,
 			}
 			if err := p.ValidateBasic(); (err != nil) != tt.wantErr {
 				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
@@ -58,6 +59,32 @@ func TestParams_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestParams_ValidateDynamicRewardWeights(t *testing.T) {
+	p := types.DefaultParams()
+	p.DynamicRewardWeights.Enabled = true
+	require.NoError(t, p.ValidateBasic())
+
+	p.DynamicRewardWeights.EpochDuration = 0
+	require.Error(t, p.ValidateBasic())
+
+	p = types.DefaultParams()
+	p.DynamicRewardWeights.Enabled = true
+	p.DynamicRewardWeights.MaxChange = math.LegacyZeroDec()
+	require.Error(t, p.ValidateBasic())
+
+	p.DynamicRewardWeights.MaxChange = math.LegacyNewDec(2)
+	require.Error(t, p.ValidateBasic())
+
+	p = types.DefaultParams()
+	p.DynamicRewardWeights.Enabled = true
+	p.DynamicRewardWeights.Multipliers = []types.RewardWeight{{Denom: "foo", Weight: math.LegacyOneDec().Neg()}}
+	require.Error(t, p.ValidateBasic())
+
+	// not validated in the static mode
+	p.DynamicRewardWeights.Enabled = false
+	require.NoError(t, p.ValidateBasic())
+}
+
 func TestDefaultParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().ValidateBasic())
 }
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPaymentStream creates a new PaymentStream instance
+func NewPaymentStream(
+	id uint64, recipient, denom string, total math.Int, startTime, endTime time.Time,
+) PaymentStream {
+	return PaymentStream{
+		Id:        id,
+		Recipient: recipient,
+		Denom:     denom,
+		Total:     total,
+		Released:  math.ZeroInt(),
+		Claimed:   math.ZeroInt(),
+		StartTime: startTime,
+		EndTime:   endTime,
+	}
+}
+
+// Unreleased returns the amount not yet paid out from the community pool.
+func (s PaymentStream) Unreleased() math.Int {
+	return s.Total.Sub(s.Released)
+}
+
+// Claimable returns the released amount not yet claimed by the recipient.
+func (s PaymentStream) Claimable() math.Int {
+	return s.Released.Sub(s.Claimed)
+}
+
+// IsFinished returns true if the stream released the total amount.
+func (s PaymentStream) IsFinished() bool {
+	return s.Unreleased().IsZero()
+}
+
+// ReleasableAt returns the amount to be released at the given time,
+// which is linearly increasing from the start time to the end time.
+func (s PaymentStream) ReleasableAt(blockTime time.Time) math.Int {
+	if blockTime.Before(s.StartTime) {
+		return math.ZeroInt()
+	}
+
+	total := s.EndTime.Sub(s.StartTime)
+	elapsed := blockTime.Sub(s.StartTime)
+	if elapsed >= total {
+		return s.Unreleased()
+	}
+
+	released := s.Total.Mul(math.NewInt(int64(elapsed))).Quo(math.NewInt(int64(total)))
+	if released.LT(s.Released) {
+		return math.ZeroInt()
+	}
+
+	return released.Sub(s.Released)
+}
+
+// Validate performs basic validation of the payment stream.
+func (s PaymentStream) Validate() error {
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return err
+	}
+
+	if s.Total.IsNil() || !s.Total.IsPositive() {
+		return fmt.Errorf("invalid payment stream total amount: %s", s.Total)
+	}
+
+	if s.Released.IsNil() || s.Released.IsNegative() || s.Released.GT(s.Total) {
+		return fmt.Errorf("invalid payment stream released amount: %s", s.Released)
+	}
+
+	if s.Claimed.IsNil() || s.Claimed.IsNegative() || s.Claimed.GT(s.Released) {
+		return fmt.Errorf("invalid payment stream claimed amount: %s", s.Claimed)
+	}
+
+	return ValidatePaymentStreamSchedule(s.StartTime, s.EndTime)
+}
+
+// ValidatePaymentStreamSchedule validates the payment schedule of a payment stream.
+func ValidatePaymentStreamSchedule(startTime, endTime time.Time) error {
+	if !endTime.After(startTime) {
+		return fmt.Errorf("end time must be after the start time: %s <= %s", endTime, startTime)
+	}
+
+	return nil
+}
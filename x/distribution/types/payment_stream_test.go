@@ -0,0 +1,39 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/initia-labs/initia/x/distribution/types"
+)
+
+func TestPaymentStream_ReleasableAt(t *testing.T) {
+	startTime := time.Now().UTC()
+	stream := types.NewPaymentStream(1, "", "foo", math.NewInt(1_000), startTime, startTime.Add(4*time.Hour))
+	require.NoError(t, stream.Validate())
+
+	require.True(t, stream.ReleasableAt(startTime.Add(-time.Second)).IsZero())
+	require.Equal(t, math.NewInt(250), stream.ReleasableAt(startTime.Add(time.Hour)))
+
+	stream.Released = math.NewInt(250)
+	require.Equal(t, math.NewInt(250), stream.ReleasableAt(startTime.Add(2*time.Hour)))
+	require.Equal(t, math.NewInt(750), stream.ReleasableAt(startTime.Add(5*time.Hour)))
+	require.Equal(t, math.NewInt(250), stream.Claimable())
+
+	stream.Released = stream.Total
+	require.True(t, stream.IsFinished())
+
+	stream.Claimed = math.NewInt(1_001)
+	require.Error(t, stream.Validate())
+}
+
+func TestValidatePaymentStreamSchedule(t *testing.T) {
+	now := time.Now().UTC()
+	require.NoError(t, types.ValidatePaymentStreamSchedule(now, now.Add(time.Hour)))
+	require.Error(t, types.ValidatePaymentStreamSchedule(now, now))
+	require.Error(t, types.ValidatePaymentStreamSchedule(now, now.Add(-time.Hour)))
+}
@@ -10,19 +10,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -591,991 +595,3635 @@ func (m *QueryDelegationTotalRewardsResponse) GetTotal() DecPools {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "initia.distribution.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "initia.distribution.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryValidatorOutstandingRewardsRequest)(nil), "initia.distribution.v1.QueryValidatorOutstandingRewardsRequest")
-	proto.RegisterType((*QueryValidatorOutstandingRewardsResponse)(nil), "initia.distribution.v1.QueryValidatorOutstandingRewardsResponse")
-	proto.RegisterType((*QueryValidatorCommissionRequest)(nil), "initia.distribution.v1.QueryValidatorCommissionRequest")
-	proto.RegisterType((*QueryValidatorCommissionResponse)(nil), "initia.distribution.v1.QueryValidatorCommissionResponse")
-	proto.RegisterType((*QueryValidatorSlashesRequest)(nil), "initia.distribution.v1.QueryValidatorSlashesRequest")
-	proto.RegisterType((*QueryValidatorSlashesResponse)(nil), "initia.distribution.v1.QueryValidatorSlashesResponse")
-	proto.RegisterType((*QueryDelegationRewardsRequest)(nil), "initia.distribution.v1.QueryDelegationRewardsRequest")
-	proto.RegisterType((*QueryDelegationRewardsResponse)(nil), "initia.distribution.v1.QueryDelegationRewardsResponse")
-	proto.RegisterType((*QueryDelegationTotalRewardsRequest)(nil), "initia.distribution.v1.QueryDelegationTotalRewardsRequest")
-	proto.RegisterType((*QueryDelegationTotalRewardsResponse)(nil), "initia.distribution.v1.QueryDelegationTotalRewardsResponse")
+// QueryGaugeRequest is the request type for the Query/Gauge RPC method.
+type QueryGaugeRequest struct {
+	// gauge_id defines the id of the gauge to query for.
+	GaugeId uint64 `protobuf:"varint,1,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("initia/distribution/v1/query.proto", fileDescriptor_6d2e9c17073286dc)
+func (m *QueryGaugeRequest) Reset()         { *m = QueryGaugeRequest{} }
+func (m *QueryGaugeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGaugeRequest) ProtoMessage()    {}
+func (*QueryGaugeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d2e9c17073286dc, []int{12}
 }
-
-var fileDescriptor_6d2e9c17073286dc = []byte{
-	// 893 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x4b, 0x6f, 0x23, 0x45,
-	0x10, 0xc7, 0xdd, 0xde, 0x6c, 0x76, 0xb7, 0x97, 0x47, 0xb6, 0x37, 0x8a, 0xac, 0x21, 0x8c, 0xad,
-	0x89, 0x94, 0x98, 0x44, 0xcc, 0xc8, 0x0e, 0x04, 0x14, 0x10, 0xe4, 0x45, 0xc2, 0x23, 0x82, 0x64,
-	0x12, 0xe5, 0x10, 0x90, 0xa2, 0xb6, 0xa7, 0x35, 0x1e, 0x69, 0x3c, 0xed, 0x4c, 0xb7, 0x0d, 0x51,
-	0x14, 0x81, 0xe0, 0x02, 0x37, 0x24, 0x2e, 0x1c, 0x73, 0xe6, 0xce, 0x07, 0xe0, 0x44, 0x8e, 0x91,
-	0x72, 0x81, 0x4b, 0x40, 0x09, 0x12, 0x48, 0x5c, 0xf9, 0x00, 0xc8, 0xdd, 0x3d, 0x7e, 0x8f, 0xed,
-	0x78, 0x6f, 0x56, 0x75, 0xd5, 0xbf, 0xea, 0x57, 0x3d, 0x55, 0x6d, 0x68, 0x78, 0x81, 0xc7, 0x3d,
-	0x6c, 0x39, 0x1e, 0xe3, 0xa1, 0x57, 0xa8, 0x72, 0x8f, 0x06, 0x56, 0x2d, 0x67, 0x1d, 0x57, 0x49,
-	0x78, 0x62, 0x56, 0x42, 0xca, 0x29, 0x9a, 0x92, 0x3e, 0x66, 0xab, 0x8f, 0x59, 0xcb, 0x69, 0xf3,
-	0x45, 0xca, 0xca, 0x94, 0x59, 0x05, 0xcc, 0x88, 0x0c, 0xb0, 0x6a, 0xb9, 0x02, 0xe1, 0x38, 0x67,
-	0x55, 0xb0, 0xeb, 0x05, 0x58, 0x38, 0x0a, 0x0d, 0x6d, 0xd2, 0xa5, 0x2e, 0x15, 0x3f, 0xad, 0xfa,
-	0x2f, 0x65, 0x9d, 0x76, 0x29, 0x75, 0x7d, 0x62, 0xe1, 0x8a, 0x67, 0xe1, 0x20, 0xa0, 0x5c, 0x84,
-	0x30, 0x75, 0xfa, 0x4a, 0x4c, 0x6d, 0x6d, 0x75, 0x08, 0x57, 0x63, 0x12, 0xa2, 0xdd, 0x7a, 0x01,
-	0x3b, 0x38, 0xc4, 0x65, 0x66, 0x93, 0xe3, 0x2a, 0x61, 0xdc, 0xd8, 0x83, 0x4f, 0xdb, 0xac, 0xac,
-	0x42, 0x03, 0x46, 0xd0, 0xdb, 0x70, 0xbc, 0x22, 0x2c, 0x29, 0x90, 0x01, 0xd9, 0xc7, 0x79, 0xdd,
-	0xec, 0x0d, 0x68, 0xca, 0xb8, 0xb5, 0xb1, 0x8b, 0xeb, 0x74, 0xc2, 0x56, 0x31, 0xc6, 0x01, 0x9c,
-	0x13, 0xa2, 0x07, 0xd8, 0xf7, 0x1c, 0xcc, 0x69, 0xf8, 0x49, 0x95, 0x33, 0x8e, 0x03, 0xc7, 0x0b,
-	0x5c, 0x9b, 0x7c, 0x8e, 0x43, 0x27, 0xca, 0x8f, 0x16, 0xe0, 0x93, 0x5a, 0xe4, 0x75, 0x84, 0x1d,
-	0x27, 0x24, 0x4c, 0xe6, 0x7c, 0x64, 0x4f, 0x34, 0x0e, 0x56, 0xa5, 0xdd, 0xf8, 0x12, 0x66, 0x07,
-	0xeb, 0x2a, 0x82, 0x3d, 0xf8, 0x20, 0x94, 0x26, 0x85, 0xb0, 0x18, 0x87, 0xd0, 0x47, 0x4d, 0x71,
-	0x45, 0x4a, 0xc6, 0xc7, 0x30, 0xdd, 0x5e, 0xc0, 0x3a, 0x2d, 0x97, 0x3d, 0xc6, 0x3c, 0x1a, 0x8c,
-	0x04, 0xf4, 0x15, 0x80, 0x99, 0x78, 0x41, 0x45, 0xf2, 0x19, 0x84, 0xc5, 0x86, 0x55, 0xc1, 0x2c,
-	0x0d, 0x84, 0x59, 0x2d, 0x16, 0xab, 0xe5, 0xaa, 0x8f, 0x39, 0x71, 0x9a, 0x9a, 0x8a, 0xa7, 0x45,
-	0xcf, 0xf8, 0x17, 0xc0, 0xe9, 0xf6, 0x12, 0xf6, 0x7c, 0xcc, 0x4a, 0x64, 0xa4, 0x1b, 0x42, 0x73,
-	0xf0, 0x45, 0xc6, 0x71, 0xc8, 0xbd, 0xc0, 0x3d, 0x2a, 0x11, 0xcf, 0x2d, 0xf1, 0x54, 0x32, 0x03,
-	0xb2, 0x63, 0xf6, 0x0b, 0x91, 0xf9, 0x7d, 0x61, 0x45, 0x33, 0xf0, 0x79, 0x22, 0x3a, 0x1d, 0xb9,
-	0xdd, 0x13, 0x6e, 0xcf, 0x49, 0xa3, 0x72, 0xda, 0x84, 0xb0, 0x39, 0x25, 0xa9, 0x31, 0x41, 0x3e,
-	0x6b, 0xca, 0x91, 0x32, 0xeb, 0x23, 0x65, 0xca, 0x19, 0x54, 0x23, 0x65, 0xee, 0x60, 0x97, 0xa8,
-	0xb2, 0xed, 0x96, 0xc8, 0xe5, 0x87, 0xdf, 0x9e, 0xa7, 0x13, 0x3f, 0x9e, 0xa7, 0x81, 0xf1, 0x33,
-	0x80, 0x2f, 0xc7, 0xd0, 0xaa, 0x6e, 0x7f, 0x04, 0x1f, 0x30, 0x69, 0x4a, 0x81, 0xcc, 0xbd, 0xec,
-	0xe3, 0xfc, 0xc2, 0xc0, 0x56, 0x0b, 0x89, 0xf7, 0x6a, 0x24, 0xe0, 0xd1, 0xf7, 0xa2, 0x14, 0xd0,
-	0x56, 0x1b, 0x40, 0x52, 0x00, 0xcc, 0x0d, 0x04, 0x90, 0x95, 0xb4, 0x12, 0x18, 0xdf, 0x44, 0x75,
-	0x6f, 0x10, 0x9f, 0xb8, 0xc2, 0xd6, 0x3d, 0x48, 0x8e, 0x3c, 0xeb, 0xbe, 0xa6, 0xc6, 0x41, 0x74,
-	0x4d, 0x3d, 0xef, 0x34, 0xd9, 0xfb, 0x4e, 0x65, 0xf7, 0xfe, 0x39, 0x4f, 0x27, 0x8c, 0x32, 0xd4,
-	0xe3, 0x8a, 0x68, 0x76, 0xaf, 0x39, 0x75, 0xf5, 0xee, 0xa5, 0xe3, 0xba, 0xb7, 0x41, 0x8a, 0x3b,
-	0x94, 0xfa, 0x6b, 0x13, 0xf5, 0x8e, 0xfd, 0xf4, 0x47, 0xfa, 0xa1, 0x32, 0xb0, 0xe6, 0xb4, 0x7d,
-	0x0a, 0x8d, 0x8e, 0x74, 0xfb, 0x94, 0x63, 0xff, 0x19, 0xc0, 0x5b, 0x58, 0x7e, 0x01, 0x70, 0xa6,
-	0xaf, 0xba, 0x22, 0xda, 0xed, 0x24, 0xca, 0xc5, 0x13, 0x45, 0x42, 0x1b, 0x51, 0x5a, 0x29, 0xd6,
-	0xb1, 0x45, 0xd0, 0x16, 0xbc, 0xcf, 0xeb, 0xa9, 0x52, 0xc9, 0x51, 0x5b, 0x24, 0xe3, 0xf3, 0x7f,
-	0x3f, 0x82, 0xf7, 0x05, 0x03, 0xfa, 0x0e, 0xc0, 0x71, 0xb9, 0x8a, 0xd1, 0x7c, 0x9c, 0x5c, 0xf7,
-	0xf6, 0xd7, 0x16, 0x86, 0xf2, 0x95, 0x9d, 0x30, 0x66, 0xbf, 0xbe, 0xfa, 0xeb, 0x87, 0x64, 0x06,
-	0xe9, 0x56, 0xcc, 0xa3, 0x23, 0xb7, 0x3f, 0xfa, 0x0f, 0xc0, 0x97, 0xfa, 0xec, 0x54, 0xf4, 0x6e,
-	0xdf, 0xa4, 0x83, 0xdf, 0x0c, 0x6d, 0x65, 0x74, 0x01, 0x85, 0x62, 0x0b, 0x94, 0x6d, 0xf4, 0x61,
-	0x1c, 0x4a, 0x63, 0x08, 0x98, 0x75, 0xda, 0x35, 0x29, 0x67, 0x16, 0x6d, 0x4a, 0x1f, 0x45, 0xb7,
-	0x7a, 0x05, 0xe0, 0xd3, 0x1e, 0x6b, 0x1c, 0xbd, 0x31, 0x5c, 0xb5, 0x5d, 0x2f, 0x89, 0xf6, 0xe6,
-	0xdd, 0x03, 0x15, 0xde, 0x07, 0x02, 0x6f, 0x1d, 0xad, 0x8e, 0x88, 0xd7, 0x7c, 0x1e, 0xd0, 0xaf,
-	0x00, 0x4e, 0x74, 0xee, 0x4a, 0xf4, 0xda, 0x70, 0x95, 0xb5, 0x3f, 0x24, 0xda, 0xeb, 0x77, 0x8c,
-	0x52, 0x30, 0x9b, 0x02, 0x66, 0x05, 0xbd, 0x33, 0x22, 0x4c, 0xb4, 0x8b, 0xaf, 0x01, 0x7c, 0xd2,
-	0xb5, 0xb8, 0x50, 0xff, 0xa2, 0xe2, 0xb6, 0xad, 0xb6, 0x74, 0xd7, 0x30, 0x05, 0x73, 0x28, 0x60,
-	0xf6, 0x91, 0x1d, 0x07, 0xd3, 0xd8, 0x58, 0xcc, 0x3a, 0xed, 0x5a, 0x6b, 0x67, 0x96, 0xfa, 0xd8,
-	0x7a, 0x81, 0xa2, 0xdf, 0x01, 0x9c, 0xea, 0xbd, 0xcc, 0xd0, 0xf2, 0x90, 0xe5, 0xf6, 0xd8, 0xaf,
-	0xda, 0x5b, 0x23, 0xc5, 0x0e, 0x7b, 0x79, 0xc3, 0xf1, 0xae, 0x6d, 0x5f, 0xdc, 0xe8, 0xe0, 0xf2,
-	0x46, 0x07, 0x7f, 0xde, 0xe8, 0xe0, 0xfb, 0x5b, 0x3d, 0x71, 0x79, 0xab, 0x27, 0x7e, 0xbb, 0xd5,
-	0x13, 0x87, 0x79, 0xd7, 0xe3, 0xa5, 0x6a, 0xc1, 0x2c, 0xd2, 0xb2, 0xca, 0xf1, 0xaa, 0x8f, 0x0b,
-	0x2c, 0xca, 0xf7, 0x45, 0x7b, 0x46, 0x7e, 0x52, 0x21, 0xac, 0x30, 0x2e, 0xfe, 0x11, 0x2f, 0xfe,
-	0x1f, 0x00, 0x00, 0xff, 0xff, 0xf4, 0x60, 0x21, 0x94, 0xda, 0x0b, 0x00, 0x00,
+func (m *QueryGaugeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGaugeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGaugeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGaugeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGaugeRequest.Merge(m, src)
+}
+func (m *QueryGaugeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGaugeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGaugeRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGaugeRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries params of the distribution module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ValidatorOutstandingRewards queries rewards of a validator address.
-	ValidatorOutstandingRewards(ctx context.Context, in *QueryValidatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardsResponse, error)
-	// ValidatorCommission queries accumulated commission for a validator.
-	ValidatorCommission(ctx context.Context, in *QueryValidatorCommissionRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionResponse, error)
-	// ValidatorSlashes queries slash events of a validator.
-	ValidatorSlashes(ctx context.Context, in *QueryValidatorSlashesRequest, opts ...grpc.CallOption) (*QueryValidatorSlashesResponse, error)
-	// DelegationRewards queries the total rewards accrued by a delegation.
-	DelegationRewards(ctx context.Context, in *QueryDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsResponse, error)
-	// DelegationTotalRewards queries the total rewards accrued by a each
-	// validator.
-	DelegationTotalRewards(ctx context.Context, in *QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsResponse, error)
+func (m *QueryGaugeRequest) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryGaugeResponse is the response type for the Query/Gauge RPC method.
+type QueryGaugeResponse struct {
+	Gauge Gauge `protobuf:"bytes,1,opt,name=gauge,proto3" json:"gauge"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGaugeResponse) Reset()         { *m = QueryGaugeResponse{} }
+func (m *QueryGaugeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGaugeResponse) ProtoMessage()    {}
+func (*QueryGaugeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d2e9c17073286dc, []int{13}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGaugeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) ValidatorOutstandingRewards(ctx context.Context, in *QueryValidatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardsResponse, error) {
-	out := new(QueryValidatorOutstandingRewardsResponse)
-	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Query/ValidatorOutstandingRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGaugeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGaugeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGaugeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGaugeResponse.Merge(m, src)
+}
+func (m *QueryGaugeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGaugeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGaugeResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ValidatorCommission(ctx context.Context, in *QueryValidatorCommissionRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionResponse, error) {
-	out := new(QueryValidatorCommissionResponse)
-	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Query/ValidatorCommission", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGaugeResponse proto.InternalMessageInfo
+
+func (m *QueryGaugeResponse) GetGauge() Gauge {
+	if m != nil {
+		return m.Gauge
 	}
-	return out, nil
+	return Gauge{}
 }
 
-func (c *queryClient) ValidatorSlashes(ctx context.Context, in *QueryValidatorSlashesRequest, opts ...grpc.CallOption) (*QueryValidatorSlashesResponse, error) {
-	out := new(QueryValidatorSlashesResponse)
-	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Query/ValidatorSlashes", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryGaugesRequest is the request type for the Query/Gauges RPC method.
+type QueryGaugesRequest struct {
+	// denom defines the optional bond denom to filter the gauges.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) DelegationRewards(ctx context.Context, in *QueryDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsResponse, error) {
-	out := new(QueryDelegationRewardsResponse)
-	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Query/DelegationRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGaugesRequest) Reset()         { *m = QueryGaugesRequest{} }
+func (m *QueryGaugesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGaugesRequest) ProtoMessage()    {}
+func (*QueryGaugesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d2e9c17073286dc, []int{14}
+}
+func (m *QueryGaugesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGaugesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGaugesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGaugesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGaugesRequest.Merge(m, src)
+}
+func (m *QueryGaugesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGaugesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGaugesRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) DelegationTotalRewards(ctx context.Context, in *QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsResponse, error) {
-	out := new(QueryDelegationTotalRewardsResponse)
-	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Query/DelegationTotalRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGaugesRequest proto.InternalMessageInfo
+
+func (m *QueryGaugesRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries params of the distribution module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ValidatorOutstandingRewards queries rewards of a validator address.
-	ValidatorOutstandingRewards(context.Context, *QueryValidatorOutstandingRewardsRequest) (*QueryValidatorOutstandingRewardsResponse, error)
-	// ValidatorCommission queries accumulated commission for a validator.
-	ValidatorCommission(context.Context, *QueryValidatorCommissionRequest) (*QueryValidatorCommissionResponse, error)
-	// ValidatorSlashes queries slash events of a validator.
-	ValidatorSlashes(context.Context, *QueryValidatorSlashesRequest) (*QueryValidatorSlashesResponse, error)
-	// DelegationRewards queries the total rewards accrued by a delegation.
-	DelegationRewards(context.Context, *QueryDelegationRewardsRequest) (*QueryDelegationRewardsResponse, error)
-	// DelegationTotalRewards queries the total rewards accrued by a each
-	// validator.
-	DelegationTotalRewards(context.Context, *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error)
+func (m *QueryGaugesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryGaugesResponse is the response type for the Query/Gauges RPC method.
+type QueryGaugesResponse struct {
+	Gauges []Gauge `protobuf:"bytes,1,rep,name=gauges,proto3" json:"gauges"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryGaugesResponse) Reset()         { *m = QueryGaugesResponse{} }
+func (m *QueryGaugesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGaugesResponse) ProtoMessage()    {}
+func (*QueryGaugesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d2e9c17073286dc, []int{15}
 }
-func (*UnimplementedQueryServer) ValidatorOutstandingRewards(ctx context.Context, req *QueryValidatorOutstandingRewardsRequest) (*QueryValidatorOutstandingRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorOutstandingRewards not implemented")
+func (m *QueryGaugesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ValidatorCommission(ctx context.Context, req *QueryValidatorCommissionRequest) (*QueryValidatorCommissionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorCommission not implemented")
+func (m *QueryGaugesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGaugesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ValidatorSlashes(ctx context.Context, req *QueryValidatorSlashesRequest) (*QueryValidatorSlashesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSlashes not implemented")
+func (m *QueryGaugesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGaugesResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) DelegationRewards(ctx context.Context, req *QueryDelegationRewardsRequest) (*QueryDelegationRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegationRewards not implemented")
+func (m *QueryGaugesResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) DelegationTotalRewards(ctx context.Context, req *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegationTotalRewards not implemented")
+func (m *QueryGaugesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGaugesResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryGaugesResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.distribution.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryGaugesResponse) GetGauges() []Gauge {
+	if m != nil {
+		return m.Gauges
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ValidatorOutstandingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorOutstandingRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorOutstandingRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.distribution.v1.Query/ValidatorOutstandingRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorOutstandingRewards(ctx, req.(*QueryValidatorOutstandingRewardsRequest))
+func (m *QueryGaugesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ValidatorCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorCommissionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorCommission(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.distribution.v1.Query/ValidatorCommission",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorCommission(ctx, req.(*QueryValidatorCommissionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryProjectedRewardRatesRequest is the request type for the
+// Query/ProjectedRewardRates RPC method.
+type QueryProjectedRewardRatesRequest struct {
+	// validator_address defines the optional validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func _Query_ValidatorSlashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorSlashesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorSlashes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.distribution.v1.Query/ValidatorSlashes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorSlashes(ctx, req.(*QueryValidatorSlashesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryProjectedRewardRatesRequest) Reset()         { *m = QueryProjectedRewardRatesRequest{} }
+func (m *QueryProjectedRewardRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedRewardRatesRequest) ProtoMessage()    {}
+func (*QueryProjectedRewardRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d2e9c17073286dc, []int{16}
 }
-
-func _Query_DelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegationRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegationRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.distribution.v1.Query/DelegationRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegationRewards(ctx, req.(*QueryDelegationRewardsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryProjectedRewardRatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_DelegationTotalRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegationTotalRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegationTotalRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.distribution.v1.Query/DelegationTotalRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegationTotalRewards(ctx, req.(*QueryDelegationTotalRewardsRequest))
+func (m *QueryProjectedRewardRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedRewardRatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "initia.distribution.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ValidatorOutstandingRewards",
-			Handler:    _Query_ValidatorOutstandingRewards_Handler,
-		},
-		{
-			MethodName: "ValidatorCommission",
-			Handler:    _Query_ValidatorCommission_Handler,
-		},
-		{
-			MethodName: "ValidatorSlashes",
-			Handler:    _Query_ValidatorSlashes_Handler,
-		},
-		{
-			MethodName: "DelegationRewards",
-			Handler:    _Query_DelegationRewards_Handler,
-		},
-		{
-			MethodName: "DelegationTotalRewards",
-			Handler:    _Query_DelegationTotalRewards_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "initia/distribution/v1/query.proto",
+func (m *QueryProjectedRewardRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedRewardRatesRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryProjectedRewardRatesRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProjectedRewardRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedRewardRatesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
+var xxx_messageInfo_QueryProjectedRewardRatesRequest proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProjectedRewardRatesRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryProjectedRewardRatesResponse is the response type for the
+// Query/ProjectedRewardRates RPC method.
+type QueryProjectedRewardRatesResponse struct {
+	Rates []ValidatorRewardRates `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryProjectedRewardRatesResponse) Reset()         { *m = QueryProjectedRewardRatesResponse{} }
+func (m *QueryProjectedRewardRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedRewardRatesResponse) ProtoMessage()    {}
+func (*QueryProjectedRewardRatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d2e9c17073286dc, []int{17}
+}
+func (m *QueryProjectedRewardRatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedRewardRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedRewardRatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryProjectedRewardRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedRewardRatesResponse.Merge(m, src)
+}
+func (m *QueryProjectedRewardRatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedRewardRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedRewardRatesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorOutstandingRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProjectedRewardRatesResponse proto.InternalMessageInfo
+
+func (m *QueryProjectedRewardRatesResponse) GetRates() []ValidatorRewardRates {
+	if m != nil {
+		return m.Rates
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryValidatorOutstandingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRealizedRewardRatesRequest is the request type for the
+// Query/RealizedRewardRates RPC method.
+type QueryRealizedRewardRatesRequest struct {
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (m *QueryValidatorOutstandingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryRealizedRewardRatesRequest) Reset()         { *m = QueryRealizedRewardRatesRequest{} }
+func (m *QueryRealizedRewardRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRealizedRewardRatesRequest) ProtoMessage()    {}
+func (*QueryRealizedRewardRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d2e9c17073286dc, []int{18}
+}
+func (m *QueryRealizedRewardRatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRealizedRewardRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRealizedRewardRatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRealizedRewardRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRealizedRewardRatesRequest.Merge(m, src)
+}
+func (m *QueryRealizedRewardRatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRealizedRewardRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRealizedRewardRatesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorOutstandingRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRealizedRewardRatesRequest proto.InternalMessageInfo
+
+func (m *QueryRealizedRewardRatesRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryValidatorOutstandingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRealizedRewardRatesResponse is the response type for the
+// Query/RealizedRewardRates RPC method.
+type QueryRealizedRewardRatesResponse struct {
+	Rates ValidatorRewardRates `protobuf:"bytes,1,opt,name=rates,proto3" json:"rates"`
+	// start_time is the end time of the oldest historical rewards period.
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the current block time.
+	EndTime time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
 }
 
-func (m *QueryValidatorOutstandingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRealizedRewardRatesResponse) Reset()         { *m = QueryRealizedRewardRatesResponse{} }
+func (m *QueryRealizedRewardRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRealizedRewardRatesResponse) ProtoMessage()    {}
+func (*QueryRealizedRewardRatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d2e9c17073286dc, []int{19}
+}
+func (m *QueryRealizedRewardRatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRealizedRewardRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRealizedRewardRatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryValidatorCommissionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRealizedRewardRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRealizedRewardRatesResponse.Merge(m, src)
+}
+func (m *QueryRealizedRewardRatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRealizedRewardRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRealizedRewardRatesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorCommissionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryRealizedRewardRatesResponse proto.InternalMessageInfo
+
+func (m *QueryRealizedRewardRatesResponse) GetRates() ValidatorRewardRates {
+	if m != nil {
+		return m.Rates
+	}
+	return ValidatorRewardRates{}
 }
 
-func (m *QueryValidatorCommissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryRealizedRewardRatesResponse) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *QueryValidatorCommissionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRealizedRewardRatesResponse) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *QueryValidatorCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPaymentStreamRequest is the request type for the Query/PaymentStream RPC method.
+type QueryPaymentStreamRequest struct {
+	// stream_id defines the id of the payment stream to query for.
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
 }
 
-func (m *QueryValidatorCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPaymentStreamRequest) Reset()         { *m = QueryPaymentStreamRequest{} }
+func (m *QueryPaymentStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentStreamRequest) ProtoMessage()    {}
+func (*QueryPaymentStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d2e9c17073286dc, []int{20}
+}
+func (m *QueryPaymentStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryPaymentStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentStreamRequest.Merge(m, src)
+}
+func (m *QueryPaymentStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentStreamRequest.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorSlashesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPaymentStreamRequest proto.InternalMessageInfo
+
+func (m *QueryPaymentStreamRequest) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryValidatorSlashesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPaymentStreamResponse is the response type for the Query/PaymentStream RPC method.
+type QueryPaymentStreamResponse struct {
+	Stream PaymentStream `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream"`
 }
 
-func (m *QueryValidatorSlashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryPaymentStreamResponse) Reset()         { *m = QueryPaymentStreamResponse{} }
+func (m *QueryPaymentStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentStreamResponse) ProtoMessage()    {}
+func (*QueryPaymentStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d2e9c17073286dc, []int{21}
+}
+func (m *QueryPaymentStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.EndingHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EndingHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.StartingHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StartingHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPaymentStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentStreamResponse.Merge(m, src)
+}
+func (m *QueryPaymentStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentStreamResponse.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorSlashesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPaymentStreamResponse proto.InternalMessageInfo
+
+func (m *QueryPaymentStreamResponse) GetStream() PaymentStream {
+	if m != nil {
+		return m.Stream
 	}
-	return dAtA[:n], nil
+	return PaymentStream{}
 }
 
-func (m *QueryValidatorSlashesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPaymentStreamsRequest is the request type for the Query/PaymentStreams RPC method.
+type QueryPaymentStreamsRequest struct {
+	// recipient defines the optional recipient address to filter the payment streams.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryValidatorSlashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Slashes) > 0 {
-		for iNdEx := len(m.Slashes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Slashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryPaymentStreamsRequest) Reset()         { *m = QueryPaymentStreamsRequest{} }
+func (m *QueryPaymentStreamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentStreamsRequest) ProtoMessage()    {}
+func (*QueryPaymentStreamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d2e9c17073286dc, []int{22}
+}
+func (m *QueryPaymentStreamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentStreamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentStreamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPaymentStreamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentStreamsRequest.Merge(m, src)
 }
-
-func (m *QueryDelegationRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPaymentStreamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentStreamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentStreamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryPaymentStreamsRequest proto.InternalMessageInfo
+
+func (m *QueryPaymentStreamsRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPaymentStreamsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPaymentStreamsResponse is the response type for the Query/PaymentStreams RPC method.
+type QueryPaymentStreamsResponse struct {
+	Streams []PaymentStream `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryPaymentStreamsResponse) Reset()         { *m = QueryPaymentStreamsResponse{} }
+func (m *QueryPaymentStreamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentStreamsResponse) ProtoMessage()    {}
+func (*QueryPaymentStreamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d2e9c17073286dc, []int{23}
+}
+func (m *QueryPaymentStreamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentStreamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentStreamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDelegationTotalRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPaymentStreamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentStreamsResponse.Merge(m, src)
 }
-
-func (m *QueryDelegationTotalRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPaymentStreamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentStreamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentStreamsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDelegationTotalRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryPaymentStreamsResponse proto.InternalMessageInfo
+
+func (m *QueryPaymentStreamsResponse) GetStreams() []PaymentStream {
+	if m != nil {
+		return m.Streams
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryDelegationTotalRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPaymentStreamsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDelegationTotalRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "initia.distribution.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "initia.distribution.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryValidatorOutstandingRewardsRequest)(nil), "initia.distribution.v1.QueryValidatorOutstandingRewardsRequest")
+	proto.RegisterType((*QueryValidatorOutstandingRewardsResponse)(nil), "initia.distribution.v1.QueryValidatorOutstandingRewardsResponse")
+	proto.RegisterType((*QueryValidatorCommissionRequest)(nil), "initia.distribution.v1.QueryValidatorCommissionRequest")
+	proto.RegisterType((*QueryValidatorCommissionResponse)(nil), "initia.distribution.v1.QueryValidatorCommissionResponse")
+	proto.RegisterType((*QueryValidatorSlashesRequest)(nil), "initia.distribution.v1.QueryValidatorSlashesRequest")
+	proto.RegisterType((*QueryValidatorSlashesResponse)(nil), "initia.distribution.v1.QueryValidatorSlashesResponse")
+	proto.RegisterType((*QueryDelegationRewardsRequest)(nil), "initia.distribution.v1.QueryDelegationRewardsRequest")
+	proto.RegisterType((*QueryDelegationRewardsResponse)(nil), "initia.distribution.v1.QueryDelegationRewardsResponse")
+	proto.RegisterType((*QueryDelegationTotalRewardsRequest)(nil), "initia.distribution.v1.QueryDelegationTotalRewardsRequest")
+	proto.RegisterType((*QueryDelegationTotalRewardsResponse)(nil), "initia.distribution.v1.QueryDelegationTotalRewardsResponse")
+	proto.RegisterType((*QueryGaugeRequest)(nil), "initia.distribution.v1.QueryGaugeRequest")
+	proto.RegisterType((*QueryGaugeResponse)(nil), "initia.distribution.v1.QueryGaugeResponse")
+	proto.RegisterType((*QueryGaugesRequest)(nil), "initia.distribution.v1.QueryGaugesRequest")
+	proto.RegisterType((*QueryGaugesResponse)(nil), "initia.distribution.v1.QueryGaugesResponse")
+	proto.RegisterType((*QueryProjectedRewardRatesRequest)(nil), "initia.distribution.v1.QueryProjectedRewardRatesRequest")
+	proto.RegisterType((*QueryProjectedRewardRatesResponse)(nil), "initia.distribution.v1.QueryProjectedRewardRatesResponse")
+	proto.RegisterType((*QueryRealizedRewardRatesRequest)(nil), "initia.distribution.v1.QueryRealizedRewardRatesRequest")
+	proto.RegisterType((*QueryRealizedRewardRatesResponse)(nil), "initia.distribution.v1.QueryRealizedRewardRatesResponse")
+	proto.RegisterType((*QueryPaymentStreamRequest)(nil), "initia.distribution.v1.QueryPaymentStreamRequest")
+	proto.RegisterType((*QueryPaymentStreamResponse)(nil), "initia.distribution.v1.QueryPaymentStreamResponse")
+	proto.RegisterType((*QueryPaymentStreamsRequest)(nil), "initia.distribution.v1.QueryPaymentStreamsRequest")
+	proto.RegisterType((*QueryPaymentStreamsResponse)(nil), "initia.distribution.v1.QueryPaymentStreamsResponse")
 }
 
-func (m *QueryDelegationTotalRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Total) > 0 {
-		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("initia/distribution/v1/query.proto", fileDescriptor_6d2e9c17073286dc)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+var fileDescriptor_6d2e9c17073286dc = []byte{
+	// 1399 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6f, 0xd4, 0xc6,
+	0x1b, 0xce, 0x84, 0x7c, 0xbe, 0xfc, 0xe0, 0x07, 0x93, 0x08, 0x05, 0x03, 0xbb, 0xa9, 0x51, 0x49,
+	0x42, 0x5a, 0xbb, 0x9b, 0x14, 0xca, 0x47, 0x55, 0x20, 0xe1, 0xb3, 0x45, 0x10, 0x9c, 0x88, 0x03,
+	0xad, 0xb4, 0x9a, 0x5d, 0x4f, 0x1d, 0x57, 0x6b, 0x7b, 0xf1, 0xcc, 0xa6, 0xa5, 0x51, 0xd4, 0x8a,
+	0x5e, 0xda, 0x1b, 0xa8, 0x97, 0x4a, 0xbd, 0x70, 0x46, 0xea, 0xa9, 0xea, 0x1f, 0x50, 0xf5, 0x50,
+	0x8e, 0x48, 0x5c, 0xda, 0x0b, 0x54, 0xd0, 0x43, 0xa5, 0xde, 0xaa, 0xfe, 0x01, 0xd5, 0xce, 0x8c,
+	0xbd, 0xeb, 0xac, 0xbd, 0x5f, 0x70, 0x5b, 0xbf, 0x7e, 0xdf, 0x67, 0x9e, 0xe7, 0x1d, 0xbf, 0x33,
+	0xcf, 0x82, 0xee, 0xfa, 0x2e, 0x77, 0x89, 0x69, 0xbb, 0x8c, 0x87, 0x6e, 0xa9, 0xc6, 0xdd, 0xc0,
+	0x37, 0x37, 0x0a, 0xe6, 0xed, 0x1a, 0x0d, 0xef, 0x18, 0xd5, 0x30, 0xe0, 0x01, 0xde, 0x27, 0x73,
+	0x8c, 0xe6, 0x1c, 0x63, 0xa3, 0xa0, 0x1d, 0x2d, 0x07, 0xcc, 0x0b, 0x98, 0x59, 0x22, 0x8c, 0xca,
+	0x02, 0x73, 0xa3, 0x50, 0xa2, 0x9c, 0x14, 0xcc, 0x2a, 0x71, 0x5c, 0x9f, 0x88, 0x44, 0x81, 0xa1,
+	0x4d, 0x3a, 0x81, 0x13, 0x88, 0x9f, 0x66, 0xfd, 0x97, 0x8a, 0x1e, 0x74, 0x82, 0xc0, 0xa9, 0x50,
+	0x93, 0x54, 0x5d, 0x93, 0xf8, 0x7e, 0xc0, 0x45, 0x09, 0x53, 0x6f, 0xf3, 0xea, 0xad, 0x78, 0x2a,
+	0xd5, 0x3e, 0x36, 0xb9, 0xeb, 0x51, 0xc6, 0x89, 0x57, 0x55, 0x09, 0x73, 0x19, 0xe4, 0x13, 0x44,
+	0x45, 0xaa, 0x3e, 0x09, 0xf8, 0x46, 0x9d, 0xe1, 0x0a, 0x09, 0x89, 0xc7, 0x2c, 0x7a, 0xbb, 0x46,
+	0x19, 0xd7, 0x57, 0x61, 0x22, 0x11, 0x65, 0xd5, 0xc0, 0x67, 0x14, 0xbf, 0x0b, 0x23, 0x55, 0x11,
+	0x99, 0x42, 0xd3, 0x68, 0x76, 0xe7, 0x42, 0xce, 0x48, 0xef, 0x80, 0x21, 0xeb, 0x96, 0x86, 0x1e,
+	0x3d, 0xcd, 0x0f, 0x58, 0xaa, 0x46, 0xbf, 0x09, 0x33, 0x02, 0xf4, 0x26, 0xa9, 0xb8, 0x36, 0xe1,
+	0x41, 0x78, 0xbd, 0xc6, 0x19, 0x27, 0xbe, 0xed, 0xfa, 0x8e, 0x45, 0x3f, 0x25, 0xa1, 0x1d, 0xad,
+	0x8f, 0xe7, 0x61, 0xef, 0x46, 0x94, 0x55, 0x24, 0xb6, 0x1d, 0x52, 0x26, 0xd7, 0x1c, 0xb7, 0xf6,
+	0xc4, 0x2f, 0xce, 0xc9, 0xb8, 0xfe, 0x05, 0xcc, 0x76, 0xc6, 0x55, 0x0a, 0x56, 0x61, 0x34, 0x94,
+	0x21, 0x25, 0x61, 0x31, 0x4b, 0x42, 0x1b, 0x34, 0xa5, 0x2b, 0x42, 0xd2, 0xaf, 0x41, 0x3e, 0x49,
+	0x60, 0x39, 0xf0, 0x3c, 0x97, 0x31, 0x37, 0xf0, 0xfb, 0x12, 0xf4, 0x25, 0x82, 0xe9, 0x6c, 0x40,
+	0xa5, 0xe4, 0x23, 0x80, 0x72, 0x1c, 0x55, 0x62, 0x8e, 0x77, 0x14, 0x73, 0xae, 0x5c, 0xae, 0x79,
+	0xb5, 0x0a, 0xe1, 0xd4, 0x6e, 0x60, 0x2a, 0x3d, 0x4d, 0x78, 0xfa, 0xdf, 0x08, 0x0e, 0x26, 0x29,
+	0xac, 0x56, 0x08, 0x5b, 0xa7, 0x7d, 0xed, 0x10, 0x9e, 0x81, 0xff, 0x33, 0x4e, 0x42, 0xee, 0xfa,
+	0x4e, 0x71, 0x9d, 0xba, 0xce, 0x3a, 0x9f, 0x1a, 0x9c, 0x46, 0xb3, 0x43, 0xd6, 0xee, 0x28, 0x7c,
+	0x59, 0x44, 0xf1, 0x61, 0xd8, 0x45, 0x7d, 0xbb, 0x29, 0x6d, 0x87, 0x48, 0xfb, 0x9f, 0x0c, 0xaa,
+	0xa4, 0x8b, 0x00, 0x8d, 0x31, 0x9a, 0x1a, 0x12, 0xca, 0x8f, 0x18, 0x72, 0xe6, 0x8c, 0xfa, 0xcc,
+	0x19, 0x72, 0x48, 0xd5, 0xcc, 0x19, 0x2b, 0xc4, 0xa1, 0x8a, 0xb6, 0xd5, 0x54, 0x79, 0x6a, 0xec,
+	0xeb, 0x07, 0xf9, 0x81, 0xef, 0x1e, 0xe4, 0x91, 0xfe, 0x13, 0x82, 0x43, 0x19, 0x6a, 0x55, 0xb7,
+	0x3f, 0x80, 0x51, 0x26, 0x43, 0x53, 0x68, 0x7a, 0xc7, 0xec, 0xce, 0x85, 0xf9, 0x8e, 0xad, 0x16,
+	0x10, 0x17, 0x36, 0xa8, 0xcf, 0xa3, 0xef, 0x45, 0x21, 0xe0, 0x4b, 0x09, 0x01, 0x83, 0x42, 0xc0,
+	0x4c, 0x47, 0x01, 0x92, 0x49, 0xb3, 0x02, 0xfd, 0xab, 0x88, 0xf7, 0x79, 0x5a, 0xa1, 0x8e, 0x88,
+	0xb5, 0x0e, 0x92, 0x2d, 0xdf, 0xb5, 0x6e, 0x53, 0xfc, 0x22, 0xda, 0xa6, 0xd4, 0x3d, 0x1d, 0x4c,
+	0xdf, 0x53, 0xd9, 0xbd, 0xbf, 0x1e, 0xe4, 0x07, 0x74, 0x0f, 0x72, 0x59, 0x24, 0x1a, 0xdd, 0x6b,
+	0x4c, 0x5d, 0xbd, 0x7b, 0xf9, 0xac, 0xee, 0x9d, 0xa7, 0xe5, 0x95, 0x20, 0xa8, 0x2c, 0xed, 0xa9,
+	0x77, 0xec, 0xe1, 0xb3, 0xfc, 0x98, 0x0a, 0xb0, 0xc6, 0xb4, 0x7d, 0x08, 0xfa, 0xb6, 0xe5, 0xd6,
+	0x02, 0x4e, 0x2a, 0x2f, 0x21, 0xbc, 0x49, 0xcb, 0xcf, 0x08, 0x0e, 0xb7, 0x45, 0x57, 0x8a, 0x6e,
+	0x6c, 0x57, 0x54, 0xc8, 0x56, 0x14, 0x01, 0x9d, 0x8f, 0x96, 0x95, 0x60, 0xdb, 0x4e, 0x11, 0x7c,
+	0x09, 0x86, 0x79, 0x7d, 0xa9, 0xa9, 0xc1, 0x7e, 0x5b, 0x24, 0xeb, 0x75, 0x03, 0xf6, 0x0a, 0x09,
+	0x97, 0x48, 0x2d, 0xfe, 0xf0, 0xf1, 0x7e, 0x18, 0x73, 0xea, 0xcf, 0x45, 0xd7, 0x16, 0x6d, 0x18,
+	0xb2, 0x46, 0xc5, 0xf3, 0x15, 0x5b, 0xbf, 0x0e, 0xb8, 0x39, 0x5f, 0x29, 0x3c, 0x09, 0xc3, 0x22,
+	0x41, 0x1d, 0x2d, 0x87, 0xb2, 0xe8, 0x88, 0x2a, 0xa5, 0x45, 0x56, 0xe8, 0x61, 0x33, 0x60, 0xbc,
+	0x23, 0x93, 0x30, 0x6c, 0x53, 0x3f, 0xf0, 0xd4, 0x2e, 0xc8, 0x87, 0x6d, 0xc3, 0x3c, 0xd8, 0xef,
+	0x30, 0xeb, 0xdf, 0x23, 0x98, 0x48, 0x2c, 0xaa, 0x64, 0x9c, 0x86, 0x11, 0x41, 0x2a, 0xda, 0xa7,
+	0xae, 0x74, 0xa8, 0x92, 0x57, 0x37, 0xa8, 0xd7, 0xd5, 0x81, 0xbe, 0x12, 0x06, 0x9f, 0xd0, 0x32,
+	0xa7, 0xb6, 0xfc, 0x04, 0x2c, 0xc2, 0xfb, 0x3b, 0x51, 0x75, 0x0f, 0x5e, 0x6b, 0x03, 0xa8, 0xb4,
+	0x5f, 0x86, 0xe1, 0x90, 0xf0, 0x58, 0xfa, 0x1b, 0x1d, 0x8f, 0xac, 0x26, 0x90, 0x68, 0x47, 0x05,
+	0x40, 0x7c, 0xc3, 0x59, 0x94, 0x54, 0xdc, 0xcf, 0x5f, 0x9e, 0xfe, 0x3f, 0xd1, 0x0d, 0x97, 0x0a,
+	0xd8, 0x4a, 0x1f, 0xbd, 0x14, 0x7d, 0xbc, 0x0c, 0x20, 0x2e, 0x9a, 0x62, 0xdd, 0x28, 0xa9, 0x7d,
+	0xd4, 0x0c, 0xe9, 0xa2, 0x8c, 0xc8, 0x45, 0x19, 0x6b, 0x91, 0x8b, 0x5a, 0x1a, 0xab, 0x17, 0xdf,
+	0x7b, 0x96, 0x47, 0xd6, 0xb8, 0xa8, 0xab, 0xbf, 0xc1, 0x67, 0x60, 0x8c, 0xfa, 0xb6, 0x84, 0xd8,
+	0xd1, 0x03, 0xc4, 0x28, 0xf5, 0xed, 0x7a, 0x5c, 0x3f, 0x01, 0xfb, 0x95, 0xa9, 0xba, 0xe3, 0x51,
+	0x9f, 0xaf, 0xf2, 0x90, 0x12, 0x2f, 0x6a, 0xdf, 0x01, 0x18, 0x67, 0x22, 0xd0, 0x18, 0xd0, 0x31,
+	0x19, 0xb8, 0x62, 0xeb, 0x04, 0xb4, 0xb4, 0x4a, 0xd5, 0xa7, 0x65, 0x18, 0x91, 0x99, 0xaa, 0x51,
+	0xaf, 0x67, 0xbb, 0xb2, 0xa6, 0xf2, 0xe8, 0x53, 0x97, 0xa5, 0xfa, 0x5d, 0x94, 0xb6, 0x46, 0xbc,
+	0xbb, 0x07, 0x61, 0x3c, 0xa4, 0x65, 0xb7, 0xea, 0x52, 0x9f, 0xab, 0x5d, 0x6d, 0x04, 0x5e, 0xd9,
+	0x10, 0xff, 0x80, 0xe0, 0x40, 0x2a, 0x09, 0xa5, 0xf4, 0x02, 0x8c, 0x4a, 0xba, 0xd1, 0x27, 0xdd,
+	0x93, 0xd4, 0xa8, 0xf6, 0x95, 0x8d, 0xf5, 0xc2, 0x7d, 0x0c, 0xc3, 0x82, 0x2f, 0xfe, 0x06, 0xc1,
+	0x88, 0x34, 0xbd, 0xf8, 0x68, 0x16, 0xa7, 0x56, 0x9f, 0xad, 0xcd, 0x77, 0x95, 0x2b, 0x57, 0xd6,
+	0x8f, 0xdc, 0x7d, 0xf2, 0xe7, 0xb7, 0x83, 0xd3, 0x38, 0x67, 0x66, 0xd8, 0x7b, 0xe9, 0xb3, 0xf1,
+	0xbf, 0x08, 0x0e, 0xb4, 0x71, 0xaf, 0xf8, 0x4c, 0xdb, 0x45, 0x3b, 0xbb, 0x73, 0xed, 0x6c, 0xff,
+	0x00, 0x4a, 0x8a, 0x25, 0xa4, 0x5c, 0xc5, 0xef, 0x67, 0x49, 0x89, 0x4f, 0x0c, 0x66, 0x6e, 0xb6,
+	0x1c, 0x2b, 0x5b, 0x66, 0xd0, 0x80, 0x2e, 0x46, 0xf7, 0xe7, 0x13, 0x04, 0x13, 0x29, 0x86, 0x19,
+	0xbf, 0xd3, 0x1d, 0xdb, 0x16, 0xcf, 0xae, 0x9d, 0xe8, 0xbd, 0x50, 0xc9, 0xbb, 0x22, 0xe4, 0x2d,
+	0xe3, 0x73, 0x7d, 0xca, 0x6b, 0x18, 0x71, 0xfc, 0x2b, 0x82, 0x3d, 0xdb, 0x5d, 0x29, 0x7e, 0xbb,
+	0x3b, 0x66, 0x49, 0xcb, 0xae, 0x1d, 0xeb, 0xb1, 0x4a, 0x89, 0xb9, 0x28, 0xc4, 0x9c, 0xc5, 0xef,
+	0xf5, 0x29, 0x26, 0x72, 0xbd, 0x4f, 0x11, 0xec, 0x6d, 0xb1, 0x88, 0xb8, 0x3d, 0xa9, 0x2c, 0x5f,
+	0xab, 0x1d, 0xef, 0xb5, 0x4c, 0x89, 0xb9, 0x25, 0xc4, 0xac, 0x61, 0x2b, 0x4b, 0x4c, 0xec, 0x0d,
+	0x99, 0xb9, 0xd9, 0x62, 0x20, 0xb7, 0x4c, 0xf5, 0xb1, 0xa5, 0x09, 0xc5, 0xbf, 0x23, 0xd8, 0x97,
+	0x6e, 0x1b, 0xf1, 0xa9, 0x2e, 0xe9, 0xa6, 0x38, 0x59, 0xed, 0x74, 0x5f, 0xb5, 0xdd, 0x6e, 0x5e,
+	0x77, 0x7a, 0xf1, 0x2f, 0x08, 0x26, 0xd3, 0xbc, 0x06, 0x6e, 0x3f, 0x24, 0x6d, 0xfc, 0x8e, 0x76,
+	0xb2, 0x8f, 0x4a, 0xa5, 0xea, 0xb8, 0x50, 0xf5, 0x16, 0x36, 0x32, 0x4f, 0xc2, 0xa8, 0x5a, 0x9d,
+	0x0e, 0x45, 0xe9, 0x03, 0x9e, 0x22, 0x98, 0x48, 0x71, 0x1c, 0x1d, 0x8e, 0x88, 0x6c, 0xd3, 0xa3,
+	0x9d, 0xe8, 0xbd, 0x50, 0x49, 0x58, 0x13, 0x12, 0xae, 0xe1, 0xab, 0x7d, 0x4e, 0x55, 0xa8, 0xb0,
+	0x93, 0x02, 0xef, 0x23, 0x18, 0x16, 0x46, 0x16, 0xcf, 0xb5, 0x65, 0xd6, 0xfc, 0xd7, 0x40, 0x3b,
+	0xda, 0x4d, 0xaa, 0xa2, 0x5d, 0x10, 0xb4, 0xe7, 0xf1, 0x5c, 0x16, 0x6d, 0xe9, 0x9c, 0xcd, 0xcd,
+	0xe8, 0xcf, 0xc6, 0x96, 0xb8, 0x1a, 0x05, 0x48, 0xa7, 0xab, 0x31, 0xf1, 0x77, 0x41, 0x9b, 0xef,
+	0x2a, 0xb7, 0xdb, 0xab, 0x51, 0x19, 0xfa, 0x1f, 0x11, 0xec, 0x4a, 0x58, 0x03, 0x5c, 0xe8, 0x70,
+	0x03, 0xb7, 0x5a, 0x35, 0x6d, 0xa1, 0x97, 0x12, 0x45, 0xf0, 0xb4, 0x20, 0x78, 0x0c, 0x2f, 0x66,
+	0xdf, 0xdd, 0xa2, 0xac, 0xa8, 0x3c, 0x8a, 0xb9, 0x19, 0xbb, 0xc1, 0x2d, 0xfc, 0x10, 0xc1, 0xee,
+	0x04, 0x2c, 0xc3, 0x3d, 0x70, 0x88, 0x3b, 0xba, 0xd8, 0x53, 0x8d, 0x22, 0x6e, 0x0a, 0xe2, 0x73,
+	0x78, 0xa6, 0x4b, 0xe2, 0x4b, 0x57, 0x1f, 0x3d, 0xcf, 0xa1, 0xc7, 0xcf, 0x73, 0xe8, 0x8f, 0xe7,
+	0x39, 0x74, 0xef, 0x45, 0x6e, 0xe0, 0xf1, 0x8b, 0xdc, 0xc0, 0x6f, 0x2f, 0x72, 0x03, 0xb7, 0x16,
+	0x1c, 0x97, 0xaf, 0xd7, 0x4a, 0x46, 0x39, 0xf0, 0x14, 0xd8, 0x9b, 0x15, 0x52, 0x62, 0x11, 0xf0,
+	0x67, 0x49, 0x68, 0x7e, 0xa7, 0x4a, 0x59, 0x69, 0x44, 0x58, 0xeb, 0xc5, 0xff, 0x06, 0x00, 0xd3,
+	0xef, 0x5a, 0x87, 0x8f, 0x15, 0x00, 0x00,
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries params of the distribution module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ValidatorOutstandingRewards queries rewards of a validator address.
+	ValidatorOutstandingRewards(ctx context.Context, in *QueryValidatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardsResponse, error)
+	// ValidatorCommission queries accumulated commission for a validator.
+	ValidatorCommission(ctx context.Context, in *QueryValidatorCommissionRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionResponse, error)
+	// ValidatorSlashes queries slash events of a validator.
+	ValidatorSlashes(ctx context.Context, in *QueryValidatorSlashesRequest, opts ...grpc.CallOption) (*QueryValidatorSlashesResponse, error)
+	// DelegationRewards queries the total rewards accrued by a delegation.
+	DelegationRewards(ctx context.Context, in *QueryDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsResponse, error)
+	// DelegationTotalRewards queries the total rewards accrued by a each
+	// validator.
+	DelegationTotalRewards(ctx context.Context, in *QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsResponse, error)
+	// ProjectedRewardRates queries the annual reward rates of the bonded validators
+	// projected from the current annual provisions and reward weights.
+	ProjectedRewardRates(ctx context.Context, in *QueryProjectedRewardRatesRequest, opts ...grpc.CallOption) (*QueryProjectedRewardRatesResponse, error)
+	// RealizedRewardRates queries the annual reward rates of a validator realized
+	// since the oldest historical rewards period.
+	RealizedRewardRates(ctx context.Context, in *QueryRealizedRewardRatesRequest, opts ...grpc.CallOption) (*QueryRealizedRewardRatesResponse, error)
+	// Gauge queries an incentive gauge by id.
+	Gauge(ctx context.Context, in *QueryGaugeRequest, opts ...grpc.CallOption) (*QueryGaugeResponse, error)
+	// Gauges queries all incentive gauges, optionally filtered by the bond denom.
+	Gauges(ctx context.Context, in *QueryGaugesRequest, opts ...grpc.CallOption) (*QueryGaugesResponse, error)
+	// PaymentStream queries a community pool payment stream by id.
+	PaymentStream(ctx context.Context, in *QueryPaymentStreamRequest, opts ...grpc.CallOption) (*QueryPaymentStreamResponse, error)
+	// PaymentStreams queries all community pool payment streams, optionally
+	// filtered by the recipient.
+	PaymentStreams(ctx context.Context, in *QueryPaymentStreamsRequest, opts ...grpc.CallOption) (*QueryPaymentStreamsResponse, error)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryValidatorOutstandingRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Rewards.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryValidatorCommissionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) ValidatorOutstandingRewards(ctx context.Context, in *QueryValidatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardsResponse, error) {
+	out := new(QueryValidatorOutstandingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Query/ValidatorOutstandingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryValidatorCommissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ValidatorCommission(ctx context.Context, in *QueryValidatorCommissionRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionResponse, error) {
+	out := new(QueryValidatorCommissionResponse)
+	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Query/ValidatorCommission", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Commission.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryValidatorSlashesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.StartingHeight != 0 {
-		n += 1 + sovQuery(uint64(m.StartingHeight))
-	}
-	if m.EndingHeight != 0 {
-		n += 1 + sovQuery(uint64(m.EndingHeight))
+func (c *queryClient) ValidatorSlashes(ctx context.Context, in *QueryValidatorSlashesRequest, opts ...grpc.CallOption) (*QueryValidatorSlashesResponse, error) {
+	out := new(QueryValidatorSlashesResponse)
+	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Query/ValidatorSlashes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) DelegationRewards(ctx context.Context, in *QueryDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsResponse, error) {
+	out := new(QueryDelegationRewardsResponse)
+	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Query/DelegationRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryValidatorSlashesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) DelegationTotalRewards(ctx context.Context, in *QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsResponse, error) {
+	out := new(QueryDelegationTotalRewardsResponse)
+	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Query/DelegationTotalRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Slashes) > 0 {
-		for _, e := range m.Slashes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) ProjectedRewardRates(ctx context.Context, in *QueryProjectedRewardRatesRequest, opts ...grpc.CallOption) (*QueryProjectedRewardRatesResponse, error) {
+	out := new(QueryProjectedRewardRatesResponse)
+	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Query/ProjectedRewardRates", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) RealizedRewardRates(ctx context.Context, in *QueryRealizedRewardRatesRequest, opts ...grpc.CallOption) (*QueryRealizedRewardRatesResponse, error) {
+	out := new(QueryRealizedRewardRatesResponse)
+	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Query/RealizedRewardRates", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryDelegationRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Gauge(ctx context.Context, in *QueryGaugeRequest, opts ...grpc.CallOption) (*QueryGaugeResponse, error) {
+	out := new(QueryGaugeResponse)
+	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Query/Gauge", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) Gauges(ctx context.Context, in *QueryGaugesRequest, opts ...grpc.CallOption) (*QueryGaugesResponse, error) {
+	out := new(QueryGaugesResponse)
+	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Query/Gauges", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) PaymentStream(ctx context.Context, in *QueryPaymentStreamRequest, opts ...grpc.CallOption) (*QueryPaymentStreamResponse, error) {
+	out := new(QueryPaymentStreamResponse)
+	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Query/PaymentStream", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryDelegationRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) PaymentStreams(ctx context.Context, in *QueryPaymentStreamsRequest, opts ...grpc.CallOption) (*QueryPaymentStreamsResponse, error) {
+	out := new(QueryPaymentStreamsResponse)
+	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Query/PaymentStreams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries params of the distribution module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ValidatorOutstandingRewards queries rewards of a validator address.
+	ValidatorOutstandingRewards(context.Context, *QueryValidatorOutstandingRewardsRequest) (*QueryValidatorOutstandingRewardsResponse, error)
+	// ValidatorCommission queries accumulated commission for a validator.
+	ValidatorCommission(context.Context, *QueryValidatorCommissionRequest) (*QueryValidatorCommissionResponse, error)
+	// ValidatorSlashes queries slash events of a validator.
+	ValidatorSlashes(context.Context, *QueryValidatorSlashesRequest) (*QueryValidatorSlashesResponse, error)
+	// DelegationRewards queries the total rewards accrued by a delegation.
+	DelegationRewards(context.Context, *QueryDelegationRewardsRequest) (*QueryDelegationRewardsResponse, error)
+	// DelegationTotalRewards queries the total rewards accrued by a each
+	// validator.
+	DelegationTotalRewards(context.Context, *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error)
+	// ProjectedRewardRates queries the annual reward rates of the bonded validators
+	// projected from the current annual provisions and reward weights.
+	ProjectedRewardRates(context.Context, *QueryProjectedRewardRatesRequest) (*QueryProjectedRewardRatesResponse, error)
+	// RealizedRewardRates queries the annual reward rates of a validator realized
+	// since the oldest historical rewards period.
+	RealizedRewardRates(context.Context, *QueryRealizedRewardRatesRequest) (*QueryRealizedRewardRatesResponse, error)
+	// Gauge queries an incentive gauge by id.
+	Gauge(context.Context, *QueryGaugeRequest) (*QueryGaugeResponse, error)
+	// Gauges queries all incentive gauges, optionally filtered by the bond denom.
+	Gauges(context.Context, *QueryGaugesRequest) (*QueryGaugesResponse, error)
+	// PaymentStream queries a community pool payment stream by id.
+	PaymentStream(context.Context, *QueryPaymentStreamRequest) (*QueryPaymentStreamResponse, error)
+	// PaymentStreams queries all community pool payment streams, optionally
+	// filtered by the recipient.
+	PaymentStreams(context.Context, *QueryPaymentStreamsRequest) (*QueryPaymentStreamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorOutstandingRewards(ctx context.Context, req *QueryValidatorOutstandingRewardsRequest) (*QueryValidatorOutstandingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorOutstandingRewards not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorCommission(ctx context.Context, req *QueryValidatorCommissionRequest) (*QueryValidatorCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorCommission not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorSlashes(ctx context.Context, req *QueryValidatorSlashesRequest) (*QueryValidatorSlashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSlashes not implemented")
+}
+func (*UnimplementedQueryServer) DelegationRewards(ctx context.Context, req *QueryDelegationRewardsRequest) (*QueryDelegationRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationRewards not implemented")
+}
+func (*UnimplementedQueryServer) DelegationTotalRewards(ctx context.Context, req *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationTotalRewards not implemented")
+}
+func (*UnimplementedQueryServer) ProjectedRewardRates(ctx context.Context, req *QueryProjectedRewardRatesRequest) (*QueryProjectedRewardRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedRewardRates not implemented")
+}
+func (*UnimplementedQueryServer) RealizedRewardRates(ctx context.Context, req *QueryRealizedRewardRatesRequest) (*QueryRealizedRewardRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RealizedRewardRates not implemented")
+}
+func (*UnimplementedQueryServer) Gauge(ctx context.Context, req *QueryGaugeRequest) (*QueryGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Gauge not implemented")
+}
+func (*UnimplementedQueryServer) Gauges(ctx context.Context, req *QueryGaugesRequest) (*QueryGaugesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Gauges not implemented")
+}
+func (*UnimplementedQueryServer) PaymentStream(ctx context.Context, req *QueryPaymentStreamRequest) (*QueryPaymentStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentStream not implemented")
+}
+func (*UnimplementedQueryServer) PaymentStreams(ctx context.Context, req *QueryPaymentStreamsRequest) (*QueryPaymentStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentStreams not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.distribution.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorOutstandingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorOutstandingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorOutstandingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.distribution.v1.Query/ValidatorOutstandingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorOutstandingRewards(ctx, req.(*QueryValidatorOutstandingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorCommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.distribution.v1.Query/ValidatorCommission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorCommission(ctx, req.(*QueryValidatorCommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorSlashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorSlashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSlashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.distribution.v1.Query/ValidatorSlashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSlashes(ctx, req.(*QueryValidatorSlashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.distribution.v1.Query/DelegationRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationRewards(ctx, req.(*QueryDelegationRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationTotalRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationTotalRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationTotalRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.distribution.v1.Query/DelegationTotalRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationTotalRewards(ctx, req.(*QueryDelegationTotalRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectedRewardRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedRewardRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedRewardRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.distribution.v1.Query/ProjectedRewardRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedRewardRates(ctx, req.(*QueryProjectedRewardRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RealizedRewardRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRealizedRewardRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RealizedRewardRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.distribution.v1.Query/RealizedRewardRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RealizedRewardRates(ctx, req.(*QueryRealizedRewardRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Gauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGaugeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Gauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.distribution.v1.Query/Gauge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Gauge(ctx, req.(*QueryGaugeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Gauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGaugesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Gauges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.distribution.v1.Query/Gauges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Gauges(ctx, req.(*QueryGaugesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.distribution.v1.Query/PaymentStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentStream(ctx, req.(*QueryPaymentStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentStreamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.distribution.v1.Query/PaymentStreams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentStreams(ctx, req.(*QueryPaymentStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "initia.distribution.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ValidatorOutstandingRewards",
+			Handler:    _Query_ValidatorOutstandingRewards_Handler,
+		},
+		{
+			MethodName: "ValidatorCommission",
+			Handler:    _Query_ValidatorCommission_Handler,
+		},
+		{
+			MethodName: "ValidatorSlashes",
+			Handler:    _Query_ValidatorSlashes_Handler,
+		},
+		{
+			MethodName: "DelegationRewards",
+			Handler:    _Query_DelegationRewards_Handler,
+		},
+		{
+			MethodName: "DelegationTotalRewards",
+			Handler:    _Query_DelegationTotalRewards_Handler,
+		},
+		{
+			MethodName: "ProjectedRewardRates",
+			Handler:    _Query_ProjectedRewardRates_Handler,
+		},
+		{
+			MethodName: "RealizedRewardRates",
+			Handler:    _Query_RealizedRewardRates_Handler,
+		},
+		{
+			MethodName: "Gauge",
+			Handler:    _Query_Gauge_Handler,
+		},
+		{
+			MethodName: "Gauges",
+			Handler:    _Query_Gauges_Handler,
+		},
+		{
+			MethodName: "PaymentStream",
+			Handler:    _Query_PaymentStream_Handler,
+		},
+		{
+			MethodName: "PaymentStreams",
+			Handler:    _Query_PaymentStreams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "initia/distribution/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOutstandingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOutstandingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOutstandingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOutstandingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOutstandingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOutstandingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorCommissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorCommissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorCommissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorSlashesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorSlashesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorSlashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndingHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndingHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartingHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartingHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorSlashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorSlashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorSlashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Slashes) > 0 {
+		for iNdEx := len(m.Slashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationTotalRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationTotalRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationTotalRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationTotalRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationTotalRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationTotalRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGaugeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGaugeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGaugeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGaugeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Gauge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGaugesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGaugesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGaugesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGaugesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGaugesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Gauges) > 0 {
+		for iNdEx := len(m.Gauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedRewardRatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedRewardRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedRewardRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedRewardRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedRewardRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedRewardRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rates) > 0 {
+		for iNdEx := len(m.Rates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRealizedRewardRatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRealizedRewardRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRealizedRewardRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRealizedRewardRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRealizedRewardRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRealizedRewardRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintQuery(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Rates.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentStreamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentStreamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentStreamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentStreamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentStreamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorOutstandingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorCommissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Commission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorSlashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartingHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartingHeight))
+	}
+	if m.EndingHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndingHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorSlashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Slashes) > 0 {
+		for _, e := range m.Slashes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegationTotalRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationTotalRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if len(m.Rewards) > 0 {
 		for _, e := range m.Rewards {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGaugeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		n += 1 + sovQuery(uint64(m.GaugeId))
+	}
+	return n
+}
+
+func (m *QueryGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Gauge.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGaugesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGaugesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Gauges) > 0 {
+		for _, e := range m.Gauges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProjectedRewardRatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProjectedRewardRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rates) > 0 {
+		for _, e := range m.Rates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRealizedRewardRatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRealizedRewardRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rates.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPaymentStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovQuery(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *QueryPaymentStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stream.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPaymentStreamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentStreamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorCommissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorCommissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorCommissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorSlashesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorSlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingHeight", wireType)
+			}
+			m.StartingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartingHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndingHeight", wireType)
+			}
+			m.EndingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndingHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorSlashesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorSlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashes = append(m.Slashes, ValidatorSlashEvent{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, DecPool{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryDelegationTotalRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryDelegationTotalRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if len(m.Total) > 0 {
-		for _, e := range m.Total {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, DelegationDelegatorReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, DecPool{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGaugeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1598,12 +4246,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGaugeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGaugeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1625,7 +4292,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGaugeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1648,15 +4315,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGaugeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1683,7 +4350,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1708,7 +4375,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGaugesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1731,15 +4398,15 @@ func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGaugesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1767,7 +4434,43 @@ func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1790,7 +4493,7 @@ func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGaugesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1813,15 +4516,49 @@ func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGaugesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gauges = append(m.Gauges, Gauge{})
+			if err := m.Gauges[len(m.Gauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1848,7 +4585,10 @@ func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1873,7 +4613,7 @@ func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProjectedRewardRatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1896,10 +4636,10 @@ func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorCommissionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProjectedRewardRatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorCommissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProjectedRewardRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1955,7 +4695,7 @@ func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProjectedRewardRatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1978,15 +4718,15 @@ func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorCommissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProjectedRewardRatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProjectedRewardRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2013,7 +4753,8 @@ func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rates = append(m.Rates, ValidatorRewardRates{})
+			if err := m.Rates[len(m.Rates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2038,7 +4779,7 @@ func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRealizedRewardRatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2061,10 +4802,10 @@ func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorSlashesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRealizedRewardRatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorSlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRealizedRewardRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2099,80 +4840,6 @@ func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingHeight", wireType)
-			}
-			m.StartingHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartingHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndingHeight", wireType)
-			}
-			m.EndingHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndingHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2194,7 +4861,7 @@ func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRealizedRewardRatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2217,15 +4884,15 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorSlashesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRealizedRewardRatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorSlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRealizedRewardRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2252,14 +4919,13 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Slashes = append(m.Slashes, ValidatorSlashEvent{})
-			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2286,10 +4952,40 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2314,7 +5010,7 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2337,49 +5033,17 @@ func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			var stringLen uint64
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2389,24 +5053,11 @@ func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2428,7 +5079,7 @@ func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2451,15 +5102,15 @@ func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2486,8 +5137,7 @@ func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, DecPool{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2512,7 +5162,7 @@ func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentStreamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2535,15 +5185,15 @@ func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationTotalRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentStreamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationTotalRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2571,7 +5221,43 @@ func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2594,7 +5280,7 @@ func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentStreamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2617,15 +5303,15 @@ func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationTotalRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentStreamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationTotalRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2652,14 +5338,14 @@ func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, DelegationDelegatorReward{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Streams = append(m.Streams, PaymentStream{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2686,8 +5372,10 @@ func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Total = append(m.Total, DecPool{})
-			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
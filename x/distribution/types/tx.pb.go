@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -14,18 +15,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -214,54 +220,567 @@ func (m *MsgDepositValidatorRewardsPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDepositValidatorRewardsPoolResponse proto.InternalMessageInfo
 
+// MsgCreateGauge defines the request structure to create an incentive gauge
+// for the reward pool of a bond denom.
+type MsgCreateGauge struct {
+	Depositor string `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// denom is the bond denom of the reward pool to incentivize.
+	Denom  string                                   `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// start_time is the time to start releasing the coins; the past time
+	// is replaced with the current block time.
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// num_epochs is the number of epochs over which the coins are released.
+	NumEpochs     uint64        `protobuf:"varint,5,opt,name=num_epochs,json=numEpochs,proto3" json:"num_epochs,omitempty"`
+	EpochDuration time.Duration `protobuf:"bytes,6,opt,name=epoch_duration,json=epochDuration,proto3,stdduration" json:"epoch_duration"`
+}
+
+func (m *MsgCreateGauge) Reset()         { *m = MsgCreateGauge{} }
+func (m *MsgCreateGauge) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateGauge) ProtoMessage()    {}
+func (*MsgCreateGauge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7186433ac180476e, []int{4}
+}
+func (m *MsgCreateGauge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateGauge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateGauge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateGauge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateGauge.Merge(m, src)
+}
+func (m *MsgCreateGauge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateGauge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateGauge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateGauge proto.InternalMessageInfo
+
+// MsgCreateGaugeResponse defines the response to executing a
+// MsgCreateGauge message.
+type MsgCreateGaugeResponse struct {
+	GaugeId uint64 `protobuf:"varint,1,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty"`
+}
+
+func (m *MsgCreateGaugeResponse) Reset()         { *m = MsgCreateGaugeResponse{} }
+func (m *MsgCreateGaugeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateGaugeResponse) ProtoMessage()    {}
+func (*MsgCreateGaugeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7186433ac180476e, []int{5}
+}
+func (m *MsgCreateGaugeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateGaugeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateGaugeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateGaugeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateGaugeResponse.Merge(m, src)
+}
+func (m *MsgCreateGaugeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateGaugeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateGaugeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateGaugeResponse proto.InternalMessageInfo
+
+func (m *MsgCreateGaugeResponse) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
+}
+
+// MsgWithdrawAllDelegatorRewards defines the request structure to withdraw
+// the rewards of all the delegations of a delegator.
+type MsgWithdrawAllDelegatorRewards struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_addresses restricts the withdrawal to the delegations to the
+	// given validators; empty means all the delegations.
+	ValidatorAddresses []string `protobuf:"bytes,2,rep,name=validator_addresses,json=validatorAddresses,proto3" json:"validator_addresses,omitempty"`
+	// start_validator_address is the validator address to resume the withdrawal
+	// from, which is returned by the previous batch.
+	StartValidatorAddress string `protobuf:"bytes,3,opt,name=start_validator_address,json=startValidatorAddress,proto3" json:"start_validator_address,omitempty"`
+	// limit is the maximum number of delegations to withdraw in this batch;
+	// zero means the maximum batch size.
+	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *MsgWithdrawAllDelegatorRewards) Reset()         { *m = MsgWithdrawAllDelegatorRewards{} }
+func (m *MsgWithdrawAllDelegatorRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawAllDelegatorRewards) ProtoMessage()    {}
+func (*MsgWithdrawAllDelegatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7186433ac180476e, []int{6}
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawAllDelegatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawAllDelegatorRewards.Merge(m, src)
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawAllDelegatorRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawAllDelegatorRewards proto.InternalMessageInfo
+
+// MsgWithdrawAllDelegatorRewardsResponse defines the response to executing a
+// MsgWithdrawAllDelegatorRewards message.
+type MsgWithdrawAllDelegatorRewardsResponse struct {
+	// amount is the withdrawn rewards aggregated per pool.
+	Amount DecPools `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=DecPools" json:"amount"`
+	// next_validator_address is the validator address to resume the withdrawal
+	// from when the batch is bounded by the limit or the gas; empty if all the
+	// delegations are withdrawn.
+	NextValidatorAddress string `protobuf:"bytes,2,opt,name=next_validator_address,json=nextValidatorAddress,proto3" json:"next_validator_address,omitempty"`
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) Reset() {
+	*m = MsgWithdrawAllDelegatorRewardsResponse{}
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawAllDelegatorRewardsResponse) ProtoMessage()    {}
+func (*MsgWithdrawAllDelegatorRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7186433ac180476e, []int{7}
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawAllDelegatorRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawAllDelegatorRewardsResponse.Merge(m, src)
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawAllDelegatorRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawAllDelegatorRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) GetAmount() DecPools {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) GetNextValidatorAddress() string {
+	if m != nil {
+		return m.NextValidatorAddress
+	}
+	return ""
+}
+
+// MsgCreatePaymentStream defines the request structure to create a payment
+// stream from the community pool. Exactly one of total and rate must be set.
+type MsgCreatePaymentStream struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Denom     string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// total is the total amount to be paid over the stream duration.
+	Total cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=total,proto3,customtype=cosmossdk.io/math.Int" json:"total"`
+	// rate is the amount to be paid per second.
+	Rate cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=rate,proto3,customtype=cosmossdk.io/math.Int" json:"rate"`
+	// start_time is the time to start the payment; the past time is replaced
+	// with the current block time.
+	StartTime time.Time `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime   time.Time `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *MsgCreatePaymentStream) Reset()         { *m = MsgCreatePaymentStream{} }
+func (m *MsgCreatePaymentStream) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePaymentStream) ProtoMessage()    {}
+func (*MsgCreatePaymentStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7186433ac180476e, []int{8}
+}
+func (m *MsgCreatePaymentStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePaymentStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePaymentStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreatePaymentStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePaymentStream.Merge(m, src)
+}
+func (m *MsgCreatePaymentStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePaymentStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePaymentStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreatePaymentStream proto.InternalMessageInfo
+
+// MsgCreatePaymentStreamResponse defines the response to executing a
+// MsgCreatePaymentStream message.
+type MsgCreatePaymentStreamResponse struct {
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *MsgCreatePaymentStreamResponse) Reset()         { *m = MsgCreatePaymentStreamResponse{} }
+func (m *MsgCreatePaymentStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePaymentStreamResponse) ProtoMessage()    {}
+func (*MsgCreatePaymentStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7186433ac180476e, []int{9}
+}
+func (m *MsgCreatePaymentStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePaymentStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePaymentStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreatePaymentStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePaymentStreamResponse.Merge(m, src)
+}
+func (m *MsgCreatePaymentStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePaymentStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePaymentStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreatePaymentStreamResponse proto.InternalMessageInfo
+
+func (m *MsgCreatePaymentStreamResponse) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+// MsgClaimPaymentStream defines the request structure to claim the released
+// amount of a payment stream.
+type MsgClaimPaymentStream struct {
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	StreamId  uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *MsgClaimPaymentStream) Reset()         { *m = MsgClaimPaymentStream{} }
+func (m *MsgClaimPaymentStream) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimPaymentStream) ProtoMessage()    {}
+func (*MsgClaimPaymentStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7186433ac180476e, []int{10}
+}
+func (m *MsgClaimPaymentStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimPaymentStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimPaymentStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimPaymentStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimPaymentStream.Merge(m, src)
+}
+func (m *MsgClaimPaymentStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimPaymentStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimPaymentStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimPaymentStream proto.InternalMessageInfo
+
+// MsgClaimPaymentStreamResponse defines the response to executing a
+// MsgClaimPaymentStream message.
+type MsgClaimPaymentStreamResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgClaimPaymentStreamResponse) Reset()         { *m = MsgClaimPaymentStreamResponse{} }
+func (m *MsgClaimPaymentStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimPaymentStreamResponse) ProtoMessage()    {}
+func (*MsgClaimPaymentStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7186433ac180476e, []int{11}
+}
+func (m *MsgClaimPaymentStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimPaymentStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimPaymentStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimPaymentStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimPaymentStreamResponse.Merge(m, src)
+}
+func (m *MsgClaimPaymentStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimPaymentStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimPaymentStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimPaymentStreamResponse proto.InternalMessageInfo
+
+func (m *MsgClaimPaymentStreamResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgCancelPaymentStream defines the request structure to cancel a payment
+// stream.
+type MsgCancelPaymentStream struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	StreamId  uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *MsgCancelPaymentStream) Reset()         { *m = MsgCancelPaymentStream{} }
+func (m *MsgCancelPaymentStream) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPaymentStream) ProtoMessage()    {}
+func (*MsgCancelPaymentStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7186433ac180476e, []int{12}
+}
+func (m *MsgCancelPaymentStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPaymentStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPaymentStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelPaymentStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPaymentStream.Merge(m, src)
+}
+func (m *MsgCancelPaymentStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPaymentStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPaymentStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelPaymentStream proto.InternalMessageInfo
+
+// MsgCancelPaymentStreamResponse defines the response to executing a
+// MsgCancelPaymentStream message.
+type MsgCancelPaymentStreamResponse struct {
+}
+
+func (m *MsgCancelPaymentStreamResponse) Reset()         { *m = MsgCancelPaymentStreamResponse{} }
+func (m *MsgCancelPaymentStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPaymentStreamResponse) ProtoMessage()    {}
+func (*MsgCancelPaymentStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7186433ac180476e, []int{13}
+}
+func (m *MsgCancelPaymentStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPaymentStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPaymentStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelPaymentStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPaymentStreamResponse.Merge(m, src)
+}
+func (m *MsgCancelPaymentStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPaymentStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPaymentStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelPaymentStreamResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "initia.distribution.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "initia.distribution.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgDepositValidatorRewardsPool)(nil), "initia.distribution.v1.MsgDepositValidatorRewardsPool")
 	proto.RegisterType((*MsgDepositValidatorRewardsPoolResponse)(nil), "initia.distribution.v1.MsgDepositValidatorRewardsPoolResponse")
+	proto.RegisterType((*MsgCreateGauge)(nil), "initia.distribution.v1.MsgCreateGauge")
+	proto.RegisterType((*MsgCreateGaugeResponse)(nil), "initia.distribution.v1.MsgCreateGaugeResponse")
+	proto.RegisterType((*MsgWithdrawAllDelegatorRewards)(nil), "initia.distribution.v1.MsgWithdrawAllDelegatorRewards")
+	proto.RegisterType((*MsgWithdrawAllDelegatorRewardsResponse)(nil), "initia.distribution.v1.MsgWithdrawAllDelegatorRewardsResponse")
+	proto.RegisterType((*MsgCreatePaymentStream)(nil), "initia.distribution.v1.MsgCreatePaymentStream")
+	proto.RegisterType((*MsgCreatePaymentStreamResponse)(nil), "initia.distribution.v1.MsgCreatePaymentStreamResponse")
+	proto.RegisterType((*MsgClaimPaymentStream)(nil), "initia.distribution.v1.MsgClaimPaymentStream")
+	proto.RegisterType((*MsgClaimPaymentStreamResponse)(nil), "initia.distribution.v1.MsgClaimPaymentStreamResponse")
+	proto.RegisterType((*MsgCancelPaymentStream)(nil), "initia.distribution.v1.MsgCancelPaymentStream")
+	proto.RegisterType((*MsgCancelPaymentStreamResponse)(nil), "initia.distribution.v1.MsgCancelPaymentStreamResponse")
 }
 
 func init() { proto.RegisterFile("initia/distribution/v1/tx.proto", fileDescriptor_7186433ac180476e) }
 
 var fileDescriptor_7186433ac180476e = []byte{
-	// 588 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xb1, 0x8f, 0x12, 0x4f,
-	0x14, 0xde, 0x85, 0xdf, 0x91, 0x30, 0x77, 0xc9, 0xcf, 0xdb, 0x10, 0x0f, 0x50, 0x17, 0x24, 0x46,
-	0x91, 0x84, 0x9d, 0x80, 0x89, 0xc5, 0x15, 0x9a, 0x43, 0x63, 0x87, 0x21, 0x18, 0x2d, 0x6c, 0xc8,
-	0x2c, 0x3b, 0x59, 0x26, 0xb2, 0x3b, 0x9b, 0x7d, 0x03, 0x1e, 0x9d, 0xb1, 0x32, 0x16, 0xc6, 0xc6,
-	0xc6, 0xea, 0xca, 0x8b, 0x15, 0xc5, 0xb5, 0xf6, 0x57, 0x5e, 0xae, 0xb2, 0x52, 0x03, 0x05, 0xfe,
-	0x0d, 0x56, 0x66, 0x77, 0x06, 0xee, 0x20, 0x1e, 0x1a, 0x1b, 0xd8, 0x79, 0xef, 0x7b, 0xef, 0x7b,
-	0x6f, 0xbe, 0x6f, 0x50, 0x81, 0xf9, 0x4c, 0x30, 0x82, 0x1d, 0x06, 0x22, 0x64, 0xf6, 0x40, 0x30,
-	0xee, 0xe3, 0x61, 0x0d, 0x8b, 0x7d, 0x2b, 0x08, 0xb9, 0xe0, 0xc6, 0x65, 0x09, 0xb0, 0xce, 0x03,
-	0xac, 0x61, 0x2d, 0xbf, 0x4d, 0x3c, 0xe6, 0x73, 0x1c, 0xff, 0x4a, 0x68, 0xde, 0xec, 0x72, 0xf0,
-	0x38, 0x60, 0x9b, 0x00, 0xc5, 0xc3, 0x9a, 0x4d, 0x05, 0xa9, 0xe1, 0x2e, 0x67, 0xbe, 0xca, 0xef,
-	0xa8, 0xbc, 0x07, 0x6e, 0x44, 0xe1, 0x81, 0xab, 0x12, 0x39, 0x99, 0xe8, 0xc4, 0x27, 0x2c, 0x0f,
-	0x2a, 0x95, 0x71, 0xb9, 0xcb, 0x65, 0x3c, 0xfa, 0x52, 0xd1, 0xdb, 0x17, 0x4c, 0xbd, 0x34, 0x64,
-	0x0c, 0x2d, 0x7d, 0xd6, 0xd1, 0xff, 0x4d, 0x70, 0x9f, 0x06, 0x0e, 0x11, 0xb4, 0x45, 0x42, 0xe2,
-	0x81, 0x71, 0x17, 0xa5, 0xc9, 0x40, 0xf4, 0x78, 0xc8, 0xc4, 0x28, 0xab, 0x17, 0xf5, 0x72, 0xba,
-	0x91, 0x3d, 0x3d, 0xaa, 0x66, 0x14, 0xf3, 0x9e, 0xe3, 0x84, 0x14, 0xe0, 0x89, 0x08, 0x99, 0xef,
-	0xb6, 0xcf, 0xa0, 0xc6, 0x1e, 0x4a, 0x05, 0x71, 0x87, 0x6c, 0xa2, 0xa8, 0x97, 0x37, 0xeb, 0xa6,
-	0xf5, 0xfb, 0xcb, 0xb1, 0x24, 0x4f, 0x23, 0x7d, 0xfc, 0xb5, 0xa0, 0x1d, 0xce, 0xc6, 0x15, 0xbd,
-	0xad, 0x0a, 0x77, 0xf1, 0xeb, 0xd9, 0xb8, 0x72, 0xd6, 0xf2, 0xed, 0x6c, 0x5c, 0xb9, 0xba, 0xb4,
-	0xc5, 0xca, 0xac, 0xa5, 0x1c, 0xda, 0x59, 0x09, 0xb5, 0x29, 0x04, 0xdc, 0x07, 0x5a, 0xfa, 0x99,
-	0x40, 0x66, 0x13, 0xdc, 0x87, 0x34, 0xe0, 0xc0, 0xc4, 0x33, 0xd2, 0x67, 0x0e, 0x11, 0x3c, 0x6c,
-	0xd3, 0x97, 0x24, 0x74, 0xa0, 0xc5, 0x79, 0x3f, 0xda, 0xd4, 0x91, 0x69, 0x1e, 0xfe, 0x79, 0xd3,
-	0x05, 0xd4, 0x78, 0x8c, 0xb6, 0x87, 0xf3, 0x7e, 0x1d, 0x22, 0x51, 0xf1, 0xd2, 0xe9, 0xc6, 0xf5,
-	0xd3, 0xa3, 0xea, 0x35, 0x55, 0xbf, 0xe0, 0x5c, 0x6e, 0x74, 0x69, 0xb8, 0x12, 0x37, 0x32, 0x68,
-	0xc3, 0xa1, 0x3e, 0xf7, 0xb2, 0xc9, 0xa8, 0x47, 0x5b, 0x1e, 0x8c, 0x11, 0x4a, 0x11, 0x8f, 0x0f,
-	0x7c, 0x91, 0xfd, 0xaf, 0x98, 0x2c, 0x6f, 0xd6, 0x73, 0x96, 0xea, 0x1b, 0x39, 0xc8, 0x52, 0x0e,
-	0xb2, 0x1e, 0x70, 0xe6, 0x37, 0x1e, 0x45, 0x57, 0xf9, 0xe9, 0x5b, 0xa1, 0xec, 0x32, 0xd1, 0x1b,
-	0xd8, 0x56, 0x97, 0x7b, 0xca, 0x28, 0xea, 0xaf, 0x0a, 0xce, 0x0b, 0x2c, 0x46, 0x01, 0x85, 0xb8,
-	0x00, 0x3e, 0xce, 0xc6, 0x95, 0xad, 0x3e, 0x75, 0x49, 0x77, 0xd4, 0x89, 0x3c, 0x08, 0x4a, 0x07,
-	0x49, 0xb8, 0x7b, 0xff, 0xcd, 0x41, 0x41, 0xfb, 0x71, 0x50, 0xd0, 0x62, 0x3d, 0x16, 0x8b, 0x47,
-	0x7a, 0xdc, 0x88, 0xf5, 0xc0, 0xeb, 0x6f, 0xb6, 0x54, 0x46, 0x37, 0xd7, 0x23, 0xe6, 0x32, 0xd5,
-	0xdf, 0x25, 0x50, 0xb2, 0x09, 0xae, 0xd1, 0x43, 0x5b, 0x4b, 0x2e, 0xbc, 0x75, 0x91, 0x7b, 0x56,
-	0xf4, 0xce, 0xe3, 0xbf, 0x04, 0xce, 0x19, 0x8d, 0x0f, 0x3a, 0xba, 0xb2, 0xd6, 0x15, 0x6b, 0x1a,
-	0xae, 0xa9, 0xcb, 0xdf, 0xfb, 0xb7, 0xba, 0xf9, 0x5c, 0xf9, 0x8d, 0x57, 0x91, 0x06, 0x8d, 0xd6,
-	0xe1, 0xc4, 0xd4, 0x8f, 0x27, 0xa6, 0x7e, 0x32, 0x31, 0xf5, 0xef, 0x13, 0x53, 0x7f, 0x3f, 0x35,
-	0xb5, 0x93, 0xa9, 0xa9, 0x7d, 0x99, 0x9a, 0xda, 0xf3, 0xfa, 0x39, 0x85, 0x25, 0x5d, 0xb5, 0x4f,
-	0x6c, 0x50, 0xdf, 0x78, 0x7f, 0xf9, 0xd1, 0xc7, 0x8a, 0xdb, 0xa9, 0xf8, 0xad, 0xdf, 0xf9, 0x15,
-	0x00, 0x00, 0xff, 0xff, 0x28, 0xba, 0x51, 0xb8, 0xce, 0x04, 0x00, 0x00,
+	// 1223 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xc6, 0xf9, 0xe5, 0xe9, 0x8f, 0x6f, 0xb3, 0xcd, 0x0f, 0xc7, 0xf9, 0x66, 0x1d, 0x56,
+	0x28, 0x98, 0x48, 0xde, 0x25, 0xa9, 0x08, 0x92, 0x25, 0x8a, 0xe2, 0xba, 0x2d, 0x39, 0xa4, 0x44,
+	0x2e, 0xb4, 0x82, 0x8b, 0x35, 0xf6, 0x0e, 0xeb, 0x51, 0x77, 0x77, 0xac, 0x9d, 0x71, 0x1a, 0x23,
+	0x21, 0x21, 0x2e, 0x20, 0x4e, 0xbd, 0x20, 0xa1, 0x9e, 0x7a, 0x40, 0xa8, 0xe2, 0x94, 0x43, 0xae,
+	0xdc, 0x38, 0xf4, 0x58, 0x72, 0x42, 0x1c, 0x5a, 0x94, 0x1c, 0xc2, 0x3f, 0xd0, 0x0b, 0x27, 0xb4,
+	0x33, 0xbb, 0x6b, 0xaf, 0xbd, 0xd9, 0x38, 0x01, 0xf5, 0xd2, 0x7a, 0xde, 0xfb, 0xbc, 0xf7, 0xe6,
+	0xfd, 0xf8, 0xbc, 0x9d, 0x80, 0x3c, 0x76, 0x30, 0xc3, 0x50, 0x37, 0x30, 0x65, 0x2e, 0xae, 0xb7,
+	0x19, 0x26, 0x8e, 0xbe, 0xb3, 0xaa, 0xb3, 0x5d, 0xad, 0xe5, 0x12, 0x46, 0xe4, 0x59, 0x01, 0xd0,
+	0x7a, 0x01, 0xda, 0xce, 0x6a, 0x6e, 0x0a, 0xda, 0xd8, 0x21, 0x3a, 0xff, 0x57, 0x40, 0x73, 0x4a,
+	0x83, 0x50, 0x9b, 0x50, 0xbd, 0x0e, 0x29, 0xd2, 0x77, 0x56, 0xeb, 0x88, 0xc1, 0x55, 0xbd, 0x41,
+	0xb0, 0xe3, 0xeb, 0xe7, 0x7c, 0xbd, 0x4d, 0x4d, 0x2f, 0x84, 0x4d, 0x4d, 0x5f, 0x31, 0x2f, 0x14,
+	0x35, 0x7e, 0xd2, 0xc5, 0xc1, 0x57, 0x4d, 0x9b, 0xc4, 0x24, 0x42, 0xee, 0xfd, 0x0a, 0x22, 0x99,
+	0x84, 0x98, 0x16, 0xd2, 0xf9, 0xa9, 0xde, 0xfe, 0x5c, 0x37, 0xda, 0x2e, 0xe4, 0x37, 0x13, 0xfa,
+	0x7c, 0xbf, 0x9e, 0x61, 0x1b, 0x51, 0x06, 0xed, 0x96, 0x0f, 0x78, 0xfb, 0x84, 0xb4, 0x23, 0x59,
+	0x72, 0xa8, 0xfa, 0x8b, 0x04, 0xfe, 0xb7, 0x45, 0xcd, 0x4f, 0x5a, 0x06, 0x64, 0x68, 0x1b, 0xba,
+	0xd0, 0xa6, 0xf2, 0x3a, 0xc8, 0xc0, 0x36, 0x6b, 0x12, 0x17, 0xb3, 0x4e, 0x56, 0x5a, 0x92, 0x0a,
+	0x99, 0x72, 0xf6, 0x60, 0xbf, 0x38, 0xed, 0x5f, 0x7d, 0xc3, 0x30, 0x5c, 0x44, 0xe9, 0x5d, 0xe6,
+	0x62, 0xc7, 0xac, 0x76, 0xa1, 0xf2, 0x06, 0x18, 0x6f, 0x71, 0x0f, 0xd9, 0x91, 0x25, 0xa9, 0x70,
+	0x61, 0x4d, 0xd1, 0xe2, 0xab, 0xab, 0x89, 0x38, 0xe5, 0xcc, 0xb3, 0x17, 0xf9, 0xd4, 0xd3, 0xe3,
+	0xbd, 0x15, 0xa9, 0xea, 0x1b, 0x96, 0xf4, 0xaf, 0x8f, 0xf7, 0x56, 0xba, 0x2e, 0xbf, 0x3b, 0xde,
+	0x5b, 0xf9, 0x7f, 0x24, 0x8b, 0xbe, 0xbb, 0xaa, 0xf3, 0x60, 0xae, 0x4f, 0x54, 0x45, 0xb4, 0x45,
+	0x1c, 0x8a, 0xd4, 0xbf, 0x47, 0x80, 0xb2, 0x45, 0xcd, 0x0a, 0x6a, 0x11, 0x8a, 0xd9, 0x3d, 0x68,
+	0x61, 0x03, 0x32, 0xe2, 0x56, 0xd1, 0x43, 0xe8, 0x1a, 0x74, 0x9b, 0x10, 0xcb, 0xcb, 0xd4, 0x10,
+	0x6a, 0xe2, 0x9e, 0x9e, 0x69, 0x08, 0x95, 0xef, 0x80, 0xa9, 0x9d, 0xc0, 0x5f, 0x0d, 0x0a, 0x14,
+	0x4f, 0x3a, 0x53, 0x7e, 0xe3, 0x60, 0xbf, 0xb8, 0xe8, 0xdb, 0x87, 0x31, 0xa3, 0x8e, 0xae, 0xec,
+	0xf4, 0xc9, 0xe5, 0x69, 0x30, 0x66, 0x20, 0x87, 0xd8, 0xd9, 0xb4, 0xe7, 0xa3, 0x2a, 0x0e, 0x72,
+	0x07, 0x8c, 0x43, 0x9b, 0xb4, 0x1d, 0x96, 0x1d, 0x5d, 0x4a, 0x17, 0x2e, 0xac, 0xcd, 0x6b, 0xbe,
+	0x5f, 0x6f, 0x04, 0x35, 0x7f, 0x04, 0xb5, 0x1b, 0x04, 0x3b, 0xe5, 0x5b, 0x5e, 0x29, 0x7f, 0x7e,
+	0x99, 0x2f, 0x98, 0x98, 0x35, 0xdb, 0x75, 0xad, 0x41, 0x6c, 0x7f, 0xd2, 0xfc, 0xff, 0x8a, 0xd4,
+	0x78, 0xa0, 0xb3, 0x4e, 0x0b, 0x51, 0x6e, 0x40, 0x1f, 0x1f, 0xef, 0xad, 0x5c, 0xb4, 0x90, 0x09,
+	0x1b, 0x9d, 0x9a, 0x37, 0xc4, 0xd4, 0xef, 0x83, 0x08, 0x58, 0xfa, 0xe0, 0xdb, 0x27, 0xf9, 0xd4,
+	0x5f, 0x4f, 0xf2, 0x29, 0xde, 0x8f, 0x30, 0x71, 0xaf, 0x1f, 0x6f, 0xf2, 0x7e, 0xe8, 0xc9, 0x95,
+	0x55, 0x0b, 0x60, 0x39, 0x19, 0x11, 0xb6, 0xe9, 0xd7, 0x34, 0xb8, 0xbc, 0x45, 0xcd, 0x1b, 0x2e,
+	0x82, 0x0c, 0xdd, 0x86, 0x6d, 0x13, 0x9d, 0xbb, 0x2d, 0x61, 0x19, 0x47, 0xe2, 0xcb, 0x98, 0x7e,
+	0xcd, 0x65, 0x94, 0x3f, 0x04, 0x80, 0x32, 0xe8, 0xb2, 0x9a, 0xc7, 0xd0, 0xec, 0x28, 0x67, 0x45,
+	0x4e, 0x13, 0xf4, 0xd5, 0x02, 0xfa, 0x6a, 0x1f, 0x07, 0xf4, 0x2d, 0x5f, 0xf2, 0xe2, 0x3f, 0x7a,
+	0x99, 0x97, 0x84, 0x9b, 0x0c, 0x37, 0xf6, 0xd4, 0xf2, 0x22, 0x00, 0x4e, 0xdb, 0xae, 0xa1, 0x16,
+	0x69, 0x34, 0x69, 0x76, 0x6c, 0x49, 0x2a, 0x8c, 0x56, 0x33, 0x4e, 0xdb, 0xbe, 0xc9, 0x05, 0xf2,
+	0x47, 0xe0, 0x32, 0x57, 0xd5, 0x82, 0x55, 0x91, 0x1d, 0xe7, 0xc1, 0xe6, 0x07, 0x82, 0x55, 0x7c,
+	0x80, 0x88, 0xf5, 0x43, 0x18, 0xeb, 0x12, 0xb7, 0x0f, 0xb4, 0x25, 0xfd, 0xe4, 0x01, 0x98, 0x0e,
+	0x07, 0xa0, 0xa7, 0x67, 0xea, 0x35, 0x30, 0x1b, 0x95, 0x04, 0x0d, 0x96, 0xe7, 0xc1, 0xa4, 0xe9,
+	0x09, 0x6a, 0xd8, 0xe0, 0xcd, 0x1c, 0xad, 0x4e, 0xf0, 0xf3, 0xa6, 0xa1, 0xbe, 0x12, 0x14, 0xbd,
+	0x8f, 0x59, 0xd3, 0x70, 0xe1, 0xc3, 0x0d, 0xcb, 0xaa, 0x20, 0xaf, 0x9a, 0xdd, 0x51, 0x91, 0x6f,
+	0x82, 0x29, 0x23, 0x90, 0x85, 0x54, 0x3b, 0x6d, 0x26, 0xae, 0x84, 0x26, 0x01, 0xc3, 0xaa, 0xe0,
+	0xea, 0x00, 0x63, 0x91, 0xc7, 0xd9, 0xf4, 0x70, 0x9c, 0x95, 0xfb, 0x39, 0x8b, 0xa8, 0xfc, 0x29,
+	0x98, 0x13, 0xdd, 0x1d, 0xdc, 0x05, 0xe9, 0x61, 0x77, 0xc1, 0x0c, 0xf7, 0x70, 0x2f, 0x66, 0x21,
+	0x58, 0xd8, 0xc6, 0x8c, 0xcf, 0xcc, 0xa5, 0xaa, 0x38, 0x94, 0x6e, 0xf7, 0x36, 0x65, 0xb0, 0x2c,
+	0x51, 0x76, 0x26, 0x14, 0x55, 0xfd, 0x4d, 0x02, 0xcb, 0xc9, 0x90, 0xb0, 0x7b, 0x77, 0x42, 0xf6,
+	0x48, 0x9c, 0x3d, 0xf9, 0x93, 0x96, 0x7a, 0x05, 0x35, 0x3c, 0x5e, 0x97, 0x67, 0x7c, 0x0e, 0x4d,
+	0xfa, 0x82, 0x3e, 0x4a, 0xdc, 0x07, 0xb3, 0x0e, 0xda, 0x8d, 0xab, 0xd9, 0xd0, 0xfb, 0x73, 0xda,
+	0x73, 0xd0, 0xaf, 0x53, 0x5f, 0xa5, 0x7b, 0x26, 0x70, 0x1b, 0x76, 0x6c, 0xe4, 0xb0, 0xbb, 0xcc,
+	0x45, 0xd0, 0x3e, 0xf7, 0x07, 0x6d, 0x1d, 0x64, 0x5c, 0xd4, 0xc0, 0x2d, 0x8c, 0x1c, 0x96, 0x1d,
+	0x39, 0xcd, 0x2e, 0x84, 0x9e, 0xb0, 0xce, 0x6f, 0x81, 0x31, 0x46, 0x18, 0xb4, 0x78, 0x4f, 0x33,
+	0xe5, 0x77, 0xbc, 0x3a, 0xfd, 0xf1, 0x22, 0x3f, 0x23, 0xbc, 0x51, 0xe3, 0x81, 0x86, 0x89, 0x6e,
+	0x43, 0xd6, 0xd4, 0x36, 0x1d, 0x76, 0xb0, 0x5f, 0x04, 0x7e, 0x98, 0x4d, 0x87, 0x89, 0x12, 0x0a,
+	0x73, 0xb9, 0x02, 0x46, 0x5d, 0xc8, 0x50, 0x76, 0xec, 0x9c, 0x6e, 0xb8, 0x75, 0xdf, 0x6a, 0x1a,
+	0xff, 0x17, 0xab, 0xa9, 0x02, 0x26, 0x91, 0x63, 0x08, 0x3f, 0x13, 0x67, 0xf5, 0x33, 0x81, 0x1c,
+	0xc3, 0x53, 0x96, 0xde, 0x8b, 0x2c, 0x9c, 0x98, 0x17, 0x80, 0x1e, 0xdf, 0x5c, 0xf5, 0x7d, 0xa0,
+	0xc4, 0x6b, 0xc2, 0x11, 0x5e, 0x00, 0x19, 0xca, 0x25, 0xdd, 0x0d, 0x34, 0x29, 0x04, 0x9b, 0x86,
+	0xfa, 0xa3, 0x04, 0x66, 0x3c, 0x7b, 0x0b, 0x62, 0x7b, 0x60, 0x6a, 0xba, 0xdd, 0x97, 0x86, 0xef,
+	0x7e, 0x24, 0xdc, 0x48, 0x34, 0x5c, 0x69, 0x3d, 0x92, 0x66, 0x68, 0xe4, 0xa5, 0xb9, 0xd0, 0x4d,
+	0x73, 0xe0, 0x32, 0xea, 0x63, 0x09, 0x2c, 0xc6, 0x6a, 0xc2, 0x2c, 0x3b, 0x7d, 0x44, 0x7d, 0x7d,
+	0x9f, 0x39, 0xf5, 0x27, 0x49, 0x50, 0x0f, 0x3a, 0x0d, 0x64, 0xfd, 0x37, 0xd4, 0x4b, 0x2c, 0xe2,
+	0x70, 0xb3, 0x32, 0x78, 0x1b, 0x75, 0x09, 0x28, 0xf1, 0x9a, 0xa0, 0x8a, 0x6b, 0xdf, 0x4c, 0x80,
+	0xf4, 0x16, 0x35, 0xe5, 0x26, 0xb8, 0x18, 0x79, 0x13, 0xbf, 0x75, 0xd2, 0xda, 0xeb, 0x7b, 0x7d,
+	0xe6, 0xf4, 0x21, 0x81, 0x61, 0xdf, 0xbe, 0x97, 0xc0, 0x42, 0xe2, 0x1b, 0x35, 0xc1, 0x61, 0x82,
+	0x5d, 0xee, 0xfa, 0xf9, 0xec, 0xc2, 0x7b, 0x21, 0x70, 0xa1, 0xf7, 0x4d, 0xb6, 0x9c, 0xe0, 0xae,
+	0x07, 0x97, 0xd3, 0x86, 0xc3, 0x45, 0xd2, 0x4f, 0xfa, 0xfe, 0x27, 0xa5, 0x9f, 0x60, 0x97, 0xbb,
+	0x7e, 0x3e, 0xbb, 0xf0, 0x5e, 0x5f, 0x82, 0xab, 0x71, 0x9f, 0x92, 0xd3, 0xd3, 0x8b, 0xe0, 0x73,
+	0xeb, 0x67, 0xc3, 0x87, 0xe1, 0xbf, 0x00, 0x72, 0xcc, 0x4a, 0x2a, 0x26, 0x79, 0x1b, 0x80, 0xe7,
+	0xde, 0x3d, 0x13, 0x3c, 0x92, 0x7a, 0x0c, 0x95, 0x13, 0x53, 0x1f, 0xc4, 0xe7, 0xd6, 0xcf, 0x86,
+	0x0f, 0xc2, 0xe7, 0xc6, 0xbe, 0xf2, 0x96, 0x4b, 0x79, 0xfb, 0xe9, 0xa1, 0x22, 0x3d, 0x3b, 0x54,
+	0xa4, 0xe7, 0x87, 0x8a, 0xf4, 0xe7, 0xa1, 0x22, 0x3d, 0x3a, 0x52, 0x52, 0xcf, 0x8f, 0x94, 0xd4,
+	0xef, 0x47, 0x4a, 0xea, 0xb3, 0xb5, 0x9e, 0xd5, 0x25, 0xc2, 0x14, 0x2d, 0x58, 0xa7, 0xfe, 0x6f,
+	0x7d, 0x37, 0xfa, 0xb7, 0x2f, 0x5f, 0x65, 0xf5, 0x71, 0xfe, 0x39, 0xba, 0xf6, 0xcf, 0x00, 0xd6,
+	0x21, 0x2c, 0x60, 0x16, 0x10, 0x00, 0x00,
 }
 
 func (this *MsgUpdateParams) Equal(that interface{}) bool {
@@ -333,25 +852,170 @@ func (this *MsgDepositValidatorRewardsPoolResponse) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *MsgCreateGaugeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a governance operation for updating the x/distribution
-	// module parameters. The authority is defined in the keeper.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	that1, ok := that.(*MsgCreateGaugeResponse)
+	if !ok {
+		that2, ok := that.(MsgCreateGaugeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.GaugeId != that1.GaugeId {
+		return false
+	}
+	return true
+}
+func (this *MsgWithdrawAllDelegatorRewardsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawAllDelegatorRewardsResponse)
+	if !ok {
+		that2, ok := that.(MsgWithdrawAllDelegatorRewardsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if this.NextValidatorAddress != that1.NextValidatorAddress {
+		return false
+	}
+	return true
+}
+func (this *MsgCreatePaymentStreamResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCreatePaymentStreamResponse)
+	if !ok {
+		that2, ok := that.(MsgCreatePaymentStreamResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StreamId != that1.StreamId {
+		return false
+	}
+	return true
+}
+func (this *MsgClaimPaymentStreamResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgClaimPaymentStreamResponse)
+	if !ok {
+		that2, ok := that.(MsgClaimPaymentStreamResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgCancelPaymentStreamResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCancelPaymentStreamResponse)
+	if !ok {
+		that2, ok := that.(MsgCancelPaymentStreamResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a governance operation for updating the x/distribution
+	// module parameters. The authority is defined in the keeper.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// DepositValidatorRewardsPool defines a method to provide additional rewards
 	// to delegators to a specific validator.
 	DepositValidatorRewardsPool(ctx context.Context, in *MsgDepositValidatorRewardsPool, opts ...grpc.CallOption) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// CreateGauge defines a method to create an incentive gauge which linearly
+	// releases the deposited coins to the reward pool of a bond denom.
+	CreateGauge(ctx context.Context, in *MsgCreateGauge, opts ...grpc.CallOption) (*MsgCreateGaugeResponse, error)
+	// WithdrawAllDelegatorRewards defines a method to withdraw the rewards of
+	// all the delegations of a delegator in a single message.
+	WithdrawAllDelegatorRewards(ctx context.Context, in *MsgWithdrawAllDelegatorRewards, opts ...grpc.CallOption) (*MsgWithdrawAllDelegatorRewardsResponse, error)
+	// CreatePaymentStream defines a governance operation for creating a payment
+	// stream which pays out the community pool to the recipient over time.
+	CreatePaymentStream(ctx context.Context, in *MsgCreatePaymentStream, opts ...grpc.CallOption) (*MsgCreatePaymentStreamResponse, error)
+	// ClaimPaymentStream defines a method for the recipient to claim the
+	// released amount of a payment stream.
+	ClaimPaymentStream(ctx context.Context, in *MsgClaimPaymentStream, opts ...grpc.CallOption) (*MsgClaimPaymentStreamResponse, error)
+	// CancelPaymentStream defines a governance operation for cancelling a
+	// payment stream; the unreleased amount remains in the community pool.
+	CancelPaymentStream(ctx context.Context, in *MsgCancelPaymentStream, opts ...grpc.CallOption) (*MsgCancelPaymentStreamResponse, error)
 }
 
 type msgClient struct {
@@ -380,6 +1044,51 @@ func (c *msgClient) DepositValidatorRewardsPool(ctx context.Context, in *MsgDepo
 	return out, nil
 }
 
+func (c *msgClient) CreateGauge(ctx context.Context, in *MsgCreateGauge, opts ...grpc.CallOption) (*MsgCreateGaugeResponse, error) {
+	out := new(MsgCreateGaugeResponse)
+	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Msg/CreateGauge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawAllDelegatorRewards(ctx context.Context, in *MsgWithdrawAllDelegatorRewards, opts ...grpc.CallOption) (*MsgWithdrawAllDelegatorRewardsResponse, error) {
+	out := new(MsgWithdrawAllDelegatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Msg/WithdrawAllDelegatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreatePaymentStream(ctx context.Context, in *MsgCreatePaymentStream, opts ...grpc.CallOption) (*MsgCreatePaymentStreamResponse, error) {
+	out := new(MsgCreatePaymentStreamResponse)
+	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Msg/CreatePaymentStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimPaymentStream(ctx context.Context, in *MsgClaimPaymentStream, opts ...grpc.CallOption) (*MsgClaimPaymentStreamResponse, error) {
+	out := new(MsgClaimPaymentStreamResponse)
+	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Msg/ClaimPaymentStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelPaymentStream(ctx context.Context, in *MsgCancelPaymentStream, opts ...grpc.CallOption) (*MsgCancelPaymentStreamResponse, error) {
+	out := new(MsgCancelPaymentStreamResponse)
+	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Msg/CancelPaymentStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/distribution
@@ -388,6 +1097,21 @@ type MsgServer interface {
 	// DepositValidatorRewardsPool defines a method to provide additional rewards
 	// to delegators to a specific validator.
 	DepositValidatorRewardsPool(context.Context, *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// CreateGauge defines a method to create an incentive gauge which linearly
+	// releases the deposited coins to the reward pool of a bond denom.
+	CreateGauge(context.Context, *MsgCreateGauge) (*MsgCreateGaugeResponse, error)
+	// WithdrawAllDelegatorRewards defines a method to withdraw the rewards of
+	// all the delegations of a delegator in a single message.
+	WithdrawAllDelegatorRewards(context.Context, *MsgWithdrawAllDelegatorRewards) (*MsgWithdrawAllDelegatorRewardsResponse, error)
+	// CreatePaymentStream defines a governance operation for creating a payment
+	// stream which pays out the community pool to the recipient over time.
+	CreatePaymentStream(context.Context, *MsgCreatePaymentStream) (*MsgCreatePaymentStreamResponse, error)
+	// ClaimPaymentStream defines a method for the recipient to claim the
+	// released amount of a payment stream.
+	ClaimPaymentStream(context.Context, *MsgClaimPaymentStream) (*MsgClaimPaymentStreamResponse, error)
+	// CancelPaymentStream defines a governance operation for cancelling a
+	// payment stream; the unreleased amount remains in the community pool.
+	CancelPaymentStream(context.Context, *MsgCancelPaymentStream) (*MsgCancelPaymentStreamResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -400,6 +1124,21 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) DepositValidatorRewardsPool(ctx context.Context, req *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositValidatorRewardsPool not implemented")
 }
+func (*UnimplementedMsgServer) CreateGauge(ctx context.Context, req *MsgCreateGauge) (*MsgCreateGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGauge not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawAllDelegatorRewards(ctx context.Context, req *MsgWithdrawAllDelegatorRewards) (*MsgWithdrawAllDelegatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAllDelegatorRewards not implemented")
+}
+func (*UnimplementedMsgServer) CreatePaymentStream(ctx context.Context, req *MsgCreatePaymentStream) (*MsgCreatePaymentStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePaymentStream not implemented")
+}
+func (*UnimplementedMsgServer) ClaimPaymentStream(ctx context.Context, req *MsgClaimPaymentStream) (*MsgClaimPaymentStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimPaymentStream not implemented")
+}
+func (*UnimplementedMsgServer) CancelPaymentStream(ctx context.Context, req *MsgCancelPaymentStream) (*MsgCancelPaymentStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPaymentStream not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -441,6 +1180,97 @@ func _Msg_DepositValidatorRewardsPool_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateGauge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateGauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.distribution.v1.Msg/CreateGauge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateGauge(ctx, req.(*MsgCreateGauge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawAllDelegatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawAllDelegatorRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawAllDelegatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.distribution.v1.Msg/WithdrawAllDelegatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawAllDelegatorRewards(ctx, req.(*MsgWithdrawAllDelegatorRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreatePaymentStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePaymentStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePaymentStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.distribution.v1.Msg/CreatePaymentStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePaymentStream(ctx, req.(*MsgCreatePaymentStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimPaymentStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimPaymentStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimPaymentStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.distribution.v1.Msg/ClaimPaymentStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimPaymentStream(ctx, req.(*MsgClaimPaymentStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelPaymentStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPaymentStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPaymentStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.distribution.v1.Msg/CancelPaymentStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPaymentStream(ctx, req.(*MsgCancelPaymentStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "initia.distribution.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -453,6 +1283,26 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DepositValidatorRewardsPool",
 			Handler:    _Msg_DepositValidatorRewardsPool_Handler,
 		},
+		{
+			MethodName: "CreateGauge",
+			Handler:    _Msg_CreateGauge_Handler,
+		},
+		{
+			MethodName: "WithdrawAllDelegatorRewards",
+			Handler:    _Msg_WithdrawAllDelegatorRewards_Handler,
+		},
+		{
+			MethodName: "CreatePaymentStream",
+			Handler:    _Msg_CreatePaymentStream_Handler,
+		},
+		{
+			MethodName: "ClaimPaymentStream",
+			Handler:    _Msg_ClaimPaymentStream_Handler,
+		},
+		{
+			MethodName: "CancelPaymentStream",
+			Handler:    _Msg_CancelPaymentStream_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/distribution/v1/tx.proto",
@@ -602,115 +1452,1840 @@ func (m *MsgDepositValidatorRewardsPoolResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCreateGauge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgCreateGauge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.EpochDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EpochDuration):])
+	if err2 != nil {
+		return 0, err2
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	if m.NumEpochs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NumEpochs))
+		i--
+		dAtA[i] = 0x28
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateGaugeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.GaugeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDepositValidatorRewardsPool) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWithdrawAllDelegatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawAllDelegatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawAllDelegatorRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.StartValidatorAddress) > 0 {
+		i -= len(m.StartValidatorAddress)
+		copy(dAtA[i:], m.StartValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StartValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ValidatorAddresses) > 0 {
+		for iNdEx := len(m.ValidatorAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorAddresses[iNdEx])
+			copy(dAtA[i:], m.ValidatorAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextValidatorAddress) > 0 {
+		i -= len(m.NextValidatorAddress)
+		copy(dAtA[i:], m.NextValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NextValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDepositValidatorRewardsPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreatePaymentStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePaymentStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePaymentStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x3a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgCreatePaymentStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgCreatePaymentStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+
+func (m *MsgCreatePaymentStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimPaymentStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimPaymentStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimPaymentStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimPaymentStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimPaymentStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimPaymentStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPaymentStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPaymentStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPaymentStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPaymentStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPaymentStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPaymentStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositValidatorRewardsPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDepositValidatorRewardsPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateGauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	if m.NumEpochs != 0 {
+		n += 1 + sovTx(uint64(m.NumEpochs))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EpochDuration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		n += 1 + sovTx(uint64(m.GaugeId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawAllDelegatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ValidatorAddresses) > 0 {
+		for _, s := range m.ValidatorAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.StartValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.NextValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreatePaymentStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Rate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreatePaymentStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *MsgClaimPaymentStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *MsgClaimPaymentStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCancelPaymentStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *MsgCancelPaymentStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositValidatorRewardsPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositValidatorRewardsPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositValidatorRewardsPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositValidatorRewardsPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositValidatorRewardsPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositValidatorRewardsPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochs", wireType)
+			}
+			m.NumEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.EpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGaugeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGaugeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawAllDelegatorRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawAllDelegatorRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawAllDelegatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddresses = append(m.ValidatorAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawAllDelegatorRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawAllDelegatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, DecPool{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePaymentStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePaymentStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePaymentStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -738,11 +3313,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -769,7 +3346,40 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -794,7 +3404,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePaymentStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -817,12 +3427,31 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePaymentStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePaymentStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -844,7 +3473,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositValidatorRewardsPool) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimPaymentStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -867,15 +3496,15 @@ func (m *MsgDepositValidatorRewardsPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositValidatorRewardsPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimPaymentStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositValidatorRewardsPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimPaymentStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -903,13 +3532,13 @@ func (m *MsgDepositValidatorRewardsPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			var stringLen uint64
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -919,29 +3548,66 @@ func (m *MsgDepositValidatorRewardsPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimPaymentStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimPaymentStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimPaymentStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -951,29 +3617,81 @@ func (m *MsgDepositValidatorRewardsPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelPaymentStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelPaymentStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelPaymentStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -983,26 +3701,43 @@ func (m *MsgDepositValidatorRewardsPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1024,7 +3759,7 @@ func (m *MsgDepositValidatorRewardsPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositValidatorRewardsPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelPaymentStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1047,10 +3782,10 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositValidatorRewardsPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelPaymentStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositValidatorRewardsPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelPaymentStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3,15 +3,17 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // create a new ValidatorHistoricalRewards
-func NewValidatorHistoricalRewards(cumulativeRewardRatios DecPools, referenceCount uint32) ValidatorHistoricalRewards {
+func NewValidatorHistoricalRewards(cumulativeRewardRatios DecPools, referenceCount uint32, time time.Time) ValidatorHistoricalRewards {
 	return ValidatorHistoricalRewards{
 		CumulativeRewardRatios: cumulativeRewardRatios,
 		ReferenceCount:         referenceCount,
+		Time:                   time,
 	}
 }
 
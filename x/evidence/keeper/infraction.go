@@ -55,6 +55,14 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 		}
 	}
 
+	// The evidence could be committed with the old consensus key of the validator
+	// which has rotated its key within the unbonding period, so the signing info
+	// and the punishments are handled with the current consensus address.
+	consAddr, err = validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
 	// calculate the age of the evidence
 	infractionHeight := evidence.GetHeight()
 	infractionTime := evidence.GetTime()
@@ -75,6 +75,10 @@ func EndBlocker(ctx sdk.Context, k *keeper.Keeper) error {
 			logger.Error("failed to execute AfterProposalFailedMinDeposit hook", "error", err)
 		}
 
+		if err = k.CallProposalMoveHooks(ctx, proposal, customtypes.MoveHookEventDropped); err != nil {
+			return false, err
+		}
+
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeInactiveProposal,
@@ -211,8 +215,24 @@ func EndBlocker(ctx sdk.Context, k *keeper.Keeper) error {
 
 		return false, nil
 	})
+	if err != nil {
+		return err
+	}
 
-	return err
+	// execute the passed proposals whose execution delay has elapsed
+	return k.ExecutionQueue.Walk(ctx, rng, func(key collections.Pair[time.Time, uint64], _ uint64) (bool, error) {
+		proposal, err := k.Proposals.Get(ctx, key.K2())
+		if err != nil {
+			return false, err
+		}
+
+		err = handleQueuedExecution(ctx, k, proposal)
+		if err != nil {
+			return false, err
+		}
+
+		return false, nil
+	})
 }
 
 func handleTallyResult(
@@ -255,57 +275,26 @@ func handleTallyResult(
 
 	switch {
 	case passed:
-		var (
-			idx    int
-			events sdk.Events
-			msg    sdk.Msg
-		)
-
-		// attempt to execute all messages within the passed proposal
-		// Messages may mutate state thus we use a cached context. If one of
-		// the handlers fails, no state mutation is written and the error
-		// message is logged.
-		cacheCtx, writeCache := ctx.CacheContext()
-		messages, err := proposal.GetMsgs()
+		// queue the execution if any of the messages has an execution delay
+		params, err := k.Params.Get(ctx)
 		if err != nil {
-			proposal.Status = v1.StatusFailed
-			proposal.FailedReason = err.Error()
-			tagValue = types.AttributeValueProposalFailed
-			logMsg = fmt.Sprintf("passed proposal (%v) failed to execute; msgs: %s", proposal, err)
-
-			break
+			return err
 		}
 
-		// execute all messages
-		for idx, msg = range messages {
-			handler := k.Router().Handler(msg)
-			var res *sdk.Result
-			res, err = safeExecuteHandler(cacheCtx, msg, handler)
+		if delay := keeper.GetExecutionDelay(params, proposal); delay > 0 {
+			proposal.Status = v1.StatusPassed
+			proposal, err = k.QueueExecution(ctx, proposal, ctx.BlockTime().Add(delay))
 			if err != nil {
-				break
+				return err
 			}
 
-			events = append(events, res.GetEvents()...)
-		}
-
-		// `err == nil` when all handlers passed.
-		// Or else, `idx` and `err` are populated with the msg index and error.
-		if err == nil {
-			proposal.Status = v1.StatusPassed
-			tagValue = types.AttributeValueProposalPassed
-			logMsg = "passed"
+			tagValue = customtypes.AttributeValueProposalQueued
+			logMsg = fmt.Sprintf("passed; execution queued until %s", proposal.ExecutionTime)
 
-			// write state to the underlying multi-store
-			writeCache()
-
-			// propagate the msg events to the current context
-			ctx.EventManager().EmitEvents(events)
-		} else {
-			proposal.Status = v1.StatusFailed
-			proposal.FailedReason = err.Error()
-			tagValue = types.AttributeValueProposalFailed
-			logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
+			break
 		}
+
+		proposal, tagValue, logMsg = executeProposal(ctx, k, proposal)
 	case proposal.Expedited:
 		// When expedited proposal fails, it is converted
 		// to a regular proposal. As a result, the voting period is extended, and,
@@ -316,7 +305,7 @@ func handleTallyResult(
 		if err != nil {
 			return err
 		}
-		endTime := proposal.VotingStartTime.Add(params.VotingPeriod)
+		endTime := proposal.VotingStartTime.Add(params.GetProposalParams(proposal.MsgTypeURLs()...).VotingPeriod)
 		proposal.VotingEndTime = &endTime
 
 		err = k.ActiveProposalsQueue.Set(ctx, collections.Join(*proposal.VotingEndTime, proposal.Id), proposal.Id)
@@ -349,6 +338,13 @@ func handleTallyResult(
 		k.Logger(ctx).Error("failed to execute AfterProposalVotingPeriodEnded hook", "error", err)
 	}
 
+	// the expedited proposal converted to regular is still in voting period
+	if proposal.Status != v1.StatusVotingPeriod {
+		if err = k.CallProposalMoveHooks(ctx, proposal, keeper.MoveHookEventFromStatus(proposal.Status)); err != nil {
+			return err
+		}
+	}
+
 	k.Logger(ctx).Info(
 		"proposal tallied",
 		"proposal", proposal.Id,
@@ -370,6 +366,108 @@ func handleTallyResult(
 	return
 }
 
+// executeProposal executes the messages of the passed proposal and updates the proposal
+// status with the execution result.
+func executeProposal(ctx sdk.Context, k *keeper.Keeper, proposal customtypes.Proposal) (_ customtypes.Proposal, tagValue, logMsg string) {
+	var (
+		idx    int
+		events sdk.Events
+		msg    sdk.Msg
+	)
+
+	// attempt to execute all messages within the passed proposal
+	// Messages may mutate state thus we use a cached context. If one of
+	// the handlers fails, no state mutation is written and the error
+	// message is logged.
+	cacheCtx, writeCache := ctx.CacheContext()
+	messages, err := proposal.GetMsgs()
+	if err != nil {
+		proposal.Status = v1.StatusFailed
+		proposal.FailedReason = err.Error()
+		tagValue = types.AttributeValueProposalFailed
+		logMsg = fmt.Sprintf("passed proposal (%v) failed to execute; msgs: %s", proposal, err)
+
+		return proposal, tagValue, logMsg
+	}
+
+	// execute all messages
+	for idx, msg = range messages {
+		handler := k.Router().Handler(msg)
+		var res *sdk.Result
+		res, err = safeExecuteHandler(cacheCtx, msg, handler)
+		if err != nil {
+			break
+		}
+
+		events = append(events, res.GetEvents()...)
+	}
+
+	// `err == nil` when all handlers passed.
+	// Or else, `idx` and `err` are populated with the msg index and error.
+	if err == nil {
+		proposal.Status = v1.StatusPassed
+		tagValue = types.AttributeValueProposalPassed
+		logMsg = "passed"
+
+		// write state to the underlying multi-store
+		writeCache()
+
+		// propagate the msg events to the current context
+		ctx.EventManager().EmitEvents(events)
+	} else {
+		proposal.Status = v1.StatusFailed
+		proposal.FailedReason = err.Error()
+		tagValue = types.AttributeValueProposalFailed
+		logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
+	}
+
+	return proposal, tagValue, logMsg
+}
+
+// handleQueuedExecution executes the passed proposal whose queued execution time has come.
+func handleQueuedExecution(ctx sdk.Context, k *keeper.Keeper, proposal customtypes.Proposal) (err error) {
+	proposal, err = k.DequeueExecution(ctx, proposal)
+	if err != nil {
+		return err
+	}
+
+	proposal, tagValue, logMsg := executeProposal(ctx, k, proposal)
+
+	err = k.SetProposal(ctx, proposal)
+	if err != nil {
+		return err
+	}
+
+	// the passed event is already called on the queue, so call the executed or failed
+	// event on the actual execution
+	event := customtypes.MoveHookEventExecuted
+	if proposal.Status != v1.StatusPassed {
+		event = customtypes.MoveHookEventFailed
+	}
+	if err = k.CallProposalMoveHooks(ctx, proposal, event); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info(
+		"queued proposal executed",
+		"proposal", proposal.Id,
+		"status", proposal.Status.String(),
+		"title", proposal.Title,
+		"results", logMsg,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			customtypes.EventTypeExecuteProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
+			sdk.NewAttribute(types.AttributeKeyProposalLog, logMsg),
+		),
+	)
+
+	return nil
+}
+
 // executes handle(msg) and recovers from panic.
 func safeExecuteHandler(ctx sdk.Context, msg sdk.Msg, handler baseapp.MsgServiceHandler,
 ) (res *sdk.Result, err error) {
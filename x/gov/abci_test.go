@@ -6,9 +6,11 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/initia-labs/initia/x/gov"
 	"github.com/initia-labs/initia/x/gov/keeper"
+	customtypes "github.com/initia-labs/initia/x/gov/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -135,6 +137,66 @@ func TestEmergencyProposalPassedEndblocker(t *testing.T) {
 	require.Equal(t, proposal.Status, v1.StatusPassed)
 }
 
+func TestEmergencyProposalSkipsExecutionDelay(t *testing.T) {
+	app := createAppWithSimpleValidators(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	params, err := app.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	params.ExecutionDelays = []customtypes.ExecutionDelay{{
+		MsgTypeUrl: sdk.MsgTypeURL(&customtypes.MsgUpdateParams{}),
+		Delay:      time.Hour * 2,
+	}}
+	params.ExpeditedVotingPeriod = votingPeriod / 2
+	params.ExpeditedMinDeposit = sdk.NewCoins(sdk.NewCoin(bondDenom, minDeposit[0].Amount.MulRaw(2)))
+	require.NoError(t, params.Validate(app.AccountKeeper.AddressCodec()))
+	err = app.GovKeeper.Params.Set(ctx, params)
+	require.NoError(t, err)
+
+	govMsgSvr := keeper.NewMsgServerImpl(app.GovKeeper)
+	govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+
+	newParams := params
+	newParams.VoterBreakdownRetention = 7
+
+	propMsg := createTextProposalMsg(t, emergencyMinDeposit[0].Amount.Int64(), false)
+	err = propMsg.SetMsgs([]sdk.Msg{&customtypes.MsgUpdateParams{
+		Authority: govAddr.String(),
+		Params:    newParams,
+	}})
+	require.NoError(t, err)
+
+	res, err := govMsgSvr.SubmitProposal(ctx, propMsg)
+	require.NoError(t, err)
+
+	proposal, err := app.GovKeeper.Proposals.Get(ctx, res.ProposalId)
+	require.NoError(t, err)
+	require.True(t, proposal.Emergency)
+
+	for _, addr := range addrs {
+		_, err = govMsgSvr.Vote(ctx, createVoteMsg(t, addr, proposal.Id, v1.OptionYes))
+		require.NoError(t, err)
+	}
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = proposal.EmergencyNextTallyTime.Add(time.Second)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	err = gov.EndBlocker(ctx, app.GovKeeper)
+	require.NoError(t, err)
+
+	// the emergency proposal is executed without the execution delay
+	proposal, err = app.GovKeeper.Proposals.Get(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusPassed, proposal.Status)
+	require.Nil(t, proposal.ExecutionTime)
+
+	params, err = app.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), params.VoterBreakdownRetention)
+}
+
 func TestTickSingleProposal(t *testing.T) {
 	testCases := []struct {
 		name      string
@@ -259,3 +321,230 @@ func TestTickSingleProposal(t *testing.T) {
 		})
 	}
 }
+
+func TestQueuedExecutionEndblocker(t *testing.T) {
+	app := createAppWithSimpleValidators(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	params, err := app.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	executionDelay := time.Hour * 2
+	params.ExecutionDelays = []customtypes.ExecutionDelay{{
+		MsgTypeUrl: sdk.MsgTypeURL(&customtypes.MsgUpdateParams{}),
+		Delay:      executionDelay,
+	}}
+	params.ExecutionCancellers = []string{addrs[4].String()}
+	params.ExpeditedVotingPeriod = votingPeriod / 2
+	params.ExpeditedMinDeposit = sdk.NewCoins(sdk.NewCoin(bondDenom, minDeposit[0].Amount.MulRaw(2)))
+	require.NoError(t, params.Validate(app.AccountKeeper.AddressCodec()))
+	err = app.GovKeeper.Params.Set(ctx, params)
+	require.NoError(t, err)
+
+	govMsgSvr := keeper.NewMsgServerImpl(app.GovKeeper)
+	govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+
+	// submit two proposals updating the voter breakdown retention
+	for _, retention := range []uint64{7, 8} {
+		newParams := params
+		newParams.VoterBreakdownRetention = retention
+
+		propMsg := createTextProposalMsg(t, minDeposit[0].Amount.Int64(), false)
+		err = propMsg.SetMsgs([]sdk.Msg{&customtypes.MsgUpdateParams{
+			Authority: govAddr.String(),
+			Params:    newParams,
+		}})
+		require.NoError(t, err)
+
+		res, err := govMsgSvr.SubmitProposal(ctx, propMsg)
+		require.NoError(t, err)
+
+		for _, addr := range addrs[:3] {
+			_, err = govMsgSvr.Vote(ctx, createVoteMsg(t, addr, res.ProposalId, v1.OptionYes))
+			require.NoError(t, err)
+		}
+	}
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(votingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	err = gov.EndBlocker(ctx, app.GovKeeper)
+	require.NoError(t, err)
+
+	// the executions are queued
+	for _, proposalID := range []uint64{1, 2} {
+		proposal, err := app.GovKeeper.Proposals.Get(ctx, proposalID)
+		require.NoError(t, err)
+		require.Equal(t, v1.StatusPassed, proposal.Status)
+		require.NotNil(t, proposal.ExecutionTime)
+		require.True(t, proposal.ExecutionTime.Equal(ctx.BlockTime().Add(executionDelay)))
+	}
+
+	qs := keeper.NewCustomQueryServer(app.GovKeeper)
+	queued, err := qs.QueuedExecutions(ctx, &customtypes.QueryQueuedExecutionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, queued.Proposals, 2)
+
+	params, err = app.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), params.VoterBreakdownRetention)
+
+	// only the gov module account and the execution cancellers can cancel
+	customMsgSvr := keeper.NewCustomMsgServerImpl(app.GovKeeper)
+	_, err = customMsgSvr.CancelQueuedExecution(ctx, &customtypes.MsgCancelQueuedExecution{
+		Authority:  addrs[0].String(),
+		ProposalId: 2,
+		Reason:     "malicious",
+	})
+	require.Error(t, err)
+
+	_, err = customMsgSvr.CancelQueuedExecution(ctx, &customtypes.MsgCancelQueuedExecution{
+		Authority:  addrs[4].String(),
+		ProposalId: 2,
+		Reason:     "malicious",
+	})
+	require.NoError(t, err)
+
+	proposal, err := app.GovKeeper.Proposals.Get(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusFailed, proposal.Status)
+	require.Nil(t, proposal.ExecutionTime)
+	require.Contains(t, proposal.FailedReason, "malicious")
+
+	// cannot cancel twice
+	_, err = customMsgSvr.CancelQueuedExecution(ctx, &customtypes.MsgCancelQueuedExecution{
+		Authority:  addrs[4].String(),
+		ProposalId: 2,
+		Reason:     "malicious",
+	})
+	require.ErrorIs(t, err, customtypes.ErrExecutionNotQueued)
+
+	// not executed before the delay
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(executionDelay - time.Second)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	err = gov.EndBlocker(ctx, app.GovKeeper)
+	require.NoError(t, err)
+
+	params, err = app.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), params.VoterBreakdownRetention)
+
+	// executed after the delay
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(time.Second)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	// the hook is called with the executed event on the execution
+	err = app.GovKeeper.SetMoveHook(ctx, customtypes.MoveHook{
+		ModuleAddr:   addrs[0].String(),
+		ModuleName:   "hook",
+		FunctionName: "on_event",
+		GasLimit:     100_000,
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = gov.EndBlocker(ctx, app.GovKeeper)
+	require.NoError(t, err)
+
+	var hookEvents []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != customtypes.EventTypeMoveHook {
+			continue
+		}
+
+		hookEvent, ok := event.GetAttribute(customtypes.AttributeKeyHookEvent)
+		require.True(t, ok)
+		hookEvents = append(hookEvents, hookEvent.Value)
+	}
+	require.Equal(t, []string{customtypes.MoveHookEventExecuted}, hookEvents)
+
+	params, err = app.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), params.VoterBreakdownRetention)
+
+	proposal, err = app.GovKeeper.Proposals.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusPassed, proposal.Status)
+	require.Nil(t, proposal.ExecutionTime)
+
+	queued, err = qs.QueuedExecutions(ctx, &customtypes.QueryQueuedExecutionsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, queued.Proposals)
+}
+
+func TestOptimisticProposalEndblocker(t *testing.T) {
+	app := createAppWithSimpleValidators(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	params, err := app.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	params.OptimisticMsgTypes = []string{sdk.MsgTypeURL(&customtypes.MsgUpdateParams{})}
+	params.ExpeditedVotingPeriod = votingPeriod / 2
+	params.ExpeditedMinDeposit = sdk.NewCoins(sdk.NewCoin(bondDenom, minDeposit[0].Amount.MulRaw(2)))
+	require.NoError(t, params.Validate(app.AccountKeeper.AddressCodec()))
+	err = app.GovKeeper.Params.Set(ctx, params)
+	require.NoError(t, err)
+
+	govMsgSvr := keeper.NewMsgServerImpl(app.GovKeeper)
+	customMsgSvr := keeper.NewCustomMsgServerImpl(app.GovKeeper)
+	govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+
+	// the message types not eligible for the optimistic proposals are rejected
+	propMsg, err := customtypes.NewMsgSubmitOptimisticProposal(
+		[]sdk.Msg{banktypes.NewMsgSend(govAddr, addrs[0], sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1))))},
+		minDeposit, addrs[0].String(), "", "Proposal", "description",
+	)
+	require.NoError(t, err)
+	_, err = customMsgSvr.SubmitOptimisticProposal(ctx, propMsg)
+	require.ErrorIs(t, err, customtypes.ErrInvalidOptimisticProposal)
+
+	// submit two optimistic proposals updating the voter breakdown retention
+	for _, retention := range []uint64{7, 8} {
+		newParams := params
+		newParams.VoterBreakdownRetention = retention
+
+		propMsg, err := customtypes.NewMsgSubmitOptimisticProposal(
+			[]sdk.Msg{&customtypes.MsgUpdateParams{Authority: govAddr.String(), Params: newParams}},
+			minDeposit, addrs[0].String(), "", "Proposal", "description",
+		)
+		require.NoError(t, err)
+
+		res, err := customMsgSvr.SubmitOptimisticProposal(ctx, propMsg)
+		require.NoError(t, err)
+
+		proposal, err := app.GovKeeper.Proposals.Get(ctx, res.ProposalId)
+		require.NoError(t, err)
+		require.True(t, proposal.Optimistic)
+		require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+	}
+
+	// the first proposal gets no votes and the second one is rejected by the no votes
+	for _, addr := range addrs[:3] {
+		_, err = govMsgSvr.Vote(ctx, createVoteMsg(t, addr, 2, v1.OptionNo))
+		require.NoError(t, err)
+	}
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(votingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	err = gov.EndBlocker(ctx, app.GovKeeper)
+	require.NoError(t, err)
+
+	proposal, err := app.GovKeeper.Proposals.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusPassed, proposal.Status)
+
+	proposal, err = app.GovKeeper.Proposals.Get(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusRejected, proposal.Status)
+
+	params, err = app.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), params.VoterBreakdownRetention)
+}
@@ -42,6 +42,13 @@ func GetQueryCmd(ac address.Codec) *cobra.Command {
 		GetCmdQueryProposals(ac),
 		GetCmdQueryParams(),
 		GetCmdQueryTally(),
+		GetCmdQueryTallyPreview(),
+		GetCmdQueryVoteDelegations(ac),
+		GetCmdQueryVoteDelegators(ac),
+		GetCmdQueryProposalVoterBreakdown(),
+		GetCmdQueryQueuedExecutions(),
+		GetCmdQueryMoveHooks(),
+		GetCmdQueryProposalDepositOutcomes(),
 	)
 
 	return govQueryCmd
@@ -317,3 +324,350 @@ $ %s query gov tally 1
 
 	return cmd
 }
+
+// GetCmdQueryTallyPreview implements the query tally preview command.
+func GetCmdQueryTallyPreview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tally-preview [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the tally of an active proposal as if the voting ended now",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tally of an active proposal as if the voting ended now,
+including whether the quorum and the threshold would be met.
+
+Example:
+$ %s query gov tally-preview 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid proposal-id: %s", args[0])
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := customtypes.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TallyPreview(
+				cmd.Context(),
+				&customtypes.QueryTallyPreviewRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryVoteDelegations implements the query vote delegations command.
+func GetCmdQueryVoteDelegations(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-delegations [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the vote delegations given by a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the vote delegations given by a delegator.
+
+Example:
+$ %s query gov vote-delegations init1...
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := customtypes.NewQueryClient(clientCtx)
+
+			if _, err := ac.StringToBytes(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VoteDelegations(
+				cmd.Context(),
+				&customtypes.QueryVoteDelegationsRequest{
+					Delegator:  args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "vote delegations")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryVoteDelegators implements the query vote delegators command.
+func GetCmdQueryVoteDelegators(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-delegators [delegate-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the vote delegations received by a delegate",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the vote delegations received by a delegate.
+
+Example:
+$ %s query gov vote-delegators init1...
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := customtypes.NewQueryClient(clientCtx)
+
+			if _, err := ac.StringToBytes(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VoteDelegators(
+				cmd.Context(),
+				&customtypes.QueryVoteDelegatorsRequest{
+					Delegate:   args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "vote delegators")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryProposalVoterBreakdown implements the query proposal voter breakdown command.
+func GetCmdQueryProposalVoterBreakdown() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "voter-breakdown [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the archived vote options and voting power of the voters of a finalized proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the archived vote options and voting power of the voters of a finalized proposal.
+The breakdowns are archived only if the archive_voter_breakdown param is enabled.
+
+Example:
+$ %s query gov voter-breakdown 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid proposal-id: %s", args[0])
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := customtypes.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ProposalVoterBreakdown(
+				cmd.Context(),
+				&customtypes.QueryProposalVoterBreakdownRequest{
+					ProposalId: proposalID,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "voter breakdowns")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryQueuedExecutions implements the query queued executions command.
+func GetCmdQueryQueuedExecutions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queued-executions",
+		Args:  cobra.NoArgs,
+		Short: "Query the passed proposals whose execution is queued",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the passed proposals whose execution is queued, ordered by the execution time.
+
+Example:
+$ %s query gov queued-executions
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := customtypes.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueuedExecutions(
+				cmd.Context(),
+				&customtypes.QueryQueuedExecutionsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "queued executions")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMoveHooks implements the query move hooks command.
+func GetCmdQueryMoveHooks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "move-hooks",
+		Args:  cobra.NoArgs,
+		Short: "Query the Move hooks called on proposal lifecycle events",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the governance-approved Move hooks called on proposal lifecycle events.
+
+Example:
+$ %s query gov move-hooks
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := customtypes.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.MoveHooks(
+				cmd.Context(),
+				&customtypes.QueryMoveHooksRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "move hooks")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryProposalDepositOutcomes implements the query proposal deposit outcomes command.
+func GetCmdQueryProposalDepositOutcomes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-outcomes [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the final outcomes of the deleted deposits of a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the final outcomes of the deleted deposits of a proposal; whether each
+deposit was refunded, burned or charged with the proposal cancel ratio, and the destination of the charges.
+
+Example:
+$ %s query gov deposit-outcomes 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid proposal-id: %s", args[0])
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := customtypes.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ProposalDepositOutcomes(
+				cmd.Context(),
+				&customtypes.QueryProposalDepositOutcomesRequest{
+					ProposalId: proposalID,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "deposit outcomes")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
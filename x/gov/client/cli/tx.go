@@ -0,0 +1,190 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	customtypes "github.com/initia-labs/initia/x/gov/types"
+)
+
+// NewTxCmd returns the transaction commands for this module, replacing the
+// submit-proposal command with the one supporting the proposal simulation.
+// The submit-optimistic-proposal command is added as well.
+func NewTxCmd(legacyPropCmds []*cobra.Command) *cobra.Command {
+	govTxCmd := govcli.NewTxCmd(legacyPropCmds)
+	for _, cmd := range govTxCmd.Commands() {
+		if cmd.Name() == "submit-proposal" {
+			govTxCmd.RemoveCommand(cmd)
+		}
+	}
+
+	govTxCmd.AddCommand(
+		NewCmdSubmitProposal(),
+		NewCmdSubmitOptimisticProposal(),
+	)
+
+	return govTxCmd
+}
+
+// NewCmdSubmitProposal implements submitting a proposal transaction command. With the
+// --dry-run flag, the proposal messages are executed against the current state by the
+// simulate proposal query instead of broadcasting the transaction.
+func NewCmdSubmitProposal() *cobra.Command {
+	cmd := govcli.NewCmdSubmitProposal()
+	cmd.Flags().Lookup(flags.FlagDryRun).Usage = "simulate the execution of the proposal messages against the current state, but don't broadcast the transaction"
+
+	submitProposal := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		dryRun, err := cmd.Flags().GetBool(flags.FlagDryRun)
+		if err != nil {
+			return err
+		}
+		if !dryRun {
+			return submitProposal(cmd, args)
+		}
+
+		clientCtx, err := client.GetClientQueryContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		msgs, err := parseProposalMsgs(clientCtx, args[0])
+		if err != nil {
+			return err
+		}
+
+		req, err := customtypes.NewQuerySimulateProposalRequest(msgs)
+		if err != nil {
+			return err
+		}
+
+		queryClient := customtypes.NewQueryClient(clientCtx)
+		res, err := queryClient.SimulateProposal(cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintProto(res)
+	}
+
+	return cmd
+}
+
+// NewCmdSubmitOptimisticProposal implements submitting an optimistic proposal transaction command.
+func NewCmdSubmitOptimisticProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-optimistic-proposal [path/to/proposal.json]",
+		Short: "Submit an optimistic proposal along with some messages, metadata and deposit",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an optimistic proposal along with some messages, metadata and deposit.
+The optimistic proposal passes at the end of the voting period unless the no and no with veto
+votes exceed the rejection threshold, and all messages must be the types eligible for it.
+They should be defined in a JSON file.
+
+Example:
+$ %s tx gov submit-optimistic-proposal path/to/proposal.json
+
+Where proposal.json contains:
+
+{
+  // array of proto-JSON-encoded sdk.Msgs
+  "messages": [
+    {
+      "@type": "/cosmos.bank.v1beta1.MsgSend",
+      "from_address": "init1...",
+      "to_address": "init1...",
+      "amount":[{"denom": "uinit","amount": "10"}]
+    }
+  ],
+  // metadata can be any of base64 encoded, raw text, stringified json, IPFS link to json
+  "metadata": "4pIMOgIGx1vZGU=",
+  "deposit": "10uinit",
+  "title": "My proposal",
+  "summary": "A short summary of my proposal"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, msgs, err := parseProposal(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := customtypes.NewMsgSubmitOptimisticProposal(msgs, deposit, clientCtx.GetFromAddress().String(), proposal.Metadata, proposal.Title, proposal.Summary)
+			if err != nil {
+				return fmt.Errorf("invalid message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// proposalFile defines the proposal json file.
+type proposalFile struct {
+	Messages []json.RawMessage `json:"messages,omitempty"`
+	Metadata string            `json:"metadata"`
+	Deposit  string            `json:"deposit"`
+	Title    string            `json:"title"`
+	Summary  string            `json:"summary"`
+}
+
+// parseProposal reads the proposal and its messages from the proposal json file.
+func parseProposal(clientCtx client.Context, path string) (proposalFile, []sdk.Msg, error) {
+	var proposal proposalFile
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return proposal, nil, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, nil, err
+	}
+
+	msgs := make([]sdk.Msg, len(proposal.Messages))
+	for i, anyJSON := range proposal.Messages {
+		var msg sdk.Msg
+		if err := clientCtx.Codec.UnmarshalInterfaceJSON(anyJSON, &msg); err != nil {
+			return proposal, nil, fmt.Errorf("failed to parse proposal message %d: %w", i, err)
+		}
+
+		msgs[i] = msg
+	}
+
+	return proposal, msgs, nil
+}
+
+// parseProposalMsgs reads the proposal messages from the proposal json file.
+func parseProposalMsgs(clientCtx client.Context, path string) ([]sdk.Msg, error) {
+	_, msgs, err := parseProposal(clientCtx, path)
+	return msgs, err
+}
@@ -55,6 +55,62 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		}
 	}
 
+	for _, delegation := range data.VoteDelegations {
+		delegator, err := ak.AddressCodec().StringToBytes(delegation.Delegator)
+		if err != nil {
+			panic(err)
+		}
+		delegate, err := ak.AddressCodec().StringToBytes(delegation.Delegate)
+		if err != nil {
+			panic(err)
+		}
+		// the delegations are validated as a whole on the genesis validation
+		err = k.DelegatedVotes.Set(ctx, collections.Join(sdk.AccAddress(delegator), delegation.MsgTypeUrl), *delegation)
+		if err != nil {
+			panic(err)
+		}
+		err = k.DelegatedVoters.Set(ctx, collections.Join3(sdk.AccAddress(delegate), sdk.AccAddress(delegator), delegation.MsgTypeUrl))
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, hook := range data.MoveHooks {
+		err := k.SetMoveHook(ctx, *hook)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, outcome := range data.DepositOutcomes {
+		depositor, err := ak.AddressCodec().StringToBytes(outcome.Depositor)
+		if err != nil {
+			panic(err)
+		}
+		err = k.DepositOutcomeHistory.Set(ctx, collections.Join(outcome.ProposalId, sdk.AccAddress(depositor)), *outcome)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, breakdown := range data.VoterBreakdowns {
+		voter, err := ak.AddressCodec().StringToBytes(breakdown.Voter)
+		if err != nil {
+			panic(err)
+		}
+		err = k.VoterBreakdowns.Set(ctx, collections.Join(breakdown.ProposalId, sdk.AccAddress(voter)), *breakdown)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, proposalID := range data.VoterBreakdownProposals {
+		err := k.VoterBreakdownProposals.Set(ctx, proposalID)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
 		case v1.StatusDepositPeriod:
@@ -78,6 +134,13 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 					panic(err)
 				}
 			}
+		case v1.StatusPassed:
+			if proposal.ExecutionTime != nil {
+				err := k.ExecutionQueue.Set(ctx, collections.Join(*proposal.ExecutionTime, proposal.Id), proposal.Id)
+				if err != nil {
+					panic(err)
+				}
+			}
 		}
 		err := k.SetProposal(ctx, *proposal)
 		if err != nil {
@@ -142,12 +205,66 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) (*customtypes.GenesisState
 		panic(err)
 	}
 
+	// export vote delegations
+	var voteDelegations []*customtypes.VoteDelegation
+	err = k.DelegatedVotes.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, string], value customtypes.VoteDelegation) (stop bool, err error) {
+		voteDelegations = append(voteDelegations, &value)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// export move hooks
+	var moveHooks []*customtypes.MoveHook
+	err = k.MoveHookRegistry.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, string], value customtypes.MoveHook) (stop bool, err error) {
+		moveHooks = append(moveHooks, &value)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// export deposit outcomes
+	var depositOutcomes []*customtypes.DepositOutcome
+	err = k.DepositOutcomeHistory.Walk(ctx, nil, func(_ collections.Pair[uint64, sdk.AccAddress], value customtypes.DepositOutcome) (stop bool, err error) {
+		depositOutcomes = append(depositOutcomes, &value)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// export voter breakdowns
+	var voterBreakdowns []*customtypes.VoterBreakdown
+	err = k.VoterBreakdowns.Walk(ctx, nil, func(_ collections.Pair[uint64, sdk.AccAddress], value customtypes.VoterBreakdown) (stop bool, err error) {
+		voterBreakdowns = append(voterBreakdowns, &value)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var voterBreakdownProposals []uint64
+	err = k.VoterBreakdownProposals.Walk(ctx, nil, func(proposalID uint64) (stop bool, err error) {
+		voterBreakdownProposals = append(voterBreakdownProposals, proposalID)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	return &customtypes.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
-		Constitution:       constitution,
+		StartingProposalId:      startingProposalID,
+		Deposits:                proposalsDeposits,
+		Votes:                   proposalsVotes,
+		Proposals:               proposals,
+		Params:                  &params,
+		Constitution:            constitution,
+		VoteDelegations:         voteDelegations,
+		MoveHooks:               moveHooks,
+		DepositOutcomes:         depositOutcomes,
+		VoterBreakdowns:         voterBreakdowns,
+		VoterBreakdownProposals: voterBreakdownProposals,
 	}, nil
 }
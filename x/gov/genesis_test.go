@@ -3,9 +3,12 @@ package gov_test
 import (
 	"testing"
 
+	"cosmossdk.io/collections"
 	"github.com/initia-labs/initia/x/gov"
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	customtypes "github.com/initia-labs/initia/x/gov/types"
 )
 
@@ -23,3 +26,71 @@ func TestExportImportState(t *testing.T) {
 
 	require.Equal(t, exportedState, genesisState)
 }
+
+func TestExportImportState_DepositOutcomes(t *testing.T) {
+	app := createDefaultApp(t)
+	ctx := app.BaseApp.NewContext(true)
+
+	depositor := sdk.AccAddress("depositor_address___")
+	outcome := customtypes.DepositOutcome{
+		ProposalId: 1,
+		Depositor:  depositor.String(),
+		Outcome:    customtypes.DepositOutcomeBurned,
+		Amount:     sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100)),
+		Burned:     sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100)),
+	}
+	err := app.GovKeeper.DepositOutcomeHistory.Set(ctx, collections.Join(outcome.ProposalId, depositor), outcome)
+	require.NoError(t, err)
+
+	exportedState, err := gov.ExportGenesis(ctx, app.GovKeeper)
+	require.NoError(t, err)
+	require.Len(t, exportedState.DepositOutcomes, 1)
+	require.Equal(t, outcome, *exportedState.DepositOutcomes[0])
+
+	err = app.GovKeeper.DepositOutcomeHistory.Remove(ctx, collections.Join(outcome.ProposalId, depositor))
+	require.NoError(t, err)
+
+	gov.InitGenesis(ctx, app.AccountKeeper, app.BankKeeper, app.GovKeeper, exportedState)
+
+	imported, err := app.GovKeeper.DepositOutcomeHistory.Get(ctx, collections.Join(outcome.ProposalId, depositor))
+	require.NoError(t, err)
+	require.Equal(t, outcome, imported)
+}
+
+func TestExportImportState_VoterBreakdowns(t *testing.T) {
+	app := createDefaultApp(t)
+	ctx := app.BaseApp.NewContext(true)
+
+	voter := sdk.AccAddress("voter_address_______")
+	breakdown := customtypes.VoterBreakdown{
+		ProposalId:   1,
+		Voter:        voter.String(),
+		StakingPower: "100.000000000000000000",
+		VestingPower: "0",
+	}
+	err := app.GovKeeper.VoterBreakdowns.Set(ctx, collections.Join(breakdown.ProposalId, voter), breakdown)
+	require.NoError(t, err)
+	err = app.GovKeeper.VoterBreakdownProposals.Set(ctx, breakdown.ProposalId)
+	require.NoError(t, err)
+
+	exportedState, err := gov.ExportGenesis(ctx, app.GovKeeper)
+	require.NoError(t, err)
+	require.Len(t, exportedState.VoterBreakdowns, 1)
+	require.Equal(t, breakdown, *exportedState.VoterBreakdowns[0])
+	require.Equal(t, []uint64{breakdown.ProposalId}, exportedState.VoterBreakdownProposals)
+
+	err = app.GovKeeper.VoterBreakdowns.Remove(ctx, collections.Join(breakdown.ProposalId, voter))
+	require.NoError(t, err)
+	err = app.GovKeeper.VoterBreakdownProposals.Remove(ctx, breakdown.ProposalId)
+	require.NoError(t, err)
+
+	gov.InitGenesis(ctx, app.AccountKeeper, app.BankKeeper, app.GovKeeper, exportedState)
+
+	imported, err := app.GovKeeper.VoterBreakdowns.Get(ctx, collections.Join(breakdown.ProposalId, voter))
+	require.NoError(t, err)
+	require.Equal(t, breakdown, imported)
+
+	has, err := app.GovKeeper.VoterBreakdownProposals.Has(ctx, breakdown.ProposalId)
+	require.NoError(t, err)
+	require.True(t, has)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -160,3 +161,192 @@ func (q CustomQueryServer) TallyResult(ctx context.Context, req *customtypes.Que
 
 	return &customtypes.QueryTallyResultResponse{TallyResult: tallyResult}, nil
 }
+
+// VoteDelegations queries the vote delegations given by a delegator
+func (q CustomQueryServer) VoteDelegations(ctx context.Context, req *customtypes.QueryVoteDelegationsRequest) (*customtypes.QueryVoteDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	delegator, err := q.Keeper.authKeeper.AddressCodec().StringToBytes(req.Delegator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	delegations, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.DelegatedVotes, req.Pagination, func(_ collections.Pair[sdk.AccAddress, string], delegation customtypes.VoteDelegation) (customtypes.VoteDelegation, error) {
+		return delegation, nil
+	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, string](delegator))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &customtypes.QueryVoteDelegationsResponse{VoteDelegations: delegations, Pagination: pageRes}, nil
+}
+
+// VoteDelegators queries the vote delegations received by a delegate
+func (q CustomQueryServer) VoteDelegators(ctx context.Context, req *customtypes.QueryVoteDelegatorsRequest) (*customtypes.QueryVoteDelegatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	delegate, err := q.Keeper.authKeeper.AddressCodec().StringToBytes(req.Delegate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	delegations, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.DelegatedVoters, req.Pagination, func(key collections.Triple[sdk.AccAddress, sdk.AccAddress, string], _ collections.NoValue) (customtypes.VoteDelegation, error) {
+		return q.Keeper.DelegatedVotes.Get(ctx, collections.Join(key.K2(), key.K3()))
+	}, func(o *query.CollectionsPaginateOptions[collections.Triple[sdk.AccAddress, sdk.AccAddress, string]]) {
+		prefix := collections.TriplePrefix[sdk.AccAddress, sdk.AccAddress, string](delegate)
+		o.Prefix = &prefix
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &customtypes.QueryVoteDelegatorsResponse{VoteDelegations: delegations, Pagination: pageRes}, nil
+}
+
+// ProposalVoterBreakdown queries the archived voter breakdowns of a finalized proposal
+func (q CustomQueryServer) ProposalVoterBreakdown(ctx context.Context, req *customtypes.QueryProposalVoterBreakdownRequest) (*customtypes.QueryProposalVoterBreakdownResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	breakdowns, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.VoterBreakdowns, req.Pagination, func(_ collections.Pair[uint64, sdk.AccAddress], breakdown customtypes.VoterBreakdown) (customtypes.VoterBreakdown, error) {
+		return breakdown, nil
+	}, query.WithCollectionPaginationPairPrefix[uint64, sdk.AccAddress](req.ProposalId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &customtypes.QueryProposalVoterBreakdownResponse{VoterBreakdowns: breakdowns, Pagination: pageRes}, nil
+}
+
+// ProposalDepositOutcomes queries the final outcomes of the deleted deposits of a proposal
+func (q CustomQueryServer) ProposalDepositOutcomes(ctx context.Context, req *customtypes.QueryProposalDepositOutcomesRequest) (*customtypes.QueryProposalDepositOutcomesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	outcomes, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.DepositOutcomeHistory, req.Pagination, func(_ collections.Pair[uint64, sdk.AccAddress], outcome customtypes.DepositOutcome) (customtypes.DepositOutcome, error) {
+		return outcome, nil
+	}, query.WithCollectionPaginationPairPrefix[uint64, sdk.AccAddress](req.ProposalId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &customtypes.QueryProposalDepositOutcomesResponse{DepositOutcomes: outcomes, Pagination: pageRes}, nil
+}
+
+// TallyPreview queries the tally of an active proposal as if the voting ended now. It runs
+// the same tally with the end of the voting period on a cached context to keep the votes.
+func (q CustomQueryServer) TallyPreview(ctx context.Context, req *customtypes.QueryTallyPreviewRequest) (*customtypes.QueryTallyPreviewResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	proposal, err := q.Keeper.Proposals.Get(ctx, req.ProposalId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if proposal.Status != v1.StatusVotingPeriod {
+		return nil, status.Errorf(codes.FailedPrecondition, "proposal %d is not in voting period", req.ProposalId)
+	}
+
+	params, err := q.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+	quorumReached, passed, burnDeposits, tallyResult, err := q.Keeper.Tally(cacheCtx, params, proposal)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &customtypes.QueryTallyPreviewResponse{
+		TallyResult:   tallyResult,
+		QuorumReached: quorumReached,
+		Passed:        passed,
+		BurnDeposits:  burnDeposits,
+		Quorum:        params.GetProposalParams(proposal.MsgTypeURLs()...).Quorum.String(),
+		Threshold:     GetProposalThreshold(params, proposal),
+		LowThreshold:  IsLowThresholdProposal(params, proposal),
+	}, nil
+}
+
+// MoveHooks implements the Query/MoveHooks gRPC method
+func (q CustomQueryServer) MoveHooks(ctx context.Context, req *customtypes.QueryMoveHooksRequest) (*customtypes.QueryMoveHooksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	hooks, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.MoveHookRegistry, req.Pagination, func(_ collections.Pair[sdk.AccAddress, string], hook customtypes.MoveHook) (customtypes.MoveHook, error) {
+		return hook, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &customtypes.QueryMoveHooksResponse{MoveHooks: hooks, Pagination: pageRes}, nil
+}
+
+// SimulateProposal implements the Query/SimulateProposal gRPC method
+func (q CustomQueryServer) SimulateProposal(ctx context.Context, req *customtypes.QuerySimulateProposalRequest) (*customtypes.QuerySimulateProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Messages) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty messages")
+	}
+
+	if len(req.Messages) > customtypes.MaxSimulateProposalMsgs {
+		return nil, status.Errorf(codes.InvalidArgument, "too many messages; max %d", customtypes.MaxSimulateProposalMsgs)
+	}
+
+	msgs, err := req.GetMsgs()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	results, success, gasUsed := q.Keeper.simulateProposalMsgs(ctx, msgs)
+	return &customtypes.QuerySimulateProposalResponse{
+		Results: results,
+		Success: success,
+		GasUsed: gasUsed,
+	}, nil
+}
+
+// QueuedExecutions implements the Query/QueuedExecutions gRPC method
+func (q CustomQueryServer) QueuedExecutions(ctx context.Context, req *customtypes.QueryQueuedExecutionsRequest) (*customtypes.QueryQueuedExecutionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	proposals, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.ExecutionQueue, req.Pagination, func(_ collections.Pair[time.Time, uint64], proposalID uint64) (customtypes.Proposal, error) {
+		return q.Keeper.Proposals.Get(ctx, proposalID)
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &customtypes.QueryQueuedExecutionsResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
@@ -1,17 +1,25 @@
 package keeper_test
 
 import (
+	"encoding/hex"
+	"fmt"
 	"testing"
 	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 
 	"github.com/initia-labs/initia/x/gov/keeper"
 	"github.com/initia-labs/initia/x/gov/types"
+	movetypes "github.com/initia-labs/initia/x/move/types"
+
+	vmtypes "github.com/initia-labs/movevm/types"
 )
 
 func Test_CustomGrpcQuerier_Params(t *testing.T) {
@@ -205,3 +213,219 @@ func Test_CustomGrpcQuerier_TallyResult(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, tallyResults, res.TallyResult)
 }
+
+func Test_CustomGrpcQuerier_ProposalDepositOutcomes(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	input.Faucet.Fund(ctx, addrs[0], sdk.NewCoin(bondDenom, math.NewInt(1_000_000)))
+	input.Faucet.Fund(ctx, addrs[1], sdk.NewCoin(bondDenom, math.NewInt(1_000_000)))
+
+	proposal, err := input.GovKeeper.SubmitProposal(ctx, nil, "", "", "", addrs[0], false)
+	require.NoError(t, err)
+
+	depositAmount := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(100_000)))
+	_, err = input.GovKeeper.AddDeposit(ctx, proposal.Id, addrs[0], depositAmount)
+	require.NoError(t, err)
+	_, err = input.GovKeeper.AddDeposit(ctx, proposal.Id, addrs[1], depositAmount)
+	require.NoError(t, err)
+
+	qs := keeper.NewCustomQueryServer(&input.GovKeeper)
+
+	// no outcomes before the deposits are deleted
+	res, err := qs.ProposalDepositOutcomes(ctx, &types.QueryProposalDepositOutcomesRequest{ProposalId: proposal.Id})
+	require.NoError(t, err)
+	require.Empty(t, res.DepositOutcomes)
+
+	err = input.GovKeeper.RefundAndDeleteDeposits(ctx, proposal.Id)
+	require.NoError(t, err)
+
+	res, err = qs.ProposalDepositOutcomes(ctx, &types.QueryProposalDepositOutcomesRequest{ProposalId: proposal.Id})
+	require.NoError(t, err)
+	require.Len(t, res.DepositOutcomes, 2)
+	for _, outcome := range res.DepositOutcomes {
+		require.Equal(t, proposal.Id, outcome.ProposalId)
+		require.Equal(t, types.DepositOutcomeRefunded, outcome.Outcome)
+		require.Equal(t, depositAmount, outcome.Refunded)
+	}
+
+	// pagination
+	res, err = qs.ProposalDepositOutcomes(ctx, &types.QueryProposalDepositOutcomesRequest{
+		ProposalId: proposal.Id,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.DepositOutcomes, 1)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	_, err = qs.ProposalDepositOutcomes(ctx, &types.QueryProposalDepositOutcomesRequest{ProposalId: 0})
+	require.Error(t, err)
+}
+
+func Test_CustomGrpcQuerier_TallyPreview(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	now := time.Now().UTC()
+	setupVesting(t, ctx, input, now)
+
+	proposal, err := input.GovKeeper.SubmitProposal(ctx, nil, "", "test", "description", addrs[0], false)
+	require.NoError(t, err)
+
+	proposalID := proposal.Id
+	qs := keeper.NewCustomQueryServer(&input.GovKeeper)
+
+	// not in voting period
+	_, err = qs.TallyPreview(ctx, &types.QueryTallyPreviewRequest{ProposalId: proposalID})
+	require.Error(t, err)
+
+	proposal.Status = v1.StatusVotingPeriod
+	err = input.GovKeeper.SetProposal(ctx, proposal)
+	require.NoError(t, err)
+
+	valAddr1 := createValidatorWithCoin(ctx, input,
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		1,
+	)
+	createValidatorWithCoin(ctx, input,
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		2,
+	)
+
+	voterAddr1 := sdk.AccAddress(valAddr1)
+
+	// vote yes
+	err = input.GovKeeper.AddVote(ctx, proposalID, voterAddr1, v1.WeightedVoteOptions{
+		{
+			Option: v1.OptionYes,
+			Weight: "1",
+		},
+	}, "")
+	require.NoError(t, err)
+
+	// add vesting vote
+	vestingVoter := addrs[1]
+	err = input.GovKeeper.AddVote(ctx, proposalID, vestingVoter, v1.WeightedVoteOptions{
+		{
+			Option: v1.OptionNo,
+			Weight: "1",
+		},
+	}, "")
+	require.NoError(t, err)
+
+	// 15 minutes passed
+	ctx = ctx.WithBlockTime(now.Add(time.Minute * 15))
+
+	params, err := input.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	res, err := qs.TallyPreview(ctx, &types.QueryTallyPreviewRequest{ProposalId: proposalID})
+	require.NoError(t, err)
+	require.True(t, res.QuorumReached)
+	require.True(t, res.Passed)
+	require.False(t, res.BurnDeposits)
+	require.Equal(t, params.Quorum, res.Quorum)
+	require.Equal(t, params.Threshold, res.Threshold)
+	require.Equal(t, math.LegacyNewDec(100_000_000).TruncateInt().String(), res.TallyResult.V1TallyResult.YesCount)
+	require.Equal(t, math.LegacyNewDec(1_500_000).TruncateInt().String(), res.TallyResult.V1TallyResult.NoCount)
+	require.Equal(t, "1500000", res.TallyResult.TotalVestingPower)
+
+	// the votes are kept
+	has, err := input.GovKeeper.Votes.Has(ctx, collections.Join(proposalID, voterAddr1))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// the preview matches the end of voting computation
+	quorumReached, passed, burnDeposits, tallyResults, err := input.GovKeeper.Tally(ctx, params, proposal)
+	require.NoError(t, err)
+	require.Equal(t, quorumReached, res.QuorumReached)
+	require.Equal(t, passed, res.Passed)
+	require.Equal(t, burnDeposits, res.BurnDeposits)
+	require.Equal(t, tallyResults, res.TallyResult)
+}
+
+func Test_CustomGrpcQuerier_SimulateProposal(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	govAddr := input.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+	_, _, newAddr := keyPubAddr()
+
+	createAccountMsg := &movetypes.MsgGovExecuteJSON{
+		Authority:     govAddr.String(),
+		Sender:        govAddr.String(),
+		ModuleAddress: vmtypes.StdAddress.String(),
+		ModuleName:    "account",
+		FunctionName:  "create_account_script",
+		Args:          []string{fmt.Sprintf("\"0x%s\"", hex.EncodeToString(newAddr))},
+	}
+
+	qs := keeper.NewCustomQueryServer(&input.GovKeeper)
+
+	// empty messages
+	_, err := qs.SimulateProposal(ctx, &types.QuerySimulateProposalRequest{})
+	require.Error(t, err)
+
+	// successful execution
+	req, err := types.NewQuerySimulateProposalRequest([]sdk.Msg{createAccountMsg})
+	require.NoError(t, err)
+
+	res, err := qs.SimulateProposal(ctx, req)
+	require.NoError(t, err)
+	require.True(t, res.Success, res.Results)
+	require.Len(t, res.Results, 1)
+	require.True(t, res.Results[0].Success)
+	require.Equal(t, sdk.MsgTypeURL(createAccountMsg), res.Results[0].MsgTypeUrl)
+	require.NotEmpty(t, res.Results[0].Events)
+	require.Positive(t, res.Results[0].GasUsed)
+	require.Equal(t, res.Results[0].GasUsed, res.GasUsed)
+
+	// the state changes are discarded
+	require.False(t, input.AccountKeeper.HasAccount(ctx, newAddr))
+
+	// the state changes are shared between the messages and the execution
+	// stops at the first failed message
+	req, err = types.NewQuerySimulateProposalRequest([]sdk.Msg{createAccountMsg, createAccountMsg, createAccountMsg})
+	require.NoError(t, err)
+
+	res, err = qs.SimulateProposal(ctx, req)
+	require.NoError(t, err)
+	require.False(t, res.Success)
+	require.Len(t, res.Results, 2)
+	require.True(t, res.Results[0].Success)
+	require.False(t, res.Results[1].Success)
+	require.NotEmpty(t, res.Results[1].Error)
+
+	// the gov module account must be the signer
+	invalidMsg := *createAccountMsg
+	invalidMsg.Authority = addrs[0].String()
+	req, err = types.NewQuerySimulateProposalRequest([]sdk.Msg{&invalidMsg})
+	require.NoError(t, err)
+
+	res, err = qs.SimulateProposal(ctx, req)
+	require.NoError(t, err)
+	require.False(t, res.Success)
+	require.Len(t, res.Results, 1)
+	require.Contains(t, res.Results[0].Error, "signer")
+
+	// too many messages
+	msgs := make([]sdk.Msg, types.MaxSimulateProposalMsgs+1)
+	for i := range msgs {
+		msgs[i] = createAccountMsg
+	}
+	req, err = types.NewQuerySimulateProposalRequest(msgs)
+	require.NoError(t, err)
+
+	_, err = qs.SimulateProposal(ctx, req)
+	require.Error(t, err)
+
+	// bounded by the gas remaining in the context gas meter
+	req, err = types.NewQuerySimulateProposalRequest([]sdk.Msg{createAccountMsg})
+	require.NoError(t, err)
+
+	gasCtx := ctx.WithGasMeter(storetypes.NewGasMeter(1_000))
+	res, err = qs.SimulateProposal(gasCtx, req)
+	require.NoError(t, err)
+	require.False(t, res.Success)
+	require.Contains(t, res.Results[0].Error, "out of gas")
+	require.Equal(t, uint64(1_000), gasCtx.GasMeter().GasConsumed())
+}
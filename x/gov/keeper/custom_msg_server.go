@@ -2,10 +2,14 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 
 	customtypes "github.com/initia-labs/initia/x/gov/types"
 )
@@ -37,3 +41,120 @@ func (k customMsgServer) UpdateParams(ctx context.Context, req *customtypes.MsgU
 
 	return &customtypes.MsgUpdateParamsResponse{}, nil
 }
+
+// DelegateVote implements MsgServer.DelegateVote method.
+func (k customMsgServer) DelegateVote(ctx context.Context, req *customtypes.MsgDelegateVote) (*customtypes.MsgDelegateVoteResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(req.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	delegate, err := k.authKeeper.AddressCodec().StringToBytes(req.Delegate)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.SetVoteDelegation(ctx, delegator, delegate, req.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			customtypes.EventTypeDelegateVote,
+			sdk.NewAttribute(customtypes.AttributeKeyDelegator, req.Delegator),
+			sdk.NewAttribute(customtypes.AttributeKeyDelegate, req.Delegate),
+			sdk.NewAttribute(customtypes.AttributeKeyMsgTypeURL, req.MsgTypeUrl),
+		),
+	)
+
+	return &customtypes.MsgDelegateVoteResponse{}, nil
+}
+
+// UndelegateVote implements MsgServer.UndelegateVote method.
+func (k customMsgServer) UndelegateVote(ctx context.Context, req *customtypes.MsgUndelegateVote) (*customtypes.MsgUndelegateVoteResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(req.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.RemoveVoteDelegation(ctx, delegator, req.MsgTypeUrl); err != nil && errors.IsOf(err, collections.ErrNotFound) {
+		return nil, errors.Wrap(customtypes.ErrInvalidVoteDelegation, "vote delegation not found")
+	} else if err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			customtypes.EventTypeUndelegateVote,
+			sdk.NewAttribute(customtypes.AttributeKeyDelegator, req.Delegator),
+			sdk.NewAttribute(customtypes.AttributeKeyMsgTypeURL, req.MsgTypeUrl),
+		),
+	)
+
+	return &customtypes.MsgUndelegateVoteResponse{}, nil
+}
+
+// CancelQueuedExecution implements MsgServer.CancelQueuedExecution method.
+func (k customMsgServer) CancelQueuedExecution(ctx context.Context, req *customtypes.MsgCancelQueuedExecution) (*customtypes.MsgCancelQueuedExecutionResponse, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.authority != req.Authority && !params.IsExecutionCanceller(req.Authority) {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s or execution cancellers, got %s", k.authority, req.Authority)
+	}
+
+	if err := k.CancelExecution(ctx, req.ProposalId, req.Reason); err != nil {
+		return nil, err
+	}
+
+	return &customtypes.MsgCancelQueuedExecutionResponse{}, nil
+}
+
+func (k customMsgServer) SubmitOptimisticProposal(ctx context.Context, req *customtypes.MsgSubmitOptimisticProposal) (*customtypes.MsgSubmitOptimisticProposalResponse, error) {
+	proposalID, err := k.submitProposalMsg(ctx, &v1.MsgSubmitProposal{
+		Messages:       req.Messages,
+		InitialDeposit: req.InitialDeposit,
+		Proposer:       req.Proposer,
+		Metadata:       req.Metadata,
+		Title:          req.Title,
+		Summary:        req.Summary,
+	}, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &customtypes.MsgSubmitOptimisticProposalResponse{ProposalId: proposalID}, nil
+}
+
+// UpdateMoveHook implements MsgServer.UpdateMoveHook method.
+func (k customMsgServer) UpdateMoveHook(ctx context.Context, req *customtypes.MsgUpdateMoveHook) (*customtypes.MsgUpdateMoveHookResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	// only the module of the hook is required to remove it
+	if req.Allowed {
+		if err := req.Hook.Validate(k.authKeeper.AddressCodec()); err != nil {
+			return nil, err
+		}
+
+		if err := k.SetMoveHook(ctx, req.Hook); err != nil {
+			return nil, err
+		}
+	} else if err := k.RemoveMoveHook(ctx, req.Hook); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			customtypes.EventTypeUpdateMoveHook,
+			sdk.NewAttribute(customtypes.AttributeKeyModuleAddr, req.Hook.ModuleAddr),
+			sdk.NewAttribute(customtypes.AttributeKeyModuleName, req.Hook.ModuleName),
+			sdk.NewAttribute(customtypes.AttributeKeyAllowed, strconv.FormatBool(req.Allowed)),
+		),
+	)
+
+	return &customtypes.MsgUpdateMoveHookResponse{}, nil
+}
@@ -41,6 +41,13 @@ func (k Keeper) DeleteAndBurnDeposits(ctx context.Context, proposalID uint64) er
 	coinsToBurn := sdk.NewCoins()
 	err := k.IterateDeposits(ctx, proposalID, func(key collections.Pair[uint64, sdk.AccAddress], deposit v1.Deposit) (stop bool, err error) {
 		coinsToBurn = coinsToBurn.Add(deposit.Amount...)
+		err = k.setDepositOutcome(ctx, deposit, customtypes.DepositOutcome{
+			Outcome: customtypes.DepositOutcomeBurned,
+			Burned:  deposit.Amount,
+		})
+		if err != nil {
+			return false, err
+		}
 		return false, k.Deposits.Remove(ctx, key)
 	})
 	if err != nil {
@@ -151,8 +158,15 @@ func (k Keeper) AddDeposit(ctx context.Context, proposalID uint64, depositorAddr
 		}
 	}
 
+	depositorStr, err := k.authKeeper.AddressCodec().BytesToString(depositorAddr)
+	if err != nil {
+		return false, err
+	}
+
 	// It needs in case that a previous deposit only activates voting period and second deposit activates emergency proposal. activatedVotingPeriod is false at that time
-	if proposal.Status == v1.StatusVotingPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(params.EmergencyMinDeposit) {
+	// If the emergency submitters are set, only the deposits from them can activate emergency proposal.
+	// The optimistic proposals cannot be activated as emergency proposal.
+	if proposal.Status == v1.StatusVotingPeriod && !proposal.Optimistic && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(params.EmergencyMinDeposit) && params.IsEmergencySubmitter(depositorStr) {
 		err = k.ActivateEmergencyProposal(ctx, proposal)
 		if err != nil {
 			return false, err
@@ -179,6 +193,11 @@ func (k Keeper) AddDeposit(ctx context.Context, proposalID uint64, depositorAddr
 		return false, err
 	}
 
+	err = k.CallMoveHooks(ctx, proposalID, customtypes.MoveHookEventDeposited, depositorAddr)
+	if err != nil {
+		return false, err
+	}
+
 	depositorStrAddr, err := k.authKeeper.AddressCodec().BytesToString(depositorAddr)
 	if err != nil {
 		return false, err
@@ -220,7 +239,7 @@ func (k Keeper) ChargeDeposit(ctx context.Context, proposalID uint64, destAddres
 			return err
 		}
 
-		var remainingAmount sdk.Coins
+		var remainingAmount, chargedAmount sdk.Coins
 
 		for _, coin := range deposit.Amount {
 			burnAmount := sdkmath.LegacyNewDecFromInt(coin.Amount).Mul(rate).TruncateInt()
@@ -231,13 +250,14 @@ func (k Keeper) ChargeDeposit(ctx context.Context, proposalID uint64, destAddres
 					coin.Amount.Sub(burnAmount),
 				),
 			)
-			cancellationCharges = cancellationCharges.Add(
+			chargedAmount = chargedAmount.Add(
 				sdk.NewCoin(
 					coin.Denom,
 					burnAmount,
 				),
 			)
 		}
+		cancellationCharges = cancellationCharges.Add(chargedAmount...)
 
 		if !remainingAmount.IsZero() {
 			err := k.bankKeeper.SendCoinsFromModuleToAccount(
@@ -247,6 +267,22 @@ func (k Keeper) ChargeDeposit(ctx context.Context, proposalID uint64, destAddres
 				return err
 			}
 		}
+
+		outcome := customtypes.DepositOutcome{
+			Outcome:  customtypes.DepositOutcomeCharged,
+			Refunded: remainingAmount,
+		}
+		if destAddress == "" {
+			outcome.Burned = chargedAmount
+		} else {
+			outcome.Charged = chargedAmount
+			outcome.Destination = destAddress
+		}
+		err = k.setDepositOutcome(ctx, *deposit, outcome)
+		if err != nil {
+			return err
+		}
+
 		err = k.Deposits.Remove(ctx, collections.Join(deposit.ProposalId, sdk.AccAddress(depositorAddress)))
 		if err != nil {
 			return err
@@ -294,15 +330,38 @@ func (k Keeper) RefundAndDeleteDeposits(ctx context.Context, proposalID uint64)
 		if err != nil {
 			return false, err
 		}
+		err = k.setDepositOutcome(ctx, deposit, customtypes.DepositOutcome{
+			Outcome:  customtypes.DepositOutcomeRefunded,
+			Refunded: deposit.Amount,
+		})
+		if err != nil {
+			return false, err
+		}
 		err = k.Deposits.Remove(ctx, key)
 		return false, err
 	})
 }
 
+// setDepositOutcome records the final outcome of the deposit before the deposit is deleted.
+func (k Keeper) setDepositOutcome(ctx context.Context, deposit v1.Deposit, outcome customtypes.DepositOutcome) error {
+	depositor, err := k.authKeeper.AddressCodec().StringToBytes(deposit.Depositor)
+	if err != nil {
+		return err
+	}
+
+	outcome.ProposalId = deposit.ProposalId
+	outcome.Depositor = deposit.Depositor
+	outcome.Amount = deposit.Amount
+	outcome.Height = sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	return k.DepositOutcomeHistory.Set(ctx, collections.Join(deposit.ProposalId, sdk.AccAddress(depositor)), outcome)
+}
+
 // validateInitialDeposit validates if initial deposit is greater than or equal to the minimum
 // required at the time of proposal submission. This threshold amount is determined by
-// the deposit parameters. Returns nil on success, error otherwise.
-func validateInitialDeposit(params customtypes.Params, initialDeposit sdk.Coins, expedited bool) error {
+// the deposit parameters and the msg type params of the proposal messages. Returns nil on
+// success, error otherwise.
+func validateInitialDeposit(params customtypes.Params, initialDeposit sdk.Coins, expedited bool, msgTypeURLs ...string) error {
 	if !initialDeposit.IsValid() || initialDeposit.IsAnyNegative() {
 		return errors.Wrap(sdkerrors.ErrInvalidCoins, initialDeposit.String())
 	}
@@ -315,15 +374,10 @@ func validateInitialDeposit(params customtypes.Params, initialDeposit sdk.Coins,
 		return nil
 	}
 
-	var minDepositCoins sdk.Coins
-	if expedited {
-		minDepositCoins = params.ExpeditedMinDeposit
-	} else {
-		minDepositCoins = params.MinDeposit
-	}
-
-	for i := range minDepositCoins {
-		minDepositCoins[i].Amount = sdkmath.LegacyNewDecFromInt(minDepositCoins[i].Amount).Mul(minInitialDepositRatio).RoundInt()
+	minDeposit := params.GetProposalMinDeposit(expedited, msgTypeURLs...)
+	minDepositCoins := make(sdk.Coins, len(minDeposit))
+	for i, coin := range minDeposit {
+		minDepositCoins[i] = sdk.NewCoin(coin.Denom, sdkmath.LegacyNewDecFromInt(coin.Amount).Mul(minInitialDepositRatio).RoundInt())
 	}
 	if !initialDeposit.IsAllGTE(minDepositCoins) {
 		return errors.Wrapf(types.ErrMinDepositTooSmall, "was (%s), need (%s)", initialDeposit, minDepositCoins)
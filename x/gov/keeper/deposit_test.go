@@ -3,13 +3,18 @@ package keeper_test
 import (
 	"sort"
 	"testing"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/stretchr/testify/require"
+
+	customtypes "github.com/initia-labs/initia/x/gov/types"
 )
 
 func TestSimpleDeposits(t *testing.T) {
@@ -119,6 +124,13 @@ func TestSimpleBurnDeposits(t *testing.T) {
 	afterBurnBalance2 := input.BankKeeper.GetBalance(ctx, addrs[1], bondDenom)
 	require.Equal(t, afterBurnBalance2.Amount, math.NewInt(9950))
 
+	outcome, err := input.GovKeeper.DepositOutcomeHistory.Get(ctx, collections.Join(uint64(2), addrs[0]))
+	require.NoError(t, err)
+	require.Equal(t, customtypes.DepositOutcomeBurned, outcome.Outcome)
+	require.Equal(t, sdk.Coins(deposit1Amount), outcome.Amount)
+	require.Equal(t, sdk.Coins(deposit1Amount), outcome.Burned)
+	require.True(t, outcome.Refunded.IsZero())
+
 	deposits2, err := input.GovKeeper.GetDeposits(ctx, 5)
 	require.NoError(t, err)
 	sort.Slice(deposits2, func(i, j int) bool { return deposits2[i].GetDepositor() < deposits2[j].GetDepositor() })
@@ -168,6 +180,13 @@ func TestSimpleRefundDeposits(t *testing.T) {
 	require.Equal(t, afterRefundBalance1.Amount, math.NewInt(10000))
 	afterRefundBalance2 := input.BankKeeper.GetBalance(ctx, addrs[1], bondDenom)
 	require.Equal(t, afterRefundBalance2.Amount, math.NewInt(10000))
+
+	outcome, err := input.GovKeeper.DepositOutcomeHistory.Get(ctx, collections.Join(uint64(2), addrs[1]))
+	require.NoError(t, err)
+	require.Equal(t, customtypes.DepositOutcomeRefunded, outcome.Outcome)
+	require.Equal(t, sdk.Coins(deposit2Amount), outcome.Amount)
+	require.Equal(t, sdk.Coins(deposit2Amount), outcome.Refunded)
+	require.True(t, outcome.Burned.IsZero())
 }
 
 func TestSimpleAddDeposit(t *testing.T) {
@@ -348,6 +367,98 @@ func TestActivateVotingPeriodAndEmergency(t *testing.T) {
 	require.True(t, prop.Emergency)
 }
 
+func TestEmergencySubmitters(t *testing.T) {
+	initAmount := math.NewInt(10000000)
+	minDepositRatio := "0.01"
+	bondDenomMinDeposit := math.NewInt(10000)
+	emergencyMinDeposit := math.NewInt(100000)
+
+	ctx, input := createDefaultTestInput(t)
+	params, err := input.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	params.MinDepositRatio = minDepositRatio
+	params.MinDeposit = sdk.Coins{sdk.NewCoin(bondDenom, bondDenomMinDeposit)}
+	params.EmergencyMinDeposit = sdk.Coins{sdk.NewCoin(bondDenom, emergencyMinDeposit)}
+	params.EmergencySubmitters = []string{addrs[1].String()}
+
+	err = input.GovKeeper.Params.Set(ctx, params)
+	require.NoError(t, err)
+
+	input.Faucet.Fund(ctx, addrs[0], sdk.NewCoin(bondDenom, initAmount))
+	input.Faucet.Fund(ctx, addrs[1], sdk.NewCoin(bondDenom, initAmount))
+
+	proposal, err := input.GovKeeper.SubmitProposal(ctx, nil, "", "", "", addrs[0], false)
+	require.NoError(t, err)
+	require.Equal(t, proposal.Id, uint64(1))
+
+	// not allowed submitter cannot activate emergency proposal
+	isActivated, err := input.GovKeeper.AddDeposit(ctx, 1, addrs[0], []sdk.Coin{sdk.NewCoin(bondDenom, emergencyMinDeposit)})
+	require.NoError(t, err)
+	require.True(t, isActivated)
+
+	prop, err := input.GovKeeper.Proposals.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, prop.Status, v1.StatusVotingPeriod)
+	require.False(t, prop.Emergency)
+
+	// allowed submitter can activate emergency proposal
+	isActivated, err = input.GovKeeper.AddDeposit(ctx, 1, addrs[1], []sdk.Coin{sdk.NewCoin(bondDenom, bondDenomMinDeposit)})
+	require.NoError(t, err)
+	require.False(t, isActivated)
+
+	prop, err = input.GovKeeper.Proposals.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, prop.Status, v1.StatusVotingPeriod)
+	require.True(t, prop.Emergency)
+}
+
+func TestMsgTypeParamsDeposit(t *testing.T) {
+	initAmount := math.NewInt(10000000)
+	minDepositRatio := "0.01"
+	bondDenomMinDeposit := math.NewInt(10000)
+	votingPeriod := time.Hour * 24 * 7
+
+	ctx, input := createDefaultTestInput(t)
+	params, err := input.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	govAddr := authtypes.NewModuleAddress(types.ModuleName)
+	sendMsg := banktypes.NewMsgSend(govAddr, addrs[0], sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1))))
+
+	params.MinDepositRatio = minDepositRatio
+	params.MinDeposit = sdk.Coins{sdk.NewCoin(bondDenom, bondDenomMinDeposit)}
+	params.MsgTypeParams = []customtypes.MsgTypeParams{{
+		MsgTypeUrl:   sdk.MsgTypeURL(sendMsg),
+		MinDeposit:   sdk.Coins{sdk.NewCoin(bondDenom, bondDenomMinDeposit.MulRaw(2))},
+		VotingPeriod: &votingPeriod,
+	}}
+
+	err = input.GovKeeper.Params.Set(ctx, params)
+	require.NoError(t, err)
+
+	input.Faucet.Fund(ctx, addrs[0], sdk.NewCoin(bondDenom, initAmount))
+
+	proposal, err := input.GovKeeper.SubmitProposal(ctx, []sdk.Msg{sendMsg}, "", "", "", addrs[0], false)
+	require.NoError(t, err)
+	require.Equal(t, proposal.Id, uint64(1))
+
+	// the global min deposit is not enough for the proposal
+	isActivated, err := input.GovKeeper.AddDeposit(ctx, 1, addrs[0], []sdk.Coin{sdk.NewCoin(bondDenom, bondDenomMinDeposit)})
+	require.NoError(t, err)
+	require.False(t, isActivated)
+
+	isActivated, err = input.GovKeeper.AddDeposit(ctx, 1, addrs[0], []sdk.Coin{sdk.NewCoin(bondDenom, bondDenomMinDeposit)})
+	require.NoError(t, err)
+	require.True(t, isActivated)
+
+	// the voting period of the msg type params is applied
+	prop, err := input.GovKeeper.Proposals.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, prop.Status, v1.StatusVotingPeriod)
+	require.Equal(t, prop.VotingStartTime.Add(votingPeriod), *prop.VotingEndTime)
+}
+
 func TestChargeDeposit(t *testing.T) {
 	initAmount := math.NewInt(10000000)
 	minDepositRatio := "0.01"
@@ -436,6 +547,20 @@ func TestChargeDeposit(t *testing.T) {
 			chargedDeposit2 := tc.deposit2.ToLegacyDec().Mul(rate).TruncateInt()
 			require.Equal(t, afterChargeBalance1.Amount, initAmount.Sub(chargedDeposit1))
 			require.Equal(t, afterChargeBalance2.Amount, initAmount.Sub(chargedDeposit2))
+
+			outcome, err := input.GovKeeper.DepositOutcomeHistory.Get(ctx, collections.Join(tc.proposalId, addrs[1]))
+			require.NoError(t, err)
+			require.Equal(t, customtypes.DepositOutcomeCharged, outcome.Outcome)
+			require.Equal(t, sdk.NewCoins(deposit2), outcome.Amount)
+			require.Equal(t, sdk.NewCoins(sdk.NewCoin(bondDenom, tc.deposit2.Sub(chargedDeposit2))), outcome.Refunded)
+			require.Equal(t, tc.destAddress, outcome.Destination)
+			if tc.destAddress == "" {
+				require.Equal(t, sdk.NewCoins(sdk.NewCoin(bondDenom, chargedDeposit2)), outcome.Burned)
+				require.True(t, outcome.Charged.IsZero())
+			} else {
+				require.Equal(t, sdk.NewCoins(sdk.NewCoin(bondDenom, chargedDeposit2)), outcome.Charged)
+				require.True(t, outcome.Burned.IsZero())
+			}
 		})
 	}
 }
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	customtypes "github.com/initia-labs/initia/x/gov/types"
+)
+
+// GetExecutionDelay returns the execution delay of the proposal, which is the longest
+// delay of its messages. The emergency proposals are executed without a delay.
+func GetExecutionDelay(params customtypes.Params, proposal customtypes.Proposal) time.Duration {
+	if proposal.Emergency {
+		return 0
+	}
+
+	return params.GetExecutionDelay(proposal.MsgTypeURLs()...)
+}
+
+// QueueExecution queues the execution of the passed proposal at the given time.
+func (k Keeper) QueueExecution(ctx context.Context, proposal customtypes.Proposal, executionTime time.Time) (customtypes.Proposal, error) {
+	proposal.ExecutionTime = &executionTime
+	if err := k.ExecutionQueue.Set(ctx, collections.Join(executionTime, proposal.Id), proposal.Id); err != nil {
+		return proposal, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			customtypes.EventTypeQueueExecution,
+			sdk.NewAttribute(customtypes.AttributeKeyProposalID, strconv.FormatUint(proposal.Id, 10)),
+			sdk.NewAttribute(customtypes.AttributeKeyExecutionTime, executionTime.String()),
+		),
+	)
+
+	return proposal, nil
+}
+
+// DequeueExecution removes the proposal from the execution queue.
+func (k Keeper) DequeueExecution(ctx context.Context, proposal customtypes.Proposal) (customtypes.Proposal, error) {
+	if proposal.ExecutionTime == nil {
+		return proposal, errors.Wrapf(customtypes.ErrExecutionNotQueued, "%d", proposal.Id)
+	}
+
+	if err := k.ExecutionQueue.Remove(ctx, collections.Join(*proposal.ExecutionTime, proposal.Id)); err != nil {
+		return proposal, err
+	}
+
+	proposal.ExecutionTime = nil
+	return proposal, nil
+}
+
+// CancelExecution cancels the queued execution of the passed proposal and marks the
+// proposal as failed.
+func (k Keeper) CancelExecution(ctx context.Context, proposalID uint64, reason string) error {
+	proposal, err := k.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	proposal, err = k.DequeueExecution(ctx, proposal)
+	if err != nil {
+		return err
+	}
+
+	proposal.Status = v1.StatusFailed
+	proposal.FailedReason = "proposal execution cancelled: " + reason
+	if err := k.SetProposal(ctx, proposal); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			customtypes.EventTypeCancelQueuedExecution,
+			sdk.NewAttribute(customtypes.AttributeKeyProposalID, strconv.FormatUint(proposalID, 10)),
+			sdk.NewAttribute(customtypes.AttributeKeyReason, reason),
+		),
+	)
+
+	return nil
+}
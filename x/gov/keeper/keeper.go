@@ -25,6 +25,7 @@ type Keeper struct {
 	bankKeeper    types.BankKeeper
 	distrKeeper   types.DistributionKeeper
 	vestingKeeper customtypes.VestingKeeper
+	moveGovKeeper customtypes.MoveGovKeeper
 
 	// The reference to the DelegationSet and ValidatorSet to get information about validators and delegators
 	sk customtypes.StakingKeeper
@@ -62,6 +63,13 @@ type Keeper struct {
 	EmergencyProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64]
 	VotingPeriodProposals   collections.Map[uint64, []byte] // TODO(tip): this could be a keyset or index.
 	EmergencyProposals      collections.Map[uint64, []byte]
+	DelegatedVotes          collections.Map[collections.Pair[sdk.AccAddress, string], customtypes.VoteDelegation]
+	DelegatedVoters         collections.KeySet[collections.Triple[sdk.AccAddress, sdk.AccAddress, string]]
+	VoterBreakdowns         collections.Map[collections.Pair[uint64, sdk.AccAddress], customtypes.VoterBreakdown]
+	VoterBreakdownProposals collections.KeySet[uint64]
+	ExecutionQueue          collections.Map[collections.Pair[time.Time, uint64], uint64]
+	MoveHookRegistry        collections.Map[collections.Pair[sdk.AccAddress, string], customtypes.MoveHook]
+	DepositOutcomeHistory   collections.Map[collections.Pair[uint64, sdk.AccAddress], customtypes.DepositOutcome]
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -74,7 +82,8 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.Codec, storeService corestoretypes.KVStoreService, authKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper, sk customtypes.StakingKeeper, distrKeeper types.DistributionKeeper,
-	vestingKeeper customtypes.VestingKeeper, router baseapp.MessageRouter, config types.Config, authority string,
+	vestingKeeper customtypes.VestingKeeper, moveGovKeeper customtypes.MoveGovKeeper, router baseapp.MessageRouter,
+	config types.Config, authority string,
 ) *Keeper {
 	// ensure governance module account is set
 	if addr := authKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -98,6 +107,7 @@ func NewKeeper(
 		bankKeeper:              bankKeeper,
 		distrKeeper:             distrKeeper,
 		vestingKeeper:           vestingKeeper,
+		moveGovKeeper:           moveGovKeeper,
 		sk:                      sk,
 		router:                  router,
 		config:                  config,
@@ -113,6 +123,13 @@ func NewKeeper(
 		EmergencyProposalsQueue: collections.NewMap(sb, customtypes.EmergencyProposalQueuePrefix, "emergency_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),
 		VotingPeriodProposals:   collections.NewMap(sb, types.VotingPeriodProposalKeyPrefix, "voting_period_proposals", collections.Uint64Key, collections.BytesValue),
 		EmergencyProposals:      collections.NewMap(sb, customtypes.EmergencyProposalsPrefix, "emergency_proposals", collections.Uint64Key, collections.BytesValue),
+		DelegatedVotes:          collections.NewMap(sb, customtypes.VoteDelegationsPrefix, "vote_delegations", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), codec.CollValue[customtypes.VoteDelegation](cdc)),
+		DelegatedVoters:         collections.NewKeySet(sb, customtypes.VoteDelegatorsPrefix, "vote_delegators", collections.TripleKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey, collections.StringKey)),
+		VoterBreakdowns:         collections.NewMap(sb, customtypes.VoterBreakdownsPrefix, "voter_breakdowns", collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey), codec.CollValue[customtypes.VoterBreakdown](cdc)),
+		VoterBreakdownProposals: collections.NewKeySet(sb, customtypes.BreakdownProposalsPrefix, "voter_breakdown_proposals", collections.Uint64Key),
+		ExecutionQueue:          collections.NewMap(sb, customtypes.ExecutionQueuePrefix, "execution_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),
+		MoveHookRegistry:        collections.NewMap(sb, customtypes.MoveHooksPrefix, "move_hooks", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), codec.CollValue[customtypes.MoveHook](cdc)),
+		DepositOutcomeHistory:   collections.NewMap(sb, customtypes.DepositOutcomesPrefix, "deposit_outcomes", collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey), codec.CollValue[customtypes.DepositOutcome](cdc)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
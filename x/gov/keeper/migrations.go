@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	customtypes "github.com/initia-labs/initia/x/gov/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	k *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k *Keeper) Migrator {
+	return Migrator{k: k}
+}
+
+// Migrate1to2 migrates the gov params from version 1 to 2. The params added in
+// version 2 are filled with their defaults, which keep the previous behavior.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.EmergencyEscalationThreshold == "" {
+		params.EmergencyEscalationThreshold = customtypes.DefaultEmergencyEscalationThreshold.String()
+	}
+	if params.OptimisticRejectionThreshold == "" {
+		params.OptimisticRejectionThreshold = customtypes.DefaultOptimisticRejectionThreshold.String()
+	}
+	if params.MsgTypeParams == nil {
+		params.MsgTypeParams = []customtypes.MsgTypeParams{}
+	}
+
+	if err := params.Validate(m.k.authKeeper.AddressCodec()); err != nil {
+		return err
+	}
+
+	return m.k.Params.Set(ctx, params)
+}
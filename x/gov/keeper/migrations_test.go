@@ -0,0 +1,31 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/initia/x/gov/keeper"
+	customtypes "github.com/initia-labs/initia/x/gov/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	// the params stored by the version 1
+	params, err := input.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.EmergencyEscalationThreshold = ""
+	params.OptimisticRejectionThreshold = ""
+	params.MsgTypeParams = nil
+	require.NoError(t, input.GovKeeper.Params.Set(ctx, params))
+
+	m := keeper.NewMigrator(&input.GovKeeper)
+	require.NoError(t, m.Migrate1to2(ctx))
+
+	params, err = input.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, customtypes.DefaultEmergencyEscalationThreshold.String(), params.EmergencyEscalationThreshold)
+	require.Equal(t, customtypes.DefaultOptimisticRejectionThreshold.String(), params.OptimisticRejectionThreshold)
+	require.Empty(t, params.MsgTypeParams)
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	customtypes "github.com/initia-labs/initia/x/gov/types"
+)
+
+// SetMoveHook registers the Move hook, replacing the existing hook of the module.
+func (k Keeper) SetMoveHook(ctx context.Context, hook customtypes.MoveHook) error {
+	moduleAddr, err := k.authKeeper.AddressCodec().StringToBytes(hook.ModuleAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.MoveHookRegistry.Set(ctx, collections.Join(sdk.AccAddress(moduleAddr), hook.ModuleName), hook)
+}
+
+// RemoveMoveHook removes the Move hook of the module.
+func (k Keeper) RemoveMoveHook(ctx context.Context, hook customtypes.MoveHook) error {
+	moduleAddr, err := k.authKeeper.AddressCodec().StringToBytes(hook.ModuleAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.MoveHookRegistry.Remove(ctx, collections.Join(sdk.AccAddress(moduleAddr), hook.ModuleName))
+}
+
+// moveHookContextKey marks the context of the Move hook execution.
+type moveHookContextKey struct{}
+
+// CallMoveHooks calls the registered Move hooks with the proposal lifecycle event. Each hook
+// is called in a cached context with its own gas budget, and the failure of a hook is only
+// logged and emitted as an event not to affect the proposal and the other hooks. The hooks
+// are not called again for the gov messages dispatched by a hook.
+func (k Keeper) CallMoveHooks(ctx context.Context, proposalID uint64, event string, account sdk.AccAddress) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdkCtx.Value(moveHookContextKey{}) != nil {
+		return nil
+	}
+
+	hookCtx := sdkCtx.WithValue(moveHookContextKey{}, true)
+	return k.MoveHookRegistry.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string], hook customtypes.MoveHook) (stop bool, err error) {
+		cacheCtx, writeCache := hookCtx.CacheContext()
+		err = k.moveGovKeeper.ExecuteGovHook(cacheCtx, key.K1(), key.K2(), hook.FunctionName, proposalID, event, account, hook.GasLimit)
+		if err == nil {
+			writeCache()
+		} else {
+			k.Logger(ctx).Error("failed to execute move hook", "module_addr", hook.ModuleAddr, "module_name", hook.ModuleName, "event", event, "error", err)
+		}
+
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(customtypes.AttributeKeyProposalID, strconv.FormatUint(proposalID, 10)),
+			sdk.NewAttribute(customtypes.AttributeKeyModuleAddr, hook.ModuleAddr),
+			sdk.NewAttribute(customtypes.AttributeKeyModuleName, hook.ModuleName),
+			sdk.NewAttribute(customtypes.AttributeKeyHookEvent, event),
+			sdk.NewAttribute(customtypes.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+		}
+		if err != nil {
+			attrs = append(attrs, sdk.NewAttribute(customtypes.AttributeKeyError, err.Error()))
+		}
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(customtypes.EventTypeMoveHook, attrs...))
+
+		return false, nil
+	})
+}
+
+// CallProposalMoveHooks calls the registered Move hooks with the proposal lifecycle event
+// and the proposer of the proposal.
+func (k Keeper) CallProposalMoveHooks(ctx context.Context, proposal customtypes.Proposal, event string) error {
+	proposer, err := k.authKeeper.AddressCodec().StringToBytes(proposal.Proposer)
+	if err != nil {
+		return err
+	}
+
+	return k.CallMoveHooks(ctx, proposal.Id, event, proposer)
+}
+
+// MoveHookEventFromStatus returns the Move hook event of the proposal ended with the given status.
+func MoveHookEventFromStatus(status v1.ProposalStatus) string {
+	switch status {
+	case v1.StatusPassed:
+		return customtypes.MoveHookEventPassed
+	case v1.StatusRejected:
+		return customtypes.MoveHookEventRejected
+	default:
+		return customtypes.MoveHookEventFailed
+	}
+}
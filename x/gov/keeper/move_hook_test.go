@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/initia-labs/initia/x/gov/keeper"
+	"github.com/initia-labs/initia/x/gov/types"
+)
+
+func Test_CustomMsgServer_UpdateMoveHook(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	hook := types.MoveHook{
+		ModuleAddr:   addrs[0].String(),
+		ModuleName:   "hook",
+		FunctionName: "on_event",
+		GasLimit:     100_000,
+	}
+
+	ms := keeper.NewCustomMsgServerImpl(&input.GovKeeper)
+	_, err := ms.UpdateMoveHook(ctx, &types.MsgUpdateMoveHook{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Hook:      hook,
+		Allowed:   true,
+	})
+	require.NoError(t, err)
+
+	qs := keeper.NewCustomQueryServer(&input.GovKeeper)
+	res, err := qs.MoveHooks(ctx, &types.QueryMoveHooksRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.MoveHook{hook}, res.MoveHooks)
+
+	// invalid hook
+	invalidHook := hook
+	invalidHook.GasLimit = 0
+	_, err = ms.UpdateMoveHook(ctx, &types.MsgUpdateMoveHook{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Hook:      invalidHook,
+		Allowed:   true,
+	})
+	require.Error(t, err)
+
+	// unauthorized
+	_, err = ms.UpdateMoveHook(ctx, &types.MsgUpdateMoveHook{
+		Authority: authtypes.NewModuleAddress("invalid").String(),
+		Hook:      hook,
+		Allowed:   false,
+	})
+	require.Error(t, err)
+
+	// remove
+	_, err = ms.UpdateMoveHook(ctx, &types.MsgUpdateMoveHook{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Hook:      hook,
+		Allowed:   false,
+	})
+	require.NoError(t, err)
+
+	res, err = qs.MoveHooks(ctx, &types.QueryMoveHooksRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.MoveHooks)
+}
+
+func TestMoveHookFailureIsolated(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	// hook to the module which does not exist
+	err := input.GovKeeper.SetMoveHook(ctx, types.MoveHook{
+		ModuleAddr:   addrs[0].String(),
+		ModuleName:   "hook",
+		FunctionName: "on_event",
+		GasLimit:     100_000,
+	})
+	require.NoError(t, err)
+
+	proposal, err := input.GovKeeper.SubmitProposal(ctx, nil, "", "", "", addrs[0], false)
+	require.NoError(t, err)
+	err = input.GovKeeper.VotingPeriodProposals.Set(ctx, proposal.Id, []byte{1})
+	require.NoError(t, err)
+	proposal.Status = v1.StatusVotingPeriod
+	err = input.GovKeeper.Proposals.Set(ctx, proposal.Id, proposal)
+	require.NoError(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = input.GovKeeper.AddVote(ctx, proposal.Id, addrs[1], v1.WeightedVoteOptions{&v1.WeightedVoteOption{Option: v1.OptionYes, Weight: "1"}}, "")
+	require.NoError(t, err)
+
+	_, err = input.GovKeeper.Votes.Get(ctx, collections.Join(proposal.Id, addrs[1]))
+	require.NoError(t, err)
+
+	var found bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeMoveHook {
+			continue
+		}
+
+		found = true
+
+		hookEvent, ok := event.GetAttribute(types.AttributeKeyHookEvent)
+		require.True(t, ok)
+		require.Equal(t, types.MoveHookEventVoted, hookEvent.Value)
+
+		success, ok := event.GetAttribute(types.AttributeKeySuccess)
+		require.True(t, ok)
+		require.Equal(t, "false", success.Value)
+	}
+	require.True(t, found)
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	customtypes "github.com/initia-labs/initia/x/gov/types"
 )
 
 type msgServer struct {
@@ -31,21 +33,34 @@ var _ v1.MsgServer = msgServer{}
 
 // SubmitProposal implements the MsgServer.SubmitProposal method.
 func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitProposal) (*v1.MsgSubmitProposalResponse, error) {
+	proposalID, err := k.submitProposalMsg(goCtx, msg, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgSubmitProposalResponse{
+		ProposalId: proposalID,
+	}, nil
+}
+
+// submitProposalMsg validates the submit proposal message, submits the proposal and adds
+// the initial deposit. Returns the id of the submitted proposal.
+func (k Keeper) submitProposalMsg(goCtx context.Context, msg *v1.MsgSubmitProposal, optimistic bool) (uint64, error) {
 	if msg.Title == "" {
-		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "proposal title cannot be empty")
+		return 0, errors.Wrap(sdkerrors.ErrInvalidRequest, "proposal title cannot be empty")
 	}
 	if msg.Summary == "" {
-		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "proposal summary cannot be empty")
+		return 0, errors.Wrap(sdkerrors.ErrInvalidRequest, "proposal summary cannot be empty")
 	}
 
 	proposer, err := k.authKeeper.AddressCodec().StringToBytes(msg.GetProposer())
 	if err != nil {
-		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
+		return 0, sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
 	}
 
 	// check that either metadata or Msgs length is non nil.
 	if len(msg.Messages) == 0 && len(msg.Metadata) == 0 {
-		return nil, errors.Wrap(types.ErrNoProposalMsgs, "either metadata or Msgs length must be non-nil")
+		return 0, errors.Wrap(types.ErrNoProposalMsgs, "either metadata or Msgs length must be non-nil")
 	}
 
 	// verify that if present, the metadata title and summary equals the proposal title and summary
@@ -53,11 +68,11 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		proposalMetadata := types.ProposalMetadata{}
 		if err := json.Unmarshal([]byte(msg.Metadata), &proposalMetadata); err == nil {
 			if proposalMetadata.Title != msg.Title {
-				return nil, errors.Wrapf(types.ErrInvalidProposalContent, "metadata title '%s' must equal proposal title '%s'", proposalMetadata.Title, msg.Title)
+				return 0, errors.Wrapf(types.ErrInvalidProposalContent, "metadata title '%s' must equal proposal title '%s'", proposalMetadata.Title, msg.Title)
 			}
 
 			if proposalMetadata.Summary != msg.Summary {
-				return nil, errors.Wrapf(types.ErrInvalidProposalContent, "metadata summary '%s' must equal proposal summary '%s'", proposalMetadata.Summary, msg.Summary)
+				return 0, errors.Wrapf(types.ErrInvalidProposalContent, "metadata summary '%s' must equal proposal summary '%s'", proposalMetadata.Summary, msg.Summary)
 			}
 		}
 
@@ -67,7 +82,7 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 
 	proposalMsgs, err := msg.GetMsgs()
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -75,25 +90,35 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 
 	params, err := k.Params.Get(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get governance parameters: %w", err)
+		return 0, fmt.Errorf("failed to get governance parameters: %w", err)
 	}
 
-	if err := validateInitialDeposit(params, initialDeposit, msg.Expedited); err != nil {
-		return nil, err
+	msgTypeURLs := make([]string, len(msg.Messages))
+	for i, anyMsg := range msg.Messages {
+		msgTypeURLs[i] = anyMsg.TypeUrl
+	}
+
+	if err := validateInitialDeposit(params, initialDeposit, msg.Expedited, msgTypeURLs...); err != nil {
+		return 0, err
 	}
 
 	if err := validateDepositDenom(params, initialDeposit); err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.Expedited)
+	var proposal customtypes.Proposal
+	if optimistic {
+		proposal, err = k.SubmitOptimisticProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer)
+	} else {
+		proposal, err = k.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.Expedited)
+	}
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	bytes, err := proposal.Marshal()
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	// ref: https://github.com/cosmos/cosmos-sdk/issues/9683
@@ -102,9 +127,9 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		"submit proposal",
 	)
 
-	votingStarted, err := k.Keeper.AddDeposit(ctx, proposal.Id, proposer, msg.GetInitialDeposit())
+	votingStarted, err := k.AddDeposit(ctx, proposal.Id, proposer, msg.GetInitialDeposit())
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	if votingStarted {
@@ -115,9 +140,7 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		)
 	}
 
-	return &v1.MsgSubmitProposalResponse{
-		ProposalId: proposal.Id,
-	}, nil
+	return proposal.Id, nil
 }
 
 // CancelProposal implements the MsgServer.CancelProposal method.
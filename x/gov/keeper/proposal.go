@@ -10,16 +10,31 @@ import (
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 
 	customtypes "github.com/initia-labs/initia/x/gov/types"
+	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
 )
 
 // SubmitProposal creates a new proposal given an array of messages
 func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress, expedited bool) (customtypes.Proposal, error) {
+	return k.submitProposal(ctx, messages, metadata, title, summary, proposer, expedited, false)
+}
+
+// SubmitOptimisticProposal creates a new optimistic proposal given an array of messages,
+// which must be the types eligible for the optimistic proposals.
+func (k Keeper) SubmitOptimisticProposal(ctx context.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress) (customtypes.Proposal, error) {
+	return k.submitProposal(ctx, messages, metadata, title, summary, proposer, false, true)
+}
+
+func (k Keeper) submitProposal(ctx context.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress, expedited, optimistic bool) (customtypes.Proposal, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	err := k.assertMetadataLength(metadata)
 	if err != nil {
@@ -46,36 +61,10 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 	for _, msg := range messages {
 		msgsStr += fmt.Sprintf(",%s", sdk.MsgTypeURL(msg))
 
-		// perform a basic validation of the message
-		if m, ok := msg.(sdk.HasValidateBasic); ok {
-			if err := m.ValidateBasic(); err != nil {
-				return customtypes.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalMsg, err.Error())
-			}
-		}
-
-		signers, _, err := k.cdc.GetMsgV1Signers(msg)
+		handler, err := k.validateProposalMsg(ctx, msg)
 		if err != nil {
 			return customtypes.Proposal{}, err
 		}
-		if len(signers) != 1 {
-			return customtypes.Proposal{}, types.ErrInvalidSigner
-		}
-
-		// assert that the governance module account is the only signer of the messages
-		if !bytes.Equal(signers[0], k.GetGovernanceAccount(ctx).GetAddress()) {
-			signerStr, err := k.authKeeper.AddressCodec().BytesToString(signers[0])
-			if err != nil {
-				return customtypes.Proposal{}, err
-			}
-
-			return customtypes.Proposal{}, errorsmod.Wrap(types.ErrInvalidSigner, signerStr)
-		}
-
-		// use the msg service router to see that there is a valid route for that message.
-		handler := k.router.Handler(msg)
-		if handler == nil {
-			return customtypes.Proposal{}, errorsmod.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
-		}
 
 		// Only if it's a MsgExecLegacyContent do we try to execute the
 		// proposal in a cached context.
@@ -94,12 +83,24 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 
 	}
 
-	proposalID, err := k.ProposalID.Next(ctx)
+	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return customtypes.Proposal{}, err
 	}
 
-	params, err := k.Params.Get(ctx)
+	if optimistic {
+		if len(messages) == 0 {
+			return customtypes.Proposal{}, errorsmod.Wrap(customtypes.ErrInvalidOptimisticProposal, "no proposal messages")
+		}
+
+		for _, msg := range messages {
+			if msgTypeURL := sdk.MsgTypeURL(msg); !params.IsOptimisticMsgType(msgTypeURL) {
+				return customtypes.Proposal{}, errorsmod.Wrapf(customtypes.ErrInvalidOptimisticProposal, "message type %s is not eligible", msgTypeURL)
+			}
+		}
+	}
+
+	proposalID, err := k.ProposalID.Next(ctx)
 	if err != nil {
 		return customtypes.Proposal{}, err
 	}
@@ -111,6 +112,7 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 	if err != nil {
 		return customtypes.Proposal{}, err
 	}
+	proposal.Optimistic = optimistic
 
 	err = k.SetProposal(ctx, proposal)
 	if err != nil {
@@ -127,6 +129,11 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 		return customtypes.Proposal{}, err
 	}
 
+	err = k.CallMoveHooks(ctx, proposalID, customtypes.MoveHookEventSubmitted, proposer)
+	if err != nil {
+		return customtypes.Proposal{}, err
+	}
+
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSubmitProposal,
@@ -138,6 +145,107 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 	return proposal, nil
 }
 
+// validateProposalMsg performs the basic validation of the proposal message, checks the
+// governance module account is the only signer and returns the handler of the message.
+func (k Keeper) validateProposalMsg(ctx context.Context, msg sdk.Msg) (baseapp.MsgServiceHandler, error) {
+	// perform a basic validation of the message
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidProposalMsg, err.Error())
+		}
+	}
+
+	signers, _, err := k.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return nil, err
+	}
+	if len(signers) != 1 {
+		return nil, types.ErrInvalidSigner
+	}
+
+	// assert that the governance module account is the only signer of the messages
+	if !bytes.Equal(signers[0], k.GetGovernanceAccount(ctx).GetAddress()) {
+		signerStr, err := k.authKeeper.AddressCodec().BytesToString(signers[0])
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, errorsmod.Wrap(types.ErrInvalidSigner, signerStr)
+	}
+
+	// use the msg service router to see that there is a valid route for that message.
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return nil, errorsmod.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+	}
+
+	return handler, nil
+}
+
+// simulateProposalMsgs executes the proposal messages in order on a cached context and
+// returns the execution results. The execution stops at the first failed message as the
+// proposal execution does, and the state changes are always discarded. The messages share
+// the gas limit bounded by the gas remaining in the context gas meter.
+func (k Keeper) simulateProposalMsgs(ctx context.Context, messages []sdk.Msg) (results []customtypes.MsgSimulationResult, success bool, gasUsed uint64) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	parentGasMeter := sdkCtx.GasMeter()
+	gasMeter := storetypes.NewGasMeter(min(customtypes.MaxSimulateProposalGas, parentGasMeter.Limit()-parentGasMeter.GasConsumedToLimit()))
+	defer func() {
+		parentGasMeter.ConsumeGas(gasMeter.GasConsumedToLimit(), "simulate proposal")
+	}()
+
+	cacheCtx, _ := sdkCtx.CacheContext()
+
+	// run in the simulate mode to let the move vm meter the gas with the simulation gas limit
+	cacheCtx = cacheCtx.WithExecMode(sdk.ExecModeSimulate).WithGasMeter(gasMeter)
+
+	results = make([]customtypes.MsgSimulationResult, 0, len(messages))
+	for _, msg := range messages {
+		gasBefore := gasMeter.GasConsumedToLimit()
+		res, err := k.safeSimulateProposalMsg(cacheCtx, msg)
+
+		result := customtypes.MsgSimulationResult{
+			MsgTypeUrl: sdk.MsgTypeURL(msg),
+			Success:    err == nil,
+			GasUsed:    gasMeter.GasConsumedToLimit() - gasBefore,
+		}
+		gasUsed += result.GasUsed
+
+		if err != nil {
+			result.Error = err.Error()
+			results = append(results, result)
+			return results, false, gasUsed
+		}
+
+		result.Events = res.Events
+		results = append(results, result)
+	}
+
+	return results, true, gasUsed
+}
+
+// safeSimulateProposalMsg validates and executes the proposal message, recovering from
+// the panics of the message handler including the out of gas.
+func (k Keeper) safeSimulateProposalMsg(ctx sdk.Context, msg sdk.Msg) (res *sdk.Result, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if oog, ok := r.(storetypes.ErrorOutOfGas); ok {
+				err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v", oog.Descriptor)
+				return
+			}
+
+			err = fmt.Errorf("handling x/gov proposal msg [%s] PANICKED: %v", msg, r)
+		}
+	}()
+
+	handler, err := k.validateProposalMsg(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, msg)
+}
+
 // CancelProposal will cancel proposal before the voting period ends
 func (k Keeper) CancelProposal(ctx context.Context, proposalID uint64, proposer string) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -270,7 +378,7 @@ func (k Keeper) ActivateVotingPeriod(ctx context.Context, proposal customtypes.P
 	if proposal.Expedited {
 		votingPeriod = params.ExpeditedVotingPeriod
 	} else {
-		votingPeriod = params.VotingPeriod
+		votingPeriod = params.GetProposalParams(proposal.MsgTypeURLs()...).VotingPeriod
 	}
 	endTime := proposal.VotingStartTime.Add(votingPeriod)
 	proposal.VotingEndTime = &endTime
@@ -330,3 +438,87 @@ func (k Keeper) ActivateEmergencyProposal(ctx context.Context, proposal customty
 	)
 	return nil
 }
+
+// escalateEmergencyProposal activates the emergency proposal when the bonded validators voted
+// yes with the voting power over the escalation threshold within the escalation window.
+func (k Keeper) escalateEmergencyProposal(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// escalation is disabled
+	if params.EmergencyEscalationWindow == 0 {
+		return nil
+	}
+
+	// only the votes from the bonded validators can escalate the proposal
+	validator, err := k.sk.GetValidator(ctx, sdk.ValAddress(voterAddr))
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	} else if !validator.IsBonded() {
+		return nil
+	}
+
+	proposal, err := k.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	if proposal.Emergency || proposal.Optimistic || proposal.Status != v1.StatusVotingPeriod {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdkCtx.BlockTime().After(proposal.VotingStartTime.Add(params.EmergencyEscalationWindow)) {
+		return nil
+	}
+
+	threshold, err := math.LegacyNewDecFromStr(params.EmergencyEscalationThreshold)
+	if err != nil {
+		return err
+	}
+
+	weights, err := k.sk.GetVotingPowerWeights(ctx)
+	if err != nil {
+		return err
+	}
+
+	totalPower := math.ZeroInt()
+	yesPower := math.LegacyZeroDec()
+	err = k.sk.IterateBondedValidatorsByPower(ctx, func(validator stakingtypes.ValidatorI) (stop bool, err error) {
+		votingPower, _ := stakingtypes.CalculateVotingPower(validator.GetBondedTokens(), weights)
+		totalPower = totalPower.Add(votingPower)
+
+		valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+		if err != nil {
+			return false, err
+		}
+
+		vote, err := k.Votes.Get(ctx, collections.Join(proposalID, sdk.AccAddress(valAddr)))
+		if err != nil && errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+
+		for _, option := range vote.Options {
+			if option.Option == v1.OptionYes {
+				yesPower = yesPower.Add(votingPower.ToLegacyDec().Mul(math.LegacyMustNewDecFromStr(option.Weight)))
+			}
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if totalPower.IsZero() || yesPower.QuoInt(totalPower).LT(threshold) {
+		return nil
+	}
+
+	return k.ActivateEmergencyProposal(ctx, proposal)
+}
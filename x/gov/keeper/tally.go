@@ -81,39 +81,94 @@ func (k Keeper) Tally(ctx context.Context, params customtypes.Params, proposal c
 		}
 	}
 
-	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
-	err = k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
-		// if validator, just record it in the map
-		voter := sdk.MustAccAddressFromBech32(vote.Voter)
+	// load the voting power providers
+	providers := make([]votingPowerProvider, len(params.VotingPowerProviders))
+	providerPowers := make([]math.LegacyDec, len(params.VotingPowerProviders))
+	for i, provider := range params.VotingPowerProviders {
+		moduleAddr, err := k.authKeeper.AddressCodec().StringToBytes(provider.ModuleAddr)
+		if err != nil {
+			return false, false, false, tallyResults, err
+		}
 
-		valAddrStr := sdk.ValAddress(voter.Bytes()).String()
-		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Options
-			currValidators[valAddrStr] = val
+		weight, err := math.LegacyNewDecFromStr(provider.Weight)
+		if err != nil {
+			return false, false, false, tallyResults, err
 		}
 
+		providers[i] = votingPowerProvider{VotingPowerProvider: provider, moduleAddr: moduleAddr, weight: weight}
+		providerPowers[i] = math.LegacyZeroDec()
+	}
+
+	// bound the number of the provider calls as each call executes a Move view function
+	providerCalls := 0
+	providerOmittedVoters := uint64(0)
+
+	// record the voting power of each voter if the breakdowns are archived
+	var breakdowns []customtypes.VoterBreakdown
+	breakdownIndex := make(map[string]int)
+
+	// tallyVoter adds the vesting and staking voting power of the voter to the results
+	// with the vote options, and deducts the delegations from the inherited validator votes
+	tallyVoter := func(voter sdk.AccAddress, options v1.WeightedVoteOptions, delegate string) error {
+		stakingPower := math.LegacyZeroDec()
+		vestingPower := math.ZeroInt()
+
 		// add vesting voting power if vesting params are provided
 		if vestingHandle != nil {
 			amount, err := k.vestingKeeper.GetUnclaimedVestedAmount(ctx, *vestingHandle, voter)
 			if err != nil {
-				return false, err
+				return err
 			}
 
 			if !amount.IsZero() {
 				// `the vesting token == the base denom`` check is done in vesting keeper,
 				// so we can safely convert the amount to voting power
 				votingPower := math.LegacyNewDecFromInt(amount)
-				for _, option := range vote.Options {
+				for _, option := range options {
 					subPower := votingPower.Mul(math.LegacyMustNewDecFromStr(option.Weight))
 					results[option.Option] = results[option.Option].Add(subPower)
 				}
 				totalVotingPower = totalVotingPower.Add(votingPower)
 				totalVestingPower = totalVestingPower.Add(amount)
+				vestingPower = amount
+			}
+		}
+
+		// add the voting power from the providers unless the provider calls exceed the limit
+		providerPower := math.LegacyZeroDec()
+		voterProviders := providers
+		if providerCalls+len(providers) > customtypes.MaxProviderCallsPerTally {
+			if providerOmittedVoters == 0 {
+				k.Logger(ctx).Error("voting power provider calls exceeded the limit", "proposal_id", proposal.Id, "limit", customtypes.MaxProviderCallsPerTally)
+			}
+			providerOmittedVoters++
+			voterProviders = nil
+		}
+		providerCalls += len(voterProviders)
+
+		for i, provider := range voterProviders {
+			amount, err := k.moveGovKeeper.GetProviderVotingPower(ctx, provider.moduleAddr, provider.ModuleName, provider.FunctionName, voter, provider.GasLimit)
+			if err != nil {
+				// the failure of a provider should not block the tally
+				k.Logger(ctx).Error("failed to get voting power from provider", "provider", provider.Name, "voter", voter.String(), "error", err)
+				continue
+			}
+			if amount.IsZero() {
+				continue
 			}
+
+			votingPower := provider.weight.MulInt(amount)
+			for _, option := range options {
+				subPower := votingPower.Mul(math.LegacyMustNewDecFromStr(option.Weight))
+				results[option.Option] = results[option.Option].Add(subPower)
+			}
+			totalVotingPower = totalVotingPower.Add(votingPower)
+			providerPowers[i] = providerPowers[i].Add(votingPower)
+			providerPower = providerPower.Add(votingPower)
 		}
 
 		// iterate over all delegations from voter, deduct from any delegated-to validators
-		err = k.sk.IterateDelegations(ctx, voter, func(delegation stakingtypes.DelegationI) (stop bool, err error) {
+		err := k.sk.IterateDelegations(ctx, voter, func(delegation stakingtypes.DelegationI) (stop bool, err error) {
 			valAddrStr := delegation.GetValidatorAddr()
 
 			if val, ok := currValidators[valAddrStr]; ok {
@@ -133,20 +188,73 @@ func (k Keeper) Tally(ctx context.Context, params customtypes.Params, proposal c
 					)
 				}
 
-				for _, option := range vote.Options {
+				for _, option := range options {
 					subPower := votingPower.Mul(math.LegacyMustNewDecFromStr(option.Weight))
 					results[option.Option] = results[option.Option].Add(subPower)
 				}
 				totalVotingPower = totalVotingPower.Add(votingPower)
+				stakingPower = stakingPower.Add(votingPower)
 			}
 
 			return false, nil
 		})
-		if err != nil {
-			return false, err
+		if err != nil || !params.ArchiveVoterBreakdown {
+			return err
+		}
+
+		breakdownIndex[voter.String()] = len(breakdowns)
+		breakdowns = append(breakdowns, customtypes.VoterBreakdown{
+			ProposalId:     proposal.Id,
+			Voter:          voter.String(),
+			Options:        options,
+			Delegate:       delegate,
+			StakingPower:   stakingPower.String(),
+			VestingPower:   vestingPower.String(),
+			InheritedPower: math.LegacyZeroDec().String(),
+			ProviderPower:  providerPower.String(),
+		})
+
+		return nil
+	}
+
+	// load the votes first to resolve the delegated votes
+	var votes []v1.Vote
+	var voters []sdk.AccAddress
+	voterOptions := make(map[string]v1.WeightedVoteOptions)
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
+	err = k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+		votes = append(votes, vote)
+		voters = append(voters, key.K2())
+		voterOptions[key.K2().String()] = vote.Options
+		return false, nil
+	})
+	if err != nil {
+		return false, false, false, tallyResults, err
+	}
+
+	for _, vote := range votes {
+		// if validator, just record it in the map
+		voter := sdk.MustAccAddressFromBech32(vote.Voter)
+
+		valAddrStr := sdk.ValAddress(voter.Bytes()).String()
+		if val, ok := currValidators[valAddrStr]; ok {
+			val.Vote = vote.Options
+			currValidators[valAddrStr] = val
+		}
+
+		if err := tallyVoter(voter, vote.Options, ""); err != nil {
+			return false, false, false, tallyResults, err
+		}
+
+		if err := k.Votes.Remove(ctx, collections.Join(vote.ProposalId, voter)); err != nil {
+			return false, false, false, tallyResults, err
 		}
+	}
 
-		return false, k.Votes.Remove(ctx, collections.Join(vote.ProposalId, voter))
+	// tally the voting power of the delegators who did not vote with the vote of their delegate
+	msgTypeURL := proposalMsgTypeURL(proposal)
+	err = k.iterateDelegatedVoters(ctx, voters, msgTypeURL, voterOptions, func(delegator, voter sdk.AccAddress, options v1.WeightedVoteOptions) error {
+		return tallyVoter(delegator, options, voter.String())
 	})
 	if err != nil {
 		return false, false, false, tallyResults, err
@@ -174,6 +282,21 @@ func (k Keeper) Tally(ctx context.Context, params customtypes.Params, proposal c
 			results[option.Option] = results[option.Option].Add(subPower)
 		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
+
+		if i, ok := breakdownIndex[sdk.AccAddress(val.Address).String()]; ok {
+			breakdowns[i].InheritedPower = votingPower.String()
+		}
+	}
+
+	if params.ArchiveVoterBreakdown {
+		if err := k.archiveVoterBreakdowns(ctx, proposal.Id, breakdowns); err != nil {
+			return false, false, false, tallyResults, err
+		}
+	}
+
+	// prune regardless of the archive switch to drop the breakdowns archived before it was disabled
+	if err := k.pruneVoterBreakdowns(ctx, params.VoterBreakdownRetention); err != nil {
+		return false, false, false, tallyResults, err
 	}
 
 	v1TallyResult := v1.NewTallyResultFromMap(results)
@@ -182,18 +305,54 @@ func (k Keeper) Tally(ctx context.Context, params customtypes.Params, proposal c
 		TallyHeight:       uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()),
 		TotalStakingPower: totalStakingPower.String(),
 		TotalVestingPower: totalVestingPower.String(),
+
+		ProviderOmittedVoters: providerOmittedVoters,
+	}
+
+	// the provider voting power is counted only for the voters as the vesting power
+	totalProviderPower := math.ZeroInt()
+	totalProviderVotingPower := math.LegacyZeroDec()
+	for i, provider := range providers {
+		providerPower := providerPowers[i].TruncateInt()
+		totalProviderPower = totalProviderPower.Add(providerPower)
+		totalProviderVotingPower = totalProviderVotingPower.Add(providerPowers[i])
+		tallyResults.ProviderPowers = append(tallyResults.ProviderPowers, customtypes.ProviderVotingPower{
+			Name:        provider.Name,
+			VotingPower: providerPower.String(),
+		})
 	}
 
 	// If there is no (staked + vesting) coins, the proposal fails
-	totalPower := totalVestingPower.Add(totalStakingPower)
+	totalPower := totalVestingPower.Add(totalStakingPower).Add(totalProviderPower)
 	if totalPower.IsZero() {
 		return false, false, false, tallyResults, nil
 	}
 
-	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(math.LegacyNewDecFromInt(totalPower))
-	quorum, _ := math.LegacyNewDecFromStr(params.Quorum)
-	if percentVoting.LT(quorum) {
+	// The optimistic proposal passes without quorum unless the no and no with veto votes
+	// exceed the rejection threshold of the total voting power
+	if proposal.Optimistic {
+		rejectionThreshold, err := math.LegacyNewDecFromStr(params.OptimisticRejectionThreshold)
+		if err != nil {
+			return false, false, false, tallyResults, err
+		}
+
+		rejectionPower := results[v1.OptionNo].Add(results[v1.OptionNoWithVeto])
+		if rejectionPower.Quo(math.LegacyNewDecFromInt(totalPower)).GT(rejectionThreshold) {
+			return true, false, false, tallyResults, nil
+		}
+
+		return true, true, false, tallyResults, nil
+	}
+
+	// If there is not enough quorum of votes, the proposal fails. The provider voting power is
+	// excluded from the quorum, as the total provider voting power of the non-voters is unknown
+	// and counting only the voters would inflate the participation.
+	percentVoting := math.LegacyZeroDec()
+	if quorumPower := totalPower.Sub(totalProviderPower); quorumPower.IsPositive() {
+		percentVoting = totalVotingPower.Sub(totalProviderVotingPower).Quo(math.LegacyNewDecFromInt(quorumPower))
+	}
+	proposalParams := params.GetProposalParams(proposal.MsgTypeURLs()...)
+	if percentVoting.LT(proposalParams.Quorum) {
 		return false, false, params.BurnVoteQuorum, tallyResults, nil
 	}
 
@@ -203,21 +362,13 @@ func (k Keeper) Tally(ctx context.Context, params customtypes.Params, proposal c
 	}
 
 	// If more than 1/3 of voters veto, proposal fails
-	vetoThreshold, _ := math.LegacyNewDecFromStr(params.VetoThreshold)
-	if results[v1.OptionNoWithVeto].Quo(totalVotingPower).GT(vetoThreshold) {
+	if results[v1.OptionNoWithVeto].Quo(totalVotingPower).GT(proposalParams.VetoThreshold) {
 		return true, false, params.BurnVoteVeto, tallyResults, nil
 	}
 
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
 	// For expedited or emergency, 2/3
-	var thresholdStr string
-	if (proposal.Emergency || proposal.Expedited) && !IsLowThresholdProposal(params, proposal) {
-		thresholdStr = params.GetExpeditedThreshold()
-	} else {
-		thresholdStr = params.GetThreshold()
-	}
-
-	threshold, _ := math.LegacyNewDecFromStr(thresholdStr)
+	threshold, _ := math.LegacyNewDecFromStr(GetProposalThreshold(params, proposal))
 
 	if results[v1.OptionYes].Quo(totalVotingPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
 		return true, true, false, tallyResults, nil
@@ -227,6 +378,26 @@ func (k Keeper) Tally(ctx context.Context, params customtypes.Params, proposal c
 	return true, false, false, tallyResults, nil
 }
 
+// votingPowerProvider is a voting power provider with the parsed module address and weight.
+type votingPowerProvider struct {
+	customtypes.VotingPowerProvider
+
+	moduleAddr sdk.AccAddress
+	weight     math.LegacyDec
+}
+
+// GetProposalThreshold returns the threshold of the yes votes to pass the proposal. The expedited
+// threshold is applied to the expedited or emergency proposals except the low threshold proposals,
+// unless the threshold of the msg type params is higher.
+func GetProposalThreshold(params customtypes.Params, proposal customtypes.Proposal) string {
+	threshold := params.GetProposalParams(proposal.MsgTypeURLs()...).Threshold
+	if (proposal.Emergency || proposal.Expedited) && !IsLowThresholdProposal(params, proposal) {
+		threshold = math.LegacyMaxDec(threshold, math.LegacyMustNewDecFromStr(params.ExpeditedThreshold))
+	}
+
+	return threshold.String()
+}
+
 // IsLowThresholdProposal checks if the proposal is a low threshold proposal
 func IsLowThresholdProposal(params customtypes.Params, proposal customtypes.Proposal) bool {
 	messages, err := proposal.GetMsgs()
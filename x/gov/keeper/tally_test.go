@@ -50,6 +50,36 @@ func Test_isLowThresholdProposal(t *testing.T) {
 	require.False(t, keeper.IsLowThresholdProposal(params, proposal))
 }
 
+func Test_GetProposalThreshold(t *testing.T) {
+	params := customtypes.DefaultParams()
+	params.MsgTypeParams = []customtypes.MsgTypeParams{{
+		MsgTypeUrl: sdk.MsgTypeURL(&movetypes.MsgScript{}),
+		Threshold:  "0.9",
+	}}
+
+	// the expedited threshold is applied if higher
+	proposal, err := customtypes.NewProposal([]sdk.Msg{&movetypes.MsgExecute{}}, 1, time.Now().UTC(), time.Now().UTC().Add(time.Hour), "", "", "", addrs[0], true)
+	require.NoError(t, err)
+	require.Equal(t, params.ExpeditedThreshold, keeper.GetProposalThreshold(params, proposal))
+
+	// the threshold of the msg type params is applied if higher
+	proposal, err = customtypes.NewProposal([]sdk.Msg{&movetypes.MsgExecute{}, &movetypes.MsgScript{}}, 1, time.Now().UTC(), time.Now().UTC().Add(time.Hour), "", "", "", addrs[0], true)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(9, 1).String(), keeper.GetProposalThreshold(params, proposal))
+
+	proposal.Expedited = false
+	require.Equal(t, math.LegacyNewDecWithPrec(9, 1).String(), keeper.GetProposalThreshold(params, proposal))
+
+	// the low threshold proposal takes the resolved threshold
+	proposal, err = customtypes.NewProposal([]sdk.Msg{&movetypes.MsgExecute{
+		ModuleAddress: "0x1",
+		ModuleName:    "vip",
+		FunctionName:  "register_snapshot",
+	}}, 1, time.Now().UTC(), time.Now().UTC().Add(time.Hour), "", "", "", addrs[0], true)
+	require.NoError(t, err)
+	require.Equal(t, params.Threshold, keeper.GetProposalThreshold(params, proposal))
+}
+
 func setupVesting(t *testing.T, ctx sdk.Context, input TestKeepers, now time.Time) {
 	err := input.MoveKeeper.PublishModuleBundle(ctx, vmtypes.TestAddress, vmtypes.NewModuleBundle(vmtypes.NewModule(vestingModule)), movetypes.UpgradePolicy_COMPATIBLE)
 	require.NoError(t, err)
@@ -178,3 +208,120 @@ func Test_Tally(t *testing.T) {
 	require.Equal(t, tallyResults.V1TallyResult.YesCount, math.LegacyNewDec(1_500_000+100_000_000).TruncateInt().String())
 	require.Equal(t, tallyResults.V1TallyResult.NoCount, math.LegacyNewDec(100_000_000).TruncateInt().String())
 }
+
+func Test_Tally_VotingPowerProviders(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	proposal, err := input.GovKeeper.SubmitProposal(ctx, nil, "", "test", "description", addrs[0], false)
+	require.NoError(t, err)
+
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	err = input.GovKeeper.SetProposal(ctx, proposal)
+	require.NoError(t, err)
+
+	valAddr := createValidatorWithCoin(ctx, input,
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		1,
+	)
+
+	voterAddr := sdk.AccAddress(valAddr)
+	err = input.GovKeeper.AddVote(ctx, proposalID, voterAddr, v1.WeightedVoteOptions{
+		{
+			Option: v1.OptionYes,
+			Weight: "1",
+		},
+	}, "")
+	require.NoError(t, err)
+
+	params, err := input.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	// use the account number as the voting power; the second provider always fails
+	stdAddr := sdk.AccAddress(vmtypes.StdAddress[:]).String()
+	params.VotingPowerProviders = []customtypes.VotingPowerProvider{
+		{
+			Name:         "account",
+			ModuleAddr:   stdAddr,
+			ModuleName:   "account",
+			FunctionName: "get_account_number",
+			Weight:       "2",
+			GasLimit:     1_000_000,
+		},
+		{
+			Name:         "unknown",
+			ModuleAddr:   stdAddr,
+			ModuleName:   "unknown",
+			FunctionName: "voting_power",
+			Weight:       "1",
+			GasLimit:     1_000_000,
+		},
+	}
+
+	accountNumber := input.AccountKeeper.GetAccount(ctx, voterAddr).GetAccountNumber()
+	require.NotZero(t, accountNumber)
+
+	_, _, _, tallyResults, err := input.GovKeeper.Tally(ctx, params, proposal)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100_000_000+2*int64(accountNumber)).String(), tallyResults.V1TallyResult.YesCount)
+	require.Equal(t, []customtypes.ProviderVotingPower{
+		{Name: "account", VotingPower: math.NewInt(2 * int64(accountNumber)).String()},
+		{Name: "unknown", VotingPower: "0"},
+	}, tallyResults.ProviderPowers)
+	require.Zero(t, tallyResults.ProviderOmittedVoters)
+}
+
+func Test_Tally_VotingPowerProviders_ExcludedFromQuorum(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	proposal, err := input.GovKeeper.SubmitProposal(ctx, nil, "", "test", "description", addrs[0], false)
+	require.NoError(t, err)
+
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	err = input.GovKeeper.SetProposal(ctx, proposal)
+	require.NoError(t, err)
+
+	createValidatorWithCoin(ctx, input,
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		1,
+	)
+	valAddr := createValidatorWithCoin(ctx, input,
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50_000_000)),
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50_000_000)),
+		2,
+	)
+
+	// the voter has 1/3 of the staking power, which is below the quorum
+	voterAddr := sdk.AccAddress(valAddr)
+	err = input.GovKeeper.AddVote(ctx, proposalID, voterAddr, v1.WeightedVoteOptions{
+		{
+			Option: v1.OptionYes,
+			Weight: "1",
+		},
+	}, "")
+	require.NoError(t, err)
+
+	params, err := input.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	// the provider voting power of the voter far exceeds the staking power
+	params.VotingPowerProviders = []customtypes.VotingPowerProvider{
+		{
+			Name:         "account",
+			ModuleAddr:   sdk.AccAddress(vmtypes.StdAddress[:]).String(),
+			ModuleName:   "account",
+			FunctionName: "get_account_number",
+			Weight:       "1000000000000",
+			GasLimit:     1_000_000,
+		},
+	}
+
+	quorumReached, _, _, tallyResults, err := input.GovKeeper.Tally(ctx, params, proposal)
+	require.NoError(t, err)
+	require.False(t, quorumReached)
+	require.Len(t, tallyResults.ProviderPowers, 1)
+	require.NotEqual(t, "0", tallyResults.ProviderPowers[0].VotingPower)
+}
@@ -10,6 +10,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	customtypes "github.com/initia-labs/initia/x/gov/types"
 )
 
 // AddVote adds a vote on a specific proposal
@@ -47,6 +49,17 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 		return err
 	}
 
+	err = k.CallMoveHooks(ctx, proposalID, customtypes.MoveHookEventVoted, voterAddr)
+	if err != nil {
+		return err
+	}
+
+	// escalate the proposal to emergency if the validators voted yes with a supermajority
+	err = k.escalateEmergencyProposal(ctx, proposalID, voterAddr)
+	if err != nil {
+		return err
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
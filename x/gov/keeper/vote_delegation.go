@@ -0,0 +1,248 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	customtypes "github.com/initia-labs/initia/x/gov/types"
+)
+
+// SetVoteDelegation delegates the voting power of the delegator to the delegate for the proposals
+// whose messages are all of the msg type url, or for all proposals if the msg type url is empty.
+// It replaces the existing delegation of the same scope.
+func (k Keeper) SetVoteDelegation(ctx context.Context, delegator, delegate sdk.AccAddress, msgTypeURL string) error {
+	if delegator.Equals(delegate) {
+		return errorsmod.Wrap(customtypes.ErrInvalidVoteDelegation, "cannot delegate vote to self")
+	}
+
+	if msgTypeURL != "" && k.router.HandlerByTypeURL(msgTypeURL) == nil {
+		return errorsmod.Wrapf(customtypes.ErrInvalidVoteDelegation, "unknown msg type url %s", msgTypeURL)
+	}
+
+	// reject the delegation closing a chain back to the delegator or making the chain
+	// longer than the max depth, counting the delegators already delegating to the
+	// delegator
+	upstreamDepth, err := k.voteDelegationUpstreamDepth(ctx, delegator, msgTypeURL)
+	if err != nil {
+		return err
+	}
+
+	visited := map[string]bool{}
+	for curr, depth := delegate, 1+upstreamDepth; !visited[curr.String()]; depth++ {
+		if depth > customtypes.MaxVoteDelegationDepth {
+			return errorsmod.Wrapf(customtypes.ErrInvalidVoteDelegation, "vote delegation chain is longer than %d", customtypes.MaxVoteDelegationDepth)
+		}
+		visited[curr.String()] = true
+
+		next, found, err := k.GetVoteDelegate(ctx, curr, msgTypeURL)
+		if err != nil {
+			return err
+		} else if !found {
+			break
+		} else if next.Equals(delegator) {
+			return errorsmod.Wrap(customtypes.ErrInvalidVoteDelegation, "vote delegation cycle detected")
+		}
+
+		curr = next
+	}
+
+	if err := k.RemoveVoteDelegation(ctx, delegator, msgTypeURL); err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	// bound the number of delegators to walk back from the delegate on tally
+	numDelegators := 0
+	err = k.DelegatedVoters.Walk(ctx, collections.NewPrefixedTripleRange[sdk.AccAddress, sdk.AccAddress, string](delegate), func(_ collections.Triple[sdk.AccAddress, sdk.AccAddress, string]) (bool, error) {
+		numDelegators++
+		return numDelegators >= customtypes.MaxVoteDelegatorsPerDelegate, nil
+	})
+	if err != nil {
+		return err
+	} else if numDelegators >= customtypes.MaxVoteDelegatorsPerDelegate {
+		return errorsmod.Wrapf(customtypes.ErrInvalidVoteDelegation, "delegate has reached the max number of delegators %d", customtypes.MaxVoteDelegatorsPerDelegate)
+	}
+
+	delegatorStr, err := k.authKeeper.AddressCodec().BytesToString(delegator)
+	if err != nil {
+		return err
+	}
+
+	delegateStr, err := k.authKeeper.AddressCodec().BytesToString(delegate)
+	if err != nil {
+		return err
+	}
+
+	if err := k.DelegatedVotes.Set(ctx, collections.Join(delegator, msgTypeURL), customtypes.VoteDelegation{
+		Delegator:  delegatorStr,
+		Delegate:   delegateStr,
+		MsgTypeUrl: msgTypeURL,
+	}); err != nil {
+		return err
+	}
+
+	return k.DelegatedVoters.Set(ctx, collections.Join3(delegate, delegator, msgTypeURL))
+}
+
+// voteDelegationUpstreamDepth returns the number of hops of the longest delegation chain
+// leading to the delegator, walking back through the delegators index. The walk stops
+// at the max depth, as no delegation can be added to such a chain anyway.
+func (k Keeper) voteDelegationUpstreamDepth(ctx context.Context, delegator sdk.AccAddress, msgTypeURL string) (int, error) {
+	queue := []sdk.AccAddress{delegator}
+
+	depth := 0
+	for ; depth < customtypes.MaxVoteDelegationDepth; depth++ {
+		// a delegator reached through the chains of different lengths is walked again
+		// on the deeper level, so the longest chain is counted
+		var next []sdk.AccAddress
+		visited := map[string]bool{}
+		for _, delegate := range queue {
+			rng := collections.NewPrefixedTripleRange[sdk.AccAddress, sdk.AccAddress, string](delegate)
+			err := k.DelegatedVoters.Walk(ctx, rng, func(key collections.Triple[sdk.AccAddress, sdk.AccAddress, string]) (bool, error) {
+				// every scope passes through the delegation for all proposals
+				upstream, scope := key.K2(), key.K3()
+				if msgTypeURL != "" && scope != "" && scope != msgTypeURL {
+					return false, nil
+				} else if visited[upstream.String()] {
+					return false, nil
+				}
+
+				visited[upstream.String()] = true
+				next = append(next, upstream)
+				return false, nil
+			})
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		if len(next) == 0 {
+			break
+		}
+		queue = next
+	}
+
+	return depth, nil
+}
+
+// RemoveVoteDelegation removes the vote delegation of the delegator with the msg type url scope.
+func (k Keeper) RemoveVoteDelegation(ctx context.Context, delegator sdk.AccAddress, msgTypeURL string) error {
+	delegation, err := k.DelegatedVotes.Get(ctx, collections.Join(delegator, msgTypeURL))
+	if err != nil {
+		return err
+	}
+
+	delegate, err := k.authKeeper.AddressCodec().StringToBytes(delegation.Delegate)
+	if err != nil {
+		return err
+	}
+
+	if err := k.DelegatedVotes.Remove(ctx, collections.Join(delegator, msgTypeURL)); err != nil {
+		return err
+	}
+
+	return k.DelegatedVoters.Remove(ctx, collections.Join3(sdk.AccAddress(delegate), delegator, msgTypeURL))
+}
+
+// GetVoteDelegate returns the delegate of the delegator for the msg type url. The delegation
+// scoped to the msg type url takes precedence over the delegation for all proposals.
+func (k Keeper) GetVoteDelegate(ctx context.Context, delegator sdk.AccAddress, msgTypeURL string) (sdk.AccAddress, bool, error) {
+	scopes := []string{""}
+	if msgTypeURL != "" {
+		scopes = []string{msgTypeURL, ""}
+	}
+
+	for _, scope := range scopes {
+		delegation, err := k.DelegatedVotes.Get(ctx, collections.Join(delegator, scope))
+		if err != nil && errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, false, err
+		}
+
+		delegate, err := k.authKeeper.AddressCodec().StringToBytes(delegation.Delegate)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return delegate, true, nil
+	}
+
+	return nil, false, nil
+}
+
+// iterateDelegatedVoters walks the vote delegations back from the voters through the
+// delegators index, and calls the callback with each delegator who did not vote, the voter
+// whose vote the delegator inherits and the vote options. Only the delegators leading to
+// the voters are visited, up to MaxVoteDelegationDepth hops from the voter.
+func (k Keeper) iterateDelegatedVoters(
+	ctx context.Context, voters []sdk.AccAddress, msgTypeURL string, voterOptions map[string]v1.WeightedVoteOptions,
+	cb func(delegator, voter sdk.AccAddress, options v1.WeightedVoteOptions) error,
+) error {
+	type delegatedVoter struct {
+		addr  sdk.AccAddress
+		voter sdk.AccAddress
+	}
+
+	queue := make([]delegatedVoter, 0, len(voters))
+	for _, voter := range voters {
+		queue = append(queue, delegatedVoter{addr: voter, voter: voter})
+	}
+
+	visited := map[string]bool{}
+	for depth := 1; depth <= customtypes.MaxVoteDelegationDepth && len(queue) > 0; depth++ {
+		var next []delegatedVoter
+		for _, delegate := range queue {
+			rng := collections.NewPrefixedTripleRange[sdk.AccAddress, sdk.AccAddress, string](delegate.addr)
+			err := k.DelegatedVoters.Walk(ctx, rng, func(key collections.Triple[sdk.AccAddress, sdk.AccAddress, string]) (bool, error) {
+				delegator, scope := key.K2(), key.K3()
+				if scope != "" && scope != msgTypeURL {
+					return false, nil
+				}
+
+				if _, voted := voterOptions[delegator.String()]; voted || visited[delegator.String()] {
+					return false, nil
+				}
+
+				// the delegation scoped to the msg type url takes precedence
+				curr, found, err := k.GetVoteDelegate(ctx, delegator, msgTypeURL)
+				if err != nil {
+					return true, err
+				} else if !found || !curr.Equals(delegate.addr) {
+					return false, nil
+				}
+
+				visited[delegator.String()] = true
+				next = append(next, delegatedVoter{addr: delegator, voter: delegate.voter})
+				return false, cb(delegator, delegate.voter, voterOptions[delegate.voter.String()])
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		queue = next
+	}
+
+	return nil
+}
+
+// proposalMsgTypeURL returns the msg type url shared by all the messages of the proposal,
+// or an empty string if the proposal has no message or messages of different types.
+func proposalMsgTypeURL(proposal customtypes.Proposal) string {
+	msgTypeURL := ""
+	for i, msg := range proposal.Messages {
+		if i == 0 {
+			msgTypeURL = msg.TypeUrl
+		} else if msg.TypeUrl != msgTypeURL {
+			return ""
+		}
+	}
+
+	return msgTypeURL
+}
@@ -0,0 +1,230 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/initia-labs/initia/x/gov/keeper"
+	customtypes "github.com/initia-labs/initia/x/gov/types"
+)
+
+const msgSendTypeURL = "/cosmos.bank.v1beta1.MsgSend"
+
+func TestDelegateVote(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewCustomMsgServerImpl(&input.GovKeeper)
+
+	// self delegation
+	_, err := ms.DelegateVote(ctx, &customtypes.MsgDelegateVote{
+		Delegator: addrs[0].String(),
+		Delegate:  addrs[0].String(),
+	})
+	require.ErrorIs(t, err, customtypes.ErrInvalidVoteDelegation)
+
+	// unknown msg type url
+	_, err = ms.DelegateVote(ctx, &customtypes.MsgDelegateVote{
+		Delegator:  addrs[0].String(),
+		Delegate:   addrs[1].String(),
+		MsgTypeUrl: "/unknown.MsgUnknown",
+	})
+	require.ErrorIs(t, err, customtypes.ErrInvalidVoteDelegation)
+
+	// 0 -> 1 -> 2
+	_, err = ms.DelegateVote(ctx, &customtypes.MsgDelegateVote{
+		Delegator: addrs[0].String(),
+		Delegate:  addrs[1].String(),
+	})
+	require.NoError(t, err)
+	_, err = ms.DelegateVote(ctx, &customtypes.MsgDelegateVote{
+		Delegator: addrs[1].String(),
+		Delegate:  addrs[2].String(),
+	})
+	require.NoError(t, err)
+
+	// 2 -> 0 closes the cycle
+	_, err = ms.DelegateVote(ctx, &customtypes.MsgDelegateVote{
+		Delegator: addrs[2].String(),
+		Delegate:  addrs[0].String(),
+	})
+	require.ErrorIs(t, err, customtypes.ErrInvalidVoteDelegation)
+
+	// scoped delegation
+	_, err = ms.DelegateVote(ctx, &customtypes.MsgDelegateVote{
+		Delegator:  addrs[0].String(),
+		Delegate:   addrs[2].String(),
+		MsgTypeUrl: msgSendTypeURL,
+	})
+	require.NoError(t, err)
+
+	delegate, found, err := input.GovKeeper.GetVoteDelegate(ctx, addrs[0], msgSendTypeURL)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, addrs[2], delegate)
+
+	delegate, found, err = input.GovKeeper.GetVoteDelegate(ctx, addrs[0], "")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, addrs[1], delegate)
+
+	queryServer := keeper.NewCustomQueryServer(&input.GovKeeper)
+	delegationsRes, err := queryServer.VoteDelegations(ctx, &customtypes.QueryVoteDelegationsRequest{Delegator: addrs[0].String()})
+	require.NoError(t, err)
+	require.Len(t, delegationsRes.VoteDelegations, 2)
+
+	delegatorsRes, err := queryServer.VoteDelegators(ctx, &customtypes.QueryVoteDelegatorsRequest{Delegate: addrs[2].String()})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []customtypes.VoteDelegation{
+		{Delegator: addrs[0].String(), Delegate: addrs[2].String(), MsgTypeUrl: msgSendTypeURL},
+		{Delegator: addrs[1].String(), Delegate: addrs[2].String()},
+	}, delegatorsRes.VoteDelegations)
+
+	// replace the delegation
+	_, err = ms.DelegateVote(ctx, &customtypes.MsgDelegateVote{
+		Delegator: addrs[1].String(),
+		Delegate:  addrs[3].String(),
+	})
+	require.NoError(t, err)
+
+	delegatorsRes, err = queryServer.VoteDelegators(ctx, &customtypes.QueryVoteDelegatorsRequest{Delegate: addrs[2].String()})
+	require.NoError(t, err)
+	require.Len(t, delegatorsRes.VoteDelegations, 1)
+
+	// revoke
+	_, err = ms.UndelegateVote(ctx, &customtypes.MsgUndelegateVote{
+		Delegator:  addrs[0].String(),
+		MsgTypeUrl: msgSendTypeURL,
+	})
+	require.NoError(t, err)
+
+	_, err = ms.UndelegateVote(ctx, &customtypes.MsgUndelegateVote{
+		Delegator:  addrs[0].String(),
+		MsgTypeUrl: msgSendTypeURL,
+	})
+	require.ErrorIs(t, err, customtypes.ErrInvalidVoteDelegation)
+
+	delegatorsRes, err = queryServer.VoteDelegators(ctx, &customtypes.QueryVoteDelegatorsRequest{Delegate: addrs[2].String()})
+	require.NoError(t, err)
+	require.Empty(t, delegatorsRes.VoteDelegations)
+}
+
+func Test_Tally_VoteDelegation(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	now := time.Now().UTC()
+	setupVesting(t, ctx, input, now)
+
+	proposal, err := input.GovKeeper.SubmitProposal(ctx, nil, "", "test", "description", addrs[0], false)
+	require.NoError(t, err)
+
+	proposal.Status = v1.StatusVotingPeriod
+	err = input.GovKeeper.SetProposal(ctx, proposal)
+	require.NoError(t, err)
+
+	params, err := input.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	valAddr1 := createValidatorWithCoin(ctx, input,
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		1,
+	)
+	valAddr2 := createValidatorWithCoin(ctx, input,
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		2,
+	)
+
+	voterAddr1 := sdk.AccAddress(valAddr1)
+	voterAddr2 := sdk.AccAddress(valAddr2)
+	vestingVoter := addrs[1]
+
+	// vesting voter -> voter 2 -> voter 1
+	require.NoError(t, input.GovKeeper.SetVoteDelegation(ctx, vestingVoter, voterAddr2, ""))
+	require.NoError(t, input.GovKeeper.SetVoteDelegation(ctx, voterAddr2, voterAddr1, ""))
+
+	// the scoped delegation does not apply to the proposal without messages
+	require.NoError(t, input.GovKeeper.SetVoteDelegation(ctx, voterAddr2, addrs[2], msgSendTypeURL))
+
+	// only voter 1 votes yes
+	err = input.GovKeeper.AddVote(ctx, proposal.Id, voterAddr1, v1.WeightedVoteOptions{
+		{
+			Option: v1.OptionYes,
+			Weight: "1",
+		},
+	}, "")
+	require.NoError(t, err)
+
+	// 15 minutes passed
+	ctx = ctx.WithBlockTime(now.Add(time.Minute * 15))
+
+	quorumReached, passed, _, tallyResults, err := input.GovKeeper.Tally(ctx, params, proposal)
+	require.NoError(t, err)
+	require.True(t, quorumReached)
+	require.True(t, passed)
+	require.Equal(t, math.LegacyNewDec(1_500_000+200_000_000).TruncateInt().String(), tallyResults.V1TallyResult.YesCount)
+	require.Equal(t, "0", tallyResults.V1TallyResult.NoCount)
+
+	// the own vote overrides the delegation
+	err = input.GovKeeper.AddVote(ctx, proposal.Id, voterAddr1, v1.WeightedVoteOptions{
+		{
+			Option: v1.OptionYes,
+			Weight: "1",
+		},
+	}, "")
+	require.NoError(t, err)
+	err = input.GovKeeper.AddVote(ctx, proposal.Id, voterAddr2, v1.WeightedVoteOptions{
+		{
+			Option: v1.OptionNo,
+			Weight: "1",
+		},
+	}, "")
+	require.NoError(t, err)
+
+	_, _, _, tallyResults, err = input.GovKeeper.Tally(ctx, params, proposal)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(100_000_000).TruncateInt().String(), tallyResults.V1TallyResult.YesCount)
+	require.Equal(t, math.LegacyNewDec(1_500_000+100_000_000).TruncateInt().String(), tallyResults.V1TallyResult.NoCount)
+}
+
+func TestDelegateVote_Limits(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	newAddr := func(i int) sdk.AccAddress {
+		return sdk.AccAddress(append(make([]byte, 18), byte(i>>8), byte(i)))
+	}
+
+	// chain of the max depth
+	for i := 0; i < customtypes.MaxVoteDelegationDepth; i++ {
+		require.NoError(t, input.GovKeeper.SetVoteDelegation(ctx, newAddr(i+1), newAddr(i), ""))
+	}
+
+	// extending the chain exceeds the max depth
+	err := input.GovKeeper.SetVoteDelegation(ctx, newAddr(customtypes.MaxVoteDelegationDepth+1), newAddr(customtypes.MaxVoteDelegationDepth), "")
+	require.ErrorIs(t, err, customtypes.ErrInvalidVoteDelegation)
+
+	// extending the tail of the chain counts the delegators already delegating to the tail
+	for i := 0; i < customtypes.MaxVoteDelegationDepth; i++ {
+		require.NoError(t, input.GovKeeper.SetVoteDelegation(ctx, newAddr(500+i), newAddr(500+i+1), ""))
+	}
+
+	err = input.GovKeeper.SetVoteDelegation(ctx, newAddr(500+customtypes.MaxVoteDelegationDepth), newAddr(500+customtypes.MaxVoteDelegationDepth+1), "")
+	require.ErrorIs(t, err, customtypes.ErrInvalidVoteDelegation)
+
+	// fill the delegators of a delegate
+	delegate := newAddr(1000)
+	for i := 0; i < customtypes.MaxVoteDelegatorsPerDelegate; i++ {
+		require.NoError(t, input.GovKeeper.SetVoteDelegation(ctx, newAddr(1001+i), delegate, ""))
+	}
+
+	err = input.GovKeeper.SetVoteDelegation(ctx, newAddr(1001+customtypes.MaxVoteDelegatorsPerDelegate), delegate, "")
+	require.ErrorIs(t, err, customtypes.ErrInvalidVoteDelegation)
+
+	// replacing the existing delegation is allowed
+	require.NoError(t, input.GovKeeper.SetVoteDelegation(ctx, newAddr(1001), delegate, ""))
+}
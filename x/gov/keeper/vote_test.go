@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/collections"
 	"github.com/cosmos/cosmos-sdk/types"
@@ -65,3 +66,72 @@ func TestVoteToInvalidProposer(t *testing.T) {
 	err = input.GovKeeper.AddVote(ctx, 2, addrs[0], v1.WeightedVoteOptions{&v1.WeightedVoteOption{Option: v1.OptionYes, Weight: "1"}}, "")
 	require.Error(t, err)
 }
+
+func TestEmergencyEscalation(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	params, err := input.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	params.EmergencyEscalationThreshold = "0.667"
+	params.EmergencyEscalationWindow = time.Hour
+	err = input.GovKeeper.Params.Set(ctx, params)
+	require.NoError(t, err)
+
+	voters := make([]types.AccAddress, 3)
+	for i := range voters {
+		valAddr := createValidatorWithCoin(ctx, input,
+			types.NewCoins(types.NewInt64Coin(bondDenom, 100_000_000)),
+			types.NewCoins(types.NewInt64Coin(bondDenom, 100_000_000)),
+			i+1,
+		)
+		voters[i] = types.AccAddress(valAddr)
+	}
+
+	proposal1, err := input.GovKeeper.SubmitProposal(ctx, nil, "", "", "", addrs[0], false)
+	require.NoError(t, err)
+	err = input.GovKeeper.ActivateVotingPeriod(ctx, proposal1)
+	require.NoError(t, err)
+
+	proposal2, err := input.GovKeeper.SubmitProposal(ctx, nil, "", "", "", addrs[0], false)
+	require.NoError(t, err)
+	err = input.GovKeeper.ActivateVotingPeriod(ctx, proposal2)
+	require.NoError(t, err)
+
+	yesOption := []*v1.WeightedVoteOption{{Option: v1.OptionYes, Weight: "1"}}
+
+	// the votes from non validators are not counted
+	err = input.GovKeeper.AddVote(ctx, proposal1.Id, addrs[0], yesOption, "")
+	require.NoError(t, err)
+
+	// 2/3 of the validator voting power is below the threshold
+	for _, voter := range voters[:2] {
+		err = input.GovKeeper.AddVote(ctx, proposal1.Id, voter, yesOption, "")
+		require.NoError(t, err)
+	}
+
+	proposal, err := input.GovKeeper.Proposals.Get(ctx, proposal1.Id)
+	require.NoError(t, err)
+	require.False(t, proposal.Emergency)
+
+	err = input.GovKeeper.AddVote(ctx, proposal1.Id, voters[2], yesOption, "")
+	require.NoError(t, err)
+
+	proposal, err = input.GovKeeper.Proposals.Get(ctx, proposal1.Id)
+	require.NoError(t, err)
+	require.True(t, proposal.Emergency)
+
+	// the escalation is not allowed after the window
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	for _, voter := range voters {
+		err = input.GovKeeper.AddVote(ctx, proposal2.Id, voter, yesOption, "")
+		require.NoError(t, err)
+	}
+
+	proposal, err = input.GovKeeper.Proposals.Get(ctx, proposal2.Id)
+	require.NoError(t, err)
+	require.False(t, proposal.Emergency)
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	customtypes "github.com/initia-labs/initia/x/gov/types"
+)
+
+// archiveVoterBreakdowns stores the voter breakdowns of the proposal.
+func (k Keeper) archiveVoterBreakdowns(ctx context.Context, proposalID uint64, breakdowns []customtypes.VoterBreakdown) error {
+	// clear the breakdowns of the previous tally, e.g. an expedited proposal converted to regular
+	if err := k.removeVoterBreakdowns(ctx, proposalID); err != nil {
+		return err
+	}
+
+	for _, breakdown := range breakdowns {
+		voter, err := k.authKeeper.AddressCodec().StringToBytes(breakdown.Voter)
+		if err != nil {
+			return err
+		}
+
+		if err := k.VoterBreakdowns.Set(ctx, collections.Join(proposalID, sdk.AccAddress(voter)), breakdown); err != nil {
+			return err
+		}
+	}
+
+	return k.VoterBreakdownProposals.Set(ctx, proposalID)
+}
+
+// pruneVoterBreakdowns prunes the breakdowns of the oldest proposals exceeding the retention.
+// Zero retention keeps all the breakdowns.
+func (k Keeper) pruneVoterBreakdowns(ctx context.Context, retention uint64) error {
+	if retention == 0 {
+		return nil
+	}
+
+	var proposalIDs []uint64
+	err := k.VoterBreakdownProposals.Walk(ctx, nil, func(id uint64) (stop bool, err error) {
+		proposalIDs = append(proposalIDs, id)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// the proposal ids are walked in ascending order; prune the oldest ones
+	for uint64(len(proposalIDs)) > retention {
+		if err := k.removeVoterBreakdowns(ctx, proposalIDs[0]); err != nil {
+			return err
+		}
+
+		proposalIDs = proposalIDs[1:]
+	}
+
+	return nil
+}
+
+// removeVoterBreakdowns removes all the voter breakdowns of the proposal.
+func (k Keeper) removeVoterBreakdowns(ctx context.Context, proposalID uint64) error {
+	if err := k.VoterBreakdowns.Clear(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)); err != nil {
+		return err
+	}
+
+	return k.VoterBreakdownProposals.Remove(ctx, proposalID)
+}
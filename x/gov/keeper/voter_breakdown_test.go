@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/initia-labs/initia/x/gov/keeper"
+	customtypes "github.com/initia-labs/initia/x/gov/types"
+	stakingkeeper "github.com/initia-labs/initia/x/mstaking/keeper"
+	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
+)
+
+func Test_Tally_VoterBreakdown(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	now := time.Now().UTC()
+	setupVesting(t, ctx, input, now)
+
+	params, err := input.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.ArchiveVoterBreakdown = true
+	params.VoterBreakdownRetention = 2
+	require.NoError(t, input.GovKeeper.Params.Set(ctx, params))
+
+	valAddr1 := createValidatorWithCoin(ctx, input,
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		1,
+	)
+	voterAddr1 := sdk.AccAddress(valAddr1)
+	vestingVoter := addrs[1]
+
+	// the delegator inherits the vote of validator 1
+	delegator := input.Faucet.NewFundedAccount(ctx, sdk.NewInt64Coin(bondDenom, 50_000_000))
+	_, err = stakingkeeper.NewMsgServerImpl(input.StakingKeeper).Delegate(ctx, stakingtypes.NewMsgDelegate(delegator.String(), valAddr1.String(), sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50_000_000))))
+	require.NoError(t, err)
+
+	require.NoError(t, input.GovKeeper.SetVoteDelegation(ctx, vestingVoter, voterAddr1, ""))
+
+	// 15 minutes passed
+	ctx = ctx.WithBlockTime(now.Add(time.Minute * 15))
+
+	queryServer := keeper.NewCustomQueryServer(&input.GovKeeper)
+	var proposalIDs []uint64
+	for i := 0; i < 3; i++ {
+		proposal, err := input.GovKeeper.SubmitProposal(ctx, nil, "", "test", "description", addrs[0], false)
+		require.NoError(t, err)
+
+		proposal.Status = v1.StatusVotingPeriod
+		require.NoError(t, input.GovKeeper.SetProposal(ctx, proposal))
+		proposalIDs = append(proposalIDs, proposal.Id)
+
+		err = input.GovKeeper.AddVote(ctx, proposal.Id, voterAddr1, v1.WeightedVoteOptions{
+			{
+				Option: v1.OptionYes,
+				Weight: "1",
+			},
+		}, "")
+		require.NoError(t, err)
+
+		_, _, _, _, err = input.GovKeeper.Tally(ctx, params, proposal)
+		require.NoError(t, err)
+	}
+
+	// the oldest breakdowns are pruned
+	res, err := queryServer.ProposalVoterBreakdown(ctx, &customtypes.QueryProposalVoterBreakdownRequest{ProposalId: proposalIDs[0]})
+	require.NoError(t, err)
+	require.Empty(t, res.VoterBreakdowns)
+
+	res, err = queryServer.ProposalVoterBreakdown(ctx, &customtypes.QueryProposalVoterBreakdownRequest{ProposalId: proposalIDs[2]})
+	require.NoError(t, err)
+	require.Len(t, res.VoterBreakdowns, 2)
+
+	breakdowns := make(map[string]customtypes.VoterBreakdown)
+	for _, breakdown := range res.VoterBreakdowns {
+		breakdowns[breakdown.Voter] = breakdown
+	}
+
+	breakdown := breakdowns[voterAddr1.String()]
+	require.Empty(t, breakdown.Delegate)
+	require.Equal(t, v1.OptionYes, breakdown.Options[0].Option)
+	require.Equal(t, math.LegacyNewDec(100_000_000).String(), breakdown.StakingPower)
+	require.Equal(t, math.LegacyNewDec(50_000_000).String(), breakdown.InheritedPower)
+	require.Equal(t, "0", breakdown.VestingPower)
+
+	breakdown = breakdowns[vestingVoter.String()]
+	require.Equal(t, voterAddr1.String(), breakdown.Delegate)
+	require.Equal(t, v1.OptionYes, breakdown.Options[0].Option)
+	require.Equal(t, math.LegacyZeroDec().String(), breakdown.StakingPower)
+	require.Equal(t, "1500000", breakdown.VestingPower)
+
+	// not archived if disabled
+	params.ArchiveVoterBreakdown = false
+	proposal, err := input.GovKeeper.SubmitProposal(ctx, nil, "", "test", "description", addrs[0], false)
+	require.NoError(t, err)
+	proposal.Status = v1.StatusVotingPeriod
+	require.NoError(t, input.GovKeeper.SetProposal(ctx, proposal))
+
+	err = input.GovKeeper.AddVote(ctx, proposal.Id, voterAddr1, v1.WeightedVoteOptions{
+		{
+			Option: v1.OptionYes,
+			Weight: "1",
+		},
+	}, "")
+	require.NoError(t, err)
+
+	_, _, _, _, err = input.GovKeeper.Tally(ctx, params, proposal)
+	require.NoError(t, err)
+
+	res, err = queryServer.ProposalVoterBreakdown(ctx, &customtypes.QueryProposalVoterBreakdownRequest{ProposalId: proposal.Id})
+	require.NoError(t, err)
+	require.Empty(t, res.VoterBreakdowns)
+
+	// the archived breakdowns are still pruned if disabled
+	params.VoterBreakdownRetention = 1
+	proposal, err = input.GovKeeper.SubmitProposal(ctx, nil, "", "test", "description", addrs[0], false)
+	require.NoError(t, err)
+	proposal.Status = v1.StatusVotingPeriod
+	require.NoError(t, input.GovKeeper.SetProposal(ctx, proposal))
+
+	_, _, _, _, err = input.GovKeeper.Tally(ctx, params, proposal)
+	require.NoError(t, err)
+
+	res, err = queryServer.ProposalVoterBreakdown(ctx, &customtypes.QueryProposalVoterBreakdownRequest{ProposalId: proposalIDs[1]})
+	require.NoError(t, err)
+	require.Empty(t, res.VoterBreakdowns)
+
+	res, err = queryServer.ProposalVoterBreakdown(ctx, &customtypes.QueryProposalVoterBreakdownRequest{ProposalId: proposalIDs[2]})
+	require.NoError(t, err)
+	require.Len(t, res.VoterBreakdowns, 2)
+}
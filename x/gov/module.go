@@ -28,7 +28,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
-	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -39,7 +38,7 @@ import (
 	customcli "github.com/initia-labs/initia/x/gov/client/cli"
 )
 
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 var (
 	_ module.AppModuleBasic = AppModuleBasic{}
@@ -108,7 +107,7 @@ func (a AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux
 func (a AppModuleBasic) GetTxCmd() *cobra.Command {
 	legacyProposalCLIHandlers := getProposalCLIHandlers(a.legacyProposalHandlers)
 
-	return cli.NewTxCmd(legacyProposalCLIHandlers)
+	return customcli.NewTxCmd(legacyProposalCLIHandlers)
 }
 
 // GetQueryCmd returns no root query command for the staking module.
@@ -182,6 +181,7 @@ type ModuleInputs struct {
 	StakingKeeper      customtypes.StakingKeeper
 	DistributionKeeper types.DistributionKeeper
 	VestingKeeper      customtypes.VestingKeeper
+	MoveGovKeeper      customtypes.MoveGovKeeper
 }
 
 type ModuleOutputs struct {
@@ -212,6 +212,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.StakingKeeper,
 		in.DistributionKeeper,
 		in.VestingKeeper,
+		in.MoveGovKeeper,
 		in.MsgServiceRouter,
 		defaultConfig,
 		authority.String(),
@@ -287,6 +288,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 
 	customtypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewCustomMsgServerImpl(am.keeper))
 	customtypes.RegisterQueryServer(cfg.QueryServer(), keeper.NewCustomQueryServer(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the gov module. It returns
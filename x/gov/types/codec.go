@@ -13,6 +13,11 @@ import (
 // for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "gov/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegateVote{}, "gov/MsgDelegateVote")
+	legacy.RegisterAminoMsg(cdc, &MsgUndelegateVote{}, "gov/MsgUndelegateVote")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelQueuedExecution{}, "gov/MsgCancelQueuedExecution")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitOptimisticProposal{}, "gov/MsgSubmitOptimisticProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateMoveHook{}, "gov/MsgUpdateMoveHook")
 	cdc.RegisterConcrete(Params{}, "gov/Params", nil)
 }
 
@@ -20,6 +25,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgDelegateVote{},
+		&MsgUndelegateVote{},
+		&MsgCancelQueuedExecution{},
+		&MsgSubmitOptimisticProposal{},
+		&MsgUpdateMoveHook{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
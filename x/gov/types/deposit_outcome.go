@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/address"
+)
+
+// Validate performs basic validation of the deposit outcome.
+func (o DepositOutcome) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(o.Depositor); err != nil {
+		return fmt.Errorf("invalid depositor address of deposit outcome: %w", err)
+	}
+
+	if _, ok := DepositOutcomeType_name[int32(o.Outcome)]; !ok || o.Outcome == DepositOutcomeUnspecified {
+		return fmt.Errorf("invalid outcome of the deposit of %s on proposal %d: %s", o.Depositor, o.ProposalId, o.Outcome)
+	}
+
+	if err := o.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount of deposit outcome: %w", err)
+	}
+	if err := o.Refunded.Validate(); err != nil {
+		return fmt.Errorf("invalid refunded amount of deposit outcome: %w", err)
+	}
+	if err := o.Burned.Validate(); err != nil {
+		return fmt.Errorf("invalid burned amount of deposit outcome: %w", err)
+	}
+	if err := o.Charged.Validate(); err != nil {
+		return fmt.Errorf("invalid charged amount of deposit outcome: %w", err)
+	}
+
+	return nil
+}
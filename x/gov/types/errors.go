@@ -0,0 +1,15 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// x/gov module sentinel errors; the codes start from 100 to avoid
+// the conflicts with the errors of the cosmos-sdk gov module.
+var (
+	ErrInvalidVoteDelegation     = errorsmod.Register(govtypes.ModuleName, 100, "invalid vote delegation")
+	ErrExecutionNotQueued        = errorsmod.Register(govtypes.ModuleName, 101, "proposal execution not queued")
+	ErrInvalidOptimisticProposal = errorsmod.Register(govtypes.ModuleName, 102, "invalid optimistic proposal")
+)
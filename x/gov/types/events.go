@@ -1,7 +1,27 @@
 package types
 
 const (
-	EventTypeEmergencyProposal = "emergency_proposal"
+	EventTypeEmergencyProposal     = "emergency_proposal"
+	EventTypeDelegateVote          = "delegate_vote"
+	EventTypeUndelegateVote        = "undelegate_vote"
+	EventTypeQueueExecution        = "queue_execution"
+	EventTypeExecuteProposal       = "execute_proposal"
+	EventTypeCancelQueuedExecution = "cancel_queued_execution"
+	EventTypeUpdateMoveHook        = "update_move_hook"
+	EventTypeMoveHook              = "move_hook"
 
-	AttributeKeyProposalID = "proposal_id"
+	AttributeKeyProposalID    = "proposal_id"
+	AttributeKeyDelegator     = "delegator"
+	AttributeKeyDelegate      = "delegate"
+	AttributeKeyMsgTypeURL    = "msg_type_url"
+	AttributeKeyExecutionTime = "execution_time"
+	AttributeKeyReason        = "reason"
+	AttributeKeyModuleAddr    = "module_addr"
+	AttributeKeyModuleName    = "module_name"
+	AttributeKeyAllowed       = "allowed"
+	AttributeKeyHookEvent     = "hook_event"
+	AttributeKeySuccess       = "success"
+	AttributeKeyError         = "error"
+
+	AttributeValueProposalQueued = "proposal_queued"
 )
@@ -22,6 +22,7 @@ type StakingKeeper interface {
 		fn func(delegation stakingtypes.DelegationI) (stop bool, err error),
 	) error
 
+	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
 	GetVotingPowerWeights(ctx context.Context) (sdk.DecCoins, error)
 	ValidatorAddressCodec() address.Codec
 }
@@ -30,3 +31,10 @@ type VestingKeeper interface {
 	GetVestingHandle(ctx context.Context, moduleAddr sdk.AccAddress, moduleName string, creator sdk.AccAddress) (*sdk.AccAddress, error)
 	GetUnclaimedVestedAmount(ctx context.Context, tableHandle, recipientAccAddr sdk.AccAddress) (math.Int, error)
 }
+
+// MoveGovKeeper calls the move functions registered to the gov module, the voting power
+// providers and the gov hooks.
+type MoveGovKeeper interface {
+	GetProviderVotingPower(ctx context.Context, moduleAddr sdk.AccAddress, moduleName, functionName string, voter sdk.AccAddress, gasLimit uint64) (math.Int, error)
+	ExecuteGovHook(ctx context.Context, moduleAddr sdk.AccAddress, moduleName, functionName string, proposalID uint64, event string, account sdk.AccAddress, gasLimit uint64) error
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	"cosmossdk.io/core/address"
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -35,6 +36,77 @@ func ValidateGenesis(data *GenesisState, ac address.Codec) error {
 		return errors.New("starting proposal id must be greater than 0")
 	}
 
+	delegations := make(map[string]bool, len(data.VoteDelegations))
+	for _, delegation := range data.VoteDelegations {
+		if err := delegation.Validate(ac); err != nil {
+			return err
+		}
+
+		key := delegation.Delegator + "/" + delegation.MsgTypeUrl
+		if delegations[key] {
+			return fmt.Errorf("duplicate vote delegation of %s for %q", delegation.Delegator, delegation.MsgTypeUrl)
+		}
+		delegations[key] = true
+	}
+
+	if err := ValidateVoteDelegations(data.VoteDelegations); err != nil {
+		return err
+	}
+
+	hooks := make(map[string]bool, len(data.MoveHooks))
+	for _, hook := range data.MoveHooks {
+		if err := hook.Validate(ac); err != nil {
+			return err
+		}
+
+		moduleAddr, _ := ac.StringToBytes(hook.ModuleAddr)
+		key := string(moduleAddr) + "/" + hook.ModuleName
+		if hooks[key] {
+			return fmt.Errorf("duplicate move hook of %s::%s", hook.ModuleAddr, hook.ModuleName)
+		}
+		hooks[key] = true
+	}
+
+	outcomes := make(map[string]bool, len(data.DepositOutcomes))
+	for _, outcome := range data.DepositOutcomes {
+		if err := outcome.Validate(ac); err != nil {
+			return err
+		}
+
+		depositor, _ := ac.StringToBytes(outcome.Depositor)
+		key := fmt.Sprintf("%d/%s", outcome.ProposalId, depositor)
+		if outcomes[key] {
+			return fmt.Errorf("duplicate deposit outcome of %s on proposal %d", outcome.Depositor, outcome.ProposalId)
+		}
+		outcomes[key] = true
+	}
+
+	breakdownProposals := make(map[uint64]bool, len(data.VoterBreakdownProposals))
+	for _, proposalID := range data.VoterBreakdownProposals {
+		if breakdownProposals[proposalID] {
+			return fmt.Errorf("duplicate voter breakdown proposal: %d", proposalID)
+		}
+		breakdownProposals[proposalID] = true
+	}
+
+	breakdowns := make(map[string]bool, len(data.VoterBreakdowns))
+	for _, breakdown := range data.VoterBreakdowns {
+		if err := breakdown.Validate(ac); err != nil {
+			return err
+		}
+
+		if !breakdownProposals[breakdown.ProposalId] {
+			return fmt.Errorf("voter breakdown of %s on proposal %d is not in the voter breakdown proposals", breakdown.Voter, breakdown.ProposalId)
+		}
+
+		voter, _ := ac.StringToBytes(breakdown.Voter)
+		key := fmt.Sprintf("%d/%s", breakdown.ProposalId, voter)
+		if breakdowns[key] {
+			return fmt.Errorf("duplicate voter breakdown of %s on proposal %d", breakdown.Voter, breakdown.ProposalId)
+		}
+		breakdowns[key] = true
+	}
+
 	return data.Params.Validate(ac)
 }
 
@@ -42,6 +42,17 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.50
 	Constitution string `protobuf:"bytes,6,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// vote_delegations defines all the vote delegations present at genesis.
+	VoteDelegations []*VoteDelegation `protobuf:"bytes,7,rep,name=vote_delegations,json=voteDelegations,proto3" json:"vote_delegations,omitempty"`
+	// move_hooks defines all the Move hooks present at genesis.
+	MoveHooks []*MoveHook `protobuf:"bytes,8,rep,name=move_hooks,json=moveHooks,proto3" json:"move_hooks,omitempty"`
+	// deposit_outcomes defines the final outcomes of the deleted deposits.
+	DepositOutcomes []*DepositOutcome `protobuf:"bytes,9,rep,name=deposit_outcomes,json=depositOutcomes,proto3" json:"deposit_outcomes,omitempty"`
+	// voter_breakdowns defines the archived voter breakdowns of the proposals.
+	VoterBreakdowns []*VoterBreakdown `protobuf:"bytes,10,rep,name=voter_breakdowns,json=voterBreakdowns,proto3" json:"voter_breakdowns,omitempty"`
+	// voter_breakdown_proposals defines the ids of the proposals whose voter
+	// breakdowns are archived.
+	VoterBreakdownProposals []uint64 `protobuf:"varint,11,rep,packed,name=voter_breakdown_proposals,json=voterBreakdownProposals,proto3" json:"voter_breakdown_proposals,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -119,6 +130,41 @@ func (m *GenesisState) GetConstitution() string {
 	return ""
 }
 
+func (m *GenesisState) GetVoteDelegations() []*VoteDelegation {
+	if m != nil {
+		return m.VoteDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMoveHooks() []*MoveHook {
+	if m != nil {
+		return m.MoveHooks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDepositOutcomes() []*DepositOutcome {
+	if m != nil {
+		return m.DepositOutcomes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoterBreakdowns() []*VoterBreakdown {
+	if m != nil {
+		return m.VoterBreakdowns
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoterBreakdownProposals() []uint64 {
+	if m != nil {
+		return m.VoterBreakdownProposals
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "initia.gov.v1.GenesisState")
 }
@@ -126,27 +172,35 @@ func init() {
 func init() { proto.RegisterFile("initia/gov/v1/genesis.proto", fileDescriptor_67ed99a117f3a32d) }
 
 var fileDescriptor_67ed99a117f3a32d = []byte{
-	// 318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xcd, 0x4e, 0xf2, 0x40,
-	0x14, 0x86, 0x19, 0xfe, 0xf2, 0x31, 0xf0, 0x6d, 0xc6, 0x1f, 0x1a, 0x4c, 0x9a, 0x86, 0x55, 0x59,
-	0x30, 0x15, 0x8c, 0x37, 0xa0, 0x26, 0xc6, 0x9d, 0xa9, 0x89, 0x0b, 0x37, 0x64, 0x80, 0x49, 0x9d,
-	0x04, 0x7a, 0x9a, 0x9e, 0x43, 0xa3, 0x77, 0xe1, 0x6d, 0x78, 0x27, 0x2e, 0x59, 0xba, 0x34, 0x70,
-	0x23, 0x26, 0x9d, 0x16, 0x43, 0x77, 0x93, 0x79, 0x9e, 0xf7, 0xcc, 0x3b, 0x87, 0x5f, 0x98, 0xd8,
-	0x90, 0x51, 0x41, 0x04, 0x59, 0x90, 0x4d, 0x82, 0x48, 0xc7, 0x1a, 0x0d, 0xca, 0x24, 0x05, 0x02,
-	0xf1, 0xdf, 0x42, 0x19, 0x41, 0x26, 0xb3, 0xc9, 0xa0, 0xbf, 0x00, 0x5c, 0x03, 0x1e, 0x5c, 0xc8,
-	0xac, 0x37, 0xe8, 0x57, 0x86, 0x94, 0x60, 0xf8, 0x59, 0xe7, 0xbd, 0x7b, 0x3b, 0xf2, 0x89, 0x14,
-	0x69, 0x71, 0xc9, 0x4f, 0x91, 0x54, 0x4a, 0x26, 0x8e, 0x66, 0x49, 0x0a, 0x09, 0xa0, 0x5a, 0xcd,
-	0xcc, 0xd2, 0x61, 0x1e, 0xf3, 0x9b, 0xa1, 0x28, 0xd9, 0x63, 0x81, 0x1e, 0x96, 0x62, 0xca, 0xff,
-	0x2d, 0x75, 0x02, 0x68, 0x08, 0x9d, 0xba, 0xd7, 0xf0, 0xbb, 0xd3, 0x73, 0x69, 0x7b, 0x14, 0xb5,
-	0xe4, 0x9d, 0xc5, 0xe1, 0xc1, 0x13, 0x23, 0xde, 0xca, 0x80, 0x34, 0x3a, 0x8d, 0x3c, 0x70, 0x52,
-	0x09, 0x3c, 0x03, 0xe9, 0xd0, 0x1a, 0xe2, 0x9a, 0x77, 0xca, 0x1e, 0xe8, 0x34, 0x73, 0xbd, 0x2f,
-	0x8f, 0xbe, 0x2d, 0xcb, 0x32, 0xe1, 0x9f, 0x29, 0xc6, 0xbc, 0x9d, 0xa8, 0x54, 0xad, 0xd1, 0x69,
-	0x79, 0xcc, 0xef, 0x4e, 0xcf, 0xaa, 0x99, 0x1c, 0x86, 0x85, 0x24, 0x86, 0xbc, 0xb7, 0x80, 0x18,
-	0xc9, 0xd0, 0x86, 0x0c, 0xc4, 0x4e, 0xdb, 0x63, 0x7e, 0x27, 0x3c, 0xba, 0xbb, 0xb9, 0xfd, 0xda,
-	0xb9, 0x6c, 0xbb, 0x73, 0xd9, 0xcf, 0xce, 0x65, 0x1f, 0x7b, 0xb7, 0xb6, 0xdd, 0xbb, 0xb5, 0xef,
-	0xbd, 0x5b, 0x7b, 0x19, 0x45, 0x86, 0x5e, 0x37, 0x73, 0xb9, 0x80, 0x75, 0x60, 0x9f, 0x19, 0xaf,
-	0xd4, 0x1c, 0x8b, 0x73, 0xf0, 0x96, 0xef, 0x9d, 0xde, 0x13, 0x8d, 0xf3, 0x76, 0xbe, 0xf7, 0xab,
-	0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc8, 0xd2, 0x42, 0x04, 0xd7, 0x01, 0x00, 0x00,
+	// 441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x1b, 0xda, 0x95, 0xd5, 0x1d, 0x1a, 0x32, 0x3f, 0x6a, 0x86, 0x88, 0xa2, 0x9d, 0xb2,
+	0xc3, 0x12, 0x56, 0x04, 0x07, 0x8e, 0x63, 0x12, 0xe3, 0x80, 0x40, 0x46, 0xe2, 0xc0, 0x25, 0x72,
+	0x1b, 0x2b, 0xb3, 0xda, 0xe4, 0x45, 0x79, 0xae, 0x81, 0xff, 0x82, 0x3f, 0x8b, 0xe3, 0x8e, 0x1c,
+	0x51, 0xfb, 0x67, 0x70, 0x41, 0x89, 0x93, 0x56, 0x89, 0xba, 0x9b, 0xed, 0xef, 0xe7, 0x3d, 0x7d,
+	0x9e, 0x6d, 0xf2, 0x5c, 0x65, 0x4a, 0x2b, 0x11, 0x26, 0x60, 0x42, 0x73, 0x11, 0x26, 0x32, 0x93,
+	0xa8, 0x30, 0xc8, 0x0b, 0xd0, 0x40, 0x1f, 0xd8, 0x30, 0x48, 0xc0, 0x04, 0xe6, 0xe2, 0x64, 0x32,
+	0x07, 0x4c, 0x01, 0xb7, 0x2c, 0x18, 0xcb, 0x9d, 0x4c, 0x3a, 0x4d, 0x9a, 0xe0, 0xf4, 0xdf, 0x80,
+	0x1c, 0xbd, 0xb7, 0x2d, 0xbf, 0x68, 0xa1, 0x25, 0x7d, 0x49, 0x1e, 0xa3, 0x16, 0x85, 0x56, 0x59,
+	0x12, 0xe5, 0x05, 0xe4, 0x80, 0x62, 0x19, 0xa9, 0x98, 0x39, 0x9e, 0xe3, 0x0f, 0x38, 0x6d, 0xb2,
+	0xcf, 0x75, 0xf4, 0x21, 0xa6, 0x53, 0x72, 0x18, 0xcb, 0x1c, 0x50, 0x69, 0x64, 0xf7, 0xbc, 0xbe,
+	0x3f, 0x9e, 0x3e, 0x0d, 0xac, 0x47, 0xad, 0x15, 0x5c, 0xd9, 0x98, 0x6f, 0x39, 0x7a, 0x46, 0x0e,
+	0x0c, 0x68, 0x89, 0xac, 0x5f, 0x15, 0x3c, 0xea, 0x14, 0x7c, 0x05, 0x2d, 0xb9, 0x25, 0xe8, 0x6b,
+	0x32, 0x6a, 0x3c, 0x90, 0x0d, 0x2a, 0x7c, 0x12, 0xb4, 0xc6, 0x0e, 0x1a, 0x19, 0xbe, 0x23, 0xe9,
+	0x39, 0x19, 0xe6, 0xa2, 0x10, 0x29, 0xb2, 0x03, 0xcf, 0xf1, 0xc7, 0xd3, 0x27, 0xdd, 0x9a, 0x2a,
+	0xe4, 0x35, 0x44, 0x4f, 0xc9, 0xd1, 0x1c, 0x32, 0xd4, 0x4a, 0xaf, 0xb4, 0x82, 0x8c, 0x0d, 0x3d,
+	0xc7, 0x1f, 0xf1, 0xd6, 0x19, 0xbd, 0x26, 0x0f, 0x4b, 0xa5, 0x28, 0x96, 0x4b, 0x99, 0x88, 0xf2,
+	0x08, 0xd9, 0xfd, 0x4a, 0xe8, 0x45, 0xa7, 0x79, 0xe9, 0x7f, 0xb5, 0xa5, 0xf8, 0xb1, 0x69, 0xed,
+	0x91, 0xbe, 0x21, 0x24, 0x05, 0x23, 0xa3, 0x1b, 0x80, 0x05, 0xb2, 0xc3, 0xbd, 0x43, 0x7d, 0x04,
+	0x23, 0xaf, 0x01, 0x16, 0x7c, 0x94, 0xd6, 0x2b, 0x2c, 0x0d, 0xea, 0x2b, 0x8c, 0x60, 0xa5, 0xe7,
+	0x90, 0x4a, 0x64, 0xa3, 0xbd, 0x06, 0xf5, 0x95, 0x7f, 0xb2, 0x14, 0x3f, 0x8e, 0x5b, 0x7b, 0x6c,
+	0x66, 0x29, 0xa2, 0x59, 0x21, 0xc5, 0x22, 0x86, 0xef, 0x19, 0x32, 0x72, 0xe7, 0x2c, 0xc5, 0x65,
+	0x43, 0xd9, 0x59, 0x76, 0x7b, 0xa4, 0x6f, 0xc9, 0xb3, 0x4e, 0xa7, 0x68, 0xf7, 0x5e, 0x63, 0xaf,
+	0xef, 0x0f, 0xf8, 0xa4, 0x5d, 0xd3, 0x3c, 0x17, 0x5e, 0xbe, 0xfb, 0xbd, 0x76, 0x9d, 0xdb, 0xb5,
+	0xeb, 0xfc, 0x5d, 0xbb, 0xce, 0xaf, 0x8d, 0xdb, 0xbb, 0xdd, 0xb8, 0xbd, 0x3f, 0x1b, 0xb7, 0xf7,
+	0xed, 0x2c, 0x51, 0xfa, 0x66, 0x35, 0x0b, 0xe6, 0x90, 0x86, 0xd6, 0xe7, 0x7c, 0x29, 0x66, 0x58,
+	0xaf, 0xc3, 0x1f, 0xd5, 0x4f, 0xd6, 0x3f, 0x73, 0x89, 0xb3, 0x61, 0xf5, 0x93, 0x5f, 0xfd, 0x1f,
+	0x00, 0xdc, 0x5e, 0xea, 0xfe, 0x29, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -169,6 +223,80 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VoterBreakdownProposals) > 0 {
+		dAtA2 := make([]byte, len(m.VoterBreakdownProposals)*10)
+		var j1 int
+		for _, num := range m.VoterBreakdownProposals {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.VoterBreakdowns) > 0 {
+		for iNdEx := len(m.VoterBreakdowns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoterBreakdowns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DepositOutcomes) > 0 {
+		for iNdEx := len(m.DepositOutcomes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositOutcomes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.MoveHooks) > 0 {
+		for iNdEx := len(m.MoveHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MoveHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.VoteDelegations) > 0 {
+		for iNdEx := len(m.VoteDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Constitution) > 0 {
 		i -= len(m.Constitution)
 		copy(dAtA[i:], m.Constitution)
@@ -284,6 +412,37 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.VoteDelegations) > 0 {
+		for _, e := range m.VoteDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MoveHooks) > 0 {
+		for _, e := range m.MoveHooks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DepositOutcomes) > 0 {
+		for _, e := range m.DepositOutcomes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoterBreakdowns) > 0 {
+		for _, e := range m.VoterBreakdowns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoterBreakdownProposals) > 0 {
+		l = 0
+		for _, e := range m.VoterBreakdownProposals {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
 	return n
 }
 
@@ -511,6 +670,218 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Constitution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteDelegations = append(m.VoteDelegations, &VoteDelegation{})
+			if err := m.VoteDelegations[len(m.VoteDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoveHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MoveHooks = append(m.MoveHooks, &MoveHook{})
+			if err := m.MoveHooks[len(m.MoveHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositOutcomes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositOutcomes = append(m.DepositOutcomes, &DepositOutcome{})
+			if err := m.DepositOutcomes[len(m.DepositOutcomes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterBreakdowns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoterBreakdowns = append(m.VoterBreakdowns, &VoterBreakdown{})
+			if err := m.VoterBreakdowns[len(m.VoterBreakdowns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.VoterBreakdownProposals = append(m.VoterBreakdownProposals, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.VoterBreakdownProposals) == 0 {
+					m.VoterBreakdownProposals = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.VoterBreakdownProposals = append(m.VoterBreakdownProposals, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterBreakdownProposals", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -23,6 +23,34 @@ func TestEmptyGenesis(t *testing.T) {
 
 func TestValidateGenesis(t *testing.T) {
 	params := types.DefaultParams()
+	hook := types.MoveHook{
+		ModuleAddr:   sdk.AccAddress("hook_module_address_").String(),
+		ModuleName:   "hook",
+		FunctionName: "on_event",
+		GasLimit:     100_000,
+	}
+	outcome := types.DepositOutcome{
+		ProposalId: 1,
+		Depositor:  sdk.AccAddress("depositor_address___").String(),
+		Outcome:    types.DepositOutcomeRefunded,
+		Amount:     sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+		Refunded:   sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+	}
+	breakdown := types.VoterBreakdown{
+		ProposalId: 1,
+		Voter:      sdk.AccAddress("voter_address_______").String(),
+	}
+	delegationChain := func(length int) []*types.VoteDelegation {
+		delegations := make([]*types.VoteDelegation, length)
+		for i := range delegations {
+			delegations[i] = &types.VoteDelegation{
+				Delegator: sdk.AccAddress(append(make([]byte, 19), byte(i))).String(),
+				Delegate:  sdk.AccAddress(append(make([]byte, 19), byte(i+1))).String(),
+			}
+		}
+
+		return delegations
+	}
 
 	testCases := []struct {
 		name         string
@@ -95,6 +123,190 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErr: true,
 		},
+		{
+			name: "valid vote delegations",
+			genesisState: func() *types.GenesisState {
+				state := types.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.VoteDelegations = delegationChain(types.MaxVoteDelegationDepth)
+
+				return state
+			},
+		},
+		{
+			name: "vote delegation chain longer than max depth",
+			genesisState: func() *types.GenesisState {
+				state := types.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.VoteDelegations = delegationChain(types.MaxVoteDelegationDepth + 1)
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "vote delegation cycle",
+			genesisState: func() *types.GenesisState {
+				delegations := delegationChain(3)
+				delegations[2].Delegate = delegations[0].Delegator
+
+				state := types.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.VoteDelegations = delegations
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "valid move hooks",
+			genesisState: func() *types.GenesisState {
+				state := types.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.MoveHooks = []*types.MoveHook{&hook}
+
+				return state
+			},
+		},
+		{
+			name: "invalid move hook",
+			genesisState: func() *types.GenesisState {
+				hook1 := hook
+				hook1.FunctionName = ""
+
+				state := types.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.MoveHooks = []*types.MoveHook{&hook1}
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "duplicate move hooks",
+			genesisState: func() *types.GenesisState {
+				hook1 := hook
+				hook1.FunctionName = "on_event2"
+
+				state := types.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.MoveHooks = []*types.MoveHook{&hook, &hook1}
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "valid deposit outcomes",
+			genesisState: func() *types.GenesisState {
+				state := types.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.DepositOutcomes = []*types.DepositOutcome{&outcome}
+
+				return state
+			},
+		},
+		{
+			name: "unspecified deposit outcome",
+			genesisState: func() *types.GenesisState {
+				outcome1 := outcome
+				outcome1.Outcome = types.DepositOutcomeUnspecified
+
+				state := types.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.DepositOutcomes = []*types.DepositOutcome{&outcome1}
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "unknown deposit outcome",
+			genesisState: func() *types.GenesisState {
+				outcome1 := outcome
+				outcome1.Outcome = types.DepositOutcomeType(100)
+
+				state := types.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.DepositOutcomes = []*types.DepositOutcome{&outcome1}
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid deposit outcome depositor",
+			genesisState: func() *types.GenesisState {
+				outcome1 := outcome
+				outcome1.Depositor = "invalid"
+
+				state := types.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.DepositOutcomes = []*types.DepositOutcome{&outcome1}
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "duplicate deposit outcomes",
+			genesisState: func() *types.GenesisState {
+				outcome1 := outcome
+				outcome1.Outcome = types.DepositOutcomeBurned
+
+				state := types.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.DepositOutcomes = []*types.DepositOutcome{&outcome, &outcome1}
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "valid voter breakdowns",
+			genesisState: func() *types.GenesisState {
+				state := types.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.VoterBreakdowns = []*types.VoterBreakdown{&breakdown}
+				state.VoterBreakdownProposals = []uint64{1}
+
+				return state
+			},
+		},
+		{
+			name: "invalid voter breakdown voter",
+			genesisState: func() *types.GenesisState {
+				breakdown1 := breakdown
+				breakdown1.Voter = "invalid"
+
+				state := types.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.VoterBreakdowns = []*types.VoterBreakdown{&breakdown1}
+				state.VoterBreakdownProposals = []uint64{1}
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "voter breakdown of unknown proposal",
+			genesisState: func() *types.GenesisState {
+				state := types.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.VoterBreakdowns = []*types.VoterBreakdown{&breakdown}
+				state.VoterBreakdownProposals = []uint64{2}
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "duplicate voter breakdowns",
+			genesisState: func() *types.GenesisState {
+				state := types.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.VoterBreakdowns = []*types.VoterBreakdown{&breakdown, &breakdown}
+				state.VoterBreakdownProposals = []uint64{1}
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "duplicate voter breakdown proposals",
+			genesisState: func() *types.GenesisState {
+				state := types.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.VoterBreakdownProposals = []uint64{1, 1}
+
+				return state
+			},
+			expErr: true,
+		},
 	}
 
 	ac := address.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
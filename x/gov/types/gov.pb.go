@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -33,6 +34,43 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DepositOutcomeType enumerates the final outcomes of a deposit.
+type DepositOutcomeType int32
+
+const (
+	// DEPOSIT_OUTCOME_TYPE_UNSPECIFIED defines an invalid outcome.
+	DepositOutcomeUnspecified DepositOutcomeType = 0
+	// DEPOSIT_OUTCOME_TYPE_REFUNDED defines the deposit returned to the depositor.
+	DepositOutcomeRefunded DepositOutcomeType = 1
+	// DEPOSIT_OUTCOME_TYPE_BURNED defines the deposit burned.
+	DepositOutcomeBurned DepositOutcomeType = 2
+	// DEPOSIT_OUTCOME_TYPE_CHARGED defines the deposit charged with the proposal
+	// cancel ratio on the proposal cancel.
+	DepositOutcomeCharged DepositOutcomeType = 3
+)
+
+var DepositOutcomeType_name = map[int32]string{
+	0: "DEPOSIT_OUTCOME_TYPE_UNSPECIFIED",
+	1: "DEPOSIT_OUTCOME_TYPE_REFUNDED",
+	2: "DEPOSIT_OUTCOME_TYPE_BURNED",
+	3: "DEPOSIT_OUTCOME_TYPE_CHARGED",
+}
+
+var DepositOutcomeType_value = map[string]int32{
+	"DEPOSIT_OUTCOME_TYPE_UNSPECIFIED": 0,
+	"DEPOSIT_OUTCOME_TYPE_REFUNDED":    1,
+	"DEPOSIT_OUTCOME_TYPE_BURNED":      2,
+	"DEPOSIT_OUTCOME_TYPE_CHARGED":     3,
+}
+
+func (x DepositOutcomeType) String() string {
+	return proto.EnumName(DepositOutcomeType_name, int32(x))
+}
+
+func (DepositOutcomeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6adfe7a550f5e4ec, []int{0}
+}
+
 // Params defines the parameters for the x/gov module.
 type Params struct {
 	// Minimum deposit for a proposal to enter voting period.
@@ -93,6 +131,47 @@ type Params struct {
 	LowThresholdFunctions []string `protobuf:"bytes,93,rep,name=low_threshold_functions,json=lowThresholdFunctions,proto3" json:"low_threshold_functions,omitempty"`
 	// Vesting is the vesting contract info for tally.
 	Vesting *Vesting `protobuf:"bytes,94,opt,name=vesting,proto3" json:"vesting,omitempty"`
+	// archive_voter_breakdown enables archiving the vote options and voting
+	// power of each voter when a proposal is finalized.
+	ArchiveVoterBreakdown bool `protobuf:"varint,95,opt,name=archive_voter_breakdown,json=archiveVoterBreakdown,proto3" json:"archive_voter_breakdown,omitempty"`
+	// voter_breakdown_retention is the number of the most recent proposals whose
+	// voter breakdowns are retained. Zero means the breakdowns are never pruned.
+	// The breakdowns are pruned on each tally even if archiving is disabled.
+	VoterBreakdownRetention uint64 `protobuf:"varint,96,opt,name=voter_breakdown_retention,json=voterBreakdownRetention,proto3" json:"voter_breakdown_retention,omitempty"`
+	// voting_power_providers are the Move view functions providing additional
+	// voting power of the voters, e.g. locked LP tokens or liquid staking receipts.
+	VotingPowerProviders []VotingPowerProvider `protobuf:"bytes,97,rep,name=voting_power_providers,json=votingPowerProviders,proto3" json:"voting_power_providers"`
+	// emergency_submitters are the accounts allowed to activate the emergency
+	// proposal by depositing the emergency minimum deposit, e.g. a security
+	// council multisig. Anyone can activate it if empty.
+	EmergencySubmitters []string `protobuf:"bytes,98,rep,name=emergency_submitters,json=emergencySubmitters,proto3" json:"emergency_submitters,omitempty"`
+	// emergency_escalation_threshold is the minimum ratio of the bonded validator
+	// voting power voted yes to escalate a proposal to the emergency proposal.
+	EmergencyEscalationThreshold string `protobuf:"bytes,99,opt,name=emergency_escalation_threshold,json=emergencyEscalationThreshold,proto3" json:"emergency_escalation_threshold,omitempty"`
+	// emergency_escalation_window is the duration from the voting start time
+	// during which a proposal can be escalated to the emergency proposal by the
+	// validator votes. Zero disables the escalation.
+	EmergencyEscalationWindow time.Duration `protobuf:"bytes,100,opt,name=emergency_escalation_window,json=emergencyEscalationWindow,proto3,stdduration" json:"emergency_escalation_window"`
+	// execution_delays are the delays of the execution of the passed proposals
+	// per message type url. A passed proposal is queued for the longest delay of
+	// its messages and executed immediately if none of them has a delay. The
+	// emergency proposals are executed immediately regardless of the delays.
+	ExecutionDelays []ExecutionDelay `protobuf:"bytes,101,rep,name=execution_delays,json=executionDelays,proto3" json:"execution_delays"`
+	// execution_cancellers are the accounts allowed to cancel the queued
+	// execution of a passed proposal in addition to the gov module account,
+	// e.g. a security council multisig.
+	ExecutionCancellers []string `protobuf:"bytes,102,rep,name=execution_cancellers,json=executionCancellers,proto3" json:"execution_cancellers,omitempty"`
+	// msg_type_params are the governance params overridden per message type url.
+	// When a proposal contains the messages of different types, the strictest
+	// params among them are applied.
+	MsgTypeParams []MsgTypeParams `protobuf:"bytes,103,rep,name=msg_type_params,json=msgTypeParams,proto3" json:"msg_type_params"`
+	// optimistic_msg_types are the message type urls eligible for the optimistic
+	// proposals. The optimistic proposals are disabled if empty.
+	OptimisticMsgTypes []string `protobuf:"bytes,104,rep,name=optimistic_msg_types,json=optimisticMsgTypes,proto3" json:"optimistic_msg_types,omitempty"`
+	// optimistic_rejection_threshold is the minimum proportion of the no and
+	// no with veto votes to the total voting power to reject an optimistic
+	// proposal.
+	OptimisticRejectionThreshold string `protobuf:"bytes,105,opt,name=optimistic_rejection_threshold,json=optimisticRejectionThreshold,proto3" json:"optimistic_rejection_threshold,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -267,91 +346,115 @@ func (m *Params) GetVesting() *Vesting {
 	return nil
 }
 
-// Vesting defines the vesting contract info for MsgVestingVote.
-// At tally time, gov module would check vesting token amount.
-type Vesting struct {
-	// module_addr is the address of the vesting module.
-	ModuleAddr string `protobuf:"bytes,1,opt,name=module_addr,json=moduleAddr,proto3" json:"module_addr,omitempty"`
-	// module_name is the name of the vesting module.
-	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
-	// creator_addr is the address of the creator of the vesting contract.
-	CreatorAddr string `protobuf:"bytes,3,opt,name=creator_addr,json=creatorAddr,proto3" json:"creator_addr,omitempty"`
+func (m *Params) GetArchiveVoterBreakdown() bool {
+	if m != nil {
+		return m.ArchiveVoterBreakdown
+	}
+	return false
 }
 
-func (m *Vesting) Reset()      { *m = Vesting{} }
-func (*Vesting) ProtoMessage() {}
-func (*Vesting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6adfe7a550f5e4ec, []int{1}
+func (m *Params) GetVoterBreakdownRetention() uint64 {
+	if m != nil {
+		return m.VoterBreakdownRetention
+	}
+	return 0
 }
-func (m *Vesting) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *Params) GetVotingPowerProviders() []VotingPowerProvider {
+	if m != nil {
+		return m.VotingPowerProviders
+	}
+	return nil
 }
-func (m *Vesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Vesting.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Params) GetEmergencySubmitters() []string {
+	if m != nil {
+		return m.EmergencySubmitters
 	}
+	return nil
 }
-func (m *Vesting) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Vesting.Merge(m, src)
+
+func (m *Params) GetEmergencyEscalationThreshold() string {
+	if m != nil {
+		return m.EmergencyEscalationThreshold
+	}
+	return ""
 }
-func (m *Vesting) XXX_Size() int {
-	return m.Size()
+
+func (m *Params) GetEmergencyEscalationWindow() time.Duration {
+	if m != nil {
+		return m.EmergencyEscalationWindow
+	}
+	return 0
 }
-func (m *Vesting) XXX_DiscardUnknown() {
-	xxx_messageInfo_Vesting.DiscardUnknown(m)
+
+func (m *Params) GetExecutionDelays() []ExecutionDelay {
+	if m != nil {
+		return m.ExecutionDelays
+	}
+	return nil
 }
 
-var xxx_messageInfo_Vesting proto.InternalMessageInfo
+func (m *Params) GetExecutionCancellers() []string {
+	if m != nil {
+		return m.ExecutionCancellers
+	}
+	return nil
+}
 
-func (m *Vesting) GetModuleAddr() string {
+func (m *Params) GetMsgTypeParams() []MsgTypeParams {
 	if m != nil {
-		return m.ModuleAddr
+		return m.MsgTypeParams
 	}
-	return ""
+	return nil
 }
 
-func (m *Vesting) GetModuleName() string {
+func (m *Params) GetOptimisticMsgTypes() []string {
 	if m != nil {
-		return m.ModuleName
+		return m.OptimisticMsgTypes
 	}
-	return ""
+	return nil
 }
 
-func (m *Vesting) GetCreatorAddr() string {
+func (m *Params) GetOptimisticRejectionThreshold() string {
 	if m != nil {
-		return m.CreatorAddr
+		return m.OptimisticRejectionThreshold
 	}
 	return ""
 }
 
-type TallyResult struct {
-	TallyHeight       uint64 `protobuf:"varint,1,opt,name=tally_height,json=tallyHeight,proto3" json:"tally_height,omitempty"`
-	TotalStakingPower string `protobuf:"bytes,2,opt,name=total_staking_power,json=totalStakingPower,proto3" json:"total_staking_power,omitempty"`
-	TotalVestingPower string `protobuf:"bytes,3,opt,name=total_vesting_power,json=totalVestingPower,proto3" json:"total_vesting_power,omitempty"`
-	// v1_tally_result is the original TallyResult from cosmos-sdk,
-	// which contains both staking and vesting power.
-	V1TallyResult *v1.TallyResult `protobuf:"bytes,4,opt,name=v1_tally_result,json=v1TallyResult,proto3" json:"v1_tally_result,omitempty"`
+// MsgTypeParams defines the governance params overriding the global params for
+// the proposals containing the messages of the given type. The empty fields are
+// not overridden.
+type MsgTypeParams struct {
+	// msg_type_url is the type url of the message, or "*" to apply to the
+	// messages without their own params.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// quorum is the minimum percentage of the total voting power to be voted.
+	Quorum string `protobuf:"bytes,2,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// threshold is the minimum proportion of the yes votes to pass.
+	Threshold string `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// veto_threshold is the minimum proportion of the veto votes to reject.
+	VetoThreshold string `protobuf:"bytes,4,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// min_deposit is the minimum deposit to enter the voting period.
+	MinDeposit []types.Coin `protobuf:"bytes,5,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit"`
+	// voting_period is the duration of the voting period. It must be strictly
+	// greater than the expedited voting period.
+	VotingPeriod *time.Duration `protobuf:"bytes,6,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
 }
 
-func (m *TallyResult) Reset()         { *m = TallyResult{} }
-func (m *TallyResult) String() string { return proto.CompactTextString(m) }
-func (*TallyResult) ProtoMessage()    {}
-func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6adfe7a550f5e4ec, []int{2}
+func (m *MsgTypeParams) Reset()         { *m = MsgTypeParams{} }
+func (m *MsgTypeParams) String() string { return proto.CompactTextString(m) }
+func (*MsgTypeParams) ProtoMessage()    {}
+func (*MsgTypeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adfe7a550f5e4ec, []int{1}
 }
-func (m *TallyResult) XXX_Unmarshal(b []byte) error {
+func (m *MsgTypeParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TallyResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgTypeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TallyResult.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgTypeParams.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -361,109 +464,81 @@ func (m *TallyResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *TallyResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TallyResult.Merge(m, src)
+func (m *MsgTypeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTypeParams.Merge(m, src)
 }
-func (m *TallyResult) XXX_Size() int {
+func (m *MsgTypeParams) XXX_Size() int {
 	return m.Size()
 }
-func (m *TallyResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_TallyResult.DiscardUnknown(m)
+func (m *MsgTypeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTypeParams.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TallyResult proto.InternalMessageInfo
+var xxx_messageInfo_MsgTypeParams proto.InternalMessageInfo
 
-func (m *TallyResult) GetTallyHeight() uint64 {
+func (m *MsgTypeParams) GetMsgTypeUrl() string {
 	if m != nil {
-		return m.TallyHeight
+		return m.MsgTypeUrl
 	}
-	return 0
+	return ""
 }
 
-func (m *TallyResult) GetTotalStakingPower() string {
+func (m *MsgTypeParams) GetQuorum() string {
 	if m != nil {
-		return m.TotalStakingPower
+		return m.Quorum
 	}
 	return ""
 }
 
-func (m *TallyResult) GetTotalVestingPower() string {
+func (m *MsgTypeParams) GetThreshold() string {
 	if m != nil {
-		return m.TotalVestingPower
+		return m.Threshold
 	}
 	return ""
 }
 
-func (m *TallyResult) GetV1TallyResult() *v1.TallyResult {
+func (m *MsgTypeParams) GetVetoThreshold() string {
 	if m != nil {
-		return m.V1TallyResult
+		return m.VetoThreshold
+	}
+	return ""
+}
+
+func (m *MsgTypeParams) GetMinDeposit() []types.Coin {
+	if m != nil {
+		return m.MinDeposit
 	}
 	return nil
 }
 
-// Proposal defines the core field members of a governance proposal.
-type Proposal struct {
-	// id defines the unique id of the proposal.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// messages are the arbitrary messages to be executed if the proposal passes.
-	Messages []*types1.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
-	// status defines the proposal status.
-	Status v1.ProposalStatus `protobuf:"varint,3,opt,name=status,proto3,enum=cosmos.gov.v1.ProposalStatus" json:"status,omitempty"`
-	// final_tally_result is the final tally result of the proposal. When
-	// querying a proposal via gRPC, this field is not populated until the
-	// proposal's voting period has ended.
-	FinalTallyResult TallyResult `protobuf:"bytes,4,opt,name=final_tally_result,json=finalTallyResult,proto3" json:"final_tally_result"`
-	// submit_time is the time of proposal submission.
-	SubmitTime *time.Time `protobuf:"bytes,5,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time,omitempty"`
-	// deposit_end_time is the end time for deposition.
-	DepositEndTime *time.Time `protobuf:"bytes,6,opt,name=deposit_end_time,json=depositEndTime,proto3,stdtime" json:"deposit_end_time,omitempty"`
-	// total_deposit is the total deposit on the proposal.
-	TotalDeposit []types.Coin `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3" json:"total_deposit"`
-	// voting_start_time is the starting time to vote on a proposal.
-	VotingStartTime *time.Time `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time,omitempty"`
-	// voting_end_time is the end time of voting on a proposal.
-	VotingEndTime          *time.Time `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time,omitempty"`
-	EmergencyStartTime     *time.Time `protobuf:"bytes,10,opt,name=emergency_start_time,json=emergencyStartTime,proto3,stdtime" json:"emergency_start_time,omitempty"`
-	EmergencyNextTallyTime *time.Time `protobuf:"bytes,11,opt,name=emergency_next_tally_time,json=emergencyNextTallyTime,proto3,stdtime" json:"emergency_next_tally_time,omitempty"`
-	// metadata is any arbitrary metadata attached to the proposal.
-	// the recommended format of the metadata is to be found here:
-	// https://docs.cosmos.network/v0.47/modules/gov#proposal-3
-	Metadata string `protobuf:"bytes,12,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// title is the title of the proposal
-	//
-	// Since: cosmos-sdk 0.47
-	Title string `protobuf:"bytes,13,opt,name=title,proto3" json:"title,omitempty"`
-	// summary is a short summary of the proposal
-	//
-	// Since: cosmos-sdk 0.47
-	Summary string `protobuf:"bytes,14,opt,name=summary,proto3" json:"summary,omitempty"`
-	// proposer is the address of the proposal sumbitter
-	//
-	// Since: cosmos-sdk 0.47
-	Proposer string `protobuf:"bytes,15,opt,name=proposer,proto3" json:"proposer,omitempty"`
-	// expedited defines if the proposal is expedited
-	//
-	// Since: cosmos-sdk 0.50
-	Expedited bool `protobuf:"varint,16,opt,name=expedited,proto3" json:"expedited,omitempty"`
-	Emergency bool `protobuf:"varint,17,opt,name=emergency,proto3" json:"emergency,omitempty"`
-	// failed_reason defines the reason why the proposal failed
-	//
-	// Since: cosmos-sdk 0.50
-	FailedReason string `protobuf:"bytes,18,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"`
+func (m *MsgTypeParams) GetVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.VotingPeriod
+	}
+	return nil
 }
 
-func (m *Proposal) Reset()         { *m = Proposal{} }
-func (m *Proposal) String() string { return proto.CompactTextString(m) }
-func (*Proposal) ProtoMessage()    {}
-func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6adfe7a550f5e4ec, []int{3}
+// ExecutionDelay defines the execution delay of the passed proposals containing
+// the messages of the given type.
+type ExecutionDelay struct {
+	// msg_type_url is the type url of the message.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// delay is the duration to wait before executing the proposal.
+	Delay time.Duration `protobuf:"bytes,2,opt,name=delay,proto3,stdduration" json:"delay"`
 }
-func (m *Proposal) XXX_Unmarshal(b []byte) error {
+
+func (m *ExecutionDelay) Reset()         { *m = ExecutionDelay{} }
+func (m *ExecutionDelay) String() string { return proto.CompactTextString(m) }
+func (*ExecutionDelay) ProtoMessage()    {}
+func (*ExecutionDelay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adfe7a550f5e4ec, []int{2}
+}
+func (m *ExecutionDelay) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExecutionDelay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Proposal.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExecutionDelay.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -473,1099 +548,5069 @@ func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Proposal) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Proposal.Merge(m, src)
+func (m *ExecutionDelay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionDelay.Merge(m, src)
 }
-func (m *Proposal) XXX_Size() int {
+func (m *ExecutionDelay) XXX_Size() int {
 	return m.Size()
 }
-func (m *Proposal) XXX_DiscardUnknown() {
-	xxx_messageInfo_Proposal.DiscardUnknown(m)
+func (m *ExecutionDelay) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionDelay.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Proposal proto.InternalMessageInfo
+var xxx_messageInfo_ExecutionDelay proto.InternalMessageInfo
 
-func (m *Proposal) GetId() uint64 {
+func (m *ExecutionDelay) GetMsgTypeUrl() string {
 	if m != nil {
-		return m.Id
+		return m.MsgTypeUrl
 	}
-	return 0
+	return ""
 }
 
-func (m *Proposal) GetMessages() []*types1.Any {
+func (m *ExecutionDelay) GetDelay() time.Duration {
 	if m != nil {
-		return m.Messages
+		return m.Delay
 	}
-	return nil
+	return 0
 }
 
-func (m *Proposal) GetStatus() v1.ProposalStatus {
-	if m != nil {
-		return m.Status
-	}
-	return v1.ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
+// VotingPowerProvider defines a Move view function providing the voting power
+// of a voter in tally. The function must have the signature
+// `#[view] public fun <function_name>(voter: address): u64`.
+//
+// The provider must exclude the tokens delegated to the validators, e.g. LP
+// tokens locked in a Move locker which delegates them, as the delegated tokens
+// are already counted as the staking voting power of the validator votes.
+// The provider voting power is counted only for the voters and excluded from
+// the quorum, and at most 10,000 provider calls are made per tally.
+type VotingPowerProvider struct {
+	// name is the unique name of the provider.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// module_addr is the address of the provider module.
+	ModuleAddr string `protobuf:"bytes,2,opt,name=module_addr,json=moduleAddr,proto3" json:"module_addr,omitempty"`
+	// module_name is the name of the provider module.
+	ModuleName string `protobuf:"bytes,3,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// function_name is the name of the view function.
+	FunctionName string `protobuf:"bytes,4,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	// weight is multiplied to the returned amount to get the voting power.
+	Weight string `protobuf:"bytes,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	// gas_limit is the gas budget of a view function call per voter.
+	GasLimit uint64 `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
-func (m *Proposal) GetFinalTallyResult() TallyResult {
-	if m != nil {
-		return m.FinalTallyResult
+func (m *VotingPowerProvider) Reset()         { *m = VotingPowerProvider{} }
+func (m *VotingPowerProvider) String() string { return proto.CompactTextString(m) }
+func (*VotingPowerProvider) ProtoMessage()    {}
+func (*VotingPowerProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adfe7a550f5e4ec, []int{3}
+}
+func (m *VotingPowerProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotingPowerProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotingPowerProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return TallyResult{}
+}
+func (m *VotingPowerProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingPowerProvider.Merge(m, src)
+}
+func (m *VotingPowerProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotingPowerProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingPowerProvider.DiscardUnknown(m)
 }
 
-func (m *Proposal) GetSubmitTime() *time.Time {
+var xxx_messageInfo_VotingPowerProvider proto.InternalMessageInfo
+
+func (m *VotingPowerProvider) GetName() string {
 	if m != nil {
-		return m.SubmitTime
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *Proposal) GetDepositEndTime() *time.Time {
+func (m *VotingPowerProvider) GetModuleAddr() string {
 	if m != nil {
-		return m.DepositEndTime
+		return m.ModuleAddr
 	}
-	return nil
+	return ""
 }
 
-func (m *Proposal) GetTotalDeposit() []types.Coin {
+func (m *VotingPowerProvider) GetModuleName() string {
 	if m != nil {
-		return m.TotalDeposit
+		return m.ModuleName
 	}
-	return nil
+	return ""
 }
 
-func (m *Proposal) GetVotingStartTime() *time.Time {
+func (m *VotingPowerProvider) GetFunctionName() string {
 	if m != nil {
-		return m.VotingStartTime
+		return m.FunctionName
 	}
-	return nil
+	return ""
 }
 
-func (m *Proposal) GetVotingEndTime() *time.Time {
+func (m *VotingPowerProvider) GetWeight() string {
 	if m != nil {
-		return m.VotingEndTime
+		return m.Weight
 	}
-	return nil
+	return ""
 }
 
-func (m *Proposal) GetEmergencyStartTime() *time.Time {
+func (m *VotingPowerProvider) GetGasLimit() uint64 {
 	if m != nil {
-		return m.EmergencyStartTime
+		return m.GasLimit
 	}
-	return nil
+	return 0
 }
 
-func (m *Proposal) GetEmergencyNextTallyTime() *time.Time {
-	if m != nil {
-		return m.EmergencyNextTallyTime
+// MoveHook defines a Move entry function called on the proposal lifecycle
+// events. The function must have the signature
+// `public entry fun <function_name>(module: &signer, proposal_id: u64, event: String, account: address)`,
+// where the signer is the hook module address and the account is the proposer,
+// depositor or voter related to the event.
+type MoveHook struct {
+	// module_addr is the address of the hook module.
+	ModuleAddr string `protobuf:"bytes,1,opt,name=module_addr,json=moduleAddr,proto3" json:"module_addr,omitempty"`
+	// module_name is the name of the hook module.
+	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// function_name is the name of the entry function.
+	FunctionName string `protobuf:"bytes,3,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	// gas_limit is the gas budget of an entry function call per event.
+	GasLimit uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *MoveHook) Reset()         { *m = MoveHook{} }
+func (m *MoveHook) String() string { return proto.CompactTextString(m) }
+func (*MoveHook) ProtoMessage()    {}
+func (*MoveHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adfe7a550f5e4ec, []int{4}
+}
+func (m *MoveHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MoveHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MoveHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *MoveHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveHook.Merge(m, src)
+}
+func (m *MoveHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *MoveHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveHook.DiscardUnknown(m)
 }
 
-func (m *Proposal) GetMetadata() string {
+var xxx_messageInfo_MoveHook proto.InternalMessageInfo
+
+func (m *MoveHook) GetModuleAddr() string {
 	if m != nil {
-		return m.Metadata
+		return m.ModuleAddr
 	}
 	return ""
 }
 
-func (m *Proposal) GetTitle() string {
+func (m *MoveHook) GetModuleName() string {
 	if m != nil {
-		return m.Title
+		return m.ModuleName
 	}
 	return ""
 }
 
-func (m *Proposal) GetSummary() string {
+func (m *MoveHook) GetFunctionName() string {
 	if m != nil {
-		return m.Summary
+		return m.FunctionName
 	}
 	return ""
 }
 
-func (m *Proposal) GetProposer() string {
+func (m *MoveHook) GetGasLimit() uint64 {
 	if m != nil {
-		return m.Proposer
+		return m.GasLimit
 	}
-	return ""
+	return 0
 }
 
-func (m *Proposal) GetExpedited() bool {
+// Vesting defines the vesting contract info for MsgVestingVote.
+// At tally time, gov module would check vesting token amount.
+type Vesting struct {
+	// module_addr is the address of the vesting module.
+	ModuleAddr string `protobuf:"bytes,1,opt,name=module_addr,json=moduleAddr,proto3" json:"module_addr,omitempty"`
+	// module_name is the name of the vesting module.
+	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// creator_addr is the address of the creator of the vesting contract.
+	CreatorAddr string `protobuf:"bytes,3,opt,name=creator_addr,json=creatorAddr,proto3" json:"creator_addr,omitempty"`
+}
+
+func (m *Vesting) Reset()      { *m = Vesting{} }
+func (*Vesting) ProtoMessage() {}
+func (*Vesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adfe7a550f5e4ec, []int{5}
+}
+func (m *Vesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vesting.Merge(m, src)
+}
+func (m *Vesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vesting proto.InternalMessageInfo
+
+func (m *Vesting) GetModuleAddr() string {
 	if m != nil {
-		return m.Expedited
+		return m.ModuleAddr
 	}
-	return false
+	return ""
 }
 
-func (m *Proposal) GetEmergency() bool {
+func (m *Vesting) GetModuleName() string {
 	if m != nil {
-		return m.Emergency
+		return m.ModuleName
 	}
-	return false
+	return ""
 }
 
-func (m *Proposal) GetFailedReason() string {
+func (m *Vesting) GetCreatorAddr() string {
 	if m != nil {
-		return m.FailedReason
+		return m.CreatorAddr
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "initia.gov.v1.Params")
-	proto.RegisterType((*Vesting)(nil), "initia.gov.v1.Vesting")
-	proto.RegisterType((*TallyResult)(nil), "initia.gov.v1.TallyResult")
-	proto.RegisterType((*Proposal)(nil), "initia.gov.v1.Proposal")
+type TallyResult struct {
+	TallyHeight       uint64 `protobuf:"varint,1,opt,name=tally_height,json=tallyHeight,proto3" json:"tally_height,omitempty"`
+	TotalStakingPower string `protobuf:"bytes,2,opt,name=total_staking_power,json=totalStakingPower,proto3" json:"total_staking_power,omitempty"`
+	TotalVestingPower string `protobuf:"bytes,3,opt,name=total_vesting_power,json=totalVestingPower,proto3" json:"total_vesting_power,omitempty"`
+	// v1_tally_result is the original TallyResult from cosmos-sdk,
+	// which contains both staking and vesting power.
+	V1TallyResult *v1.TallyResult `protobuf:"bytes,4,opt,name=v1_tally_result,json=v1TallyResult,proto3" json:"v1_tally_result,omitempty"`
+	// provider_powers are the voting powers counted from the voting power
+	// providers.
+	ProviderPowers []ProviderVotingPower `protobuf:"bytes,5,rep,name=provider_powers,json=providerPowers,proto3" json:"provider_powers"`
+	// provider_omitted_voters is the number of the voters whose voting powers
+	// from the providers were not counted, as the provider calls reached the max
+	// per tally. The provider powers are truncated if it is not zero.
+	ProviderOmittedVoters uint64 `protobuf:"varint,6,opt,name=provider_omitted_voters,json=providerOmittedVoters,proto3" json:"provider_omitted_voters,omitempty"`
 }
 
-func init() { proto.RegisterFile("initia/gov/v1/gov.proto", fileDescriptor_6adfe7a550f5e4ec) }
+func (m *TallyResult) Reset()         { *m = TallyResult{} }
+func (m *TallyResult) String() string { return proto.CompactTextString(m) }
+func (*TallyResult) ProtoMessage()    {}
+func (*TallyResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adfe7a550f5e4ec, []int{6}
+}
+func (m *TallyResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallyResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallyResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TallyResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallyResult.Merge(m, src)
+}
+func (m *TallyResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallyResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallyResult.DiscardUnknown(m)
+}
 
-var fileDescriptor_6adfe7a550f5e4ec = []byte{
-	// 1257 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x26, 0x6d, 0x62, 0x3f, 0xff, 0x49, 0x32, 0x4d, 0x9b, 0x4d, 0x44, 0xed, 0x10, 0x10,
-	0x0a, 0x15, 0xb5, 0x49, 0xa1, 0x1c, 0x8a, 0x04, 0x8a, 0x9b, 0xa2, 0xa6, 0xa2, 0x55, 0xd8, 0x54,
-	0x11, 0xa2, 0xc0, 0x32, 0xf6, 0x4e, 0x9c, 0x11, 0xbb, 0x3b, 0x66, 0x67, 0xd6, 0x75, 0xbe, 0x02,
-	0xa7, 0x1e, 0x7b, 0xec, 0x91, 0x63, 0x0f, 0xfd, 0x10, 0x3d, 0x56, 0x3d, 0x71, 0x6a, 0x51, 0x73,
-	0x28, 0x1f, 0x00, 0x71, 0x46, 0xf3, 0x6f, 0xd7, 0x36, 0x56, 0x15, 0x5f, 0x12, 0xcf, 0x7b, 0xbf,
-	0xdf, 0x6f, 0xde, 0x7b, 0xf3, 0x66, 0xde, 0xc2, 0x2a, 0x8d, 0xa9, 0xa0, 0xb8, 0xd9, 0x65, 0xfd,
-	0x66, 0x7f, 0x5b, 0xfe, 0x6b, 0xf4, 0x12, 0x26, 0x18, 0xaa, 0x68, 0x47, 0x43, 0x5a, 0xfa, 0xdb,
-	0xeb, 0xcb, 0x38, 0xa2, 0x31, 0x6b, 0xaa, 0xbf, 0x1a, 0xb1, 0x5e, 0xeb, 0x30, 0x1e, 0x31, 0xde,
-	0x6c, 0x63, 0x4e, 0x9a, 0xfd, 0xed, 0x36, 0x11, 0x78, 0xbb, 0xd9, 0x61, 0x34, 0x36, 0xfe, 0x55,
-	0xe3, 0x1f, 0x97, 0x5e, 0x5f, 0xd3, 0x0e, 0x5f, 0xad, 0x9a, 0x7a, 0x61, 0x5c, 0x2b, 0x5d, 0xd6,
-	0x65, 0xda, 0x2e, 0x7f, 0x59, 0x42, 0x97, 0xb1, 0x6e, 0x48, 0x9a, 0x6a, 0xd5, 0x4e, 0x8f, 0x9a,
-	0x38, 0x3e, 0xb1, 0x41, 0x8c, 0xbb, 0x82, 0x34, 0xc1, 0x82, 0x32, 0x1b, 0x44, 0x7d, 0xdc, 0x2f,
-	0x68, 0x44, 0xb8, 0xc0, 0x51, 0x4f, 0x03, 0x36, 0xff, 0x05, 0x98, 0xdf, 0xc7, 0x09, 0x8e, 0x38,
-	0xba, 0x05, 0xa5, 0x88, 0xc6, 0x7e, 0x40, 0x7a, 0x8c, 0x53, 0xe1, 0x3a, 0x1b, 0x73, 0x5b, 0xa5,
-	0x6b, 0x6b, 0x0d, 0x13, 0xa0, 0x4c, 0xb3, 0x61, 0xd2, 0x6c, 0xdc, 0x64, 0x34, 0x6e, 0x15, 0x9f,
-	0xbf, 0xaa, 0xcf, 0xfc, 0xf1, 0xf6, 0xe9, 0x15, 0xc7, 0x83, 0x88, 0xc6, 0xbb, 0x9a, 0x87, 0x0e,
-	0x01, 0x45, 0x78, 0x60, 0x65, 0xfc, 0x1e, 0x49, 0x28, 0x0b, 0xdc, 0xd9, 0x0d, 0x47, 0xa9, 0xe9,
-	0x78, 0x1a, 0x36, 0x9e, 0xc6, 0xae, 0x89, 0xb7, 0x55, 0x91, 0x6a, 0x8f, 0x5f, 0xd7, 0x1d, 0xad,
-	0xb8, 0x14, 0xe1, 0x81, 0x51, 0xdc, 0x57, 0x0a, 0xe8, 0x2e, 0x54, 0xfa, 0x4c, 0xd0, 0xb8, 0x6b,
-	0x25, 0xe7, 0xa6, 0x94, 0x2c, 0x6b, 0xba, 0x91, 0xfb, 0x08, 0xe6, 0x7f, 0x4b, 0x59, 0x92, 0x46,
-	0xee, 0xb9, 0x0d, 0x67, 0xab, 0xd8, 0xaa, 0xbe, 0x7c, 0x76, 0x15, 0x4c, 0xae, 0xbb, 0xa4, 0xe3,
-	0x19, 0x2f, 0xfa, 0x04, 0x8a, 0xe2, 0x38, 0x21, 0xfc, 0x98, 0x85, 0x81, 0x7b, 0x7e, 0x22, 0x34,
-	0x07, 0xa0, 0xeb, 0x50, 0xed, 0x13, 0xc1, 0xfc, 0x9c, 0x32, 0x3f, 0x91, 0x52, 0x91, 0xa8, 0xfb,
-	0x19, 0x6d, 0x0f, 0xd6, 0x64, 0xe9, 0x75, 0xcf, 0x85, 0x59, 0xed, 0x54, 0x1e, 0xee, 0xc2, 0x44,
-	0x85, 0x4b, 0x11, 0x8d, 0xf7, 0x34, 0xde, 0xd4, 0xc9, 0x93, 0x68, 0xd4, 0x82, 0x8b, 0xbd, 0x84,
-	0xf5, 0x18, 0xc7, 0xa1, 0xdf, 0xc1, 0x71, 0x87, 0x84, 0x46, 0xa6, 0x30, 0x51, 0xe6, 0x82, 0x05,
-	0xdf, 0x54, 0x58, 0xad, 0x71, 0x07, 0x56, 0xc6, 0x35, 0x02, 0xc2, 0x85, 0x5b, 0x54, 0x12, 0xee,
-	0xcb, 0x67, 0x57, 0x57, 0x8c, 0xc4, 0x4e, 0x10, 0x24, 0x84, 0xf3, 0x03, 0x91, 0xd0, 0xb8, 0xeb,
-	0xa1, 0x51, 0xb1, 0x5d, 0xc2, 0x05, 0xfa, 0x05, 0x56, 0xc9, 0xa0, 0x47, 0x02, 0x2a, 0x48, 0xe0,
-	0x8f, 0x1e, 0x20, 0x4c, 0x79, 0x80, 0x17, 0x33, 0xa1, 0xc3, 0xe1, 0x93, 0xfc, 0x1a, 0x2e, 0xe4,
-	0x3b, 0xe4, 0x85, 0x2f, 0x4d, 0xcc, 0x17, 0x65, 0xd0, 0xbc, 0xfa, 0xdf, 0x43, 0xae, 0xec, 0x0f,
-	0x5f, 0x81, 0xf2, 0x14, 0x57, 0x20, 0x8f, 0xe1, 0x6e, 0x7e, 0x17, 0xb6, 0x60, 0xa9, 0x9d, 0x26,
-	0xb1, 0xcc, 0x9b, 0xf8, 0xa6, 0xdd, 0x2a, 0x1b, 0xce, 0x56, 0xc1, 0xab, 0x4a, 0xfb, 0x21, 0x13,
-	0xe4, 0x3b, 0xdd, 0x66, 0x3b, 0x70, 0x59, 0x21, 0xb3, 0xba, 0x67, 0xf7, 0x27, 0x21, 0x92, 0xed,
-	0x56, 0x15, 0x6d, 0x5d, 0x82, 0xf6, 0x0d, 0xc6, 0xde, 0x0f, 0x8d, 0x40, 0x1f, 0x42, 0x35, 0xdf,
-	0x4c, 0xf6, 0x97, 0xbb, 0xa8, 0x38, 0x65, 0xbb, 0xd5, 0x21, 0x11, 0x0c, 0xdd, 0x80, 0xe5, 0xa1,
-	0x14, 0x4d, 0x6f, 0x2c, 0x4d, 0xac, 0xd5, 0x62, 0x7e, 0xab, 0x75, 0x5f, 0xc8, 0x42, 0x45, 0x24,
-	0xe9, 0x92, 0xb8, 0x73, 0x32, 0x52, 0xa8, 0x07, 0x53, 0x15, 0xca, 0x4a, 0x0c, 0x15, 0xaa, 0x0d,
-	0x6e, 0xae, 0x2c, 0x70, 0x18, 0x9e, 0xf8, 0x34, 0x16, 0x24, 0xe9, 0xe3, 0xd0, 0xfd, 0x71, 0xca,
-	0x36, 0xb9, 0x94, 0x29, 0xdd, 0x97, 0x42, 0x7b, 0x46, 0x07, 0x7d, 0x01, 0xab, 0x21, 0x7b, 0x98,
-	0x77, 0x88, 0x7f, 0x94, 0xc6, 0x1d, 0x29, 0xc0, 0xdd, 0x9f, 0x36, 0xe6, 0xb6, 0x8a, 0xde, 0xc5,
-	0x90, 0x3d, 0xcc, 0xba, 0xe2, 0x1b, 0xeb, 0x44, 0x5f, 0xc2, 0x42, 0x9f, 0x70, 0xd9, 0x70, 0xee,
-	0xcf, 0x2a, 0x94, 0x4b, 0x8d, 0x91, 0xe1, 0xd0, 0x38, 0xd4, 0x5e, 0x95, 0xa4, 0x8a, 0x61, 0xc6,
-	0xb3, 0x8c, 0x1b, 0xab, 0x8f, 0x9f, 0xd4, 0x67, 0xfe, 0x7e, 0x52, 0x77, 0x7e, 0x7f, 0xfb, 0xf4,
-	0x0a, 0xc8, 0x59, 0xa0, 0x5f, 0xdb, 0xcd, 0x01, 0x2c, 0x18, 0x1e, 0xaa, 0x43, 0x29, 0x62, 0x41,
-	0x1a, 0x12, 0x1f, 0x07, 0x41, 0xe2, 0x3a, 0xf2, 0x30, 0x3c, 0xd0, 0x26, 0x79, 0xc1, 0x86, 0x00,
-	0x31, 0x8e, 0x88, 0x7a, 0x4b, 0x33, 0xc0, 0x3d, 0x1c, 0x11, 0xf4, 0x3e, 0x94, 0x3b, 0x09, 0xc1,
-	0x82, 0x25, 0x5a, 0x62, 0x4e, 0x21, 0x4a, 0xc6, 0x26, 0x35, 0x6e, 0x14, 0x6c, 0x20, 0x9b, 0xff,
-	0x38, 0x50, 0x52, 0x95, 0xf1, 0x08, 0x4f, 0x43, 0x21, 0xc9, 0xba, 0xe2, 0xc7, 0x84, 0x76, 0x8f,
-	0x85, 0xda, 0xff, 0x9c, 0x57, 0x52, 0xb6, 0xdb, 0xca, 0x84, 0xbe, 0x82, 0x0b, 0x82, 0x09, 0x1c,
-	0xfa, 0x5c, 0xe0, 0x5f, 0xd5, 0x0d, 0x66, 0x0f, 0x49, 0xa2, 0x03, 0x19, 0x69, 0x9b, 0xbd, 0x58,
-	0x78, 0xcb, 0x0a, 0x7a, 0xa0, 0x91, 0xfb, 0x12, 0x98, 0xf3, 0x4d, 0x59, 0x0c, 0x7f, 0xee, 0x1d,
-	0x7c, 0x53, 0x1c, 0xcd, 0x6f, 0xc1, 0x62, 0x7f, 0xdb, 0xf4, 0x45, 0xa2, 0xa2, 0x56, 0xaf, 0x76,
-	0xe9, 0xda, 0xba, 0x6d, 0x39, 0x73, 0x14, 0x43, 0x79, 0x79, 0x95, 0xfe, 0xf6, 0xd0, 0x72, 0xf3,
-	0xd5, 0x02, 0x14, 0xec, 0xcd, 0x41, 0x55, 0x98, 0xa5, 0x81, 0xc9, 0x74, 0x96, 0x06, 0xe8, 0x53,
-	0x28, 0x44, 0x84, 0x73, 0xdc, 0x25, 0xdc, 0x9d, 0x55, 0xcd, 0xbc, 0xf2, 0xbf, 0x7e, 0xdb, 0x89,
-	0x4f, 0xbc, 0x0c, 0x85, 0xae, 0xc3, 0x3c, 0x17, 0x58, 0xa4, 0x5c, 0x65, 0x51, 0xbd, 0x76, 0x79,
-	0x2c, 0x12, 0xbb, 0xd5, 0x81, 0x02, 0x79, 0x06, 0x8c, 0x0e, 0x00, 0x1d, 0xd1, 0x18, 0x87, 0x93,
-	0x93, 0x19, 0xed, 0xab, 0xa1, 0xe8, 0x87, 0x2f, 0xd0, 0x92, 0x12, 0x18, 0x3e, 0xc1, 0x1d, 0x28,
-	0xf1, 0xb4, 0x1d, 0x51, 0xe1, 0xcb, 0xf1, 0xae, 0xa6, 0x94, 0x54, 0x1b, 0x4f, 0xe0, 0xbe, 0x9d,
-	0xfd, 0xad, 0x73, 0x8f, 0x5e, 0xd7, 0x1d, 0x0f, 0x34, 0x49, 0x9a, 0xd1, 0x1d, 0x58, 0xb2, 0x4f,
-	0x02, 0x89, 0x03, 0xad, 0x33, 0x7f, 0x46, 0x9d, 0xaa, 0x61, 0xde, 0x8a, 0x03, 0xa5, 0xb5, 0x07,
-	0x15, 0x7d, 0xda, 0xf6, 0x79, 0x58, 0x98, 0xe2, 0x79, 0x28, 0x2b, 0xaa, 0x7d, 0x17, 0xbe, 0x85,
-	0x65, 0x33, 0x33, 0xb8, 0xc0, 0x89, 0xc9, 0xaf, 0x70, 0xc6, 0xb8, 0x16, 0x35, 0xf5, 0x40, 0x32,
-	0x55, 0x60, 0xb7, 0xc1, 0x98, 0xf2, 0x1c, 0x8b, 0x67, 0xd4, 0x32, 0xdf, 0x1e, 0x36, 0x45, 0x0f,
-	0x56, 0xf2, 0xf7, 0x6a, 0x28, 0x34, 0x38, 0xa3, 0x1c, 0xca, 0xd8, 0x79, 0x74, 0x0f, 0x60, 0x2d,
-	0xd7, 0x8c, 0xc9, 0x40, 0x98, 0x1e, 0x51, 0xc2, 0xa5, 0x33, 0x0a, 0xe7, 0x8f, 0xdf, 0x3d, 0x32,
-	0x10, 0xaa, 0x49, 0x94, 0xf8, 0xba, 0x6c, 0x70, 0x81, 0x03, 0x2c, 0xb0, 0x5b, 0x56, 0xaf, 0x43,
-	0xb6, 0x46, 0x2b, 0x70, 0x5e, 0x50, 0x11, 0x12, 0x35, 0x9a, 0x8a, 0x9e, 0x5e, 0x20, 0x17, 0x16,
-	0x78, 0x1a, 0x45, 0x38, 0x39, 0x51, 0xb3, 0xa7, 0xe8, 0xd9, 0x25, 0xfa, 0x1c, 0x0a, 0x7a, 0x4c,
-	0x91, 0x44, 0x8d, 0x98, 0x77, 0x7d, 0x12, 0x64, 0x48, 0xf4, 0x1e, 0x14, 0xb3, 0x11, 0xa9, 0x06,
-	0x4e, 0xc1, 0xcb, 0x0d, 0xca, 0x6b, 0x23, 0x77, 0x97, 0x8d, 0xd7, 0x1a, 0xd0, 0x07, 0x50, 0x39,
-	0xc2, 0x34, 0x24, 0x81, 0x9f, 0x10, 0xcc, 0x59, 0xec, 0x22, 0x15, 0x51, 0x59, 0x1b, 0x3d, 0x65,
-	0x6b, 0xdd, 0x7c, 0xfe, 0xa6, 0xe6, 0xbc, 0x78, 0x53, 0x73, 0xfe, 0x7a, 0x53, 0x73, 0x1e, 0x9d,
-	0xd6, 0x66, 0x5e, 0x9c, 0xd6, 0x66, 0xfe, 0x3c, 0xad, 0xcd, 0xfc, 0xf0, 0x71, 0x97, 0x8a, 0xe3,
-	0xb4, 0xdd, 0xe8, 0xb0, 0xa8, 0xa9, 0xaf, 0xd8, 0xd5, 0x10, 0xb7, 0xb9, 0xf9, 0xdd, 0x1c, 0xa8,
-	0x6f, 0x74, 0x71, 0xd2, 0x23, 0xbc, 0x3d, 0xaf, 0x2a, 0xfb, 0xd9, 0x7f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x58, 0xb1, 0xcc, 0x82, 0x19, 0x0c, 0x00, 0x00,
+var xxx_messageInfo_TallyResult proto.InternalMessageInfo
+
+func (m *TallyResult) GetTallyHeight() uint64 {
+	if m != nil {
+		return m.TallyHeight
+	}
+	return 0
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *TallyResult) GetTotalStakingPower() string {
+	if m != nil {
+		return m.TotalStakingPower
 	}
+	return ""
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *TallyResult) GetTotalVestingPower() string {
+	if m != nil {
+		return m.TotalVestingPower
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *TallyResult) GetV1TallyResult() *v1.TallyResult {
+	if m != nil {
+		return m.V1TallyResult
 	}
-	if len(this.MinDeposit) != len(that1.MinDeposit) {
-		return false
+	return nil
+}
+
+func (m *TallyResult) GetProviderPowers() []ProviderVotingPower {
+	if m != nil {
+		return m.ProviderPowers
 	}
-	for i := range this.MinDeposit {
-		if !this.MinDeposit[i].Equal(&that1.MinDeposit[i]) {
-			return false
+	return nil
+}
+
+func (m *TallyResult) GetProviderOmittedVoters() uint64 {
+	if m != nil {
+		return m.ProviderOmittedVoters
+	}
+	return 0
+}
+
+// ProviderVotingPower defines the voting power counted from a voting power
+// provider.
+type ProviderVotingPower struct {
+	// name is the name of the provider.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// voting_power is the weighted voting power counted from the provider.
+	VotingPower string `protobuf:"bytes,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+}
+
+func (m *ProviderVotingPower) Reset()         { *m = ProviderVotingPower{} }
+func (m *ProviderVotingPower) String() string { return proto.CompactTextString(m) }
+func (*ProviderVotingPower) ProtoMessage()    {}
+func (*ProviderVotingPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adfe7a550f5e4ec, []int{7}
+}
+func (m *ProviderVotingPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderVotingPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderVotingPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.MaxDepositPeriod != that1.MaxDepositPeriod {
-		return false
+}
+func (m *ProviderVotingPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderVotingPower.Merge(m, src)
+}
+func (m *ProviderVotingPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderVotingPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderVotingPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderVotingPower proto.InternalMessageInfo
+
+func (m *ProviderVotingPower) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if this.VotingPeriod != that1.VotingPeriod {
-		return false
+	return ""
+}
+
+func (m *ProviderVotingPower) GetVotingPower() string {
+	if m != nil {
+		return m.VotingPower
 	}
-	if this.Quorum != that1.Quorum {
-		return false
+	return ""
+}
+
+// VoterBreakdown defines the archived vote options and voting power of a voter
+// on a finalized proposal.
+type VoterBreakdown struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter is the address of the voter.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// options are the vote options counted for the voter.
+	Options []*v1.WeightedVoteOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	// delegate is the address of the account whose vote was counted by the
+	// vote delegation. It is empty if the voter voted by itself.
+	Delegate string `protobuf:"bytes,4,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// staking_power is the voting power of the delegations of the voter.
+	StakingPower string `protobuf:"bytes,5,opt,name=staking_power,json=stakingPower,proto3" json:"staking_power,omitempty"`
+	// vesting_power is the voting power of the unclaimed vesting of the voter.
+	VestingPower string `protobuf:"bytes,6,opt,name=vesting_power,json=vestingPower,proto3" json:"vesting_power,omitempty"`
+	// inherited_power is the voting power inherited by the validator of the voter
+	// from the delegators who did not vote.
+	InheritedPower string `protobuf:"bytes,7,opt,name=inherited_power,json=inheritedPower,proto3" json:"inherited_power,omitempty"`
+	// provider_power is the weighted voting power of the voter counted from the
+	// voting power providers.
+	ProviderPower string `protobuf:"bytes,8,opt,name=provider_power,json=providerPower,proto3" json:"provider_power,omitempty"`
+}
+
+func (m *VoterBreakdown) Reset()         { *m = VoterBreakdown{} }
+func (m *VoterBreakdown) String() string { return proto.CompactTextString(m) }
+func (*VoterBreakdown) ProtoMessage()    {}
+func (*VoterBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adfe7a550f5e4ec, []int{8}
+}
+func (m *VoterBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoterBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoterBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.Threshold != that1.Threshold {
-		return false
+}
+func (m *VoterBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoterBreakdown.Merge(m, src)
+}
+func (m *VoterBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoterBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoterBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoterBreakdown proto.InternalMessageInfo
+
+func (m *VoterBreakdown) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	if this.VetoThreshold != that1.VetoThreshold {
-		return false
+	return 0
+}
+
+func (m *VoterBreakdown) GetVoter() string {
+	if m != nil {
+		return m.Voter
 	}
-	if this.MinInitialDepositRatio != that1.MinInitialDepositRatio {
-		return false
+	return ""
+}
+
+func (m *VoterBreakdown) GetOptions() []*v1.WeightedVoteOption {
+	if m != nil {
+		return m.Options
 	}
-	if this.ProposalCancelRatio != that1.ProposalCancelRatio {
-		return false
+	return nil
+}
+
+func (m *VoterBreakdown) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
 	}
-	if this.ProposalCancelDest != that1.ProposalCancelDest {
-		return false
+	return ""
+}
+
+func (m *VoterBreakdown) GetStakingPower() string {
+	if m != nil {
+		return m.StakingPower
 	}
-	if this.ExpeditedVotingPeriod != that1.ExpeditedVotingPeriod {
-		return false
+	return ""
+}
+
+func (m *VoterBreakdown) GetVestingPower() string {
+	if m != nil {
+		return m.VestingPower
 	}
-	if this.ExpeditedThreshold != that1.ExpeditedThreshold {
-		return false
+	return ""
+}
+
+func (m *VoterBreakdown) GetInheritedPower() string {
+	if m != nil {
+		return m.InheritedPower
 	}
-	if len(this.ExpeditedMinDeposit) != len(that1.ExpeditedMinDeposit) {
-		return false
+	return ""
+}
+
+func (m *VoterBreakdown) GetProviderPower() string {
+	if m != nil {
+		return m.ProviderPower
 	}
-	for i := range this.ExpeditedMinDeposit {
-		if !this.ExpeditedMinDeposit[i].Equal(&that1.ExpeditedMinDeposit[i]) {
-			return false
+	return ""
+}
+
+// Proposal defines the core field members of a governance proposal.
+type Proposal struct {
+	// id defines the unique id of the proposal.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// messages are the arbitrary messages to be executed if the proposal passes.
+	Messages []*types1.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	// status defines the proposal status.
+	Status v1.ProposalStatus `protobuf:"varint,3,opt,name=status,proto3,enum=cosmos.gov.v1.ProposalStatus" json:"status,omitempty"`
+	// final_tally_result is the final tally result of the proposal. When
+	// querying a proposal via gRPC, this field is not populated until the
+	// proposal's voting period has ended.
+	FinalTallyResult TallyResult `protobuf:"bytes,4,opt,name=final_tally_result,json=finalTallyResult,proto3" json:"final_tally_result"`
+	// submit_time is the time of proposal submission.
+	SubmitTime *time.Time `protobuf:"bytes,5,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time,omitempty"`
+	// deposit_end_time is the end time for deposition.
+	DepositEndTime *time.Time `protobuf:"bytes,6,opt,name=deposit_end_time,json=depositEndTime,proto3,stdtime" json:"deposit_end_time,omitempty"`
+	// total_deposit is the total deposit on the proposal.
+	TotalDeposit []types.Coin `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3" json:"total_deposit"`
+	// voting_start_time is the starting time to vote on a proposal.
+	VotingStartTime *time.Time `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time,omitempty"`
+	// voting_end_time is the end time of voting on a proposal.
+	VotingEndTime          *time.Time `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time,omitempty"`
+	EmergencyStartTime     *time.Time `protobuf:"bytes,10,opt,name=emergency_start_time,json=emergencyStartTime,proto3,stdtime" json:"emergency_start_time,omitempty"`
+	EmergencyNextTallyTime *time.Time `protobuf:"bytes,11,opt,name=emergency_next_tally_time,json=emergencyNextTallyTime,proto3,stdtime" json:"emergency_next_tally_time,omitempty"`
+	// metadata is any arbitrary metadata attached to the proposal.
+	// the recommended format of the metadata is to be found here:
+	// https://docs.cosmos.network/v0.47/modules/gov#proposal-3
+	Metadata string `protobuf:"bytes,12,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// title is the title of the proposal
+	//
+	// Since: cosmos-sdk 0.47
+	Title string `protobuf:"bytes,13,opt,name=title,proto3" json:"title,omitempty"`
+	// summary is a short summary of the proposal
+	//
+	// Since: cosmos-sdk 0.47
+	Summary string `protobuf:"bytes,14,opt,name=summary,proto3" json:"summary,omitempty"`
+	// proposer is the address of the proposal sumbitter
+	//
+	// Since: cosmos-sdk 0.47
+	Proposer string `protobuf:"bytes,15,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// expedited defines if the proposal is expedited
+	//
+	// Since: cosmos-sdk 0.50
+	Expedited bool `protobuf:"varint,16,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	Emergency bool `protobuf:"varint,17,opt,name=emergency,proto3" json:"emergency,omitempty"`
+	// failed_reason defines the reason why the proposal failed
+	//
+	// Since: cosmos-sdk 0.50
+	FailedReason string `protobuf:"bytes,18,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"`
+	// execution_time is the time when the passed proposal is executed. It is set
+	// only while the execution of the proposal is queued, during which the status
+	// is PROPOSAL_STATUS_PASSED although the messages are not executed yet. Once
+	// executed, the status remains PROPOSAL_STATUS_PASSED or turns into
+	// PROPOSAL_STATUS_FAILED if the execution fails or is cancelled.
+	ExecutionTime *time.Time `protobuf:"bytes,19,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time,omitempty"`
+	// optimistic is true if the proposal passes at the end of the voting period
+	// unless it is rejected by the no and no with veto votes.
+	Optimistic bool `protobuf:"varint,20,opt,name=optimistic,proto3" json:"optimistic,omitempty"`
+}
+
+func (m *Proposal) Reset()         { *m = Proposal{} }
+func (m *Proposal) String() string { return proto.CompactTextString(m) }
+func (*Proposal) ProtoMessage()    {}
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adfe7a550f5e4ec, []int{9}
+}
+func (m *Proposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Proposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.BurnVoteQuorum != that1.BurnVoteQuorum {
-		return false
-	}
-	if this.BurnProposalDepositPrevote != that1.BurnProposalDepositPrevote {
-		return false
-	}
-	if this.BurnVoteVeto != that1.BurnVoteVeto {
-		return false
-	}
-	if this.MinDepositRatio != that1.MinDepositRatio {
-		return false
-	}
-	if len(this.EmergencyMinDeposit) != len(that1.EmergencyMinDeposit) {
-		return false
+}
+func (m *Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Proposal.Merge(m, src)
+}
+func (m *Proposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Proposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Proposal proto.InternalMessageInfo
+
+func (m *Proposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	for i := range this.EmergencyMinDeposit {
-		if !this.EmergencyMinDeposit[i].Equal(&that1.EmergencyMinDeposit[i]) {
-			return false
-		}
+	return 0
+}
+
+func (m *Proposal) GetMessages() []*types1.Any {
+	if m != nil {
+		return m.Messages
 	}
-	if this.EmergencyTallyInterval != that1.EmergencyTallyInterval {
-		return false
+	return nil
+}
+
+func (m *Proposal) GetStatus() v1.ProposalStatus {
+	if m != nil {
+		return m.Status
 	}
-	if len(this.LowThresholdFunctions) != len(that1.LowThresholdFunctions) {
-		return false
+	return v1.ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
+}
+
+func (m *Proposal) GetFinalTallyResult() TallyResult {
+	if m != nil {
+		return m.FinalTallyResult
 	}
-	for i := range this.LowThresholdFunctions {
-		if this.LowThresholdFunctions[i] != that1.LowThresholdFunctions[i] {
-			return false
-		}
+	return TallyResult{}
+}
+
+func (m *Proposal) GetSubmitTime() *time.Time {
+	if m != nil {
+		return m.SubmitTime
 	}
-	if !this.Vesting.Equal(that1.Vesting) {
-		return false
+	return nil
+}
+
+func (m *Proposal) GetDepositEndTime() *time.Time {
+	if m != nil {
+		return m.DepositEndTime
 	}
-	return true
+	return nil
 }
-func (this *Vesting) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Proposal) GetTotalDeposit() []types.Coin {
+	if m != nil {
+		return m.TotalDeposit
 	}
+	return nil
+}
 
-	that1, ok := that.(*Vesting)
-	if !ok {
-		that2, ok := that.(Vesting)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *Proposal) GetVotingStartTime() *time.Time {
+	if m != nil {
+		return m.VotingStartTime
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *Proposal) GetVotingEndTime() *time.Time {
+	if m != nil {
+		return m.VotingEndTime
 	}
-	if this.ModuleAddr != that1.ModuleAddr {
-		return false
+	return nil
+}
+
+func (m *Proposal) GetEmergencyStartTime() *time.Time {
+	if m != nil {
+		return m.EmergencyStartTime
 	}
-	if this.ModuleName != that1.ModuleName {
-		return false
+	return nil
+}
+
+func (m *Proposal) GetEmergencyNextTallyTime() *time.Time {
+	if m != nil {
+		return m.EmergencyNextTallyTime
 	}
-	if this.CreatorAddr != that1.CreatorAddr {
-		return false
+	return nil
+}
+
+func (m *Proposal) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
 	}
-	return true
+	return ""
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *Proposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Proposal) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Vesting != nil {
-		{
-			size, err := m.Vesting.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGov(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5
-		i--
-		dAtA[i] = 0xf2
-	}
-	if len(m.LowThresholdFunctions) > 0 {
-		for iNdEx := len(m.LowThresholdFunctions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.LowThresholdFunctions[iNdEx])
-			copy(dAtA[i:], m.LowThresholdFunctions[iNdEx])
-			i = encodeVarintGov(dAtA, i, uint64(len(m.LowThresholdFunctions[iNdEx])))
-			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0xea
-		}
-	}
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.EmergencyTallyInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EmergencyTallyInterval):])
-	if err2 != nil {
-		return 0, err2
+func (m *Proposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
 	}
-	i -= n2
-	i = encodeVarintGov(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x5
-	i--
-	dAtA[i] = 0xe2
-	if len(m.EmergencyMinDeposit) > 0 {
-		for iNdEx := len(m.EmergencyMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EmergencyMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0xda
-		}
+	return ""
+}
+
+func (m *Proposal) GetExpedited() bool {
+	if m != nil {
+		return m.Expedited
 	}
-	if len(m.MinDepositRatio) > 0 {
-		i -= len(m.MinDepositRatio)
-		copy(dAtA[i:], m.MinDepositRatio)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.MinDepositRatio)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+	return false
+}
+
+func (m *Proposal) GetEmergency() bool {
+	if m != nil {
+		return m.Emergency
 	}
-	if m.BurnVoteVeto {
-		i--
-		if m.BurnVoteVeto {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x78
+	return false
+}
+
+func (m *Proposal) GetFailedReason() string {
+	if m != nil {
+		return m.FailedReason
 	}
-	if m.BurnProposalDepositPrevote {
-		i--
-		if m.BurnProposalDepositPrevote {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x70
+	return ""
+}
+
+func (m *Proposal) GetExecutionTime() *time.Time {
+	if m != nil {
+		return m.ExecutionTime
 	}
-	if m.BurnVoteQuorum {
-		i--
-		if m.BurnVoteQuorum {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
+	return nil
+}
+
+func (m *Proposal) GetOptimistic() bool {
+	if m != nil {
+		return m.Optimistic
 	}
-	if len(m.ExpeditedMinDeposit) > 0 {
-		for iNdEx := len(m.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ExpeditedMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
+	return false
+}
+
+// DepositOutcome defines the final outcome of a deposit on a proposal after the
+// deposit is deleted.
+type DepositOutcome struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// depositor is the address of the depositor.
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// outcome is the outcome of the deposit.
+	Outcome DepositOutcomeType `protobuf:"varint,3,opt,name=outcome,proto3,enum=initia.gov.v1.DepositOutcomeType" json:"outcome,omitempty"`
+	// amount is the deposited amount.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// refunded is the amount returned to the depositor.
+	Refunded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=refunded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded"`
+	// burned is the amount burned.
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	// charged is the amount charged with the proposal cancel ratio and sent to
+	// the destination. The charged amount is counted as burned if the proposal
+	// cancel destination is empty.
+	Charged github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=charged,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"charged"`
+	// destination is the address receiving the charged amount.
+	Destination string `protobuf:"bytes,8,opt,name=destination,proto3" json:"destination,omitempty"`
+	// height is the block height at which the deposit is deleted.
+	Height int64 `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *DepositOutcome) Reset()         { *m = DepositOutcome{} }
+func (m *DepositOutcome) String() string { return proto.CompactTextString(m) }
+func (*DepositOutcome) ProtoMessage()    {}
+func (*DepositOutcome) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adfe7a550f5e4ec, []int{10}
+}
+func (m *DepositOutcome) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositOutcome) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositOutcome.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ExpeditedThreshold) > 0 {
-		i -= len(m.ExpeditedThreshold)
-		copy(dAtA[i:], m.ExpeditedThreshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ExpeditedThreshold)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpeditedVotingPeriod):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintGov(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x52
-	if len(m.ProposalCancelDest) > 0 {
-		i -= len(m.ProposalCancelDest)
-		copy(dAtA[i:], m.ProposalCancelDest)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelDest)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.ProposalCancelRatio) > 0 {
-		i -= len(m.ProposalCancelRatio)
-		copy(dAtA[i:], m.ProposalCancelRatio)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelRatio)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.MinInitialDepositRatio) > 0 {
-		i -= len(m.MinInitialDepositRatio)
-		copy(dAtA[i:], m.MinInitialDepositRatio)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.MinInitialDepositRatio)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.VetoThreshold) > 0 {
-		i -= len(m.VetoThreshold)
-		copy(dAtA[i:], m.VetoThreshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Threshold) > 0 {
-		i -= len(m.Threshold)
-		copy(dAtA[i:], m.Threshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Quorum) > 0 {
-		i -= len(m.Quorum)
-		copy(dAtA[i:], m.Quorum)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *DepositOutcome) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositOutcome.Merge(m, src)
+}
+func (m *DepositOutcome) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositOutcome) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositOutcome.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositOutcome proto.InternalMessageInfo
+
+func (m *DepositOutcome) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
-	if err4 != nil {
-		return 0, err4
+	return 0
+}
+
+func (m *DepositOutcome) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
 	}
-	i -= n4
-	i = encodeVarintGov(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x1a
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxDepositPeriod):])
-	if err5 != nil {
-		return 0, err5
+	return ""
+}
+
+func (m *DepositOutcome) GetOutcome() DepositOutcomeType {
+	if m != nil {
+		return m.Outcome
 	}
-	i -= n5
-	i = encodeVarintGov(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x12
-	if len(m.MinDeposit) > 0 {
-		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return DepositOutcomeUnspecified
+}
+
+func (m *DepositOutcome) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Vesting) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DepositOutcome) GetRefunded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Refunded
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Vesting) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DepositOutcome) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
 }
 
-func (m *Vesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CreatorAddr) > 0 {
-		i -= len(m.CreatorAddr)
-		copy(dAtA[i:], m.CreatorAddr)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.CreatorAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ModuleName) > 0 {
-		i -= len(m.ModuleName)
-		copy(dAtA[i:], m.ModuleName)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ModuleName)))
-		i--
-		dAtA[i] = 0x12
+func (m *DepositOutcome) GetCharged() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Charged
 	}
-	if len(m.ModuleAddr) > 0 {
-		i -= len(m.ModuleAddr)
-		copy(dAtA[i:], m.ModuleAddr)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ModuleAddr)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *DepositOutcome) GetDestination() string {
+	if m != nil {
+		return m.Destination
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *TallyResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DepositOutcome) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// VoteDelegation defines a delegation of the voting power (staking and vesting)
+// of an account to another account.
+type VoteDelegation struct {
+	// delegator is the address of the account delegating its voting power.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegate is the address of the account receiving the voting power.
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// msg_type_url scopes the delegation to the proposals whose messages are all
+	// of the given type. The delegation applies to all proposals if it is empty.
+	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
 }
 
-func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.V1TallyResult != nil {
-		{
-			size, err := m.V1TallyResult.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGov(dAtA, i, uint64(size))
+func (m *VoteDelegation) Reset()         { *m = VoteDelegation{} }
+func (m *VoteDelegation) String() string { return proto.CompactTextString(m) }
+func (*VoteDelegation) ProtoMessage()    {}
+func (*VoteDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adfe7a550f5e4ec, []int{11}
+}
+func (m *VoteDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TotalVestingPower) > 0 {
-		i -= len(m.TotalVestingPower)
-		copy(dAtA[i:], m.TotalVestingPower)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.TotalVestingPower)))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.TotalStakingPower) > 0 {
-		i -= len(m.TotalStakingPower)
-		copy(dAtA[i:], m.TotalStakingPower)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.TotalStakingPower)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *VoteDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteDelegation.Merge(m, src)
+}
+func (m *VoteDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteDelegation proto.InternalMessageInfo
+
+func (m *VoteDelegation) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	if m.TallyHeight != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.TallyHeight))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *VoteDelegation) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Proposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VoteDelegation) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterEnum("initia.gov.v1.DepositOutcomeType", DepositOutcomeType_name, DepositOutcomeType_value)
+	proto.RegisterType((*Params)(nil), "initia.gov.v1.Params")
+	proto.RegisterType((*MsgTypeParams)(nil), "initia.gov.v1.MsgTypeParams")
+	proto.RegisterType((*ExecutionDelay)(nil), "initia.gov.v1.ExecutionDelay")
+	proto.RegisterType((*VotingPowerProvider)(nil), "initia.gov.v1.VotingPowerProvider")
+	proto.RegisterType((*MoveHook)(nil), "initia.gov.v1.MoveHook")
+	proto.RegisterType((*Vesting)(nil), "initia.gov.v1.Vesting")
+	proto.RegisterType((*TallyResult)(nil), "initia.gov.v1.TallyResult")
+	proto.RegisterType((*ProviderVotingPower)(nil), "initia.gov.v1.ProviderVotingPower")
+	proto.RegisterType((*VoterBreakdown)(nil), "initia.gov.v1.VoterBreakdown")
+	proto.RegisterType((*Proposal)(nil), "initia.gov.v1.Proposal")
+	proto.RegisterType((*DepositOutcome)(nil), "initia.gov.v1.DepositOutcome")
+	proto.RegisterType((*VoteDelegation)(nil), "initia.gov.v1.VoteDelegation")
 }
 
-func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FailedReason) > 0 {
-		i -= len(m.FailedReason)
-		copy(dAtA[i:], m.FailedReason)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.FailedReason)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
+func init() { proto.RegisterFile("initia/gov/v1/gov.proto", fileDescriptor_6adfe7a550f5e4ec) }
+
+var fileDescriptor_6adfe7a550f5e4ec = []byte{
+	// 2265 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4b, 0x6f, 0x1b, 0xd7,
+	0xf5, 0x17, 0x45, 0x89, 0x22, 0x0f, 0x1f, 0x92, 0xae, 0x5e, 0x23, 0xda, 0x96, 0x68, 0xfd, 0xff,
+	0x08, 0x54, 0xa3, 0x26, 0x23, 0xbb, 0x4e, 0x51, 0x05, 0x4d, 0x6b, 0x89, 0x74, 0xac, 0x34, 0xb6,
+	0xd4, 0xd1, 0x23, 0x6d, 0x93, 0x76, 0x32, 0xe4, 0x5c, 0x91, 0x13, 0xcf, 0xcc, 0x65, 0xe7, 0x5e,
+	0x52, 0xd2, 0xbe, 0x8b, 0xc2, 0xab, 0x2c, 0x8a, 0x22, 0x1b, 0x03, 0x06, 0xba, 0x09, 0xba, 0x28,
+	0xb2, 0xc8, 0x77, 0x68, 0x16, 0x5d, 0x04, 0xd9, 0xb4, 0x40, 0x81, 0xa6, 0xb0, 0x17, 0xe9, 0xc7,
+	0x28, 0xee, 0x63, 0x5e, 0xf4, 0xd8, 0x92, 0x80, 0x7a, 0x13, 0x6b, 0xee, 0x39, 0xbf, 0xdf, 0x3d,
+	0xf7, 0xbc, 0xee, 0xb9, 0x0c, 0x2c, 0xd9, 0x9e, 0xcd, 0x6c, 0xb3, 0xd1, 0x25, 0xc3, 0xc6, 0x70,
+	0x83, 0xff, 0x53, 0xef, 0xfb, 0x84, 0x11, 0x54, 0x96, 0x82, 0x3a, 0x5f, 0x19, 0x6e, 0x54, 0x67,
+	0x4d, 0xd7, 0xf6, 0x48, 0x43, 0xfc, 0x57, 0x6a, 0x54, 0x57, 0x3a, 0x84, 0xba, 0x84, 0x36, 0xda,
+	0x26, 0xc5, 0x8d, 0xe1, 0x46, 0x1b, 0x33, 0x73, 0xa3, 0xd1, 0x21, 0xb6, 0xa7, 0xe4, 0x4b, 0x4a,
+	0x3e, 0x4a, 0x5d, 0x5d, 0x96, 0x02, 0x43, 0x7c, 0x35, 0xe4, 0x87, 0x12, 0xcd, 0x77, 0x49, 0x97,
+	0xc8, 0x75, 0xfe, 0x57, 0x00, 0xe8, 0x12, 0xd2, 0x75, 0x70, 0x43, 0x7c, 0xb5, 0x07, 0xc7, 0x0d,
+	0xd3, 0x3b, 0x0b, 0x8c, 0x18, 0x15, 0x59, 0x03, 0xdf, 0x64, 0x36, 0x09, 0x8c, 0x58, 0x1d, 0x95,
+	0x33, 0xdb, 0xc5, 0x94, 0x99, 0x6e, 0x5f, 0x2a, 0xac, 0xfd, 0x7d, 0x16, 0x72, 0x7b, 0xa6, 0x6f,
+	0xba, 0x14, 0xb5, 0xa0, 0xe8, 0xda, 0x9e, 0x61, 0xe1, 0x3e, 0xa1, 0x36, 0xd3, 0x32, 0xb5, 0xec,
+	0x7a, 0xf1, 0xd6, 0x72, 0x5d, 0x19, 0xc8, 0x8f, 0x59, 0x57, 0xc7, 0xac, 0x6f, 0x13, 0xdb, 0xdb,
+	0x2a, 0x7c, 0xf5, 0xaf, 0xd5, 0xb1, 0xcf, 0xbf, 0xfb, 0xe2, 0x46, 0x46, 0x07, 0xd7, 0xf6, 0x9a,
+	0x12, 0x87, 0x8e, 0x00, 0xb9, 0xe6, 0x69, 0x40, 0x63, 0xf4, 0xb1, 0x6f, 0x13, 0x4b, 0x1b, 0xaf,
+	0x65, 0x04, 0x9b, 0xb4, 0xa7, 0x1e, 0xd8, 0x53, 0x6f, 0x2a, 0x7b, 0xb7, 0xca, 0x9c, 0xed, 0xb3,
+	0x6f, 0x57, 0x33, 0x92, 0x71, 0xc6, 0x35, 0x4f, 0x15, 0xe3, 0x9e, 0x60, 0x40, 0x0f, 0xa0, 0x3c,
+	0x24, 0xcc, 0xf6, 0xba, 0x01, 0x65, 0xf6, 0x92, 0x94, 0x25, 0x09, 0x57, 0x74, 0x6f, 0x40, 0xee,
+	0xb7, 0x03, 0xe2, 0x0f, 0x5c, 0x6d, 0xa2, 0x96, 0x59, 0x2f, 0x6c, 0x55, 0xbe, 0xf9, 0xf2, 0x26,
+	0xa8, 0xb3, 0x36, 0x71, 0x47, 0x57, 0x52, 0xf4, 0x7d, 0x28, 0xb0, 0x9e, 0x8f, 0x69, 0x8f, 0x38,
+	0x96, 0x36, 0x99, 0xaa, 0x1a, 0x29, 0xa0, 0x3b, 0x50, 0x19, 0x62, 0x46, 0x8c, 0x08, 0x92, 0x4b,
+	0x85, 0x94, 0xb9, 0xd6, 0x41, 0x08, 0xdb, 0x81, 0x65, 0xee, 0x7a, 0x99, 0x73, 0x4e, 0xe8, 0x3b,
+	0x71, 0x0e, 0x6d, 0x2a, 0x95, 0x61, 0xd1, 0xb5, 0xbd, 0x1d, 0xa9, 0xaf, 0xfc, 0xa4, 0x73, 0x6d,
+	0xb4, 0x05, 0x0b, 0x7d, 0x9f, 0xf4, 0x09, 0x35, 0x1d, 0xa3, 0x63, 0x7a, 0x1d, 0xec, 0x28, 0x9a,
+	0x7c, 0x2a, 0xcd, 0x5c, 0xa0, 0xbc, 0x2d, 0x74, 0x25, 0xc7, 0x7b, 0x30, 0x3f, 0xca, 0x61, 0x61,
+	0xca, 0xb4, 0x82, 0xa0, 0xd0, 0xbe, 0xf9, 0xf2, 0xe6, 0xbc, 0xa2, 0xb8, 0x6b, 0x59, 0x3e, 0xa6,
+	0x74, 0x9f, 0xf9, 0xb6, 0xd7, 0xd5, 0x51, 0x92, 0xac, 0x89, 0x29, 0x43, 0x1f, 0xc3, 0x12, 0x3e,
+	0xed, 0x63, 0xcb, 0x66, 0xd8, 0x32, 0x92, 0x01, 0x84, 0x4b, 0x06, 0x70, 0x21, 0x24, 0x3a, 0x8a,
+	0x47, 0xf2, 0x27, 0x30, 0x17, 0xed, 0x10, 0x39, 0xbe, 0x98, 0x7a, 0x5e, 0x14, 0xaa, 0x46, 0xde,
+	0xff, 0x05, 0x44, 0xcc, 0x46, 0xbc, 0x04, 0x4a, 0x97, 0x28, 0x81, 0xc8, 0x86, 0x07, 0x51, 0x2d,
+	0xac, 0xc3, 0x4c, 0x7b, 0xe0, 0x7b, 0xfc, 0xdc, 0xd8, 0x50, 0xe9, 0x56, 0xae, 0x65, 0xd6, 0xf3,
+	0x7a, 0x85, 0xaf, 0x1f, 0x11, 0x86, 0x7f, 0x2e, 0xd3, 0xec, 0x2e, 0x5c, 0x13, 0x9a, 0xa1, 0xdf,
+	0xc3, 0xfa, 0xf1, 0x31, 0x47, 0x6b, 0x15, 0x01, 0xab, 0x72, 0xa5, 0x3d, 0xa5, 0x13, 0xd4, 0x87,
+	0xd4, 0x40, 0xff, 0x0f, 0x95, 0x68, 0x33, 0x9e, 0x5f, 0xda, 0xb4, 0xc0, 0x94, 0x82, 0xad, 0x8e,
+	0x30, 0x23, 0x68, 0x13, 0x66, 0x63, 0x47, 0x54, 0xb9, 0x31, 0x93, 0xea, 0xab, 0xe9, 0xa8, 0xaa,
+	0x65, 0x5e, 0x70, 0x47, 0xb9, 0xd8, 0xef, 0x62, 0xaf, 0x73, 0x96, 0x70, 0xd4, 0x87, 0x97, 0x72,
+	0x54, 0x40, 0x11, 0x73, 0x54, 0x1b, 0xb4, 0x88, 0x99, 0x99, 0x8e, 0x73, 0x66, 0xd8, 0x1e, 0xc3,
+	0xfe, 0xd0, 0x74, 0xb4, 0x8f, 0x2e, 0x99, 0x26, 0x8b, 0x21, 0xd3, 0x01, 0x27, 0xda, 0x51, 0x3c,
+	0xe8, 0x2d, 0x58, 0x72, 0xc8, 0x49, 0x94, 0x21, 0xc6, 0xf1, 0xc0, 0xeb, 0x70, 0x02, 0xaa, 0xfd,
+	0xba, 0x96, 0x5d, 0x2f, 0xe8, 0x0b, 0x0e, 0x39, 0x09, 0xb3, 0xe2, 0x5e, 0x20, 0x44, 0x6f, 0xc3,
+	0xd4, 0x10, 0x53, 0x9e, 0x70, 0xda, 0x6f, 0x84, 0x29, 0x8b, 0xf5, 0xc4, 0xe5, 0x50, 0x3f, 0x92,
+	0x52, 0x71, 0x48, 0x61, 0xc3, 0x98, 0x1e, 0x20, 0xf8, 0xa6, 0xa6, 0xdf, 0xe9, 0xd9, 0x43, 0x2c,
+	0xe2, 0xe2, 0x1b, 0x6d, 0x1f, 0x9b, 0x8f, 0x2c, 0x72, 0xe2, 0x69, 0x86, 0x88, 0xce, 0x82, 0x12,
+	0xf3, 0x00, 0xf9, 0x5b, 0x81, 0x10, 0x6d, 0xc2, 0xf2, 0x88, 0xbe, 0xe1, 0x63, 0x86, 0x3d, 0x6e,
+	0x92, 0xf6, 0x71, 0x2d, 0xb3, 0x3e, 0xa1, 0x2f, 0x0d, 0x13, 0x10, 0x3d, 0x10, 0xa3, 0x0e, 0x2c,
+	0x06, 0x85, 0x46, 0x4e, 0xb0, 0xcf, 0x73, 0x6a, 0x68, 0x5b, 0xd8, 0xa7, 0x9a, 0x29, 0xe2, 0xb4,
+	0x36, 0x6a, 0xbf, 0xac, 0x26, 0xae, 0xbb, 0xa7, 0x54, 0xe3, 0x01, 0x9b, 0x1f, 0xbe, 0x28, 0xa7,
+	0xe8, 0x67, 0x30, 0x1f, 0x45, 0x8c, 0x0e, 0xda, 0xae, 0xcd, 0x18, 0xdf, 0xa2, 0x5d, 0xcb, 0xbe,
+	0xb2, 0x47, 0x44, 0xe1, 0xdf, 0x0f, 0x41, 0xe8, 0x00, 0x56, 0x22, 0x32, 0x4c, 0x3b, 0xa6, 0x23,
+	0x22, 0x1b, 0xab, 0xe6, 0x4e, 0x6a, 0x86, 0x5e, 0x0d, 0x51, 0xad, 0x10, 0x14, 0xd5, 0x75, 0x0f,
+	0xae, 0xa4, 0xb2, 0x9e, 0xd8, 0x9e, 0x45, 0x4e, 0x34, 0xeb, 0x92, 0x79, 0xb5, 0x9c, 0xb2, 0xd9,
+	0x07, 0x82, 0x0a, 0xed, 0xc3, 0x0c, 0x3e, 0xc5, 0x9d, 0x81, 0xa0, 0xb7, 0xb0, 0x63, 0x9e, 0x51,
+	0x0d, 0x0b, 0x5f, 0x5f, 0x1b, 0xf1, 0x75, 0x2b, 0x50, 0x6b, 0x72, 0xad, 0xb8, 0x9b, 0xa7, 0x71,
+	0x42, 0x24, 0x3d, 0x1c, 0x92, 0xca, 0x36, 0xec, 0x70, 0x0f, 0x1f, 0x9f, 0xeb, 0xe1, 0x00, 0xb5,
+	0x1d, 0x82, 0xd0, 0x2e, 0x4c, 0xbb, 0xb4, 0x6b, 0xb0, 0xb3, 0x3e, 0x36, 0xfa, 0xe2, 0xbe, 0xd7,
+	0xba, 0xc2, 0xc0, 0xab, 0x23, 0x06, 0x3e, 0xa0, 0xdd, 0x83, 0xb3, 0x3e, 0x96, 0x33, 0x41, 0xdc,
+	0xbe, 0xb2, 0x1b, 0x97, 0xa0, 0x37, 0x61, 0x9e, 0xf4, 0x99, 0xed, 0xda, 0x94, 0xd9, 0x1d, 0x23,
+	0xe0, 0xa6, 0x5a, 0x4f, 0x94, 0x12, 0x8a, 0x64, 0x8a, 0x50, 0x04, 0x39, 0x86, 0xf0, 0xf1, 0x27,
+	0xb8, 0x33, 0x12, 0x64, 0x3b, 0x3d, 0xc8, 0x11, 0x4a, 0x0f, 0x40, 0x61, 0x90, 0x37, 0x97, 0x3e,
+	0x7b, 0xba, 0x3a, 0xf6, 0x9f, 0xa7, 0xab, 0x99, 0xc7, 0xdf, 0x7d, 0x71, 0x03, 0xf8, 0xb0, 0x25,
+	0x0d, 0x5c, 0xfb, 0xdb, 0x38, 0x94, 0x13, 0x87, 0x41, 0x35, 0x28, 0x85, 0x3e, 0x18, 0xf8, 0x8e,
+	0x96, 0xe1, 0xdb, 0xe9, 0xa0, 0xce, 0x75, 0xe8, 0x3b, 0xb1, 0xa1, 0x60, 0xfc, 0xe2, 0x43, 0x41,
+	0xf6, 0xf2, 0x43, 0xc1, 0xc4, 0x45, 0x86, 0x82, 0x9f, 0x26, 0xe7, 0xb1, 0xc9, 0xf3, 0x7a, 0xec,
+	0x04, 0x8f, 0x55, 0x62, 0x14, 0x6b, 0x8e, 0x8e, 0x4c, 0xb9, 0xf3, 0x52, 0x7e, 0x82, 0xa7, 0x7b,
+	0x72, 0x52, 0xda, 0x9c, 0xe0, 0xde, 0x5d, 0x3b, 0x85, 0x4a, 0x32, 0x77, 0x2f, 0xe0, 0xce, 0x77,
+	0x60, 0x52, 0x14, 0xc3, 0xa5, 0xa7, 0x3f, 0x09, 0x53, 0x3b, 0xff, 0x33, 0x03, 0x73, 0x29, 0x2d,
+	0x0a, 0x21, 0x98, 0xf0, 0x4c, 0x17, 0xab, 0x7d, 0xc5, 0xdf, 0x68, 0x15, 0x8a, 0x2e, 0xb1, 0x06,
+	0x0e, 0x36, 0x4c, 0xcb, 0xf2, 0x65, 0x14, 0x75, 0x90, 0x4b, 0xbc, 0x4a, 0x62, 0x0a, 0x02, 0x9b,
+	0x8d, 0x2b, 0x3c, 0xe4, 0x0c, 0xff, 0x07, 0xe5, 0xe0, 0x5e, 0x90, 0x2a, 0x22, 0x56, 0x7a, 0x29,
+	0x58, 0x14, 0x4a, 0x6f, 0x40, 0xee, 0x04, 0xdb, 0xdd, 0x1e, 0x7b, 0xc9, 0x44, 0xa8, 0xa4, 0xe8,
+	0x0a, 0x14, 0xba, 0x26, 0x35, 0x1c, 0xdb, 0xb5, 0x99, 0x70, 0xfe, 0x84, 0x9e, 0xef, 0x9a, 0xf4,
+	0x7d, 0xfe, 0xad, 0x4e, 0xf7, 0x87, 0x0c, 0xe4, 0x1f, 0x90, 0x21, 0xbe, 0x4f, 0xc8, 0xa3, 0x51,
+	0xf3, 0x33, 0xe7, 0x99, 0x3f, 0x7e, 0xbe, 0xf9, 0xd9, 0x14, 0xf3, 0x13, 0x66, 0x4d, 0xa4, 0x9a,
+	0x75, 0x0a, 0x53, 0xea, 0x5a, 0xfb, 0x1f, 0x18, 0x75, 0x1d, 0x4a, 0x1d, 0x1f, 0x9b, 0x8c, 0xf8,
+	0x92, 0x42, 0xda, 0x54, 0x54, 0x6b, 0x9c, 0x63, 0x33, 0x1f, 0x94, 0xf1, 0xda, 0xef, 0xb2, 0x50,
+	0x14, 0x17, 0xb7, 0x8e, 0xe9, 0xc0, 0x61, 0x1c, 0x2c, 0x07, 0x82, 0x9e, 0xf4, 0x78, 0x46, 0xd8,
+	0x5b, 0x14, 0x6b, 0xf7, 0xa5, 0x9b, 0xdf, 0x81, 0x39, 0x46, 0x98, 0xe9, 0x18, 0x94, 0x99, 0x8f,
+	0xc2, 0x7b, 0x2f, 0xa5, 0x86, 0x77, 0x3c, 0xa6, 0xcf, 0x0a, 0xd5, 0x7d, 0xa9, 0x29, 0x32, 0x2a,
+	0xc2, 0xab, 0x5b, 0x5b, 0xe1, 0xb3, 0xaf, 0xc0, 0x2b, 0xe7, 0x48, 0xfc, 0x16, 0x4c, 0x0f, 0x37,
+	0xd4, 0xd8, 0xe2, 0x0b, 0xab, 0x85, 0x57, 0x8b, 0xb7, 0xaa, 0x41, 0xb5, 0xaa, 0xe6, 0x1a, 0x3b,
+	0x97, 0x5e, 0x1e, 0x6e, 0xc4, 0x8f, 0x79, 0x04, 0xd3, 0xc1, 0x3d, 0x2d, 0xb7, 0xa7, 0xda, 0x64,
+	0xea, 0x6d, 0x1d, 0xe4, 0x7f, 0xac, 0x24, 0xe2, 0x6d, 0xba, 0x12, 0xb0, 0x08, 0x09, 0xe5, 0x03,
+	0x48, 0xc8, 0x4b, 0xc4, 0x7d, 0x6b, 0xc9, 0x49, 0x84, 0xaa, 0x84, 0x5c, 0x08, 0xc4, 0xbb, 0x52,
+	0x2a, 0x06, 0x11, 0xba, 0xf6, 0x11, 0xcc, 0xa5, 0xec, 0x94, 0x5a, 0x74, 0x1b, 0x50, 0x8a, 0xcf,
+	0x1b, 0x2f, 0xf1, 0x7b, 0x31, 0x36, 0x46, 0xac, 0xfd, 0x25, 0x0b, 0x95, 0x91, 0x89, 0x67, 0x15,
+	0x8a, 0xe1, 0xf0, 0x6b, 0x5b, 0x2a, 0xcc, 0x10, 0x2c, 0xed, 0x58, 0xa8, 0x0e, 0x93, 0xc2, 0x70,
+	0xc5, 0xff, 0xf2, 0x0b, 0x50, 0xaa, 0xf1, 0xb9, 0x8d, 0xf4, 0xe5, 0x7c, 0x97, 0x15, 0x9e, 0xbc,
+	0x3e, 0x12, 0x8d, 0x0f, 0x44, 0xf6, 0xc8, 0x13, 0xef, 0x0a, 0x4d, 0x3d, 0x40, 0xa0, 0x1f, 0x40,
+	0xde, 0xc2, 0x0e, 0xee, 0x9a, 0x4c, 0x75, 0x80, 0x57, 0xec, 0x17, 0x6a, 0xa2, 0xdb, 0x50, 0x4e,
+	0xa6, 0x60, 0x7a, 0x7b, 0x28, 0xd1, 0x78, 0xf6, 0xdd, 0x86, 0x72, 0x32, 0xef, 0x72, 0xa9, 0xfe,
+	0x2b, 0x0d, 0xe3, 0x29, 0xf7, 0x43, 0x98, 0xb6, 0xbd, 0x1e, 0xf6, 0xc5, 0x9b, 0x45, 0xc2, 0xd2,
+	0xdf, 0x89, 0x95, 0x50, 0x4d, 0x02, 0xef, 0x40, 0x25, 0x99, 0x67, 0x2f, 0x79, 0x18, 0x96, 0x13,
+	0x79, 0xb4, 0xf6, 0xd7, 0x3c, 0xe4, 0x83, 0x77, 0x07, 0xaa, 0xc0, 0x78, 0x18, 0xa1, 0x71, 0xdb,
+	0x42, 0x6f, 0x42, 0xde, 0xc5, 0x94, 0x9a, 0x5d, 0x4c, 0xb5, 0x71, 0xe1, 0xea, 0xf9, 0x17, 0x5a,
+	0xfd, 0x5d, 0xef, 0x4c, 0x0f, 0xb5, 0xd0, 0x1d, 0xc8, 0x51, 0x66, 0xb2, 0x01, 0x15, 0x45, 0x56,
+	0xb9, 0x75, 0x6d, 0x24, 0x34, 0xc1, 0x56, 0xfb, 0x42, 0x49, 0x57, 0xca, 0x68, 0x1f, 0xd0, 0xb1,
+	0xed, 0x99, 0x4e, 0x7a, 0xad, 0x25, 0xeb, 0x24, 0x56, 0x5c, 0xf1, 0xfa, 0x98, 0x11, 0x04, 0xf1,
+	0xca, 0xbb, 0x0b, 0x45, 0x39, 0xbf, 0x1a, 0xcc, 0x76, 0xb1, 0x36, 0xa9, 0xd8, 0x46, 0x0f, 0x70,
+	0x10, 0xfc, 0x72, 0xb2, 0x35, 0xf1, 0x29, 0xbf, 0x24, 0x41, 0x82, 0xf8, 0x32, 0x7a, 0x0f, 0x66,
+	0x82, 0x07, 0x15, 0xf6, 0x2c, 0xc9, 0x93, 0xbb, 0x20, 0x4f, 0x45, 0x21, 0x5b, 0x9e, 0x25, 0xb8,
+	0x76, 0xa0, 0x2c, 0x9b, 0x91, 0x5a, 0xd7, 0xa6, 0x2e, 0xf1, 0xb8, 0x2a, 0x09, 0x68, 0x70, 0xff,
+	0xbf, 0x0f, 0xb3, 0xaa, 0x30, 0x29, 0x33, 0x7d, 0x75, 0xbe, 0xfc, 0x05, 0xed, 0x9a, 0x96, 0xd0,
+	0x7d, 0x8e, 0x14, 0x86, 0xdd, 0x07, 0xb5, 0x14, 0x9d, 0xb1, 0x70, 0x41, 0x2e, 0x35, 0x86, 0x04,
+	0x47, 0xd4, 0x13, 0x6f, 0x87, 0xc8, 0x34, 0xb8, 0x20, 0x1d, 0x8a, 0xde, 0x10, 0xa1, 0x75, 0x1f,
+	0x42, 0x34, 0x9f, 0x1b, 0x1e, 0x3e, 0x65, 0x2a, 0x47, 0x04, 0x71, 0xf1, 0x82, 0xc4, 0xd1, 0xd3,
+	0xf1, 0x21, 0x3e, 0x65, 0x22, 0x49, 0x04, 0x79, 0x95, 0x27, 0x38, 0x33, 0x2d, 0x93, 0x99, 0x5a,
+	0x49, 0x74, 0xbe, 0xf0, 0x1b, 0xcd, 0xc3, 0x24, 0xb3, 0x99, 0x83, 0xc5, 0xc3, 0xbe, 0xa0, 0xcb,
+	0x0f, 0xa4, 0xc1, 0x14, 0x1d, 0xb8, 0xae, 0xe9, 0x9f, 0x89, 0x97, 0x7b, 0x41, 0x0f, 0x3e, 0x79,
+	0x67, 0x91, 0x4d, 0x0d, 0xfb, 0xda, 0xf4, 0x79, 0x9d, 0x25, 0xd0, 0x44, 0x57, 0xa1, 0x10, 0xfe,
+	0xc0, 0x20, 0x9e, 0xeb, 0x79, 0x3d, 0x5a, 0x10, 0xd2, 0xc0, 0x72, 0x6d, 0x56, 0x49, 0x83, 0x05,
+	0x31, 0x13, 0x98, 0xb6, 0x83, 0x2d, 0xc3, 0xc7, 0x26, 0x25, 0x9e, 0x86, 0xd4, 0x4c, 0x20, 0x16,
+	0x75, 0xb1, 0x86, 0xde, 0x85, 0x4a, 0xf4, 0xda, 0x10, 0x4e, 0x9b, 0xbb, 0x68, 0x70, 0x43, 0x9c,
+	0xf0, 0xd5, 0x0a, 0x40, 0x34, 0xb0, 0x6b, 0xf3, 0xc2, 0x98, 0xd8, 0xca, 0xda, 0xe7, 0x93, 0x50,
+	0x51, 0x09, 0xba, 0x3b, 0x60, 0x1d, 0x22, 0xa7, 0xb6, 0x57, 0xb7, 0xfe, 0xb7, 0xa0, 0xa0, 0xaa,
+	0x81, 0x9c, 0xdf, 0xfe, 0x23, 0x55, 0x71, 0x05, 0xc8, 0x3d, 0x54, 0x9f, 0xb9, 0x3e, 0xd2, 0x24,
+	0x92, 0x86, 0xf0, 0xb9, 0x55, 0x0f, 0x10, 0xa8, 0x07, 0x39, 0xd3, 0x25, 0x03, 0x8f, 0x37, 0x98,
+	0x73, 0x2a, 0xf0, 0x0e, 0xaf, 0xc0, 0x3f, 0x7f, 0xbb, 0xba, 0xde, 0xb5, 0x59, 0x6f, 0xd0, 0xae,
+	0x77, 0x88, 0xab, 0x7e, 0xd8, 0x55, 0xff, 0xdc, 0xa4, 0xd6, 0xa3, 0x86, 0x78, 0x20, 0x09, 0x00,
+	0x95, 0xd5, 0xaa, 0xf8, 0x91, 0x03, 0x79, 0x1f, 0x1f, 0x0f, 0x3c, 0x0b, 0x5b, 0xda, 0xe4, 0x6b,
+	0xda, 0x2b, 0xdc, 0x81, 0x9f, 0x8b, 0xff, 0x22, 0x84, 0xf9, 0x73, 0xe0, 0x35, 0x9d, 0x4b, 0xf2,
+	0xa3, 0x4f, 0x60, 0xaa, 0xd3, 0x33, 0xfd, 0x2e, 0xb6, 0xb4, 0xa9, 0xd7, 0xb4, 0x55, 0xb0, 0x01,
+	0xda, 0x84, 0xa2, 0x25, 0x2e, 0x48, 0xf1, 0xa0, 0xd0, 0xf2, 0xe7, 0x24, 0x49, 0x5c, 0x19, 0x2d,
+	0x42, 0x4e, 0x0d, 0x97, 0xbc, 0xa1, 0x65, 0x75, 0xf5, 0xb5, 0xf6, 0x34, 0x23, 0xa7, 0x94, 0xa6,
+	0xbc, 0xdf, 0xb9, 0xaa, 0xc8, 0x44, 0xf1, 0x45, 0xd4, 0x28, 0xfc, 0xea, 0x4c, 0x54, 0xaa, 0x89,
+	0x79, 0x62, 0xfc, 0xc2, 0xf3, 0xc4, 0xe8, 0x13, 0x2b, 0x3b, 0xfa, 0xc4, 0xba, 0xf1, 0xc7, 0x71,
+	0x40, 0x2f, 0x26, 0x31, 0xda, 0x86, 0x5a, 0xb3, 0xb5, 0xb7, 0xbb, 0xbf, 0x73, 0x60, 0xec, 0x1e,
+	0x1e, 0x6c, 0xef, 0x3e, 0x68, 0x19, 0x07, 0xbf, 0xdc, 0x6b, 0x19, 0x87, 0x0f, 0xf7, 0xf7, 0x5a,
+	0xdb, 0x3b, 0xf7, 0x76, 0x5a, 0xcd, 0x99, 0xb1, 0xea, 0xb5, 0xc7, 0x4f, 0x6a, 0xcb, 0x49, 0xf4,
+	0xa1, 0x47, 0xfb, 0xb8, 0x63, 0x1f, 0xdb, 0xd8, 0x42, 0x3f, 0x86, 0x6b, 0xa9, 0x24, 0x7a, 0xeb,
+	0xde, 0xe1, 0xc3, 0x66, 0xab, 0x39, 0x93, 0xa9, 0x56, 0x1f, 0x3f, 0xa9, 0x2d, 0x26, 0x19, 0xf4,
+	0x20, 0xcf, 0x7e, 0x04, 0x57, 0x52, 0xe1, 0x5b, 0x87, 0xfa, 0xc3, 0x56, 0x73, 0x66, 0xbc, 0xaa,
+	0x3d, 0x7e, 0x52, 0x9b, 0x4f, 0x82, 0xb7, 0x64, 0xe2, 0xbc, 0x0d, 0x57, 0x53, 0xa1, 0xdb, 0xf7,
+	0xef, 0xea, 0xef, 0xb6, 0x9a, 0x33, 0xd9, 0xea, 0xf2, 0xe3, 0x27, 0xb5, 0x85, 0x24, 0x76, 0x5b,
+	0x66, 0x42, 0x75, 0xe2, 0xf7, 0x7f, 0x5a, 0x19, 0xdb, 0xda, 0xfe, 0xea, 0xd9, 0x4a, 0xe6, 0xeb,
+	0x67, 0x2b, 0x99, 0x7f, 0x3f, 0x5b, 0xc9, 0x7c, 0xfa, 0x7c, 0x65, 0xec, 0xeb, 0xe7, 0x2b, 0x63,
+	0xff, 0x78, 0xbe, 0x32, 0xf6, 0xab, 0xef, 0xc5, 0x32, 0x4c, 0x76, 0x83, 0x9b, 0x8e, 0xd9, 0xa6,
+	0xea, 0xef, 0xc6, 0xa9, 0xf8, 0x3f, 0x36, 0x22, 0xd1, 0xda, 0x39, 0xd1, 0xf4, 0x6e, 0xff, 0x77,
+	0x00, 0xfa, 0x92, 0xd9, 0x1c, 0x27, 0x1a, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.Emergency {
-		i--
-		if m.Emergency {
-			dAtA[i] = 1
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x88
 	}
-	if m.Expedited {
-		i--
-		if m.Expedited {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.MinDeposit) != len(that1.MinDeposit) {
+		return false
+	}
+	for i := range this.MinDeposit {
+		if !this.MinDeposit[i].Equal(&that1.MinDeposit[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
 	}
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
-		i--
-		dAtA[i] = 0x7a
+	if this.MaxDepositPeriod != that1.MaxDepositPeriod {
+		return false
 	}
-	if len(m.Summary) > 0 {
-		i -= len(m.Summary)
-		copy(dAtA[i:], m.Summary)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Summary)))
-		i--
-		dAtA[i] = 0x72
+	if this.VotingPeriod != that1.VotingPeriod {
+		return false
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x6a
+	if this.Quorum != that1.Quorum {
+		return false
 	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x62
+	if this.Threshold != that1.Threshold {
+		return false
 	}
-	if m.EmergencyNextTallyTime != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EmergencyNextTallyTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EmergencyNextTallyTime):])
-		if err7 != nil {
-			return 0, err7
-		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
-		i--
-		dAtA[i] = 0x5a
+	if this.VetoThreshold != that1.VetoThreshold {
+		return false
 	}
-	if m.EmergencyStartTime != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EmergencyStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EmergencyStartTime):])
-		if err8 != nil {
-			return 0, err8
-		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
-		i--
-		dAtA[i] = 0x52
+	if this.MinInitialDepositRatio != that1.MinInitialDepositRatio {
+		return false
 	}
-	if m.VotingEndTime != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
-		if err9 != nil {
-			return 0, err9
-		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
-		i--
-		dAtA[i] = 0x4a
+	if this.ProposalCancelRatio != that1.ProposalCancelRatio {
+		return false
 	}
-	if m.VotingStartTime != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
-		if err10 != nil {
-			return 0, err10
-		}
-		i -= n10
-		i = encodeVarintGov(dAtA, i, uint64(n10))
-		i--
-		dAtA[i] = 0x42
+	if this.ProposalCancelDest != that1.ProposalCancelDest {
+		return false
 	}
-	if len(m.TotalDeposit) > 0 {
-		for iNdEx := len(m.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+	if this.ExpeditedVotingPeriod != that1.ExpeditedVotingPeriod {
+		return false
 	}
-	if m.DepositEndTime != nil {
-		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
-		if err11 != nil {
-			return 0, err11
-		}
-		i -= n11
-		i = encodeVarintGov(dAtA, i, uint64(n11))
-		i--
-		dAtA[i] = 0x32
+	if this.ExpeditedThreshold != that1.ExpeditedThreshold {
+		return false
 	}
-	if m.SubmitTime != nil {
-		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
-		if err12 != nil {
-			return 0, err12
+	if len(this.ExpeditedMinDeposit) != len(that1.ExpeditedMinDeposit) {
+		return false
+	}
+	for i := range this.ExpeditedMinDeposit {
+		if !this.ExpeditedMinDeposit[i].Equal(&that1.ExpeditedMinDeposit[i]) {
+			return false
 		}
-		i -= n12
-		i = encodeVarintGov(dAtA, i, uint64(n12))
-		i--
-		dAtA[i] = 0x2a
 	}
-	{
-		size, err := m.FinalTallyResult.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if this.BurnVoteQuorum != that1.BurnVoteQuorum {
+		return false
+	}
+	if this.BurnProposalDepositPrevote != that1.BurnProposalDepositPrevote {
+		return false
+	}
+	if this.BurnVoteVeto != that1.BurnVoteVeto {
+		return false
+	}
+	if this.MinDepositRatio != that1.MinDepositRatio {
+		return false
+	}
+	if len(this.EmergencyMinDeposit) != len(that1.EmergencyMinDeposit) {
+		return false
+	}
+	for i := range this.EmergencyMinDeposit {
+		if !this.EmergencyMinDeposit[i].Equal(&that1.EmergencyMinDeposit[i]) {
+			return false
 		}
-		i -= size
-		i = encodeVarintGov(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.Status != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x18
+	if this.EmergencyTallyInterval != that1.EmergencyTallyInterval {
+		return false
 	}
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	if len(this.LowThresholdFunctions) != len(that1.LowThresholdFunctions) {
+		return false
+	}
+	for i := range this.LowThresholdFunctions {
+		if this.LowThresholdFunctions[i] != that1.LowThresholdFunctions[i] {
+			return false
 		}
 	}
-	if m.Id != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	if !this.Vesting.Equal(that1.Vesting) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if this.ArchiveVoterBreakdown != that1.ArchiveVoterBreakdown {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.VoterBreakdownRetention != that1.VoterBreakdownRetention {
+		return false
 	}
-	var l int
-	_ = l
-	if len(m.MinDeposit) > 0 {
-		for _, e := range m.MinDeposit {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	if len(this.VotingPowerProviders) != len(that1.VotingPowerProviders) {
+		return false
+	}
+	for i := range this.VotingPowerProviders {
+		if !this.VotingPowerProviders[i].Equal(&that1.VotingPowerProviders[i]) {
+			return false
 		}
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxDepositPeriod)
-	n += 1 + l + sovGov(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod)
-	n += 1 + l + sovGov(uint64(l))
-	l = len(m.Quorum)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(this.EmergencySubmitters) != len(that1.EmergencySubmitters) {
+		return false
 	}
-	l = len(m.Threshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	for i := range this.EmergencySubmitters {
+		if this.EmergencySubmitters[i] != that1.EmergencySubmitters[i] {
+			return false
+		}
 	}
-	l = len(m.VetoThreshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if this.EmergencyEscalationThreshold != that1.EmergencyEscalationThreshold {
+		return false
 	}
-	l = len(m.MinInitialDepositRatio)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if this.EmergencyEscalationWindow != that1.EmergencyEscalationWindow {
+		return false
 	}
-	l = len(m.ProposalCancelRatio)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(this.ExecutionDelays) != len(that1.ExecutionDelays) {
+		return false
 	}
-	l = len(m.ProposalCancelDest)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	for i := range this.ExecutionDelays {
+		if !this.ExecutionDelays[i].Equal(&that1.ExecutionDelays[i]) {
+			return false
+		}
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpeditedVotingPeriod)
-	n += 1 + l + sovGov(uint64(l))
-	l = len(m.ExpeditedThreshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(this.ExecutionCancellers) != len(that1.ExecutionCancellers) {
+		return false
 	}
-	if len(m.ExpeditedMinDeposit) > 0 {
-		for _, e := range m.ExpeditedMinDeposit {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	for i := range this.ExecutionCancellers {
+		if this.ExecutionCancellers[i] != that1.ExecutionCancellers[i] {
+			return false
 		}
 	}
-	if m.BurnVoteQuorum {
-		n += 2
+	if len(this.MsgTypeParams) != len(that1.MsgTypeParams) {
+		return false
 	}
-	if m.BurnProposalDepositPrevote {
-		n += 2
+	for i := range this.MsgTypeParams {
+		if !this.MsgTypeParams[i].Equal(&that1.MsgTypeParams[i]) {
+			return false
+		}
 	}
-	if m.BurnVoteVeto {
-		n += 2
+	if len(this.OptimisticMsgTypes) != len(that1.OptimisticMsgTypes) {
+		return false
 	}
-	l = len(m.MinDepositRatio)
-	if l > 0 {
-		n += 2 + l + sovGov(uint64(l))
+	for i := range this.OptimisticMsgTypes {
+		if this.OptimisticMsgTypes[i] != that1.OptimisticMsgTypes[i] {
+			return false
+		}
 	}
-	if len(m.EmergencyMinDeposit) > 0 {
-		for _, e := range m.EmergencyMinDeposit {
-			l = e.Size()
-			n += 2 + l + sovGov(uint64(l))
+	if this.OptimisticRejectionThreshold != that1.OptimisticRejectionThreshold {
+		return false
+	}
+	return true
+}
+func (this *MsgTypeParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgTypeParams)
+	if !ok {
+		that2, ok := that.(MsgTypeParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EmergencyTallyInterval)
-	n += 2 + l + sovGov(uint64(l))
-	if len(m.LowThresholdFunctions) > 0 {
-		for _, s := range m.LowThresholdFunctions {
-			l = len(s)
-			n += 2 + l + sovGov(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MsgTypeUrl != that1.MsgTypeUrl {
+		return false
+	}
+	if this.Quorum != that1.Quorum {
+		return false
+	}
+	if this.Threshold != that1.Threshold {
+		return false
+	}
+	if this.VetoThreshold != that1.VetoThreshold {
+		return false
+	}
+	if len(this.MinDeposit) != len(that1.MinDeposit) {
+		return false
+	}
+	for i := range this.MinDeposit {
+		if !this.MinDeposit[i].Equal(&that1.MinDeposit[i]) {
+			return false
 		}
 	}
-	if m.Vesting != nil {
-		l = m.Vesting.Size()
-		n += 2 + l + sovGov(uint64(l))
+	if this.VotingPeriod != nil && that1.VotingPeriod != nil {
+		if *this.VotingPeriod != *that1.VotingPeriod {
+			return false
+		}
+	} else if this.VotingPeriod != nil {
+		return false
+	} else if that1.VotingPeriod != nil {
+		return false
 	}
-	return n
+	return true
 }
+func (this *ExecutionDelay) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Vesting) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ExecutionDelay)
+	if !ok {
+		that2, ok := that.(ExecutionDelay)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.ModuleAddr)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.ModuleName)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if this.MsgTypeUrl != that1.MsgTypeUrl {
+		return false
 	}
-	l = len(m.CreatorAddr)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if this.Delay != that1.Delay {
+		return false
+	}
+	return true
+}
+func (this *VotingPowerProvider) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VotingPowerProvider)
+	if !ok {
+		that2, ok := that.(VotingPowerProvider)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.ModuleAddr != that1.ModuleAddr {
+		return false
+	}
+	if this.ModuleName != that1.ModuleName {
+		return false
+	}
+	if this.FunctionName != that1.FunctionName {
+		return false
+	}
+	if this.Weight != that1.Weight {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	return true
+}
+func (this *MoveHook) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MoveHook)
+	if !ok {
+		that2, ok := that.(MoveHook)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ModuleAddr != that1.ModuleAddr {
+		return false
+	}
+	if this.ModuleName != that1.ModuleName {
+		return false
+	}
+	if this.FunctionName != that1.FunctionName {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	return true
+}
+func (this *Vesting) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Vesting)
+	if !ok {
+		that2, ok := that.(Vesting)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ModuleAddr != that1.ModuleAddr {
+		return false
+	}
+	if this.ModuleName != that1.ModuleName {
+		return false
+	}
+	if this.CreatorAddr != that1.CreatorAddr {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OptimisticRejectionThreshold) > 0 {
+		i -= len(m.OptimisticRejectionThreshold)
+		copy(dAtA[i:], m.OptimisticRejectionThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticRejectionThreshold)))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.OptimisticMsgTypes) > 0 {
+		for iNdEx := len(m.OptimisticMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptimisticMsgTypes[iNdEx])
+			copy(dAtA[i:], m.OptimisticMsgTypes[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.MsgTypeParams) > 0 {
+		for iNdEx := len(m.MsgTypeParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgTypeParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.ExecutionCancellers) > 0 {
+		for iNdEx := len(m.ExecutionCancellers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExecutionCancellers[iNdEx])
+			copy(dAtA[i:], m.ExecutionCancellers[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.ExecutionCancellers[iNdEx])))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.ExecutionDelays) > 0 {
+		for iNdEx := len(m.ExecutionDelays) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionDelays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.EmergencyEscalationWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EmergencyEscalationWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGov(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x6
+	i--
+	dAtA[i] = 0xa2
+	if len(m.EmergencyEscalationThreshold) > 0 {
+		i -= len(m.EmergencyEscalationThreshold)
+		copy(dAtA[i:], m.EmergencyEscalationThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.EmergencyEscalationThreshold)))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.EmergencySubmitters) > 0 {
+		for iNdEx := len(m.EmergencySubmitters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EmergencySubmitters[iNdEx])
+			copy(dAtA[i:], m.EmergencySubmitters[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.EmergencySubmitters[iNdEx])))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.VotingPowerProviders) > 0 {
+		for iNdEx := len(m.VotingPowerProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotingPowerProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.VoterBreakdownRetention != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.VoterBreakdownRetention))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.ArchiveVoterBreakdown {
+		i--
+		if m.ArchiveVoterBreakdown {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.Vesting != nil {
+		{
+			size, err := m.Vesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xf2
+	}
+	if len(m.LowThresholdFunctions) > 0 {
+		for iNdEx := len(m.LowThresholdFunctions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LowThresholdFunctions[iNdEx])
+			copy(dAtA[i:], m.LowThresholdFunctions[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.LowThresholdFunctions[iNdEx])))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.EmergencyTallyInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EmergencyTallyInterval):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGov(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x5
+	i--
+	dAtA[i] = 0xe2
+	if len(m.EmergencyMinDeposit) > 0 {
+		for iNdEx := len(m.EmergencyMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EmergencyMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.MinDepositRatio) > 0 {
+		i -= len(m.MinDepositRatio)
+		copy(dAtA[i:], m.MinDepositRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MinDepositRatio)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.BurnVoteVeto {
+		i--
+		if m.BurnVoteVeto {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.BurnProposalDepositPrevote {
+		i--
+		if m.BurnProposalDepositPrevote {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.BurnVoteQuorum {
+		i--
+		if m.BurnVoteQuorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for iNdEx := len(m.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpeditedMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ExpeditedThreshold) > 0 {
+		i -= len(m.ExpeditedThreshold)
+		copy(dAtA[i:], m.ExpeditedThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ExpeditedThreshold)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpeditedVotingPeriod):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGov(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x52
+	if len(m.ProposalCancelDest) > 0 {
+		i -= len(m.ProposalCancelDest)
+		copy(dAtA[i:], m.ProposalCancelDest)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelDest)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ProposalCancelRatio) > 0 {
+		i -= len(m.ProposalCancelRatio)
+		copy(dAtA[i:], m.ProposalCancelRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelRatio)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.MinInitialDepositRatio) > 0 {
+		i -= len(m.MinInitialDepositRatio)
+		copy(dAtA[i:], m.MinInitialDepositRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MinInitialDepositRatio)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGov(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxDepositPeriod):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintGov(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTypeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTypeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTypeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPeriod != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionDelay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionDelay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionDelay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Delay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Delay):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintGov(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotingPowerProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingPowerProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingPowerProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FunctionName) > 0 {
+		i -= len(m.FunctionName)
+		copy(dAtA[i:], m.FunctionName)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.FunctionName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ModuleAddr) > 0 {
+		i -= len(m.ModuleAddr)
+		copy(dAtA[i:], m.ModuleAddr)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ModuleAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MoveHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MoveHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MoveHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FunctionName) > 0 {
+		i -= len(m.FunctionName)
+		copy(dAtA[i:], m.FunctionName)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.FunctionName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleAddr) > 0 {
+		i -= len(m.ModuleAddr)
+		copy(dAtA[i:], m.ModuleAddr)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ModuleAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CreatorAddr) > 0 {
+		i -= len(m.CreatorAddr)
+		copy(dAtA[i:], m.CreatorAddr)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.CreatorAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleAddr) > 0 {
+		i -= len(m.ModuleAddr)
+		copy(dAtA[i:], m.ModuleAddr)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ModuleAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TallyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProviderOmittedVoters != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProviderOmittedVoters))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ProviderPowers) > 0 {
+		for iNdEx := len(m.ProviderPowers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderPowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.V1TallyResult != nil {
+		{
+			size, err := m.V1TallyResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TotalVestingPower) > 0 {
+		i -= len(m.TotalVestingPower)
+		copy(dAtA[i:], m.TotalVestingPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.TotalVestingPower)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TotalStakingPower) > 0 {
+		i -= len(m.TotalStakingPower)
+		copy(dAtA[i:], m.TotalStakingPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.TotalStakingPower)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TallyHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.TallyHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderVotingPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderVotingPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderVotingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VotingPower) > 0 {
+		i -= len(m.VotingPower)
+		copy(dAtA[i:], m.VotingPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VotingPower)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoterBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoterBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoterBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderPower) > 0 {
+		i -= len(m.ProviderPower)
+		copy(dAtA[i:], m.ProviderPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProviderPower)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.InheritedPower) > 0 {
+		i -= len(m.InheritedPower)
+		copy(dAtA[i:], m.InheritedPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.InheritedPower)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.VestingPower) > 0 {
+		i -= len(m.VestingPower)
+		copy(dAtA[i:], m.VestingPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VestingPower)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StakingPower) > 0 {
+		i -= len(m.StakingPower)
+		copy(dAtA[i:], m.StakingPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.StakingPower)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Optimistic {
+		i--
+		if m.Optimistic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.ExecutionTime != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExecutionTime):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.FailedReason) > 0 {
+		i -= len(m.FailedReason)
+		copy(dAtA[i:], m.FailedReason)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.FailedReason)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.Emergency {
+		i--
+		if m.Emergency {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.EmergencyNextTallyTime != nil {
+		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EmergencyNextTallyTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EmergencyNextTallyTime):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.EmergencyStartTime != nil {
+		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EmergencyStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EmergencyStartTime):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintGov(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.VotingEndTime != nil {
+		n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintGov(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.VotingStartTime != nil {
+		n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintGov(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.TotalDeposit) > 0 {
+		for iNdEx := len(m.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.DepositEndTime != nil {
+		n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintGov(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SubmitTime != nil {
+		n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintGov(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.FinalTallyResult.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Status != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositOutcome) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositOutcome) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositOutcome) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Charged) > 0 {
+		for iNdEx := len(m.Charged) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Charged[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Refunded) > 0 {
+		for iNdEx := len(m.Refunded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Outcome != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxDepositPeriod)
+	n += 1 + l + sovGov(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod)
+	n += 1 + l + sovGov(uint64(l))
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.MinInitialDepositRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ProposalCancelRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ProposalCancelDest)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpeditedVotingPeriod)
+	n += 1 + l + sovGov(uint64(l))
+	l = len(m.ExpeditedThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for _, e := range m.ExpeditedMinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.BurnVoteQuorum {
+		n += 2
+	}
+	if m.BurnProposalDepositPrevote {
+		n += 2
+	}
+	if m.BurnVoteVeto {
+		n += 2
+	}
+	l = len(m.MinDepositRatio)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if len(m.EmergencyMinDeposit) > 0 {
+		for _, e := range m.EmergencyMinDeposit {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EmergencyTallyInterval)
+	n += 2 + l + sovGov(uint64(l))
+	if len(m.LowThresholdFunctions) > 0 {
+		for _, s := range m.LowThresholdFunctions {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Vesting != nil {
+		l = m.Vesting.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.ArchiveVoterBreakdown {
+		n += 3
+	}
+	if m.VoterBreakdownRetention != 0 {
+		n += 2 + sovGov(uint64(m.VoterBreakdownRetention))
+	}
+	if len(m.VotingPowerProviders) > 0 {
+		for _, e := range m.VotingPowerProviders {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.EmergencySubmitters) > 0 {
+		for _, s := range m.EmergencySubmitters {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.EmergencyEscalationThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EmergencyEscalationWindow)
+	n += 2 + l + sovGov(uint64(l))
+	if len(m.ExecutionDelays) > 0 {
+		for _, e := range m.ExecutionDelays {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.ExecutionCancellers) > 0 {
+		for _, s := range m.ExecutionCancellers {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.MsgTypeParams) > 0 {
+		for _, e := range m.MsgTypeParams {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.OptimisticMsgTypes) > 0 {
+		for _, s := range m.OptimisticMsgTypes {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.OptimisticRejectionThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTypeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.VotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutionDelay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Delay)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *VotingPowerProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ModuleAddr)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.FunctionName)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovGov(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *MoveHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleAddr)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.FunctionName)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovGov(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *Vesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleAddr)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.CreatorAddr)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *TallyResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TallyHeight != 0 {
+		n += 1 + sovGov(uint64(m.TallyHeight))
+	}
+	l = len(m.TotalStakingPower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.TotalVestingPower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.V1TallyResult != nil {
+		l = m.V1TallyResult.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.ProviderPowers) > 0 {
+		for _, e := range m.ProviderPowers {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.ProviderOmittedVoters != 0 {
+		n += 1 + sovGov(uint64(m.ProviderOmittedVoters))
+	}
+	return n
+}
+
+func (m *ProviderVotingPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VotingPower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *VoterBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.StakingPower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VestingPower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.InheritedPower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ProviderPower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovGov(uint64(m.Status))
+	}
+	l = m.FinalTallyResult.Size()
+	n += 1 + l + sovGov(uint64(l))
+	if m.SubmitTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.DepositEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.TotalDeposit) > 0 {
+		for _, e := range m.TotalDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.VotingStartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.VotingEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.EmergencyStartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EmergencyStartTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.EmergencyNextTallyTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EmergencyNextTallyTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Expedited {
+		n += 3
+	}
+	if m.Emergency {
+		n += 3
+	}
+	l = len(m.FailedReason)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.ExecutionTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExecutionTime)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.Optimistic {
+		n += 3
+	}
+	return n
+}
+
+func (m *DepositOutcome) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovGov(uint64(m.Outcome))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.Refunded) > 0 {
+		for _, e := range m.Refunded {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.Charged) > 0 {
+		for _, e := range m.Charged {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGov(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *VoteDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelDest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalCancelDest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedMinDeposit = append(m.ExpeditedMinDeposit, types.Coin{})
+			if err := m.ExpeditedMinDeposit[len(m.ExpeditedMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteQuorum", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnVoteQuorum = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnProposalDepositPrevote", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnProposalDepositPrevote = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteVeto", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnVoteVeto = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDepositRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDepositRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 91:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyMinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencyMinDeposit = append(m.EmergencyMinDeposit, types.Coin{})
+			if err := m.EmergencyMinDeposit[len(m.EmergencyMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 92:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyTallyInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.EmergencyTallyInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 93:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowThresholdFunctions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LowThresholdFunctions = append(m.LowThresholdFunctions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 94:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Vesting == nil {
+				m.Vesting = &Vesting{}
+			}
+			if err := m.Vesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 95:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveVoterBreakdown", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ArchiveVoterBreakdown = bool(v != 0)
+		case 96:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterBreakdownRetention", wireType)
+			}
+			m.VoterBreakdownRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoterBreakdownRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 97:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPowerProviders = append(m.VotingPowerProviders, VotingPowerProvider{})
+			if err := m.VotingPowerProviders[len(m.VotingPowerProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencySubmitters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencySubmitters = append(m.EmergencySubmitters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyEscalationThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencyEscalationThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyEscalationWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.EmergencyEscalationWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 101:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelays", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionDelays = append(m.ExecutionDelays, ExecutionDelay{})
+			if err := m.ExecutionDelays[len(m.ExecutionDelays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 102:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionCancellers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionCancellers = append(m.ExecutionCancellers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 103:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeParams = append(m.MsgTypeParams, MsgTypeParams{})
+			if err := m.MsgTypeParams[len(m.MsgTypeParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 104:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptimisticMsgTypes = append(m.OptimisticMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 105:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticRejectionThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptimisticRejectionThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTypeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTypeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTypeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionDelay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionDelay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionDelay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VotingPowerProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotingPowerProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotingPowerProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunctionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MoveHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MoveHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MoveHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunctionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *TallyResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TallyHeight != 0 {
-		n += 1 + sovGov(uint64(m.TallyHeight))
-	}
-	l = len(m.TotalStakingPower)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.TotalVestingPower)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	if m.V1TallyResult != nil {
-		l = m.V1TallyResult.Size()
-		n += 1 + l + sovGov(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *Proposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovGov(uint64(m.Id))
-	}
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+func (m *Vesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if m.Status != 0 {
-		n += 1 + sovGov(uint64(m.Status))
-	}
-	l = m.FinalTallyResult.Size()
-	n += 1 + l + sovGov(uint64(l))
-	if m.SubmitTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
-		n += 1 + l + sovGov(uint64(l))
-	}
-	if m.DepositEndTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime)
-		n += 1 + l + sovGov(uint64(l))
-	}
-	if len(m.TotalDeposit) > 0 {
-		for _, e := range m.TotalDeposit {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.VotingStartTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime)
-		n += 1 + l + sovGov(uint64(l))
-	}
-	if m.VotingEndTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime)
-		n += 1 + l + sovGov(uint64(l))
-	}
-	if m.EmergencyStartTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EmergencyStartTime)
-		n += 1 + l + sovGov(uint64(l))
-	}
-	if m.EmergencyNextTallyTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EmergencyNextTallyTime)
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Summary)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	if m.Expedited {
-		n += 3
-	}
-	if m.Emergency {
-		n += 3
-	}
-	l = len(m.FailedReason)
-	if l > 0 {
-		n += 2 + l + sovGov(uint64(l))
-	}
-	return n
-}
 
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1588,17 +5633,36 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyHeight", wireType)
+			}
+			m.TallyHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TallyHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStakingPower", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1608,29 +5672,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDeposit = append(m.MinDeposit, types.Coin{})
-			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TotalStakingPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVestingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.TotalVestingPower = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field V1TallyResult", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1657,13 +5751,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if m.V1TallyResult == nil {
+				m.V1TallyResult = &v1.TallyResult{}
+			}
+			if err := m.V1TallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPowers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1690,13 +5787,83 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			m.ProviderPowers = append(m.ProviderPowers, ProviderVotingPower{})
+			if err := m.ProviderPowers[len(m.ProviderPowers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderOmittedVoters", wireType)
+			}
+			m.ProviderOmittedVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProviderOmittedVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderVotingPower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderVotingPower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderVotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1724,11 +5891,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quorum = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1756,11 +5923,80 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Threshold = string(dAtA[iNdEx:postIndex])
+			m.VotingPower = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoterBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoterBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoterBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1788,13 +6024,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1804,27 +6040,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
+			m.Options = append(m.Options, &v1.WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1852,11 +6090,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
+			m.Delegate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelDest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingPower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1884,13 +6122,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposalCancelDest = string(dAtA[iNdEx:postIndex])
+			m.StakingPower = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPower", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1900,28 +6138,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.VestingPower = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InheritedPower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1949,13 +6186,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
+			m.InheritedPower = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPower", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1965,31 +6202,79 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpeditedMinDeposit = append(m.ExpeditedMinDeposit, types.Coin{})
-			if err := m.ExpeditedMinDeposit[len(m.ExpeditedMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ProviderPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 13:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteQuorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var v int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1999,17 +6284,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BurnVoteQuorum = bool(v != 0)
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnProposalDepositPrevote", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2019,17 +6303,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BurnProposalDepositPrevote = bool(v != 0)
-		case 15:
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteVeto", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var v int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2039,17 +6337,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Status |= v1.ProposalStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BurnVoteVeto = bool(v != 0)
-		case 16:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDepositRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2059,27 +6356,28 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDepositRatio = string(dAtA[iNdEx:postIndex])
+			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 91:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyMinDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2106,14 +6404,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EmergencyMinDeposit = append(m.EmergencyMinDeposit, types.Coin{})
-			if err := m.EmergencyMinDeposit[len(m.EmergencyMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SubmitTime == nil {
+				m.SubmitTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 92:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyTallyInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2140,15 +6440,18 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.EmergencyTallyInterval, dAtA[iNdEx:postIndex]); err != nil {
+			if m.DepositEndTime == nil {
+				m.DepositEndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.DepositEndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 93:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowThresholdFunctions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2158,27 +6461,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LowThresholdFunctions = append(m.LowThresholdFunctions, string(dAtA[iNdEx:postIndex]))
+			m.TotalDeposit = append(m.TotalDeposit, types.Coin{})
+			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 94:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2205,68 +6510,54 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Vesting == nil {
-				m.Vesting = &Vesting{}
+			if m.VotingStartTime == nil {
+				m.VotingStartTime = new(time.Time)
 			}
-			if err := m.Vesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingStartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGov
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Vesting) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.VotingEndTime == nil {
+				m.VotingEndTime = new(time.Time)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Vesting: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vesting: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyStartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2276,29 +6567,33 @@ func (m *Vesting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleAddr = string(dAtA[iNdEx:postIndex])
+			if m.EmergencyStartTime == nil {
+				m.EmergencyStartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EmergencyStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyNextTallyTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2308,27 +6603,31 @@ func (m *Vesting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			if m.EmergencyNextTallyTime == nil {
+				m.EmergencyNextTallyTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EmergencyNextTallyTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2356,80 +6655,11 @@ func (m *Vesting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatorAddr = string(dAtA[iNdEx:postIndex])
+			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TallyResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TallyHeight", wireType)
-			}
-			m.TallyHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TallyHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalStakingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2457,11 +6687,11 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalStakingPower = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalVestingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2489,13 +6719,13 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalVestingPower = string(dAtA[iNdEx:postIndex])
+			m.Summary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field V1TallyResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2505,102 +6735,29 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.V1TallyResult == nil {
-				m.V1TallyResult = &v1.TallyResult{}
-			}
-			if err := m.V1TallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Proposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2610,31 +6767,17 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Messages = append(m.Messages, &types1.Any{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+			m.Expedited = bool(v != 0)
+		case 17:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Emergency", wireType)
 			}
-			m.Status = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2644,16 +6787,17 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= v1.ProposalStatus(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			m.Emergency = bool(v != 0)
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedReason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2663,28 +6807,27 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FailedReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2711,18 +6854,18 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SubmitTime == nil {
-				m.SubmitTime = new(time.Time)
+			if m.ExecutionTime == nil {
+				m.ExecutionTime = new(time.Time)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Optimistic", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2732,33 +6875,86 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
+			m.Optimistic = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGov
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DepositEndTime == nil {
-				m.DepositEndTime = new(time.Time)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositOutcome) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.DepositEndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositOutcome: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositOutcome: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2768,29 +6964,46 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDeposit = append(m.TotalDeposit, types.Coin{})
-			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= DepositOutcomeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2817,16 +7030,14 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotingStartTime == nil {
-				m.VotingStartTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingStartTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2853,16 +7064,14 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotingEndTime == nil {
-				m.VotingEndTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Refunded = append(m.Refunded, types.Coin{})
+			if err := m.Refunded[len(m.Refunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyStartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2889,16 +7098,14 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EmergencyStartTime == nil {
-				m.EmergencyStartTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EmergencyStartTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyNextTallyTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Charged", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2925,16 +7132,14 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EmergencyNextTallyTime == nil {
-				m.EmergencyNextTallyTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EmergencyNextTallyTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Charged = append(m.Charged, types.Coin{})
+			if err := m.Charged[len(m.Charged)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2962,13 +7167,13 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.Destination = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2978,27 +7183,64 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGov
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 14:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3026,11 +7268,11 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Summary = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3058,51 +7300,11 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
+			m.Delegate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Expedited = bool(v != 0)
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Emergency", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Emergency = bool(v != 0)
-		case 18:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3130,7 +7332,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailedReason = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3,4 +3,11 @@ package types
 var (
 	EmergencyProposalsPrefix     = []byte{0x90}
 	EmergencyProposalQueuePrefix = []byte{0x91}
+	VoteDelegationsPrefix        = []byte{0x92}
+	VoteDelegatorsPrefix         = []byte{0x93}
+	VoterBreakdownsPrefix        = []byte{0x94}
+	BreakdownProposalsPrefix     = []byte{0x95}
+	ExecutionQueuePrefix         = []byte{0x96}
+	MoveHooksPrefix              = []byte{0x97}
+	DepositOutcomesPrefix        = []byte{0x98}
 )
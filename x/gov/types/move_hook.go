@@ -0,0 +1,37 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/core/address"
+)
+
+// Move hook events passed to the hook entry functions
+const (
+	MoveHookEventSubmitted = "submitted"
+	MoveHookEventDeposited = "deposited"
+	MoveHookEventVoted     = "voted"
+	MoveHookEventPassed    = "passed"
+	MoveHookEventRejected  = "rejected"
+	MoveHookEventFailed    = "failed"
+	MoveHookEventDropped   = "dropped"
+	MoveHookEventExecuted  = "executed"
+)
+
+// Validate performs basic validation of the Move hook.
+func (h MoveHook) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(h.ModuleAddr); err != nil {
+		return fmt.Errorf("invalid module address of move hook: %w", err)
+	}
+
+	if h.ModuleName == "" || h.FunctionName == "" {
+		return errors.New("module name and function name of move hook cannot be empty")
+	}
+
+	if h.GasLimit == 0 {
+		return fmt.Errorf("gas limit of move hook %s::%s must be positive", h.ModuleAddr, h.ModuleName)
+	}
+
+	return nil
+}
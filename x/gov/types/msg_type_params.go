@@ -0,0 +1,190 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgTypeParamsWildcard is the msg type url of the params applied to the messages
+// without their own params.
+const MsgTypeParamsWildcard = "*"
+
+// ProposalParams is the set of governance params resolved for a proposal.
+type ProposalParams struct {
+	Quorum        math.LegacyDec
+	Threshold     math.LegacyDec
+	VetoThreshold math.LegacyDec
+	MinDeposit    sdk.Coins
+	VotingPeriod  time.Duration
+}
+
+// Validate performs basic validation of the msg type params. The voting period must be
+// longer than the expedited voting period as the global voting period.
+func (p MsgTypeParams) Validate(minDepositDenoms map[string]bool, expeditedVotingPeriod time.Duration) error {
+	if p.MsgTypeUrl == "" {
+		return errors.New("msg type params msg type url cannot be empty")
+	}
+
+	if p.Quorum != "" {
+		quorum, err := math.LegacyNewDecFromStr(p.Quorum)
+		if err != nil {
+			return fmt.Errorf("invalid quorum of msg type params %s: %w", p.MsgTypeUrl, err)
+		}
+		if quorum.IsNegative() || quorum.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("quorum of msg type params %s must be between 0 and 1: %s", p.MsgTypeUrl, quorum)
+		}
+	}
+
+	if p.Threshold != "" {
+		threshold, err := math.LegacyNewDecFromStr(p.Threshold)
+		if err != nil {
+			return fmt.Errorf("invalid threshold of msg type params %s: %w", p.MsgTypeUrl, err)
+		}
+		if !threshold.IsPositive() || threshold.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("threshold of msg type params %s must be between 0 and 1: %s", p.MsgTypeUrl, threshold)
+		}
+	}
+
+	if p.VetoThreshold != "" {
+		vetoThreshold, err := math.LegacyNewDecFromStr(p.VetoThreshold)
+		if err != nil {
+			return fmt.Errorf("invalid veto threshold of msg type params %s: %w", p.MsgTypeUrl, err)
+		}
+		if !vetoThreshold.IsPositive() || vetoThreshold.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("veto threshold of msg type params %s must be between 0 and 1: %s", p.MsgTypeUrl, vetoThreshold)
+		}
+	}
+
+	if minDeposit := sdk.Coins(p.MinDeposit); len(minDeposit) > 0 {
+		if !minDeposit.IsValid() {
+			return fmt.Errorf("invalid minimum deposit of msg type params %s: %s", p.MsgTypeUrl, minDeposit)
+		}
+
+		// deposits are only accepted in the denoms of the global min deposit
+		for _, coin := range minDeposit {
+			if !minDepositDenoms[coin.Denom] {
+				return fmt.Errorf("minimum deposit denom of msg type params %s is not allowed: %s", p.MsgTypeUrl, coin.Denom)
+			}
+		}
+	}
+
+	if p.VotingPeriod != nil && *p.VotingPeriod <= 0 {
+		return fmt.Errorf("voting period of msg type params %s must be positive: %s", p.MsgTypeUrl, *p.VotingPeriod)
+	}
+	if p.VotingPeriod != nil && *p.VotingPeriod <= expeditedVotingPeriod {
+		return fmt.Errorf("voting period of msg type params %s must be strictly greater than the expedited voting period %s: %s", p.MsgTypeUrl, expeditedVotingPeriod, *p.VotingPeriod)
+	}
+
+	return nil
+}
+
+// validateMsgTypeParams validates the msg type params and checks the msg type urls are unique.
+func validateMsgTypeParams(msgTypeParams []MsgTypeParams, minDeposit sdk.Coins, expeditedVotingPeriod time.Duration) error {
+	minDepositDenoms := make(map[string]bool, len(minDeposit))
+	for _, coin := range minDeposit {
+		minDepositDenoms[coin.Denom] = true
+	}
+
+	msgTypeURLs := make(map[string]bool, len(msgTypeParams))
+	for _, params := range msgTypeParams {
+		if err := params.Validate(minDepositDenoms, expeditedVotingPeriod); err != nil {
+			return err
+		}
+
+		if msgTypeURLs[params.MsgTypeUrl] {
+			return fmt.Errorf("duplicate msg type params: %s", params.MsgTypeUrl)
+		}
+		msgTypeURLs[params.MsgTypeUrl] = true
+	}
+
+	return nil
+}
+
+// getMsgTypeParams returns the params of the given message type url, falling back to
+// the wildcard params.
+func (p Params) getMsgTypeParams(msgTypeURL string) (MsgTypeParams, bool) {
+	var wildcard *MsgTypeParams
+	for i, params := range p.MsgTypeParams {
+		if params.MsgTypeUrl == msgTypeURL {
+			return params, true
+		} else if params.MsgTypeUrl == MsgTypeParamsWildcard {
+			wildcard = &p.MsgTypeParams[i]
+		}
+	}
+
+	if wildcard != nil {
+		return *wildcard, true
+	}
+
+	return MsgTypeParams{}, false
+}
+
+// GetProposalParams returns the governance params applied to a proposal with the given
+// message type urls. Each message takes its own params, the wildcard params or the global
+// params in order, and the strictest of them are applied to the proposal; the highest
+// quorum, threshold, min deposit and voting period and the lowest veto threshold.
+func (p Params) GetProposalParams(msgTypeURLs ...string) ProposalParams {
+	global := ProposalParams{
+		Quorum:        math.LegacyMustNewDecFromStr(p.Quorum),
+		Threshold:     math.LegacyMustNewDecFromStr(p.Threshold),
+		VetoThreshold: math.LegacyMustNewDecFromStr(p.VetoThreshold),
+		MinDeposit:    p.MinDeposit,
+		VotingPeriod:  p.VotingPeriod,
+	}
+	if len(msgTypeURLs) == 0 {
+		return global
+	}
+
+	var res *ProposalParams
+	for _, msgTypeURL := range msgTypeURLs {
+		params := global
+		if msgTypeParams, found := p.getMsgTypeParams(msgTypeURL); found {
+			if msgTypeParams.Quorum != "" {
+				params.Quorum = math.LegacyMustNewDecFromStr(msgTypeParams.Quorum)
+			}
+			if msgTypeParams.Threshold != "" {
+				params.Threshold = math.LegacyMustNewDecFromStr(msgTypeParams.Threshold)
+			}
+			if msgTypeParams.VetoThreshold != "" {
+				params.VetoThreshold = math.LegacyMustNewDecFromStr(msgTypeParams.VetoThreshold)
+			}
+			if len(msgTypeParams.MinDeposit) > 0 {
+				params.MinDeposit = msgTypeParams.MinDeposit
+			}
+			if msgTypeParams.VotingPeriod != nil {
+				params.VotingPeriod = *msgTypeParams.VotingPeriod
+			}
+		}
+
+		if res == nil {
+			res = &params
+			continue
+		}
+
+		res.Quorum = math.LegacyMaxDec(res.Quorum, params.Quorum)
+		res.Threshold = math.LegacyMaxDec(res.Threshold, params.Threshold)
+		res.VetoThreshold = math.LegacyMinDec(res.VetoThreshold, params.VetoThreshold)
+		res.MinDeposit = res.MinDeposit.Max(params.MinDeposit)
+		if params.VotingPeriod > res.VotingPeriod {
+			res.VotingPeriod = params.VotingPeriod
+		}
+	}
+
+	return *res
+}
+
+// GetProposalMinDeposit returns the min deposit of a proposal with the given message type urls.
+// The expedited proposals take the higher of the expedited min deposit and the resolved one.
+func (p Params) GetProposalMinDeposit(expedited bool, msgTypeURLs ...string) sdk.Coins {
+	minDeposit := p.GetProposalParams(msgTypeURLs...).MinDeposit
+	if expedited {
+		return sdk.Coins(p.ExpeditedMinDeposit).Max(minDeposit)
+	}
+
+	return minDeposit
+}
@@ -37,6 +37,9 @@ var (
 	DefaultBurnVoteVeto              = true  // set to true to replicate behavior of when this change was made (0.47)
 	DefaultMinDepositRatio           = math.LegacyMustNewDecFromStr("0.01")
 	DefaultLowThresholdFunctions     = []string{"0x1::vip::register_snapshot"}
+
+	DefaultEmergencyEscalationThreshold = math.LegacyNewDecWithPrec(667, 3)
+	DefaultOptimisticRejectionThreshold = math.LegacyNewDecWithPrec(1, 1)
 )
 
 // NewParams creates a new Params instance with given values.
@@ -71,7 +74,7 @@ func NewParams(
 
 // DefaultParams returns the default governance params
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinExpeditedDepositTokens)),
 		DefaultPeriod,
@@ -92,6 +95,14 @@ func DefaultParams() Params {
 		DefaultEmergencyTallyInterval,
 		DefaultLowThresholdFunctions,
 	)
+
+	// the escalation is disabled by default with the zero window
+	params.EmergencyEscalationThreshold = DefaultEmergencyEscalationThreshold.String()
+
+	// the optimistic proposals are disabled by default with no eligible message types
+	params.OptimisticRejectionThreshold = DefaultOptimisticRejectionThreshold.String()
+
+	return params
 }
 
 func (p Params) String() string {
@@ -215,6 +226,88 @@ func (p Params) Validate(ac address.Codec) error {
 		return fmt.Errorf("emergency minimum deposit must be greater than or equal to minimum deposit")
 	}
 
+	if err := validateAddresses(ac, "emergency submitter", p.EmergencySubmitters); err != nil {
+		return err
+	}
+
+	if p.EmergencyEscalationWindow < 0 {
+		return fmt.Errorf("emergency escalation window cannot be negative: %s", p.EmergencyEscalationWindow)
+	}
+	if p.EmergencyEscalationWindow > 0 {
+		emergencyEscalationThreshold, err := math.LegacyNewDecFromStr(p.EmergencyEscalationThreshold)
+		if err != nil {
+			return fmt.Errorf("invalid emergency escalation threshold string: %w", err)
+		}
+		if !emergencyEscalationThreshold.IsPositive() {
+			return fmt.Errorf("emergency escalation threshold must be positive: %s", emergencyEscalationThreshold)
+		}
+		if emergencyEscalationThreshold.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("emergency escalation threshold too large: %s", emergencyEscalationThreshold)
+		}
+	}
+
+	delays := make(map[string]bool, len(p.ExecutionDelays))
+	for _, delay := range p.ExecutionDelays {
+		if delay.MsgTypeUrl == "" {
+			return fmt.Errorf("execution delay msg type url cannot be empty")
+		}
+		if delay.Delay <= 0 {
+			return fmt.Errorf("execution delay of %s must be positive: %s", delay.MsgTypeUrl, delay.Delay)
+		}
+		if delays[delay.MsgTypeUrl] {
+			return fmt.Errorf("duplicate execution delay: %s", delay.MsgTypeUrl)
+		}
+		delays[delay.MsgTypeUrl] = true
+	}
+
+	if err := validateAddresses(ac, "execution canceller", p.ExecutionCancellers); err != nil {
+		return err
+	}
+
+	if err := validateMsgTypeParams(p.MsgTypeParams, minDeposit, p.ExpeditedVotingPeriod); err != nil {
+		return err
+	}
+
+	optimisticMsgTypes := make(map[string]bool, len(p.OptimisticMsgTypes))
+	for _, msgType := range p.OptimisticMsgTypes {
+		if msgType == "" {
+			return fmt.Errorf("optimistic msg type cannot be empty")
+		}
+		if optimisticMsgTypes[msgType] {
+			return fmt.Errorf("duplicate optimistic msg type: %s", msgType)
+		}
+		optimisticMsgTypes[msgType] = true
+	}
+
+	// validated regardless of the optimistic msg types, as the proposals submitted while
+	// the msg types were allowed are still tallied with the threshold
+	optimisticRejectionThreshold, err := math.LegacyNewDecFromStr(p.OptimisticRejectionThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid optimistic rejection threshold string: %w", err)
+	}
+	if !optimisticRejectionThreshold.IsPositive() {
+		return fmt.Errorf("optimistic rejection threshold must be positive: %s", optimisticRejectionThreshold)
+	}
+	if optimisticRejectionThreshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("optimistic rejection threshold too large: %s", optimisticRejectionThreshold)
+	}
+
+	return validateVotingPowerProviders(ac, p.VotingPowerProviders)
+}
+
+// validateAddresses checks the addresses are valid and unique.
+func validateAddresses(ac address.Codec, name string, addrs []string) error {
+	seen := make(map[string]bool, len(addrs))
+	for _, addr := range addrs {
+		if _, err := ac.StringToBytes(addr); err != nil {
+			return fmt.Errorf("invalid %s address: %s", name, addr)
+		}
+		if seen[addr] {
+			return fmt.Errorf("duplicate %s: %s", name, addr)
+		}
+		seen[addr] = true
+	}
+
 	return nil
 }
 
@@ -248,3 +341,56 @@ func (p Params) IsLowThresholdFunction(fid string) bool {
 
 	return false
 }
+
+// IsEmergencySubmitter returns true if the account is allowed to activate the emergency
+// proposal with the emergency deposit. Anyone is allowed if the allowlist is empty.
+func (p Params) IsEmergencySubmitter(addr string) bool {
+	if len(p.EmergencySubmitters) == 0 {
+		return true
+	}
+
+	for _, submitter := range p.EmergencySubmitters {
+		if addr == submitter {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsExecutionCanceller returns true if the account is allowed to cancel the queued execution
+// of the passed proposals.
+func (p Params) IsExecutionCanceller(addr string) bool {
+	for _, canceller := range p.ExecutionCancellers {
+		if addr == canceller {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsOptimisticMsgType returns true if the message type is eligible for the optimistic proposals.
+func (p Params) IsOptimisticMsgType(msgTypeURL string) bool {
+	for _, msgType := range p.OptimisticMsgTypes {
+		if msgTypeURL == msgType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetExecutionDelay returns the longest execution delay of the given message type urls.
+func (p Params) GetExecutionDelay(msgTypeURLs ...string) time.Duration {
+	var delay time.Duration
+	for _, msgTypeURL := range msgTypeURLs {
+		for _, executionDelay := range p.ExecutionDelays {
+			if executionDelay.MsgTypeUrl == msgTypeURL && executionDelay.Delay > delay {
+				delay = executionDelay.Delay
+			}
+		}
+	}
+
+	return delay
+}
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
@@ -93,6 +94,215 @@ func Test_Params(t *testing.T) {
 
 	params.VotingPeriod = _votingPeriod
 	require.NoError(t, params.Validate(ac))
+
+	provider := types.VotingPowerProvider{
+		Name:         "foo",
+		ModuleAddr:   sdk.AccAddress(make([]byte, 32)).String(),
+		ModuleName:   "account",
+		FunctionName: "get_account_number",
+		Weight:       "0.5",
+		GasLimit:     100_000,
+	}
+	params.VotingPowerProviders = []types.VotingPowerProvider{provider}
+	require.NoError(t, params.Validate(ac))
+
+	invalidProvider := provider
+	invalidProvider.Name = ""
+	params.VotingPowerProviders = []types.VotingPowerProvider{invalidProvider}
+	require.Error(t, params.Validate(ac))
+
+	invalidProvider = provider
+	invalidProvider.Weight = "0"
+	params.VotingPowerProviders = []types.VotingPowerProvider{invalidProvider}
+	require.Error(t, params.Validate(ac))
+
+	invalidProvider = provider
+	invalidProvider.GasLimit = 0
+	params.VotingPowerProviders = []types.VotingPowerProvider{invalidProvider}
+	require.Error(t, params.Validate(ac))
+
+	params.VotingPowerProviders = []types.VotingPowerProvider{provider, provider}
+	require.Error(t, params.Validate(ac))
+
+	params.VotingPowerProviders = nil
+	require.NoError(t, params.Validate(ac))
+
+	submitter := sdk.AccAddress(make([]byte, 20)).String()
+	params.EmergencySubmitters = []string{submitter}
+	require.NoError(t, params.Validate(ac))
+	params.EmergencySubmitters = []string{"foo"}
+	require.Error(t, params.Validate(ac))
+	params.EmergencySubmitters = []string{submitter, submitter}
+	require.Error(t, params.Validate(ac))
+
+	params.EmergencySubmitters = nil
+
+	params.EmergencyEscalationWindow = time.Hour
+	require.NoError(t, params.Validate(ac))
+	params.EmergencyEscalationThreshold = "0"
+	require.Error(t, params.Validate(ac))
+	params.EmergencyEscalationThreshold = "1.1"
+	require.Error(t, params.Validate(ac))
+
+	// the threshold is not validated when the escalation is disabled
+	params.EmergencyEscalationWindow = 0
+	require.NoError(t, params.Validate(ac))
+
+	delay := types.ExecutionDelay{MsgTypeUrl: "/initia.gov.v1.MsgUpdateParams", Delay: time.Hour}
+	params.ExecutionDelays = []types.ExecutionDelay{delay}
+	require.NoError(t, params.Validate(ac))
+	params.ExecutionDelays = []types.ExecutionDelay{delay, delay}
+	require.Error(t, params.Validate(ac))
+	params.ExecutionDelays = []types.ExecutionDelay{{MsgTypeUrl: "", Delay: time.Hour}}
+	require.Error(t, params.Validate(ac))
+	params.ExecutionDelays = []types.ExecutionDelay{{MsgTypeUrl: delay.MsgTypeUrl, Delay: 0}}
+	require.Error(t, params.Validate(ac))
+
+	params.ExecutionDelays = nil
+
+	params.ExecutionCancellers = []string{submitter}
+	require.NoError(t, params.Validate(ac))
+	params.ExecutionCancellers = []string{"foo"}
+	require.Error(t, params.Validate(ac))
+
+	params.ExecutionCancellers = nil
+	require.NoError(t, params.Validate(ac))
+
+	votingPeriod := params.ExpeditedVotingPeriod + time.Hour
+	msgTypeParams := types.MsgTypeParams{
+		MsgTypeUrl:    "/initia.gov.v1.MsgUpdateParams",
+		Quorum:        "0.5",
+		Threshold:     "0.667",
+		VetoThreshold: "0.2",
+		MinDeposit:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100))),
+		VotingPeriod:  &votingPeriod,
+	}
+	params.MsgTypeParams = []types.MsgTypeParams{msgTypeParams, {MsgTypeUrl: types.MsgTypeParamsWildcard}}
+	require.NoError(t, params.Validate(ac))
+	params.MsgTypeParams = []types.MsgTypeParams{msgTypeParams, msgTypeParams}
+	require.Error(t, params.Validate(ac))
+	params.MsgTypeParams = []types.MsgTypeParams{{MsgTypeUrl: ""}}
+	require.Error(t, params.Validate(ac))
+	params.MsgTypeParams = []types.MsgTypeParams{{MsgTypeUrl: "foo", Quorum: "1.1"}}
+	require.Error(t, params.Validate(ac))
+	params.MsgTypeParams = []types.MsgTypeParams{{MsgTypeUrl: "foo", Threshold: "0"}}
+	require.Error(t, params.Validate(ac))
+	params.MsgTypeParams = []types.MsgTypeParams{{MsgTypeUrl: "foo", VetoThreshold: "bar"}}
+	require.Error(t, params.Validate(ac))
+	params.MsgTypeParams = []types.MsgTypeParams{{MsgTypeUrl: "foo", MinDeposit: sdk.NewCoins(sdk.NewCoin("bar", math.NewInt(100)))}}
+	require.Error(t, params.Validate(ac))
+	zeroPeriod := time.Duration(0)
+	params.MsgTypeParams = []types.MsgTypeParams{{MsgTypeUrl: "foo", VotingPeriod: &zeroPeriod}}
+	require.Error(t, params.Validate(ac))
+	expeditedPeriod := params.ExpeditedVotingPeriod
+	params.MsgTypeParams = []types.MsgTypeParams{{MsgTypeUrl: "foo", VotingPeriod: &expeditedPeriod}}
+	require.Error(t, params.Validate(ac))
+
+	params.MsgTypeParams = nil
+	require.NoError(t, params.Validate(ac))
+
+	params.OptimisticMsgTypes = []string{"foo"}
+	require.NoError(t, params.Validate(ac))
+	params.OptimisticMsgTypes = []string{"foo", "foo"}
+	require.Error(t, params.Validate(ac))
+	params.OptimisticMsgTypes = []string{""}
+	require.Error(t, params.Validate(ac))
+
+	params.OptimisticMsgTypes = []string{"foo"}
+	params.OptimisticRejectionThreshold = "0"
+	require.Error(t, params.Validate(ac))
+	params.OptimisticRejectionThreshold = "1.1"
+	require.Error(t, params.Validate(ac))
+
+	// the threshold is validated even when the optimistic proposals are disabled
+	params.OptimisticMsgTypes = nil
+	require.Error(t, params.Validate(ac))
+	params.OptimisticRejectionThreshold = ""
+	require.Error(t, params.Validate(ac))
+	params.OptimisticRejectionThreshold = types.DefaultOptimisticRejectionThreshold.String()
+	require.NoError(t, params.Validate(ac))
+}
+
+func Test_Params_GetProposalParams(t *testing.T) {
+	params := types.DefaultParams()
+	global := params.GetProposalParams()
+	require.Equal(t, types.DefaultQuorum, global.Quorum)
+	require.Equal(t, types.DefaultThreshold, global.Threshold)
+	require.Equal(t, types.DefaultVetoThreshold, global.VetoThreshold)
+	require.Equal(t, sdk.Coins(params.MinDeposit), global.MinDeposit)
+	require.Equal(t, params.VotingPeriod, global.VotingPeriod)
+	require.Equal(t, global, params.GetProposalParams("foo"))
+
+	longPeriod := params.VotingPeriod * 2
+	params.MsgTypeParams = []types.MsgTypeParams{
+		{
+			MsgTypeUrl: "foo",
+			Quorum:     "0.5",
+			MinDeposit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, types.DefaultMinDepositTokens.MulRaw(2))),
+		},
+		{
+			MsgTypeUrl:    "bar",
+			Threshold:     "0.9",
+			VetoThreshold: "0.1",
+			VotingPeriod:  &longPeriod,
+		},
+	}
+
+	// the empty fields are inherited from the global params
+	fooParams := params.GetProposalParams("foo")
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), fooParams.Quorum)
+	require.Equal(t, types.DefaultThreshold, fooParams.Threshold)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, types.DefaultMinDepositTokens.MulRaw(2))), fooParams.MinDeposit)
+	require.Equal(t, params.VotingPeriod, fooParams.VotingPeriod)
+
+	// the strictest params are applied to the mixed messages
+	mixedParams := params.GetProposalParams("foo", "bar", "baz")
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), mixedParams.Quorum)
+	require.Equal(t, math.LegacyNewDecWithPrec(9, 1), mixedParams.Threshold)
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 1), mixedParams.VetoThreshold)
+	require.Equal(t, fooParams.MinDeposit, mixedParams.MinDeposit)
+	require.Equal(t, longPeriod, mixedParams.VotingPeriod)
+
+	// the wildcard params are applied to the messages without their own params
+	params.MsgTypeParams = append(params.MsgTypeParams, types.MsgTypeParams{MsgTypeUrl: types.MsgTypeParamsWildcard, Quorum: "0.6"})
+	require.Equal(t, math.LegacyNewDecWithPrec(6, 1), params.GetProposalParams("baz").Quorum)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), params.GetProposalParams("foo").Quorum)
+	require.Equal(t, math.LegacyNewDecWithPrec(6, 1), params.GetProposalParams("foo", "baz").Quorum)
+
+	// the expedited min deposit is applied if higher
+	require.Equal(t, sdk.Coins(params.ExpeditedMinDeposit), params.GetProposalMinDeposit(true, "foo"))
+	require.Equal(t, fooParams.MinDeposit, params.GetProposalMinDeposit(false, "foo"))
+}
+
+func Test_Params_GetExecutionDelay(t *testing.T) {
+	params := types.DefaultParams()
+	require.Zero(t, params.GetExecutionDelay("foo"))
+
+	params.ExecutionDelays = []types.ExecutionDelay{
+		{MsgTypeUrl: "foo", Delay: time.Hour},
+		{MsgTypeUrl: "bar", Delay: time.Hour * 2},
+	}
+	require.Equal(t, time.Hour, params.GetExecutionDelay("foo"))
+	require.Equal(t, time.Hour*2, params.GetExecutionDelay("foo", "bar", "baz"))
+	require.Zero(t, params.GetExecutionDelay())
+}
+
+func Test_Params_IsEmergencySubmitter(t *testing.T) {
+	params := types.DefaultParams()
+	require.True(t, params.IsEmergencySubmitter("foo"))
+
+	params.EmergencySubmitters = []string{"bar"}
+	require.False(t, params.IsEmergencySubmitter("foo"))
+	require.True(t, params.IsEmergencySubmitter("bar"))
+}
+
+func Test_Params_IsOptimisticMsgType(t *testing.T) {
+	params := types.DefaultParams()
+	require.False(t, params.IsOptimisticMsgType("foo"))
+
+	params.OptimisticMsgTypes = []string{"foo"}
+	require.True(t, params.IsOptimisticMsgType("foo"))
+	require.False(t, params.IsOptimisticMsgType("bar"))
 }
 
 func Test_Params_IsLowThresholdFunction(t *testing.T) {
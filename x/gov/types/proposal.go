@@ -42,14 +42,21 @@ func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
 	return sdktx.GetMsgs(p.Messages, "sdk.MsgProposal")
 }
 
+// MsgTypeURLs returns the type urls of the proposal messages
+func (p Proposal) MsgTypeURLs() []string {
+	msgTypeURLs := make([]string, len(p.Messages))
+	for i, msg := range p.Messages {
+		msgTypeURLs[i] = msg.TypeUrl
+	}
+	return msgTypeURLs
+}
+
 // GetMinDepositFromParams returns min expedited deposit from the gov params if
 // the proposal is expedited. Otherwise, returns the regular min deposit from
-// gov params.
+// gov params. The min deposit overridden by the msg type params is applied if
+// it is higher.
 func (p Proposal) GetMinDepositFromParams(params Params) sdk.Coins {
-	if p.Expedited {
-		return params.ExpeditedMinDeposit
-	}
-	return params.MinDeposit
+	return params.GetProposalMinDeposit(p.Expedited, p.MsgTypeURLs()...)
 }
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const (
+	// MaxSimulateProposalMsgs is the maximum number of messages in a proposal simulation.
+	MaxSimulateProposalMsgs = 32
+
+	// MaxSimulateProposalGas is the gas limit shared by the messages of a proposal simulation.
+	MaxSimulateProposalGas = 100_000_000
+)
+
+var _ types.UnpackInterfacesMessage = QuerySimulateProposalRequest{}
+
+// NewQuerySimulateProposalRequest creates a new QuerySimulateProposalRequest instance
+func NewQuerySimulateProposalRequest(messages []sdk.Msg) (*QuerySimulateProposalRequest, error) {
+	msgs, err := sdktx.SetMsgs(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QuerySimulateProposalRequest{Messages: msgs}, nil
+}
+
+// GetMsgs returns the messages to be simulated
+func (req QuerySimulateProposalRequest) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(req.Messages, "sdk.MsgProposal")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (req QuerySimulateProposalRequest) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, req.Messages)
+}
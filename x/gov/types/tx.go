@@ -0,0 +1,36 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var _ types.UnpackInterfacesMessage = MsgSubmitOptimisticProposal{}
+
+// NewMsgSubmitOptimisticProposal creates a new MsgSubmitOptimisticProposal instance
+func NewMsgSubmitOptimisticProposal(messages []sdk.Msg, initialDeposit sdk.Coins, proposer, metadata, title, summary string) (*MsgSubmitOptimisticProposal, error) {
+	msgs, err := sdktx.SetMsgs(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSubmitOptimisticProposal{
+		Messages:       msgs,
+		InitialDeposit: initialDeposit,
+		Proposer:       proposer,
+		Metadata:       metadata,
+		Title:          title,
+		Summary:        summary,
+	}, nil
+}
+
+// GetMsgs returns the proposal messages
+func (msg MsgSubmitOptimisticProposal) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(msg.Messages, "sdk.MsgProposal")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgSubmitOptimisticProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, msg.Messages)
+}
@@ -7,6 +7,9 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -126,277 +129,2889 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "initia.gov.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "initia.gov.v1.MsgUpdateParamsResponse")
+// MsgDelegateVote is the Msg/DelegateVote request type.
+type MsgDelegateVote struct {
+	// delegator is the address of the account delegating its voting power.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegate is the address of the account receiving the voting power.
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// msg_type_url optionally scopes the delegation to the proposals whose
+	// messages are all of the given type.
+	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
 }
 
-func init() { proto.RegisterFile("initia/gov/v1/tx.proto", fileDescriptor_baa84aaddaa44003) }
-
-var fileDescriptor_baa84aaddaa44003 = []byte{
-	// 348 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcb, 0xcc, 0xcb, 0x2c,
-	0xc9, 0x4c, 0xd4, 0x4f, 0xcf, 0x2f, 0xd3, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0xe2, 0x85, 0x88, 0xeb, 0xa5, 0xe7, 0x97, 0xe9, 0x95, 0x19, 0x4a, 0x09, 0x26,
-	0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x0a, 0x29, 0xf1, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc,
-	0x62, 0xfd, 0xdc, 0xe2, 0x74, 0x90, 0xce, 0xdc, 0xe2, 0x74, 0xa8, 0x84, 0x24, 0x44, 0x22, 0x1e,
-	0xcc, 0xd3, 0x87, 0x70, 0xa0, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x10, 0x71, 0x10, 0x0b, 0x66,
-	0x12, 0xaa, 0x1b, 0x40, 0x56, 0x82, 0x25, 0x94, 0x56, 0x33, 0x72, 0xf1, 0xfb, 0x16, 0xa7, 0x87,
-	0x16, 0xa4, 0x24, 0x96, 0xa4, 0x06, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x0b, 0x99, 0x71, 0x71, 0x26,
-	0x96, 0x96, 0x64, 0xe4, 0x17, 0x65, 0x96, 0x54, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x3a, 0x49,
-	0x5c, 0xda, 0xa2, 0x2b, 0x02, 0xb5, 0xc7, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x38, 0xb8, 0xa4,
-	0x28, 0x33, 0x2f, 0x3d, 0x08, 0xa1, 0x54, 0xc8, 0x82, 0x8b, 0xad, 0x00, 0x6c, 0x82, 0x04, 0x93,
-	0x02, 0xa3, 0x06, 0xb7, 0x91, 0xa8, 0x1e, 0x8a, 0x0f, 0xf5, 0x20, 0xc6, 0x3b, 0x71, 0x9e, 0xb8,
-	0x27, 0xcf, 0xb0, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0xa8, 0x7a, 0x2b, 0xb5, 0xa6, 0xe7, 0x1b,
-	0xb4, 0x10, 0x26, 0x75, 0x3d, 0xdf, 0xa0, 0x25, 0x0c, 0x72, 0x2a, 0x9a, 0xcb, 0x94, 0x24, 0xb9,
-	0xc4, 0xd1, 0x84, 0x82, 0x52, 0x8b, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x8d, 0x52, 0xb8, 0x98, 0x7d,
-	0x8b, 0xd3, 0x85, 0xc2, 0xb8, 0x78, 0x50, 0xfc, 0x22, 0x87, 0xe6, 0x06, 0x34, 0xed, 0x52, 0x6a,
-	0xf8, 0xe5, 0x61, 0xc6, 0x4b, 0xb1, 0x36, 0x80, 0x1c, 0xec, 0xe4, 0xbe, 0xe2, 0x91, 0x1c, 0xe3,
-	0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c,
-	0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x69, 0xa6, 0x67, 0x96, 0x64, 0x94,
-	0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0x8c, 0xd5, 0xcd, 0x49, 0x4c, 0x2a, 0x86, 0xb2, 0xf5,
-	0x2b, 0xc0, 0xc1, 0x5f, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x7e, 0x63, 0x40, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xc6, 0xc6, 0x84, 0xe9, 0x1d, 0x02, 0x00, 0x00,
+func (m *MsgDelegateVote) Reset()         { *m = MsgDelegateVote{} }
+func (m *MsgDelegateVote) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVote) ProtoMessage()    {}
+func (*MsgDelegateVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baa84aaddaa44003, []int{2}
 }
-
-func (this *MsgUpdateParams) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*MsgUpdateParams)
-	if !ok {
-		that2, ok := that.(MsgUpdateParams)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *MsgDelegateVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Authority != that1.Authority {
-		return false
+}
+func (m *MsgDelegateVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVote.Merge(m, src)
+}
+func (m *MsgDelegateVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateVote proto.InternalMessageInfo
+
+func (m *MsgDelegateVote) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	if !this.Params.Equal(&that1.Params) {
-		return false
+	return ""
+}
+
+func (m *MsgDelegateVote) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
 	}
-	return true
+	return ""
 }
-func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MsgDelegateVote) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
+	return ""
+}
 
-	that1, ok := that.(*MsgUpdateParamsResponse)
-	if !ok {
-		that2, ok := that.(MsgUpdateParamsResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// MsgDelegateVoteResponse defines the Msg/DelegateVote response type.
+type MsgDelegateVoteResponse struct {
+}
+
+func (m *MsgDelegateVoteResponse) Reset()         { *m = MsgDelegateVoteResponse{} }
+func (m *MsgDelegateVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVoteResponse) ProtoMessage()    {}
+func (*MsgDelegateVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baa84aaddaa44003, []int{3}
+}
+func (m *MsgDelegateVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	return true
+}
+func (m *MsgDelegateVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVoteResponse.Merge(m, src)
+}
+func (m *MsgDelegateVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVoteResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgDelegateVoteResponse proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a governance operation for updating the x/gov
-	// module parameters. The authority is defined in the keeper.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+// MsgUndelegateVote is the Msg/UndelegateVote request type.
+type MsgUndelegateVote struct {
+	// delegator is the address of the account revoking the delegation.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// msg_type_url is the scope of the delegation to revoke.
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUndelegateVote) Reset()         { *m = MsgUndelegateVote{} }
+func (m *MsgUndelegateVote) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateVote) ProtoMessage()    {}
+func (*MsgUndelegateVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baa84aaddaa44003, []int{4}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgUndelegateVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateVote.Merge(m, src)
+}
+func (m *MsgUndelegateVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateVote.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/initia.gov.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUndelegateVote proto.InternalMessageInfo
+
+func (m *MsgUndelegateVote) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a governance operation for updating the x/gov
-	// module parameters. The authority is defined in the keeper.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgUndelegateVote) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgUndelegateVoteResponse defines the Msg/UndelegateVote response type.
+type MsgUndelegateVoteResponse struct {
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgUndelegateVoteResponse) Reset()         { *m = MsgUndelegateVoteResponse{} }
+func (m *MsgUndelegateVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateVoteResponse) ProtoMessage()    {}
+func (*MsgUndelegateVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baa84aaddaa44003, []int{5}
+}
+func (m *MsgUndelegateVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateVoteResponse.Merge(m, src)
+}
+func (m *MsgUndelegateVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateVoteResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgUndelegateVoteResponse proto.InternalMessageInfo
+
+// MsgCancelQueuedExecution is the Msg/CancelQueuedExecution request type.
+type MsgCancelQueuedExecution struct {
+	// authority is the gov module account or one of the execution cancellers.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// proposal_id is the id of the proposal whose execution is cancelled.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// reason is the reason of the cancellation.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgCancelQueuedExecution) Reset()         { *m = MsgCancelQueuedExecution{} }
+func (m *MsgCancelQueuedExecution) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelQueuedExecution) ProtoMessage()    {}
+func (*MsgCancelQueuedExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baa84aaddaa44003, []int{6}
+}
+func (m *MsgCancelQueuedExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelQueuedExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelQueuedExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+}
+func (m *MsgCancelQueuedExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelQueuedExecution.Merge(m, src)
+}
+func (m *MsgCancelQueuedExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelQueuedExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelQueuedExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelQueuedExecution proto.InternalMessageInfo
+
+func (m *MsgCancelQueuedExecution) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.gov.v1.Msg/UpdateParams",
+	return ""
+}
+
+func (m *MsgCancelQueuedExecution) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	return 0
+}
+
+func (m *MsgCancelQueuedExecution) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "initia.gov.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "initia/gov/v1/tx.proto",
+// MsgCancelQueuedExecutionResponse defines the Msg/CancelQueuedExecution
+// response type.
+type MsgCancelQueuedExecutionResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelQueuedExecutionResponse) Reset()         { *m = MsgCancelQueuedExecutionResponse{} }
+func (m *MsgCancelQueuedExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelQueuedExecutionResponse) ProtoMessage()    {}
+func (*MsgCancelQueuedExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baa84aaddaa44003, []int{7}
+}
+func (m *MsgCancelQueuedExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelQueuedExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelQueuedExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelQueuedExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelQueuedExecutionResponse.Merge(m, src)
+}
+func (m *MsgCancelQueuedExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelQueuedExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelQueuedExecutionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelQueuedExecutionResponse proto.InternalMessageInfo
+
+// MsgSubmitOptimisticProposal is the Msg/SubmitOptimisticProposal request type.
+type MsgSubmitOptimisticProposal struct {
+	// messages are the arbitrary messages to be executed if the proposal passes.
+	// All of them must be the types eligible for the optimistic proposals.
+	Messages []*types.Any `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	// initial_deposit is the deposit value that must be paid at proposal submission.
+	InitialDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=initial_deposit,json=initialDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"initial_deposit"`
+	// proposer is the account address of the proposer.
+	Proposer string `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// metadata is any arbitrary metadata attached to the proposal.
+	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// title is the title of the proposal.
+	Title string `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	// summary is the summary of the proposal
+	Summary string `protobuf:"bytes,6,opt,name=summary,proto3" json:"summary,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSubmitOptimisticProposal) Reset()         { *m = MsgSubmitOptimisticProposal{} }
+func (m *MsgSubmitOptimisticProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitOptimisticProposal) ProtoMessage()    {}
+func (*MsgSubmitOptimisticProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baa84aaddaa44003, []int{8}
+}
+func (m *MsgSubmitOptimisticProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitOptimisticProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitOptimisticProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitOptimisticProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitOptimisticProposal.Merge(m, src)
+}
+func (m *MsgSubmitOptimisticProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitOptimisticProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitOptimisticProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitOptimisticProposal proto.InternalMessageInfo
+
+func (m *MsgSubmitOptimisticProposal) GetMessages() []*types.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *MsgSubmitOptimisticProposal) GetInitialDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.InitialDeposit
+	}
+	return nil
+}
+
+func (m *MsgSubmitOptimisticProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *MsgSubmitOptimisticProposal) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *MsgSubmitOptimisticProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *MsgSubmitOptimisticProposal) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
+}
+
+// MsgSubmitOptimisticProposalResponse defines the Msg/SubmitOptimisticProposal
+// response type.
+type MsgSubmitOptimisticProposalResponse struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgSubmitOptimisticProposalResponse) Reset()         { *m = MsgSubmitOptimisticProposalResponse{} }
+func (m *MsgSubmitOptimisticProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitOptimisticProposalResponse) ProtoMessage()    {}
+func (*MsgSubmitOptimisticProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baa84aaddaa44003, []int{9}
+}
+func (m *MsgSubmitOptimisticProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitOptimisticProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitOptimisticProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitOptimisticProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitOptimisticProposalResponse.Merge(m, src)
+}
+func (m *MsgSubmitOptimisticProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitOptimisticProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitOptimisticProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitOptimisticProposalResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitOptimisticProposalResponse) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// MsgUpdateMoveHook is the Msg/UpdateMoveHook request type.
+type MsgUpdateMoveHook struct {
+	// authority is the address that controls the module
+	// (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// hook is the Move hook to be registered or removed. A module has at most
+	// one hook, which is replaced on the registration.
+	Hook MoveHook `protobuf:"bytes,2,opt,name=hook,proto3" json:"hook"`
+	// allowed is the flag whether the hook is registered or removed.
+	Allowed bool `protobuf:"varint,3,opt,name=allowed,proto3" json:"allowed,omitempty"`
+}
+
+func (m *MsgUpdateMoveHook) Reset()         { *m = MsgUpdateMoveHook{} }
+func (m *MsgUpdateMoveHook) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMoveHook) ProtoMessage()    {}
+func (*MsgUpdateMoveHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baa84aaddaa44003, []int{10}
+}
+func (m *MsgUpdateMoveHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMoveHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMoveHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMoveHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMoveHook.Merge(m, src)
+}
+func (m *MsgUpdateMoveHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMoveHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMoveHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMoveHook proto.InternalMessageInfo
+
+func (m *MsgUpdateMoveHook) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateMoveHook) GetHook() MoveHook {
+	if m != nil {
+		return m.Hook
+	}
+	return MoveHook{}
+}
+
+func (m *MsgUpdateMoveHook) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+// MsgUpdateMoveHookResponse defines the Msg/UpdateMoveHook response type.
+type MsgUpdateMoveHookResponse struct {
+}
+
+func (m *MsgUpdateMoveHookResponse) Reset()         { *m = MsgUpdateMoveHookResponse{} }
+func (m *MsgUpdateMoveHookResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMoveHookResponse) ProtoMessage()    {}
+func (*MsgUpdateMoveHookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baa84aaddaa44003, []int{11}
+}
+func (m *MsgUpdateMoveHookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMoveHookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMoveHookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMoveHookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMoveHookResponse.Merge(m, src)
+}
+func (m *MsgUpdateMoveHookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMoveHookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMoveHookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMoveHookResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "initia.gov.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "initia.gov.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgDelegateVote)(nil), "initia.gov.v1.MsgDelegateVote")
+	proto.RegisterType((*MsgDelegateVoteResponse)(nil), "initia.gov.v1.MsgDelegateVoteResponse")
+	proto.RegisterType((*MsgUndelegateVote)(nil), "initia.gov.v1.MsgUndelegateVote")
+	proto.RegisterType((*MsgUndelegateVoteResponse)(nil), "initia.gov.v1.MsgUndelegateVoteResponse")
+	proto.RegisterType((*MsgCancelQueuedExecution)(nil), "initia.gov.v1.MsgCancelQueuedExecution")
+	proto.RegisterType((*MsgCancelQueuedExecutionResponse)(nil), "initia.gov.v1.MsgCancelQueuedExecutionResponse")
+	proto.RegisterType((*MsgSubmitOptimisticProposal)(nil), "initia.gov.v1.MsgSubmitOptimisticProposal")
+	proto.RegisterType((*MsgSubmitOptimisticProposalResponse)(nil), "initia.gov.v1.MsgSubmitOptimisticProposalResponse")
+	proto.RegisterType((*MsgUpdateMoveHook)(nil), "initia.gov.v1.MsgUpdateMoveHook")
+	proto.RegisterType((*MsgUpdateMoveHookResponse)(nil), "initia.gov.v1.MsgUpdateMoveHookResponse")
+}
+
+func init() { proto.RegisterFile("initia/gov/v1/tx.proto", fileDescriptor_baa84aaddaa44003) }
+
+var fileDescriptor_baa84aaddaa44003 = []byte{
+	// 910 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0xae, 0xd3, 0x0f, 0xda, 0x69, 0xd9, 0xd5, 0x9a, 0x76, 0xeb, 0x7a, 0x91, 0x13, 0x19, 0xa9,
+	0x84, 0x48, 0xb5, 0x49, 0x80, 0x15, 0xea, 0x6d, 0xb3, 0xcb, 0xd7, 0x21, 0x62, 0xc9, 0xb2, 0x3d,
+	0x20, 0xa4, 0x68, 0x12, 0x0f, 0xd3, 0x51, 0x6d, 0x8f, 0xf1, 0x8c, 0x43, 0xc3, 0x09, 0x71, 0xe4,
+	0xc4, 0x8d, 0xbf, 0xb0, 0x82, 0x4b, 0x0f, 0x1c, 0xb9, 0xb3, 0xdc, 0x56, 0x9c, 0x38, 0x01, 0x4a,
+	0x0f, 0xf9, 0x19, 0xa0, 0x19, 0x8f, 0xbd, 0xb1, 0x93, 0x34, 0xab, 0x6a, 0x2f, 0xad, 0xdf, 0x79,
+	0x9f, 0x79, 0xe7, 0x79, 0xbf, 0x1e, 0x05, 0xdc, 0x26, 0x21, 0xe1, 0x04, 0xba, 0x98, 0x0e, 0xdd,
+	0x61, 0xd3, 0xe5, 0xe7, 0x4e, 0x14, 0x53, 0x4e, 0xf5, 0x57, 0xd3, 0x73, 0x07, 0xd3, 0xa1, 0x33,
+	0x6c, 0x9a, 0xb7, 0x60, 0x40, 0x42, 0xea, 0xca, 0xbf, 0x29, 0xc2, 0xb4, 0x06, 0x94, 0x05, 0x94,
+	0xb9, 0x7d, 0xc8, 0x90, 0x3b, 0x6c, 0xf6, 0x11, 0x87, 0x4d, 0x77, 0x40, 0x49, 0xa8, 0xfc, 0xfb,
+	0xca, 0x1f, 0x30, 0x2c, 0x22, 0x07, 0x0c, 0x2b, 0xc7, 0x41, 0xea, 0xe8, 0x49, 0xcb, 0x4d, 0x0d,
+	0xe5, 0xda, 0xc5, 0x14, 0xd3, 0xf4, 0x5c, 0x7c, 0x65, 0x17, 0x30, 0xa5, 0xd8, 0x47, 0xae, 0xb4,
+	0xfa, 0xc9, 0x57, 0x2e, 0x0c, 0x47, 0xd9, 0x23, 0x45, 0xfa, 0x82, 0xad, 0x74, 0xd8, 0xbf, 0x68,
+	0xe0, 0x66, 0x87, 0xe1, 0xc7, 0x91, 0x07, 0x39, 0x7a, 0x08, 0x63, 0x18, 0x30, 0xfd, 0x2e, 0xd8,
+	0x82, 0x09, 0x3f, 0xa5, 0x31, 0xe1, 0x23, 0x43, 0xab, 0x69, 0xf5, 0xad, 0xb6, 0xf1, 0xe7, 0xaf,
+	0x47, 0xbb, 0x8a, 0xc2, 0x3d, 0xcf, 0x8b, 0x11, 0x63, 0x8f, 0x78, 0x4c, 0x42, 0xdc, 0x7d, 0x0e,
+	0xd5, 0xdf, 0x07, 0x1b, 0x91, 0x8c, 0x60, 0x54, 0x6a, 0x5a, 0x7d, 0xbb, 0xb5, 0xe7, 0x14, 0x8a,
+	0xe3, 0xa4, 0xe1, 0xdb, 0x5b, 0x4f, 0xff, 0xae, 0xae, 0x3c, 0x99, 0x5c, 0x34, 0xb4, 0xae, 0xc2,
+	0x1f, 0x1f, 0x7e, 0x3f, 0xb9, 0x68, 0x3c, 0x8f, 0xf4, 0xc3, 0xe4, 0xa2, 0xf1, 0x9a, 0xa0, 0x5a,
+	0x62, 0x66, 0x1f, 0x80, 0xfd, 0xd2, 0x51, 0x17, 0xb1, 0x88, 0x86, 0x0c, 0xd9, 0x7f, 0xa4, 0x89,
+	0x3c, 0x40, 0x3e, 0xc2, 0x90, 0xa3, 0x13, 0xca, 0x91, 0x48, 0xc4, 0x4b, 0x6d, 0x1a, 0x2f, 0x4f,
+	0x24, 0x87, 0xea, 0xef, 0x82, 0x4d, 0x65, 0x20, 0xa3, 0xb2, 0xe4, 0x5a, 0x8e, 0xd4, 0x6b, 0x60,
+	0x27, 0x60, 0xb8, 0xc7, 0x47, 0x11, 0xea, 0x25, 0xb1, 0x6f, 0xac, 0x8a, 0x9b, 0x5d, 0x10, 0x30,
+	0xfc, 0xf9, 0x28, 0x42, 0x8f, 0x63, 0x5f, 0xa5, 0x99, 0xbf, 0x33, 0x9d, 0xe6, 0x34, 0x6f, 0x95,
+	0xe6, 0xf4, 0x51, 0x9e, 0xe6, 0x4f, 0x1a, 0xb8, 0x25, 0x4a, 0x10, 0x7a, 0x2f, 0x23, 0xd1, 0x32,
+	0xe5, 0xca, 0x0c, 0xe5, 0xfa, 0x2c, 0xe5, 0xbd, 0xac, 0x33, 0x05, 0x0e, 0xf6, 0x1d, 0x70, 0x30,
+	0x73, 0x98, 0xd3, 0xfe, 0x4d, 0x03, 0x46, 0x87, 0xe1, 0xfb, 0x30, 0x1c, 0x20, 0xff, 0xb3, 0x04,
+	0x25, 0xc8, 0xfb, 0xe0, 0x1c, 0x0d, 0x12, 0x4e, 0x68, 0x78, 0xed, 0x79, 0xab, 0x82, 0xed, 0x28,
+	0xa6, 0x11, 0x65, 0xd0, 0xef, 0x11, 0x4f, 0x92, 0x5f, 0xeb, 0x82, 0xec, 0xe8, 0x13, 0x4f, 0xbf,
+	0x0d, 0x36, 0x62, 0x04, 0x19, 0x0d, 0x55, 0x2f, 0x94, 0x75, 0xec, 0xce, 0x8e, 0xdb, 0xeb, 0x2a,
+	0xa9, 0xb9, 0x0c, 0x6d, 0x1b, 0xd4, 0x16, 0xf9, 0xf2, 0x14, 0xff, 0xab, 0x80, 0x3b, 0x1d, 0x86,
+	0x1f, 0x25, 0xfd, 0x80, 0xf0, 0x4f, 0x23, 0x4e, 0x02, 0xc2, 0x38, 0x19, 0x3c, 0x54, 0x74, 0xf4,
+	0xb7, 0xc1, 0x66, 0x80, 0x18, 0x83, 0x18, 0x31, 0x43, 0xab, 0xad, 0xd6, 0xb7, 0x5b, 0xbb, 0x4e,
+	0xba, 0xb0, 0x4e, 0xb6, 0xb0, 0xce, 0xbd, 0x70, 0xd4, 0xcd, 0x51, 0xfa, 0x08, 0xdc, 0x4c, 0x17,
+	0xc8, 0xef, 0x79, 0x28, 0xa2, 0x8c, 0x70, 0xa3, 0x22, 0x2f, 0x1e, 0x38, 0xaa, 0x34, 0x42, 0x53,
+	0x1c, 0xa5, 0x29, 0xce, 0x7d, 0x4a, 0xc2, 0xf6, 0x7b, 0x62, 0xb9, 0x7e, 0xfe, 0xa7, 0x5a, 0xc7,
+	0x84, 0x9f, 0x26, 0x7d, 0x67, 0x40, 0x03, 0x25, 0x1d, 0xea, 0xdf, 0x11, 0xf3, 0xce, 0x5c, 0xd1,
+	0x70, 0x26, 0x2f, 0xb0, 0x74, 0x11, 0x6f, 0xa8, 0x87, 0x1e, 0xa4, 0xef, 0x88, 0x0d, 0x48, 0xeb,
+	0x88, 0x62, 0x63, 0x75, 0x49, 0x47, 0x72, 0xa4, 0x6e, 0x8a, 0x14, 0x39, 0xf4, 0x20, 0x87, 0xc6,
+	0x9a, 0xac, 0x78, 0x6e, 0xeb, 0xbb, 0x60, 0x9d, 0x13, 0xee, 0x23, 0x63, 0x5d, 0x3a, 0x52, 0x43,
+	0x37, 0xc0, 0x2b, 0x2c, 0x09, 0x02, 0x18, 0x8f, 0x8c, 0x0d, 0x79, 0x9e, 0x99, 0xc7, 0x4d, 0xd1,
+	0xa3, 0x3c, 0xb4, 0x68, 0x51, 0x55, 0xb5, 0x68, 0x51, 0x85, 0xed, 0x0f, 0xc1, 0x1b, 0x57, 0xb8,
+	0xb3, 0x46, 0x95, 0xc7, 0x46, 0x2b, 0x8f, 0x8d, 0xfd, 0xbb, 0xda, 0x31, 0x29, 0x33, 0x1d, 0x3a,
+	0x44, 0x1f, 0x53, 0x7a, 0x76, 0xed, 0x29, 0xbd, 0x0b, 0xd6, 0x4e, 0x29, 0x3d, 0x53, 0x9a, 0xb8,
+	0x5f, 0xd2, 0xc4, 0x2c, 0xfc, 0xb4, 0x2a, 0x4a, 0xbc, 0x28, 0x0d, 0xf4, 0x7d, 0xfa, 0x0d, 0xf2,
+	0x64, 0x07, 0x36, 0xbb, 0x99, 0xa9, 0x76, 0xb2, 0x30, 0xbe, 0x7b, 0x05, 0xb5, 0xcc, 0x82, 0x66,
+	0x3b, 0x59, 0x38, 0xcc, 0xea, 0xd0, 0x9a, 0xac, 0x81, 0xd5, 0x0e, 0xc3, 0xfa, 0x09, 0xd8, 0x29,
+	0xc8, 0xbf, 0x55, 0xa6, 0x58, 0x54, 0x5c, 0xf3, 0xf0, 0x6a, 0x7f, 0x5e, 0xe7, 0x13, 0xb0, 0x53,
+	0x50, 0xe3, 0x39, 0x71, 0xa7, 0xfd, 0xe6, 0xe1, 0xd5, 0xfe, 0x3c, 0xee, 0x97, 0xe0, 0x46, 0x49,
+	0xfe, 0x6a, 0x73, 0x18, 0x15, 0x10, 0x66, 0x7d, 0x19, 0x22, 0x8f, 0xfe, 0x35, 0xd8, 0x9b, 0xaf,
+	0x52, 0x6f, 0xce, 0x86, 0x98, 0x0b, 0x34, 0xdd, 0x17, 0x04, 0xe6, 0x4f, 0x7e, 0x0b, 0x8c, 0x85,
+	0xaa, 0xd1, 0x98, 0x0d, 0xb6, 0x08, 0x6b, 0xb6, 0x5e, 0x1c, 0x5b, 0x28, 0x66, 0x71, 0xce, 0x6b,
+	0x8b, 0xda, 0x9b, 0x21, 0xcc, 0xfa, 0x32, 0x44, 0x16, 0xdd, 0x5c, 0xff, 0x4e, 0x0c, 0x74, 0xfb,
+	0xa3, 0x27, 0x63, 0x4b, 0x7b, 0x3a, 0xb6, 0xb4, 0x67, 0x63, 0x4b, 0xfb, 0x77, 0x6c, 0x69, 0x3f,
+	0x5e, 0x5a, 0x2b, 0xcf, 0x2e, 0xad, 0x95, 0xbf, 0x2e, 0xad, 0x95, 0x2f, 0xde, 0x9a, 0x92, 0xaa,
+	0x34, 0xf0, 0x91, 0x0f, 0xfb, 0x4c, 0x7d, 0xbb, 0xe7, 0xf2, 0x47, 0x8b, 0x54, 0xac, 0xfe, 0x86,
+	0x54, 0xca, 0x77, 0xfe, 0x1f, 0x00, 0x6b, 0x06, 0x0c, 0x16, 0x8e, 0x09, 0x00, 0x00,
+}
+
+func (this *MsgUpdateParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParams)
+	if !ok {
+		that2, ok := that.(MsgUpdateParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParamsResponse)
+	if !ok {
+		that2, ok := that.(MsgUpdateParamsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgDelegateVote) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgDelegateVote)
+	if !ok {
+		that2, ok := that.(MsgDelegateVote)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Delegator != that1.Delegator {
+		return false
+	}
+	if this.Delegate != that1.Delegate {
+		return false
+	}
+	if this.MsgTypeUrl != that1.MsgTypeUrl {
+		return false
+	}
+	return true
+}
+func (this *MsgDelegateVoteResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgDelegateVoteResponse)
+	if !ok {
+		that2, ok := that.(MsgDelegateVoteResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgUndelegateVote) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUndelegateVote)
+	if !ok {
+		that2, ok := that.(MsgUndelegateVote)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Delegator != that1.Delegator {
+		return false
+	}
+	if this.MsgTypeUrl != that1.MsgTypeUrl {
+		return false
+	}
+	return true
+}
+func (this *MsgUndelegateVoteResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUndelegateVoteResponse)
+	if !ok {
+		that2, ok := that.(MsgUndelegateVoteResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgCancelQueuedExecution) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCancelQueuedExecution)
+	if !ok {
+		that2, ok := that.(MsgCancelQueuedExecution)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if this.ProposalId != that1.ProposalId {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
+}
+func (this *MsgCancelQueuedExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCancelQueuedExecutionResponse)
+	if !ok {
+		that2, ok := that.(MsgCancelQueuedExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgSubmitOptimisticProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSubmitOptimisticProposal)
+	if !ok {
+		that2, ok := that.(MsgSubmitOptimisticProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Messages) != len(that1.Messages) {
+		return false
+	}
+	for i := range this.Messages {
+		if !this.Messages[i].Equal(that1.Messages[i]) {
+			return false
+		}
+	}
+	if len(this.InitialDeposit) != len(that1.InitialDeposit) {
+		return false
+	}
+	for i := range this.InitialDeposit {
+		if !this.InitialDeposit[i].Equal(&that1.InitialDeposit[i]) {
+			return false
+		}
+	}
+	if this.Proposer != that1.Proposer {
+		return false
+	}
+	if this.Metadata != that1.Metadata {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Summary != that1.Summary {
+		return false
+	}
+	return true
+}
+func (this *MsgSubmitOptimisticProposalResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSubmitOptimisticProposalResponse)
+	if !ok {
+		that2, ok := that.(MsgSubmitOptimisticProposalResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProposalId != that1.ProposalId {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateMoveHook) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateMoveHook)
+	if !ok {
+		that2, ok := that.(MsgUpdateMoveHook)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if !this.Hook.Equal(&that1.Hook) {
+		return false
+	}
+	if this.Allowed != that1.Allowed {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateMoveHookResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateMoveHookResponse)
+	if !ok {
+		that2, ok := that.(MsgUpdateMoveHookResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a governance operation for updating the x/gov
+	// module parameters. The authority is defined in the keeper.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// DelegateVote defines a method to delegate the voting power of an account
+	// to another account.
+	DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error)
+	// UndelegateVote defines a method to revoke a vote delegation.
+	UndelegateVote(ctx context.Context, in *MsgUndelegateVote, opts ...grpc.CallOption) (*MsgUndelegateVoteResponse, error)
+	// CancelQueuedExecution defines a method to cancel the queued execution of a
+	// passed proposal.
+	CancelQueuedExecution(ctx context.Context, in *MsgCancelQueuedExecution, opts ...grpc.CallOption) (*MsgCancelQueuedExecutionResponse, error)
+	// SubmitOptimisticProposal defines a method to submit an optimistic proposal,
+	// which passes unless it is rejected by the no and no with veto votes.
+	SubmitOptimisticProposal(ctx context.Context, in *MsgSubmitOptimisticProposal, opts ...grpc.CallOption) (*MsgSubmitOptimisticProposalResponse, error)
+	// UpdateMoveHook defines a governance operation for registering or removing
+	// a Move hook called on the proposal lifecycle events.
+	UpdateMoveHook(ctx context.Context, in *MsgUpdateMoveHook, opts ...grpc.CallOption) (*MsgUpdateMoveHookResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/initia.gov.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error) {
+	out := new(MsgDelegateVoteResponse)
+	err := c.cc.Invoke(ctx, "/initia.gov.v1.Msg/DelegateVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateVote(ctx context.Context, in *MsgUndelegateVote, opts ...grpc.CallOption) (*MsgUndelegateVoteResponse, error) {
+	out := new(MsgUndelegateVoteResponse)
+	err := c.cc.Invoke(ctx, "/initia.gov.v1.Msg/UndelegateVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelQueuedExecution(ctx context.Context, in *MsgCancelQueuedExecution, opts ...grpc.CallOption) (*MsgCancelQueuedExecutionResponse, error) {
+	out := new(MsgCancelQueuedExecutionResponse)
+	err := c.cc.Invoke(ctx, "/initia.gov.v1.Msg/CancelQueuedExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitOptimisticProposal(ctx context.Context, in *MsgSubmitOptimisticProposal, opts ...grpc.CallOption) (*MsgSubmitOptimisticProposalResponse, error) {
+	out := new(MsgSubmitOptimisticProposalResponse)
+	err := c.cc.Invoke(ctx, "/initia.gov.v1.Msg/SubmitOptimisticProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateMoveHook(ctx context.Context, in *MsgUpdateMoveHook, opts ...grpc.CallOption) (*MsgUpdateMoveHookResponse, error) {
+	out := new(MsgUpdateMoveHookResponse)
+	err := c.cc.Invoke(ctx, "/initia.gov.v1.Msg/UpdateMoveHook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a governance operation for updating the x/gov
+	// module parameters. The authority is defined in the keeper.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// DelegateVote defines a method to delegate the voting power of an account
+	// to another account.
+	DelegateVote(context.Context, *MsgDelegateVote) (*MsgDelegateVoteResponse, error)
+	// UndelegateVote defines a method to revoke a vote delegation.
+	UndelegateVote(context.Context, *MsgUndelegateVote) (*MsgUndelegateVoteResponse, error)
+	// CancelQueuedExecution defines a method to cancel the queued execution of a
+	// passed proposal.
+	CancelQueuedExecution(context.Context, *MsgCancelQueuedExecution) (*MsgCancelQueuedExecutionResponse, error)
+	// SubmitOptimisticProposal defines a method to submit an optimistic proposal,
+	// which passes unless it is rejected by the no and no with veto votes.
+	SubmitOptimisticProposal(context.Context, *MsgSubmitOptimisticProposal) (*MsgSubmitOptimisticProposalResponse, error)
+	// UpdateMoveHook defines a governance operation for registering or removing
+	// a Move hook called on the proposal lifecycle events.
+	UpdateMoveHook(context.Context, *MsgUpdateMoveHook) (*MsgUpdateMoveHookResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) DelegateVote(ctx context.Context, req *MsgDelegateVote) (*MsgDelegateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateVote not implemented")
+}
+func (*UnimplementedMsgServer) UndelegateVote(ctx context.Context, req *MsgUndelegateVote) (*MsgUndelegateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateVote not implemented")
+}
+func (*UnimplementedMsgServer) CancelQueuedExecution(ctx context.Context, req *MsgCancelQueuedExecution) (*MsgCancelQueuedExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelQueuedExecution not implemented")
+}
+func (*UnimplementedMsgServer) SubmitOptimisticProposal(ctx context.Context, req *MsgSubmitOptimisticProposal) (*MsgSubmitOptimisticProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitOptimisticProposal not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMoveHook(ctx context.Context, req *MsgUpdateMoveHook) (*MsgUpdateMoveHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMoveHook not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.gov.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.gov.v1.Msg/DelegateVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateVote(ctx, req.(*MsgDelegateVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.gov.v1.Msg/UndelegateVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateVote(ctx, req.(*MsgUndelegateVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelQueuedExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelQueuedExecution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelQueuedExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.gov.v1.Msg/CancelQueuedExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelQueuedExecution(ctx, req.(*MsgCancelQueuedExecution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitOptimisticProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitOptimisticProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitOptimisticProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.gov.v1.Msg/SubmitOptimisticProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitOptimisticProposal(ctx, req.(*MsgSubmitOptimisticProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMoveHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMoveHook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMoveHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.gov.v1.Msg/UpdateMoveHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMoveHook(ctx, req.(*MsgUpdateMoveHook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "initia.gov.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "DelegateVote",
+			Handler:    _Msg_DelegateVote_Handler,
+		},
+		{
+			MethodName: "UndelegateVote",
+			Handler:    _Msg_UndelegateVote_Handler,
+		},
+		{
+			MethodName: "CancelQueuedExecution",
+			Handler:    _Msg_CancelQueuedExecution_Handler,
+		},
+		{
+			MethodName: "SubmitOptimisticProposal",
+			Handler:    _Msg_SubmitOptimisticProposal_Handler,
+		},
+		{
+			MethodName: "UpdateMoveHook",
+			Handler:    _Msg_UpdateMoveHook_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "initia/gov/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelQueuedExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelQueuedExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelQueuedExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelQueuedExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelQueuedExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelQueuedExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitOptimisticProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitOptimisticProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitOptimisticProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InitialDeposit) > 0 {
+		for iNdEx := len(m.InitialDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitialDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitOptimisticProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitOptimisticProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitOptimisticProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMoveHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMoveHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMoveHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Hook.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMoveHookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMoveHookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMoveHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUndelegateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUndelegateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelQueuedExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelQueuedExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitOptimisticProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.InitialDeposit) > 0 {
+		for _, e := range m.InitialDeposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitOptimisticProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgUpdateMoveHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Hook.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Allowed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateMoveHookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelQueuedExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelQueuedExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelQueuedExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelQueuedExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelQueuedExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelQueuedExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitOptimisticProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitOptimisticProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitOptimisticProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialDeposit = append(m.InitialDeposit, types1.Coin{})
+			if err := m.InitialDeposit[len(m.InitialDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSubmitOptimisticProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitOptimisticProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitOptimisticProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMoveHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -419,10 +3034,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMoveHook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMoveHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -459,7 +3074,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -486,10 +3101,30 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Hook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -511,7 +3146,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMoveHookResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -534,10 +3169,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMoveHookResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMoveHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
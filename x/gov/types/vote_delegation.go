@@ -0,0 +1,85 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/core/address"
+)
+
+const (
+	// MaxVoteDelegationDepth is the maximum number of hops from a delegator to the voter
+	// whose vote the delegator inherits.
+	MaxVoteDelegationDepth = 8
+
+	// MaxVoteDelegatorsPerDelegate is the maximum number of vote delegations to a delegate.
+	MaxVoteDelegatorsPerDelegate = 1000
+)
+
+// Validate performs basic validation of the vote delegation.
+func (d VoteDelegation) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(d.Delegator); err != nil {
+		return err
+	}
+
+	if _, err := ac.StringToBytes(d.Delegate); err != nil {
+		return err
+	}
+
+	if d.Delegator == d.Delegate {
+		return errors.New("cannot delegate vote to self")
+	}
+
+	return nil
+}
+
+// ValidateVoteDelegations checks the vote delegations as a whole against the limits
+// enforced when a delegation is set: no cycle, no chain longer than the max depth and
+// no delegate with more than the max number of delegators.
+func ValidateVoteDelegations(delegations []*VoteDelegation) error {
+	type delegationKey struct {
+		delegator  string
+		msgTypeURL string
+	}
+
+	delegates := make(map[delegationKey]string, len(delegations))
+	numDelegators := make(map[string]int)
+	for _, delegation := range delegations {
+		delegates[delegationKey{delegation.Delegator, delegation.MsgTypeUrl}] = delegation.Delegate
+
+		numDelegators[delegation.Delegate]++
+		if numDelegators[delegation.Delegate] > MaxVoteDelegatorsPerDelegate {
+			return fmt.Errorf("delegate %s has more than the max number of delegators %d", delegation.Delegate, MaxVoteDelegatorsPerDelegate)
+		}
+	}
+
+	// the delegation scoped to the msg type url takes precedence, as on tally
+	getDelegate := func(delegator, msgTypeURL string) (string, bool) {
+		if delegate, found := delegates[delegationKey{delegator, msgTypeURL}]; found {
+			return delegate, true
+		}
+
+		delegate, found := delegates[delegationKey{delegator, ""}]
+		return delegate, found
+	}
+
+	for _, delegation := range delegations {
+		curr := delegation.Delegate
+		for depth := 1; ; depth++ {
+			if depth > MaxVoteDelegationDepth {
+				return fmt.Errorf("vote delegation chain of %s is longer than %d", delegation.Delegator, MaxVoteDelegationDepth)
+			}
+
+			next, found := getDelegate(curr, delegation.MsgTypeUrl)
+			if !found {
+				break
+			} else if next == delegation.Delegator {
+				return fmt.Errorf("vote delegation cycle detected at %s", delegation.Delegator)
+			}
+
+			curr = next
+		}
+	}
+
+	return nil
+}
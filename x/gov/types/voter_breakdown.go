@@ -0,0 +1,22 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/address"
+)
+
+// Validate performs basic validation of the voter breakdown.
+func (b VoterBreakdown) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(b.Voter); err != nil {
+		return fmt.Errorf("invalid voter address of voter breakdown: %w", err)
+	}
+
+	if b.Delegate != "" {
+		if _, err := ac.StringToBytes(b.Delegate); err != nil {
+			return fmt.Errorf("invalid delegate address of the voter breakdown of %s on proposal %d: %w", b.Voter, b.ProposalId, err)
+		}
+	}
+
+	return nil
+}
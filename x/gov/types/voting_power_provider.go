@@ -0,0 +1,59 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+)
+
+// MaxProviderCallsPerTally is the maximum number of the voting power provider calls in a tally.
+// The voters tallied after the limit is reached get no provider voting power.
+const MaxProviderCallsPerTally = 10_000
+
+// Validate performs basic validation of the voting power provider.
+func (p VotingPowerProvider) Validate(ac address.Codec) error {
+	if p.Name == "" {
+		return errors.New("voting power provider name cannot be empty")
+	}
+
+	if _, err := ac.StringToBytes(p.ModuleAddr); err != nil {
+		return fmt.Errorf("invalid module address of voting power provider %s: %w", p.Name, err)
+	}
+
+	if p.ModuleName == "" || p.FunctionName == "" {
+		return fmt.Errorf("module name and function name of voting power provider %s cannot be empty", p.Name)
+	}
+
+	weight, err := math.LegacyNewDecFromStr(p.Weight)
+	if err != nil {
+		return fmt.Errorf("invalid weight of voting power provider %s: %w", p.Name, err)
+	}
+	if !weight.IsPositive() {
+		return fmt.Errorf("weight of voting power provider %s must be positive: %s", p.Name, weight)
+	}
+
+	if p.GasLimit == 0 {
+		return fmt.Errorf("gas limit of voting power provider %s must be positive", p.Name)
+	}
+
+	return nil
+}
+
+// validateVotingPowerProviders validates the voting power providers and checks the names are unique.
+func validateVotingPowerProviders(ac address.Codec, providers []VotingPowerProvider) error {
+	names := make(map[string]bool, len(providers))
+	for _, provider := range providers {
+		if err := provider.Validate(ac); err != nil {
+			return err
+		}
+
+		if names[provider.Name] {
+			return fmt.Errorf("duplicate voting power provider name: %s", provider.Name)
+		}
+		names[provider.Name] = true
+	}
+
+	return nil
+}
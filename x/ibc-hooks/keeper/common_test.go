@@ -328,6 +328,7 @@ func _createTestInput(
 		accountKeeper,
 		bankKeeper,
 		movekeeper.NewVotingPowerKeeper(moveKeeper),
+		movekeeper.NewDexKeeper(moveKeeper),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		vc, cc,
 	)
@@ -354,6 +355,7 @@ func _createTestInput(
 		bankKeeper,
 		stakingKeeper,
 		movekeeper.NewDexKeeper(moveKeeper),
+		rewardKeeper,
 		authtypes.FeeCollectorName,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -424,6 +426,7 @@ func _createTestInput(
 		stakingKeeper,
 		distKeeper,
 		movekeeper.NewVestingKeeper(moveKeeper),
+		movekeeper.NewMoveGovKeeper(moveKeeper),
 		msgRouter,
 		govConfig,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -338,6 +338,7 @@ func _createTestInput(
 		accountKeeper,
 		bankKeeper,
 		movekeeper.NewVotingPowerKeeper(moveKeeper),
+		movekeeper.NewDexKeeper(moveKeeper),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		vc, cc,
 	)
@@ -364,6 +365,7 @@ func _createTestInput(
 		bankKeeper,
 		stakingKeeper,
 		movekeeper.NewDexKeeper(moveKeeper),
+		rewardKeeper,
 		authtypes.FeeCollectorName,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -443,6 +445,7 @@ func _createTestInput(
 		stakingKeeper,
 		distKeeper,
 		movekeeper.NewVestingKeeper(moveKeeper),
+		movekeeper.NewMoveGovKeeper(moveKeeper),
 		msgRouter,
 		govConfig,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -11,11 +11,13 @@ import (
 
 	distrtypes "github.com/initia-labs/initia/x/distribution/types"
 	"github.com/initia-labs/initia/x/move/types"
+	mstakingtypes "github.com/initia-labs/initia/x/mstaking/types"
 	vmtypes "github.com/initia-labs/movevm/types"
 )
 
 var _ types.AnteKeeper = DexKeeper{}
 var _ distrtypes.DexKeeper = DexKeeper{}
+var _ mstakingtypes.DexKeeper = DexKeeper{}
 
 // DexKeeper implement dex features
 type DexKeeper struct {
@@ -352,6 +354,278 @@ func (k DexKeeper) SwapToBase(
 	)
 }
 
+// SwapBondDenom converts the offer coin of the account into the denom of the min return
+// coin. LP coins are converted into base coins by withdrawing the liquidity and swapping
+// the withdrawn quote coins, and base coins are converted into LP coins by providing
+// single asset liquidity. Conversion between two LP coins goes through the base coin.
+//
+// The min return amount is enforced by the dex on the last step, and the intermediate
+// swap of a conversion between two LP coins takes the base amount required to mint the
+// min return amount at the current pool ratio as its min return amount.
+func (k DexKeeper) SwapBondDenom(
+	ctx context.Context,
+	addr sdk.AccAddress,
+	offerCoin sdk.Coin,
+	minReturn sdk.Coin,
+) error {
+	targetDenom := minReturn.Denom
+	if offerCoin.Denom == targetDenom {
+		return types.ErrInvalidRequest.Wrap("offer denom and target denom must be different")
+	}
+
+	vmAddr, err := vmtypes.NewAccountAddressFromBytes(addr[:])
+	if err != nil {
+		return err
+	}
+
+	denomBase, err := k.BaseDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	metadataBase, err := types.MetadataAddressFromDenom(denomBase)
+	if err != nil {
+		return err
+	}
+
+	var metadataLP vmtypes.AccountAddress
+	if targetDenom != denomBase {
+		metadataLP, err = types.MetadataAddressFromDenom(targetDenom)
+		if err != nil {
+			return err
+		}
+
+		if _, err := k.getWhitelistedQuote(ctx, metadataBase, metadataLP); err != nil {
+			return err
+		}
+	}
+
+	baseAmount := offerCoin.Amount
+	if offerCoin.Denom != denomBase {
+		minBaseAmount := minReturn.Amount
+		if targetDenom != denomBase {
+			minBaseAmount, err = k.minBaseAmountToProvide(ctx, metadataLP, minReturn.Amount)
+			if err != nil {
+				return err
+			}
+		}
+
+		baseAmount, err = k.withdrawLiquidityToBase(ctx, vmAddr, metadataBase, offerCoin, minBaseAmount)
+		if err != nil {
+			return err
+		}
+	}
+
+	if targetDenom == denomBase || baseAmount.IsZero() {
+		return nil
+	}
+
+	// build argument bytes
+	amountBz, err := serializeAmount(baseAmount)
+	if err != nil {
+		return err
+	}
+
+	minLiquidityBz, err := serializeMinAmount(minReturn.Amount)
+	if err != nil {
+		return err
+	}
+
+	// provide base coin to the pool to receive LP coin
+	return k.ExecuteEntryFunction(
+		ctx,
+		vmAddr,
+		vmtypes.StdAddress,
+		types.MoveModuleNameDex,
+		types.FunctionNameDexSingleAssetProvideLiquidityScript,
+		[]vmtypes.TypeTag{},
+		[][]byte{metadataLP[:], metadataBase[:], amountBz, minLiquidityBz},
+	)
+}
+
+// minBaseAmountToProvide returns the base amount required to mint the given LP amount.
+// Single asset liquidity provision mints at most the share of the provided base amount
+// in the base balance of the pool, so the returned amount is a lower bound.
+func (k DexKeeper) minBaseAmountToProvide(
+	ctx context.Context,
+	metadataLP vmtypes.AccountAddress,
+	lpAmount math.Int,
+) (math.Int, error) {
+	balanceBase, _, err := k.getPoolBalances(ctx, metadataLP)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	supplyLP, err := NewMoveBankKeeper(k.Keeper).GetSupplyWithMetadata(ctx, metadataLP)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	if !supplyLP.IsPositive() {
+		return math.ZeroInt(), types.ErrInvalidDexConfig.Wrapf("pool `%s` has no liquidity", metadataLP.String())
+	}
+
+	return lpAmount.Mul(balanceBase).Quo(supplyLP), nil
+}
+
+// withdrawLiquidityToBase withdraws the liquidity of the given LP coin and swaps the
+// withdrawn quote coin to base coin with the remaining amount to the min base amount as
+// the min return amount. It returns the total amount of base coin received.
+func (k DexKeeper) withdrawLiquidityToBase(
+	ctx context.Context,
+	vmAddr vmtypes.AccountAddress,
+	metadataBase vmtypes.AccountAddress,
+	lpCoin sdk.Coin,
+	minBaseAmount math.Int,
+) (math.Int, error) {
+	metadataLP, err := types.MetadataAddressFromDenom(lpCoin.Denom)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	metadataQuote, err := k.getWhitelistedQuote(ctx, metadataBase, metadataLP)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	moveBankKeeper := NewMoveBankKeeper(k.Keeper)
+	storeBase := types.UserDerivedObjectAddress(vmAddr, metadataBase)
+	storeQuote := types.UserDerivedObjectAddress(vmAddr, metadataQuote)
+
+	_, baseBefore, err := moveBankKeeper.Balance(ctx, storeBase)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	_, quoteBefore, err := moveBankKeeper.Balance(ctx, storeQuote)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	// build argument bytes
+	liquidityBz, err := serializeAmount(lpCoin.Amount)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	// withdraw liquidity to receive base and quote coins
+	err = k.ExecuteEntryFunction(
+		ctx,
+		vmAddr,
+		vmtypes.StdAddress,
+		types.MoveModuleNameDex,
+		types.FunctionNameDexWithdrawLiquidityScript,
+		[]vmtypes.TypeTag{},
+		[][]byte{metadataLP[:], liquidityBz, {0}, {0}},
+	)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	_, baseWithdrawn, err := moveBankKeeper.Balance(ctx, storeBase)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	_, quoteAfter, err := moveBankKeeper.Balance(ctx, storeQuote)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	// swap withdrawn quote coin to base coin
+	if quoteAmount := quoteAfter.Sub(quoteBefore); quoteAmount.IsPositive() {
+		offerAmountBz, err := serializeAmount(quoteAmount)
+		if err != nil {
+			return math.ZeroInt(), err
+		}
+
+		// the swap must return at least the remaining amount to the min base amount
+		minReturnBz, err := serializeMinAmount(minBaseAmount.Sub(baseWithdrawn.Sub(baseBefore)))
+		if err != nil {
+			return math.ZeroInt(), err
+		}
+
+		err = k.ExecuteEntryFunction(
+			ctx,
+			vmAddr,
+			vmtypes.StdAddress,
+			types.MoveModuleNameDex,
+			types.FunctionNameDexSwapScript,
+			[]vmtypes.TypeTag{},
+			[][]byte{metadataLP[:], metadataQuote[:], offerAmountBz, minReturnBz},
+		)
+		if err != nil {
+			return math.ZeroInt(), err
+		}
+	}
+
+	_, baseAfter, err := moveBankKeeper.Balance(ctx, storeBase)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	return baseAfter.Sub(baseBefore), nil
+}
+
+// serializeAmount serializes the amount into a move u64 argument.
+func serializeAmount(amount math.Int) ([]byte, error) {
+	if !amount.IsUint64() {
+		return nil, types.ErrInvalidRequest.Wrapf("amount %s overflows u64", amount)
+	}
+
+	return vmtypes.SerializeUint64(amount.Uint64())
+}
+
+// serializeMinAmount serializes the min amount into a move option<u64> argument. The
+// min amount is at least one, so the dex never accepts an empty return.
+func serializeMinAmount(amount math.Int) ([]byte, error) {
+	bz, err := serializeAmount(math.MaxInt(amount, math.OneInt()))
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{1}, bz...), nil
+}
+
+// getWhitelistedQuote returns the quote metadata of the given LP pool
+// after checking the pool is the whitelisted one for the quote coin.
+func (k DexKeeper) getWhitelistedQuote(
+	ctx context.Context,
+	metadataBase vmtypes.AccountAddress,
+	metadataLP vmtypes.AccountAddress,
+) (vmtypes.AccountAddress, error) {
+	metadataA, metadataB, err := k.GetPoolMetadata(ctx, metadataLP)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return vmtypes.AccountAddress{}, types.ErrInvalidDexConfig.Wrapf("pool `%s` not found", metadataLP.String())
+	} else if err != nil {
+		return vmtypes.AccountAddress{}, err
+	}
+
+	var metadataQuote vmtypes.AccountAddress
+	switch metadataBase {
+	case metadataA:
+		metadataQuote = metadataB
+	case metadataB:
+		metadataQuote = metadataA
+	default:
+		return vmtypes.AccountAddress{}, types.ErrInvalidDexConfig.Wrap("the pair does not contain base denom")
+	}
+
+	if found, err := k.hasDexPair(ctx, metadataQuote); err != nil {
+		return vmtypes.AccountAddress{}, err
+	} else if !found {
+		return vmtypes.AccountAddress{}, types.ErrInvalidDexConfig.Wrapf("pool `%s` is not whitelisted", metadataLP.String())
+	}
+
+	if whitelistedLP, err := k.getMetadataLP(ctx, metadataQuote); err != nil {
+		return vmtypes.AccountAddress{}, err
+	} else if whitelistedLP != metadataLP {
+		return vmtypes.AccountAddress{}, types.ErrInvalidDexConfig.Wrapf("pool `%s` is not whitelisted", metadataLP.String())
+	}
+
+	return metadataQuote, nil
+}
+
 func (k DexKeeper) GetPoolMetadata(
 	ctx context.Context,
 	metadataLP vmtypes.AccountAddress,
@@ -228,6 +228,71 @@ func Test_SwapToBase(t *testing.T) {
 	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 997 /* swap fee deducted */)), coins)
 }
 
+func Test_SwapBondDenom(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	dexKeeper := keeper.NewDexKeeper(&input.MoveKeeper)
+
+	baseDenom := bondDenom
+	baseAmount := math.NewInt(4_000_000_000_000)
+
+	denomQuote := "uusdc"
+	quoteAmount := math.NewInt(1_000_000_000_000)
+
+	metadataQuote, err := types.MetadataAddressFromDenom(denomQuote)
+	require.NoError(t, err)
+
+	metadataLP := createDexPool(
+		t, ctx, input,
+		sdk.NewCoin(baseDenom, baseAmount), sdk.NewCoin(denomQuote, quoteAmount),
+		math.LegacyNewDecWithPrec(8, 1), math.LegacyNewDecWithPrec(2, 1),
+	)
+	denomLP, err := types.DenomFromMetadataAddress(ctx, keeper.NewMoveBankKeeper(&input.MoveKeeper), metadataLP)
+	require.NoError(t, err)
+
+	// not whitelisted yet
+	baseOfferCoin := sdk.NewInt64Coin(baseDenom, 1_000_000)
+	fundedAddr := input.Faucet.NewFundedAccount(ctx, baseOfferCoin)
+	err = dexKeeper.SwapBondDenom(ctx, fundedAddr, baseOfferCoin, sdk.NewInt64Coin(denomLP, 1))
+	require.ErrorIs(t, err, types.ErrInvalidDexConfig)
+
+	err = dexKeeper.SetDexPair(ctx, types.DexPair{
+		MetadataQuote: metadataQuote.String(),
+		MetadataLP:    metadataLP.String(),
+	})
+	require.NoError(t, err)
+
+	// min return not satisfied
+	cacheCtx, _ := ctx.CacheContext()
+	err = dexKeeper.SwapBondDenom(cacheCtx, fundedAddr, baseOfferCoin, sdk.NewInt64Coin(denomLP, 1_000_000_000))
+	require.Error(t, err)
+
+	// base => lp
+	err = dexKeeper.SwapBondDenom(ctx, fundedAddr, baseOfferCoin, sdk.NewInt64Coin(denomLP, 1))
+	require.NoError(t, err)
+
+	coins := input.BankKeeper.GetAllBalances(ctx, fundedAddr)
+	require.True(t, coins.AmountOf(baseDenom).IsZero())
+	lpAmount := coins.AmountOf(denomLP)
+	require.True(t, lpAmount.IsPositive())
+
+	// min return not satisfied
+	cacheCtx, _ = ctx.CacheContext()
+	err = dexKeeper.SwapBondDenom(cacheCtx, fundedAddr, sdk.NewCoin(denomLP, lpAmount), baseOfferCoin)
+	require.Error(t, err)
+
+	// lp => base
+	err = dexKeeper.SwapBondDenom(ctx, fundedAddr, sdk.NewCoin(denomLP, lpAmount), sdk.NewInt64Coin(baseDenom, 1))
+	require.NoError(t, err)
+
+	coins = input.BankKeeper.GetAllBalances(ctx, fundedAddr)
+	require.True(t, coins.AmountOf(denomLP).IsZero())
+	require.True(t, coins.AmountOf(denomQuote).IsZero())
+
+	// swap fees are deducted during the round trip
+	require.True(t, coins.AmountOf(baseDenom).IsPositive())
+	require.True(t, coins.AmountOf(baseDenom).LT(baseOfferCoin.Amount))
+}
+
 func TestDexPair(t *testing.T) {
 	ctx, input := createDefaultTestInput(t)
 	dexKeeper := keeper.NewDexKeeper(&input.MoveKeeper)
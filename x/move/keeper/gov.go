@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cometbft/cometbft/libs/json"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	govtypes "github.com/initia-labs/initia/x/gov/types"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+var _ govtypes.MoveGovKeeper = MoveGovKeeper{}
+
+// MoveGovKeeper implements move wrapper for the gov module's types.MoveGovKeeper interface
+type MoveGovKeeper struct {
+	*Keeper
+}
+
+// NewMoveGovKeeper creates a new instance of MoveGovKeeper
+func NewMoveGovKeeper(k *Keeper) MoveGovKeeper {
+	return MoveGovKeeper{k}
+}
+
+// GetProviderVotingPower calls the voting power provider view function, which has the signature
+// `#[view] public fun <function_name>(voter: address): u64`, with the gas limit and returns
+// the amount returned. The gas used is charged to the parent gas meter.
+func (gk MoveGovKeeper) GetProviderVotingPower(
+	ctx context.Context, moduleAccAddr sdk.AccAddress, moduleName, functionName string, voterAccAddr sdk.AccAddress, gasLimit uint64,
+) (amount math.Int, err error) {
+	moduleAddr, err := vmtypes.NewAccountAddressFromBytes(moduleAccAddr.Bytes())
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	voterAddr, err := vmtypes.NewAccountAddressFromBytes(voterAccAddr.Bytes())
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	// run the view function with the gas budget of the provider
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	gasMeter := storetypes.NewGasMeter(gasLimit)
+	defer func() {
+		// recover the out of gas panic of the gas budget
+		if r := recover(); r != nil {
+			amount, err = math.ZeroInt(), fmt.Errorf("voting power provider panic: %v", r)
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "voting power provider")
+	}()
+
+	output, _, err := gk.executeViewFunction(
+		sdkCtx.WithGasMeter(gasMeter),
+		moduleAddr,
+		moduleName,
+		functionName,
+		[]vmtypes.TypeTag{},
+		[][]byte{[]byte(fmt.Sprintf("\"%s\"", voterAddr))},
+		true,
+	)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	// output should be a json encoded u64 string
+	var amountStr string
+	err = json.Unmarshal([]byte(output.Ret), &amountStr)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	amount, ok := math.NewIntFromString(amountStr)
+	if !ok {
+		return math.ZeroInt(), fmt.Errorf("invalid voting power: %s", amountStr)
+	}
+
+	return amount, nil
+}
+
+// ExecuteGovHook calls the gov hook entry function, which has the signature
+// `public entry fun <function_name>(module: &signer, proposal_id: u64, event: String, account: address)`,
+// with the gas limit bounded by the gas remaining in the parent gas meter. The hook module is
+// the signer of the call and the gas used is charged to the parent gas meter.
+func (gk MoveGovKeeper) ExecuteGovHook(
+	ctx context.Context, moduleAccAddr sdk.AccAddress, moduleName, functionName string, proposalID uint64, event string, accountAccAddr sdk.AccAddress, gasLimit uint64,
+) (err error) {
+	moduleAddr, err := vmtypes.NewAccountAddressFromBytes(moduleAccAddr.Bytes())
+	if err != nil {
+		return err
+	}
+
+	accountAddr, err := vmtypes.NewAccountAddressFromBytes(accountAccAddr.Bytes())
+	if err != nil {
+		return err
+	}
+
+	// run the entry function with the gas budget of the hook, which cannot exceed the
+	// gas remaining in the parent gas meter
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	parentGasMeter := sdkCtx.GasMeter()
+	gasMeter := storetypes.NewGasMeter(min(gasLimit, parentGasMeter.Limit()-parentGasMeter.GasConsumedToLimit()))
+	defer func() {
+		// recover the out of gas panic of the gas budget
+		if r := recover(); r != nil {
+			err = fmt.Errorf("gov hook panic: %v", r)
+		}
+
+		parentGasMeter.ConsumeGas(gasMeter.GasConsumedToLimit(), "gov hook")
+	}()
+
+	return gk.ExecuteEntryFunctionJSON(
+		sdkCtx.WithGasMeter(gasMeter),
+		moduleAddr,
+		moduleAddr,
+		moduleName,
+		functionName,
+		[]vmtypes.TypeTag{},
+		[]string{
+			fmt.Sprintf("\"%d\"", proposalID),
+			fmt.Sprintf("\"%s\"", event),
+			fmt.Sprintf("\"%s\"", accountAddr),
+		},
+	)
+}
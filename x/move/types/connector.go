@@ -54,7 +54,9 @@ const (
 	FunctionNameStakingSlashUnbondingCoinForChain   = "slash_unbonding_for_chain"
 
 	// function names for dex
-	FunctionNameDexSwapScript = "swap_script"
+	FunctionNameDexSwapScript                        = "swap_script"
+	FunctionNameDexWithdrawLiquidityScript           = "withdraw_liquidity_script"
+	FunctionNameDexSingleAssetProvideLiquidityScript = "single_asset_provide_liquidity_script"
 
 	// function names for object
 	FunctionNameObjectTransfer = "transfer"
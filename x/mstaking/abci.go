@@ -13,10 +13,15 @@ import (
 )
 
 // BeginBlocker will persist the current header and validator set as a historical entry
-// and prune the oldest entry based on the HistoricalEntries parameter
+// and prune the oldest entry based on the HistoricalEntries parameter. It also records
+// the voting power snapshot at every PowerSnapshotInterval blocks.
 func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
-	return k.TrackHistoricalInfo(ctx)
+	if err := k.TrackHistoricalInfo(ctx); err != nil {
+		return err
+	}
+
+	return k.TrackPowerSnapshot(ctx)
 }
 
 // Called every block, update validator set
@@ -28,6 +28,10 @@ const (
 	FlagGenesisFormat = "genesis-format"
 	FlagNodeID        = "node-id"
 	FlagIP            = "ip"
+
+	FlagAddressDelegator = "delegator"
+	FlagStartTime        = "start-time"
+	FlagEndTime          = "end-time"
 )
 
 // common flagsets to add to various functions
@@ -56,6 +60,17 @@ func FlagSetCommissionCreate() *flag.FlagSet {
 	return fs
 }
 
+// FlagSetQueue Returns the FlagSet used for the unbonding and redelegation queue queries.
+func FlagSetQueue() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagStartTime, "", "The RFC3339 lower bound of the completion time; defaults to the current block time")
+	fs.String(FlagEndTime, "", "The RFC3339 upper bound of the completion time; defaults to the start time plus the unbonding time")
+	fs.String(FlagAddressDelegator, "", "The Bech32 address of the delegator to filter for")
+	fs.String(FlagAddressValidator, "", "The Bech32 address of the validator to filter for")
+	return fs
+}
+
 // FlagSetAmount Returns the FlagSet for amount related operations.
 func FlagSetAmount() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
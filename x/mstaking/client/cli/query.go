@@ -2,7 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"cosmossdk.io/core/address"
 	"github.com/spf13/cobra"
@@ -39,6 +41,14 @@ func GetQueryCmd(ac, vc address.Codec) *cobra.Command {
 		GetCmdQueryValidatorRedelegations(vc),
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
+		GetCmdQueryValidatorPowerAt(vc),
+		GetCmdQueryVotingPowerWeightsAt(),
+		GetCmdQueryPendingPowerUpdates(),
+		GetCmdQueryUnbondingQueue(ac, vc),
+		GetCmdQueryRedelegationQueue(ac, vc),
+		GetCmdQueryUnbondingSummary(ac),
+		GetCmdQueryValidatorSlashEvents(vc),
+		GetCmdQuerySlashImpacts(ac, vc),
 	)
 
 	return stakingQueryCmd
@@ -702,3 +712,432 @@ $ %s query staking params
 
 	return cmd
 }
+
+// GetCmdQueryValidatorPowerAt implements the validator power at height query command.
+func GetCmdQueryValidatorPowerAt(vc address.Codec) *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-power-at [validator-addr] [height]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the validator tokens and voting power at a past height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validator tokens and voting power recorded at the latest
+power snapshot at or before the given height.
+
+Example:
+$ %s query mstaking validator-power-at %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 1000
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			valAddrStr := args[0]
+			if _, err := vc.StringToBytes(valAddrStr); err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorPowerAt(cmd.Context(), &types.QueryValidatorPowerAtRequest{
+				ValidatorAddr: valAddrStr,
+				Height:        height,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryVotingPowerWeightsAt implements the voting power weights at height query command.
+func GetCmdQueryVotingPowerWeightsAt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "voting-power-weights-at [height]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the voting power weights at a past height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the voting power weights recorded at the latest power snapshot
+at or before the given height.
+
+Example:
+$ %s query mstaking voting-power-weights-at 1000
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VotingPowerWeightsAt(cmd.Context(), &types.QueryVotingPowerWeightsAtRequest{
+				Height: height,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPendingPowerUpdates implements the pending power updates query command.
+func GetCmdQueryPendingPowerUpdates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-power-updates",
+		Args:  cobra.NoArgs,
+		Short: "Query the validator power changes carried over to the later blocks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the bonded validators whose consensus power is not yet
+fully updated due to the max power change rate.
+
+Example:
+$ %s query mstaking pending-power-updates
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingPowerUpdates(cmd.Context(), &types.QueryPendingPowerUpdatesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryUnbondingQueue implements the unbonding queue query command.
+func GetCmdQueryUnbondingQueue(ac, vc address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-queue",
+		Args:  cobra.NoArgs,
+		Short: "Query the unbonding delegation entries maturing in a time range",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the unbonding delegation entries maturing in a time range,
+optionally filtered by delegator and validator.
+
+Example:
+$ %s query mstaking unbonding-queue --start-time 2024-01-01T00:00:00Z --end-time 2024-01-31T00:00:00Z --delegator %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, sdk.GetConfig().GetBech32AccountAddrPrefix(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startTime, endTime, delAddr, valAddr, err := parseQueueFlags(cmd, ac, vc)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.UnbondingQueue(cmd.Context(), &types.QueryUnbondingQueueRequest{
+				StartTime:     startTime,
+				EndTime:       endTime,
+				DelegatorAddr: delAddr,
+				ValidatorAddr: valAddr,
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetQueue())
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unbonding queue entries")
+
+	return cmd
+}
+
+// GetCmdQueryRedelegationQueue implements the redelegation queue query command.
+func GetCmdQueryRedelegationQueue(ac, vc address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redelegation-queue",
+		Args:  cobra.NoArgs,
+		Short: "Query the redelegation entries maturing in a time range",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the redelegation entries maturing in a time range,
+optionally filtered by delegator and source or destination validator.
+
+Example:
+$ %s query mstaking redelegation-queue --start-time 2024-01-01T00:00:00Z --validator %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, sdk.GetConfig().GetBech32ValidatorAddrPrefix(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startTime, endTime, delAddr, valAddr, err := parseQueueFlags(cmd, ac, vc)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RedelegationQueue(cmd.Context(), &types.QueryRedelegationQueueRequest{
+				StartTime:     startTime,
+				EndTime:       endTime,
+				DelegatorAddr: delAddr,
+				ValidatorAddr: valAddr,
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetQueue())
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "redelegation queue entries")
+
+	return cmd
+}
+
+// GetCmdQueryUnbondingSummary implements the delegator unbonding summary query command.
+func GetCmdQueryUnbondingSummary(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-summary [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the total unbonding amounts of a delegator and when they unlock",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total unbonding amounts of a delegator per denom
+and the amounts unlocked at each completion time.
+
+Example:
+$ %s query mstaking unbonding-summary %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, sdk.GetConfig().GetBech32AccountAddrPrefix(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err := ac.StringToBytes(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorUnbondingSummary(cmd.Context(), &types.QueryDelegatorUnbondingSummaryRequest{
+				DelegatorAddr: args[0],
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unbonding summary")
+
+	return cmd
+}
+
+// GetCmdQueryValidatorSlashEvents implements the validator slash events query command.
+func GetCmdQueryValidatorSlashEvents(vc address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-events [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the slash events of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the slash events of a validator ordered by id.
+
+Example:
+$ %s query mstaking slash-events %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, sdk.GetConfig().GetBech32ValidatorAddrPrefix(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err := vc.StringToBytes(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorSlashEvents(cmd.Context(), &types.QueryValidatorSlashEventsRequest{
+				ValidatorAddr: args[0],
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "slash events")
+
+	return cmd
+}
+
+// GetCmdQuerySlashImpacts implements the delegator slash impacts query command.
+func GetCmdQuerySlashImpacts(ac, vc address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-impacts [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the tokens a delegator lost by the slash events",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokens a delegator lost from the delegations, unbonding
+delegations and redelegations by the slash events, optionally filtered by validator.
+
+Example:
+$ %s query mstaking slash-impacts %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --validator %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, sdk.GetConfig().GetBech32AccountAddrPrefix(), sdk.GetConfig().GetBech32ValidatorAddrPrefix(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err := ac.StringToBytes(args[0]); err != nil {
+				return err
+			}
+
+			valAddr, _ := cmd.Flags().GetString(FlagAddressValidator)
+			if valAddr != "" {
+				if _, err := vc.StringToBytes(valAddr); err != nil {
+					return err
+				}
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorSlashImpacts(cmd.Context(), &types.QueryDelegatorSlashImpactsRequest{
+				DelegatorAddr: args[0],
+				ValidatorAddr: valAddr,
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagAddressValidator, "", "Filter the impacts by the validator address")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "slash impacts")
+
+	return cmd
+}
+
+func parseQueueFlags(cmd *cobra.Command, ac, vc address.Codec) (startTime, endTime time.Time, delAddr, valAddr string, err error) {
+	if s, _ := cmd.Flags().GetString(FlagStartTime); s != "" {
+		if startTime, err = time.Parse(time.RFC3339, s); err != nil {
+			return
+		}
+	}
+
+	if s, _ := cmd.Flags().GetString(FlagEndTime); s != "" {
+		if endTime, err = time.Parse(time.RFC3339, s); err != nil {
+			return
+		}
+	}
+
+	if delAddr, _ = cmd.Flags().GetString(FlagAddressDelegator); delAddr != "" {
+		if _, err = ac.StringToBytes(delAddr); err != nil {
+			return
+		}
+	}
+
+	if valAddr, _ = cmd.Flags().GetString(FlagAddressValidator); valAddr != "" {
+		if _, err = vc.StringToBytes(valAddr); err != nil {
+			return
+		}
+	}
+
+	return
+}
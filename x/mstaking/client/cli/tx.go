@@ -46,6 +46,8 @@ func NewTxCmd(ac, vc address.Codec) *cobra.Command {
 		NewDelegateCmd(ac, vc),
 		NewRedelegateCmd(ac, vc),
 		NewUnbondCmd(ac, vc),
+		NewSwapDelegationDenomCmd(ac, vc),
+		NewRotateConsPubKeyCmd(vc),
 	)
 
 	return stakingTxCmd
@@ -300,6 +302,118 @@ $ %s tx staking unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake --from
 	return cmd
 }
 
+func NewSwapDelegationDenomCmd(ac, vc address.Codec) *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "swap-delegation-denom [src-validator-addr] [dst-validator-addr] [amount] [min-target-amount]",
+		Short: "Convert delegated tokens to another bond denom and redelegate them",
+		Args:  cobra.ExactArgs(4),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Convert an amount of delegated tokens to another bond denom through the dex
+and redelegate the converted tokens to the destination validator. The conversion fails
+when the converted amount is less than the min-target-amount.
+
+Example:
+$ %s tx mstaking swap-delegation-denom %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm 100ulp 95stake --from mykey
+`,
+				version.AppName, bech32PrefixValAddr, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			delAddrStr, err := ac.BytesToString(delAddr)
+			if err != nil {
+				return err
+			}
+
+			valSrcAddrStr := args[0]
+			if _, err := vc.StringToBytes(valSrcAddrStr); err != nil {
+				return err
+			}
+
+			valDstAddrStr := args[1]
+			if _, err := vc.StringToBytes(valDstAddrStr); err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			minTargetAmount, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSwapDelegationDenom(delAddrStr, valSrcAddrStr, valDstAddrStr, amount, minTargetAmount)
+			if err := msg.Validate(ac, vc); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRotateConsPubKeyCmd(vc address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-cons-pubkey [pubkey]",
+		Short: "Rotate the consensus public key of an existing validator",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Rotate the consensus public key of the validator operated by the sender.
+The old key keeps being slashable until the unbonding period passes, and
+the key rotation fee is charged to the validator operator.
+
+Example:
+$ %s tx mstaking rotate-cons-pubkey '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"oWg2ISpLF405Jcm2vXV+2v4fnjodh6aafuIdeoW+rUw="}' --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			valAddr := clientCtx.GetFromAddress()
+			valAddrStr, err := vc.BytesToString(valAddr)
+			if err != nil {
+				return err
+			}
+
+			var pk cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgRotateConsPubKey(valAddrStr, pk)
+			if err != nil {
+				return err
+			}
+			if err := msg.Validate(vc); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func newBuildCreateValidatorMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet, vc address.Codec) (tx.Factory, *types.MsgCreateValidator, error) {
 	fAmount, _ := fs.GetString(FlagAmount)
 	amount, err := sdk.ParseCoinsNormalized(fAmount)
@@ -44,9 +44,63 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateSlashEvents(data); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateConsPubKeyRotations(data); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateSlashEvents(data *types.GenesisState) error {
+	events := make(map[uint64]bool, len(data.SlashEvents))
+	for _, event := range data.SlashEvents {
+		if event.Id >= data.NextSlashEventId {
+			return fmt.Errorf("slash event id %d must be less than the next slash event id %d", event.Id, data.NextSlashEventId)
+		}
+
+		if events[event.Id] {
+			return fmt.Errorf("duplicate slash event id %d", event.Id)
+		}
+		events[event.Id] = true
+	}
+
+	impacts := make(map[string]bool, len(data.SlashImpacts))
+	for _, impact := range data.SlashImpacts {
+		if !events[impact.SlashEventId] {
+			return fmt.Errorf("slash impact of %s refers to unknown slash event id %d", impact.DelegatorAddress, impact.SlashEventId)
+		}
+
+		key := fmt.Sprintf("%s/%d", impact.DelegatorAddress, impact.SlashEventId)
+		if impacts[key] {
+			return fmt.Errorf("duplicate slash impact of %s for slash event id %d", impact.DelegatorAddress, impact.SlashEventId)
+		}
+		impacts[key] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStateConsPubKeyRotations(data *types.GenesisState) error {
+	rotations := make(map[string]bool, len(data.ConsPubkeyRotationHistories))
+	for _, history := range data.ConsPubkeyRotationHistories {
+		if _, err := history.GetOldConsAddr(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%d", history.OperatorAddress, history.Height)
+		if rotations[key] {
+			return fmt.Errorf("duplicate cons pubkey rotation of %s at height %d", history.OperatorAddress, history.Height)
+		}
+		rotations[key] = true
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
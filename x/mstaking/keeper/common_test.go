@@ -352,11 +352,13 @@ func _createTestInput(
 		accountKeeper,
 		bankKeeper,
 		movekeeper.NewVotingPowerKeeper(moveKeeper),
+		movekeeper.NewDexKeeper(moveKeeper),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		vc, cc,
 	)
 	stakingParams := stakingtypes.DefaultParams()
 	stakingParams.BondDenoms = []string{bondDenom}
+	stakingParams.KeyRotationFee.Denom = bondDenom
 	stakingKeeper.SetParams(ctx, stakingParams)
 
 	rewardKeeper := rewardkeeper.NewKeeper(
@@ -378,6 +380,7 @@ func _createTestInput(
 		bankKeeper,
 		stakingKeeper,
 		movekeeper.NewDexKeeper(moveKeeper),
+		rewardKeeper,
 		authtypes.FeeCollectorName,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -440,6 +443,7 @@ func _createTestInput(
 		stakingKeeper,
 		distKeeper,
 		movekeeper.NewVestingKeeper(moveKeeper),
+		movekeeper.NewMoveGovKeeper(moveKeeper),
 		msgRouter,
 		govConfig,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -0,0 +1,216 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/initia-labs/initia/x/mstaking/types"
+)
+
+// RotateValidatorConsPubKey replaces the consensus public key of the validator with the new one.
+// The old consensus address keeps pointing to the validator until the rotation matures
+// after the unbonding period, so the infractions committed with the old key can still
+// be slashed and the old key cannot be reused by other validators.
+func (k Keeper) RotateValidatorConsPubKey(ctx context.Context, validator types.Validator, newPubKey cryptotypes.PubKey) (sdk.Coin, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the new key must not be used by any validator, including the old keys
+	// of the rotations in the unbonding period
+	if _, err := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(newPubKey)); err == nil {
+		return sdk.Coin{}, types.ErrValidatorPubKeyExists
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return sdk.Coin{}, err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+
+	// only one rotation is allowed per block, because the consensus engine
+	// cannot process multiple key changes of a validator at once
+	if found, err := k.ConsPubKeyRotationHistories.Has(ctx, collections.Join(valAddr, height)); err != nil {
+		return sdk.Coin{}, err
+	} else if found {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrConsPubKeyRotationLimitExceeded, "already rotated in this block")
+	}
+
+	numRotations, err := k.countConsPubKeyRotations(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if numRotations >= uint64(params.MaxConsPubkeyRotations) {
+		return sdk.Coin{}, errorsmod.Wrapf(
+			types.ErrConsPubKeyRotationLimitExceeded,
+			"%d rotations are pending in the unbonding period; max %d", numRotations, params.MaxConsPubkeyRotations,
+		)
+	}
+
+	// charge the rotation fee to the validator operator
+	fee := params.KeyRotationFee
+	if fee.Amount.IsNil() {
+		fee = sdk.Coin{}
+	} else if fee.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(valAddr), authtypes.FeeCollectorName, sdk.NewCoins(fee)); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	oldPubKey, err := validator.ConsPubKey()
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator.ConsensusPubkey = pkAny
+	if err := k.SetValidator(ctx, validator); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	unbondingTime, err := k.UnbondingTime(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	completionTime := sdkCtx.BlockTime().Add(unbondingTime)
+	history, err := types.NewConsPubKeyRotationHistory(validator.GetOperator(), oldPubKey, newPubKey, height, completionTime, fee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.SetConsPubKeyRotationHistory(ctx, history); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.Hooks().AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey, valAddr); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return fee, nil
+}
+
+// SetConsPubKeyRotationHistory stores the rotation history and queues it for
+// the maturity at its completion time.
+func (k Keeper) SetConsPubKeyRotationHistory(ctx context.Context, history types.ConsPubKeyRotationHistory) error {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(history.OperatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.ConsPubKeyRotationHistories.Set(ctx, collections.Join(valAddr, history.Height), history); err != nil {
+		return err
+	}
+
+	return k.ConsPubKeyRotationQueue.Set(ctx, collections.Join(history.CompletionTime, valAddr), history.Height)
+}
+
+// GetConsPubKeyRotationHistories returns the pending consensus key rotations of the validator.
+func (k Keeper) GetConsPubKeyRotationHistories(ctx context.Context, valAddr sdk.ValAddress) (histories []types.ConsPubKeyRotationHistory, err error) {
+	err = k.ConsPubKeyRotationHistories.Walk(ctx, collections.NewPrefixedPairRange[[]byte, int64](valAddr), func(_ collections.Pair[[]byte, int64], history types.ConsPubKeyRotationHistory) (stop bool, err error) {
+		histories = append(histories, history)
+		return false, nil
+	})
+
+	return histories, err
+}
+
+// countConsPubKeyRotations returns the number of pending consensus key rotations of the validator.
+func (k Keeper) countConsPubKeyRotations(ctx context.Context, valAddr sdk.ValAddress) (count uint64, err error) {
+	err = k.ConsPubKeyRotationHistories.Walk(ctx, collections.NewPrefixedPairRange[[]byte, int64](valAddr), func(_ collections.Pair[[]byte, int64], _ types.ConsPubKeyRotationHistory) (stop bool, err error) {
+		count++
+		return false, nil
+	})
+
+	return count, err
+}
+
+// getBlockConsPubKeyRotation returns the consensus key rotation of the validator
+// made in the current block, if any.
+func (k Keeper) getBlockConsPubKeyRotation(ctx context.Context, valAddr sdk.ValAddress) (types.ConsPubKeyRotationHistory, bool, error) {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	history, err := k.ConsPubKeyRotationHistories.Get(ctx, collections.Join([]byte(valAddr), height))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ConsPubKeyRotationHistory{}, false, nil
+	} else if err != nil {
+		return types.ConsPubKeyRotationHistory{}, false, err
+	}
+
+	return history, true, nil
+}
+
+// CompleteMatureConsPubKeyRotations releases the old consensus addresses of the
+// rotations whose unbonding period has passed.
+func (k Keeper) CompleteMatureConsPubKeyRotations(ctx context.Context) error {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	var matured []collections.Pair[time.Time, []byte]
+	var heights []int64
+	err := k.ConsPubKeyRotationQueue.Walk(ctx, collections.NewPrefixUntilPairRange[time.Time, []byte](blockTime), func(key collections.Pair[time.Time, []byte], height int64) (stop bool, err error) {
+		matured = append(matured, key)
+		heights = append(heights, height)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, key := range matured {
+		valAddr := key.K2()
+		historyKey := collections.Join(valAddr, heights[i])
+
+		history, err := k.ConsPubKeyRotationHistories.Get(ctx, historyKey)
+		if err != nil {
+			return err
+		}
+
+		oldConsAddr, err := history.GetOldConsAddr()
+		if err != nil {
+			return err
+		}
+
+		// the old consensus address could be already deleted by the validator removal
+		if addr, err := k.ValidatorsByConsAddr.Get(ctx, oldConsAddr); err == nil && bytes.Equal(addr, valAddr) {
+			if err := k.ValidatorsByConsAddr.Remove(ctx, oldConsAddr); err != nil {
+				return err
+			}
+		} else if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		if err := k.Hooks().AfterConsensusPubKeyRotationMatured(ctx, oldConsAddr, valAddr); err != nil {
+			return err
+		}
+
+		if err := k.ConsPubKeyRotationHistories.Remove(ctx, historyKey); err != nil {
+			return err
+		}
+		if err := k.ConsPubKeyRotationQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,261 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	staking "github.com/initia-labs/initia/x/mstaking"
+	"github.com/initia-labs/initia/x/mstaking/keeper"
+	"github.com/initia-labs/initia/x/mstaking/types"
+)
+
+func Test_RotateConsPubKey(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(input.StakingKeeper)
+
+	valAddr := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 1)
+	createValidatorWithBalance(ctx, input, 100_000_000, 2_000_000, 2)
+
+	validator, err := input.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	oldPubKey, err := validator.ConsPubKey()
+	require.NoError(t, err)
+	oldConsAddr := sdk.ConsAddress(oldPubKey.Address())
+
+	params, err := input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	fee := params.KeyRotationFee
+
+	feeCollector := input.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	balanceBefore := input.BankKeeper.GetBalance(ctx, sdk.AccAddress(valAddr), bondDenom)
+	feeCollectorBefore := input.BankKeeper.GetBalance(ctx, feeCollector, bondDenom)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	newPubKey := ed25519.GenPrivKey().PubKey()
+	msg, err := types.NewMsgRotateConsPubKey(valAddr.String(), newPubKey)
+	require.NoError(t, err)
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.NoError(t, err)
+
+	// fee charged
+	require.Equal(t, balanceBefore.Sub(fee), input.BankKeeper.GetBalance(ctx, sdk.AccAddress(valAddr), bondDenom))
+	require.Equal(t, feeCollectorBefore.Add(fee), input.BankKeeper.GetBalance(ctx, feeCollector, bondDenom))
+
+	// both the old and new consensus addresses point to the validator
+	validator, err = input.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(newPubKey.Address()))
+	require.NoError(t, err)
+	require.Equal(t, valAddr.String(), validator.GetOperator())
+	consPubKey, err := validator.ConsPubKey()
+	require.NoError(t, err)
+	require.True(t, newPubKey.Equals(consPubKey))
+
+	validator, err = input.StakingKeeper.GetValidatorByConsAddr(ctx, oldConsAddr)
+	require.NoError(t, err)
+	require.Equal(t, valAddr.String(), validator.GetOperator())
+
+	histories, err := input.StakingKeeper.GetConsPubKeyRotationHistories(ctx, valAddr)
+	require.NoError(t, err)
+	require.Len(t, histories, 1)
+	require.Equal(t, ctx.BlockHeight(), histories[0].Height)
+	require.Equal(t, fee, histories[0].Fee)
+
+	// the old key is removed and the new key is added
+	updates, err := input.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+
+	oldCmtPubKey, err := cryptocodec.ToCmtProtoPublicKey(oldPubKey)
+	require.NoError(t, err)
+	newCmtPubKey, err := cryptocodec.ToCmtProtoPublicKey(newPubKey)
+	require.NoError(t, err)
+	require.Equal(t, oldCmtPubKey, updates[0].PubKey)
+	require.Equal(t, int64(0), updates[0].Power)
+	require.Equal(t, newCmtPubKey, updates[1].PubKey)
+	require.Equal(t, int64(1), updates[1].Power)
+
+	// no more updates in the next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	updates, err = input.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+
+	// mature the rotation
+	unbondingTime, err := input.StakingKeeper.UnbondingTime(ctx)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(unbondingTime))
+
+	_, err = input.StakingKeeper.BlockValidatorUpdates(ctx)
+	require.NoError(t, err)
+
+	_, err = input.StakingKeeper.GetValidatorByConsAddr(ctx, oldConsAddr)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	histories, err = input.StakingKeeper.GetConsPubKeyRotationHistories(ctx, valAddr)
+	require.NoError(t, err)
+	require.Empty(t, histories)
+}
+
+func Test_RotateConsPubKey_Genesis(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(input.StakingKeeper)
+
+	valAddr := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 1)
+	createValidatorWithBalance(ctx, input, 100_000_000, 2_000_000, 2)
+
+	validator, err := input.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	oldPubKey, err := validator.ConsPubKey()
+	require.NoError(t, err)
+	oldConsAddr := sdk.ConsAddress(oldPubKey.Address())
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	msg, err := types.NewMsgRotateConsPubKey(valAddr.String(), ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.NoError(t, err)
+
+	histories, err := input.StakingKeeper.GetConsPubKeyRotationHistories(ctx, valAddr)
+	require.NoError(t, err)
+
+	// the rotation is exported
+	genState := input.StakingKeeper.ExportGenesis(ctx)
+	require.Equal(t, histories, genState.ConsPubkeyRotationHistories)
+	require.NoError(t, staking.ValidateGenesis(genState))
+
+	invalidGenState := *genState
+	invalidGenState.ConsPubkeyRotationHistories = append(genState.ConsPubkeyRotationHistories, genState.ConsPubkeyRotationHistories[0])
+	require.Error(t, staking.ValidateGenesis(&invalidGenState))
+
+	// clear the rotation state and import it back
+	history := histories[0]
+	require.NoError(t, input.StakingKeeper.ConsPubKeyRotationHistories.Remove(ctx, collections.Join([]byte(valAddr), history.Height)))
+	require.NoError(t, input.StakingKeeper.ConsPubKeyRotationQueue.Remove(ctx, collections.Join(history.CompletionTime, []byte(valAddr))))
+	require.NoError(t, input.StakingKeeper.ValidatorsByConsAddr.Remove(ctx, oldConsAddr))
+
+	input.StakingKeeper.InitGenesis(ctx, genState)
+
+	histories, err = input.StakingKeeper.GetConsPubKeyRotationHistories(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, genState.ConsPubkeyRotationHistories, histories)
+
+	validator, err = input.StakingKeeper.GetValidatorByConsAddr(ctx, oldConsAddr)
+	require.NoError(t, err)
+	require.Equal(t, valAddr.String(), validator.GetOperator())
+
+	// the imported rotation matures
+	unbondingTime, err := input.StakingKeeper.UnbondingTime(ctx)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(unbondingTime))
+	require.NoError(t, input.StakingKeeper.CompleteMatureConsPubKeyRotations(ctx))
+
+	_, err = input.StakingKeeper.GetValidatorByConsAddr(ctx, oldConsAddr)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	histories, err = input.StakingKeeper.GetConsPubKeyRotationHistories(ctx, valAddr)
+	require.NoError(t, err)
+	require.Empty(t, histories)
+}
+
+func Test_RotateConsPubKey_Restrictions(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(input.StakingKeeper)
+
+	valAddr := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 1)
+	valAddr2 := createValidatorWithBalance(ctx, input, 100_000_000, 2_000_000, 2)
+
+	validator, err := input.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	oldPubKey, err := validator.ConsPubKey()
+	require.NoError(t, err)
+
+	validator2, err := input.StakingKeeper.GetValidator(ctx, valAddr2)
+	require.NoError(t, err)
+	pubKey2, err := validator2.ConsPubKey()
+	require.NoError(t, err)
+
+	// cannot use the key of the other validator
+	msg, err := types.NewMsgRotateConsPubKey(valAddr.String(), pubKey2)
+	require.NoError(t, err)
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.ErrorIs(t, err, types.ErrValidatorPubKeyExists)
+
+	// rotate
+	msg, err = types.NewMsgRotateConsPubKey(valAddr.String(), ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.NoError(t, err)
+
+	// cannot rotate more than max rotations within the unbonding period
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	msg, err = types.NewMsgRotateConsPubKey(valAddr.String(), ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.ErrorIs(t, err, types.ErrConsPubKeyRotationLimitExceeded)
+
+	// cannot take the old key of the rotation in the unbonding period
+	msg, err = types.NewMsgRotateConsPubKey(valAddr2.String(), oldPubKey)
+	require.NoError(t, err)
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.ErrorIs(t, err, types.ErrValidatorPubKeyExists)
+
+	// cannot rotate twice in a block even if the limit allows
+	params, err := input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxConsPubkeyRotations = 10
+	params.KeyRotationFee = sdk.NewCoin(bondDenom, math.ZeroInt())
+	require.NoError(t, input.StakingKeeper.SetParams(ctx, params))
+
+	msg, err = types.NewMsgRotateConsPubKey(valAddr.String(), ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.NoError(t, err)
+
+	msg, err = types.NewMsgRotateConsPubKey(valAddr.String(), ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.ErrorIs(t, err, types.ErrConsPubKeyRotationLimitExceeded)
+}
+
+func Test_RotateConsPubKey_NoLongerBonded(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(input.StakingKeeper)
+
+	valAddr := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 1)
+	createValidatorWithBalance(ctx, input, 100_000_000, 2_000_000, 2)
+
+	validator, err := input.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	oldPubKey, err := validator.ConsPubKey()
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	msg, err := types.NewMsgRotateConsPubKey(valAddr.String(), ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.NoError(t, err)
+
+	// kick the validator out of the bonded set in the same block
+	params, err := input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxValidators = 1
+	require.NoError(t, input.StakingKeeper.SetParams(ctx, params))
+
+	// only the old key, which the consensus engine knows, is removed
+	updates, err := input.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+
+	oldCmtPubKey, err := cryptocodec.ToCmtProtoPublicKey(oldPubKey)
+	require.NoError(t, err)
+	require.Equal(t, oldCmtPubKey, updates[0].PubKey)
+	require.Equal(t, int64(0), updates[0].Power)
+}
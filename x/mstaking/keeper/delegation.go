@@ -342,6 +342,75 @@ func (k Keeper) DequeueAllMatureUBDQueue(ctx context.Context, curTime time.Time)
 	return matureUnbondings, err
 }
 
+// GetUBDQueueEntries returns at most limit unbonding delegation entries whose completion
+// time is in [startTime, endTime] ordered by completion time, skipping the first offset
+// entries. An empty delegator or validator address matches all. The returned flag reports
+// whether more entries remain after the returned ones.
+func (k Keeper) GetUBDQueueEntries(
+	ctx context.Context,
+	startTime, endTime time.Time,
+	delAddr, valAddr string,
+	offset, limit uint64,
+) (entries []types.UnbondingQueueEntry, more bool, err error) {
+	rng := new(collections.Range[time.Time]).StartInclusive(startTime).EndInclusive(endTime)
+
+	count := uint64(0)
+
+	err = k.UnbondingQueue.Walk(ctx, rng, func(completionTime time.Time, dvPairs types.DVPairs) (stop bool, err error) {
+		visited := make(map[types.DVPair]bool)
+		for _, dvPair := range dvPairs.Pairs {
+			if visited[dvPair] {
+				continue
+			}
+			visited[dvPair] = true
+
+			if (delAddr != "" && dvPair.DelegatorAddress != delAddr) || (valAddr != "" && dvPair.ValidatorAddress != valAddr) {
+				continue
+			}
+
+			delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(dvPair.DelegatorAddress)
+			if err != nil {
+				return true, err
+			}
+			validatorAddress, err := k.validatorAddressCodec.StringToBytes(dvPair.ValidatorAddress)
+			if err != nil {
+				return true, err
+			}
+
+			// the unbonding delegation could be removed by the cancellation
+			ubd, err := k.GetUnbondingDelegation(ctx, delegatorAddress, validatorAddress)
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			} else if err != nil {
+				return true, err
+			}
+
+			for _, entry := range ubd.Entries {
+				if !entry.CompletionTime.Equal(completionTime) {
+					continue
+				}
+
+				if count++; count <= offset {
+					continue
+				} else if uint64(len(entries)) == limit {
+					more = true
+					return true, nil
+				}
+
+				entries = append(entries, types.UnbondingQueueEntry{
+					DelegatorAddress: ubd.DelegatorAddress,
+					ValidatorAddress: ubd.ValidatorAddress,
+					Entry:            entry,
+				})
+			}
+		}
+
+		return false, nil
+	})
+
+	return entries, more, err
+}
+
 // return a given amount of all the delegator redelegations
 func (k Keeper) GetRedelegations(
 	ctx context.Context,
@@ -609,6 +678,83 @@ func (k Keeper) DequeueAllMatureRedelegationQueue(ctx context.Context, curTime t
 	return matureRedelegations, err
 }
 
+// GetRedelegationQueueEntries returns at most limit redelegation entries whose completion
+// time is in [startTime, endTime] ordered by completion time, skipping the first offset
+// entries. An empty delegator address matches all. The validator address matches both the
+// source and the destination validators; an empty one matches all. The returned flag
+// reports whether more entries remain after the returned ones.
+func (k Keeper) GetRedelegationQueueEntries(
+	ctx context.Context,
+	startTime, endTime time.Time,
+	delAddr, valAddr string,
+	offset, limit uint64,
+) (entries []types.RedelegationQueueEntry, more bool, err error) {
+	rng := new(collections.Range[time.Time]).StartInclusive(startTime).EndInclusive(endTime)
+
+	count := uint64(0)
+
+	err = k.RedelegationQueue.Walk(ctx, rng, func(completionTime time.Time, triplets types.DVVTriplets) (stop bool, err error) {
+		visited := make(map[types.DVVTriplet]bool)
+		for _, triplet := range triplets.Triplets {
+			if visited[triplet] {
+				continue
+			}
+			visited[triplet] = true
+
+			if delAddr != "" && triplet.DelegatorAddress != delAddr {
+				continue
+			}
+			if valAddr != "" && triplet.ValidatorSrcAddress != valAddr && triplet.ValidatorDstAddress != valAddr {
+				continue
+			}
+
+			delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(triplet.DelegatorAddress)
+			if err != nil {
+				return true, err
+			}
+			valSrcAddr, err := k.validatorAddressCodec.StringToBytes(triplet.ValidatorSrcAddress)
+			if err != nil {
+				return true, err
+			}
+			valDstAddr, err := k.validatorAddressCodec.StringToBytes(triplet.ValidatorDstAddress)
+			if err != nil {
+				return true, err
+			}
+
+			red, err := k.GetRedelegation(ctx, delegatorAddress, valSrcAddr, valDstAddr)
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			} else if err != nil {
+				return true, err
+			}
+
+			for _, entry := range red.Entries {
+				if !entry.CompletionTime.Equal(completionTime) {
+					continue
+				}
+
+				if count++; count <= offset {
+					continue
+				} else if uint64(len(entries)) == limit {
+					more = true
+					return true, nil
+				}
+
+				entries = append(entries, types.RedelegationQueueEntry{
+					DelegatorAddress:    red.DelegatorAddress,
+					ValidatorSrcAddress: red.ValidatorSrcAddress,
+					ValidatorDstAddress: red.ValidatorDstAddress,
+					Entry:               entry,
+				})
+			}
+		}
+
+		return false, nil
+	})
+
+	return entries, more, err
+}
+
 // Delegate performs a delegation, set/update everything necessary within the store.
 // tokenSrc indicates the bond status of the incoming funds.
 func (k Keeper) Delegate(
@@ -1068,6 +1214,121 @@ func (k Keeper) CompleteRedelegation(
 	return balances, nil
 }
 
+// BeginSwapDelegationDenom unbonds the given shares from the source validator,
+// converts the returned coins into the target bond denom through the dex and
+// delegates the converted coins to the destination validator. The conversion is
+// recorded as a redelegation entry, so the converted delegation remains
+// slashable for infractions of the source validator until the entry matures.
+func (k Keeper) BeginSwapDelegationDenom(
+	ctx context.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress,
+	sharesAmount sdk.DecCoins, minTargetAmount sdk.Coin,
+) (completionTime time.Time, targetAmount sdk.Coin, err error) {
+	// the min target amount bounds the conversion of a single source denom
+	if len(sharesAmount) != 1 {
+		return time.Time{}, sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "shares of a single denom must be swapped")
+	}
+
+	srcValidator, err := k.Validators.Get(ctx, valSrcAddr)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return time.Time{}, sdk.Coin{}, types.ErrBadRedelegationSrc
+	} else if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	if _, err := k.Validators.Get(ctx, valDstAddr); err != nil && errors.Is(err, collections.ErrNotFound) {
+		return time.Time{}, sdk.Coin{}, types.ErrBadRedelegationDst
+	} else if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	// check if this is a transitive redelegation
+	if has, err := k.HasReceivingRedelegation(ctx, delAddr, valSrcAddr); err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	} else if has {
+		return time.Time{}, sdk.Coin{}, types.ErrTransitiveRedelegation
+	}
+
+	if has, err := k.HasMaxRedelegationEntries(ctx, delAddr, valSrcAddr, valDstAddr); err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	} else if has {
+		return time.Time{}, sdk.Coin{}, types.ErrMaxRedelegationEntries
+	}
+
+	returnAmount, err := k.Unbond(ctx, delAddr, valSrcAddr, sharesAmount)
+	if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	if returnAmount.IsZero() {
+		return time.Time{}, sdk.Coin{}, types.ErrTinyRedelegationAmount
+	}
+
+	// the dex swap is executed by the delegator, so move the unbonded
+	// coins from the pool of the source validator to the delegator
+	poolName := types.NotBondedPoolName
+	if srcValidator.IsBonded() {
+		poolName = types.BondedPoolName
+	}
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, poolName, delAddr, returnAmount); err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	balanceBefore := k.bankKeeper.GetBalance(ctx, delAddr, minTargetAmount.Denom)
+	if err := k.dexKeeper.SwapBondDenom(ctx, delAddr, returnAmount[0], minTargetAmount); err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	targetAmount = k.bankKeeper.GetBalance(ctx, delAddr, minTargetAmount.Denom).Sub(balanceBefore)
+	if targetAmount.IsZero() {
+		return time.Time{}, sdk.Coin{}, types.ErrTinyRedelegationAmount
+	} else if targetAmount.IsLT(minTargetAmount) {
+		return time.Time{}, sdk.Coin{}, errorsmod.Wrapf(
+			types.ErrSwapSlippageExceeded, "got %s, expected at least %s", targetAmount, minTargetAmount,
+		)
+	}
+
+	// reload the destination validator; the unbond above could have
+	// modified it when the source and destination are the same
+	dstValidator, err := k.Validators.Get(ctx, valDstAddr)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return time.Time{}, sdk.Coin{}, types.ErrBadRedelegationDst
+	} else if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	sharesCreated, err := k.Delegate(ctx, delAddr, sdk.NewCoins(targetAmount), types.Unbonded, dstValidator, true)
+	if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	// create the redelegation entry
+	completionTime, height, completeNow, err := k.getBeginInfo(ctx, valSrcAddr)
+	if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	if completeNow { // no need to create the redelegation object
+		return completionTime, targetAmount, nil
+	}
+
+	// the initial balance is recorded in the target denom converted at the swap,
+	// so the slash amount of the entry matches the denom of the unbonding and
+	// delegation balances at the destination validator
+	red, err := k.SetRedelegationEntry(
+		ctx, delAddr, valSrcAddr, valDstAddr,
+		height, completionTime, sdk.NewCoins(targetAmount), sharesCreated,
+	)
+	if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	if err := k.InsertRedelegationQueue(ctx, red, completionTime); err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	return completionTime, targetAmount, nil
+}
+
 // ValidateUnbondAmount validates that a given unbond or redelegation amount is
 // valid based on upon the converted shares. If the amount is valid, the total
 // amount of respective shares is returned, otherwise an error is returned.
@@ -713,6 +713,128 @@ func Test_BeginRedelegation(t *testing.T) {
 	require.Equal(t, halfCoins, redelegatedCoins)
 }
 
+func Test_BeginSwapDelegationDenom(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	// create dex to register second bond denom
+	baseDenom := bondDenom
+	metadataLP := createDexPool(t, ctx, input, sdk.NewInt64Coin(baseDenom, 1_000_000_000), sdk.NewInt64Coin("uusdc", 2_500_000_000), math.LegacyNewDecWithPrec(8, 1), math.LegacyNewDecWithPrec(2, 1))
+
+	secondBondDenom, err := movetypes.DenomFromMetadataAddress(ctx, movekeeper.NewMoveBankKeeper(&input.MoveKeeper), metadataLP)
+	require.NoError(t, err)
+
+	// update params
+	params, err := input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.BondDenoms = append(params.BondDenoms, secondBondDenom)
+	require.NoError(t, input.StakingKeeper.SetParams(ctx, params))
+
+	valAddr := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 1)
+	valAddr2 := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 2)
+
+	bondCoin := sdk.NewCoin(bondDenom, math.NewInt(1_000_000))
+	delAddr := input.Faucet.NewFundedAccount(ctx, bondCoin)
+
+	validator, err := input.StakingKeeper.Validators.Get(ctx, valAddr)
+	require.NoError(t, err)
+
+	shares, err := input.StakingKeeper.Delegate(ctx, delAddr, sdk.NewCoins(bondCoin), types.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	// slippage exceeded; rejected by the min return of the dex
+	cacheCtx, _ := ctx.CacheContext()
+	_, _, err = input.StakingKeeper.BeginSwapDelegationDenom(cacheCtx, delAddr, valAddr, valAddr2, shares, sdk.NewInt64Coin(secondBondDenom, 1_000_000_000))
+	require.Error(t, err)
+
+	// shares of multiple denoms
+	cacheCtx, _ = ctx.CacheContext()
+	_, _, err = input.StakingKeeper.BeginSwapDelegationDenom(cacheCtx, delAddr, valAddr, valAddr2, shares.Add(sdk.NewInt64DecCoin("uusdc", 1)), sdk.NewInt64Coin(secondBondDenom, 1))
+	require.Error(t, err)
+
+	// swap whole delegation into the second bond denom
+	completeTime, targetAmount, err := input.StakingKeeper.BeginSwapDelegationDenom(ctx, delAddr, valAddr, valAddr2, shares, sdk.NewInt64Coin(secondBondDenom, 1))
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeader().Time.Add(params.UnbondingTime), completeTime)
+	require.Equal(t, secondBondDenom, targetAmount.Denom)
+	require.True(t, targetAmount.Amount.IsPositive())
+
+	// source delegation removed
+	_, err = input.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// destination delegation holds the converted coins
+	delegation, err := input.StakingKeeper.GetDelegation(ctx, delAddr, valAddr2)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(targetAmount), delegation.Shares)
+
+	// no leftovers in the delegator account
+	require.True(t, input.BankKeeper.GetAllBalances(ctx, delAddr).IsZero())
+
+	// conversion is tracked as a redelegation
+	red, err := input.StakingKeeper.GetRedelegation(ctx, delAddr, valAddr, valAddr2)
+	require.NoError(t, err)
+	require.Len(t, red.Entries, 1)
+	require.Equal(t, sdk.NewCoins(targetAmount), red.Entries[0].InitialBalance)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(targetAmount), red.Entries[0].SharesDst)
+}
+
+func Test_SlashAfterSwapDelegationDenomAndUndelegate(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	// create dex to register second bond denom
+	baseDenom := bondDenom
+	metadataLP := createDexPool(t, ctx, input, sdk.NewInt64Coin(baseDenom, 1_000_000_000), sdk.NewInt64Coin("uusdc", 2_500_000_000), math.LegacyNewDecWithPrec(8, 1), math.LegacyNewDecWithPrec(2, 1))
+
+	secondBondDenom, err := movetypes.DenomFromMetadataAddress(ctx, movekeeper.NewMoveBankKeeper(&input.MoveKeeper), metadataLP)
+	require.NoError(t, err)
+
+	// update params
+	params, err := input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.BondDenoms = append(params.BondDenoms, secondBondDenom)
+	require.NoError(t, input.StakingKeeper.SetParams(ctx, params))
+
+	valAddr := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 1)
+	valAddr2 := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 2)
+
+	bondCoin := sdk.NewCoin(bondDenom, math.NewInt(1_000_000))
+	delAddr := input.Faucet.NewFundedAccount(ctx, bondCoin)
+
+	validator, err := input.StakingKeeper.Validators.Get(ctx, valAddr)
+	require.NoError(t, err)
+
+	shares, err := input.StakingKeeper.Delegate(ctx, delAddr, sdk.NewCoins(bondCoin), types.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	// swap and undelegate the converted delegation right away
+	ctx = ctx.WithBlockHeight(11)
+	_, targetAmount, err := input.StakingKeeper.BeginSwapDelegationDenom(ctx, delAddr, valAddr, valAddr2, shares, sdk.NewInt64Coin(secondBondDenom, 1))
+	require.NoError(t, err)
+
+	delegation, err := input.StakingKeeper.GetDelegation(ctx, delAddr, valAddr2)
+	require.NoError(t, err)
+	_, _, err = input.StakingKeeper.Undelegate(ctx, delAddr, valAddr2, delegation.Shares)
+	require.NoError(t, err)
+
+	// slash the source validator for an infraction before the swap
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+
+	fraction := math.LegacyNewDecWithPrec(5, 1)
+	ctx = ctx.WithBlockHeight(12)
+	_, err = input.StakingKeeper.Slash(ctx, consAddr, 10, fraction)
+	require.NoError(t, err)
+
+	// the unbonding delegation is slashed in the target denom
+	ubd, err := input.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr2)
+	require.NoError(t, err)
+	require.Len(t, ubd.Entries, 1)
+
+	slashAmount := math.LegacyNewDecFromInt(targetAmount.Amount).Mul(fraction).TruncateInt()
+	require.True(t, slashAmount.IsPositive())
+	require.Equal(t, sdk.NewCoins(targetAmount.SubAmount(slashAmount)), ubd.Entries[0].Balance)
+}
+
 func Test_ValidateUnbondAmount(t *testing.T) {
 	ctx, input := createDefaultTestInput(t)
 
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log"
 
+	"cosmossdk.io/collections"
+
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -187,6 +189,48 @@ func (k Keeper) InitGenesis(
 		panic(err)
 	}
 
+	for _, event := range data.SlashEvents {
+		if err := k.SetSlashEvent(ctx, event); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, impact := range data.SlashImpacts {
+		if err := k.SetSlashImpact(ctx, impact); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.NextSlashEventId.Set(ctx, data.NextSlashEventId); err != nil {
+		panic(err)
+	}
+
+	for _, history := range data.ConsPubkeyRotationHistories {
+		if err := k.SetConsPubKeyRotationHistory(ctx, history); err != nil {
+			panic(err)
+		}
+
+		// the old consensus address keeps pointing to the validator until the
+		// rotation matures; the removed validators have no index to restore
+		valAddr, err := k.validatorAddressCodec.StringToBytes(history.OperatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		if found, err := k.Validators.Has(ctx, valAddr); err != nil {
+			panic(err)
+		} else if !found {
+			continue
+		}
+
+		oldConsAddr, err := history.GetOldConsAddr()
+		if err != nil {
+			panic(err)
+		}
+		if err := k.ValidatorsByConsAddr.Set(ctx, oldConsAddr, valAddr); err != nil {
+			panic(err)
+		}
+	}
+
 	// don't need to run Tendermint updates if we exported
 	if data.Exported {
 		for _, lv := range data.LastValidatorPowers {
@@ -280,6 +324,38 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	var slashEvents []types.SlashEvent
+	err = k.SlashEvents.Walk(ctx, nil, func(_ collections.Pair[[]byte, uint64], event types.SlashEvent) (stop bool, err error) {
+		slashEvents = append(slashEvents, event)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var slashImpacts []types.DelegatorSlashImpact
+	err = k.SlashImpacts.Walk(ctx, nil, func(_ collections.Pair[[]byte, uint64], impact types.DelegatorSlashImpact) (stop bool, err error) {
+		slashImpacts = append(slashImpacts, impact)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	nextSlashEventId, err := k.NextSlashEventId.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	var rotationHistories []types.ConsPubKeyRotationHistory
+	err = k.ConsPubKeyRotationHistories.Walk(ctx, nil, func(_ collections.Pair[[]byte, int64], history types.ConsPubKeyRotationHistory) (stop bool, err error) {
+		rotationHistories = append(rotationHistories, history)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
 		Params:               params,
 		LastValidatorPowers:  lastValidatorPowers,
@@ -289,5 +365,10 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		Redelegations:        redelegations,
 		Exported:             true,
 		NextUnbondingId:      nextUnbondingId,
+		SlashEvents:          slashEvents,
+		SlashImpacts:         slashImpacts,
+		NextSlashEventId:     nextSlashEventId,
+
+		ConsPubkeyRotationHistories: rotationHistories,
 	}
 }
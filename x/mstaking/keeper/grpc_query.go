@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -411,6 +412,321 @@ func (q Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*type
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// ValidatorPowerAt queries the validator power at the latest power snapshot at or before the given height
+func (q Querier) ValidatorPowerAt(ctx context.Context, req *types.QueryValidatorPowerAtRequest) (*types.QueryValidatorPowerAtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	if _, err := q.Keeper.validatorAddressCodec.StringToBytes(req.ValidatorAddr); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.Height <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must be positive")
+	}
+
+	if req.Height > sdk.UnwrapSDKContext(ctx).BlockHeight() {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d is greater than the current height", req.Height)
+	}
+
+	snapshotHeight, snapshot, err := q.Keeper.GetPowerSnapshotAt(ctx, req.Height)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "power snapshot not found at height %d", req.Height)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	for _, validatorPower := range snapshot.Validators {
+		if validatorPower.OperatorAddress == req.ValidatorAddr {
+			return &types.QueryValidatorPowerAtResponse{
+				SnapshotHeight: snapshotHeight,
+				ValidatorPower: validatorPower,
+			}, nil
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, "validator %s not found in power snapshot at height %d", req.ValidatorAddr, snapshotHeight)
+}
+
+// VotingPowerWeightsAt queries the voting power weights at the latest power snapshot at or before the given height
+func (q Querier) VotingPowerWeightsAt(ctx context.Context, req *types.QueryVotingPowerWeightsAtRequest) (*types.QueryVotingPowerWeightsAtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Height <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must be positive")
+	}
+
+	if req.Height > sdk.UnwrapSDKContext(ctx).BlockHeight() {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d is greater than the current height", req.Height)
+	}
+
+	snapshotHeight, snapshot, err := q.Keeper.GetPowerSnapshotAt(ctx, req.Height)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "power snapshot not found at height %d", req.Height)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVotingPowerWeightsAtResponse{
+		SnapshotHeight:     snapshotHeight,
+		VotingPowerWeights: snapshot.VotingPowerWeights,
+	}, nil
+}
+
+// PendingPowerUpdates queries the consensus power changes carried over to the later blocks
+func (q Querier) PendingPowerUpdates(ctx context.Context, _ *types.QueryPendingPowerUpdatesRequest) (*types.QueryPendingPowerUpdatesResponse, error) {
+	pendingUpdates, err := q.GetPendingPowerUpdates(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingPowerUpdatesResponse{PendingPowerUpdates: pendingUpdates}, nil
+}
+
+// UnbondingQueue queries the unbonding delegation entries maturing in the given time range
+func (q Querier) UnbondingQueue(ctx context.Context, req *types.QueryUnbondingQueueRequest) (*types.QueryUnbondingQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	startTime, endTime, err := q.queueQueryTimeRange(ctx, req.StartTime, req.EndTime, req.DelegatorAddr, req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, limit, err := queueQueryPage(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, more, err := q.GetUBDQueueEntries(ctx, startTime, endTime, req.DelegatorAddr, req.ValidatorAddr, offset, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pageRes := &query.PageResponse{}
+	if more {
+		pageRes.NextKey = sdk.Uint64ToBigEndian(offset + uint64(len(entries)))
+	}
+
+	return &types.QueryUnbondingQueueResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// RedelegationQueue queries the redelegation entries maturing in the given time range
+func (q Querier) RedelegationQueue(ctx context.Context, req *types.QueryRedelegationQueueRequest) (*types.QueryRedelegationQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	startTime, endTime, err := q.queueQueryTimeRange(ctx, req.StartTime, req.EndTime, req.DelegatorAddr, req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, limit, err := queueQueryPage(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, more, err := q.GetRedelegationQueueEntries(ctx, startTime, endTime, req.DelegatorAddr, req.ValidatorAddr, offset, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pageRes := &query.PageResponse{}
+	if more {
+		pageRes.NextKey = sdk.Uint64ToBigEndian(offset + uint64(len(entries)))
+	}
+
+	return &types.QueryRedelegationQueueResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// DelegatorUnbondingSummary queries the total unbonding amounts of a delegator and their completion times
+func (q Querier) DelegatorUnbondingSummary(ctx context.Context, req *types.QueryDelegatorUnbondingSummaryRequest) (*types.QueryDelegatorUnbondingSummaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+
+	delAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	offset, limit, err := queueQueryPage(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	// the total covers all of the unbonding delegations, and only the maturities are paginated
+	var ubds []types.UnbondingDelegation
+	err = q.Keeper.UnbondingDelegations.Walk(ctx, collections.NewPrefixedPairRange[[]byte, []byte](delAddr), func(_ collections.Pair[[]byte, []byte], ubd types.UnbondingDelegation) (stop bool, err error) {
+		ubds = append(ubds, ubd)
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	total, maturities := summarizeUnbondingDelegations(ubds)
+
+	pageRes := &query.PageResponse{}
+	if offset >= uint64(len(maturities)) {
+		maturities = []types.UnbondingMaturity{}
+	} else if end := offset + limit; end < uint64(len(maturities)) {
+		maturities = maturities[offset:end]
+		pageRes.NextKey = sdk.Uint64ToBigEndian(end)
+	} else {
+		maturities = maturities[offset:]
+	}
+
+	return &types.QueryDelegatorUnbondingSummaryResponse{
+		TotalUnbonding: total,
+		Maturities:     maturities,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// ValidatorSlashEvents queries the slash events of a validator
+func (q Querier) ValidatorSlashEvents(ctx context.Context, req *types.QueryValidatorSlashEventsRequest) (*types.QueryValidatorSlashEventsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := q.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	events, pageRes, err := query.CollectionPaginate(
+		ctx, q.Keeper.SlashEvents, req.Pagination,
+		func(_ collections.Pair[[]byte, uint64], event types.SlashEvent) (types.SlashEvent, error) {
+			return event, nil
+		}, query.WithCollectionPaginationPairPrefix[[]byte, uint64](valAddr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorSlashEventsResponse{SlashEvents: events, Pagination: pageRes}, nil
+}
+
+// DelegatorSlashImpacts queries the tokens a delegator lost by the slash events
+func (q Querier) DelegatorSlashImpacts(ctx context.Context, req *types.QueryDelegatorSlashImpactsRequest) (*types.QueryDelegatorSlashImpactsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+
+	delAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.ValidatorAddr != "" {
+		if _, err := q.validatorAddressCodec.StringToBytes(req.ValidatorAddr); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	impacts, pageRes, err := query.CollectionFilteredPaginate(
+		ctx, q.Keeper.SlashImpacts, req.Pagination,
+		func(_ collections.Pair[[]byte, uint64], impact types.DelegatorSlashImpact) (bool, error) {
+			return req.ValidatorAddr == "" || impact.ValidatorAddress == req.ValidatorAddr, nil
+		},
+		func(_ collections.Pair[[]byte, uint64], impact types.DelegatorSlashImpact) (types.DelegatorSlashImpact, error) {
+			return impact, nil
+		}, query.WithCollectionPaginationPairPrefix[[]byte, uint64](delAddr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegatorSlashImpactsResponse{Impacts: impacts, Pagination: pageRes}, nil
+}
+
+// queueQueryTimeRange validates the queue query arguments and returns the time range
+// of the query, which starts at the current block time by default.
+func (q Querier) queueQueryTimeRange(ctx context.Context, startTime, endTime time.Time, delAddr, valAddr string) (time.Time, time.Time, error) {
+	if delAddr != "" {
+		if _, err := q.authKeeper.AddressCodec().StringToBytes(delAddr); err != nil {
+			return time.Time{}, time.Time{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if valAddr != "" {
+		if _, err := q.validatorAddressCodec.StringToBytes(valAddr); err != nil {
+			return time.Time{}, time.Time{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	unbondingTime, err := q.UnbondingTime(ctx)
+	if err != nil {
+		return time.Time{}, time.Time{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if startTime.IsZero() {
+		startTime = sdk.UnwrapSDKContext(ctx).BlockTime()
+	}
+
+	// no entry matures later than the unbonding time from now, so the range
+	// is bounded by the unbonding time
+	if endTime.IsZero() {
+		endTime = startTime.Add(unbondingTime)
+	}
+
+	if endTime.Before(startTime) {
+		return time.Time{}, time.Time{}, status.Error(codes.InvalidArgument, "end time cannot be before start time")
+	} else if endTime.Sub(startTime) > unbondingTime {
+		return time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "time range cannot be longer than the unbonding time %s", unbondingTime)
+	}
+
+	return startTime, endTime, nil
+}
+
+// queueQueryPage returns the offset and the limit of the queue query page. The page
+// key is the big endian encoded offset returned as the next key of the previous page.
+func queueQueryPage(pageReq *query.PageRequest) (uint64, uint64, error) {
+	if pageReq == nil {
+		return 0, query.DefaultLimit, nil
+	}
+
+	offset := pageReq.Offset
+	if len(pageReq.Key) != 0 {
+		if offset != 0 {
+			return 0, 0, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+		} else if len(pageReq.Key) != 8 {
+			return 0, 0, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+
+		offset = sdk.BigEndianToUint64(pageReq.Key)
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	return offset, limit, nil
+}
+
 func queryRedelegation(ctx context.Context, q Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
 	if err != nil {
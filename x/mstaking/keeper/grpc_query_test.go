@@ -2,11 +2,13 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/initia-labs/initia/x/mstaking/keeper"
 	"github.com/initia-labs/initia/x/mstaking/types"
@@ -454,3 +456,249 @@ func Test_grpcParams(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, _params, params.Params)
 }
+
+func Test_grpcQueryValidatorPowerAt(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	valAddr := createValidatorWithBalance(ctx, input, 100_000_000, 2_000_000, 1)
+	valAddrStr, err := input.StakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(t, err)
+
+	params, err := input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.NoError(t, input.StakingKeeper.TrackPowerSnapshot(ctx.WithBlockHeight(int64(params.PowerSnapshotInterval))))
+
+	ctx = ctx.WithBlockHeight(int64(params.PowerSnapshotInterval) + 1)
+	querier := keeper.Querier{&input.StakingKeeper}
+	res, err := querier.ValidatorPowerAt(ctx, &types.QueryValidatorPowerAtRequest{
+		ValidatorAddr: valAddrStr,
+		Height:        int64(params.PowerSnapshotInterval) + 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(params.PowerSnapshotInterval), res.SnapshotHeight)
+	require.Equal(t, valAddrStr, res.ValidatorPower.OperatorAddress)
+	require.Equal(t, math.NewInt(2_000_000), res.ValidatorPower.VotingPower)
+
+	// no snapshot before the first interval
+	_, err = querier.ValidatorPowerAt(ctx, &types.QueryValidatorPowerAtRequest{
+		ValidatorAddr: valAddrStr,
+		Height:        1,
+	})
+	require.Error(t, err)
+
+	// future height
+	_, err = querier.ValidatorPowerAt(ctx, &types.QueryValidatorPowerAtRequest{
+		ValidatorAddr: valAddrStr,
+		Height:        ctx.BlockHeight() + 1,
+	})
+	require.Error(t, err)
+}
+
+func Test_grpcQueryVotingPowerWeightsAt(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params, err := input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.NoError(t, input.StakingKeeper.TrackPowerSnapshot(ctx.WithBlockHeight(int64(params.PowerSnapshotInterval))))
+
+	ctx = ctx.WithBlockHeight(int64(params.PowerSnapshotInterval))
+	querier := keeper.Querier{&input.StakingKeeper}
+	res, err := querier.VotingPowerWeightsAt(ctx, &types.QueryVotingPowerWeightsAtRequest{
+		Height: int64(params.PowerSnapshotInterval),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(params.PowerSnapshotInterval), res.SnapshotHeight)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin(bondDenom, math.OneInt())), res.VotingPowerWeights)
+}
+
+func Test_grpcQueryUnbondingQueue(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	valAddr1 := createValidatorWithBalance(ctx, input, 100_000_000, 2_000_000, 1)
+	valAddrStr1, err := input.StakingKeeper.ValidatorAddressCodec().BytesToString(valAddr1)
+	require.NoError(t, err)
+	valAddr2 := createValidatorWithBalance(ctx, input, 100_000_000, 2_000_000, 2)
+
+	bondCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(2_000_000)))
+	delAddr := input.Faucet.NewFundedAccount(ctx, bondCoins...)
+	delAddrStr, err := input.AccountKeeper.AddressCodec().BytesToString(delAddr)
+	require.NoError(t, err)
+
+	validator, err := input.StakingKeeper.Validators.Get(ctx, valAddr2)
+	require.NoError(t, err)
+	_, err = input.StakingKeeper.Delegate(ctx, delAddr, bondCoins, types.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	completionTime1, _, err := input.StakingKeeper.Undelegate(ctx, valAddr1.Bytes(), valAddr1, sdk.NewDecCoins(sdk.NewInt64DecCoin(bondDenom, 500_000)))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	completionTime2, _, err := input.StakingKeeper.Undelegate(ctx, delAddr, valAddr2, sdk.NewDecCoins(sdk.NewInt64DecCoin(bondDenom, 500_000)))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	completionTime3, _, err := input.StakingKeeper.Undelegate(ctx, delAddr, valAddr2, sdk.NewDecCoins(sdk.NewInt64DecCoin(bondDenom, 700_000)))
+	require.NoError(t, err)
+
+	querier := keeper.Querier{&input.StakingKeeper}
+
+	// query all entries ordered by completion time
+	res, err := querier.UnbondingQueue(ctx, &types.QueryUnbondingQueueRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 3)
+	require.Equal(t, valAddrStr1, res.Entries[0].ValidatorAddress)
+	require.Equal(t, completionTime1, res.Entries[0].Entry.CompletionTime)
+	require.Equal(t, completionTime2, res.Entries[1].Entry.CompletionTime)
+	require.Equal(t, completionTime3, res.Entries[2].Entry.CompletionTime)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 700_000)), res.Entries[2].Entry.Balance)
+
+	// query with the time range
+	res, err = querier.UnbondingQueue(ctx, &types.QueryUnbondingQueueRequest{
+		StartTime: completionTime2,
+		EndTime:   completionTime2,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	require.Equal(t, delAddrStr, res.Entries[0].DelegatorAddress)
+
+	// query with the delegator
+	res, err = querier.UnbondingQueue(ctx, &types.QueryUnbondingQueueRequest{
+		DelegatorAddr: delAddrStr,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 2)
+
+	// query with the validator
+	res, err = querier.UnbondingQueue(ctx, &types.QueryUnbondingQueueRequest{
+		ValidatorAddr: valAddrStr1,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+
+	// the end time must not be before the start time
+	_, err = querier.UnbondingQueue(ctx, &types.QueryUnbondingQueueRequest{
+		StartTime: completionTime2,
+		EndTime:   completionTime1,
+	})
+	require.Error(t, err)
+
+	// the time range cannot be longer than the unbonding time
+	unbondingTime, err := input.StakingKeeper.UnbondingTime(ctx)
+	require.NoError(t, err)
+	_, err = querier.UnbondingQueue(ctx, &types.QueryUnbondingQueueRequest{
+		StartTime: ctx.BlockTime(),
+		EndTime:   ctx.BlockTime().Add(unbondingTime + time.Second),
+	})
+	require.Error(t, err)
+
+	// query with the pagination
+	res, err = querier.UnbondingQueue(ctx, &types.QueryUnbondingQueueRequest{
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 2)
+	require.Equal(t, completionTime2, res.Entries[1].Entry.CompletionTime)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = querier.UnbondingQueue(ctx, &types.QueryUnbondingQueueRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	require.Equal(t, completionTime3, res.Entries[0].Entry.CompletionTime)
+	require.Nil(t, res.Pagination.NextKey)
+
+	// query the delegator summary
+	summary, err := querier.DelegatorUnbondingSummary(ctx, &types.QueryDelegatorUnbondingSummaryRequest{
+		DelegatorAddr: delAddrStr,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_200_000)), summary.TotalUnbonding)
+	require.Equal(t, []types.UnbondingMaturity{
+		{CompletionTime: completionTime2, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500_000))},
+		{CompletionTime: completionTime3, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 700_000))},
+	}, summary.Maturities)
+
+	// the total is not affected by the pagination of the maturities
+	summary, err = querier.DelegatorUnbondingSummary(ctx, &types.QueryDelegatorUnbondingSummaryRequest{
+		DelegatorAddr: delAddrStr,
+		Pagination:    &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_200_000)), summary.TotalUnbonding)
+	require.Len(t, summary.Maturities, 1)
+	require.Equal(t, completionTime2, summary.Maturities[0].CompletionTime)
+	require.NotNil(t, summary.Pagination.NextKey)
+
+	summary, err = querier.DelegatorUnbondingSummary(ctx, &types.QueryDelegatorUnbondingSummaryRequest{
+		DelegatorAddr: delAddrStr,
+		Pagination:    &query.PageRequest{Key: summary.Pagination.NextKey, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_200_000)), summary.TotalUnbonding)
+	require.Len(t, summary.Maturities, 1)
+	require.Equal(t, completionTime3, summary.Maturities[0].CompletionTime)
+	require.Nil(t, summary.Pagination.NextKey)
+}
+
+func Test_grpcQueryRedelegationQueue(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	valAddr1 := createValidatorWithBalance(ctx, input, 100_000_000, 2_000_000, 1)
+	valAddr2 := createValidatorWithBalance(ctx, input, 100_000_000, 2_000_000, 2)
+	valAddr3 := createValidatorWithBalance(ctx, input, 100_000_000, 2_000_000, 3)
+	valAddrStr3, err := input.StakingKeeper.ValidatorAddressCodec().BytesToString(valAddr3)
+	require.NoError(t, err)
+
+	// bond the validators to make the redelegations wait for the unbonding period
+	_, err = input.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+
+	bondCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1_000_000)))
+	delAddr := input.Faucet.NewFundedAccount(ctx, bondCoins...)
+	delAddrStr, err := input.AccountKeeper.AddressCodec().BytesToString(delAddr)
+	require.NoError(t, err)
+
+	validator, err := input.StakingKeeper.Validators.Get(ctx, valAddr1)
+	require.NoError(t, err)
+	_, err = input.StakingKeeper.Delegate(ctx, delAddr, bondCoins, types.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	completionTime1, err := input.StakingKeeper.BeginRedelegation(ctx, valAddr1.Bytes(), valAddr1, valAddr2, sdk.NewDecCoins(sdk.NewInt64DecCoin(bondDenom, 500_000)))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	completionTime2, err := input.StakingKeeper.BeginRedelegation(ctx, delAddr, valAddr1, valAddr3, sdk.NewDecCoins(sdk.NewInt64DecCoin(bondDenom, 500_000)))
+	require.NoError(t, err)
+
+	querier := keeper.Querier{&input.StakingKeeper}
+
+	res, err := querier.RedelegationQueue(ctx, &types.QueryRedelegationQueueRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 2)
+	require.Equal(t, completionTime1, res.Entries[0].Entry.CompletionTime)
+	require.Equal(t, completionTime2, res.Entries[1].Entry.CompletionTime)
+
+	// query with the time range
+	res, err = querier.RedelegationQueue(ctx, &types.QueryRedelegationQueueRequest{
+		EndTime: completionTime1,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+
+	// query with the delegator
+	res, err = querier.RedelegationQueue(ctx, &types.QueryRedelegationQueueRequest{
+		DelegatorAddr: delAddrStr,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	require.Equal(t, valAddrStr3, res.Entries[0].ValidatorDstAddress)
+
+	// the validator matches the destination validator
+	res, err = querier.RedelegationQueue(ctx, &types.QueryRedelegationQueueRequest{
+		ValidatorAddr: valAddrStr3,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	require.Equal(t, delAddrStr, res.Entries[0].DelegatorAddress)
+}
@@ -32,6 +32,7 @@ type Keeper struct {
 	authKeeper        types.AccountKeeper
 	bankKeeper        types.BankKeeper
 	VotingPowerKeeper types.VotingPowerKeeper
+	dexKeeper         types.DexKeeper
 	hooks             types.StakingHooks
 	slashingHooks     types.SlashingHooks
 
@@ -65,6 +66,17 @@ type Keeper struct {
 	ValidatorQueue    collections.Map[time.Time, types.ValAddresses]
 
 	HistoricalInfos collections.Map[int64, cosmostypes.HistoricalInfo]
+	PowerSnapshots  collections.Map[int64, types.PowerSnapshot]
+
+	ConsPubKeyRotationHistories collections.Map[collections.Pair[[]byte, int64], types.ConsPubKeyRotationHistory] // valAddr, height
+	ConsPubKeyRotationQueue     collections.Map[collections.Pair[time.Time, []byte], int64]                       // completionTime, valAddr
+
+	NextSlashEventId collections.Sequence
+	SlashEvents      collections.Map[collections.Pair[[]byte, uint64], types.SlashEvent]           // valAddr, id
+	SlashImpacts     collections.Map[collections.Pair[[]byte, uint64], types.DelegatorSlashImpact] // delAddr, id
+
+	SlashEventQueue          collections.Map[collections.Pair[time.Time, uint64], []byte] // time, id => valAddr
+	SlashImpactsByEventIndex collections.KeySet[collections.Pair[uint64, []byte]]         // id, delAddr
 
 	Params collections.Item[types.Params]
 }
@@ -76,6 +88,7 @@ func NewKeeper(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
 	vk types.VotingPowerKeeper,
+	dk types.DexKeeper,
 	authority string,
 	validatorAddressCodec addresscodec.Codec,
 	consensusAddressCodec addresscodec.Codec,
@@ -102,6 +115,7 @@ func NewKeeper(
 		authKeeper:        ak,
 		bankKeeper:        bk,
 		VotingPowerKeeper: vk,
+		dexKeeper:         dk,
 		hooks:             nil,
 		slashingHooks:     nil,
 
@@ -133,6 +147,17 @@ func NewKeeper(
 		ValidatorQueue:    collections.NewMap(sb, types.ValidatorQueuePrefix, "validator_queue", sdk.TimeKey, codec.CollValue[types.ValAddresses](cdc)),
 
 		HistoricalInfos: collections.NewMap(sb, types.HistoricalInfosPrefix, "historical_infos", collections.Int64Key, codec.CollValue[cosmostypes.HistoricalInfo](cdc)),
+		PowerSnapshots:  collections.NewMap(sb, types.PowerSnapshotsPrefix, "power_snapshots", collections.Int64Key, codec.CollValue[types.PowerSnapshot](cdc)),
+
+		ConsPubKeyRotationHistories: collections.NewMap(sb, types.ConsPubKeyRotationHistoriesPrefix, "cons_pubkey_rotation_histories", collections.PairKeyCodec(collections.BytesKey, collections.Int64Key), codec.CollValue[types.ConsPubKeyRotationHistory](cdc)),
+		ConsPubKeyRotationQueue:     collections.NewMap(sb, types.ConsPubKeyRotationQueuePrefix, "cons_pubkey_rotation_queue", collections.PairKeyCodec(sdk.TimeKey, collections.BytesKey), collections.Int64Value),
+
+		NextSlashEventId: collections.NewSequence(sb, types.NextSlashEventIdKey, "next_slash_event_id"),
+		SlashEvents:      collections.NewMap(sb, types.SlashEventsPrefix, "slash_events", collections.PairKeyCodec(collections.BytesKey, collections.Uint64Key), codec.CollValue[types.SlashEvent](cdc)),
+		SlashImpacts:     collections.NewMap(sb, types.SlashImpactsPrefix, "slash_impacts", collections.PairKeyCodec(collections.BytesKey, collections.Uint64Key), codec.CollValue[types.DelegatorSlashImpact](cdc)),
+
+		SlashEventQueue:          collections.NewMap(sb, types.SlashEventQueuePrefix, "slash_event_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.BytesValue),
+		SlashImpactsByEventIndex: collections.NewKeySet(sb, types.SlashImpactsByEventIndexPrefix, "slash_impacts_by_event_index", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey)),
 
 		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 	}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/mstaking/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	k *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k *Keeper) Migrator {
+	return Migrator{k: k}
+}
+
+// Migrate1to2 migrates the staking params from version 1 to 2. The params added in
+// version 2 are read as zero values from the store, so they are filled with their
+// defaults.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.PowerSnapshotInterval == 0 && params.PowerSnapshotEntries == 0 {
+		params.PowerSnapshotInterval = types.DefaultPowerSnapshotInterval
+		params.PowerSnapshotEntries = types.DefaultPowerSnapshotEntries
+	}
+
+	if params.MaxPowerChangeRate.IsNil() {
+		params.MaxPowerChangeRate = math.LegacyZeroDec()
+	}
+
+	if params.KeyRotationFee.Denom == "" && len(params.BondDenoms) > 0 {
+		params.KeyRotationFee = types.DefaultKeyRotationFee(params.BondDenoms[0])
+	}
+
+	if params.MaxConsPubkeyRotations == 0 {
+		params.MaxConsPubkeyRotations = types.DefaultMaxConsPubKeyRotations
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	return m.k.SetParams(ctx, params)
+}
@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/mstaking/keeper"
+	"github.com/initia-labs/initia/x/mstaking/types"
+)
+
+func Test_Migrate1to2(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	// the params stored by the version 1
+	params, err := input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.PowerSnapshotInterval = 0
+	params.PowerSnapshotEntries = 0
+	params.MaxPowerChangeRate = math.LegacyDec{}
+	params.KeyRotationFee = sdk.Coin{}
+	params.MaxConsPubkeyRotations = 0
+	require.NoError(t, input.StakingKeeper.SetParams(ctx, params))
+
+	m := keeper.NewMigrator(&input.StakingKeeper)
+	require.NoError(t, m.Migrate1to2(ctx))
+
+	params, err = input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultPowerSnapshotInterval, params.PowerSnapshotInterval)
+	require.Equal(t, types.DefaultPowerSnapshotEntries, params.PowerSnapshotEntries)
+	require.True(t, params.MaxPowerChangeRate.IsZero())
+	require.Equal(t, types.DefaultKeyRotationFee(params.BondDenoms[0]), params.KeyRotationFee)
+	require.Equal(t, types.DefaultMaxConsPubKeyRotations, params.MaxConsPubkeyRotations)
+}
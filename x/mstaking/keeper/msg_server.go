@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"errors"
+	"slices"
 	"strconv"
 	"time"
 
@@ -523,6 +524,148 @@ func (k msgServer) CancelUnbondingDelegation(ctx context.Context, msg *types.Msg
 	return &types.MsgCancelUnbondingDelegationResponse{}, nil
 }
 
+// SwapDelegationDenom defines a method for converting delegated coins of one bond denom to another
+// bond denom and redelegating them from a source validator to a destination validator
+func (k msgServer) SwapDelegationDenom(ctx context.Context, msg *types.MsgSwapDelegationDenom) (*types.MsgSwapDelegationDenomResponse, error) {
+	if err := msg.Validate(k.authKeeper.AddressCodec(), k.validatorAddressCodec); err != nil {
+		return nil, err
+	}
+
+	valSrcAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorSrcAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid source validator address: %s", err)
+	}
+
+	valDstAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorDstAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid destination validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	bondDenoms, err := k.BondDenoms(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !types.IsAllBondDenoms(sdk.NewCoins(msg.Amount), bondDenoms) {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected one of %s", msg.Amount.Denom, bondDenoms,
+		)
+	}
+
+	if !slices.Contains(bondDenoms, msg.MinTargetAmount.Denom) {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid target denomination: got %s, expected one of %s", msg.MinTargetAmount.Denom, bondDenoms,
+		)
+	}
+
+	shares, err := k.ValidateUnbondAmount(
+		ctx, delegatorAddress, valSrcAddr, sdk.NewCoins(msg.Amount),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTime, targetAmount, err := k.BeginSwapDelegationDenom(
+		ctx, delegatorAddress, valSrcAddr, valDstAddr, shares, msg.MinTargetAmount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		telemetry.IncrCounter(1, types.ModuleName, "swap_delegation_denom")
+
+		if msg.Amount.Amount.IsInt64() {
+			telemetry.SetGaugeWithLabels(
+				[]string{"tx", "msg", sdk.MsgTypeURL(msg)},
+				float32(msg.Amount.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", msg.Amount.Denom)},
+			)
+		}
+	}()
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSwapDelegationDenom,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeySrcValidator, msg.ValidatorSrcAddress),
+			sdk.NewAttribute(types.AttributeKeyDstValidator, msg.ValidatorDstAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyTargetAmount, targetAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	})
+
+	return &types.MsgSwapDelegationDenomResponse{
+		CompletionTime: completionTime,
+		TargetAmount:   targetAmount,
+	}, nil
+}
+
+// RotateConsPubKey defines a method for rotating the consensus public key of a validator
+func (k msgServer) RotateConsPubKey(ctx context.Context, msg *types.MsgRotateConsPubKey) (*types.MsgRotateConsPubKeyResponse, error) {
+	if err := msg.Validate(k.validatorAddressCodec); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	// validator must already be registered
+	validator, err := k.Validators.Get(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	pk, ok := msg.NewPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cp := sdkCtx.ConsensusParams()
+	if cp.Validator != nil {
+		if !tmstrings.StringInSlice(pk.Type(), cp.Validator.PubKeyTypes) {
+			return nil, errorsmod.Wrapf(
+				types.ErrValidatorPubKeyTypeNotSupported,
+				"got: %s, expected: %s", pk.Type(), cp.Validator.PubKeyTypes,
+			)
+		}
+	}
+
+	oldConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	fee, err := k.RotateValidatorConsPubKey(ctx, validator, pk)
+	if err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounter(1, types.ModuleName, "rotate_cons_pubkey")
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRotateConsPubKey,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyOldConsAddress, oldConsAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyNewConsAddress, sdk.ConsAddress(pk.Address()).String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	})
+
+	return &types.MsgRotateConsPubKeyResponse{}, nil
+}
+
 func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if err := msg.Validate(ms.Keeper.authKeeper.AddressCodec()); err != nil {
 		return nil, err
@@ -59,6 +59,21 @@ func (k Keeper) HistoricalEntries(ctx context.Context) (uint32, error) {
 	return params.HistoricalEntries, nil
 }
 
+// MaxPowerChangeRate - maximum fraction of the last total consensus power
+// which can be changed in a single block
+func (k Keeper) MaxPowerChangeRate(ctx context.Context) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	if params.MaxPowerChangeRate.IsNil() {
+		return math.LegacyZeroDec(), nil
+	}
+
+	return params.MaxPowerChangeRate, nil
+}
+
 // BondDenoms - Bondable coin denominations
 func (k Keeper) BondDenoms(ctx context.Context) ([]string, error) {
 	params, err := k.GetParams(ctx)
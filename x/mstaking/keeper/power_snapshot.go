@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/mstaking/types"
+)
+
+// GetPowerSnapshotAt returns the latest power snapshot recorded at or
+// before the given height together with the snapshot height. The snapshot
+// only covers the heights within a snapshot interval, so the height after
+// the interval of the latest snapshot is not found.
+func (k Keeper) GetPowerSnapshotAt(ctx context.Context, height int64) (int64, types.PowerSnapshot, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, types.PowerSnapshot{}, err
+	}
+
+	iter, err := k.PowerSnapshots.Iterate(ctx, new(collections.Range[int64]).EndInclusive(height).Descending())
+	if err != nil {
+		return 0, types.PowerSnapshot{}, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, types.PowerSnapshot{}, collections.ErrNotFound
+	}
+
+	kv, err := iter.KeyValue()
+	if err != nil {
+		return 0, types.PowerSnapshot{}, err
+	}
+
+	// the snapshot is stale if no snapshot was taken for the interval after it
+	if kv.Key != height && kv.Key+int64(params.PowerSnapshotInterval) <= height {
+		return 0, types.PowerSnapshot{}, collections.ErrNotFound
+	}
+
+	return kv.Key, kv.Value, nil
+}
+
+// TrackPowerSnapshot saves the bonded validator powers and the voting power weights
+// at every snapshot interval and deletes the snapshots that are out of the retention
+// window, which is snapshot interval * snapshot entries blocks.
+func (k Keeper) TrackPowerSnapshot(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	// if there is no need to persist power snapshots, return
+	interval := int64(params.PowerSnapshotInterval)
+	if interval == 0 {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+	if height%interval != 0 {
+		return nil
+	}
+
+	// prune the snapshots which are out of the retention window
+	pruneHeight := height - interval*int64(params.PowerSnapshotEntries)
+	if err := k.PowerSnapshots.Clear(ctx, new(collections.Range[int64]).EndInclusive(pruneHeight)); err != nil {
+		return err
+	}
+
+	if params.PowerSnapshotEntries == 0 {
+		return nil
+	}
+
+	weights, err := k.GetVotingPowerWeights(ctx)
+	if err != nil {
+		return err
+	}
+
+	lastVals, err := k.GetLastValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	validators := make([]types.ValidatorPower, 0, len(lastVals))
+	for _, v := range lastVals {
+		validators = append(validators, types.ValidatorPower{
+			OperatorAddress: v.OperatorAddress,
+			Tokens:          v.Tokens,
+			VotingPowers:    v.VotingPowers,
+			VotingPower:     v.VotingPower,
+		})
+	}
+
+	return k.PowerSnapshots.Set(ctx, height, types.PowerSnapshot{
+		Time:               sdkCtx.BlockTime(),
+		VotingPowerWeights: weights,
+		Validators:         validators,
+	})
+}
@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func Test_PowerSnapshot(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params, err := input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	params.PowerSnapshotInterval = 10
+	params.PowerSnapshotEntries = 2
+	require.NoError(t, input.StakingKeeper.SetParams(ctx, params))
+
+	valAddr := createValidatorWithBalance(ctx, input, 100_000_000, 2_000_000, 1)
+	valAddrStr, err := input.StakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(t, err)
+
+	// not a snapshot height
+	require.NoError(t, input.StakingKeeper.TrackPowerSnapshot(ctx.WithBlockHeight(5)))
+	_, _, err = input.StakingKeeper.GetPowerSnapshotAt(ctx, 5)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	require.NoError(t, input.StakingKeeper.TrackPowerSnapshot(ctx.WithBlockHeight(10)))
+	require.NoError(t, input.StakingKeeper.TrackPowerSnapshot(ctx.WithBlockHeight(20)))
+
+	snapshotHeight, snapshot, err := input.StakingKeeper.GetPowerSnapshotAt(ctx, 15)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), snapshotHeight)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin(bondDenom, math.OneInt())), snapshot.VotingPowerWeights)
+	require.Len(t, snapshot.Validators, 1)
+	require.Equal(t, valAddrStr, snapshot.Validators[0].OperatorAddress)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(2_000_000))), snapshot.Validators[0].Tokens)
+	require.Equal(t, math.NewInt(2_000_000), snapshot.Validators[0].VotingPower)
+
+	// prune the oldest snapshot
+	require.NoError(t, input.StakingKeeper.TrackPowerSnapshot(ctx.WithBlockHeight(30)))
+
+	_, _, err = input.StakingKeeper.GetPowerSnapshotAt(ctx, 15)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	snapshotHeight, _, err = input.StakingKeeper.GetPowerSnapshotAt(ctx, 25)
+	require.NoError(t, err)
+	require.Equal(t, int64(20), snapshotHeight)
+
+	snapshotHeight, _, err = input.StakingKeeper.GetPowerSnapshotAt(ctx, 39)
+	require.NoError(t, err)
+	require.Equal(t, int64(30), snapshotHeight)
+
+	// no snapshot covers the height after the interval of the latest snapshot
+	_, _, err = input.StakingKeeper.GetPowerSnapshotAt(ctx, 100)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
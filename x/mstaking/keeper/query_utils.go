@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"slices"
+	"sort"
 
 	"cosmossdk.io/collections"
 	"github.com/initia-labs/initia/x/mstaking/types"
@@ -74,6 +76,33 @@ func (k Keeper) GetAllUnbondingDelegations(ctx context.Context, delegator sdk.Ac
 	return unbondingDelegations, err
 }
 
+// summarizeUnbondingDelegations returns the total unbonding tokens of the unbonding
+// delegations and the tokens unlocked at each completion time ordered by completion time.
+func summarizeUnbondingDelegations(ubds []types.UnbondingDelegation) (sdk.Coins, []types.UnbondingMaturity) {
+	total := sdk.NewCoins()
+	maturities := []types.UnbondingMaturity{}
+	for _, ubd := range ubds {
+		for _, entry := range ubd.Entries {
+			total = total.Add(entry.Balance...)
+
+			i := sort.Search(len(maturities), func(i int) bool {
+				return !maturities[i].CompletionTime.Before(entry.CompletionTime)
+			})
+			if i < len(maturities) && maturities[i].CompletionTime.Equal(entry.CompletionTime) {
+				maturities[i].Amount = maturities[i].Amount.Add(entry.Balance...)
+				continue
+			}
+
+			maturities = slices.Insert(maturities, i, types.UnbondingMaturity{
+				CompletionTime: entry.CompletionTime,
+				Amount:         sdk.NewCoins(entry.Balance...),
+			})
+		}
+	}
+
+	return total, maturities
+}
+
 // return all redelegations for a delegator
 func (k Keeper) GetAllRedelegations(
 	ctx context.Context, delegator sdk.AccAddress, srcValAddress, dstValAddress sdk.ValAddress,
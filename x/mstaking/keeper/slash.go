@@ -76,6 +76,12 @@ func (k Keeper) Slash(ctx context.Context, consAddr sdk.ConsAddress, infractionH
 		k.Logger(ctx).Error("failed to call before validator modified hook", "error", err)
 	}
 
+	// track the tokens lost by the delegators for the slash event history
+	impacts := newSlashImpacts()
+
+	// the tokens burned from the unbonding delegations and the redelegations
+	burnedFromEntries := sdk.NewCoins()
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	switch {
 	case infractionHeight > sdkCtx.BlockHeight():
@@ -100,9 +106,17 @@ func (k Keeper) Slash(ctx context.Context, consAddr sdk.ConsAddress, infractionH
 		}
 
 		for _, unbondingDelegation := range unbondingDelegations {
-			if _, err := k.SlashUnbondingDelegation(ctx, unbondingDelegation, infractionHeight, slashFactor); err != nil {
+			_, burned, err := k.slashUnbondingDelegation(ctx, unbondingDelegation, infractionHeight, slashFactor)
+			if err != nil {
 				return nil, err
 			}
+
+			if !burned.IsZero() {
+				burnedFromEntries = burnedFromEntries.Add(burned...)
+				if impact := impacts.get(unbondingDelegation.DelegatorAddress); impact != nil {
+					impact.Unbonding = impact.Unbonding.Add(burned...)
+				}
+			}
 		}
 
 		// Iterate through redelegations from slashed source validator
@@ -112,9 +126,17 @@ func (k Keeper) Slash(ctx context.Context, consAddr sdk.ConsAddress, infractionH
 		}
 
 		for _, redelegation := range redelegations {
-			if _, err := k.SlashRedelegation(ctx, validator, redelegation, infractionHeight, slashFactor); err != nil {
+			_, burned, err := k.slashRedelegation(ctx, validator, redelegation, infractionHeight, slashFactor)
+			if err != nil {
 				return nil, err
 			}
+
+			if !burned.IsZero() {
+				burnedFromEntries = burnedFromEntries.Add(burned...)
+				if impact := impacts.get(redelegation.DelegatorAddress); impact != nil {
+					impact.Redelegation = impact.Redelegation.Add(burned...)
+				}
+			}
 		}
 	}
 
@@ -143,6 +165,10 @@ func (k Keeper) Slash(ctx context.Context, consAddr sdk.ConsAddress, infractionH
 			logger.Error("failed to call before validator slashed hook", "error", err)
 			return nil, err
 		}
+
+		if err := k.addDelegationSlashImpacts(ctx, validator, operatorAddress, effectiveFractions, impacts); err != nil {
+			return nil, err
+		}
 	}
 
 	// Deduct from validator's bonded tokens and update the validator.
@@ -165,6 +191,10 @@ func (k Keeper) Slash(ctx context.Context, consAddr sdk.ConsAddress, infractionH
 		panic("invalid validator status")
 	}
 
+	if err := k.recordSlashEvent(ctx, operatorAddress, infractionHeight, slashFactor, tokensToBurn.Add(burnedFromEntries...), impacts); err != nil {
+		return nil, err
+	}
+
 	logger.Info(
 		"validator slashed by slash factor",
 		"validator", validator.GetOperator(),
@@ -221,10 +251,22 @@ func (k Keeper) SlashUnbondingDelegation(
 	infractionHeight int64,
 	slashFactor math.LegacyDec,
 ) (totalSlashAmount sdk.Coins, err error) {
+	totalSlashAmount, _, err = k.slashUnbondingDelegation(ctx, unbondingDelegation, infractionHeight, slashFactor)
+	return totalSlashAmount, err
+}
+
+// slashUnbondingDelegation slashes an unbonding delegation and returns the amount that
+// would have been slashed and the amount actually burned.
+func (k Keeper) slashUnbondingDelegation(
+	ctx context.Context,
+	unbondingDelegation types.UnbondingDelegation,
+	infractionHeight int64,
+	slashFactor math.LegacyDec,
+) (totalSlashAmount, burnedAmount sdk.Coins, err error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	now := sdkCtx.BlockHeader().Time
 	totalSlashAmount = sdk.NewCoins()
-	burnedAmount := sdk.NewCoins()
+	burnedAmount = sdk.NewCoins()
 
 	// perform slashing on all entries within the unbonding delegation
 	for i, entry := range unbondingDelegation.Entries {
@@ -264,15 +306,15 @@ func (k Keeper) SlashUnbondingDelegation(
 		entry.Balance = entry.Balance.Sub(unbondingSlashAmount...)
 		unbondingDelegation.Entries[i] = entry
 		if err := k.SetUnbondingDelegation(ctx, unbondingDelegation); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
 	if err := k.burnNotBondedTokens(ctx, burnedAmount); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return totalSlashAmount, nil
+	return totalSlashAmount, burnedAmount, nil
 }
 
 // slash a redelegation and update the pool
@@ -288,6 +330,19 @@ func (k Keeper) SlashRedelegation(
 	infractionHeight int64,
 	slashFactor math.LegacyDec,
 ) (sdk.Coins, error) {
+	totalSlashAmount, _, err := k.slashRedelegation(ctx, srcValidator, redelegation, infractionHeight, slashFactor)
+	return totalSlashAmount, err
+}
+
+// slashRedelegation slashes a redelegation and returns the amount that would have been
+// slashed and the amount actually burned.
+func (k Keeper) slashRedelegation(
+	ctx context.Context,
+	srcValidator types.Validator,
+	redelegation types.Redelegation,
+	infractionHeight int64,
+	slashFactor math.LegacyDec,
+) (sdk.Coins, sdk.Coins, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	now := sdkCtx.BlockHeader().Time
 	totalSlashAmount := sdk.NewCoins()
@@ -295,12 +350,12 @@ func (k Keeper) SlashRedelegation(
 
 	valDstAddr, err := k.validatorAddressCodec.StringToBytes(redelegation.ValidatorDstAddress)
 	if err != nil {
-		return nil, fmt.Errorf("SlashRedelegation: could not parse validator destination address: %w", err)
+		return nil, nil, fmt.Errorf("SlashRedelegation: could not parse validator destination address: %w", err)
 	}
 
 	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(redelegation.DelegatorAddress)
 	if err != nil {
-		return nil, fmt.Errorf("SlashRedelegation: could not parse delegator address: %w", err)
+		return nil, nil, fmt.Errorf("SlashRedelegation: could not parse delegator address: %w", err)
 	}
 
 	// perform slashing on all entries within the redelegation
@@ -347,7 +402,7 @@ func (k Keeper) SlashRedelegation(
 					entry.Balance = entry.Balance.Sub(unbondingSlashAmount...)
 					unbondingDelegation.Entries[i] = entry
 					if err = k.SetUnbondingDelegation(ctx, unbondingDelegation); err != nil {
-						return nil, err
+						return nil, nil, err
 					}
 				}
 			}
@@ -370,7 +425,7 @@ func (k Keeper) SlashRedelegation(
 			// If deleted, delegation has zero shares, and we can't unbond any more
 			continue
 		} else if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		for i, share := range sharesToUnbond {
@@ -379,12 +434,12 @@ func (k Keeper) SlashRedelegation(
 
 		tokensToBurn, err := k.Unbond(ctx, delAddr, valDstAddr, sharesToUnbond)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		dstValidator, err := k.Validators.Get(ctx, valDstAddr)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		// tokens of a redelegation currently live in the destination validator
@@ -400,12 +455,12 @@ func (k Keeper) SlashRedelegation(
 	}
 
 	if err := k.burnBondedTokens(ctx, bondedBurnedAmount); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if err := k.burnNotBondedTokens(ctx, notBondedBurnedAmount); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return totalSlashAmount, nil
+	return totalSlashAmount, bondedBurnedAmount.Add(notBondedBurnedAmount...), nil
 }
@@ -0,0 +1,232 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/mstaking/types"
+)
+
+// slashImpacts accumulates the tokens lost by the delegators during a slash,
+// keyed by the delegator address.
+type slashImpacts struct {
+	impacts map[string]*types.DelegatorSlashImpact
+
+	// truncated is set once an impact is dropped by the max number of impacts
+	truncated bool
+}
+
+func newSlashImpacts() *slashImpacts {
+	return &slashImpacts{impacts: map[string]*types.DelegatorSlashImpact{}}
+}
+
+// get returns the impact of the delegator, creating an empty one if not exists. It
+// returns nil and marks the impacts truncated if the impacts already reached the max
+// number of impacts per slash event.
+func (impacts *slashImpacts) get(delAddr string) *types.DelegatorSlashImpact {
+	impact, found := impacts.impacts[delAddr]
+	if !found {
+		if len(impacts.impacts) >= types.MaxSlashImpactsPerEvent {
+			impacts.truncated = true
+			return nil
+		}
+
+		impact = &types.DelegatorSlashImpact{
+			DelegatorAddress: delAddr,
+			Delegation:       sdk.NewCoins(),
+			Unbonding:        sdk.NewCoins(),
+			Redelegation:     sdk.NewCoins(),
+		}
+		impacts.impacts[delAddr] = impact
+	}
+
+	return impact
+}
+
+// addDelegationSlashImpacts computes the tokens lost by the delegations to the validator
+// with the effective slash fractions and adds them to the impacts. It stops once the
+// impacts reached the max number of impacts per slash event.
+func (k Keeper) addDelegationSlashImpacts(
+	ctx context.Context,
+	validator types.Validator,
+	valAddr sdk.ValAddress,
+	effectiveFractions sdk.DecCoins,
+	impacts *slashImpacts,
+) error {
+	return k.DelegationsByValIndex.Walk(ctx, collections.NewPrefixedPairRange[[]byte, []byte](valAddr), func(key collections.Pair[[]byte, []byte], _ bool) (stop bool, err error) {
+		delegation, err := k.GetDelegation(ctx, key.K2(), key.K1())
+		if err != nil {
+			return true, err
+		}
+
+		tokens := validator.TokensFromShares(delegation.Shares)
+
+		lost := sdk.NewCoins()
+		for _, fraction := range effectiveFractions {
+			amount := tokens.AmountOf(fraction.Denom).Mul(fraction.Amount).TruncateInt()
+			if amount.IsPositive() {
+				lost = lost.Add(sdk.NewCoin(fraction.Denom, amount))
+			}
+		}
+
+		if lost.IsZero() {
+			return false, nil
+		}
+
+		impact := impacts.get(delegation.DelegatorAddress)
+		if impact == nil {
+			return true, nil
+		}
+
+		impact.Delegation = impact.Delegation.Add(lost...)
+		return false, nil
+	})
+}
+
+// recordSlashEvent stores the slash event of the validator and the impacts of the
+// delegators affected by the slash.
+func (k Keeper) recordSlashEvent(
+	ctx context.Context,
+	valAddr sdk.ValAddress,
+	infractionHeight int64,
+	slashFactor math.LegacyDec,
+	burned sdk.Coins,
+	impacts *slashImpacts,
+) error {
+	id, err := k.NextSlashEventId.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	valAddrStr, err := k.validatorAddressCodec.BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	event := types.SlashEvent{
+		Id:               id,
+		ValidatorAddress: valAddrStr,
+		InfractionHeight: infractionHeight,
+		Height:           sdkCtx.BlockHeight(),
+		Time:             sdkCtx.BlockTime(),
+		SlashFactor:      slashFactor,
+		Burned:           burned,
+		Truncated:        impacts.truncated,
+	}
+	if err := k.SetSlashEvent(ctx, event); err != nil {
+		return err
+	}
+
+	// store the impacts in the order of the delegator address to be deterministic
+	delAddrs := make([]string, 0, len(impacts.impacts))
+	for delAddr := range impacts.impacts {
+		delAddrs = append(delAddrs, delAddr)
+	}
+	sort.Strings(delAddrs)
+
+	for _, delAddrStr := range delAddrs {
+		impact := impacts.impacts[delAddrStr]
+		if impact.Delegation.IsZero() && impact.Unbonding.IsZero() && impact.Redelegation.IsZero() {
+			continue
+		}
+
+		impact.SlashEventId = id
+		impact.ValidatorAddress = valAddrStr
+		impact.Height = event.Height
+		impact.Time = event.Time
+		if err := k.SetSlashImpact(ctx, *impact); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetSlashEvent stores the slash event and queues it to be pruned.
+func (k Keeper) SetSlashEvent(ctx context.Context, event types.SlashEvent) error {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(event.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.SlashEvents.Set(ctx, collections.Join(valAddr, event.Id), event); err != nil {
+		return err
+	}
+
+	return k.SlashEventQueue.Set(ctx, collections.Join(event.Time, event.Id), valAddr)
+}
+
+// SetSlashImpact stores the slash impact of the delegator and indexes it by the slash
+// event id.
+func (k Keeper) SetSlashImpact(ctx context.Context, impact types.DelegatorSlashImpact) error {
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(impact.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.SlashImpacts.Set(ctx, collections.Join(delAddr, impact.SlashEventId), impact); err != nil {
+		return err
+	}
+
+	return k.SlashImpactsByEventIndex.Set(ctx, collections.Join(impact.SlashEventId, delAddr))
+}
+
+// PruneSlashEvents removes the slash events and their impacts older than the unbonding
+// time, as the infractions behind them cannot affect the stake anymore.
+func (k Keeper) PruneSlashEvents(ctx context.Context) error {
+	unbondingTime, err := k.UnbondingTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	cutoff := sdk.UnwrapSDKContext(ctx).BlockTime().Add(-unbondingTime)
+
+	var expired []collections.Pair[time.Time, uint64]
+	var valAddrs [][]byte
+	err = k.SlashEventQueue.Walk(ctx, collections.NewPrefixUntilPairRange[time.Time, uint64](cutoff), func(key collections.Pair[time.Time, uint64], valAddr []byte) (stop bool, err error) {
+		expired = append(expired, key)
+		valAddrs = append(valAddrs, valAddr)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, key := range expired {
+		id := key.K2()
+
+		var delAddrs [][]byte
+		err := k.SlashImpactsByEventIndex.Walk(ctx, collections.NewPrefixedPairRange[uint64, []byte](id), func(key collections.Pair[uint64, []byte]) (stop bool, err error) {
+			delAddrs = append(delAddrs, key.K2())
+			return false, nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, delAddr := range delAddrs {
+			if err := k.SlashImpacts.Remove(ctx, collections.Join(delAddr, id)); err != nil {
+				return err
+			}
+			if err := k.SlashImpactsByEventIndex.Remove(ctx, collections.Join(id, delAddr)); err != nil {
+				return err
+			}
+		}
+
+		if err := k.SlashEvents.Remove(ctx, collections.Join(valAddrs[i], id)); err != nil {
+			return err
+		}
+		if err := k.SlashEventQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	staking "github.com/initia-labs/initia/x/mstaking"
+	"github.com/initia-labs/initia/x/mstaking/keeper"
+	"github.com/initia-labs/initia/x/mstaking/types"
+)
+
+func Test_SlashEventHistory(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	valAddr1 := createValidatorWithBalance(ctx, input, 100_000_000, 2_000_000, 1)
+	valAddr2 := createValidatorWithBalance(ctx, input, 100_000_000, 2_000_000, 2)
+
+	validator, err := input.StakingKeeper.Validators.Get(ctx, valAddr1)
+	require.NoError(t, err)
+
+	// another delegator to the slashed validator
+	bondCoin := sdk.NewCoin(bondDenom, math.NewInt(1_000_000))
+	delAddr := input.Faucet.NewFundedAccount(ctx, bondCoin)
+	_, err = input.StakingKeeper.Delegate(ctx, delAddr, sdk.NewCoins(bondCoin), types.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(90)
+	_, _, err = input.StakingKeeper.Undelegate(ctx, valAddr1.Bytes(), valAddr1, sdk.NewDecCoins(sdk.NewDecCoin(bondDenom, math.NewInt(500_000))))
+	require.NoError(t, err)
+	_, err = input.StakingKeeper.BeginRedelegation(ctx, valAddr1.Bytes(), valAddr1, valAddr2, sdk.NewDecCoins(sdk.NewDecCoin(bondDenom, math.NewInt(500_000))))
+	require.NoError(t, err)
+
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+
+	// 10% slashing
+	ctx = ctx.WithBlockHeight(100)
+	burned, err := input.StakingKeeper.Slash(ctx, consAddr, 90, math.LegacyNewDecWithPrec(1, 1))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 200_000)), burned)
+
+	valAddr1Str, err := input.StakingKeeper.ValidatorAddressCodec().BytesToString(valAddr1)
+	require.NoError(t, err)
+
+	querier := keeper.Querier{Keeper: &input.StakingKeeper}
+	eventsRes, err := querier.ValidatorSlashEvents(ctx, &types.QueryValidatorSlashEventsRequest{ValidatorAddr: valAddr1Str})
+	require.NoError(t, err)
+	require.Len(t, eventsRes.SlashEvents, 1)
+	require.Equal(t, int64(90), eventsRes.SlashEvents[0].InfractionHeight)
+	require.Equal(t, int64(100), eventsRes.SlashEvents[0].Height)
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 1), eventsRes.SlashEvents[0].SlashFactor)
+	require.False(t, eventsRes.SlashEvents[0].Truncated)
+
+	// the burned tokens include the slashed unbonding and redelegation
+	require.Equal(t, burned.Add(sdk.NewInt64Coin(bondDenom, 100_000)), eventsRes.SlashEvents[0].Burned)
+
+	// the validator operator lost from all of the delegation, unbonding and redelegation
+	operatorAddr, err := input.AccountKeeper.AddressCodec().BytesToString(valAddr1.Bytes())
+	require.NoError(t, err)
+	impactsRes, err := querier.DelegatorSlashImpacts(ctx, &types.QueryDelegatorSlashImpactsRequest{DelegatorAddr: operatorAddr})
+	require.NoError(t, err)
+	require.Len(t, impactsRes.Impacts, 1)
+
+	impact := impactsRes.Impacts[0]
+	require.Equal(t, eventsRes.SlashEvents[0].Id, impact.SlashEventId)
+	require.Equal(t, valAddr1Str, impact.ValidatorAddress)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000)), impact.Delegation)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50_000)), impact.Unbonding)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50_000)), impact.Redelegation)
+
+	// the other delegator only lost from the delegation
+	delAddrStr, err := input.AccountKeeper.AddressCodec().BytesToString(delAddr)
+	require.NoError(t, err)
+	impactsRes, err = querier.DelegatorSlashImpacts(ctx, &types.QueryDelegatorSlashImpactsRequest{DelegatorAddr: delAddrStr})
+	require.NoError(t, err)
+	require.Len(t, impactsRes.Impacts, 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000)), impactsRes.Impacts[0].Delegation)
+	require.True(t, impactsRes.Impacts[0].Unbonding.IsZero())
+	require.True(t, impactsRes.Impacts[0].Redelegation.IsZero())
+
+	// filtered by the other validator
+	valAddr2Str, err := input.StakingKeeper.ValidatorAddressCodec().BytesToString(valAddr2)
+	require.NoError(t, err)
+	impactsRes, err = querier.DelegatorSlashImpacts(ctx, &types.QueryDelegatorSlashImpactsRequest{DelegatorAddr: delAddrStr, ValidatorAddr: valAddr2Str})
+	require.NoError(t, err)
+	require.Empty(t, impactsRes.Impacts)
+
+	eventsRes, err = querier.ValidatorSlashEvents(ctx, &types.QueryValidatorSlashEventsRequest{ValidatorAddr: valAddr2Str})
+	require.NoError(t, err)
+	require.Empty(t, eventsRes.SlashEvents)
+
+	// the slash events are exported and imported
+	genState := input.StakingKeeper.ExportGenesis(ctx)
+	require.Len(t, genState.SlashEvents, 1)
+	require.Len(t, genState.SlashImpacts, 2)
+	require.Equal(t, genState.SlashEvents[0].Id+1, genState.NextSlashEventId)
+	require.NoError(t, staking.ValidateGenesis(genState))
+
+	invalidGenState := *genState
+	invalidGenState.NextSlashEventId = genState.SlashEvents[0].Id
+	require.Error(t, staking.ValidateGenesis(&invalidGenState))
+
+	// pruned after the unbonding time
+	unbondingTime, err := input.StakingKeeper.UnbondingTime(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, input.StakingKeeper.PruneSlashEvents(ctx.WithBlockTime(ctx.BlockTime().Add(unbondingTime-time.Second))))
+	eventsRes, err = querier.ValidatorSlashEvents(ctx, &types.QueryValidatorSlashEventsRequest{ValidatorAddr: valAddr1Str})
+	require.NoError(t, err)
+	require.Len(t, eventsRes.SlashEvents, 1)
+
+	require.NoError(t, input.StakingKeeper.PruneSlashEvents(ctx.WithBlockTime(ctx.BlockTime().Add(unbondingTime))))
+	eventsRes, err = querier.ValidatorSlashEvents(ctx, &types.QueryValidatorSlashEventsRequest{ValidatorAddr: valAddr1Str})
+	require.NoError(t, err)
+	require.Empty(t, eventsRes.SlashEvents)
+
+	impactsRes, err = querier.DelegatorSlashImpacts(ctx, &types.QueryDelegatorSlashImpactsRequest{DelegatorAddr: delAddrStr})
+	require.NoError(t, err)
+	require.Empty(t, impactsRes.Impacts)
+
+	// the imported slash events are pruned as well
+	input.StakingKeeper.InitGenesis(ctx, genState)
+	impactsRes, err = querier.DelegatorSlashImpacts(ctx, &types.QueryDelegatorSlashImpactsRequest{DelegatorAddr: delAddrStr})
+	require.NoError(t, err)
+	require.Len(t, impactsRes.Impacts, 1)
+
+	require.NoError(t, input.StakingKeeper.PruneSlashEvents(ctx.WithBlockTime(ctx.BlockTime().Add(unbondingTime))))
+	impactsRes, err = querier.DelegatorSlashImpacts(ctx, &types.QueryDelegatorSlashImpactsRequest{DelegatorAddr: delAddrStr})
+	require.NoError(t, err)
+	require.Empty(t, impactsRes.Impacts)
+}
+
+func Test_SlashEventHistory_Truncated(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	valAddr := createValidatorWithBalance(ctx, input, 100_000_000, 2_000_000, 1)
+
+	validator, err := input.StakingKeeper.Validators.Get(ctx, valAddr)
+	require.NoError(t, err)
+
+	// more delegators than the max number of impacts per slash event
+	bondCoin := sdk.NewCoin(bondDenom, math.NewInt(1_000_000))
+	for i := 0; i < types.MaxSlashImpactsPerEvent; i++ {
+		delAddr := input.Faucet.NewFundedAccount(ctx, bondCoin)
+		validator, err = input.StakingKeeper.Validators.Get(ctx, valAddr)
+		require.NoError(t, err)
+		_, err = input.StakingKeeper.Delegate(ctx, delAddr, sdk.NewCoins(bondCoin), types.Unbonded, validator, true)
+		require.NoError(t, err)
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(100)
+	_, err = input.StakingKeeper.Slash(ctx, consAddr, 100, math.LegacyNewDecWithPrec(1, 1))
+	require.NoError(t, err)
+
+	valAddrStr, err := input.StakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(t, err)
+
+	querier := keeper.Querier{Keeper: &input.StakingKeeper}
+	eventsRes, err := querier.ValidatorSlashEvents(ctx, &types.QueryValidatorSlashEventsRequest{ValidatorAddr: valAddrStr})
+	require.NoError(t, err)
+	require.Len(t, eventsRes.SlashEvents, 1)
+	require.True(t, eventsRes.SlashEvents[0].Truncated)
+}
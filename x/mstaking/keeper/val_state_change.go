@@ -8,6 +8,7 @@ import (
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	"github.com/initia-labs/initia/x/mstaking/types"
@@ -37,6 +38,16 @@ func (k Keeper) BlockValidatorUpdates(ctx context.Context) ([]abci.ValidatorUpda
 		return nil, err
 	}
 
+	// release the old consensus addresses of the mature key rotations
+	if err := k.CompleteMatureConsPubKeyRotations(ctx); err != nil {
+		return nil, err
+	}
+
+	// remove the slash events older than the unbonding time
+	if err := k.PruneSlashEvents(ctx); err != nil {
+		return nil, err
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	// Remove all mature unbonding delegations from the ubd queue.
 	matureUnbonds, err := k.DequeueAllMatureUBDQueue(ctx, sdkCtx.BlockHeader().Time)
@@ -166,6 +177,14 @@ func (k Keeper) ApplyVotingPowerUpdates(ctx context.Context) error {
 // It gets called once after genesis, another time maybe after genesis transactions,
 // then once at every EndBlock.
 //
+// The power changes of the validator set are bounded by the MaxPowerChangeRate
+// param, and the remaining changes are carried over to the later blocks. A validator
+// entering the bonded set is admitted with its power bounded by the remaining budget
+// and reaches its whole power over the later blocks. Its entry is deferred when the
+// budget is exhausted or no slot is free. A validator pushed out of the bonded set
+// loses its power gradually and keeps its slot until it is removed; only jailed or
+// zero-power validators are removed in full.
+//
 // CONTRACT: Only validators with non-zero power or zero-power that were bonded
 // at the previous block height or were removed from the validator set entirely
 // are returned to Tendermint.
@@ -191,35 +210,35 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 		return nil, err
 	}
 
-	counter := 0
-
-	// Iterate over validators, highest power to lowest.
-	err = k.ValidatorsByConsPowerIndex.Walk(ctx, new(collections.PairRange[int64, []byte]).Descending(), func(key collections.Pair[int64, []byte], value bool) (stop bool, err error) {
-		valAddr := key.K2()
-		validator := k.mustGetValidator(ctx, valAddr)
+	// remaining power change budget of this block; negative means no limit
+	budget, err := k.powerChangeBudget(ctx, last)
+	if err != nil {
+		return nil, err
+	}
 
-		if validator.Jailed {
-			panic("should never retrieve a jailed validator from the power store")
+	// bond applies the power of the validator staying in or entering the bonded set
+	bond := func(validator types.Validator) error {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+		if err != nil {
+			return err
 		}
 
-		// if we get to a zero-power validator (which we don't bond),
-		// there are no more possible bonded validators
-		if validator.PotentialConsensusPower(powerReduction) == 0 {
-			return true, nil
-		}
+		// fetch the old power bytes
+		valAddrStr := validator.GetOperator()
+		oldPower, found := last[valAddrStr]
 
 		// apply the appropriate state change if necessary
 		switch {
 		case validator.IsUnbonded():
 			validator, err = k.unbondedToBonded(ctx, validator)
 			if err != nil {
-				return true, err
+				return err
 			}
 			amtFromNotBondedToBonded = amtFromNotBondedToBonded.Add(validator.GetTokens()...)
 		case validator.IsUnbonding():
 			validator, err = k.unbondingToBonded(ctx, validator)
 			if err != nil {
-				return true, err
+				return err
 			}
 			amtFromNotBondedToBonded = amtFromNotBondedToBonded.Add(validator.GetTokens()...)
 		case validator.IsBonded():
@@ -228,34 +247,74 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 			panic("unexpected validator status")
 		}
 
-		// fetch the old power bytes
-		valAddrStr, err := sdk.Bech32ifyAddressBytes(sdk.GetConfig().GetBech32ValidatorAddrPrefix(), valAddr)
-		if err != nil {
-			return true, err
-		}
-
-		oldPower, found := last[valAddrStr]
 		newPower := validator.ConsensusPower(powerReduction)
 
-		// update the validator set if power has changed
-		if !found || oldPower != newPower {
-			updates = append(updates, validator.ABCIValidatorUpdate(powerReduction))
+		// bound the power change of the validator
+		if budget >= 0 {
+			if delta := newPower - oldPower; delta > budget {
+				newPower = oldPower + budget
+			} else if delta < -budget {
+				newPower = oldPower - budget
+			}
 
-			err = k.SetLastValidatorConsPower(ctx, valAddr, newPower)
+			if newPower > oldPower {
+				budget -= newPower - oldPower
+			} else {
+				budget -= oldPower - newPower
+			}
+		}
+
+		// remove the old consensus key of the bonded validator rotated in this block
+		rotation, rotated, err := k.getBlockConsPubKeyRotation(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+		if found && rotated {
+			update, err := rotation.ABCIValidatorUpdateZero()
 			if err != nil {
-				return true, err
+				return err
+			}
+			updates = append(updates, update)
+		}
+
+		// update the validator set if power or consensus key has changed
+		if !found || oldPower != newPower || rotated {
+			update := validator.ABCIValidatorUpdate(powerReduction)
+			update.Power = newPower
+			updates = append(updates, update)
+
+			if err := k.SetLastValidatorConsPower(ctx, valAddr, newPower); err != nil {
+				return err
 			}
 		}
 
 		delete(last, valAddrStr)
-		counter++
+		return nil
+	}
 
-		return counter == int(maxValidators), nil
-	})
+	// the validators eligible for the bonded set, highest power to lowest
+	candidates, err := k.bondedSetCandidates(ctx, maxValidators, powerReduction)
 	if err != nil {
 		return nil, err
 	}
 
+	// apply the power changes of the validators staying in the bonded set first, and
+	// admit the entering validators after the validators pushed out of the set are
+	// removed or reduced
+	entrants := []types.Validator{}
+	bonded := 0
+	for _, validator := range candidates {
+		if _, found := last[validator.GetOperator()]; !found {
+			entrants = append(entrants, validator)
+			continue
+		}
+
+		if err := bond(validator); err != nil {
+			return nil, err
+		}
+		bonded++
+	}
+
 	noLongerBonded, err := sortNoLongerBonded(last, k.validatorAddressCodec)
 	if err != nil {
 		return nil, err
@@ -263,6 +322,44 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 
 	for _, valAddr := range noLongerBonded {
 		validator := k.mustGetValidator(ctx, sdk.ValAddress(valAddr))
+
+		valAddrStr, err := k.validatorAddressCodec.BytesToString(valAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		// the consensus engine only knows the old key of the validator rotated in this block
+		rotation, rotated, err := k.getBlockConsPubKeyRotation(ctx, sdk.ValAddress(valAddr))
+		if err != nil {
+			return nil, err
+		}
+
+		// reduce the power of the validator pushed out of the bonded set gradually
+		oldPower := last[valAddrStr]
+		if budget >= 0 && budget < oldPower && !rotated && !validator.Jailed && validator.PotentialConsensusPower(powerReduction) > 0 {
+			// the validator keeps its slot until it is removed
+			bonded++
+			if budget == 0 {
+				continue
+			}
+
+			newPower := oldPower - budget
+			budget = 0
+
+			update := validator.ABCIValidatorUpdate(powerReduction)
+			update.Power = newPower
+			updates = append(updates, update)
+
+			if err := k.SetLastValidatorConsPower(ctx, valAddr, newPower); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+		if budget >= 0 {
+			budget -= min(budget, oldPower)
+		}
+
 		validator, err = k.bondedToUnbonding(ctx, validator)
 		if err != nil {
 			return nil, err
@@ -272,7 +369,37 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 		if err := k.DeleteLastValidatorConsPower(ctx, valAddr); err != nil {
 			return nil, err
 		}
-		updates = append(updates, validator.ABCIValidatorUpdateZero())
+
+		if rotated {
+			update, err := rotation.ABCIValidatorUpdateZero()
+			if err != nil {
+				return nil, err
+			}
+			updates = append(updates, update)
+		} else {
+			updates = append(updates, validator.ABCIValidatorUpdateZero())
+		}
+	}
+
+	// admit the entering validators to the free slots; no limit is applied if no validator
+	// stays bonded, as for the initial validator set
+	for _, validator := range entrants {
+		if bonded >= int(maxValidators) {
+			break
+		}
+		if bonded == 0 {
+			budget = -1
+		}
+
+		// defer the entry of the validator until the budget is replenished
+		if budget == 0 {
+			break
+		}
+
+		if err := bond(validator); err != nil {
+			return nil, err
+		}
+		bonded++
 	}
 
 	// Update the pools based on the recent updates in the validator set:
@@ -501,6 +628,126 @@ func (k Keeper) completeUnbondingValidator(ctx context.Context, validator types.
 	return validator, nil
 }
 
+// bondedSetCandidates returns the validators eligible for the bonded set, highest power
+// to lowest, up to the max validators.
+func (k Keeper) bondedSetCandidates(ctx context.Context, maxValidators uint32, powerReduction math.Int) ([]types.Validator, error) {
+	candidates := []types.Validator{}
+	err := k.ValidatorsByConsPowerIndex.Walk(ctx, new(collections.PairRange[int64, []byte]).Descending(), func(key collections.Pair[int64, []byte], value bool) (stop bool, err error) {
+		validator := k.mustGetValidator(ctx, key.K2())
+
+		if validator.Jailed {
+			panic("should never retrieve a jailed validator from the power store")
+		}
+
+		// if we get to a zero-power validator (which we don't bond),
+		// there are no more possible bonded validators
+		if validator.PotentialConsensusPower(powerReduction) == 0 {
+			return true, nil
+		}
+
+		candidates = append(candidates, validator)
+		return len(candidates) == int(maxValidators), nil
+	})
+
+	return candidates, err
+}
+
+// powerChangeBudget returns the maximum total consensus power change of the
+// validators staying in the bonded set for a block, or -1 if there is no limit.
+func (k Keeper) powerChangeBudget(ctx context.Context, last validatorsByAddr) (int64, error) {
+	rate, err := k.MaxPowerChangeRate(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if rate.IsZero() {
+		return -1, nil
+	}
+
+	totalPower := int64(0)
+	for _, power := range last {
+		totalPower += power
+	}
+
+	// no limit for the initial validator set
+	if totalPower == 0 {
+		return -1, nil
+	}
+
+	// allow at least one unit of power change to guarantee the convergence
+	budget := rate.MulInt64(totalPower).Ceil().TruncateInt64()
+	if budget < 1 {
+		budget = 1
+	}
+
+	return budget, nil
+}
+
+// GetPendingPowerUpdates returns the validators whose consensus power applied to the
+// consensus engine differs from the power computed from their voting power, including
+// the validators pushed out of the bonded set and the deferred entrants.
+func (k Keeper) GetPendingPowerUpdates(ctx context.Context) ([]types.PendingPowerUpdate, error) {
+	powerReduction := k.PowerReduction(ctx)
+
+	maxValidators, err := k.MaxValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := k.bondedSetCandidates(ctx, maxValidators, powerReduction)
+	if err != nil {
+		return nil, err
+	}
+
+	candidateSet := make(map[string]bool, len(candidates))
+	for _, validator := range candidates {
+		candidateSet[validator.GetOperator()] = true
+	}
+
+	pendingUpdates := []types.PendingPowerUpdate{}
+	bonded := make(map[string]bool)
+	err = k.LastValidatorConsPowers.Walk(ctx, nil, func(valAddr []byte, power int64) (stop bool, err error) {
+		validator, err := k.Validators.Get(ctx, valAddr)
+		if err != nil {
+			return true, err
+		}
+		bonded[validator.GetOperator()] = true
+
+		targetPower := validator.ConsensusPower(powerReduction)
+		if validator.Jailed || !candidateSet[validator.GetOperator()] {
+			targetPower = 0
+		}
+
+		if targetPower != power {
+			pendingUpdates = append(pendingUpdates, types.PendingPowerUpdate{
+				OperatorAddress: validator.OperatorAddress,
+				Power:           power,
+				TargetPower:     targetPower,
+			})
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the entrants deferred until the budget is replenished or a slot is freed
+	for _, validator := range candidates {
+		if bonded[validator.GetOperator()] {
+			continue
+		}
+
+		pendingUpdates = append(pendingUpdates, types.PendingPowerUpdate{
+			OperatorAddress: validator.OperatorAddress,
+			Power:           0,
+			TargetPower:     validator.PotentialConsensusPower(powerReduction),
+		})
+	}
+
+	return pendingUpdates, nil
+}
+
 // map of operator bech32-addresses to serialized power
 // We use bech32 strings here, because we can't have slices as keys: map[[]byte]int64
 type validatorsByAddr map[string]int64
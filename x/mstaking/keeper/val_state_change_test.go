@@ -144,6 +144,189 @@ func Test_ApplyVotingPowerUpdates(t *testing.T) {
 	require.False(t, isWhitelist)
 }
 
+func Test_ApplyAndReturnValidatorSetUpdates_MaxPowerChangeRate(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	vpk := &votingPowerKeeper{}
+	vpk.SetWeights(sdk.NewDecCoins(sdk.NewInt64DecCoin(bondDenom, 1)))
+	input.StakingKeeper.VotingPowerKeeper = vpk
+
+	valAddr1 := createValidatorWithBalance(ctx, input, 1_000_000_000, 100_000_000, 1)
+	valAddr2 := createValidatorWithBalance(ctx, input, 1_000_000_000, 200_000_000, 2)
+
+	power1, err := input.StakingKeeper.GetLastValidatorConsPower(ctx, valAddr1)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), power1)
+	power2, err := input.StakingKeeper.GetLastValidatorConsPower(ctx, valAddr2)
+	require.NoError(t, err)
+	require.Equal(t, int64(200), power2)
+
+	// allow 10% of the total power change per block
+	params, err := input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxPowerChangeRate = math.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, input.StakingKeeper.SetParams(ctx, params))
+
+	// double the weight to shift the power sharply
+	vpk.SetWeights(sdk.NewDecCoins(sdk.NewInt64DecCoin(bondDenom, 2)))
+
+	for i := 0; ; i++ {
+		require.Less(t, i, 100, "power updates do not converge")
+
+		totalPower := int64(0)
+		require.NoError(t, input.StakingKeeper.IterateLastValidatorConsPowers(ctx, func(_ sdk.ValAddress, power int64) (bool, error) {
+			totalPower += power
+			return false, nil
+		}))
+		budget := math.LegacyNewDecWithPrec(1, 1).MulInt64(totalPower).Ceil().TruncateInt64()
+
+		updates, err := input.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+		require.NoError(t, err)
+		if len(updates) == 0 {
+			break
+		}
+
+		newPower1, err := input.StakingKeeper.GetLastValidatorConsPower(ctx, valAddr1)
+		require.NoError(t, err)
+		newPower2, err := input.StakingKeeper.GetLastValidatorConsPower(ctx, valAddr2)
+		require.NoError(t, err)
+
+		// the total power change is bounded by the budget
+		require.LessOrEqual(t, (newPower1-power1)+(newPower2-power2), budget)
+		power1, power2 = newPower1, newPower2
+	}
+
+	require.Equal(t, int64(200), power1)
+	require.Equal(t, int64(400), power2)
+
+	pendingUpdates, err := input.StakingKeeper.GetPendingPowerUpdates(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pendingUpdates)
+}
+
+func Test_ApplyAndReturnValidatorSetUpdates_MaxPowerChangeRate_EntryAndExit(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	vpk := &votingPowerKeeper{}
+	vpk.SetWeights(sdk.NewDecCoins(sdk.NewInt64DecCoin(bondDenom, 1)))
+	input.StakingKeeper.VotingPowerKeeper = vpk
+
+	valAddr1 := createValidatorWithBalance(ctx, input, 1_000_000_000, 100_000_000, 1)
+
+	// allow 10% of the total power change per block with a single validator slot
+	params, err := input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxPowerChangeRate = math.LegacyNewDecWithPrec(1, 1)
+	params.MaxValidators = 1
+	require.NoError(t, input.StakingKeeper.SetParams(ctx, params))
+
+	// the entering validator is deferred while the validator pushed out of the set keeps its slot
+	valAddr2 := createValidatorWithBalance(ctx, input, 1_000_000_000, 200_000_000, 2)
+	power2, err := input.StakingKeeper.GetLastValidatorConsPower(ctx, valAddr2)
+	require.NoError(t, err)
+	require.Zero(t, power2)
+
+	power1, err := input.StakingKeeper.GetLastValidatorConsPower(ctx, valAddr1)
+	require.NoError(t, err)
+	require.Equal(t, int64(90), power1)
+	validator1, err := input.StakingKeeper.Validators.Get(ctx, valAddr1)
+	require.NoError(t, err)
+	require.True(t, validator1.IsBonded())
+
+	// both the exiting and the deferred entering validators are pending
+	valAddrStr1, err := input.StakingKeeper.ValidatorAddressCodec().BytesToString(valAddr1)
+	require.NoError(t, err)
+	valAddrStr2, err := input.StakingKeeper.ValidatorAddressCodec().BytesToString(valAddr2)
+	require.NoError(t, err)
+	pendingUpdates, err := input.StakingKeeper.GetPendingPowerUpdates(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []types.PendingPowerUpdate{
+		{OperatorAddress: valAddrStr1, Power: 90, TargetPower: 0},
+		{OperatorAddress: valAddrStr2, Power: 0, TargetPower: 200},
+	}, pendingUpdates)
+
+	for i := 0; ; i++ {
+		require.Less(t, i, 100, "power updates do not converge")
+
+		totalPower := int64(0)
+		require.NoError(t, input.StakingKeeper.IterateLastValidatorConsPowers(ctx, func(_ sdk.ValAddress, power int64) (bool, error) {
+			totalPower += power
+			return false, nil
+		}))
+		budget := math.LegacyNewDecWithPrec(1, 1).MulInt64(totalPower).Ceil().TruncateInt64()
+
+		updates, err := input.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+		require.NoError(t, err)
+		if len(updates) == 0 {
+			break
+		}
+
+		// the bonded set never exceeds the max validators
+		bonded := 0
+		newTotalPower := int64(0)
+		require.NoError(t, input.StakingKeeper.IterateLastValidatorConsPowers(ctx, func(_ sdk.ValAddress, power int64) (bool, error) {
+			bonded++
+			newTotalPower += power
+			return false, nil
+		}))
+		require.LessOrEqual(t, bonded, 1)
+
+		// the exiting validator is reduced within the budget
+		power2, err = input.StakingKeeper.GetLastValidatorConsPower(ctx, valAddr2)
+		require.NoError(t, err)
+		if power2 == 0 {
+			require.GreaterOrEqual(t, newTotalPower-totalPower, -budget)
+		}
+	}
+
+	power2, err = input.StakingKeeper.GetLastValidatorConsPower(ctx, valAddr2)
+	require.NoError(t, err)
+	require.Equal(t, int64(200), power2)
+
+	power1, err = input.StakingKeeper.GetLastValidatorConsPower(ctx, valAddr1)
+	require.NoError(t, err)
+	require.Zero(t, power1)
+	validator1, err = input.StakingKeeper.Validators.Get(ctx, valAddr1)
+	require.NoError(t, err)
+	require.True(t, validator1.IsUnbonding())
+
+	pendingUpdates, err = input.StakingKeeper.GetPendingPowerUpdates(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pendingUpdates)
+}
+
+func Test_GetPendingPowerUpdates(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	vpk := &votingPowerKeeper{}
+	vpk.SetWeights(sdk.NewDecCoins(sdk.NewInt64DecCoin(bondDenom, 1)))
+	input.StakingKeeper.VotingPowerKeeper = vpk
+
+	valAddr := createValidatorWithBalance(ctx, input, 1_000_000_000, 100_000_000, 1)
+	valAddrStr, err := input.StakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(t, err)
+
+	params, err := input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxPowerChangeRate = math.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, input.StakingKeeper.SetParams(ctx, params))
+
+	vpk.SetWeights(sdk.NewDecCoins(sdk.NewInt64DecCoin(bondDenom, 3)))
+
+	updates, err := input.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+	require.Equal(t, int64(110), updates[0].Power)
+
+	pendingUpdates, err := input.StakingKeeper.GetPendingPowerUpdates(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []types.PendingPowerUpdate{{
+		OperatorAddress: valAddrStr,
+		Power:           110,
+		TargetPower:     300,
+	}}, pendingUpdates)
+}
+
 func Test_UnbondingToBonding(t *testing.T) {
 	ctx, input := createDefaultTestInput(t)
 
@@ -24,7 +24,7 @@ import (
 	"github.com/initia-labs/initia/x/mstaking/types"
 )
 
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 var (
 	_ module.AppModuleBasic      = AppModule{}
@@ -129,6 +129,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), querier)
 	compatibilityQuerier := keeper.CompatibilityQuerier{Keeper: am.keeper}
 	cosmostypes.RegisterQueryServer(cfg.QueryServer(), compatibilityQuerier)
+
+	m := keeper.NewMigrator(&am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the staking module. It returns
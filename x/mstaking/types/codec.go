@@ -18,6 +18,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUndelegate{}, "mstaking/MsgUndelegate")
 	legacy.RegisterAminoMsg(cdc, &MsgBeginRedelegate{}, "mstaking/MsgBeginRedelegate")
 	legacy.RegisterAminoMsg(cdc, &MsgCancelUnbondingDelegation{}, "mstaking/MsgCancelUnbondingDelegation")
+	legacy.RegisterAminoMsg(cdc, &MsgSwapDelegationDenom{}, "mstaking/MsgSwapDelegationDenom")
+	legacy.RegisterAminoMsg(cdc, &MsgRotateConsPubKey{}, "mstaking/MsgRotateConsPubKey")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "mstaking/MsgUpdateParams")
 
 	cdc.RegisterInterface((*isStakeAuthorization_Validators)(nil), nil)
@@ -36,6 +38,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgUndelegate{},
 		&MsgBeginRedelegate{},
 		&MsgCancelUnbondingDelegation{},
+		&MsgSwapDelegationDenom{},
+		&MsgRotateConsPubKey{},
 		&MsgUpdateParams{},
 	)
 	registry.RegisterImplementations(
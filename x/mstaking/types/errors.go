@@ -55,4 +55,6 @@ var (
 	ErrInvalidSigner                   = errorsmod.Register(ModuleName, 43, "expected authority account as only signer for proposal message")
 	ErrBadRedelegationSrc              = errorsmod.Register(ModuleName, 44, "redelegation source validator not found")
 	ErrNoUnbondingType                 = errorsmod.Register(ModuleName, 45, "unbonding type not found")
+	ErrSwapSlippageExceeded            = errorsmod.Register(ModuleName, 46, "converted amount is less than the minimum target amount")
+	ErrConsPubKeyRotationLimitExceeded = errorsmod.Register(ModuleName, 47, "exceeded the maximum consensus key rotations within an unbonding period")
 )
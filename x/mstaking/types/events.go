@@ -10,6 +10,8 @@ const (
 	EventTypeUnbond                    = "unbond"
 	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
 	EventTypeRedelegate                = "redelegate"
+	EventTypeSwapDelegationDenom       = "swap_delegation_denom"
+	EventTypeRotateConsPubKey          = "rotate_cons_pubkey"
 
 	AttributeKeyValidator      = "validator"
 	AttributeKeyCommissionRate = "commission_rate"
@@ -19,5 +21,9 @@ const (
 	AttributeKeyCompletionTime = "completion_time"
 	AttributeKeyCreationHeight = "creation_height"
 	AttributeKeyNewShares      = "new_shares"
+	AttributeKeyTargetAmount   = "target_amount"
+	AttributeKeyOldConsAddress = "old_cons_address"
+	AttributeKeyNewConsAddress = "new_cons_address"
+	AttributeKeyFee            = "fee"
 	AttributeValueCategory     = ModuleName
 )
@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/math"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -13,6 +14,13 @@ type VotingPowerKeeper interface {
 	GetVotingPowerWeights(ctx context.Context, bondDenoms []string) (sdk.DecCoins, error)
 }
 
+// DexKeeper expected move dex keeper (noalias)
+type DexKeeper interface {
+	// SwapBondDenom converts the offer coin of the account into the bond denom of the
+	// min return coin through the whitelisted dex pools.
+	SwapBondDenom(ctx context.Context, addr sdk.AccAddress, offerCoin sdk.Coin, minReturn sdk.Coin) error
+}
+
 // CommunityPoolKeeper expected community pool keeper (noalias)
 type CommunityPoolKeeper interface {
 	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
@@ -41,6 +49,7 @@ type BankKeeper interface {
 	GetSupply(ctx context.Context, denom string) sdk.Coin
 
 	SendCoinsFromModuleToModule(ctx context.Context, senderPool, recipientPool string, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	UndelegateCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	DelegateCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 
@@ -104,6 +113,9 @@ type StakingHooks interface {
 	AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error
 	BeforeValidatorSlashed(ctx context.Context, valAddr sdk.ValAddress, fraction sdk.DecCoins) error
 	AfterUnbondingInitiated(ctx context.Context, id uint64) error
+
+	AfterConsensusPubKeyUpdate(ctx context.Context, oldPubKey, newPubKey cryptotypes.PubKey, valAddr sdk.ValAddress) error // Must be called when a validator's consensus key is rotated
+	AfterConsensusPubKeyRotationMatured(ctx context.Context, oldConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) error    // Must be called when a consensus key rotation matures
 }
 
 type SlashingHooks interface {
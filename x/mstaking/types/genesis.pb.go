@@ -43,6 +43,16 @@ type GenesisState struct {
 	Exported      bool           `protobuf:"varint,7,opt,name=exported,proto3" json:"exported,omitempty"`
 	// sdk missed this
 	NextUnbondingId uint64 `protobuf:"varint,8,opt,name=next_unbonding_id,json=nextUnbondingId,proto3" json:"next_unbonding_id,omitempty"`
+	// slash_events defines the slash events within the unbonding time.
+	SlashEvents []SlashEvent `protobuf:"bytes,9,rep,name=slash_events,json=slashEvents,proto3" json:"slash_events" yaml:"slash_events"`
+	// slash_impacts defines the tokens lost by the delegators by the slash events.
+	SlashImpacts []DelegatorSlashImpact `protobuf:"bytes,10,rep,name=slash_impacts,json=slashImpacts,proto3" json:"slash_impacts" yaml:"slash_impacts"`
+	// next_slash_event_id defines the id of the next slash event.
+	NextSlashEventId uint64 `protobuf:"varint,11,opt,name=next_slash_event_id,json=nextSlashEventId,proto3" json:"next_slash_event_id,omitempty"`
+	// cons_pubkey_rotation_histories defines the consensus pubkey rotations
+	// within the unbonding time. The rotation queue and the old consensus
+	// address indexes are rebuilt from them.
+	ConsPubkeyRotationHistories []ConsPubKeyRotationHistory `protobuf:"bytes,12,rep,name=cons_pubkey_rotation_histories,json=consPubkeyRotationHistories,proto3" json:"cons_pubkey_rotation_histories" yaml:"cons_pubkey_rotation_histories"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -134,6 +144,34 @@ func (m *GenesisState) GetNextUnbondingId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetSlashEvents() []SlashEvent {
+	if m != nil {
+		return m.SlashEvents
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashImpacts() []DelegatorSlashImpact {
+	if m != nil {
+		return m.SlashImpacts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextSlashEventId() uint64 {
+	if m != nil {
+		return m.NextSlashEventId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetConsPubkeyRotationHistories() []ConsPubKeyRotationHistory {
+	if m != nil {
+		return m.ConsPubkeyRotationHistories
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -183,41 +221,50 @@ func init() {
 func init() { proto.RegisterFile("initia/mstaking/v1/genesis.proto", fileDescriptor_57b79fb94e52eb30) }
 
 var fileDescriptor_57b79fb94e52eb30 = []byte{
-	// 538 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0xe3, 0x75, 0xed, 0x3a, 0x17, 0x84, 0x6a, 0x3a, 0x29, 0x54, 0x90, 0x86, 0x1e, 0xa0,
-	0x4c, 0x5a, 0xa2, 0x95, 0xdb, 0x24, 0x0e, 0x44, 0x48, 0x80, 0xc4, 0x61, 0xca, 0x04, 0x07, 0x24,
-	0x54, 0xb9, 0x8b, 0x95, 0x59, 0x24, 0x76, 0x15, 0xbb, 0xa5, 0x7b, 0x00, 0x04, 0x47, 0x1e, 0x61,
-	0x47, 0x8e, 0x1c, 0x78, 0x88, 0x1d, 0x27, 0xc4, 0x81, 0xd3, 0x84, 0xda, 0x03, 0x9c, 0x79, 0x02,
-	0x54, 0xdb, 0x4d, 0xb3, 0x35, 0x97, 0x28, 0x9f, 0xfd, 0xff, 0xfe, 0xbf, 0xbf, 0x2d, 0x7f, 0xd0,
-	0xa5, 0x8c, 0x4a, 0x8a, 0xfd, 0x54, 0x48, 0xfc, 0x9e, 0xb2, 0xd8, 0x9f, 0xec, 0xfb, 0x31, 0x61,
-	0x44, 0x50, 0xe1, 0x8d, 0x32, 0x2e, 0x39, 0x42, 0x5a, 0xe1, 0x2d, 0x15, 0xde, 0x64, 0xbf, 0xdd,
-	0xc4, 0x29, 0x65, 0xdc, 0x57, 0x5f, 0x2d, 0x6b, 0xdf, 0x39, 0xe6, 0x22, 0xe5, 0x62, 0xa0, 0x2a,
-	0x5f, 0x17, 0x66, 0xab, 0x15, 0xf3, 0x98, 0xeb, 0xf5, 0xc5, 0x9f, 0x59, 0x2d, 0x23, 0x2f, 0x11,
-	0x4a, 0xd1, 0xfd, 0x59, 0x85, 0x37, 0x9e, 0xeb, 0x2c, 0x47, 0x12, 0x4b, 0x82, 0x9e, 0xc0, 0xda,
-	0x08, 0x67, 0x38, 0x15, 0x36, 0x70, 0x41, 0xaf, 0xd1, 0x6f, 0x7b, 0xeb, 0xd9, 0xbc, 0x43, 0xa5,
-	0x08, 0xb6, 0xcf, 0x2f, 0x3b, 0xd6, 0xd7, 0x3f, 0xdf, 0x76, 0x41, 0x68, 0x9a, 0xd0, 0x47, 0x00,
-	0x77, 0x12, 0x2c, 0xe4, 0x60, 0x82, 0x13, 0x1a, 0x61, 0xc9, 0xb3, 0xc1, 0x88, 0x7f, 0x20, 0x99,
-	0xb0, 0x37, 0xdc, 0x4a, 0xaf, 0xd1, 0x7f, 0x50, 0x66, 0xf7, 0x0a, 0x0b, 0xf9, 0x66, 0xa9, 0x3f,
-	0x5c, 0xc8, 0x83, 0x47, 0x0b, 0xeb, 0x7f, 0x97, 0x9d, 0xbb, 0xa7, 0x38, 0x4d, 0x0e, 0xba, 0xa5,
-	0x96, 0x5d, 0x8d, 0xbe, 0x9d, 0xac, 0xb5, 0x0b, 0xf4, 0x0e, 0xc2, 0x5c, 0x2e, 0xec, 0x8a, 0x62,
-	0xdf, 0x2b, 0x63, 0xe7, 0x8d, 0x81, 0x63, 0x90, 0x4d, 0x8d, 0x5c, 0xb5, 0x1b, 0x4e, 0xc1, 0x10,
-	0x61, 0xd8, 0x88, 0x48, 0x42, 0x62, 0x2c, 0x29, 0x67, 0xc2, 0xde, 0x54, 0xfe, 0x4e, 0x99, 0xff,
-	0xb3, 0x5c, 0x16, 0x74, 0x0c, 0x00, 0x69, 0x40, 0xc1, 0xc0, 0x10, 0x8a, 0x9e, 0xe8, 0x13, 0x80,
-	0x3b, 0x63, 0x36, 0xe4, 0x2c, 0xa2, 0x2c, 0x1e, 0x14, 0x69, 0x55, 0x45, 0x7b, 0x58, 0x46, 0x7b,
-	0xbd, 0x6c, 0x28, 0x60, 0xaf, 0x5d, 0x65, 0xa9, 0xa7, 0x09, 0xd0, 0x1a, 0xaf, 0xf7, 0x0b, 0x44,
-	0xe1, 0xcd, 0x8c, 0x14, 0x03, 0xd4, 0x54, 0x00, 0xb7, 0x2c, 0x40, 0x58, 0x10, 0x06, 0xf7, 0x0d,
-	0xb9, 0xa5, 0xc9, 0x57, 0x4c, 0x0c, 0xf1, 0xaa, 0x33, 0x6a, 0xc3, 0x3a, 0x99, 0x8e, 0x78, 0x26,
-	0x49, 0x64, 0x6f, 0xb9, 0xa0, 0x57, 0x0f, 0xf3, 0x1a, 0xed, 0xc2, 0x26, 0x23, 0x53, 0x39, 0x58,
-	0x1d, 0x80, 0x46, 0x76, 0xdd, 0x05, 0xbd, 0xcd, 0xf0, 0xd6, 0x62, 0x23, 0x3f, 0xfb, 0xcb, 0xa8,
-	0x7b, 0x02, 0xd1, 0xfa, 0xa3, 0x42, 0x7d, 0xb8, 0x85, 0xa3, 0x28, 0x23, 0x42, 0x3f, 0xee, 0xed,
-	0xc0, 0xfe, 0xf1, 0x7d, 0xaf, 0x65, 0xe6, 0xe8, 0xa9, 0xde, 0x39, 0x92, 0x19, 0x65, 0x71, 0xb8,
-	0x14, 0xa2, 0x16, 0xac, 0xaa, 0xd7, 0x66, 0x6f, 0xb8, 0xa0, 0x57, 0x09, 0x75, 0x71, 0x50, 0xff,
-	0x7c, 0xd6, 0xb1, 0xfe, 0x9e, 0x75, 0xac, 0xe0, 0xc5, 0xf9, 0xcc, 0x01, 0x17, 0x33, 0x07, 0xfc,
-	0x9e, 0x39, 0xe0, 0xcb, 0xdc, 0xb1, 0x2e, 0xe6, 0x8e, 0xf5, 0x6b, 0xee, 0x58, 0x6f, 0xbd, 0x98,
-	0xca, 0x93, 0xf1, 0xd0, 0x3b, 0xe6, 0xa9, 0xaf, 0x6f, 0x6a, 0x2f, 0xc1, 0x43, 0x61, 0xfe, 0xfd,
-	0xe9, 0x6a, 0x2a, 0xe5, 0xe9, 0x88, 0x88, 0x61, 0x4d, 0x4d, 0xe4, 0xe3, 0xff, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xa2, 0x53, 0x0f, 0xd2, 0x2f, 0x04, 0x00, 0x00,
+	// 687 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0xe3, 0xfe, 0x4d, 0x2e, 0xa9, 0xa0, 0xd7, 0x54, 0x32, 0x01, 0x1c, 0x53, 0x09, 0x08,
+	0x95, 0x92, 0xa8, 0x61, 0xab, 0xc4, 0x80, 0x01, 0xd1, 0x0a, 0x86, 0x2a, 0x15, 0x0c, 0x48, 0xc8,
+	0xba, 0xc4, 0x27, 0xe7, 0xd4, 0xd8, 0x67, 0xf9, 0xbd, 0x84, 0x66, 0x46, 0x08, 0x46, 0x3e, 0x42,
+	0x27, 0xc4, 0xc8, 0xc0, 0x87, 0xe8, 0x58, 0x31, 0x31, 0x55, 0xa8, 0x1d, 0x60, 0xe6, 0x13, 0x20,
+	0xdf, 0x5d, 0x12, 0xb7, 0x31, 0x5d, 0x22, 0x9f, 0xef, 0x79, 0x9f, 0xdf, 0xf3, 0xbe, 0x8e, 0x5e,
+	0x64, 0xb3, 0x90, 0x09, 0x46, 0x9a, 0x01, 0x08, 0x72, 0xc0, 0x42, 0xbf, 0x39, 0xdc, 0x6a, 0xfa,
+	0x34, 0xa4, 0xc0, 0xa0, 0x11, 0xc5, 0x5c, 0x70, 0x8c, 0x95, 0xa2, 0x31, 0x56, 0x34, 0x86, 0x5b,
+	0x95, 0x55, 0x12, 0xb0, 0x90, 0x37, 0xe5, 0xaf, 0x92, 0x55, 0x6e, 0x74, 0x39, 0x04, 0x1c, 0x5c,
+	0x79, 0x6a, 0xaa, 0x83, 0xbe, 0x2a, 0xfb, 0xdc, 0xe7, 0xea, 0x7d, 0xf2, 0xa4, 0xdf, 0x66, 0x91,
+	0xc7, 0x08, 0xa9, 0xd8, 0x78, 0x5f, 0x40, 0xa5, 0xe7, 0x2a, 0xcb, 0xbe, 0x20, 0x82, 0xe2, 0x47,
+	0x68, 0x29, 0x22, 0x31, 0x09, 0xc0, 0x34, 0x6c, 0xa3, 0x56, 0x6c, 0x55, 0x1a, 0xb3, 0xd9, 0x1a,
+	0x7b, 0x52, 0xe1, 0x14, 0x8e, 0x4f, 0xab, 0xb9, 0xaf, 0xbf, 0xbf, 0x6d, 0x1a, 0x6d, 0x5d, 0x84,
+	0x3f, 0x18, 0x68, 0xbd, 0x4f, 0x40, 0xb8, 0x43, 0xd2, 0x67, 0x1e, 0x11, 0x3c, 0x76, 0x23, 0xfe,
+	0x8e, 0xc6, 0x60, 0xce, 0xd9, 0xf3, 0xb5, 0x62, 0xeb, 0x5e, 0x96, 0xdd, 0x4b, 0x02, 0xe2, 0xf5,
+	0x58, 0xbf, 0x97, 0xc8, 0x9d, 0x07, 0x89, 0xf5, 0xdf, 0xd3, 0xea, 0xad, 0x11, 0x09, 0xfa, 0xdb,
+	0x1b, 0x99, 0x96, 0x1b, 0x0a, 0xbd, 0xd6, 0x9f, 0x29, 0x07, 0xfc, 0x16, 0xa1, 0x89, 0x1c, 0xcc,
+	0x79, 0xc9, 0xbe, 0x9d, 0xc5, 0x9e, 0x14, 0x3a, 0x96, 0x46, 0xae, 0x2a, 0xe4, 0xb4, 0x5c, 0x73,
+	0x52, 0x86, 0x98, 0xa0, 0xa2, 0x47, 0xfb, 0xd4, 0x27, 0x82, 0xf1, 0x10, 0xcc, 0x05, 0xe9, 0x6f,
+	0x65, 0xf9, 0x3f, 0x9d, 0xc8, 0x9c, 0xaa, 0x06, 0x60, 0x05, 0x48, 0x19, 0x68, 0x42, 0xda, 0x13,
+	0x7f, 0x34, 0xd0, 0xfa, 0x20, 0xec, 0xf0, 0xd0, 0x63, 0xa1, 0xef, 0xa6, 0x69, 0x8b, 0x92, 0x76,
+	0x3f, 0x8b, 0xf6, 0x6a, 0x5c, 0x90, 0xc2, 0x5e, 0x1a, 0x65, 0xa6, 0xa7, 0x0e, 0x50, 0x1e, 0xcc,
+	0xd6, 0x03, 0x66, 0x68, 0x25, 0xa6, 0xe9, 0x00, 0x4b, 0x32, 0x80, 0x9d, 0x15, 0xa0, 0x9d, 0x12,
+	0x3a, 0x77, 0x34, 0xb9, 0xac, 0xc8, 0x31, 0x9d, 0x25, 0x5e, 0x74, 0xc6, 0x15, 0x94, 0xa7, 0x87,
+	0x11, 0x8f, 0x05, 0xf5, 0xcc, 0x65, 0xdb, 0xa8, 0xe5, 0xdb, 0x93, 0x33, 0xde, 0x44, 0xab, 0x21,
+	0x3d, 0x14, 0xee, 0xb4, 0x01, 0xe6, 0x99, 0x79, 0xdb, 0xa8, 0x2d, 0xb4, 0xaf, 0x25, 0x17, 0x93,
+	0xde, 0x77, 0x3d, 0xdc, 0x45, 0x25, 0xe8, 0x13, 0xe8, 0xb9, 0x74, 0x48, 0x43, 0x01, 0x66, 0xe1,
+	0xff, 0x1f, 0x68, 0x3f, 0xd1, 0x3d, 0x4b, 0x64, 0x8e, 0xad, 0xf3, 0xae, 0xa9, 0xbc, 0x69, 0x87,
+	0xf1, 0x17, 0x82, 0x89, 0x1a, 0x70, 0x84, 0x56, 0x94, 0x84, 0x05, 0x11, 0xe9, 0x0a, 0x30, 0x91,
+	0xa4, 0xd4, 0xae, 0xf8, 0x1b, 0xf0, 0x58, 0xe2, 0x76, 0x65, 0xc1, 0xe5, 0xf9, 0x5c, 0x30, 0xd3,
+	0xc0, 0x12, 0x4c, 0xf5, 0x80, 0xeb, 0x68, 0x4d, 0x8e, 0x20, 0x95, 0x2c, 0x19, 0x42, 0x51, 0x0e,
+	0xe1, 0x7a, 0x72, 0x35, 0xed, 0x66, 0xd7, 0xc3, 0x5f, 0x0c, 0x64, 0x75, 0x79, 0x08, 0x6e, 0x34,
+	0xe8, 0x1c, 0xd0, 0x91, 0x1b, 0x73, 0x21, 0xe7, 0xec, 0xf6, 0x18, 0x08, 0x1e, 0x33, 0x0a, 0x66,
+	0x49, 0x46, 0xae, 0x67, 0x45, 0x7e, 0xc2, 0x43, 0xd8, 0x1b, 0x74, 0x5e, 0xd0, 0x51, 0x5b, 0xd7,
+	0xed, 0xc8, 0xb2, 0x91, 0xd3, 0xd2, 0xb9, 0xef, 0xaa, 0xdc, 0x57, 0x23, 0x74, 0x23, 0x37, 0xbb,
+	0xca, 0xee, 0xe0, 0xb2, 0x5d, 0x22, 0xe9, 0x21, 0x3c, 0xbb, 0x03, 0x70, 0x0b, 0x2d, 0x13, 0xcf,
+	0x8b, 0x29, 0xa8, 0x5d, 0x54, 0x70, 0xcc, 0x1f, 0xdf, 0xeb, 0x65, 0xbd, 0xf6, 0x1e, 0xab, 0x9b,
+	0x7d, 0x11, 0xb3, 0xd0, 0x6f, 0x8f, 0x85, 0xb8, 0x8c, 0x16, 0xe5, 0x72, 0x30, 0xe7, 0x6c, 0xa3,
+	0x36, 0xdf, 0x56, 0x87, 0xed, 0xfc, 0xa7, 0xa3, 0x6a, 0xee, 0xcf, 0x51, 0x35, 0xe7, 0xec, 0x1c,
+	0x9f, 0x59, 0xc6, 0xc9, 0x99, 0x65, 0xfc, 0x3a, 0xb3, 0x8c, 0xcf, 0xe7, 0x56, 0xee, 0xe4, 0xdc,
+	0xca, 0xfd, 0x3c, 0xb7, 0x72, 0x6f, 0x1a, 0x3e, 0x13, 0xbd, 0x41, 0xa7, 0xd1, 0xe5, 0x41, 0x53,
+	0x4d, 0xa3, 0xde, 0x27, 0x1d, 0xd0, 0xcf, 0xcd, 0xc3, 0xe9, 0x12, 0x15, 0xa3, 0x88, 0x42, 0x67,
+	0x49, 0x2e, 0xd0, 0x87, 0xff, 0x06, 0x00, 0x42, 0xa5, 0xbc, 0xd0, 0xde, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -240,6 +287,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConsPubkeyRotationHistories) > 0 {
+		for iNdEx := len(m.ConsPubkeyRotationHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsPubkeyRotationHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.NextSlashEventId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextSlashEventId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.SlashImpacts) > 0 {
+		for iNdEx := len(m.SlashImpacts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashImpacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.SlashEvents) > 0 {
+		for iNdEx := len(m.SlashEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.NextUnbondingId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextUnbondingId))
 		i--
@@ -428,6 +522,27 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextUnbondingId != 0 {
 		n += 1 + sovGenesis(uint64(m.NextUnbondingId))
 	}
+	if len(m.SlashEvents) > 0 {
+		for _, e := range m.SlashEvents {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlashImpacts) > 0 {
+		for _, e := range m.SlashImpacts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextSlashEventId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextSlashEventId))
+	}
+	if len(m.ConsPubkeyRotationHistories) > 0 {
+		for _, e := range m.ConsPubkeyRotationHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -724,6 +839,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashEvents = append(m.SlashEvents, SlashEvent{})
+			if err := m.SlashEvents[len(m.SlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashImpacts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashImpacts = append(m.SlashImpacts, DelegatorSlashImpact{})
+			if err := m.SlashImpacts[len(m.SlashImpacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSlashEventId", wireType)
+			}
+			m.NextSlashEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSlashEventId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsPubkeyRotationHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsPubkeyRotationHistories = append(m.ConsPubkeyRotationHistories, ConsPubKeyRotationHistory{})
+			if err := m.ConsPubkeyRotationHistories[len(m.ConsPubkeyRotationHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
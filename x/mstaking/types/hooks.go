@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"cosmossdk.io/math"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -118,6 +119,26 @@ func (h MultiStakingHooks) AfterUnbondingInitiated(ctx context.Context, id uint6
 	return nil
 }
 
+func (h MultiStakingHooks) AfterConsensusPubKeyUpdate(ctx context.Context, oldPubKey, newPubKey cryptotypes.PubKey, valAddr sdk.ValAddress) error {
+	var err error
+	for i := range h {
+		if err = h[i].AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey, valAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiStakingHooks) AfterConsensusPubKeyRotationMatured(ctx context.Context, oldConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	var err error
+	for i := range h {
+		if err = h[i].AfterConsensusPubKeyRotationMatured(ctx, oldConsAddr, valAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // combine multiple slashing hooks, all hook functions are run in array sequence
 var _ SlashingHooks = &MultiSlashingHooks{}
 
@@ -40,7 +40,19 @@ var (
 	RedelegationQueuePrefix = []byte{0x52} // prefix for the timestamps in redelegations queue
 	ValidatorQueuePrefix    = []byte{0x53} // prefix for the timestamps in validator queue
 
+	ConsPubKeyRotationQueuePrefix = []byte{0x54} // prefix for the timestamps in consensus key rotation queue
+	SlashEventQueuePrefix         = []byte{0x55} // prefix for the timestamps in slash event queue
+
 	HistoricalInfosPrefix = []byte{0x61} // prefix for the historical info
+	PowerSnapshotsPrefix  = []byte{0x62} // prefix for the voting power snapshots
+
+	ConsPubKeyRotationHistoriesPrefix = []byte{0x63} // prefix for the consensus key rotation histories
+
+	NextSlashEventIdKey = []byte{0x64} // key for the counter for the incrementing id for slash events
+	SlashEventsPrefix   = []byte{0x65} // prefix for the slash events, by validator operator
+	SlashImpactsPrefix  = []byte{0x66} // prefix for the slash impacts, by delegator
+
+	SlashImpactsByEventIndexPrefix = []byte{0x67} // prefix for each key for a slash impact, by slash event id
 
 	ParamsKey = []byte{0x71} // prefix for parameters for module x/staking
 )
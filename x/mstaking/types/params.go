@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -33,10 +34,30 @@ const (
 
 	// DefaultMinVotingPower minimum voting power to get into power update validator set
 	DefaultMinVotingPower uint64 = 1_000_000
+
+	// DefaultPowerSnapshotInterval is the number of blocks between voting power snapshots
+	DefaultPowerSnapshotInterval uint32 = 100
+
+	// DefaultPowerSnapshotEntries is the number of voting power snapshots to persist
+	DefaultPowerSnapshotEntries uint32 = 1000
+
+	// DefaultMaxConsPubKeyRotations is the maximum number of consensus key rotations
+	// within an unbonding period
+	DefaultMaxConsPubKeyRotations uint32 = 1
+
+	// MaxConsPubKeyRotationsLimit is the upper bound of the MaxConsPubkeyRotations param
+	MaxConsPubKeyRotationsLimit uint32 = 100
+
+	// DefaultKeyRotationFeeAmount is the amount of the bond denom charged for a
+	// consensus key rotation
+	DefaultKeyRotationFeeAmount int64 = 1_000_000
 )
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries uint32, historicalEntries uint32, bondDenoms []string, minVotingPower uint64) Params {
+func NewParams(
+	unbondingTime time.Duration, maxValidators, maxEntries uint32, historicalEntries uint32, bondDenoms []string, minVotingPower uint64,
+	powerSnapshotInterval, powerSnapshotEntries uint32, maxPowerChangeRate math.LegacyDec, keyRotationFee sdk.Coin, maxConsPubkeyRotations uint32,
+) Params {
 	return Params{
 		UnbondingTime:     unbondingTime,
 		MaxValidators:     maxValidators,
@@ -44,6 +65,13 @@ func NewParams(unbondingTime time.Duration, maxValidators, maxEntries uint32, hi
 		HistoricalEntries: historicalEntries,
 		BondDenoms:        bondDenoms,
 		MinVotingPower:    minVotingPower,
+
+		PowerSnapshotInterval: powerSnapshotInterval,
+		PowerSnapshotEntries:  powerSnapshotEntries,
+		MaxPowerChangeRate:    maxPowerChangeRate,
+
+		KeyRotationFee:         keyRotationFee,
+		MaxConsPubkeyRotations: maxConsPubkeyRotations,
 	}
 }
 
@@ -56,9 +84,19 @@ func DefaultParams() Params {
 		DefaultHistoricalEntries,
 		[]string{sdk.DefaultBondDenom},
 		DefaultMinVotingPower,
+		DefaultPowerSnapshotInterval,
+		DefaultPowerSnapshotEntries,
+		math.LegacyZeroDec(),
+		DefaultKeyRotationFee(sdk.DefaultBondDenom),
+		DefaultMaxConsPubKeyRotations,
 	)
 }
 
+// DefaultKeyRotationFee returns the default consensus key rotation fee in the given bond denom.
+func DefaultKeyRotationFee(bondDenom string) sdk.Coin {
+	return sdk.NewInt64Coin(bondDenom, DefaultKeyRotationFeeAmount)
+}
+
 // String returns a human readable string representation of the parameters.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
@@ -111,6 +149,30 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validatePowerSnapshotInterval(p.PowerSnapshotInterval); err != nil {
+		return err
+	}
+
+	if err := validatePowerSnapshotEntries(p.PowerSnapshotEntries); err != nil {
+		return err
+	}
+
+	if err := validateMaxPowerChangeRate(p.MaxPowerChangeRate); err != nil {
+		return err
+	}
+
+	if err := validateKeyRotationFee(p.KeyRotationFee); err != nil {
+		return err
+	}
+
+	if p.KeyRotationFee.Denom != "" && !slices.Contains(p.BondDenoms, p.KeyRotationFee.Denom) {
+		return fmt.Errorf("key rotation fee denom must be one of the bond denoms: %s", p.KeyRotationFee.Denom)
+	}
+
+	if err := validateMaxConsPubKeyRotations(p.MaxConsPubkeyRotations); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -206,3 +268,78 @@ func validateMinVotingPower(i interface{}) error {
 
 	return nil
 }
+
+func validatePowerSnapshotInterval(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePowerSnapshotEntries(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxPowerChangeRate(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// nil is allowed for the backward compatibility and it disables the limit
+	if v.IsNil() {
+		return nil
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("max power change rate cannot be negative: %s", v)
+	}
+
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max power change rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateKeyRotationFee(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// empty fee is allowed for the backward compatibility and it means no fee
+	if v.Denom == "" && v.Amount.IsNil() {
+		return nil
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid key rotation fee: %w", err)
+	}
+
+	return nil
+}
+
+func validateMaxConsPubKeyRotations(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max consensus pubkey rotations must be positive: %d", v)
+	}
+
+	if v > MaxConsPubKeyRotationsLimit {
+		return fmt.Errorf("max consensus pubkey rotations too large: %d > %d", v, MaxConsPubKeyRotationsLimit)
+	}
+
+	return nil
+}
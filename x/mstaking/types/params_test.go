@@ -21,3 +21,19 @@ func TestParamsEqual(t *testing.T) {
 	ok = p1.Equal(p2)
 	require.False(t, ok)
 }
+
+func TestParamsValidateKeyRotation(t *testing.T) {
+	params := types.DefaultParams()
+	require.NoError(t, params.Validate())
+	require.Equal(t, params.BondDenoms[0], params.KeyRotationFee.Denom)
+
+	params.MaxConsPubkeyRotations = 0
+	require.Error(t, params.Validate())
+
+	params.MaxConsPubkeyRotations = types.MaxConsPubKeyRotationsLimit + 1
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.KeyRotationFee.Denom = "soup"
+	require.Error(t, params.Validate())
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ codectypes.UnpackInterfacesMessage = ConsPubKeyRotationHistory{}
+
+// NewConsPubKeyRotationHistory creates a new ConsPubKeyRotationHistory instance
+func NewConsPubKeyRotationHistory(
+	operatorAddr string, oldPubKey, newPubKey cryptotypes.PubKey,
+	height int64, completionTime time.Time, fee sdk.Coin,
+) (ConsPubKeyRotationHistory, error) {
+	oldPkAny, err := codectypes.NewAnyWithValue(oldPubKey)
+	if err != nil {
+		return ConsPubKeyRotationHistory{}, err
+	}
+
+	newPkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return ConsPubKeyRotationHistory{}, err
+	}
+
+	return ConsPubKeyRotationHistory{
+		OperatorAddress: operatorAddr,
+		OldConsPubkey:   oldPkAny,
+		NewConsPubkey:   newPkAny,
+		Height:          height,
+		CompletionTime:  completionTime,
+		Fee:             fee,
+	}, nil
+}
+
+// GetOldConsPubKey returns the consensus public key before the rotation
+func (h ConsPubKeyRotationHistory) GetOldConsPubKey() (cryptotypes.PubKey, error) {
+	pk, ok := h.OldConsPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	return pk, nil
+}
+
+// GetOldConsAddr returns the consensus address before the rotation
+func (h ConsPubKeyRotationHistory) GetOldConsAddr() (sdk.ConsAddress, error) {
+	pk, err := h.GetOldConsPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.ConsAddress(pk.Address()), nil
+}
+
+// ABCIValidatorUpdateZero returns an abci.ValidatorUpdate which removes
+// the consensus public key before the rotation from the consensus engine
+func (h ConsPubKeyRotationHistory) ABCIValidatorUpdateZero() (abci.ValidatorUpdate, error) {
+	pk, err := h.GetOldConsPubKey()
+	if err != nil {
+		return abci.ValidatorUpdate{}, err
+	}
+
+	tmProtoPk, err := cryptocodec.ToCmtProtoPublicKey(pk)
+	if err != nil {
+		return abci.ValidatorUpdate{}, err
+	}
+
+	return abci.ValidatorUpdate{
+		PubKey: tmProtoPk,
+		Power:  0,
+	}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (h ConsPubKeyRotationHistory) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	if err := unpacker.UnpackAny(h.OldConsPubkey, &pk); err != nil {
+		return err
+	}
+
+	return unpacker.UnpackAny(h.NewConsPubkey, &pk)
+}
@@ -0,0 +1,5 @@
+package types
+
+// MaxSlashImpactsPerEvent is the maximum number of delegator impacts recorded for a
+// slash event, which bounds the state written by a slash.
+const MaxSlashImpactsPerEvent = 1000
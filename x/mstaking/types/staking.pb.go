@@ -894,6 +894,20 @@ type Params struct {
 	MinVotingPower uint64 `protobuf:"varint,6,opt,name=min_voting_power,json=minVotingPower,proto3" json:"min_voting_power,omitempty" yaml:"min_voting_power"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// power_snapshot_interval is the number of blocks between voting power snapshots.
+	// zero disables the voting power snapshots.
+	PowerSnapshotInterval uint32 `protobuf:"varint,8,opt,name=power_snapshot_interval,json=powerSnapshotInterval,proto3" json:"power_snapshot_interval,omitempty" yaml:"power_snapshot_interval"`
+	// power_snapshot_entries is the number of voting power snapshots to persist.
+	PowerSnapshotEntries uint32 `protobuf:"varint,9,opt,name=power_snapshot_entries,json=powerSnapshotEntries,proto3" json:"power_snapshot_entries,omitempty" yaml:"power_snapshot_entries"`
+	// max_power_change_rate is the maximum fraction of the last total consensus power which
+	// can be changed in a single block by the power changes of the bonded validators. The
+	// remaining changes are carried over to the later blocks. zero disables the limit.
+	MaxPowerChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=max_power_change_rate,json=maxPowerChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_power_change_rate" yaml:"max_power_change_rate"`
+	// key_rotation_fee is the fee charged to the validator operator for a consensus key rotation.
+	KeyRotationFee types1.Coin `protobuf:"bytes,11,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee" yaml:"key_rotation_fee"`
+	// max_cons_pubkey_rotations is the maximum number of consensus key rotations
+	// a validator can perform within an unbonding period.
+	MaxConsPubkeyRotations uint32 `protobuf:"varint,12,opt,name=max_cons_pubkey_rotations,json=maxConsPubkeyRotations,proto3" json:"max_cons_pubkey_rotations,omitempty" yaml:"max_cons_pubkey_rotations"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -970,24 +984,64 @@ func (m *Params) GetMinVotingPower() uint64 {
 	return 0
 }
 
-// DelegationResponse is equivalent to Delegation except that it contains a
-// balance in addition to shares which is more suitable for client responses.
-type DelegationResponse struct {
-	Delegation Delegation                               `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
-	Balance    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+func (m *Params) GetPowerSnapshotInterval() uint32 {
+	if m != nil {
+		return m.PowerSnapshotInterval
+	}
+	return 0
 }
 
-func (m *DelegationResponse) Reset()      { *m = DelegationResponse{} }
-func (*DelegationResponse) ProtoMessage() {}
-func (*DelegationResponse) Descriptor() ([]byte, []int) {
+func (m *Params) GetPowerSnapshotEntries() uint32 {
+	if m != nil {
+		return m.PowerSnapshotEntries
+	}
+	return 0
+}
+
+func (m *Params) GetKeyRotationFee() types1.Coin {
+	if m != nil {
+		return m.KeyRotationFee
+	}
+	return types1.Coin{}
+}
+
+func (m *Params) GetMaxConsPubkeyRotations() uint32 {
+	if m != nil {
+		return m.MaxConsPubkeyRotations
+	}
+	return 0
+}
+
+// ConsPubKeyRotationHistory defines a consensus key rotation of a validator which
+// is not yet matured. The old consensus key remains slashable until the rotation
+// matures after an unbonding period.
+type ConsPubKeyRotationHistory struct {
+	// operator_address defines the address of the validator's operator; bech encoded in JSON.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty" yaml:"operator_address"`
+	// old_cons_pubkey is the consensus public key before the rotation.
+	OldConsPubkey *types.Any `protobuf:"bytes,2,opt,name=old_cons_pubkey,json=oldConsPubkey,proto3" json:"old_cons_pubkey,omitempty" yaml:"old_cons_pubkey"`
+	// new_cons_pubkey is the consensus public key after the rotation.
+	NewConsPubkey *types.Any `protobuf:"bytes,3,opt,name=new_cons_pubkey,json=newConsPubkey,proto3" json:"new_cons_pubkey,omitempty" yaml:"new_cons_pubkey"`
+	// height is the block height at which the rotation took place.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// completion_time is the time at which the rotation matures.
+	CompletionTime time.Time `protobuf:"bytes,5,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+	// fee is the rotation fee paid by the validator operator.
+	Fee types1.Coin `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *ConsPubKeyRotationHistory) Reset()         { *m = ConsPubKeyRotationHistory{} }
+func (m *ConsPubKeyRotationHistory) String() string { return proto.CompactTextString(m) }
+func (*ConsPubKeyRotationHistory) ProtoMessage()    {}
+func (*ConsPubKeyRotationHistory) Descriptor() ([]byte, []int) {
 	return fileDescriptor_869fea6a46e7b076, []int{15}
 }
-func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
+func (m *ConsPubKeyRotationHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConsPubKeyRotationHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConsPubKeyRotationHistory.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -997,52 +1051,110 @@ func (m *DelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegationResponse.Merge(m, src)
+func (m *ConsPubKeyRotationHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsPubKeyRotationHistory.Merge(m, src)
 }
-func (m *DelegationResponse) XXX_Size() int {
+func (m *ConsPubKeyRotationHistory) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegationResponse.DiscardUnknown(m)
+func (m *ConsPubKeyRotationHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsPubKeyRotationHistory.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_ConsPubKeyRotationHistory proto.InternalMessageInfo
 
-func (m *DelegationResponse) GetDelegation() Delegation {
+// PendingPowerUpdate defines a consensus power change of a validator which is
+// not yet applied to the consensus engine due to the power change limit. The
+// validators pushed out of the bonded set target zero power, and the deferred
+// entering validators have zero applied power.
+type PendingPowerUpdate struct {
+	// operator_address defines the address of the validator's operator; bech encoded in JSON.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty" yaml:"operator_address"`
+	// power is the consensus power currently applied to the consensus engine.
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	// target_power is the consensus power computed from the validator voting power.
+	TargetPower int64 `protobuf:"varint,3,opt,name=target_power,json=targetPower,proto3" json:"target_power,omitempty" yaml:"target_power"`
+}
+
+func (m *PendingPowerUpdate) Reset()         { *m = PendingPowerUpdate{} }
+func (m *PendingPowerUpdate) String() string { return proto.CompactTextString(m) }
+func (*PendingPowerUpdate) ProtoMessage()    {}
+func (*PendingPowerUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_869fea6a46e7b076, []int{16}
+}
+func (m *PendingPowerUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingPowerUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingPowerUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingPowerUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingPowerUpdate.Merge(m, src)
+}
+func (m *PendingPowerUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingPowerUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingPowerUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingPowerUpdate proto.InternalMessageInfo
+
+func (m *PendingPowerUpdate) GetOperatorAddress() string {
 	if m != nil {
-		return m.Delegation
+		return m.OperatorAddress
 	}
-	return Delegation{}
+	return ""
 }
 
-func (m *DelegationResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *PendingPowerUpdate) GetPower() int64 {
 	if m != nil {
-		return m.Balance
+		return m.Power
 	}
-	return nil
+	return 0
 }
 
-// RedelegationEntryResponse is equivalent to a RedelegationEntry except that it
-// contains a balance in addition to shares which is more suitable for client
-// responses.
-type RedelegationEntryResponse struct {
-	RedelegationEntry RedelegationEntry                        `protobuf:"bytes,1,opt,name=redelegation_entry,json=redelegationEntry,proto3" json:"redelegation_entry"`
-	Balance           github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+func (m *PendingPowerUpdate) GetTargetPower() int64 {
+	if m != nil {
+		return m.TargetPower
+	}
+	return 0
 }
 
-func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResponse{} }
-func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
-func (*RedelegationEntryResponse) ProtoMessage()    {}
-func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_869fea6a46e7b076, []int{16}
+// ValidatorPower defines the token composition and voting power
+// of a validator at the time of a voting power snapshot.
+type ValidatorPower struct {
+	// operator_address defines the address of the validator's operator; bech encoded in JSON.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty" yaml:"operator_address"`
+	// tokens define the delegated tokens (incl. self-delegation).
+	Tokens github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=tokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens" yaml:"tokens"`
+	// voting_powers defines the voting powers for each bond denoms
+	VotingPowers github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=voting_powers,json=votingPowers,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"voting_powers" yaml:"voting_powers"`
+	// voting_power defines the sum of voting powers
+	VotingPower cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=voting_power,json=votingPower,proto3,customtype=cosmossdk.io/math.Int" json:"voting_power" yaml:"voting_power"`
 }
-func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *ValidatorPower) Reset()         { *m = ValidatorPower{} }
+func (m *ValidatorPower) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPower) ProtoMessage()    {}
+func (*ValidatorPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_869fea6a46e7b076, []int{17}
+}
+func (m *ValidatorPower) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RedelegationEntryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RedelegationEntryResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorPower.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1052,52 +1164,62 @@ func (m *RedelegationEntryResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *RedelegationEntryResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RedelegationEntryResponse.Merge(m, src)
+func (m *ValidatorPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPower.Merge(m, src)
 }
-func (m *RedelegationEntryResponse) XXX_Size() int {
+func (m *ValidatorPower) XXX_Size() int {
 	return m.Size()
 }
-func (m *RedelegationEntryResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RedelegationEntryResponse.DiscardUnknown(m)
+func (m *ValidatorPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPower.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RedelegationEntryResponse proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorPower proto.InternalMessageInfo
 
-func (m *RedelegationEntryResponse) GetRedelegationEntry() RedelegationEntry {
+func (m *ValidatorPower) GetOperatorAddress() string {
 	if m != nil {
-		return m.RedelegationEntry
+		return m.OperatorAddress
 	}
-	return RedelegationEntry{}
+	return ""
 }
 
-func (m *RedelegationEntryResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *ValidatorPower) GetTokens() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Balance
+		return m.Tokens
 	}
 	return nil
 }
 
-// RedelegationResponse is equivalent to a Redelegation except that its entries
-// contain a balance in addition to shares which is more suitable for client
-// responses.
-type RedelegationResponse struct {
-	Redelegation Redelegation                `protobuf:"bytes,1,opt,name=redelegation,proto3" json:"redelegation"`
-	Entries      []RedelegationEntryResponse `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+func (m *ValidatorPower) GetVotingPowers() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.VotingPowers
+	}
+	return nil
 }
 
-func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
-func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*RedelegationResponse) ProtoMessage()    {}
-func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_869fea6a46e7b076, []int{17}
+// PowerSnapshot defines the bonded validator set powers and the voting power
+// weights recorded at a snapshot height.
+type PowerSnapshot struct {
+	// time is the block time of the snapshot height.
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// voting_power_weights defines the voting power weights for each bond denoms.
+	VotingPowerWeights github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=voting_power_weights,json=votingPowerWeights,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"voting_power_weights" yaml:"voting_power_weights"`
+	// validators defines the powers of the bonded validators.
+	Validators []ValidatorPower `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators"`
 }
-func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *PowerSnapshot) Reset()         { *m = PowerSnapshot{} }
+func (m *PowerSnapshot) String() string { return proto.CompactTextString(m) }
+func (*PowerSnapshot) ProtoMessage()    {}
+func (*PowerSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_869fea6a46e7b076, []int{18}
+}
+func (m *PowerSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RedelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PowerSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RedelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PowerSnapshot.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1107,52 +1229,62 @@ func (m *RedelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *RedelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RedelegationResponse.Merge(m, src)
+func (m *PowerSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PowerSnapshot.Merge(m, src)
 }
-func (m *RedelegationResponse) XXX_Size() int {
+func (m *PowerSnapshot) XXX_Size() int {
 	return m.Size()
 }
-func (m *RedelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RedelegationResponse.DiscardUnknown(m)
+func (m *PowerSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_PowerSnapshot.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RedelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_PowerSnapshot proto.InternalMessageInfo
 
-func (m *RedelegationResponse) GetRedelegation() Redelegation {
+func (m *PowerSnapshot) GetTime() time.Time {
 	if m != nil {
-		return m.Redelegation
+		return m.Time
 	}
-	return Redelegation{}
+	return time.Time{}
 }
 
-func (m *RedelegationResponse) GetEntries() []RedelegationEntryResponse {
+func (m *PowerSnapshot) GetVotingPowerWeights() github_com_cosmos_cosmos_sdk_types.DecCoins {
 	if m != nil {
-		return m.Entries
+		return m.VotingPowerWeights
 	}
 	return nil
 }
 
-// Pool is used for tracking bonded and not-bonded token supply of the bond
-// denomination.
-type Pool struct {
-	NotBondedTokens    github_com_cosmos_cosmos_sdk_types.Coins    `protobuf:"bytes,1,rep,name=not_bonded_tokens,json=notBondedTokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"not_bonded_tokens" yaml:"not_bonded_tokens"`
-	BondedTokens       github_com_cosmos_cosmos_sdk_types.Coins    `protobuf:"bytes,2,rep,name=bonded_tokens,json=bondedTokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bonded_tokens" yaml:"bonded_tokens"`
-	VotingPowerWeights github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=voting_power_weights,json=votingPowerWeights,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"voting_power_weights" yaml:"voting_power_weights"`
+func (m *PowerSnapshot) GetValidators() []ValidatorPower {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
 }
 
-func (m *Pool) Reset()         { *m = Pool{} }
-func (m *Pool) String() string { return proto.CompactTextString(m) }
-func (*Pool) ProtoMessage()    {}
-func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_869fea6a46e7b076, []int{18}
+// UnbondingQueueEntry defines an unbonding delegation entry scheduled
+// in the unbonding queue.
+type UnbondingQueueEntry struct {
+	// delegator_address is the bech32-encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	// validator_address is the bech32-encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// entry defines the unbonding delegation entry.
+	Entry UnbondingDelegationEntry `protobuf:"bytes,3,opt,name=entry,proto3" json:"entry"`
 }
-func (m *Pool) XXX_Unmarshal(b []byte) error {
+
+func (m *UnbondingQueueEntry) Reset()         { *m = UnbondingQueueEntry{} }
+func (m *UnbondingQueueEntry) String() string { return proto.CompactTextString(m) }
+func (*UnbondingQueueEntry) ProtoMessage()    {}
+func (*UnbondingQueueEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_869fea6a46e7b076, []int{19}
+}
+func (m *UnbondingQueueEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnbondingQueueEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Pool.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnbondingQueueEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1162,929 +1294,1403 @@ func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Pool) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Pool.Merge(m, src)
+func (m *UnbondingQueueEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingQueueEntry.Merge(m, src)
 }
-func (m *Pool) XXX_Size() int {
+func (m *UnbondingQueueEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *Pool) XXX_DiscardUnknown() {
-	xxx_messageInfo_Pool.DiscardUnknown(m)
+func (m *UnbondingQueueEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingQueueEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Pool proto.InternalMessageInfo
+var xxx_messageInfo_UnbondingQueueEntry proto.InternalMessageInfo
 
-func (m *Pool) GetNotBondedTokens() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *UnbondingQueueEntry) GetDelegatorAddress() string {
 	if m != nil {
-		return m.NotBondedTokens
+		return m.DelegatorAddress
 	}
-	return nil
+	return ""
 }
 
-func (m *Pool) GetBondedTokens() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *UnbondingQueueEntry) GetValidatorAddress() string {
 	if m != nil {
-		return m.BondedTokens
+		return m.ValidatorAddress
 	}
-	return nil
+	return ""
 }
 
-func (m *Pool) GetVotingPowerWeights() github_com_cosmos_cosmos_sdk_types.DecCoins {
+func (m *UnbondingQueueEntry) GetEntry() UnbondingDelegationEntry {
 	if m != nil {
-		return m.VotingPowerWeights
+		return m.Entry
 	}
-	return nil
+	return UnbondingDelegationEntry{}
 }
 
-func init() {
-	proto.RegisterEnum("initia.mstaking.v1.BondStatus", BondStatus_name, BondStatus_value)
-	proto.RegisterEnum("initia.mstaking.v1.Infraction", Infraction_name, Infraction_value)
-	proto.RegisterType((*CommissionRates)(nil), "initia.mstaking.v1.CommissionRates")
-	proto.RegisterType((*Commission)(nil), "initia.mstaking.v1.Commission")
-	proto.RegisterType((*Description)(nil), "initia.mstaking.v1.Description")
-	proto.RegisterType((*Validator)(nil), "initia.mstaking.v1.Validator")
-	proto.RegisterType((*ValAddresses)(nil), "initia.mstaking.v1.ValAddresses")
-	proto.RegisterType((*DVPair)(nil), "initia.mstaking.v1.DVPair")
-	proto.RegisterType((*DVPairs)(nil), "initia.mstaking.v1.DVPairs")
-	proto.RegisterType((*DVVTriplet)(nil), "initia.mstaking.v1.DVVTriplet")
-	proto.RegisterType((*DVVTriplets)(nil), "initia.mstaking.v1.DVVTriplets")
-	proto.RegisterType((*Delegation)(nil), "initia.mstaking.v1.Delegation")
-	proto.RegisterType((*UnbondingDelegation)(nil), "initia.mstaking.v1.UnbondingDelegation")
-	proto.RegisterType((*UnbondingDelegationEntry)(nil), "initia.mstaking.v1.UnbondingDelegationEntry")
-	proto.RegisterType((*RedelegationEntry)(nil), "initia.mstaking.v1.RedelegationEntry")
-	proto.RegisterType((*Redelegation)(nil), "initia.mstaking.v1.Redelegation")
-	proto.RegisterType((*Params)(nil), "initia.mstaking.v1.Params")
-	proto.RegisterType((*DelegationResponse)(nil), "initia.mstaking.v1.DelegationResponse")
-	proto.RegisterType((*RedelegationEntryResponse)(nil), "initia.mstaking.v1.RedelegationEntryResponse")
-	proto.RegisterType((*RedelegationResponse)(nil), "initia.mstaking.v1.RedelegationResponse")
-	proto.RegisterType((*Pool)(nil), "initia.mstaking.v1.Pool")
+// RedelegationQueueEntry defines a redelegation entry scheduled
+// in the redelegation queue.
+type RedelegationQueueEntry struct {
+	// delegator_address is the bech32-encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	// validator_src_address is the validator redelegation source operator address.
+	ValidatorSrcAddress string `protobuf:"bytes,2,opt,name=validator_src_address,json=validatorSrcAddress,proto3" json:"validator_src_address,omitempty" yaml:"validator_src_address"`
+	// validator_dst_address is the validator redelegation destination operator address.
+	ValidatorDstAddress string `protobuf:"bytes,3,opt,name=validator_dst_address,json=validatorDstAddress,proto3" json:"validator_dst_address,omitempty" yaml:"validator_dst_address"`
+	// entry defines the redelegation entry.
+	Entry RedelegationEntry `protobuf:"bytes,4,opt,name=entry,proto3" json:"entry"`
 }
 
-func init() { proto.RegisterFile("initia/mstaking/v1/staking.proto", fileDescriptor_869fea6a46e7b076) }
-
-var fileDescriptor_869fea6a46e7b076 = []byte{
-	// 2074 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x6c, 0x1b, 0x59,
-	0xfd, 0xcf, 0x24, 0xae, 0x13, 0x7f, 0xed, 0xc4, 0xce, 0x6b, 0xda, 0x3a, 0x6e, 0x7f, 0xb6, 0x77,
-	0x56, 0x3f, 0x14, 0x2d, 0x5b, 0x9b, 0x76, 0x51, 0x2b, 0x55, 0x20, 0x6d, 0x1c, 0xa7, 0xad, 0x97,
-	0x6e, 0x1a, 0x26, 0x69, 0x0a, 0x8b, 0xd0, 0x68, 0x3c, 0xf3, 0xea, 0x0c, 0xf1, 0xcc, 0x98, 0x79,
-	0xcf, 0x6d, 0xcc, 0x89, 0x0b, 0xa2, 0xea, 0x61, 0xb5, 0x9c, 0x58, 0x0e, 0x95, 0x2a, 0xb8, 0x20,
-	0xb4, 0x37, 0x10, 0x88, 0x03, 0x47, 0xa4, 0x05, 0x84, 0xd4, 0x23, 0x5a, 0x21, 0x2f, 0x6a, 0x39,
-	0x20, 0x4e, 0x28, 0x67, 0x0e, 0xe8, 0xfd, 0x99, 0x3f, 0x1e, 0x7b, 0xeb, 0xa4, 0x5a, 0xad, 0x56,
-	0x82, 0x53, 0xfc, 0xbe, 0xef, 0xfb, 0xf9, 0xbe, 0xef, 0xff, 0xef, 0xbc, 0x17, 0xa8, 0xda, 0xae,
-	0x4d, 0x6d, 0xa3, 0xee, 0x10, 0x6a, 0x1c, 0xd8, 0x6e, 0xa7, 0x7e, 0xff, 0x52, 0x5d, 0xfe, 0xac,
-	0xf5, 0x7c, 0x8f, 0x7a, 0x08, 0x09, 0x8e, 0x5a, 0xc0, 0x51, 0xbb, 0x7f, 0xa9, 0x54, 0x36, 0x3d,
-	0xe2, 0x78, 0xa4, 0xde, 0x36, 0x08, 0xae, 0xdf, 0xbf, 0xd4, 0xc6, 0xd4, 0xb8, 0x54, 0x37, 0x3d,
-	0xdb, 0x15, 0x98, 0xd2, 0xaa, 0xd8, 0xd7, 0xf9, 0xaa, 0x2e, 0x16, 0x72, 0x6b, 0xa5, 0xe3, 0x75,
-	0x3c, 0x41, 0x67, 0xbf, 0x02, 0x40, 0xc7, 0xf3, 0x3a, 0x5d, 0x5c, 0xe7, 0xab, 0x76, 0xff, 0x5e,
-	0xdd, 0x70, 0x07, 0x72, 0xab, 0x9c, 0xdc, 0xb2, 0xfa, 0xbe, 0x41, 0x6d, 0x2f, 0x38, 0xab, 0x92,
-	0xdc, 0xa7, 0xb6, 0x83, 0x09, 0x35, 0x9c, 0x9e, 0x60, 0x50, 0xdf, 0x9d, 0x85, 0xfc, 0x86, 0xe7,
-	0x38, 0x36, 0x21, 0xb6, 0xe7, 0x6a, 0x06, 0xc5, 0x04, 0x5d, 0x85, 0x94, 0x6f, 0x50, 0x5c, 0x54,
-	0xaa, 0xca, 0x5a, 0xa6, 0xf1, 0xea, 0x87, 0xc3, 0xca, 0xcc, 0x47, 0xc3, 0xca, 0x79, 0xa1, 0x29,
-	0xb1, 0x0e, 0x6a, 0xb6, 0x57, 0x77, 0x0c, 0xba, 0x5f, 0xbb, 0x85, 0x3b, 0x86, 0x39, 0x68, 0x62,
-	0x53, 0xe3, 0x00, 0xf4, 0x75, 0x58, 0x70, 0x8c, 0x43, 0x9d, 0x83, 0x67, 0x39, 0xf8, 0xca, 0x31,
-	0xc0, 0x47, 0xc3, 0x4a, 0x7e, 0x60, 0x38, 0xdd, 0x6b, 0x6a, 0x00, 0x56, 0xb5, 0x79, 0xc7, 0x38,
-	0x64, 0xca, 0x20, 0x0c, 0x79, 0x46, 0x35, 0xf7, 0x0d, 0xb7, 0x83, 0x85, 0xe4, 0x39, 0x2e, 0xf9,
-	0xab, 0xc7, 0x93, 0x7c, 0x36, 0x92, 0x1c, 0x93, 0xa1, 0x6a, 0x8b, 0x8e, 0x71, 0xb8, 0xc1, 0x09,
-	0xec, 0x98, 0x6b, 0x0b, 0xef, 0x3f, 0xa9, 0xcc, 0xfc, 0xe3, 0x49, 0x45, 0x51, 0xff, 0xac, 0x00,
-	0x44, 0x0e, 0x41, 0xdf, 0x80, 0x82, 0x19, 0xae, 0x38, 0x96, 0x70, 0xbf, 0x64, 0x2f, 0xbf, 0x5a,
-	0x1b, 0x8f, 0x7d, 0x2d, 0xe1, 0xca, 0xc6, 0x02, 0xd3, 0xf2, 0xe9, 0xb0, 0xa2, 0x68, 0x79, 0x33,
-	0xe1, 0xe5, 0x6f, 0x41, 0xb6, 0xdf, 0xb3, 0x0c, 0x8a, 0x75, 0x16, 0x13, 0xee, 0xaf, 0xec, 0xe5,
-	0x52, 0x4d, 0x04, 0xac, 0x16, 0x04, 0xac, 0xb6, 0x1b, 0x04, 0xac, 0x51, 0x66, 0xb2, 0x8e, 0x86,
-	0x15, 0x24, 0x4c, 0x8a, 0x81, 0xd5, 0xf7, 0x3e, 0xae, 0x28, 0x1a, 0x08, 0x0a, 0x03, 0xc4, 0xec,
-	0xf9, 0x83, 0x02, 0xd9, 0x26, 0x26, 0xa6, 0x6f, 0xf7, 0x58, 0x5e, 0xa0, 0x22, 0xcc, 0x3b, 0x9e,
-	0x6b, 0x1f, 0x60, 0x5f, 0xc4, 0x57, 0x0b, 0x96, 0xa8, 0x04, 0x0b, 0xb6, 0x85, 0x5d, 0x6a, 0xd3,
-	0x81, 0x88, 0x9e, 0x16, 0xae, 0x19, 0xea, 0x01, 0x6e, 0x13, 0x3b, 0x70, 0xbf, 0x16, 0x2c, 0xd1,
-	0x75, 0x28, 0x10, 0x6c, 0xf6, 0x7d, 0x9b, 0x0e, 0x74, 0xd3, 0x73, 0xa9, 0x61, 0xd2, 0x62, 0x8a,
-	0x47, 0xe8, 0xfc, 0xd1, 0xb0, 0x72, 0x4e, 0xe8, 0x9a, 0xe4, 0x50, 0xb5, 0x7c, 0x40, 0xda, 0x10,
-	0x14, 0x76, 0x82, 0x85, 0xa9, 0x61, 0x77, 0x49, 0xf1, 0x94, 0x38, 0x41, 0x2e, 0x63, 0xb6, 0xfc,
-	0x36, 0x03, 0x99, 0x3d, 0xa3, 0x6b, 0x5b, 0x06, 0xf5, 0x7c, 0x76, 0xb2, 0xd7, 0xc3, 0x3e, 0xfb,
-	0xad, 0x1b, 0x96, 0xe5, 0x63, 0x42, 0x64, 0xca, 0xc6, 0x4e, 0x4e, 0x72, 0xa8, 0x5a, 0x3e, 0x20,
-	0xad, 0x0b, 0x0a, 0xa2, 0x2c, 0xc4, 0x2e, 0xc1, 0x2e, 0xe9, 0x13, 0xbd, 0xd7, 0x6f, 0x1f, 0xe0,
-	0x81, 0x8c, 0xc6, 0xca, 0x58, 0x34, 0xd6, 0xdd, 0x41, 0xe3, 0x8d, 0x48, 0x7a, 0x12, 0xa7, 0xfe,
-	0xf1, 0x57, 0x17, 0x57, 0x64, 0x45, 0x9b, 0xfe, 0xa0, 0x47, 0xbd, 0xda, 0x76, 0xbf, 0xfd, 0x35,
-	0x3c, 0x60, 0xe1, 0x97, 0xac, 0xdb, 0x9c, 0x13, 0x9d, 0x85, 0xf4, 0x77, 0x0c, 0xbb, 0x8b, 0x2d,
-	0xee, 0xd0, 0x05, 0x4d, 0xae, 0xd0, 0x15, 0x48, 0x13, 0x6a, 0xd0, 0x3e, 0xe1, 0x5e, 0x5c, 0xba,
-	0x5c, 0x9e, 0x94, 0x66, 0x0d, 0xcf, 0xb5, 0x76, 0x38, 0x97, 0x26, 0xb9, 0x11, 0x85, 0x34, 0xf5,
-	0x0e, 0xb0, 0xcb, 0xdc, 0x37, 0xb7, 0x96, 0xbd, 0xbc, 0x5a, 0x93, 0x7a, 0xb0, 0x36, 0x54, 0x93,
-	0x6d, 0xa8, 0xb6, 0xe1, 0xd9, 0x6e, 0x63, 0x5d, 0x26, 0xd2, 0xa2, 0x30, 0x42, 0xc0, 0xd4, 0x5f,
-	0x7c, 0x5c, 0x59, 0xeb, 0xd8, 0x74, 0xbf, 0xdf, 0xae, 0x99, 0x9e, 0x23, 0xfb, 0x92, 0xfc, 0x73,
-	0x91, 0x58, 0x07, 0x75, 0x3a, 0xe8, 0x61, 0xc2, 0x25, 0x10, 0x4d, 0x9e, 0x85, 0x7e, 0xac, 0x40,
-	0xc1, 0xc2, 0x5d, 0xdc, 0xe1, 0x3e, 0x26, 0xfb, 0x86, 0x8f, 0x49, 0x31, 0xcd, 0x15, 0xb8, 0x30,
-	0x51, 0x81, 0x26, 0x36, 0xb9, 0x0e, 0x5b, 0x52, 0x07, 0xe9, 0xc8, 0xa4, 0x0c, 0xa6, 0xcd, 0x17,
-	0x8f, 0xa1, 0x8d, 0x14, 0x47, 0xb4, 0x7c, 0x28, 0x61, 0x87, 0x0b, 0x40, 0x37, 0x20, 0x6b, 0x45,
-	0x69, 0x5f, 0x9c, 0xe7, 0x01, 0xad, 0x4c, 0x72, 0x66, 0xac, 0x3a, 0x1a, 0x29, 0xa6, 0x96, 0x16,
-	0x47, 0xb2, 0x34, 0xeb, 0xbb, 0x6d, 0xcf, 0xb5, 0x6c, 0xb7, 0xa3, 0xef, 0x63, 0xbb, 0xb3, 0x4f,
-	0x8b, 0x0b, 0x55, 0x65, 0x6d, 0x2e, 0x9e, 0x66, 0x49, 0x0e, 0x55, 0xcb, 0x87, 0xa4, 0x9b, 0x9c,
-	0x82, 0x2c, 0x58, 0x8a, 0xb8, 0x78, 0xc9, 0x67, 0xa6, 0x96, 0xfc, 0x2b, 0xd2, 0x4b, 0x67, 0x92,
-	0xa7, 0x44, 0x55, 0xbf, 0x18, 0x12, 0x19, 0x0c, 0x35, 0x01, 0xa2, 0x46, 0x53, 0x04, 0x7e, 0x42,
-	0xf9, 0xc5, 0x9d, 0x4a, 0x1a, 0x1d, 0xc3, 0xa1, 0x87, 0x0a, 0x2c, 0xde, 0xf7, 0x28, 0x3b, 0xa9,
-	0xe7, 0x3d, 0xc0, 0x3e, 0x29, 0x66, 0xa7, 0x25, 0xd5, 0x4d, 0xa9, 0xea, 0x8a, 0x50, 0x75, 0x04,
-	0x7d, 0xb2, 0xdc, 0xca, 0x09, 0xec, 0x36, 0x87, 0xa2, 0xbb, 0x90, 0x8b, 0xcb, 0x2a, 0xe6, 0x78,
-	0x85, 0x7f, 0x59, 0x76, 0xff, 0x33, 0xe3, 0xdd, 0xbf, 0xe5, 0xd2, 0xa3, 0x61, 0xe5, 0xf4, 0xb8,
-	0x1a, 0xaa, 0x96, 0x8d, 0x49, 0x46, 0x5f, 0x81, 0xf3, 0x91, 0x3f, 0x3d, 0x57, 0xdf, 0xf7, 0xba,
-	0x96, 0xee, 0xe3, 0x7b, 0xba, 0xe9, 0xf5, 0x5d, 0x5a, 0x5c, 0x64, 0x21, 0xd6, 0xce, 0x85, 0x2c,
-	0xb7, 0xdd, 0x9b, 0x5e, 0xd7, 0xd2, 0xf0, 0xbd, 0x0d, 0xb6, 0x8d, 0x5e, 0x81, 0x5c, 0x84, 0xb6,
-	0xad, 0xe2, 0x52, 0x55, 0x59, 0x4b, 0x69, 0xd9, 0x90, 0xd6, 0xb2, 0xae, 0xe5, 0x1e, 0x3e, 0xa9,
-	0xcc, 0xc8, 0xde, 0x35, 0xa3, 0x5e, 0x81, 0xdc, 0x9e, 0xd1, 0x95, 0x3d, 0x07, 0x13, 0x74, 0x01,
-	0x32, 0x46, 0xb0, 0x28, 0x2a, 0xd5, 0xb9, 0xb5, 0x8c, 0x16, 0x11, 0x44, 0xcf, 0xfb, 0xfe, 0x5f,
-	0xab, 0x8a, 0xfa, 0x81, 0x02, 0xe9, 0xe6, 0xde, 0xb6, 0x61, 0xfb, 0xa8, 0x05, 0xcb, 0x51, 0x9d,
-	0x8c, 0x76, 0xbc, 0x0b, 0x47, 0xc3, 0x4a, 0x31, 0x59, 0x4a, 0x61, 0xcb, 0x8b, 0x4a, 0x34, 0xe8,
-	0x79, 0x2d, 0x58, 0xbe, 0x1f, 0x34, 0xd2, 0x50, 0xd4, 0x6c, 0x52, 0xd4, 0x18, 0x8b, 0xaa, 0x15,
-	0x42, 0x9a, 0x14, 0x95, 0x30, 0x73, 0x1d, 0xe6, 0x85, 0xb6, 0x04, 0x5d, 0x81, 0x53, 0x3d, 0xf6,
-	0x83, 0x5b, 0xc7, 0xf2, 0x7c, 0x52, 0xed, 0x71, 0x5e, 0x99, 0x81, 0x82, 0x5d, 0xfd, 0xd1, 0x2c,
-	0x40, 0x73, 0x6f, 0x6f, 0xd7, 0xb7, 0x7b, 0x5d, 0x4c, 0x3f, 0x4d, 0xab, 0x77, 0xe1, 0x4c, 0x64,
-	0x12, 0xf1, 0xcd, 0x84, 0xe5, 0xd5, 0xa3, 0x61, 0xe5, 0x42, 0xd2, 0xf2, 0x18, 0x9b, 0xaa, 0x9d,
-	0x0e, 0xe9, 0x3b, 0xbe, 0x39, 0x51, 0xaa, 0x45, 0x68, 0x28, 0x75, 0xee, 0x93, 0xa5, 0xc6, 0xd8,
-	0xe2, 0x52, 0x9b, 0x84, 0x4e, 0x76, 0xeb, 0x6d, 0xc8, 0x46, 0x2e, 0x21, 0xe8, 0x4d, 0x58, 0xa0,
-	0xf2, 0xb7, 0xf4, 0x6e, 0x79, 0xb2, 0x77, 0x03, 0x88, 0xf4, 0x70, 0x88, 0x52, 0x3f, 0x60, 0x4e,
-	0x16, 0xfe, 0x61, 0x05, 0xff, 0xb9, 0x4c, 0x2d, 0xf4, 0x3d, 0x48, 0xcb, 0x91, 0x32, 0x77, 0x8c,
-	0x91, 0xd2, 0x1c, 0x1d, 0x6b, 0x2f, 0x39, 0x48, 0xe4, 0x89, 0x09, 0xff, 0xff, 0x70, 0x16, 0x4e,
-	0xdf, 0x09, 0x6a, 0xfb, 0x73, 0xef, 0xb7, 0x5b, 0x30, 0x8f, 0x5d, 0xea, 0xdb, 0xa1, 0xe3, 0x5e,
-	0x9f, 0x94, 0x1d, 0x13, 0xec, 0xd9, 0x74, 0xa9, 0x3f, 0x90, 0xb9, 0x12, 0x88, 0x48, 0x78, 0xe2,
-	0x77, 0x29, 0x28, 0x7e, 0x12, 0x12, 0x6d, 0x40, 0xde, 0xf4, 0x31, 0x27, 0x04, 0xa3, 0x52, 0xe1,
-	0xa3, 0xb2, 0x14, 0x7d, 0x8a, 0x27, 0x18, 0x54, 0x6d, 0x29, 0xa0, 0xc8, 0x41, 0xd9, 0x01, 0xf6,
-	0xad, 0xcc, 0xd2, 0x94, 0x71, 0x1d, 0xf3, 0xe3, 0x58, 0x95, 0xc1, 0x0f, 0x0e, 0x19, 0x15, 0x20,
-	0x46, 0xe5, 0x52, 0x44, 0xe5, 0xb3, 0xf2, 0x5d, 0x05, 0xf2, 0xc2, 0x2f, 0x5d, 0xbd, 0x6d, 0x74,
-	0x0d, 0xd7, 0xc4, 0xd2, 0x5f, 0x2f, 0x98, 0x73, 0x6f, 0x8d, 0x1e, 0x94, 0xc0, 0x9f, 0x6c, 0xd2,
-	0x2d, 0x49, 0x74, 0x43, 0x80, 0xd1, 0x03, 0x98, 0x0f, 0xf4, 0x48, 0x4d, 0xd3, 0xa3, 0x21, 0xf5,
-	0x58, 0x12, 0x7a, 0xbc, 0xd4, 0xf9, 0xc1, 0x69, 0x63, 0xd3, 0xec, 0xd4, 0xd8, 0x34, 0x9b, 0x36,
-	0x2e, 0xd3, 0x2f, 0x1c, 0x97, 0xb1, 0x6f, 0xf8, 0xdf, 0xa7, 0x60, 0x59, 0xc3, 0xd6, 0xff, 0x12,
-	0xe7, 0xd3, 0x4c, 0x9c, 0x1f, 0x28, 0x00, 0xa2, 0x6f, 0xb1, 0xc9, 0x22, 0x93, 0xe7, 0xc5, 0xdd,
-	0x32, 0xf8, 0x5e, 0x5b, 0x8e, 0x77, 0x4b, 0x86, 0x3e, 0x71, 0xc7, 0xcc, 0x08, 0x6c, 0x93, 0xd0,
-	0xcf, 0x32, 0x8f, 0x3e, 0x9a, 0x85, 0x5c, 0x3c, 0x8f, 0xfe, 0x4b, 0xbf, 0x13, 0xd0, 0x66, 0xd4,
-	0xeb, 0x45, 0xd8, 0xff, 0x7f, 0x52, 0xaf, 0x1f, 0xab, 0xb8, 0x17, 0x37, 0xf9, 0x5f, 0xa6, 0x20,
-	0xbd, 0x6d, 0xf8, 0x86, 0x43, 0x90, 0x39, 0x76, 0x6d, 0x11, 0xcf, 0x1f, 0xab, 0x63, 0x35, 0xd5,
-	0x94, 0x4f, 0x4f, 0x53, 0x6e, 0x2d, 0xef, 0x4f, 0xb8, 0xb5, 0xbc, 0x09, 0x4b, 0x8e, 0x71, 0xa8,
-	0x87, 0xf6, 0x09, 0x4f, 0x2f, 0x36, 0x56, 0x23, 0x29, 0xa3, 0xfb, 0xe2, 0x01, 0x27, 0x7c, 0x0b,
-	0x20, 0xe8, 0x2a, 0x64, 0x19, 0x47, 0x34, 0xf6, 0x18, 0xfc, 0x6c, 0xf4, 0x5a, 0x12, 0xdb, 0x54,
-	0x35, 0x70, 0x8c, 0xc3, 0x4d, 0xb1, 0x40, 0xb7, 0x00, 0xed, 0xdb, 0x84, 0x7a, 0xbe, 0x6d, 0x1a,
-	0x5d, 0x3d, 0x72, 0x25, 0xc3, 0xff, 0xdf, 0xd1, 0xb0, 0xb2, 0x2a, 0xf0, 0xe3, 0x3c, 0xaa, 0xb6,
-	0x1c, 0x11, 0x03, 0x69, 0x57, 0x21, 0xcb, 0xec, 0xd2, 0x2d, 0xec, 0x7a, 0x8e, 0xb8, 0x8a, 0x67,
-	0xe2, 0x6a, 0xc4, 0x36, 0x55, 0x0d, 0xd8, 0xaa, 0xc9, 0x17, 0x68, 0x13, 0x0a, 0x8e, 0xed, 0xea,
-	0x23, 0x57, 0x1d, 0x56, 0x0b, 0xa9, 0xf8, 0x2d, 0x33, 0xc9, 0xa1, 0x6a, 0x4b, 0x8e, 0xed, 0xee,
-	0xc5, 0x2e, 0x35, 0xdf, 0x85, 0xd3, 0x8c, 0x29, 0xf1, 0x64, 0xc5, 0x6f, 0xbf, 0x19, 0x71, 0xef,
-	0x9f, 0xfe, 0x64, 0x56, 0x8a, 0x0e, 0x4b, 0xc8, 0x51, 0xb5, 0x65, 0xc7, 0x76, 0x47, 0xdf, 0xb8,
-	0x62, 0x25, 0xf9, 0x27, 0x05, 0x50, 0xf4, 0x45, 0xa0, 0x61, 0xd2, 0xf3, 0x5c, 0xc2, 0xaf, 0xa4,
-	0x51, 0xf2, 0xc9, 0xec, 0x99, 0xfc, 0xb9, 0x1a, 0x72, 0x05, 0x57, 0xd2, 0x58, 0x79, 0xe3, 0x68,
-	0x36, 0xce, 0x4e, 0x6b, 0xb5, 0x5f, 0x62, 0xe8, 0x97, 0x9a, 0x84, 0xa1, 0x35, 0x33, 0xea, 0xdf,
-	0x15, 0x58, 0x1d, 0x2b, 0x9b, 0xd0, 0xa8, 0x77, 0x00, 0xf9, 0xb1, 0x4d, 0x9e, 0x14, 0x03, 0x69,
-	0xdc, 0x89, 0x2a, 0x70, 0xd9, 0x1f, 0x1b, 0x86, 0x9f, 0x91, 0xa9, 0x29, 0x1e, 0xb4, 0xdf, 0x28,
-	0xb0, 0x12, 0xd7, 0x2d, 0xb4, 0xf0, 0x2d, 0xc8, 0xc5, 0x55, 0x93, 0xb6, 0x55, 0xa7, 0xd9, 0x26,
-	0xcd, 0x1a, 0xc1, 0xa2, 0xb7, 0xa3, 0x26, 0x25, 0x2c, 0xba, 0x78, 0x2c, 0x17, 0x05, 0xba, 0x24,
-	0x9b, 0x55, 0x8a, 0x07, 0xe8, 0xdf, 0x73, 0x90, 0xda, 0xf6, 0xbc, 0x2e, 0xfa, 0xb9, 0x02, 0xcb,
-	0xae, 0x47, 0x75, 0x56, 0x4e, 0xd8, 0xd2, 0xe5, 0x33, 0x98, 0x32, 0xcd, 0x75, 0x06, 0x13, 0xfa,
-	0xcf, 0x61, 0x65, 0x1c, 0x1b, 0x8d, 0x8b, 0xb1, 0xad, 0x93, 0xcd, 0xe9, 0xbc, 0xeb, 0xd1, 0x06,
-	0x87, 0xef, 0x8a, 0xf7, 0xb2, 0x9f, 0x28, 0xb0, 0x38, 0xaa, 0xe6, 0xd4, 0x08, 0x7f, 0x53, 0xaa,
-	0xb9, 0x98, 0x54, 0x71, 0x25, 0x6a, 0x29, 0x2f, 0xa9, 0x5e, 0xae, 0x1d, 0xd7, 0xed, 0xa7, 0x0a,
-	0xac, 0xc4, 0xbb, 0x8b, 0xfe, 0x80, 0x7f, 0x56, 0x1d, 0xef, 0xf2, 0xa5, 0xc9, 0x9e, 0x7f, 0x7e,
-	0xfc, 0xdd, 0x25, 0x90, 0x73, 0xe2, 0x0f, 0x0b, 0x14, 0x7b, 0xae, 0xb9, 0x2b, 0x64, 0x5c, 0x5b,
-	0x60, 0x49, 0xfb, 0xaf, 0x27, 0x15, 0xe5, 0xb5, 0x5f, 0x2b, 0x00, 0xd1, 0x3b, 0x28, 0x7a, 0x1d,
-	0xce, 0x35, 0x6e, 0x6f, 0x35, 0xf5, 0x9d, 0xdd, 0xf5, 0xdd, 0x3b, 0x3b, 0xfa, 0x9d, 0xad, 0x9d,
-	0xed, 0xcd, 0x8d, 0xd6, 0xf5, 0xd6, 0x66, 0xb3, 0x30, 0x53, 0xca, 0x3f, 0x7a, 0x5c, 0xcd, 0xde,
-	0x71, 0x49, 0x0f, 0x9b, 0xf6, 0x3d, 0x1b, 0x5b, 0xe8, 0x0b, 0xb0, 0x32, 0xca, 0xcd, 0x56, 0x9b,
-	0xcd, 0x82, 0x52, 0xca, 0x3d, 0x7a, 0x5c, 0x5d, 0x10, 0x17, 0x1c, 0x6c, 0xa1, 0x35, 0x38, 0x33,
-	0xce, 0xd7, 0xda, 0xba, 0x51, 0x98, 0x2d, 0x2d, 0x3e, 0x7a, 0x5c, 0xcd, 0x84, 0x37, 0x21, 0xa4,
-	0x02, 0x8a, 0x73, 0x4a, 0x79, 0x73, 0x25, 0x78, 0xf4, 0xb8, 0x9a, 0x16, 0x39, 0x50, 0x4a, 0x3d,
-	0xfc, 0x59, 0x79, 0xe6, 0xb5, 0x6f, 0x03, 0xb4, 0xdc, 0x7b, 0xbe, 0x61, 0xf2, 0xd2, 0x28, 0xc1,
-	0xd9, 0xd6, 0xd6, 0x75, 0x6d, 0x7d, 0x63, 0xb7, 0x75, 0x7b, 0x6b, 0x54, 0xed, 0xc4, 0x5e, 0xf3,
-	0xf6, 0x9d, 0xc6, 0xad, 0x4d, 0x7d, 0xa7, 0x75, 0x63, 0xab, 0xa0, 0xa0, 0x73, 0x70, 0x7a, 0x64,
-	0xef, 0xee, 0xd6, 0x6e, 0xeb, 0xed, 0xcd, 0xc2, 0x6c, 0xe3, 0xe6, 0x87, 0xcf, 0xca, 0xca, 0xd3,
-	0x67, 0x65, 0xe5, 0x6f, 0xcf, 0xca, 0xca, 0x7b, 0xcf, 0xcb, 0x33, 0x4f, 0x9f, 0x97, 0x67, 0xfe,
-	0xf2, 0xbc, 0x3c, 0xf3, 0x4e, 0x2d, 0xe6, 0x7c, 0x51, 0x7e, 0x17, 0xbb, 0x46, 0x9b, 0xc8, 0xdf,
-	0xf5, 0xc3, 0xe8, 0xff, 0x5c, 0x3c, 0x10, 0xed, 0x34, 0x1f, 0xed, 0x6f, 0xfc, 0x27, 0x00, 0x00,
-	0xff, 0xff, 0x85, 0xa4, 0x31, 0xfe, 0x07, 0x1b, 0x00, 0x00,
+func (m *RedelegationQueueEntry) Reset()         { *m = RedelegationQueueEntry{} }
+func (m *RedelegationQueueEntry) String() string { return proto.CompactTextString(m) }
+func (*RedelegationQueueEntry) ProtoMessage()    {}
+func (*RedelegationQueueEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_869fea6a46e7b076, []int{20}
 }
-
-func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
-	return StakingDescription()
+func (m *RedelegationQueueEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
-	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
-	var gzipped = []byte{
-		// 6855 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0x7d, 0x70, 0x24, 0x57,
-		0x75, 0xaf, 0x66, 0x34, 0x1a, 0xcd, 0x9c, 0x19, 0x8d, 0x5a, 0x2d, 0xad, 0x76, 0x56, 0x6b, 0x4b,
-		0xda, 0x31, 0xb6, 0xe5, 0xb5, 0x57, 0xb2, 0xd7, 0xf6, 0xae, 0x3d, 0x8b, 0x31, 0x33, 0x9a, 0x59,
-		0xed, 0xac, 0xf5, 0x45, 0x8f, 0xb4, 0xfe, 0xa0, 0xa8, 0x7e, 0xad, 0x9e, 0xab, 0x51, 0x7b, 0x7b,
-		0xba, 0x87, 0xee, 0x9e, 0xdd, 0x95, 0xeb, 0xd5, 0x2b, 0x53, 0x3c, 0x1e, 0xe0, 0xc7, 0xf3, 0x83,
-		0xc7, 0xab, 0xc2, 0x7c, 0x98, 0x18, 0xa8, 0x00, 0x21, 0x24, 0x81, 0x40, 0x20, 0x84, 0x22, 0x95,
-		0x54, 0x8a, 0x04, 0x48, 0x25, 0xe5, 0xe4, 0x8f, 0x14, 0x45, 0xa5, 0x16, 0xb0, 0xa9, 0x82, 0x80,
-		0x49, 0x60, 0x63, 0x52, 0x49, 0x39, 0x49, 0xa5, 0xee, 0x57, 0x7f, 0xcd, 0x48, 0x3d, 0xda, 0x80,
-		0x43, 0x55, 0xf2, 0xcf, 0xae, 0xfa, 0xde, 0xf3, 0xfb, 0xdd, 0x73, 0xcf, 0x3d, 0xf7, 0xdc, 0x73,
-		0xef, 0xed, 0x1e, 0xf8, 0x6e, 0x19, 0x66, 0x9b, 0xa6, 0xd9, 0xd4, 0xd1, 0x42, 0xdb, 0x32, 0x1d,
-		0x73, 0xab, 0xb3, 0xbd, 0xd0, 0x40, 0xb6, 0x6a, 0x69, 0x6d, 0xc7, 0xb4, 0xe6, 0x49, 0x99, 0x38,
-		0x4a, 0x25, 0xe6, 0xb9, 0x44, 0x61, 0x05, 0xc6, 0xce, 0x6a, 0x3a, 0xaa, 0xb8, 0x82, 0x75, 0xe4,
-		0x88, 0xf7, 0x41, 0x62, 0x5b, 0xd3, 0x51, 0x3e, 0x36, 0x3b, 0x38, 0x97, 0x39, 0xf9, 0x9a, 0xf9,
-		0x10, 0x68, 0x3e, 0x88, 0x58, 0xc7, 0xc5, 0x12, 0x41, 0x14, 0xfe, 0x35, 0x01, 0xe3, 0x3d, 0x6a,
-		0x45, 0x11, 0x12, 0x86, 0xd2, 0xc2, 0x8c, 0xb1, 0xb9, 0xb4, 0x44, 0xfe, 0x16, 0xf3, 0x30, 0xdc,
-		0x56, 0xd4, 0x8b, 0x4a, 0x13, 0xe5, 0xe3, 0xa4, 0x98, 0x3f, 0x8a, 0xd3, 0x00, 0x0d, 0xd4, 0x46,
-		0x46, 0x03, 0x19, 0xea, 0x6e, 0x7e, 0x70, 0x76, 0x70, 0x2e, 0x2d, 0xf9, 0x4a, 0xc4, 0xdb, 0x61,
-		0xac, 0xdd, 0xd9, 0xd2, 0x35, 0x55, 0xf6, 0x89, 0xc1, 0xec, 0xe0, 0xdc, 0x90, 0x24, 0xd0, 0x8a,
-		0x8a, 0x27, 0x7c, 0x2b, 0x8c, 0x5e, 0x46, 0xca, 0x45, 0xbf, 0x68, 0x86, 0x88, 0xe6, 0x70, 0xb1,
-		0x4f, 0x70, 0x11, 0xb2, 0x2d, 0x64, 0xdb, 0x4a, 0x13, 0xc9, 0xce, 0x6e, 0x1b, 0xe5, 0x13, 0xa4,
-		0xf7, 0xb3, 0x5d, 0xbd, 0x0f, 0xf7, 0x3c, 0xc3, 0x50, 0x1b, 0xbb, 0x6d, 0x24, 0x96, 0x20, 0x8d,
-		0x8c, 0x4e, 0x8b, 0x32, 0x0c, 0xed, 0x61, 0xbf, 0xaa, 0xd1, 0x69, 0x85, 0x59, 0x52, 0x18, 0xc6,
-		0x28, 0x86, 0x6d, 0x64, 0x5d, 0xd2, 0x54, 0x94, 0x4f, 0x12, 0x82, 0x5b, 0xbb, 0x08, 0xea, 0xb4,
-		0x3e, 0xcc, 0xc1, 0x71, 0xe2, 0x22, 0xa4, 0xd1, 0x15, 0x07, 0x19, 0xb6, 0x66, 0x1a, 0xf9, 0x61,
-		0x42, 0x72, 0x73, 0x8f, 0x51, 0x44, 0x7a, 0x23, 0x4c, 0xe1, 0xe1, 0xc4, 0x53, 0x30, 0x6c, 0xb6,
-		0x1d, 0xcd, 0x34, 0xec, 0x7c, 0x6a, 0x36, 0x36, 0x97, 0x39, 0x79, 0x43, 0x4f, 0x47, 0x58, 0xa3,
-		0x32, 0x12, 0x17, 0x16, 0x6b, 0x20, 0xd8, 0x66, 0xc7, 0x52, 0x91, 0xac, 0x9a, 0x0d, 0x24, 0x6b,
-		0xc6, 0xb6, 0x99, 0x4f, 0x13, 0x82, 0x99, 0xee, 0x8e, 0x10, 0xc1, 0x45, 0xb3, 0x81, 0x6a, 0xc6,
-		0xb6, 0x29, 0xe5, 0xec, 0xc0, 0xb3, 0x38, 0x09, 0x49, 0x7b, 0xd7, 0x70, 0x94, 0x2b, 0xf9, 0x2c,
-		0xf1, 0x10, 0xf6, 0x84, 0x5d, 0x07, 0x35, 0x34, 0xdc, 0x5c, 0x7e, 0x84, 0xba, 0x0e, 0x7b, 0x2c,
-		0x7c, 0x29, 0x09, 0xa3, 0xfd, 0x38, 0xdf, 0x19, 0x18, 0xda, 0xc6, 0xfd, 0xcf, 0xc7, 0x0f, 0x62,
-		0x1d, 0x8a, 0x09, 0x9a, 0x37, 0x79, 0x9d, 0xe6, 0x2d, 0x41, 0xc6, 0x40, 0xb6, 0x83, 0x1a, 0xd4,
-		0x57, 0x06, 0xfb, 0xf4, 0x36, 0xa0, 0xa0, 0x6e, 0x67, 0x4b, 0x5c, 0x97, 0xb3, 0x3d, 0x02, 0xa3,
-		0xae, 0x4a, 0xb2, 0xa5, 0x18, 0x4d, 0xee, 0xb5, 0x0b, 0x51, 0x9a, 0xcc, 0x57, 0x39, 0x4e, 0xc2,
-		0x30, 0x29, 0x87, 0x02, 0xcf, 0x62, 0x05, 0xc0, 0x34, 0x90, 0xb9, 0x2d, 0x37, 0x90, 0xaa, 0xe7,
-		0x53, 0x7b, 0x58, 0x69, 0x0d, 0x8b, 0x74, 0x59, 0xc9, 0xa4, 0xa5, 0xaa, 0x2e, 0xde, 0xef, 0x39,
-		0xe1, 0xf0, 0x1e, 0x3e, 0xb4, 0x42, 0xa7, 0x5f, 0x97, 0x1f, 0x6e, 0x42, 0xce, 0x42, 0x78, 0x46,
-		0xa0, 0x06, 0xeb, 0x59, 0x9a, 0x28, 0x31, 0x1f, 0xd9, 0x33, 0x89, 0xc1, 0x68, 0xc7, 0x46, 0x2c,
-		0xff, 0xa3, 0x78, 0x13, 0xb8, 0x05, 0x32, 0x71, 0x2b, 0x20, 0xf1, 0x29, 0xcb, 0x0b, 0x57, 0x95,
-		0x16, 0x9a, 0x7a, 0x02, 0x72, 0x41, 0xf3, 0x88, 0x13, 0x30, 0x64, 0x3b, 0x8a, 0xe5, 0x10, 0x2f,
-		0x1c, 0x92, 0xe8, 0x83, 0x28, 0xc0, 0x20, 0x32, 0x1a, 0x24, 0xfe, 0x0d, 0x49, 0xf8, 0x4f, 0xf1,
-		0xf5, 0x5e, 0x87, 0x07, 0x49, 0x87, 0x6f, 0xe9, 0x1e, 0xd1, 0x00, 0x73, 0xb8, 0xdf, 0x53, 0xa7,
-		0x61, 0x24, 0xd0, 0x81, 0x7e, 0x9b, 0x2e, 0xfc, 0x77, 0x38, 0xd4, 0x93, 0x5a, 0x7c, 0x04, 0x26,
-		0x3a, 0x86, 0x66, 0x38, 0xc8, 0x6a, 0x5b, 0x08, 0x7b, 0x2c, 0x6d, 0x2a, 0xff, 0xfd, 0xe1, 0x3d,
-		0x7c, 0x6e, 0xd3, 0x2f, 0x4d, 0x59, 0xa4, 0xf1, 0x4e, 0x77, 0xe1, 0xf1, 0x74, 0xea, 0x07, 0xc3,
-		0xc2, 0x93, 0x4f, 0x3e, 0xf9, 0x64, 0xbc, 0xf0, 0x87, 0x49, 0x98, 0xe8, 0x35, 0x67, 0x7a, 0x4e,
-		0xdf, 0x49, 0x48, 0x1a, 0x9d, 0xd6, 0x16, 0xb2, 0x88, 0x91, 0x86, 0x24, 0xf6, 0x24, 0x96, 0x60,
-		0x48, 0x57, 0xb6, 0x90, 0x9e, 0x4f, 0xcc, 0xc6, 0xe6, 0x72, 0x27, 0x6f, 0xef, 0x6b, 0x56, 0xce,
-		0x2f, 0x63, 0x88, 0x44, 0x91, 0xe2, 0xeb, 0x20, 0xc1, 0x82, 0x37, 0x66, 0x38, 0xde, 0x1f, 0x03,
-		0x9e, 0x4b, 0x12, 0xc1, 0x89, 0x47, 0x21, 0x8d, 0xff, 0xa7, 0xbe, 0x91, 0x24, 0x3a, 0xa7, 0x70,
-		0x01, 0xf6, 0x0b, 0x71, 0x0a, 0x52, 0x64, 0x9a, 0x34, 0x10, 0x5f, 0xf4, 0xdc, 0x67, 0xec, 0x58,
-		0x0d, 0xb4, 0xad, 0x74, 0x74, 0x47, 0xbe, 0xa4, 0xe8, 0x1d, 0x44, 0x1c, 0x3e, 0x2d, 0x65, 0x59,
-		0xe1, 0x05, 0x5c, 0x26, 0xce, 0x40, 0x86, 0xce, 0x2a, 0xcd, 0x68, 0xa0, 0x2b, 0x24, 0xae, 0x0e,
-		0x49, 0x74, 0xa2, 0xd5, 0x70, 0x09, 0x6e, 0xfe, 0x71, 0xdb, 0x34, 0xb8, 0x6b, 0x92, 0x26, 0x70,
-		0x01, 0x69, 0xfe, 0x74, 0x38, 0xa4, 0xdf, 0xd8, 0xbb, 0x7b, 0x5d, 0x73, 0xe9, 0x56, 0x18, 0x25,
-		0x12, 0x77, 0xb3, 0xa1, 0x57, 0xf4, 0xfc, 0xd8, 0x6c, 0x6c, 0x2e, 0x25, 0xe5, 0x68, 0xf1, 0x1a,
-		0x2b, 0x2d, 0x7c, 0x21, 0x0e, 0x09, 0x12, 0x58, 0x46, 0x21, 0xb3, 0xf1, 0xe8, 0x7a, 0x55, 0xae,
-		0xac, 0x6d, 0x96, 0x97, 0xab, 0x42, 0x4c, 0xcc, 0x01, 0x90, 0x82, 0xb3, 0xcb, 0x6b, 0xa5, 0x0d,
-		0x21, 0xee, 0x3e, 0xd7, 0x56, 0x37, 0x4e, 0xdd, 0x23, 0x0c, 0xba, 0x80, 0x4d, 0x5a, 0x90, 0xf0,
-		0x0b, 0xdc, 0x7d, 0x52, 0x18, 0x12, 0x05, 0xc8, 0x52, 0x82, 0xda, 0x23, 0xd5, 0xca, 0xa9, 0x7b,
-		0x84, 0x64, 0xb0, 0xe4, 0xee, 0x93, 0xc2, 0xb0, 0x38, 0x02, 0x69, 0x52, 0x52, 0x5e, 0x5b, 0x5b,
-		0x16, 0x52, 0x2e, 0x67, 0x7d, 0x43, 0xaa, 0xad, 0x2e, 0x09, 0x69, 0x97, 0x73, 0x49, 0x5a, 0xdb,
-		0x5c, 0x17, 0xc0, 0x65, 0x58, 0xa9, 0xd6, 0xeb, 0xa5, 0xa5, 0xaa, 0x90, 0x71, 0x25, 0xca, 0x8f,
-		0x6e, 0x54, 0xeb, 0x42, 0x36, 0xa0, 0xd6, 0xdd, 0x27, 0x85, 0x11, 0xb7, 0x89, 0xea, 0xea, 0xe6,
-		0x8a, 0x90, 0x13, 0xc7, 0x60, 0x84, 0x36, 0xc1, 0x95, 0x18, 0x0d, 0x15, 0x9d, 0xba, 0x47, 0x10,
-		0x3c, 0x45, 0x28, 0xcb, 0x58, 0xa0, 0xe0, 0xd4, 0x3d, 0x82, 0x58, 0x58, 0x84, 0x21, 0xe2, 0x86,
-		0xa2, 0x08, 0xb9, 0xe5, 0x52, 0xb9, 0xba, 0x2c, 0xaf, 0xad, 0x6f, 0xd4, 0xd6, 0x56, 0x4b, 0xcb,
-		0x42, 0xcc, 0x2b, 0x93, 0xaa, 0x6f, 0xd8, 0xac, 0x49, 0xd5, 0x8a, 0x10, 0xf7, 0x97, 0xad, 0x57,
-		0x4b, 0x1b, 0xd5, 0x8a, 0x30, 0x58, 0x50, 0x61, 0xa2, 0x57, 0x40, 0xed, 0x39, 0x85, 0x7c, 0xbe,
-		0x10, 0xdf, 0xc3, 0x17, 0x08, 0x57, 0xd8, 0x17, 0x0a, 0x2f, 0xc6, 0x61, 0xbc, 0xc7, 0xa2, 0xd2,
-		0xb3, 0x91, 0x07, 0x61, 0x88, 0xfa, 0x32, 0x5d, 0x66, 0x6f, 0xeb, 0xb9, 0x3a, 0x11, 0xcf, 0xee,
-		0x5a, 0x6a, 0x09, 0xce, 0x9f, 0x84, 0x0c, 0xee, 0x91, 0x84, 0x60, 0x8a, 0x2e, 0x87, 0x7d, 0x53,
-		0x57, 0xf0, 0xa7, 0xeb, 0xe3, 0xa9, 0x7e, 0xd6, 0x47, 0x52, 0x76, 0xb0, 0x45, 0x60, 0xa8, 0xc7,
-		0x22, 0x70, 0x06, 0xc6, 0xba, 0x88, 0xfa, 0x0e, 0xc6, 0x6f, 0x8d, 0x41, 0x7e, 0x2f, 0xe3, 0x44,
-		0x84, 0xc4, 0x78, 0x20, 0x24, 0x9e, 0x09, 0x5b, 0xf0, 0xd8, 0xde, 0x83, 0xd0, 0x35, 0xd6, 0x9f,
-		0x88, 0xc1, 0x64, 0xef, 0x64, 0xb3, 0xa7, 0x0e, 0xaf, 0x83, 0x64, 0x0b, 0x39, 0x3b, 0x26, 0x4f,
-		0xab, 0x6e, 0xe9, 0xb1, 0x58, 0xe3, 0xea, 0xf0, 0x60, 0x33, 0x94, 0x7f, 0xb5, 0x1f, 0xdc, 0x2b,
-		0x63, 0xa4, 0xda, 0x74, 0x69, 0xfa, 0xce, 0x38, 0x1c, 0xea, 0x49, 0xde, 0x53, 0xd1, 0x1b, 0x01,
-		0x34, 0xa3, 0xdd, 0x71, 0x68, 0xea, 0x44, 0x23, 0x71, 0x9a, 0x94, 0x90, 0xe0, 0x85, 0xa3, 0x6c,
-		0xc7, 0x71, 0xeb, 0x07, 0x49, 0x3d, 0xd0, 0x22, 0x22, 0x70, 0x9f, 0xa7, 0x68, 0x82, 0x28, 0x3a,
-		0xbd, 0x47, 0x4f, 0xbb, 0x1c, 0xf3, 0x4e, 0x10, 0x54, 0x5d, 0x43, 0x86, 0x23, 0xdb, 0x8e, 0x85,
-		0x94, 0x96, 0x66, 0x34, 0xc9, 0x52, 0x93, 0x2a, 0x0e, 0x6d, 0x2b, 0xba, 0x8d, 0xa4, 0x51, 0x5a,
-		0x5d, 0xe7, 0xb5, 0x18, 0x41, 0x1c, 0xc8, 0xf2, 0x21, 0x92, 0x01, 0x04, 0xad, 0x76, 0x11, 0x85,
-		0xf7, 0xa4, 0x21, 0xe3, 0x4b, 0xcd, 0xc5, 0x63, 0x90, 0x7d, 0x5c, 0xb9, 0xa4, 0xc8, 0x7c, 0xbb,
-		0x45, 0x2d, 0x91, 0xc1, 0x65, 0xeb, 0x6c, 0xcb, 0x75, 0x27, 0x4c, 0x10, 0x11, 0xb3, 0xe3, 0x20,
-		0x4b, 0x56, 0x75, 0xc5, 0xb6, 0x89, 0xd1, 0x52, 0x44, 0x54, 0xc4, 0x75, 0x6b, 0xb8, 0x6a, 0x91,
-		0xd7, 0x88, 0xf7, 0xc2, 0x38, 0x41, 0xb4, 0x3a, 0xba, 0xa3, 0xb5, 0x75, 0x24, 0xe3, 0x0d, 0xa0,
-		0x4d, 0x96, 0x1c, 0x57, 0xb3, 0x31, 0x2c, 0xb1, 0xc2, 0x04, 0xb0, 0x46, 0xb6, 0x58, 0x81, 0x1b,
-		0x09, 0xac, 0x89, 0x0c, 0x64, 0x29, 0x0e, 0x92, 0xd1, 0x9b, 0x3b, 0x8a, 0x6e, 0xcb, 0x8a, 0xd1,
-		0x90, 0x77, 0x14, 0x7b, 0x27, 0x3f, 0x81, 0x09, 0xca, 0xf1, 0x7c, 0x4c, 0x3a, 0x82, 0x05, 0x97,
-		0x98, 0x5c, 0x95, 0x88, 0x95, 0x8c, 0xc6, 0x39, 0xc5, 0xde, 0x11, 0x8b, 0x30, 0x49, 0x58, 0x6c,
-		0xc7, 0xd2, 0x8c, 0xa6, 0xac, 0xee, 0x20, 0xf5, 0xa2, 0xdc, 0x71, 0xb6, 0xef, 0xcb, 0x1f, 0xf5,
-		0xb7, 0x4f, 0x34, 0xac, 0x13, 0x99, 0x45, 0x2c, 0xb2, 0xe9, 0x6c, 0xdf, 0x27, 0xd6, 0x21, 0x8b,
-		0x07, 0xa3, 0xa5, 0x3d, 0x81, 0xe4, 0x6d, 0xd3, 0x22, 0x6b, 0x68, 0xae, 0x47, 0x68, 0xf2, 0x59,
-		0x70, 0x7e, 0x8d, 0x01, 0x56, 0xcc, 0x06, 0x2a, 0x0e, 0xd5, 0xd7, 0xab, 0xd5, 0x8a, 0x94, 0xe1,
-		0x2c, 0x67, 0x4d, 0x0b, 0x3b, 0x54, 0xd3, 0x74, 0x0d, 0x9c, 0xa1, 0x0e, 0xd5, 0x34, 0xb9, 0x79,
-		0xef, 0x85, 0x71, 0x55, 0xa5, 0x7d, 0xd6, 0x54, 0x99, 0x6d, 0xd3, 0xec, 0xbc, 0x10, 0x30, 0x96,
-		0xaa, 0x2e, 0x51, 0x01, 0xe6, 0xe3, 0xb6, 0x78, 0x3f, 0x1c, 0xf2, 0x8c, 0xe5, 0x07, 0x8e, 0x75,
-		0xf5, 0x32, 0x0c, 0xbd, 0x17, 0xc6, 0xdb, 0xbb, 0xdd, 0x40, 0x31, 0xd0, 0x62, 0x7b, 0x37, 0x0c,
-		0x3b, 0x0d, 0x13, 0xed, 0x9d, 0x76, 0x37, 0xee, 0xb8, 0x1f, 0x27, 0xb6, 0x77, 0xda, 0x61, 0xe0,
-		0xcd, 0x64, 0xcf, 0x6e, 0x21, 0x55, 0x71, 0x50, 0x23, 0x7f, 0xd8, 0x2f, 0xee, 0xab, 0x10, 0xe7,
-		0x41, 0x50, 0x55, 0x19, 0x19, 0xca, 0x96, 0x8e, 0x64, 0xc5, 0x42, 0x86, 0x62, 0xe7, 0x67, 0x88,
-		0x70, 0xc2, 0xb1, 0x3a, 0x48, 0xca, 0xa9, 0x6a, 0x95, 0x54, 0x96, 0x48, 0x9d, 0x78, 0x1c, 0xc6,
-		0xcc, 0xad, 0xc7, 0x55, 0xea, 0x91, 0x72, 0xdb, 0x42, 0xdb, 0xda, 0x95, 0xfc, 0x6b, 0x88, 0x79,
-		0x47, 0x71, 0x05, 0xf1, 0xc7, 0x75, 0x52, 0x2c, 0xde, 0x06, 0x82, 0x6a, 0xef, 0x28, 0x56, 0x9b,
-		0x84, 0x64, 0xbb, 0xad, 0xa8, 0x28, 0x7f, 0x33, 0x15, 0xa5, 0xe5, 0xab, 0xbc, 0x18, 0xcf, 0x08,
-		0xfb, 0xb2, 0xb6, 0xed, 0x70, 0xc6, 0x5b, 0xe9, 0x8c, 0x20, 0x65, 0x8c, 0x6d, 0x0e, 0x04, 0x6c,
-		0x89, 0x40, 0xc3, 0x73, 0x44, 0x2c, 0xd7, 0xde, 0x69, 0xfb, 0xdb, 0xbd, 0x09, 0x46, 0xb0, 0xa4,
-		0xd7, 0xe8, 0x6d, 0x34, 0x71, 0x6b, 0xef, 0xf8, 0x5a, 0xbc, 0x07, 0x26, 0xb1, 0x50, 0x0b, 0x39,
-		0x4a, 0x43, 0x71, 0x14, 0x9f, 0xf4, 0x1d, 0x44, 0x1a, 0x9b, 0x7d, 0x85, 0x55, 0x06, 0xf4, 0xb4,
-		0x3a, 0x5b, 0xbb, 0xae, 0x63, 0x9d, 0xa0, 0x7a, 0xe2, 0x32, 0xee, 0x5a, 0xbf, 0xb0, 0xe4, 0xbc,
-		0x50, 0x84, 0xac, 0xdf, 0xef, 0xc5, 0x34, 0x50, 0xcf, 0x17, 0x62, 0x38, 0x09, 0x5a, 0x5c, 0xab,
-		0xe0, 0xf4, 0xe5, 0xb1, 0xaa, 0x10, 0xc7, 0x69, 0xd4, 0x72, 0x6d, 0xa3, 0x2a, 0x4b, 0x9b, 0xab,
-		0x1b, 0xb5, 0x95, 0xaa, 0x30, 0xe8, 0x4b, 0xec, 0xcf, 0x27, 0x52, 0xb7, 0x08, 0xb7, 0x16, 0xbe,
-		0x3c, 0x08, 0xb9, 0xe0, 0x4e, 0x4d, 0x7c, 0x2d, 0x1c, 0xe6, 0x07, 0x2e, 0x36, 0x72, 0xe4, 0xcb,
-		0x9a, 0x45, 0x26, 0x64, 0x4b, 0xa1, 0x8b, 0xa3, 0xeb, 0x3f, 0x13, 0x4c, 0xaa, 0x8e, 0x9c, 0x87,
-		0x35, 0x0b, 0x4f, 0xb7, 0x96, 0xe2, 0x88, 0xcb, 0x30, 0x63, 0x98, 0xb2, 0xed, 0x28, 0x46, 0x43,
-		0xb1, 0x1a, 0xb2, 0x77, 0xd4, 0x25, 0x2b, 0xaa, 0x8a, 0x6c, 0xdb, 0xa4, 0x0b, 0xa1, 0xcb, 0x72,
-		0x83, 0x61, 0xd6, 0x99, 0xb0, 0xb7, 0x42, 0x94, 0x98, 0x68, 0xc8, 0x7d, 0x07, 0xf7, 0x72, 0xdf,
-		0xa3, 0x90, 0x6e, 0x29, 0x6d, 0x19, 0x19, 0x8e, 0xb5, 0x4b, 0xf2, 0xf3, 0x94, 0x94, 0x6a, 0x29,
-		0xed, 0x2a, 0x7e, 0x16, 0x2f, 0xc0, 0x2d, 0x9e, 0xa8, 0xac, 0xa3, 0xa6, 0xa2, 0xee, 0xca, 0x24,
-		0x19, 0x27, 0xc7, 0x06, 0xb2, 0x6a, 0x1a, 0xdb, 0xba, 0xa6, 0x3a, 0x36, 0x89, 0x0f, 0x34, 0xc6,
-		0x15, 0x3c, 0xc4, 0x32, 0x01, 0x9c, 0xb7, 0x4d, 0x83, 0xe4, 0xe0, 0x8b, 0x5c, 0xfa, 0x55, 0xd9,
-		0x7e, 0x9d, 0x4f, 0xa4, 0x12, 0xc2, 0xd0, 0xf9, 0x44, 0x6a, 0x48, 0x48, 0x9e, 0x4f, 0xa4, 0x92,
-		0xc2, 0xf0, 0xf9, 0x44, 0x2a, 0x25, 0xa4, 0xcf, 0x27, 0x52, 0x69, 0x01, 0x0a, 0x5f, 0x4c, 0x41,
-		0xd6, 0xbf, 0x33, 0xc0, 0x1b, 0x2d, 0x95, 0xac, 0x8d, 0x31, 0x12, 0x3d, 0x6f, 0xda, 0x77, 0x1f,
-		0x31, 0xbf, 0x88, 0x17, 0xcd, 0x62, 0x92, 0xa6, 0xe1, 0x12, 0x45, 0xe2, 0x84, 0x05, 0xbb, 0x35,
-		0xa2, 0x69, 0x4f, 0x4a, 0x62, 0x4f, 0xe2, 0x12, 0x24, 0x1f, 0xb7, 0x09, 0x77, 0x92, 0x70, 0xbf,
-		0x66, 0x7f, 0xee, 0xf3, 0x75, 0x42, 0x9e, 0x3e, 0x5f, 0x97, 0x57, 0xd7, 0xa4, 0x95, 0xd2, 0xb2,
-		0xc4, 0xe0, 0xe2, 0x11, 0x48, 0xe8, 0xca, 0x13, 0xbb, 0xc1, 0xe5, 0x95, 0x14, 0x89, 0xf3, 0x30,
-		0xda, 0x31, 0x2e, 0x21, 0x4b, 0xdb, 0xd6, 0xf0, 0x50, 0x61, 0xa9, 0x51, 0xbf, 0x54, 0xce, 0xab,
-		0x5d, 0xc6, 0xf2, 0x7d, 0xba, 0xc7, 0x11, 0x48, 0x5c, 0x46, 0xca, 0xc5, 0xe0, 0x22, 0x48, 0x8a,
-		0xc4, 0x39, 0xc8, 0x36, 0xd0, 0x56, 0xa7, 0x29, 0x5b, 0xa8, 0xa1, 0xa8, 0x4e, 0x30, 0xf4, 0x67,
-		0x48, 0x95, 0x44, 0x6a, 0xc4, 0x87, 0x20, 0x8d, 0xc7, 0xc8, 0x20, 0x63, 0x3c, 0x46, 0x4c, 0x70,
-		0x62, 0x7f, 0x13, 0xb0, 0x21, 0xe6, 0x20, 0xc9, 0xc3, 0x8b, 0x67, 0x21, 0xe9, 0x28, 0x56, 0x13,
-		0x39, 0x24, 0xf2, 0xe7, 0x7a, 0x1c, 0x7e, 0xf4, 0x60, 0xda, 0x20, 0x08, 0xb2, 0xa7, 0x65, 0xe8,
-		0x5f, 0x60, 0x94, 0x59, 0x80, 0x21, 0xe2, 0x1e, 0x22, 0x00, 0x73, 0x10, 0x61, 0x40, 0x4c, 0x41,
-		0x62, 0x71, 0x4d, 0xc2, 0x91, 0x46, 0x80, 0x2c, 0x2d, 0x95, 0xd7, 0x6b, 0xd5, 0xc5, 0xaa, 0x10,
-		0x2f, 0xdc, 0x0b, 0x49, 0x3a, 0xe6, 0x38, 0x0a, 0xb9, 0xa3, 0x2e, 0x0c, 0xb0, 0x47, 0xc6, 0x11,
-		0xe3, 0xb5, 0x9b, 0x2b, 0xe5, 0xaa, 0x24, 0xc4, 0x0b, 0x9b, 0x30, 0x1a, 0xb2, 0x93, 0x78, 0x08,
-		0xc6, 0xa4, 0xea, 0x46, 0x75, 0x15, 0xef, 0xb3, 0xe4, 0xcd, 0xd5, 0x87, 0x56, 0xd7, 0x1e, 0x5e,
-		0x15, 0x06, 0x82, 0xc5, 0x3c, 0xa4, 0xc5, 0xc4, 0x09, 0x10, 0xbc, 0xe2, 0xfa, 0xda, 0xa6, 0x44,
-		0xb4, 0x79, 0x57, 0x1c, 0x84, 0xb0, 0xd5, 0xc4, 0xc3, 0x30, 0xbe, 0x51, 0x92, 0x96, 0xaa, 0x1b,
-		0x32, 0xdd, 0x3b, 0xba, 0xd4, 0x13, 0x20, 0xf8, 0x2b, 0xce, 0xd6, 0xc8, 0xd6, 0x78, 0x06, 0x8e,
-		0xfa, 0x4b, 0xab, 0x8f, 0x6c, 0x54, 0x57, 0xeb, 0xa4, 0xf1, 0xd2, 0xea, 0x12, 0x8e, 0xaf, 0x21,
-		0x3e, 0xbe, 0x5b, 0x1d, 0xc4, 0xaa, 0x06, 0xf9, 0xaa, 0xcb, 0x15, 0x21, 0x11, 0x2e, 0x5e, 0x5b,
-		0xad, 0xae, 0x9d, 0x15, 0x86, 0xc2, 0xad, 0x93, 0x1d, 0x6c, 0x52, 0x9c, 0x82, 0xc9, 0x70, 0xa9,
-		0x5c, 0x5d, 0xdd, 0x90, 0x1e, 0x15, 0x86, 0xc3, 0x0d, 0xd7, 0xab, 0xd2, 0x85, 0xda, 0x62, 0x55,
-		0x48, 0x89, 0x93, 0x20, 0x06, 0x35, 0xda, 0x38, 0xb7, 0x56, 0x11, 0xd2, 0x5d, 0x11, 0xa5, 0x60,
-		0x43, 0xd6, 0xbf, 0x8d, 0x7c, 0x75, 0xce, 0x92, 0x9e, 0x89, 0x43, 0xc6, 0xb7, 0x2d, 0xc4, 0xf9,
-		0xbc, 0xa2, 0xeb, 0xe6, 0x65, 0x59, 0xd1, 0x35, 0xc5, 0x66, 0xf1, 0x06, 0x48, 0x51, 0x09, 0x97,
-		0xf4, 0x3b, 0xbf, 0xfb, 0x8f, 0xf0, 0xc9, 0x5f, 0xc6, 0x08, 0x3f, 0x24, 0x24, 0x0b, 0x1f, 0x8e,
-		0x81, 0x10, 0xde, 0xef, 0x85, 0xba, 0x1f, 0xdb, 0xab, 0xfb, 0xaf, 0xca, 0xd8, 0x7d, 0x28, 0x06,
-		0xb9, 0xe0, 0x26, 0x2f, 0xa4, 0xde, 0xb1, 0xff, 0x50, 0xf5, 0xbe, 0x13, 0x87, 0x91, 0xc0, 0xd6,
-		0xae, 0x5f, 0xed, 0xde, 0x0c, 0x63, 0x5a, 0x03, 0xb5, 0xda, 0xa6, 0x83, 0x0c, 0x75, 0x57, 0xd6,
-		0xd1, 0x25, 0xa4, 0xe7, 0x0b, 0x24, 0x28, 0x2f, 0xec, 0xbf, 0x79, 0x9c, 0xaf, 0x79, 0xb8, 0x65,
-		0x0c, 0x2b, 0x8e, 0xd7, 0x2a, 0xd5, 0x95, 0xf5, 0xb5, 0x8d, 0xea, 0xea, 0xe2, 0xa3, 0x3c, 0xba,
-		0x48, 0x82, 0x16, 0x12, 0xfb, 0x05, 0x06, 0xed, 0x75, 0x10, 0xc2, 0x4a, 0xe1, 0x58, 0xd1, 0x43,
-		0x2d, 0x61, 0x40, 0x1c, 0x87, 0xd1, 0xd5, 0x35, 0xb9, 0x5e, 0xab, 0x54, 0xe5, 0xea, 0xd9, 0xb3,
-		0xd5, 0xc5, 0x8d, 0x3a, 0x3d, 0x0e, 0x74, 0xa5, 0x37, 0x84, 0xb8, 0xdf, 0xc4, 0x1f, 0x18, 0x84,
-		0xf1, 0x1e, 0x9a, 0x88, 0x25, 0xb6, 0x91, 0xa7, 0x67, 0x0b, 0x27, 0xfa, 0xd1, 0x7e, 0x1e, 0xa7,
-		0xd2, 0xeb, 0x8a, 0xe5, 0xb0, 0x7d, 0xff, 0x6d, 0x80, 0xad, 0x64, 0x38, 0x78, 0x65, 0xb7, 0xd8,
-		0x31, 0x2b, 0xdd, 0xdd, 0x8f, 0x7a, 0xe5, 0xf4, 0xa4, 0xf5, 0x0e, 0x10, 0xdb, 0xa6, 0xad, 0x39,
-		0xda, 0x25, 0x24, 0x6b, 0x06, 0x3f, 0x93, 0xc5, 0xbb, 0xfd, 0x84, 0x24, 0xf0, 0x9a, 0x9a, 0xe1,
-		0xb8, 0xd2, 0x06, 0x6a, 0x2a, 0x21, 0x69, 0x9c, 0x79, 0x0c, 0x4a, 0x02, 0xaf, 0x71, 0xa5, 0x8f,
-		0x41, 0xb6, 0x61, 0x76, 0xf0, 0x16, 0x88, 0xca, 0xe1, 0x68, 0x11, 0x93, 0x32, 0xb4, 0xcc, 0x15,
-		0x61, 0x9b, 0x5b, 0xef, 0x30, 0x38, 0x2b, 0x65, 0x68, 0x19, 0x15, 0xb9, 0x15, 0x46, 0x95, 0x66,
-		0xd3, 0xc2, 0xe4, 0x9c, 0x88, 0x6e, 0xd7, 0x73, 0x6e, 0x31, 0x11, 0x9c, 0x3a, 0x0f, 0x29, 0x6e,
-		0x07, 0x9c, 0xc1, 0x62, 0x4b, 0xc8, 0x6d, 0x7a, 0x06, 0x15, 0x9f, 0x4b, 0x4b, 0x29, 0x83, 0x57,
-		0x1e, 0x83, 0xac, 0x66, 0xcb, 0xde, 0xdd, 0x56, 0x7c, 0x36, 0x3e, 0x97, 0x92, 0x32, 0x9a, 0xed,
-		0xde, 0x0b, 0x14, 0x3e, 0x11, 0x87, 0x5c, 0xf0, 0xd6, 0x4e, 0xac, 0x40, 0x4a, 0x37, 0x55, 0x85,
-		0xb8, 0x16, 0xbd, 0x32, 0x9e, 0x8b, 0xb8, 0xe8, 0x9b, 0x5f, 0x66, 0xf2, 0x92, 0x8b, 0x9c, 0xfa,
-		0xf3, 0x18, 0xa4, 0x78, 0xb1, 0x38, 0x09, 0x89, 0xb6, 0xe2, 0xec, 0x10, 0xba, 0xa1, 0x72, 0x5c,
-		0x88, 0x49, 0xe4, 0x19, 0x97, 0xdb, 0x6d, 0xc5, 0x20, 0x2e, 0xc0, 0xca, 0xf1, 0x33, 0x1e, 0x57,
-		0x1d, 0x29, 0x0d, 0x72, 0x16, 0x60, 0xb6, 0x5a, 0xc8, 0x70, 0x6c, 0x3e, 0xae, 0xac, 0x7c, 0x91,
-		0x15, 0x8b, 0xb7, 0xc3, 0x98, 0x63, 0x29, 0x9a, 0x1e, 0x90, 0x4d, 0x10, 0x59, 0x81, 0x57, 0xb8,
-		0xc2, 0x45, 0x38, 0xc2, 0x79, 0x1b, 0xc8, 0x51, 0xd4, 0x1d, 0xd4, 0xf0, 0x40, 0x49, 0x72, 0xe6,
-		0x77, 0x98, 0x09, 0x54, 0x58, 0x3d, 0xc7, 0x16, 0x9e, 0x8f, 0xc3, 0x18, 0x3f, 0xbd, 0x68, 0xb8,
-		0xc6, 0x5a, 0x01, 0x50, 0x0c, 0xc3, 0x74, 0xfc, 0xe6, 0xea, 0x76, 0xe5, 0x2e, 0xdc, 0x7c, 0xc9,
-		0x05, 0x49, 0x3e, 0x82, 0xa9, 0x1f, 0xc5, 0x00, 0xbc, 0xaa, 0x3d, 0xed, 0x36, 0x03, 0x19, 0x76,
-		0x27, 0x4b, 0x2e, 0xf6, 0xe9, 0x81, 0x17, 0xd0, 0xa2, 0xb3, 0x9a, 0x4e, 0x8e, 0x25, 0xb7, 0x50,
-		0x53, 0x33, 0xd8, 0x7d, 0x0a, 0x7d, 0xe0, 0xc7, 0x92, 0x09, 0xef, 0x7a, 0x4a, 0x82, 0x94, 0x8d,
-		0x5a, 0x8a, 0xe1, 0x68, 0x2a, 0xbb, 0x21, 0x39, 0x75, 0x20, 0xe5, 0xe7, 0xeb, 0x0c, 0x2d, 0xb9,
-		0x3c, 0x85, 0x39, 0x48, 0xf1, 0x52, 0x9c, 0xf8, 0xad, 0xae, 0xad, 0x56, 0x85, 0x01, 0x71, 0x18,
-		0x06, 0xeb, 0xd5, 0x0d, 0x21, 0x86, 0xb7, 0x9d, 0xa5, 0xe5, 0x5a, 0xa9, 0x2e, 0xc4, 0xcb, 0xff,
-		0x03, 0xc6, 0x55, 0xb3, 0x15, 0x6e, 0xb0, 0x2c, 0x84, 0x8e, 0xfc, 0xec, 0x73, 0xb1, 0xc7, 0x4e,
-		0x30, 0xa1, 0xa6, 0xa9, 0x2b, 0x46, 0x73, 0xde, 0xb4, 0x9a, 0xde, 0x6b, 0x11, 0x78, 0x77, 0x60,
-		0xfb, 0x5e, 0x8e, 0x68, 0x6f, 0xfd, 0x63, 0x2c, 0xf6, 0xd1, 0xf8, 0xe0, 0xd2, 0x7a, 0xf9, 0x53,
-		0xf1, 0xa9, 0x25, 0x0a, 0x5c, 0xe7, 0xdd, 0x91, 0xd0, 0xb6, 0x8e, 0x54, 0xac, 0x3c, 0xfc, 0xf8,
-		0x76, 0x98, 0x68, 0x9a, 0x4d, 0x93, 0x30, 0x2d, 0xe0, 0xbf, 0xd8, 0x7b, 0x15, 0x69, 0xb7, 0x74,
-		0x2a, 0xf2, 0x25, 0x8c, 0xe2, 0x2a, 0x8c, 0x33, 0x61, 0x99, 0x5c, 0xdf, 0xd2, 0xc3, 0x05, 0x71,
-		0xdf, 0x93, 0xed, 0xfc, 0x67, 0xbf, 0x47, 0xb2, 0x12, 0x69, 0x8c, 0x41, 0x71, 0x1d, 0x3d, 0x7f,
-		0x28, 0x4a, 0x70, 0x28, 0xc0, 0x47, 0x63, 0x04, 0xb2, 0x22, 0x18, 0xbf, 0xca, 0x18, 0xc7, 0x7d,
-		0x8c, 0x75, 0x06, 0x2d, 0x2e, 0xc2, 0xc8, 0x41, 0xb8, 0xfe, 0x98, 0x71, 0x65, 0x91, 0x9f, 0x64,
-		0x09, 0x46, 0x09, 0x89, 0xda, 0xb1, 0x1d, 0xb3, 0x45, 0x02, 0xf0, 0xfe, 0x34, 0x7f, 0xf2, 0x3d,
-		0x3a, 0x69, 0x73, 0x18, 0xb6, 0xe8, 0xa2, 0x8a, 0x45, 0x20, 0x37, 0xd6, 0x0d, 0xa4, 0xea, 0x11,
-		0x0c, 0x5f, 0x63, 0x8a, 0xb8, 0xf2, 0xc5, 0x0b, 0x30, 0x81, 0xff, 0x26, 0xf1, 0xd1, 0xaf, 0x49,
-		0xf4, 0x31, 0x78, 0xfe, 0x2f, 0xde, 0x4a, 0xe3, 0xc2, 0xb8, 0x4b, 0xe0, 0xd3, 0xc9, 0x37, 0x8a,
-		0x4d, 0xe4, 0x38, 0xc8, 0xb2, 0x65, 0x45, 0xef, 0xa5, 0x9e, 0xef, 0x1c, 0x31, 0xff, 0xfe, 0x97,
-		0x82, 0xa3, 0xb8, 0x44, 0x91, 0x25, 0x5d, 0x2f, 0x6e, 0xc2, 0xe1, 0x1e, 0x5e, 0xd1, 0x07, 0xe7,
-		0x07, 0x18, 0xe7, 0x44, 0x97, 0x67, 0x60, 0xda, 0x75, 0xe0, 0xe5, 0xee, 0x58, 0xf6, 0xc1, 0xf9,
-		0x41, 0xc6, 0x29, 0x32, 0x2c, 0x1f, 0x52, 0xcc, 0x78, 0x1e, 0xc6, 0x2e, 0x21, 0x6b, 0xcb, 0xb4,
-		0xd9, 0xd9, 0x6d, 0x1f, 0x74, 0x1f, 0x62, 0x74, 0xa3, 0x0c, 0x48, 0x0e, 0x73, 0x31, 0xd7, 0xfd,
-		0x90, 0xda, 0x56, 0x54, 0xd4, 0x07, 0xc5, 0xb3, 0x8c, 0x62, 0x18, 0xcb, 0x63, 0x68, 0x09, 0xb2,
-		0x4d, 0x93, 0x2d, 0x91, 0xd1, 0xf0, 0x0f, 0x33, 0x78, 0x86, 0x63, 0x18, 0x45, 0xdb, 0x6c, 0x77,
-		0x74, 0xbc, 0x7e, 0x46, 0x53, 0xfc, 0x0a, 0xa7, 0xe0, 0x18, 0x46, 0x71, 0x00, 0xb3, 0x3e, 0xc7,
-		0x29, 0x6c, 0x9f, 0x3d, 0x1f, 0x84, 0x8c, 0x69, 0xe8, 0xbb, 0xa6, 0xd1, 0x8f, 0x12, 0x1f, 0x61,
-		0x0c, 0xc0, 0x20, 0x98, 0xe0, 0x0c, 0xa4, 0xfb, 0x1d, 0x88, 0x5f, 0x7d, 0x89, 0x4f, 0x0f, 0x3e,
-		0x02, 0x4b, 0x30, 0xca, 0x03, 0x94, 0x66, 0x1a, 0x7d, 0x50, 0x7c, 0x9c, 0x51, 0xe4, 0x7c, 0x30,
-		0xd6, 0x0d, 0x07, 0xd9, 0x4e, 0x13, 0xf5, 0x43, 0xf2, 0x09, 0xde, 0x0d, 0x06, 0x61, 0xa6, 0xdc,
-		0x42, 0x86, 0xba, 0xd3, 0x1f, 0xc3, 0x27, 0xb9, 0x29, 0x39, 0x06, 0x53, 0x2c, 0xc2, 0x48, 0x4b,
-		0xb1, 0xec, 0x1d, 0x45, 0xef, 0x6b, 0x38, 0x7e, 0x8d, 0x71, 0x64, 0x5d, 0x10, 0xb3, 0x48, 0xc7,
-		0x38, 0x08, 0xcd, 0xa7, 0xb8, 0x45, 0x7c, 0x30, 0x36, 0xf5, 0x6c, 0x87, 0x1c, 0x74, 0x1f, 0x84,
-		0xed, 0xd7, 0xf9, 0xd4, 0xa3, 0xd8, 0x15, 0x3f, 0xe3, 0x19, 0x48, 0xdb, 0xda, 0x13, 0x7d, 0xd1,
-		0x7c, 0x9a, 0x8f, 0x34, 0x01, 0x60, 0xf0, 0xa3, 0x70, 0xa4, 0xe7, 0x32, 0xd1, 0x07, 0xd9, 0x6f,
-		0x30, 0xb2, 0xc9, 0x1e, 0x4b, 0x05, 0x0b, 0x09, 0x07, 0xa5, 0xfc, 0x4d, 0x1e, 0x12, 0x50, 0x88,
-		0x6b, 0x1d, 0x6f, 0x5a, 0x6c, 0x65, 0xfb, 0x60, 0x56, 0xfb, 0x2d, 0x6e, 0x35, 0x8a, 0x0d, 0x58,
-		0x6d, 0x03, 0x26, 0x19, 0xe3, 0xc1, 0xc6, 0xf5, 0x33, 0x3c, 0xb0, 0x52, 0xf4, 0x66, 0x70, 0x74,
-		0xdf, 0x08, 0x53, 0xae, 0x39, 0x79, 0x76, 0x6c, 0xcb, 0x2d, 0xa5, 0xdd, 0x07, 0xf3, 0x67, 0x19,
-		0x33, 0x8f, 0xf8, 0x6e, 0x7a, 0x6d, 0xaf, 0x28, 0x6d, 0x4c, 0xfe, 0x08, 0xe4, 0x39, 0x79, 0xc7,
-		0xb0, 0x90, 0x6a, 0x36, 0x0d, 0xed, 0x09, 0xd4, 0xe8, 0x83, 0xfa, 0xb7, 0x43, 0x43, 0xb5, 0xe9,
-		0x83, 0x63, 0xe6, 0x1a, 0x08, 0x6e, 0xae, 0x22, 0x6b, 0xad, 0xb6, 0x69, 0x39, 0x11, 0x8c, 0x9f,
-		0xe3, 0x23, 0xe5, 0xe2, 0x6a, 0x04, 0x56, 0xac, 0x02, 0x7d, 0xfb, 0xa3, 0x5f, 0x97, 0xfc, 0x3c,
-		0x23, 0x1a, 0xf1, 0x50, 0x2c, 0x70, 0xa8, 0x66, 0xab, 0xad, 0x58, 0xfd, 0xc4, 0xbf, 0xdf, 0xe1,
-		0x81, 0x83, 0x41, 0x58, 0xe0, 0xc0, 0x19, 0x1d, 0x5e, 0xed, 0xfb, 0x60, 0xf8, 0x02, 0x0f, 0x1c,
-		0x1c, 0xc3, 0x28, 0x78, 0xc2, 0xd0, 0x07, 0xc5, 0x17, 0x39, 0x05, 0xc7, 0x60, 0x8a, 0x37, 0x78,
-		0x0b, 0xad, 0x85, 0x9a, 0x9a, 0xed, 0x58, 0x34, 0x25, 0xdf, 0x9f, 0xea, 0x77, 0x5f, 0x0a, 0x26,
-		0x61, 0x92, 0x0f, 0x8a, 0x23, 0x11, 0xbb, 0xfa, 0x20, 0x5b, 0xb6, 0x68, 0xc5, 0xbe, 0xc4, 0x23,
-		0x91, 0x0f, 0x86, 0x75, 0xf3, 0x65, 0x88, 0xd8, 0xec, 0x2a, 0xde, 0xa8, 0xf4, 0x41, 0xf7, 0x7b,
-		0x21, 0xe5, 0xea, 0x1c, 0x8b, 0x39, 0x7d, 0xf9, 0x4f, 0xc7, 0xb8, 0x88, 0x76, 0xfb, 0xf2, 0xce,
-		0x2f, 0x87, 0xf2, 0x9f, 0x4d, 0x8a, 0xa4, 0x31, 0x64, 0x34, 0x94, 0x4f, 0x89, 0x51, 0xef, 0xfa,
-		0xe5, 0xdf, 0xf2, 0x32, 0xeb, 0x6f, 0x30, 0x9d, 0x2a, 0x2e, 0x63, 0x27, 0x0f, 0x26, 0x3d, 0xd1,
-		0x64, 0x6f, 0x7d, 0xd9, 0xf5, 0xf3, 0x40, 0xce, 0x53, 0x3c, 0x0b, 0x23, 0x81, 0x84, 0x27, 0x9a,
-		0xea, 0x7f, 0x32, 0xaa, 0xac, 0x3f, 0xdf, 0x29, 0xde, 0x0b, 0x09, 0x9c, 0xbc, 0x44, 0xc3, 0xdf,
-		0xc6, 0xe0, 0x44, 0xbc, 0xf8, 0x00, 0xa4, 0x78, 0xd2, 0x12, 0x0d, 0xfd, 0x5f, 0x0c, 0xea, 0x42,
-		0x30, 0x9c, 0x27, 0x2c, 0xd1, 0xf0, 0xb7, 0x73, 0x38, 0x87, 0x60, 0x78, 0xff, 0x26, 0xfc, 0x83,
-		0xff, 0x9d, 0x60, 0x8b, 0x0e, 0xb7, 0xdd, 0x19, 0x18, 0x66, 0x99, 0x4a, 0x34, 0xfa, 0x9d, 0xac,
-		0x71, 0x8e, 0x28, 0x9e, 0x86, 0xa1, 0x3e, 0x0d, 0xfe, 0x7f, 0x18, 0x94, 0xca, 0x17, 0x17, 0x21,
-		0xe3, 0xcb, 0x4e, 0xa2, 0xe1, 0x4f, 0x33, 0xb8, 0x1f, 0x85, 0x55, 0x67, 0xd9, 0x49, 0x34, 0xc1,
-		0xff, 0xe5, 0xaa, 0x33, 0x04, 0x36, 0x1b, 0x4f, 0x4c, 0xa2, 0xd1, 0xef, 0xe6, 0x56, 0xe7, 0x90,
-		0xe2, 0x83, 0x90, 0x76, 0x17, 0x9b, 0x68, 0xfc, 0x7b, 0x18, 0xde, 0xc3, 0x60, 0x0b, 0xf8, 0x16,
-		0xbb, 0x68, 0x8a, 0xff, 0xc7, 0x2d, 0xe0, 0x43, 0xe1, 0x69, 0x14, 0x4e, 0x60, 0xa2, 0x99, 0xde,
-		0xcb, 0xa7, 0x51, 0x28, 0x7f, 0xc1, 0xa3, 0x49, 0x62, 0x7e, 0x34, 0xc5, 0xff, 0xe7, 0xa3, 0x49,
-		0xe4, 0xb1, 0x1a, 0xe1, 0x8c, 0x20, 0x9a, 0xe3, 0x7d, 0x5c, 0x8d, 0x50, 0x42, 0x50, 0x5c, 0x07,
-		0xb1, 0x3b, 0x1b, 0x88, 0xe6, 0x7b, 0x86, 0xf1, 0x8d, 0x75, 0x25, 0x03, 0xc5, 0x87, 0x61, 0xb2,
-		0x77, 0x26, 0x10, 0xcd, 0xfa, 0xfe, 0x97, 0x43, 0x7b, 0x37, 0x7f, 0x22, 0x50, 0xdc, 0xf0, 0x96,
-		0x14, 0x7f, 0x16, 0x10, 0x4d, 0xfb, 0x81, 0x97, 0x83, 0x81, 0xdb, 0x9f, 0x04, 0x14, 0x4b, 0x00,
-		0xde, 0x02, 0x1c, 0xcd, 0xf5, 0x21, 0xc6, 0xe5, 0x03, 0xe1, 0xa9, 0xc1, 0xd6, 0xdf, 0x68, 0xfc,
-		0xb3, 0x7c, 0x6a, 0x30, 0x04, 0x9e, 0x1a, 0x7c, 0xe9, 0x8d, 0x46, 0x7f, 0x98, 0x4f, 0x0d, 0x0e,
-		0xc1, 0x9e, 0xed, 0x5b, 0xdd, 0xa2, 0x19, 0x3e, 0xc2, 0x3d, 0xdb, 0x87, 0x2a, 0xae, 0xc2, 0x58,
-		0xd7, 0x82, 0x18, 0x4d, 0xf5, 0x51, 0x46, 0x25, 0x84, 0xd7, 0x43, 0xff, 0xe2, 0xc5, 0x16, 0xc3,
-		0x68, 0xb6, 0x8f, 0x85, 0x16, 0x2f, 0xb6, 0x16, 0x16, 0xcf, 0x40, 0xca, 0xe8, 0xe8, 0x3a, 0x9e,
-		0x3c, 0xe2, 0xfe, 0xef, 0xe7, 0xe6, 0xff, 0xe6, 0x15, 0x66, 0x1d, 0x0e, 0x28, 0xde, 0x0b, 0x43,
-		0xa8, 0xb5, 0x85, 0x1a, 0x51, 0xc8, 0x1f, 0xbe, 0xc2, 0x03, 0x26, 0x96, 0x2e, 0x3e, 0x08, 0x40,
-		0x8f, 0x46, 0xc8, 0xc5, 0x79, 0x04, 0xf6, 0x47, 0xaf, 0xb0, 0x17, 0xe2, 0x3c, 0x88, 0x47, 0x40,
-		0x5f, 0xaf, 0xdb, 0x9f, 0xe0, 0xa5, 0x20, 0x01, 0x19, 0x91, 0xfb, 0x61, 0xf8, 0x71, 0xdb, 0x34,
-		0x1c, 0xa5, 0x19, 0x85, 0xfe, 0x31, 0x43, 0x73, 0x79, 0x6c, 0xb0, 0x96, 0x69, 0x21, 0x47, 0x69,
-		0xda, 0x51, 0xd8, 0xbf, 0x65, 0x58, 0x17, 0x80, 0xc1, 0xaa, 0x62, 0x3b, 0xfd, 0xf4, 0xfb, 0xef,
-		0x38, 0x98, 0x03, 0xb0, 0xd2, 0xf8, 0xef, 0x8b, 0x68, 0x37, 0x0a, 0xfb, 0x13, 0xae, 0x34, 0x93,
-		0x2f, 0x3e, 0x00, 0x69, 0xfc, 0x27, 0x7d, 0xcb, 0x35, 0x02, 0xfc, 0x53, 0x06, 0xf6, 0x10, 0xb8,
-		0x65, 0xdb, 0x69, 0x38, 0x5a, 0xb4, 0xb1, 0xaf, 0xb1, 0x91, 0xe6, 0xf2, 0xc5, 0x12, 0x64, 0x6c,
-		0xa7, 0xd1, 0xe8, 0xb0, 0xfc, 0x34, 0x02, 0xfe, 0xf7, 0xaf, 0xb8, 0x47, 0x16, 0x2e, 0x06, 0x8f,
-		0xf6, 0xe5, 0x8b, 0x4e, 0xdb, 0x24, 0xf7, 0x2d, 0x51, 0x0c, 0x2f, 0x33, 0x06, 0x1f, 0xa4, 0xb8,
-		0x08, 0x59, 0xdc, 0x17, 0x0b, 0xb5, 0x11, 0xb9, 0x1c, 0x8b, 0xa0, 0xf8, 0x19, 0x33, 0x40, 0x00,
-		0x54, 0xfe, 0x6f, 0x5f, 0x7b, 0x61, 0x3a, 0xf6, 0xfc, 0x0b, 0xd3, 0xb1, 0xef, 0xbc, 0x30, 0x1d,
-		0x7b, 0xf7, 0x8b, 0xd3, 0x03, 0xcf, 0xbf, 0x38, 0x3d, 0xf0, 0xcd, 0x17, 0xa7, 0x07, 0x7a, 0x9f,
-		0x12, 0xc3, 0x92, 0xb9, 0x64, 0xd2, 0xf3, 0xe1, 0xc7, 0x6e, 0x6e, 0x6a, 0xce, 0x4e, 0x67, 0x6b,
-		0x5e, 0x35, 0x5b, 0x0b, 0xaa, 0x69, 0xb7, 0x4c, 0x7b, 0x21, 0x78, 0xae, 0x4b, 0xfe, 0x82, 0x7f,
-		0x4a, 0xc2, 0x11, 0x5a, 0x2d, 0xd3, 0x2a, 0xfa, 0xc0, 0x0e, 0x7d, 0xb3, 0xfe, 0xaa, 0xe8, 0x73,
-		0xdf, 0xc2, 0x43, 0x30, 0x5e, 0xc3, 0xbd, 0xc7, 0x59, 0x9d, 0x77, 0x62, 0xdd, 0xf3, 0xfd, 0xd4,
-		0xd9, 0x40, 0x02, 0xc3, 0xce, 0xeb, 0xfd, 0x45, 0x85, 0xb7, 0xc4, 0x40, 0xa8, 0xab, 0x8a, 0xae,
-		0x58, 0xff, 0x5e, 0x2a, 0xf1, 0x34, 0x00, 0xbd, 0xbe, 0x76, 0x3f, 0x44, 0xca, 0x9d, 0xcc, 0xcf,
-		0xfb, 0x3b, 0x37, 0x4f, 0x5b, 0x22, 0x6f, 0x84, 0xa4, 0x89, 0x2c, 0xfe, 0xf3, 0xf8, 0x23, 0x00,
-		0x5e, 0x85, 0x78, 0x14, 0x0e, 0xd7, 0x17, 0x4b, 0xcb, 0x25, 0x89, 0xbf, 0xf4, 0x50, 0x5f, 0xaf,
-		0x2e, 0xd6, 0xce, 0xd6, 0xaa, 0x15, 0x61, 0x40, 0x9c, 0x04, 0xd1, 0x5f, 0xe9, 0xbe, 0xa4, 0x71,
-		0x08, 0xc6, 0xfc, 0xe5, 0xf4, 0xad, 0xfb, 0x38, 0x5e, 0xf9, 0xb4, 0x56, 0x5b, 0x47, 0xe4, 0x26,
-		0x45, 0xd6, 0xb8, 0xd5, 0xa2, 0x83, 0xea, 0xd7, 0xff, 0x8a, 0xbe, 0x89, 0x3d, 0xee, 0xc1, 0x5d,
-		0x9b, 0x17, 0x97, 0x61, 0x4c, 0x51, 0x55, 0xd4, 0x0e, 0x50, 0x46, 0xb8, 0x1e, 0x26, 0x24, 0x77,
-		0x43, 0x0c, 0xe9, 0xb1, 0x9d, 0x86, 0xa4, 0x4d, 0x7a, 0x1f, 0x45, 0xf1, 0x0d, 0x46, 0xc1, 0xc4,
-		0x8b, 0x06, 0x8c, 0xe1, 0x95, 0x0c, 0x6f, 0x78, 0x3d, 0x35, 0xf6, 0xdf, 0x37, 0x7d, 0xe5, 0x73,
-		0x77, 0x92, 0x9b, 0xa2, 0x63, 0xc1, 0x61, 0xe9, 0xe1, 0x4e, 0x92, 0xc0, 0xb8, 0x3d, 0x45, 0x11,
-		0xe4, 0x78, 0x7b, 0x4c, 0xe1, 0xfd, 0x1b, 0xfb, 0x7d, 0xd6, 0xd8, 0x74, 0x2f, 0x1f, 0xf0, 0xb5,
-		0x34, 0xc2, 0x58, 0x69, 0x45, 0xb9, 0xba, 0xd7, 0x74, 0x7c, 0xec, 0xf6, 0xee, 0xd9, 0x46, 0xff,
-		0x3b, 0x41, 0x98, 0xcf, 0xf8, 0x9b, 0xd9, 0x4a, 0xd2, 0x2f, 0x4a, 0xe0, 0x83, 0x09, 0x18, 0x53,
-		0x5a, 0x9a, 0x61, 0x2e, 0x90, 0x7f, 0xd9, 0x9c, 0x1b, 0x22, 0x0f, 0x7d, 0x5c, 0xb2, 0x9c, 0xa2,
-		0x53, 0x21, 0xda, 0x63, 0x7e, 0xfa, 0xae, 0x8f, 0x0f, 0x79, 0xd3, 0xa5, 0xb8, 0x02, 0x02, 0x7f,
-		0x29, 0x11, 0x19, 0xaa, 0xd9, 0xe8, 0x6b, 0xd7, 0x75, 0x8d, 0x73, 0xf0, 0xfd, 0x7a, 0x95, 0x41,
-		0x8b, 0xaf, 0x85, 0x94, 0x4b, 0x13, 0x15, 0x69, 0x39, 0x89, 0x8b, 0xc0, 0x71, 0x96, 0xce, 0xcc,
-		0x7e, 0x56, 0xd5, 0x97, 0x39, 0x9e, 0xce, 0xd0, 0x55, 0xdc, 0x9b, 0x25, 0xc8, 0x35, 0x4c, 0xc3,
-		0x91, 0xcd, 0x96, 0xe6, 0xa0, 0x56, 0xdb, 0x89, 0x5c, 0xa7, 0x7e, 0x46, 0x49, 0x52, 0xd2, 0x08,
-		0xc6, 0xad, 0x71, 0x18, 0xd6, 0x84, 0x7e, 0x77, 0xd4, 0x8f, 0x26, 0xff, 0xe0, 0x6a, 0x42, 0x30,
-		0x58, 0x93, 0xeb, 0xf2, 0x0e, 0xbb, 0x71, 0x91, 0xdd, 0xd5, 0x39, 0x57, 0xa8, 0x17, 0xb8, 0xde,
-		0xf1, 0x47, 0x09, 0x98, 0x66, 0xc2, 0x5b, 0x8a, 0x8d, 0x16, 0x2e, 0xdd, 0xb5, 0x85, 0x1c, 0xe5,
-		0xae, 0x05, 0xd5, 0xd4, 0x0c, 0xe6, 0x2a, 0xe3, 0x2c, 0x58, 0xe3, 0xfa, 0x79, 0x56, 0x3f, 0xd5,
-		0xf3, 0xfa, 0x6e, 0x6a, 0xef, 0x20, 0x3f, 0xd5, 0xed, 0x83, 0x05, 0x1d, 0x12, 0x8b, 0xa6, 0x66,
-		0x88, 0x13, 0x30, 0xd4, 0x40, 0x86, 0xd9, 0x62, 0xe1, 0x96, 0x3e, 0x88, 0xe7, 0x20, 0xa9, 0xb4,
-		0xcc, 0x8e, 0xe1, 0xd0, 0x50, 0x5b, 0xbe, 0xf3, 0x6b, 0x57, 0x67, 0x06, 0xbe, 0x75, 0x75, 0xe6,
-		0x10, 0xa5, 0xb5, 0x1b, 0x17, 0xe7, 0x35, 0x73, 0xa1, 0xa5, 0x38, 0x3b, 0x78, 0xfa, 0xfe, 0xe5,
-		0xe7, 0x4f, 0x00, 0x6b, 0xaf, 0x66, 0x38, 0x9f, 0xfc, 0xfe, 0x67, 0x8e, 0xc7, 0x24, 0x86, 0x2f,
-		0x26, 0x7e, 0xf0, 0xdc, 0x4c, 0xac, 0xd0, 0x86, 0xe1, 0x0a, 0x52, 0xf7, 0x69, 0xb0, 0x16, 0x6a,
-		0xf0, 0x2e, 0xd6, 0xe0, 0xd1, 0xee, 0x06, 0xe9, 0x0b, 0x48, 0x15, 0xa4, 0xfa, 0x9a, 0xad, 0x20,
-		0x35, 0xd4, 0x62, 0x0d, 0x52, 0x35, 0xc3, 0xa1, 0x1f, 0x4f, 0x3c, 0x00, 0x83, 0x9a, 0x41, 0xdf,
-		0xc7, 0x4d, 0x97, 0x6f, 0x3f, 0x40, 0x57, 0x24, 0x8c, 0x2b, 0xac, 0x41, 0xaa, 0x82, 0x54, 0x4a,
-		0xb5, 0x08, 0x83, 0x0d, 0xa4, 0x32, 0xaa, 0xeb, 0x50, 0x12, 0xa3, 0xcb, 0x95, 0x6f, 0x7e, 0x77,
-		0x7a, 0xe0, 0xc9, 0x17, 0xa6, 0x07, 0xf6, 0x74, 0xa7, 0x42, 0xb4, 0x3b, 0xb9, 0x5e, 0xf4, 0x2f,
-		0x31, 0x38, 0x12, 0x8e, 0x24, 0x8a, 0xb1, 0xbb, 0xd7, 0xc7, 0xf2, 0xa7, 0x60, 0xb0, 0x64, 0xec,
-		0x8a, 0x47, 0xe8, 0x06, 0x46, 0xee, 0x58, 0x3a, 0x1b, 0x89, 0x61, 0xfc, 0xbc, 0x69, 0xe9, 0x78,
-		0x84, 0xf8, 0xf7, 0x4e, 0xb1, 0xb9, 0x2c, 0xfb, 0x88, 0xa9, 0xfc, 0x74, 0xec, 0x60, 0x99, 0x4a,
-		0xaa, 0x64, 0xec, 0x12, 0x9b, 0xad, 0xc7, 0x1e, 0xbb, 0x23, 0xf2, 0x1e, 0xfb, 0xa2, 0x61, 0x5e,
-		0x36, 0xb0, 0xda, 0xed, 0x2d, 0x7e, 0x87, 0x3d, 0x1d, 0xbe, 0xc3, 0x7e, 0x18, 0xe9, 0xfa, 0x43,
-		0x58, 0x6e, 0x23, 0xd0, 0xff, 0xf7, 0xc6, 0x61, 0xba, 0x2b, 0x92, 0xb2, 0x24, 0x6f, 0x2f, 0x23,
-		0x14, 0x21, 0x55, 0xe1, 0xb9, 0x63, 0x1e, 0x86, 0x6d, 0xa4, 0x9a, 0x46, 0xc3, 0x26, 0x86, 0x18,
-		0x94, 0xf8, 0x23, 0x36, 0x84, 0xa1, 0x18, 0xa6, 0xcd, 0x3e, 0x46, 0xa2, 0x0f, 0xe5, 0x0f, 0x1e,
-		0xd0, 0x10, 0x23, 0xbc, 0x25, 0x6e, 0x8d, 0xbb, 0xfa, 0xb4, 0x06, 0xef, 0x44, 0xe0, 0x66, 0xbf,
-		0x5f, 0xab, 0xbc, 0x2f, 0x0e, 0x33, 0x61, 0xab, 0xe0, 0xcc, 0xd9, 0x76, 0x94, 0x56, 0x7b, 0x2f,
-		0xb3, 0x9c, 0x81, 0xf4, 0x06, 0x97, 0x39, 0xb0, 0x5d, 0x9e, 0x3d, 0xa0, 0x5d, 0x72, 0x6e, 0x53,
-		0xdc, 0x30, 0x27, 0xfb, 0x34, 0x8c, 0xdb, 0x8f, 0xeb, 0xb2, 0xcc, 0xdb, 0x4f, 0xc1, 0xac, 0x66,
-		0x68, 0x8e, 0xa6, 0x2c, 0xb4, 0x6c, 0x47, 0xb9, 0xa8, 0x19, 0xcd, 0x85, 0x4b, 0x77, 0x2d, 0xb0,
-		0x3f, 0x99, 0x69, 0x44, 0x2a, 0x31, 0xcf, 0x25, 0xe6, 0x2f, 0xdd, 0x35, 0x15, 0x11, 0xab, 0xf7,
-		0x0b, 0xc0, 0x7b, 0x44, 0xec, 0x3d, 0xa7, 0xed, 0x54, 0x84, 0x47, 0x4f, 0x45, 0x8d, 0x6d, 0xe1,
-		0xe9, 0x38, 0x8c, 0x2e, 0x9a, 0xad, 0x96, 0x66, 0xd3, 0x2f, 0xa1, 0x1d, 0xf2, 0x5d, 0x4c, 0xc2,
-		0x52, 0x1c, 0x96, 0x59, 0x97, 0x6f, 0xea, 0x23, 0x7a, 0x49, 0x04, 0x20, 0xbe, 0x01, 0x52, 0x2d,
-		0xe5, 0x8a, 0x4c, 0xc0, 0x34, 0x3e, 0x9f, 0xea, 0x03, 0x7c, 0xed, 0xea, 0xcc, 0xe8, 0xae, 0xd2,
-		0xd2, 0x8b, 0x05, 0x0e, 0x2e, 0x48, 0xc3, 0x2d, 0xe5, 0x0a, 0x56, 0x46, 0x44, 0x30, 0x8a, 0x4b,
-		0xd5, 0x1d, 0xc5, 0x68, 0x22, 0xca, 0x4c, 0xb6, 0x47, 0xe5, 0x07, 0xfa, 0x63, 0x9e, 0xf4, 0x98,
-		0x7d, 0x1c, 0x05, 0x69, 0xa4, 0xa5, 0x5c, 0x59, 0x24, 0x05, 0xb8, 0x99, 0x62, 0xea, 0x99, 0xe7,
-		0x66, 0x06, 0xc8, 0x82, 0xf0, 0x67, 0x31, 0x00, 0xcf, 0x20, 0xe2, 0x23, 0x20, 0xa8, 0xee, 0x13,
-		0xc1, 0x52, 0x37, 0xcf, 0x9c, 0xbc, 0x69, 0xbe, 0x7b, 0xec, 0xe7, 0x43, 0xa6, 0x2c, 0xa7, 0xb0,
-		0x96, 0xcf, 0x5f, 0x9d, 0x89, 0x49, 0xa3, 0x6a, 0xc8, 0xca, 0x6f, 0x84, 0x4c, 0xa7, 0xdd, 0x50,
-		0x1c, 0x24, 0xe3, 0x31, 0x61, 0xdf, 0xa5, 0x4e, 0x75, 0x25, 0x1b, 0xae, 0xf7, 0x97, 0xa7, 0x31,
-		0xd7, 0xb5, 0xab, 0x33, 0x22, 0xed, 0x92, 0x0f, 0x5c, 0x78, 0xf7, 0xb7, 0x67, 0x62, 0x12, 0xd0,
-		0x12, 0x0c, 0xf0, 0xf5, 0xe7, 0xeb, 0x31, 0xc8, 0x54, 0x7c, 0x1b, 0xa0, 0x3c, 0x0c, 0xb7, 0x4c,
-		0x43, 0xbb, 0x88, 0x2c, 0x1e, 0xcf, 0xd9, 0xa3, 0x38, 0x05, 0x29, 0xfa, 0x5e, 0xa0, 0xb3, 0xcb,
-		0xbf, 0xd7, 0xe6, 0xcf, 0x18, 0x75, 0x19, 0x6d, 0xd9, 0x1a, 0x37, 0xbf, 0xc4, 0x1f, 0xc5, 0xb3,
-		0x20, 0xd8, 0x48, 0xed, 0x58, 0x9a, 0xb3, 0x2b, 0xab, 0xa6, 0xe1, 0x28, 0xaa, 0x43, 0xdf, 0x30,
-		0x2b, 0x1f, 0xbd, 0x76, 0x75, 0xe6, 0x30, 0xd5, 0x35, 0x2c, 0x51, 0x90, 0x46, 0x79, 0xd1, 0x22,
-		0x2d, 0xc1, 0x2d, 0x34, 0x90, 0xa3, 0x68, 0xba, 0x9d, 0xa7, 0xf9, 0x14, 0x7f, 0xf4, 0xf5, 0xe5,
-		0x4b, 0x69, 0x48, 0x5f, 0x50, 0x74, 0xad, 0xa1, 0xe0, 0x0d, 0xe0, 0x59, 0x10, 0xcc, 0x36, 0xb2,
-		0x14, 0xf2, 0x19, 0x4c, 0xa3, 0x61, 0x21, 0xdb, 0x66, 0x2e, 0xeb, 0x6b, 0x39, 0x2c, 0x51, 0x90,
-		0x46, 0x79, 0x51, 0x89, 0x96, 0x88, 0x0e, 0x1e, 0x62, 0xc3, 0x46, 0x86, 0xdd, 0xb1, 0xe5, 0x76,
-		0x67, 0xeb, 0x22, 0xda, 0x65, 0xa3, 0x31, 0xd1, 0x35, 0x1a, 0x25, 0x63, 0xb7, 0x7c, 0xb7, 0xc7,
-		0x1e, 0xc6, 0x15, 0xbe, 0xf1, 0xf9, 0x13, 0x13, 0x6c, 0x46, 0xab, 0xd6, 0x6e, 0xdb, 0x31, 0xe7,
-		0xd7, 0x3b, 0x5b, 0x0f, 0xa1, 0x5d, 0x3c, 0xfc, 0x4c, 0x74, 0x9d, 0x48, 0x8a, 0x93, 0x90, 0x7c,
-		0x5c, 0xd1, 0x74, 0xfe, 0x06, 0xb6, 0xc4, 0x9e, 0xc4, 0x53, 0x90, 0xb4, 0x1d, 0xc5, 0xe9, 0xd8,
-		0xec, 0xb3, 0xfe, 0xe9, 0x5e, 0x6e, 0x56, 0x36, 0x8d, 0x46, 0x9d, 0x48, 0x49, 0x4c, 0x5a, 0x74,
-		0x20, 0xe9, 0x98, 0x17, 0x91, 0x61, 0xb3, 0xdf, 0xb4, 0x38, 0x32, 0xdf, 0x23, 0x25, 0x9c, 0xc7,
-		0xa9, 0x55, 0xb9, 0xc4, 0x1c, 0x69, 0x84, 0x76, 0x82, 0xc2, 0x0a, 0x9f, 0xfa, 0xf6, 0xcc, 0x5c,
-		0x74, 0x3a, 0x41, 0x18, 0x6c, 0x89, 0xb5, 0x25, 0xbe, 0x2f, 0x06, 0x42, 0x03, 0xe9, 0xa8, 0x49,
-		0x6c, 0x6c, 0xef, 0x28, 0x16, 0xb2, 0xd9, 0xaf, 0x84, 0xdc, 0xd0, 0x53, 0x01, 0x96, 0xde, 0x95,
-		0x57, 0x99, 0x0e, 0xcc, 0x90, 0x61, 0x0e, 0xac, 0x4d, 0x1f, 0xb9, 0x32, 0xa7, 0xb3, 0xa5, 0x51,
-		0x97, 0xa1, 0x4e, 0x08, 0xc4, 0xa5, 0xe0, 0x51, 0x00, 0xff, 0x41, 0x8d, 0x1e, 0xc6, 0xf4, 0xcd,
-		0x8e, 0x72, 0x02, 0xab, 0x15, 0x3c, 0x31, 0x38, 0x0b, 0x42, 0xc7, 0xd8, 0x32, 0x0d, 0xf2, 0x62,
-		0xe4, 0x0e, 0xd2, 0x9a, 0x3b, 0x0e, 0x79, 0xf5, 0x74, 0xd0, 0xef, 0x66, 0x61, 0x89, 0x82, 0x34,
-		0xea, 0x16, 0x9d, 0x23, 0x25, 0x62, 0x03, 0x72, 0x9e, 0x14, 0x99, 0xf2, 0xe9, 0xc8, 0x29, 0x7f,
-		0x8c, 0x59, 0xe9, 0x50, 0xb8, 0x15, 0x6f, 0xd6, 0x8f, 0xb8, 0x85, 0x18, 0x26, 0x56, 0x00, 0xbc,
-		0x40, 0x43, 0xbe, 0xcd, 0xc9, 0xf4, 0x76, 0x21, 0x2f, 0x52, 0xb1, 0x4e, 0xfb, 0x70, 0xe2, 0x3b,
-		0x62, 0x30, 0x72, 0xc9, 0x74, 0x70, 0x4b, 0x6d, 0xf3, 0x32, 0xb2, 0x6c, 0xf2, 0x33, 0x42, 0xfb,
-		0x3a, 0xd5, 0x39, 0xa6, 0xea, 0x04, 0x55, 0x35, 0x80, 0x3e, 0x98, 0x6f, 0x65, 0x29, 0x76, 0x9d,
-		0x40, 0xc5, 0x87, 0x21, 0xeb, 0xe7, 0xa2, 0x3f, 0x8e, 0x53, 0xbe, 0x67, 0xdf, 0xec, 0xfc, 0xda,
-		0xd5, 0x99, 0xf1, 0x6e, 0x35, 0x0a, 0x52, 0xc6, 0xc7, 0x2c, 0xbe, 0x16, 0x8e, 0x7a, 0xf6, 0x34,
-		0x0d, 0x79, 0xc7, 0xd4, 0x1b, 0xb2, 0x85, 0xb6, 0x65, 0x95, 0xec, 0x2f, 0x46, 0x48, 0x2e, 0x73,
-		0xd8, 0x15, 0x59, 0x33, 0xce, 0x99, 0x7a, 0x43, 0x42, 0xdb, 0x8b, 0xb8, 0x5a, 0x3c, 0x06, 0x59,
-		0x0f, 0xad, 0x35, 0xf2, 0x39, 0xf2, 0xae, 0x74, 0xc6, 0x2d, 0xab, 0x35, 0x8a, 0xd9, 0x77, 0x3c,
-		0x37, 0x33, 0xc0, 0x62, 0xd7, 0x40, 0xe1, 0x14, 0x64, 0x2f, 0x28, 0x3a, 0x8b, 0x39, 0xc8, 0x16,
-		0x6f, 0x80, 0xb4, 0xc2, 0x1f, 0xc8, 0x2b, 0xac, 0x69, 0xc9, 0x2b, 0xa0, 0x31, 0xef, 0xc9, 0xbf,
-		0x9e, 0x8d, 0x15, 0x3e, 0x1d, 0x83, 0x64, 0xe5, 0xc2, 0xba, 0xa2, 0x59, 0x62, 0x0d, 0xc6, 0xbc,
-		0x79, 0x12, 0x8c, 0x78, 0x37, 0x5c, 0xbb, 0x3a, 0x93, 0x0f, 0x4f, 0x25, 0x37, 0xe4, 0x79, 0x53,
-		0x94, 0xc7, 0xbc, 0x1a, 0x8c, 0x5d, 0xe2, 0x81, 0xd4, 0xa5, 0x8a, 0x87, 0xa9, 0xba, 0x44, 0x0a,
-		0x92, 0xe0, 0x96, 0x31, 0xaa, 0x50, 0x37, 0x4b, 0x30, 0x4c, 0xb5, 0xb5, 0xc5, 0x53, 0x30, 0xd4,
-		0xc6, 0x7f, 0xb0, 0x17, 0x7f, 0xa7, 0x7a, 0xce, 0x3d, 0x22, 0xcb, 0x3c, 0x90, 0x8a, 0x17, 0xde,
-		0x13, 0x07, 0xa8, 0x5c, 0xb8, 0xb0, 0x61, 0x69, 0x6d, 0x1d, 0x39, 0x3f, 0xcf, 0x5e, 0x6f, 0xc0,
-		0x21, 0xaf, 0x4b, 0xb6, 0xa5, 0x86, 0x7a, 0x3e, 0x7b, 0xed, 0xea, 0xcc, 0x0d, 0xe1, 0x9e, 0xfb,
-		0xc4, 0x0a, 0xd2, 0xb8, 0x5b, 0x5e, 0xb7, 0xd4, 0x9e, 0xac, 0x0d, 0xdb, 0x71, 0x59, 0x07, 0xf7,
-		0x66, 0xf5, 0x89, 0xf9, 0x59, 0x2b, 0xb6, 0xd3, 0xdb, 0xac, 0x6b, 0x90, 0xf1, 0x4c, 0x62, 0x8b,
-		0xaf, 0x87, 0x94, 0xc3, 0xfe, 0x66, 0xd6, 0x9d, 0xee, 0x6d, 0x5d, 0x0e, 0x61, 0x16, 0x76, 0x51,
-		0x85, 0x4f, 0x63, 0x23, 0x53, 0xfb, 0xe0, 0x09, 0xff, 0x4b, 0xe9, 0x5a, 0xe2, 0x13, 0x90, 0x64,
-		0x4b, 0xca, 0x60, 0x1f, 0x4b, 0x4a, 0x25, 0xb8, 0xac, 0x5d, 0xe7, 0x42, 0xc2, 0x5a, 0x0c, 0xd9,
-		0xff, 0xed, 0x71, 0x18, 0xdf, 0xe4, 0x73, 0xfb, 0x97, 0xde, 0x6e, 0xcb, 0x30, 0x8c, 0x0c, 0xc7,
-		0xd2, 0x5c, 0xc3, 0xdd, 0xd1, 0xcb, 0x3b, 0x7a, 0xf4, 0x87, 0x7c, 0xdb, 0xcb, 0x7c, 0x85, 0x53,
-		0x84, 0x2c, 0xf1, 0x95, 0x04, 0xe4, 0xf7, 0x42, 0x8a, 0x8b, 0x30, 0xaa, 0x5a, 0x88, 0x14, 0xf0,
-		0xa5, 0x92, 0xec, 0x09, 0xcb, 0x53, 0x5e, 0x2a, 0x1e, 0x12, 0x28, 0x48, 0x39, 0x5e, 0xc2, 0x16,
-		0xca, 0x26, 0xe0, 0x5c, 0x19, 0xbb, 0x29, 0x96, 0xea, 0x33, 0x39, 0x2e, 0xb0, 0xc1, 0xe7, 0x8d,
-		0x04, 0x09, 0xe8, 0x52, 0x99, 0xf3, 0x4a, 0xc9, 0x5a, 0xf9, 0x74, 0x0c, 0x46, 0xa9, 0x5d, 0x74,
-		0x79, 0x4b, 0xd1, 0x15, 0x43, 0xe5, 0x3f, 0x4d, 0xb6, 0xcf, 0x3a, 0x77, 0x3e, 0xd8, 0x50, 0x08,
-		0x7f, 0xb0, 0x95, 0x2e, 0xc7, 0xd0, 0x65, 0x0a, 0x16, 0x2f, 0xc3, 0x30, 0xd7, 0x23, 0x11, 0xa5,
-		0x47, 0x99, 0xe9, 0x91, 0xa3, 0x7a, 0x5c, 0x57, 0xfb, 0xbc, 0xb5, 0xae, 0xd5, 0x6c, 0xa8, 0x6b,
-		0x35, 0x8b, 0x5a, 0x2e, 0x93, 0xfb, 0x2e, 0x97, 0xbe, 0x1c, 0xfe, 0xab, 0x09, 0x18, 0x93, 0x50,
-		0xe3, 0xbf, 0x1c, 0xe7, 0xe7, 0xe9, 0x38, 0x6f, 0x8b, 0x01, 0xd0, 0xb8, 0x85, 0x57, 0x16, 0xe6,
-		0x3c, 0xfb, 0x47, 0x4b, 0x9e, 0xaf, 0x8d, 0xf9, 0xa3, 0x25, 0x46, 0x1f, 0x38, 0x62, 0xa6, 0x29,
-		0xb6, 0x62, 0x3b, 0xaf, 0xa6, 0x1f, 0x7d, 0x2b, 0x0e, 0x59, 0xbf, 0x1f, 0xfd, 0x27, 0xcd, 0x13,
-		0xc4, 0xaa, 0x17, 0xeb, 0x13, 0xec, 0x77, 0x07, 0x7b, 0xc4, 0xfa, 0xae, 0x19, 0xb7, 0x7f, 0x90,
-		0xff, 0x5c, 0x02, 0x92, 0xeb, 0x8a, 0xa5, 0xb4, 0x6c, 0x51, 0xed, 0xda, 0xb6, 0xd0, 0xe3, 0x8f,
-		0x23, 0xdd, 0xbf, 0x2c, 0xc8, 0x8e, 0x9e, 0x22, 0x76, 0x2d, 0xcf, 0xf4, 0xd8, 0xb5, 0xbc, 0x1e,
-		0x72, 0x2d, 0xe5, 0x8a, 0xec, 0xf6, 0x8f, 0x5a, 0x7a, 0xa4, 0x7c, 0xc4, 0x63, 0x09, 0xd6, 0xd3,
-		0x03, 0x1c, 0xf7, 0x2c, 0xc0, 0x16, 0x4f, 0x43, 0x06, 0x4b, 0x78, 0xcb, 0x1e, 0x86, 0x4f, 0x7a,
-		0xa7, 0x25, 0xbe, 0xca, 0x82, 0x04, 0x2d, 0xe5, 0x4a, 0x95, 0x3e, 0x88, 0xcb, 0x20, 0xee, 0x68,
-		0xb6, 0x63, 0x5a, 0x9a, 0xaa, 0xe8, 0xb2, 0x67, 0x4a, 0x8c, 0xbf, 0xf1, 0xda, 0xd5, 0x99, 0x23,
-		0x14, 0xdf, 0x2d, 0x53, 0x90, 0xc6, 0xbc, 0x42, 0xce, 0x76, 0x1a, 0x32, 0xb8, 0x5f, 0x32, 0xb9,
-		0xad, 0xa0, 0x5b, 0xf1, 0xb4, 0x5f, 0x0d, 0x5f, 0x65, 0x41, 0x02, 0xfc, 0x54, 0x21, 0x0f, 0x62,
-		0x15, 0x84, 0x96, 0x66, 0xc8, 0x81, 0xad, 0x0e, 0x9e, 0x0b, 0x09, 0xff, 0x2e, 0x33, 0x2c, 0x51,
-		0x90, 0x72, 0x2d, 0xcd, 0xb8, 0xe0, 0xdb, 0xd4, 0xbc, 0x19, 0xc6, 0xb1, 0x50, 0xe8, 0xc8, 0x8a,
-		0xfe, 0xba, 0x1e, 0xdd, 0xf7, 0x47, 0x1f, 0x99, 0x4d, 0x79, 0x8d, 0x85, 0x78, 0x0a, 0xd2, 0x58,
-		0x4b, 0x33, 0x82, 0x67, 0x5c, 0xbe, 0x29, 0xf9, 0xa7, 0x31, 0x10, 0xbd, 0x8c, 0x40, 0x42, 0x76,
-		0xdb, 0x34, 0x6c, 0xb2, 0x25, 0xf5, 0x9c, 0x8f, 0x79, 0x4f, 0xef, 0x74, 0xd5, 0x95, 0xe2, 0x5b,
-		0x52, 0xdf, 0xf4, 0x46, 0xde, 0xda, 0x18, 0x8f, 0x0a, 0xb5, 0xe4, 0x1a, 0xea, 0xba, 0x56, 0x42,
-		0xb7, 0x37, 0x03, 0x85, 0xef, 0xc5, 0xe0, 0x48, 0xd7, 0xb4, 0x71, 0x3b, 0xf5, 0x18, 0x88, 0x96,
-		0xaf, 0x92, 0xfd, 0x4a, 0x0a, 0xed, 0xdc, 0x81, 0x66, 0xe0, 0x98, 0xd5, 0xb5, 0x18, 0xbe, 0x4a,
-		0x5d, 0xa5, 0x17, 0x60, 0x5f, 0x8c, 0xc1, 0x84, 0x5f, 0x37, 0xb7, 0x87, 0xe7, 0x21, 0xeb, 0x57,
-		0x8d, 0xf5, 0x6d, 0x36, 0xaa, 0x6f, 0xac, 0x5b, 0x01, 0xac, 0xb8, 0xe2, 0x05, 0x29, 0xfe, 0x41,
-		0x73, 0x3f, 0x26, 0xe2, 0xba, 0x84, 0x83, 0x55, 0x82, 0x0c, 0xd0, 0x3f, 0x0f, 0x42, 0x62, 0xdd,
-		0x34, 0x75, 0xf1, 0x93, 0x31, 0x18, 0x33, 0x4c, 0x47, 0xc6, 0xd3, 0x09, 0x35, 0x64, 0x76, 0x0c,
-		0x16, 0x8b, 0x32, 0x9d, 0x82, 0x49, 0x7f, 0x78, 0x75, 0xa6, 0x1b, 0xeb, 0x2d, 0x17, 0x5d, 0x55,
-		0x07, 0x5b, 0xa7, 0x47, 0x0d, 0xd3, 0x29, 0x13, 0xf8, 0x06, 0x3d, 0x2f, 0x7b, 0x7f, 0x0c, 0x46,
-		0x82, 0x6a, 0x46, 0x8e, 0xf0, 0xa3, 0x4c, 0xcd, 0x91, 0xb0, 0x8a, 0x13, 0x5e, 0x48, 0xb9, 0x4e,
-		0xf5, 0xb2, 0x5b, 0x7e, 0xdd, 0x3e, 0x1a, 0x83, 0x09, 0x7f, 0x74, 0x91, 0x2f, 0x93, 0xb4, 0xaa,
-		0xbf, 0xcd, 0x97, 0xc4, 0x62, 0xfe, 0xd1, 0xee, 0x73, 0x17, 0xce, 0x73, 0xe0, 0xc4, 0x42, 0xf4,
-		0x1d, 0xd7, 0x3c, 0x4c, 0x39, 0x8a, 0x29, 0xec, 0xb4, 0x3f, 0x79, 0x6e, 0x26, 0x76, 0xfc, 0x0b,
-		0x31, 0x00, 0xef, 0x1c, 0x54, 0xbc, 0x03, 0x0e, 0x97, 0xd7, 0x56, 0x2b, 0x72, 0x7d, 0xa3, 0xb4,
-		0xb1, 0x59, 0x0f, 0xbe, 0x91, 0x33, 0x35, 0xfa, 0xd4, 0xb3, 0xb3, 0x99, 0x4d, 0xc3, 0x6e, 0x23,
-		0x95, 0xfc, 0xce, 0x8d, 0x78, 0x0b, 0x4c, 0x04, 0xa5, 0xf1, 0x53, 0xb5, 0x22, 0xc4, 0xa6, 0xb2,
-		0x4f, 0x3d, 0x3b, 0x9b, 0xa2, 0x1b, 0x1c, 0xd4, 0x10, 0xe7, 0xe0, 0x50, 0xb7, 0x5c, 0x6d, 0x75,
-		0x49, 0x88, 0x4f, 0x8d, 0x3c, 0xf5, 0xec, 0x6c, 0xda, 0xdd, 0x09, 0x89, 0x05, 0x10, 0xfd, 0x92,
-		0x8c, 0x6f, 0x70, 0x0a, 0x9e, 0x7a, 0x76, 0x36, 0x49, 0x7d, 0x60, 0x2a, 0xf1, 0x8e, 0x8f, 0x4d,
-		0x0f, 0x1c, 0x7f, 0x13, 0x40, 0xcd, 0xd8, 0xb6, 0x14, 0xf2, 0x99, 0xad, 0x38, 0x05, 0x93, 0xb5,
-		0xd5, 0xb3, 0x52, 0x69, 0x91, 0xfd, 0x30, 0x8b, 0xff, 0x45, 0xa2, 0x60, 0x1d, 0xfd, 0x75, 0x51,
-		0xb9, 0x5e, 0x5b, 0x5a, 0x15, 0x62, 0xe4, 0x17, 0x08, 0xfc, 0x75, 0x0f, 0xd3, 0x9f, 0x6e, 0x89,
-		0x97, 0xcf, 0xed, 0x79, 0x5b, 0x3c, 0xef, 0x33, 0x3e, 0x9d, 0x7e, 0x27, 0x74, 0x65, 0xcb, 0x66,
-		0x7f, 0x2f, 0x5c, 0xf1, 0xee, 0xb9, 0x02, 0x37, 0xc7, 0xff, 0x16, 0x00, 0x00, 0xff, 0xff, 0xfe,
-		0x6c, 0x9c, 0x02, 0x6e, 0x5d, 0x00, 0x00,
-	}
-	r := bytes.NewReader(gzipped)
-	gzipr, err := compress_gzip.NewReader(r)
-	if err != nil {
-		panic(err)
+func (m *RedelegationQueueEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationQueueEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	ungzipped, err := io_ioutil.ReadAll(gzipr)
-	if err != nil {
-		panic(err)
+}
+func (m *RedelegationQueueEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationQueueEntry.Merge(m, src)
+}
+func (m *RedelegationQueueEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationQueueEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationQueueEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegationQueueEntry proto.InternalMessageInfo
+
+func (m *RedelegationQueueEntry) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	if err := github_com_cosmos_gogoproto_proto.Unmarshal(ungzipped, d); err != nil {
-		panic(err)
+	return ""
+}
+
+func (m *RedelegationQueueEntry) GetValidatorSrcAddress() string {
+	if m != nil {
+		return m.ValidatorSrcAddress
 	}
-	return d
+	return ""
 }
-func (this *CommissionRates) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RedelegationQueueEntry) GetValidatorDstAddress() string {
+	if m != nil {
+		return m.ValidatorDstAddress
 	}
+	return ""
+}
 
-	that1, ok := that.(*CommissionRates)
-	if !ok {
-		that2, ok := that.(CommissionRates)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Rate.Equal(that1.Rate) {
-		return false
-	}
-	if !this.MaxRate.Equal(that1.MaxRate) {
-		return false
-	}
-	if !this.MaxChangeRate.Equal(that1.MaxChangeRate) {
-		return false
+func (m *RedelegationQueueEntry) GetEntry() RedelegationEntry {
+	if m != nil {
+		return m.Entry
 	}
-	return true
+	return RedelegationEntry{}
 }
-func (this *Commission) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Commission)
-	if !ok {
-		that2, ok := that.(Commission)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.CommissionRates.Equal(&that1.CommissionRates) {
-		return false
-	}
-	if !this.UpdateTime.Equal(that1.UpdateTime) {
-		return false
-	}
-	return true
+// UnbondingMaturity defines the amount of tokens unlocked at a completion time.
+type UnbondingMaturity struct {
+	// completion_time defines the unix time for unbonding completion.
+	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+	// amount defines the tokens to receive at completion.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
-func (this *Description) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Description)
-	if !ok {
-		that2, ok := that.(Description)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *UnbondingMaturity) Reset()         { *m = UnbondingMaturity{} }
+func (m *UnbondingMaturity) String() string { return proto.CompactTextString(m) }
+func (*UnbondingMaturity) ProtoMessage()    {}
+func (*UnbondingMaturity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_869fea6a46e7b076, []int{21}
+}
+func (m *UnbondingMaturity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingMaturity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingMaturity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Moniker != that1.Moniker {
-		return false
-	}
-	if this.Identity != that1.Identity {
-		return false
-	}
-	if this.Website != that1.Website {
-		return false
-	}
-	if this.SecurityContact != that1.SecurityContact {
-		return false
-	}
-	if this.Details != that1.Details {
-		return false
-	}
-	return true
 }
-func (this *UnbondingDelegationEntry) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *UnbondingMaturity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingMaturity.Merge(m, src)
+}
+func (m *UnbondingMaturity) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingMaturity) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingMaturity.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*UnbondingDelegationEntry)
-	if !ok {
-		that2, ok := that.(UnbondingDelegationEntry)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.CreationHeight != that1.CreationHeight {
-		return false
-	}
-	if !this.CompletionTime.Equal(that1.CompletionTime) {
-		return false
-	}
-	if len(this.InitialBalance) != len(that1.InitialBalance) {
-		return false
-	}
-	for i := range this.InitialBalance {
-		if !this.InitialBalance[i].Equal(&that1.InitialBalance[i]) {
-			return false
-		}
+var xxx_messageInfo_UnbondingMaturity proto.InternalMessageInfo
+
+func (m *UnbondingMaturity) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
 	}
-	if len(this.Balance) != len(that1.Balance) {
-		return false
+	return time.Time{}
+}
+
+func (m *UnbondingMaturity) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	for i := range this.Balance {
-		if !this.Balance[i].Equal(&that1.Balance[i]) {
-			return false
+	return nil
+}
+
+// DelegationResponse is equivalent to Delegation except that it contains a
+// balance in addition to shares which is more suitable for client responses.
+type DelegationResponse struct {
+	Delegation Delegation                               `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
+	Balance    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+}
+
+func (m *DelegationResponse) Reset()      { *m = DelegationResponse{} }
+func (*DelegationResponse) ProtoMessage() {}
+func (*DelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_869fea6a46e7b076, []int{22}
+}
+func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.UnbondingId != that1.UnbondingId {
-		return false
-	}
-	if this.UnbondingOnHoldRefCount != that1.UnbondingOnHoldRefCount {
-		return false
+}
+func (m *DelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationResponse.Merge(m, src)
+}
+func (m *DelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationResponse proto.InternalMessageInfo
+
+func (m *DelegationResponse) GetDelegation() Delegation {
+	if m != nil {
+		return m.Delegation
 	}
-	return true
+	return Delegation{}
 }
-func (this *RedelegationEntry) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *DelegationResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
 	}
+	return nil
+}
 
-	that1, ok := that.(*RedelegationEntry)
-	if !ok {
-		that2, ok := that.(RedelegationEntry)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// RedelegationEntryResponse is equivalent to a RedelegationEntry except that it
+// contains a balance in addition to shares which is more suitable for client
+// responses.
+type RedelegationEntryResponse struct {
+	RedelegationEntry RedelegationEntry                        `protobuf:"bytes,1,opt,name=redelegation_entry,json=redelegationEntry,proto3" json:"redelegation_entry"`
+	Balance           github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+}
+
+func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResponse{} }
+func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
+func (*RedelegationEntryResponse) ProtoMessage()    {}
+func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_869fea6a46e7b076, []int{23}
+}
+func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegationEntryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationEntryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *RedelegationEntryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationEntryResponse.Merge(m, src)
+}
+func (m *RedelegationEntryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationEntryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationEntryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegationEntryResponse proto.InternalMessageInfo
+
+func (m *RedelegationEntryResponse) GetRedelegationEntry() RedelegationEntry {
+	if m != nil {
+		return m.RedelegationEntry
 	}
-	if this.CreationHeight != that1.CreationHeight {
-		return false
+	return RedelegationEntry{}
+}
+
+func (m *RedelegationEntryResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
 	}
-	if !this.CompletionTime.Equal(that1.CompletionTime) {
-		return false
-	}
-	if len(this.InitialBalance) != len(that1.InitialBalance) {
-		return false
-	}
-	for i := range this.InitialBalance {
-		if !this.InitialBalance[i].Equal(&that1.InitialBalance[i]) {
-			return false
-		}
-	}
-	if len(this.SharesDst) != len(that1.SharesDst) {
-		return false
-	}
-	for i := range this.SharesDst {
-		if !this.SharesDst[i].Equal(&that1.SharesDst[i]) {
-			return false
+	return nil
+}
+
+// RedelegationResponse is equivalent to a Redelegation except that its entries
+// contain a balance in addition to shares which is more suitable for client
+// responses.
+type RedelegationResponse struct {
+	Redelegation Redelegation                `protobuf:"bytes,1,opt,name=redelegation,proto3" json:"redelegation"`
+	Entries      []RedelegationEntryResponse `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
+func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*RedelegationResponse) ProtoMessage()    {}
+func (*RedelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_869fea6a46e7b076, []int{24}
+}
+func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.UnbondingId != that1.UnbondingId {
-		return false
-	}
-	if this.UnbondingOnHoldRefCount != that1.UnbondingOnHoldRefCount {
-		return false
+}
+func (m *RedelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationResponse.Merge(m, src)
+}
+func (m *RedelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegationResponse proto.InternalMessageInfo
+
+func (m *RedelegationResponse) GetRedelegation() Redelegation {
+	if m != nil {
+		return m.Redelegation
 	}
-	return true
+	return Redelegation{}
 }
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RedelegationResponse) GetEntries() []RedelegationEntryResponse {
+	if m != nil {
+		return m.Entries
 	}
+	return nil
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// Pool is used for tracking bonded and not-bonded token supply of the bond
+// denomination.
+type Pool struct {
+	NotBondedTokens    github_com_cosmos_cosmos_sdk_types.Coins    `protobuf:"bytes,1,rep,name=not_bonded_tokens,json=notBondedTokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"not_bonded_tokens" yaml:"not_bonded_tokens"`
+	BondedTokens       github_com_cosmos_cosmos_sdk_types.Coins    `protobuf:"bytes,2,rep,name=bonded_tokens,json=bondedTokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bonded_tokens" yaml:"bonded_tokens"`
+	VotingPowerWeights github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=voting_power_weights,json=votingPowerWeights,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"voting_power_weights" yaml:"voting_power_weights"`
+}
+
+func (m *Pool) Reset()         { *m = Pool{} }
+func (m *Pool) String() string { return proto.CompactTextString(m) }
+func (*Pool) ProtoMessage()    {}
+func (*Pool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_869fea6a46e7b076, []int{25}
+}
+func (m *Pool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.UnbondingTime != that1.UnbondingTime {
-		return false
-	}
-	if this.MaxValidators != that1.MaxValidators {
-		return false
-	}
-	if this.MaxEntries != that1.MaxEntries {
-		return false
+}
+func (m *Pool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pool.Merge(m, src)
+}
+func (m *Pool) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pool) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pool proto.InternalMessageInfo
+
+func (m *Pool) GetNotBondedTokens() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.NotBondedTokens
 	}
-	if this.HistoricalEntries != that1.HistoricalEntries {
-		return false
+	return nil
+}
+
+func (m *Pool) GetBondedTokens() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BondedTokens
 	}
-	if len(this.BondDenoms) != len(that1.BondDenoms) {
-		return false
+	return nil
+}
+
+func (m *Pool) GetVotingPowerWeights() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.VotingPowerWeights
 	}
-	for i := range this.BondDenoms {
-		if this.BondDenoms[i] != that1.BondDenoms[i] {
-			return false
+	return nil
+}
+
+// SlashEvent defines a slash of a validator.
+type SlashEvent struct {
+	// id is the unique id of the slash event.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// validator_address is the operator address of the slashed validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// infraction_height is the height at which the infraction was committed.
+	InfractionHeight int64 `protobuf:"varint,3,opt,name=infraction_height,json=infractionHeight,proto3" json:"infraction_height,omitempty" yaml:"infraction_height"`
+	// height is the height at which the validator was slashed.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the validator was slashed.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// slash_factor is the fraction of the stake slashed.
+	SlashFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=slash_factor,json=slashFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_factor" yaml:"slash_factor"`
+	// burned defines the tokens burned by the slash, from the validator, the unbonding
+	// delegations and the redelegations.
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	// truncated is true if the delegator impacts were dropped by the max number of
+	// impacts per slash event.
+	Truncated bool `protobuf:"varint,8,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (m *SlashEvent) Reset()         { *m = SlashEvent{} }
+func (m *SlashEvent) String() string { return proto.CompactTextString(m) }
+func (*SlashEvent) ProtoMessage()    {}
+func (*SlashEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_869fea6a46e7b076, []int{26}
+}
+func (m *SlashEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.MinVotingPower != that1.MinVotingPower {
-		return false
+}
+func (m *SlashEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashEvent.Merge(m, src)
+}
+func (m *SlashEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashEvent proto.InternalMessageInfo
+
+func (m *SlashEvent) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
-		return false
+	return 0
+}
+
+func (m *SlashEvent) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return true
+	return ""
 }
-func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *SlashEvent) GetInfractionHeight() int64 {
+	if m != nil {
+		return m.InfractionHeight
 	}
+	return 0
+}
 
-	that1, ok := that.(*RedelegationEntryResponse)
-	if !ok {
-		that2, ok := that.(RedelegationEntryResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *SlashEvent) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *SlashEvent) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	if !this.RedelegationEntry.Equal(&that1.RedelegationEntry) {
-		return false
+	return time.Time{}
+}
+
+func (m *SlashEvent) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
 	}
-	if len(this.Balance) != len(that1.Balance) {
-		return false
+	return nil
+}
+
+func (m *SlashEvent) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
 	}
-	for i := range this.Balance {
-		if !this.Balance[i].Equal(&that1.Balance[i]) {
-			return false
+	return false
+}
+
+// DelegatorSlashImpact defines the tokens a delegator lost by a slash event.
+type DelegatorSlashImpact struct {
+	// slash_event_id is the id of the slash event.
+	SlashEventId uint64 `protobuf:"varint,1,opt,name=slash_event_id,json=slashEventId,proto3" json:"slash_event_id,omitempty" yaml:"slash_event_id"`
+	// delegator_address is the bech32-encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	// validator_address is the operator address of the slashed validator.
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// height is the height at which the validator was slashed.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the validator was slashed.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// delegation defines the tokens lost from the delegation to the slashed validator.
+	Delegation github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=delegation,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"delegation"`
+	// unbonding defines the tokens burned from the unbonding delegations from the
+	// slashed validator.
+	Unbonding github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=unbonding,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unbonding"`
+	// redelegation defines the tokens burned from the redelegations from the
+	// slashed validator.
+	Redelegation github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=redelegation,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"redelegation"`
+}
+
+func (m *DelegatorSlashImpact) Reset()         { *m = DelegatorSlashImpact{} }
+func (m *DelegatorSlashImpact) String() string { return proto.CompactTextString(m) }
+func (*DelegatorSlashImpact) ProtoMessage()    {}
+func (*DelegatorSlashImpact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_869fea6a46e7b076, []int{27}
+}
+func (m *DelegatorSlashImpact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorSlashImpact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorSlashImpact.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *Pool) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *DelegatorSlashImpact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorSlashImpact.Merge(m, src)
+}
+func (m *DelegatorSlashImpact) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorSlashImpact) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorSlashImpact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorSlashImpact proto.InternalMessageInfo
+
+func (m *DelegatorSlashImpact) GetSlashEventId() uint64 {
+	if m != nil {
+		return m.SlashEventId
 	}
+	return 0
+}
 
-	that1, ok := that.(*Pool)
-	if !ok {
-		that2, ok := that.(Pool)
-		if ok {
+func (m *DelegatorSlashImpact) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *DelegatorSlashImpact) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *DelegatorSlashImpact) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DelegatorSlashImpact) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *DelegatorSlashImpact) GetDelegation() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Delegation
+	}
+	return nil
+}
+
+func (m *DelegatorSlashImpact) GetUnbonding() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unbonding
+	}
+	return nil
+}
+
+func (m *DelegatorSlashImpact) GetRedelegation() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Redelegation
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("initia.mstaking.v1.BondStatus", BondStatus_name, BondStatus_value)
+	proto.RegisterEnum("initia.mstaking.v1.Infraction", Infraction_name, Infraction_value)
+	proto.RegisterType((*CommissionRates)(nil), "initia.mstaking.v1.CommissionRates")
+	proto.RegisterType((*Commission)(nil), "initia.mstaking.v1.Commission")
+	proto.RegisterType((*Description)(nil), "initia.mstaking.v1.Description")
+	proto.RegisterType((*Validator)(nil), "initia.mstaking.v1.Validator")
+	proto.RegisterType((*ValAddresses)(nil), "initia.mstaking.v1.ValAddresses")
+	proto.RegisterType((*DVPair)(nil), "initia.mstaking.v1.DVPair")
+	proto.RegisterType((*DVPairs)(nil), "initia.mstaking.v1.DVPairs")
+	proto.RegisterType((*DVVTriplet)(nil), "initia.mstaking.v1.DVVTriplet")
+	proto.RegisterType((*DVVTriplets)(nil), "initia.mstaking.v1.DVVTriplets")
+	proto.RegisterType((*Delegation)(nil), "initia.mstaking.v1.Delegation")
+	proto.RegisterType((*UnbondingDelegation)(nil), "initia.mstaking.v1.UnbondingDelegation")
+	proto.RegisterType((*UnbondingDelegationEntry)(nil), "initia.mstaking.v1.UnbondingDelegationEntry")
+	proto.RegisterType((*RedelegationEntry)(nil), "initia.mstaking.v1.RedelegationEntry")
+	proto.RegisterType((*Redelegation)(nil), "initia.mstaking.v1.Redelegation")
+	proto.RegisterType((*Params)(nil), "initia.mstaking.v1.Params")
+	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "initia.mstaking.v1.ConsPubKeyRotationHistory")
+	proto.RegisterType((*PendingPowerUpdate)(nil), "initia.mstaking.v1.PendingPowerUpdate")
+	proto.RegisterType((*ValidatorPower)(nil), "initia.mstaking.v1.ValidatorPower")
+	proto.RegisterType((*PowerSnapshot)(nil), "initia.mstaking.v1.PowerSnapshot")
+	proto.RegisterType((*UnbondingQueueEntry)(nil), "initia.mstaking.v1.UnbondingQueueEntry")
+	proto.RegisterType((*RedelegationQueueEntry)(nil), "initia.mstaking.v1.RedelegationQueueEntry")
+	proto.RegisterType((*UnbondingMaturity)(nil), "initia.mstaking.v1.UnbondingMaturity")
+	proto.RegisterType((*DelegationResponse)(nil), "initia.mstaking.v1.DelegationResponse")
+	proto.RegisterType((*RedelegationEntryResponse)(nil), "initia.mstaking.v1.RedelegationEntryResponse")
+	proto.RegisterType((*RedelegationResponse)(nil), "initia.mstaking.v1.RedelegationResponse")
+	proto.RegisterType((*Pool)(nil), "initia.mstaking.v1.Pool")
+	proto.RegisterType((*SlashEvent)(nil), "initia.mstaking.v1.SlashEvent")
+	proto.RegisterType((*DelegatorSlashImpact)(nil), "initia.mstaking.v1.DelegatorSlashImpact")
+}
+
+func init() { proto.RegisterFile("initia/mstaking/v1/staking.proto", fileDescriptor_869fea6a46e7b076) }
+
+var fileDescriptor_869fea6a46e7b076 = []byte{
+	// 2746 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3a, 0x4b, 0x6c, 0x1b, 0xd7,
+	0xb5, 0x1a, 0x92, 0xa2, 0xc8, 0xc3, 0x9f, 0x74, 0x2d, 0xcb, 0x94, 0xec, 0x90, 0xcc, 0xe4, 0xbd,
+	0x07, 0x21, 0x2f, 0xa6, 0x9e, 0x9d, 0x07, 0xa7, 0x10, 0x5a, 0x34, 0xa2, 0x28, 0x47, 0x4c, 0x1c,
+	0x59, 0x19, 0xc9, 0x76, 0x9b, 0x22, 0x18, 0x0c, 0x67, 0xae, 0xa8, 0xa9, 0xc8, 0x19, 0x76, 0xe6,
+	0x52, 0x36, 0xbb, 0xea, 0x26, 0xa8, 0xe1, 0x45, 0x90, 0xae, 0x9a, 0x2e, 0x0c, 0x04, 0xed, 0xa2,
+	0x45, 0x11, 0x74, 0x51, 0x14, 0xfd, 0x2c, 0xba, 0x2c, 0x90, 0xb6, 0x28, 0x10, 0x74, 0x55, 0x04,
+	0x05, 0xd3, 0x26, 0x45, 0x51, 0x74, 0x55, 0x08, 0xe8, 0xae, 0x8b, 0xe2, 0x7e, 0xe6, 0xc3, 0x21,
+	0xf5, 0x33, 0xe4, 0xc0, 0x45, 0xbb, 0xd2, 0xdc, 0x7b, 0xcf, 0xff, 0x9c, 0x7b, 0xce, 0xb9, 0x47,
+	0x84, 0x8a, 0x69, 0x99, 0xc4, 0xd4, 0x96, 0x3a, 0x2e, 0xd1, 0xf6, 0x4c, 0xab, 0xb5, 0xb4, 0x7f,
+	0x65, 0x49, 0x7c, 0x56, 0xbb, 0x8e, 0x4d, 0x6c, 0x84, 0x38, 0x44, 0xd5, 0x83, 0xa8, 0xee, 0x5f,
+	0x59, 0x28, 0xe9, 0xb6, 0xdb, 0xb1, 0xdd, 0xa5, 0xa6, 0xe6, 0xe2, 0xa5, 0xfd, 0x2b, 0x4d, 0x4c,
+	0xb4, 0x2b, 0x4b, 0xba, 0x6d, 0x5a, 0x1c, 0x67, 0x61, 0x9e, 0x9f, 0xab, 0x6c, 0xb5, 0xc4, 0x17,
+	0xe2, 0x68, 0xb6, 0x65, 0xb7, 0x6c, 0xbe, 0x4f, 0xbf, 0x3c, 0x84, 0x96, 0x6d, 0xb7, 0xda, 0x78,
+	0x89, 0xad, 0x9a, 0xbd, 0x9d, 0x25, 0xcd, 0xea, 0x8b, 0xa3, 0x52, 0xf4, 0xc8, 0xe8, 0x39, 0x1a,
+	0x31, 0x6d, 0x8f, 0x57, 0x39, 0x7a, 0x4e, 0xcc, 0x0e, 0x76, 0x89, 0xd6, 0xe9, 0x72, 0x00, 0xf9,
+	0xad, 0x18, 0x14, 0x56, 0xed, 0x4e, 0xc7, 0x74, 0x5d, 0xd3, 0xb6, 0x14, 0x8d, 0x60, 0x17, 0xbd,
+	0x00, 0x09, 0x47, 0x23, 0xb8, 0x28, 0x55, 0xa4, 0xc5, 0x74, 0xed, 0x99, 0xf7, 0x07, 0xe5, 0x89,
+	0x0f, 0x07, 0xe5, 0x8b, 0x5c, 0x52, 0xd7, 0xd8, 0xab, 0x9a, 0xf6, 0x52, 0x47, 0x23, 0xbb, 0xd5,
+	0x1b, 0xb8, 0xa5, 0xe9, 0xfd, 0x3a, 0xd6, 0x15, 0x86, 0x80, 0x5e, 0x83, 0x54, 0x47, 0xbb, 0xa7,
+	0x32, 0xe4, 0x18, 0x43, 0xbe, 0x76, 0x02, 0xe4, 0x83, 0x41, 0xb9, 0xd0, 0xd7, 0x3a, 0xed, 0x65,
+	0xd9, 0x43, 0x96, 0x95, 0xa9, 0x8e, 0x76, 0x8f, 0x0a, 0x83, 0x30, 0x14, 0xe8, 0xae, 0xbe, 0xab,
+	0x59, 0x2d, 0xcc, 0x29, 0xc7, 0x19, 0xe5, 0xcf, 0x9d, 0x8c, 0xf2, 0x5c, 0x40, 0x39, 0x44, 0x43,
+	0x56, 0x72, 0x1d, 0xed, 0xde, 0x2a, 0xdb, 0xa0, 0x6c, 0x96, 0x53, 0xef, 0xbc, 0x5b, 0x9e, 0xf8,
+	0xcb, 0xbb, 0x65, 0x49, 0xfe, 0x8d, 0x04, 0x10, 0x18, 0x04, 0x7d, 0x01, 0xa6, 0x75, 0x7f, 0xc5,
+	0x70, 0x5d, 0x66, 0x97, 0xcc, 0xd5, 0x67, 0xaa, 0xa3, 0xbe, 0xaf, 0x46, 0x4c, 0x59, 0x4b, 0x51,
+	0x29, 0x3f, 0x18, 0x94, 0x25, 0xa5, 0xa0, 0x47, 0xac, 0xfc, 0x25, 0xc8, 0xf4, 0xba, 0x86, 0x46,
+	0xb0, 0x4a, 0x7d, 0xc2, 0xec, 0x95, 0xb9, 0xba, 0x50, 0xe5, 0x0e, 0xab, 0x7a, 0x0e, 0xab, 0x6e,
+	0x7b, 0x0e, 0xab, 0x95, 0x28, 0xad, 0x83, 0x41, 0x19, 0x71, 0x95, 0x42, 0xc8, 0xf2, 0xdb, 0x1f,
+	0x95, 0x25, 0x05, 0xf8, 0x0e, 0x45, 0x08, 0xe9, 0xf3, 0x4b, 0x09, 0x32, 0x75, 0xec, 0xea, 0x8e,
+	0xd9, 0xa5, 0x71, 0x81, 0x8a, 0x30, 0xd5, 0xb1, 0x2d, 0x73, 0x0f, 0x3b, 0xdc, 0xbf, 0x8a, 0xb7,
+	0x44, 0x0b, 0x90, 0x32, 0x0d, 0x6c, 0x11, 0x93, 0xf4, 0xb9, 0xf7, 0x14, 0x7f, 0x4d, 0xb1, 0xee,
+	0xe2, 0xa6, 0x6b, 0x7a, 0xe6, 0x57, 0xbc, 0x25, 0xba, 0x0e, 0xd3, 0x2e, 0xd6, 0x7b, 0x8e, 0x49,
+	0xfa, 0xaa, 0x6e, 0x5b, 0x44, 0xd3, 0x49, 0x31, 0xc1, 0x3c, 0x74, 0xf1, 0x60, 0x50, 0xbe, 0xc0,
+	0x65, 0x8d, 0x42, 0xc8, 0x4a, 0xc1, 0xdb, 0x5a, 0xe5, 0x3b, 0x94, 0x83, 0x81, 0x89, 0x66, 0xb6,
+	0xdd, 0xe2, 0x24, 0xe7, 0x20, 0x96, 0x21, 0x5d, 0x7e, 0x96, 0x86, 0xf4, 0x6d, 0xad, 0x6d, 0x1a,
+	0x1a, 0xb1, 0x1d, 0xca, 0xd9, 0xee, 0x62, 0x87, 0x7e, 0xab, 0x9a, 0x61, 0x38, 0xd8, 0x75, 0x8b,
+	0x52, 0x94, 0x73, 0x14, 0x42, 0x56, 0x0a, 0xde, 0xd6, 0x0a, 0xdf, 0x41, 0x84, 0xba, 0xd8, 0x72,
+	0xb1, 0xe5, 0xf6, 0x5c, 0xb5, 0xdb, 0x6b, 0xee, 0xe1, 0xbe, 0xf0, 0xc6, 0xec, 0x88, 0x37, 0x56,
+	0xac, 0x7e, 0xed, 0xf9, 0x80, 0x7a, 0x14, 0x4f, 0xfe, 0xd5, 0x8f, 0x2e, 0xcf, 0x8a, 0x1b, 0xad,
+	0x3b, 0xfd, 0x2e, 0xb1, 0xab, 0x9b, 0xbd, 0xe6, 0x2b, 0xb8, 0xaf, 0x14, 0x7c, 0xd0, 0x4d, 0x06,
+	0x89, 0xe6, 0x20, 0xf9, 0x65, 0xcd, 0x6c, 0x63, 0x83, 0x19, 0x34, 0xa5, 0x88, 0x15, 0xba, 0x06,
+	0x49, 0x97, 0x68, 0xa4, 0xe7, 0x32, 0x2b, 0xe6, 0xaf, 0x96, 0xc6, 0x85, 0x59, 0xcd, 0xb6, 0x8c,
+	0x2d, 0x06, 0xa5, 0x08, 0x68, 0x44, 0x20, 0x49, 0xec, 0x3d, 0x6c, 0x51, 0xf3, 0xc5, 0x17, 0x33,
+	0x57, 0xe7, 0xab, 0x42, 0x0e, 0x9a, 0x86, 0xaa, 0x22, 0x0d, 0x55, 0x57, 0x6d, 0xd3, 0xaa, 0xad,
+	0x88, 0x40, 0xca, 0x71, 0x25, 0x38, 0x9a, 0xfc, 0xfd, 0x8f, 0xca, 0x8b, 0x2d, 0x93, 0xec, 0xf6,
+	0x9a, 0x55, 0xdd, 0xee, 0x88, 0xbc, 0x24, 0xfe, 0x5c, 0x76, 0x8d, 0xbd, 0x25, 0xd2, 0xef, 0x62,
+	0x97, 0x51, 0x70, 0x15, 0xc1, 0x0b, 0x7d, 0x53, 0x82, 0x69, 0x03, 0xb7, 0x71, 0x8b, 0xd9, 0xd8,
+	0xdd, 0xd5, 0x1c, 0xec, 0x16, 0x93, 0x4c, 0x80, 0x4b, 0x63, 0x05, 0xa8, 0x63, 0x9d, 0xc9, 0xb0,
+	0x21, 0x64, 0x10, 0x86, 0x8c, 0xd2, 0xa0, 0xd2, 0xfc, 0xef, 0x09, 0xa4, 0x11, 0xe4, 0x5c, 0xa5,
+	0xe0, 0x53, 0xd8, 0x62, 0x04, 0xd0, 0x4b, 0x90, 0x31, 0x82, 0xb0, 0x2f, 0x4e, 0x31, 0x87, 0x96,
+	0xc7, 0x19, 0x33, 0x74, 0x3b, 0x6a, 0x09, 0x2a, 0x96, 0x12, 0xc6, 0xa4, 0x61, 0xd6, 0xb3, 0x9a,
+	0xb6, 0x65, 0x98, 0x56, 0x4b, 0xdd, 0xc5, 0x66, 0x6b, 0x97, 0x14, 0x53, 0x15, 0x69, 0x31, 0x1e,
+	0x0e, 0xb3, 0x28, 0x84, 0xac, 0x14, 0xfc, 0xad, 0x75, 0xb6, 0x83, 0x0c, 0xc8, 0x07, 0x50, 0xec,
+	0xca, 0xa7, 0x8f, 0xbd, 0xf2, 0x4f, 0x0b, 0x2b, 0x9d, 0x8f, 0x72, 0x09, 0x6e, 0x7d, 0xce, 0xdf,
+	0xa4, 0x68, 0xa8, 0x0e, 0x10, 0x24, 0x9a, 0x22, 0x30, 0x0e, 0xa5, 0xa3, 0x33, 0x95, 0x50, 0x3a,
+	0x84, 0x87, 0xee, 0x4b, 0x90, 0xdb, 0xb7, 0x09, 0xe5, 0xd4, 0xb5, 0xef, 0x62, 0xc7, 0x2d, 0x66,
+	0x8e, 0x0b, 0xaa, 0x75, 0x21, 0xea, 0x2c, 0x17, 0x75, 0x08, 0xfb, 0x74, 0xb1, 0x95, 0xe5, 0xb8,
+	0x9b, 0x0c, 0x15, 0xdd, 0x81, 0x6c, 0x98, 0x56, 0x31, 0xcb, 0x6e, 0xf8, 0xff, 0x8b, 0xec, 0x7f,
+	0x7e, 0x34, 0xfb, 0x37, 0x2c, 0x72, 0x30, 0x28, 0x9f, 0x1b, 0x15, 0x43, 0x56, 0x32, 0x21, 0xca,
+	0xe8, 0xb3, 0x70, 0x31, 0xb0, 0xa7, 0x6d, 0xa9, 0xbb, 0x76, 0xdb, 0x50, 0x1d, 0xbc, 0xa3, 0xea,
+	0x76, 0xcf, 0x22, 0xc5, 0x1c, 0x75, 0xb1, 0x72, 0xc1, 0x07, 0xb9, 0x69, 0xad, 0xdb, 0x6d, 0x43,
+	0xc1, 0x3b, 0xab, 0xf4, 0x18, 0x3d, 0x0d, 0xd9, 0x00, 0xdb, 0x34, 0x8a, 0xf9, 0x8a, 0xb4, 0x98,
+	0x50, 0x32, 0xfe, 0x5e, 0xc3, 0x58, 0xce, 0xde, 0x7f, 0xb7, 0x3c, 0x21, 0x72, 0xd7, 0x84, 0x7c,
+	0x0d, 0xb2, 0xb7, 0xb5, 0xb6, 0xc8, 0x39, 0xd8, 0x45, 0x97, 0x20, 0xad, 0x79, 0x8b, 0xa2, 0x54,
+	0x89, 0x2f, 0xa6, 0x95, 0x60, 0x83, 0xe7, 0xbc, 0xaf, 0xfd, 0xbe, 0x22, 0xc9, 0xef, 0x49, 0x90,
+	0xac, 0xdf, 0xde, 0xd4, 0x4c, 0x07, 0x35, 0x60, 0x26, 0xb8, 0x27, 0xc3, 0x19, 0xef, 0xd2, 0xc1,
+	0xa0, 0x5c, 0x8c, 0x5e, 0x25, 0x3f, 0xe5, 0x05, 0x57, 0xd4, 0xcb, 0x79, 0x0d, 0x98, 0xd9, 0xf7,
+	0x12, 0xa9, 0x4f, 0x2a, 0x16, 0x25, 0x35, 0x02, 0x22, 0x2b, 0xd3, 0xfe, 0x9e, 0x20, 0x15, 0x51,
+	0x73, 0x05, 0xa6, 0xb8, 0xb4, 0x2e, 0xba, 0x06, 0x93, 0x5d, 0xfa, 0xc1, 0xb4, 0xa3, 0x71, 0x3e,
+	0xee, 0xee, 0x31, 0x58, 0x11, 0x81, 0x1c, 0x5c, 0xfe, 0x46, 0x0c, 0xa0, 0x7e, 0xfb, 0xf6, 0xb6,
+	0x63, 0x76, 0xdb, 0x98, 0x9c, 0xa5, 0xd6, 0xdb, 0x70, 0x3e, 0x50, 0xc9, 0x75, 0xf4, 0x88, 0xe6,
+	0x95, 0x83, 0x41, 0xf9, 0x52, 0x54, 0xf3, 0x10, 0x98, 0xac, 0x9c, 0xf3, 0xf7, 0xb7, 0x1c, 0x7d,
+	0x2c, 0x55, 0xc3, 0x25, 0x3e, 0xd5, 0xf8, 0xe1, 0x54, 0x43, 0x60, 0x61, 0xaa, 0x75, 0x97, 0x8c,
+	0x37, 0xeb, 0x4d, 0xc8, 0x04, 0x26, 0x71, 0xd1, 0x8b, 0x90, 0x22, 0xe2, 0x5b, 0x58, 0xb7, 0x34,
+	0xde, 0xba, 0x1e, 0x8a, 0xb0, 0xb0, 0x8f, 0x25, 0xbf, 0x47, 0x8d, 0xcc, 0xed, 0x43, 0x2f, 0xfc,
+	0x13, 0x19, 0x5a, 0xe8, 0xab, 0x90, 0x14, 0x25, 0x25, 0x7e, 0x82, 0x92, 0x52, 0x1f, 0x2e, 0x6b,
+	0x8f, 0x58, 0x48, 0x04, 0xc7, 0x88, 0xfd, 0xbf, 0x1e, 0x83, 0x73, 0xb7, 0xbc, 0xbb, 0xfd, 0xc4,
+	0xdb, 0xed, 0x06, 0x4c, 0x61, 0x8b, 0x38, 0xa6, 0x6f, 0xb8, 0xe7, 0xc6, 0x45, 0xc7, 0x18, 0x7d,
+	0xd6, 0x2c, 0xe2, 0xf4, 0x45, 0xac, 0x78, 0x24, 0x22, 0x96, 0xf8, 0x79, 0x02, 0x8a, 0x87, 0x61,
+	0xa2, 0x55, 0x28, 0xe8, 0x0e, 0x66, 0x1b, 0x5e, 0xa9, 0x94, 0x58, 0xa9, 0x5c, 0x08, 0x5a, 0xf1,
+	0x08, 0x80, 0xac, 0xe4, 0xbd, 0x1d, 0x51, 0x28, 0x5b, 0x40, 0x7b, 0x65, 0x1a, 0xa6, 0x14, 0xea,
+	0x84, 0xcd, 0xb1, 0x2c, 0x9c, 0xef, 0x31, 0x19, 0x26, 0xc0, 0x4b, 0x65, 0x3e, 0xd8, 0x65, 0xb5,
+	0xf2, 0x2d, 0x09, 0x0a, 0xdc, 0x2e, 0x6d, 0xb5, 0xa9, 0xb5, 0x35, 0x4b, 0xc7, 0xc5, 0xf8, 0x71,
+	0x75, 0xee, 0xe5, 0x61, 0x46, 0x11, 0xfc, 0xd3, 0x55, 0xba, 0xbc, 0xc0, 0xae, 0x71, 0x64, 0x74,
+	0x17, 0xa6, 0x3c, 0x39, 0x12, 0xc7, 0xc9, 0x51, 0x13, 0x72, 0xe4, 0xb9, 0x1c, 0x8f, 0xc4, 0xdf,
+	0xe3, 0x36, 0x52, 0xcd, 0x26, 0x47, 0xaa, 0xd9, 0x71, 0xe5, 0x32, 0x79, 0x64, 0xb9, 0x0c, 0xf5,
+	0xf0, 0xbf, 0x48, 0xc0, 0x8c, 0x82, 0x8d, 0xff, 0x04, 0xce, 0x59, 0x06, 0xce, 0x9b, 0x12, 0x00,
+	0xcf, 0x5b, 0xb4, 0xb2, 0x14, 0x13, 0x27, 0xc8, 0x96, 0x5e, 0xbf, 0x36, 0x13, 0xce, 0x96, 0x14,
+	0xfb, 0xd4, 0x19, 0x33, 0xcd, 0x71, 0xeb, 0x2e, 0xf9, 0x34, 0xe3, 0xe8, 0xc3, 0x18, 0x64, 0xc3,
+	0x71, 0xf4, 0x6f, 0xda, 0x27, 0xa0, 0xb5, 0x20, 0xd7, 0x73, 0xb7, 0xff, 0xf7, 0xb8, 0x5c, 0x3f,
+	0x72, 0xe3, 0x8e, 0x4e, 0xf2, 0xdf, 0x4d, 0x41, 0x72, 0x53, 0x73, 0xb4, 0x8e, 0x8b, 0xf4, 0x91,
+	0x67, 0x0b, 0x1f, 0x7f, 0xcc, 0x8f, 0xdc, 0xa9, 0xba, 0x18, 0x3d, 0x1d, 0xf3, 0x6a, 0x79, 0x67,
+	0xcc, 0xab, 0xe5, 0x45, 0xc8, 0xd3, 0x09, 0x8d, 0xaf, 0x1f, 0xb7, 0x74, 0xae, 0x36, 0x1f, 0x50,
+	0x19, 0x3e, 0xe7, 0x03, 0x1c, 0x7f, 0x16, 0x40, 0x67, 0x56, 0x19, 0x0a, 0x11, 0x94, 0x3d, 0x8a,
+	0x3e, 0x17, 0x4c, 0x4b, 0x42, 0x87, 0xb2, 0x02, 0x1d, 0xed, 0xde, 0x1a, 0x5f, 0xa0, 0x1b, 0x80,
+	0x76, 0x4d, 0x97, 0xd8, 0x8e, 0xa9, 0x6b, 0x6d, 0x35, 0x30, 0x25, 0xc5, 0x7f, 0xea, 0x60, 0x50,
+	0x9e, 0xe7, 0xf8, 0xa3, 0x30, 0xb2, 0x32, 0x13, 0x6c, 0x7a, 0xd4, 0x5e, 0x80, 0x0c, 0xd5, 0x4b,
+	0x35, 0xb0, 0x65, 0x77, 0xf8, 0x53, 0x3c, 0x1d, 0x16, 0x23, 0x74, 0x28, 0x2b, 0x40, 0x57, 0x75,
+	0xb6, 0x40, 0x6b, 0x30, 0xdd, 0x31, 0x2d, 0x75, 0xe8, 0xa9, 0x43, 0xef, 0x42, 0x22, 0xfc, 0xca,
+	0x8c, 0x42, 0xc8, 0x4a, 0xbe, 0x63, 0x5a, 0xb7, 0x43, 0x8f, 0x9a, 0xaf, 0xc0, 0x39, 0x0a, 0x14,
+	0x19, 0x59, 0xb1, 0xd7, 0x6f, 0xba, 0xb6, 0x72, 0xb2, 0x91, 0xd9, 0x42, 0xc0, 0x2c, 0x42, 0x47,
+	0x56, 0x66, 0x3a, 0xa6, 0x35, 0x3c, 0xe3, 0x42, 0xaf, 0xc3, 0x05, 0x26, 0x8c, 0xea, 0x5a, 0x5a,
+	0xd7, 0xdd, 0xb5, 0x89, 0x6a, 0x5a, 0x04, 0x3b, 0xfb, 0x5a, 0x9b, 0x3d, 0x93, 0x73, 0x35, 0xf9,
+	0x60, 0x50, 0x2e, 0x71, 0x9a, 0x87, 0x00, 0xca, 0xca, 0x79, 0x76, 0xb2, 0x25, 0x0e, 0x1a, 0x62,
+	0x1f, 0xdd, 0x81, 0xb9, 0x08, 0x8a, 0xe7, 0xa0, 0x34, 0x23, 0xfd, 0xf4, 0xc1, 0xa0, 0xfc, 0xd4,
+	0x58, 0xd2, 0xbe, 0x93, 0x66, 0x87, 0x28, 0x7b, 0x7e, 0xda, 0x87, 0xf3, 0x34, 0x22, 0x38, 0x52,
+	0x78, 0xb8, 0x08, 0xcc, 0x52, 0xab, 0x27, 0xb3, 0xd4, 0xa5, 0x20, 0xb6, 0x46, 0x28, 0xc9, 0x0a,
+	0xea, 0x68, 0xf7, 0x98, 0x57, 0x82, 0x39, 0x23, 0x32, 0x60, 0x7a, 0x0f, 0xf7, 0x55, 0xc7, 0x26,
+	0xbc, 0x94, 0xed, 0x60, 0x5c, 0xcc, 0x88, 0xfb, 0x74, 0x68, 0xe5, 0x28, 0x0f, 0xcf, 0x4a, 0xa2,
+	0x04, 0x64, 0x25, 0xbf, 0x87, 0xfb, 0x8a, 0xd8, 0xb9, 0x8e, 0x31, 0x52, 0x61, 0x9e, 0x0d, 0x3c,
+	0x6d, 0xcb, 0x1b, 0x4c, 0xf9, 0x08, 0x2e, 0x7b, 0x40, 0xe7, 0x6a, 0xff, 0x75, 0x30, 0x28, 0x57,
+	0x02, 0xf1, 0xc7, 0x82, 0xca, 0xca, 0x1c, 0x9d, 0x92, 0xda, 0x96, 0x98, 0x59, 0x79, 0x2c, 0xc2,
+	0x23, 0xb9, 0x37, 0x13, 0x30, 0x2f, 0x20, 0x5e, 0x09, 0x20, 0xd6, 0xd9, 0xc5, 0xe8, 0x9f, 0xd9,
+	0x88, 0xae, 0x0b, 0x05, 0x5a, 0x26, 0x42, 0x52, 0x1e, 0x39, 0xa1, 0xbb, 0x1a, 0x94, 0xd9, 0x08,
+	0xda, 0xe1, 0x03, 0xba, 0x9c, 0xdd, 0x36, 0x02, 0x55, 0x29, 0x47, 0x0b, 0xdf, 0x1d, 0xe2, 0x18,
+	0x3f, 0x19, 0xc7, 0x08, 0xda, 0x11, 0x1c, 0x2d, 0x7c, 0x37, 0xc4, 0x71, 0x0e, 0x92, 0xa2, 0xf3,
+	0x49, 0xb0, 0x1a, 0x98, 0xdc, 0x3d, 0xb4, 0xab, 0x99, 0x7c, 0x2c, 0x5d, 0xcd, 0x15, 0x88, 0xd3,
+	0x70, 0x4c, 0x1e, 0x17, 0x8e, 0xbc, 0x72, 0x50, 0xd8, 0xe5, 0xd4, 0x7d, 0xaf, 0x62, 0xfc, 0x40,
+	0x02, 0xb4, 0x89, 0x59, 0x46, 0x67, 0x31, 0x7f, 0x8b, 0xcd, 0xa2, 0xcf, 0x2c, 0x00, 0x66, 0x61,
+	0x92, 0xe7, 0xc4, 0x18, 0xb3, 0x0d, 0x5f, 0xa0, 0x65, 0xc8, 0x12, 0xcd, 0x69, 0x61, 0x22, 0x12,
+	0x26, 0xf5, 0x50, 0xbc, 0x76, 0x21, 0x18, 0xff, 0x84, 0x4f, 0x65, 0x25, 0xc3, 0x97, 0x4c, 0x3e,
+	0xf9, 0x87, 0x71, 0xc8, 0xfb, 0xf5, 0x83, 0x6d, 0x9d, 0xe1, 0x40, 0xd9, 0x1b, 0xc5, 0xc6, 0x3e,
+	0xc5, 0x51, 0xec, 0xe8, 0xcc, 0x2e, 0xfe, 0xa4, 0xcc, 0xec, 0x12, 0x67, 0x34, 0xb3, 0x93, 0x7f,
+	0x1a, 0x83, 0xdc, 0x66, 0x38, 0x9f, 0xa3, 0xcf, 0x40, 0x22, 0xd4, 0x94, 0x1c, 0x75, 0x25, 0xd8,
+	0xbf, 0x62, 0x58, 0xe0, 0x33, 0x0c, 0xf4, 0x6d, 0x09, 0x66, 0xc3, 0xac, 0xd4, 0xbb, 0xec, 0xbe,
+	0x79, 0x4e, 0x3b, 0xba, 0x7b, 0x56, 0x84, 0xe5, 0x2e, 0x8e, 0x8a, 0xec, 0xd1, 0x39, 0x75, 0x1f,
+	0x8d, 0x42, 0x9a, 0xde, 0xe1, 0x34, 0xd0, 0x3a, 0x40, 0xa8, 0x29, 0xe2, 0x0e, 0x95, 0xc7, 0x35,
+	0x78, 0xc3, 0xa1, 0xec, 0x8d, 0x74, 0x03, 0x5c, 0xf9, 0xef, 0x52, 0x68, 0x82, 0xf1, 0x5a, 0x0f,
+	0xf7, 0x30, 0x7f, 0x79, 0x3d, 0x99, 0x13, 0x8c, 0x75, 0x98, 0xa4, 0x15, 0xdc, 0x4b, 0xba, 0x8f,
+	0x32, 0xbf, 0xe0, 0x04, 0xe4, 0xdf, 0xc6, 0x60, 0x2e, 0xdc, 0xfd, 0x3e, 0x1e, 0xd5, 0xff, 0x95,
+	0x5e, 0x0c, 0x2b, 0x9e, 0x6d, 0x13, 0xcc, 0xb6, 0xa7, 0x7a, 0x2f, 0x08, 0xa3, 0xfe, 0x51, 0x82,
+	0x19, 0xdf, 0xfc, 0xaf, 0x6a, 0x84, 0xfd, 0x27, 0x6f, 0x5c, 0xa5, 0x92, 0x1e, 0x4b, 0xa5, 0xd2,
+	0x21, 0xa9, 0x75, 0xd8, 0x73, 0xf1, 0xd8, 0x04, 0xfb, 0x7f, 0x94, 0xfc, 0xe9, 0xf2, 0x29, 0x27,
+	0x2d, 0xff, 0x5a, 0x02, 0x14, 0x44, 0x96, 0x82, 0xdd, 0xae, 0x6d, 0xb9, 0xec, 0x1f, 0x2c, 0x81,
+	0x69, 0x84, 0x7e, 0xe3, 0x87, 0xaf, 0x3e, 0x94, 0x77, 0x1b, 0x03, 0x3c, 0x84, 0x83, 0x49, 0xcf,
+	0x63, 0x50, 0xc1, 0xa3, 0xed, 0xf7, 0x69, 0x13, 0xf2, 0x9f, 0x24, 0x98, 0x1f, 0x71, 0xaa, 0xaf,
+	0xd4, 0xeb, 0x80, 0x9c, 0xd0, 0xa1, 0xca, 0xe3, 0x43, 0x3a, 0x7d, 0x7c, 0xcc, 0x38, 0xd1, 0x83,
+	0x4f, 0x4b, 0xd5, 0x04, 0x6b, 0x47, 0x7f, 0x22, 0xc1, 0x6c, 0x58, 0x36, 0x5f, 0xc3, 0x97, 0x21,
+	0x1b, 0x16, 0x4d, 0xe8, 0x56, 0x39, 0x4e, 0x37, 0xa1, 0xd6, 0x10, 0x2e, 0x7a, 0x35, 0x78, 0x72,
+	0x73, 0x8d, 0x2e, 0x9f, 0xc8, 0x44, 0x9e, 0x2c, 0xd1, 0xa7, 0x77, 0x82, 0x39, 0xe8, 0x1f, 0x71,
+	0x48, 0x6c, 0xda, 0x76, 0x1b, 0x7d, 0x4f, 0x82, 0x19, 0xcb, 0x26, 0x2a, 0xbd, 0x5d, 0xd8, 0x50,
+	0x45, 0x27, 0x21, 0x1d, 0x67, 0x3a, 0x8d, 0x12, 0xfd, 0xeb, 0xa0, 0x3c, 0x8a, 0x1b, 0xa4, 0xb2,
+	0x91, 0xa3, 0xd3, 0x15, 0xf9, 0x82, 0x65, 0x93, 0x1a, 0x43, 0xdf, 0x66, 0xd8, 0xe8, 0x5b, 0x12,
+	0xe4, 0x86, 0xc5, 0x3c, 0xd6, 0xc3, 0x5f, 0x14, 0x62, 0xe6, 0xa2, 0x22, 0xce, 0x06, 0x0f, 0xe4,
+	0x47, 0x14, 0x2f, 0xdb, 0x0c, 0xcb, 0x76, 0x68, 0x79, 0x8f, 0x3f, 0x39, 0xe5, 0x7d, 0x39, 0x45,
+	0x83, 0xf6, 0x6f, 0x34, 0x70, 0xff, 0x1c, 0x07, 0xd8, 0x6a, 0x6b, 0xee, 0xee, 0xda, 0x3e, 0xb6,
+	0x08, 0xca, 0x43, 0xcc, 0x34, 0x58, 0x90, 0x26, 0x94, 0x98, 0x69, 0x9c, 0x65, 0x69, 0x6d, 0xc0,
+	0x8c, 0x69, 0xed, 0x38, 0x9a, 0x1e, 0x1e, 0xb6, 0xf2, 0xce, 0x39, 0x44, 0x6a, 0x04, 0x44, 0x56,
+	0xa6, 0x83, 0x3d, 0x31, 0x70, 0x3d, 0xec, 0xc9, 0xe2, 0x35, 0x65, 0x93, 0xa7, 0x6e, 0xca, 0xde,
+	0x80, 0xac, 0x4b, 0xad, 0xa0, 0xee, 0x68, 0x3a, 0xb1, 0xf9, 0x08, 0x24, 0x5d, 0x5b, 0x3e, 0xd9,
+	0x73, 0x5c, 0xf4, 0x8f, 0x61, 0x02, 0xb2, 0x92, 0x61, 0xcb, 0xeb, 0x6c, 0x45, 0x0b, 0x47, 0xb3,
+	0xe7, 0x58, 0xd8, 0x28, 0x4e, 0x3d, 0x86, 0xc2, 0xc1, 0x49, 0xd3, 0xff, 0xec, 0x12, 0xa7, 0x67,
+	0xe9, 0x1a, 0xc1, 0x06, 0x1b, 0x81, 0xa4, 0x94, 0x60, 0x43, 0xbe, 0x3f, 0x09, 0xb3, 0x75, 0xff,
+	0xb7, 0x0a, 0x54, 0xb6, 0x46, 0xa7, 0x4b, 0x7f, 0x00, 0xf3, 0x79, 0xc8, 0x73, 0xc9, 0x31, 0x8d,
+	0x00, 0xd5, 0x73, 0x7f, 0x78, 0x06, 0x36, 0x7c, 0x2e, 0x2b, 0x59, 0xd7, 0x8f, 0x98, 0x86, 0x31,
+	0xbe, 0x9d, 0x89, 0x9d, 0x5d, 0x27, 0x17, 0x7f, 0xa4, 0x70, 0x3b, 0xfb, 0x18, 0xd9, 0x1b, 0xaa,
+	0xc0, 0xc9, 0xb3, 0x77, 0x64, 0xb8, 0x50, 0x9b, 0x90, 0xf6, 0x47, 0x95, 0x8f, 0x23, 0x68, 0x02,
+	0xea, 0xc8, 0x8e, 0x94, 0xa8, 0xd4, 0xd9, 0x73, 0x1b, 0x62, 0xf0, 0xec, 0x8f, 0x25, 0x80, 0xe0,
+	0x97, 0x44, 0xe8, 0x39, 0xb8, 0x50, 0xbb, 0xb9, 0x51, 0x57, 0xb7, 0xb6, 0x57, 0xb6, 0x6f, 0x6d,
+	0xa9, 0xb7, 0x36, 0xb6, 0x36, 0xd7, 0x56, 0x1b, 0xd7, 0x1b, 0x6b, 0xf5, 0xe9, 0x89, 0x85, 0xc2,
+	0x83, 0x87, 0x95, 0xcc, 0x2d, 0xcb, 0xed, 0x62, 0xdd, 0xdc, 0x31, 0xb1, 0x81, 0xfe, 0x07, 0x66,
+	0x87, 0xa1, 0xe9, 0x6a, 0xad, 0x3e, 0x2d, 0x2d, 0x64, 0x1f, 0x3c, 0xac, 0xa4, 0x78, 0x77, 0x88,
+	0x0d, 0xb4, 0x08, 0xe7, 0x47, 0xe1, 0x1a, 0x1b, 0x2f, 0x4d, 0xc7, 0x16, 0x72, 0x0f, 0x1e, 0x56,
+	0xd2, 0x7e, 0x1b, 0x89, 0x64, 0x40, 0x61, 0x48, 0x41, 0x2f, 0xbe, 0x00, 0x0f, 0x1e, 0x56, 0x92,
+	0xbc, 0xee, 0x2c, 0x24, 0xee, 0x7f, 0xa7, 0x34, 0xf1, 0xec, 0x1b, 0x00, 0x0d, 0x3f, 0x17, 0xa1,
+	0x05, 0x98, 0x6b, 0x6c, 0x5c, 0x57, 0x56, 0x56, 0xb7, 0x1b, 0x37, 0x37, 0x86, 0xc5, 0x8e, 0x9c,
+	0xd5, 0x6f, 0xde, 0xaa, 0xdd, 0x58, 0x53, 0xb7, 0x1a, 0x2f, 0x6d, 0x4c, 0x4b, 0xe8, 0x02, 0x9c,
+	0x1b, 0x3a, 0xbb, 0xb3, 0xb1, 0xdd, 0x78, 0x75, 0x6d, 0x3a, 0x56, 0x5b, 0x7f, 0xff, 0xe3, 0x92,
+	0xf4, 0xc1, 0xc7, 0x25, 0xe9, 0x0f, 0x1f, 0x97, 0xa4, 0xb7, 0x3f, 0x29, 0x4d, 0x7c, 0xf0, 0x49,
+	0x69, 0xe2, 0x77, 0x9f, 0x94, 0x26, 0x5e, 0xaf, 0x86, 0x2c, 0xcd, 0x4b, 0xfe, 0xe5, 0xb6, 0xd6,
+	0x74, 0xc5, 0xf7, 0xd2, 0xbd, 0xe0, 0x97, 0xa2, 0xcc, 0xea, 0xcd, 0x24, 0x0b, 0xe7, 0xe7, 0xff,
+	0x39, 0x00, 0xf7, 0x58, 0xa5, 0xbd, 0x49, 0x2a, 0x00, 0x00,
+}
+
+func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
+	return StakingDescription()
+}
+func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
+	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
+	var gzipped = []byte{
+		// 8843 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6d, 0x70, 0x5c, 0xd7,
+		0x79, 0x1f, 0xce, 0xbb, 0x6f, 0xd8, 0x7d, 0x16, 0xd8, 0xbd, 0x38, 0x00, 0xc9, 0x25, 0x28, 0x01,
+		0xd0, 0x95, 0x2d, 0x51, 0x94, 0x04, 0x8a, 0x94, 0x44, 0x89, 0x2b, 0x3b, 0xf2, 0x2e, 0x76, 0x09,
+		0x2e, 0x05, 0x2c, 0xe0, 0xbb, 0x00, 0x29, 0x29, 0x7f, 0xcf, 0xcd, 0xc5, 0xee, 0x01, 0xb0, 0xe2,
+		0xee, 0xbd, 0xeb, 0x7b, 0xef, 0x92, 0x84, 0x3e, 0x78, 0x94, 0x38, 0x4e, 0x14, 0x3b, 0xf6, 0xdf,
+		0x6e, 0x32, 0xb5, 0x6c, 0x47, 0x8e, 0xe3, 0x4c, 0x63, 0xd7, 0xb5, 0xd3, 0xc4, 0x76, 0x9d, 0xb8,
+		0x9d, 0xf4, 0x65, 0x3a, 0x69, 0xed, 0xa4, 0xed, 0x38, 0x99, 0x69, 0xc7, 0x93, 0xe9, 0x30, 0x8d,
+		0xed, 0xb1, 0x64, 0xc7, 0x69, 0x12, 0x56, 0xee, 0x64, 0xc6, 0xed, 0xb8, 0x73, 0xde, 0xee, 0xdb,
+		0x2e, 0x70, 0x17, 0x2c, 0xe5, 0x61, 0xa7, 0xfd, 0x42, 0xee, 0x3d, 0xe7, 0xf9, 0xfd, 0xce, 0x73,
+		0x9e, 0xf3, 0x9c, 0x73, 0x9e, 0xf3, 0x72, 0x2f, 0xe0, 0xe6, 0xcf, 0xc0, 0xfc, 0xb6, 0x69, 0x6e,
+		0x77, 0xf0, 0xa9, 0x9e, 0x65, 0x3a, 0xe6, 0x66, 0x7f, 0xeb, 0x54, 0x0b, 0xdb, 0x4d, 0xab, 0xdd,
+		0x73, 0x4c, 0x6b, 0x81, 0xa6, 0xa1, 0x3c, 0x93, 0x58, 0x10, 0x12, 0xca, 0x0a, 0x4c, 0x9e, 0x6f,
+		0x77, 0x70, 0xc5, 0x15, 0x6c, 0x60, 0x07, 0x3d, 0x09, 0x89, 0xad, 0x76, 0x07, 0x17, 0xa4, 0xf9,
+		0xf8, 0x89, 0xec, 0x99, 0xb7, 0x2c, 0x84, 0x40, 0x0b, 0x41, 0xc4, 0x1a, 0x49, 0x56, 0x29, 0x42,
+		0x79, 0x25, 0x09, 0x53, 0x43, 0x72, 0x11, 0x82, 0x84, 0xa1, 0x77, 0x09, 0xa3, 0x74, 0x22, 0xa3,
+		0xd2, 0xdf, 0xa8, 0x00, 0x63, 0x3d, 0xbd, 0x79, 0x45, 0xdf, 0xc6, 0x85, 0x18, 0x4d, 0x16, 0x8f,
+		0x68, 0x16, 0xa0, 0x85, 0x7b, 0xd8, 0x68, 0x61, 0xa3, 0xb9, 0x5b, 0x88, 0xcf, 0xc7, 0x4f, 0x64,
+		0x54, 0x5f, 0x0a, 0x7a, 0x10, 0x26, 0x7b, 0xfd, 0xcd, 0x4e, 0xbb, 0xa9, 0xf9, 0xc4, 0x60, 0x3e,
+		0x7e, 0x22, 0xa9, 0xca, 0x2c, 0xa3, 0xe2, 0x09, 0xdf, 0x0f, 0xf9, 0x6b, 0x58, 0xbf, 0xe2, 0x17,
+		0xcd, 0x52, 0xd1, 0x1c, 0x49, 0xf6, 0x09, 0x2e, 0xc2, 0x78, 0x17, 0xdb, 0xb6, 0xbe, 0x8d, 0x35,
+		0x67, 0xb7, 0x87, 0x0b, 0x09, 0x5a, 0xfb, 0xf9, 0x81, 0xda, 0x87, 0x6b, 0x9e, 0xe5, 0xa8, 0xf5,
+		0xdd, 0x1e, 0x46, 0x25, 0xc8, 0x60, 0xa3, 0xdf, 0x65, 0x0c, 0xc9, 0x3d, 0xec, 0x57, 0x35, 0xfa,
+		0xdd, 0x30, 0x4b, 0x9a, 0xc0, 0x38, 0xc5, 0x98, 0x8d, 0xad, 0xab, 0xed, 0x26, 0x2e, 0xa4, 0x28,
+		0xc1, 0xfd, 0x03, 0x04, 0x0d, 0x96, 0x1f, 0xe6, 0x10, 0x38, 0xb4, 0x08, 0x19, 0x7c, 0xdd, 0xc1,
+		0x86, 0xdd, 0x36, 0x8d, 0xc2, 0x18, 0x25, 0x79, 0xeb, 0x90, 0x56, 0xc4, 0x9d, 0x56, 0x98, 0xc2,
+		0xc3, 0xa1, 0xb3, 0x30, 0x66, 0xf6, 0x9c, 0xb6, 0x69, 0xd8, 0x85, 0xf4, 0xbc, 0x74, 0x22, 0x7b,
+		0xe6, 0xae, 0xa1, 0x8e, 0xb0, 0xca, 0x64, 0x54, 0x21, 0x8c, 0x6a, 0x20, 0xdb, 0x66, 0xdf, 0x6a,
+		0x62, 0xad, 0x69, 0xb6, 0xb0, 0xd6, 0x36, 0xb6, 0xcc, 0x42, 0x86, 0x12, 0xcc, 0x0d, 0x56, 0x84,
+		0x0a, 0x2e, 0x9a, 0x2d, 0x5c, 0x33, 0xb6, 0x4c, 0x35, 0x67, 0x07, 0x9e, 0xd1, 0x11, 0x48, 0xd9,
+		0xbb, 0x86, 0xa3, 0x5f, 0x2f, 0x8c, 0x53, 0x0f, 0xe1, 0x4f, 0xe8, 0x0c, 0x8c, 0xe1, 0x56, 0x9b,
+		0x14, 0x57, 0xc8, 0xcd, 0x4b, 0x27, 0x72, 0x67, 0x0a, 0x83, 0x36, 0x66, 0xf9, 0xaa, 0x10, 0x54,
+		0xbe, 0x9a, 0x82, 0xfc, 0x28, 0x6e, 0xf9, 0x14, 0x24, 0xb7, 0x88, 0x65, 0x0a, 0xb1, 0x83, 0xd8,
+		0x8d, 0x61, 0x82, 0x86, 0x4f, 0xdd, 0xa2, 0xe1, 0x4b, 0x90, 0x35, 0xb0, 0xed, 0xe0, 0x16, 0xf3,
+		0xa2, 0xf8, 0x88, 0x7e, 0x08, 0x0c, 0x34, 0xe8, 0x86, 0x89, 0x5b, 0x72, 0xc3, 0x67, 0x21, 0xef,
+		0xaa, 0xa4, 0x59, 0xba, 0xb1, 0x2d, 0xfc, 0xf9, 0x54, 0x94, 0x26, 0x0b, 0x55, 0x81, 0x53, 0x09,
+		0x4c, 0xcd, 0xe1, 0xc0, 0x33, 0xaa, 0x00, 0x98, 0x06, 0x36, 0xb7, 0xb4, 0x16, 0x6e, 0x76, 0x0a,
+		0xe9, 0x3d, 0xac, 0xb4, 0x4a, 0x44, 0x06, 0xac, 0x64, 0xb2, 0xd4, 0x66, 0x07, 0x9d, 0xf3, 0xdc,
+		0x73, 0x6c, 0x0f, 0xef, 0x5a, 0x61, 0x1d, 0x73, 0xc0, 0x43, 0x37, 0x20, 0x67, 0x61, 0xd2, 0x57,
+		0x70, 0x8b, 0xd7, 0x2c, 0x43, 0x95, 0x58, 0x88, 0xac, 0x99, 0xca, 0x61, 0xac, 0x62, 0x13, 0x96,
+		0xff, 0x11, 0xdd, 0x0b, 0x6e, 0x82, 0x46, 0xdd, 0x0a, 0xe8, 0xc8, 0x35, 0x2e, 0x12, 0xeb, 0x7a,
+		0x17, 0xcf, 0xbc, 0x08, 0xb9, 0xa0, 0x79, 0xd0, 0x34, 0x24, 0x6d, 0x47, 0xb7, 0x1c, 0xea, 0x85,
+		0x49, 0x95, 0x3d, 0x20, 0x19, 0xe2, 0xd8, 0x68, 0xd1, 0x91, 0x31, 0xa9, 0x92, 0x9f, 0xe8, 0x1d,
+		0x5e, 0x85, 0xe3, 0xb4, 0xc2, 0xf7, 0x0d, 0xb6, 0x68, 0x80, 0x39, 0x5c, 0xef, 0x99, 0x27, 0x60,
+		0x22, 0x50, 0x81, 0x51, 0x8b, 0x56, 0xfe, 0x5d, 0x02, 0x0e, 0x0f, 0xe5, 0x46, 0xcf, 0xc2, 0x74,
+		0xdf, 0x68, 0x1b, 0x0e, 0xb6, 0x7a, 0x16, 0x26, 0x2e, 0xcb, 0xca, 0x2a, 0xbc, 0x36, 0xb6, 0x87,
+		0xd3, 0x6d, 0xf8, 0xa5, 0x19, 0x8b, 0x3a, 0xd5, 0x1f, 0x4c, 0x44, 0xcf, 0x41, 0x96, 0xf8, 0x87,
+		0x6e, 0xe9, 0x94, 0x90, 0xf5, 0xc6, 0x33, 0xa3, 0x55, 0x79, 0xa1, 0xe2, 0x21, 0xcb, 0xf1, 0x97,
+		0xa5, 0x98, 0xea, 0xe7, 0x42, 0x4f, 0x40, 0x7a, 0x0b, 0xeb, 0x4e, 0xdf, 0xc2, 0x76, 0xe1, 0x0c,
+		0x35, 0xe5, 0xf1, 0xc1, 0x4e, 0xca, 0x04, 0x1a, 0xd8, 0x51, 0x5d, 0x61, 0xd4, 0x85, 0xf1, 0xab,
+		0xd8, 0x6a, 0x6f, 0xb5, 0x9b, 0x4c, 0xa9, 0x38, 0x1d, 0x7c, 0x9e, 0x1c, 0x51, 0xa9, 0x4b, 0x3e,
+		0x68, 0xc3, 0xd1, 0x1d, 0x5c, 0x84, 0x8d, 0xfa, 0xa5, 0xaa, 0x5a, 0x3b, 0x5f, 0xab, 0x56, 0x98,
+		0x9a, 0x01, 0xfa, 0x99, 0x5f, 0x95, 0x20, 0xeb, 0xab, 0x09, 0x19, 0x0e, 0x8d, 0x7e, 0x77, 0x13,
+		0x5b, 0xbc, 0xbd, 0xf8, 0x13, 0x3a, 0x0e, 0x99, 0xad, 0x7e, 0xa7, 0xc3, 0x9c, 0x8e, 0xcd, 0xa5,
+		0x69, 0x92, 0x40, 0x1c, 0x8e, 0x8c, 0x71, 0x7c, 0x18, 0xa1, 0x63, 0x1c, 0xf9, 0x8d, 0x66, 0x20,
+		0x2d, 0x9c, 0xb2, 0x90, 0x9c, 0x97, 0x4e, 0xa4, 0x55, 0xf7, 0x99, 0xe5, 0xf5, 0xb0, 0xee, 0xe0,
+		0x56, 0x21, 0x25, 0xf2, 0xd8, 0xf3, 0xc5, 0x44, 0x3a, 0x21, 0x27, 0x95, 0xc7, 0x60, 0x72, 0xa0,
+		0x2a, 0x28, 0x0f, 0xd9, 0x4a, 0x75, 0x71, 0xb9, 0xa4, 0x96, 0xd6, 0x6b, 0xab, 0x75, 0xf9, 0x10,
+		0xca, 0x81, 0xaf, 0x76, 0xb2, 0x74, 0x32, 0x93, 0x7e, 0x7d, 0x4c, 0x7e, 0xe9, 0xa5, 0x97, 0x5e,
+		0x8a, 0x29, 0xff, 0x2a, 0x05, 0xd3, 0xc3, 0x06, 0xc1, 0xa1, 0xe3, 0xb1, 0x57, 0xe9, 0x78, 0xa0,
+		0xd2, 0x25, 0x48, 0x76, 0xf4, 0x4d, 0xdc, 0x29, 0x24, 0x68, 0x23, 0x3c, 0x38, 0xd2, 0x30, 0xbb,
+		0xb0, 0x4c, 0x20, 0x2a, 0x43, 0xa2, 0x9f, 0xe2, 0xa6, 0x49, 0x52, 0x86, 0x93, 0xa3, 0x31, 0x90,
+		0xc1, 0x91, 0x9b, 0xf1, 0x38, 0x64, 0xc8, 0xff, 0xcc, 0xee, 0x29, 0x66, 0x77, 0x92, 0x40, 0xed,
+		0x3e, 0x03, 0x69, 0x3a, 0xee, 0xb5, 0xb0, 0xdb, 0x26, 0xe2, 0x99, 0x8c, 0x14, 0x2d, 0xbc, 0xa5,
+		0xf7, 0x3b, 0x8e, 0x76, 0x55, 0xef, 0xf4, 0x31, 0x1d, 0xc1, 0x32, 0xea, 0x38, 0x4f, 0xbc, 0x44,
+		0xd2, 0xd0, 0x1c, 0x64, 0xd9, 0x30, 0xd9, 0x36, 0x5a, 0xf8, 0x3a, 0x9d, 0x42, 0x93, 0x2a, 0x1b,
+		0x39, 0x6b, 0x24, 0x85, 0x14, 0xff, 0x82, 0x6d, 0x1a, 0x62, 0xac, 0xa1, 0x45, 0x90, 0x04, 0x5a,
+		0xfc, 0x13, 0xe1, 0xd9, 0xfb, 0xee, 0xe1, 0xd5, 0x1b, 0x18, 0x1c, 0xef, 0x87, 0x3c, 0x95, 0x78,
+		0x94, 0x77, 0x65, 0xbd, 0x53, 0x98, 0xa4, 0x6e, 0x90, 0x63, 0xc9, 0xab, 0x3c, 0x55, 0xf9, 0x4a,
+		0x0c, 0x12, 0x74, 0xa6, 0xc8, 0x43, 0x76, 0xfd, 0xb9, 0xb5, 0xaa, 0x56, 0x59, 0xdd, 0x28, 0x2f,
+		0x57, 0x65, 0x89, 0x34, 0x3d, 0x4d, 0x38, 0xbf, 0xbc, 0x5a, 0x5a, 0x97, 0x63, 0xee, 0x73, 0xad,
+		0xbe, 0x7e, 0xf6, 0x31, 0x39, 0xee, 0x02, 0x36, 0x58, 0x42, 0xc2, 0x2f, 0xf0, 0xe8, 0x19, 0x39,
+		0x89, 0x64, 0x18, 0x67, 0x04, 0xb5, 0x67, 0xab, 0x95, 0xb3, 0x8f, 0xc9, 0xa9, 0x60, 0xca, 0xa3,
+		0x67, 0xe4, 0x31, 0x34, 0x01, 0x19, 0x9a, 0x52, 0x5e, 0x5d, 0x5d, 0x96, 0xd3, 0x2e, 0x67, 0x63,
+		0x5d, 0xad, 0xd5, 0x97, 0xe4, 0x8c, 0xcb, 0xb9, 0xa4, 0xae, 0x6e, 0xac, 0xc9, 0xe0, 0x32, 0xac,
+		0x54, 0x1b, 0x8d, 0xd2, 0x52, 0x55, 0xce, 0xba, 0x12, 0xe5, 0xe7, 0xd6, 0xab, 0x0d, 0x79, 0x3c,
+		0xa0, 0xd6, 0xa3, 0x67, 0xe4, 0x09, 0xb7, 0x88, 0x6a, 0x7d, 0x63, 0x45, 0xce, 0xa1, 0x49, 0x98,
+		0x60, 0x45, 0x08, 0x25, 0xf2, 0xa1, 0xa4, 0xb3, 0x8f, 0xc9, 0xb2, 0xa7, 0x08, 0x63, 0x99, 0x0c,
+		0x24, 0x9c, 0x7d, 0x4c, 0x46, 0xca, 0x22, 0x24, 0xa9, 0x1b, 0x22, 0x04, 0xb9, 0xe5, 0x52, 0xb9,
+		0xba, 0xac, 0xad, 0xae, 0x91, 0x4e, 0x53, 0x5a, 0x96, 0x25, 0x2f, 0x4d, 0xad, 0xae, 0x55, 0x4b,
+		0xeb, 0xd5, 0x8a, 0x1c, 0xf7, 0xa7, 0xbd, 0x73, 0xa3, 0xa6, 0x56, 0x2b, 0x72, 0x4c, 0x69, 0xc2,
+		0xf4, 0xb0, 0x19, 0x72, 0x68, 0x17, 0xf2, 0xf9, 0x42, 0x6c, 0x0f, 0x5f, 0xa0, 0x5c, 0x61, 0x5f,
+		0x50, 0xbe, 0x1d, 0x83, 0xa9, 0x21, 0x51, 0xc2, 0xd0, 0x42, 0x9e, 0x86, 0x24, 0xf3, 0x65, 0x36,
+		0x52, 0x3f, 0x30, 0x34, 0xdc, 0xa0, 0x9e, 0x3d, 0x10, 0x3b, 0x51, 0x9c, 0x3f, 0xde, 0x8c, 0xef,
+		0x11, 0x6f, 0x12, 0x8a, 0x01, 0x87, 0x7d, 0xd7, 0xc0, 0x6c, 0xce, 0x02, 0x9e, 0xb3, 0xa3, 0x04,
+		0x3c, 0x34, 0xed, 0x60, 0xb3, 0x7a, 0x72, 0xc8, 0xac, 0xfe, 0x14, 0x4c, 0x0e, 0x10, 0x8d, 0x3c,
+		0xbb, 0xbe, 0x57, 0x82, 0xc2, 0x5e, 0xc6, 0x89, 0x18, 0x12, 0x63, 0x81, 0x21, 0xf1, 0xa9, 0xb0,
+		0x05, 0xef, 0xd9, 0xbb, 0x11, 0x06, 0xda, 0xfa, 0x33, 0x12, 0x1c, 0x19, 0xbe, 0xae, 0x18, 0xaa,
+		0xc3, 0x4f, 0x41, 0xaa, 0x8b, 0x9d, 0x1d, 0x53, 0xc4, 0xc9, 0xf7, 0x0d, 0x89, 0xbe, 0x48, 0x76,
+		0xb8, 0xb1, 0x39, 0x0a, 0x9d, 0x0b, 0xeb, 0x3a, 0xb7, 0xd7, 0x2a, 0x67, 0x40, 0xd3, 0x5f, 0x8a,
+		0xc1, 0xe1, 0xa1, 0xe4, 0x43, 0x15, 0xbd, 0x1b, 0xa0, 0x6d, 0xf4, 0xfa, 0x0e, 0x8b, 0x85, 0xd9,
+		0x48, 0x9c, 0xa1, 0x29, 0x74, 0xf0, 0x22, 0xa3, 0x6c, 0xdf, 0x71, 0xf3, 0xd9, 0x2c, 0x09, 0x2c,
+		0x89, 0x0a, 0x3c, 0xe9, 0x29, 0x9a, 0xa0, 0x8a, 0xce, 0xee, 0x51, 0xd3, 0x01, 0xc7, 0x7c, 0x04,
+		0xe4, 0x66, 0xa7, 0x8d, 0x0d, 0x47, 0xb3, 0x1d, 0x0b, 0xeb, 0xdd, 0xb6, 0xb1, 0xcd, 0x66, 0xdb,
+		0x62, 0x72, 0x4b, 0xef, 0xd8, 0x58, 0xcd, 0xb3, 0xec, 0x86, 0xc8, 0x25, 0x08, 0xea, 0x40, 0x96,
+		0x0f, 0x91, 0x0a, 0x20, 0x58, 0xb6, 0x8b, 0x50, 0xbe, 0x90, 0x81, 0xac, 0x6f, 0x15, 0x86, 0xee,
+		0x81, 0xf1, 0x17, 0xf4, 0xab, 0xba, 0x26, 0x56, 0xd6, 0xcc, 0x12, 0x59, 0x92, 0xb6, 0xc6, 0x92,
+		0xd0, 0x23, 0x30, 0x4d, 0x45, 0xcc, 0xbe, 0x83, 0x2d, 0xad, 0xd9, 0xd1, 0x6d, 0x9b, 0x1a, 0x2d,
+		0x4d, 0x45, 0x11, 0xc9, 0x5b, 0x25, 0x59, 0x8b, 0x22, 0x07, 0x3d, 0x0e, 0x53, 0x14, 0xd1, 0xed,
+		0x77, 0x9c, 0x76, 0xaf, 0x83, 0x35, 0xb2, 0xd6, 0xb7, 0x0b, 0xe0, 0xd7, 0x6c, 0x92, 0x48, 0xac,
+		0x70, 0x01, 0xa2, 0x91, 0x8d, 0x2a, 0x70, 0x37, 0x85, 0x6d, 0x63, 0x03, 0x5b, 0xba, 0x83, 0x35,
+		0xfc, 0xee, 0xbe, 0xde, 0xb1, 0x35, 0xdd, 0x68, 0x69, 0x3b, 0xba, 0xbd, 0x53, 0x98, 0x26, 0x04,
+		0xe5, 0x58, 0x41, 0x52, 0x8f, 0x11, 0xc1, 0x25, 0x2e, 0x57, 0xa5, 0x62, 0x25, 0xa3, 0x75, 0x41,
+		0xb7, 0x77, 0x50, 0x11, 0x8e, 0x50, 0x16, 0xdb, 0xb1, 0xda, 0xc6, 0xb6, 0xd6, 0xdc, 0xc1, 0xcd,
+		0x2b, 0x5a, 0xdf, 0xd9, 0x7a, 0xb2, 0x70, 0xdc, 0x5f, 0x3e, 0xd5, 0xb0, 0x41, 0x65, 0x16, 0x89,
+		0xc8, 0x86, 0xb3, 0xf5, 0x24, 0x6a, 0xc0, 0x38, 0x69, 0x8c, 0x6e, 0xfb, 0x45, 0xac, 0x6d, 0x99,
+		0x16, 0x9d, 0x43, 0x73, 0x43, 0x86, 0x26, 0x9f, 0x05, 0x17, 0x56, 0x39, 0x60, 0xc5, 0x6c, 0xe1,
+		0x62, 0xb2, 0xb1, 0x56, 0xad, 0x56, 0xd4, 0xac, 0x60, 0x39, 0x6f, 0x5a, 0xc4, 0xa1, 0xb6, 0x4d,
+		0xd7, 0xc0, 0x59, 0xe6, 0x50, 0xdb, 0xa6, 0x30, 0xef, 0xe3, 0x30, 0xd5, 0x6c, 0xb2, 0x3a, 0xb7,
+		0x9b, 0x1a, 0x5f, 0x91, 0xdb, 0x05, 0x39, 0x60, 0xac, 0x66, 0x73, 0x89, 0x09, 0x70, 0x1f, 0xb7,
+		0xd1, 0x39, 0x38, 0xec, 0x19, 0xcb, 0x0f, 0x9c, 0x1c, 0xa8, 0x65, 0x18, 0xfa, 0x38, 0x4c, 0xf5,
+		0x76, 0x07, 0x81, 0x28, 0x50, 0x62, 0x6f, 0x37, 0x0c, 0x7b, 0x2b, 0xdd, 0x65, 0xb1, 0x70, 0x93,
+		0x86, 0x7a, 0x47, 0xfd, 0xd2, 0xbe, 0x0c, 0xb4, 0x00, 0x72, 0xb3, 0xa9, 0x61, 0x43, 0xdf, 0xec,
+		0x60, 0x4d, 0xb7, 0xb0, 0xa1, 0xdb, 0x85, 0x39, 0x2a, 0x9c, 0x70, 0xac, 0x3e, 0x56, 0x73, 0xcd,
+		0x66, 0x95, 0x66, 0x96, 0x68, 0x1e, 0x3a, 0x09, 0x93, 0xe6, 0xe6, 0x0b, 0x4d, 0xe6, 0x58, 0x5a,
+		0xcf, 0xc2, 0x5b, 0xed, 0xeb, 0x85, 0xb7, 0x50, 0x2b, 0xe5, 0x49, 0x06, 0x75, 0xab, 0x35, 0x9a,
+		0x8c, 0x1e, 0x00, 0xb9, 0x69, 0xef, 0xe8, 0x56, 0x8f, 0x8e, 0xac, 0x76, 0x4f, 0x6f, 0xe2, 0xc2,
+		0x5b, 0x99, 0x28, 0x4b, 0xaf, 0x8b, 0x64, 0xe2, 0xd8, 0xf6, 0xb5, 0xf6, 0x96, 0x23, 0x18, 0xef,
+		0x67, 0x8e, 0x4d, 0xd3, 0x38, 0xdb, 0x09, 0x90, 0x7b, 0x3b, 0xbd, 0x60, 0xc1, 0x27, 0xa8, 0x58,
+		0xae, 0xb7, 0xd3, 0xf3, 0x97, 0x7b, 0x2f, 0x4c, 0xf4, 0x76, 0xfc, 0x85, 0x3e, 0xc0, 0xe2, 0xaf,
+		0xde, 0x8e, 0xaf, 0xc4, 0xc7, 0xe0, 0x08, 0x11, 0xea, 0x62, 0x47, 0x6f, 0xe9, 0x8e, 0xee, 0x93,
+		0x7e, 0x88, 0x4a, 0x4f, 0xf7, 0x76, 0x7a, 0x2b, 0x3c, 0x33, 0xa0, 0xa7, 0xd5, 0xdf, 0xdc, 0x75,
+		0xfd, 0xe3, 0x61, 0xa6, 0x27, 0x49, 0x13, 0x1e, 0x72, 0xcb, 0xcb, 0x8f, 0x37, 0x6d, 0xb1, 0xa5,
+		0x14, 0x61, 0xdc, 0xef, 0xf7, 0x28, 0x03, 0xcc, 0xf3, 0x65, 0x89, 0x04, 0x41, 0x8b, 0xab, 0x15,
+		0x12, 0xbe, 0x3c, 0x5f, 0x95, 0x63, 0x24, 0x8c, 0x5a, 0xae, 0xad, 0x57, 0x35, 0x75, 0xa3, 0xbe,
+		0x5e, 0x5b, 0xa9, 0xca, 0x71, 0x5f, 0x60, 0x7f, 0x31, 0x91, 0x3e, 0x29, 0x3f, 0x78, 0x31, 0x91,
+		0xbe, 0x4f, 0xbe, 0x9f, 0x9a, 0x67, 0xc0, 0x29, 0x95, 0x37, 0xe2, 0x90, 0x0b, 0x2e, 0xcb, 0xd1,
+		0xdb, 0xe0, 0xa8, 0xd8, 0x77, 0xb3, 0xb1, 0xa3, 0x5d, 0x6b, 0x5b, 0xb4, 0xb3, 0x76, 0x75, 0x36,
+		0x71, 0xba, 0x4e, 0x39, 0xcd, 0xa5, 0x1a, 0xd8, 0xb9, 0xdc, 0xb6, 0x48, 0x57, 0xec, 0xea, 0x0e,
+		0x5a, 0x86, 0x39, 0xc3, 0xd4, 0x6c, 0x47, 0x37, 0x5a, 0xba, 0xd5, 0xd2, 0xbc, 0x1d, 0x4f, 0x4d,
+		0x6f, 0x36, 0xb1, 0x6d, 0x9b, 0x6c, 0x92, 0x74, 0x59, 0xee, 0x32, 0xcc, 0x06, 0x17, 0xf6, 0x66,
+		0x8f, 0x12, 0x17, 0x0d, 0xf5, 0x89, 0xf8, 0x5e, 0x7d, 0xe2, 0x38, 0x64, 0xba, 0x7a, 0x4f, 0xc3,
+		0x86, 0x63, 0xed, 0xd2, 0xd8, 0x3d, 0xad, 0xa6, 0xbb, 0x7a, 0xaf, 0x4a, 0x9e, 0xd1, 0x25, 0xb8,
+		0xcf, 0x13, 0xd5, 0x3a, 0x78, 0x5b, 0x6f, 0xee, 0x6a, 0x34, 0x50, 0xa7, 0x7b, 0x44, 0x5a, 0xd3,
+		0x34, 0xb6, 0x3a, 0xed, 0xa6, 0x63, 0x17, 0xb2, 0xee, 0xf8, 0xa7, 0x78, 0x88, 0x65, 0x0a, 0xb8,
+		0x68, 0x9b, 0x06, 0x8d, 0xcf, 0x17, 0x85, 0x74, 0xc0, 0x6d, 0xc6, 0xef, 0x08, 0xb7, 0x09, 0x36,
+		0x7d, 0x42, 0x4e, 0x5e, 0x4c, 0xa4, 0x93, 0x72, 0xea, 0x62, 0x22, 0x9d, 0x92, 0xc7, 0x2e, 0x26,
+		0xd2, 0x69, 0x39, 0x73, 0x31, 0x91, 0xce, 0xc8, 0xa0, 0xbc, 0x3a, 0x01, 0xe3, 0xfe, 0xe5, 0x06,
+		0x59, 0xbd, 0x35, 0xe9, 0x84, 0x2b, 0xd1, 0x21, 0xf9, 0xde, 0x7d, 0x17, 0x27, 0x0b, 0x8b, 0x64,
+		0x26, 0x2e, 0xa6, 0x58, 0x6c, 0xaf, 0x32, 0x24, 0x89, 0x82, 0x48, 0x27, 0xc3, 0x2c, 0x96, 0x4a,
+		0xab, 0xfc, 0x09, 0x2d, 0x41, 0xea, 0x05, 0x9b, 0x72, 0xa7, 0x28, 0xf7, 0x5b, 0xf6, 0xe7, 0xbe,
+		0xd8, 0xa0, 0xe4, 0x99, 0x8b, 0x0d, 0xad, 0xbe, 0xaa, 0xae, 0x94, 0x96, 0x55, 0x0e, 0x47, 0xc7,
+		0x20, 0xd1, 0xd1, 0x5f, 0xdc, 0x0d, 0xce, 0xd9, 0x34, 0x09, 0x2d, 0x40, 0xbe, 0x6f, 0xb0, 0xb5,
+		0x3a, 0x69, 0x63, 0x22, 0x95, 0xf7, 0x4b, 0xe5, 0xbc, 0xdc, 0x65, 0x22, 0x3f, 0xa2, 0x5f, 0x1d,
+		0x83, 0x04, 0xd9, 0x94, 0x0e, 0xce, 0xac, 0x34, 0x09, 0x9d, 0x80, 0xf1, 0x16, 0xde, 0xec, 0x6f,
+		0x6b, 0x16, 0x6e, 0xe9, 0x4d, 0x27, 0x38, 0x9f, 0x64, 0x69, 0x96, 0x4a, 0x73, 0xd0, 0x33, 0x90,
+		0x21, 0x6d, 0x64, 0xd0, 0x36, 0x9e, 0xa4, 0x26, 0x78, 0x78, 0x7f, 0x13, 0xf0, 0x26, 0x16, 0x20,
+		0xd5, 0xc3, 0xa3, 0x0b, 0x30, 0xe6, 0xe8, 0xd6, 0x36, 0x76, 0xec, 0xc2, 0xd4, 0x7c, 0xfc, 0x44,
+		0xee, 0xcc, 0xc2, 0x28, 0x54, 0xeb, 0x14, 0x42, 0x57, 0xca, 0x02, 0x8e, 0x2e, 0x83, 0xcc, 0xb7,
+		0x62, 0x35, 0xbe, 0xcc, 0xb5, 0x0b, 0xd3, 0xd4, 0x01, 0x1f, 0xda, 0x9f, 0x92, 0xef, 0xe4, 0x56,
+		0x18, 0x48, 0xcd, 0xe3, 0xc0, 0x73, 0xb0, 0x5f, 0x1c, 0x3e, 0x48, 0xbf, 0xd8, 0x80, 0x3c, 0xff,
+		0xad, 0xd9, 0xfd, 0x5e, 0xcf, 0xb4, 0x9c, 0xc2, 0x91, 0x79, 0x29, 0x5a, 0x21, 0x41, 0xc6, 0x30,
+		0x6a, 0x6e, 0x2b, 0xf0, 0xfc, 0xe6, 0x75, 0xb7, 0x99, 0xe7, 0x21, 0x17, 0x34, 0x86, 0x7f, 0x23,
+		0x3c, 0x3e, 0xe2, 0x46, 0x38, 0x59, 0x96, 0x88, 0x85, 0x1a, 0x99, 0x9a, 0xd8, 0xc3, 0xcc, 0xdf,
+		0x8f, 0x41, 0x2e, 0x58, 0x31, 0xb4, 0x04, 0x48, 0xb4, 0x58, 0xdb, 0x70, 0x2c, 0xb3, 0xd5, 0x6f,
+		0xe2, 0x56, 0x41, 0x8a, 0x28, 0x67, 0x92, 0x63, 0x6a, 0x2e, 0xc4, 0x4f, 0xe4, 0xeb, 0x05, 0xb1,
+		0x11, 0x89, 0x2a, 0x5e, 0xff, 0x38, 0x05, 0x53, 0x82, 0x80, 0x90, 0x5d, 0xd3, 0x2d, 0x83, 0x84,
+		0xc8, 0x2c, 0x68, 0x47, 0xbe, 0xac, 0xcb, 0x2c, 0x07, 0x95, 0x40, 0xb8, 0x8b, 0x66, 0xe1, 0xae,
+		0x49, 0xf6, 0xbb, 0x12, 0x11, 0xc5, 0xe6, 0x38, 0x40, 0x65, 0xf2, 0xca, 0x29, 0x48, 0xd2, 0xe1,
+		0x07, 0x01, 0xf0, 0x01, 0x48, 0x3e, 0x84, 0xd2, 0x90, 0x58, 0x5c, 0x55, 0xc9, 0xf4, 0x28, 0xc3,
+		0x38, 0x4b, 0xd5, 0xd6, 0x6a, 0xd5, 0xc5, 0xaa, 0x1c, 0x53, 0x1e, 0x87, 0x14, 0x1b, 0x53, 0xc8,
+		0xd4, 0xe9, 0x8e, 0x2a, 0xf2, 0x21, 0xfe, 0xc8, 0x39, 0x24, 0x91, 0xbb, 0xb1, 0x52, 0xae, 0xaa,
+		0x72, 0x4c, 0xd9, 0x80, 0x7c, 0xa8, 0x1f, 0xa2, 0xc3, 0x30, 0xa9, 0x56, 0xd7, 0xab, 0x75, 0xb2,
+		0x39, 0xa0, 0x6d, 0xd4, 0x9f, 0xa9, 0xaf, 0x5e, 0x26, 0x3b, 0x6b, 0x81, 0x64, 0x31, 0x0f, 0x4b,
+		0x68, 0x1a, 0x64, 0x2f, 0xb9, 0xb1, 0xba, 0xa1, 0x52, 0x6d, 0x7e, 0x39, 0x06, 0x72, 0xb8, 0x53,
+		0xa2, 0xa3, 0x30, 0xb5, 0x5e, 0x52, 0x97, 0xaa, 0xeb, 0x1a, 0xdb, 0xf0, 0x70, 0xa9, 0xa7, 0x41,
+		0xf6, 0x67, 0x9c, 0xaf, 0xd1, 0xfd, 0x9c, 0x39, 0x38, 0xee, 0x4f, 0xad, 0x3e, 0xbb, 0x5e, 0xad,
+		0x37, 0x68, 0xe1, 0xa5, 0xfa, 0x12, 0x09, 0x0a, 0x42, 0x7c, 0x62, 0x8b, 0x25, 0x4e, 0x54, 0x0d,
+		0xf2, 0x55, 0x97, 0x2b, 0x72, 0x22, 0x9c, 0xbc, 0x5a, 0xaf, 0xae, 0x9e, 0x97, 0x93, 0xe1, 0xd2,
+		0xe9, 0xb6, 0x4b, 0x0a, 0xcd, 0xc0, 0x91, 0x70, 0xaa, 0x56, 0xad, 0xaf, 0xab, 0xcf, 0xc9, 0x63,
+		0xe1, 0x82, 0x1b, 0x55, 0xf5, 0x52, 0x6d, 0xb1, 0x2a, 0xa7, 0xd1, 0x11, 0x40, 0x41, 0x8d, 0xd6,
+		0x2f, 0xac, 0x56, 0xe4, 0xcc, 0xb0, 0x19, 0x0b, 0xc9, 0x53, 0xca, 0xe7, 0x25, 0x18, 0xf7, 0x6f,
+		0x81, 0x04, 0x06, 0x15, 0xe9, 0x4e, 0x9b, 0x6c, 0x95, 0x3f, 0x89, 0x41, 0xd6, 0xb7, 0x17, 0x42,
+		0x16, 0xb1, 0x7a, 0xa7, 0x63, 0x5e, 0xd3, 0xf4, 0x4e, 0x5b, 0xb7, 0xf9, 0x7c, 0x08, 0x34, 0xa9,
+		0x44, 0x52, 0x46, 0x9d, 0x7f, 0x46, 0x0f, 0x5d, 0x52, 0xb7, 0x1c, 0xba, 0x8c, 0xdd, 0x81, 0xa1,
+		0x4b, 0x52, 0x4e, 0x29, 0xdf, 0x8c, 0x81, 0x1c, 0xde, 0x1d, 0x09, 0xd9, 0x4d, 0xda, 0xcb, 0x6e,
+		0xfe, 0xfa, 0xc5, 0x0e, 0x52, 0xbf, 0xf0, 0xac, 0x1e, 0xdf, 0x73, 0x56, 0x1f, 0x32, 0x59, 0x25,
+		0xee, 0xe4, 0xc9, 0xca, 0xef, 0xae, 0xff, 0x51, 0x82, 0x5c, 0x70, 0x33, 0x27, 0x60, 0x31, 0xe5,
+		0x20, 0x16, 0x0b, 0xb6, 0xc8, 0x3d, 0x7b, 0xb5, 0xc8, 0x4f, 0xa4, 0x5e, 0x1f, 0x8b, 0xc3, 0x44,
+		0x60, 0xef, 0x67, 0x54, 0xed, 0xde, 0x0d, 0x93, 0xed, 0x16, 0xee, 0xf6, 0x4c, 0x87, 0xdc, 0x3c,
+		0xd0, 0x3a, 0xf8, 0x2a, 0xee, 0x50, 0x33, 0xe4, 0x86, 0x9c, 0xae, 0x06, 0x4a, 0x58, 0xa8, 0x79,
+		0xb8, 0x65, 0x02, 0x2b, 0x4e, 0xd5, 0x2a, 0xd5, 0x95, 0xb5, 0xd5, 0xf5, 0x6a, 0x7d, 0xf1, 0x39,
+		0x31, 0x92, 0xab, 0x72, 0x3b, 0x24, 0x16, 0x30, 0xf8, 0xbd, 0x77, 0xc6, 0xa2, 0x73, 0x0d, 0xe4,
+		0x70, 0x6d, 0xc8, 0x80, 0x3e, 0xa4, 0x3e, 0xf2, 0x21, 0x34, 0x05, 0xf9, 0xfa, 0xaa, 0xd6, 0xa8,
+		0x55, 0xaa, 0x5a, 0xf5, 0xfc, 0xf9, 0xea, 0xe2, 0x7a, 0x83, 0x1d, 0x34, 0xb8, 0xd2, 0xeb, 0x72,
+		0xcc, 0xdf, 0x36, 0x1f, 0x8f, 0xc3, 0xd4, 0x10, 0x4d, 0x50, 0x89, 0x6f, 0x11, 0xb2, 0x5d, 0xcb,
+		0x87, 0x47, 0xd1, 0x7e, 0x81, 0xac, 0xee, 0xd7, 0x74, 0xcb, 0xe1, 0x3b, 0x8a, 0x0f, 0x00, 0x31,
+		0xaf, 0xe1, 0x90, 0xf0, 0xde, 0xe2, 0x07, 0x38, 0x2c, 0x04, 0xc9, 0x7b, 0xe9, 0xec, 0x0c, 0xe7,
+		0x21, 0x40, 0x3d, 0xd3, 0x6e, 0x3b, 0xed, 0xab, 0xe4, 0x22, 0x84, 0x38, 0xed, 0x21, 0x1d, 0x37,
+		0xa1, 0xca, 0x22, 0xa7, 0x66, 0x38, 0xae, 0xb4, 0x81, 0xb7, 0xf5, 0x90, 0x34, 0x59, 0x7e, 0xc4,
+		0x55, 0x59, 0xe4, 0xb8, 0xd2, 0xf7, 0xc0, 0x78, 0xcb, 0xec, 0x93, 0x5d, 0x19, 0x26, 0x47, 0x86,
+		0x64, 0x49, 0xcd, 0xb2, 0x34, 0x57, 0x84, 0x6f, 0x9b, 0x79, 0xc7, 0x4c, 0xe3, 0x6a, 0x96, 0xa5,
+		0x31, 0x91, 0xfb, 0x21, 0xaf, 0x6f, 0x6f, 0x5b, 0x84, 0x5c, 0x10, 0xb1, 0x8d, 0xc0, 0x9c, 0x9b,
+		0x4c, 0x05, 0x67, 0x2e, 0x42, 0x5a, 0xd8, 0x81, 0xac, 0x7f, 0x89, 0x25, 0xb4, 0x1e, 0xdb, 0xdd,
+		0x8e, 0x91, 0x93, 0x27, 0x43, 0x64, 0xde, 0x03, 0xe3, 0x6d, 0x5b, 0xf3, 0xae, 0x41, 0xc4, 0xe6,
+		0x63, 0x27, 0xd2, 0x6a, 0xb6, 0x6d, 0xbb, 0xa7, 0xa2, 0xca, 0x17, 0xb2, 0x00, 0x9e, 0xb3, 0xa1,
+		0x8f, 0x48, 0x90, 0x63, 0x13, 0x4c, 0xcf, 0xc2, 0x36, 0x36, 0x9a, 0x62, 0x59, 0xf8, 0xc0, 0x3e,
+		0x2e, 0xca, 0x86, 0xb9, 0x35, 0x0e, 0x28, 0x3f, 0xfd, 0xb2, 0x24, 0xbd, 0x22, 0x25, 0x5e, 0x91,
+		0xa4, 0x4f, 0x4b, 0x13, 0x28, 0x5d, 0x7d, 0x76, 0x6d, 0xb9, 0xb6, 0x58, 0x5b, 0x2f, 0x7c, 0x77,
+		0x8c, 0x3e, 0xd7, 0x56, 0xf8, 0xf3, 0x6b, 0x63, 0xc1, 0xfc, 0xd7, 0xc7, 0xbe, 0x28, 0xc5, 0xd3,
+		0xaf, 0x8f, 0xa9, 0x13, 0x5b, 0x7e, 0x3e, 0xd4, 0xf1, 0xdf, 0xa0, 0x88, 0xed, 0xb5, 0x90, 0xf4,
+		0xb4, 0xa9, 0xf2, 0x7b, 0x13, 0xe5, 0x07, 0xa8, 0x22, 0x29, 0xaa, 0x48, 0x16, 0xa5, 0x16, 0x97,
+		0x57, 0x1b, 0xd5, 0x0a, 0x55, 0x23, 0x83, 0x12, 0xab, 0x6b, 0xd5, 0x7a, 0xe1, 0x35, 0x51, 0xa4,
+		0x77, 0xd9, 0xe2, 0x15, 0x09, 0x8e, 0x8a, 0x53, 0x56, 0x3e, 0xd7, 0x62, 0xa3, 0x69, 0xb6, 0x44,
+		0x74, 0x9b, 0x3b, 0x73, 0x7a, 0xbf, 0xc2, 0x55, 0x0e, 0xa5, 0x26, 0xa9, 0x72, 0x60, 0xf9, 0xe1,
+		0x01, 0x93, 0x94, 0xea, 0x15, 0xae, 0x4b, 0x16, 0xa5, 0xd6, 0x4a, 0x8b, 0xcf, 0x54, 0x2b, 0x9e,
+		0x36, 0x87, 0xad, 0x61, 0x2c, 0xe8, 0x3d, 0x90, 0x27, 0xbb, 0xad, 0xc4, 0x37, 0xda, 0x2d, 0x76,
+		0xec, 0x9d, 0xd8, 0xeb, 0xbc, 0xd4, 0xd3, 0x88, 0x6c, 0xbf, 0x5e, 0x72, 0x11, 0xe5, 0x07, 0x7c,
+		0xaa, 0x64, 0x50, 0xa2, 0xbe, 0x5a, 0xaf, 0x0a, 0x35, 0xe8, 0x11, 0xf1, 0x73, 0x9e, 0x1a, 0xb9,
+		0x7e, 0x00, 0x8a, 0xde, 0x03, 0xb2, 0xd8, 0x1e, 0x72, 0x4d, 0x92, 0xdc, 0xeb, 0xc8, 0xd7, 0x53,
+		0x80, 0x6f, 0x32, 0xb9, 0xc6, 0xb8, 0xcf, 0xa7, 0xc1, 0x34, 0xca, 0x2f, 0x57, 0xeb, 0x4b, 0xeb,
+		0x17, 0xb4, 0x35, 0xb5, 0x4a, 0x4f, 0xee, 0x0a, 0xdf, 0x15, 0xc5, 0xe7, 0xbb, 0x41, 0x20, 0xfa,
+		0x39, 0x09, 0xb2, 0x2c, 0x04, 0x62, 0x7b, 0x52, 0x6c, 0x53, 0xe1, 0xbe, 0xfd, 0xca, 0xa6, 0x11,
+		0x10, 0x95, 0x2e, 0x9f, 0xa3, 0xc5, 0xc6, 0x85, 0x43, 0x1c, 0x45, 0x68, 0xb9, 0xba, 0x54, 0x5a,
+		0x7c, 0x4e, 0x2b, 0x57, 0x1b, 0xeb, 0x64, 0x24, 0x5b, 0x55, 0x99, 0x8f, 0x02, 0x4a, 0x96, 0x96,
+		0x97, 0x57, 0x2f, 0x7b, 0x86, 0x80, 0x17, 0x5c, 0x1a, 0xe5, 0xff, 0x83, 0x89, 0x80, 0xbb, 0x93,
+		0xa0, 0x98, 0x06, 0xd3, 0xa4, 0x06, 0x8d, 0x6a, 0x7d, 0xd1, 0x1f, 0xc4, 0x8f, 0x83, 0xeb, 0xde,
+		0xb2, 0x44, 0x9e, 0x84, 0xf3, 0xcb, 0x31, 0x32, 0x8c, 0x72, 0x05, 0xdc, 0xb3, 0xc4, 0xb8, 0xf2,
+		0x04, 0xa4, 0x85, 0xfb, 0x92, 0xd0, 0x9c, 0x46, 0xd8, 0xa1, 0x85, 0x41, 0x1a, 0xa8, 0xef, 0xca,
+		0x12, 0x59, 0x06, 0x31, 0x9f, 0x96, 0x63, 0xca, 0x25, 0x38, 0x3c, 0xd4, 0xf5, 0xd0, 0xbd, 0x30,
+		0x27, 0xce, 0x2f, 0x59, 0xd0, 0xaf, 0x55, 0xeb, 0x8b, 0xab, 0x15, 0xb2, 0x4c, 0xf2, 0x38, 0x01,
+		0xb8, 0x0f, 0x32, 0x2d, 0x85, 0x7f, 0xca, 0x31, 0xa5, 0x06, 0xb9, 0xa0, 0x03, 0xa1, 0xe3, 0x70,
+		0x74, 0x63, 0xfd, 0xfc, 0x93, 0xda, 0xa5, 0xd2, 0x72, 0xad, 0x52, 0x0a, 0x2d, 0x88, 0x00, 0xb8,
+		0x17, 0xc9, 0x31, 0xa2, 0x28, 0xf1, 0x2e, 0x39, 0xae, 0x24, 0xd2, 0x92, 0x2c, 0x29, 0x0d, 0xc8,
+		0x87, 0x5c, 0x01, 0xdd, 0x05, 0x05, 0xbe, 0x42, 0x19, 0xa6, 0xd5, 0x14, 0x84, 0x9d, 0x83, 0xad,
+		0xd5, 0x2a, 0xd5, 0xe5, 0xda, 0x4a, 0x6d, 0x9d, 0xea, 0x77, 0x01, 0xc0, 0x6b, 0x63, 0x32, 0x67,
+		0x5d, 0x6c, 0xac, 0xd6, 0xb5, 0xf3, 0x64, 0xa1, 0xb7, 0xee, 0xa3, 0xca, 0x00, 0x6b, 0x53, 0x59,
+		0x22, 0xeb, 0x91, 0xc1, 0x86, 0x97, 0x63, 0x27, 0x3f, 0x20, 0x91, 0x29, 0xeb, 0x03, 0xf5, 0x99,
+		0x9f, 0x93, 0xd0, 0xdd, 0xe9, 0xd7, 0xc7, 0xd0, 0xd8, 0x42, 0x6f, 0x73, 0xa1, 0xd9, 0xeb, 0xcd,
+		0xe4, 0xc9, 0x8f, 0xc5, 0x5e, 0xef, 0xbc, 0x98, 0x88, 0xe7, 0xd2, 0xdf, 0x1b, 0x43, 0x69, 0x92,
+		0x4a, 0x0e, 0x01, 0x66, 0x64, 0xf2, 0xeb, 0xa2, 0x7e, 0x55, 0x77, 0x05, 0x8e, 0xa7, 0xbf, 0x3f,
+		0x86, 0x52, 0x24, 0x79, 0xdb, 0x9c, 0xc9, 0x91, 0xff, 0x97, 0x4c, 0x37, 0xf3, 0xde, 0xf4, 0x2f,
+		0xd4, 0x11, 0x90, 0x44, 0xea, 0xb1, 0xa7, 0x67, 0x10, 0xf9, 0x4d, 0x8f, 0xcd, 0x4e, 0x0b, 0xa1,
+		0x93, 0xa9, 0xf4, 0x07, 0xea, 0xf2, 0x87, 0xeb, 0x27, 0x53, 0xe9, 0x0f, 0xd7, 0xe5, 0x8f, 0xd4,
+		0x2f, 0xa6, 0xd2, 0xaf, 0x8d, 0xc9, 0xaf, 0x8f, 0x29, 0x7f, 0x1d, 0x07, 0xe4, 0xf9, 0xb7, 0xbb,
+		0xf3, 0xf2, 0x2c, 0xa4, 0xdd, 0xad, 0x1c, 0x76, 0x57, 0xf4, 0x6d, 0xfb, 0x74, 0x0b, 0x01, 0xf3,
+		0x25, 0x85, 0xb6, 0x76, 0x5c, 0x36, 0xb2, 0x6e, 0xef, 0xb6, 0x8d, 0x76, 0xb7, 0xdf, 0xd5, 0xc4,
+		0xfe, 0x46, 0xe4, 0xba, 0x9d, 0x03, 0xf8, 0x33, 0xa5, 0xd0, 0xaf, 0x07, 0x28, 0x92, 0x91, 0x14,
+		0x0c, 0xc0, 0x9f, 0x67, 0xfe, 0x4e, 0x82, 0xc2, 0x5e, 0xca, 0xde, 0xd2, 0xd6, 0x4b, 0x1d, 0xa6,
+		0xcd, 0xab, 0xd8, 0xb2, 0xda, 0x2d, 0x7a, 0x9a, 0xe2, 0x06, 0x64, 0x89, 0xe8, 0x80, 0x6c, 0xca,
+		0x07, 0x74, 0x1b, 0xb5, 0x4c, 0xe6, 0xcd, 0xeb, 0x64, 0xca, 0x10, 0x4c, 0xc9, 0x68, 0xa6, 0x09,
+		0x0a, 0x11, 0x1c, 0x17, 0x49, 0x37, 0x21, 0x6b, 0xa0, 0x98, 0x1c, 0xf7, 0xa2, 0x3e, 0xe5, 0x33,
+		0x31, 0xc8, 0x05, 0x2f, 0x67, 0xa2, 0x0a, 0xa4, 0x3b, 0x26, 0xbf, 0xf8, 0xc4, 0x5a, 0xfb, 0x44,
+		0xc4, 0x7d, 0xce, 0x85, 0x65, 0x2e, 0xaf, 0xba, 0xc8, 0x99, 0xff, 0x20, 0x41, 0x5a, 0x24, 0xa3,
+		0x23, 0x90, 0xe8, 0xe9, 0xce, 0x0e, 0xa5, 0x4b, 0x96, 0x63, 0xb2, 0xa4, 0xd2, 0x67, 0x92, 0x6e,
+		0xf7, 0x74, 0x76, 0xe9, 0x8b, 0xa7, 0x93, 0x67, 0x12, 0x79, 0x75, 0xb0, 0xde, 0xa2, 0xe7, 0x80,
+		0x66, 0xb7, 0x8b, 0x0d, 0xc7, 0x16, 0x91, 0x17, 0x4f, 0x5f, 0xe4, 0xc9, 0xe4, 0x8e, 0xb0, 0x63,
+		0xe9, 0xed, 0x4e, 0x40, 0x36, 0x41, 0x65, 0x65, 0x91, 0xe1, 0x0a, 0x17, 0xe1, 0x98, 0xe0, 0x6d,
+		0x61, 0x47, 0x6f, 0xee, 0xe0, 0x96, 0x07, 0x4a, 0xd1, 0xf3, 0xfe, 0xa3, 0x5c, 0xa0, 0xc2, 0xf3,
+		0x05, 0x56, 0xf9, 0x46, 0x0c, 0x26, 0xc5, 0xc9, 0x65, 0xcb, 0x35, 0xd6, 0x0a, 0x80, 0x6e, 0x18,
+		0xa6, 0xe3, 0x37, 0xd7, 0x60, 0xb0, 0x39, 0x80, 0x5b, 0x28, 0xb9, 0x20, 0xd5, 0x47, 0x30, 0xf3,
+		0x97, 0x12, 0x80, 0x97, 0xb5, 0xa7, 0xdd, 0xe6, 0x20, 0xcb, 0xaf, 0xde, 0xd2, 0xfb, 0xdb, 0x6c,
+		0x83, 0x0f, 0x58, 0x12, 0x39, 0xe3, 0x24, 0x7b, 0x7f, 0x9b, 0x78, 0xbb, 0x6d, 0xf0, 0xbb, 0x54,
+		0xec, 0x41, 0x5c, 0x49, 0x48, 0x78, 0x77, 0x0d, 0x55, 0x48, 0xdb, 0xb8, 0xab, 0x1b, 0x4e, 0xbb,
+		0xc9, 0x7b, 0xcd, 0xd9, 0x03, 0x29, 0xbf, 0xd0, 0xe0, 0x68, 0xd5, 0xe5, 0x51, 0x4e, 0x40, 0x5a,
+		0xa4, 0xba, 0xa3, 0xf4, 0x21, 0x34, 0x06, 0xf1, 0x46, 0x95, 0xcc, 0x53, 0x74, 0xb0, 0xac, 0x95,
+		0x1a, 0x72, 0xec, 0xe4, 0x67, 0x62, 0x30, 0x26, 0xba, 0xf1, 0x14, 0xe4, 0xab, 0x95, 0x5a, 0x68,
+		0xc0, 0x9f, 0x82, 0x9c, 0x48, 0x64, 0xa3, 0xaa, 0xfc, 0xf3, 0x63, 0xfe, 0xc4, 0x35, 0x75, 0x75,
+		0x7d, 0xf5, 0x8c, 0xfc, 0xdd, 0xc1, 0xc4, 0x47, 0xe5, 0xd7, 0xc6, 0xd0, 0x24, 0x8c, 0x8b, 0xc4,
+		0x33, 0x8f, 0x9c, 0x79, 0x54, 0x7e, 0x3d, 0x9c, 0xf4, 0x98, 0xfc, 0x3d, 0xba, 0xb7, 0x24, 0x92,
+		0x4e, 0x6b, 0xeb, 0x64, 0xd4, 0x5e, 0xad, 0x2f, 0x3f, 0x27, 0x4b, 0xfe, 0x8c, 0x33, 0xbe, 0x8c,
+		0x18, 0xba, 0x1b, 0x8e, 0x8a, 0x8c, 0x73, 0xe7, 0xce, 0x9d, 0x7b, 0xc2, 0x97, 0xf9, 0xea, 0x07,
+		0x53, 0xe1, 0xec, 0x27, 0x7d, 0xd9, 0x9f, 0x1c, 0xcc, 0x3e, 0xe7, 0xcb, 0xfe, 0xf5, 0x0f, 0xa6,
+		0xd0, 0x14, 0x64, 0x45, 0xf6, 0x4a, 0xe9, 0x59, 0xf9, 0xc7, 0x3f, 0xfe, 0xf1, 0x8f, 0xc7, 0xca,
+		0xef, 0x81, 0xa9, 0xa6, 0xd9, 0x0d, 0x37, 0x4d, 0x59, 0x0e, 0x5d, 0x8c, 0xb0, 0x2f, 0x48, 0xcf,
+		0x3f, 0xcc, 0x85, 0xb6, 0xcd, 0x8e, 0x6e, 0x6c, 0x2f, 0x98, 0xd6, 0xb6, 0xf7, 0x9e, 0x00, 0x09,
+		0x72, 0x6d, 0xdf, 0xdb, 0x02, 0xbd, 0xcd, 0xbf, 0x93, 0xa4, 0x4f, 0xc7, 0xe2, 0x4b, 0x6b, 0xe5,
+		0xcf, 0xc5, 0x66, 0x96, 0x18, 0x70, 0x4d, 0x34, 0xbc, 0x8a, 0xb7, 0x3a, 0xb8, 0x49, 0x5a, 0x07,
+		0xfe, 0xea, 0x41, 0x98, 0xde, 0x36, 0xb7, 0x4d, 0xca, 0x74, 0x8a, 0xfc, 0x62, 0x4a, 0xa0, 0x8c,
+		0x9b, 0x3a, 0x13, 0xf9, 0x56, 0x42, 0xb1, 0x0e, 0x53, 0x5c, 0x58, 0xa3, 0x31, 0x37, 0x3b, 0xbb,
+		0x45, 0xfb, 0xde, 0xff, 0x29, 0xfc, 0xee, 0x77, 0xe8, 0x66, 0x89, 0x3a, 0xc9, 0xa1, 0x24, 0x8f,
+		0x1d, 0xef, 0x16, 0x55, 0x38, 0x1c, 0xe0, 0x63, 0xeb, 0x1d, 0x6c, 0x45, 0x30, 0xfe, 0x21, 0x67,
+		0x9c, 0xf2, 0x31, 0x36, 0x38, 0xb4, 0xb8, 0x08, 0x13, 0x07, 0xe1, 0xfa, 0x37, 0x9c, 0x6b, 0x1c,
+		0xfb, 0x49, 0x96, 0x20, 0x4f, 0x49, 0x9a, 0x7d, 0xdb, 0x31, 0xbb, 0x74, 0x31, 0xb9, 0x3f, 0xcd,
+		0xbf, 0xfd, 0x0e, 0x1b, 0xde, 0x72, 0x04, 0xb6, 0xe8, 0xa2, 0x8a, 0x45, 0xa0, 0x6b, 0x07, 0x72,
+		0xa9, 0x35, 0x82, 0xe1, 0x6b, 0x5c, 0x11, 0x57, 0xbe, 0x78, 0x09, 0xa6, 0xc9, 0x6f, 0xba, 0xd6,
+		0xf3, 0x6b, 0x12, 0x7d, 0x59, 0xa8, 0xf0, 0x27, 0xef, 0x65, 0x23, 0xe8, 0x94, 0x4b, 0xe0, 0xd3,
+		0xc9, 0xd7, 0x8a, 0xdb, 0xd8, 0x71, 0xb0, 0x65, 0x6b, 0x7a, 0x67, 0x98, 0x7a, 0xbe, 0xdb, 0x16,
+		0x85, 0x8f, 0xfd, 0x20, 0xd8, 0x8a, 0x4b, 0x0c, 0x59, 0xea, 0x74, 0x8a, 0x1b, 0x70, 0x74, 0x88,
+		0x57, 0x8c, 0xc0, 0xf9, 0x71, 0xce, 0x39, 0x3d, 0xe0, 0x19, 0x84, 0x76, 0x0d, 0x44, 0xba, 0xdb,
+		0x96, 0x23, 0x70, 0x7e, 0x82, 0x73, 0x22, 0x8e, 0x15, 0x4d, 0x4a, 0x18, 0x2f, 0xc2, 0xe4, 0x55,
+		0x6c, 0x6d, 0x9a, 0x36, 0xbf, 0xe1, 0x32, 0x02, 0xdd, 0xaf, 0x71, 0xba, 0x3c, 0x07, 0xd2, 0x2b,
+		0x2f, 0x84, 0xeb, 0x1c, 0xa4, 0xb7, 0xf4, 0x26, 0x1e, 0x81, 0xe2, 0x55, 0x4e, 0x31, 0x46, 0xe4,
+		0x09, 0xb4, 0x04, 0xe3, 0xdb, 0x26, 0x5f, 0xee, 0x47, 0xc3, 0x3f, 0xc9, 0xe1, 0x59, 0x81, 0xe1,
+		0x14, 0x3d, 0xb3, 0xd7, 0xef, 0x90, 0xbd, 0x80, 0x68, 0x8a, 0x5f, 0x17, 0x14, 0x02, 0xc3, 0x29,
+		0x0e, 0x60, 0xd6, 0x4f, 0x09, 0x0a, 0xdb, 0x67, 0xcf, 0xa7, 0xc9, 0xc5, 0xd7, 0xce, 0xae, 0x69,
+		0x8c, 0xa2, 0xc4, 0x6f, 0x70, 0x06, 0xe0, 0x10, 0x42, 0xf0, 0x14, 0x64, 0x46, 0x6d, 0x88, 0x7f,
+		0xf0, 0x03, 0xd1, 0x3d, 0x44, 0x0b, 0x2c, 0x41, 0x5e, 0x0c, 0x50, 0xe4, 0xd8, 0x28, 0x9a, 0xe2,
+		0xb7, 0x38, 0x45, 0xce, 0x07, 0xe3, 0xd5, 0x70, 0xb0, 0xed, 0x6c, 0xe3, 0x51, 0x48, 0x3e, 0x23,
+		0xaa, 0xc1, 0x21, 0xdc, 0x94, 0x9b, 0xd8, 0x68, 0xee, 0x8c, 0xc6, 0xf0, 0x59, 0x61, 0x4a, 0x81,
+		0x21, 0x14, 0x8b, 0x30, 0xd1, 0xd5, 0x2d, 0x7b, 0x47, 0xef, 0x8c, 0xd4, 0x1c, 0xff, 0x90, 0x73,
+		0x8c, 0xbb, 0x20, 0x6e, 0x91, 0xbe, 0x71, 0x10, 0x9a, 0xcf, 0x09, 0x8b, 0xf4, 0x8d, 0x00, 0xd1,
+		0x1a, 0x4c, 0xdb, 0x0e, 0x8d, 0x7c, 0x0f, 0xc2, 0xf6, 0x8f, 0x44, 0xd7, 0x63, 0xd8, 0x15, 0x3f,
+		0xe3, 0x53, 0x90, 0xb1, 0xdb, 0x2f, 0x8e, 0x44, 0xf3, 0x79, 0xd1, 0xd2, 0x14, 0x40, 0xc0, 0xcf,
+		0xc1, 0xb1, 0xa1, 0xd3, 0xc4, 0x08, 0x64, 0x5f, 0xe0, 0x64, 0x47, 0x86, 0x4c, 0x15, 0x7c, 0x48,
+		0x38, 0x28, 0xe5, 0x6f, 0x8b, 0x21, 0x01, 0x87, 0xb8, 0xd6, 0xc8, 0x06, 0xac, 0xad, 0x6f, 0x1d,
+		0xcc, 0x6a, 0xff, 0x58, 0x58, 0x8d, 0x61, 0x03, 0x56, 0x5b, 0x87, 0x23, 0x9c, 0xf1, 0x60, 0xed,
+		0xfa, 0x3b, 0x62, 0x60, 0x65, 0xe8, 0x8d, 0x60, 0xeb, 0xfe, 0x34, 0xcc, 0xb8, 0xe6, 0x14, 0x3b,
+		0x7d, 0xb6, 0x46, 0xee, 0xc9, 0x44, 0x33, 0xff, 0x2e, 0x67, 0x16, 0x23, 0xbe, 0xbb, 0x55, 0x68,
+		0xaf, 0xe8, 0x3d, 0x42, 0xfe, 0x2c, 0x14, 0x04, 0x79, 0xdf, 0xb0, 0x70, 0xd3, 0xdc, 0x36, 0xda,
+		0x2f, 0xe2, 0xd6, 0x08, 0xd4, 0x5f, 0x0c, 0x35, 0xd5, 0x86, 0x0f, 0x4e, 0x98, 0x6b, 0x20, 0xbb,
+		0xb1, 0x8a, 0xd6, 0xee, 0xd2, 0x53, 0x91, 0xfd, 0x19, 0xbf, 0x24, 0x5a, 0xca, 0xc5, 0xd5, 0x28,
+		0xac, 0x58, 0x05, 0x76, 0x47, 0x7e, 0x54, 0x97, 0xfc, 0x32, 0x27, 0x9a, 0xf0, 0x50, 0x7c, 0xe0,
+		0x68, 0x9a, 0xdd, 0x9e, 0x6e, 0x8d, 0x32, 0xfe, 0xfd, 0x13, 0x31, 0x70, 0x70, 0x08, 0x1f, 0x38,
+		0x48, 0x44, 0x47, 0x66, 0xfb, 0x11, 0x18, 0xbe, 0x22, 0x06, 0x0e, 0x81, 0xe1, 0x14, 0x22, 0x60,
+		0x18, 0x81, 0xe2, 0xf7, 0x04, 0x85, 0xc0, 0x10, 0x8a, 0x77, 0x7a, 0x13, 0xad, 0x85, 0xb7, 0xdb,
+		0xb6, 0xc3, 0xdf, 0x62, 0xd9, 0x9f, 0xea, 0xf7, 0x7f, 0x10, 0x0c, 0xc2, 0x54, 0x1f, 0x94, 0x8c,
+		0x44, 0x7c, 0x7b, 0x8e, 0x6e, 0x3f, 0x47, 0x2b, 0xf6, 0x55, 0x31, 0x12, 0xf9, 0x60, 0x44, 0x37,
+		0x5f, 0x84, 0x48, 0xcc, 0xde, 0x24, 0x4b, 0xba, 0x11, 0xe8, 0xfe, 0x69, 0x48, 0xb9, 0x86, 0xc0,
+		0x12, 0x4e, 0x5f, 0xfc, 0xd3, 0x37, 0xae, 0xe0, 0xdd, 0x91, 0xbc, 0xf3, 0x9f, 0x85, 0xe2, 0x9f,
+		0x0d, 0x86, 0x64, 0x63, 0x48, 0x3e, 0x14, 0x4f, 0xa1, 0xa8, 0x57, 0xdc, 0x0a, 0x3f, 0xfb, 0x06,
+		0xaf, 0x6f, 0x30, 0x9c, 0x2a, 0x2e, 0x83, 0xcc, 0x53, 0xbc, 0x00, 0x36, 0x92, 0xec, 0xbd, 0x6f,
+		0xb8, 0x7e, 0x1e, 0x88, 0x79, 0x8a, 0xe7, 0x61, 0x22, 0x10, 0xf0, 0x44, 0x53, 0xfd, 0x3c, 0xa7,
+		0x1a, 0xf7, 0xc7, 0x3b, 0xc5, 0xc7, 0x21, 0x41, 0x82, 0x97, 0x68, 0xf8, 0xfb, 0x38, 0x9c, 0x8a,
+		0x17, 0xdf, 0x0e, 0x69, 0x11, 0xb4, 0x44, 0x43, 0x7f, 0x81, 0x43, 0x5d, 0x08, 0x81, 0x8b, 0x80,
+		0x25, 0x1a, 0xfe, 0x8b, 0x02, 0x2e, 0x20, 0x04, 0x3e, 0xba, 0x09, 0xff, 0xe5, 0x07, 0x12, 0x0c,
+		0x2e, 0x20, 0x45, 0x72, 0x47, 0x9f, 0x45, 0x2a, 0xd1, 0xe8, 0x5f, 0xe2, 0x85, 0x0b, 0x44, 0xf1,
+		0x09, 0x48, 0x8e, 0x68, 0xf0, 0x0f, 0x72, 0x28, 0x93, 0x2f, 0x2e, 0x42, 0xd6, 0x17, 0x9d, 0x44,
+		0xc3, 0x3f, 0xc4, 0xe1, 0x7e, 0x14, 0x51, 0x9d, 0x47, 0x27, 0xd1, 0x04, 0xff, 0xbf, 0x50, 0x9d,
+		0x23, 0x88, 0xd9, 0x44, 0x60, 0x12, 0x8d, 0xfe, 0xb0, 0xb0, 0xba, 0x80, 0x14, 0x9f, 0x86, 0x8c,
+		0x3b, 0xd9, 0x44, 0xe3, 0x3f, 0xc2, 0xf1, 0x1e, 0x86, 0x58, 0xa0, 0x6f, 0x1c, 0x80, 0xe2, 0xef,
+		0x09, 0x0b, 0xf8, 0x50, 0xa4, 0x1b, 0x85, 0x03, 0x98, 0x68, 0xa6, 0x5f, 0x11, 0xdd, 0x28, 0x14,
+		0xbf, 0x90, 0xd6, 0xa4, 0x63, 0x7e, 0x34, 0xc5, 0xaf, 0x8a, 0xd6, 0xa4, 0xf2, 0x44, 0x8d, 0x70,
+		0x44, 0x10, 0xcd, 0xf1, 0x51, 0xa1, 0x46, 0x28, 0x20, 0x28, 0xae, 0x01, 0x1a, 0x8c, 0x06, 0xa2,
+		0xf9, 0x5e, 0xe1, 0x7c, 0x93, 0x03, 0xc1, 0x40, 0xf1, 0x32, 0x1c, 0x19, 0x1e, 0x09, 0x44, 0xb3,
+		0x7e, 0xec, 0x8d, 0xd0, 0xda, 0xcd, 0x1f, 0x08, 0x14, 0xd7, 0x61, 0x7a, 0x58, 0x14, 0x10, 0x4d,
+		0xfb, 0xf1, 0x37, 0x82, 0x03, 0xb7, 0x3f, 0x08, 0x28, 0x96, 0x00, 0xbc, 0x09, 0x38, 0x9a, 0xeb,
+		0xd7, 0x38, 0x97, 0x0f, 0x44, 0xba, 0x06, 0x9f, 0x7f, 0xa3, 0xf1, 0xaf, 0x8a, 0xae, 0xc1, 0x11,
+		0xa4, 0x6b, 0x88, 0xa9, 0x37, 0x1a, 0xfd, 0x49, 0xd1, 0x35, 0x04, 0x84, 0x78, 0xb6, 0x6f, 0x76,
+		0x8b, 0x66, 0xf8, 0x0d, 0xe1, 0xd9, 0x3e, 0x54, 0xb1, 0x0e, 0x93, 0x03, 0x13, 0x62, 0x34, 0xd5,
+		0xa7, 0x39, 0x95, 0x1c, 0x9e, 0x0f, 0xfd, 0x93, 0x17, 0x9f, 0x0c, 0xa3, 0xd9, 0x7e, 0x33, 0x34,
+		0x79, 0xf1, 0xb9, 0xb0, 0xf8, 0x14, 0xa4, 0x8d, 0x7e, 0xa7, 0x43, 0x3a, 0x0f, 0xda, 0xff, 0x2d,
+		0xc6, 0xc2, 0xf7, 0x7e, 0xc4, 0xad, 0x23, 0x00, 0xc5, 0xc7, 0x21, 0x89, 0xbb, 0x9b, 0xb8, 0x15,
+		0x85, 0xfc, 0xfe, 0x8f, 0xc4, 0x80, 0x49, 0xa4, 0x8b, 0x4f, 0x03, 0xb0, 0xad, 0x11, 0x7a, 0x13,
+		0x38, 0x02, 0xfb, 0x97, 0x3f, 0xe2, 0xaf, 0x0d, 0x79, 0x10, 0x8f, 0x80, 0xbd, 0x84, 0xb4, 0x3f,
+		0xc1, 0x0f, 0x82, 0x04, 0xb4, 0x45, 0xce, 0xc1, 0x18, 0x39, 0x00, 0x74, 0xf4, 0xed, 0x28, 0xf4,
+		0x5f, 0x71, 0xb4, 0x90, 0x27, 0x06, 0xeb, 0x9a, 0x16, 0x76, 0xf4, 0x6d, 0x3b, 0x0a, 0xfb, 0x5f,
+		0x39, 0xd6, 0x05, 0x10, 0x70, 0x53, 0xb7, 0x9d, 0x51, 0xea, 0xfd, 0xd7, 0x02, 0x2c, 0x00, 0x44,
+		0x69, 0xf2, 0xfb, 0x0a, 0xde, 0x8d, 0xc2, 0xfe, 0x8d, 0x50, 0x9a, 0xcb, 0x17, 0xdf, 0x0e, 0x19,
+		0xf2, 0x93, 0xbd, 0x0b, 0x18, 0x01, 0xfe, 0x5b, 0x0e, 0xf6, 0x10, 0xa4, 0x64, 0xdb, 0x69, 0x39,
+		0xed, 0x68, 0x63, 0xdf, 0xe4, 0x2d, 0x2d, 0xe4, 0x8b, 0x25, 0xc8, 0xda, 0x4e, 0xab, 0xd5, 0xe7,
+		0xf1, 0x69, 0x04, 0xfc, 0xbf, 0xfd, 0xc8, 0xdd, 0xb2, 0x70, 0x31, 0xa4, 0xb5, 0xaf, 0x5d, 0x71,
+		0x7a, 0x26, 0xbd, 0x3b, 0x12, 0xc5, 0xf0, 0x06, 0x67, 0xf0, 0x41, 0x8a, 0x8b, 0x30, 0x4e, 0xea,
+		0x22, 0x8e, 0xe0, 0xa3, 0x28, 0x7e, 0xc8, 0x0d, 0x10, 0x00, 0x95, 0x7f, 0xe6, 0x6b, 0xdf, 0x9a,
+		0x95, 0xbe, 0xf1, 0xad, 0x59, 0xe9, 0xbf, 0x7c, 0x6b, 0x56, 0xfa, 0xf0, 0xb7, 0x67, 0x0f, 0x7d,
+		0xe3, 0xdb, 0xb3, 0x87, 0xbe, 0xf9, 0xed, 0xd9, 0x43, 0xc3, 0x77, 0x89, 0x61, 0xc9, 0x5c, 0x32,
+		0xd9, 0xfe, 0xf0, 0xf3, 0x6f, 0xdd, 0x6e, 0x3b, 0x3b, 0xfd, 0xcd, 0x85, 0xa6, 0xd9, 0x3d, 0xd5,
+		0x34, 0xed, 0xae, 0x69, 0x9f, 0x0a, 0xee, 0xeb, 0xd2, 0x5f, 0xf0, 0x89, 0x0c, 0x1c, 0x63, 0xd9,
+		0x1a, 0xcb, 0x62, 0x0f, 0x8c, 0x13, 0x8d, 0xfb, 0xb3, 0xa2, 0xf7, 0x7d, 0x95, 0x67, 0x60, 0xaa,
+		0x46, 0x6a, 0x4f, 0xa2, 0x3a, 0x6f, 0xc7, 0x7a, 0xe8, 0x5b, 0x7c, 0xf3, 0x81, 0x00, 0x86, 0x9f,
+		0x6c, 0xf8, 0x93, 0x94, 0x9f, 0x95, 0x40, 0x6e, 0x34, 0xf5, 0x8e, 0x6e, 0xfd, 0xef, 0x52, 0xa1,
+		0x27, 0x00, 0xd8, 0x1d, 0x0c, 0xf7, 0xfb, 0x1b, 0xe4, 0x74, 0xcf, 0x5f, 0xb9, 0x05, 0x56, 0x12,
+		0xbd, 0xe1, 0x9e, 0xa1, 0xb2, 0xe4, 0xe7, 0xc9, 0x67, 0x01, 0xbc, 0x0c, 0x72, 0x66, 0xdd, 0x58,
+		0x2c, 0x2d, 0x97, 0x54, 0x71, 0x98, 0xde, 0x58, 0xab, 0x2e, 0xb2, 0xd7, 0xe1, 0x0f, 0x91, 0x03,
+		0x61, 0x7f, 0xa6, 0x7b, 0x2b, 0xf8, 0x30, 0x4c, 0xfa, 0xd3, 0xd9, 0xbb, 0xc9, 0xb1, 0xe2, 0x05,
+		0xb2, 0xf2, 0x21, 0xf7, 0xc0, 0x34, 0xbd, 0xd5, 0xc2, 0x2d, 0xad, 0x6d, 0xa0, 0x88, 0xf7, 0x10,
+		0x0b, 0x5f, 0xff, 0x4f, 0x49, 0x5a, 0xb5, 0x09, 0x06, 0x2c, 0x11, 0x5c, 0xcd, 0x20, 0x73, 0x68,
+		0xbb, 0xdb, 0xeb, 0x60, 0x7a, 0x7a, 0xa5, 0xb5, 0x85, 0xfd, 0xa3, 0x87, 0x67, 0xc2, 0x47, 0x4e,
+		0xc2, 0xa6, 0x3c, 0xb8, 0xdb, 0x7a, 0xc5, 0x67, 0xbc, 0x5b, 0x1a, 0xae, 0x82, 0x91, 0x8c, 0x7f,
+		0xc4, 0x35, 0x14, 0xab, 0x33, 0xa1, 0xe2, 0x32, 0x4c, 0x92, 0x97, 0x77, 0x7a, 0x01, 0xfd, 0x22,
+		0x7a, 0x84, 0xa8, 0xad, 0xcc, 0x91, 0x9e, 0x6a, 0x4f, 0x40, 0xca, 0xa6, 0x8d, 0x12, 0x45, 0x21,
+		0xd4, 0xe1, 0xe2, 0x64, 0x39, 0xcf, 0xdc, 0xc0, 0xad, 0x51, 0x04, 0xc1, 0x1f, 0x73, 0x82, 0x71,
+		0x0a, 0x13, 0xb5, 0x31, 0x60, 0x92, 0x7d, 0x7c, 0x02, 0xfb, 0x6a, 0xb3, 0xff, 0xaa, 0xf0, 0x0f,
+		0xbe, 0xf4, 0x08, 0x3d, 0x31, 0xbc, 0x27, 0xe8, 0x74, 0x43, 0x3a, 0x8b, 0x2a, 0x73, 0x6e, 0xaf,
+		0xbe, 0x18, 0x72, 0xa2, 0x3c, 0x5e, 0xef, 0xfd, 0x0b, 0xfb, 0xe7, 0xbc, 0xb0, 0xd9, 0x61, 0x1e,
+		0xee, 0x2b, 0x69, 0x82, 0xb3, 0xb2, 0x8c, 0x62, 0x19, 0x26, 0xc8, 0x21, 0xa3, 0x67, 0x9c, 0xfd,
+		0x4b, 0xf9, 0x17, 0x5f, 0x7a, 0x84, 0x75, 0x34, 0x02, 0xe2, 0xa6, 0x29, 0x57, 0xf7, 0x1a, 0xb0,
+		0x9e, 0x7f, 0x70, 0x70, 0x3c, 0x62, 0xff, 0x3d, 0x4c, 0xd9, 0x9f, 0xf2, 0xab, 0xba, 0x99, 0xa2,
+		0xff, 0x3d, 0x0a, 0x9f, 0x48, 0xc0, 0x24, 0x79, 0x2d, 0xd6, 0x3c, 0x45, 0xff, 0xe5, 0xa3, 0x52,
+		0x92, 0x3e, 0x8c, 0x70, 0x0c, 0x75, 0x96, 0x0d, 0x16, 0xd1, 0x7e, 0xfb, 0xb7, 0xbf, 0xfc, 0x5b,
+		0x49, 0x6f, 0x40, 0x29, 0xae, 0x0c, 0xde, 0x50, 0x8a, 0xe6, 0xb8, 0x29, 0x38, 0xc2, 0x17, 0x8e,
+		0x8a, 0x6f, 0x23, 0x67, 0x3b, 0x9c, 0x26, 0x6a, 0x2e, 0x12, 0x24, 0x2e, 0x82, 0xcc, 0x44, 0xcc,
+		0x69, 0x47, 0x89, 0x3b, 0xde, 0x10, 0x78, 0x36, 0x86, 0x91, 0xfb, 0x7d, 0xc5, 0x25, 0xc8, 0xb5,
+		0x4c, 0xc3, 0xd1, 0xcc, 0x6e, 0xdb, 0xc1, 0xdd, 0x9e, 0x13, 0x39, 0x93, 0xff, 0x90, 0x91, 0xa4,
+		0xd5, 0x09, 0x82, 0x5b, 0x15, 0x30, 0xa2, 0x09, 0xfb, 0x7e, 0xc5, 0x28, 0x9a, 0xfc, 0x77, 0x57,
+		0x13, 0x8a, 0x21, 0x9a, 0xdc, 0x92, 0x77, 0xd8, 0xad, 0x2b, 0xfc, 0x34, 0xd3, 0xb9, 0xce, 0xbc,
+		0xc0, 0xf5, 0x8e, 0x7f, 0x9d, 0x80, 0x59, 0x2e, 0xbc, 0xa9, 0xdb, 0xf8, 0xd4, 0xd5, 0xd3, 0x9b,
+		0xd8, 0xd1, 0x4f, 0x9f, 0x6a, 0x9a, 0x6d, 0x83, 0xbb, 0xca, 0x14, 0xcb, 0x5f, 0x20, 0xf9, 0x0b,
+		0x3c, 0x7f, 0x66, 0xe8, 0x01, 0xe7, 0xcc, 0xde, 0xd3, 0xe0, 0xcc, 0xa0, 0x0f, 0x2a, 0x1d, 0x48,
+		0x2c, 0x9a, 0x6d, 0xfa, 0xee, 0x4d, 0x0b, 0x1b, 0x66, 0x97, 0x4f, 0x48, 0xec, 0x01, 0x5d, 0x80,
+		0x94, 0xde, 0x35, 0xfb, 0x86, 0xc3, 0x26, 0xa3, 0xf2, 0x23, 0x5f, 0xbb, 0x31, 0x77, 0xe8, 0xcf,
+		0x6e, 0xcc, 0x1d, 0x66, 0xb4, 0x76, 0xeb, 0xca, 0x42, 0xdb, 0x3c, 0xd5, 0xd5, 0x9d, 0x1d, 0x32,
+		0x04, 0xfc, 0xe9, 0x97, 0x1f, 0x06, 0x5e, 0x5e, 0xcd, 0x70, 0x3e, 0xfb, 0xda, 0xef, 0x9c, 0x94,
+		0x54, 0x8e, 0x2f, 0x26, 0x5e, 0xff, 0xd4, 0x9c, 0xa4, 0xf4, 0x60, 0xac, 0x82, 0x9b, 0xfb, 0x14,
+		0x58, 0x0b, 0x15, 0x78, 0x9a, 0x17, 0x78, 0x7c, 0xb0, 0x40, 0x76, 0xa7, 0xbf, 0x82, 0x9b, 0xbe,
+		0x62, 0x2b, 0xb8, 0x19, 0x2a, 0xb1, 0x06, 0xe9, 0x9a, 0xe1, 0xb0, 0x97, 0xf0, 0xdf, 0x0e, 0xf1,
+		0xb6, 0xc1, 0xde, 0xdd, 0xcc, 0x94, 0x1f, 0x3c, 0x40, 0x55, 0x54, 0x82, 0x53, 0x56, 0x21, 0x5d,
+		0xc1, 0x4d, 0x46, 0xb5, 0x08, 0xf1, 0x16, 0x6e, 0x16, 0xa4, 0x5b, 0x55, 0x92, 0xa0, 0xcb, 0x95,
+		0x6f, 0xfe, 0xc5, 0xec, 0xa1, 0x97, 0xbe, 0x35, 0x7b, 0x68, 0x4f, 0x77, 0x52, 0xa2, 0xdd, 0xc9,
+		0xf5, 0xa2, 0xff, 0x29, 0xc1, 0x31, 0xe6, 0xc9, 0xde, 0x48, 0xa2, 0x1b, 0xbb, 0x7b, 0x7d, 0x5f,
+		0xef, 0x2c, 0xc4, 0x4b, 0xc6, 0x2e, 0x3a, 0xc6, 0x96, 0x78, 0x5a, 0xdf, 0xea, 0xf0, 0x96, 0x18,
+		0x23, 0xcf, 0x1b, 0x56, 0x27, 0xf8, 0x3a, 0xd6, 0x38, 0x7f, 0x1d, 0xab, 0xfc, 0x21, 0xe9, 0x60,
+		0xb1, 0x5c, 0xba, 0x64, 0xec, 0x52, 0x9b, 0xad, 0x49, 0xcf, 0x3f, 0x14, 0x79, 0xd2, 0x7f, 0xc5,
+		0x30, 0xaf, 0x19, 0x44, 0xed, 0xde, 0xa6, 0x38, 0xe5, 0x9f, 0x0d, 0x9f, 0xf2, 0x5f, 0xc6, 0x9d,
+		0xce, 0x33, 0x44, 0x6e, 0x3d, 0x50, 0xff, 0x5f, 0x89, 0xc1, 0xec, 0xc0, 0x48, 0xca, 0xc3, 0xe0,
+		0xbd, 0x8c, 0x50, 0x84, 0x74, 0x85, 0x8b, 0x90, 0xaf, 0xfe, 0xd9, 0xb8, 0x69, 0x1a, 0x2d, 0xf6,
+		0x5e, 0x4d, 0x5c, 0x15, 0x8f, 0xc4, 0x10, 0x86, 0x6e, 0x98, 0x36, 0xff, 0xa8, 0x05, 0x7b, 0x28,
+		0x7f, 0xe2, 0x80, 0x86, 0x98, 0x10, 0x25, 0x09, 0x6b, 0x9c, 0x1e, 0xd1, 0x1a, 0xa2, 0x12, 0x81,
+		0xbb, 0x0f, 0xa3, 0x5a, 0xe5, 0xa3, 0x31, 0x98, 0x0b, 0x5b, 0x85, 0xac, 0x2d, 0x6c, 0x47, 0xef,
+		0xf6, 0xf6, 0x32, 0xcb, 0x53, 0x90, 0x59, 0x17, 0x32, 0x07, 0xb6, 0xcb, 0xab, 0x07, 0xb4, 0x4b,
+		0xce, 0x2d, 0x4a, 0x18, 0xe6, 0xcc, 0x88, 0x86, 0x71, 0xeb, 0x71, 0x4b, 0x96, 0xf9, 0xfa, 0x3a,
+		0xcc, 0xb7, 0x8d, 0xb6, 0xd3, 0xd6, 0x4f, 0x75, 0x6d, 0x47, 0xbf, 0xd2, 0x36, 0xb6, 0x4f, 0x5d,
+		0x3d, 0x7d, 0x8a, 0xff, 0xe4, 0xa6, 0x41, 0x4c, 0x62, 0x41, 0x48, 0x2c, 0x5c, 0x3d, 0x3d, 0x13,
+		0x31, 0x56, 0xef, 0x37, 0x00, 0xef, 0x31, 0x62, 0xef, 0xd9, 0x6d, 0x67, 0x22, 0x3c, 0x7a, 0x26,
+		0xaa, 0x6d, 0x95, 0x0f, 0xc5, 0x20, 0x4f, 0x6e, 0x84, 0xb5, 0x6d, 0xf6, 0xd5, 0x2f, 0x07, 0x93,
+		0x57, 0x5c, 0x12, 0x96, 0xee, 0xf0, 0xb5, 0x47, 0xf9, 0xde, 0x11, 0x46, 0x2f, 0x95, 0x02, 0xd0,
+		0x3b, 0x21, 0xdd, 0xd5, 0xaf, 0x6b, 0x14, 0xcc, 0xc6, 0xe7, 0xb3, 0x23, 0x80, 0x6f, 0xde, 0x98,
+		0xcb, 0xef, 0xea, 0xdd, 0x4e, 0x51, 0x11, 0x60, 0x45, 0x1d, 0xeb, 0xea, 0xd7, 0x89, 0x32, 0x08,
+		0xd3, 0xcb, 0x90, 0x5a, 0x73, 0x47, 0x37, 0xb6, 0x31, 0x63, 0xa6, 0x0b, 0xc8, 0xf2, 0xdb, 0x47,
+		0x63, 0x3e, 0xe2, 0x31, 0xfb, 0x38, 0x14, 0x75, 0xa2, 0xab, 0x5f, 0x5f, 0xa4, 0x09, 0xa4, 0x98,
+		0x62, 0xfa, 0x95, 0x4f, 0xcd, 0x1d, 0xa2, 0x13, 0xc2, 0xbf, 0x97, 0x00, 0x3c, 0x83, 0xa0, 0x67,
+		0x41, 0x6e, 0xba, 0x4f, 0x14, 0x2b, 0x5e, 0xab, 0xbb, 0x77, 0x61, 0xb0, 0xed, 0x17, 0x42, 0xa6,
+		0x2c, 0xa7, 0x89, 0x96, 0xdf, 0xb8, 0x31, 0x27, 0xa9, 0xf9, 0x66, 0xc8, 0xca, 0x3f, 0x0d, 0xd9,
+		0x7e, 0xaf, 0xa5, 0x3b, 0x58, 0x23, 0x6d, 0xc2, 0xdf, 0xbe, 0x9a, 0x19, 0x08, 0x36, 0x5c, 0xef,
+		0x2f, 0xcf, 0x12, 0xae, 0x9b, 0x37, 0xe6, 0x10, 0xab, 0x92, 0x0f, 0xac, 0x7c, 0xf8, 0xcf, 0xe7,
+		0x24, 0x15, 0x58, 0x0a, 0x01, 0xf8, 0xea, 0xf3, 0x75, 0xfa, 0x29, 0x36, 0x6f, 0x89, 0x58, 0x80,
+		0xb1, 0xae, 0x69, 0xb4, 0xaf, 0xf0, 0x6f, 0xb1, 0x65, 0x54, 0xf1, 0x48, 0xbe, 0xfb, 0xc5, 0xde,
+		0x02, 0x71, 0x76, 0xc5, 0x77, 0xbf, 0xc4, 0x33, 0x41, 0x5d, 0xc3, 0x9b, 0x76, 0x5b, 0x98, 0x5f,
+		0x15, 0x8f, 0xe8, 0x3c, 0xf9, 0xf2, 0x4b, 0xb3, 0x6f, 0xb5, 0x9d, 0x5d, 0xf2, 0x96, 0x9d, 0x43,
+		0x5e, 0x06, 0xa3, 0x77, 0x6d, 0xca, 0xc7, 0x6f, 0xde, 0x98, 0x3b, 0xca, 0x74, 0x0d, 0x4b, 0x28,
+		0x6a, 0x5e, 0x24, 0x2d, 0xb2, 0x14, 0x52, 0x42, 0x0b, 0x3b, 0x7a, 0xbb, 0xc3, 0xae, 0x82, 0x66,
+		0x54, 0xf1, 0xe8, 0xab, 0xcb, 0x57, 0x33, 0x90, 0xe1, 0x57, 0xab, 0x4d, 0x8b, 0x94, 0x6c, 0xf6,
+		0xb0, 0xa5, 0xd3, 0x4f, 0x26, 0xb4, 0x5a, 0x16, 0xb6, 0xed, 0x82, 0x14, 0x2e, 0x39, 0x2c, 0xa1,
+		0xa8, 0x79, 0x91, 0x54, 0x62, 0x29, 0xc8, 0x21, 0x4d, 0x6c, 0xd8, 0xd8, 0xb0, 0xfb, 0xb6, 0xd6,
+		0xeb, 0x6f, 0x5e, 0xc1, 0xbb, 0xbc, 0x35, 0xa6, 0x07, 0x5a, 0xa3, 0x64, 0xec, 0x96, 0x1f, 0xf5,
+		0xd8, 0xc3, 0x38, 0xe5, 0x8f, 0xbe, 0xfc, 0xf0, 0x34, 0xef, 0xd1, 0x4d, 0x6b, 0xb7, 0xe7, 0x98,
+		0x0b, 0x6b, 0xfd, 0xcd, 0x67, 0xf0, 0xae, 0x9a, 0x77, 0x45, 0xd7, 0xa8, 0x24, 0xf9, 0x08, 0xc0,
+		0x0b, 0x7a, 0xbb, 0x23, 0x5e, 0x6a, 0x54, 0xf9, 0x13, 0x3a, 0x0b, 0x29, 0xdb, 0xd1, 0x9d, 0xbe,
+		0xcd, 0xef, 0x0d, 0xcf, 0x0e, 0x73, 0xb3, 0xb2, 0x69, 0xb4, 0x1a, 0x54, 0x4a, 0xe5, 0xd2, 0xc8,
+		0x81, 0x94, 0x63, 0x5e, 0xc1, 0x86, 0xcd, 0x3f, 0x76, 0x79, 0x6c, 0x61, 0x48, 0x48, 0xb8, 0x40,
+		0x42, 0xab, 0x72, 0x89, 0x3b, 0xd2, 0x04, 0xab, 0x04, 0x83, 0x29, 0x9f, 0xfb, 0xf3, 0xb9, 0x13,
+		0xd1, 0xe1, 0x04, 0x65, 0xb0, 0x55, 0x5e, 0x16, 0xfa, 0xa8, 0x04, 0x72, 0x0b, 0x77, 0xf0, 0x36,
+		0xb5, 0x31, 0xf9, 0xf2, 0x09, 0xb6, 0xf9, 0xe7, 0x43, 0xef, 0x1a, 0xaa, 0x00, 0x0f, 0xef, 0xca,
+		0x75, 0xae, 0x03, 0x37, 0x64, 0x98, 0x83, 0x68, 0x33, 0x42, 0xac, 0x2c, 0xe8, 0x6c, 0x35, 0xef,
+		0x32, 0x34, 0x28, 0x01, 0x5a, 0x0a, 0x6e, 0x96, 0x88, 0x2f, 0x6d, 0x0e, 0x31, 0xa6, 0xaf, 0x77,
+		0x94, 0x13, 0x44, 0xad, 0xe0, 0x9e, 0xca, 0x79, 0x72, 0x66, 0xb1, 0x69, 0x1a, 0xf4, 0x92, 0xed,
+		0x0e, 0x6e, 0x6f, 0xef, 0x38, 0xf4, 0x45, 0xa3, 0xb8, 0xdf, 0xcd, 0xc2, 0x12, 0x8a, 0x9a, 0x77,
+		0x93, 0x2e, 0xd0, 0x14, 0xd4, 0x82, 0x9c, 0x27, 0x45, 0xbb, 0x7c, 0x26, 0xb2, 0xcb, 0xdf, 0xc3,
+		0xad, 0x74, 0x38, 0x5c, 0x8a, 0xd7, 0xeb, 0x27, 0xdc, 0x44, 0x02, 0x23, 0x9f, 0x28, 0xf5, 0x06,
+		0x1a, 0xfa, 0x39, 0x86, 0xec, 0x99, 0xd9, 0xfd, 0x47, 0x2a, 0x5e, 0x69, 0x1f, 0x0e, 0xbd, 0x2c,
+		0xc1, 0xc4, 0x55, 0xd3, 0x21, 0x25, 0xf5, 0xcc, 0x6b, 0xd8, 0xb2, 0x0b, 0xd9, 0x28, 0xa7, 0xba,
+		0xc0, 0x55, 0x9d, 0x66, 0xaa, 0x06, 0xd0, 0x07, 0xf3, 0xad, 0x71, 0x86, 0x5d, 0xa3, 0x50, 0x74,
+		0x19, 0xc6, 0xfd, 0x5c, 0xec, 0x7b, 0xba, 0xe5, 0xc7, 0xf6, 0x8d, 0xce, 0x6f, 0xde, 0x98, 0x9b,
+		0x1a, 0x54, 0x43, 0x51, 0xb3, 0x3e, 0x66, 0xf4, 0x36, 0x38, 0xee, 0xd9, 0xd3, 0x34, 0xb4, 0x1d,
+		0xb3, 0xd3, 0xd2, 0x2c, 0xbc, 0xa5, 0x35, 0xe9, 0xfa, 0x62, 0x82, 0xc6, 0x32, 0x47, 0x5d, 0x91,
+		0x55, 0xe3, 0x82, 0xd9, 0x69, 0xa9, 0x78, 0x6b, 0x91, 0x64, 0x93, 0x77, 0xc5, 0x3c, 0x74, 0xbb,
+		0x45, 0xbf, 0xe6, 0x9b, 0x50, 0xb3, 0x6e, 0x5a, 0xad, 0x55, 0x1c, 0x7f, 0xf9, 0x53, 0x73, 0x87,
+		0xf8, 0xd8, 0x75, 0x48, 0x39, 0x0b, 0xe3, 0x97, 0xf4, 0x0e, 0x1f, 0x73, 0xb0, 0x8d, 0xee, 0x82,
+		0x8c, 0x2e, 0x1e, 0xe8, 0x75, 0xe8, 0x8c, 0xea, 0x25, 0xb0, 0x31, 0xef, 0xa5, 0xff, 0x3c, 0x2f,
+		0x91, 0x17, 0xbc, 0x53, 0x95, 0x4b, 0x6b, 0x7a, 0xdb, 0x42, 0x35, 0x98, 0x74, 0xbd, 0x3c, 0x34,
+		0xe2, 0xdd, 0x75, 0xf3, 0xc6, 0x5c, 0x21, 0xdc, 0x95, 0xdc, 0x21, 0xcf, 0xeb, 0xa2, 0x62, 0xcc,
+		0xab, 0xc1, 0xe4, 0x55, 0x31, 0x90, 0xba, 0x54, 0xb1, 0x30, 0xd5, 0x80, 0x88, 0xa2, 0xca, 0x6e,
+		0x1a, 0xa7, 0x0a, 0x55, 0xb3, 0x04, 0x63, 0x4c, 0x5b, 0x1b, 0x9d, 0x85, 0x64, 0x8f, 0xfc, 0xe0,
+		0x97, 0xc8, 0x67, 0x86, 0xf6, 0x3d, 0x2a, 0xcb, 0x3d, 0x90, 0x89, 0x2b, 0x1f, 0x89, 0x01, 0x54,
+		0x2e, 0x5d, 0x5a, 0xb7, 0xda, 0xbd, 0x0e, 0x76, 0x6e, 0x67, 0xad, 0xd7, 0xe1, 0xb0, 0x57, 0x25,
+		0xdb, 0x6a, 0x86, 0x6a, 0x3e, 0x7f, 0xf3, 0xc6, 0xdc, 0x5d, 0xe1, 0x9a, 0xfb, 0xc4, 0x14, 0x75,
+		0xca, 0x4d, 0x6f, 0x58, 0xcd, 0xa1, 0xac, 0x2d, 0xdb, 0x71, 0x59, 0xe3, 0x7b, 0xb3, 0xfa, 0xc4,
+		0xfc, 0xac, 0x15, 0xdb, 0x19, 0x6e, 0xd6, 0x55, 0xc8, 0x7a, 0x26, 0xb1, 0xd1, 0x3b, 0x20, 0xed,
+		0xf0, 0xdf, 0xdc, 0xba, 0xb3, 0xc3, 0xad, 0x2b, 0x20, 0xdc, 0xc2, 0x2e, 0x4a, 0xf9, 0x3c, 0x31,
+		0x32, 0xb3, 0x0f, 0xe9, 0xf0, 0x77, 0xa4, 0x6b, 0xa1, 0x17, 0x21, 0xc5, 0xa7, 0x94, 0xf8, 0x08,
+		0x53, 0x4a, 0x25, 0x38, 0xad, 0xdd, 0xe2, 0x44, 0xc2, 0x4b, 0x0c, 0xd9, 0xff, 0x17, 0x63, 0xe4,
+		0xa5, 0x5c, 0xde, 0xb7, 0xef, 0x78, 0xbb, 0x2d, 0xc3, 0x18, 0x36, 0x1c, 0xab, 0xed, 0x1a, 0xee,
+		0xa1, 0x61, 0xde, 0x31, 0xa4, 0x3e, 0xf4, 0x3b, 0x50, 0xdc, 0x57, 0x04, 0x45, 0xc8, 0x12, 0x7f,
+		0x90, 0x80, 0xc2, 0x5e, 0x48, 0xb4, 0x08, 0xf9, 0xa6, 0x85, 0x69, 0x82, 0x98, 0x2a, 0xe9, 0x9a,
+		0xb0, 0x3c, 0xe3, 0x85, 0xe2, 0x21, 0x01, 0x45, 0xcd, 0x89, 0x14, 0x3e, 0x51, 0x6e, 0x03, 0x89,
+		0x95, 0x89, 0x9b, 0x12, 0xa9, 0x11, 0x83, 0x63, 0x85, 0x37, 0xbe, 0x28, 0x24, 0x48, 0xc0, 0xa6,
+		0xca, 0x9c, 0x97, 0x4a, 0xe7, 0xca, 0x0f, 0x49, 0x90, 0x67, 0x76, 0xe9, 0x68, 0x9b, 0x7a, 0x47,
+		0x27, 0xaf, 0xef, 0xc6, 0xa3, 0xe6, 0xb9, 0x8b, 0xc1, 0x82, 0x42, 0xf8, 0x83, 0xcd, 0x74, 0x39,
+		0x8e, 0x2e, 0x33, 0x30, 0xba, 0x06, 0x63, 0x42, 0x8f, 0x44, 0x94, 0x1e, 0x65, 0xae, 0x47, 0x8e,
+		0xe9, 0x71, 0x4b, 0xe5, 0x8b, 0xd2, 0x06, 0x66, 0xb3, 0xe4, 0xc0, 0x6c, 0x16, 0x35, 0x5d, 0xa6,
+		0xf6, 0x9d, 0x2e, 0x7d, 0x31, 0xfc, 0x1f, 0x26, 0x60, 0x52, 0xc5, 0xad, 0xff, 0xe7, 0x38, 0xb7,
+		0xd3, 0x71, 0xde, 0x27, 0x01, 0xb0, 0x71, 0x8b, 0xcc, 0x2c, 0x85, 0xc4, 0x08, 0xa3, 0xa5, 0x88,
+		0xd7, 0x26, 0xfd, 0xa3, 0x25, 0x41, 0x1f, 0x78, 0xc4, 0xcc, 0x30, 0x6c, 0xc5, 0x76, 0x7e, 0x92,
+		0x7e, 0xf4, 0x67, 0x31, 0x18, 0xf7, 0xfb, 0xd1, 0xff, 0xa5, 0x71, 0x02, 0xaa, 0x7a, 0x63, 0x7d,
+		0x82, 0xff, 0x41, 0x82, 0x21, 0x63, 0xfd, 0x40, 0x8f, 0xdb, 0x7f, 0x90, 0xff, 0x4c, 0x1a, 0x52,
+		0x6b, 0xba, 0xa5, 0x77, 0x6d, 0xd4, 0x1c, 0x58, 0xb6, 0xb0, 0xed, 0x8f, 0x63, 0x83, 0x7f, 0x72,
+		0x80, 0x6f, 0x3d, 0x45, 0xac, 0x5a, 0x5e, 0x19, 0xb2, 0x6a, 0x79, 0x07, 0x90, 0x37, 0x58, 0x35,
+		0xb7, 0x7e, 0xcc, 0xd2, 0x13, 0xe5, 0x63, 0x1e, 0x4b, 0x30, 0x9f, 0x6d, 0xe0, 0xb8, 0x7b, 0x01,
+		0x64, 0xcf, 0x2a, 0x4b, 0x24, 0xbc, 0x69, 0x8f, 0xc0, 0x8f, 0x78, 0xbb, 0x25, 0xbe, 0x4c, 0x45,
+		0x85, 0xae, 0x7e, 0xbd, 0xca, 0x1e, 0xd0, 0x32, 0xa0, 0x9d, 0xb6, 0xed, 0x98, 0x56, 0xbb, 0xa9,
+		0x77, 0x34, 0xcf, 0x94, 0x04, 0x7f, 0xf7, 0xcd, 0x1b, 0x73, 0xc7, 0x18, 0x7e, 0x50, 0x46, 0x51,
+		0x27, 0xbd, 0x44, 0xc1, 0xf6, 0x04, 0x64, 0x49, 0xbd, 0x34, 0x7a, 0x5a, 0xc1, 0x96, 0xe2, 0x19,
+		0xbf, 0x1a, 0xbe, 0x4c, 0x45, 0x05, 0xf2, 0x54, 0xa1, 0x0f, 0xa8, 0x0a, 0x72, 0xb7, 0x6d, 0x68,
+		0x81, 0xa5, 0x0e, 0xe9, 0x0b, 0x09, 0xff, 0x2a, 0x33, 0x2c, 0xa1, 0xd0, 0x77, 0x87, 0x2f, 0xf9,
+		0x16, 0x35, 0xef, 0x86, 0x29, 0x22, 0x14, 0xda, 0xb2, 0x62, 0x5f, 0x69, 0x2f, 0x97, 0x46, 0xdb,
+		0x32, 0x9b, 0xf1, 0x0a, 0x0b, 0xf1, 0x28, 0xea, 0x64, 0xb7, 0x6d, 0x04, 0xf7, 0xb8, 0xd0, 0xf3,
+		0x70, 0x94, 0x2a, 0xa3, 0xd9, 0x86, 0xde, 0xb3, 0x77, 0x4c, 0x87, 0x1d, 0x16, 0x5f, 0xd5, 0x3b,
+		0x74, 0x99, 0x3c, 0x51, 0x56, 0x6e, 0xde, 0x98, 0x9b, 0x65, 0x9c, 0x7b, 0x08, 0x2a, 0xea, 0x61,
+		0x9a, 0xd3, 0xe0, 0x19, 0x35, 0x9e, 0x8e, 0x2e, 0xc3, 0x91, 0x10, 0x44, 0x34, 0x50, 0x86, 0x52,
+		0xdf, 0x73, 0xf3, 0xc6, 0xdc, 0xdd, 0x43, 0xa9, 0xdd, 0x46, 0x9a, 0x0e, 0x30, 0x8b, 0x76, 0xba,
+		0x0a, 0x87, 0x89, 0x47, 0x30, 0x90, 0x7f, 0x73, 0x91, 0x7e, 0x8d, 0xbe, 0xbc, 0x38, 0x9a, 0xa5,
+		0xee, 0xf2, 0x7c, 0x6b, 0x80, 0x49, 0x51, 0x51, 0x57, 0xbf, 0x4e, 0x5b, 0xc5, 0xdb, 0x67, 0x44,
+		0x2d, 0x90, 0xaf, 0xe0, 0x5d, 0xcd, 0xe2, 0x6f, 0x9b, 0x6a, 0x5b, 0x98, 0x7d, 0x88, 0x77, 0xdf,
+		0x99, 0x63, 0x2e, 0xb8, 0x57, 0x12, 0x26, 0x50, 0xd4, 0xdc, 0x15, 0xbc, 0xab, 0xf2, 0x94, 0xf3,
+		0x18, 0x23, 0x0d, 0x8e, 0xd1, 0x0d, 0x4f, 0xd3, 0x10, 0x1b, 0x53, 0x2e, 0x80, 0x7d, 0x81, 0x73,
+		0xa2, 0xfc, 0x96, 0x9b, 0x37, 0xe6, 0xe6, 0x3d, 0xf5, 0x87, 0x8a, 0x2a, 0xea, 0x11, 0xb2, 0x4b,
+		0x6a, 0x1a, 0x7c, 0xcf, 0x4a, 0x14, 0xe1, 0xdf, 0x92, 0x7b, 0x5f, 0x02, 0x8e, 0x71, 0x89, 0x67,
+		0x3c, 0x89, 0x0b, 0xb4, 0x63, 0xec, 0xde, 0xb6, 0x2d, 0xba, 0x1e, 0xe4, 0xcd, 0x4e, 0xcb, 0xaf,
+		0xe5, 0xbe, 0x3b, 0x74, 0x67, 0xbc, 0x69, 0x36, 0x04, 0xdb, 0x7b, 0x83, 0x6e, 0xc2, 0xec, 0xb4,
+		0x78, 0x45, 0xc8, 0xf6, 0x5c, 0x0f, 0xf2, 0x06, 0xbe, 0x16, 0x28, 0x31, 0x3e, 0x5a, 0x89, 0x21,
+		0xd8, 0x3e, 0x25, 0x1a, 0xf8, 0x9a, 0xaf, 0xc4, 0x23, 0x90, 0xe2, 0x91, 0x4f, 0x82, 0xce, 0x81,
+		0xa9, 0x9d, 0x3d, 0xa3, 0x9a, 0xe4, 0x9b, 0x12, 0xd5, 0x9c, 0x86, 0x38, 0x71, 0xc7, 0x54, 0x94,
+		0x3b, 0xb2, 0x99, 0x83, 0xc8, 0x16, 0xd3, 0x2f, 0x8b, 0x19, 0xe3, 0xb7, 0x25, 0x40, 0x6b, 0x98,
+		0x8e, 0xe8, 0xd4, 0xe7, 0x37, 0xe8, 0x5e, 0xf4, 0x6d, 0x73, 0x80, 0x69, 0x48, 0xb2, 0x31, 0x31,
+		0x46, 0x6d, 0xc3, 0x1e, 0x50, 0x11, 0xc6, 0xd9, 0x47, 0x3a, 0xf9, 0x80, 0x19, 0xa7, 0x21, 0xe3,
+		0x51, 0x6f, 0xfb, 0xc7, 0x9f, 0xab, 0xa8, 0x59, 0xf6, 0x48, 0xf5, 0x53, 0xbe, 0x18, 0x87, 0x9c,
+		0x3b, 0x7f, 0xd0, 0xa4, 0xdb, 0xb8, 0xa1, 0x2c, 0xb6, 0x62, 0x63, 0x3f, 0xc1, 0xad, 0xd8, 0xc1,
+		0x3d, 0xbb, 0xf8, 0x9d, 0xb2, 0x67, 0x97, 0xb8, 0x4d, 0x7b, 0x76, 0xca, 0xef, 0xc7, 0x60, 0x62,
+		0xcd, 0x3f, 0x9e, 0x93, 0xbf, 0xf8, 0xe6, 0x0b, 0x4a, 0xf6, 0xeb, 0x12, 0xf4, 0x28, 0x86, 0x3a,
+		0x3e, 0x45, 0xa0, 0x4f, 0x4b, 0x30, 0xed, 0x2f, 0x4a, 0xbb, 0x46, 0xfb, 0x9b, 0x68, 0xb4, 0xfd,
+		0xa3, 0x67, 0x95, 0x5b, 0xee, 0xf8, 0xa0, 0xca, 0x82, 0xe7, 0xc0, 0x71, 0x34, 0xf2, 0xd5, 0xf4,
+		0x32, 0xe3, 0x40, 0x17, 0x00, 0x7c, 0x41, 0x11, 0x6b, 0x50, 0x65, 0x58, 0x80, 0x17, 0x74, 0x65,
+		0xb1, 0xa5, 0xeb, 0x61, 0x95, 0x1f, 0x4a, 0xbe, 0x1d, 0x8c, 0x77, 0xf6, 0x71, 0x1f, 0xb3, 0x95,
+		0xd7, 0x9d, 0xb9, 0x83, 0x71, 0x01, 0x92, 0xec, 0x1b, 0xd5, 0x71, 0xfe, 0xa9, 0xc0, 0x83, 0xef,
+		0x5f, 0x30, 0x02, 0xe5, 0x4f, 0x63, 0x70, 0xc4, 0x1f, 0xfd, 0xbe, 0x39, 0x55, 0xff, 0x3f, 0x69,
+		0xc5, 0x50, 0x12, 0xb6, 0x65, 0x1f, 0x6f, 0x39, 0xd0, 0x7a, 0x81, 0x1b, 0xf5, 0x2f, 0x24, 0x98,
+		0x74, 0xcd, 0xbf, 0xa2, 0x3b, 0xf4, 0x24, 0x6f, 0xd8, 0x4c, 0x25, 0xbd, 0x29, 0x33, 0x55, 0xd3,
+		0x77, 0x0b, 0x28, 0x62, 0x88, 0xa3, 0x37, 0x92, 0x0e, 0x36, 0x9e, 0x32, 0x6a, 0xe5, 0x8f, 0x25,
+		0x40, 0x9e, 0x67, 0xa9, 0xd8, 0xee, 0x99, 0x86, 0x4d, 0x0f, 0x58, 0x3c, 0xd3, 0xf0, 0xfa, 0x0d,
+		0xdf, 0x7c, 0x75, 0xa5, 0x44, 0x6f, 0xf4, 0x70, 0x08, 0x7b, 0x3b, 0x3d, 0x6f, 0x42, 0x15, 0x04,
+		0xb7, 0x1b, 0xa7, 0x1d, 0x52, 0xbe, 0x23, 0xc1, 0xb1, 0x81, 0x46, 0x75, 0x2b, 0xf5, 0x3c, 0x20,
+		0xcb, 0x97, 0xc9, 0xbf, 0x0f, 0x2f, 0x1d, 0xdc, 0x3f, 0x26, 0xad, 0x70, 0xc6, 0x4f, 0xaa, 0xaa,
+		0xec, 0x3a, 0xd7, 0xef, 0x49, 0x30, 0xed, 0xd7, 0xcd, 0xad, 0xe1, 0x45, 0x18, 0xf7, 0xab, 0xc6,
+		0xeb, 0x36, 0x1f, 0x55, 0x37, 0x5e, 0xad, 0x00, 0x16, 0xad, 0x78, 0x4b, 0x6e, 0xf1, 0x31, 0xc6,
+		0x51, 0x4c, 0x24, 0x74, 0x09, 0x2f, 0xbd, 0x13, 0xb4, 0x81, 0xfe, 0x47, 0x1c, 0x12, 0x6b, 0xa6,
+		0xd9, 0x41, 0x9f, 0x95, 0x60, 0xd2, 0x30, 0x1d, 0x8d, 0xf4, 0x2e, 0xdc, 0xd2, 0x78, 0x24, 0x21,
+		0x45, 0x99, 0x4e, 0x27, 0xa4, 0xdf, 0xbf, 0x31, 0x37, 0x88, 0xf5, 0x86, 0xb2, 0x81, 0xac, 0x83,
+		0x4d, 0xf2, 0x79, 0xc3, 0x74, 0xca, 0x14, 0xbe, 0x4e, 0xd1, 0xe8, 0x63, 0x12, 0x4c, 0x04, 0xd5,
+		0x8c, 0x6c, 0xe1, 0xe7, 0xb8, 0x9a, 0x13, 0x61, 0x15, 0xa7, 0xbd, 0x05, 0xf2, 0x2d, 0xaa, 0x37,
+		0xbe, 0xe9, 0xd7, 0x6d, 0xcf, 0xe9, 0x3d, 0x7e, 0xe7, 0x4c, 0xef, 0xc5, 0x34, 0x71, 0xda, 0xbf,
+		0x21, 0x8e, 0xfb, 0xdd, 0x38, 0x40, 0xa3, 0xa3, 0xdb, 0x3b, 0xd5, 0xab, 0xd8, 0x70, 0x50, 0x0e,
+		0x62, 0x6d, 0xf6, 0xd9, 0xde, 0x84, 0x1a, 0x6b, 0xb7, 0x6e, 0xe7, 0xd4, 0x5a, 0x83, 0xc9, 0xb6,
+		0xb1, 0x65, 0xe9, 0x4d, 0xff, 0x66, 0x2b, 0x8b, 0x9c, 0x7d, 0x54, 0x03, 0x22, 0x8a, 0x2a, 0x7b,
+		0x69, 0x7c, 0xc3, 0x75, 0xaf, 0x25, 0x8b, 0x08, 0xca, 0x92, 0x07, 0x0e, 0xca, 0xde, 0x05, 0xe3,
+		0x36, 0xb1, 0x82, 0xb6, 0xa5, 0x37, 0x1d, 0x93, 0x6d, 0x81, 0x64, 0xca, 0xc5, 0xd1, 0x96, 0xe3,
+		0x3c, 0x7e, 0xf4, 0x13, 0x28, 0x6a, 0x96, 0x3e, 0x9e, 0xa7, 0x4f, 0x64, 0xe2, 0xd8, 0xec, 0x5b,
+		0x06, 0x6e, 0x15, 0xc6, 0xa2, 0x1c, 0xf5, 0x16, 0x26, 0x0e, 0x46, 0x4d, 0x4e, 0x76, 0x1d, 0xab,
+		0x6f, 0xb0, 0x2f, 0xe9, 0xa6, 0xd9, 0xeb, 0x9c, 0x6e, 0x82, 0xf2, 0x72, 0x12, 0xa6, 0x2b, 0xee,
+		0x5d, 0x05, 0xa2, 0x5b, 0xad, 0xdb, 0x23, 0x17, 0x60, 0x9e, 0x86, 0x1c, 0xd3, 0x1c, 0x13, 0x0f,
+		0xd0, 0x44, 0xf3, 0xfb, 0xf7, 0xc0, 0x82, 0xf9, 0x8a, 0x3a, 0x6e, 0xbb, 0x1e, 0x53, 0x6b, 0x0d,
+		0x0f, 0x67, 0x62, 0xb7, 0x2f, 0x92, 0x8b, 0xdf, 0x92, 0xbb, 0xdd, 0x7e, 0x1f, 0xb9, 0x12, 0x98,
+		0x81, 0x53, 0xb7, 0xbf, 0x21, 0xfd, 0x13, 0x75, 0x1b, 0x32, 0xee, 0x56, 0xe5, 0x9b, 0xe1, 0x34,
+		0x1e, 0x3b, 0x32, 0x43, 0x53, 0x54, 0xfa, 0xf6, 0x97, 0x16, 0x28, 0xe0, 0xe4, 0x57, 0x24, 0x00,
+		0xef, 0x26, 0x11, 0x7a, 0x08, 0x8e, 0x96, 0x57, 0xeb, 0x15, 0xad, 0xb1, 0x5e, 0x5a, 0xdf, 0x68,
+		0x04, 0xdf, 0xfa, 0x99, 0xc9, 0xbf, 0xff, 0xd5, 0xf9, 0xec, 0x86, 0x61, 0xf7, 0x70, 0x93, 0xfe,
+		0x71, 0x10, 0x74, 0x1f, 0x4c, 0x07, 0xa5, 0xc9, 0x13, 0xf9, 0xe4, 0xe4, 0xcc, 0xf8, 0xfb, 0x5f,
+		0x9d, 0x4f, 0xb3, 0xe8, 0x10, 0xb7, 0xd0, 0x09, 0x38, 0x3c, 0x28, 0x47, 0xde, 0x18, 0x8a, 0xcd,
+		0x4c, 0xbc, 0xff, 0xd5, 0xf9, 0x8c, 0x1b, 0x46, 0x22, 0x05, 0x90, 0x5f, 0x92, 0xf3, 0xc5, 0x67,
+		0xe0, 0xfd, 0xaf, 0xce, 0xa7, 0xd8, 0xbc, 0x33, 0x93, 0x78, 0xf9, 0x37, 0x67, 0x0f, 0x9d, 0x7c,
+		0x17, 0x40, 0xcd, 0x1d, 0x8b, 0xc8, 0x17, 0x45, 0x6b, 0xf5, 0xf3, 0x6a, 0x69, 0x91, 0x7f, 0x6b,
+		0xcd, 0xa7, 0x76, 0x28, 0x8f, 0xfd, 0x9d, 0x47, 0xad, 0x51, 0x5b, 0xaa, 0xb3, 0xaf, 0xa1, 0x05,
+		0xf2, 0x2e, 0xb3, 0xbf, 0x47, 0x10, 0x2b, 0x5f, 0xd8, 0xf3, 0xbe, 0xf5, 0x82, 0xcf, 0xd2, 0x6c,
+		0xca, 0x7f, 0xb8, 0xa3, 0x6f, 0xda, 0xfc, 0xf7, 0xa9, 0xeb, 0xde, 0x4d, 0xd1, 0xc0, 0xdd, 0xeb,
+		0xff, 0x35, 0x00, 0x17, 0xba, 0x9c, 0x4d, 0xe3, 0x7c, 0x00, 0x00,
+	}
+	r := bytes.NewReader(gzipped)
+	gzipr, err := compress_gzip.NewReader(r)
+	if err != nil {
+		panic(err)
+	}
+	ungzipped, err := io_ioutil.ReadAll(gzipr)
+	if err != nil {
+		panic(err)
+	}
+	if err := github_com_cosmos_gogoproto_proto.Unmarshal(ungzipped, d); err != nil {
+		panic(err)
+	}
+	return d
+}
+func (this *CommissionRates) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommissionRates)
+	if !ok {
+		that2, ok := that.(CommissionRates)
+		if ok {
 			that1 = &that2
 		} else {
 			return false
@@ -2095,369 +2701,318 @@ func (this *Pool) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.NotBondedTokens) != len(that1.NotBondedTokens) {
+	if !this.Rate.Equal(that1.Rate) {
 		return false
 	}
-	for i := range this.NotBondedTokens {
-		if !this.NotBondedTokens[i].Equal(&that1.NotBondedTokens[i]) {
-			return false
-		}
+	if !this.MaxRate.Equal(that1.MaxRate) {
+		return false
 	}
-	if len(this.BondedTokens) != len(that1.BondedTokens) {
+	if !this.MaxChangeRate.Equal(that1.MaxChangeRate) {
 		return false
 	}
-	for i := range this.BondedTokens {
-		if !this.BondedTokens[i].Equal(&that1.BondedTokens[i]) {
+	return true
+}
+func (this *Commission) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Commission)
+	if !ok {
+		that2, ok := that.(Commission)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if len(this.VotingPowerWeights) != len(that1.VotingPowerWeights) {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	for i := range this.VotingPowerWeights {
-		if !this.VotingPowerWeights[i].Equal(&that1.VotingPowerWeights[i]) {
-			return false
-		}
+	if !this.CommissionRates.Equal(&that1.CommissionRates) {
+		return false
+	}
+	if !this.UpdateTime.Equal(that1.UpdateTime) {
+		return false
 	}
 	return true
 }
-func (m *CommissionRates) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *Description) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CommissionRates) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *CommissionRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MaxChangeRate.Size()
-		i -= size
-		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	that1, ok := that.(*Description)
+	if !ok {
+		that2, ok := that.(Description)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.MaxRate.Size()
-		i -= size
-		if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Rate.Size()
-		i -= size
-		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if this.Moniker != that1.Moniker {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *Commission) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Identity != that1.Identity {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Commission) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Commission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime):])
-	if err1 != nil {
-		return 0, err1
+	if this.Website != that1.Website {
+		return false
 	}
-	i -= n1
-	i = encodeVarintStaking(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.CommissionRates.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if this.SecurityContact != that1.SecurityContact {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *Description) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Details != that1.Details {
+		return false
 	}
-	return dAtA[:n], nil
+	return true
 }
+func (this *UnbondingDelegationEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Description) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Description) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Details) > 0 {
-		i -= len(m.Details)
-		copy(dAtA[i:], m.Details)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Details)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.SecurityContact) > 0 {
-		i -= len(m.SecurityContact)
-		copy(dAtA[i:], m.SecurityContact)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.SecurityContact)))
-		i--
-		dAtA[i] = 0x22
+	that1, ok := that.(*UnbondingDelegationEntry)
+	if !ok {
+		that2, ok := that.(UnbondingDelegationEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Website) > 0 {
-		i -= len(m.Website)
-		copy(dAtA[i:], m.Website)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Website)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Identity) > 0 {
-		i -= len(m.Identity)
-		copy(dAtA[i:], m.Identity)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Identity)))
-		i--
-		dAtA[i] = 0x12
+	if this.CreationHeight != that1.CreationHeight {
+		return false
 	}
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
-		copy(dAtA[i:], m.Moniker)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Moniker)))
-		i--
-		dAtA[i] = 0xa
+	if !this.CompletionTime.Equal(that1.CompletionTime) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Validator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.InitialBalance) != len(that1.InitialBalance) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UnbondingId != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingId))
-		i--
-		dAtA[i] = 0x70
+	for i := range this.InitialBalance {
+		if !this.InitialBalance[i].Equal(&that1.InitialBalance[i]) {
+			return false
+		}
 	}
-	if m.UnbondingOnHoldRefCount != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingOnHoldRefCount))
-		i--
-		dAtA[i] = 0x68
+	if len(this.Balance) != len(that1.Balance) {
+		return false
 	}
-	{
-		size := m.VotingPower.Size()
-		i -= size
-		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	for i := range this.Balance {
+		if !this.Balance[i].Equal(&that1.Balance[i]) {
+			return false
 		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x62
-	if len(m.VotingPowers) > 0 {
-		for iNdEx := len(m.VotingPowers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VotingPowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
-		}
+	if this.UnbondingId != that1.UnbondingId {
+		return false
 	}
-	{
-		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if this.UnbondingOnHoldRefCount != that1.UnbondingOnHoldRefCount {
+		return false
+	}
+	return true
+}
+func (this *RedelegationEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RedelegationEntry)
+	if !ok {
+		that2, ok := that.(RedelegationEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x52
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime):])
-	if err4 != nil {
-		return 0, err4
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i -= n4
-	i = encodeVarintStaking(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x4a
-	if m.UnbondingHeight != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingHeight))
-		i--
-		dAtA[i] = 0x40
+	if this.CreationHeight != that1.CreationHeight {
+		return false
 	}
-	{
-		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if !this.CompletionTime.Equal(that1.CompletionTime) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x3a
-	if len(m.DelegatorShares) > 0 {
-		for iNdEx := len(m.DelegatorShares) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DelegatorShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
+	if len(this.InitialBalance) != len(that1.InitialBalance) {
+		return false
 	}
-	if len(m.Tokens) > 0 {
-		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+	for i := range this.InitialBalance {
+		if !this.InitialBalance[i].Equal(&that1.InitialBalance[i]) {
+			return false
 		}
 	}
-	if m.Status != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x20
+	if len(this.SharesDst) != len(that1.SharesDst) {
+		return false
 	}
-	if m.Jailed {
-		i--
-		if m.Jailed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	for i := range this.SharesDst {
+		if !this.SharesDst[i].Equal(&that1.SharesDst[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0x18
 	}
-	if m.ConsensusPubkey != nil {
-		{
-			size, err := m.ConsensusPubkey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintStaking(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if this.UnbondingId != that1.UnbondingId {
+		return false
 	}
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	if this.UnbondingOnHoldRefCount != that1.UnbondingOnHoldRefCount {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *ValAddresses) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *ValAddresses) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.UnbondingTime != that1.UnbondingTime {
+		return false
+	}
+	if this.MaxValidators != that1.MaxValidators {
+		return false
+	}
+	if this.MaxEntries != that1.MaxEntries {
+		return false
+	}
+	if this.HistoricalEntries != that1.HistoricalEntries {
+		return false
+	}
+	if len(this.BondDenoms) != len(that1.BondDenoms) {
+		return false
+	}
+	for i := range this.BondDenoms {
+		if this.BondDenoms[i] != that1.BondDenoms[i] {
+			return false
+		}
+	}
+	if this.MinVotingPower != that1.MinVotingPower {
+		return false
+	}
+	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
+		return false
+	}
+	if this.PowerSnapshotInterval != that1.PowerSnapshotInterval {
+		return false
+	}
+	if this.PowerSnapshotEntries != that1.PowerSnapshotEntries {
+		return false
+	}
+	if !this.MaxPowerChangeRate.Equal(that1.MaxPowerChangeRate) {
+		return false
+	}
+	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
+		return false
+	}
+	if this.MaxConsPubkeyRotations != that1.MaxConsPubkeyRotations {
+		return false
+	}
+	return true
 }
+func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ValAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintStaking(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*RedelegationEntryResponse)
+	if !ok {
+		that2, ok := that.(RedelegationEntryResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RedelegationEntry.Equal(&that1.RedelegationEntry) {
+		return false
+	}
+	if len(this.Balance) != len(that1.Balance) {
+		return false
+	}
+	for i := range this.Balance {
+		if !this.Balance[i].Equal(&that1.Balance[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *Pool) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DVPair) Marshal() (dAtA []byte, err error) {
+	that1, ok := that.(*Pool)
+	if !ok {
+		that2, ok := that.(Pool)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.NotBondedTokens) != len(that1.NotBondedTokens) {
+		return false
+	}
+	for i := range this.NotBondedTokens {
+		if !this.NotBondedTokens[i].Equal(&that1.NotBondedTokens[i]) {
+			return false
+		}
+	}
+	if len(this.BondedTokens) != len(that1.BondedTokens) {
+		return false
+	}
+	for i := range this.BondedTokens {
+		if !this.BondedTokens[i].Equal(&that1.BondedTokens[i]) {
+			return false
+		}
+	}
+	if len(this.VotingPowerWeights) != len(that1.VotingPowerWeights) {
+		return false
+	}
+	for i := range this.VotingPowerWeights {
+		if !this.VotingPowerWeights[i].Equal(&that1.VotingPowerWeights[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *CommissionRates) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2467,34 +3022,50 @@ func (m *DVPair) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DVPair) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommissionRates) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DVPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommissionRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxRate.Size()
+		i -= size
+		if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *DVPairs) Marshal() (dAtA []byte, err error) {
+func (m *Commission) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2504,34 +3075,38 @@ func (m *DVPairs) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DVPairs) MarshalTo(dAtA []byte) (int, error) {
+func (m *Commission) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DVPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Commission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Pairs) > 0 {
-		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintStaking(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CommissionRates.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *DVVTriplet) Marshal() (dAtA []byte, err error) {
+func (m *Description) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2541,41 +3116,55 @@ func (m *DVVTriplet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DVVTriplet) MarshalTo(dAtA []byte) (int, error) {
+func (m *Description) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DVVTriplet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Description) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValidatorDstAddress) > 0 {
-		i -= len(m.ValidatorDstAddress)
-		copy(dAtA[i:], m.ValidatorDstAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorDstAddress)))
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Details)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x2a
 	}
-	if len(m.ValidatorSrcAddress) > 0 {
-		i -= len(m.ValidatorSrcAddress)
-		copy(dAtA[i:], m.ValidatorSrcAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
+	if len(m.SecurityContact) > 0 {
+		i -= len(m.SecurityContact)
+		copy(dAtA[i:], m.SecurityContact)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.SecurityContact)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Website)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
 
-func (m *DVVTriplets) Marshal() (dAtA []byte, err error) {
+func (m *Validator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2585,20 +3174,40 @@ func (m *DVVTriplets) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DVVTriplets) MarshalTo(dAtA []byte) (int, error) {
+func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DVVTriplets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Triplets) > 0 {
-		for iNdEx := len(m.Triplets) - 1; iNdEx >= 0; iNdEx-- {
+	if m.UnbondingId != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.UnbondingOnHoldRefCount != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingOnHoldRefCount))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.VotingPowers) > 0 {
+		for iNdEx := len(m.VotingPowers) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Triplets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.VotingPowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2606,13 +3215,108 @@ func (m *DVVTriplets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintStaking(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintStaking(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x4a
+	if m.UnbondingHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.DelegatorShares) > 0 {
+		for iNdEx := len(m.DelegatorShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConsensusPubkey != nil {
+		{
+			size, err := m.ConsensusPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Delegation) Marshal() (dAtA []byte, err error) {
+func (m *ValAddresses) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2622,48 +3326,29 @@ func (m *Delegation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValAddresses) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Shares) > 0 {
-		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintStaking(dAtA, i, uint64(len(m.Addresses[iNdEx])))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UnbondingDelegation) Marshal() (dAtA []byte, err error) {
+func (m *DVPair) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2673,30 +3358,16 @@ func (m *UnbondingDelegation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnbondingDelegation) MarshalTo(dAtA []byte) (int, error) {
+func (m *DVPair) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DVPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
 	if len(m.ValidatorAddress) > 0 {
 		i -= len(m.ValidatorAddress)
 		copy(dAtA[i:], m.ValidatorAddress)
@@ -2714,7 +3385,7 @@ func (m *UnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *UnbondingDelegationEntry) Marshal() (dAtA []byte, err error) {
+func (m *DVPairs) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2724,44 +3395,20 @@ func (m *UnbondingDelegationEntry) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnbondingDelegationEntry) MarshalTo(dAtA []byte) (int, error) {
+func (m *DVPairs) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DVPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UnbondingOnHoldRefCount != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingOnHoldRefCount))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.UnbondingId != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Balance) > 0 {
-		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.InitialBalance) > 0 {
-		for iNdEx := len(m.InitialBalance) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Pairs) > 0 {
+		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.InitialBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Pairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2769,26 +3416,13 @@ func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 				i = encodeVarintStaking(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0xa
 		}
 	}
-	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err7 != nil {
-		return 0, err7
-	}
-	i -= n7
-	i = encodeVarintStaking(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0x12
-	if m.CreationHeight != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.CreationHeight))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RedelegationEntry) Marshal() (dAtA []byte, err error) {
+func (m *DVVTriplet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2798,71 +3432,41 @@ func (m *RedelegationEntry) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RedelegationEntry) MarshalTo(dAtA []byte) (int, error) {
+func (m *DVVTriplet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DVVTriplet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UnbondingOnHoldRefCount != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingOnHoldRefCount))
+	if len(m.ValidatorDstAddress) > 0 {
+		i -= len(m.ValidatorDstAddress)
+		copy(dAtA[i:], m.ValidatorDstAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorDstAddress)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x1a
 	}
-	if m.UnbondingId != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingId))
+	if len(m.ValidatorSrcAddress) > 0 {
+		i -= len(m.ValidatorSrcAddress)
+		copy(dAtA[i:], m.ValidatorSrcAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
 		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.SharesDst) > 0 {
-		for iNdEx := len(m.SharesDst) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SharesDst[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.InitialBalance) > 0 {
-		for iNdEx := len(m.InitialBalance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InitialBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err8 != nil {
-		return 0, err8
+		dAtA[i] = 0x12
 	}
-	i -= n8
-	i = encodeVarintStaking(dAtA, i, uint64(n8))
-	i--
-	dAtA[i] = 0x12
-	if m.CreationHeight != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.CreationHeight))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Redelegation) Marshal() (dAtA []byte, err error) {
+func (m *DVVTriplets) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2872,20 +3476,20 @@ func (m *Redelegation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Redelegation) MarshalTo(dAtA []byte) (int, error) {
+func (m *DVVTriplets) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Redelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DVVTriplets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Triplets) > 0 {
+		for iNdEx := len(m.Triplets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Triplets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2893,34 +3497,13 @@ func (m *Redelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintStaking(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.ValidatorDstAddress) > 0 {
-		i -= len(m.ValidatorDstAddress)
-		copy(dAtA[i:], m.ValidatorDstAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorDstAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ValidatorSrcAddress) > 0 {
-		i -= len(m.ValidatorSrcAddress)
-		copy(dAtA[i:], m.ValidatorSrcAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
+func (m *Delegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2930,67 +3513,48 @@ func (m *Params) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.MinCommissionRate.Size()
-		i -= size
-		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	if m.MinVotingPower != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.MinVotingPower))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.BondDenoms) > 0 {
-		for iNdEx := len(m.BondDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.BondDenoms[iNdEx])
-			copy(dAtA[i:], m.BondDenoms[iNdEx])
-			i = encodeVarintStaking(dAtA, i, uint64(len(m.BondDenoms[iNdEx])))
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.HistoricalEntries != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.HistoricalEntries))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.MaxEntries != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.MaxEntries))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x12
 	}
-	if m.MaxValidators != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.MaxValidators))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
 		i--
-		dAtA[i] = 0x10
-	}
-	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err9 != nil {
-		return 0, err9
+		dAtA[i] = 0xa
 	}
-	i -= n9
-	i = encodeVarintStaking(dAtA, i, uint64(n9))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *UnbondingDelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3000,20 +3564,20 @@ func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnbondingDelegation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Balance) > 0 {
-		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3021,23 +3585,27 @@ func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintStaking(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
 	}
-	{
-		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *RedelegationEntryResponse) Marshal() (dAtA []byte, err error) {
+func (m *UnbondingDelegationEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3047,16 +3615,26 @@ func (m *RedelegationEntryResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RedelegationEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnbondingDelegationEntry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RedelegationEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.UnbondingOnHoldRefCount != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingOnHoldRefCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UnbondingId != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingId))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Balance) > 0 {
 		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3068,23 +3646,40 @@ func (m *RedelegationEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 				i = encodeVarintStaking(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x22
 		}
 	}
-	{
-		size, err := m.RedelegationEntry.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.InitialBalance) > 0 {
+		for iNdEx := len(m.InitialBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitialBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintStaking(dAtA, i, uint64(n7))
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
+	if m.CreationHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RedelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *RedelegationEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3094,44 +3689,71 @@ func (m *RedelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RedelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RedelegationEntry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
+	if m.UnbondingOnHoldRefCount != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingOnHoldRefCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UnbondingId != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SharesDst) > 0 {
+		for iNdEx := len(m.SharesDst) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SharesDst[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
 					return 0, err
 				}
 				i -= size
 				i = encodeVarintStaking(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x22
 		}
 	}
-	{
-		size, err := m.Redelegation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.InitialBalance) > 0 {
+		for iNdEx := len(m.InitialBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitialBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintStaking(dAtA, i, uint64(n8))
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
+	if m.CreationHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Pool) Marshal() (dAtA []byte, err error) {
+func (m *Redelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3141,20 +3763,20 @@ func (m *Pool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+func (m *Redelegation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Redelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.VotingPowerWeights) > 0 {
-		for iNdEx := len(m.VotingPowerWeights) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.VotingPowerWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3162,520 +3784,3378 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintStaking(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x22
 		}
 	}
-	if len(m.BondedTokens) > 0 {
-		for iNdEx := len(m.BondedTokens) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BondedTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.ValidatorDstAddress) > 0 {
+		i -= len(m.ValidatorDstAddress)
+		copy(dAtA[i:], m.ValidatorDstAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorDstAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.NotBondedTokens) > 0 {
-		for iNdEx := len(m.NotBondedTokens) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NotBondedTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.ValidatorSrcAddress) > 0 {
+		i -= len(m.ValidatorSrcAddress)
+		copy(dAtA[i:], m.ValidatorSrcAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *CommissionRates) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Rate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxChangeRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Commission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CommissionRates.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime)
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Description) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if m.MaxConsPubkeyRotations != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxConsPubkeyRotations))
+		i--
+		dAtA[i] = 0x60
 	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	{
+		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MaxPowerChangeRate.Size()
+		i -= size
+		if _, err := m.MaxPowerChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	l = len(m.SecurityContact)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x52
+	if m.PowerSnapshotEntries != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.PowerSnapshotEntries))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.Details)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if m.PowerSnapshotInterval != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.PowerSnapshotInterval))
+		i--
+		dAtA[i] = 0x40
 	}
-	return n
-}
-
-func (m *Validator) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	if m.MinVotingPower != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MinVotingPower))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.ConsensusPubkey != nil {
-		l = m.ConsensusPubkey.Size()
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.BondDenoms) > 0 {
+		for iNdEx := len(m.BondDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BondDenoms[iNdEx])
+			copy(dAtA[i:], m.BondDenoms[iNdEx])
+			i = encodeVarintStaking(dAtA, i, uint64(len(m.BondDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.Jailed {
-		n += 2
+	if m.HistoricalEntries != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.HistoricalEntries))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Status != 0 {
-		n += 1 + sovStaking(uint64(m.Status))
+	if m.MaxEntries != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxEntries))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Tokens) > 0 {
-		for _, e := range m.Tokens {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+	if m.MaxValidators != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxValidators))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.DelegatorShares) > 0 {
-		for _, e := range m.DelegatorShares {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err10 != nil {
+		return 0, err10
 	}
-	l = m.Description.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if m.UnbondingHeight != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingHeight))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime)
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Commission.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.VotingPowers) > 0 {
-		for _, e := range m.VotingPowers {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
-	}
-	l = m.VotingPower.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if m.UnbondingOnHoldRefCount != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
-	}
-	if m.UnbondingId != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingId))
-	}
-	return n
+	i -= n10
+	i = encodeVarintStaking(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ValAddresses) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovStaking(uint64(l))
-		}
+func (m *ConsPubKeyRotationHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DVPair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	return n
+func (m *ConsPubKeyRotationHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DVPairs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ConsPubKeyRotationHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pairs) > 0 {
-		for _, e := range m.Pairs {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *DVVTriplet) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x32
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err12 != nil {
+		return 0, err12
 	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i -= n12
+	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.ValidatorSrcAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if m.NewConsPubkey != nil {
+		{
+			size, err := m.NewConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ValidatorDstAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if m.OldConsPubkey != nil {
+		{
+			size, err := m.OldConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DVVTriplets) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Triplets) > 0 {
-		for _, e := range m.Triplets {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+func (m *PendingPowerUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Delegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	if len(m.Shares) > 0 {
-		for _, e := range m.Shares {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
-	}
-	return n
+func (m *PendingPowerUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnbondingDelegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PendingPowerUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if m.TargetPower != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.TargetPower))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if m.Power != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UnbondingDelegationEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CreationHeight != 0 {
-		n += 1 + sovStaking(uint64(m.CreationHeight))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.InitialBalance) > 0 {
-		for _, e := range m.InitialBalance {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	if len(m.Balance) > 0 {
-		for _, e := range m.Balance {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.VotingPowers) > 0 {
+		for iNdEx := len(m.VotingPowers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotingPowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.UnbondingId != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingId))
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.UnbondingOnHoldRefCount != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RedelegationEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PowerSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PowerSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PowerSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CreationHeight != 0 {
-		n += 1 + sovStaking(uint64(m.CreationHeight))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.InitialBalance) > 0 {
-		for _, e := range m.InitialBalance {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.SharesDst) > 0 {
-		for _, e := range m.SharesDst {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+	if len(m.VotingPowerWeights) > 0 {
+		for iNdEx := len(m.VotingPowerWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotingPowerWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.UnbondingId != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingId))
-	}
-	if m.UnbondingOnHoldRefCount != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err15 != nil {
+		return 0, err15
 	}
-	return n
+	i -= n15
+	i = encodeVarintStaking(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Redelegation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UnbondingQueueEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingQueueEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingQueueEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ValidatorSrcAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	l = len(m.ValidatorDstAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RedelegationQueueEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationQueueEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationQueueEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime)
-	n += 1 + l + sovStaking(uint64(l))
-	if m.MaxValidators != 0 {
-		n += 1 + sovStaking(uint64(m.MaxValidators))
-	}
-	if m.MaxEntries != 0 {
-		n += 1 + sovStaking(uint64(m.MaxEntries))
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	if m.HistoricalEntries != 0 {
-		n += 1 + sovStaking(uint64(m.HistoricalEntries))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ValidatorDstAddress) > 0 {
+		i -= len(m.ValidatorDstAddress)
+		copy(dAtA[i:], m.ValidatorDstAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorDstAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.BondDenoms) > 0 {
-		for _, s := range m.BondDenoms {
-			l = len(s)
-			n += 1 + l + sovStaking(uint64(l))
-		}
+	if len(m.ValidatorSrcAddress) > 0 {
+		i -= len(m.ValidatorSrcAddress)
+		copy(dAtA[i:], m.ValidatorSrcAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.MinVotingPower != 0 {
-		n += 1 + sovStaking(uint64(m.MinVotingPower))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.MinCommissionRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Delegation.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Balance) > 0 {
-		for _, e := range m.Balance {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+func (m *UnbondingMaturity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RedelegationEntryResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UnbondingMaturity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingMaturity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.RedelegationEntry.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Balance) > 0 {
-		for _, e := range m.Balance {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintStaking(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *RedelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Redelegation.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Pool) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegationEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.NotBondedTokens) > 0 {
-		for _, e := range m.NotBondedTokens {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.BondedTokens) > 0 {
-		for _, e := range m.BondedTokens {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+	{
+		size, err := m.RedelegationEntry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	if len(m.VotingPowerWeights) > 0 {
-		for _, e := range m.VotingPowerWeights {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size, err := m.Redelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovStaking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozStaking(x uint64) (n int) {
-	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *ValAddresses) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VotingPowerWeights) > 0 {
+		for iNdEx := len(m.VotingPowerWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotingPowerWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	s := strings.Join([]string{`&ValAddresses{`,
-		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.BondedTokens) > 0 {
+		for iNdEx := len(m.BondedTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BondedTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.NotBondedTokens) > 0 {
+		for iNdEx := len(m.NotBondedTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NotBondedTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
-func valueToStringStaking(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+func (m *SlashEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return dAtA[:n], nil
 }
-func (m *CommissionRates) Unmarshal(dAtA []byte) error {
+
+func (m *SlashEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.SlashFactor.Size()
+		i -= size
+		if _, err := m.SlashFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n22, err22 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintStaking(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InfractionHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.InfractionHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorSlashImpact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorSlashImpact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorSlashImpact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Redelegation) > 0 {
+		for iNdEx := len(m.Redelegation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redelegation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Unbonding) > 0 {
+		for iNdEx := len(m.Unbonding) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbonding[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Delegation) > 0 {
+		for iNdEx := len(m.Delegation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n23, err23 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintStaking(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SlashEventId != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.SlashEventId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CommissionRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *Commission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommissionRates.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *Description) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.SecurityContact)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *Validator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.ConsensusPubkey != nil {
+		l = m.ConsensusPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovStaking(uint64(m.Status))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if len(m.DelegatorShares) > 0 {
+		for _, e := range m.DelegatorShares {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	l = m.Description.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingHeight != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.VotingPowers) > 0 {
+		for _, e := range m.VotingPowers {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	l = m.VotingPower.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingOnHoldRefCount != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	}
+	if m.UnbondingId != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingId))
+	}
+	return n
+}
+
+func (m *ValAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DVPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *DVPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DVVTriplet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *DVVTriplets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Triplets) > 0 {
+		for _, e := range m.Triplets {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Delegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnbondingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnbondingDelegationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		n += 1 + sovStaking(uint64(m.CreationHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.InitialBalance) > 0 {
+		for _, e := range m.InitialBalance {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if m.UnbondingId != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingId))
+	}
+	if m.UnbondingOnHoldRefCount != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	}
+	return n
+}
+
+func (m *RedelegationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		n += 1 + sovStaking(uint64(m.CreationHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.InitialBalance) > 0 {
+		for _, e := range m.InitialBalance {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if len(m.SharesDst) > 0 {
+		for _, e := range m.SharesDst {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if m.UnbondingId != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingId))
+	}
+	if m.UnbondingOnHoldRefCount != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	}
+	return n
+}
+
+func (m *Redelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime)
+	n += 1 + l + sovStaking(uint64(l))
+	if m.MaxValidators != 0 {
+		n += 1 + sovStaking(uint64(m.MaxValidators))
+	}
+	if m.MaxEntries != 0 {
+		n += 1 + sovStaking(uint64(m.MaxEntries))
+	}
+	if m.HistoricalEntries != 0 {
+		n += 1 + sovStaking(uint64(m.HistoricalEntries))
+	}
+	if len(m.BondDenoms) > 0 {
+		for _, s := range m.BondDenoms {
+			l = len(s)
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if m.MinVotingPower != 0 {
+		n += 1 + sovStaking(uint64(m.MinVotingPower))
+	}
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.PowerSnapshotInterval != 0 {
+		n += 1 + sovStaking(uint64(m.PowerSnapshotInterval))
+	}
+	if m.PowerSnapshotEntries != 0 {
+		n += 1 + sovStaking(uint64(m.PowerSnapshotEntries))
+	}
+	l = m.MaxPowerChangeRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.KeyRotationFee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.MaxConsPubkeyRotations != 0 {
+		n += 1 + sovStaking(uint64(m.MaxConsPubkeyRotations))
+	}
+	return n
+}
+
+func (m *ConsPubKeyRotationHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.OldConsPubkey != nil {
+		l = m.OldConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.NewConsPubkey != nil {
+		l = m.NewConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *PendingPowerUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovStaking(uint64(m.Power))
+	}
+	if m.TargetPower != 0 {
+		n += 1 + sovStaking(uint64(m.TargetPower))
+	}
+	return n
+}
+
+func (m *ValidatorPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if len(m.VotingPowers) > 0 {
+		for _, e := range m.VotingPowers {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	l = m.VotingPower.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *PowerSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.VotingPowerWeights) > 0 {
+		for _, e := range m.VotingPowerWeights {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnbondingQueueEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Entry.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *RedelegationQueueEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Entry.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *UnbondingMaturity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RedelegationEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RedelegationEntry.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RedelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Redelegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NotBondedTokens) > 0 {
+		for _, e := range m.NotBondedTokens {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if len(m.BondedTokens) > 0 {
+		for _, e := range m.BondedTokens {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if len(m.VotingPowerWeights) > 0 {
+		for _, e := range m.VotingPowerWeights {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SlashEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.InfractionHeight != 0 {
+		n += 1 + sovStaking(uint64(m.InfractionHeight))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.SlashFactor.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if m.Truncated {
+		n += 2
+	}
+	return n
+}
+
+func (m *DelegatorSlashImpact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashEventId != 0 {
+		n += 1 + sovStaking(uint64(m.SlashEventId))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Delegation) > 0 {
+		for _, e := range m.Delegation {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if len(m.Unbonding) > 0 {
+		for _, e := range m.Unbonding {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if len(m.Redelegation) > 0 {
+		for _, e := range m.Redelegation {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovStaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStaking(x uint64) (n int) {
+	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ValAddresses) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValAddresses{`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringStaking(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *CommissionRates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Description) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusPubkey == nil {
+				m.ConsensusPubkey = &types.Any{}
+			}
+			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BondStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, types1.Coin{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorShares = append(m.DelegatorShares, types1.DecCoin{})
+			if err := m.DelegatorShares[len(m.DelegatorShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+			}
+			m.UnbondingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPowers = append(m.VotingPowers, types1.Coin{})
+			if err := m.VotingPowers[len(m.VotingPowers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			}
+			m.UnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, DVPair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVVTriplet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVVTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVVTriplets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVVTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Triplets = append(m.Triplets, DVVTriplet{})
+			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Delegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, types1.DecCoin{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3698,17 +7178,36 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -3718,31 +7217,30 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -3752,31 +7250,31 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InitialBalance = append(m.InitialBalance, types1.Coin{})
+			if err := m.InitialBalance[len(m.InitialBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -3786,26 +7284,64 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Balance = append(m.Balance, types1.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			}
+			m.UnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -3827,7 +7363,7 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Commission) Unmarshal(dAtA []byte) error {
+func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3850,15 +7386,34 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3885,13 +7440,13 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3918,10 +7473,83 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.InitialBalance = append(m.InitialBalance, types1.Coin{})
+			if err := m.InitialBalance[len(m.InitialBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SharesDst = append(m.SharesDst, types1.DecCoin{})
+			if err := m.SharesDst[len(m.SharesDst)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			}
+			m.UnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -3943,7 +7571,7 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Description) Unmarshal(dAtA []byte) error {
+func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3966,47 +7594,15 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4034,11 +7630,11 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4066,11 +7662,11 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4098,13 +7694,13 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4114,23 +7710,25 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Details = string(dAtA[iNdEx:postIndex])
+			m.Entries = append(m.Entries, RedelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4153,7 +7751,7 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4176,17 +7774,17 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4196,29 +7794,30 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
 			}
-			var msglen int
+			m.MaxValidators = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4228,33 +7827,16 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxValidators |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ConsensusPubkey == nil {
-				m.ConsensusPubkey = &types.Any{}
-			}
-			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
 			}
-			var v int
+			m.MaxEntries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4264,17 +7846,16 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxEntries |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Jailed = bool(v != 0)
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
 			}
-			m.Status = 0
+			m.HistoricalEntries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4284,16 +7865,16 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BondStatus(b&0x7F) << shift
+				m.HistoricalEntries |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenoms", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4303,31 +7884,29 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, types1.Coin{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BondDenoms = append(m.BondDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVotingPower", wireType)
 			}
-			var msglen int
+			m.MinVotingPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4337,31 +7916,16 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinVotingPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorShares = append(m.DelegatorShares, types1.DecCoin{})
-			if err := m.DelegatorShares[len(m.DelegatorShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4371,30 +7935,31 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerSnapshotInterval", wireType)
 			}
-			m.UnbondingHeight = 0
+			m.PowerSnapshotInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4404,16 +7969,16 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingHeight |= int64(b&0x7F) << shift
+				m.PowerSnapshotInterval |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerSnapshotEntries", wireType)
 			}
-			var msglen int
+			m.PowerSnapshotEntries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4423,95 +7988,14 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PowerSnapshotEntries |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VotingPowers = append(m.VotingPowers, types1.Coin{})
-			if err := m.VotingPowers[len(m.VotingPowers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPowerChangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4537,36 +8021,17 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
-			}
-			m.UnbondingOnHoldRefCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return io.ErrUnexpectedEOF
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			if err := m.MaxPowerChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.UnbondingId = 0
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationFee", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4576,66 +8041,30 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValAddresses) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.KeyRotationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsPubkeyRotations", wireType)
 			}
-			var stringLen uint64
+			m.MaxConsPubkeyRotations = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4645,24 +8074,11 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxConsPubkeyRotations |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -4684,7 +8100,7 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVPair) Unmarshal(dAtA []byte) error {
+func (m *ConsPubKeyRotationHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4707,15 +8123,15 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4743,13 +8159,13 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConsPubkey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4759,77 +8175,31 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DVPairs) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.OldConsPubkey == nil {
+				m.OldConsPubkey = &types.Any{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.OldConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DVPairs: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVPairs: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsPubkey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4856,66 +8226,18 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pairs = append(m.Pairs, DVPair{})
-			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.NewConsPubkey == nil {
+				m.NewConsPubkey = &types.Any{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.NewConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DVVTriplet: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVVTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4925,29 +8247,16 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4957,29 +8266,30 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4989,23 +8299,24 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5028,7 +8339,7 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
+func (m *PendingPowerUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5051,17 +8362,17 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVVTriplets: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingPowerUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVVTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingPowerUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5071,26 +8382,62 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Triplets = append(m.Triplets, DVVTriplet{})
-			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPower", wireType)
+			}
+			m.TargetPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5112,7 +8459,7 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Delegation) Unmarshal(dAtA []byte) error {
+func (m *ValidatorPower) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5135,15 +8482,15 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorPower: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorPower: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5171,13 +8518,13 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5187,27 +8534,29 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Tokens = append(m.Tokens, types1.Coin{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5234,8 +8583,42 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Shares = append(m.Shares, types1.DecCoin{})
-			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VotingPowers = append(m.VotingPowers, types1.Coin{})
+			if err := m.VotingPowers[len(m.VotingPowers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5260,7 +8643,7 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
+func (m *PowerSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5283,17 +8666,17 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: PowerSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PowerSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5303,29 +8686,30 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerWeights", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5335,27 +8719,29 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.VotingPowerWeights = append(m.VotingPowerWeights, types1.DecCoin{})
+			if err := m.VotingPowerWeights[len(m.VotingPowerWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5382,8 +8768,8 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validators = append(m.Validators, ValidatorPower{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5408,7 +8794,7 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *UnbondingQueueEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5431,36 +8817,17 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingQueueEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
-			}
-			m.CreationHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5470,30 +8837,29 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5503,29 +8869,27 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitialBalance = append(m.InitialBalance, types1.Coin{})
-			if err := m.InitialBalance[len(m.InitialBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5550,51 +8914,12 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Balance = append(m.Balance, types1.Coin{})
-			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
-			}
-			m.UnbondingId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
-			}
-			m.UnbondingOnHoldRefCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5616,7 +8941,7 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *RedelegationQueueEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5639,17 +8964,17 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationQueueEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			m.CreationHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5659,16 +8984,29 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5678,30 +9016,29 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5711,29 +9048,27 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitialBalance = append(m.InitialBalance, types1.Coin{})
-			if err := m.InitialBalance[len(m.InitialBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5760,16 +9095,65 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SharesDst = append(m.SharesDst, types1.DecCoin{})
-			if err := m.SharesDst[len(m.SharesDst)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.UnbondingId = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingMaturity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingMaturity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingMaturity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5779,16 +9163,30 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
 			}
-			m.UnbondingOnHoldRefCount = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5798,11 +9196,26 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5824,7 +9237,7 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Redelegation) Unmarshal(dAtA []byte) error {
+func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5847,17 +9260,17 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5867,29 +9280,30 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5899,29 +9313,81 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			m.Balance = append(m.Balance, types1.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5931,27 +9397,28 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5978,8 +9445,8 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Balance = append(m.Balance, types1.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6004,7 +9471,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6027,15 +9494,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6062,15 +9529,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			m.MaxValidators = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6080,54 +9547,81 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxValidators |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
 			}
-			m.MaxEntries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxEntries |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.HistoricalEntries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HistoricalEntries |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Entries = append(m.Entries, RedelegationEntryResponse{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 5:
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6137,29 +9631,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondDenoms = append(m.BondDenoms, string(dAtA[iNdEx:postIndex]))
+			m.NotBondedTokens = append(m.NotBondedTokens, types1.Coin{})
+			if err := m.NotBondedTokens[len(m.NotBondedTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinVotingPower", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
 			}
-			m.MinVotingPower = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6169,16 +9665,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinVotingPower |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondedTokens = append(m.BondedTokens, types1.Coin{})
+			if err := m.BondedTokens[len(m.BondedTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerWeights", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6188,23 +9699,23 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VotingPowerWeights = append(m.VotingPowerWeights, types1.DecCoin{})
+			if err := m.VotingPowerWeights[len(m.VotingPowerWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6229,7 +9740,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *SlashEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6252,15 +9763,104 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionHeight", wireType)
+			}
+			m.InfractionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfractionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6287,15 +9887,15 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFactor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6305,79 +9905,29 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = append(m.Balance, types1.Coin{})
-			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SlashFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6404,15 +9954,16 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Burned = append(m.Burned, types1.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6422,26 +9973,12 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Balance = append(m.Balance, types1.Coin{})
-			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Truncated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6463,7 +10000,7 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *DelegatorSlashImpact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6486,17 +10023,36 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorSlashImpact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorSlashImpact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashEventId", wireType)
+			}
+			m.SlashEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashEventId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6506,30 +10062,29 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6539,79 +10094,79 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntryResponse{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Pool) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6638,14 +10193,14 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NotBondedTokens = append(m.NotBondedTokens, types1.Coin{})
-			if err := m.NotBondedTokens[len(m.NotBondedTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delegation = append(m.Delegation, types1.Coin{})
+			if err := m.Delegation[len(m.Delegation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6672,14 +10227,14 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondedTokens = append(m.BondedTokens, types1.Coin{})
-			if err := m.BondedTokens[len(m.BondedTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Unbonding = append(m.Unbonding, types1.Coin{})
+			if err := m.Unbonding[len(m.Unbonding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerWeights", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6706,8 +10261,8 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VotingPowerWeights = append(m.VotingPowerWeights, types1.DecCoin{})
-			if err := m.VotingPowerWeights[len(m.VotingPowerWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Redelegation = append(m.Redelegation, types1.Coin{})
+			if err := m.Redelegation[len(m.Redelegation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
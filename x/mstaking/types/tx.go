@@ -19,6 +19,9 @@ var (
 	_ sdk.Msg                            = &MsgUndelegate{}
 	_ sdk.Msg                            = &MsgBeginRedelegate{}
 	_ sdk.Msg                            = &MsgCancelUnbondingDelegation{}
+	_ sdk.Msg                            = &MsgSwapDelegationDenom{}
+	_ sdk.Msg                            = &MsgRotateConsPubKey{}
+	_ codectypes.UnpackInterfacesMessage = (*MsgRotateConsPubKey)(nil)
 	_ sdk.Msg                            = &MsgUpdateParams{}
 )
 
@@ -276,8 +279,111 @@ func (msg MsgCancelUnbondingDelegation) Validate(accAddrCodec address.Codec, val
 	return nil
 }
 
+// NewMsgSwapDelegationDenom creates a new MsgSwapDelegationDenom instance.
+//
+//nolint:interfacer
+func NewMsgSwapDelegationDenom(
+	delAddr, valSrcAddr, valDstAddr string, amount, minTargetAmount sdk.Coin,
+) *MsgSwapDelegationDenom {
+	return &MsgSwapDelegationDenom{
+		DelegatorAddress:    delAddr,
+		ValidatorSrcAddress: valSrcAddr,
+		ValidatorDstAddress: valDstAddr,
+		Amount:              amount,
+		MinTargetAmount:     minTargetAmount,
+	}
+}
+
+// Validate implements the sdk.Msg interface.
+func (msg MsgSwapDelegationDenom) Validate(accAddrCodec address.Codec, valAddrCodec address.Codec) error {
+	if addr, err := accAddrCodec.StringToBytes(msg.DelegatorAddress); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return ErrEmptyDelegatorAddr
+	}
+
+	if addr, err := valAddrCodec.StringToBytes(msg.ValidatorSrcAddress); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return ErrEmptyValidatorAddr
+	}
+
+	if addr, err := valAddrCodec.StringToBytes(msg.ValidatorDstAddress); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return ErrEmptyValidatorAddr
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	if !msg.MinTargetAmount.IsValid() {
+		return errors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid min target amount",
+		)
+	}
+
+	if msg.Amount.Denom == msg.MinTargetAmount.Denom {
+		return errors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"source and target denoms must be different",
+		)
+	}
+
+	return nil
+}
+
 /* MsgUpdateParams */
 
+// NewMsgRotateConsPubKey creates a new MsgRotateConsPubKey instance.
+//
+//nolint:interfacer
+func NewMsgRotateConsPubKey(valAddr string, pubKey cryptotypes.PubKey) (*MsgRotateConsPubKey, error) {
+	var pkAny *codectypes.Any
+	if pubKey != nil {
+		var err error
+		if pkAny, err = codectypes.NewAnyWithValue(pubKey); err != nil {
+			return nil, err
+		}
+	}
+	return &MsgRotateConsPubKey{
+		ValidatorAddress: valAddr,
+		NewPubkey:        pkAny,
+	}, nil
+}
+
+// Validate implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) Validate(valAddrCodec address.Codec) error {
+	if msg.ValidatorAddress == "" {
+		return ErrEmptyValidatorAddr
+	}
+
+	valAddr, err := valAddrCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+	if len(valAddr) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "empty validator address")
+	}
+
+	if msg.NewPubkey == nil {
+		return ErrEmptyValidatorPubKey
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRotateConsPubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPubkey, &pubKey)
+}
+
 // Validate executes sanity validation on the provided data
 func (msg MsgUpdateParams) Validate(accAddrCodec address.Codec) error {
 	if addr, err := accAddrCodec.StringToBytes(msg.Authority); err != nil {
@@ -541,6 +541,186 @@ func (m *MsgCancelUnbondingDelegationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelUnbondingDelegationResponse proto.InternalMessageInfo
 
+// MsgSwapDelegationDenom defines a SDK message for converting delegated coins
+// of one bond denom to another bond denom and redelegating them from a source
+// validator to a destination validator.
+type MsgSwapDelegationDenom struct {
+	DelegatorAddress    string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorSrcAddress string `protobuf:"bytes,2,opt,name=validator_src_address,json=validatorSrcAddress,proto3" json:"validator_src_address,omitempty" yaml:"validator_src_address"`
+	ValidatorDstAddress string `protobuf:"bytes,3,opt,name=validator_dst_address,json=validatorDstAddress,proto3" json:"validator_dst_address,omitempty" yaml:"validator_dst_address"`
+	// amount is the delegated coin of the source bond denom to be converted.
+	Amount types1.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount" yaml:"amount"`
+	// min_target_amount is the minimum amount of the target bond denom which
+	// must be received from the conversion; it protects the delegator from
+	// slippage of the dex pool.
+	MinTargetAmount types1.Coin `protobuf:"bytes,5,opt,name=min_target_amount,json=minTargetAmount,proto3" json:"min_target_amount" yaml:"min_target_amount"`
+}
+
+func (m *MsgSwapDelegationDenom) Reset()         { *m = MsgSwapDelegationDenom{} }
+func (m *MsgSwapDelegationDenom) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapDelegationDenom) ProtoMessage()    {}
+func (*MsgSwapDelegationDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7c45bd70733957, []int{12}
+}
+func (m *MsgSwapDelegationDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapDelegationDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapDelegationDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapDelegationDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapDelegationDenom.Merge(m, src)
+}
+func (m *MsgSwapDelegationDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapDelegationDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapDelegationDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapDelegationDenom proto.InternalMessageInfo
+
+// MsgSwapDelegationDenomResponse defines the Msg/SwapDelegationDenom response type.
+type MsgSwapDelegationDenomResponse struct {
+	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	// target_amount returns the amount of converted coins which were delegated
+	// to the destination validator.
+	TargetAmount types1.Coin `protobuf:"bytes,2,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount"`
+}
+
+func (m *MsgSwapDelegationDenomResponse) Reset()         { *m = MsgSwapDelegationDenomResponse{} }
+func (m *MsgSwapDelegationDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapDelegationDenomResponse) ProtoMessage()    {}
+func (*MsgSwapDelegationDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7c45bd70733957, []int{13}
+}
+func (m *MsgSwapDelegationDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapDelegationDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapDelegationDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapDelegationDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapDelegationDenomResponse.Merge(m, src)
+}
+func (m *MsgSwapDelegationDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapDelegationDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapDelegationDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapDelegationDenomResponse proto.InternalMessageInfo
+
+func (m *MsgSwapDelegationDenomResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgSwapDelegationDenomResponse) GetTargetAmount() types1.Coin {
+	if m != nil {
+		return m.TargetAmount
+	}
+	return types1.Coin{}
+}
+
+// MsgRotateConsPubKey defines a SDK message for rotating the consensus
+// public key of an existing validator.
+type MsgRotateConsPubKey struct {
+	ValidatorAddress string     `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	NewPubkey        *types.Any `protobuf:"bytes,2,opt,name=new_pubkey,json=newPubkey,proto3" json:"new_pubkey,omitempty"`
+}
+
+func (m *MsgRotateConsPubKey) Reset()         { *m = MsgRotateConsPubKey{} }
+func (m *MsgRotateConsPubKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateConsPubKey) ProtoMessage()    {}
+func (*MsgRotateConsPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7c45bd70733957, []int{14}
+}
+func (m *MsgRotateConsPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateConsPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateConsPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateConsPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateConsPubKey.Merge(m, src)
+}
+func (m *MsgRotateConsPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateConsPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateConsPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateConsPubKey proto.InternalMessageInfo
+
+// MsgRotateConsPubKeyResponse defines the Msg/RotateConsPubKey response type.
+type MsgRotateConsPubKeyResponse struct {
+}
+
+func (m *MsgRotateConsPubKeyResponse) Reset()         { *m = MsgRotateConsPubKeyResponse{} }
+func (m *MsgRotateConsPubKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateConsPubKeyResponse) ProtoMessage()    {}
+func (*MsgRotateConsPubKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7c45bd70733957, []int{15}
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateConsPubKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateConsPubKeyResponse.Merge(m, src)
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateConsPubKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateConsPubKeyResponse proto.InternalMessageInfo
+
 // MsgUpdateParams is the Msg/UpdateParams request type.
 type MsgUpdateParams struct {
 	// authority is the address that controls the module (defaults to x/gov unless overwritten).
@@ -555,7 +735,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7c45bd70733957, []int{12}
+	return fileDescriptor_9b7c45bd70733957, []int{16}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -607,7 +787,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7c45bd70733957, []int{13}
+	return fileDescriptor_9b7c45bd70733957, []int{17}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -649,6 +829,10 @@ func init() {
 	proto.RegisterType((*MsgUndelegateResponse)(nil), "initia.mstaking.v1.MsgUndelegateResponse")
 	proto.RegisterType((*MsgCancelUnbondingDelegation)(nil), "initia.mstaking.v1.MsgCancelUnbondingDelegation")
 	proto.RegisterType((*MsgCancelUnbondingDelegationResponse)(nil), "initia.mstaking.v1.MsgCancelUnbondingDelegationResponse")
+	proto.RegisterType((*MsgSwapDelegationDenom)(nil), "initia.mstaking.v1.MsgSwapDelegationDenom")
+	proto.RegisterType((*MsgSwapDelegationDenomResponse)(nil), "initia.mstaking.v1.MsgSwapDelegationDenomResponse")
+	proto.RegisterType((*MsgRotateConsPubKey)(nil), "initia.mstaking.v1.MsgRotateConsPubKey")
+	proto.RegisterType((*MsgRotateConsPubKeyResponse)(nil), "initia.mstaking.v1.MsgRotateConsPubKeyResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "initia.mstaking.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "initia.mstaking.v1.MsgUpdateParamsResponse")
 }
@@ -656,82 +840,94 @@ func init() {
 func init() { proto.RegisterFile("initia/mstaking/v1/tx.proto", fileDescriptor_9b7c45bd70733957) }
 
 var fileDescriptor_9b7c45bd70733957 = []byte{
-	// 1189 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x4f, 0xdc, 0x46,
-	0x14, 0x5e, 0xb3, 0x80, 0xc2, 0x50, 0x20, 0x38, 0x90, 0x2c, 0x86, 0xae, 0x89, 0x93, 0x26, 0x94,
-	0x14, 0xbb, 0x90, 0xaa, 0x6d, 0x50, 0x52, 0x89, 0x05, 0x52, 0xa4, 0x66, 0xa3, 0x68, 0x93, 0xf4,
-	0x90, 0x0b, 0x99, 0xb5, 0xa7, 0x66, 0xca, 0xda, 0x63, 0x79, 0x66, 0x51, 0xf6, 0x56, 0x55, 0xaa,
-	0x5a, 0xf5, 0xc4, 0xa1, 0xa7, 0x9e, 0x38, 0xf4, 0x50, 0xf5, 0xc4, 0x21, 0xfd, 0x1f, 0xa2, 0x9e,
-	0xa2, 0x9e, 0xaa, 0x1e, 0x96, 0x0a, 0x54, 0xd1, 0xf3, 0xfe, 0x05, 0x95, 0x7f, 0xff, 0x5a, 0x67,
-	0x01, 0x55, 0x69, 0x0f, 0xbd, 0x80, 0x3d, 0xef, 0x7b, 0x6f, 0xe6, 0xbd, 0xef, 0xf3, 0x9b, 0xb7,
-	0x60, 0x1a, 0x9b, 0x98, 0x61, 0xa8, 0x18, 0x94, 0xc1, 0x6d, 0x6c, 0xea, 0xca, 0xce, 0xa2, 0xc2,
-	0x9e, 0xc9, 0x96, 0x4d, 0x18, 0xe1, 0x79, 0xcf, 0x28, 0x07, 0x46, 0x79, 0x67, 0x51, 0x18, 0x87,
-	0x06, 0x36, 0x89, 0xe2, 0xfe, 0xf5, 0x60, 0x42, 0x59, 0x25, 0xd4, 0x20, 0x54, 0xa9, 0x43, 0x8a,
-	0x94, 0x9d, 0xc5, 0x3a, 0x62, 0x70, 0x51, 0x51, 0x09, 0x36, 0x7d, 0xfb, 0x25, 0xdf, 0x6e, 0x50,
-	0x37, 0xbc, 0x41, 0x75, 0xdf, 0x30, 0xe5, 0x19, 0x36, 0xdd, 0x37, 0xc5, 0x7b, 0xf1, 0x4d, 0x13,
-	0x3a, 0xd1, 0x89, 0xb7, 0xee, 0x3c, 0x05, 0x0e, 0x3a, 0x21, 0x7a, 0x03, 0x29, 0xee, 0x5b, 0xbd,
-	0xf9, 0x99, 0x02, 0xcd, 0x96, 0x6f, 0x12, 0xd3, 0x26, 0x86, 0x0d, 0x44, 0x19, 0x34, 0x2c, 0x1f,
-	0x30, 0xdb, 0x25, 0xd3, 0x20, 0x2f, 0x17, 0x21, 0x7d, 0xd5, 0x0f, 0xf8, 0x2a, 0xd5, 0x57, 0x6d,
-	0x04, 0x19, 0xfa, 0x14, 0x36, 0xb0, 0x06, 0x19, 0xb1, 0xf9, 0x7b, 0x60, 0x58, 0x43, 0x54, 0xb5,
-	0xb1, 0xc5, 0x30, 0x31, 0x4b, 0xdc, 0x2c, 0x37, 0x37, 0xbc, 0x24, 0xca, 0xd9, 0xda, 0xc8, 0x6b,
-	0x11, 0xac, 0x32, 0xf4, 0xa2, 0x2d, 0x16, 0x7e, 0x3c, 0xde, 0x9f, 0xe7, 0x6a, 0x71, 0x77, 0xfe,
-	0x3e, 0x00, 0x2a, 0x31, 0x0c, 0x4c, 0xa9, 0x13, 0xac, 0xcf, 0x0d, 0x76, 0xa5, 0x5b, 0xb0, 0xd5,
-	0x10, 0x55, 0x83, 0x0c, 0xd1, 0x78, 0xc0, 0x58, 0x04, 0xfe, 0x3e, 0x18, 0xdf, 0x09, 0x8e, 0xba,
-	0x09, 0x35, 0xcd, 0x46, 0x94, 0x96, 0x8a, 0xb3, 0xdc, 0xdc, 0x50, 0xe5, 0xf2, 0xaf, 0xcf, 0x17,
-	0xde, 0xf4, 0xab, 0x1a, 0xa6, 0xb3, 0xe2, 0x41, 0x1e, 0x32, 0x1b, 0x9b, 0x7a, 0xed, 0xfc, 0x4e,
-	0x6a, 0x9d, 0xbf, 0x0b, 0x06, 0xad, 0x66, 0x7d, 0x1b, 0xb5, 0x4a, 0xfd, 0xee, 0xd9, 0x26, 0x64,
-	0xaf, 0xb0, 0x72, 0x50, 0x58, 0x79, 0xc5, 0x6c, 0x55, 0x4a, 0xbf, 0x3c, 0x5f, 0x98, 0xf0, 0x43,
-	0xab, 0x76, 0xcb, 0x62, 0x44, 0x7e, 0xd0, 0xac, 0x7f, 0x82, 0x5a, 0x35, 0xdf, 0x9b, 0x6f, 0x81,
-	0x41, 0x68, 0x90, 0xa6, 0xc9, 0x4a, 0x03, 0xb3, 0xc5, 0xb9, 0xe1, 0xa5, 0x29, 0xd9, 0x87, 0x3b,
-	0x2a, 0x91, 0x7d, 0x95, 0xc8, 0xab, 0x04, 0x9b, 0x95, 0xbb, 0x4e, 0x66, 0x9d, 0xb6, 0x38, 0xd2,
-	0x82, 0x46, 0x63, 0x59, 0xf2, 0xdc, 0xa4, 0x9f, 0x0e, 0xc4, 0x39, 0x1d, 0xb3, 0xad, 0x66, 0x5d,
-	0x56, 0x89, 0xe1, 0xab, 0xc3, 0xff, 0xb7, 0x40, 0xb5, 0x6d, 0x85, 0xb5, 0x2c, 0x44, 0xdd, 0x08,
-	0xd4, 0x2b, 0x8b, 0xbf, 0xe1, 0xf2, 0x47, 0xdf, 0xec, 0x89, 0x85, 0xbf, 0xf6, 0xc4, 0xc2, 0x97,
-	0xc7, 0xfb, 0xf3, 0xd9, 0xea, 0x7c, 0x7b, 0xbc, 0x3f, 0x3f, 0x1d, 0xaa, 0x20, 0x4b, 0xb8, 0x34,
-	0x03, 0x84, 0xec, 0x6a, 0x0d, 0x51, 0x8b, 0x98, 0x14, 0x49, 0x07, 0x7d, 0xe0, 0x7c, 0x95, 0xea,
-	0xeb, 0x1a, 0x66, 0x91, 0x46, 0x3e, 0x3e, 0x93, 0x46, 0xfa, 0x9d, 0xc4, 0x93, 0xf2, 0x78, 0xda,
-	0x8d, 0xce, 0x3e, 0x97, 0xce, 0x9b, 0x9d, 0xb6, 0x38, 0xea, 0x97, 0xc8, 0x33, 0x48, 0x67, 0x21,
-	0xb8, 0x0e, 0xc6, 0x22, 0xf9, 0x6c, 0xda, 0x90, 0x21, 0x5f, 0x2e, 0xb7, 0x7e, 0x6f, 0x8b, 0xd3,
-	0x5e, 0x34, 0xaa, 0x6d, 0xcb, 0x98, 0x28, 0x06, 0x64, 0x5b, 0xf2, 0x3d, 0xa4, 0x43, 0xb5, 0xb5,
-	0x86, 0xd4, 0x4e, 0x5b, 0xbc, 0xe8, 0x6d, 0x9f, 0xf2, 0x97, 0x6a, 0xa3, 0x6a, 0x42, 0xac, 0xcb,
-	0xb7, 0x7b, 0x33, 0x30, 0x15, 0x67, 0x20, 0x51, 0x4c, 0x49, 0x00, 0xa5, 0xf4, 0x5a, 0x58, 0xfd,
-	0xdd, 0x22, 0x18, 0xae, 0x52, 0x7d, 0x0d, 0x35, 0x90, 0x0e, 0x19, 0xe2, 0x21, 0x18, 0xd7, 0xbc,
-	0xe7, 0x58, 0xbd, 0x38, 0x37, 0x9f, 0xf7, 0x3a, 0x6d, 0xb1, 0xe4, 0x1d, 0x38, 0x03, 0x71, 0x2a,
-	0x17, 0xe8, 0x37, 0x55, 0xb0, 0x10, 0x1b, 0x14, 0xec, 0xf3, 0x7c, 0x4a, 0xee, 0x44, 0x5b, 0x64,
-	0x20, 0x67, 0x22, 0x27, 0xfa, 0x6a, 0x8a, 0xaf, 0xfb, 0xab, 0xf9, 0x20, 0xc1, 0x59, 0xa6, 0x62,
-	0x0e, 0x67, 0x13, 0x71, 0xce, 0x02, 0x0a, 0xa4, 0x49, 0x70, 0x21, 0xf6, 0x1a, 0x32, 0xb5, 0xe7,
-	0x75, 0xd3, 0x0a, 0xd2, 0xb1, 0x59, 0x43, 0xda, 0x6b, 0x24, 0xac, 0x09, 0x26, 0x23, 0x36, 0xa8,
-	0xad, 0xa6, 0x48, 0x5b, 0xe9, 0xb4, 0xc5, 0x99, 0x34, 0x69, 0x31, 0xd8, 0x09, 0x88, 0xbb, 0x10,
-	0x3a, 0x3e, 0xb4, 0xd5, 0xae, 0xdb, 0x6a, 0x94, 0xa5, 0xba, 0x71, 0xd7, 0x6d, 0x63, 0xb0, 0x53,
-	0x6d, 0xbb, 0x46, 0x59, 0x56, 0x32, 0xfd, 0xff, 0x6e, 0xa3, 0xed, 0x2a, 0x99, 0x44, 0xa3, 0x4d,
-	0x69, 0x41, 0xb2, 0xdc, 0x46, 0x9b, 0x5a, 0x0d, 0x04, 0xc4, 0xd7, 0xdc, 0x46, 0x65, 0x35, 0x90,
-	0xd3, 0x18, 0x37, 0x9d, 0xeb, 0xdc, 0xef, 0xab, 0x42, 0xe6, 0x4a, 0x7a, 0x14, 0xdc, 0xf5, 0x95,
-	0x11, 0x27, 0xc5, 0xdd, 0x03, 0x91, 0xf3, 0x4e, 0x3a, 0x1a, 0x45, 0x70, 0x30, 0xd2, 0x77, 0x45,
-	0x30, 0x52, 0xa5, 0xfa, 0x63, 0x53, 0xfb, 0xbf, 0x81, 0xfc, 0xd3, 0x6a, 0xb8, 0xd5, 0x5b, 0x0d,
-	0x17, 0xe3, 0x6a, 0x88, 0x48, 0x90, 0x7e, 0xe0, 0xc0, 0x64, 0x62, 0x25, 0x14, 0x41, 0xf5, 0x2c,
-	0x22, 0x38, 0x17, 0x88, 0x20, 0xcd, 0x3f, 0x7f, 0x3b, 0x2c, 0x4f, 0x5f, 0xaf, 0xf2, 0xc4, 0xe6,
-	0x2d, 0xdf, 0x47, 0xfa, 0xbe, 0x08, 0x66, 0x9c, 0xc9, 0x00, 0x9a, 0x2a, 0x6a, 0x3c, 0x36, 0xeb,
-	0xc4, 0xd4, 0xb0, 0x19, 0x74, 0x3e, 0xe7, 0xf6, 0x5e, 0xcf, 0x17, 0x53, 0xe9, 0x14, 0x82, 0x59,
-	0xcf, 0x17, 0xcc, 0x2b, 0xc2, 0xfc, 0x87, 0xb4, 0xc0, 0x5f, 0x07, 0x63, 0xaa, 0x33, 0x3f, 0x39,
-	0xa4, 0x6d, 0x21, 0xac, 0x6f, 0x31, 0x77, 0x9c, 0x2c, 0xd6, 0x46, 0x83, 0xe5, 0x0d, 0x77, 0x75,
-	0x79, 0xa3, 0xb7, 0x68, 0xde, 0x4a, 0xcc, 0x6a, 0x79, 0xb5, 0x97, 0xae, 0x81, 0xab, 0xaf, 0xb2,
-	0x87, 0xf7, 0xd2, 0xcf, 0x1c, 0x18, 0x73, 0xb4, 0x66, 0x69, 0x90, 0xa1, 0x07, 0xd0, 0x86, 0x06,
-	0xe5, 0xdf, 0x07, 0x43, 0xb0, 0xc9, 0xb6, 0x88, 0x8d, 0x59, 0xab, 0x27, 0x5f, 0x11, 0x94, 0xbf,
-	0x03, 0x06, 0x2d, 0x37, 0x82, 0x3f, 0xc8, 0x0b, 0xdd, 0x26, 0x3e, 0x6f, 0x8f, 0x84, 0x9e, 0x3c,
-	0xa7, 0xe5, 0x1b, 0x4e, 0xd2, 0x51, 0x38, 0x27, 0xd9, 0x52, 0xe2, 0x0b, 0x89, 0x9d, 0x51, 0x9a,
-	0x02, 0x97, 0x52, 0x4b, 0x41, 0x4a, 0x4b, 0x7f, 0x0e, 0x80, 0x62, 0x95, 0xea, 0x3c, 0x06, 0x63,
-	0xe9, 0x1f, 0x2f, 0xd7, 0xba, 0x9d, 0x28, 0x3b, 0xdd, 0x0a, 0xf2, 0xc9, 0x70, 0xe1, 0x77, 0xa9,
-	0x82, 0x91, 0xe4, 0x04, 0x7c, 0x35, 0x27, 0x40, 0x02, 0x25, 0xbc, 0x73, 0x12, 0x54, 0xb8, 0xc9,
-	0x23, 0x70, 0x2e, 0x1c, 0xf4, 0xc4, 0x1c, 0xcf, 0x00, 0x20, 0x5c, 0xef, 0x01, 0x08, 0xa3, 0x62,
-	0x30, 0x96, 0x1e, 0x4a, 0xf2, 0xaa, 0x94, 0xc2, 0xe5, 0x56, 0x29, 0xef, 0x0a, 0x7b, 0x02, 0x40,
-	0xec, 0xaa, 0xb9, 0x9c, 0xe3, 0x1d, 0x41, 0x84, 0xb7, 0x7b, 0x42, 0xc2, 0xd8, 0x5f, 0x73, 0x60,
-	0x2a, 0xbf, 0x13, 0xbd, 0x9b, 0xc7, 0x67, 0x9e, 0x87, 0xf0, 0xe1, 0x69, 0x3d, 0xc2, 0x93, 0x3c,
-	0x05, 0x6f, 0x24, 0xbe, 0xa6, 0x2b, 0x79, 0x49, 0xc4, 0x40, 0xc2, 0x8d, 0x13, 0x80, 0x82, 0x1d,
-	0x84, 0x81, 0x2f, 0x9c, 0xef, 0xa6, 0xb2, 0xf1, 0xe2, 0xb0, 0xcc, 0xbd, 0x3c, 0x2c, 0x73, 0x7f,
-	0x1c, 0x96, 0xb9, 0xdd, 0xa3, 0x72, 0xe1, 0xe5, 0x51, 0xb9, 0xf0, 0xdb, 0x51, 0xb9, 0xf0, 0x44,
-	0x8e, 0xb5, 0x29, 0x2f, 0xee, 0x42, 0x03, 0xd6, 0xa9, 0xff, 0xac, 0x3c, 0x8b, 0x7e, 0xf5, 0xbb,
-	0x2d, 0xab, 0x3e, 0xe8, 0xde, 0x1a, 0x37, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xb1, 0x0c, 0xb8,
-	0x4a, 0xff, 0x10, 0x00, 0x00,
+	// 1384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x6c, 0xd4, 0xc6,
+	0x17, 0x8e, 0xb3, 0x21, 0x3f, 0x32, 0x21, 0x09, 0x31, 0x01, 0x36, 0x4e, 0x58, 0x07, 0xf3, 0x2f,
+	0xbf, 0xd0, 0xd8, 0x4d, 0xa8, 0xda, 0x12, 0x41, 0xab, 0x6c, 0x02, 0xa5, 0x2a, 0x8b, 0xd0, 0x02,
+	0x3d, 0x70, 0x59, 0x66, 0xed, 0xa9, 0xe3, 0x66, 0xed, 0xb1, 0x3c, 0xb3, 0x81, 0xbd, 0x55, 0x95,
+	0xaa, 0x56, 0x3d, 0x71, 0xe8, 0xa9, 0x27, 0x0e, 0x3d, 0x54, 0x3d, 0x71, 0xa0, 0xe7, 0x5e, 0x11,
+	0x27, 0xc4, 0xa9, 0xea, 0x61, 0xa9, 0xe0, 0x40, 0xd5, 0x63, 0xa4, 0xde, 0x2b, 0xdb, 0xe3, 0xff,
+	0x36, 0xbb, 0x89, 0x28, 0xed, 0x81, 0x4b, 0xb2, 0x9e, 0xf7, 0xcd, 0x7b, 0x9e, 0xf7, 0x7d, 0xfb,
+	0xde, 0xbc, 0x05, 0x33, 0x86, 0x65, 0x50, 0x03, 0x2a, 0x26, 0xa1, 0x70, 0xd3, 0xb0, 0x74, 0x65,
+	0x6b, 0x49, 0xa1, 0x77, 0x64, 0xdb, 0xc1, 0x14, 0xf3, 0xbc, 0x6f, 0x94, 0x03, 0xa3, 0xbc, 0xb5,
+	0x24, 0x4c, 0x42, 0xd3, 0xb0, 0xb0, 0xe2, 0xfd, 0xf5, 0x61, 0x42, 0x45, 0xc5, 0xc4, 0xc4, 0x44,
+	0x69, 0x42, 0x82, 0x94, 0xad, 0xa5, 0x26, 0xa2, 0x70, 0x49, 0x51, 0xb1, 0x61, 0x31, 0xfb, 0x61,
+	0x66, 0x37, 0x89, 0xe7, 0xde, 0x24, 0x3a, 0x33, 0x4c, 0xfb, 0x86, 0x86, 0xf7, 0xa4, 0xf8, 0x0f,
+	0xcc, 0x34, 0xa5, 0x63, 0x1d, 0xfb, 0xeb, 0xee, 0xa7, 0x60, 0x83, 0x8e, 0xb1, 0xde, 0x42, 0x8a,
+	0xf7, 0xd4, 0x6c, 0x7f, 0xa6, 0x40, 0xab, 0xc3, 0x4c, 0x62, 0xda, 0x44, 0x0d, 0x13, 0x11, 0x0a,
+	0x4d, 0x9b, 0x01, 0xe6, 0x72, 0x4e, 0x1a, 0x9c, 0xcb, 0x43, 0x48, 0x5f, 0x0d, 0x01, 0xbe, 0x46,
+	0xf4, 0x35, 0x07, 0x41, 0x8a, 0x3e, 0x85, 0x2d, 0x43, 0x83, 0x14, 0x3b, 0xfc, 0x65, 0x30, 0xaa,
+	0x21, 0xa2, 0x3a, 0x86, 0x4d, 0x0d, 0x6c, 0x95, 0xb9, 0x39, 0x6e, 0x7e, 0x74, 0x59, 0x94, 0xb3,
+	0xb9, 0x91, 0xd7, 0x23, 0x58, 0x75, 0xe4, 0x61, 0x57, 0x1c, 0xf8, 0xf1, 0xc5, 0xfd, 0x05, 0xae,
+	0x1e, 0xdf, 0xce, 0x5f, 0x01, 0x40, 0xc5, 0xa6, 0x69, 0x10, 0xe2, 0x3a, 0x1b, 0xf4, 0x9c, 0x1d,
+	0xcb, 0x73, 0xb6, 0x16, 0xa2, 0xea, 0x90, 0x22, 0x12, 0x77, 0x18, 0xf3, 0xc0, 0x5f, 0x01, 0x93,
+	0x5b, 0xc1, 0xab, 0x36, 0xa0, 0xa6, 0x39, 0x88, 0x90, 0x72, 0x69, 0x8e, 0x9b, 0x1f, 0xa9, 0x1e,
+	0x7d, 0xf2, 0x60, 0xf1, 0x08, 0xcb, 0x6a, 0x78, 0x9c, 0x55, 0x1f, 0x72, 0x8d, 0x3a, 0x86, 0xa5,
+	0xd7, 0xf7, 0x6f, 0xa5, 0xd6, 0xf9, 0x8b, 0x60, 0xd8, 0x6e, 0x37, 0x37, 0x51, 0xa7, 0x3c, 0xe4,
+	0xbd, 0xdb, 0x94, 0xec, 0x27, 0x56, 0x0e, 0x12, 0x2b, 0xaf, 0x5a, 0x9d, 0x6a, 0xf9, 0xd1, 0x83,
+	0xc5, 0x29, 0xe6, 0x5a, 0x75, 0x3a, 0x36, 0xc5, 0xf2, 0xd5, 0x76, 0xf3, 0x13, 0xd4, 0xa9, 0xb3,
+	0xdd, 0x7c, 0x07, 0x0c, 0x43, 0x13, 0xb7, 0x2d, 0x5a, 0xde, 0x33, 0x57, 0x9a, 0x1f, 0x5d, 0x9e,
+	0x96, 0x19, 0xdc, 0x55, 0x89, 0xcc, 0x54, 0x22, 0xaf, 0x61, 0xc3, 0xaa, 0x5e, 0x74, 0x4f, 0xb6,
+	0xdd, 0x15, 0xc7, 0x3a, 0xd0, 0x6c, 0xad, 0x48, 0xfe, 0x36, 0xe9, 0xa7, 0xa7, 0xe2, 0xbc, 0x6e,
+	0xd0, 0x8d, 0x76, 0x53, 0x56, 0xb1, 0xc9, 0xd4, 0xc1, 0xfe, 0x2d, 0x12, 0x6d, 0x53, 0xa1, 0x1d,
+	0x1b, 0x11, 0xcf, 0x03, 0xf1, 0xd3, 0xc2, 0x02, 0xae, 0x7c, 0xf0, 0xcd, 0x3d, 0x71, 0xe0, 0x8f,
+	0x7b, 0xe2, 0xc0, 0x97, 0x2f, 0xee, 0x2f, 0x64, 0xb3, 0xf3, 0xed, 0x8b, 0xfb, 0x0b, 0x33, 0xa1,
+	0x0a, 0xb2, 0x84, 0x4b, 0xb3, 0x40, 0xc8, 0xae, 0xd6, 0x11, 0xb1, 0xb1, 0x45, 0x90, 0xf4, 0x74,
+	0x10, 0xec, 0xaf, 0x11, 0xfd, 0x82, 0x66, 0xd0, 0x48, 0x23, 0x1f, 0xed, 0x4a, 0x23, 0x43, 0xee,
+	0xc1, 0x93, 0xf2, 0xb8, 0x95, 0x47, 0xe7, 0xa0, 0x47, 0xe7, 0x99, 0xed, 0xae, 0x38, 0xce, 0x52,
+	0xe4, 0x1b, 0xa4, 0xdd, 0x10, 0xdc, 0x04, 0x13, 0x91, 0x7c, 0x1a, 0x0e, 0xa4, 0x88, 0xc9, 0xe5,
+	0xec, 0x6f, 0x5d, 0x71, 0xc6, 0xf7, 0x46, 0xb4, 0x4d, 0xd9, 0xc0, 0x8a, 0x09, 0xe9, 0x86, 0x7c,
+	0x19, 0xe9, 0x50, 0xed, 0xac, 0x23, 0x75, 0xbb, 0x2b, 0x1e, 0xf2, 0xc3, 0xa7, 0xf6, 0x4b, 0xf5,
+	0x71, 0x35, 0x21, 0xd6, 0x95, 0x73, 0xbd, 0x19, 0x98, 0x8e, 0x33, 0x90, 0x48, 0xa6, 0x24, 0x80,
+	0x72, 0x7a, 0x2d, 0xcc, 0xfe, 0xdd, 0x12, 0x18, 0xad, 0x11, 0x7d, 0x1d, 0xb5, 0x90, 0x0e, 0x29,
+	0xe2, 0x21, 0x98, 0xd4, 0xfc, 0xcf, 0xb1, 0x7c, 0x71, 0xde, 0x79, 0xde, 0xd9, 0xee, 0x8a, 0x65,
+	0xff, 0x85, 0x33, 0x10, 0xe9, 0x49, 0xa4, 0xdf, 0x54, 0xc2, 0x42, 0x6c, 0x90, 0xb0, 0xcf, 0x8b,
+	0x29, 0x39, 0x1f, 0x85, 0xc8, 0x40, 0x76, 0x45, 0x4e, 0xf4, 0xad, 0x29, 0xbd, 0xee, 0x6f, 0xcd,
+	0x7b, 0x09, 0xce, 0x32, 0x19, 0x73, 0x39, 0x9b, 0x8a, 0x73, 0x16, 0x50, 0x20, 0x1d, 0x04, 0x07,
+	0x62, 0x8f, 0x21, 0x53, 0xf7, 0xfc, 0x6a, 0x5a, 0x45, 0xba, 0x61, 0xd5, 0x91, 0xf6, 0x1a, 0x09,
+	0x6b, 0x83, 0x83, 0x11, 0x1b, 0xc4, 0x51, 0x53, 0xa4, 0xad, 0x6e, 0x77, 0xc5, 0xd9, 0x34, 0x69,
+	0x31, 0x58, 0x1f, 0xc4, 0x1d, 0x08, 0x37, 0x5e, 0x73, 0xd4, 0xdc, 0xb0, 0x1a, 0xa1, 0xa9, 0x6a,
+	0x9c, 0x1b, 0x36, 0x06, 0xdb, 0x51, 0xd8, 0x75, 0x42, 0xb3, 0x92, 0x19, 0xfa, 0x77, 0x0b, 0x6d,
+	0xae, 0x64, 0x12, 0x85, 0x36, 0xa5, 0x05, 0xc9, 0x06, 0x42, 0x76, 0x35, 0x10, 0x10, 0x5f, 0xf7,
+	0x0a, 0x95, 0xdd, 0x42, 0x6e, 0x61, 0x6c, 0xb8, 0xed, 0x9c, 0xd5, 0x55, 0x21, 0xd3, 0x92, 0xae,
+	0x07, 0xbd, 0xbe, 0x3a, 0xe6, 0x1e, 0xf1, 0xee, 0x53, 0x91, 0xf3, 0xdf, 0x74, 0x3c, 0xf2, 0xe0,
+	0x62, 0xa4, 0xef, 0x4a, 0x60, 0xac, 0x46, 0xf4, 0x1b, 0x96, 0xf6, 0xa6, 0x80, 0xbc, 0x6a, 0x35,
+	0x9c, 0xed, 0xad, 0x86, 0x43, 0x71, 0x35, 0x44, 0x24, 0x48, 0x3f, 0x70, 0xe0, 0x60, 0x62, 0x25,
+	0x14, 0x41, 0x6d, 0x37, 0x22, 0xd8, 0x1b, 0x88, 0x20, 0xcd, 0x3f, 0x7f, 0x2e, 0x4c, 0xcf, 0x60,
+	0xaf, 0xf4, 0xc4, 0xee, 0x5b, 0x6c, 0x8f, 0xf4, 0x7d, 0x09, 0xcc, 0xba, 0x37, 0x03, 0x68, 0xa9,
+	0xa8, 0x75, 0xc3, 0x6a, 0x62, 0x4b, 0x33, 0xac, 0xa0, 0xf2, 0xb9, 0xdd, 0xfb, 0x42, 0xb1, 0x98,
+	0xca, 0x3b, 0x10, 0xcc, 0x85, 0x62, 0xc1, 0xbc, 0xc4, 0xcd, 0x7f, 0x48, 0x0b, 0xfc, 0x29, 0x30,
+	0xa1, 0x3a, 0xc8, 0x4b, 0x4a, 0x63, 0x03, 0x19, 0xfa, 0x06, 0xf5, 0xae, 0x93, 0xa5, 0xfa, 0x78,
+	0xb0, 0x7c, 0xc9, 0x5b, 0x5d, 0xb9, 0xd4, 0x5b, 0x34, 0x27, 0x12, 0x77, 0xb5, 0xa2, 0xdc, 0x4b,
+	0x27, 0xc1, 0xf1, 0x97, 0xd9, 0xc3, 0xbe, 0xf4, 0xe7, 0x10, 0x38, 0x54, 0x23, 0xfa, 0xb5, 0xdb,
+	0xd0, 0x8e, 0xac, 0xeb, 0xc8, 0xc2, 0xe6, 0x9b, 0xde, 0xf4, 0xaa, 0x7b, 0x53, 0x2d, 0xd6, 0x9b,
+	0xb8, 0x97, 0x2b, 0x50, 0xc8, 0x55, 0x60, 0x52, 0x55, 0x2d, 0x30, 0x69, 0x1a, 0x56, 0x83, 0x42,
+	0x47, 0x47, 0xb4, 0x11, 0x8e, 0x17, 0x3d, 0x3c, 0x9f, 0x60, 0x9e, 0x19, 0x7d, 0x19, 0x0f, 0x2c,
+	0xc8, 0x84, 0x69, 0x58, 0xd7, 0xbd, 0xf5, 0x55, 0xbf, 0x9e, 0x55, 0x7b, 0x4b, 0x53, 0x8c, 0x4b,
+	0x33, 0x47, 0x51, 0xd2, 0x2f, 0x1c, 0xa8, 0xe4, 0x9b, 0xfe, 0xc9, 0x36, 0xc7, 0x7f, 0x0c, 0xc6,
+	0x92, 0x49, 0x1a, 0x9c, 0xe3, 0xfa, 0xae, 0x76, 0xfb, 0x68, 0x2c, 0x0b, 0xd2, 0x5f, 0x9c, 0x77,
+	0xbd, 0xab, 0x63, 0x0a, 0x29, 0x5a, 0xc3, 0x16, 0xf1, 0xe7, 0xbc, 0xfc, 0xb9, 0x93, 0xdb, 0xfd,
+	0xdc, 0x59, 0x03, 0xc0, 0x42, 0xb7, 0x1b, 0x6c, 0xf6, 0x1c, 0xdc, 0xd5, 0xec, 0x39, 0x62, 0xa1,
+	0xdb, 0x57, 0x3d, 0x07, 0x2b, 0x1f, 0xf6, 0x9e, 0x40, 0x66, 0xe3, 0xe4, 0xa5, 0xcf, 0x27, 0x1d,
+	0x01, 0x33, 0x39, 0xcb, 0x61, 0x15, 0xf9, 0x99, 0x03, 0x13, 0x6e, 0xc7, 0xb2, 0x35, 0x48, 0xd1,
+	0x55, 0xe8, 0x40, 0x93, 0xf0, 0xef, 0x82, 0x11, 0xd8, 0xa6, 0x1b, 0xd8, 0x31, 0x68, 0xa7, 0x67,
+	0xd5, 0x8f, 0xa0, 0xfc, 0x79, 0x30, 0x6c, 0x7b, 0x1e, 0xd8, 0xb1, 0x85, 0xbc, 0xb9, 0xd1, 0x8f,
+	0x91, 0xe8, 0x4a, 0xfe, 0xa6, 0x95, 0xd3, 0xee, 0x11, 0x23, 0x77, 0xee, 0xd1, 0xca, 0x89, 0x3e,
+	0x1b, 0x7b, 0x47, 0x69, 0x1a, 0x1c, 0x4e, 0x2d, 0x05, 0x47, 0x5a, 0x7e, 0xf4, 0x3f, 0x50, 0xaa,
+	0x11, 0x9d, 0x37, 0xc0, 0x44, 0xfa, 0x27, 0x90, 0x93, 0x79, 0x6f, 0x94, 0x9d, 0x91, 0x05, 0xb9,
+	0x3f, 0x5c, 0xa8, 0x7d, 0x15, 0x8c, 0x25, 0xe7, 0xe8, 0xe3, 0x05, 0x0e, 0x12, 0x28, 0xe1, 0xad,
+	0x7e, 0x50, 0x61, 0x90, 0xeb, 0x60, 0x6f, 0x38, 0x2e, 0x8a, 0x05, 0x3b, 0x03, 0x80, 0x70, 0xaa,
+	0x07, 0x20, 0xf4, 0x6a, 0x80, 0x89, 0xf4, 0x68, 0x53, 0x94, 0xa5, 0x14, 0x4e, 0x90, 0xfb, 0xc3,
+	0x85, 0xa1, 0x6e, 0x02, 0x10, 0xbb, 0xb0, 0x1e, 0x2d, 0xd8, 0x1d, 0x41, 0x84, 0xff, 0xf7, 0x84,
+	0x84, 0xbe, 0xbf, 0xe6, 0xc0, 0x74, 0xf1, 0x7d, 0xe6, 0xed, 0x22, 0x3e, 0x8b, 0x76, 0x08, 0xef,
+	0xef, 0x74, 0x47, 0xf8, 0x26, 0x6d, 0x70, 0x20, 0xaf, 0x27, 0x2f, 0x14, 0x38, 0xcc, 0xc1, 0x0a,
+	0xcb, 0xfd, 0x63, 0xc3, 0xb0, 0x2d, 0xb0, 0x3f, 0x53, 0xdb, 0x8a, 0x44, 0x90, 0x06, 0x0a, 0x4a,
+	0x9f, 0xc0, 0x30, 0xda, 0x2d, 0xb0, 0x2f, 0x51, 0x32, 0x8e, 0x15, 0x31, 0x15, 0x03, 0x09, 0xa7,
+	0xfb, 0x00, 0x05, 0x11, 0x84, 0x3d, 0x5f, 0xb8, 0xc5, 0xa1, 0x7a, 0xe9, 0xe1, 0xb3, 0x0a, 0xf7,
+	0xf8, 0x59, 0x85, 0xfb, 0xfd, 0x59, 0x85, 0xbb, 0xfb, 0xbc, 0x32, 0xf0, 0xf8, 0x79, 0x65, 0xe0,
+	0xd7, 0xe7, 0x95, 0x81, 0x9b, 0x72, 0xec, 0x46, 0xe7, 0xfb, 0x5d, 0x6c, 0xc1, 0x26, 0x61, 0x9f,
+	0x95, 0x3b, 0xd1, 0x0f, 0xa4, 0xde, 0xed, 0xae, 0x39, 0xec, 0x15, 0xdf, 0x33, 0x7f, 0x0f, 0x00,
+	0x6f, 0xe6, 0xf0, 0x0b, 0x2a, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -762,6 +958,13 @@ type MsgClient interface {
 	// CancelUnbondingDelegation defines a method for performing canceling the unbonding delegation
 	// and delegate back to previous validator.
 	CancelUnbondingDelegation(ctx context.Context, in *MsgCancelUnbondingDelegation, opts ...grpc.CallOption) (*MsgCancelUnbondingDelegationResponse, error)
+	// SwapDelegationDenom defines a method for converting delegated coins of
+	// one bond denom to another bond denom through the whitelisted dex pool and
+	// redelegating the converted coins to a destination validator.
+	SwapDelegationDenom(ctx context.Context, in *MsgSwapDelegationDenom, opts ...grpc.CallOption) (*MsgSwapDelegationDenomResponse, error)
+	// RotateConsPubKey defines a method for rotating the consensus public key
+	// of an existing validator.
+	RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error)
 	// UpdateParams defines an operation for updating the x/staking module
 	// parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -829,6 +1032,24 @@ func (c *msgClient) CancelUnbondingDelegation(ctx context.Context, in *MsgCancel
 	return out, nil
 }
 
+func (c *msgClient) SwapDelegationDenom(ctx context.Context, in *MsgSwapDelegationDenom, opts ...grpc.CallOption) (*MsgSwapDelegationDenomResponse, error) {
+	out := new(MsgSwapDelegationDenomResponse)
+	err := c.cc.Invoke(ctx, "/initia.mstaking.v1.Msg/SwapDelegationDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error) {
+	out := new(MsgRotateConsPubKeyResponse)
+	err := c.cc.Invoke(ctx, "/initia.mstaking.v1.Msg/RotateConsPubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/initia.mstaking.v1.Msg/UpdateParams", in, out, opts...)
@@ -856,6 +1077,13 @@ type MsgServer interface {
 	// CancelUnbondingDelegation defines a method for performing canceling the unbonding delegation
 	// and delegate back to previous validator.
 	CancelUnbondingDelegation(context.Context, *MsgCancelUnbondingDelegation) (*MsgCancelUnbondingDelegationResponse, error)
+	// SwapDelegationDenom defines a method for converting delegated coins of
+	// one bond denom to another bond denom through the whitelisted dex pool and
+	// redelegating the converted coins to a destination validator.
+	SwapDelegationDenom(context.Context, *MsgSwapDelegationDenom) (*MsgSwapDelegationDenomResponse, error)
+	// RotateConsPubKey defines a method for rotating the consensus public key
+	// of an existing validator.
+	RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error)
 	// UpdateParams defines an operation for updating the x/staking module
 	// parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -883,6 +1111,12 @@ func (*UnimplementedMsgServer) Undelegate(ctx context.Context, req *MsgUndelegat
 func (*UnimplementedMsgServer) CancelUnbondingDelegation(ctx context.Context, req *MsgCancelUnbondingDelegation) (*MsgCancelUnbondingDelegationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelUnbondingDelegation not implemented")
 }
+func (*UnimplementedMsgServer) SwapDelegationDenom(ctx context.Context, req *MsgSwapDelegationDenom) (*MsgSwapDelegationDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapDelegationDenom not implemented")
+}
+func (*UnimplementedMsgServer) RotateConsPubKey(ctx context.Context, req *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateConsPubKey not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -999,6 +1233,42 @@ func _Msg_CancelUnbondingDelegation_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SwapDelegationDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapDelegationDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapDelegationDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.mstaking.v1.Msg/SwapDelegationDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapDelegationDenom(ctx, req.(*MsgSwapDelegationDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateConsPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateConsPubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateConsPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.mstaking.v1.Msg/RotateConsPubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateConsPubKey(ctx, req.(*MsgRotateConsPubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -1017,6 +1287,7 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "initia.mstaking.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1045,6 +1316,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelUnbondingDelegation",
 			Handler:    _Msg_CancelUnbondingDelegation_Handler,
 		},
+		{
+			MethodName: "SwapDelegationDenom",
+			Handler:    _Msg_SwapDelegationDenom_Handler,
+		},
+		{
+			MethodName: "RotateConsPubKey",
+			Handler:    _Msg_RotateConsPubKey_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -1566,7 +1845,7 @@ func (m *MsgCancelUnbondingDelegationResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgSwapDelegationDenom) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1576,18 +1855,18 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSwapDelegationDenom) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSwapDelegationDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.MinTargetAmount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1595,18 +1874,42 @@ func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ValidatorDstAddress) > 0 {
+		i -= len(m.ValidatorDstAddress)
+		copy(dAtA[i:], m.ValidatorDstAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorDstAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorSrcAddress) > 0 {
+		i -= len(m.ValidatorSrcAddress)
+		copy(dAtA[i:], m.ValidatorSrcAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSwapDelegationDenomResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1616,63 +1919,209 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSwapDelegationDenomResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSwapDelegationDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.TargetAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintTx(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRotateConsPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRotateConsPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateConsPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Description.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Commission.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Pubkey != nil {
-		l = m.Pubkey.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.NewPubkey != nil {
+		{
+			size, err := m.NewPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *MsgRotateConsPubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateConsPubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateConsPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Description.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pubkey != nil {
+		l = m.Pubkey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	return n
 }
 
@@ -1849,6 +2298,70 @@ func (m *MsgCancelUnbondingDelegationResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSwapDelegationDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinTargetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSwapDelegationDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TargetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRotateConsPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewPubkey != nil {
+		l = m.NewPubkey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateConsPubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3291,6 +3804,502 @@ func (m *MsgCancelUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSwapDelegationDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapDelegationDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapDelegationDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTargetAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinTargetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapDelegationDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapDelegationDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapDelegationDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateConsPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateConsPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateConsPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPubkey == nil {
+				m.NewPubkey = &types.Any{}
+			}
+			if err := m.NewPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateConsPubKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateConsPubKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateConsPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
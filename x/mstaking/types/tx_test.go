@@ -235,3 +235,73 @@ func TestMsgUndelegate(t *testing.T) {
 		}
 	}
 }
+
+// test Validate for MsgSwapDelegationDenom
+func TestMsgSwapDelegationDenom(t *testing.T) {
+	tests := []struct {
+		name             string
+		delegatorAddr    sdk.AccAddress
+		validatorSrcAddr sdk.ValAddress
+		validatorDstAddr sdk.ValAddress
+		amount           sdk.Coin
+		minTargetAmount  sdk.Coin
+		expectPass       bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), valAddr2, valAddr3, sdk.NewInt64Coin("ulp", 1), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), true},
+		{"same validator", sdk.AccAddress(valAddr1), valAddr2, valAddr2, sdk.NewInt64Coin("ulp", 1), sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), true},
+		{"zero amount", sdk.AccAddress(valAddr1), valAddr2, valAddr3, sdk.NewInt64Coin("ulp", 0), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"empty target denom", sdk.AccAddress(valAddr1), valAddr2, valAddr3, sdk.NewInt64Coin("ulp", 1), sdk.Coin{}, false},
+		{"same denom", sdk.AccAddress(valAddr1), valAddr2, valAddr3, sdk.NewInt64Coin("ulp", 1), sdk.NewInt64Coin("ulp", 1), false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), valAddr1, valAddr3, sdk.NewInt64Coin("ulp", 1), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"empty source validator", sdk.AccAddress(valAddr1), emptyAddr, valAddr3, sdk.NewInt64Coin("ulp", 1), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"empty destination validator", sdk.AccAddress(valAddr1), valAddr2, emptyAddr, sdk.NewInt64Coin("ulp", 1), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+	}
+
+	accAddrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	valAddrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix())
+
+	for _, tc := range tests {
+		delAddrStr, err := accAddrCodec.BytesToString(tc.delegatorAddr)
+		require.NoError(t, err)
+		srcValAddrStr, err := valAddrCodec.BytesToString(tc.validatorSrcAddr)
+		require.NoError(t, err)
+		dstValAddrStr, err := valAddrCodec.BytesToString(tc.validatorDstAddr)
+		require.NoError(t, err)
+
+		msg := types.NewMsgSwapDelegationDenom(delAddrStr, srcValAddrStr, dstValAddrStr, tc.amount, tc.minTargetAmount)
+		if tc.expectPass {
+			require.Nil(t, msg.Validate(accAddrCodec, valAddrCodec), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.Validate(accAddrCodec, valAddrCodec), "test: %v", tc.name)
+		}
+	}
+}
+
+// test Validate for MsgRotateConsPubKey
+func TestMsgRotateConsPubKey(t *testing.T) {
+	tests := []struct {
+		name          string
+		validatorAddr sdk.ValAddress
+		pubkey        cryptotypes.PubKey
+		expectPass    bool
+	}{
+		{"basic good", valAddr1, pk2, true},
+		{"empty validator", emptyAddr, pk2, false},
+		{"empty pubkey", valAddr1, emptyPubkey, false},
+	}
+
+	valAddrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix())
+
+	for _, tc := range tests {
+		valAddrStr, err := valAddrCodec.BytesToString(tc.validatorAddr)
+		require.NoError(t, err)
+
+		msg, err := types.NewMsgRotateConsPubKey(valAddrStr, tc.pubkey)
+		require.NoError(t, err)
+		if tc.expectPass {
+			require.Nil(t, msg.Validate(valAddrCodec), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.Validate(valAddrCodec), "test: %v", tc.name)
+		}
+	}
+}
@@ -25,10 +25,6 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 	if err != nil {
 		return err
 	}
-	annualProvisions, err := k.GetAnnualProvisions(ctx)
-	if err != nil {
-		return err
-	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	timeDiff := sdkCtx.BlockTime().Sub(lastReleaseTimestamp)
@@ -52,20 +48,35 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 		return nil
 	}
 
-	remainRewardAmt := k.GetRemainRewardAmount(ctx, params.RewardDenom)
-	blockProvisionAmt := math.MinInt(remainRewardAmt, annualProvisions.Mul(math.LegacyNewDec(int64(timeDiff)).QuoInt64(int64(time.Hour*24*365))).TruncateInt())
+	// update release rate by the emission curve
+	releaseRate, err := k.UpdateReleaseRate(ctx, params, timeDiff)
+	if err != nil {
+		return err
+	}
+
+	annualProvisions, err := k.GetAnnualProvisions(ctx)
+	if err != nil {
+		return err
+	}
+
+	blockProvisionAmt, err := k.GetBlockProvision(ctx, params, timeDiff)
+	if err != nil {
+		return err
+	}
+
 	blockProvisionCoin := sdk.NewCoin(params.RewardDenom, blockProvisionAmt)
 	blockProvisionCoins := sdk.NewCoins(blockProvisionCoin)
 
-	// send the minted coins to the fee collector account
-	err = k.AddCollectedFees(ctx, blockProvisionCoins)
+	// send the released coins to the destinations and the fee collector account
+	err = k.SendReleasedRewards(ctx, params, blockProvisionCoins)
 	if err != nil {
 		return err
 	}
 
-	// update release rate on every year
-	if sdkCtx.BlockTime().Sub(lastDilutionTimestamp) >= params.DilutionPeriod {
-		// dilute release rate
+	// the dilution is only applied to the geometric curve
+	if params.EmissionSchedule.Curve == types.EmissionCurveGeometric &&
+		sdkCtx.BlockTime().Sub(lastDilutionTimestamp) >= params.DilutionPeriod {
+		// dilute release rate on every dilution period
 		releaseRate := params.ReleaseRate.Sub(params.ReleaseRate.Mul(params.DilutionRate))
 		if releaseRate.IsNegative() {
 			releaseRate = math.LegacyZeroDec()
@@ -92,7 +103,7 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeReward,
-			sdk.NewAttribute(types.AttributeKeyReleaseRate, params.ReleaseRate.String()),
+			sdk.NewAttribute(types.AttributeKeyReleaseRate, releaseRate.String()),
 			sdk.NewAttribute(types.AttributeKeyAnnualProvisions, annualProvisions.String()),
 			sdk.NewAttribute(sdk.AttributeKeyAmount, blockProvisionAmt.String()),
 		),
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -25,6 +26,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryAnnualProvisions(),
 		GetCmdQueryLastDilutionTimestamp(),
+		GetCmdQueryEmissionForecast(),
 	)
 
 	return mintingQueryCmd
@@ -117,3 +119,36 @@ func GetCmdQueryLastDilutionTimestamp() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryEmissionForecast implements a command to return the forecasted
+// emissions for the next periods.
+func GetCmdQueryEmissionForecast() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emission-forecast [num-periods]",
+		Short: "Query the forecasted emissions for the next dilution periods",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			numPeriods, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EmissionForecast(cmd.Context(), &types.QueryEmissionForecastRequest{NumPeriods: uint32(numPeriods)})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
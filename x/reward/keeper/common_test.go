@@ -307,6 +307,7 @@ func _createTestInput(
 		accountKeeper,
 		bankKeeper,
 		movekeeper.NewVotingPowerKeeper(moveKeeper),
+		movekeeper.NewDexKeeper(moveKeeper),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		vc, cc,
 	)
@@ -333,6 +334,7 @@ func _createTestInput(
 		bankKeeper,
 		stakingKeeper,
 		movekeeper.NewDexKeeper(moveKeeper),
+		rewardKeeper,
 		authtypes.FeeCollectorName,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -342,6 +344,7 @@ func _createTestInput(
 	}
 	require.NoError(t, distKeeper.Params.Set(ctx, distrParams))
 	stakingKeeper.SetHooks(distKeeper.Hooks())
+	rewardKeeper.SetCommunityPoolKeeper(distKeeper)
 
 	// set genesis items required for distribution
 	require.NoError(t, distKeeper.FeePool.Set(ctx, distributiontypes.InitialFeePool()))
@@ -395,6 +398,7 @@ func _createTestInput(
 		stakingKeeper,
 		distKeeper,
 		movekeeper.NewVestingKeeper(moveKeeper),
+		movekeeper.NewMoveGovKeeper(moveKeeper),
 		msgRouter,
 		govConfig,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
+	"github.com/initia-labs/initia/x/reward/types"
+)
+
+// GetBondedRatio returns the ratio of the reward denom supply bonded to the validators.
+func (k Keeper) GetBondedRatio(ctx context.Context, denom string) math.LegacyDec {
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if !supply.IsPositive() {
+		return math.LegacyZeroDec()
+	}
+
+	bonded := k.bankKeeper.GetBalance(ctx, k.accKeeper.GetModuleAddress(stakingtypes.BondedPoolName), denom).Amount
+	return math.LegacyNewDecFromInt(bonded).QuoInt(supply)
+}
+
+// UpdateReleaseRate updates the release rate by the emission curve for the elapsed time
+// and returns the release rate in effect. The computed rate is stored apart from the params,
+// so the release rate params are left untouched. The geometric curve dilutes the release
+// rate params after the release instead.
+func (k Keeper) UpdateReleaseRate(ctx context.Context, params types.Params, elapsed time.Duration) (math.LegacyDec, error) {
+	schedule := params.EmissionSchedule
+
+	var releaseRate math.LegacyDec
+	switch schedule.Curve {
+	case types.EmissionCurvePiecewiseLinear:
+		releaseRate = schedule.PiecewiseLinear.ReleaseRateAt(sdk.UnwrapSDKContext(ctx).BlockTime())
+	case types.EmissionCurveTargetStakingRatio:
+		currentRate, err := k.getReleaseRate(ctx, params)
+		if err != nil {
+			return math.LegacyZeroDec(), err
+		}
+
+		bondedRatio := k.GetBondedRatio(ctx, params.RewardDenom)
+		releaseRate = schedule.TargetStakingRatio.NextReleaseRate(currentRate, bondedRatio, elapsed)
+	default:
+		return params.ReleaseRate, nil
+	}
+
+	return releaseRate, k.CurrentReleaseRate.Set(ctx, releaseRate)
+}
+
+// GetBlockProvision returns the amount to be released for the block, which is bounded
+// by the remaining rewards.
+func (k Keeper) GetBlockProvision(ctx context.Context, params types.Params, elapsed time.Duration) (math.Int, error) {
+	var provision math.Int
+	if params.EmissionSchedule.Curve == types.EmissionCurveFixedPerBlock {
+		provision = params.EmissionSchedule.FixedPerBlock.Amount
+	} else {
+		annualProvisions, err := k.GetAnnualProvisions(ctx)
+		if err != nil {
+			return math.ZeroInt(), err
+		}
+
+		provision = annualProvisions.Mul(math.LegacyNewDec(int64(elapsed)).QuoInt64(int64(types.Year))).TruncateInt()
+	}
+
+	return math.MinInt(k.GetRemainRewardAmount(ctx, params.RewardDenom), provision), nil
+}
+
+// GetEmissionForecast forecasts the emissions for the next periods of the dilution period
+// length from the current block time, assuming the current supply and bonded ratio. The
+// forecasted amounts are bounded by the remaining rewards.
+func (k Keeper) GetEmissionForecast(ctx context.Context, numPeriods uint32) ([]types.EmissionForecastPeriod, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := params.EmissionSchedule
+	supply := k.bankKeeper.GetSupply(ctx, params.RewardDenom).Amount
+	remaining := k.GetRemainRewardAmount(ctx, params.RewardDenom)
+	bondedRatio := k.GetBondedRatio(ctx, params.RewardDenom)
+
+	period := params.DilutionPeriod
+	if period <= 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("dilution period must be positive")
+	}
+	years := math.LegacyNewDec(int64(period)).QuoInt64(int64(types.Year))
+
+	startTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	releaseRate, err := k.getReleaseRate(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	periods := make([]types.EmissionForecastPeriod, numPeriods)
+	for i := range periods {
+		endTime := startTime.Add(period)
+
+		var amount math.LegacyDec
+		switch schedule.Curve {
+		case types.EmissionCurvePiecewiseLinear:
+			releaseRate = schedule.PiecewiseLinear.ReleaseRateAt(startTime)
+			amount = schedule.PiecewiseLinear.IntegrateReleaseRate(startTime, endTime).MulInt(supply)
+		case types.EmissionCurveFixedPerBlock:
+			amount = schedule.FixedPerBlock.AnnualProvisions().Mul(years)
+		case types.EmissionCurveTargetStakingRatio:
+			releaseRate = schedule.TargetStakingRatio.NextReleaseRate(releaseRate, bondedRatio, period)
+			amount = releaseRate.MulInt(supply).Mul(years)
+		default:
+			amount = releaseRate.MulInt(supply).Mul(years)
+		}
+
+		released := math.ZeroInt()
+		if params.ReleaseEnabled {
+			released = math.MinInt(remaining, amount.TruncateInt())
+			remaining = remaining.Sub(released)
+		}
+
+		periods[i] = types.EmissionForecastPeriod{
+			StartTime:   startTime,
+			EndTime:     endTime,
+			ReleaseRate: releaseRate,
+			Amount:      released,
+		}
+
+		// dilute the release rate at the end of the period
+		if schedule.Curve == types.EmissionCurveGeometric {
+			releaseRate = math.LegacyMaxDec(releaseRate.Sub(releaseRate.Mul(params.DilutionRate)), math.LegacyZeroDec())
+		}
+
+		startTime = endTime
+	}
+
+	return periods, nil
+}
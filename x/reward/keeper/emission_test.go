@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/reward/keeper"
+	"github.com/initia-labs/initia/x/reward/types"
+)
+
+func Test_PiecewiseLinearEmission(t *testing.T) {
+	now := time.Now().UTC()
+	emission := types.PiecewiseLinearEmission{
+		Points: []types.EmissionPoint{
+			{Time: now, ReleaseRate: math.LegacyNewDecWithPrec(10, 2)},
+			{Time: now.Add(types.Year), ReleaseRate: math.LegacyNewDecWithPrec(6, 2)},
+			{Time: now.Add(2 * types.Year), ReleaseRate: math.LegacyNewDecWithPrec(6, 2)},
+		},
+	}
+	require.NoError(t, emission.Validate())
+
+	require.Equal(t, math.LegacyNewDecWithPrec(10, 2), emission.ReleaseRateAt(now.Add(-time.Hour)))
+	require.Equal(t, math.LegacyNewDecWithPrec(8, 2), emission.ReleaseRateAt(now.Add(types.Year/2)))
+	require.Equal(t, math.LegacyNewDecWithPrec(6, 2), emission.ReleaseRateAt(now.Add(3*types.Year)))
+
+	// (0.1 + 0.06) / 2 for the first year and 0.06 for the second year
+	require.Equal(t, math.LegacyNewDecWithPrec(14, 2), emission.IntegrateReleaseRate(now, now.Add(2*types.Year)))
+
+	// unsorted points
+	emission.Points[0], emission.Points[1] = emission.Points[1], emission.Points[0]
+	require.Error(t, emission.Validate())
+}
+
+func Test_TargetStakingRatioEmission(t *testing.T) {
+	emission := types.TargetStakingRatioEmission{
+		GoalBonded:        math.LegacyNewDecWithPrec(5, 1),
+		RateChangePerYear: math.LegacyNewDecWithPrec(2, 2),
+		MinReleaseRate:    math.LegacyNewDecWithPrec(2, 2),
+		MaxReleaseRate:    math.LegacyNewDecWithPrec(10, 2),
+	}
+	require.NoError(t, emission.Validate())
+
+	rate := math.LegacyNewDecWithPrec(5, 2)
+
+	// below the goal; increase the release rate
+	require.Equal(t, math.LegacyNewDecWithPrec(6, 2), emission.NextReleaseRate(rate, math.LegacyNewDecWithPrec(25, 2), types.Year))
+
+	// above the goal; decrease the release rate
+	require.Equal(t, math.LegacyNewDecWithPrec(4, 2), emission.NextReleaseRate(rate, math.LegacyNewDecWithPrec(75, 2), types.Year))
+
+	// bounded by the min and max release rates
+	require.Equal(t, emission.MaxReleaseRate, emission.NextReleaseRate(rate, math.LegacyZeroDec(), 10*types.Year))
+	require.Equal(t, emission.MinReleaseRate, emission.NextReleaseRate(rate, math.LegacyOneDec(), 10*types.Year))
+
+	emission.MinReleaseRate = math.LegacyNewDecWithPrec(20, 2)
+	require.Error(t, emission.Validate())
+}
+
+func Test_UpdateReleaseRate(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params, err := input.RewardKeeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	// the geometric curve does not update the release rate
+	releaseRate, err := input.RewardKeeper.UpdateReleaseRate(ctx, params, types.Year)
+	require.NoError(t, err)
+	require.Equal(t, params.ReleaseRate, releaseRate)
+
+	now := ctx.BlockTime()
+	params.EmissionSchedule = types.EmissionSchedule{
+		Curve: types.EmissionCurvePiecewiseLinear,
+		PiecewiseLinear: &types.PiecewiseLinearEmission{
+			Points: []types.EmissionPoint{
+				{Time: now.Add(-types.Year), ReleaseRate: math.LegacyNewDecWithPrec(10, 2)},
+				{Time: now.Add(types.Year), ReleaseRate: math.LegacyNewDecWithPrec(6, 2)},
+			},
+		},
+	}
+	require.NoError(t, input.RewardKeeper.SetParams(ctx, params))
+
+	releaseRate, err = input.RewardKeeper.UpdateReleaseRate(ctx, params, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(8, 2), releaseRate)
+
+	stored, err := input.RewardKeeper.GetReleaseRate(ctx)
+	require.NoError(t, err)
+	require.Equal(t, releaseRate, stored)
+
+	// the params are left untouched
+	storedParams, err := input.RewardKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, storedParams)
+
+	// the computed release rate is exported and imported
+	require.NoError(t, input.RewardKeeper.SetLastReleaseTimestamp(ctx, now))
+	require.NoError(t, input.RewardKeeper.SetLastDilutionTimestamp(ctx, now))
+	genState := input.RewardKeeper.ExportGenesis(ctx)
+	require.NotNil(t, genState.CurrentReleaseRate)
+	require.Equal(t, releaseRate, *genState.CurrentReleaseRate)
+	require.NoError(t, types.ValidateGenesis(*genState, input.AccountKeeper.AddressCodec()))
+
+	require.NoError(t, input.RewardKeeper.CurrentReleaseRate.Remove(ctx))
+	input.RewardKeeper.InitGenesis(ctx, genState)
+	stored, err = input.RewardKeeper.GetReleaseRate(ctx)
+	require.NoError(t, err)
+	require.Equal(t, releaseRate, stored)
+}
+
+func Test_GetBlockProvision(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params, err := input.RewardKeeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	rewardModuleAddr := input.AccountKeeper.GetModuleAddress(types.ModuleName)
+	input.Faucet.Fund(ctx, rewardModuleAddr, sdk.NewInt64Coin(params.RewardDenom, 1_000))
+
+	params.EmissionSchedule = types.EmissionSchedule{
+		Curve: types.EmissionCurveFixedPerBlock,
+		FixedPerBlock: &types.FixedPerBlockEmission{
+			Amount:        math.NewInt(100),
+			BlocksPerYear: 1_000,
+		},
+	}
+
+	provision, err := input.RewardKeeper.GetBlockProvision(ctx, params, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), provision)
+
+	// bounded by the remaining rewards
+	params.EmissionSchedule.FixedPerBlock.Amount = math.NewInt(10_000)
+	provision, err = input.RewardKeeper.GetBlockProvision(ctx, params, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1_000), provision)
+}
+
+func Test_GRPCEmissionForecast(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params, err := input.RewardKeeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	params.ReleaseEnabled = true
+	params.DilutionPeriod = types.Year
+	params.DilutionRate = math.LegacyNewDecWithPrec(5, 1)
+	params.ReleaseRate = math.LegacyNewDecWithPrec(1, 2)
+	require.NoError(t, input.RewardKeeper.SetParams(ctx, params))
+
+	supply := input.BankKeeper.GetSupply(ctx, params.RewardDenom).Amount
+	remaining := supply.QuoRaw(80)
+
+	rewardModuleAddr := input.AccountKeeper.GetModuleAddress(types.ModuleName)
+	input.Faucet.Fund(ctx, rewardModuleAddr, sdk.NewCoin(params.RewardDenom, remaining))
+
+	// the funded coins are newly minted
+	supply = input.BankKeeper.GetSupply(ctx, params.RewardDenom).Amount
+
+	qs := keeper.NewQueryServerImpl(&input.RewardKeeper)
+	_, err = qs.EmissionForecast(ctx, &types.QueryEmissionForecastRequest{NumPeriods: 0})
+	require.Error(t, err)
+	_, err = qs.EmissionForecast(ctx, &types.QueryEmissionForecastRequest{NumPeriods: types.MaxForecastPeriods + 1})
+	require.Error(t, err)
+
+	res, err := qs.EmissionForecast(ctx, &types.QueryEmissionForecastRequest{NumPeriods: 3})
+	require.NoError(t, err)
+	require.Len(t, res.Periods, 3)
+
+	// the geometric curve halves the release rate on every period
+	require.Equal(t, ctx.BlockTime(), res.Periods[0].StartTime)
+	require.Equal(t, ctx.BlockTime().Add(types.Year), res.Periods[0].EndTime)
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 2), res.Periods[0].ReleaseRate)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 3), res.Periods[1].ReleaseRate)
+	require.Equal(t, res.Periods[0].EndTime, res.Periods[1].StartTime)
+
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 2).MulInt(supply).TruncateInt(), res.Periods[0].Amount)
+
+	// bounded by the remaining rewards
+	total := res.Periods[0].Amount.Add(res.Periods[1].Amount).Add(res.Periods[2].Amount)
+	require.Equal(t, remaining, total)
+	require.True(t, res.Periods[2].Amount.IsZero())
+}
+
+func Test_GRPCEmissionForecast_ZeroDilutionPeriod(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params, err := input.RewardKeeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	// bypass the params validation
+	params.DilutionPeriod = 0
+	require.NoError(t, input.RewardKeeper.Params.Set(ctx, params))
+
+	qs := keeper.NewQueryServerImpl(&input.RewardKeeper)
+	_, err = qs.EmissionForecast(ctx, &types.QueryEmissionForecastRequest{NumPeriods: 1})
+	require.Error(t, err)
+}
@@ -2,12 +2,18 @@ package keeper
 
 import (
 	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
 
 	"github.com/initia-labs/initia/x/reward/types"
 )
 
 // InitGenesis new mint genesis
 func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) {
+	if err := k.validateReleaseDestinations(data.Params.ReleaseDestinations); err != nil {
+		panic(err)
+	}
 
 	if err := k.SetParams(ctx, data.Params); err != nil {
 		panic(err)
@@ -21,6 +27,12 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) {
 		panic(err)
 	}
 
+	if data.CurrentReleaseRate != nil {
+		if err := k.CurrentReleaseRate.Set(ctx, *data.CurrentReleaseRate); err != nil {
+			panic(err)
+		}
+	}
+
 	k.accKeeper.GetModuleAccount(ctx, types.ModuleName)
 }
 
@@ -41,5 +53,14 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, lastMintTimestamp, lastDilutionTimestamp)
+	genState := types.NewGenesisState(params, lastMintTimestamp, lastDilutionTimestamp)
+
+	currentReleaseRate, err := k.CurrentReleaseRate.Get(ctx)
+	if err == nil {
+		genState.CurrentReleaseRate = &currentReleaseRate
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+
+	return genState
 }
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/initia-labs/initia/x/reward/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -39,3 +42,18 @@ func (qs QueryServer) LastDilutionTimestamp(c context.Context, _ *types.QueryLas
 	lastDilutionTimestamp, err := qs.GetLastDilutionTimestamp(ctx)
 	return &types.QueryLastDilutionTimestampResponse{LastDilutionTimestamp: lastDilutionTimestamp}, err
 }
+
+// EmissionForecast returns the forecasted emissions for the next periods.
+func (qs QueryServer) EmissionForecast(c context.Context, req *types.QueryEmissionForecastRequest) (*types.QueryEmissionForecastResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.NumPeriods == 0 || req.NumPeriods > types.MaxForecastPeriods {
+		return nil, status.Errorf(codes.InvalidArgument, "number of periods must be in [1, %d]", types.MaxForecastPeriods)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	periods, err := qs.GetEmissionForecast(ctx, req.NumPeriods)
+	return &types.QueryEmissionForecastResponse{Periods: periods}, err
+}
@@ -25,6 +25,10 @@ type Keeper struct {
 	bankKeeper       types.BankKeeper
 	feeCollectorName string
 
+	// communityPoolKeeper is set after the creation because the distribution
+	// keeper depends on the reward keeper.
+	communityPoolKeeper types.CommunityPoolKeeper
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -33,6 +37,10 @@ type Keeper struct {
 	Params                collections.Item[types.Params]
 	LastReleaseTimestamp  collections.Item[time.Time]
 	LastDilutionTimestamp collections.Item[time.Time]
+
+	// CurrentReleaseRate is the release rate computed by the piecewise linear and
+	// target staking ratio curves, which is kept apart from the params.
+	CurrentReleaseRate collections.Item[math.LegacyDec]
 }
 
 // NewKeeper creates a new reward Keeper instance
@@ -60,6 +68,7 @@ func NewKeeper(
 		Params:                collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		LastReleaseTimestamp:  collections.NewItem(sb, types.LastReleaseTimestampKey, "last_release_timestamp", collectioncodec.KeyToValueCodec(sdk.TimeKey)),
 		LastDilutionTimestamp: collections.NewItem(sb, types.LastDilutionTimestampKey, "last_dilution_timestamp", collectioncodec.KeyToValueCodec(sdk.TimeKey)),
+		CurrentReleaseRate:    collections.NewItem(sb, types.CurrentReleaseRateKey, "current_release_rate", sdk.LegacyDecValue),
 	}
 
 	schema, err := sb.Build()
@@ -71,6 +80,17 @@ func NewKeeper(
 	return k
 }
 
+// SetCommunityPoolKeeper sets the community pool keeper used to fund the community pool
+// with the release destination share.
+func (k *Keeper) SetCommunityPoolKeeper(cpk types.CommunityPoolKeeper) *Keeper {
+	if k.communityPoolKeeper != nil {
+		panic("cannot set community pool keeper twice")
+	}
+
+	k.communityPoolKeeper = cpk
+	return k
+}
+
 // GetAuthority returns the x/reward module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -111,7 +131,16 @@ func (k Keeper) GetAnnualProvisions(ctx context.Context) (math.LegacyDec, error)
 		return math.LegacyZeroDec(), err
 	}
 
-	annualProvisions := params.ReleaseRate.MulInt(k.bankKeeper.GetSupply(ctx, params.RewardDenom).Amount)
+	if params.EmissionSchedule.Curve == types.EmissionCurveFixedPerBlock {
+		return params.EmissionSchedule.FixedPerBlock.AnnualProvisions(), nil
+	}
+
+	releaseRate, err := k.getReleaseRate(ctx, params)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	annualProvisions := releaseRate.MulInt(k.bankKeeper.GetSupply(ctx, params.RewardDenom).Amount)
 	return annualProvisions, nil
 }
 
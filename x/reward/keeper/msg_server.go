@@ -7,6 +7,8 @@ import (
 
 	"cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -31,6 +33,27 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
 	}
 
+	if err := ms.validateReleaseDestinations(msg.Params.ReleaseDestinations); err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	params, err := ms.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// restart the emission curve from the release rate params when the curve or
+	// the release rate is changed.
+	if params.EmissionSchedule.Curve != msg.Params.EmissionSchedule.Curve ||
+		!params.ReleaseRate.Equal(msg.Params.ReleaseRate) {
+		if err := ms.CurrentReleaseRate.Remove(ctx); err != nil {
+			return nil, err
+		}
+		if err := ms.SetLastDilutionTimestamp(ctx, sdk.UnwrapSDKContext(ctx).BlockTime()); err != nil {
+			return nil, err
+		}
+	}
+
 	// store params
 	if err := ms.SetParams(ctx, msg.Params); err != nil {
 		return nil, err
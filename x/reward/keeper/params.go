@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 
 	"github.com/initia-labs/initia/x/reward/types"
@@ -19,14 +21,33 @@ func (k Keeper) SetReleaseRate(ctx context.Context, rate math.LegacyDec) error {
 	return k.SetParams(ctx, params)
 }
 
-// GetReleaseRate return release rate params
+// GetReleaseRate returns the release rate in effect, which is the rate computed by the
+// emission curve for the piecewise linear and target staking ratio curves and the release
+// rate params otherwise.
 func (k Keeper) GetReleaseRate(ctx context.Context) (math.LegacyDec, error) {
 	params, err := k.GetParams(ctx)
 	if err != nil {
 		return math.LegacyZeroDec(), err
 	}
 
-	return params.ReleaseRate, nil
+	return k.getReleaseRate(ctx, params)
+}
+
+func (k Keeper) getReleaseRate(ctx context.Context, params types.Params) (math.LegacyDec, error) {
+	switch params.EmissionSchedule.Curve {
+	case types.EmissionCurvePiecewiseLinear, types.EmissionCurveTargetStakingRatio:
+		releaseRate, err := k.CurrentReleaseRate.Get(ctx)
+		if errors.Is(err, collections.ErrNotFound) {
+			// the curve has not computed the release rate yet
+			return params.ReleaseRate, nil
+		} else if err != nil {
+			return math.LegacyZeroDec(), err
+		}
+
+		return releaseRate, nil
+	default:
+		return params.ReleaseRate, nil
+	}
 }
 
 // GetParams returns the current x/slashing module parameters.
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/reward/types"
+)
+
+// SendReleasedRewards splits the released rewards across the release destinations by
+// their ratios and sends the rest to the fee collector as the staking rewards.
+func (k Keeper) SendReleasedRewards(ctx context.Context, params types.Params, rewards sdk.Coins) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	remaining := rewards
+	for _, destination := range params.ReleaseDestinations {
+		share, _ := sdk.NewDecCoinsFromCoins(rewards...).MulDecTruncate(destination.Ratio).TruncateDecimal()
+		if share.IsZero() {
+			continue
+		}
+
+		// the share falls back to the fee collector when the destination cannot
+		// receive it, so a misconfigured destination does not halt the chain.
+		cacheCtx, writeCache := sdkCtx.CacheContext()
+		recipient, err := k.sendToReleaseDestination(cacheCtx, destination, share)
+		if err != nil {
+			k.Logger(ctx).Error("failed to send released rewards to the destination", "destination", destination.Name, "error", err)
+			continue
+		}
+		writeCache()
+
+		remaining = remaining.Sub(share...)
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeReleaseDestination,
+				sdk.NewAttribute(types.AttributeKeyDestination, destination.Name),
+				sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, share.String()),
+			),
+		)
+	}
+
+	if remaining.IsZero() {
+		return nil
+	}
+
+	// send the rest to the fee collector account
+	if err := k.AddCollectedFees(ctx, remaining); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReleaseDestination,
+			sdk.NewAttribute(types.AttributeKeyDestination, types.FeeCollectorDestinationName),
+			sdk.NewAttribute(types.AttributeKeyRecipient, k.feeCollectorName),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, remaining.String()),
+		),
+	)
+
+	return nil
+}
+
+// sendToReleaseDestination sends the share to the destination and returns the recipient
+// to be emitted with the event.
+func (k Keeper) sendToReleaseDestination(ctx context.Context, destination types.ReleaseDestination, share sdk.Coins) (string, error) {
+	switch destination.DestinationType {
+	case types.ReleaseDestinationTypeAddress:
+		addr, err := k.accKeeper.AddressCodec().StringToBytes(destination.Address)
+		if err != nil {
+			return "", err
+		}
+
+		return destination.Address, k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, share)
+	case types.ReleaseDestinationTypeModule:
+		return destination.Address, k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, destination.Address, share)
+	case types.ReleaseDestinationTypeCommunityPool:
+		if k.communityPoolKeeper == nil {
+			return "", fmt.Errorf("community pool keeper is not set")
+		}
+
+		return "community_pool", k.communityPoolKeeper.FundCommunityPool(ctx, share, k.accKeeper.GetModuleAddress(types.ModuleName))
+	default:
+		return "", fmt.Errorf("unknown release destination type: %s", destination.DestinationType)
+	}
+}
+
+// validateReleaseDestinations checks the release destinations can receive the rewards.
+func (k Keeper) validateReleaseDestinations(destinations []types.ReleaseDestination) error {
+	for _, destination := range destinations {
+		switch destination.DestinationType {
+		case types.ReleaseDestinationTypeAddress:
+			addr, err := k.accKeeper.AddressCodec().StringToBytes(destination.Address)
+			if err != nil {
+				return err
+			}
+			if k.bankKeeper.BlockedAddr(addr) {
+				return fmt.Errorf("address %s of destination %s is not allowed to receive funds", destination.Address, destination.Name)
+			}
+		case types.ReleaseDestinationTypeModule:
+			if k.accKeeper.GetModuleAddress(destination.Address) == nil {
+				return fmt.Errorf("module account %s of destination %s does not exist", destination.Address, destination.Name)
+			}
+		case types.ReleaseDestinationTypeCommunityPool:
+			if k.communityPoolKeeper == nil {
+				return fmt.Errorf("community pool keeper is not set for destination %s", destination.Name)
+			}
+		}
+	}
+
+	return nil
+}
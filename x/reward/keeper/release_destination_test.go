@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	movetypes "github.com/initia-labs/initia/x/move/types"
+	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
+	"github.com/initia-labs/initia/x/reward/keeper"
+	"github.com/initia-labs/initia/x/reward/types"
+)
+
+func Test_SendReleasedRewards(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params, err := input.RewardKeeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	_, _, ecosystemFund := keyPubAddr()
+	params.ReleaseDestinations = []types.ReleaseDestination{
+		{
+			Name:            "ecosystem",
+			DestinationType: types.ReleaseDestinationTypeAddress,
+			Address:         ecosystemFund.String(),
+			Ratio:           math.LegacyNewDecWithPrec(2, 1),
+		},
+		{
+			Name:            "vip",
+			DestinationType: types.ReleaseDestinationTypeModule,
+			Address:         movetypes.MoveStakingModuleName,
+			Ratio:           math.LegacyNewDecWithPrec(3, 1),
+		},
+		{
+			Name:            "community",
+			DestinationType: types.ReleaseDestinationTypeCommunityPool,
+			Ratio:           math.LegacyNewDecWithPrec(1, 1),
+		},
+	}
+	require.NoError(t, params.Validate())
+
+	rewardCoin := sdk.NewCoin(params.RewardDenom, math.NewInt(1_000))
+	rewardModuleAddr := input.AccountKeeper.GetModuleAddress(types.ModuleName)
+	input.Faucet.Fund(ctx, rewardModuleAddr, rewardCoin)
+
+	feeCollectorAddr := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBalance := input.BankKeeper.GetBalance(ctx, feeCollectorAddr, params.RewardDenom)
+	moduleBalance := input.BankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(movetypes.MoveStakingModuleName), params.RewardDenom)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, input.RewardKeeper.SendReleasedRewards(ctx, params, sdk.NewCoins(rewardCoin)))
+
+	require.Equal(t, math.NewInt(200), input.BankKeeper.GetBalance(ctx, ecosystemFund, params.RewardDenom).Amount)
+	require.Equal(t, math.NewInt(300), input.BankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(movetypes.MoveStakingModuleName), params.RewardDenom).Amount.Sub(moduleBalance.Amount))
+	require.Equal(t, math.NewInt(400), input.BankKeeper.GetBalance(ctx, feeCollectorAddr, params.RewardDenom).Amount.Sub(feeCollectorBalance.Amount))
+
+	feePool, err := input.DistKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(100), feePool.CommunityPool.AmountOf(params.RewardDenom))
+
+	var destinations []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeReleaseDestination {
+			continue
+		}
+
+		attr, found := event.GetAttribute(types.AttributeKeyDestination)
+		require.True(t, found)
+		destinations = append(destinations, attr.Value)
+	}
+	require.Equal(t, []string{"ecosystem", "vip", "community", types.FeeCollectorDestinationName}, destinations)
+}
+
+func Test_ReleaseDestinationsValidate(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params, err := input.RewardKeeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	// ratios sum up to more than one
+	params.ReleaseDestinations = []types.ReleaseDestination{
+		{Name: "a", DestinationType: types.ReleaseDestinationTypeCommunityPool, Ratio: math.LegacyNewDecWithPrec(6, 1)},
+		{Name: "b", DestinationType: types.ReleaseDestinationTypeCommunityPool, Ratio: math.LegacyNewDecWithPrec(6, 1)},
+	}
+	require.Error(t, params.Validate())
+
+	// duplicate names
+	params.ReleaseDestinations[1].Name = "a"
+	params.ReleaseDestinations[1].Ratio = math.LegacyNewDecWithPrec(1, 1)
+	require.Error(t, params.Validate())
+
+	// reserved name
+	params.ReleaseDestinations = []types.ReleaseDestination{
+		{Name: types.FeeCollectorDestinationName, DestinationType: types.ReleaseDestinationTypeCommunityPool, Ratio: math.LegacyNewDecWithPrec(1, 1)},
+	}
+	require.Error(t, params.Validate())
+
+	// unknown module account
+	params.ReleaseDestinations = []types.ReleaseDestination{
+		{Name: "unknown", DestinationType: types.ReleaseDestinationTypeModule, Address: "unknown", Ratio: math.LegacyNewDecWithPrec(1, 1)},
+	}
+	require.NoError(t, params.Validate())
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	ms := keeper.NewMsgServerImpl(&input.RewardKeeper)
+	_, err = ms.UpdateParams(ctx, types.NewMsgUpdateParams(authority, params))
+	require.Error(t, err)
+
+	// invalid account address
+	params.ReleaseDestinations = []types.ReleaseDestination{
+		{Name: "invalid", DestinationType: types.ReleaseDestinationTypeAddress, Address: "invalid", Ratio: math.LegacyNewDecWithPrec(1, 1)},
+	}
+	_, err = ms.UpdateParams(ctx, types.NewMsgUpdateParams(authority, params))
+	require.Error(t, err)
+
+	// blocked account address
+	params.ReleaseDestinations = []types.ReleaseDestination{
+		{Name: "blocked", DestinationType: types.ReleaseDestinationTypeAddress, Address: authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(), Ratio: math.LegacyNewDecWithPrec(1, 1)},
+	}
+	require.NoError(t, params.Validate())
+	_, err = ms.UpdateParams(ctx, types.NewMsgUpdateParams(authority, params))
+	require.Error(t, err)
+
+	// staking pools
+	params.ReleaseDestinations = []types.ReleaseDestination{
+		{Name: "pool", DestinationType: types.ReleaseDestinationTypeModule, Address: stakingtypes.BondedPoolName, Ratio: math.LegacyNewDecWithPrec(1, 1)},
+	}
+	require.Error(t, params.Validate())
+
+	params.ReleaseDestinations = []types.ReleaseDestination{
+		{Name: "pool", DestinationType: types.ReleaseDestinationTypeAddress, Address: authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String(), Ratio: math.LegacyNewDecWithPrec(1, 1)},
+	}
+	_, err = ms.UpdateParams(ctx, types.NewMsgUpdateParams(authority, params))
+	require.Error(t, err)
+
+	// modules tracking their balances
+	for _, name := range []string{govtypes.ModuleName, distrtypes.ModuleName, types.ModuleName} {
+		params.ReleaseDestinations = []types.ReleaseDestination{
+			{Name: "tracked", DestinationType: types.ReleaseDestinationTypeModule, Address: name, Ratio: math.LegacyNewDecWithPrec(1, 1)},
+		}
+		require.Error(t, params.Validate())
+
+		params.ReleaseDestinations = []types.ReleaseDestination{
+			{Name: "tracked", DestinationType: types.ReleaseDestinationTypeAddress, Address: authtypes.NewModuleAddress(name).String(), Ratio: math.LegacyNewDecWithPrec(1, 1)},
+		}
+		_, err = ms.UpdateParams(ctx, types.NewMsgUpdateParams(authority, params))
+		require.Error(t, err)
+	}
+
+	params.ReleaseDestinations = []types.ReleaseDestination{
+		{Name: "pool", DestinationType: types.ReleaseDestinationTypeAddress, Address: authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String(), Ratio: math.LegacyNewDecWithPrec(1, 1)},
+	}
+	genState := types.DefaultGenesisState()
+	genState.Params.ReleaseDestinations = params.ReleaseDestinations
+	require.Error(t, types.ValidateGenesis(*genState, input.AccountKeeper.AddressCodec()))
+
+	genState.Params.ReleaseDestinations[0].Address = "invalid"
+	require.Error(t, types.ValidateGenesis(*genState, input.AccountKeeper.AddressCodec()))
+}
+
+func Test_SendReleasedRewards_FallbackToFeeCollector(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params, err := input.RewardKeeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	// the blocked address cannot receive the rewards
+	params.ReleaseDestinations = []types.ReleaseDestination{
+		{
+			Name:            "blocked",
+			DestinationType: types.ReleaseDestinationTypeAddress,
+			Address:         authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			Ratio:           math.LegacyNewDecWithPrec(2, 1),
+		},
+	}
+
+	rewardCoin := sdk.NewCoin(params.RewardDenom, math.NewInt(1_000))
+	rewardModuleAddr := input.AccountKeeper.GetModuleAddress(types.ModuleName)
+	input.Faucet.Fund(ctx, rewardModuleAddr, rewardCoin)
+
+	feeCollectorAddr := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBalance := input.BankKeeper.GetBalance(ctx, feeCollectorAddr, params.RewardDenom)
+
+	require.NoError(t, input.RewardKeeper.SendReleasedRewards(ctx, params, sdk.NewCoins(rewardCoin)))
+	require.Equal(t, math.NewInt(1_000), input.BankKeeper.GetBalance(ctx, feeCollectorAddr, params.RewardDenom).Amount.Sub(feeCollectorBalance.Amount))
+}
@@ -0,0 +1,169 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+const (
+	// Year is the length of a year used to compute the annual provisions.
+	Year = time.Hour * 24 * 365
+
+	// MaxForecastPeriods is the maximum number of periods of an emission forecast.
+	MaxForecastPeriods = 100
+)
+
+// DefaultEmissionSchedule returns the default geometric emission schedule.
+func DefaultEmissionSchedule() EmissionSchedule {
+	return EmissionSchedule{Curve: EmissionCurveGeometric}
+}
+
+// Validate performs basic validation of the emission schedule.
+func (s EmissionSchedule) Validate() error {
+	switch s.Curve {
+	case EmissionCurveGeometric:
+		return nil
+	case EmissionCurvePiecewiseLinear:
+		if s.PiecewiseLinear == nil {
+			return fmt.Errorf("piecewise linear emission must be set")
+		}
+
+		return s.PiecewiseLinear.Validate()
+	case EmissionCurveFixedPerBlock:
+		if s.FixedPerBlock == nil {
+			return fmt.Errorf("fixed per block emission must be set")
+		}
+
+		return s.FixedPerBlock.Validate()
+	case EmissionCurveTargetStakingRatio:
+		if s.TargetStakingRatio == nil {
+			return fmt.Errorf("target staking ratio emission must be set")
+		}
+
+		return s.TargetStakingRatio.Validate()
+	default:
+		return fmt.Errorf("unknown emission curve: %s", s.Curve)
+	}
+}
+
+// Validate performs basic validation of the piecewise linear emission.
+func (e PiecewiseLinearEmission) Validate() error {
+	if len(e.Points) == 0 {
+		return fmt.Errorf("piecewise linear emission must have at least one point")
+	}
+
+	for i, point := range e.Points {
+		if err := validateReleaseRate(point.ReleaseRate); err != nil {
+			return err
+		}
+
+		if i > 0 && !point.Time.After(e.Points[i-1].Time) {
+			return fmt.Errorf("emission points must be sorted by time without duplicates")
+		}
+	}
+
+	return nil
+}
+
+// ReleaseRateAt returns the release rate at the given time, which is linearly
+// interpolated between the points.
+func (e PiecewiseLinearEmission) ReleaseRateAt(t time.Time) math.LegacyDec {
+	first, last := e.Points[0], e.Points[len(e.Points)-1]
+	if !t.After(first.Time) {
+		return first.ReleaseRate
+	} else if !t.Before(last.Time) {
+		return last.ReleaseRate
+	}
+
+	for i := 1; i < len(e.Points); i++ {
+		prev, next := e.Points[i-1], e.Points[i]
+		if t.After(next.Time) {
+			continue
+		}
+
+		elapsed := math.LegacyNewDec(int64(t.Sub(prev.Time)))
+		duration := math.LegacyNewDec(int64(next.Time.Sub(prev.Time)))
+		return prev.ReleaseRate.Add(next.ReleaseRate.Sub(prev.ReleaseRate).Mul(elapsed).Quo(duration))
+	}
+
+	return last.ReleaseRate
+}
+
+// IntegrateReleaseRate returns the integral of the release rate from the start time to
+// the end time in years, which multiplied by the supply is the amount released.
+func (e PiecewiseLinearEmission) IntegrateReleaseRate(start, end time.Time) math.LegacyDec {
+	// split the range at the points to integrate the linear segments
+	times := []time.Time{start}
+	for _, point := range e.Points {
+		if point.Time.After(start) && point.Time.Before(end) {
+			times = append(times, point.Time)
+		}
+	}
+	times = append(times, end)
+
+	integral := math.LegacyZeroDec()
+	for i := 1; i < len(times); i++ {
+		years := math.LegacyNewDec(int64(times[i].Sub(times[i-1]))).QuoInt64(int64(Year))
+		average := e.ReleaseRateAt(times[i-1]).Add(e.ReleaseRateAt(times[i])).QuoInt64(2)
+		integral = integral.Add(average.Mul(years))
+	}
+
+	return integral
+}
+
+// Validate performs basic validation of the fixed per block emission.
+func (e FixedPerBlockEmission) Validate() error {
+	if e.Amount.IsNil() || e.Amount.IsNegative() {
+		return fmt.Errorf("invalid fixed per block amount: %s", e.Amount)
+	}
+
+	if e.BlocksPerYear == 0 {
+		return fmt.Errorf("blocks per year must be positive")
+	}
+
+	return nil
+}
+
+// AnnualProvisions returns the amount released in a year.
+func (e FixedPerBlockEmission) AnnualProvisions() math.LegacyDec {
+	return math.LegacyNewDecFromInt(e.Amount.Mul(math.NewIntFromUint64(e.BlocksPerYear)))
+}
+
+// Validate performs basic validation of the target staking ratio emission.
+func (e TargetStakingRatioEmission) Validate() error {
+	if e.GoalBonded.IsNil() || !e.GoalBonded.IsPositive() || e.GoalBonded.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("goal bonded must be in (0, 1]: %s", e.GoalBonded)
+	}
+
+	if err := validateReleaseRate(e.RateChangePerYear); err != nil {
+		return fmt.Errorf("invalid rate change per year: %w", err)
+	}
+
+	if err := validateReleaseRate(e.MinReleaseRate); err != nil {
+		return fmt.Errorf("invalid min release rate: %w", err)
+	}
+
+	if err := validateReleaseRate(e.MaxReleaseRate); err != nil {
+		return fmt.Errorf("invalid max release rate: %w", err)
+	}
+
+	if e.MinReleaseRate.GT(e.MaxReleaseRate) {
+		return fmt.Errorf("min release rate must not be greater than max release rate")
+	}
+
+	return nil
+}
+
+// NextReleaseRate returns the release rate moved toward the goal bonded ratio for the
+// elapsed time and bounded by the min and max release rates.
+func (e TargetStakingRatioEmission) NextReleaseRate(rate, bondedRatio math.LegacyDec, elapsed time.Duration) math.LegacyDec {
+	years := math.LegacyNewDec(int64(elapsed)).QuoInt64(int64(Year))
+	change := math.LegacyOneDec().Sub(bondedRatio.Quo(e.GoalBonded)).Mul(e.RateChangePerYear).Mul(years)
+
+	rate = rate.Add(change)
+	rate = math.LegacyMaxDec(rate, e.MinReleaseRate)
+	rate = math.LegacyMinDec(rate, e.MaxReleaseRate)
+	return rate
+}
@@ -2,8 +2,11 @@ package types
 
 // Reward module event types
 const (
-	EventTypeReward = ModuleName
+	EventTypeReward             = ModuleName
+	EventTypeReleaseDestination = "release_destination"
 
 	AttributeKeyReleaseRate      = "release_rate"
 	AttributeKeyAnnualProvisions = "annual_provisions"
+	AttributeKeyDestination      = "destination"
+	AttributeKeyRecipient        = "recipient"
 )
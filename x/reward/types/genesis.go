@@ -1,6 +1,11 @@
 package types
 
-import "time"
+import (
+	"time"
+
+	"cosmossdk.io/core/address"
+	"github.com/pkg/errors"
+)
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(params Params, lastReleaseTimestamp time.Time, lastDilutionTimestamp time.Time) *GenesisState {
@@ -22,6 +27,16 @@ func DefaultGenesisState() *GenesisState {
 
 // ValidateGenesis validates the provided genesis state to ensure the
 // expected invariants holds.
-func ValidateGenesis(data GenesisState) error {
-	return data.Params.Validate()
+func ValidateGenesis(data GenesisState, ac address.Codec) error {
+	if data.CurrentReleaseRate != nil {
+		if err := validateReleaseRate(*data.CurrentReleaseRate); err != nil {
+			return errors.Wrap(err, "invalid current release rate")
+		}
+	}
+
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	return validateReleaseDestinationAddresses(data.Params.ReleaseDestinations, ac)
 }
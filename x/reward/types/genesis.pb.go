@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -33,6 +34,9 @@ type GenesisState struct {
 	Params                Params    `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	LastReleaseTimestamp  time.Time `protobuf:"bytes,2,opt,name=last_release_timestamp,json=lastReleaseTimestamp,proto3,stdtime" json:"last_release_timestamp"`
 	LastDilutionTimestamp time.Time `protobuf:"bytes,3,opt,name=last_dilution_timestamp,json=lastDilutionTimestamp,proto3,stdtime" json:"last_dilution_timestamp"`
+	// current_release_rate is the release rate computed by the piecewise linear
+	// and target staking ratio curves, which is empty until the curve computes it.
+	CurrentReleaseRate *cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=current_release_rate,json=currentReleaseRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"current_release_rate,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -96,26 +100,30 @@ func init() {
 func init() { proto.RegisterFile("initia/reward/v1/genesis.proto", fileDescriptor_d961ed3976a50db3) }
 
 var fileDescriptor_d961ed3976a50db3 = []byte{
-	// 299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x90, 0xc1, 0x4a, 0xc3, 0x40,
-	0x18, 0x84, 0xb3, 0x55, 0x8a, 0x44, 0x0f, 0x12, 0xaa, 0x86, 0x20, 0x1b, 0xf1, 0xe4, 0x41, 0x77,
-	0xa9, 0x82, 0x0f, 0x50, 0x44, 0xaf, 0x12, 0x3d, 0x15, 0xa1, 0x6c, 0xec, 0xba, 0x2e, 0x24, 0xd9,
-	0x90, 0xfd, 0x53, 0xf5, 0xe6, 0x23, 0xf4, 0xb1, 0x7a, 0xec, 0xd1, 0x93, 0x4a, 0xf2, 0x22, 0x92,
-	0xdd, 0xa4, 0x8a, 0x9e, 0xbc, 0xfd, 0x3f, 0x33, 0x7c, 0x33, 0x8c, 0x8b, 0x65, 0x26, 0x41, 0x32,
-	0x5a, 0xf0, 0x27, 0x56, 0x4c, 0xe9, 0x6c, 0x48, 0x05, 0xcf, 0xb8, 0x96, 0x9a, 0xe4, 0x85, 0x02,
-	0xe5, 0x6d, 0x5b, 0x9d, 0x58, 0x9d, 0xcc, 0x86, 0xc1, 0x40, 0x28, 0xa1, 0x8c, 0x48, 0x9b, 0xcb,
-	0xfa, 0x82, 0x50, 0x28, 0x25, 0x12, 0x4e, 0xcd, 0x17, 0x97, 0x0f, 0x14, 0x64, 0xca, 0x35, 0xb0,
-	0x34, 0x6f, 0x0d, 0xfb, 0x7f, 0x82, 0xe0, 0x25, 0xe7, 0x6d, 0xcc, 0xe1, 0x6b, 0xcf, 0xdd, 0xba,
-	0xb2, 0xc1, 0x37, 0xc0, 0x80, 0x7b, 0xe7, 0x6e, 0x3f, 0x67, 0x05, 0x4b, 0xb5, 0x8f, 0x0e, 0xd0,
-	0xd1, 0xe6, 0xa9, 0x4f, 0x7e, 0x17, 0x21, 0xd7, 0x46, 0x1f, 0xad, 0x2f, 0xde, 0x43, 0x27, 0x6a,
-	0xdd, 0xde, 0xd8, 0xdd, 0x4d, 0x98, 0x86, 0x49, 0xc1, 0x13, 0xce, 0x34, 0x9f, 0xac, 0x6a, 0xf8,
-	0x3d, 0xc3, 0x09, 0x88, 0x2d, 0x4a, 0xba, 0xa2, 0xe4, 0xb6, 0x73, 0x8c, 0x36, 0x1a, 0xd2, 0xfc,
-	0x23, 0x44, 0xd1, 0xa0, 0x61, 0x44, 0x16, 0xb1, 0xd2, 0xbd, 0x3b, 0x77, 0xcf, 0xb0, 0xa7, 0x32,
-	0x29, 0x41, 0xaa, 0xec, 0x07, 0x7c, 0xed, 0x1f, 0xf0, 0x9d, 0x06, 0x72, 0xd1, 0x32, 0xbe, 0x0d,
-	0x97, 0x8b, 0x0a, 0xa3, 0x65, 0x85, 0xd1, 0x67, 0x85, 0xd1, 0xbc, 0xc6, 0xce, 0xb2, 0xc6, 0xce,
-	0x5b, 0x8d, 0x9d, 0xf1, 0xb1, 0x90, 0xf0, 0x58, 0xc6, 0xe4, 0x5e, 0xa5, 0xd4, 0xae, 0x70, 0x92,
-	0xb0, 0x58, 0xb7, 0x37, 0x7d, 0xee, 0x36, 0x35, 0x83, 0xc6, 0x7d, 0x13, 0x7e, 0xf6, 0x15, 0x00,
-	0x00, 0xff, 0xff, 0x27, 0xab, 0xd2, 0xd3, 0xda, 0x01, 0x00, 0x00,
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xcd, 0x4a, 0xc3, 0x40,
+	0x14, 0x85, 0x33, 0xb5, 0x14, 0x8d, 0x2e, 0x24, 0x54, 0x0d, 0x55, 0x92, 0xa2, 0x9b, 0x2e, 0x74,
+	0x86, 0x2a, 0xf8, 0x00, 0xa1, 0xe8, 0xc6, 0x85, 0x44, 0xdd, 0x14, 0xa1, 0x4c, 0xd2, 0x6b, 0x3a,
+	0x98, 0x64, 0xc2, 0xcc, 0xa4, 0xda, 0xb7, 0xe8, 0x63, 0x75, 0xd9, 0x9d, 0xe2, 0xa2, 0x4a, 0xfb,
+	0x22, 0x92, 0x9f, 0xb6, 0xa2, 0x2b, 0x77, 0x37, 0x9c, 0xc3, 0x77, 0xbf, 0xcc, 0xd5, 0x2d, 0x16,
+	0x33, 0xc5, 0x28, 0x11, 0xf0, 0x42, 0x45, 0x9f, 0x0c, 0xdb, 0x24, 0x80, 0x18, 0x24, 0x93, 0x38,
+	0x11, 0x5c, 0x71, 0x63, 0xb7, 0xc8, 0x71, 0x91, 0xe3, 0x61, 0xbb, 0x51, 0x0f, 0x78, 0xc0, 0xf3,
+	0x90, 0x64, 0x53, 0xd1, 0x6b, 0xd8, 0x01, 0xe7, 0x41, 0x08, 0x24, 0xff, 0xf2, 0xd2, 0x27, 0xa2,
+	0x58, 0x04, 0x52, 0xd1, 0x28, 0x29, 0x0b, 0x47, 0x7f, 0x16, 0xa9, 0x51, 0x02, 0xe5, 0x9a, 0xe3,
+	0xb7, 0x8a, 0xbe, 0x73, 0x5d, 0x2c, 0xbe, 0x53, 0x54, 0x81, 0x71, 0xa9, 0xd7, 0x12, 0x2a, 0x68,
+	0x24, 0x4d, 0xd4, 0x44, 0xad, 0xed, 0x73, 0x13, 0xff, 0x16, 0xc1, 0xb7, 0x79, 0xee, 0x54, 0x27,
+	0x33, 0x5b, 0x73, 0xcb, 0xb6, 0xd1, 0xd5, 0xf7, 0x43, 0x2a, 0x55, 0x4f, 0x40, 0x08, 0x54, 0x42,
+	0x6f, 0xa5, 0x61, 0x56, 0x72, 0x4e, 0x03, 0x17, 0xa2, 0x78, 0x29, 0x8a, 0xef, 0x97, 0x0d, 0x67,
+	0x33, 0x23, 0x8d, 0x3f, 0x6d, 0xe4, 0xd6, 0x33, 0x86, 0x5b, 0x20, 0x56, 0xb9, 0xf1, 0xa8, 0x1f,
+	0xe4, 0xec, 0x3e, 0x0b, 0x53, 0xc5, 0x78, 0xfc, 0x03, 0xbe, 0xf1, 0x0f, 0xf8, 0x5e, 0x06, 0xe9,
+	0x94, 0x8c, 0x35, 0xfd, 0x41, 0xaf, 0xfb, 0xa9, 0x10, 0x10, 0xaf, 0xe5, 0x05, 0x55, 0x60, 0x56,
+	0x9b, 0xa8, 0xb5, 0xe5, 0x9c, 0x4c, 0x66, 0x36, 0xfa, 0x98, 0xd9, 0x87, 0x3e, 0x97, 0x11, 0x97,
+	0xb2, 0xff, 0x8c, 0x19, 0x27, 0x11, 0x55, 0x03, 0x7c, 0x03, 0x01, 0xf5, 0x47, 0x1d, 0xf0, 0x5d,
+	0xa3, 0x04, 0x94, 0xe6, 0x2e, 0x55, 0xe0, 0x5c, 0x4d, 0xe6, 0x16, 0x9a, 0xce, 0x2d, 0xf4, 0x35,
+	0xb7, 0xd0, 0x78, 0x61, 0x69, 0xd3, 0x85, 0xa5, 0xbd, 0x2f, 0x2c, 0xad, 0x7b, 0x1a, 0x30, 0x35,
+	0x48, 0x3d, 0xec, 0xf3, 0x88, 0x14, 0x8f, 0x7b, 0x16, 0x52, 0x4f, 0x96, 0x33, 0x79, 0x5d, 0x9e,
+	0x2a, 0xbf, 0x93, 0x57, 0xcb, 0xff, 0xe9, 0xe2, 0x7b, 0x00, 0xa2, 0x70, 0x1c, 0xf2, 0x31, 0x02,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -138,6 +146,18 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CurrentReleaseRate != nil {
+		{
+			size := m.CurrentReleaseRate.Size()
+			i -= size
+			if _, err := m.CurrentReleaseRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastDilutionTimestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastDilutionTimestamp):])
 	if err1 != nil {
 		return 0, err1
@@ -190,6 +210,10 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastDilutionTimestamp)
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.CurrentReleaseRate != nil {
+		l = m.CurrentReleaseRate.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -327,6 +351,42 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReleaseRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.CurrentReleaseRate = &v
+			if err := m.CurrentReleaseRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
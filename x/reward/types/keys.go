@@ -15,4 +15,5 @@ var (
 	ParamsKey                = []byte{0x00} // Prefix for params key
 	LastReleaseTimestampKey  = []byte{0x01} // Key to store last release timestamp
 	LastDilutionTimestampKey = []byte{0x02} // Key to store last dilution timestamp
+	CurrentReleaseRateKey    = []byte{0x03} // Key to store release rate of the emission curve
 )
@@ -40,11 +40,12 @@ func NewParams(
 // DefaultParams returns default move parameters
 func DefaultParams() Params {
 	return Params{
-		RewardDenom:    DefaultRewardDenom,
-		ReleaseRate:    DefaultReleaseRate,
-		DilutionRate:   DefaultDilutionRate,
-		DilutionPeriod: DefaultDilutionPeriod,
-		ReleaseEnabled: DefaultReleaseEnabled,
+		RewardDenom:      DefaultRewardDenom,
+		ReleaseRate:      DefaultReleaseRate,
+		DilutionRate:     DefaultDilutionRate,
+		DilutionPeriod:   DefaultDilutionPeriod,
+		ReleaseEnabled:   DefaultReleaseEnabled,
+		EmissionSchedule: DefaultEmissionSchedule(),
 	}
 }
 
@@ -76,6 +77,14 @@ func (p Params) Validate() error {
 		return errors.Wrap(err, "invalid release enabled")
 	}
 
+	if err := p.EmissionSchedule.Validate(); err != nil {
+		return errors.Wrap(err, "invalid emission schedule")
+	}
+
+	if err := validateReleaseDestinations(p.ReleaseDestinations); err != nil {
+		return errors.Wrap(err, "invalid release destinations")
+	}
+
 	return nil
 }
 
@@ -98,7 +107,7 @@ func validateDilutionPeriod(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if v == 0 {
+	if v <= 0 {
 		return fmt.Errorf("DilutionPeriod must be bigger than 0")
 	}
 
@@ -280,6 +280,99 @@ func (m *QueryLastDilutionTimestampResponse) GetLastDilutionTimestamp() time.Tim
 	return time.Time{}
 }
 
+// QueryEmissionForecastRequest is the request type for the
+// Query/EmissionForecast RPC method.
+type QueryEmissionForecastRequest struct {
+	// num_periods is the number of periods to forecast.
+	NumPeriods uint32 `protobuf:"varint,1,opt,name=num_periods,json=numPeriods,proto3" json:"num_periods,omitempty"`
+}
+
+func (m *QueryEmissionForecastRequest) Reset()         { *m = QueryEmissionForecastRequest{} }
+func (m *QueryEmissionForecastRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionForecastRequest) ProtoMessage()    {}
+func (*QueryEmissionForecastRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0604a422725e0bb, []int{6}
+}
+func (m *QueryEmissionForecastRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionForecastRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionForecastRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmissionForecastRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionForecastRequest.Merge(m, src)
+}
+func (m *QueryEmissionForecastRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionForecastRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionForecastRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmissionForecastRequest proto.InternalMessageInfo
+
+func (m *QueryEmissionForecastRequest) GetNumPeriods() uint32 {
+	if m != nil {
+		return m.NumPeriods
+	}
+	return 0
+}
+
+// QueryEmissionForecastResponse is the response type for the
+// Query/EmissionForecast RPC method.
+type QueryEmissionForecastResponse struct {
+	Periods []EmissionForecastPeriod `protobuf:"bytes,1,rep,name=periods,proto3" json:"periods"`
+}
+
+func (m *QueryEmissionForecastResponse) Reset()         { *m = QueryEmissionForecastResponse{} }
+func (m *QueryEmissionForecastResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionForecastResponse) ProtoMessage()    {}
+func (*QueryEmissionForecastResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0604a422725e0bb, []int{7}
+}
+func (m *QueryEmissionForecastResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionForecastResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionForecastResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmissionForecastResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionForecastResponse.Merge(m, src)
+}
+func (m *QueryEmissionForecastResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionForecastResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionForecastResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmissionForecastResponse proto.InternalMessageInfo
+
+func (m *QueryEmissionForecastResponse) GetPeriods() []EmissionForecastPeriod {
+	if m != nil {
+		return m.Periods
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "initia.reward.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "initia.reward.v1.QueryParamsResponse")
@@ -287,44 +380,51 @@ func init() {
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "initia.reward.v1.QueryAnnualProvisionsResponse")
 	proto.RegisterType((*QueryLastDilutionTimestampRequest)(nil), "initia.reward.v1.QueryLastDilutionTimestampRequest")
 	proto.RegisterType((*QueryLastDilutionTimestampResponse)(nil), "initia.reward.v1.QueryLastDilutionTimestampResponse")
+	proto.RegisterType((*QueryEmissionForecastRequest)(nil), "initia.reward.v1.QueryEmissionForecastRequest")
+	proto.RegisterType((*QueryEmissionForecastResponse)(nil), "initia.reward.v1.QueryEmissionForecastResponse")
 }
 
 func init() { proto.RegisterFile("initia/reward/v1/query.proto", fileDescriptor_c0604a422725e0bb) }
 
 var fileDescriptor_c0604a422725e0bb = []byte{
-	// 504 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xd1, 0x6e, 0xd3, 0x30,
-	0x14, 0x86, 0x6b, 0x06, 0x15, 0x32, 0x5c, 0x14, 0xb3, 0x89, 0x2a, 0x94, 0x74, 0xa4, 0x4c, 0x02,
-	0x0d, 0x6c, 0x75, 0x43, 0xdc, 0x53, 0x4d, 0x5c, 0x0d, 0xa9, 0x54, 0x5c, 0x21, 0xa4, 0xca, 0x4d,
-	0x4d, 0x66, 0x91, 0xc4, 0x69, 0xec, 0x74, 0xf4, 0x76, 0x4f, 0x30, 0x89, 0x17, 0xe0, 0x25, 0x78,
-	0x87, 0x5d, 0x4e, 0xe2, 0x06, 0x81, 0x34, 0x50, 0xcb, 0x83, 0xa0, 0xd8, 0x4e, 0x25, 0x9a, 0xa5,
-	0xc0, 0x5d, 0x9b, 0xff, 0x9c, 0xff, 0x7c, 0x39, 0xff, 0x09, 0x6c, 0xf1, 0x98, 0x2b, 0x4e, 0x49,
-	0xca, 0x8e, 0x69, 0x3a, 0x26, 0xd3, 0x2e, 0x99, 0x64, 0x2c, 0x9d, 0xe1, 0x24, 0x15, 0x4a, 0xa0,
-	0x86, 0x51, 0xb1, 0x51, 0xf1, 0xb4, 0xeb, 0x6c, 0x06, 0x22, 0x10, 0x5a, 0x24, 0xf9, 0x2f, 0x53,
-	0xe7, 0xb4, 0x02, 0x21, 0x82, 0x90, 0x11, 0x9a, 0x70, 0x42, 0xe3, 0x58, 0x28, 0xaa, 0xb8, 0x88,
-	0xa5, 0x55, 0xdb, 0x56, 0xd5, 0xff, 0x46, 0xd9, 0x3b, 0xa2, 0x78, 0xc4, 0xa4, 0xa2, 0x51, 0x52,
-	0xb4, 0x97, 0x20, 0xd4, 0x2c, 0x61, 0xb6, 0xdd, 0xdb, 0x84, 0xe8, 0x55, 0xce, 0xd4, 0xa7, 0x29,
-	0x8d, 0xe4, 0x80, 0x4d, 0x32, 0x26, 0x95, 0xf7, 0x12, 0xde, 0xfe, 0xe3, 0xa9, 0x4c, 0x44, 0x2c,
-	0x19, 0x7a, 0x06, 0xeb, 0x89, 0x7e, 0xd2, 0x04, 0xdb, 0xe0, 0xe1, 0x8d, 0xbd, 0x26, 0x5e, 0x7d,
-	0x05, 0x6c, 0x3a, 0x7a, 0x57, 0xcf, 0x2e, 0xda, 0xb5, 0x81, 0xad, 0xf6, 0x5c, 0xd8, 0xd2, 0x76,
-	0xcf, 0xe3, 0x38, 0xa3, 0x61, 0x3f, 0x15, 0x53, 0x2e, 0xf3, 0x57, 0x28, 0xc6, 0x4d, 0xe0, 0xbd,
-	0x0a, 0xdd, 0x0e, 0xee, 0xc3, 0x5b, 0x54, 0x6b, 0xc3, 0x64, 0x29, 0x6a, 0x86, 0x9b, 0xbd, 0x4e,
-	0x3e, 0xe9, 0xdb, 0x45, 0xfb, 0xae, 0x2f, 0x64, 0x24, 0xa4, 0x1c, 0xbf, 0xc7, 0x5c, 0x90, 0x88,
-	0xaa, 0x23, 0x7c, 0xc8, 0x02, 0xea, 0xcf, 0x0e, 0x98, 0x3f, 0x68, 0xd0, 0x15, 0x67, 0xaf, 0x03,
-	0xef, 0xeb, 0x91, 0x87, 0x54, 0xaa, 0x03, 0x1e, 0x66, 0xf9, 0x4a, 0x5f, 0x17, 0x9b, 0x2b, 0xb8,
-	0x4e, 0x00, 0xf4, 0xd6, 0x55, 0x59, 0xba, 0xb7, 0xf0, 0x4e, 0x48, 0xa5, 0x1a, 0x8e, 0x6d, 0xc5,
-	0x70, 0x19, 0x41, 0x73, 0x43, 0xef, 0xc9, 0xc1, 0x26, 0x24, 0x5c, 0x84, 0x84, 0x97, 0x26, 0xbd,
-	0xeb, 0x39, 0xff, 0xe9, 0x8f, 0x36, 0x18, 0x6c, 0x85, 0x97, 0x4d, 0xd9, 0xfb, 0xbe, 0x01, 0xaf,
-	0x69, 0x08, 0x74, 0x0c, 0xeb, 0x66, 0xbd, 0xe8, 0x41, 0x79, 0xf1, 0xe5, 0x14, 0x9d, 0x9d, 0xbf,
-	0x54, 0x19, 0x7c, 0x6f, 0xfb, 0xe4, 0xcb, 0xaf, 0x8f, 0x57, 0x1c, 0xd4, 0x24, 0xa5, 0x4b, 0x31,
-	0xf9, 0xa1, 0x4f, 0x00, 0x36, 0x56, 0xb3, 0x41, 0xb8, 0xc2, 0xbd, 0x22, 0x64, 0x87, 0xfc, 0x73,
-	0xbd, 0xe5, 0xda, 0xd5, 0x5c, 0x3b, 0xa8, 0x53, 0xe6, 0x2a, 0x1d, 0x03, 0xfa, 0x0c, 0xe0, 0xd6,
-	0xa5, 0x29, 0xa1, 0xfd, 0x8a, 0xb9, 0xeb, 0x92, 0x77, 0x9e, 0xfe, 0x5f, 0x93, 0x25, 0xee, 0x6a,
-	0xe2, 0x5d, 0xf4, 0xa8, 0x4c, 0x5c, 0x71, 0x20, 0xbd, 0x17, 0x67, 0x73, 0x17, 0x9c, 0xcf, 0x5d,
-	0xf0, 0x73, 0xee, 0x82, 0xd3, 0x85, 0x5b, 0x3b, 0x5f, 0xb8, 0xb5, 0xaf, 0x0b, 0xb7, 0xf6, 0xe6,
-	0x71, 0xc0, 0xd5, 0x51, 0x36, 0xc2, 0xbe, 0x88, 0xac, 0xdd, 0x93, 0x90, 0x8e, 0x64, 0x61, 0xfd,
-	0xa1, 0x30, 0xd7, 0x5f, 0xf3, 0xa8, 0xae, 0x4f, 0x6b, 0xff, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xa2, 0x1a, 0xc1, 0xdc, 0x73, 0x04, 0x00, 0x00,
+	// 587 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xd1, 0x6e, 0xd3, 0x30,
+	0x14, 0x6d, 0xd8, 0x18, 0xc8, 0x03, 0x69, 0x98, 0x4d, 0x54, 0xa1, 0x4b, 0x47, 0xca, 0xa4, 0xa2,
+	0x81, 0xad, 0x76, 0x88, 0x57, 0x44, 0x35, 0x26, 0x1e, 0x86, 0x54, 0x2a, 0x9e, 0x10, 0x52, 0xe5,
+	0xb6, 0x5e, 0x66, 0x91, 0xc4, 0x69, 0xec, 0x74, 0xf4, 0x75, 0x5f, 0x30, 0x89, 0x1f, 0xe0, 0x27,
+	0xf8, 0x87, 0x3d, 0x4e, 0xe2, 0x05, 0xf1, 0x30, 0x50, 0xcb, 0x77, 0x20, 0x14, 0xdb, 0x29, 0xa2,
+	0x59, 0xb6, 0xf1, 0x96, 0xf8, 0xde, 0x73, 0xee, 0xf1, 0xb9, 0x27, 0x01, 0x15, 0x16, 0x32, 0xc9,
+	0x08, 0x8e, 0xe9, 0x21, 0x89, 0x07, 0x78, 0xd4, 0xc0, 0xc3, 0x84, 0xc6, 0x63, 0x14, 0xc5, 0x5c,
+	0x72, 0xb8, 0xa2, 0xab, 0x48, 0x57, 0xd1, 0xa8, 0x61, 0xaf, 0x7a, 0xdc, 0xe3, 0xaa, 0x88, 0xd3,
+	0x27, 0xdd, 0x67, 0x57, 0x3c, 0xce, 0x3d, 0x9f, 0x62, 0x12, 0x31, 0x4c, 0xc2, 0x90, 0x4b, 0x22,
+	0x19, 0x0f, 0x85, 0xa9, 0x56, 0x4d, 0x55, 0xbd, 0xf5, 0x92, 0x7d, 0x2c, 0x59, 0x40, 0x85, 0x24,
+	0x41, 0x94, 0xc1, 0x73, 0x22, 0xe4, 0x38, 0xa2, 0x06, 0xee, 0xae, 0x02, 0xf8, 0x26, 0xd5, 0xd4,
+	0x26, 0x31, 0x09, 0x44, 0x87, 0x0e, 0x13, 0x2a, 0xa4, 0xfb, 0x1a, 0xdc, 0xfd, 0xe7, 0x54, 0x44,
+	0x3c, 0x14, 0x14, 0x3e, 0x03, 0x4b, 0x91, 0x3a, 0x29, 0x5b, 0x1b, 0x56, 0x7d, 0xb9, 0x59, 0x46,
+	0xf3, 0x57, 0x40, 0x1a, 0xd1, 0x5a, 0x3c, 0x39, 0xab, 0x96, 0x3a, 0xa6, 0xdb, 0x75, 0x40, 0x45,
+	0xd1, 0xbd, 0x08, 0xc3, 0x84, 0xf8, 0xed, 0x98, 0x8f, 0x98, 0x48, 0xaf, 0x90, 0x8d, 0x1b, 0x82,
+	0xf5, 0x82, 0xba, 0x19, 0xdc, 0x06, 0x77, 0x88, 0xaa, 0x75, 0xa3, 0x59, 0x51, 0x69, 0xb8, 0xd5,
+	0xaa, 0xa5, 0x93, 0xbe, 0x9f, 0x55, 0xef, 0xf7, 0xb9, 0x08, 0xb8, 0x10, 0x83, 0x0f, 0x88, 0x71,
+	0x1c, 0x10, 0x79, 0x80, 0xf6, 0xa8, 0x47, 0xfa, 0xe3, 0x1d, 0xda, 0xef, 0xac, 0x90, 0x39, 0x66,
+	0xb7, 0x06, 0x1e, 0xa8, 0x91, 0x7b, 0x44, 0xc8, 0x1d, 0xe6, 0x27, 0xa9, 0xa5, 0x6f, 0x33, 0xe7,
+	0x32, 0x5d, 0x47, 0x16, 0x70, 0x2f, 0xea, 0x32, 0xea, 0xde, 0x83, 0x7b, 0x3e, 0x11, 0xb2, 0x3b,
+	0x30, 0x1d, 0xdd, 0xd9, 0x0a, 0xca, 0x0b, 0xca, 0x27, 0x1b, 0xe9, 0x25, 0xa1, 0x6c, 0x49, 0x68,
+	0x46, 0xd2, 0xba, 0x99, 0xea, 0x3f, 0xfe, 0x51, 0xb5, 0x3a, 0x6b, 0xfe, 0x79, 0x53, 0xdc, 0xe7,
+	0xc6, 0xbc, 0x97, 0x01, 0x13, 0xa9, 0xf6, 0x5d, 0x1e, 0xd3, 0x3e, 0x11, 0xd2, 0x88, 0x84, 0x55,
+	0xb0, 0x1c, 0x26, 0x41, 0x37, 0xa2, 0x31, 0xe3, 0x03, 0xed, 0xca, 0xed, 0x0e, 0x08, 0x93, 0xa0,
+	0xad, 0x4f, 0x5c, 0x06, 0xd6, 0x0b, 0x08, 0x8c, 0xfe, 0x57, 0xe0, 0xc6, 0x5f, 0xf4, 0x42, 0x7d,
+	0xb9, 0x59, 0xcf, 0xef, 0x75, 0x1e, 0xac, 0xc9, 0xcd, 0x9e, 0x33, 0x78, 0xf3, 0xf7, 0x22, 0xb8,
+	0xae, 0x66, 0xc1, 0x43, 0xb0, 0xa4, 0xa3, 0x00, 0x1f, 0xe6, 0xc9, 0xf2, 0x89, 0xb3, 0x37, 0x2f,
+	0xe9, 0xd2, 0x52, 0xdd, 0x8d, 0xa3, 0xaf, 0xbf, 0x3e, 0x5d, 0xb3, 0x61, 0x19, 0xe7, 0x52, 0xad,
+	0xb3, 0x06, 0x3f, 0x5b, 0x60, 0x65, 0x3e, 0x47, 0x10, 0x15, 0xb0, 0x17, 0x04, 0xd2, 0xc6, 0x57,
+	0xee, 0x37, 0xba, 0xb6, 0x94, 0xae, 0x4d, 0x58, 0xcb, 0xeb, 0xca, 0x05, 0x17, 0x7e, 0xb1, 0xc0,
+	0xda, 0xb9, 0x89, 0x82, 0xdb, 0x05, 0x73, 0x2f, 0x4a, 0xa9, 0xfd, 0xf4, 0xff, 0x40, 0x46, 0x71,
+	0x43, 0x29, 0xde, 0x82, 0x8f, 0xf2, 0x8a, 0x0b, 0xc2, 0xac, 0xac, 0x9d, 0xcf, 0x41, 0xa1, 0xb5,
+	0x05, 0x71, 0xb5, 0xf1, 0x95, 0xfb, 0x2f, 0xb7, 0x96, 0x1a, 0x4c, 0x77, 0xdf, 0x80, 0x5a, 0xbb,
+	0x27, 0x13, 0xc7, 0x3a, 0x9d, 0x38, 0xd6, 0xcf, 0x89, 0x63, 0x1d, 0x4f, 0x9d, 0xd2, 0xe9, 0xd4,
+	0x29, 0x7d, 0x9b, 0x3a, 0xa5, 0x77, 0x8f, 0x3d, 0x26, 0x0f, 0x92, 0x1e, 0xea, 0xf3, 0xc0, 0x10,
+	0x3d, 0xf1, 0x49, 0x4f, 0x64, 0xa4, 0x1f, 0x33, 0x5a, 0xf5, 0x73, 0xec, 0x2d, 0xa9, 0x2f, 0x75,
+	0xfb, 0xcf, 0x00, 0xc7, 0xa3, 0xc2, 0x29, 0xc2, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -345,6 +445,9 @@ type QueryClient interface {
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
 	// LastDilutionTimestamp returns the time when the last release rate dilution occurred.
 	LastDilutionTimestamp(ctx context.Context, in *QueryLastDilutionTimestampRequest, opts ...grpc.CallOption) (*QueryLastDilutionTimestampResponse, error)
+	// EmissionForecast returns the forecasted emissions for the next periods of
+	// the dilution period length under the current emission schedule.
+	EmissionForecast(ctx context.Context, in *QueryEmissionForecastRequest, opts ...grpc.CallOption) (*QueryEmissionForecastResponse, error)
 }
 
 type queryClient struct {
@@ -382,6 +485,15 @@ func (c *queryClient) LastDilutionTimestamp(ctx context.Context, in *QueryLastDi
 	return out, nil
 }
 
+func (c *queryClient) EmissionForecast(ctx context.Context, in *QueryEmissionForecastRequest, opts ...grpc.CallOption) (*QueryEmissionForecastResponse, error) {
+	out := new(QueryEmissionForecastResponse)
+	err := c.cc.Invoke(ctx, "/initia.reward.v1.Query/EmissionForecast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -390,6 +502,9 @@ type QueryServer interface {
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
 	// LastDilutionTimestamp returns the time when the last release rate dilution occurred.
 	LastDilutionTimestamp(context.Context, *QueryLastDilutionTimestampRequest) (*QueryLastDilutionTimestampResponse, error)
+	// EmissionForecast returns the forecasted emissions for the next periods of
+	// the dilution period length under the current emission schedule.
+	EmissionForecast(context.Context, *QueryEmissionForecastRequest) (*QueryEmissionForecastResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -405,6 +520,9 @@ func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) LastDilutionTimestamp(ctx context.Context, req *QueryLastDilutionTimestampRequest) (*QueryLastDilutionTimestampResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LastDilutionTimestamp not implemented")
 }
+func (*UnimplementedQueryServer) EmissionForecast(ctx context.Context, req *QueryEmissionForecastRequest) (*QueryEmissionForecastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionForecast not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -464,6 +582,25 @@ func _Query_LastDilutionTimestamp_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EmissionForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.reward.v1.Query/EmissionForecast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionForecast(ctx, req.(*QueryEmissionForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "initia.reward.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -480,6 +617,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LastDilutionTimestamp",
 			Handler:    _Query_LastDilutionTimestamp_Handler,
 		},
+		{
+			MethodName: "EmissionForecast",
+			Handler:    _Query_EmissionForecast_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/reward/v1/query.proto",
@@ -651,6 +792,71 @@ func (m *QueryLastDilutionTimestampResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEmissionForecastRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionForecastRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionForecastRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumPeriods != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumPeriods))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionForecastResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionForecastResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionForecastResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Periods) > 0 {
+		for iNdEx := len(m.Periods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Periods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -722,6 +928,33 @@ func (m *QueryLastDilutionTimestampResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEmissionForecastRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumPeriods != 0 {
+		n += 1 + sovQuery(uint64(m.NumPeriods))
+	}
+	return n
+}
+
+func (m *QueryEmissionForecastResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Periods) > 0 {
+		for _, e := range m.Periods {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1127,6 +1360,159 @@ func (m *QueryLastDilutionTimestampResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEmissionForecastRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionForecastRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionForecastRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPeriods", wireType)
+			}
+			m.NumPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPeriods |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionForecastResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionForecastResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionForecastResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Periods = append(m.Periods, EmissionForecastPeriod{})
+			if err := m.Periods[len(m.Periods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
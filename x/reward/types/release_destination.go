@@ -0,0 +1,123 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
+)
+
+// FeeCollectorDestinationName is the name of the fee collector destination, which receives
+// the rest of the released rewards as the staking rewards.
+const FeeCollectorDestinationName = "fee_collector"
+
+// Validate performs basic validation of the release destination.
+func (d ReleaseDestination) Validate() error {
+	if d.Name == "" {
+		return fmt.Errorf("empty destination name")
+	}
+
+	if d.Name == FeeCollectorDestinationName {
+		return fmt.Errorf("destination name %s is reserved", d.Name)
+	}
+
+	if d.Ratio.IsNil() || !d.Ratio.IsPositive() || d.Ratio.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("ratio of destination %s must be in (0, 1]: %s", d.Name, d.Ratio)
+	}
+
+	switch d.DestinationType {
+	case ReleaseDestinationTypeAddress, ReleaseDestinationTypeModule:
+		if d.Address == "" {
+			return fmt.Errorf("empty address of destination %s", d.Name)
+		}
+
+		if d.DestinationType == ReleaseDestinationTypeModule && isRestrictedModuleName(d.Address) {
+			return fmt.Errorf("module %s cannot be the destination %s", d.Address, d.Name)
+		}
+	case ReleaseDestinationTypeCommunityPool:
+		if d.Address != "" {
+			return fmt.Errorf("address of community pool destination %s must be empty", d.Name)
+		}
+	default:
+		return fmt.Errorf("unknown type of destination %s: %s", d.Name, d.DestinationType)
+	}
+
+	return nil
+}
+
+// validateReleaseDestinations validates the destinations, which must have unique names
+// and the ratios summing up to at most one.
+func validateReleaseDestinations(destinations []ReleaseDestination) error {
+	names := make(map[string]bool, len(destinations))
+	ratioSum := math.LegacyZeroDec()
+	for _, destination := range destinations {
+		if err := destination.Validate(); err != nil {
+			return err
+		}
+
+		if names[destination.Name] {
+			return fmt.Errorf("duplicate destination name: %s", destination.Name)
+		}
+		names[destination.Name] = true
+
+		ratioSum = ratioSum.Add(destination.Ratio)
+	}
+
+	if ratioSum.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("sum of destination ratios must not be greater than 1: %s", ratioSum)
+	}
+
+	return nil
+}
+
+// validateReleaseDestinationAddresses validates the account addresses of the address
+// type destinations, which must not be the restricted modules.
+func validateReleaseDestinationAddresses(destinations []ReleaseDestination, ac address.Codec) error {
+	for _, destination := range destinations {
+		if destination.DestinationType != ReleaseDestinationTypeAddress {
+			continue
+		}
+
+		addr, err := ac.StringToBytes(destination.Address)
+		if err != nil {
+			return fmt.Errorf("invalid address of destination %s: %w", destination.Name, err)
+		}
+
+		for _, name := range restrictedModuleNames {
+			if bytes.Equal(addr, authtypes.NewModuleAddress(name)) {
+				return fmt.Errorf("module %s cannot be the destination %s", name, destination.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// restrictedModuleNames are the modules which cannot receive the released rewards, as
+// their balances are tracked by their own state: the staking pools hold the staked tokens
+// only, and the deposits, the reward pools and the emission would not account the
+// received tokens.
+var restrictedModuleNames = []string{
+	stakingtypes.BondedPoolName,
+	stakingtypes.NotBondedPoolName,
+	govtypes.ModuleName,
+	distrtypes.ModuleName,
+	ModuleName,
+}
+
+func isRestrictedModuleName(name string) bool {
+	for _, restricted := range restrictedModuleNames {
+		if name == restricted {
+			return true
+		}
+	}
+
+	return false
+}
@@ -30,5 +30,9 @@ func (msg MsgUpdateParams) Validate(accCodec address.Codec) error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
 	}
 
+	if err := validateReleaseDestinationAddresses(msg.Params.ReleaseDestinations, accCodec); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+	}
+
 	return msg.Params.Validate()
 }
@@ -11,6 +11,7 @@ import (
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -29,6 +30,79 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ReleaseDestinationType enumerates the types of the release destinations.
+type ReleaseDestinationType int32
+
+const (
+	// RELEASE_DESTINATION_TYPE_ADDRESS sends the share to the account address.
+	ReleaseDestinationTypeAddress ReleaseDestinationType = 0
+	// RELEASE_DESTINATION_TYPE_MODULE sends the share to the module account of
+	// the module name.
+	ReleaseDestinationTypeModule ReleaseDestinationType = 1
+	// RELEASE_DESTINATION_TYPE_COMMUNITY_POOL funds the community pool with the
+	// share.
+	ReleaseDestinationTypeCommunityPool ReleaseDestinationType = 2
+)
+
+var ReleaseDestinationType_name = map[int32]string{
+	0: "RELEASE_DESTINATION_TYPE_ADDRESS",
+	1: "RELEASE_DESTINATION_TYPE_MODULE",
+	2: "RELEASE_DESTINATION_TYPE_COMMUNITY_POOL",
+}
+
+var ReleaseDestinationType_value = map[string]int32{
+	"RELEASE_DESTINATION_TYPE_ADDRESS":        0,
+	"RELEASE_DESTINATION_TYPE_MODULE":         1,
+	"RELEASE_DESTINATION_TYPE_COMMUNITY_POOL": 2,
+}
+
+func (x ReleaseDestinationType) String() string {
+	return proto.EnumName(ReleaseDestinationType_name, int32(x))
+}
+
+func (ReleaseDestinationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f585f1737696c, []int{0}
+}
+
+// EmissionCurve enumerates the supported emission curves.
+type EmissionCurve int32
+
+const (
+	// EMISSION_CURVE_GEOMETRIC dilutes the release rate by the dilution rate
+	// every dilution period.
+	EmissionCurveGeometric EmissionCurve = 0
+	// EMISSION_CURVE_PIECEWISE_LINEAR interpolates the release rate linearly
+	// between the points of the schedule.
+	EmissionCurvePiecewiseLinear EmissionCurve = 1
+	// EMISSION_CURVE_FIXED_PER_BLOCK releases a fixed amount every block.
+	EmissionCurveFixedPerBlock EmissionCurve = 2
+	// EMISSION_CURVE_TARGET_STAKING_RATIO adjusts the release rate toward the
+	// goal bonded ratio of the reward denom.
+	EmissionCurveTargetStakingRatio EmissionCurve = 3
+)
+
+var EmissionCurve_name = map[int32]string{
+	0: "EMISSION_CURVE_GEOMETRIC",
+	1: "EMISSION_CURVE_PIECEWISE_LINEAR",
+	2: "EMISSION_CURVE_FIXED_PER_BLOCK",
+	3: "EMISSION_CURVE_TARGET_STAKING_RATIO",
+}
+
+var EmissionCurve_value = map[string]int32{
+	"EMISSION_CURVE_GEOMETRIC":            0,
+	"EMISSION_CURVE_PIECEWISE_LINEAR":     1,
+	"EMISSION_CURVE_FIXED_PER_BLOCK":      2,
+	"EMISSION_CURVE_TARGET_STAKING_RATIO": 3,
+}
+
+func (x EmissionCurve) String() string {
+	return proto.EnumName(EmissionCurve_name, int32(x))
+}
+
+func (EmissionCurve) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f585f1737696c, []int{1}
+}
+
 // Params defines the set of mint parameters.
 type Params struct {
 	RewardDenom    string                      `protobuf:"bytes,1,opt,name=reward_denom,json=rewardDenom,proto3" json:"reward_denom,omitempty"`
@@ -40,6 +114,12 @@ type Params struct {
 	//    `last_dilution_timestamp = block.timestamp`
 	DilutionRate   cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=dilution_rate,json=dilutionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"dilution_rate" yaml:"dilution_rate"`
 	ReleaseEnabled bool                        `protobuf:"varint,5,opt,name=release_enabled,json=releaseEnabled,proto3" json:"release_enabled,omitempty"`
+	// emission_schedule defines the curve to release the rewards.
+	EmissionSchedule EmissionSchedule `protobuf:"bytes,6,opt,name=emission_schedule,json=emissionSchedule,proto3" json:"emission_schedule"`
+	// release_destinations defines the destinations receiving the shares of the
+	// released rewards; the rest is sent to the fee collector as the staking
+	// rewards.
+	ReleaseDestinations []ReleaseDestination `protobuf:"bytes,7,rep,name=release_destinations,json=releaseDestinations,proto3" json:"release_destinations"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -74,40 +154,394 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// ReleaseDestination defines a destination of the released rewards.
+type ReleaseDestination struct {
+	// name is the unique name of the destination emitted with the events.
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DestinationType ReleaseDestinationType `protobuf:"varint,2,opt,name=destination_type,json=destinationType,proto3,enum=initia.reward.v1.ReleaseDestinationType" json:"destination_type,omitempty"`
+	// address is the account address for the address type and the module name
+	// for the module type; it is empty for the community pool type.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// ratio is the share of the released rewards sent to the destination.
+	Ratio cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=ratio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ratio"`
+}
+
+func (m *ReleaseDestination) Reset()         { *m = ReleaseDestination{} }
+func (m *ReleaseDestination) String() string { return proto.CompactTextString(m) }
+func (*ReleaseDestination) ProtoMessage()    {}
+func (*ReleaseDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f585f1737696c, []int{1}
+}
+func (m *ReleaseDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleaseDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseDestination.Merge(m, src)
+}
+func (m *ReleaseDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseDestination proto.InternalMessageInfo
+
+// EmissionSchedule defines the emission curve and its configuration; only the
+// configuration of the selected curve is used.
+type EmissionSchedule struct {
+	Curve              EmissionCurve               `protobuf:"varint,1,opt,name=curve,proto3,enum=initia.reward.v1.EmissionCurve" json:"curve,omitempty"`
+	PiecewiseLinear    *PiecewiseLinearEmission    `protobuf:"bytes,2,opt,name=piecewise_linear,json=piecewiseLinear,proto3" json:"piecewise_linear,omitempty"`
+	FixedPerBlock      *FixedPerBlockEmission      `protobuf:"bytes,3,opt,name=fixed_per_block,json=fixedPerBlock,proto3" json:"fixed_per_block,omitempty"`
+	TargetStakingRatio *TargetStakingRatioEmission `protobuf:"bytes,4,opt,name=target_staking_ratio,json=targetStakingRatio,proto3" json:"target_staking_ratio,omitempty"`
+}
+
+func (m *EmissionSchedule) Reset()         { *m = EmissionSchedule{} }
+func (m *EmissionSchedule) String() string { return proto.CompactTextString(m) }
+func (*EmissionSchedule) ProtoMessage()    {}
+func (*EmissionSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f585f1737696c, []int{2}
+}
+func (m *EmissionSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionSchedule.Merge(m, src)
+}
+func (m *EmissionSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionSchedule proto.InternalMessageInfo
+
+// EmissionPoint defines the release rate at a point of time.
+type EmissionPoint struct {
+	Time        time.Time                   `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	ReleaseRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=release_rate,json=releaseRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"release_rate"`
+}
+
+func (m *EmissionPoint) Reset()         { *m = EmissionPoint{} }
+func (m *EmissionPoint) String() string { return proto.CompactTextString(m) }
+func (*EmissionPoint) ProtoMessage()    {}
+func (*EmissionPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f585f1737696c, []int{3}
+}
+func (m *EmissionPoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionPoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionPoint.Merge(m, src)
+}
+func (m *EmissionPoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionPoint proto.InternalMessageInfo
+
+// PiecewiseLinearEmission defines the release rate curve by the points sorted
+// by time; the rate is constant before the first and after the last point.
+type PiecewiseLinearEmission struct {
+	Points []EmissionPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points"`
+}
+
+func (m *PiecewiseLinearEmission) Reset()         { *m = PiecewiseLinearEmission{} }
+func (m *PiecewiseLinearEmission) String() string { return proto.CompactTextString(m) }
+func (*PiecewiseLinearEmission) ProtoMessage()    {}
+func (*PiecewiseLinearEmission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f585f1737696c, []int{4}
+}
+func (m *PiecewiseLinearEmission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PiecewiseLinearEmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PiecewiseLinearEmission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PiecewiseLinearEmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PiecewiseLinearEmission.Merge(m, src)
+}
+func (m *PiecewiseLinearEmission) XXX_Size() int {
+	return m.Size()
+}
+func (m *PiecewiseLinearEmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_PiecewiseLinearEmission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PiecewiseLinearEmission proto.InternalMessageInfo
+
+// FixedPerBlockEmission defines the fixed amount released every block.
+type FixedPerBlockEmission struct {
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// blocks_per_year is the expected number of blocks per year, which is used
+	// to compute the annual provisions.
+	BlocksPerYear uint64 `protobuf:"varint,2,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+}
+
+func (m *FixedPerBlockEmission) Reset()         { *m = FixedPerBlockEmission{} }
+func (m *FixedPerBlockEmission) String() string { return proto.CompactTextString(m) }
+func (*FixedPerBlockEmission) ProtoMessage()    {}
+func (*FixedPerBlockEmission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f585f1737696c, []int{5}
+}
+func (m *FixedPerBlockEmission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FixedPerBlockEmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FixedPerBlockEmission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FixedPerBlockEmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FixedPerBlockEmission.Merge(m, src)
+}
+func (m *FixedPerBlockEmission) XXX_Size() int {
+	return m.Size()
+}
+func (m *FixedPerBlockEmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_FixedPerBlockEmission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FixedPerBlockEmission proto.InternalMessageInfo
+
+// TargetStakingRatioEmission defines the controller which moves the release
+// rate toward the goal bonded ratio like the inflation of the cosmos mint
+// module; the rate changes by `(1 - bonded_ratio / goal_bonded) *
+// rate_change_per_year` per year.
+type TargetStakingRatioEmission struct {
+	GoalBonded        cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=goal_bonded,json=goalBonded,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"goal_bonded"`
+	RateChangePerYear cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate_change_per_year,json=rateChangePerYear,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate_change_per_year"`
+	MinReleaseRate    cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=min_release_rate,json=minReleaseRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_release_rate"`
+	MaxReleaseRate    cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_release_rate,json=maxReleaseRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_release_rate"`
+}
+
+func (m *TargetStakingRatioEmission) Reset()         { *m = TargetStakingRatioEmission{} }
+func (m *TargetStakingRatioEmission) String() string { return proto.CompactTextString(m) }
+func (*TargetStakingRatioEmission) ProtoMessage()    {}
+func (*TargetStakingRatioEmission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f585f1737696c, []int{6}
+}
+func (m *TargetStakingRatioEmission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TargetStakingRatioEmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TargetStakingRatioEmission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TargetStakingRatioEmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TargetStakingRatioEmission.Merge(m, src)
+}
+func (m *TargetStakingRatioEmission) XXX_Size() int {
+	return m.Size()
+}
+func (m *TargetStakingRatioEmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_TargetStakingRatioEmission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TargetStakingRatioEmission proto.InternalMessageInfo
+
+// EmissionForecastPeriod defines the forecasted emission of a period.
+type EmissionForecastPeriod struct {
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime   time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// release_rate is the release rate at the start of the period.
+	ReleaseRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=release_rate,json=releaseRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"release_rate"`
+	// amount is the amount of the reward denom released during the period.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *EmissionForecastPeriod) Reset()         { *m = EmissionForecastPeriod{} }
+func (m *EmissionForecastPeriod) String() string { return proto.CompactTextString(m) }
+func (*EmissionForecastPeriod) ProtoMessage()    {}
+func (*EmissionForecastPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f585f1737696c, []int{7}
+}
+func (m *EmissionForecastPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionForecastPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionForecastPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionForecastPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionForecastPeriod.Merge(m, src)
+}
+func (m *EmissionForecastPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionForecastPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionForecastPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionForecastPeriod proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("initia.reward.v1.ReleaseDestinationType", ReleaseDestinationType_name, ReleaseDestinationType_value)
+	proto.RegisterEnum("initia.reward.v1.EmissionCurve", EmissionCurve_name, EmissionCurve_value)
 	proto.RegisterType((*Params)(nil), "initia.reward.v1.Params")
+	proto.RegisterType((*ReleaseDestination)(nil), "initia.reward.v1.ReleaseDestination")
+	proto.RegisterType((*EmissionSchedule)(nil), "initia.reward.v1.EmissionSchedule")
+	proto.RegisterType((*EmissionPoint)(nil), "initia.reward.v1.EmissionPoint")
+	proto.RegisterType((*PiecewiseLinearEmission)(nil), "initia.reward.v1.PiecewiseLinearEmission")
+	proto.RegisterType((*FixedPerBlockEmission)(nil), "initia.reward.v1.FixedPerBlockEmission")
+	proto.RegisterType((*TargetStakingRatioEmission)(nil), "initia.reward.v1.TargetStakingRatioEmission")
+	proto.RegisterType((*EmissionForecastPeriod)(nil), "initia.reward.v1.EmissionForecastPeriod")
 }
 
 func init() { proto.RegisterFile("initia/reward/v1/types.proto", fileDescriptor_4a8f585f1737696c) }
 
 var fileDescriptor_4a8f585f1737696c = []byte{
-	// 414 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0x0f, 0xd2, 0x40,
-	0x14, 0xc7, 0x7b, 0xa2, 0x44, 0xcb, 0x2f, 0xad, 0x0c, 0x15, 0xcd, 0x15, 0xbb, 0x48, 0x88, 0xde,
-	0x05, 0xdd, 0x70, 0x23, 0xb8, 0x19, 0x83, 0x1d, 0x4d, 0x0c, 0x5e, 0xdb, 0xb3, 0x5c, 0x6c, 0x7b,
-	0xa4, 0xbd, 0xa2, 0xec, 0x4e, 0x4e, 0x8e, 0x8c, 0x8c, 0x8c, 0xfc, 0x19, 0x8c, 0x8c, 0xc6, 0x01,
-	0xb5, 0x0c, 0x38, 0xfb, 0x17, 0x98, 0xde, 0xb5, 0x46, 0x37, 0x97, 0xe6, 0xbd, 0xef, 0xeb, 0xfb,
-	0x7e, 0x5e, 0xee, 0x3d, 0xfd, 0x1e, 0x8b, 0x99, 0x60, 0x04, 0x27, 0xf4, 0x3d, 0x49, 0x7c, 0xbc,
-	0x1a, 0x61, 0xb1, 0x5e, 0xd2, 0x14, 0x2d, 0x13, 0x2e, 0xb8, 0x71, 0x53, 0x55, 0x91, 0xaa, 0xa2,
-	0xd5, 0xa8, 0x77, 0x8b, 0x44, 0x2c, 0xe6, 0x58, 0x7e, 0xd5, 0x4f, 0xbd, 0x6e, 0xc0, 0x03, 0x2e,
-	0x43, 0x5c, 0x44, 0xa5, 0x0a, 0x03, 0xce, 0x83, 0x90, 0x62, 0x99, 0xb9, 0xd9, 0x5b, 0xec, 0x67,
-	0x09, 0x11, 0x8c, 0xc7, 0xaa, 0x6e, 0x7f, 0xac, 0xe9, 0xf5, 0x19, 0x49, 0x48, 0x94, 0x1a, 0xf7,
-	0xf5, 0xa6, 0x02, 0xcc, 0x7d, 0x1a, 0xf3, 0xc8, 0x04, 0x7d, 0x30, 0xb8, 0xe1, 0x34, 0x94, 0x36,
-	0x2d, 0x24, 0xe3, 0xa5, 0xde, 0xf1, 0x59, 0x98, 0x15, 0xfd, 0xf3, 0x25, 0x4d, 0x18, 0xf7, 0xcd,
-	0x2b, 0x7d, 0x30, 0x68, 0x3c, 0xbe, 0x83, 0x14, 0x07, 0x55, 0x1c, 0x34, 0x2d, 0x39, 0x93, 0xd6,
-	0xe1, 0x64, 0x69, 0x9b, 0x6f, 0x16, 0xd8, 0x5d, 0xf6, 0x43, 0xe0, 0xb4, 0x2b, 0x83, 0x99, 0xec,
-	0x37, 0x5e, 0x17, 0xd4, 0x90, 0x92, 0x94, 0xce, 0x13, 0x22, 0xa8, 0x59, 0x2b, 0xa8, 0x93, 0x71,
-	0xd1, 0xf4, 0xf5, 0x64, 0xdd, 0xf5, 0x78, 0x1a, 0xf1, 0x34, 0xf5, 0xdf, 0x21, 0xc6, 0x71, 0x44,
-	0xc4, 0x02, 0x3d, 0xa7, 0x01, 0xf1, 0xd6, 0x53, 0xea, 0xfd, 0x3a, 0x59, 0xb7, 0xd7, 0x24, 0x0a,
-	0xc7, 0xf6, 0xdf, 0x06, 0x76, 0x31, 0xb1, 0x4c, 0x1d, 0x22, 0xa8, 0xf1, 0x46, 0x6f, 0xfd, 0x99,
-	0x58, 0xfa, 0x5f, 0x95, 0xfe, 0x4f, 0xff, 0xcf, 0xbf, 0xab, 0xfc, 0xff, 0x71, 0xb0, 0x9d, 0x66,
-	0x95, 0x4b, 0xc2, 0x03, 0xbd, 0x53, 0xf1, 0x69, 0x4c, 0xdc, 0x90, 0xfa, 0xe6, 0xb5, 0x3e, 0x18,
-	0x5c, 0x77, 0xda, 0xa5, 0xfc, 0x4c, 0xa9, 0xe3, 0xde, 0x66, 0x6b, 0x69, 0x3f, 0xb7, 0x16, 0xf8,
-	0x74, 0xd9, 0x0f, 0x5b, 0xe5, 0xa6, 0xd5, 0xdb, 0x4f, 0x5e, 0x1c, 0x7e, 0x40, 0x6d, 0x97, 0x43,
-	0x70, 0xc8, 0x21, 0x38, 0xe6, 0x10, 0x7c, 0xcf, 0x21, 0xf8, 0x7c, 0x86, 0xda, 0xf1, 0x0c, 0xb5,
-	0x2f, 0x67, 0xa8, 0xbd, 0x7a, 0x18, 0x30, 0xb1, 0xc8, 0x5c, 0xe4, 0xf1, 0x08, 0xab, 0x73, 0x78,
-	0x14, 0x12, 0x37, 0x2d, 0x63, 0xfc, 0xa1, 0x3a, 0x1d, 0x79, 0x37, 0x6e, 0x5d, 0xee, 0xe1, 0xc9,
-	0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xba, 0xf3, 0xa9, 0x59, 0x58, 0x02, 0x00, 0x00,
+	// 1234 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcd, 0x8f, 0xdb, 0x44,
+	0x14, 0xc0, 0xe3, 0x6c, 0xba, 0x6d, 0x27, 0xcd, 0xae, 0x3b, 0xdd, 0x96, 0x60, 0x5a, 0xc7, 0xf5,
+	0x22, 0x76, 0x59, 0x95, 0x44, 0x0d, 0xaa, 0x04, 0x45, 0x1c, 0xf2, 0xe1, 0x5d, 0xa2, 0x26, 0x9b,
+	0x30, 0x71, 0x81, 0x56, 0x2a, 0x66, 0x12, 0x4f, 0xb3, 0x56, 0x63, 0x4f, 0x64, 0x3b, 0xdb, 0xcd,
+	0x9d, 0x03, 0xca, 0xa9, 0x27, 0xd4, 0x4b, 0xa4, 0x4a, 0x1c, 0xe8, 0xb1, 0x7f, 0x04, 0x87, 0x1e,
+	0x2b, 0x0e, 0x08, 0x71, 0x28, 0xb0, 0x3d, 0x94, 0x33, 0x7f, 0x01, 0x9a, 0xb1, 0xbd, 0xcd, 0xd7,
+	0xa2, 0x6c, 0x2f, 0x91, 0x3d, 0xef, 0xbd, 0xdf, 0x7b, 0xf3, 0xbe, 0x1c, 0x70, 0xd9, 0x72, 0x2c,
+	0xdf, 0xc2, 0x39, 0x97, 0x3c, 0xc4, 0xae, 0x99, 0xdb, 0xbf, 0x9e, 0xf3, 0x07, 0x3d, 0xe2, 0x65,
+	0x7b, 0x2e, 0xf5, 0x29, 0x14, 0x03, 0x69, 0x36, 0x90, 0x66, 0xf7, 0xaf, 0x4b, 0xe7, 0xb1, 0x6d,
+	0x39, 0x34, 0xc7, 0x7f, 0x03, 0x25, 0x69, 0xad, 0x43, 0x3b, 0x94, 0x3f, 0xe6, 0xd8, 0x53, 0x78,
+	0x2a, 0x77, 0x28, 0xed, 0x74, 0x49, 0x8e, 0xbf, 0xb5, 0xfa, 0xf7, 0x73, 0x66, 0xdf, 0xc5, 0xbe,
+	0x45, 0x9d, 0x50, 0x9e, 0x99, 0x96, 0xfb, 0x96, 0x4d, 0x3c, 0x1f, 0xdb, 0xbd, 0x40, 0x41, 0xfd,
+	0x25, 0x01, 0x96, 0x1b, 0xd8, 0xc5, 0xb6, 0x07, 0xaf, 0x82, 0x73, 0x41, 0x04, 0x86, 0x49, 0x1c,
+	0x6a, 0xa7, 0x05, 0x45, 0xd8, 0x3c, 0x8b, 0x92, 0xc1, 0x59, 0x99, 0x1d, 0xc1, 0x2f, 0xc1, 0xaa,
+	0x69, 0x75, 0xfb, 0xcc, 0x81, 0xd1, 0x23, 0xae, 0x45, 0xcd, 0x74, 0x5c, 0x11, 0x36, 0x93, 0xf9,
+	0x77, 0xb3, 0x81, 0xa3, 0x6c, 0xe4, 0x28, 0x5b, 0x0e, 0x03, 0x29, 0xa6, 0x9e, 0xbf, 0xcc, 0xc4,
+	0x1e, 0xff, 0x99, 0x11, 0x9e, 0xbe, 0x7e, 0xb6, 0x25, 0xa0, 0x95, 0x08, 0xd0, 0xe0, 0xf6, 0xf0,
+	0x1e, 0xf3, 0xda, 0x25, 0xd8, 0x23, 0x86, 0x8b, 0x7d, 0x92, 0x5e, 0x62, 0x5e, 0x8b, 0x37, 0x99,
+	0xd1, 0x1f, 0x2f, 0x33, 0xef, 0xb5, 0xa9, 0x67, 0x53, 0xcf, 0x33, 0x1f, 0x64, 0x2d, 0x9a, 0xb3,
+	0xb1, 0xbf, 0x97, 0xad, 0x92, 0x0e, 0x6e, 0x0f, 0xca, 0xa4, 0xfd, 0xef, 0xcb, 0xcc, 0x85, 0x01,
+	0xb6, 0xbb, 0x37, 0xd5, 0x71, 0x80, 0x8a, 0x92, 0xe1, 0x2b, 0xc2, 0x3e, 0x81, 0xdf, 0x81, 0xd4,
+	0x51, 0xc4, 0x9c, 0x9f, 0xe0, 0xfc, 0xcf, 0x16, 0xe3, 0xaf, 0x05, 0xfc, 0x09, 0x82, 0x8a, 0xce,
+	0x45, 0xef, 0xdc, 0xc3, 0x06, 0x58, 0x8d, 0xfc, 0x13, 0x07, 0xb7, 0xba, 0xc4, 0x4c, 0x9f, 0x52,
+	0x84, 0xcd, 0x33, 0x68, 0x25, 0x3c, 0xd6, 0x82, 0x53, 0x78, 0x17, 0x9c, 0x27, 0xb6, 0xe5, 0x79,
+	0x0c, 0xe4, 0xb5, 0xf7, 0x88, 0xd9, 0xef, 0x92, 0xf4, 0x32, 0x4f, 0x9f, 0x9a, 0x9d, 0x6e, 0x81,
+	0xac, 0x16, 0xaa, 0x36, 0x43, 0xcd, 0xe2, 0x59, 0x16, 0x72, 0x90, 0x43, 0x91, 0x4c, 0x09, 0x61,
+	0x0b, 0xac, 0x45, 0x41, 0x98, 0xc4, 0xf3, 0x2d, 0x87, 0xe7, 0xde, 0x4b, 0x9f, 0x56, 0x96, 0x36,
+	0x93, 0xf9, 0xf7, 0x67, 0xf1, 0x28, 0xd0, 0x2e, 0xbf, 0x51, 0x1e, 0x77, 0x70, 0xc1, 0x9d, 0x11,
+	0x7b, 0x37, 0xa5, 0xc7, 0x4f, 0x32, 0xb1, 0x7f, 0x9e, 0x64, 0x84, 0xe1, 0xeb, 0x67, 0x5b, 0xa9,
+	0xb0, 0x95, 0x83, 0xde, 0x51, 0x7f, 0x15, 0x00, 0x9c, 0x45, 0x42, 0x08, 0x12, 0x0e, 0xb6, 0x49,
+	0xd8, 0x4a, 0xfc, 0x19, 0x36, 0x81, 0x38, 0x16, 0xa2, 0xc1, 0x06, 0x81, 0x37, 0xd1, 0x4a, 0x7e,
+	0x73, 0x91, 0x30, 0xf5, 0x41, 0x8f, 0xa0, 0x55, 0x73, 0xf2, 0x00, 0xa6, 0xc1, 0x69, 0x6c, 0x9a,
+	0x2e, 0xf1, 0xbc, 0xa0, 0x81, 0x50, 0xf4, 0x0a, 0x3f, 0x05, 0xa7, 0x78, 0x23, 0x86, 0x85, 0x5f,
+	0x5f, 0xa0, 0xf0, 0x28, 0xb0, 0x50, 0x5f, 0xc4, 0x81, 0x38, 0x5d, 0x06, 0x78, 0x03, 0x9c, 0x6a,
+	0xf7, 0xdd, 0xfd, 0xe0, 0x4e, 0x2b, 0xf9, 0xcc, 0xf1, 0x95, 0x2b, 0x31, 0x35, 0x14, 0x68, 0x43,
+	0x1d, 0x88, 0x3d, 0x8b, 0xb4, 0xc9, 0x43, 0xcb, 0x23, 0x46, 0xd7, 0x72, 0x08, 0x76, 0xc3, 0xd1,
+	0xf9, 0x70, 0x96, 0xd0, 0x88, 0x34, 0xab, 0x5c, 0x31, 0x02, 0xa2, 0xd5, 0xde, 0xa4, 0x00, 0xd6,
+	0xc1, 0xea, 0x7d, 0xeb, 0x80, 0x98, 0x6c, 0x18, 0x8d, 0x56, 0x97, 0xb6, 0x1f, 0xf0, 0xeb, 0x27,
+	0xf3, 0x1b, 0xb3, 0xd0, 0x6d, 0xa6, 0xd8, 0x20, 0x6e, 0x91, 0xa9, 0x1d, 0x21, 0x53, 0xf7, 0xc7,
+	0x8f, 0xe1, 0xb7, 0x60, 0xcd, 0xc7, 0x6e, 0x87, 0xf8, 0x86, 0xe7, 0xe3, 0x07, 0x96, 0xd3, 0x31,
+	0xde, 0x24, 0x2f, 0x99, 0xbf, 0x36, 0x4b, 0xd5, 0xb9, 0x76, 0x33, 0x50, 0x46, 0x4c, 0xf7, 0x08,
+	0x0d, 0xfd, 0x19, 0x99, 0xfa, 0xa3, 0x00, 0x52, 0x91, 0x42, 0x83, 0x5a, 0x8e, 0x0f, 0x3f, 0x07,
+	0x09, 0xb6, 0x93, 0x78, 0x3a, 0x93, 0x79, 0x69, 0x66, 0x8f, 0xe8, 0xd1, 0xc2, 0x0a, 0x16, 0xc9,
+	0xa3, 0xa3, 0x45, 0xc2, 0xcd, 0xe0, 0xf6, 0xd4, 0xfa, 0x88, 0x2f, 0x5e, 0xe5, 0xf1, 0x3d, 0xa1,
+	0xde, 0x03, 0xef, 0x1c, 0x93, 0x75, 0x58, 0x04, 0xcb, 0x3d, 0x16, 0xaa, 0x97, 0x16, 0xf8, 0x34,
+	0xfd, 0x4f, 0xc9, 0xf9, 0x95, 0xc6, 0x07, 0x29, 0xb4, 0x54, 0xf7, 0xc1, 0xc5, 0xb9, 0xf9, 0x87,
+	0x37, 0xc0, 0x32, 0xb6, 0x69, 0xdf, 0xf1, 0x83, 0x19, 0x29, 0x5e, 0x09, 0x23, 0xbf, 0x38, 0x1b,
+	0x79, 0xc5, 0xf1, 0x51, 0xa8, 0x0c, 0x3f, 0x00, 0xab, 0xbc, 0xdc, 0x1e, 0xaf, 0xfc, 0x20, 0xea,
+	0xa6, 0x04, 0x4a, 0x05, 0xc7, 0x0d, 0xe2, 0xde, 0x21, 0xd8, 0x55, 0x7f, 0x8b, 0x03, 0xe9, 0xf8,
+	0x12, 0xc1, 0x32, 0x48, 0x76, 0x28, 0xee, 0x1a, 0x2d, 0xea, 0x98, 0xc4, 0x4c, 0x0b, 0x8b, 0x27,
+	0x0f, 0x30, 0xbb, 0x22, 0x37, 0x83, 0x3a, 0x58, 0x63, 0xb9, 0x37, 0xda, 0x7b, 0xd8, 0xe9, 0x90,
+	0xc9, 0x88, 0x16, 0xc4, 0x9d, 0x67, 0x80, 0x12, 0xb7, 0x0f, 0x43, 0x87, 0x35, 0x20, 0xda, 0x96,
+	0x63, 0xcc, 0xf9, 0x38, 0x2c, 0x44, 0x5c, 0xb1, 0x2d, 0x07, 0x8d, 0x7d, 0x08, 0x18, 0x0e, 0x1f,
+	0x4c, 0xe2, 0x12, 0x27, 0xc1, 0xe1, 0x83, 0x31, 0x9c, 0xfa, 0x73, 0x1c, 0x5c, 0x8a, 0xd2, 0xb8,
+	0x4d, 0x5d, 0xd2, 0xc6, 0x9e, 0x1f, 0x7e, 0xd1, 0xbe, 0x00, 0xc0, 0xf3, 0xb1, 0xeb, 0x1b, 0x6f,
+	0xd7, 0xd7, 0x67, 0xb9, 0x31, 0x13, 0xc3, 0x32, 0x38, 0x43, 0x1c, 0x33, 0xe0, 0xc4, 0x4f, 0xca,
+	0x39, 0x4d, 0x1c, 0x53, 0x9f, 0x37, 0x22, 0x4b, 0x6f, 0x37, 0x22, 0x63, 0xad, 0x9a, 0x38, 0x41,
+	0xab, 0x6e, 0x7d, 0x1f, 0x07, 0x97, 0xe6, 0xaf, 0x71, 0xb8, 0x03, 0x14, 0xa4, 0x55, 0xb5, 0x42,
+	0x53, 0x33, 0xca, 0x5a, 0x53, 0xaf, 0xec, 0x16, 0xf4, 0x4a, 0x7d, 0xd7, 0xd0, 0xef, 0x34, 0x34,
+	0xa3, 0x50, 0x2e, 0x23, 0xad, 0xd9, 0x14, 0x63, 0xd2, 0xd5, 0xe1, 0x48, 0xb9, 0x32, 0x9f, 0x50,
+	0x08, 0x97, 0xbc, 0x06, 0x32, 0xc7, 0x82, 0x6a, 0xf5, 0xf2, 0xed, 0xaa, 0x26, 0x0a, 0x92, 0x32,
+	0x1c, 0x29, 0x97, 0xe7, 0x73, 0x6a, 0x94, 0xef, 0x76, 0x1d, 0x6c, 0x1c, 0x8b, 0x29, 0xd5, 0x6b,
+	0xb5, 0xdb, 0xbb, 0x15, 0xfd, 0x8e, 0xd1, 0xa8, 0xd7, 0xab, 0x62, 0x5c, 0xda, 0x18, 0x8e, 0x94,
+	0xf5, 0xf9, 0xb8, 0x12, 0xb5, 0xed, 0xbe, 0x63, 0xf9, 0x83, 0x06, 0xa5, 0x5d, 0x29, 0xf1, 0xc3,
+	0x4f, 0x72, 0x6c, 0xeb, 0x69, 0x1c, 0xa4, 0x26, 0xbe, 0x0c, 0xf0, 0x13, 0x90, 0xd6, 0x6a, 0x95,
+	0x66, 0x93, 0xb9, 0x28, 0xdd, 0x46, 0x5f, 0x69, 0xc6, 0x8e, 0x56, 0xaf, 0x69, 0x3a, 0xaa, 0x94,
+	0xc4, 0x98, 0x24, 0x0d, 0x47, 0xca, 0xa5, 0x09, 0x83, 0x1d, 0x42, 0x6d, 0xe2, 0xbb, 0x56, 0x9b,
+	0x5d, 0x77, 0xca, 0xb2, 0x51, 0xd1, 0x4a, 0xda, 0xd7, 0x95, 0xa6, 0x66, 0x54, 0x2b, 0xbb, 0x5a,
+	0x01, 0x45, 0xd7, 0x9d, 0x00, 0x4c, 0x2d, 0x38, 0x58, 0x04, 0xf2, 0x14, 0x66, 0xbb, 0xf2, 0x8d,
+	0x56, 0x36, 0x1a, 0x1a, 0x32, 0x8a, 0xd5, 0x7a, 0xe9, 0x96, 0x18, 0x97, 0xe4, 0xe1, 0x48, 0x91,
+	0x26, 0x28, 0x13, 0x7b, 0x0c, 0x56, 0xc1, 0xfa, 0x14, 0x43, 0x2f, 0xa0, 0x1d, 0x4d, 0x37, 0x9a,
+	0x7a, 0xe1, 0x56, 0x65, 0x77, 0xc7, 0x40, 0x2c, 0x87, 0xe2, 0x92, 0xb4, 0x3e, 0x1c, 0x29, 0x99,
+	0x09, 0xd0, 0xec, 0x5e, 0x0a, 0x52, 0x55, 0xdc, 0x7d, 0xfe, 0xb7, 0x1c, 0x7b, 0x7a, 0x28, 0x0b,
+	0xcf, 0x0f, 0x65, 0xe1, 0xc5, 0xa1, 0x2c, 0xfc, 0x75, 0x28, 0x0b, 0x8f, 0x5e, 0xc9, 0xb1, 0x17,
+	0xaf, 0xe4, 0xd8, 0xef, 0xaf, 0xe4, 0xd8, 0xdd, 0x6b, 0x1d, 0xcb, 0xdf, 0xeb, 0xb7, 0xb2, 0x6d,
+	0x6a, 0xe7, 0x82, 0x65, 0xfc, 0x51, 0x17, 0xb7, 0xbc, 0xf0, 0x39, 0x77, 0x10, 0xfd, 0xd1, 0xe6,
+	0xff, 0xb2, 0x5b, 0xcb, 0x7c, 0x58, 0x3e, 0xfe, 0x6f, 0x00, 0x22, 0xd8, 0xbc, 0x08, 0x86, 0x0b,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -144,116 +578,1768 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.ReleaseEnabled != that1.ReleaseEnabled {
 		return false
 	}
+	if !this.EmissionSchedule.Equal(&that1.EmissionSchedule) {
+		return false
+	}
+	if len(this.ReleaseDestinations) != len(that1.ReleaseDestinations) {
+		return false
+	}
+	for i := range this.ReleaseDestinations {
+		if !this.ReleaseDestinations[i].Equal(&that1.ReleaseDestinations[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ReleaseDestination) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*ReleaseDestination)
+	if !ok {
+		that2, ok := that.(ReleaseDestination)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.DestinationType != that1.DestinationType {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Ratio.Equal(that1.Ratio) {
+		return false
+	}
+	return true
 }
+func (this *EmissionSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ReleaseEnabled {
-		i--
-		if m.ReleaseEnabled {
-			dAtA[i] = 1
+	that1, ok := that.(*EmissionSchedule)
+	if !ok {
+		that2, ok := that.(EmissionSchedule)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x28
 	}
-	{
-		size := m.DilutionRate.Size()
-		i -= size
-		if _, err := m.DilutionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.ReleaseRate.Size()
-		i -= size
-		if _, err := m.ReleaseRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if this.Curve != that1.Curve {
+		return false
+	}
+	if !this.PiecewiseLinear.Equal(that1.PiecewiseLinear) {
+		return false
+	}
+	if !this.FixedPerBlock.Equal(that1.FixedPerBlock) {
+		return false
+	}
+	if !this.TargetStakingRatio.Equal(that1.TargetStakingRatio) {
+		return false
+	}
+	return true
+}
+func (this *EmissionPoint) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EmissionPoint)
+	if !ok {
+		that2, ok := that.(EmissionPoint)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if !this.ReleaseRate.Equal(that1.ReleaseRate) {
+		return false
+	}
+	return true
+}
+func (this *PiecewiseLinearEmission) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PiecewiseLinearEmission)
+	if !ok {
+		that2, ok := that.(PiecewiseLinearEmission)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Points) != len(that1.Points) {
+		return false
+	}
+	for i := range this.Points {
+		if !this.Points[i].Equal(&that1.Points[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *FixedPerBlockEmission) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FixedPerBlockEmission)
+	if !ok {
+		that2, ok := that.(FixedPerBlockEmission)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.BlocksPerYear != that1.BlocksPerYear {
+		return false
+	}
+	return true
+}
+func (this *TargetStakingRatioEmission) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TargetStakingRatioEmission)
+	if !ok {
+		that2, ok := that.(TargetStakingRatioEmission)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GoalBonded.Equal(that1.GoalBonded) {
+		return false
+	}
+	if !this.RateChangePerYear.Equal(that1.RateChangePerYear) {
+		return false
+	}
+	if !this.MinReleaseRate.Equal(that1.MinReleaseRate) {
+		return false
+	}
+	if !this.MaxReleaseRate.Equal(that1.MaxReleaseRate) {
+		return false
+	}
+	return true
+}
+func (this *EmissionForecastPeriod) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EmissionForecastPeriod)
+	if !ok {
+		that2, ok := that.(EmissionForecastPeriod)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
+	}
+	if !this.EndTime.Equal(that1.EndTime) {
+		return false
+	}
+	if !this.ReleaseRate.Equal(that1.ReleaseRate) {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReleaseDestinations) > 0 {
+		for iNdEx := len(m.ReleaseDestinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReleaseDestinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.EmissionSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.ReleaseEnabled {
+		i--
+		if m.ReleaseEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.DilutionRate.Size()
+		i -= size
+		if _, err := m.DilutionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ReleaseRate.Size()
+		i -= size
+		if _, err := m.ReleaseRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DilutionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DilutionPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTypes(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.RewardDenom) > 0 {
+		i -= len(m.RewardDenom)
+		copy(dAtA[i:], m.RewardDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RewardDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Ratio.Size()
+		i -= size
+		if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DestinationType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DestinationType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TargetStakingRatio != nil {
+		{
+			size, err := m.TargetStakingRatio.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FixedPerBlock != nil {
+		{
+			size, err := m.FixedPerBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PiecewiseLinear != nil {
+		{
+			size, err := m.PiecewiseLinear.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Curve != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Curve))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ReleaseRate.Size()
+		i -= size
+		if _, err := m.ReleaseRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PiecewiseLinearEmission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PiecewiseLinearEmission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PiecewiseLinearEmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FixedPerBlockEmission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FixedPerBlockEmission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FixedPerBlockEmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlocksPerYear != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlocksPerYear))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TargetStakingRatioEmission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TargetStakingRatioEmission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TargetStakingRatioEmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxReleaseRate.Size()
+		i -= size
+		if _, err := m.MaxReleaseRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinReleaseRate.Size()
+		i -= size
+		if _, err := m.MinReleaseRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RateChangePerYear.Size()
+		i -= size
+		if _, err := m.RateChangePerYear.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.GoalBonded.Size()
+		i -= size
+		if _, err := m.GoalBonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionForecastPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionForecastPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionForecastPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ReleaseRate.Size()
+		i -= size
+		if _, err := m.ReleaseRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RewardDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DilutionPeriod)
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ReleaseRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.DilutionRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.ReleaseEnabled {
+		n += 2
+	}
+	l = m.EmissionSchedule.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.ReleaseDestinations) > 0 {
+		for _, e := range m.ReleaseDestinations {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReleaseDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.DestinationType != 0 {
+		n += 1 + sovTypes(uint64(m.DestinationType))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Ratio.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *EmissionSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Curve != 0 {
+		n += 1 + sovTypes(uint64(m.Curve))
+	}
+	if m.PiecewiseLinear != nil {
+		l = m.PiecewiseLinear.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.FixedPerBlock != nil {
+		l = m.FixedPerBlock.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TargetStakingRatio != nil {
+		l = m.TargetStakingRatio.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *EmissionPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ReleaseRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *PiecewiseLinearEmission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FixedPerBlockEmission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.BlocksPerYear != 0 {
+		n += 1 + sovTypes(uint64(m.BlocksPerYear))
+	}
+	return n
+}
+
+func (m *TargetStakingRatioEmission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GoalBonded.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.RateChangePerYear.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.MinReleaseRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.MaxReleaseRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *EmissionForecastPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ReleaseRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DilutionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DilutionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReleaseRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DilutionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DilutionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReleaseEnabled = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmissionSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseDestinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleaseDestinations = append(m.ReleaseDestinations, ReleaseDestination{})
+			if err := m.ReleaseDestinations[len(m.ReleaseDestinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationType", wireType)
+			}
+			m.DestinationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationType |= ReleaseDestinationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			m.Curve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Curve |= EmissionCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PiecewiseLinear", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PiecewiseLinear == nil {
+				m.PiecewiseLinear = &PiecewiseLinearEmission{}
+			}
+			if err := m.PiecewiseLinear.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedPerBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FixedPerBlock == nil {
+				m.FixedPerBlock = &FixedPerBlockEmission{}
+			}
+			if err := m.FixedPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetStakingRatio", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TargetStakingRatio == nil {
+				m.TargetStakingRatio = &TargetStakingRatioEmission{}
+			}
+			if err := m.TargetStakingRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionPoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionPoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReleaseRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PiecewiseLinearEmission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PiecewiseLinearEmission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PiecewiseLinearEmission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = append(m.Points, EmissionPoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FixedPerBlockEmission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FixedPerBlockEmission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FixedPerBlockEmission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+			}
+			m.BlocksPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DilutionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DilutionPeriod):])
-	if err1 != nil {
-		return 0, err1
 	}
-	i -= n1
-	i = encodeVarintTypes(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x12
-	if len(m.RewardDenom) > 0 {
-		i -= len(m.RewardDenom)
-		copy(dAtA[i:], m.RewardDenom)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.RewardDenom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RewardDenom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DilutionPeriod)
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.ReleaseRate.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.DilutionRate.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.ReleaseEnabled {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *TargetStakingRatioEmission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -276,15 +2362,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: TargetStakingRatioEmission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TargetStakingRatioEmission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -312,13 +2398,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DilutionPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateChangePerYear", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -328,28 +2416,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DilutionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RateChangePerYear.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReleaseRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -377,13 +2466,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReleaseRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinReleaseRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DilutionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReleaseRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -411,15 +2500,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DilutionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxReleaseRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseEnabled", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionForecastPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionForecastPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionForecastPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -429,12 +2568,126 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReleaseEnabled = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReleaseRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
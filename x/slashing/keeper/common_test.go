@@ -311,11 +311,13 @@ func _createTestInput(
 		accountKeeper,
 		bankKeeper,
 		movekeeper.NewVotingPowerKeeper(moveKeeper),
+		movekeeper.NewDexKeeper(moveKeeper),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		vc, cc,
 	)
 	stakingParams := stakingtypes.DefaultParams()
 	stakingParams.BondDenoms = []string{bondDenom}
+	stakingParams.KeyRotationFee.Denom = bondDenom
 	require.NoError(t, stakingKeeper.SetParams(ctx, stakingParams))
 	rewardKeeper := rewardkeeper.NewKeeper(
 		appCodec,
@@ -336,6 +338,7 @@ func _createTestInput(
 		bankKeeper,
 		stakingKeeper,
 		movekeeper.NewDexKeeper(moveKeeper),
+		rewardKeeper,
 		authtypes.FeeCollectorName,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -409,6 +412,7 @@ func _createTestInput(
 		stakingKeeper,
 		distKeeper,
 		movekeeper.NewVestingKeeper(moveKeeper),
+		movekeeper.NewMoveGovKeeper(moveKeeper),
 		msgRouter,
 		govConfig,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
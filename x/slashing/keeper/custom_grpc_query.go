@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	customtypes "github.com/initia-labs/initia/x/slashing/types"
+)
+
+var _ customtypes.QueryServer = CustomQueryServer{}
+
+// CustomQueryServer implement initia slashing queries
+type CustomQueryServer struct {
+	Keeper
+}
+
+// NewCustomQueryServer create CustomQueryServer instance
+func NewCustomQueryServer(k Keeper) CustomQueryServer {
+	return CustomQueryServer{k}
+}
+
+// MissedBlocks returns the missed blocks of a validator in the current window.
+func (q CustomQueryServer) MissedBlocks(ctx context.Context, req *customtypes.QueryMissedBlocksRequest) (*customtypes.QueryMissedBlocksResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consAddr, err := q.sk.ConsensusAddressCodec().StringToBytes(req.ConsAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if !q.HasValidatorSigningInfo(ctx, consAddr) {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
+	}
+
+	missedBlocks, err := q.GetValidatorMissedBlocks(ctx, consAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &customtypes.QueryMissedBlocksResponse{MissedBlocks: missedBlocks}, nil
+}
+
+// ValidatorUptime returns the uptime of a validator over the current window.
+func (q CustomQueryServer) ValidatorUptime(ctx context.Context, req *customtypes.QueryValidatorUptimeRequest) (*customtypes.QueryValidatorUptimeResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consAddr, err := q.sk.ConsensusAddressCodec().StringToBytes(req.ConsAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	signInfo, err := q.GetValidatorSigningInfo(ctx, sdk.ConsAddress(consAddr))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
+	}
+
+	signedBlocksWindow, err := q.SignedBlocksWindow(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// the index offset is the number of the blocks handled since the validator
+	// started or was unjailed
+	windowBlocks := min(signInfo.IndexOffset, signedBlocksWindow)
+
+	uptime := math.LegacyOneDec()
+	if windowBlocks > 0 {
+		uptime = math.LegacyNewDec(windowBlocks - signInfo.MissedBlocksCounter).QuoInt64(windowBlocks)
+	}
+
+	return &customtypes.QueryValidatorUptimeResponse{
+		SignedBlocksWindow:  signedBlocksWindow,
+		WindowBlocks:        windowBlocks,
+		MissedBlocksCounter: signInfo.MissedBlocksCounter,
+		Uptime:              uptime,
+	}, nil
+}
+
+// GraceMode returns the grace mode suspending the downtime jailing.
+func (q CustomQueryServer) GraceMode(ctx context.Context, req *customtypes.QueryGraceModeRequest) (*customtypes.QueryGraceModeResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	graceMode, found, err := q.GetGraceMode(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if !found {
+		return &customtypes.QueryGraceModeResponse{}, nil
+	}
+
+	return &customtypes.QueryGraceModeResponse{
+		GraceMode: &graceMode,
+		Active:    graceMode.IsActive(sdk.UnwrapSDKContext(ctx).BlockHeight()),
+	}, nil
+}
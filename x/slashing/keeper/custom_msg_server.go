@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	customtypes "github.com/initia-labs/initia/x/slashing/types"
+)
+
+type customMsgServer struct {
+	Keeper
+}
+
+// NewCustomMsgServerImpl returns an implementation of the initia slashing MsgServer
+// interface for the provided Keeper.
+func NewCustomMsgServerImpl(k Keeper) customtypes.MsgServer {
+	return &customMsgServer{Keeper: k}
+}
+
+var _ customtypes.MsgServer = customMsgServer{}
+
+// ActivateGraceMode implements MsgServer.ActivateGraceMode method.
+// It suspends the downtime jailing from the current height for the given blocks.
+func (k customMsgServer) ActivateGraceMode(ctx context.Context, req *customtypes.MsgActivateGraceMode) (*customtypes.MsgActivateGraceModeResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	signedBlocksWindow, err := k.SignedBlocksWindow(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Blocks == 0 || req.Blocks > uint64(signedBlocksWindow) {
+		return nil, errors.Wrapf(customtypes.ErrInvalidGraceMode, "blocks must be in [1, %d]", signedBlocksWindow)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	graceMode := customtypes.GraceMode{
+		StartHeight: sdkCtx.BlockHeight(),
+		EndHeight:   sdkCtx.BlockHeight() + int64(req.Blocks),
+	}
+	if err := k.SetGraceMode(ctx, graceMode); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			customtypes.EventTypeActivateGraceMode,
+			sdk.NewAttribute(customtypes.AttributeKeyStartHeight, strconv.FormatInt(graceMode.StartHeight, 10)),
+			sdk.NewAttribute(customtypes.AttributeKeyEndHeight, strconv.FormatInt(graceMode.EndHeight, 10)),
+		),
+	)
+
+	return &customtypes.MsgActivateGraceModeResponse{}, nil
+}
+
+// DeactivateGraceMode implements MsgServer.DeactivateGraceMode method.
+// It ends the grace mode before its end height.
+func (k customMsgServer) DeactivateGraceMode(ctx context.Context, req *customtypes.MsgDeactivateGraceMode) (*customtypes.MsgDeactivateGraceModeResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if active, err := k.IsGraceModeActive(ctx, sdkCtx.BlockHeight()); err != nil {
+		return nil, err
+	} else if !active {
+		return nil, errors.Wrap(customtypes.ErrInvalidGraceMode, "grace mode is not active")
+	}
+
+	if err := k.DeleteGraceMode(ctx); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			customtypes.EventTypeDeactivateGraceMode,
+			sdk.NewAttribute(customtypes.AttributeKeyEndHeight, strconv.FormatInt(sdkCtx.BlockHeight(), 10)),
+		),
+	)
+
+	return &customtypes.MsgDeactivateGraceModeResponse{}, nil
+}
@@ -5,11 +5,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 
 	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
+	customtypes "github.com/initia-labs/initia/x/slashing/types"
 )
 
 // InitGenesis initialize default parameters
 // and the keeper's address to pubkey map
-func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+func (k Keeper) InitGenesis(ctx sdk.Context, data *customtypes.GenesisState) {
 	err := k.sk.IterateValidators(ctx,
 		func(validator stakingtypes.ValidatorI) (bool, error) {
 			consPk, err := validator.ConsPubKey()
@@ -54,12 +55,18 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 	if err := k.SetParams(ctx, data.Params); err != nil {
 		panic(err)
 	}
+
+	if data.GraceMode != nil {
+		if err := k.SetGraceMode(ctx, *data.GraceMode); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis writes the current store values
 // to a genesis file, which can be imported again
 // with InitGenesis
-func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
+func (k Keeper) ExportGenesis(ctx sdk.Context) (data *customtypes.GenesisState) {
 	params, err := k.GetParams(ctx)
 	if err != nil {
 		panic(err)
@@ -89,5 +96,12 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	var graceMode *customtypes.GraceMode
+	if mode, found, err := k.GetGraceMode(ctx); err != nil {
+		panic(err)
+	} else if found {
+		graceMode = &mode
+	}
+
+	return customtypes.NewGenesisState(params, signingInfos, missedBlocks, graceMode)
 }
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/initia-labs/initia/x/slashing/types"
+)
+
+// GetGraceMode returns the last activated grace mode and whether it exists.
+func (k Keeper) GetGraceMode(ctx context.Context) (types.GraceMode, bool, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	var graceMode types.GraceMode
+	bz, err := store.Get(types.GraceModeKey)
+	if err != nil || bz == nil {
+		return graceMode, false, err
+	}
+
+	err = k.cdc.Unmarshal(bz, &graceMode)
+	return graceMode, err == nil, err
+}
+
+// SetGraceMode stores the grace mode.
+func (k Keeper) SetGraceMode(ctx context.Context, graceMode types.GraceMode) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&graceMode)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.GraceModeKey, bz)
+}
+
+// DeleteGraceMode removes the grace mode.
+func (k Keeper) DeleteGraceMode(ctx context.Context) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.GraceModeKey)
+}
+
+// IsGraceModeActive returns true if the grace mode is active at the given height.
+func (k Keeper) IsGraceModeActive(ctx context.Context, height int64) (bool, error) {
+	graceMode, found, err := k.GetGraceMode(ctx)
+	if err != nil || !found {
+		return false, err
+	}
+
+	return graceMode.IsActive(height), nil
+}
@@ -0,0 +1,192 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	staking "github.com/initia-labs/initia/x/mstaking"
+	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
+	"github.com/initia-labs/initia/x/slashing/keeper"
+	customtypes "github.com/initia-labs/initia/x/slashing/types"
+)
+
+func TestGraceMode(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	p, err := input.SlashingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	p.SignedBlocksWindow = 100
+	input.SlashingKeeper.SetParams(ctx, p)
+
+	signedBlock, err := input.SlashingKeeper.SignedBlocksWindow(ctx)
+	require.NoError(t, err)
+
+	power := int64(100)
+	_, valPubKey := createValidatorWithBalanceAndGetPk(ctx, input, 100_000_000, 100_000_000, 1)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	height := int64(0)
+	for ; height < signedBlock; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		require.NoError(t, input.SlashingKeeper.HandleValidatorSignature(ctx, valPubKey.Address(), power, comet.BlockIDFlagCommit))
+	}
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	ms := keeper.NewCustomMsgServerImpl(input.SlashingKeeper)
+
+	// invalid authority
+	ctx = ctx.WithBlockHeight(height)
+	_, err = ms.ActivateGraceMode(ctx, &customtypes.MsgActivateGraceMode{Authority: authtypes.NewModuleAddress("foo").String(), Blocks: 10})
+	require.Error(t, err)
+
+	// longer than the signed blocks window
+	_, err = ms.ActivateGraceMode(ctx, &customtypes.MsgActivateGraceMode{Authority: authority, Blocks: uint64(signedBlock) + 1})
+	require.ErrorIs(t, err, customtypes.ErrInvalidGraceMode)
+
+	_, err = ms.ActivateGraceMode(ctx, &customtypes.MsgActivateGraceMode{Authority: authority, Blocks: uint64(signedBlock)})
+	require.NoError(t, err)
+
+	queryServer := keeper.NewCustomQueryServer(input.SlashingKeeper)
+	graceModeRes, err := queryServer.GraceMode(ctx, &customtypes.QueryGraceModeRequest{})
+	require.NoError(t, err)
+	require.True(t, graceModeRes.Active)
+	require.Equal(t, customtypes.GraceMode{StartHeight: height, EndHeight: height + signedBlock}, *graceModeRes.GraceMode)
+
+	signInfo, err := input.SlashingKeeper.GetValidatorSigningInfo(ctx, sdk.GetConsAddress(valPubKey))
+	require.NoError(t, err)
+	indexOffset := signInfo.IndexOffset
+
+	// all blocks missed during the grace mode
+	for ; height < 2*signedBlock; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		require.NoError(t, input.SlashingKeeper.HandleValidatorSignature(ctx, valPubKey.Address(), power, comet.BlockIDFlagAbsent))
+	}
+
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	// validator should not have been jailed
+	validator, err := input.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(valPubKey))
+	require.NoError(t, err)
+	require.Equal(t, stakingtypes.Bonded, validator.GetStatus())
+
+	// the votes are not tracked during the grace mode
+	signInfo, err = input.SlashingKeeper.GetValidatorSigningInfo(ctx, sdk.GetConsAddress(valPubKey))
+	require.NoError(t, err)
+	require.Zero(t, signInfo.MissedBlocksCounter)
+	require.Equal(t, indexOffset, signInfo.IndexOffset)
+
+	// grace mode ended
+	ctx = ctx.WithBlockHeight(height)
+	graceModeRes, err = queryServer.GraceMode(ctx, &customtypes.QueryGraceModeRequest{})
+	require.NoError(t, err)
+	require.False(t, graceModeRes.Active)
+
+	_, err = ms.DeactivateGraceMode(ctx, &customtypes.MsgDeactivateGraceMode{Authority: authority})
+	require.ErrorIs(t, err, customtypes.ErrInvalidGraceMode)
+
+	// the missed blocks are counted again
+	require.NoError(t, input.SlashingKeeper.HandleValidatorSignature(ctx, valPubKey.Address(), power, comet.BlockIDFlagAbsent))
+	signInfo, err = input.SlashingKeeper.GetValidatorSigningInfo(ctx, sdk.GetConsAddress(valPubKey))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), signInfo.MissedBlocksCounter)
+
+	// deactivate the grace mode before the end height
+	_, err = ms.ActivateGraceMode(ctx, &customtypes.MsgActivateGraceMode{Authority: authority, Blocks: 10})
+	require.NoError(t, err)
+	_, err = ms.DeactivateGraceMode(ctx, &customtypes.MsgDeactivateGraceMode{Authority: authority})
+	require.NoError(t, err)
+
+	graceModeRes, err = queryServer.GraceMode(ctx, &customtypes.QueryGraceModeRequest{})
+	require.NoError(t, err)
+	require.Nil(t, graceModeRes.GraceMode)
+	require.False(t, graceModeRes.Active)
+}
+
+func TestGraceModeGenesis(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	genState := input.SlashingKeeper.ExportGenesis(ctx)
+	require.Nil(t, genState.GraceMode)
+
+	graceMode := customtypes.GraceMode{StartHeight: 10, EndHeight: 20}
+	require.NoError(t, input.SlashingKeeper.SetGraceMode(ctx, graceMode))
+
+	genState = input.SlashingKeeper.ExportGenesis(ctx)
+	require.Equal(t, &graceMode, genState.GraceMode)
+	require.NoError(t, customtypes.ValidateGenesis(*genState))
+
+	invalidGenState := *genState
+	invalidGenState.GraceMode = &customtypes.GraceMode{StartHeight: 20, EndHeight: 20}
+	require.Error(t, customtypes.ValidateGenesis(invalidGenState))
+
+	// the grace mode is imported
+	require.NoError(t, input.SlashingKeeper.DeleteGraceMode(ctx))
+	input.SlashingKeeper.InitGenesis(ctx, genState)
+
+	imported, found, err := input.SlashingKeeper.GetGraceMode(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, graceMode, imported)
+}
+
+func TestValidatorUptime(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	p, err := input.SlashingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	p.SignedBlocksWindow = 100
+	input.SlashingKeeper.SetParams(ctx, p)
+
+	power := int64(100)
+	_, valPubKey := createValidatorWithBalanceAndGetPk(ctx, input, 100_000_000, 100_000_000, 1)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	consAddr := sdk.ConsAddress(valPubKey.Address()).String()
+	queryServer := keeper.NewCustomQueryServer(input.SlashingKeeper)
+
+	_, err = queryServer.ValidatorUptime(ctx, &customtypes.QueryValidatorUptimeRequest{ConsAddress: sdk.ConsAddress([]byte("unknown_validator___")).String()})
+	require.Error(t, err)
+
+	// 40 blocks signed and 10 blocks missed
+	height := int64(0)
+	for ; height < 40; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		require.NoError(t, input.SlashingKeeper.HandleValidatorSignature(ctx, valPubKey.Address(), power, comet.BlockIDFlagCommit))
+	}
+	for ; height < 50; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		require.NoError(t, input.SlashingKeeper.HandleValidatorSignature(ctx, valPubKey.Address(), power, comet.BlockIDFlagAbsent))
+	}
+
+	uptimeRes, err := queryServer.ValidatorUptime(ctx, &customtypes.QueryValidatorUptimeRequest{ConsAddress: consAddr})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), uptimeRes.SignedBlocksWindow)
+	require.Equal(t, int64(50), uptimeRes.WindowBlocks)
+	require.Equal(t, int64(10), uptimeRes.MissedBlocksCounter)
+	require.Equal(t, math.LegacyNewDecWithPrec(8, 1), uptimeRes.Uptime)
+
+	missedBlocksRes, err := queryServer.MissedBlocks(ctx, &customtypes.QueryMissedBlocksRequest{ConsAddress: consAddr})
+	require.NoError(t, err)
+	require.Len(t, missedBlocksRes.MissedBlocks, 10)
+	require.Equal(t, int64(40), missedBlocksRes.MissedBlocks[0].Index)
+
+	// the window is full
+	for ; height < 150; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		require.NoError(t, input.SlashingKeeper.HandleValidatorSignature(ctx, valPubKey.Address(), power, comet.BlockIDFlagCommit))
+	}
+
+	uptimeRes, err = queryServer.ValidatorUptime(ctx, &customtypes.QueryValidatorUptimeRequest{ConsAddress: consAddr})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), uptimeRes.WindowBlocks)
+	require.Zero(t, uptimeRes.MissedBlocksCounter)
+	require.Equal(t, math.LegacyOneDec(), uptimeRes.Uptime)
+}
@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/cometbft/cometbft/crypto"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 
@@ -65,6 +67,42 @@ func (h Hooks) AfterValidatorCreated(ctx context.Context, valAddr sdk.ValAddress
 	return h.k.AddPubkey(ctx, consPk)
 }
 
+// AfterConsensusPubKeyUpdate adds the address-pubkey relation of the new key and moves
+// the signing info and the missed block bitmap to the new consensus address. The old
+// address-pubkey relation is kept to handle the evidences of the old key until the
+// rotation matures.
+func (h Hooks) AfterConsensusPubKeyUpdate(ctx context.Context, oldPubKey, newPubKey cryptotypes.PubKey, _ sdk.ValAddress) error {
+	if err := h.k.AddPubkey(ctx, newPubKey); err != nil {
+		return err
+	}
+
+	oldConsAddr := sdk.ConsAddress(oldPubKey.Address())
+	newConsAddr := sdk.ConsAddress(newPubKey.Address())
+
+	signingInfo, err := h.k.GetValidatorSigningInfo(ctx, oldConsAddr)
+	if errors.Is(err, types.ErrNoSigningInfoFound) {
+		// the validator has never been bonded
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	signingInfo.Address = newConsAddr.String()
+	if err := h.k.SetValidatorSigningInfo(ctx, newConsAddr, signingInfo); err != nil {
+		return err
+	}
+	if err := h.k.deleteValidatorSigningInfo(ctx, oldConsAddr); err != nil {
+		return err
+	}
+
+	return h.k.moveMissedBlockBitmap(ctx, oldConsAddr, newConsAddr)
+}
+
+// AfterConsensusPubKeyRotationMatured deletes the address-pubkey relation of the old key.
+func (h Hooks) AfterConsensusPubKeyRotationMatured(ctx context.Context, oldConsAddr sdk.ConsAddress, _ sdk.ValAddress) error {
+	return h.k.deleteAddrPubkeyRelation(ctx, crypto.Address(oldConsAddr))
+}
+
 func (h Hooks) AfterValidatorBeginUnbonding(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
 	return nil
 }
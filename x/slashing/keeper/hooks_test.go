@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/comet"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	staking "github.com/initia-labs/initia/x/mstaking"
+	stakingkeeper "github.com/initia-labs/initia/x/mstaking/keeper"
+	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
+)
+
+func TestConsPubKeyRotation(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	signedBlock, err := input.SlashingKeeper.SignedBlocksWindow(ctx)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(signedBlock + 1)
+
+	valAddr, oldPubKey := createValidatorWithBalanceAndGetPk(ctx, input, 100_000_000, 10_000_000, 1)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	oldConsAddr := sdk.ConsAddress(oldPubKey.Address())
+	require.NoError(t, input.SlashingKeeper.HandleValidatorSignature(ctx, oldPubKey.Address(), 10, comet.BlockIDFlagAbsent))
+
+	// rotate the consensus key
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	newPubKey := ed25519.GenPrivKey().PubKey()
+	newConsAddr := sdk.ConsAddress(newPubKey.Address())
+
+	msg, err := stakingtypes.NewMsgRotateConsPubKey(valAddr.String(), newPubKey)
+	require.NoError(t, err)
+	_, err = stakingkeeper.NewMsgServerImpl(input.StakingKeeper).RotateConsPubKey(ctx, msg)
+	require.NoError(t, err)
+
+	// signing info and missed blocks are moved to the new consensus address
+	_, err = input.SlashingKeeper.GetValidatorSigningInfo(ctx, oldConsAddr)
+	require.ErrorIs(t, err, types.ErrNoSigningInfoFound)
+
+	info, err := input.SlashingKeeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.NoError(t, err)
+	require.Equal(t, newConsAddr.String(), info.Address)
+	require.Equal(t, int64(1), info.MissedBlocksCounter)
+
+	missed, err := input.SlashingKeeper.GetMissedBlockBitmapValue(ctx, newConsAddr, 0)
+	require.NoError(t, err)
+	require.True(t, missed)
+
+	// both pubkeys are registered during the unbonding period
+	_, err = input.SlashingKeeper.GetPubkey(ctx, oldPubKey.Address())
+	require.NoError(t, err)
+	_, err = input.SlashingKeeper.GetPubkey(ctx, newPubKey.Address())
+	require.NoError(t, err)
+
+	// the signature of the old key is recorded to the new signing info
+	require.NoError(t, input.SlashingKeeper.HandleValidatorSignature(ctx, oldPubKey.Address(), 10, comet.BlockIDFlagAbsent))
+	info, err = input.SlashingKeeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), info.IndexOffset)
+	require.Equal(t, int64(2), info.MissedBlocksCounter)
+
+	// mature the rotation
+	unbondingTime, err := input.StakingKeeper.UnbondingTime(ctx)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(unbondingTime))
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	_, err = input.SlashingKeeper.GetPubkey(ctx, oldPubKey.Address())
+	require.Error(t, err)
+	_, err = input.SlashingKeeper.GetPubkey(ctx, newPubKey.Address())
+	require.NoError(t, err)
+}
@@ -33,6 +33,27 @@ func (k Keeper) HandleValidatorSignature(ctx context.Context, addr cryptotypes.A
 		return nil
 	}
 
+	// during the grace mode, the votes are not tracked at all, so the downtime caused
+	// by a chain-wide incident neither jails the validators nor stays in their window.
+	graceModeActive, err := k.IsGraceModeActive(ctx, height)
+	if err != nil {
+		return err
+	} else if graceModeActive {
+		return nil
+	}
+
+	// the votes signed with the old key are delivered until the consensus engine
+	// applies the key rotation, so track the signing info of the current key
+	validator, err := k.sk.ValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return err
+	}
+
+	consAddr, err = validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
 	// fetch signing info
 	signInfo, err := k.GetValidatorSigningInfo(ctx, consAddr)
 	if err != nil {
@@ -59,6 +80,7 @@ func (k Keeper) HandleValidatorSignature(ctx context.Context, addr cryptotypes.A
 	}
 
 	missed := signed == comet.BlockIDFlagAbsent
+
 	switch {
 	case !previous && missed:
 		// Bitmap value has changed from not missed to missed, so we flip the bit
@@ -109,11 +131,6 @@ func (k Keeper) HandleValidatorSignature(ctx context.Context, addr cryptotypes.A
 
 	// if we are past the minimum height and the validator has missed too many blocks, punish them
 	if height > minHeight && signInfo.MissedBlocksCounter > maxMissed {
-		validator, err := k.sk.ValidatorByConsAddr(ctx, consAddr)
-		if err != nil {
-			return err
-		}
-
 		if validator != nil && !validator.IsJailed() {
 			// Downtime confirmed: slash and jail the validator
 			// We need to retrieve the stake distribution which signed the block, so we subtract ValidatorUpdateDelay from the evidence height,
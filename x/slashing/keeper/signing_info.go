@@ -50,6 +50,12 @@ func (k Keeper) SetValidatorSigningInfo(ctx context.Context, address sdk.ConsAdd
 	return store.Set(types.ValidatorSigningInfoKey(address), bz)
 }
 
+// deleteValidatorSigningInfo removes the validator signing info of a consensus address
+func (k Keeper) deleteValidatorSigningInfo(ctx context.Context, address sdk.ConsAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.ValidatorSigningInfoKey(address))
+}
+
 // IterateValidatorSigningInfos iterates over the stored ValidatorSigningInfo
 func (k Keeper) IterateValidatorSigningInfos(ctx context.Context,
 	handler func(address sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool),
@@ -215,6 +221,40 @@ func (k Keeper) DeleteMissedBlockBitmap(ctx context.Context, addr sdk.ConsAddres
 	return nil
 }
 
+// moveMissedBlockBitmap moves a validator's missed block bitmap from one
+// consensus address to another.
+func (k Keeper) moveMissedBlockBitmap(ctx context.Context, from, to sdk.ConsAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	fromPrefix := types.ValidatorMissedBlockBitmapPrefixKey(from)
+	toPrefix := types.ValidatorMissedBlockBitmapPrefixKey(to)
+
+	iter, err := store.Iterator(fromPrefix, storetypes.PrefixEndBytes(fromPrefix))
+	if err != nil {
+		return err
+	}
+
+	var keys, chunks [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+		chunks = append(chunks, iter.Value())
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for i, key := range keys {
+		chunkIndex := key[len(fromPrefix):]
+		if err := store.Set(append(append([]byte{}, toPrefix...), chunkIndex...), chunks[i]); err != nil {
+			return err
+		}
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // IterateMissedBlockBitmap iterates over a validator's signed blocks window
 // bitmap and performs a callback function on each index, i.e. block height, in
 // the range [0, SignedBlocksWindow).
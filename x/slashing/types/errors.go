@@ -0,0 +1,12 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// x/slashing module sentinel errors
+var (
+	ErrInvalidGraceMode = errorsmod.Register(types.ModuleName, 100, "invalid grace mode")
+)
@@ -0,0 +1,10 @@
+package types
+
+// slashing module event types
+const (
+	EventTypeActivateGraceMode   = "activate_grace_mode"
+	EventTypeDeactivateGraceMode = "deactivate_grace_mode"
+
+	AttributeKeyStartHeight = "start_height"
+	AttributeKeyEndHeight   = "end_height"
+)
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// NewGenesisState creates a new slashing genesis state
+func NewGenesisState(
+	params slashingtypes.Params, signingInfos []slashingtypes.SigningInfo,
+	missedBlocks []slashingtypes.ValidatorMissedBlocks, graceMode *GraceMode,
+) *GenesisState {
+	return &GenesisState{
+		Params:       params,
+		SigningInfos: signingInfos,
+		MissedBlocks: missedBlocks,
+		GraceMode:    graceMode,
+	}
+}
+
+// DefaultGenesisState returns the default slashing genesis state
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:       slashingtypes.DefaultParams(),
+		SigningInfos: []slashingtypes.SigningInfo{},
+		MissedBlocks: []slashingtypes.ValidatorMissedBlocks{},
+	}
+}
+
+// ValidateGenesis validates the slashing genesis state
+func ValidateGenesis(data GenesisState) error {
+	if err := slashingtypes.ValidateGenesis(slashingtypes.GenesisState{
+		Params:       data.Params,
+		SigningInfos: data.SigningInfos,
+		MissedBlocks: data.MissedBlocks,
+	}); err != nil {
+		return err
+	}
+
+	if data.GraceMode != nil && data.GraceMode.StartHeight >= data.GraceMode.EndHeight {
+		return fmt.Errorf("grace mode start height %d must be less than end height %d", data.GraceMode.StartHeight, data.GraceMode.EndHeight)
+	}
+
+	return nil
+}
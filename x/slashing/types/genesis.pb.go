@@ -0,0 +1,525 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: initia/slashing/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the slashing module's genesis state. It extends the
+// cosmos slashing genesis state with the grace mode.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params types.Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// signing_infos represents a map between validator addresses and their
+	// signing infos.
+	SigningInfos []types.SigningInfo `protobuf:"bytes,2,rep,name=signing_infos,json=signingInfos,proto3" json:"signing_infos"`
+	// missed_blocks represents a map between validator addresses and their
+	// missed blocks.
+	MissedBlocks []types.ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+	// grace_mode is the last activated grace mode, which is empty if never
+	// activated or deactivated.
+	GraceMode *GraceMode `protobuf:"bytes,4,opt,name=grace_mode,json=graceMode,proto3" json:"grace_mode,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_441346ed3f1003ad, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() types.Params {
+	if m != nil {
+		return m.Params
+	}
+	return types.Params{}
+}
+
+func (m *GenesisState) GetSigningInfos() []types.SigningInfo {
+	if m != nil {
+		return m.SigningInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMissedBlocks() []types.ValidatorMissedBlocks {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGraceMode() *GraceMode {
+	if m != nil {
+		return m.GraceMode
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "initia.slashing.v1.GenesisState")
+}
+
+func init() { proto.RegisterFile("initia/slashing/v1/genesis.proto", fileDescriptor_441346ed3f1003ad) }
+
+var fileDescriptor_441346ed3f1003ad = []byte{
+	// 356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x31, 0x4f, 0xf2, 0x40,
+	0x18, 0xc7, 0x5b, 0x78, 0x43, 0x42, 0x81, 0xe1, 0x6d, 0x1c, 0x1a, 0x12, 0x0b, 0x1a, 0x35, 0xc4,
+	0xc4, 0x6b, 0xc0, 0xd5, 0xa9, 0x0b, 0x3a, 0x90, 0x18, 0x30, 0x0e, 0x0e, 0x92, 0x6b, 0x7b, 0x1c,
+	0x17, 0xdb, 0x3b, 0xd2, 0xe7, 0x24, 0xfa, 0x2d, 0xfc, 0x18, 0x8e, 0x7e, 0x0a, 0xc3, 0xc8, 0xe8,
+	0x64, 0x0c, 0x0c, 0x7e, 0x0d, 0xc3, 0x1d, 0x48, 0x51, 0x59, 0x9a, 0x7f, 0xae, 0xbf, 0xff, 0xef,
+	0x9e, 0xcb, 0x63, 0xd5, 0x19, 0x67, 0x92, 0x61, 0x0f, 0x62, 0x0c, 0x43, 0xc6, 0xa9, 0x37, 0x6e,
+	0x7a, 0x94, 0x70, 0x02, 0x0c, 0xd0, 0x28, 0x15, 0x52, 0xd8, 0xb6, 0x26, 0xd0, 0x8a, 0x40, 0xe3,
+	0x66, 0xf5, 0x3f, 0x4e, 0x18, 0x17, 0x9e, 0xfa, 0x6a, 0xac, 0x7a, 0x18, 0x0a, 0x48, 0x04, 0x64,
+	0x45, 0x01, 0x91, 0xf8, 0x87, 0xad, 0x7a, 0xb4, 0x0d, 0xfb, 0xd6, 0x6b, 0x6e, 0x87, 0x0a, 0x2a,
+	0x54, 0xf4, 0x16, 0x69, 0x79, 0xba, 0xf7, 0xc7, 0xb4, 0x9b, 0xc5, 0xfd, 0xd7, 0x9c, 0x55, 0x6e,
+	0xeb, 0x2b, 0x7b, 0x12, 0x4b, 0x62, 0xfb, 0x56, 0x61, 0x84, 0x53, 0x9c, 0x80, 0x63, 0xd6, 0xcd,
+	0x46, 0xa9, 0x55, 0x43, 0x7a, 0x84, 0xec, 0x83, 0xd4, 0x08, 0xe8, 0x52, 0x61, 0x7e, 0x71, 0xf2,
+	0x5e, 0x33, 0x9e, 0x3f, 0x5f, 0x8e, 0xcd, 0xee, 0xb2, 0x69, 0x5f, 0x59, 0x15, 0x60, 0x94, 0x33,
+	0x4e, 0xfb, 0x8c, 0x0f, 0x04, 0x38, 0xb9, 0x7a, 0xbe, 0x51, 0x6a, 0x1d, 0x6c, 0x55, 0xf5, 0x34,
+	0x7d, 0xc1, 0x07, 0x22, 0xeb, 0x2b, 0xc3, 0xfa, 0x1c, 0xec, 0x5b, 0xab, 0x92, 0x30, 0x00, 0x12,
+	0xf5, 0x83, 0x58, 0x84, 0x77, 0xe0, 0xe4, 0x95, 0x15, 0x6d, 0xb5, 0x5e, 0xe3, 0x98, 0x45, 0x58,
+	0x8a, 0xb4, 0xa3, 0x6a, 0xbe, 0x6a, 0x6d, 0xf8, 0x93, 0xcc, 0x0f, 0xfb, 0xcc, 0xb2, 0x68, 0x8a,
+	0x43, 0xd2, 0x4f, 0x44, 0x44, 0x9c, 0x7f, 0xea, 0xf5, 0xbb, 0xe8, 0xf7, 0x3a, 0x51, 0x7b, 0x41,
+	0x75, 0x44, 0x44, 0xba, 0x45, 0xba, 0x8a, 0xfe, 0xf9, 0x64, 0xe6, 0x9a, 0xd3, 0x99, 0x6b, 0x7e,
+	0xcc, 0x5c, 0xf3, 0x69, 0xee, 0x1a, 0xd3, 0xb9, 0x6b, 0xbc, 0xcd, 0x5d, 0xe3, 0x06, 0x51, 0x26,
+	0x87, 0xf7, 0x01, 0x0a, 0x45, 0xe2, 0x69, 0xdb, 0x49, 0x8c, 0x03, 0x58, 0x66, 0xef, 0x61, 0xbd,
+	0x1e, 0xf9, 0x38, 0x22, 0x10, 0x14, 0xd4, 0x66, 0x4e, 0xbf, 0x06, 0x00, 0x10, 0xe3, 0xd1, 0x0d,
+	0x6c, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GraceMode != nil {
+		{
+			size, err := m.GraceMode.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MissedBlocks) > 0 {
+		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SigningInfos) > 0 {
+		for iNdEx := len(m.SigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SigningInfos) > 0 {
+		for _, e := range m.SigningInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MissedBlocks) > 0 {
+		for _, e := range m.MissedBlocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.GraceMode != nil {
+		l = m.GraceMode.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningInfos = append(m.SigningInfos, types.SigningInfo{})
+			if err := m.SigningInfos[len(m.SigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBlocks = append(m.MissedBlocks, types.ValidatorMissedBlocks{})
+			if err := m.MissedBlocks[len(m.MissedBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GraceMode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GraceMode == nil {
+				m.GraceMode = &GraceMode{}
+			}
+			if err := m.GraceMode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
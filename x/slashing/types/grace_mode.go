@@ -0,0 +1,6 @@
+package types
+
+// IsActive returns true if the grace mode is active at the given height.
+func (g GraceMode) IsActive(height int64) bool {
+	return g.StartHeight <= height && height < g.EndHeight
+}
@@ -0,0 +1,5 @@
+package types
+
+// GraceModeKey is the key to store the grace mode; the initia specific keys start
+// from 0x90 to avoid the collision with the cosmos slashing keys.
+var GraceModeKey = []byte{0x90}
@@ -0,0 +1,1502 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: initia/slashing/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryMissedBlocksRequest is the request type for the Query/MissedBlocks RPC
+// method.
+type QueryMissedBlocksRequest struct {
+	// cons_address is the address to query the missed blocks for.
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+}
+
+func (m *QueryMissedBlocksRequest) Reset()         { *m = QueryMissedBlocksRequest{} }
+func (m *QueryMissedBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMissedBlocksRequest) ProtoMessage()    {}
+func (*QueryMissedBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1f68280573da87f, []int{0}
+}
+func (m *QueryMissedBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMissedBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMissedBlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMissedBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissedBlocksRequest.Merge(m, src)
+}
+func (m *QueryMissedBlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMissedBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissedBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMissedBlocksRequest proto.InternalMessageInfo
+
+func (m *QueryMissedBlocksRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+// QueryMissedBlocksResponse is the response type for the Query/MissedBlocks
+// RPC method.
+type QueryMissedBlocksResponse struct {
+	// missed_blocks defines the indexes of the missed blocks in the signed
+	// blocks window bitmap.
+	MissedBlocks []types.MissedBlock `protobuf:"bytes,1,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+}
+
+func (m *QueryMissedBlocksResponse) Reset()         { *m = QueryMissedBlocksResponse{} }
+func (m *QueryMissedBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMissedBlocksResponse) ProtoMessage()    {}
+func (*QueryMissedBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1f68280573da87f, []int{1}
+}
+func (m *QueryMissedBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMissedBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMissedBlocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMissedBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissedBlocksResponse.Merge(m, src)
+}
+func (m *QueryMissedBlocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMissedBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissedBlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMissedBlocksResponse proto.InternalMessageInfo
+
+func (m *QueryMissedBlocksResponse) GetMissedBlocks() []types.MissedBlock {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return nil
+}
+
+// QueryValidatorUptimeRequest is the request type for the
+// Query/ValidatorUptime RPC method.
+type QueryValidatorUptimeRequest struct {
+	// cons_address is the address to query the uptime for.
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+}
+
+func (m *QueryValidatorUptimeRequest) Reset()         { *m = QueryValidatorUptimeRequest{} }
+func (m *QueryValidatorUptimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorUptimeRequest) ProtoMessage()    {}
+func (*QueryValidatorUptimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1f68280573da87f, []int{2}
+}
+func (m *QueryValidatorUptimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorUptimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorUptimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorUptimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorUptimeRequest.Merge(m, src)
+}
+func (m *QueryValidatorUptimeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorUptimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorUptimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorUptimeRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorUptimeRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+// QueryValidatorUptimeResponse is the response type for the
+// Query/ValidatorUptime RPC method.
+type QueryValidatorUptimeResponse struct {
+	// signed_blocks_window is the size of the signed blocks window.
+	SignedBlocksWindow int64 `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+	// window_blocks is the number of blocks the validator should have signed in
+	// the current window, which is less than the window size for the validators
+	// recently started or unjailed.
+	WindowBlocks int64 `protobuf:"varint,2,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	// missed_blocks_counter is the number of blocks missed in the window.
+	MissedBlocksCounter int64 `protobuf:"varint,3,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// uptime is the ratio of the signed blocks to the window blocks.
+	Uptime cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=uptime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"uptime"`
+}
+
+func (m *QueryValidatorUptimeResponse) Reset()         { *m = QueryValidatorUptimeResponse{} }
+func (m *QueryValidatorUptimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorUptimeResponse) ProtoMessage()    {}
+func (*QueryValidatorUptimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1f68280573da87f, []int{3}
+}
+func (m *QueryValidatorUptimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorUptimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorUptimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorUptimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorUptimeResponse.Merge(m, src)
+}
+func (m *QueryValidatorUptimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorUptimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorUptimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorUptimeResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorUptimeResponse) GetSignedBlocksWindow() int64 {
+	if m != nil {
+		return m.SignedBlocksWindow
+	}
+	return 0
+}
+
+func (m *QueryValidatorUptimeResponse) GetWindowBlocks() int64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+func (m *QueryValidatorUptimeResponse) GetMissedBlocksCounter() int64 {
+	if m != nil {
+		return m.MissedBlocksCounter
+	}
+	return 0
+}
+
+// QueryGraceModeRequest is the request type for the Query/GraceMode RPC
+// method.
+type QueryGraceModeRequest struct {
+}
+
+func (m *QueryGraceModeRequest) Reset()         { *m = QueryGraceModeRequest{} }
+func (m *QueryGraceModeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGraceModeRequest) ProtoMessage()    {}
+func (*QueryGraceModeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1f68280573da87f, []int{4}
+}
+func (m *QueryGraceModeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGraceModeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGraceModeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGraceModeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGraceModeRequest.Merge(m, src)
+}
+func (m *QueryGraceModeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGraceModeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGraceModeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGraceModeRequest proto.InternalMessageInfo
+
+// QueryGraceModeResponse is the response type for the Query/GraceMode RPC
+// method.
+type QueryGraceModeResponse struct {
+	// grace_mode is the last activated grace mode, which is empty if never
+	// activated or deactivated.
+	GraceMode *GraceMode `protobuf:"bytes,1,opt,name=grace_mode,json=graceMode,proto3" json:"grace_mode,omitempty"`
+	// active is true if the grace mode is active at the current height.
+	Active bool `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (m *QueryGraceModeResponse) Reset()         { *m = QueryGraceModeResponse{} }
+func (m *QueryGraceModeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGraceModeResponse) ProtoMessage()    {}
+func (*QueryGraceModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1f68280573da87f, []int{5}
+}
+func (m *QueryGraceModeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGraceModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGraceModeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGraceModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGraceModeResponse.Merge(m, src)
+}
+func (m *QueryGraceModeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGraceModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGraceModeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGraceModeResponse proto.InternalMessageInfo
+
+func (m *QueryGraceModeResponse) GetGraceMode() *GraceMode {
+	if m != nil {
+		return m.GraceMode
+	}
+	return nil
+}
+
+func (m *QueryGraceModeResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QueryMissedBlocksRequest)(nil), "initia.slashing.v1.QueryMissedBlocksRequest")
+	proto.RegisterType((*QueryMissedBlocksResponse)(nil), "initia.slashing.v1.QueryMissedBlocksResponse")
+	proto.RegisterType((*QueryValidatorUptimeRequest)(nil), "initia.slashing.v1.QueryValidatorUptimeRequest")
+	proto.RegisterType((*QueryValidatorUptimeResponse)(nil), "initia.slashing.v1.QueryValidatorUptimeResponse")
+	proto.RegisterType((*QueryGraceModeRequest)(nil), "initia.slashing.v1.QueryGraceModeRequest")
+	proto.RegisterType((*QueryGraceModeResponse)(nil), "initia.slashing.v1.QueryGraceModeResponse")
+}
+
+func init() { proto.RegisterFile("initia/slashing/v1/query.proto", fileDescriptor_b1f68280573da87f) }
+
+var fileDescriptor_b1f68280573da87f = []byte{
+	// 623 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x6b, 0x13, 0x41,
+	0x18, 0xcd, 0x36, 0x5a, 0xcc, 0x34, 0x45, 0x1c, 0xdb, 0x1a, 0xd3, 0xba, 0x6d, 0xb7, 0x2a, 0x6d,
+	0xb5, 0x3b, 0x4d, 0xbc, 0x08, 0x2a, 0x68, 0x14, 0xf4, 0x60, 0x0f, 0x2e, 0xfe, 0x00, 0x2f, 0x61,
+	0xb2, 0x3b, 0x6c, 0x87, 0x66, 0x67, 0xd2, 0x9d, 0x49, 0x6a, 0x10, 0x2f, 0x1e, 0x3d, 0x09, 0xfe,
+	0x0d, 0xa2, 0x47, 0xff, 0x8c, 0x1e, 0x0b, 0x5e, 0xc4, 0x43, 0x91, 0x44, 0xea, 0xbf, 0x21, 0x3b,
+	0xb3, 0x9b, 0x6c, 0xe3, 0x06, 0xe2, 0x25, 0x4c, 0xbe, 0xef, 0x7d, 0x6f, 0xde, 0xfb, 0xe6, 0x25,
+	0xc0, 0xa4, 0x8c, 0x4a, 0x8a, 0x91, 0x68, 0x62, 0xb1, 0x4b, 0x99, 0x8f, 0x3a, 0x15, 0xb4, 0xdf,
+	0x26, 0x61, 0xd7, 0x6e, 0x85, 0x5c, 0x72, 0x08, 0x75, 0xdf, 0x4e, 0xfa, 0x76, 0xa7, 0x52, 0xbe,
+	0x80, 0x03, 0xca, 0x38, 0x52, 0x9f, 0x1a, 0x56, 0xbe, 0xe6, 0x72, 0x11, 0x70, 0x91, 0xa6, 0x69,
+	0x10, 0x89, 0x2b, 0xc8, 0x27, 0x8c, 0x08, 0x2a, 0x62, 0xd8, 0x9c, 0xcf, 0x7d, 0xae, 0x8e, 0x28,
+	0x3a, 0xc5, 0xd5, 0x25, 0x9f, 0x73, 0xbf, 0x49, 0x10, 0x6e, 0x51, 0x84, 0x19, 0xe3, 0x12, 0x4b,
+	0xca, 0x59, 0x32, 0xb3, 0x9a, 0xa1, 0x70, 0xa0, 0x46, 0x41, 0xac, 0x7b, 0xa0, 0xf4, 0x2c, 0xd2,
+	0xbc, 0x43, 0x85, 0x20, 0x5e, 0xad, 0xc9, 0xdd, 0x3d, 0xe1, 0x90, 0xfd, 0x36, 0x11, 0x12, 0xae,
+	0x82, 0xa2, 0xcb, 0x99, 0xa8, 0x63, 0xcf, 0x0b, 0x89, 0x10, 0x25, 0x63, 0xc5, 0x58, 0x2f, 0x38,
+	0x33, 0x51, 0xed, 0x81, 0x2e, 0x59, 0xfb, 0xe0, 0x72, 0xc6, 0xb8, 0x68, 0x71, 0x26, 0x08, 0x7c,
+	0x0e, 0x66, 0x03, 0x55, 0xaf, 0x37, 0x54, 0xa3, 0x64, 0xac, 0xe4, 0xd7, 0x67, 0xaa, 0x57, 0x6d,
+	0xed, 0x38, 0xbd, 0x18, 0xe5, 0xd8, 0x4e, 0xb1, 0xd4, 0x0a, 0x87, 0xc7, 0xcb, 0xb9, 0xaf, 0x7f,
+	0xbe, 0x6d, 0x1a, 0x4e, 0x31, 0x48, 0xb1, 0x5b, 0xf7, 0xc1, 0xa2, 0xba, 0xf2, 0x25, 0x6e, 0x52,
+	0x0f, 0x4b, 0x1e, 0xbe, 0x68, 0x49, 0x1a, 0x90, 0xff, 0x10, 0x7d, 0x62, 0x80, 0xa5, 0x6c, 0x8a,
+	0x58, 0xf8, 0x36, 0x98, 0x13, 0xd4, 0x67, 0x03, 0xe1, 0xf5, 0x03, 0xca, 0x3c, 0x7e, 0xa0, 0xb8,
+	0xf2, 0x0e, 0xd4, 0x3d, 0x2d, 0xe7, 0x95, 0xea, 0xc0, 0x35, 0x30, 0xab, 0x31, 0x89, 0xd5, 0x29,
+	0x05, 0x2d, 0xea, 0xa2, 0x86, 0xc2, 0x2a, 0x98, 0x3f, 0xb5, 0x8f, 0xba, 0xcb, 0xdb, 0x4c, 0x92,
+	0xb0, 0x94, 0x57, 0xe0, 0x8b, 0x69, 0x9b, 0x0f, 0x75, 0x0b, 0xde, 0x01, 0xd3, 0x6d, 0x25, 0xae,
+	0x74, 0x26, 0x32, 0x52, 0x5b, 0x8b, 0xd6, 0xf2, 0xf3, 0x78, 0x79, 0x51, 0xef, 0x50, 0x78, 0x7b,
+	0x36, 0xe5, 0x28, 0xc0, 0x72, 0xd7, 0x7e, 0x4a, 0x7c, 0xec, 0x76, 0x1f, 0x11, 0xd7, 0x89, 0x47,
+	0xac, 0x4b, 0x60, 0x5e, 0xf9, 0x7c, 0x1c, 0x62, 0x97, 0xec, 0x70, 0x2f, 0x59, 0x92, 0xc5, 0xc0,
+	0xc2, 0x68, 0x23, 0xb6, 0x7e, 0x17, 0x00, 0x3f, 0x2a, 0xd6, 0x03, 0xee, 0x11, 0x65, 0x78, 0xa6,
+	0x7a, 0xc5, 0xfe, 0x37, 0xc9, 0xf6, 0x70, 0xb4, 0xe0, 0x27, 0x47, 0xb8, 0x00, 0xa6, 0xb1, 0x2b,
+	0x69, 0x87, 0x28, 0xff, 0xe7, 0x9c, 0xf8, 0x5b, 0xf5, 0x24, 0x0f, 0xce, 0xaa, 0x0b, 0xe1, 0x67,
+	0x03, 0x14, 0xd3, 0x61, 0x81, 0x37, 0xb3, 0xc8, 0xc7, 0x45, 0xb2, 0xbc, 0x35, 0x21, 0x5a, 0xbb,
+	0xb1, 0x6e, 0xbf, 0xff, 0xfe, 0xfb, 0xd3, 0x54, 0x15, 0x6e, 0xa3, 0x8c, 0x5f, 0xc2, 0xa9, 0xb7,
+	0x40, 0x6f, 0xd3, 0xa9, 0x79, 0x07, 0xbf, 0x18, 0xe0, 0xfc, 0x48, 0x3c, 0x20, 0x1a, 0x7b, 0x79,
+	0x76, 0x16, 0xcb, 0xdb, 0x93, 0x0f, 0xc4, 0x82, 0x2b, 0x4a, 0xf0, 0x0d, 0xb8, 0x91, 0x25, 0x58,
+	0xbf, 0xea, 0xa8, 0xd2, 0x0f, 0x06, 0x28, 0x0c, 0x1e, 0x03, 0x6e, 0x8c, 0xbd, 0x72, 0x34, 0x04,
+	0xe5, 0xcd, 0x49, 0xa0, 0xb1, 0xae, 0xeb, 0x4a, 0xd7, 0x0a, 0x34, 0xb3, 0x74, 0x0d, 0x03, 0x53,
+	0x7b, 0x72, 0xd8, 0x33, 0x8d, 0xa3, 0x9e, 0x69, 0xfc, 0xea, 0x99, 0xc6, 0xc7, 0xbe, 0x99, 0x3b,
+	0xea, 0x9b, 0xb9, 0x1f, 0x7d, 0x33, 0xf7, 0xda, 0xf6, 0xa9, 0xdc, 0x6d, 0x37, 0x6c, 0x97, 0x07,
+	0x31, 0xc7, 0x56, 0x13, 0x37, 0x44, 0xc2, 0xf7, 0x66, 0xc8, 0x28, 0xbb, 0x2d, 0x22, 0x1a, 0xd3,
+	0xea, 0xff, 0xe9, 0xd6, 0xdf, 0x01, 0x00, 0x41, 0xb4, 0xe4, 0xc0, 0x66, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// MissedBlocks queries the missed blocks of a validator in the current
+	// signed blocks window.
+	MissedBlocks(ctx context.Context, in *QueryMissedBlocksRequest, opts ...grpc.CallOption) (*QueryMissedBlocksResponse, error)
+	// ValidatorUptime queries the uptime of a validator over the current
+	// signed blocks window.
+	ValidatorUptime(ctx context.Context, in *QueryValidatorUptimeRequest, opts ...grpc.CallOption) (*QueryValidatorUptimeResponse, error)
+	// GraceMode queries the grace mode suspending the downtime jailing.
+	GraceMode(ctx context.Context, in *QueryGraceModeRequest, opts ...grpc.CallOption) (*QueryGraceModeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) MissedBlocks(ctx context.Context, in *QueryMissedBlocksRequest, opts ...grpc.CallOption) (*QueryMissedBlocksResponse, error) {
+	out := new(QueryMissedBlocksResponse)
+	err := c.cc.Invoke(ctx, "/initia.slashing.v1.Query/MissedBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorUptime(ctx context.Context, in *QueryValidatorUptimeRequest, opts ...grpc.CallOption) (*QueryValidatorUptimeResponse, error) {
+	out := new(QueryValidatorUptimeResponse)
+	err := c.cc.Invoke(ctx, "/initia.slashing.v1.Query/ValidatorUptime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GraceMode(ctx context.Context, in *QueryGraceModeRequest, opts ...grpc.CallOption) (*QueryGraceModeResponse, error) {
+	out := new(QueryGraceModeResponse)
+	err := c.cc.Invoke(ctx, "/initia.slashing.v1.Query/GraceMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// MissedBlocks queries the missed blocks of a validator in the current
+	// signed blocks window.
+	MissedBlocks(context.Context, *QueryMissedBlocksRequest) (*QueryMissedBlocksResponse, error)
+	// ValidatorUptime queries the uptime of a validator over the current
+	// signed blocks window.
+	ValidatorUptime(context.Context, *QueryValidatorUptimeRequest) (*QueryValidatorUptimeResponse, error)
+	// GraceMode queries the grace mode suspending the downtime jailing.
+	GraceMode(context.Context, *QueryGraceModeRequest) (*QueryGraceModeResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) MissedBlocks(ctx context.Context, req *QueryMissedBlocksRequest) (*QueryMissedBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissedBlocks not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorUptime(ctx context.Context, req *QueryValidatorUptimeRequest) (*QueryValidatorUptimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorUptime not implemented")
+}
+func (*UnimplementedQueryServer) GraceMode(ctx context.Context, req *QueryGraceModeRequest) (*QueryGraceModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GraceMode not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_MissedBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissedBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MissedBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.slashing.v1.Query/MissedBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MissedBlocks(ctx, req.(*QueryMissedBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorUptime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorUptimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorUptime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.slashing.v1.Query/ValidatorUptime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorUptime(ctx, req.(*QueryValidatorUptimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GraceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGraceModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GraceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.slashing.v1.Query/GraceMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GraceMode(ctx, req.(*QueryGraceModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "initia.slashing.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MissedBlocks",
+			Handler:    _Query_MissedBlocks_Handler,
+		},
+		{
+			MethodName: "ValidatorUptime",
+			Handler:    _Query_ValidatorUptime_Handler,
+		},
+		{
+			MethodName: "GraceMode",
+			Handler:    _Query_GraceMode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "initia/slashing/v1/query.proto",
+}
+
+func (m *QueryMissedBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMissedBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissedBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMissedBlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMissedBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissedBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissedBlocks) > 0 {
+		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorUptimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorUptimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorUptimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorUptimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorUptimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorUptimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Uptime.Size()
+		i -= size
+		if _, err := m.Uptime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGraceModeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGraceModeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGraceModeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGraceModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGraceModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGraceModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GraceMode != nil {
+		{
+			size, err := m.GraceMode.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryMissedBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissedBlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MissedBlocks) > 0 {
+		for _, e := range m.MissedBlocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorUptimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorUptimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovQuery(uint64(m.SignedBlocksWindow))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.WindowBlocks))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovQuery(uint64(m.MissedBlocksCounter))
+	}
+	l = m.Uptime.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGraceModeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGraceModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GraceMode != nil {
+		l = m.GraceMode.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryMissedBlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissedBlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissedBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissedBlocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissedBlocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissedBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBlocks = append(m.MissedBlocks, types.MissedBlock{})
+			if err := m.MissedBlocks[len(m.MissedBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorUptimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorUptimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorUptimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorUptimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorUptimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorUptimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Uptime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGraceModeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGraceModeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGraceModeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGraceModeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGraceModeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGraceModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GraceMode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GraceMode == nil {
+				m.GraceMode = &GraceMode{}
+			}
+			if err := m.GraceMode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
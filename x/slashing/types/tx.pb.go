@@ -0,0 +1,1010 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: initia/slashing/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgActivateGraceMode is the Msg/ActivateGraceMode request type.
+type MsgActivateGraceMode struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// blocks is the number of blocks the grace mode lasts from the current
+	// height, which must not be greater than the signed blocks window.
+	Blocks uint64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *MsgActivateGraceMode) Reset()         { *m = MsgActivateGraceMode{} }
+func (m *MsgActivateGraceMode) String() string { return proto.CompactTextString(m) }
+func (*MsgActivateGraceMode) ProtoMessage()    {}
+func (*MsgActivateGraceMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea88ec4742b910e1, []int{0}
+}
+func (m *MsgActivateGraceMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgActivateGraceMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgActivateGraceMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgActivateGraceMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgActivateGraceMode.Merge(m, src)
+}
+func (m *MsgActivateGraceMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgActivateGraceMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgActivateGraceMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgActivateGraceMode proto.InternalMessageInfo
+
+func (m *MsgActivateGraceMode) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgActivateGraceMode) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+// MsgActivateGraceModeResponse defines the response structure for executing a
+// MsgActivateGraceMode message.
+type MsgActivateGraceModeResponse struct {
+}
+
+func (m *MsgActivateGraceModeResponse) Reset()         { *m = MsgActivateGraceModeResponse{} }
+func (m *MsgActivateGraceModeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgActivateGraceModeResponse) ProtoMessage()    {}
+func (*MsgActivateGraceModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea88ec4742b910e1, []int{1}
+}
+func (m *MsgActivateGraceModeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgActivateGraceModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgActivateGraceModeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgActivateGraceModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgActivateGraceModeResponse.Merge(m, src)
+}
+func (m *MsgActivateGraceModeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgActivateGraceModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgActivateGraceModeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgActivateGraceModeResponse proto.InternalMessageInfo
+
+// MsgDeactivateGraceMode is the Msg/DeactivateGraceMode request type.
+type MsgDeactivateGraceMode struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgDeactivateGraceMode) Reset()         { *m = MsgDeactivateGraceMode{} }
+func (m *MsgDeactivateGraceMode) String() string { return proto.CompactTextString(m) }
+func (*MsgDeactivateGraceMode) ProtoMessage()    {}
+func (*MsgDeactivateGraceMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea88ec4742b910e1, []int{2}
+}
+func (m *MsgDeactivateGraceMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeactivateGraceMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeactivateGraceMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeactivateGraceMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeactivateGraceMode.Merge(m, src)
+}
+func (m *MsgDeactivateGraceMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeactivateGraceMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeactivateGraceMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeactivateGraceMode proto.InternalMessageInfo
+
+func (m *MsgDeactivateGraceMode) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgDeactivateGraceModeResponse defines the response structure for executing a
+// MsgDeactivateGraceMode message.
+type MsgDeactivateGraceModeResponse struct {
+}
+
+func (m *MsgDeactivateGraceModeResponse) Reset()         { *m = MsgDeactivateGraceModeResponse{} }
+func (m *MsgDeactivateGraceModeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeactivateGraceModeResponse) ProtoMessage()    {}
+func (*MsgDeactivateGraceModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea88ec4742b910e1, []int{3}
+}
+func (m *MsgDeactivateGraceModeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeactivateGraceModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeactivateGraceModeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeactivateGraceModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeactivateGraceModeResponse.Merge(m, src)
+}
+func (m *MsgDeactivateGraceModeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeactivateGraceModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeactivateGraceModeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeactivateGraceModeResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgActivateGraceMode)(nil), "initia.slashing.v1.MsgActivateGraceMode")
+	proto.RegisterType((*MsgActivateGraceModeResponse)(nil), "initia.slashing.v1.MsgActivateGraceModeResponse")
+	proto.RegisterType((*MsgDeactivateGraceMode)(nil), "initia.slashing.v1.MsgDeactivateGraceMode")
+	proto.RegisterType((*MsgDeactivateGraceModeResponse)(nil), "initia.slashing.v1.MsgDeactivateGraceModeResponse")
+}
+
+func init() { proto.RegisterFile("initia/slashing/v1/tx.proto", fileDescriptor_ea88ec4742b910e1) }
+
+var fileDescriptor_ea88ec4742b910e1 = []byte{
+	// 391 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0x1a, 0x31,
+	0x14, 0xc7, 0xfd, 0x83, 0x84, 0x37, 0xae, 0x88, 0xd2, 0x6b, 0xeb, 0xa2, 0x9b, 0xd0, 0x49, 0x9c,
+	0x81, 0x4a, 0x1d, 0xd8, 0x40, 0x95, 0xba, 0xf4, 0x16, 0xba, 0x75, 0xa9, 0x7c, 0x87, 0x65, 0xac,
+	0x72, 0x67, 0x74, 0xcf, 0x20, 0xd8, 0xaa, 0x2a, 0x53, 0xa6, 0x8c, 0xf9, 0x08, 0x19, 0x19, 0xf2,
+	0x21, 0x32, 0xa2, 0x4c, 0x19, 0xa3, 0x63, 0xe0, 0x0b, 0xe4, 0x03, 0x44, 0xb9, 0x3f, 0x21, 0x12,
+	0x17, 0x89, 0x21, 0x8b, 0xf5, 0xde, 0xfb, 0xfd, 0xfc, 0x7e, 0x3f, 0x3f, 0xdb, 0xf8, 0xa3, 0x0c,
+	0xa5, 0x96, 0x8c, 0xc2, 0x94, 0xc1, 0x44, 0x86, 0x82, 0x2e, 0xba, 0x54, 0x2f, 0x9d, 0x59, 0xa4,
+	0xb4, 0x32, 0x8c, 0x14, 0x74, 0x72, 0xd0, 0x59, 0x74, 0xcd, 0x2a, 0x0b, 0x64, 0xa8, 0x68, 0xb2,
+	0xa6, 0x34, 0xf3, 0xbd, 0xaf, 0x20, 0x50, 0x40, 0x03, 0x48, 0xb6, 0x07, 0x20, 0x32, 0xe0, 0x43,
+	0x0a, 0xfc, 0x49, 0x32, 0x9a, 0x26, 0x19, 0x54, 0x13, 0x4a, 0xa8, 0xb4, 0xfe, 0x10, 0xa5, 0x55,
+	0xeb, 0x1c, 0xe1, 0x9a, 0x0b, 0x62, 0xe0, 0x6b, 0xb9, 0x60, 0x9a, 0xff, 0x88, 0x98, 0xcf, 0x5d,
+	0x35, 0xe6, 0xc6, 0x37, 0x5c, 0x61, 0x73, 0x3d, 0x51, 0x91, 0xd4, 0xab, 0x06, 0x6a, 0xa2, 0x56,
+	0x65, 0xd8, 0xb8, 0xbe, 0x6c, 0xd7, 0xb2, 0x9e, 0x83, 0xf1, 0x38, 0xe2, 0x00, 0xbf, 0x74, 0x24,
+	0x43, 0x31, 0xda, 0x53, 0x8d, 0x3a, 0x2e, 0x7b, 0x53, 0xe5, 0xff, 0x85, 0xc6, 0xab, 0x26, 0x6a,
+	0xbd, 0x19, 0x65, 0x59, 0xbf, 0xf3, 0x7f, 0xb7, 0xb6, 0xf7, 0xbc, 0xd3, 0xdd, 0xda, 0xfe, 0xfc,
+	0x38, 0x82, 0x22, 0x07, 0x16, 0xc1, 0x9f, 0x8a, 0xea, 0x23, 0x0e, 0x33, 0x15, 0x02, 0xb7, 0x4e,
+	0x10, 0xae, 0xbb, 0x20, 0xbe, 0x73, 0xf6, 0x52, 0xe6, 0xfb, 0xbd, 0x43, 0x93, 0x5f, 0x9e, 0x9a,
+	0x2c, 0xd0, 0xb2, 0x9a, 0x98, 0x14, 0x23, 0xb9, 0xd1, 0xde, 0x1d, 0xc2, 0xaf, 0x5d, 0x10, 0x86,
+	0xc2, 0xd5, 0xc3, 0x39, 0xb7, 0x9c, 0xc3, 0x2b, 0x77, 0x8a, 0xce, 0x6d, 0x76, 0x8e, 0x65, 0xe6,
+	0xc2, 0xc6, 0x1c, 0xbf, 0x2b, 0x9a, 0x8e, 0xfd, 0x4c, 0xa3, 0x02, 0xae, 0xd9, 0x3b, 0x9e, 0x9b,
+	0xcb, 0x9a, 0x6f, 0xff, 0xed, 0xd6, 0x36, 0x1a, 0xfe, 0xbc, 0x88, 0x09, 0xba, 0x8a, 0x09, 0xda,
+	0xc4, 0x04, 0xdd, 0xc6, 0x04, 0x9d, 0x6d, 0x49, 0x69, 0xb3, 0x25, 0xa5, 0x9b, 0x2d, 0x29, 0xfd,
+	0x76, 0x84, 0xd4, 0x93, 0xb9, 0xe7, 0xf8, 0x2a, 0xa0, 0xa9, 0x44, 0x7b, 0xca, 0x3c, 0xc8, 0x62,
+	0xba, 0xdc, 0xff, 0x0f, 0xbd, 0x9a, 0x71, 0xf0, 0xca, 0xc9, 0x7b, 0xfd, 0x7a, 0x3f, 0x00, 0xeb,
+	0xa2, 0x0c, 0x5c, 0x3f, 0x03, 0x00, 0x00,
+}
+
+func (this *MsgActivateGraceMode) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgActivateGraceMode)
+	if !ok {
+		that2, ok := that.(MsgActivateGraceMode)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if this.Blocks != that1.Blocks {
+		return false
+	}
+	return true
+}
+func (this *MsgActivateGraceModeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgActivateGraceModeResponse)
+	if !ok {
+		that2, ok := that.(MsgActivateGraceModeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgDeactivateGraceMode) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgDeactivateGraceMode)
+	if !ok {
+		that2, ok := that.(MsgDeactivateGraceMode)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	return true
+}
+func (this *MsgDeactivateGraceModeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgDeactivateGraceModeResponse)
+	if !ok {
+		that2, ok := that.(MsgDeactivateGraceModeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ActivateGraceMode defines a governance operation for suspending the
+	// downtime jailing for a bounded number of blocks.
+	ActivateGraceMode(ctx context.Context, in *MsgActivateGraceMode, opts ...grpc.CallOption) (*MsgActivateGraceModeResponse, error)
+	// DeactivateGraceMode defines a governance operation for ending the grace
+	// mode before its end height.
+	DeactivateGraceMode(ctx context.Context, in *MsgDeactivateGraceMode, opts ...grpc.CallOption) (*MsgDeactivateGraceModeResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ActivateGraceMode(ctx context.Context, in *MsgActivateGraceMode, opts ...grpc.CallOption) (*MsgActivateGraceModeResponse, error) {
+	out := new(MsgActivateGraceModeResponse)
+	err := c.cc.Invoke(ctx, "/initia.slashing.v1.Msg/ActivateGraceMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeactivateGraceMode(ctx context.Context, in *MsgDeactivateGraceMode, opts ...grpc.CallOption) (*MsgDeactivateGraceModeResponse, error) {
+	out := new(MsgDeactivateGraceModeResponse)
+	err := c.cc.Invoke(ctx, "/initia.slashing.v1.Msg/DeactivateGraceMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ActivateGraceMode defines a governance operation for suspending the
+	// downtime jailing for a bounded number of blocks.
+	ActivateGraceMode(context.Context, *MsgActivateGraceMode) (*MsgActivateGraceModeResponse, error)
+	// DeactivateGraceMode defines a governance operation for ending the grace
+	// mode before its end height.
+	DeactivateGraceMode(context.Context, *MsgDeactivateGraceMode) (*MsgDeactivateGraceModeResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) ActivateGraceMode(ctx context.Context, req *MsgActivateGraceMode) (*MsgActivateGraceModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateGraceMode not implemented")
+}
+func (*UnimplementedMsgServer) DeactivateGraceMode(ctx context.Context, req *MsgDeactivateGraceMode) (*MsgDeactivateGraceModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateGraceMode not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_ActivateGraceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgActivateGraceMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ActivateGraceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.slashing.v1.Msg/ActivateGraceMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ActivateGraceMode(ctx, req.(*MsgActivateGraceMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeactivateGraceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeactivateGraceMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeactivateGraceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.slashing.v1.Msg/DeactivateGraceMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeactivateGraceMode(ctx, req.(*MsgDeactivateGraceMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "initia.slashing.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ActivateGraceMode",
+			Handler:    _Msg_ActivateGraceMode_Handler,
+		},
+		{
+			MethodName: "DeactivateGraceMode",
+			Handler:    _Msg_DeactivateGraceMode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "initia/slashing/v1/tx.proto",
+}
+
+func (m *MsgActivateGraceMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgActivateGraceMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgActivateGraceMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgActivateGraceModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgActivateGraceModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgActivateGraceModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeactivateGraceMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeactivateGraceMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeactivateGraceMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeactivateGraceModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeactivateGraceModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeactivateGraceModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgActivateGraceMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovTx(uint64(m.Blocks))
+	}
+	return n
+}
+
+func (m *MsgActivateGraceModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeactivateGraceMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeactivateGraceModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgActivateGraceMode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivateGraceMode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivateGraceMode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivateGraceModeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivateGraceModeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivateGraceModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeactivateGraceMode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeactivateGraceMode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeactivateGraceMode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeactivateGraceModeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeactivateGraceModeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeactivateGraceModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)